@@ -31,11 +31,16 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
 
 	"clouditor.io/clouditor/v2/persistence"
 
 	"github.com/google/uuid"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 var (
@@ -43,8 +48,13 @@ var (
 	ErrMetricEmpty             = errors.New("metric is missing or empty")
 	ErrCloudServiceIDIsMissing = errors.New("cloud service id is missing")
 	ErrCloudServiceIDIsInvalid = errors.New("cloud service id is invalid")
+	ErrInvalidMetricVersion    = errors.New("metric version is not a valid semantic version")
+	ErrInvalidTargetValue      = errors.New("target value does not fit the operator")
 )
 
+// DefaultMetricVersion is the version of a metric that is published without an explicit version.
+const DefaultMetricVersion = "1.0.0"
+
 func (r *Range) UnmarshalJSON(b []byte) (err error) {
 	// Check for the different range types
 	var (
@@ -166,3 +176,174 @@ func CheckCloudServiceID(serviceID string) error {
 
 	return nil
 }
+
+// LifecycleState returns the effective lifecycle state of the metric. Metrics without a state, e.g., because they were
+// created before metrics had a lifecycle, are handled as published or as deprecated, if deprecated_since is set.
+func (x *Metric) LifecycleState() Metric_State {
+	if x.GetState() != Metric_STATE_UNSPECIFIED {
+		return x.GetState()
+	}
+
+	if x.GetDeprecatedSince() != nil {
+		return Metric_STATE_DEPRECATED
+	}
+
+	return Metric_STATE_PUBLISHED
+}
+
+// AssuranceLevelRank returns the rank of an assurance level, so that levels can be compared independently of a
+// catalog. The levels basic and low rank lowest, followed by substantial and medium and finally high. An empty or
+// unknown level has the rank 0.
+func AssuranceLevelRank(level string) int {
+	switch level {
+	case "basic", "low":
+		return 1
+	case "substantial", "medium":
+		return 2
+	case "high":
+		return 3
+	default:
+		return 0
+	}
+}
+
+// IsRelevantFor checks, whether the metric needs to be assessed for the given assurance level. Metrics without an
+// assurance level are relevant for all levels. If no level is given, all metrics are relevant.
+func (x *Metric) IsRelevantFor(level string) bool {
+	if x.GetAssuranceLevel() == "" || level == "" {
+		return true
+	}
+
+	return AssuranceLevelRank(x.GetAssuranceLevel()) <= AssuranceLevelRank(level)
+}
+
+// CompareMetricVersions compares two semantic metric versions. The result is 0 if a == b, -1 if a < b and +1 if a > b.
+func CompareMetricVersions(a, b string) (int, error) {
+	va, err := parseMetricVersion(a)
+	if err != nil {
+		return 0, err
+	}
+
+	vb, err := parseMetricVersion(b)
+	if err != nil {
+		return 0, err
+	}
+
+	for i := range va {
+		if va[i] < vb[i] {
+			return -1, nil
+		} else if va[i] > vb[i] {
+			return 1, nil
+		}
+	}
+
+	return 0, nil
+}
+
+// NextMetricVersion returns the next patch version of the given semantic metric version. If version is empty,
+// DefaultMetricVersion is returned.
+func NextMetricVersion(version string) (string, error) {
+	if version == "" {
+		return DefaultMetricVersion, nil
+	}
+
+	v, err := parseMetricVersion(version)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%d.%d.%d", v[0], v[1], v[2]+1), nil
+}
+
+// parseMetricVersion parses a semantic version in the form of major.minor.patch.
+func parseMetricVersion(version string) (v [3]int, err error) {
+	parts := strings.Split(version, ".")
+	if len(parts) != len(v) {
+		return v, ErrInvalidMetricVersion
+	}
+
+	for i, part := range parts {
+		v[i], err = strconv.Atoi(part)
+		if err != nil || v[i] < 0 {
+			return v, ErrInvalidMetricVersion
+		}
+	}
+
+	return v, nil
+}
+
+// CheckTargetValue checks, whether the target value has the form that the operator of a metric configuration expects:
+//   - inRange expects an object with a numeric min and/or max, e.g., {"min": 1, "max": 10}
+//   - oneOf and noneOf expect a list of values
+//   - matches expects a regular expression or a list of regular expressions
+//   - durationAtLeast and durationAtMost expect a duration, e.g., "720h"
+//
+// The target values of all other operators are not checked, since they can be compared with any value.
+func CheckTargetValue(operator string, target *structpb.Value) (err error) {
+	switch operator {
+	case "inRange":
+		err = checkRange(target.GetStructValue())
+	case "oneOf", "noneOf":
+		if target.GetListValue() == nil {
+			err = errors.New("expected a list of values")
+		}
+	case "matches":
+		err = checkPatterns(target)
+	case "durationAtLeast", "durationAtMost":
+		_, err = time.ParseDuration(target.GetStringValue())
+	}
+
+	if err != nil {
+		return fmt.Errorf("%w %s: %v", ErrInvalidTargetValue, operator, err)
+	}
+
+	return nil
+}
+
+// checkRange checks that the range has a numeric min and/or max and that min is not greater than max.
+func checkRange(r *structpb.Struct) error {
+	if r == nil || len(r.Fields) == 0 {
+		return errors.New("expected an object with min and/or max")
+	}
+
+	for key, v := range r.Fields {
+		if key != "min" && key != "max" {
+			return fmt.Errorf("unexpected field %s", key)
+		}
+
+		if _, ok := v.GetKind().(*structpb.Value_NumberValue); !ok {
+			return fmt.Errorf("%s is not a number", key)
+		}
+	}
+
+	lower, hasMin := r.Fields["min"]
+	upper, hasMax := r.Fields["max"]
+	if hasMin && hasMax && lower.GetNumberValue() > upper.GetNumberValue() {
+		return errors.New("min is greater than max")
+	}
+
+	return nil
+}
+
+// checkPatterns checks that the value is a regular expression or a list of regular expressions, which compile.
+func checkPatterns(v *structpb.Value) (err error) {
+	var patterns []*structpb.Value
+
+	if list := v.GetListValue(); list != nil {
+		patterns = list.Values
+	} else {
+		patterns = []*structpb.Value{v}
+	}
+
+	for _, p := range patterns {
+		if _, ok := p.GetKind().(*structpb.Value_StringValue); !ok {
+			return errors.New("expected a regular expression")
+		}
+
+		if _, err = regexp.Compile(p.GetStringValue()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -32,6 +32,7 @@ import (
 	"clouditor.io/clouditor/v2/api"
 	"clouditor.io/clouditor/v2/internal/testdata"
 	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/util"
 	"clouditor.io/clouditor/v2/persistence"
 
 	"google.golang.org/protobuf/runtime/protoimpl"
@@ -392,3 +393,253 @@ func TestMetricConfiguration_Hash(t *testing.T) {
 		})
 	}
 }
+
+func TestMetric_LifecycleState(t *testing.T) {
+	tests := []struct {
+		name   string
+		metric *Metric
+		want   Metric_State
+	}{
+		{
+			name:   "explicit state",
+			metric: &Metric{State: Metric_STATE_DRAFT},
+			want:   Metric_STATE_DRAFT,
+		},
+		{
+			name:   "legacy published",
+			metric: &Metric{},
+			want:   Metric_STATE_PUBLISHED,
+		},
+		{
+			name:   "legacy deprecated",
+			metric: &Metric{DeprecatedSince: timestamppb.Now()},
+			want:   Metric_STATE_DEPRECATED,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.metric.LifecycleState())
+		})
+	}
+}
+
+func TestMetric_IsRelevantFor(t *testing.T) {
+	tests := []struct {
+		name   string
+		metric *Metric
+		level  string
+		want   bool
+	}{
+		{
+			name:   "metric without level",
+			metric: &Metric{},
+			level:  "basic",
+			want:   true,
+		},
+		{
+			name:   "no level selected",
+			metric: &Metric{AssuranceLevel: util.Ref("high")},
+			want:   true,
+		},
+		{
+			name:   "lower level",
+			metric: &Metric{AssuranceLevel: util.Ref("basic")},
+			level:  "substantial",
+			want:   true,
+		},
+		{
+			name:   "same level",
+			metric: &Metric{AssuranceLevel: util.Ref("medium")},
+			level:  "substantial",
+			want:   true,
+		},
+		{
+			name:   "higher level",
+			metric: &Metric{AssuranceLevel: util.Ref("high")},
+			level:  "substantial",
+			want:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.metric.IsRelevantFor(tt.level))
+		})
+	}
+}
+
+func TestCompareMetricVersions(t *testing.T) {
+	type args struct {
+		a string
+		b string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    int
+		wantErr assert.WantErr
+	}{
+		{
+			name:    "equal",
+			args:    args{a: "1.2.3", b: "1.2.3"},
+			want:    0,
+			wantErr: assert.Nil[error],
+		},
+		{
+			name:    "lower",
+			args:    args{a: "1.2.3", b: "1.10.0"},
+			want:    -1,
+			wantErr: assert.Nil[error],
+		},
+		{
+			name:    "greater",
+			args:    args{a: "2.0.0", b: "1.10.0"},
+			want:    1,
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "invalid",
+			args: args{a: "2.0", b: "1.10.0"},
+			want: 0,
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, ErrInvalidMetricVersion)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := CompareMetricVersions(tt.args.a, tt.args.b)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNextMetricVersion(t *testing.T) {
+	tests := []struct {
+		name    string
+		version string
+		want    string
+		wantErr assert.WantErr
+	}{
+		{
+			name:    "empty",
+			version: "",
+			want:    DefaultMetricVersion,
+			wantErr: assert.Nil[error],
+		},
+		{
+			name:    "patch",
+			version: "1.2.3",
+			want:    "1.2.4",
+			wantErr: assert.Nil[error],
+		},
+		{
+			name:    "invalid",
+			version: "a.b.c",
+			want:    "",
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, ErrInvalidMetricVersion)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NextMetricVersion(tt.version)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCheckTargetValue(t *testing.T) {
+	type args struct {
+		operator string
+		target   any
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr assert.WantErr
+	}{
+		{
+			name:    "comparison is not checked",
+			args:    args{operator: "==", target: true},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name:    "inRange",
+			args:    args{operator: "inRange", target: map[string]any{"min": 1, "max": 10}},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name:    "inRange with only max",
+			args:    args{operator: "inRange", target: map[string]any{"max": 10}},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "inRange without bounds",
+			args: args{operator: "inRange", target: map[string]any{}},
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, ErrInvalidTargetValue)
+			},
+		},
+		{
+			name: "inRange with min greater than max",
+			args: args{operator: "inRange", target: map[string]any{"min": 10, "max": 1}},
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "min is greater than max")
+			},
+		},
+		{
+			name: "inRange with string bound",
+			args: args{operator: "inRange", target: map[string]any{"min": "1"}},
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "min is not a number")
+			},
+		},
+		{
+			name:    "oneOf",
+			args:    args{operator: "oneOf", target: []any{"TLS1_2", "TLS1_3"}},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "noneOf without list",
+			args: args{operator: "noneOf", target: "TLS1_0"},
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, ErrInvalidTargetValue)
+			},
+		},
+		{
+			name:    "matches list",
+			args:    args{operator: "matches", target: []any{"^Standard_D", "^Standard_E"}},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "matches invalid regular expression",
+			args: args{operator: "matches", target: "^(Standard"},
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, ErrInvalidTargetValue)
+			},
+		},
+		{
+			name:    "durationAtLeast",
+			args:    args{operator: "durationAtLeast", target: "720h"},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "durationAtMost without unit",
+			args: args{operator: "durationAtMost", target: 30},
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, ErrInvalidTargetValue)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target, err := structpb.NewValue(tt.args.target)
+			assert.NoError(t, err)
+
+			tt.wantErr(t, CheckTargetValue(tt.args.operator, target))
+		})
+	}
+}
@@ -31,6 +31,7 @@ import (
 	"fmt"
 	"net"
 
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"golang.org/x/oauth2"
 	"golang.org/x/oauth2/clientcredentials"
 	"google.golang.org/grpc"
@@ -136,6 +137,9 @@ func DefaultGrpcDialOptions(hostport string, s UsesAuthorizer, additionalOpts ..
 		}
 	}
 
+	// Propagate the trace context to the server
+	opts = append(opts, grpc.WithStatsHandler(otelgrpc.NewClientHandler()))
+
 	// Apply any additional options that we might have
 	opts = append(opts, additionalOpts...)
 
@@ -0,0 +1,70 @@
+// Copyright 2023 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package api
+
+import (
+	"io"
+
+	"github.com/klauspost/compress/zstd"
+	"google.golang.org/grpc/encoding"
+	"google.golang.org/grpc/encoding/gzip"
+)
+
+const (
+	// CompressorGzip is the name of the gzip compressor for gRPC calls.
+	CompressorGzip = gzip.Name
+
+	// CompressorZstd is the name of the zstd compressor for gRPC calls. It compresses better and faster than gzip and
+	// is therefore recommended for streaming evidences, which mostly consist of raw JSON payloads of the cloud APIs.
+	CompressorZstd = "zstd"
+)
+
+// Compressors contains the names of all compressors that can be used for gRPC calls. Since they are registered by
+// this package, all of our gRPC servers accept calls compressed with them.
+var Compressors = []string{CompressorGzip, CompressorZstd}
+
+func init() {
+	encoding.RegisterCompressor(zstdCompressor{})
+}
+
+// zstdCompressor implements [encoding.Compressor] using zstd.
+type zstdCompressor struct{}
+
+// Name implements [encoding.Compressor].
+func (zstdCompressor) Name() string {
+	return CompressorZstd
+}
+
+// Compress implements [encoding.Compressor].
+func (zstdCompressor) Compress(w io.Writer) (io.WriteCloser, error) {
+	return zstd.NewWriter(w, zstd.WithEncoderConcurrency(1))
+}
+
+// Decompress implements [encoding.Compressor]. The decoder runs synchronously with a concurrency of 1, so that it does
+// not start any goroutines, which would need to be stopped by closing it.
+func (zstdCompressor) Decompress(r io.Reader) (io.Reader, error) {
+	return zstd.NewReader(r, zstd.WithDecoderConcurrency(1))
+}
@@ -0,0 +1,65 @@
+// Copyright 2023 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package api
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+
+	"google.golang.org/grpc/encoding"
+)
+
+func TestCompressors(t *testing.T) {
+	var payload = []byte(strings.Repeat(`{"large": "payload"}`, 100))
+
+	for _, name := range Compressors {
+		t.Run(name, func(t *testing.T) {
+			var buf bytes.Buffer
+
+			c := encoding.GetCompressor(name)
+			assert.NotNil(t, c)
+
+			w, err := c.Compress(&buf)
+			assert.NoError(t, err)
+
+			_, err = w.Write(payload)
+			assert.NoError(t, err)
+			assert.NoError(t, w.Close())
+			assert.True(t, buf.Len() < len(payload))
+
+			r, err := c.Decompress(&buf)
+			assert.NoError(t, err)
+
+			got, err := io.ReadAll(r)
+			assert.NoError(t, err)
+			assert.Equal(t, payload, got)
+		})
+	}
+}
@@ -121,6 +121,23 @@ func (conn *RPCConnection[T]) NewStream(ctx context.Context, desc *grpc.StreamDe
 	return
 }
 
+// CheckHealth returns an error, if the connection to the gRPC server failed. A connection that has not been established
+// yet is considered to be healthy, since it is only established at the first client call.
+func (conn *RPCConnection[T]) CheckHealth() error {
+	if conn == nil {
+		return errors.New("RPC connection not configured")
+	}
+
+	conn.m.RLock()
+	defer conn.m.RUnlock()
+
+	if conn.cc != nil && conn.cc.GetState() == connectivity.TransientFailure {
+		return fmt.Errorf("connection to gRPC target %q failed", conn.Target)
+	}
+
+	return nil
+}
+
 // init takes care of actually establishing the connection to the gRPC server. If the connection is already established,
 // this is a no-op. This function is go-routine safe, because potentially multiple callers could access this at the same
 // time.
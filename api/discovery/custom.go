@@ -0,0 +1,172 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package discovery
+
+import (
+	"errors"
+	"fmt"
+
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// ErrInvalidCustomResource indicates that the properties of a resource do not match its [CustomResourceType].
+var ErrInvalidCustomResource = errors.New("invalid custom resource")
+
+// ResourceTypes returns the resource types of the resources of this type, i.e., its name followed by its super types.
+func (t *CustomResourceType) ResourceTypes() []string {
+	return append([]string{t.Name}, t.SuperTypes...)
+}
+
+// ValidateProperties checks whether the properties of a resource match the declared properties of this type.
+func (t *CustomResourceType) ValidateProperties(props *structpb.Struct) (err error) {
+	var (
+		errs     []error
+		declared = make(map[string]*CustomResourceProperty)
+	)
+
+	for _, p := range t.Properties {
+		declared[p.Name] = p
+
+		if v, ok := props.GetFields()[p.Name]; p.Required && (!ok || isNull(v)) {
+			errs = append(errs, fmt.Errorf("property %q is required", p.Name))
+		}
+	}
+
+	for name, v := range props.GetFields() {
+		p, ok := declared[name]
+		if !ok {
+			errs = append(errs, fmt.Errorf("property %q is not declared", name))
+			continue
+		}
+
+		if isNull(v) {
+			continue
+		}
+
+		if !p.Repeated {
+			if !p.matches(v) {
+				errs = append(errs, fmt.Errorf("property %q is not of type %s", name, p.Type))
+			}
+			continue
+		}
+
+		list := v.GetListValue()
+		if list == nil {
+			errs = append(errs, fmt.Errorf("property %q is not a list", name))
+			continue
+		}
+
+		for _, elem := range list.Values {
+			if !p.matches(elem) {
+				errs = append(errs, fmt.Errorf("property %q contains a value that is not of type %s", name, p.Type))
+				break
+			}
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("%w of type %s: %w", ErrInvalidCustomResource, t.Name, errors.Join(errs...))
+	}
+
+	return nil
+}
+
+// Edges returns the edges of the resource with the given ID to the resources referenced by its properties that
+// declare a relationship.
+func (t *CustomResourceType) Edges(id string, props *structpb.Struct) (edges []*GraphEdge) {
+	for _, p := range t.Properties {
+		if p.Relationship == "" {
+			continue
+		}
+
+		v := props.GetFields()[p.Name]
+
+		var targets []*structpb.Value
+		if p.Repeated {
+			targets = v.GetListValue().GetValues()
+		} else if v != nil {
+			targets = []*structpb.Value{v}
+		}
+
+		for _, target := range targets {
+			if target.GetStringValue() == "" {
+				continue
+			}
+
+			edges = append(edges, &GraphEdge{
+				Id:           id + "-" + target.GetStringValue(),
+				Source:       id,
+				Target:       target.GetStringValue(),
+				Type:         p.Name,
+				Relationship: p.Relationship,
+			})
+		}
+	}
+
+	return
+}
+
+// matches checks whether a single value is of the type of the property.
+func (p *CustomResourceProperty) matches(v *structpb.Value) bool {
+	switch p.Type {
+	case CustomResourceProperty_PROPERTY_TYPE_STRING:
+		_, ok := v.Kind.(*structpb.Value_StringValue)
+		return ok
+	case CustomResourceProperty_PROPERTY_TYPE_NUMBER:
+		_, ok := v.Kind.(*structpb.Value_NumberValue)
+		return ok
+	case CustomResourceProperty_PROPERTY_TYPE_BOOLEAN:
+		_, ok := v.Kind.(*structpb.Value_BoolValue)
+		return ok
+	case CustomResourceProperty_PROPERTY_TYPE_OBJECT:
+		_, ok := v.Kind.(*structpb.Value_StructValue)
+		return ok
+	default:
+		return false
+	}
+}
+
+// CustomProperties returns the properties of the resource, if it is a resource of a [CustomResourceType], i.e., its
+// properties are a [structpb.Struct].
+func (r *Resource) CustomProperties() (props *structpb.Struct, ok bool) {
+	props = new(structpb.Struct)
+
+	if r.Properties == nil || !r.Properties.MessageIs(props) {
+		return nil, false
+	}
+
+	if err := r.Properties.UnmarshalTo(props); err != nil {
+		return nil, false
+	}
+
+	return props, true
+}
+
+// isNull checks whether the value is a JSON null.
+func isNull(v *structpb.Value) bool {
+	_, ok := v.GetKind().(*structpb.Value_NullValue)
+	return ok
+}
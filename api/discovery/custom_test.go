@@ -0,0 +1,148 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package discovery
+
+import (
+	"testing"
+
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/testutil/prototest"
+
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// mockLibraryType is a custom resource type of a software library, which depends on other libraries.
+var mockLibraryType = &CustomResourceType{
+	Name:       "Library",
+	SuperTypes: []string{"Resource"},
+	Properties: []*CustomResourceProperty{
+		{Name: "name", Type: CustomResourceProperty_PROPERTY_TYPE_STRING, Required: true},
+		{Name: "vulnerabilities", Type: CustomResourceProperty_PROPERTY_TYPE_STRING, Repeated: true},
+		{Name: "stars", Type: CustomResourceProperty_PROPERTY_TYPE_NUMBER},
+		{Name: "dependencies", Type: CustomResourceProperty_PROPERTY_TYPE_STRING, Repeated: true, Relationship: "depends_on"},
+	},
+}
+
+func newStruct(t *testing.T, m map[string]any) *structpb.Struct {
+	s, err := structpb.NewStruct(m)
+	assert.NoError(t, err)
+
+	return s
+}
+
+func TestCustomResourceType_ResourceTypes(t *testing.T) {
+	assert.Equal(t, []string{"Library", "Resource"}, mockLibraryType.ResourceTypes())
+}
+
+func TestCustomResourceType_ValidateProperties(t *testing.T) {
+	tests := []struct {
+		name    string
+		props   map[string]any
+		wantErr assert.WantErr
+	}{
+		{
+			name: "happy path",
+			props: map[string]any{
+				"name":            "log4j",
+				"vulnerabilities": []any{"CVE-2021-44832"},
+				"stars":           3000,
+				"dependencies":    nil,
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name:  "missing required property",
+			props: map[string]any{"stars": 1},
+			wantErr: func(t *testing.T, err error) bool {
+				assert.ErrorIs(t, err, ErrInvalidCustomResource)
+				return assert.ErrorContains(t, err, `property "name" is required`)
+			},
+		},
+		{
+			name:  "undeclared property",
+			props: map[string]any{"name": "log4j", "version": "2.17.0"},
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, `property "version" is not declared`)
+			},
+		},
+		{
+			name:  "wrong type",
+			props: map[string]any{"name": "log4j", "stars": "many"},
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, `property "stars" is not of type PROPERTY_TYPE_NUMBER`)
+			},
+		},
+		{
+			name:  "not a list",
+			props: map[string]any{"name": "log4j", "vulnerabilities": "CVE-2021-44832"},
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, `property "vulnerabilities" is not a list`)
+			},
+		},
+		{
+			name:  "wrong type in list",
+			props: map[string]any{"name": "log4j", "vulnerabilities": []any{true}},
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, `property "vulnerabilities" contains a value that is not of type PROPERTY_TYPE_STRING`)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.wantErr(t, mockLibraryType.ValidateProperties(newStruct(t, tt.props)))
+		})
+	}
+}
+
+func TestCustomResourceType_Edges(t *testing.T) {
+	got := mockLibraryType.Edges("log4j", newStruct(t, map[string]any{
+		"name":         "log4j",
+		"dependencies": []any{"log4j-api", ""},
+	}))
+
+	assert.Equal(t, []*GraphEdge{
+		{
+			Id:           "log4j-log4j-api",
+			Source:       "log4j",
+			Target:       "log4j-api",
+			Type:         "dependencies",
+			Relationship: "depends_on",
+		},
+	}, got)
+}
+
+func TestResource_CustomProperties(t *testing.T) {
+	props := newStruct(t, map[string]any{"name": "log4j"})
+
+	got, ok := (&Resource{Properties: prototest.NewAny(t, props)}).CustomProperties()
+	assert.True(t, ok)
+	assert.Equal(t, props, got)
+
+	got, ok = (&Resource{Properties: prototest.NewAny(t, &ontology.VirtualMachine{Id: "vm"})}).CustomProperties()
+	assert.False(t, ok)
+	assert.Nil(t, got)
+}
@@ -44,8 +44,12 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	ExperimentalDiscovery_UpdateResource_FullMethodName = "/clouditor.discovery.v1experimental.ExperimentalDiscovery/UpdateResource"
-	ExperimentalDiscovery_ListGraphEdges_FullMethodName = "/clouditor.discovery.v1experimental.ExperimentalDiscovery/ListGraphEdges"
+	ExperimentalDiscovery_UpdateResource_FullMethodName       = "/clouditor.discovery.v1experimental.ExperimentalDiscovery/UpdateResource"
+	ExperimentalDiscovery_ListGraphEdges_FullMethodName       = "/clouditor.discovery.v1experimental.ExperimentalDiscovery/ListGraphEdges"
+	ExperimentalDiscovery_QueryGraph_FullMethodName           = "/clouditor.discovery.v1experimental.ExperimentalDiscovery/QueryGraph"
+	ExperimentalDiscovery_ExportGraph_FullMethodName          = "/clouditor.discovery.v1experimental.ExperimentalDiscovery/ExportGraph"
+	ExperimentalDiscovery_RegisterResourceType_FullMethodName = "/clouditor.discovery.v1experimental.ExperimentalDiscovery/RegisterResourceType"
+	ExperimentalDiscovery_ListResourceTypes_FullMethodName    = "/clouditor.discovery.v1experimental.ExperimentalDiscovery/ListResourceTypes"
 )
 
 // ExperimentalDiscoveryClient is the client API for ExperimentalDiscovery service.
@@ -63,6 +67,30 @@ type ExperimentalDiscoveryClient interface {
 	//
 	// Note: THIS API IS EXPERIMENTAL AND SUBJECT TO CHANGE
 	ListGraphEdges(ctx context.Context, in *ListGraphEdgesRequest, opts ...grpc.CallOption) (*ListGraphEdgesResponse, error)
+	// QueryGraph returns the paths in our resource graph that match a path
+	// expression, e.g., all virtual machines that are reachable from the
+	// internet through a load balancer without a web application firewall.
+	//
+	// Note: THIS API IS EXPERIMENTAL AND SUBJECT TO CHANGE
+	QueryGraph(ctx context.Context, in *QueryGraphRequest, opts ...grpc.CallOption) (*QueryGraphResponse, error)
+	// ExportGraph exports the resource graph, i.e., the resources and the edges
+	// between them, in a format that can be visualized by standard tools, e.g.,
+	// Graphviz, Cytoscape or Neo4j.
+	//
+	// Note: THIS API IS EXPERIMENTAL AND SUBJECT TO CHANGE
+	ExportGraph(ctx context.Context, in *ExportGraphRequest, opts ...grpc.CallOption) (*ExportGraphResponse, error)
+	// RegisterResourceType registers a custom resource type (or updates it, if it
+	// is already registered), which is not part of our ontology. Afterward,
+	// resources of this type can be added using UpdateResource. This is used to
+	// give third-party tools the possibility to add domain-specific resources to
+	// the resource graph.
+	//
+	// Note: THIS API IS EXPERIMENTAL AND SUBJECT TO CHANGE
+	RegisterResourceType(ctx context.Context, in *RegisterResourceTypeRequest, opts ...grpc.CallOption) (*CustomResourceType, error)
+	// ListResourceTypes lists all registered custom resource types.
+	//
+	// Note: THIS API IS EXPERIMENTAL AND SUBJECT TO CHANGE
+	ListResourceTypes(ctx context.Context, in *ListResourceTypesRequest, opts ...grpc.CallOption) (*ListResourceTypesResponse, error)
 }
 
 type experimentalDiscoveryClient struct {
@@ -91,6 +119,42 @@ func (c *experimentalDiscoveryClient) ListGraphEdges(ctx context.Context, in *Li
 	return out, nil
 }
 
+func (c *experimentalDiscoveryClient) QueryGraph(ctx context.Context, in *QueryGraphRequest, opts ...grpc.CallOption) (*QueryGraphResponse, error) {
+	out := new(QueryGraphResponse)
+	err := c.cc.Invoke(ctx, ExperimentalDiscovery_QueryGraph_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *experimentalDiscoveryClient) ExportGraph(ctx context.Context, in *ExportGraphRequest, opts ...grpc.CallOption) (*ExportGraphResponse, error) {
+	out := new(ExportGraphResponse)
+	err := c.cc.Invoke(ctx, ExperimentalDiscovery_ExportGraph_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *experimentalDiscoveryClient) RegisterResourceType(ctx context.Context, in *RegisterResourceTypeRequest, opts ...grpc.CallOption) (*CustomResourceType, error) {
+	out := new(CustomResourceType)
+	err := c.cc.Invoke(ctx, ExperimentalDiscovery_RegisterResourceType_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *experimentalDiscoveryClient) ListResourceTypes(ctx context.Context, in *ListResourceTypesRequest, opts ...grpc.CallOption) (*ListResourceTypesResponse, error) {
+	out := new(ListResourceTypesResponse)
+	err := c.cc.Invoke(ctx, ExperimentalDiscovery_ListResourceTypes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ExperimentalDiscoveryServer is the server API for ExperimentalDiscovery service.
 // All implementations must embed UnimplementedExperimentalDiscoveryServer
 // for forward compatibility
@@ -106,6 +170,30 @@ type ExperimentalDiscoveryServer interface {
 	//
 	// Note: THIS API IS EXPERIMENTAL AND SUBJECT TO CHANGE
 	ListGraphEdges(context.Context, *ListGraphEdgesRequest) (*ListGraphEdgesResponse, error)
+	// QueryGraph returns the paths in our resource graph that match a path
+	// expression, e.g., all virtual machines that are reachable from the
+	// internet through a load balancer without a web application firewall.
+	//
+	// Note: THIS API IS EXPERIMENTAL AND SUBJECT TO CHANGE
+	QueryGraph(context.Context, *QueryGraphRequest) (*QueryGraphResponse, error)
+	// ExportGraph exports the resource graph, i.e., the resources and the edges
+	// between them, in a format that can be visualized by standard tools, e.g.,
+	// Graphviz, Cytoscape or Neo4j.
+	//
+	// Note: THIS API IS EXPERIMENTAL AND SUBJECT TO CHANGE
+	ExportGraph(context.Context, *ExportGraphRequest) (*ExportGraphResponse, error)
+	// RegisterResourceType registers a custom resource type (or updates it, if it
+	// is already registered), which is not part of our ontology. Afterward,
+	// resources of this type can be added using UpdateResource. This is used to
+	// give third-party tools the possibility to add domain-specific resources to
+	// the resource graph.
+	//
+	// Note: THIS API IS EXPERIMENTAL AND SUBJECT TO CHANGE
+	RegisterResourceType(context.Context, *RegisterResourceTypeRequest) (*CustomResourceType, error)
+	// ListResourceTypes lists all registered custom resource types.
+	//
+	// Note: THIS API IS EXPERIMENTAL AND SUBJECT TO CHANGE
+	ListResourceTypes(context.Context, *ListResourceTypesRequest) (*ListResourceTypesResponse, error)
 	mustEmbedUnimplementedExperimentalDiscoveryServer()
 }
 
@@ -119,6 +207,18 @@ func (UnimplementedExperimentalDiscoveryServer) UpdateResource(context.Context,
 func (UnimplementedExperimentalDiscoveryServer) ListGraphEdges(context.Context, *ListGraphEdgesRequest) (*ListGraphEdgesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListGraphEdges not implemented")
 }
+func (UnimplementedExperimentalDiscoveryServer) QueryGraph(context.Context, *QueryGraphRequest) (*QueryGraphResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryGraph not implemented")
+}
+func (UnimplementedExperimentalDiscoveryServer) ExportGraph(context.Context, *ExportGraphRequest) (*ExportGraphResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportGraph not implemented")
+}
+func (UnimplementedExperimentalDiscoveryServer) RegisterResourceType(context.Context, *RegisterResourceTypeRequest) (*CustomResourceType, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterResourceType not implemented")
+}
+func (UnimplementedExperimentalDiscoveryServer) ListResourceTypes(context.Context, *ListResourceTypesRequest) (*ListResourceTypesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListResourceTypes not implemented")
+}
 func (UnimplementedExperimentalDiscoveryServer) mustEmbedUnimplementedExperimentalDiscoveryServer() {}
 
 // UnsafeExperimentalDiscoveryServer may be embedded to opt out of forward compatibility for this service.
@@ -168,6 +268,78 @@ func _ExperimentalDiscovery_ListGraphEdges_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ExperimentalDiscovery_QueryGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGraphRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExperimentalDiscoveryServer).QueryGraph(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExperimentalDiscovery_QueryGraph_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExperimentalDiscoveryServer).QueryGraph(ctx, req.(*QueryGraphRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExperimentalDiscovery_ExportGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportGraphRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExperimentalDiscoveryServer).ExportGraph(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExperimentalDiscovery_ExportGraph_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExperimentalDiscoveryServer).ExportGraph(ctx, req.(*ExportGraphRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExperimentalDiscovery_RegisterResourceType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterResourceTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExperimentalDiscoveryServer).RegisterResourceType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExperimentalDiscovery_RegisterResourceType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExperimentalDiscoveryServer).RegisterResourceType(ctx, req.(*RegisterResourceTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExperimentalDiscovery_ListResourceTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListResourceTypesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExperimentalDiscoveryServer).ListResourceTypes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExperimentalDiscovery_ListResourceTypes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExperimentalDiscoveryServer).ListResourceTypes(ctx, req.(*ListResourceTypesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ExperimentalDiscovery_ServiceDesc is the grpc.ServiceDesc for ExperimentalDiscovery service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -183,6 +355,22 @@ var ExperimentalDiscovery_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListGraphEdges",
 			Handler:    _ExperimentalDiscovery_ListGraphEdges_Handler,
 		},
+		{
+			MethodName: "QueryGraph",
+			Handler:    _ExperimentalDiscovery_QueryGraph_Handler,
+		},
+		{
+			MethodName: "ExportGraph",
+			Handler:    _ExperimentalDiscovery_ExportGraph_Handler,
+		},
+		{
+			MethodName: "RegisterResourceType",
+			Handler:    _ExperimentalDiscovery_RegisterResourceType_Handler,
+		},
+		{
+			MethodName: "ListResourceTypes",
+			Handler:    _ExperimentalDiscovery_ListResourceTypes_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/discovery/experimental.proto",
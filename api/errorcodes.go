@@ -0,0 +1,281 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+// ErrorDomain is the domain of the [errdetails.ErrorInfo] that identifies the error code of our errors.
+const ErrorDomain = "clouditor.io"
+
+// ErrorCode is a stable, machine-readable identifier of an error, e.g., CLOUDITOR-ASSESS-001. It is attached to gRPC
+// errors as the reason of an [errdetails.ErrorInfo], so that clients can map errors without matching their messages.
+// Once released, the ID of an error code must not change. All error codes are contained in the catalog returned by
+// [ErrorCodes].
+type ErrorCode struct {
+	// ID is the identifier of the error code in the form CLOUDITOR-<area>-<number>
+	ID string `json:"id"`
+
+	// Status is the gRPC status code of errors with this error code
+	Status codes.Code `json:"-"`
+
+	// Message is the (English) message of the error code
+	Message string `json:"message"`
+
+	// Translations contains the message of the error code in other languages by their ISO 639-1 code, e.g., "de"
+	Translations map[string]string `json:"translations,omitempty"`
+}
+
+// errorCodes contains all error codes by their ID
+var errorCodes = map[string]*ErrorCode{}
+
+// newErrorCode registers a new error code in the catalog. It panics, if the ID is already taken, so that a duplicate
+// ID is already detected by our tests.
+func newErrorCode(id string, status codes.Code, message string, translations map[string]string) *ErrorCode {
+	if _, ok := errorCodes[id]; ok {
+		panic(fmt.Sprintf("duplicate error code %s", id))
+	}
+
+	c := &ErrorCode{ID: id, Status: status, Message: message, Translations: translations}
+	errorCodes[id] = c
+
+	return c
+}
+
+// Generic error codes, which are attached to errors of the respective gRPC status code that have no specific error
+// code. Their number is the gRPC status code.
+var genericErrorCodes = map[codes.Code]*ErrorCode{
+	codes.Canceled:           newErrorCode("CLOUDITOR-GEN-001", codes.Canceled, "the request was canceled", map[string]string{"de": "Die Anfrage wurde abgebrochen"}),
+	codes.Unknown:            newErrorCode("CLOUDITOR-GEN-002", codes.Unknown, "an unknown error occurred", map[string]string{"de": "Ein unbekannter Fehler ist aufgetreten"}),
+	codes.InvalidArgument:    newErrorCode("CLOUDITOR-GEN-003", codes.InvalidArgument, "the request is invalid", map[string]string{"de": "Die Anfrage ist ungültig"}),
+	codes.DeadlineExceeded:   newErrorCode("CLOUDITOR-GEN-004", codes.DeadlineExceeded, "the request timed out", map[string]string{"de": "Die Anfrage hat zu lange gedauert"}),
+	codes.NotFound:           newErrorCode("CLOUDITOR-GEN-005", codes.NotFound, "the requested entity was not found", map[string]string{"de": "Das angefragte Objekt wurde nicht gefunden"}),
+	codes.AlreadyExists:      newErrorCode("CLOUDITOR-GEN-006", codes.AlreadyExists, "the entity already exists", map[string]string{"de": "Das Objekt existiert bereits"}),
+	codes.PermissionDenied:   newErrorCode("CLOUDITOR-GEN-007", codes.PermissionDenied, "access denied", map[string]string{"de": "Zugriff verweigert"}),
+	codes.ResourceExhausted:  newErrorCode("CLOUDITOR-GEN-008", codes.ResourceExhausted, "a quota or limit was exceeded", map[string]string{"de": "Ein Kontingent oder Limit wurde überschritten"}),
+	codes.FailedPrecondition: newErrorCode("CLOUDITOR-GEN-009", codes.FailedPrecondition, "the request cannot be executed in the current state", map[string]string{"de": "Die Anfrage kann im aktuellen Zustand nicht ausgeführt werden"}),
+	codes.Aborted:            newErrorCode("CLOUDITOR-GEN-010", codes.Aborted, "the request was aborted due to a conflict", map[string]string{"de": "Die Anfrage wurde wegen eines Konflikts abgebrochen"}),
+	codes.OutOfRange:         newErrorCode("CLOUDITOR-GEN-011", codes.OutOfRange, "a value is out of range", map[string]string{"de": "Ein Wert liegt außerhalb des gültigen Bereichs"}),
+	codes.Unimplemented:      newErrorCode("CLOUDITOR-GEN-012", codes.Unimplemented, "the request is not supported", map[string]string{"de": "Die Anfrage wird nicht unterstützt"}),
+	codes.Internal:           newErrorCode("CLOUDITOR-GEN-013", codes.Internal, "an internal error occurred", map[string]string{"de": "Ein interner Fehler ist aufgetreten"}),
+	codes.Unavailable:        newErrorCode("CLOUDITOR-GEN-014", codes.Unavailable, "the service is temporarily unavailable", map[string]string{"de": "Der Dienst ist vorübergehend nicht verfügbar"}),
+	codes.DataLoss:           newErrorCode("CLOUDITOR-GEN-015", codes.DataLoss, "data was lost or corrupted", map[string]string{"de": "Daten sind verloren gegangen oder beschädigt"}),
+	codes.Unauthenticated:    newErrorCode("CLOUDITOR-GEN-016", codes.Unauthenticated, "the request is not authenticated", map[string]string{"de": "Die Anfrage ist nicht authentifiziert"}),
+}
+
+// Error codes of the API in general, e.g., of the authentication or the validation of requests.
+var (
+	ErrorCodeInvalidAuthToken        = newErrorCode("CLOUDITOR-API-001", codes.Unauthenticated, "invalid auth token", map[string]string{"de": "Ungültiges Authentifizierungstoken"})
+	ErrorCodeInvalidAPIKey           = newErrorCode("CLOUDITOR-API-002", codes.Unauthenticated, "invalid API key", map[string]string{"de": "Ungültiger API-Schlüssel"})
+	ErrorCodeAPIKeyNotAccepted       = newErrorCode("CLOUDITOR-API-003", codes.Unauthenticated, "API keys are not accepted for this RPC", map[string]string{"de": "API-Schlüssel werden für diese Anfrage nicht akzeptiert"})
+	ErrorCodePermissionDenied        = newErrorCode("CLOUDITOR-API-004", codes.PermissionDenied, "access denied", map[string]string{"de": "Zugriff verweigert"})
+	ErrorCodeValidationFailed        = newErrorCode("CLOUDITOR-API-005", codes.InvalidArgument, "the request does not satisfy its constraints", map[string]string{"de": "Die Anfrage erfüllt ihre Vorgaben nicht"})
+	ErrorCodeIdempotencyKeyReuse     = newErrorCode("CLOUDITOR-API-006", codes.InvalidArgument, "idempotency key was already used for a different request", map[string]string{"de": "Der Idempotenzschlüssel wurde bereits für eine andere Anfrage verwendet"})
+	ErrorCodeInvalidAuditorGrant     = newErrorCode("CLOUDITOR-API-007", codes.Unauthenticated, "invalid auditor grant", map[string]string{"de": "Ungültige Prüferfreigabe"})
+	ErrorCodeAuditorGrantNotAccepted = newErrorCode("CLOUDITOR-API-008", codes.Unauthenticated, "auditor grants are not accepted for this RPC", map[string]string{"de": "Prüferfreigaben werden für diese Anfrage nicht akzeptiert"})
+)
+
+// Error codes of the orchestrator.
+var (
+	ErrorCodeCloudServiceNotFound        = newErrorCode("CLOUDITOR-ORCH-001", codes.NotFound, "service not found", map[string]string{"de": "Cloud-Dienst nicht gefunden"})
+	ErrorCodeCatalogNotFound             = newErrorCode("CLOUDITOR-ORCH-002", codes.NotFound, "catalog not found", map[string]string{"de": "Katalog nicht gefunden"})
+	ErrorCodeControlNotFound             = newErrorCode("CLOUDITOR-ORCH-003", codes.NotFound, "control not found", map[string]string{"de": "Kontrolle nicht gefunden"})
+	ErrorCodeToENotFound                 = newErrorCode("CLOUDITOR-ORCH-004", codes.NotFound, "ToE not found", map[string]string{"de": "Evaluierungsgegenstand nicht gefunden"})
+	ErrorCodeMetricNotFound              = newErrorCode("CLOUDITOR-ORCH-005", codes.NotFound, "metric not found", map[string]string{"de": "Metrik nicht gefunden"})
+	ErrorCodeCertificateNotFound         = newErrorCode("CLOUDITOR-ORCH-006", codes.NotFound, "certificate not found", map[string]string{"de": "Zertifikat nicht gefunden"})
+	ErrorCodeOrganizationNotFound        = newErrorCode("CLOUDITOR-ORCH-007", codes.NotFound, "organization not found", map[string]string{"de": "Organisation nicht gefunden"})
+	ErrorCodeWaiverNotFound              = newErrorCode("CLOUDITOR-ORCH-008", codes.NotFound, "waiver not found", map[string]string{"de": "Ausnahme nicht gefunden"})
+	ErrorCodeAPIKeyNotFound              = newErrorCode("CLOUDITOR-ORCH-009", codes.NotFound, "API key not found", map[string]string{"de": "API-Schlüssel nicht gefunden"})
+	ErrorCodeAgentNotFound               = newErrorCode("CLOUDITOR-ORCH-010", codes.NotFound, "agent not found", map[string]string{"de": "Agent nicht gefunden"})
+	ErrorCodeRoleAssignmentNotFound      = newErrorCode("CLOUDITOR-ORCH-011", codes.NotFound, "role assignment not found", map[string]string{"de": "Rollenzuweisung nicht gefunden"})
+	ErrorCodeWebhookNotFound             = newErrorCode("CLOUDITOR-ORCH-012", codes.NotFound, "webhook not found", map[string]string{"de": "Webhook nicht gefunden"})
+	ErrorCodeNotificationChannelNotFound = newErrorCode("CLOUDITOR-ORCH-013", codes.NotFound, "notification channel not found", map[string]string{"de": "Benachrichtigungskanal nicht gefunden"})
+	ErrorCodeTicketIntegrationNotFound   = newErrorCode("CLOUDITOR-ORCH-014", codes.NotFound, "ticket integration not found", map[string]string{"de": "Ticket-Integration nicht gefunden"})
+	ErrorCodeMetricNotDisabled           = newErrorCode("CLOUDITOR-ORCH-015", codes.NotFound, "metric is not disabled", map[string]string{"de": "Metrik ist nicht deaktiviert"})
+	ErrorCodeAuditorGrantNotFound        = newErrorCode("CLOUDITOR-ORCH-016", codes.NotFound, "auditor grant not found", map[string]string{"de": "Prüferfreigabe nicht gefunden"})
+)
+
+// Error codes of the assessment.
+var (
+	ErrorCodeOrchestratorUnavailable  = newErrorCode("CLOUDITOR-ASSESS-001", codes.Unavailable, "could not get stream to orchestrator", map[string]string{"de": "Keine Verbindung zum Orchestrator"})
+	ErrorCodeEvidenceStoreUnavailable = newErrorCode("CLOUDITOR-ASSESS-002", codes.Unavailable, "could not get stream to evidence store", map[string]string{"de": "Keine Verbindung zum Evidence Store"})
+	ErrorCodeUnsupportedOntology      = newErrorCode("CLOUDITOR-ASSESS-003", codes.InvalidArgument, "the ontology version of the evidence is not supported", map[string]string{"de": "Die Ontologie-Version des Nachweises wird nicht unterstützt"})
+	ErrorCodeInvalidResource          = newErrorCode("CLOUDITOR-ASSESS-004", codes.Internal, "invalid embedded resource", map[string]string{"de": "Ungültige eingebettete Ressource"})
+	ErrorCodeEvaluationFailed         = newErrorCode("CLOUDITOR-ASSESS-005", codes.Internal, "could not evaluate evidence", map[string]string{"de": "Der Nachweis konnte nicht bewertet werden"})
+)
+
+// Error codes of the evidence store.
+var (
+	ErrorCodeEvidenceNotFound = newErrorCode("CLOUDITOR-EVID-001", codes.NotFound, "evidence not found", map[string]string{"de": "Nachweis nicht gefunden"})
+)
+
+// Error codes of the evaluation.
+var (
+	ErrorCodeEvaluationResultNotFound = newErrorCode("CLOUDITOR-EVAL-001", codes.NotFound, "evaluation result not found", map[string]string{"de": "Evaluierungsergebnis nicht gefunden"})
+	ErrorCodeAttestationNotFound      = newErrorCode("CLOUDITOR-EVAL-002", codes.NotFound, "attestation not found", map[string]string{"de": "Bestätigung nicht gefunden"})
+)
+
+// Err returns a gRPC error with the message of the error code.
+func (c *ErrorCode) Err() error {
+	return c.status(c.Message).Err()
+}
+
+// Errorf returns a gRPC error with the error code and the given message, e.g., to add the cause of the error.
+func (c *ErrorCode) Errorf(format string, a ...any) error {
+	return c.status(fmt.Sprintf(format, a...)).Err()
+}
+
+// status returns a status with the given message, which contains the error code in its details.
+func (c *ErrorCode) status(msg string) *status.Status {
+	st := status.New(c.Status, msg)
+
+	// Fall back to the plain status, if the details cannot be attached for some reason
+	if withDetails, err := st.WithDetails(c.errorInfo()); err == nil {
+		st = withDetails
+	}
+
+	return st
+}
+
+// errorInfo returns the error info that identifies the error code.
+func (c *ErrorCode) errorInfo() *errdetails.ErrorInfo {
+	return &errdetails.ErrorInfo{Reason: c.ID, Domain: ErrorDomain}
+}
+
+// ErrorCodes returns the catalog of all error codes, sorted by their ID.
+func ErrorCodes() (catalog []*ErrorCode) {
+	for _, c := range errorCodes {
+		catalog = append(catalog, c)
+	}
+
+	slices.SortFunc(catalog, func(a *ErrorCode, b *ErrorCode) int {
+		return strings.Compare(a.ID, b.ID)
+	})
+
+	return catalog
+}
+
+// ErrorCatalog exports the catalog of all error codes as JSON, including their gRPC status code, so that clients,
+// e.g., a UI, can map the error codes to (translated) messages.
+func ErrorCatalog() ([]byte, error) {
+	type entry struct {
+		*ErrorCode
+		Status string `json:"status"`
+	}
+
+	var entries []entry
+	for _, c := range ErrorCodes() {
+		entries = append(entries, entry{ErrorCode: c, Status: c.Status.String()})
+	}
+
+	return json.MarshalIndent(entries, "", "  ")
+}
+
+// ErrorCodeOf returns the error code contained in the details of the gRPC error err. If err does not contain an error
+// code, ok is false.
+func ErrorCodeOf(err error) (c *ErrorCode, ok bool) {
+	info := errorInfoOf(err)
+	if info == nil {
+		return nil, false
+	}
+
+	c, ok = errorCodes[info.Reason]
+
+	return c, ok
+}
+
+// WithErrorCode makes sure, that the gRPC error err contains an error code. If it does not, the generic error code of
+// its status code is added. Additionally, if there is a translation of the error code for one of the given languages
+// (ISO 639-1 codes in the order of preference), it is added as [errdetails.LocalizedMessage].
+func WithErrorCode(err error, languages ...string) error {
+	// Errors that are not gRPC errors are returned to the client as unknown errors
+	st, _ := status.FromError(err)
+	if st.Code() == codes.OK {
+		return err
+	}
+
+	var (
+		details []protoadapt.MessageV1
+		info    = errorInfoOf(err)
+		c       = errorCodes[info.GetReason()]
+	)
+
+	if info == nil {
+		c = genericErrorCodes[st.Code()]
+		if c == nil {
+			return err
+		}
+
+		details = append(details, c.errorInfo())
+	} else if c == nil {
+		// An error code of a newer version, which we cannot translate
+		return err
+	}
+
+	for _, lang := range languages {
+		if msg, ok := c.Translations[lang]; ok {
+			details = append(details, &errdetails.LocalizedMessage{Locale: lang, Message: msg})
+			break
+		}
+	}
+
+	if len(details) == 0 {
+		return err
+	}
+
+	// Keep the original error, if the details cannot be attached for some reason
+	withDetails, detailsErr := st.WithDetails(details...)
+	if detailsErr != nil {
+		return err
+	}
+
+	return withDetails.Err()
+}
+
+// errorInfoOf returns our [errdetails.ErrorInfo] contained in the details of the gRPC error err, if any.
+func errorInfoOf(err error) *errdetails.ErrorInfo {
+	st, ok := status.FromError(err)
+	if !ok {
+		return nil
+	}
+
+	for _, d := range st.Details() {
+		if info, ok := d.(*errdetails.ErrorInfo); ok && info.Domain == ErrorDomain {
+			return info
+		}
+	}
+
+	return nil
+}
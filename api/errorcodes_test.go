@@ -0,0 +1,154 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestErrorCodes(t *testing.T) {
+	catalog := ErrorCodes()
+	assert.Equal(t, len(errorCodes), len(catalog))
+
+	for i, c := range catalog {
+		assert.True(t, strings.HasPrefix(c.ID, "CLOUDITOR-"), c.ID)
+		assert.NotEmpty(t, c.Message, c.ID)
+		assert.NotEmpty(t, c.Translations["de"], c.ID)
+
+		if i > 0 {
+			assert.True(t, catalog[i-1].ID < c.ID)
+		}
+	}
+
+	// Every status code except OK has a generic error code
+	for code := codes.Canceled; code <= codes.Unauthenticated; code++ {
+		assert.NotNil(t, genericErrorCodes[code])
+	}
+}
+
+func TestErrorCatalog(t *testing.T) {
+	var entries []map[string]any
+
+	b, err := ErrorCatalog()
+	assert.NoError(t, err)
+	assert.NoError(t, json.Unmarshal(b, &entries))
+	assert.Equal(t, len(errorCodes), len(entries))
+	assert.Equal[any](t, "CLOUDITOR-API-001", entries[0]["id"])
+	assert.Equal[any](t, "Unauthenticated", entries[0]["status"])
+
+	// Make sure, the exported catalog is up-to-date
+	exported, err := os.ReadFile("errorcodes.json")
+	assert.NoError(t, err)
+	assert.Equal(t, string(b)+"\n", string(exported))
+}
+
+func TestWithErrorCode(t *testing.T) {
+	type args struct {
+		err       error
+		languages []string
+	}
+	tests := []struct {
+		name        string
+		args        args
+		wantCode    *ErrorCode
+		wantMessage string
+		wantLocale  string
+	}{
+		{
+			name: "no gRPC error",
+			args: args{
+				err: errors.New("some error"),
+			},
+			wantCode:    genericErrorCodes[codes.Unknown],
+			wantMessage: "some error",
+		},
+		{
+			name: "generic error code",
+			args: args{
+				err:       status.Error(codes.Internal, "database error"),
+				languages: []string{"fr", "de"},
+			},
+			wantCode:    genericErrorCodes[codes.Internal],
+			wantMessage: "database error",
+			wantLocale:  "de",
+		},
+		{
+			name: "specific error code",
+			args: args{
+				err:       ErrorCodePermissionDenied.Err(),
+				languages: []string{"de"},
+			},
+			wantCode:    ErrorCodePermissionDenied,
+			wantMessage: "access denied",
+			wantLocale:  "de",
+		},
+		{
+			name: "specific error code without translation",
+			args: args{
+				err:       ErrorCodeValidationFailed.Errorf("invalid request: %s", "name is required"),
+				languages: []string{"fr"},
+			},
+			wantCode:    ErrorCodeValidationFailed,
+			wantMessage: "invalid request: name is required",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := WithErrorCode(tt.args.err, tt.args.languages...)
+
+			c, ok := ErrorCodeOf(err)
+			assert.True(t, ok)
+			assert.Same(t, tt.wantCode, c)
+
+			st := status.Convert(err)
+			assert.Equal(t, tt.wantCode.Status, st.Code())
+			assert.Equal(t, tt.wantMessage, st.Message())
+
+			var locale string
+			for _, d := range st.Details() {
+				if msg, ok := d.(*errdetails.LocalizedMessage); ok {
+					locale = msg.Locale
+					assert.Equal(t, tt.wantCode.Translations[locale], msg.Message)
+				}
+			}
+			assert.Equal(t, tt.wantLocale, locale)
+		})
+	}
+}
+
+func TestWithErrorCode_OK(t *testing.T) {
+	assert.Nil(t, WithErrorCode(nil))
+}
@@ -25,8 +25,55 @@
 
 package evaluation
 
+import (
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// ResultHookFunc is a hook function that is informed about new evaluation results.
+type ResultHookFunc func(ctx context.Context, result *EvaluationResult, err error)
+
+var (
+	ErrAttestationExpired = errors.New("the expiry of an attestation must be in the future")
+)
+
 // GetCloudServiceId is a shortcut to implement CloudServiceRequest. It returns
 // the cloud service ID of the inner object.
 func (req *CreateEvaluationResultRequest) GetCloudServiceId() string {
 	return req.GetResult().GetCloudServiceId()
 }
+
+// GetCloudServiceId is a shortcut to implement CloudServiceRequest. It returns
+// the cloud service ID of the inner object.
+func (req *CreateAttestationRequest) GetCloudServiceId() string {
+	return req.GetAttestation().GetCloudServiceId()
+}
+
+func (req *CreateAttestationRequest) GetPayload() proto.Message {
+	return req.Attestation
+}
+
+func (req *AddAttestationDocumentRequest) GetPayload() proto.Message {
+	return &Attestation{Id: req.AttestationId}
+}
+
+func (req *RemoveAttestationRequest) GetPayload() proto.Message {
+	return &Attestation{Id: req.AttestationId}
+}
+
+// IsActive checks, whether this attestation is still valid at the given time.
+func (a *Attestation) IsActive(t time.Time) bool {
+	return a.ExpiresAt.AsTime().After(t)
+}
+
+// Status returns the (manual) evaluation status that corresponds to the decision of the reviewer.
+func (a *Attestation) Status() EvaluationStatus {
+	if a.Compliant {
+		return EvaluationStatus_EVALUATION_STATUS_COMPLIANT_MANUALLY
+	}
+
+	return EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT_MANUALLY
+}
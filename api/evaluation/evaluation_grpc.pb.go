@@ -33,9 +33,11 @@ package evaluation
 
 import (
 	context "context"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -44,10 +46,22 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Evaluation_StartEvaluation_FullMethodName        = "/clouditor.evaluation.v1.Evaluation/StartEvaluation"
-	Evaluation_StopEvaluation_FullMethodName         = "/clouditor.evaluation.v1.Evaluation/StopEvaluation"
-	Evaluation_ListEvaluationResults_FullMethodName  = "/clouditor.evaluation.v1.Evaluation/ListEvaluationResults"
-	Evaluation_CreateEvaluationResult_FullMethodName = "/clouditor.evaluation.v1.Evaluation/CreateEvaluationResult"
+	Evaluation_StartEvaluation_FullMethodName             = "/clouditor.evaluation.v1.Evaluation/StartEvaluation"
+	Evaluation_StopEvaluation_FullMethodName              = "/clouditor.evaluation.v1.Evaluation/StopEvaluation"
+	Evaluation_TriggerEvaluation_FullMethodName           = "/clouditor.evaluation.v1.Evaluation/TriggerEvaluation"
+	Evaluation_ListEvaluationRuns_FullMethodName          = "/clouditor.evaluation.v1.Evaluation/ListEvaluationRuns"
+	Evaluation_ListEvaluationResults_FullMethodName       = "/clouditor.evaluation.v1.Evaluation/ListEvaluationResults"
+	Evaluation_CreateEvaluationResult_FullMethodName      = "/clouditor.evaluation.v1.Evaluation/CreateEvaluationResult"
+	Evaluation_SubscribeEvaluationResults_FullMethodName  = "/clouditor.evaluation.v1.Evaluation/SubscribeEvaluationResults"
+	Evaluation_GetComplianceHistory_FullMethodName        = "/clouditor.evaluation.v1.Evaluation/GetComplianceHistory"
+	Evaluation_DrillDownEvaluationResult_FullMethodName   = "/clouditor.evaluation.v1.Evaluation/DrillDownEvaluationResult"
+	Evaluation_GenerateComplianceReport_FullMethodName    = "/clouditor.evaluation.v1.Evaluation/GenerateComplianceReport"
+	Evaluation_GetAssuranceLevelCompliance_FullMethodName = "/clouditor.evaluation.v1.Evaluation/GetAssuranceLevelCompliance"
+	Evaluation_CreateAttestation_FullMethodName           = "/clouditor.evaluation.v1.Evaluation/CreateAttestation"
+	Evaluation_GetAttestation_FullMethodName              = "/clouditor.evaluation.v1.Evaluation/GetAttestation"
+	Evaluation_ListAttestations_FullMethodName            = "/clouditor.evaluation.v1.Evaluation/ListAttestations"
+	Evaluation_AddAttestationDocument_FullMethodName      = "/clouditor.evaluation.v1.Evaluation/AddAttestationDocument"
+	Evaluation_RemoveAttestation_FullMethodName           = "/clouditor.evaluation.v1.Evaluation/RemoveAttestation"
 )
 
 // EvaluationClient is the client API for Evaluation service.
@@ -60,12 +74,61 @@ type EvaluationClient interface {
 	// StopEvaluation stops the evaluation for the given target of evaluation.
 	// Part of the public API, also exposed as REST.
 	StopEvaluation(ctx context.Context, in *StopEvaluationRequest, opts ...grpc.CallOption) (*StopEvaluationResponse, error)
+	// Triggers an on-demand evaluation of the given target of evaluation,
+	// independently of its schedule. The evaluation runs in the background and
+	// fails, if another evaluation of the target of evaluation is still in
+	// progress. Part of the public API, also exposed as REST.
+	TriggerEvaluation(ctx context.Context, in *TriggerEvaluationRequest, opts ...grpc.CallOption) (*EvaluationRun, error)
+	// Lists the history of evaluation runs that the user can access. Part of the
+	// public API, also exposed as REST.
+	ListEvaluationRuns(ctx context.Context, in *ListEvaluationRunsRequest, opts ...grpc.CallOption) (*ListEvaluationRunsResponse, error)
 	// List all evaluation results that the user can access. It can further be
 	// restricted by various filtering options. Part of the public API, also
 	// exposed as REST.
 	ListEvaluationResults(ctx context.Context, in *ListEvaluationResultsRequest, opts ...grpc.CallOption) (*ListEvaluationResultsResponse, error)
 	// Creates an evaluation result
 	CreateEvaluationResult(ctx context.Context, in *CreateEvaluationResultRequest, opts ...grpc.CallOption) (*EvaluationResult, error)
+	// Subscribes to a stream of new evaluation results, optionally filtered by
+	// cloud service, catalog, control and compliance state. Part of the public
+	// API, also exposed as REST (as a server-sent stream).
+	SubscribeEvaluationResults(ctx context.Context, in *SubscribeEvaluationResultsRequest, opts ...grpc.CallOption) (Evaluation_SubscribeEvaluationResultsClient, error)
+	// Retrieves the compliance history of a cloud service as time-series, based
+	// on the periodically taken compliance snapshots. Part of the public API,
+	// also exposed as REST.
+	GetComplianceHistory(ctx context.Context, in *GetComplianceHistoryRequest, opts ...grpc.CallOption) (*GetComplianceHistoryResponse, error)
+	// Drills down into the evaluation result of a control at a given point in
+	// time and returns the contributing assessment results as well as the
+	// concrete failing resources including their evidences. Part of the public
+	// API, also exposed as REST.
+	DrillDownEvaluationResult(ctx context.Context, in *DrillDownEvaluationResultRequest, opts ...grpc.CallOption) (*DrillDownEvaluationResultResponse, error)
+	// Generates an audit-ready compliance report of a target of evaluation,
+	// containing the catalog, the scope, the compliance status of each control,
+	// failing resources, waivers and evidence references. The report is rendered
+	// as HTML or PDF and can be directly downloaded. Part of the public API, also
+	// exposed as REST.
+	GenerateComplianceReport(ctx context.Context, in *GenerateComplianceReportRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error)
+	// Retrieves the compliance of a target of evaluation for each assurance
+	// level of its catalog, e.g., basic, substantial and high for the EUCS,
+	// based on the latest evaluation result of each control. Part of the public
+	// API, also exposed as REST.
+	GetAssuranceLevelCompliance(ctx context.Context, in *GetAssuranceLevelComplianceRequest, opts ...grpc.CallOption) (*GetAssuranceLevelComplianceResponse, error)
+	// Creates a manual attestation of a control, e.g., for policies or trainings
+	// that cannot be assessed automatically. Active attestations are merged with
+	// the automated results during the evaluation of the control. Part of the
+	// public API, also exposed as REST.
+	CreateAttestation(ctx context.Context, in *CreateAttestationRequest, opts ...grpc.CallOption) (*Attestation, error)
+	// Retrieves an attestation including its documents. Part of the public API,
+	// also exposed as REST.
+	GetAttestation(ctx context.Context, in *GetAttestationRequest, opts ...grpc.CallOption) (*Attestation, error)
+	// Lists all attestations that the user can access. The content of the
+	// documents is not included. Part of the public API, also exposed as REST.
+	ListAttestations(ctx context.Context, in *ListAttestationsRequest, opts ...grpc.CallOption) (*ListAttestationsResponse, error)
+	// Uploads a document, e.g., a signed policy, as evidence of an attestation.
+	// Part of the public API, also exposed as REST.
+	AddAttestationDocument(ctx context.Context, in *AddAttestationDocumentRequest, opts ...grpc.CallOption) (*AttestationDocument, error)
+	// Removes (revokes) an attestation including its documents. Part of the
+	// public API, also exposed as REST.
+	RemoveAttestation(ctx context.Context, in *RemoveAttestationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type evaluationClient struct {
@@ -94,6 +157,24 @@ func (c *evaluationClient) StopEvaluation(ctx context.Context, in *StopEvaluatio
 	return out, nil
 }
 
+func (c *evaluationClient) TriggerEvaluation(ctx context.Context, in *TriggerEvaluationRequest, opts ...grpc.CallOption) (*EvaluationRun, error) {
+	out := new(EvaluationRun)
+	err := c.cc.Invoke(ctx, Evaluation_TriggerEvaluation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *evaluationClient) ListEvaluationRuns(ctx context.Context, in *ListEvaluationRunsRequest, opts ...grpc.CallOption) (*ListEvaluationRunsResponse, error) {
+	out := new(ListEvaluationRunsResponse)
+	err := c.cc.Invoke(ctx, Evaluation_ListEvaluationRuns_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *evaluationClient) ListEvaluationResults(ctx context.Context, in *ListEvaluationResultsRequest, opts ...grpc.CallOption) (*ListEvaluationResultsResponse, error) {
 	out := new(ListEvaluationResultsResponse)
 	err := c.cc.Invoke(ctx, Evaluation_ListEvaluationResults_FullMethodName, in, out, opts...)
@@ -112,6 +193,119 @@ func (c *evaluationClient) CreateEvaluationResult(ctx context.Context, in *Creat
 	return out, nil
 }
 
+func (c *evaluationClient) SubscribeEvaluationResults(ctx context.Context, in *SubscribeEvaluationResultsRequest, opts ...grpc.CallOption) (Evaluation_SubscribeEvaluationResultsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Evaluation_ServiceDesc.Streams[0], Evaluation_SubscribeEvaluationResults_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &evaluationSubscribeEvaluationResultsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Evaluation_SubscribeEvaluationResultsClient interface {
+	Recv() (*EvaluationResult, error)
+	grpc.ClientStream
+}
+
+type evaluationSubscribeEvaluationResultsClient struct {
+	grpc.ClientStream
+}
+
+func (x *evaluationSubscribeEvaluationResultsClient) Recv() (*EvaluationResult, error) {
+	m := new(EvaluationResult)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *evaluationClient) GetComplianceHistory(ctx context.Context, in *GetComplianceHistoryRequest, opts ...grpc.CallOption) (*GetComplianceHistoryResponse, error) {
+	out := new(GetComplianceHistoryResponse)
+	err := c.cc.Invoke(ctx, Evaluation_GetComplianceHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *evaluationClient) DrillDownEvaluationResult(ctx context.Context, in *DrillDownEvaluationResultRequest, opts ...grpc.CallOption) (*DrillDownEvaluationResultResponse, error) {
+	out := new(DrillDownEvaluationResultResponse)
+	err := c.cc.Invoke(ctx, Evaluation_DrillDownEvaluationResult_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *evaluationClient) GenerateComplianceReport(ctx context.Context, in *GenerateComplianceReportRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error) {
+	out := new(httpbody.HttpBody)
+	err := c.cc.Invoke(ctx, Evaluation_GenerateComplianceReport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *evaluationClient) GetAssuranceLevelCompliance(ctx context.Context, in *GetAssuranceLevelComplianceRequest, opts ...grpc.CallOption) (*GetAssuranceLevelComplianceResponse, error) {
+	out := new(GetAssuranceLevelComplianceResponse)
+	err := c.cc.Invoke(ctx, Evaluation_GetAssuranceLevelCompliance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *evaluationClient) CreateAttestation(ctx context.Context, in *CreateAttestationRequest, opts ...grpc.CallOption) (*Attestation, error) {
+	out := new(Attestation)
+	err := c.cc.Invoke(ctx, Evaluation_CreateAttestation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *evaluationClient) GetAttestation(ctx context.Context, in *GetAttestationRequest, opts ...grpc.CallOption) (*Attestation, error) {
+	out := new(Attestation)
+	err := c.cc.Invoke(ctx, Evaluation_GetAttestation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *evaluationClient) ListAttestations(ctx context.Context, in *ListAttestationsRequest, opts ...grpc.CallOption) (*ListAttestationsResponse, error) {
+	out := new(ListAttestationsResponse)
+	err := c.cc.Invoke(ctx, Evaluation_ListAttestations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *evaluationClient) AddAttestationDocument(ctx context.Context, in *AddAttestationDocumentRequest, opts ...grpc.CallOption) (*AttestationDocument, error) {
+	out := new(AttestationDocument)
+	err := c.cc.Invoke(ctx, Evaluation_AddAttestationDocument_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *evaluationClient) RemoveAttestation(ctx context.Context, in *RemoveAttestationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Evaluation_RemoveAttestation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EvaluationServer is the server API for Evaluation service.
 // All implementations must embed UnimplementedEvaluationServer
 // for forward compatibility
@@ -122,12 +316,61 @@ type EvaluationServer interface {
 	// StopEvaluation stops the evaluation for the given target of evaluation.
 	// Part of the public API, also exposed as REST.
 	StopEvaluation(context.Context, *StopEvaluationRequest) (*StopEvaluationResponse, error)
+	// Triggers an on-demand evaluation of the given target of evaluation,
+	// independently of its schedule. The evaluation runs in the background and
+	// fails, if another evaluation of the target of evaluation is still in
+	// progress. Part of the public API, also exposed as REST.
+	TriggerEvaluation(context.Context, *TriggerEvaluationRequest) (*EvaluationRun, error)
+	// Lists the history of evaluation runs that the user can access. Part of the
+	// public API, also exposed as REST.
+	ListEvaluationRuns(context.Context, *ListEvaluationRunsRequest) (*ListEvaluationRunsResponse, error)
 	// List all evaluation results that the user can access. It can further be
 	// restricted by various filtering options. Part of the public API, also
 	// exposed as REST.
 	ListEvaluationResults(context.Context, *ListEvaluationResultsRequest) (*ListEvaluationResultsResponse, error)
 	// Creates an evaluation result
 	CreateEvaluationResult(context.Context, *CreateEvaluationResultRequest) (*EvaluationResult, error)
+	// Subscribes to a stream of new evaluation results, optionally filtered by
+	// cloud service, catalog, control and compliance state. Part of the public
+	// API, also exposed as REST (as a server-sent stream).
+	SubscribeEvaluationResults(*SubscribeEvaluationResultsRequest, Evaluation_SubscribeEvaluationResultsServer) error
+	// Retrieves the compliance history of a cloud service as time-series, based
+	// on the periodically taken compliance snapshots. Part of the public API,
+	// also exposed as REST.
+	GetComplianceHistory(context.Context, *GetComplianceHistoryRequest) (*GetComplianceHistoryResponse, error)
+	// Drills down into the evaluation result of a control at a given point in
+	// time and returns the contributing assessment results as well as the
+	// concrete failing resources including their evidences. Part of the public
+	// API, also exposed as REST.
+	DrillDownEvaluationResult(context.Context, *DrillDownEvaluationResultRequest) (*DrillDownEvaluationResultResponse, error)
+	// Generates an audit-ready compliance report of a target of evaluation,
+	// containing the catalog, the scope, the compliance status of each control,
+	// failing resources, waivers and evidence references. The report is rendered
+	// as HTML or PDF and can be directly downloaded. Part of the public API, also
+	// exposed as REST.
+	GenerateComplianceReport(context.Context, *GenerateComplianceReportRequest) (*httpbody.HttpBody, error)
+	// Retrieves the compliance of a target of evaluation for each assurance
+	// level of its catalog, e.g., basic, substantial and high for the EUCS,
+	// based on the latest evaluation result of each control. Part of the public
+	// API, also exposed as REST.
+	GetAssuranceLevelCompliance(context.Context, *GetAssuranceLevelComplianceRequest) (*GetAssuranceLevelComplianceResponse, error)
+	// Creates a manual attestation of a control, e.g., for policies or trainings
+	// that cannot be assessed automatically. Active attestations are merged with
+	// the automated results during the evaluation of the control. Part of the
+	// public API, also exposed as REST.
+	CreateAttestation(context.Context, *CreateAttestationRequest) (*Attestation, error)
+	// Retrieves an attestation including its documents. Part of the public API,
+	// also exposed as REST.
+	GetAttestation(context.Context, *GetAttestationRequest) (*Attestation, error)
+	// Lists all attestations that the user can access. The content of the
+	// documents is not included. Part of the public API, also exposed as REST.
+	ListAttestations(context.Context, *ListAttestationsRequest) (*ListAttestationsResponse, error)
+	// Uploads a document, e.g., a signed policy, as evidence of an attestation.
+	// Part of the public API, also exposed as REST.
+	AddAttestationDocument(context.Context, *AddAttestationDocumentRequest) (*AttestationDocument, error)
+	// Removes (revokes) an attestation including its documents. Part of the
+	// public API, also exposed as REST.
+	RemoveAttestation(context.Context, *RemoveAttestationRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedEvaluationServer()
 }
 
@@ -141,12 +384,48 @@ func (UnimplementedEvaluationServer) StartEvaluation(context.Context, *StartEval
 func (UnimplementedEvaluationServer) StopEvaluation(context.Context, *StopEvaluationRequest) (*StopEvaluationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StopEvaluation not implemented")
 }
+func (UnimplementedEvaluationServer) TriggerEvaluation(context.Context, *TriggerEvaluationRequest) (*EvaluationRun, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TriggerEvaluation not implemented")
+}
+func (UnimplementedEvaluationServer) ListEvaluationRuns(context.Context, *ListEvaluationRunsRequest) (*ListEvaluationRunsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEvaluationRuns not implemented")
+}
 func (UnimplementedEvaluationServer) ListEvaluationResults(context.Context, *ListEvaluationResultsRequest) (*ListEvaluationResultsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListEvaluationResults not implemented")
 }
 func (UnimplementedEvaluationServer) CreateEvaluationResult(context.Context, *CreateEvaluationResultRequest) (*EvaluationResult, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateEvaluationResult not implemented")
 }
+func (UnimplementedEvaluationServer) SubscribeEvaluationResults(*SubscribeEvaluationResultsRequest, Evaluation_SubscribeEvaluationResultsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeEvaluationResults not implemented")
+}
+func (UnimplementedEvaluationServer) GetComplianceHistory(context.Context, *GetComplianceHistoryRequest) (*GetComplianceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetComplianceHistory not implemented")
+}
+func (UnimplementedEvaluationServer) DrillDownEvaluationResult(context.Context, *DrillDownEvaluationResultRequest) (*DrillDownEvaluationResultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DrillDownEvaluationResult not implemented")
+}
+func (UnimplementedEvaluationServer) GenerateComplianceReport(context.Context, *GenerateComplianceReportRequest) (*httpbody.HttpBody, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateComplianceReport not implemented")
+}
+func (UnimplementedEvaluationServer) GetAssuranceLevelCompliance(context.Context, *GetAssuranceLevelComplianceRequest) (*GetAssuranceLevelComplianceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAssuranceLevelCompliance not implemented")
+}
+func (UnimplementedEvaluationServer) CreateAttestation(context.Context, *CreateAttestationRequest) (*Attestation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAttestation not implemented")
+}
+func (UnimplementedEvaluationServer) GetAttestation(context.Context, *GetAttestationRequest) (*Attestation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAttestation not implemented")
+}
+func (UnimplementedEvaluationServer) ListAttestations(context.Context, *ListAttestationsRequest) (*ListAttestationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAttestations not implemented")
+}
+func (UnimplementedEvaluationServer) AddAttestationDocument(context.Context, *AddAttestationDocumentRequest) (*AttestationDocument, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAttestationDocument not implemented")
+}
+func (UnimplementedEvaluationServer) RemoveAttestation(context.Context, *RemoveAttestationRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAttestation not implemented")
+}
 func (UnimplementedEvaluationServer) mustEmbedUnimplementedEvaluationServer() {}
 
 // UnsafeEvaluationServer may be embedded to opt out of forward compatibility for this service.
@@ -196,6 +475,42 @@ func _Evaluation_StopEvaluation_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Evaluation_TriggerEvaluation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TriggerEvaluationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EvaluationServer).TriggerEvaluation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Evaluation_TriggerEvaluation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EvaluationServer).TriggerEvaluation(ctx, req.(*TriggerEvaluationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Evaluation_ListEvaluationRuns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEvaluationRunsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EvaluationServer).ListEvaluationRuns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Evaluation_ListEvaluationRuns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EvaluationServer).ListEvaluationRuns(ctx, req.(*ListEvaluationRunsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Evaluation_ListEvaluationResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListEvaluationResultsRequest)
 	if err := dec(in); err != nil {
@@ -232,6 +547,189 @@ func _Evaluation_CreateEvaluationResult_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Evaluation_SubscribeEvaluationResults_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeEvaluationResultsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(EvaluationServer).SubscribeEvaluationResults(m, &evaluationSubscribeEvaluationResultsServer{stream})
+}
+
+type Evaluation_SubscribeEvaluationResultsServer interface {
+	Send(*EvaluationResult) error
+	grpc.ServerStream
+}
+
+type evaluationSubscribeEvaluationResultsServer struct {
+	grpc.ServerStream
+}
+
+func (x *evaluationSubscribeEvaluationResultsServer) Send(m *EvaluationResult) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Evaluation_GetComplianceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetComplianceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EvaluationServer).GetComplianceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Evaluation_GetComplianceHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EvaluationServer).GetComplianceHistory(ctx, req.(*GetComplianceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Evaluation_DrillDownEvaluationResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DrillDownEvaluationResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EvaluationServer).DrillDownEvaluationResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Evaluation_DrillDownEvaluationResult_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EvaluationServer).DrillDownEvaluationResult(ctx, req.(*DrillDownEvaluationResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Evaluation_GenerateComplianceReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateComplianceReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EvaluationServer).GenerateComplianceReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Evaluation_GenerateComplianceReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EvaluationServer).GenerateComplianceReport(ctx, req.(*GenerateComplianceReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Evaluation_GetAssuranceLevelCompliance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAssuranceLevelComplianceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EvaluationServer).GetAssuranceLevelCompliance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Evaluation_GetAssuranceLevelCompliance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EvaluationServer).GetAssuranceLevelCompliance(ctx, req.(*GetAssuranceLevelComplianceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Evaluation_CreateAttestation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAttestationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EvaluationServer).CreateAttestation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Evaluation_CreateAttestation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EvaluationServer).CreateAttestation(ctx, req.(*CreateAttestationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Evaluation_GetAttestation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAttestationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EvaluationServer).GetAttestation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Evaluation_GetAttestation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EvaluationServer).GetAttestation(ctx, req.(*GetAttestationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Evaluation_ListAttestations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAttestationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EvaluationServer).ListAttestations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Evaluation_ListAttestations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EvaluationServer).ListAttestations(ctx, req.(*ListAttestationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Evaluation_AddAttestationDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddAttestationDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EvaluationServer).AddAttestationDocument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Evaluation_AddAttestationDocument_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EvaluationServer).AddAttestationDocument(ctx, req.(*AddAttestationDocumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Evaluation_RemoveAttestation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveAttestationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EvaluationServer).RemoveAttestation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Evaluation_RemoveAttestation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EvaluationServer).RemoveAttestation(ctx, req.(*RemoveAttestationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Evaluation_ServiceDesc is the grpc.ServiceDesc for Evaluation service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -247,6 +745,14 @@ var Evaluation_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "StopEvaluation",
 			Handler:    _Evaluation_StopEvaluation_Handler,
 		},
+		{
+			MethodName: "TriggerEvaluation",
+			Handler:    _Evaluation_TriggerEvaluation_Handler,
+		},
+		{
+			MethodName: "ListEvaluationRuns",
+			Handler:    _Evaluation_ListEvaluationRuns_Handler,
+		},
 		{
 			MethodName: "ListEvaluationResults",
 			Handler:    _Evaluation_ListEvaluationResults_Handler,
@@ -255,7 +761,49 @@ var Evaluation_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateEvaluationResult",
 			Handler:    _Evaluation_CreateEvaluationResult_Handler,
 		},
+		{
+			MethodName: "GetComplianceHistory",
+			Handler:    _Evaluation_GetComplianceHistory_Handler,
+		},
+		{
+			MethodName: "DrillDownEvaluationResult",
+			Handler:    _Evaluation_DrillDownEvaluationResult_Handler,
+		},
+		{
+			MethodName: "GenerateComplianceReport",
+			Handler:    _Evaluation_GenerateComplianceReport_Handler,
+		},
+		{
+			MethodName: "GetAssuranceLevelCompliance",
+			Handler:    _Evaluation_GetAssuranceLevelCompliance_Handler,
+		},
+		{
+			MethodName: "CreateAttestation",
+			Handler:    _Evaluation_CreateAttestation_Handler,
+		},
+		{
+			MethodName: "GetAttestation",
+			Handler:    _Evaluation_GetAttestation_Handler,
+		},
+		{
+			MethodName: "ListAttestations",
+			Handler:    _Evaluation_ListAttestations_Handler,
+		},
+		{
+			MethodName: "AddAttestationDocument",
+			Handler:    _Evaluation_AddAttestationDocument_Handler,
+		},
+		{
+			MethodName: "RemoveAttestation",
+			Handler:    _Evaluation_RemoveAttestation_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeEvaluationResults",
+			Handler:       _Evaluation_SubscribeEvaluationResults_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/evaluation/evaluation.proto",
 }
@@ -28,6 +28,8 @@ package evidence
 import (
 	"context"
 
+	"clouditor.io/clouditor/v2/api/ontology"
+
 	"google.golang.org/protobuf/proto"
 )
 
@@ -36,3 +38,16 @@ type EvidenceHookFunc func(ctx context.Context, evidence *Evidence, err error)
 func (req *StoreEvidenceRequest) GetPayload() proto.Message {
 	return req.Evidence
 }
+
+// Relationships returns the typed relationships of the resource to other resources, which are included in its evidence.
+func Relationships(r ontology.IsResource) (relationships []*Relationship) {
+	for _, rel := range ontology.Related(r) {
+		relationships = append(relationships, &Relationship{
+			Type:       string(rel.Type),
+			Property:   rel.Property,
+			ResourceId: rel.Value,
+		})
+	}
+
+	return
+}
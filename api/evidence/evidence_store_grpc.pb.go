@@ -44,10 +44,12 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	EvidenceStore_StoreEvidence_FullMethodName  = "/clouditor.evidence.v1.EvidenceStore/StoreEvidence"
-	EvidenceStore_StoreEvidences_FullMethodName = "/clouditor.evidence.v1.EvidenceStore/StoreEvidences"
-	EvidenceStore_ListEvidences_FullMethodName  = "/clouditor.evidence.v1.EvidenceStore/ListEvidences"
-	EvidenceStore_GetEvidence_FullMethodName    = "/clouditor.evidence.v1.EvidenceStore/GetEvidence"
+	EvidenceStore_StoreEvidence_FullMethodName     = "/clouditor.evidence.v1.EvidenceStore/StoreEvidence"
+	EvidenceStore_StoreEvidences_FullMethodName    = "/clouditor.evidence.v1.EvidenceStore/StoreEvidences"
+	EvidenceStore_ListEvidences_FullMethodName     = "/clouditor.evidence.v1.EvidenceStore/ListEvidences"
+	EvidenceStore_GetEvidence_FullMethodName       = "/clouditor.evidence.v1.EvidenceStore/GetEvidence"
+	EvidenceStore_ImportEvidences_FullMethodName   = "/clouditor.evidence.v1.EvidenceStore/ImportEvidences"
+	EvidenceStore_SubscribeEvidence_FullMethodName = "/clouditor.evidence.v1.EvidenceStore/SubscribeEvidence"
 )
 
 // EvidenceStoreClient is the client API for EvidenceStore service.
@@ -65,6 +67,16 @@ type EvidenceStoreClient interface {
 	// Returns a particular stored evidence. Part of the public API, also exposed
 	// as REST.
 	GetEvidence(ctx context.Context, in *GetEvidenceRequest, opts ...grpc.CallOption) (*Evidence, error)
+	// Imports evidences from the output of third-party tools, e.g., a JSON
+	// array or a CSV file. Each record is mapped into an ontology resource
+	// according to the supplied mapping and stored as an evidence. If an
+	// assessment is configured, the evidences are sent to it instead, which
+	// stores them. Part of the public API, also exposed as REST.
+	ImportEvidences(ctx context.Context, in *ImportEvidencesRequest, opts ...grpc.CallOption) (*ImportEvidencesResponse, error)
+	// Subscribes to newly stored evidences. The evidences can optionally be
+	// filtered by cloud service and resource type. Part of the public API, not
+	// exposed as REST.
+	SubscribeEvidence(ctx context.Context, in *SubscribeEvidenceRequest, opts ...grpc.CallOption) (EvidenceStore_SubscribeEvidenceClient, error)
 }
 
 type evidenceStoreClient struct {
@@ -133,6 +145,47 @@ func (c *evidenceStoreClient) GetEvidence(ctx context.Context, in *GetEvidenceRe
 	return out, nil
 }
 
+func (c *evidenceStoreClient) ImportEvidences(ctx context.Context, in *ImportEvidencesRequest, opts ...grpc.CallOption) (*ImportEvidencesResponse, error) {
+	out := new(ImportEvidencesResponse)
+	err := c.cc.Invoke(ctx, EvidenceStore_ImportEvidences_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *evidenceStoreClient) SubscribeEvidence(ctx context.Context, in *SubscribeEvidenceRequest, opts ...grpc.CallOption) (EvidenceStore_SubscribeEvidenceClient, error) {
+	stream, err := c.cc.NewStream(ctx, &EvidenceStore_ServiceDesc.Streams[1], EvidenceStore_SubscribeEvidence_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &evidenceStoreSubscribeEvidenceClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type EvidenceStore_SubscribeEvidenceClient interface {
+	Recv() (*Evidence, error)
+	grpc.ClientStream
+}
+
+type evidenceStoreSubscribeEvidenceClient struct {
+	grpc.ClientStream
+}
+
+func (x *evidenceStoreSubscribeEvidenceClient) Recv() (*Evidence, error) {
+	m := new(Evidence)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // EvidenceStoreServer is the server API for EvidenceStore service.
 // All implementations must embed UnimplementedEvidenceStoreServer
 // for forward compatibility
@@ -148,6 +201,16 @@ type EvidenceStoreServer interface {
 	// Returns a particular stored evidence. Part of the public API, also exposed
 	// as REST.
 	GetEvidence(context.Context, *GetEvidenceRequest) (*Evidence, error)
+	// Imports evidences from the output of third-party tools, e.g., a JSON
+	// array or a CSV file. Each record is mapped into an ontology resource
+	// according to the supplied mapping and stored as an evidence. If an
+	// assessment is configured, the evidences are sent to it instead, which
+	// stores them. Part of the public API, also exposed as REST.
+	ImportEvidences(context.Context, *ImportEvidencesRequest) (*ImportEvidencesResponse, error)
+	// Subscribes to newly stored evidences. The evidences can optionally be
+	// filtered by cloud service and resource type. Part of the public API, not
+	// exposed as REST.
+	SubscribeEvidence(*SubscribeEvidenceRequest, EvidenceStore_SubscribeEvidenceServer) error
 	mustEmbedUnimplementedEvidenceStoreServer()
 }
 
@@ -167,6 +230,12 @@ func (UnimplementedEvidenceStoreServer) ListEvidences(context.Context, *ListEvid
 func (UnimplementedEvidenceStoreServer) GetEvidence(context.Context, *GetEvidenceRequest) (*Evidence, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetEvidence not implemented")
 }
+func (UnimplementedEvidenceStoreServer) ImportEvidences(context.Context, *ImportEvidencesRequest) (*ImportEvidencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportEvidences not implemented")
+}
+func (UnimplementedEvidenceStoreServer) SubscribeEvidence(*SubscribeEvidenceRequest, EvidenceStore_SubscribeEvidenceServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeEvidence not implemented")
+}
 func (UnimplementedEvidenceStoreServer) mustEmbedUnimplementedEvidenceStoreServer() {}
 
 // UnsafeEvidenceStoreServer may be embedded to opt out of forward compatibility for this service.
@@ -260,6 +329,45 @@ func _EvidenceStore_GetEvidence_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EvidenceStore_ImportEvidences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportEvidencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EvidenceStoreServer).ImportEvidences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EvidenceStore_ImportEvidences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EvidenceStoreServer).ImportEvidences(ctx, req.(*ImportEvidencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EvidenceStore_SubscribeEvidence_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeEvidenceRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(EvidenceStoreServer).SubscribeEvidence(m, &evidenceStoreSubscribeEvidenceServer{stream})
+}
+
+type EvidenceStore_SubscribeEvidenceServer interface {
+	Send(*Evidence) error
+	grpc.ServerStream
+}
+
+type evidenceStoreSubscribeEvidenceServer struct {
+	grpc.ServerStream
+}
+
+func (x *evidenceStoreSubscribeEvidenceServer) Send(m *Evidence) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // EvidenceStore_ServiceDesc is the grpc.ServiceDesc for EvidenceStore service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -279,6 +387,10 @@ var EvidenceStore_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetEvidence",
 			Handler:    _EvidenceStore_GetEvidence_Handler,
 		},
+		{
+			MethodName: "ImportEvidences",
+			Handler:    _EvidenceStore_ImportEvidences_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -287,6 +399,11 @@ var EvidenceStore_ServiceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "SubscribeEvidence",
+			Handler:       _EvidenceStore_SubscribeEvidence_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "api/evidence/evidence_store.proto",
 }
@@ -24,3 +24,24 @@
 // This file is part of Clouditor Community Edition.
 
 package evidence
+
+import (
+	"testing"
+
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/util"
+)
+
+func TestRelationships(t *testing.T) {
+	got := Relationships(&ontology.VirtualMachine{
+		Id:              "my-vm",
+		ParentId:        util.Ref("my-resource-group"),
+		BlockStorageIds: []string{"my-disk"},
+	})
+
+	assert.Equal(t, []*Relationship{
+		{Type: "stores_data_in", Property: "block_storage", ResourceId: "my-disk"},
+		{Type: "parent", Property: "parent", ResourceId: "my-resource-group"},
+	}, got)
+}
@@ -0,0 +1,105 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package api
+
+import (
+	"errors"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// ErrInvalidFieldMask is returned if a field mask contains paths that do not exist in the masked message.
+var ErrInvalidFieldMask = errors.New("invalid field mask")
+
+// fieldMaskTree is the tree representation of the paths of a field mask. An empty sub-tree selects the whole field.
+type fieldMaskTree map[string]fieldMaskTree
+
+// ValidateFieldMask checks whether all paths of mask are valid for the message type of m. A nil or empty mask is
+// always valid.
+//
+// Note: This function already returns a gRPC error, so the error can be returned directly without any wrapping in a
+// request function.
+func ValidateFieldMask(mask *fieldmaskpb.FieldMask, m proto.Message) error {
+	if len(mask.GetPaths()) == 0 || mask.IsValid(m) {
+		return nil
+	}
+
+	return status.Errorf(codes.InvalidArgument, "%v: %s", ErrInvalidFieldMask, strings.Join(mask.GetPaths(), ","))
+}
+
+// ApplyFieldMask clears all fields of the messages in ms that are not selected by the paths of mask. Nested fields of
+// singular messages can be selected using dot-separated paths, e.g., "metric_configuration.is_default". A nil or empty
+// mask selects all fields, in which case the messages are not modified.
+func ApplyFieldMask[T proto.Message](mask *fieldmaskpb.FieldMask, ms ...T) {
+	if len(mask.GetPaths()) == 0 {
+		return
+	}
+
+	// Normalize the paths first, so that paths that are already covered by their parent are removed
+	tree := fieldMaskTree{}
+	for _, path := range fieldmaskpb.Union(mask, &fieldmaskpb.FieldMask{}).GetPaths() {
+		node := tree
+		for _, name := range strings.Split(path, ".") {
+			if node[name] == nil {
+				node[name] = fieldMaskTree{}
+			}
+			node = node[name]
+		}
+	}
+
+	for _, m := range ms {
+		tree.prune(m.ProtoReflect())
+	}
+}
+
+// prune clears all populated fields of m that are not part of the tree.
+func (tree fieldMaskTree) prune(m protoreflect.Message) {
+	var clear []protoreflect.FieldDescriptor
+
+	if !m.IsValid() {
+		return
+	}
+
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		sub, ok := tree[string(fd.Name())]
+		if !ok {
+			clear = append(clear, fd)
+		} else if len(sub) > 0 && fd.Message() != nil && !fd.IsList() && !fd.IsMap() {
+			sub.prune(v.Message())
+		}
+
+		return true
+	})
+
+	for _, fd := range clear {
+		m.Clear(fd)
+	}
+}
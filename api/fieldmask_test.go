@@ -0,0 +1,150 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package api
+
+import (
+	"testing"
+
+	"clouditor.io/clouditor/v2/api/assessment"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestValidateFieldMask(t *testing.T) {
+	type args struct {
+		mask *fieldmaskpb.FieldMask
+		m    proto.Message
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr assert.WantErr
+	}{
+		{
+			name: "nil mask",
+			args: args{
+				m: &assessment.AssessmentResult{},
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "valid mask",
+			args: args{
+				mask: &fieldmaskpb.FieldMask{Paths: []string{"id", "metric_configuration.is_default"}},
+				m:    &assessment.AssessmentResult{},
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "invalid mask",
+			args: args{
+				mask: &fieldmaskpb.FieldMask{Paths: []string{"id", "metric_configuration.unknown"}},
+				m:    &assessment.AssessmentResult{},
+			},
+			wantErr: func(t *testing.T, err error) bool {
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+				return assert.ErrorContains(t, err, "invalid field mask: id,metric_configuration.unknown")
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.wantErr(t, ValidateFieldMask(tt.args.mask, tt.args.m))
+		})
+	}
+}
+
+func TestApplyFieldMask(t *testing.T) {
+	var now = timestamppb.Now()
+
+	newResult := func() *assessment.AssessmentResult {
+		return &assessment.AssessmentResult{
+			Id:        "11111111-1111-1111-1111-111111111111",
+			Timestamp: now,
+			MetricId:  "metric",
+			MetricConfiguration: &assessment.MetricConfiguration{
+				Operator:  "==",
+				IsDefault: true,
+			},
+			Compliant:     true,
+			ResourceTypes: []string{"Resource"},
+		}
+	}
+
+	tests := []struct {
+		name string
+		mask *fieldmaskpb.FieldMask
+		want *assessment.AssessmentResult
+	}{
+		{
+			name: "nil mask",
+			want: newResult(),
+		},
+		{
+			name: "top-level fields",
+			mask: &fieldmaskpb.FieldMask{Paths: []string{"id", "timestamp", "resource_types"}},
+			want: &assessment.AssessmentResult{
+				Id:            "11111111-1111-1111-1111-111111111111",
+				Timestamp:     now,
+				ResourceTypes: []string{"Resource"},
+			},
+		},
+		{
+			name: "nested fields",
+			mask: &fieldmaskpb.FieldMask{Paths: []string{"id", "metric_configuration.is_default"}},
+			want: &assessment.AssessmentResult{
+				Id: "11111111-1111-1111-1111-111111111111",
+				MetricConfiguration: &assessment.MetricConfiguration{
+					IsDefault: true,
+				},
+			},
+		},
+		{
+			name: "nested field covered by its parent",
+			mask: &fieldmaskpb.FieldMask{Paths: []string{"metric_configuration.is_default", "metric_configuration"}},
+			want: &assessment.AssessmentResult{
+				MetricConfiguration: &assessment.MetricConfiguration{
+					Operator:  "==",
+					IsDefault: true,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := []*assessment.AssessmentResult{newResult(), newResult()}
+
+			ApplyFieldMask(tt.mask, got...)
+
+			assert.Equal(t, []*assessment.AssessmentResult{tt.want, tt.want}, got)
+		})
+	}
+}
@@ -0,0 +1,210 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package ontology
+
+import (
+	"regexp"
+	"strings"
+)
+
+// eeaCountries contains the ISO 3166-1 alpha-2 codes of the member states of the European Economic Area, i.e., the
+// member states of the EU as well as Iceland, Liechtenstein and Norway.
+var eeaCountries = map[string]bool{
+	"AT": true, "BE": true, "BG": true, "CY": true, "CZ": true, "DE": true, "DK": true, "EE": true, "ES": true,
+	"FI": true, "FR": true, "GR": true, "HR": true, "HU": true, "IE": true, "IS": true, "IT": true, "LI": true,
+	"LT": true, "LU": true, "LV": true, "MT": true, "NL": true, "NO": true, "PL": true, "PT": true, "RO": true,
+	"SE": true, "SI": true, "SK": true,
+}
+
+// regionCountries maps the (normalized) regions of Azure, AWS and GCP to the ISO 3166-1 alpha-2 code of the country
+// their data centers are located in.
+var regionCountries = map[string]string{
+	// Azure
+	"australiacentral":   "AU",
+	"australiacentral2":  "AU",
+	"australiaeast":      "AU",
+	"australiasoutheast": "AU",
+	"austriaeast":        "AT",
+	"belgiumcentral":     "BE",
+	"brazilsouth":        "BR",
+	"brazilsoutheast":    "BR",
+	"canadacentral":      "CA",
+	"canadaeast":         "CA",
+	"centralindia":       "IN",
+	"centralus":          "US",
+	"eastasia":           "HK",
+	"eastus":             "US",
+	"eastus2":            "US",
+	"francecentral":      "FR",
+	"francesouth":        "FR",
+	"germanynorth":       "DE",
+	"germanywestcentral": "DE",
+	"israelcentral":      "IL",
+	"italynorth":         "IT",
+	"japaneast":          "JP",
+	"japanwest":          "JP",
+	"koreacentral":       "KR",
+	"koreasouth":         "KR",
+	"mexicocentral":      "MX",
+	"northcentralus":     "US",
+	"northeurope":        "IE",
+	"norwayeast":         "NO",
+	"norwaywest":         "NO",
+	"polandcentral":      "PL",
+	"qatarcentral":       "QA",
+	"southafricanorth":   "ZA",
+	"southafricawest":    "ZA",
+	"southcentralus":     "US",
+	"southeastasia":      "SG",
+	"southindia":         "IN",
+	"spaincentral":       "ES",
+	"swedencentral":      "SE",
+	"swedensouth":        "SE",
+	"switzerlandnorth":   "CH",
+	"switzerlandwest":    "CH",
+	"uaecentral":         "AE",
+	"uaenorth":           "AE",
+	"uksouth":            "GB",
+	"ukwest":             "GB",
+	"westcentralus":      "US",
+	"westeurope":         "NL",
+	"westindia":          "IN",
+	"westus":             "US",
+	"westus2":            "US",
+	"westus3":            "US",
+
+	// AWS
+	"af-south-1":     "ZA",
+	"ap-east-1":      "HK",
+	"ap-northeast-1": "JP",
+	"ap-northeast-2": "KR",
+	"ap-northeast-3": "JP",
+	"ap-south-1":     "IN",
+	"ap-south-2":     "IN",
+	"ap-southeast-1": "SG",
+	"ap-southeast-2": "AU",
+	"ap-southeast-3": "ID",
+	"ap-southeast-4": "AU",
+	"ca-central-1":   "CA",
+	"ca-west-1":      "CA",
+	"eu-central-1":   "DE",
+	"eu-central-2":   "CH",
+	"eu-north-1":     "SE",
+	"eu-south-1":     "IT",
+	"eu-south-2":     "ES",
+	"eu-west-1":      "IE",
+	"eu-west-2":      "GB",
+	"eu-west-3":      "FR",
+	"il-central-1":   "IL",
+	"me-central-1":   "AE",
+	"me-south-1":     "BH",
+	"sa-east-1":      "BR",
+	"us-east-1":      "US",
+	"us-east-2":      "US",
+	"us-west-1":      "US",
+	"us-west-2":      "US",
+
+	// GCP
+	"asia-east1":              "TW",
+	"asia-east2":              "HK",
+	"asia-northeast1":         "JP",
+	"asia-northeast2":         "JP",
+	"asia-northeast3":         "KR",
+	"asia-south1":             "IN",
+	"asia-south2":             "IN",
+	"asia-southeast1":         "SG",
+	"asia-southeast2":         "ID",
+	"australia-southeast1":    "AU",
+	"australia-southeast2":    "AU",
+	"europe-central2":         "PL",
+	"europe-north1":           "FI",
+	"europe-southwest1":       "ES",
+	"europe-west1":            "BE",
+	"europe-west2":            "GB",
+	"europe-west3":            "DE",
+	"europe-west4":            "NL",
+	"europe-west6":            "CH",
+	"europe-west8":            "IT",
+	"europe-west9":            "FR",
+	"europe-west10":           "DE",
+	"europe-west12":           "IT",
+	"me-central1":             "QA",
+	"me-central2":             "SA",
+	"me-west1":                "IL",
+	"northamerica-northeast1": "CA",
+	"northamerica-northeast2": "CA",
+	"southamerica-east1":      "BR",
+	"southamerica-west1":      "CL",
+	"us-central1":             "US",
+	"us-east1":                "US",
+	"us-east4":                "US",
+	"us-east5":                "US",
+	"us-south1":               "US",
+	"us-west1":                "US",
+	"us-west2":                "US",
+	"us-west3":                "US",
+	"us-west4":                "US",
+}
+
+// zoneSuffix matches the suffix of an availability zone, e.g., the "a" of the AWS zone eu-central-1a or the "-b" of
+// the GCP zone europe-west3-b.
+var zoneSuffix = regexp.MustCompile(`(\d)(-?[a-z])$`)
+
+// RegionCountry returns the ISO 3166-1 alpha-2 code of the country, in which the region of a cloud provider is
+// located. The region can be given in the naming scheme of Azure (e.g., "westeurope" or "West Europe"), AWS (e.g.,
+// "eu-central-1") or GCP (e.g., "europe-west3"). Availability zones, e.g., "eu-central-1a", are mapped to their region.
+func RegionCountry(region string) (country string, ok bool) {
+	// Azure also uses display names, such as "West Europe"
+	region = strings.ReplaceAll(strings.ToLower(strings.TrimSpace(region)), " ", "")
+
+	if country, ok = regionCountries[region]; ok {
+		return
+	}
+
+	country, ok = regionCountries[zoneSuffix.ReplaceAllString(region, "$1")]
+
+	return
+}
+
+// IsEEA returns whether the country, given as ISO 3166-1 alpha-2 code, is a member of the European Economic Area.
+func IsEEA(country string) bool {
+	return eeaCountries[strings.ToUpper(country)]
+}
+
+// Normalize sets the country of the location and whether it is in the European Economic Area based on its region, so
+// that locations can be compared independently of the naming scheme of the cloud provider. A country that is already
+// set, e.g., by a discoverer that knows it better, is kept.
+func (x *GeoLocation) Normalize() {
+	if x == nil {
+		return
+	}
+
+	if x.Country == "" {
+		x.Country, _ = RegionCountry(x.Region)
+	}
+
+	x.Eea = IsEEA(x.Country)
+}
@@ -0,0 +1,91 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package ontology
+
+import (
+	"testing"
+
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+)
+
+func TestRegionCountry(t *testing.T) {
+	tests := []struct {
+		name   string
+		region string
+		want   string
+		wantOk bool
+	}{
+		{name: "Azure", region: "westeurope", want: "NL", wantOk: true},
+		{name: "Azure display name", region: "Germany West Central", want: "DE", wantOk: true},
+		{name: "AWS", region: "eu-north-1", want: "SE", wantOk: true},
+		{name: "AWS availability zone", region: "eu-central-1a", want: "DE", wantOk: true},
+		{name: "GCP", region: "europe-west1", want: "BE", wantOk: true},
+		{name: "GCP zone", region: "europe-west3-b", want: "DE", wantOk: true},
+		{name: "unknown", region: "moon-south-1", want: "", wantOk: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := RegionCountry(tt.region)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantOk, ok)
+		})
+	}
+}
+
+func TestGeoLocation_Normalize(t *testing.T) {
+	tests := []struct {
+		name string
+		loc  *GeoLocation
+		want *GeoLocation
+	}{
+		{
+			name: "EEA",
+			loc:  &GeoLocation{Region: "norwayeast"},
+			want: &GeoLocation{Region: "norwayeast", Country: "NO", Eea: true},
+		},
+		{
+			name: "not EEA",
+			loc:  &GeoLocation{Region: "eu-west-2"},
+			want: &GeoLocation{Region: "eu-west-2", Country: "GB"},
+		},
+		{
+			name: "country is kept",
+			loc:  &GeoLocation{Region: "on-premises", Country: "de"},
+			want: &GeoLocation{Region: "on-premises", Country: "de", Eea: true},
+		},
+		{
+			name: "unknown region",
+			loc:  &GeoLocation{Region: "on-premises"},
+			want: &GeoLocation{Region: "on-premises"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.loc.Normalize()
+			assert.Equal(t, tt.want, tt.loc)
+		})
+	}
+}
@@ -0,0 +1,189 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package ontology
+
+import (
+	"encoding/json"
+	"slices"
+	"strings"
+
+	"buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// typeURLPrefix is the prefix of the type URL of resources wrapped in a [google.golang.org/protobuf/types/known/anypb.Any],
+// e.g., in an evidence.
+const typeURLPrefix = "type.googleapis.com/"
+
+// JSONSchema returns a JSON Schema of the ontology, which can be used by collectors written in other languages to
+// validate the resources of their evidences. The schema validates a resource in its protobuf JSON representation, as
+// it is embedded in an evidence, i.e., including its "@type". All messages of the ontology are contained in "$defs";
+// abstract classes, such as "Storage", are a choice of their subclasses.
+func JSONSchema() ([]byte, error) {
+	var (
+		defs      = make(map[string]any)
+		resources []any
+	)
+
+	msgs := File_api_ontology_ontology_proto.Messages()
+	for i := 0; i < msgs.Len(); i++ {
+		msg := msgs.Get(i)
+		defs[string(msg.Name())] = messageSchema(msg)
+
+		// Only concrete resources can be embedded in an evidence
+		names, _ := proto.GetExtension(msg.Options(), E_ResourceTypeNames).([]string)
+		if !slices.Contains(names, "Resource") {
+			continue
+		}
+
+		resources = append(resources, map[string]any{
+			"allOf": []any{
+				ref(msg),
+				map[string]any{
+					"properties": map[string]any{
+						"@type": map[string]any{"const": typeURLPrefix + string(msg.FullName())},
+					},
+					"required": []string{"@type"},
+				},
+			},
+		})
+	}
+
+	return json.MarshalIndent(map[string]any{
+		"$schema":  "https://json-schema.org/draft/2020-12/schema",
+		"title":    "Clouditor Ontology",
+		"$comment": "Ontology version " + Version,
+		"$defs":    defs,
+		"oneOf":    resources,
+	}, "", "  ")
+}
+
+// messageSchema returns the schema of a message of the ontology.
+func messageSchema(msg protoreflect.MessageDescriptor) map[string]any {
+	var (
+		fields     = msg.Fields()
+		properties = make(map[string]any)
+		required   []string
+		schema     = map[string]any{"type": "object"}
+	)
+
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		properties[field.JSONName()] = fieldSchema(field)
+
+		c, _ := proto.GetExtension(field.Options(), validate.E_Field).(*validate.FieldConstraints)
+		if c.GetRequired() {
+			required = append(required, field.JSONName())
+		}
+	}
+
+	schema["properties"] = properties
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+
+	// Abstract classes consist of a single oneof containing their subclasses, of which exactly one needs to be set
+	if oneofs := msg.Oneofs(); oneofs.Len() == 1 && oneofs.Get(0).Fields().Len() == fields.Len() {
+		var choices []any
+		for i := 0; i < fields.Len(); i++ {
+			choices = append(choices, map[string]any{"required": []string{fields.Get(i).JSONName()}})
+		}
+
+		schema["oneOf"] = choices
+		schema["maxProperties"] = 1
+	}
+
+	return schema
+}
+
+// fieldSchema returns the schema of a field according to the protobuf JSON mapping.
+func fieldSchema(field protoreflect.FieldDescriptor) map[string]any {
+	if field.IsMap() {
+		return map[string]any{
+			"type":                 "object",
+			"additionalProperties": kindSchema(field.MapValue()),
+		}
+	} else if field.IsList() {
+		return map[string]any{
+			"type":  "array",
+			"items": kindSchema(field),
+		}
+	}
+
+	return kindSchema(field)
+}
+
+// kindSchema returns the schema of a single value of a field according to the protobuf JSON mapping.
+func kindSchema(field protoreflect.FieldDescriptor) map[string]any {
+	switch field.Kind() {
+	case protoreflect.BoolKind:
+		return map[string]any{"type": "boolean"}
+	case protoreflect.StringKind:
+		return map[string]any{"type": "string"}
+	case protoreflect.BytesKind:
+		return map[string]any{"type": "string", "contentEncoding": "base64"}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return map[string]any{"type": "integer"}
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind,
+		protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		// 64-bit integers are encoded as strings, but numbers are accepted as well
+		return map[string]any{"type": []string{"integer", "string"}}
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return map[string]any{"type": "number"}
+	case protoreflect.EnumKind:
+		var (
+			values = field.Enum().Values()
+			names  []string
+		)
+		for i := 0; i < values.Len(); i++ {
+			names = append(names, string(values.Get(i).Name()))
+		}
+
+		return map[string]any{"type": "string", "enum": names}
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		switch field.Message().FullName() {
+		case "google.protobuf.Timestamp":
+			return map[string]any{"type": "string", "format": "date-time"}
+		case "google.protobuf.Duration":
+			return map[string]any{"type": "string", "pattern": `^-?[0-9]+(\.[0-9]+)?s$`}
+		}
+
+		if strings.HasPrefix(string(field.Message().FullName()), "google.protobuf.") {
+			return map[string]any{}
+		}
+
+		return ref(field.Message())
+	}
+
+	return map[string]any{}
+}
+
+// ref returns a reference to the schema of the message in "$defs".
+func ref(msg protoreflect.MessageDescriptor) map[string]any {
+	return map[string]any{"$ref": "#/$defs/" + string(msg.Name())}
+}
@@ -0,0 +1,63 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package ontology
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+)
+
+func TestJSONSchema(t *testing.T) {
+	var schema struct {
+		Defs  map[string]map[string]any `json:"$defs"`
+		OneOf []any                     `json:"oneOf"`
+	}
+
+	b, err := JSONSchema()
+	assert.NoError(t, err)
+	assert.NoError(t, json.Unmarshal(b, &schema))
+
+	// Concrete resources contain their properties
+	vm := schema.Defs["VirtualMachine"]
+	assert.Equal(t, "object", vm["type"])
+	assert.Contains(t, vm["properties"], "blockStorageIds")
+
+	// Abstract classes are a choice of their subclasses
+	assert.Equal(t, 4, len(schema.Defs["Storage"]["oneOf"].([]any)))
+
+	// Make sure, the exported schema is up-to-date
+	exported, err := os.ReadFile("ontology.schema.json")
+	assert.NoError(t, err)
+	assert.Equal(t, string(b)+"\n", string(exported))
+
+	// The Python package ships its own copy
+	exported, err = os.ReadFile("../../python/src/clouditor/ontology.schema.json")
+	assert.NoError(t, err)
+	assert.Equal(t, string(b)+"\n", string(exported))
+}
@@ -3,7 +3,6 @@ package ontology
 import (
 	"encoding/json"
 	"slices"
-	"strings"
 
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
@@ -27,6 +26,9 @@ var _ IsResource = &VirtualMachine{}
 type Relationship struct {
 	Property string
 	Value    string
+
+	// Type is the declared type of the relationship, see [RelationshipTypes]
+	Type RelationshipType
 }
 
 func Related(r IsResource) []Relationship {
@@ -39,26 +41,30 @@ func Related(r IsResource) []Relationship {
 		field := fields.Get(i)
 
 		// TODO(oxisto): Can we maybe have a proto option on these fields instead of matching by name?
-		property, found := strings.CutSuffix(string(field.Name()), "_id")
-		if found {
-			v := r.ProtoReflect().Get(field)
-			vv := v.Interface()
-			if vvv, ok := vv.(string); ok {
-				// Make sure, the value is really set
-				if vvv != "" {
-					ids = append(ids, Relationship{
-						Property: string(property),
-						Value:    vvv,
-					})
-				}
-			} else if vvvv, ok := vv.([]string); ok {
-				for _, vvv := range vvvv {
-					ids = append(ids, Relationship{
-						Property: string(property),
-						Value:    vvv,
-					})
-				}
+		property, found := referenceProperty(field)
+		if !found {
+			continue
+		}
+
+		v := r.ProtoReflect().Get(field)
+
+		if field.IsList() {
+			// Repeated fields, e.g., network_interface_ids, contain multiple related resources
+			list := v.List()
+			for j := 0; j < list.Len(); j++ {
+				ids = append(ids, Relationship{
+					Property: property,
+					Value:    list.Get(j).String(),
+					Type:     RelationshipTypes[property],
+				})
 			}
+		} else if v.String() != "" {
+			// Make sure, the value is really set
+			ids = append(ids, Relationship{
+				Property: property,
+				Value:    v.String(),
+				Type:     RelationshipTypes[property],
+			})
 		}
 	}
 
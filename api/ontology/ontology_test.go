@@ -59,9 +59,25 @@ func TestRelated(t *testing.T) {
 				{
 					Property: "parent",
 					Value:    "some-storage-account-id",
+					Type:     RelationshipTypeParent,
 				},
 			},
 		},
+		{
+			name: "repeated field",
+			args: args{
+				r: &VirtualMachine{
+					Id:                  "some-id",
+					BlockStorageIds:     []string{"some-disk-id"},
+					NetworkInterfaceIds: []string{"some-nic-id", "other-nic-id"},
+				},
+			},
+			want: []Relationship{
+				{Property: "block_storage", Value: "some-disk-id", Type: RelationshipTypeStoresDataIn},
+				{Property: "network_interface", Value: "some-nic-id", Type: RelationshipTypeDependsOn},
+				{Property: "network_interface", Value: "other-nic-id", Type: RelationshipTypeDependsOn},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -71,6 +87,10 @@ func TestRelated(t *testing.T) {
 	}
 }
 
+func TestValidateRelationships(t *testing.T) {
+	assert.NoError(t, ValidateRelationships())
+}
+
 func TestResourceMap(t *testing.T) {
 	type args struct {
 		r IsResource
@@ -102,6 +122,7 @@ func TestResourceMap(t *testing.T) {
 					"encryptionInUse":            nil,
 					"geoLocation":                nil,
 					"id":                         "my-id",
+					"instanceMetadataService":    nil,
 					"internetAccessibleEndpoint": false,
 					"labels":                     map[string]any{},
 					"name":                       "My VM",
@@ -110,6 +131,7 @@ func TestResourceMap(t *testing.T) {
 					"osLogging":                  nil,
 					"raw":                        "",
 					"redundancies":               []any{},
+					"remoteAdministration":       nil,
 					"resourceLogging":            nil,
 					"automaticUpdates": map[string]any{
 						"enabled":      false,
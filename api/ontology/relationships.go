@@ -0,0 +1,139 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package ontology
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// ErrUndeclaredRelationship indicates that a reference to another resource is not declared in [RelationshipTypes].
+var ErrUndeclaredRelationship = errors.New("undeclared relationship")
+
+// RelationshipType describes the semantics of a relationship between two resources, i.e., of an edge in the resource
+// graph.
+type RelationshipType string
+
+const (
+	// RelationshipTypeParent is the relationship of a resource to the resource it belongs to, e.g., a storage account
+	// or a resource group.
+	RelationshipTypeParent RelationshipType = "parent"
+
+	// RelationshipTypeDependsOn is the relationship of a resource to a resource it needs to function, e.g., a
+	// virtual machine to its network interface.
+	RelationshipTypeDependsOn RelationshipType = "depends_on"
+
+	// RelationshipTypeStoresDataIn is the relationship of a resource to a resource it stores data in, e.g., a virtual
+	// machine to its block storage.
+	RelationshipTypeStoresDataIn RelationshipType = "stores_data_in"
+
+	// RelationshipTypeAuthenticatesWith is the relationship of a resource to the credentials or the identity it uses to
+	// authenticate.
+	RelationshipTypeAuthenticatesWith RelationshipType = "authenticates_with"
+
+	// RelationshipTypeTransfers is the relationship of a data flow to the data assets it transfers.
+	RelationshipTypeTransfers RelationshipType = "transfers"
+)
+
+// RelationshipTypes declares the relationship type of each property of the ontology that references other resources,
+// i.e., whose field name ends with "_id" or "_ids". Every such property needs to be declared here, which is validated
+// by [ValidateRelationships] when the ontology is generated.
+var RelationshipTypes = map[string]RelationshipType{
+	"parent":            RelationshipTypeParent,
+	"application":       RelationshipTypeDependsOn,
+	"compute":           RelationshipTypeDependsOn,
+	"container":         RelationshipTypeDependsOn,
+	"database_service":  RelationshipTypeDependsOn,
+	"image":             RelationshipTypeDependsOn,
+	"network_interface": RelationshipTypeDependsOn,
+	"network_service":   RelationshipTypeDependsOn,
+	"block_storage":     RelationshipTypeStoresDataIn,
+	"database_storage":  RelationshipTypeStoresDataIn,
+	"logging_service":   RelationshipTypeStoresDataIn,
+	"object_storage":    RelationshipTypeStoresDataIn,
+	"storage":           RelationshipTypeStoresDataIn,
+	"credential":        RelationshipTypeAuthenticatesWith,
+	"identity":          RelationshipTypeAuthenticatesWith,
+	"data_asset":        RelationshipTypeTransfers,
+}
+
+// ValidateRelationships checks that all properties of the ontology referencing other resources are declared in
+// [RelationshipTypes] and that all declarations are used.
+func ValidateRelationships() (err error) {
+	var (
+		errs []error
+		used = make(map[string]bool)
+	)
+
+	var validate func(msgs protoreflect.MessageDescriptors)
+	validate = func(msgs protoreflect.MessageDescriptors) {
+		for i := 0; i < msgs.Len(); i++ {
+			msg := msgs.Get(i)
+			fields := msg.Fields()
+
+			for j := 0; j < fields.Len(); j++ {
+				property, ok := referenceProperty(fields.Get(j))
+				if !ok {
+					continue
+				}
+
+				used[property] = true
+				if _, ok := RelationshipTypes[property]; !ok {
+					errs = append(errs, fmt.Errorf("%w: %s.%s", ErrUndeclaredRelationship, msg.Name(), fields.Get(j).Name()))
+				}
+			}
+
+			validate(msg.Messages())
+		}
+	}
+
+	validate(File_api_ontology_ontology_proto.Messages())
+
+	for property := range RelationshipTypes {
+		if !used[property] {
+			errs = append(errs, fmt.Errorf("relationship %q is declared but not used in the ontology", property))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// referenceProperty returns the property name of a field that references other resources, e.g., "block_storage"
+// for the field "block_storage_ids".
+func referenceProperty(field protoreflect.FieldDescriptor) (property string, ok bool) {
+	if field.Kind() != protoreflect.StringKind {
+		return "", false
+	}
+
+	if field.IsList() {
+		return strings.CutSuffix(string(field.Name()), "_ids")
+	}
+
+	return strings.CutSuffix(string(field.Name()), "_id")
+}
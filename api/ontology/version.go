@@ -0,0 +1,64 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package ontology
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Version is the version of the ontology in the form "<major>.<minor>". It needs to be increased whenever the
+// ontology is changed: the major version for incompatible changes, e.g., removed or renamed properties, and the minor
+// version for compatible ones, e.g., new resource types or properties.
+const Version = "1.6"
+
+// ErrIncompatibleVersion indicates that a resource was described using a version of the ontology that is not
+// compatible with [Version].
+var ErrIncompatibleVersion = errors.New("incompatible ontology version")
+
+// CheckVersion checks whether a resource described using the ontology version v can be processed using [Version],
+// i.e., whether the major versions are the same and v is not newer than [Version]. An empty version is accepted for
+// collectors that do not supply a version yet.
+func CheckVersion(v string) (err error) {
+	var major, minor, ourMajor, ourMinor int
+
+	if v == "" {
+		return nil
+	}
+
+	_, err = fmt.Sscanf(v, "%d.%d", &major, &minor)
+	if err != nil {
+		return fmt.Errorf("%w: invalid version %q", ErrIncompatibleVersion, v)
+	}
+
+	_, _ = fmt.Sscanf(Version, "%d.%d", &ourMajor, &ourMinor)
+
+	if major != ourMajor || minor > ourMinor {
+		return fmt.Errorf("%w: %s is not compatible with %s", ErrIncompatibleVersion, v, Version)
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package ontology
+
+import (
+	"testing"
+
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+)
+
+func TestCheckVersion(t *testing.T) {
+	tests := []struct {
+		name    string
+		v       string
+		wantErr assert.WantErr
+	}{
+		{
+			name:    "empty",
+			v:       "",
+			wantErr: assert.Nil[error],
+		},
+		{
+			name:    "same version",
+			v:       Version,
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "newer minor version",
+			v:    "1.99",
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, ErrIncompatibleVersion)
+			},
+		},
+		{
+			name: "other major version",
+			v:    "2.0",
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, ErrIncompatibleVersion)
+			},
+		},
+		{
+			name: "invalid version",
+			v:    "latest",
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "invalid version")
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.wantErr(t, CheckVersion(tt.v))
+		})
+	}
+}
@@ -27,13 +27,27 @@ package orchestrator
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"slices"
+	"strings"
+	"time"
 
 	"clouditor.io/clouditor/v2/api/assessment"
 
+	"github.com/google/uuid"
 	"google.golang.org/protobuf/proto"
 )
 
+var (
+	ErrInvalidScope             = errors.New("the end of the audit scope must be after its start")
+	ErrNotificationURLMissing   = errors.New("the url of a Slack or Teams notification channel must not be empty")
+	ErrNotificationNoRecipients = errors.New("an email notification channel needs at least one recipient")
+	ErrWaiverExpired            = errors.New("the expiry of a waiver must be in the future")
+	ErrAuditorGrantExpired      = errors.New("the expiry of an auditor grant must be in the future")
+	ErrInvalidStateTransition   = errors.New("invalid certificate state transition")
+)
+
 type CloudServiceHookFunc func(ctx context.Context, cld *CloudService, err error)
 type TargetOfEvaluationHookFunc func(ctx context.Context, event *TargetOfEvaluationChangeEvent, err error)
 
@@ -79,6 +93,78 @@ func (req *UpdateTargetOfEvaluationRequest) GetCloudServiceId() string {
 	return req.GetTargetOfEvaluation().GetCloudServiceId()
 }
 
+// GetCloudServiceId is a shortcut to implement CloudServiceRequest. It returns
+// the cloud service ID of the inner object.
+func (req *CreateWebhookRequest) GetCloudServiceId() string {
+	return req.GetWebhook().GetCloudServiceId()
+}
+
+// GetCloudServiceId is a shortcut to implement CloudServiceRequest. It returns
+// the cloud service ID of the inner object.
+func (req *UpdateWebhookRequest) GetCloudServiceId() string {
+	return req.GetWebhook().GetCloudServiceId()
+}
+
+// GetCloudServiceId is a shortcut to implement CloudServiceRequest. It returns
+// the cloud service ID of the inner object.
+func (req *CreateNotificationChannelRequest) GetCloudServiceId() string {
+	return req.GetChannel().GetCloudServiceId()
+}
+
+// GetCloudServiceId is a shortcut to implement CloudServiceRequest. It returns
+// the cloud service ID of the inner object.
+func (req *UpdateNotificationChannelRequest) GetCloudServiceId() string {
+	return req.GetChannel().GetCloudServiceId()
+}
+
+// GetCloudServiceId is a shortcut to implement CloudServiceRequest. It returns
+// the cloud service ID of the inner object.
+func (req *SendNotificationRequest) GetCloudServiceId() string {
+	return req.GetNotification().GetCloudServiceId()
+}
+
+// GetCloudServiceId is a shortcut to implement CloudServiceRequest. It returns
+// the cloud service ID of the inner object.
+func (req *CreateTicketIntegrationRequest) GetCloudServiceId() string {
+	return req.GetIntegration().GetCloudServiceId()
+}
+
+// GetCloudServiceId is a shortcut to implement CloudServiceRequest. It returns
+// the cloud service ID of the inner object.
+func (req *UpdateTicketIntegrationRequest) GetCloudServiceId() string {
+	return req.GetIntegration().GetCloudServiceId()
+}
+
+// GetCloudServiceId is a shortcut to implement CloudServiceRequest. It returns
+// the cloud service ID of the inner object.
+func (req *CreateWaiverRequest) GetCloudServiceId() string {
+	return req.GetWaiver().GetCloudServiceId()
+}
+
+// GetCloudServiceId is a shortcut to implement CloudServiceRequest. It returns
+// the cloud service ID of the inner object.
+func (req *CreateRoleAssignmentRequest) GetCloudServiceId() string {
+	return req.GetRoleAssignment().GetCloudServiceId()
+}
+
+// GetCloudServiceId is a shortcut to implement CloudServiceRequest. It returns
+// the cloud service ID of the inner object.
+func (req *ReportCertificationObservationRequest) GetCloudServiceId() string {
+	return req.GetObservation().GetCloudServiceId()
+}
+
+// GetCloudServiceId is a shortcut to implement CloudServiceRequest. It returns
+// the cloud service ID of the inner object.
+func (req *RegisterAgentRequest) GetCloudServiceId() string {
+	return req.GetAgent().GetCloudServiceId()
+}
+
+// GetCloudServiceId is a shortcut to implement CloudServiceRequest. It returns
+// the cloud service ID of the inner object.
+func (req *CreateAuditorGrantRequest) GetCloudServiceId() string {
+	return req.GetAuditorGrant().GetCloudServiceId()
+}
+
 func (req *StoreAssessmentResultRequest) GetPayload() proto.Message {
 	return req.Result
 }
@@ -107,6 +193,62 @@ func (req *RemoveCatalogRequest) GetPayload() proto.Message {
 	return &Catalog{Id: req.CatalogId}
 }
 
+func (req *RestoreCatalogRequest) GetPayload() proto.Message {
+	return &Catalog{Id: req.CatalogId}
+}
+
+func (req *AddControlMetricRequest) GetPayload() proto.Message {
+	return &Control{Id: req.ControlId, CategoryName: req.CategoryName, CategoryCatalogId: req.CatalogId}
+}
+
+func (req *RemoveControlMetricRequest) GetPayload() proto.Message {
+	return &Control{Id: req.ControlId, CategoryName: req.CategoryName, CategoryCatalogId: req.CatalogId}
+}
+
+func (req *SetControlAggregationStrategyRequest) GetPayload() proto.Message {
+	return &Control{Id: req.ControlId, CategoryName: req.CategoryName, CategoryCatalogId: req.CatalogId}
+}
+
+func (req *CreateOrganizationRequest) GetPayload() proto.Message {
+	return req.Organization
+}
+
+func (req *UpdateOrganizationRequest) GetPayload() proto.Message {
+	return req.Organization
+}
+
+func (req *RemoveOrganizationRequest) GetPayload() proto.Message {
+	return &Organization{Id: req.OrganizationId}
+}
+
+func (req *CreateRoleAssignmentRequest) GetPayload() proto.Message {
+	return req.RoleAssignment
+}
+
+func (req *RemoveRoleAssignmentRequest) GetPayload() proto.Message {
+	return &RoleAssignment{Id: req.RoleAssignmentId}
+}
+
+func (req *CreateApiKeyRequest) GetPayload() proto.Message {
+	return req.ApiKey
+}
+
+func (req *RotateApiKeyRequest) GetPayload() proto.Message {
+	return &ApiKey{Id: req.ApiKeyId}
+}
+
+func (req *RemoveApiKeyRequest) GetPayload() proto.Message {
+	return &ApiKey{Id: req.ApiKeyId}
+}
+
+func (req *CreateAuditorGrantRequest) GetPayload() proto.Message {
+	return req.AuditorGrant
+}
+
+func (req *RevokeAuditorGrantRequest) GetPayload() proto.Message {
+	return &AuditorGrant{Id: req.AuditorGrantId}
+}
+
 func (req *CreateCertificateRequest) GetPayload() proto.Message {
 	return req.Certificate
 }
@@ -119,6 +261,18 @@ func (req *RemoveCertificateRequest) GetPayload() proto.Message {
 	return &Certificate{Id: req.CertificateId}
 }
 
+func (req *RestoreCertificateRequest) GetPayload() proto.Message {
+	return &Certificate{Id: req.CertificateId}
+}
+
+func (req *UpdateCertificateStateRequest) GetPayload() proto.Message {
+	return &Certificate{Id: req.CertificateId, CurrentState: req.State}
+}
+
+func (req *ReportCertificationObservationRequest) GetPayload() proto.Message {
+	return req.Observation
+}
+
 func (req *RegisterCloudServiceRequest) GetPayload() proto.Message {
 	return req.CloudService
 }
@@ -131,6 +285,10 @@ func (req *RemoveCloudServiceRequest) GetPayload() proto.Message {
 	return &CloudService{Id: req.CloudServiceId}
 }
 
+func (req *RestoreCloudServiceRequest) GetPayload() proto.Message {
+	return &CloudService{Id: req.CloudServiceId}
+}
+
 func (req *CreateMetricRequest) GetPayload() proto.Message {
 	return req.Metric
 }
@@ -143,10 +301,26 @@ func (req *RemoveMetricRequest) GetPayload() proto.Message {
 	return &assessment.Metric{Id: req.MetricId}
 }
 
+func (req *RestoreMetricRequest) GetPayload() proto.Message {
+	return &assessment.Metric{Id: req.MetricId}
+}
+
+func (req *PublishMetricRequest) GetPayload() proto.Message {
+	return &assessment.Metric{Id: req.MetricId}
+}
+
 func (req *UpdateMetricConfigurationRequest) GetPayload() proto.Message {
 	return req.Configuration
 }
 
+func (req *DisableMetricRequest) GetPayload() proto.Message {
+	return &DisabledMetric{CloudServiceId: req.CloudServiceId, MetricId: req.MetricId, Reason: req.Reason}
+}
+
+func (req *EnableMetricRequest) GetPayload() proto.Message {
+	return &DisabledMetric{CloudServiceId: req.CloudServiceId, MetricId: req.MetricId}
+}
+
 func (req *UpdateMetricImplementationRequest) GetPayload() proto.Message {
 	return req.Implementation
 }
@@ -163,6 +337,62 @@ func (req *RemoveTargetOfEvaluationRequest) GetPayload() proto.Message {
 	return &TargetOfEvaluation{CloudServiceId: req.CloudServiceId, CatalogId: req.CatalogId}
 }
 
+func (req *CreateWebhookRequest) GetPayload() proto.Message {
+	return req.Webhook
+}
+
+func (req *UpdateWebhookRequest) GetPayload() proto.Message {
+	return req.Webhook
+}
+
+func (req *RemoveWebhookRequest) GetPayload() proto.Message {
+	return &Webhook{Id: req.WebhookId}
+}
+
+func (req *CreateNotificationChannelRequest) GetPayload() proto.Message {
+	return req.Channel
+}
+
+func (req *UpdateNotificationChannelRequest) GetPayload() proto.Message {
+	return req.Channel
+}
+
+func (req *RemoveNotificationChannelRequest) GetPayload() proto.Message {
+	return &NotificationChannel{Id: req.ChannelId}
+}
+
+func (req *CreateTicketIntegrationRequest) GetPayload() proto.Message {
+	return req.Integration
+}
+
+func (req *UpdateTicketIntegrationRequest) GetPayload() proto.Message {
+	return req.Integration
+}
+
+func (req *RemoveTicketIntegrationRequest) GetPayload() proto.Message {
+	return &TicketIntegration{Id: req.IntegrationId}
+}
+
+func (req *CreateWaiverRequest) GetPayload() proto.Message {
+	return req.Waiver
+}
+
+func (req *RemoveWaiverRequest) GetPayload() proto.Message {
+	return &Waiver{Id: req.WaiverId}
+}
+
+func (req *RegisterAgentRequest) GetPayload() proto.Message {
+	return req.Agent
+}
+
+func (req *UpdateAgentConfigurationRequest) GetPayload() proto.Message {
+	return &Agent{Id: req.AgentId, Configuration: req.Configuration}
+}
+
+func (req *DeregisterAgentRequest) GetPayload() proto.Message {
+	return &Agent{Id: req.AgentId}
+}
+
 // IsRelevantFor checks, whether this control is relevant for the given target of evaluation. For now this mainly
 // checks, whether the assurance level matches, if the ToE has one. In the future, this could also include checks, if
 // the control is somehow out of scope.
@@ -184,3 +414,238 @@ func (c *Control) IsRelevantFor(toe *TargetOfEvaluation, catalog *Catalog) bool
 
 	return idxControl <= idxToe
 }
+
+// EffectiveAggregationStrategy returns the strategy that is used to combine the results of the sub-controls of this
+// control. If the control does not specify a strategy, the strategy of the catalog is used. If neither does, all
+// sub-controls must be compliant.
+func (c *Control) EffectiveAggregationStrategy(catalog *Catalog) *AggregationStrategy {
+	if c.AggregationStrategy != nil {
+		return c.AggregationStrategy
+	}
+
+	if catalog.GetAggregationStrategy() != nil {
+		return catalog.AggregationStrategy
+	}
+
+	return &AggregationStrategy{Type: AggregationStrategyType_AGGREGATION_STRATEGY_TYPE_ALL_MUST_PASS}
+}
+
+// Weight returns the weight of this control for the WEIGHTED_BY_SEVERITY aggregation strategy. Controls without a
+// severity have the same weight as controls with a low severity.
+func (c *Control) Weight() float64 {
+	return float64(max(c.GetSeverity(), ControlSeverity_CONTROL_SEVERITY_LOW))
+}
+
+// ValidateScope checks, whether the time frame of the audit scope of this target of evaluation is valid, i.e., whether
+// its end is after its start.
+func (toe *TargetOfEvaluation) ValidateScope() error {
+	if toe.ScopeStart != nil && toe.ScopeEnd != nil && !toe.ScopeEnd.AsTime().After(toe.ScopeStart.AsTime()) {
+		return ErrInvalidScope
+	}
+
+	return nil
+}
+
+// InScope checks, whether the given assessment result is within the audit scope of this target of evaluation. This is
+// the case if one of the resource types of the result is in scope (or no resource types are specified) and the result
+// was created within the time frame of the scope.
+func (toe *TargetOfEvaluation) InScope(result *assessment.AssessmentResult) bool {
+	if len(toe.ResourceTypes) > 0 && !slices.ContainsFunc(result.ResourceTypes, func(typ string) bool {
+		return slices.Contains(toe.ResourceTypes, typ)
+	}) {
+		return false
+	}
+
+	if toe.ScopeStart != nil && result.Timestamp.AsTime().Before(toe.ScopeStart.AsTime()) {
+		return false
+	}
+
+	if toe.ScopeEnd != nil && result.Timestamp.AsTime().After(toe.ScopeEnd.AsTime()) {
+		return false
+	}
+
+	return true
+}
+
+// IsSubscribedTo checks, whether this webhook should be notified about events of the given type. This is the case if
+// the webhook is enabled and either has no specific events configured or explicitly lists the event type.
+func (w *Webhook) IsSubscribedTo(typ WebhookEventType) bool {
+	if !w.Enabled {
+		return false
+	}
+
+	return len(w.Events) == 0 || slices.Contains(w.Events, typ)
+}
+
+// ValidateTarget checks, whether this notification channel has the target that is needed for its type, i.e., a URL
+// for Slack and Teams channels and at least one recipient for email channels.
+func (c *NotificationChannel) ValidateTarget() error {
+	switch c.Type {
+	case NotificationChannelType_NOTIFICATION_CHANNEL_TYPE_SLACK, NotificationChannelType_NOTIFICATION_CHANNEL_TYPE_TEAMS:
+		if c.Url == "" {
+			return ErrNotificationURLMissing
+		}
+	case NotificationChannelType_NOTIFICATION_CHANNEL_TYPE_EMAIL:
+		if len(c.Recipients) == 0 {
+			return ErrNotificationNoRecipients
+		}
+	}
+
+	return nil
+}
+
+// Accepts checks, whether a notification with the given severity should be sent to this channel. This is the case if
+// the channel is enabled and the severity is at least its minimum severity.
+func (c *NotificationChannel) Accepts(severity NotificationSeverity) bool {
+	return c.Enabled && severity >= c.MinSeverity
+}
+
+// ProjectFor returns the Jira project key or ServiceNow assignment group in which tickets for the given metric are
+// opened. This is the mapped project of the metric, if there is one, and otherwise the default project.
+func (i *TicketIntegration) ProjectFor(metricID string) string {
+	if project, ok := i.MetricProjects[metricID]; ok && project != "" {
+		return project
+	}
+
+	return i.Project
+}
+
+// ConsecutiveResults returns the number of consecutive non-compliant assessment results that are needed before a
+// ticket is opened, which is at least 1.
+func (i *TicketIntegration) ConsecutiveResults() int {
+	return max(1, int(i.MinConsecutiveResults))
+}
+
+// IsActive checks, whether this waiver is still valid at the given time.
+func (w *Waiver) IsActive(t time.Time) bool {
+	return w.ExpiresAt.AsTime().After(t)
+}
+
+// Covers checks, whether this waiver accepts the risk of the given assessment result at the given time, i.e., whether
+// the waiver is active and matches the cloud service, resource and metric of the result.
+func (w *Waiver) Covers(result *assessment.AssessmentResult, t time.Time) bool {
+	return w.IsActive(t) &&
+		w.CloudServiceId == result.CloudServiceId &&
+		w.ResourceId == result.ResourceId &&
+		w.MetricId == result.MetricId
+}
+
+// IsActive checks, whether this auditor grant is neither expired nor revoked at the given time.
+func (g *AuditorGrant) IsActive(t time.Time) bool {
+	return g.ExpiresAt.AsTime().After(t) && (g.RevokedAt == nil || g.RevokedAt.AsTime().After(t))
+}
+
+// DefaultAgentHeartbeatInterval is the interval in which agents send heartbeats, if their configuration does not
+// specify one.
+const DefaultAgentHeartbeatInterval = 30 * time.Second
+
+// agentStaleHeartbeats is the number of missed heartbeats after which an agent is considered dead rather than stale.
+const agentStaleHeartbeats = 10
+
+// HeartbeatInterval returns the interval in which the agent is expected to send heartbeats.
+func (a *Agent) HeartbeatInterval() time.Duration {
+	if a.GetConfiguration().GetHeartbeatInterval() > 0 {
+		return time.Duration(a.GetConfiguration().GetHeartbeatInterval()) * time.Second
+	}
+
+	return DefaultAgentHeartbeatInterval
+}
+
+// HealthAt derives the health of the agent at the given time from its last heartbeat. An agent is alive, if it sent a
+// heartbeat within twice its heartbeat interval, so that a single late heartbeat is tolerated. It is stale, if it
+// missed only a few heartbeats, and dead otherwise or if it never sent a heartbeat.
+func (a *Agent) HealthAt(t time.Time) AgentHealth {
+	if a.LastHeartbeatAt == nil {
+		return AgentHealth_AGENT_HEALTH_DEAD
+	}
+
+	silence := t.Sub(a.LastHeartbeatAt.AsTime())
+	switch {
+	case silence <= 2*a.HeartbeatInterval():
+		return AgentHealth_AGENT_HEALTH_ALIVE
+	case silence <= agentStaleHeartbeats*a.HeartbeatInterval():
+		return AgentHealth_AGENT_HEALTH_STALE
+	default:
+		return AgentHealth_AGENT_HEALTH_DEAD
+	}
+}
+
+// certificateTransitions contains the allowed lifecycle transitions of a certificate. Certificates without a state,
+// e.g., created before the lifecycle was introduced, are treated as issued. Expired certificates can only be re-issued,
+// e.g., after they have been renewed. Withdrawn certificates are final.
+var certificateTransitions = map[CertificateState][]CertificateState{
+	CertificateState_CERTIFICATE_STATE_UNSPECIFIED: {CertificateState_CERTIFICATE_STATE_SUSPENDED, CertificateState_CERTIFICATE_STATE_EXPIRED, CertificateState_CERTIFICATE_STATE_WITHDRAWN},
+	CertificateState_CERTIFICATE_STATE_ISSUED:      {CertificateState_CERTIFICATE_STATE_SUSPENDED, CertificateState_CERTIFICATE_STATE_EXPIRED, CertificateState_CERTIFICATE_STATE_WITHDRAWN},
+	CertificateState_CERTIFICATE_STATE_SUSPENDED:   {CertificateState_CERTIFICATE_STATE_ISSUED, CertificateState_CERTIFICATE_STATE_EXPIRED, CertificateState_CERTIFICATE_STATE_WITHDRAWN},
+	CertificateState_CERTIFICATE_STATE_EXPIRED:     {CertificateState_CERTIFICATE_STATE_ISSUED},
+}
+
+// TransitionTo transitions the certificate into the given lifecycle state and records the transition in the state
+// history. An error is returned if the transition is not allowed.
+func (c *Certificate) TransitionTo(state CertificateState, reason *string, automatic bool, t time.Time) error {
+	if !slices.Contains(certificateTransitions[c.CurrentState], state) {
+		return fmt.Errorf("%w: from %s to %s", ErrInvalidStateTransition, c.CurrentState, state)
+	}
+
+	c.CurrentState = state
+	c.States = append(c.States, &State{
+		Id:            uuid.NewString(),
+		State:         state.Name(),
+		Timestamp:     t.UTC().Format(time.RFC3339Nano),
+		CertificateId: c.Id,
+		Reason:        reason,
+		Automatic:     automatic,
+	})
+
+	return nil
+}
+
+// SuspendedAutomatically checks, whether the certificate is suspended and the (latest) suspension was triggered
+// automatically, e.g., by the continuous evaluation. Only such certificates are re-issued automatically.
+func (c *Certificate) SuspendedAutomatically() bool {
+	var (
+		latest  *State
+		latestT time.Time
+	)
+
+	if c.CurrentState != CertificateState_CERTIFICATE_STATE_SUSPENDED {
+		return false
+	}
+
+	// The state history is not necessarily ordered, so we need to look for the latest suspension
+	for _, state := range c.States {
+		if state.State != CertificateState_CERTIFICATE_STATE_SUSPENDED.Name() {
+			continue
+		}
+
+		t, err := time.Parse(time.RFC3339Nano, state.Timestamp)
+		if err != nil {
+			continue
+		}
+
+		if latest == nil || !t.Before(latestT) {
+			latest, latestT = state, t
+		}
+	}
+
+	return latest != nil && latest.Automatic
+}
+
+// Name returns the name of the state as it is recorded in the state history of a certificate, e.g., `suspended`.
+func (s CertificateState) Name() string {
+	return strings.ToLower(strings.TrimPrefix(s.String(), "CERTIFICATE_STATE_"))
+}
+
+// AssessedResource records that a resource of a cloud service was assessed at least once, so that the number of
+// assessed resources in the [AssessmentStatistics] can be updated incrementally. It also tracks when the resource was
+// last seen, so that resources, which no longer appear in the discovery, can be detected.
+type AssessedResource struct {
+	CloudServiceId string `gorm:"primaryKey"`
+	ResourceId     string `gorm:"primaryKey"`
+
+	// LastSeen is the timestamp of the latest assessment result of the resource
+	LastSeen time.Time `gorm:"index"`
+
+	// DecommissionedAt is set once the resource was not seen for a while. It is reset if the resource is seen again.
+	DecommissionedAt *time.Time `gorm:"index"`
+}
@@ -47,52 +47,124 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Orchestrator_RegisterAssessmentTool_FullMethodName      = "/clouditor.orchestrator.v1.Orchestrator/RegisterAssessmentTool"
-	Orchestrator_ListAssessmentTools_FullMethodName         = "/clouditor.orchestrator.v1.Orchestrator/ListAssessmentTools"
-	Orchestrator_GetAssessmentTool_FullMethodName           = "/clouditor.orchestrator.v1.Orchestrator/GetAssessmentTool"
-	Orchestrator_UpdateAssessmentTool_FullMethodName        = "/clouditor.orchestrator.v1.Orchestrator/UpdateAssessmentTool"
-	Orchestrator_DeregisterAssessmentTool_FullMethodName    = "/clouditor.orchestrator.v1.Orchestrator/DeregisterAssessmentTool"
-	Orchestrator_StoreAssessmentResult_FullMethodName       = "/clouditor.orchestrator.v1.Orchestrator/StoreAssessmentResult"
-	Orchestrator_StoreAssessmentResults_FullMethodName      = "/clouditor.orchestrator.v1.Orchestrator/StoreAssessmentResults"
-	Orchestrator_GetAssessmentResult_FullMethodName         = "/clouditor.orchestrator.v1.Orchestrator/GetAssessmentResult"
-	Orchestrator_ListAssessmentResults_FullMethodName       = "/clouditor.orchestrator.v1.Orchestrator/ListAssessmentResults"
-	Orchestrator_CreateMetric_FullMethodName                = "/clouditor.orchestrator.v1.Orchestrator/CreateMetric"
-	Orchestrator_UpdateMetric_FullMethodName                = "/clouditor.orchestrator.v1.Orchestrator/UpdateMetric"
-	Orchestrator_GetMetric_FullMethodName                   = "/clouditor.orchestrator.v1.Orchestrator/GetMetric"
-	Orchestrator_ListMetrics_FullMethodName                 = "/clouditor.orchestrator.v1.Orchestrator/ListMetrics"
-	Orchestrator_RemoveMetric_FullMethodName                = "/clouditor.orchestrator.v1.Orchestrator/RemoveMetric"
-	Orchestrator_RegisterCloudService_FullMethodName        = "/clouditor.orchestrator.v1.Orchestrator/RegisterCloudService"
-	Orchestrator_UpdateCloudService_FullMethodName          = "/clouditor.orchestrator.v1.Orchestrator/UpdateCloudService"
-	Orchestrator_GetCloudService_FullMethodName             = "/clouditor.orchestrator.v1.Orchestrator/GetCloudService"
-	Orchestrator_ListCloudServices_FullMethodName           = "/clouditor.orchestrator.v1.Orchestrator/ListCloudServices"
-	Orchestrator_RemoveCloudService_FullMethodName          = "/clouditor.orchestrator.v1.Orchestrator/RemoveCloudService"
-	Orchestrator_GetCloudServiceStatistics_FullMethodName   = "/clouditor.orchestrator.v1.Orchestrator/GetCloudServiceStatistics"
-	Orchestrator_UpdateMetricConfiguration_FullMethodName   = "/clouditor.orchestrator.v1.Orchestrator/UpdateMetricConfiguration"
-	Orchestrator_GetMetricConfiguration_FullMethodName      = "/clouditor.orchestrator.v1.Orchestrator/GetMetricConfiguration"
-	Orchestrator_ListMetricConfigurations_FullMethodName    = "/clouditor.orchestrator.v1.Orchestrator/ListMetricConfigurations"
-	Orchestrator_UpdateMetricImplementation_FullMethodName  = "/clouditor.orchestrator.v1.Orchestrator/UpdateMetricImplementation"
-	Orchestrator_GetMetricImplementation_FullMethodName     = "/clouditor.orchestrator.v1.Orchestrator/GetMetricImplementation"
-	Orchestrator_SubscribeMetricChangeEvents_FullMethodName = "/clouditor.orchestrator.v1.Orchestrator/SubscribeMetricChangeEvents"
-	Orchestrator_CreateCertificate_FullMethodName           = "/clouditor.orchestrator.v1.Orchestrator/CreateCertificate"
-	Orchestrator_GetCertificate_FullMethodName              = "/clouditor.orchestrator.v1.Orchestrator/GetCertificate"
-	Orchestrator_ListCertificates_FullMethodName            = "/clouditor.orchestrator.v1.Orchestrator/ListCertificates"
-	Orchestrator_ListPublicCertificates_FullMethodName      = "/clouditor.orchestrator.v1.Orchestrator/ListPublicCertificates"
-	Orchestrator_UpdateCertificate_FullMethodName           = "/clouditor.orchestrator.v1.Orchestrator/UpdateCertificate"
-	Orchestrator_RemoveCertificate_FullMethodName           = "/clouditor.orchestrator.v1.Orchestrator/RemoveCertificate"
-	Orchestrator_CreateCatalog_FullMethodName               = "/clouditor.orchestrator.v1.Orchestrator/CreateCatalog"
-	Orchestrator_ListCatalogs_FullMethodName                = "/clouditor.orchestrator.v1.Orchestrator/ListCatalogs"
-	Orchestrator_GetCatalog_FullMethodName                  = "/clouditor.orchestrator.v1.Orchestrator/GetCatalog"
-	Orchestrator_RemoveCatalog_FullMethodName               = "/clouditor.orchestrator.v1.Orchestrator/RemoveCatalog"
-	Orchestrator_UpdateCatalog_FullMethodName               = "/clouditor.orchestrator.v1.Orchestrator/UpdateCatalog"
-	Orchestrator_GetCategory_FullMethodName                 = "/clouditor.orchestrator.v1.Orchestrator/GetCategory"
-	Orchestrator_ListControls_FullMethodName                = "/clouditor.orchestrator.v1.Orchestrator/ListControls"
-	Orchestrator_GetControl_FullMethodName                  = "/clouditor.orchestrator.v1.Orchestrator/GetControl"
-	Orchestrator_CreateTargetOfEvaluation_FullMethodName    = "/clouditor.orchestrator.v1.Orchestrator/CreateTargetOfEvaluation"
-	Orchestrator_GetTargetOfEvaluation_FullMethodName       = "/clouditor.orchestrator.v1.Orchestrator/GetTargetOfEvaluation"
-	Orchestrator_ListTargetsOfEvaluation_FullMethodName     = "/clouditor.orchestrator.v1.Orchestrator/ListTargetsOfEvaluation"
-	Orchestrator_UpdateTargetOfEvaluation_FullMethodName    = "/clouditor.orchestrator.v1.Orchestrator/UpdateTargetOfEvaluation"
-	Orchestrator_RemoveTargetOfEvaluation_FullMethodName    = "/clouditor.orchestrator.v1.Orchestrator/RemoveTargetOfEvaluation"
-	Orchestrator_GetRuntimeInfo_FullMethodName              = "/clouditor.orchestrator.v1.Orchestrator/GetRuntimeInfo"
+	Orchestrator_RegisterAssessmentTool_FullMethodName              = "/clouditor.orchestrator.v1.Orchestrator/RegisterAssessmentTool"
+	Orchestrator_ListAssessmentTools_FullMethodName                 = "/clouditor.orchestrator.v1.Orchestrator/ListAssessmentTools"
+	Orchestrator_GetAssessmentTool_FullMethodName                   = "/clouditor.orchestrator.v1.Orchestrator/GetAssessmentTool"
+	Orchestrator_UpdateAssessmentTool_FullMethodName                = "/clouditor.orchestrator.v1.Orchestrator/UpdateAssessmentTool"
+	Orchestrator_DeregisterAssessmentTool_FullMethodName            = "/clouditor.orchestrator.v1.Orchestrator/DeregisterAssessmentTool"
+	Orchestrator_StoreAssessmentResult_FullMethodName               = "/clouditor.orchestrator.v1.Orchestrator/StoreAssessmentResult"
+	Orchestrator_StoreAssessmentResults_FullMethodName              = "/clouditor.orchestrator.v1.Orchestrator/StoreAssessmentResults"
+	Orchestrator_GetAssessmentResult_FullMethodName                 = "/clouditor.orchestrator.v1.Orchestrator/GetAssessmentResult"
+	Orchestrator_GetAssessmentResultEvidence_FullMethodName         = "/clouditor.orchestrator.v1.Orchestrator/GetAssessmentResultEvidence"
+	Orchestrator_ListAssessmentResults_FullMethodName               = "/clouditor.orchestrator.v1.Orchestrator/ListAssessmentResults"
+	Orchestrator_GetResourceAssessmentSummary_FullMethodName        = "/clouditor.orchestrator.v1.Orchestrator/GetResourceAssessmentSummary"
+	Orchestrator_ListResourceTypeAssessmentSummaries_FullMethodName = "/clouditor.orchestrator.v1.Orchestrator/ListResourceTypeAssessmentSummaries"
+	Orchestrator_GetAssessmentStatistics_FullMethodName             = "/clouditor.orchestrator.v1.Orchestrator/GetAssessmentStatistics"
+	Orchestrator_SubscribeAssessmentResults_FullMethodName          = "/clouditor.orchestrator.v1.Orchestrator/SubscribeAssessmentResults"
+	Orchestrator_ExportOscalAssessmentResults_FullMethodName        = "/clouditor.orchestrator.v1.Orchestrator/ExportOscalAssessmentResults"
+	Orchestrator_CreateMetric_FullMethodName                        = "/clouditor.orchestrator.v1.Orchestrator/CreateMetric"
+	Orchestrator_UpdateMetric_FullMethodName                        = "/clouditor.orchestrator.v1.Orchestrator/UpdateMetric"
+	Orchestrator_GetMetric_FullMethodName                           = "/clouditor.orchestrator.v1.Orchestrator/GetMetric"
+	Orchestrator_ListMetrics_FullMethodName                         = "/clouditor.orchestrator.v1.Orchestrator/ListMetrics"
+	Orchestrator_RemoveMetric_FullMethodName                        = "/clouditor.orchestrator.v1.Orchestrator/RemoveMetric"
+	Orchestrator_RestoreMetric_FullMethodName                       = "/clouditor.orchestrator.v1.Orchestrator/RestoreMetric"
+	Orchestrator_PublishMetric_FullMethodName                       = "/clouditor.orchestrator.v1.Orchestrator/PublishMetric"
+	Orchestrator_RegisterCloudService_FullMethodName                = "/clouditor.orchestrator.v1.Orchestrator/RegisterCloudService"
+	Orchestrator_UpdateCloudService_FullMethodName                  = "/clouditor.orchestrator.v1.Orchestrator/UpdateCloudService"
+	Orchestrator_GetCloudService_FullMethodName                     = "/clouditor.orchestrator.v1.Orchestrator/GetCloudService"
+	Orchestrator_ListCloudServices_FullMethodName                   = "/clouditor.orchestrator.v1.Orchestrator/ListCloudServices"
+	Orchestrator_RemoveCloudService_FullMethodName                  = "/clouditor.orchestrator.v1.Orchestrator/RemoveCloudService"
+	Orchestrator_RestoreCloudService_FullMethodName                 = "/clouditor.orchestrator.v1.Orchestrator/RestoreCloudService"
+	Orchestrator_GetCloudServiceStatistics_FullMethodName           = "/clouditor.orchestrator.v1.Orchestrator/GetCloudServiceStatistics"
+	Orchestrator_UpdateMetricConfiguration_FullMethodName           = "/clouditor.orchestrator.v1.Orchestrator/UpdateMetricConfiguration"
+	Orchestrator_GetMetricConfiguration_FullMethodName              = "/clouditor.orchestrator.v1.Orchestrator/GetMetricConfiguration"
+	Orchestrator_ListMetricConfigurations_FullMethodName            = "/clouditor.orchestrator.v1.Orchestrator/ListMetricConfigurations"
+	Orchestrator_UpdateMetricImplementation_FullMethodName          = "/clouditor.orchestrator.v1.Orchestrator/UpdateMetricImplementation"
+	Orchestrator_TestMetricImplementation_FullMethodName            = "/clouditor.orchestrator.v1.Orchestrator/TestMetricImplementation"
+	Orchestrator_GetMetricImplementation_FullMethodName             = "/clouditor.orchestrator.v1.Orchestrator/GetMetricImplementation"
+	Orchestrator_ListMetricImplementationRevisions_FullMethodName   = "/clouditor.orchestrator.v1.Orchestrator/ListMetricImplementationRevisions"
+	Orchestrator_ListMetricConfigurationRevisions_FullMethodName    = "/clouditor.orchestrator.v1.Orchestrator/ListMetricConfigurationRevisions"
+	Orchestrator_DisableMetric_FullMethodName                       = "/clouditor.orchestrator.v1.Orchestrator/DisableMetric"
+	Orchestrator_EnableMetric_FullMethodName                        = "/clouditor.orchestrator.v1.Orchestrator/EnableMetric"
+	Orchestrator_ListDisabledMetrics_FullMethodName                 = "/clouditor.orchestrator.v1.Orchestrator/ListDisabledMetrics"
+	Orchestrator_SubscribeMetricChangeEvents_FullMethodName         = "/clouditor.orchestrator.v1.Orchestrator/SubscribeMetricChangeEvents"
+	Orchestrator_CreateCertificate_FullMethodName                   = "/clouditor.orchestrator.v1.Orchestrator/CreateCertificate"
+	Orchestrator_GetCertificate_FullMethodName                      = "/clouditor.orchestrator.v1.Orchestrator/GetCertificate"
+	Orchestrator_ListCertificates_FullMethodName                    = "/clouditor.orchestrator.v1.Orchestrator/ListCertificates"
+	Orchestrator_ListPublicCertificates_FullMethodName              = "/clouditor.orchestrator.v1.Orchestrator/ListPublicCertificates"
+	Orchestrator_UpdateCertificate_FullMethodName                   = "/clouditor.orchestrator.v1.Orchestrator/UpdateCertificate"
+	Orchestrator_UpdateCertificateState_FullMethodName              = "/clouditor.orchestrator.v1.Orchestrator/UpdateCertificateState"
+	Orchestrator_ReportCertificationObservation_FullMethodName      = "/clouditor.orchestrator.v1.Orchestrator/ReportCertificationObservation"
+	Orchestrator_RemoveCertificate_FullMethodName                   = "/clouditor.orchestrator.v1.Orchestrator/RemoveCertificate"
+	Orchestrator_RestoreCertificate_FullMethodName                  = "/clouditor.orchestrator.v1.Orchestrator/RestoreCertificate"
+	Orchestrator_CreateCatalog_FullMethodName                       = "/clouditor.orchestrator.v1.Orchestrator/CreateCatalog"
+	Orchestrator_ListCatalogs_FullMethodName                        = "/clouditor.orchestrator.v1.Orchestrator/ListCatalogs"
+	Orchestrator_GetCatalog_FullMethodName                          = "/clouditor.orchestrator.v1.Orchestrator/GetCatalog"
+	Orchestrator_RemoveCatalog_FullMethodName                       = "/clouditor.orchestrator.v1.Orchestrator/RemoveCatalog"
+	Orchestrator_RestoreCatalog_FullMethodName                      = "/clouditor.orchestrator.v1.Orchestrator/RestoreCatalog"
+	Orchestrator_UpdateCatalog_FullMethodName                       = "/clouditor.orchestrator.v1.Orchestrator/UpdateCatalog"
+	Orchestrator_ListBuiltinCatalogs_FullMethodName                 = "/clouditor.orchestrator.v1.Orchestrator/ListBuiltinCatalogs"
+	Orchestrator_LoadBuiltinCatalog_FullMethodName                  = "/clouditor.orchestrator.v1.Orchestrator/LoadBuiltinCatalog"
+	Orchestrator_ImportOscalCatalog_FullMethodName                  = "/clouditor.orchestrator.v1.Orchestrator/ImportOscalCatalog"
+	Orchestrator_GetCategory_FullMethodName                         = "/clouditor.orchestrator.v1.Orchestrator/GetCategory"
+	Orchestrator_ListControls_FullMethodName                        = "/clouditor.orchestrator.v1.Orchestrator/ListControls"
+	Orchestrator_GetControl_FullMethodName                          = "/clouditor.orchestrator.v1.Orchestrator/GetControl"
+	Orchestrator_AddControlMetric_FullMethodName                    = "/clouditor.orchestrator.v1.Orchestrator/AddControlMetric"
+	Orchestrator_RemoveControlMetric_FullMethodName                 = "/clouditor.orchestrator.v1.Orchestrator/RemoveControlMetric"
+	Orchestrator_GetCatalogCoverage_FullMethodName                  = "/clouditor.orchestrator.v1.Orchestrator/GetCatalogCoverage"
+	Orchestrator_SetControlAggregationStrategy_FullMethodName       = "/clouditor.orchestrator.v1.Orchestrator/SetControlAggregationStrategy"
+	Orchestrator_CreateTargetOfEvaluation_FullMethodName            = "/clouditor.orchestrator.v1.Orchestrator/CreateTargetOfEvaluation"
+	Orchestrator_GetTargetOfEvaluation_FullMethodName               = "/clouditor.orchestrator.v1.Orchestrator/GetTargetOfEvaluation"
+	Orchestrator_ListTargetsOfEvaluation_FullMethodName             = "/clouditor.orchestrator.v1.Orchestrator/ListTargetsOfEvaluation"
+	Orchestrator_UpdateTargetOfEvaluation_FullMethodName            = "/clouditor.orchestrator.v1.Orchestrator/UpdateTargetOfEvaluation"
+	Orchestrator_RemoveTargetOfEvaluation_FullMethodName            = "/clouditor.orchestrator.v1.Orchestrator/RemoveTargetOfEvaluation"
+	Orchestrator_CreateWebhook_FullMethodName                       = "/clouditor.orchestrator.v1.Orchestrator/CreateWebhook"
+	Orchestrator_GetWebhook_FullMethodName                          = "/clouditor.orchestrator.v1.Orchestrator/GetWebhook"
+	Orchestrator_ListWebhooks_FullMethodName                        = "/clouditor.orchestrator.v1.Orchestrator/ListWebhooks"
+	Orchestrator_UpdateWebhook_FullMethodName                       = "/clouditor.orchestrator.v1.Orchestrator/UpdateWebhook"
+	Orchestrator_RemoveWebhook_FullMethodName                       = "/clouditor.orchestrator.v1.Orchestrator/RemoveWebhook"
+	Orchestrator_CreateNotificationChannel_FullMethodName           = "/clouditor.orchestrator.v1.Orchestrator/CreateNotificationChannel"
+	Orchestrator_GetNotificationChannel_FullMethodName              = "/clouditor.orchestrator.v1.Orchestrator/GetNotificationChannel"
+	Orchestrator_ListNotificationChannels_FullMethodName            = "/clouditor.orchestrator.v1.Orchestrator/ListNotificationChannels"
+	Orchestrator_UpdateNotificationChannel_FullMethodName           = "/clouditor.orchestrator.v1.Orchestrator/UpdateNotificationChannel"
+	Orchestrator_RemoveNotificationChannel_FullMethodName           = "/clouditor.orchestrator.v1.Orchestrator/RemoveNotificationChannel"
+	Orchestrator_SendNotification_FullMethodName                    = "/clouditor.orchestrator.v1.Orchestrator/SendNotification"
+	Orchestrator_CreateTicketIntegration_FullMethodName             = "/clouditor.orchestrator.v1.Orchestrator/CreateTicketIntegration"
+	Orchestrator_GetTicketIntegration_FullMethodName                = "/clouditor.orchestrator.v1.Orchestrator/GetTicketIntegration"
+	Orchestrator_ListTicketIntegrations_FullMethodName              = "/clouditor.orchestrator.v1.Orchestrator/ListTicketIntegrations"
+	Orchestrator_UpdateTicketIntegration_FullMethodName             = "/clouditor.orchestrator.v1.Orchestrator/UpdateTicketIntegration"
+	Orchestrator_RemoveTicketIntegration_FullMethodName             = "/clouditor.orchestrator.v1.Orchestrator/RemoveTicketIntegration"
+	Orchestrator_ListTickets_FullMethodName                         = "/clouditor.orchestrator.v1.Orchestrator/ListTickets"
+	Orchestrator_CreateWaiver_FullMethodName                        = "/clouditor.orchestrator.v1.Orchestrator/CreateWaiver"
+	Orchestrator_GetWaiver_FullMethodName                           = "/clouditor.orchestrator.v1.Orchestrator/GetWaiver"
+	Orchestrator_ListWaivers_FullMethodName                         = "/clouditor.orchestrator.v1.Orchestrator/ListWaivers"
+	Orchestrator_RemoveWaiver_FullMethodName                        = "/clouditor.orchestrator.v1.Orchestrator/RemoveWaiver"
+	Orchestrator_CreateOrganization_FullMethodName                  = "/clouditor.orchestrator.v1.Orchestrator/CreateOrganization"
+	Orchestrator_GetOrganization_FullMethodName                     = "/clouditor.orchestrator.v1.Orchestrator/GetOrganization"
+	Orchestrator_ListOrganizations_FullMethodName                   = "/clouditor.orchestrator.v1.Orchestrator/ListOrganizations"
+	Orchestrator_UpdateOrganization_FullMethodName                  = "/clouditor.orchestrator.v1.Orchestrator/UpdateOrganization"
+	Orchestrator_RemoveOrganization_FullMethodName                  = "/clouditor.orchestrator.v1.Orchestrator/RemoveOrganization"
+	Orchestrator_CreateRoleAssignment_FullMethodName                = "/clouditor.orchestrator.v1.Orchestrator/CreateRoleAssignment"
+	Orchestrator_GetRoleAssignment_FullMethodName                   = "/clouditor.orchestrator.v1.Orchestrator/GetRoleAssignment"
+	Orchestrator_ListRoleAssignments_FullMethodName                 = "/clouditor.orchestrator.v1.Orchestrator/ListRoleAssignments"
+	Orchestrator_RemoveRoleAssignment_FullMethodName                = "/clouditor.orchestrator.v1.Orchestrator/RemoveRoleAssignment"
+	Orchestrator_CreateApiKey_FullMethodName                        = "/clouditor.orchestrator.v1.Orchestrator/CreateApiKey"
+	Orchestrator_GetApiKey_FullMethodName                           = "/clouditor.orchestrator.v1.Orchestrator/GetApiKey"
+	Orchestrator_ListApiKeys_FullMethodName                         = "/clouditor.orchestrator.v1.Orchestrator/ListApiKeys"
+	Orchestrator_RotateApiKey_FullMethodName                        = "/clouditor.orchestrator.v1.Orchestrator/RotateApiKey"
+	Orchestrator_RemoveApiKey_FullMethodName                        = "/clouditor.orchestrator.v1.Orchestrator/RemoveApiKey"
+	Orchestrator_CreateAuditorGrant_FullMethodName                  = "/clouditor.orchestrator.v1.Orchestrator/CreateAuditorGrant"
+	Orchestrator_GetAuditorGrant_FullMethodName                     = "/clouditor.orchestrator.v1.Orchestrator/GetAuditorGrant"
+	Orchestrator_ListAuditorGrants_FullMethodName                   = "/clouditor.orchestrator.v1.Orchestrator/ListAuditorGrants"
+	Orchestrator_RevokeAuditorGrant_FullMethodName                  = "/clouditor.orchestrator.v1.Orchestrator/RevokeAuditorGrant"
+	Orchestrator_ListAuditLogEntries_FullMethodName                 = "/clouditor.orchestrator.v1.Orchestrator/ListAuditLogEntries"
+	Orchestrator_ExportAuditLog_FullMethodName                      = "/clouditor.orchestrator.v1.Orchestrator/ExportAuditLog"
+	Orchestrator_RegisterAgent_FullMethodName                       = "/clouditor.orchestrator.v1.Orchestrator/RegisterAgent"
+	Orchestrator_SendAgentHeartbeat_FullMethodName                  = "/clouditor.orchestrator.v1.Orchestrator/SendAgentHeartbeat"
+	Orchestrator_ListAgents_FullMethodName                          = "/clouditor.orchestrator.v1.Orchestrator/ListAgents"
+	Orchestrator_UpdateAgentConfiguration_FullMethodName            = "/clouditor.orchestrator.v1.Orchestrator/UpdateAgentConfiguration"
+	Orchestrator_DeregisterAgent_FullMethodName                     = "/clouditor.orchestrator.v1.Orchestrator/DeregisterAgent"
+	Orchestrator_GetRuntimeInfo_FullMethodName                      = "/clouditor.orchestrator.v1.Orchestrator/GetRuntimeInfo"
 )
 
 // OrchestratorClient is the client API for Orchestrator service.
@@ -118,8 +190,34 @@ type OrchestratorClient interface {
 	StoreAssessmentResults(ctx context.Context, opts ...grpc.CallOption) (Orchestrator_StoreAssessmentResultsClient, error)
 	// Get an assessment result by ID
 	GetAssessmentResult(ctx context.Context, in *GetAssessmentResultRequest, opts ...grpc.CallOption) (*assessment.AssessmentResult, error)
+	// Retrieves the evidences an assessment result is based on, so that auditors
+	// can verify which data led to the result. The values of the properties used
+	// by the metric are contained in the result itself. Part of the public API,
+	// also exposed as REST.
+	GetAssessmentResultEvidence(ctx context.Context, in *GetAssessmentResultEvidenceRequest, opts ...grpc.CallOption) (*GetAssessmentResultEvidenceResponse, error)
 	// List all assessment results. Part of the public API, also exposed as REST.
 	ListAssessmentResults(ctx context.Context, in *ListAssessmentResultsRequest, opts ...grpc.CallOption) (*ListAssessmentResultsResponse, error)
+	// Aggregates the latest assessment results of a single resource, i.e., the
+	// latest result per metric and the number of (non-)compliant results. Part
+	// of the public API, also exposed as REST.
+	GetResourceAssessmentSummary(ctx context.Context, in *GetResourceAssessmentSummaryRequest, opts ...grpc.CallOption) (*ResourceAssessmentSummary, error)
+	// Aggregates the latest assessment results of a cloud service per resource
+	// type. Part of the public API, also exposed as REST.
+	ListResourceTypeAssessmentSummaries(ctx context.Context, in *ListResourceTypeAssessmentSummariesRequest, opts ...grpc.CallOption) (*ListResourceTypeAssessmentSummariesResponse, error)
+	// Retrieves statistics about the assessment results of a cloud service, such
+	// as the number of assessed resources, the results per metric and the
+	// compliance ratio. The statistics are updated incrementally whenever results
+	// are stored, so that they do not need to be aggregated on each request.
+	// Part of the public API, also exposed as REST.
+	GetAssessmentStatistics(ctx context.Context, in *GetAssessmentStatisticsRequest, opts ...grpc.CallOption) (*AssessmentStatistics, error)
+	// Subscribes to a stream of new assessment results, optionally filtered by
+	// cloud service, metric and compliance state. Part of the public API, also
+	// exposed as REST (as a server-sent stream).
+	SubscribeAssessmentResults(ctx context.Context, in *SubscribeAssessmentResultsRequest, opts ...grpc.CallOption) (Orchestrator_SubscribeAssessmentResultsClient, error)
+	// Exports the latest assessment results of a cloud service as OSCAL
+	// assessment-results. The results are mapped to the controls of the
+	// specified catalog, which are reported as findings.
+	ExportOscalAssessmentResults(ctx context.Context, in *ExportOscalAssessmentResultsRequest, opts ...grpc.CallOption) (*ExportOscalAssessmentResultsResponse, error)
 	// Creates a new metric
 	CreateMetric(ctx context.Context, in *CreateMetricRequest, opts ...grpc.CallOption) (*assessment.Metric, error)
 	// Updates an existing metric
@@ -130,6 +228,11 @@ type OrchestratorClient interface {
 	ListMetrics(ctx context.Context, in *ListMetricsRequest, opts ...grpc.CallOption) (*ListMetricsResponse, error)
 	// Removes a new metric
 	RemoveMetric(ctx context.Context, in *RemoveMetricRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Restores a removed, i.e., deprecated metric
+	RestoreMetric(ctx context.Context, in *RestoreMetricRequest, opts ...grpc.CallOption) (*assessment.Metric, error)
+	// Publishes a metric that is currently in the draft state. Only published
+	// metrics are used in assessments.
+	PublishMetric(ctx context.Context, in *PublishMetricRequest, opts ...grpc.CallOption) (*assessment.Metric, error)
 	// Registers a new target cloud service
 	RegisterCloudService(ctx context.Context, in *RegisterCloudServiceRequest, opts ...grpc.CallOption) (*CloudService, error)
 	// Registers a new target cloud service
@@ -138,8 +241,10 @@ type OrchestratorClient interface {
 	GetCloudService(ctx context.Context, in *GetCloudServiceRequest, opts ...grpc.CallOption) (*CloudService, error)
 	// Lists all target cloud services
 	ListCloudServices(ctx context.Context, in *ListCloudServicesRequest, opts ...grpc.CallOption) (*ListCloudServicesResponse, error)
-	// Removes a target cloud service
+	// Removes a target cloud service. It can be restored until it is purged.
 	RemoveCloudService(ctx context.Context, in *RemoveCloudServiceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Restores a removed target cloud service
+	RestoreCloudService(ctx context.Context, in *RestoreCloudServiceRequest, opts ...grpc.CallOption) (*CloudService, error)
 	// Retrieves target cloud service statistics
 	GetCloudServiceStatistics(ctx context.Context, in *GetCloudServiceStatisticsRequest, opts ...grpc.CallOption) (*GetCloudServiceStatisticsResponse, error)
 	// Updates a metric configuration (target value and operator) for a specific
@@ -153,8 +258,25 @@ type OrchestratorClient interface {
 	ListMetricConfigurations(ctx context.Context, in *ListMetricConfigurationRequest, opts ...grpc.CallOption) (*ListMetricConfigurationResponse, error)
 	// Updates an existing metric implementation
 	UpdateMetricImplementation(ctx context.Context, in *UpdateMetricImplementationRequest, opts ...grpc.CallOption) (*assessment.MetricImplementation, error)
+	// Tests a metric implementation without storing it. The Rego code of the
+	// implementation is compiled and evaluated against the resources of the
+	// supplied sample evidences. Part of the public API, also exposed as REST.
+	TestMetricImplementation(ctx context.Context, in *TestMetricImplementationRequest, opts ...grpc.CallOption) (*TestMetricImplementationResponse, error)
 	// Returns the metric implementation of the passed metric id
 	GetMetricImplementation(ctx context.Context, in *GetMetricImplementationRequest, opts ...grpc.CallOption) (*assessment.MetricImplementation, error)
+	// Lists the (immutable) history of the implementation of a metric.
+	ListMetricImplementationRevisions(ctx context.Context, in *ListMetricImplementationRevisionsRequest, opts ...grpc.CallOption) (*ListMetricImplementationRevisionsResponse, error)
+	// Lists the (immutable) history of the configuration of a metric for a
+	// cloud service.
+	ListMetricConfigurationRevisions(ctx context.Context, in *ListMetricConfigurationRevisionsRequest, opts ...grpc.CallOption) (*ListMetricConfigurationRevisionsResponse, error)
+	// Disables a metric for a specific cloud service. Disabled metrics are not
+	// assessed and controls that only consist of disabled metrics are evaluated
+	// as not applicable.
+	DisableMetric(ctx context.Context, in *DisableMetricRequest, opts ...grpc.CallOption) (*DisabledMetric, error)
+	// Re-enables a previously disabled metric for a specific cloud service.
+	EnableMetric(ctx context.Context, in *EnableMetricRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Lists all disabled metrics of a specific cloud service.
+	ListDisabledMetrics(ctx context.Context, in *ListDisabledMetricsRequest, opts ...grpc.CallOption) (*ListDisabledMetricsResponse, error)
 	SubscribeMetricChangeEvents(ctx context.Context, in *SubscribeMetricChangeEventRequest, opts ...grpc.CallOption) (Orchestrator_SubscribeMetricChangeEventsClient, error)
 	// Creates a new certificate
 	CreateCertificate(ctx context.Context, in *CreateCertificateRequest, opts ...grpc.CallOption) (*Certificate, error)
@@ -166,8 +288,19 @@ type OrchestratorClient interface {
 	ListPublicCertificates(ctx context.Context, in *ListPublicCertificatesRequest, opts ...grpc.CallOption) (*ListPublicCertificatesResponse, error)
 	// Updates an existing certificate
 	UpdateCertificate(ctx context.Context, in *UpdateCertificateRequest, opts ...grpc.CallOption) (*Certificate, error)
-	// Removes a certificate
+	// Transitions an existing certificate into a new lifecycle state, e.g., to
+	// suspend or re-issue it
+	UpdateCertificateState(ctx context.Context, in *UpdateCertificateStateRequest, opts ...grpc.CallOption) (*Certificate, error)
+	// Reports the compliance of a target of evaluation as observed by the
+	// continuous evaluation. The certification lifecycle engine derives state
+	// changes of the affected certificates, i.e., whether they continue, are
+	// suspended or are withdrawn, from the observations within its observation
+	// window.
+	ReportCertificationObservation(ctx context.Context, in *ReportCertificationObservationRequest, opts ...grpc.CallOption) (*ReportCertificationObservationResponse, error)
+	// Removes a certificate. It can be restored until it is purged.
 	RemoveCertificate(ctx context.Context, in *RemoveCertificateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Restores a removed certificate
+	RestoreCertificate(ctx context.Context, in *RestoreCertificateRequest, opts ...grpc.CallOption) (*Certificate, error)
 	// Creates a new security controls catalog
 	CreateCatalog(ctx context.Context, in *CreateCatalogRequest, opts ...grpc.CallOption) (*Catalog, error)
 	// Lists all security controls catalogs. Each catalog includes a list of its
@@ -176,10 +309,23 @@ type OrchestratorClient interface {
 	// Retrieves a specific catalog by it's ID. The catalog includes a list of all
 	// of it categories as well as the first level of controls in each category.
 	GetCatalog(ctx context.Context, in *GetCatalogRequest, opts ...grpc.CallOption) (*Catalog, error)
-	// Removes a catalog
+	// Removes a catalog. It can be restored until it is purged.
 	RemoveCatalog(ctx context.Context, in *RemoveCatalogRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Restores a removed catalog
+	RestoreCatalog(ctx context.Context, in *RestoreCatalogRequest, opts ...grpc.CallOption) (*Catalog, error)
 	// Updates an existing certificate
 	UpdateCatalog(ctx context.Context, in *UpdateCatalogRequest, opts ...grpc.CallOption) (*Catalog, error)
+	// Lists all catalogs that are built into Clouditor, e.g., EUCS or BSI C5.
+	// Each catalog only contains its meta-data, such as name and version, but no
+	// categories.
+	ListBuiltinCatalogs(ctx context.Context, in *ListBuiltinCatalogsRequest, opts ...grpc.CallOption) (*ListBuiltinCatalogsResponse, error)
+	// Loads a built-in catalog into the orchestrator. If the catalog already
+	// exists, it is updated to the built-in version.
+	LoadBuiltinCatalog(ctx context.Context, in *LoadBuiltinCatalogRequest, opts ...grpc.CallOption) (*Catalog, error)
+	// Imports an OSCAL catalog or profile as a new catalog. Since a profile
+	// only contains a selection of controls, it is resolved against an already
+	// existing catalog, specified by the base catalog ID.
+	ImportOscalCatalog(ctx context.Context, in *ImportOscalCatalogRequest, opts ...grpc.CallOption) (*Catalog, error)
 	// Retrieves a category of a catalog specified by the catalog ID and the
 	// category name. It includes the first level of controls within each
 	// category.
@@ -193,6 +339,20 @@ type OrchestratorClient interface {
 	// sub-controls if present or a list of metrics if no sub-controls but metrics
 	// are present.
 	GetControl(ctx context.Context, in *GetControlRequest, opts ...grpc.CallOption) (*Control, error)
+	// Maps a metric to a control, i.e., the metric is used to assess the
+	// control. Returns the control including its metrics.
+	AddControlMetric(ctx context.Context, in *AddControlMetricRequest, opts ...grpc.CallOption) (*Control, error)
+	// Removes the mapping of a metric to a control
+	RemoveControlMetric(ctx context.Context, in *RemoveControlMetricRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Reports which controls of a catalog are covered by metrics. Controls
+	// without any mapped metric, neither directly nor through one of their
+	// sub-controls, are gaps in the assessment. Part of the public API, also
+	// exposed as REST.
+	GetCatalogCoverage(ctx context.Context, in *GetCatalogCoverageRequest, opts ...grpc.CallOption) (*CatalogCoverage, error)
+	// Sets the strategy that specifies how the results of the sub-controls of a
+	// control are combined during the evaluation. If no strategy is given, the
+	// strategy of the catalog is used. Returns the updated control.
+	SetControlAggregationStrategy(ctx context.Context, in *SetControlAggregationStrategyRequest, opts ...grpc.CallOption) (*Control, error)
 	// Creates a new Target of Evaluation
 	CreateTargetOfEvaluation(ctx context.Context, in *CreateTargetOfEvaluationRequest, opts ...grpc.CallOption) (*TargetOfEvaluation, error)
 	// Retrieves a Target of Evaluation
@@ -203,6 +363,132 @@ type OrchestratorClient interface {
 	UpdateTargetOfEvaluation(ctx context.Context, in *UpdateTargetOfEvaluationRequest, opts ...grpc.CallOption) (*TargetOfEvaluation, error)
 	// Removes a Target of Evaluation
 	RemoveTargetOfEvaluation(ctx context.Context, in *RemoveTargetOfEvaluationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Creates a new webhook, which is notified about events of a cloud service
+	CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*Webhook, error)
+	// Retrieves a webhook. The secret of the webhook is never returned.
+	GetWebhook(ctx context.Context, in *GetWebhookRequest, opts ...grpc.CallOption) (*Webhook, error)
+	// Lists all webhooks. The secrets of the webhooks are never returned.
+	ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*ListWebhooksResponse, error)
+	// Updates an existing webhook. If no secret is specified, the existing
+	// secret is kept.
+	UpdateWebhook(ctx context.Context, in *UpdateWebhookRequest, opts ...grpc.CallOption) (*Webhook, error)
+	// Removes a webhook
+	RemoveWebhook(ctx context.Context, in *RemoveWebhookRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Creates a new notification channel, e.g., a Slack or Microsoft Teams
+	// webhook or a list of email recipients
+	CreateNotificationChannel(ctx context.Context, in *CreateNotificationChannelRequest, opts ...grpc.CallOption) (*NotificationChannel, error)
+	// Retrieves a notification channel
+	GetNotificationChannel(ctx context.Context, in *GetNotificationChannelRequest, opts ...grpc.CallOption) (*NotificationChannel, error)
+	// Lists all notification channels
+	ListNotificationChannels(ctx context.Context, in *ListNotificationChannelsRequest, opts ...grpc.CallOption) (*ListNotificationChannelsResponse, error)
+	// Updates an existing notification channel
+	UpdateNotificationChannel(ctx context.Context, in *UpdateNotificationChannelRequest, opts ...grpc.CallOption) (*NotificationChannel, error)
+	// Removes a notification channel
+	RemoveNotificationChannel(ctx context.Context, in *RemoveNotificationChannelRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Sends a notification to all enabled notification channels of the cloud
+	// service whose severity threshold is met. This is used by the evaluation
+	// to inform about controls that became non-compliant.
+	SendNotification(ctx context.Context, in *SendNotificationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Creates a new ticket integration, which opens tickets in Jira or
+	// ServiceNow for persistent non-compliant assessment results
+	CreateTicketIntegration(ctx context.Context, in *CreateTicketIntegrationRequest, opts ...grpc.CallOption) (*TicketIntegration, error)
+	// Retrieves a ticket integration. The token of the integration is never
+	// returned.
+	GetTicketIntegration(ctx context.Context, in *GetTicketIntegrationRequest, opts ...grpc.CallOption) (*TicketIntegration, error)
+	// Lists all ticket integrations. The tokens of the integrations are never
+	// returned.
+	ListTicketIntegrations(ctx context.Context, in *ListTicketIntegrationsRequest, opts ...grpc.CallOption) (*ListTicketIntegrationsResponse, error)
+	// Updates an existing ticket integration. If no token is specified, the
+	// existing token is kept.
+	UpdateTicketIntegration(ctx context.Context, in *UpdateTicketIntegrationRequest, opts ...grpc.CallOption) (*TicketIntegration, error)
+	// Removes a ticket integration and all of its tickets. The tickets in the
+	// external system are left untouched.
+	RemoveTicketIntegration(ctx context.Context, in *RemoveTicketIntegrationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Lists all tickets that were opened by ticket integrations
+	ListTickets(ctx context.Context, in *ListTicketsRequest, opts ...grpc.CallOption) (*ListTicketsResponse, error)
+	// Creates a new waiver, which accepts the risk of a non-compliant resource
+	// regarding a metric until it expires. This requires the waiver role, if
+	// one is configured.
+	CreateWaiver(ctx context.Context, in *CreateWaiverRequest, opts ...grpc.CallOption) (*Waiver, error)
+	// Retrieves a waiver
+	GetWaiver(ctx context.Context, in *GetWaiverRequest, opts ...grpc.CallOption) (*Waiver, error)
+	// Lists all waivers
+	ListWaivers(ctx context.Context, in *ListWaiversRequest, opts ...grpc.CallOption) (*ListWaiversResponse, error)
+	// Removes (revokes) a waiver. This requires the waiver role, if one is
+	// configured.
+	RemoveWaiver(ctx context.Context, in *RemoveWaiverRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Creates a new organization (tenant). This requires access to all cloud
+	// services.
+	CreateOrganization(ctx context.Context, in *CreateOrganizationRequest, opts ...grpc.CallOption) (*Organization, error)
+	// Retrieves an organization
+	GetOrganization(ctx context.Context, in *GetOrganizationRequest, opts ...grpc.CallOption) (*Organization, error)
+	// Lists all organizations the current user is a member of
+	ListOrganizations(ctx context.Context, in *ListOrganizationsRequest, opts ...grpc.CallOption) (*ListOrganizationsResponse, error)
+	// Updates an organization. This requires access to all cloud services.
+	UpdateOrganization(ctx context.Context, in *UpdateOrganizationRequest, opts ...grpc.CallOption) (*Organization, error)
+	// Removes an organization. This requires access to all cloud services and
+	// the organization must not contain any cloud services anymore.
+	RemoveOrganization(ctx context.Context, in *RemoveOrganizationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Creates a new role assignment, which grants a role to a user, either for
+	// all cloud services or only for a specific one. This requires the admin
+	// role for the cloud service of the role assignment.
+	CreateRoleAssignment(ctx context.Context, in *CreateRoleAssignmentRequest, opts ...grpc.CallOption) (*RoleAssignment, error)
+	// Retrieves a role assignment
+	GetRoleAssignment(ctx context.Context, in *GetRoleAssignmentRequest, opts ...grpc.CallOption) (*RoleAssignment, error)
+	// Lists all role assignments
+	ListRoleAssignments(ctx context.Context, in *ListRoleAssignmentsRequest, opts ...grpc.CallOption) (*ListRoleAssignmentsResponse, error)
+	// Removes a role assignment. This requires the admin role for the cloud
+	// service of the role assignment.
+	RemoveRoleAssignment(ctx context.Context, in *RemoveRoleAssignmentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Creates a new API key, which allows a machine collector to submit
+	// evidences for specific cloud services without a full OAuth 2.0 flow. The
+	// key itself is only returned once. This requires the admin role for all
+	// cloud services of the API key.
+	CreateApiKey(ctx context.Context, in *CreateApiKeyRequest, opts ...grpc.CallOption) (*CreateApiKeyResponse, error)
+	// Retrieves an API key (without the key itself)
+	GetApiKey(ctx context.Context, in *GetApiKeyRequest, opts ...grpc.CallOption) (*ApiKey, error)
+	// Lists all API keys (without the keys themselves)
+	ListApiKeys(ctx context.Context, in *ListApiKeysRequest, opts ...grpc.CallOption) (*ListApiKeysResponse, error)
+	// Rotates an API key, i.e., it issues a new key and invalidates the old
+	// one. This requires the admin role for all cloud services of the API key.
+	RotateApiKey(ctx context.Context, in *RotateApiKeyRequest, opts ...grpc.CallOption) (*CreateApiKeyResponse, error)
+	// Removes (revokes) an API key. This requires the admin role for all cloud
+	// services of the API key.
+	RemoveApiKey(ctx context.Context, in *RemoveApiKeyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Creates a new auditor grant, which gives an external auditor time-limited,
+	// read-only access to a cloud service and a catalog. The token of the grant
+	// is only returned once. This requires the admin role for the cloud service.
+	CreateAuditorGrant(ctx context.Context, in *CreateAuditorGrantRequest, opts ...grpc.CallOption) (*CreateAuditorGrantResponse, error)
+	// Retrieves an auditor grant (without its token)
+	GetAuditorGrant(ctx context.Context, in *GetAuditorGrantRequest, opts ...grpc.CallOption) (*AuditorGrant, error)
+	// Lists all auditor grants (without their tokens)
+	ListAuditorGrants(ctx context.Context, in *ListAuditorGrantsRequest, opts ...grpc.CallOption) (*ListAuditorGrantsResponse, error)
+	// Revokes an auditor grant before it expires. This requires the admin role
+	// for the cloud service of the grant.
+	RevokeAuditorGrant(ctx context.Context, in *RevokeAuditorGrantRequest, opts ...grpc.CallOption) (*AuditorGrant, error)
+	// Lists all entries of the audit log, which records who called which RPC
+	// with which outcome. Only entries of the cloud services the user has
+	// access to are listed, unless the user has access to all cloud services.
+	ListAuditLogEntries(ctx context.Context, in *ListAuditLogEntriesRequest, opts ...grpc.CallOption) (*ListAuditLogEntriesResponse, error)
+	// Exports the entries of the audit log as JSON or CSV.
+	ExportAuditLog(ctx context.Context, in *ExportAuditLogRequest, opts ...grpc.CallOption) (*ExportAuditLogResponse, error)
+	// Registers an external evidence collector (agent) for a cloud service. If
+	// an agent with the same ID is already registered, its registration is
+	// updated but its configuration is kept. The agent receives its
+	// configuration (intervals, scopes) in the response.
+	RegisterAgent(ctx context.Context, in *RegisterAgentRequest, opts ...grpc.CallOption) (*Agent, error)
+	// Records a heartbeat of an agent, which signals that the agent is still
+	// alive. The agent receives its current configuration in the response, so
+	// that configuration changes are picked up with the next heartbeat.
+	SendAgentHeartbeat(ctx context.Context, in *SendAgentHeartbeatRequest, opts ...grpc.CallOption) (*SendAgentHeartbeatResponse, error)
+	// Lists all registered agents together with their health, which is derived
+	// from their last heartbeat
+	ListAgents(ctx context.Context, in *ListAgentsRequest, opts ...grpc.CallOption) (*ListAgentsResponse, error)
+	// Updates the configuration of an agent. The agent receives the new
+	// configuration with its next heartbeat.
+	UpdateAgentConfiguration(ctx context.Context, in *UpdateAgentConfigurationRequest, opts ...grpc.CallOption) (*Agent, error)
+	// Deregisters an agent
+	DeregisterAgent(ctx context.Context, in *DeregisterAgentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Get Runtime Information
 	GetRuntimeInfo(ctx context.Context, in *runtime.GetRuntimeInfoRequest, opts ...grpc.CallOption) (*runtime.Runtime, error)
 }
@@ -309,6 +595,15 @@ func (c *orchestratorClient) GetAssessmentResult(ctx context.Context, in *GetAss
 	return out, nil
 }
 
+func (c *orchestratorClient) GetAssessmentResultEvidence(ctx context.Context, in *GetAssessmentResultEvidenceRequest, opts ...grpc.CallOption) (*GetAssessmentResultEvidenceResponse, error) {
+	out := new(GetAssessmentResultEvidenceResponse)
+	err := c.cc.Invoke(ctx, Orchestrator_GetAssessmentResultEvidence_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *orchestratorClient) ListAssessmentResults(ctx context.Context, in *ListAssessmentResultsRequest, opts ...grpc.CallOption) (*ListAssessmentResultsResponse, error) {
 	out := new(ListAssessmentResultsResponse)
 	err := c.cc.Invoke(ctx, Orchestrator_ListAssessmentResults_FullMethodName, in, out, opts...)
@@ -318,6 +613,74 @@ func (c *orchestratorClient) ListAssessmentResults(ctx context.Context, in *List
 	return out, nil
 }
 
+func (c *orchestratorClient) GetResourceAssessmentSummary(ctx context.Context, in *GetResourceAssessmentSummaryRequest, opts ...grpc.CallOption) (*ResourceAssessmentSummary, error) {
+	out := new(ResourceAssessmentSummary)
+	err := c.cc.Invoke(ctx, Orchestrator_GetResourceAssessmentSummary_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orchestratorClient) ListResourceTypeAssessmentSummaries(ctx context.Context, in *ListResourceTypeAssessmentSummariesRequest, opts ...grpc.CallOption) (*ListResourceTypeAssessmentSummariesResponse, error) {
+	out := new(ListResourceTypeAssessmentSummariesResponse)
+	err := c.cc.Invoke(ctx, Orchestrator_ListResourceTypeAssessmentSummaries_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orchestratorClient) GetAssessmentStatistics(ctx context.Context, in *GetAssessmentStatisticsRequest, opts ...grpc.CallOption) (*AssessmentStatistics, error) {
+	out := new(AssessmentStatistics)
+	err := c.cc.Invoke(ctx, Orchestrator_GetAssessmentStatistics_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orchestratorClient) SubscribeAssessmentResults(ctx context.Context, in *SubscribeAssessmentResultsRequest, opts ...grpc.CallOption) (Orchestrator_SubscribeAssessmentResultsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Orchestrator_ServiceDesc.Streams[1], Orchestrator_SubscribeAssessmentResults_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &orchestratorSubscribeAssessmentResultsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Orchestrator_SubscribeAssessmentResultsClient interface {
+	Recv() (*AssessmentResultEvent, error)
+	grpc.ClientStream
+}
+
+type orchestratorSubscribeAssessmentResultsClient struct {
+	grpc.ClientStream
+}
+
+func (x *orchestratorSubscribeAssessmentResultsClient) Recv() (*AssessmentResultEvent, error) {
+	m := new(AssessmentResultEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *orchestratorClient) ExportOscalAssessmentResults(ctx context.Context, in *ExportOscalAssessmentResultsRequest, opts ...grpc.CallOption) (*ExportOscalAssessmentResultsResponse, error) {
+	out := new(ExportOscalAssessmentResultsResponse)
+	err := c.cc.Invoke(ctx, Orchestrator_ExportOscalAssessmentResults_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *orchestratorClient) CreateMetric(ctx context.Context, in *CreateMetricRequest, opts ...grpc.CallOption) (*assessment.Metric, error) {
 	out := new(assessment.Metric)
 	err := c.cc.Invoke(ctx, Orchestrator_CreateMetric_FullMethodName, in, out, opts...)
@@ -363,6 +726,24 @@ func (c *orchestratorClient) RemoveMetric(ctx context.Context, in *RemoveMetricR
 	return out, nil
 }
 
+func (c *orchestratorClient) RestoreMetric(ctx context.Context, in *RestoreMetricRequest, opts ...grpc.CallOption) (*assessment.Metric, error) {
+	out := new(assessment.Metric)
+	err := c.cc.Invoke(ctx, Orchestrator_RestoreMetric_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orchestratorClient) PublishMetric(ctx context.Context, in *PublishMetricRequest, opts ...grpc.CallOption) (*assessment.Metric, error) {
+	out := new(assessment.Metric)
+	err := c.cc.Invoke(ctx, Orchestrator_PublishMetric_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *orchestratorClient) RegisterCloudService(ctx context.Context, in *RegisterCloudServiceRequest, opts ...grpc.CallOption) (*CloudService, error) {
 	out := new(CloudService)
 	err := c.cc.Invoke(ctx, Orchestrator_RegisterCloudService_FullMethodName, in, out, opts...)
@@ -408,6 +789,15 @@ func (c *orchestratorClient) RemoveCloudService(ctx context.Context, in *RemoveC
 	return out, nil
 }
 
+func (c *orchestratorClient) RestoreCloudService(ctx context.Context, in *RestoreCloudServiceRequest, opts ...grpc.CallOption) (*CloudService, error) {
+	out := new(CloudService)
+	err := c.cc.Invoke(ctx, Orchestrator_RestoreCloudService_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *orchestratorClient) GetCloudServiceStatistics(ctx context.Context, in *GetCloudServiceStatisticsRequest, opts ...grpc.CallOption) (*GetCloudServiceStatisticsResponse, error) {
 	out := new(GetCloudServiceStatisticsResponse)
 	err := c.cc.Invoke(ctx, Orchestrator_GetCloudServiceStatistics_FullMethodName, in, out, opts...)
@@ -453,6 +843,15 @@ func (c *orchestratorClient) UpdateMetricImplementation(ctx context.Context, in
 	return out, nil
 }
 
+func (c *orchestratorClient) TestMetricImplementation(ctx context.Context, in *TestMetricImplementationRequest, opts ...grpc.CallOption) (*TestMetricImplementationResponse, error) {
+	out := new(TestMetricImplementationResponse)
+	err := c.cc.Invoke(ctx, Orchestrator_TestMetricImplementation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *orchestratorClient) GetMetricImplementation(ctx context.Context, in *GetMetricImplementationRequest, opts ...grpc.CallOption) (*assessment.MetricImplementation, error) {
 	out := new(assessment.MetricImplementation)
 	err := c.cc.Invoke(ctx, Orchestrator_GetMetricImplementation_FullMethodName, in, out, opts...)
@@ -462,8 +861,53 @@ func (c *orchestratorClient) GetMetricImplementation(ctx context.Context, in *Ge
 	return out, nil
 }
 
+func (c *orchestratorClient) ListMetricImplementationRevisions(ctx context.Context, in *ListMetricImplementationRevisionsRequest, opts ...grpc.CallOption) (*ListMetricImplementationRevisionsResponse, error) {
+	out := new(ListMetricImplementationRevisionsResponse)
+	err := c.cc.Invoke(ctx, Orchestrator_ListMetricImplementationRevisions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orchestratorClient) ListMetricConfigurationRevisions(ctx context.Context, in *ListMetricConfigurationRevisionsRequest, opts ...grpc.CallOption) (*ListMetricConfigurationRevisionsResponse, error) {
+	out := new(ListMetricConfigurationRevisionsResponse)
+	err := c.cc.Invoke(ctx, Orchestrator_ListMetricConfigurationRevisions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orchestratorClient) DisableMetric(ctx context.Context, in *DisableMetricRequest, opts ...grpc.CallOption) (*DisabledMetric, error) {
+	out := new(DisabledMetric)
+	err := c.cc.Invoke(ctx, Orchestrator_DisableMetric_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orchestratorClient) EnableMetric(ctx context.Context, in *EnableMetricRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Orchestrator_EnableMetric_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orchestratorClient) ListDisabledMetrics(ctx context.Context, in *ListDisabledMetricsRequest, opts ...grpc.CallOption) (*ListDisabledMetricsResponse, error) {
+	out := new(ListDisabledMetricsResponse)
+	err := c.cc.Invoke(ctx, Orchestrator_ListDisabledMetrics_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *orchestratorClient) SubscribeMetricChangeEvents(ctx context.Context, in *SubscribeMetricChangeEventRequest, opts ...grpc.CallOption) (Orchestrator_SubscribeMetricChangeEventsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Orchestrator_ServiceDesc.Streams[1], Orchestrator_SubscribeMetricChangeEvents_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &Orchestrator_ServiceDesc.Streams[2], Orchestrator_SubscribeMetricChangeEvents_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -539,6 +983,24 @@ func (c *orchestratorClient) UpdateCertificate(ctx context.Context, in *UpdateCe
 	return out, nil
 }
 
+func (c *orchestratorClient) UpdateCertificateState(ctx context.Context, in *UpdateCertificateStateRequest, opts ...grpc.CallOption) (*Certificate, error) {
+	out := new(Certificate)
+	err := c.cc.Invoke(ctx, Orchestrator_UpdateCertificateState_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orchestratorClient) ReportCertificationObservation(ctx context.Context, in *ReportCertificationObservationRequest, opts ...grpc.CallOption) (*ReportCertificationObservationResponse, error) {
+	out := new(ReportCertificationObservationResponse)
+	err := c.cc.Invoke(ctx, Orchestrator_ReportCertificationObservation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *orchestratorClient) RemoveCertificate(ctx context.Context, in *RemoveCertificateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, Orchestrator_RemoveCertificate_FullMethodName, in, out, opts...)
@@ -548,6 +1010,15 @@ func (c *orchestratorClient) RemoveCertificate(ctx context.Context, in *RemoveCe
 	return out, nil
 }
 
+func (c *orchestratorClient) RestoreCertificate(ctx context.Context, in *RestoreCertificateRequest, opts ...grpc.CallOption) (*Certificate, error) {
+	out := new(Certificate)
+	err := c.cc.Invoke(ctx, Orchestrator_RestoreCertificate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *orchestratorClient) CreateCatalog(ctx context.Context, in *CreateCatalogRequest, opts ...grpc.CallOption) (*Catalog, error) {
 	out := new(Catalog)
 	err := c.cc.Invoke(ctx, Orchestrator_CreateCatalog_FullMethodName, in, out, opts...)
@@ -584,6 +1055,15 @@ func (c *orchestratorClient) RemoveCatalog(ctx context.Context, in *RemoveCatalo
 	return out, nil
 }
 
+func (c *orchestratorClient) RestoreCatalog(ctx context.Context, in *RestoreCatalogRequest, opts ...grpc.CallOption) (*Catalog, error) {
+	out := new(Catalog)
+	err := c.cc.Invoke(ctx, Orchestrator_RestoreCatalog_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *orchestratorClient) UpdateCatalog(ctx context.Context, in *UpdateCatalogRequest, opts ...grpc.CallOption) (*Catalog, error) {
 	out := new(Catalog)
 	err := c.cc.Invoke(ctx, Orchestrator_UpdateCatalog_FullMethodName, in, out, opts...)
@@ -593,6 +1073,33 @@ func (c *orchestratorClient) UpdateCatalog(ctx context.Context, in *UpdateCatalo
 	return out, nil
 }
 
+func (c *orchestratorClient) ListBuiltinCatalogs(ctx context.Context, in *ListBuiltinCatalogsRequest, opts ...grpc.CallOption) (*ListBuiltinCatalogsResponse, error) {
+	out := new(ListBuiltinCatalogsResponse)
+	err := c.cc.Invoke(ctx, Orchestrator_ListBuiltinCatalogs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orchestratorClient) LoadBuiltinCatalog(ctx context.Context, in *LoadBuiltinCatalogRequest, opts ...grpc.CallOption) (*Catalog, error) {
+	out := new(Catalog)
+	err := c.cc.Invoke(ctx, Orchestrator_LoadBuiltinCatalog_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orchestratorClient) ImportOscalCatalog(ctx context.Context, in *ImportOscalCatalogRequest, opts ...grpc.CallOption) (*Catalog, error) {
+	out := new(Catalog)
+	err := c.cc.Invoke(ctx, Orchestrator_ImportOscalCatalog_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *orchestratorClient) GetCategory(ctx context.Context, in *GetCategoryRequest, opts ...grpc.CallOption) (*Category, error) {
 	out := new(Category)
 	err := c.cc.Invoke(ctx, Orchestrator_GetCategory_FullMethodName, in, out, opts...)
@@ -620,6 +1127,42 @@ func (c *orchestratorClient) GetControl(ctx context.Context, in *GetControlReque
 	return out, nil
 }
 
+func (c *orchestratorClient) AddControlMetric(ctx context.Context, in *AddControlMetricRequest, opts ...grpc.CallOption) (*Control, error) {
+	out := new(Control)
+	err := c.cc.Invoke(ctx, Orchestrator_AddControlMetric_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orchestratorClient) RemoveControlMetric(ctx context.Context, in *RemoveControlMetricRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Orchestrator_RemoveControlMetric_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orchestratorClient) GetCatalogCoverage(ctx context.Context, in *GetCatalogCoverageRequest, opts ...grpc.CallOption) (*CatalogCoverage, error) {
+	out := new(CatalogCoverage)
+	err := c.cc.Invoke(ctx, Orchestrator_GetCatalogCoverage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orchestratorClient) SetControlAggregationStrategy(ctx context.Context, in *SetControlAggregationStrategyRequest, opts ...grpc.CallOption) (*Control, error) {
+	out := new(Control)
+	err := c.cc.Invoke(ctx, Orchestrator_SetControlAggregationStrategy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *orchestratorClient) CreateTargetOfEvaluation(ctx context.Context, in *CreateTargetOfEvaluationRequest, opts ...grpc.CallOption) (*TargetOfEvaluation, error) {
 	out := new(TargetOfEvaluation)
 	err := c.cc.Invoke(ctx, Orchestrator_CreateTargetOfEvaluation_FullMethodName, in, out, opts...)
@@ -665,1100 +1208,3243 @@ func (c *orchestratorClient) RemoveTargetOfEvaluation(ctx context.Context, in *R
 	return out, nil
 }
 
-func (c *orchestratorClient) GetRuntimeInfo(ctx context.Context, in *runtime.GetRuntimeInfoRequest, opts ...grpc.CallOption) (*runtime.Runtime, error) {
-	out := new(runtime.Runtime)
-	err := c.cc.Invoke(ctx, Orchestrator_GetRuntimeInfo_FullMethodName, in, out, opts...)
+func (c *orchestratorClient) CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*Webhook, error) {
+	out := new(Webhook)
+	err := c.cc.Invoke(ctx, Orchestrator_CreateWebhook_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// OrchestratorServer is the server API for Orchestrator service.
-// All implementations must embed UnimplementedOrchestratorServer
-// for forward compatibility
-type OrchestratorServer interface {
-	// Registers the passed assessment tool
-	RegisterAssessmentTool(context.Context, *RegisterAssessmentToolRequest) (*AssessmentTool, error)
-	// Lists all assessment tools assessing evidences for the metric given by the
-	// passed metric id
-	ListAssessmentTools(context.Context, *ListAssessmentToolsRequest) (*ListAssessmentToolsResponse, error)
-	// Returns assessment tool given by the passed tool id
-	GetAssessmentTool(context.Context, *GetAssessmentToolRequest) (*AssessmentTool, error)
-	// Updates the assessment tool given by the passed id
-	UpdateAssessmentTool(context.Context, *UpdateAssessmentToolRequest) (*AssessmentTool, error)
-	// Remove assessment tool with passed id from the list of active assessment
-	// tools
-	DeregisterAssessmentTool(context.Context, *DeregisterAssessmentToolRequest) (*emptypb.Empty, error)
-	// Stores the assessment result provided by an assessment tool
-	StoreAssessmentResult(context.Context, *StoreAssessmentResultRequest) (*StoreAssessmentResultResponse, error)
-	// Stores stream of assessment results provided by an assessment tool and
-	// returns a response stream. Part of the public API, not exposed as REST.
-	StoreAssessmentResults(Orchestrator_StoreAssessmentResultsServer) error
-	// Get an assessment result by ID
-	GetAssessmentResult(context.Context, *GetAssessmentResultRequest) (*assessment.AssessmentResult, error)
-	// List all assessment results. Part of the public API, also exposed as REST.
-	ListAssessmentResults(context.Context, *ListAssessmentResultsRequest) (*ListAssessmentResultsResponse, error)
-	// Creates a new metric
-	CreateMetric(context.Context, *CreateMetricRequest) (*assessment.Metric, error)
-	// Updates an existing metric
-	UpdateMetric(context.Context, *UpdateMetricRequest) (*assessment.Metric, error)
-	// Returns the metric with the passed metric id
-	GetMetric(context.Context, *GetMetricRequest) (*assessment.Metric, error)
-	// List all metrics provided by the metric catalog
-	ListMetrics(context.Context, *ListMetricsRequest) (*ListMetricsResponse, error)
-	// Removes a new metric
-	RemoveMetric(context.Context, *RemoveMetricRequest) (*emptypb.Empty, error)
-	// Registers a new target cloud service
-	RegisterCloudService(context.Context, *RegisterCloudServiceRequest) (*CloudService, error)
-	// Registers a new target cloud service
-	UpdateCloudService(context.Context, *UpdateCloudServiceRequest) (*CloudService, error)
-	// Retrieves a target cloud service
-	GetCloudService(context.Context, *GetCloudServiceRequest) (*CloudService, error)
-	// Lists all target cloud services
-	ListCloudServices(context.Context, *ListCloudServicesRequest) (*ListCloudServicesResponse, error)
-	// Removes a target cloud service
-	RemoveCloudService(context.Context, *RemoveCloudServiceRequest) (*emptypb.Empty, error)
-	// Retrieves target cloud service statistics
-	GetCloudServiceStatistics(context.Context, *GetCloudServiceStatisticsRequest) (*GetCloudServiceStatisticsResponse, error)
-	// Updates a metric configuration (target value and operator) for a specific
-	// service and metric ID
-	UpdateMetricConfiguration(context.Context, *UpdateMetricConfigurationRequest) (*assessment.MetricConfiguration, error)
-	// Retrieves a metric configuration (target value and operator) for a specific
-	// service and metric ID.
-	GetMetricConfiguration(context.Context, *GetMetricConfigurationRequest) (*assessment.MetricConfiguration, error)
-	// Lists all a metric configurations (target value and operator) for a
-	// specific service ID
-	ListMetricConfigurations(context.Context, *ListMetricConfigurationRequest) (*ListMetricConfigurationResponse, error)
-	// Updates an existing metric implementation
-	UpdateMetricImplementation(context.Context, *UpdateMetricImplementationRequest) (*assessment.MetricImplementation, error)
-	// Returns the metric implementation of the passed metric id
-	GetMetricImplementation(context.Context, *GetMetricImplementationRequest) (*assessment.MetricImplementation, error)
-	SubscribeMetricChangeEvents(*SubscribeMetricChangeEventRequest, Orchestrator_SubscribeMetricChangeEventsServer) error
-	// Creates a new certificate
-	CreateCertificate(context.Context, *CreateCertificateRequest) (*Certificate, error)
-	// Retrieves a certificate
-	GetCertificate(context.Context, *GetCertificateRequest) (*Certificate, error)
-	// Lists all target certificates
-	ListCertificates(context.Context, *ListCertificatesRequest) (*ListCertificatesResponse, error)
-	// Lists all target certificates without state history
-	ListPublicCertificates(context.Context, *ListPublicCertificatesRequest) (*ListPublicCertificatesResponse, error)
-	// Updates an existing certificate
-	UpdateCertificate(context.Context, *UpdateCertificateRequest) (*Certificate, error)
-	// Removes a certificate
-	RemoveCertificate(context.Context, *RemoveCertificateRequest) (*emptypb.Empty, error)
-	// Creates a new security controls catalog
-	CreateCatalog(context.Context, *CreateCatalogRequest) (*Catalog, error)
-	// Lists all security controls catalogs. Each catalog includes a list of its
-	// categories but no additional sub-resources.
-	ListCatalogs(context.Context, *ListCatalogsRequest) (*ListCatalogsResponse, error)
-	// Retrieves a specific catalog by it's ID. The catalog includes a list of all
-	// of it categories as well as the first level of controls in each category.
-	GetCatalog(context.Context, *GetCatalogRequest) (*Catalog, error)
-	// Removes a catalog
-	RemoveCatalog(context.Context, *RemoveCatalogRequest) (*emptypb.Empty, error)
-	// Updates an existing certificate
-	UpdateCatalog(context.Context, *UpdateCatalogRequest) (*Catalog, error)
-	// Retrieves a category of a catalog specified by the catalog ID and the
-	// category name. It includes the first level of controls within each
-	// category.
-	GetCategory(context.Context, *GetCategoryRequest) (*Category, error)
-	// If no additional parameters are specified, this lists all controls. If a
-	// catalog ID and a category name is specified, then only controls containing
-	// in this category are returned.
-	ListControls(context.Context, *ListControlsRequest) (*ListControlsResponse, error)
-	// Retrieves a control specified by the catalog ID, the control's category
-	// name and the control ID. If present, it also includes a list of
-	// sub-controls if present or a list of metrics if no sub-controls but metrics
-	// are present.
-	GetControl(context.Context, *GetControlRequest) (*Control, error)
-	// Creates a new Target of Evaluation
-	CreateTargetOfEvaluation(context.Context, *CreateTargetOfEvaluationRequest) (*TargetOfEvaluation, error)
-	// Retrieves a Target of Evaluation
-	GetTargetOfEvaluation(context.Context, *GetTargetOfEvaluationRequest) (*TargetOfEvaluation, error)
-	// Lists all Targets of Evaluation
-	ListTargetsOfEvaluation(context.Context, *ListTargetsOfEvaluationRequest) (*ListTargetsOfEvaluationResponse, error)
-	// Updates an existing Target of Evaluation
-	UpdateTargetOfEvaluation(context.Context, *UpdateTargetOfEvaluationRequest) (*TargetOfEvaluation, error)
-	// Removes a Target of Evaluation
-	RemoveTargetOfEvaluation(context.Context, *RemoveTargetOfEvaluationRequest) (*emptypb.Empty, error)
-	// Get Runtime Information
-	GetRuntimeInfo(context.Context, *runtime.GetRuntimeInfoRequest) (*runtime.Runtime, error)
-	mustEmbedUnimplementedOrchestratorServer()
+func (c *orchestratorClient) GetWebhook(ctx context.Context, in *GetWebhookRequest, opts ...grpc.CallOption) (*Webhook, error) {
+	out := new(Webhook)
+	err := c.cc.Invoke(ctx, Orchestrator_GetWebhook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedOrchestratorServer must be embedded to have forward compatible implementations.
-type UnimplementedOrchestratorServer struct {
+func (c *orchestratorClient) ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*ListWebhooksResponse, error) {
+	out := new(ListWebhooksResponse)
+	err := c.cc.Invoke(ctx, Orchestrator_ListWebhooks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (UnimplementedOrchestratorServer) RegisterAssessmentTool(context.Context, *RegisterAssessmentToolRequest) (*AssessmentTool, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisterAssessmentTool not implemented")
-}
-func (UnimplementedOrchestratorServer) ListAssessmentTools(context.Context, *ListAssessmentToolsRequest) (*ListAssessmentToolsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListAssessmentTools not implemented")
+func (c *orchestratorClient) UpdateWebhook(ctx context.Context, in *UpdateWebhookRequest, opts ...grpc.CallOption) (*Webhook, error) {
+	out := new(Webhook)
+	err := c.cc.Invoke(ctx, Orchestrator_UpdateWebhook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedOrchestratorServer) GetAssessmentTool(context.Context, *GetAssessmentToolRequest) (*AssessmentTool, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAssessmentTool not implemented")
+
+func (c *orchestratorClient) RemoveWebhook(ctx context.Context, in *RemoveWebhookRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Orchestrator_RemoveWebhook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedOrchestratorServer) UpdateAssessmentTool(context.Context, *UpdateAssessmentToolRequest) (*AssessmentTool, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateAssessmentTool not implemented")
+
+func (c *orchestratorClient) CreateNotificationChannel(ctx context.Context, in *CreateNotificationChannelRequest, opts ...grpc.CallOption) (*NotificationChannel, error) {
+	out := new(NotificationChannel)
+	err := c.cc.Invoke(ctx, Orchestrator_CreateNotificationChannel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedOrchestratorServer) DeregisterAssessmentTool(context.Context, *DeregisterAssessmentToolRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeregisterAssessmentTool not implemented")
+
+func (c *orchestratorClient) GetNotificationChannel(ctx context.Context, in *GetNotificationChannelRequest, opts ...grpc.CallOption) (*NotificationChannel, error) {
+	out := new(NotificationChannel)
+	err := c.cc.Invoke(ctx, Orchestrator_GetNotificationChannel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedOrchestratorServer) StoreAssessmentResult(context.Context, *StoreAssessmentResultRequest) (*StoreAssessmentResultResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StoreAssessmentResult not implemented")
+
+func (c *orchestratorClient) ListNotificationChannels(ctx context.Context, in *ListNotificationChannelsRequest, opts ...grpc.CallOption) (*ListNotificationChannelsResponse, error) {
+	out := new(ListNotificationChannelsResponse)
+	err := c.cc.Invoke(ctx, Orchestrator_ListNotificationChannels_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedOrchestratorServer) StoreAssessmentResults(Orchestrator_StoreAssessmentResultsServer) error {
-	return status.Errorf(codes.Unimplemented, "method StoreAssessmentResults not implemented")
+
+func (c *orchestratorClient) UpdateNotificationChannel(ctx context.Context, in *UpdateNotificationChannelRequest, opts ...grpc.CallOption) (*NotificationChannel, error) {
+	out := new(NotificationChannel)
+	err := c.cc.Invoke(ctx, Orchestrator_UpdateNotificationChannel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedOrchestratorServer) GetAssessmentResult(context.Context, *GetAssessmentResultRequest) (*assessment.AssessmentResult, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAssessmentResult not implemented")
+
+func (c *orchestratorClient) RemoveNotificationChannel(ctx context.Context, in *RemoveNotificationChannelRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Orchestrator_RemoveNotificationChannel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedOrchestratorServer) ListAssessmentResults(context.Context, *ListAssessmentResultsRequest) (*ListAssessmentResultsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListAssessmentResults not implemented")
+
+func (c *orchestratorClient) SendNotification(ctx context.Context, in *SendNotificationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Orchestrator_SendNotification_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedOrchestratorServer) CreateMetric(context.Context, *CreateMetricRequest) (*assessment.Metric, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateMetric not implemented")
+
+func (c *orchestratorClient) CreateTicketIntegration(ctx context.Context, in *CreateTicketIntegrationRequest, opts ...grpc.CallOption) (*TicketIntegration, error) {
+	out := new(TicketIntegration)
+	err := c.cc.Invoke(ctx, Orchestrator_CreateTicketIntegration_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedOrchestratorServer) UpdateMetric(context.Context, *UpdateMetricRequest) (*assessment.Metric, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateMetric not implemented")
+
+func (c *orchestratorClient) GetTicketIntegration(ctx context.Context, in *GetTicketIntegrationRequest, opts ...grpc.CallOption) (*TicketIntegration, error) {
+	out := new(TicketIntegration)
+	err := c.cc.Invoke(ctx, Orchestrator_GetTicketIntegration_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedOrchestratorServer) GetMetric(context.Context, *GetMetricRequest) (*assessment.Metric, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetMetric not implemented")
+
+func (c *orchestratorClient) ListTicketIntegrations(ctx context.Context, in *ListTicketIntegrationsRequest, opts ...grpc.CallOption) (*ListTicketIntegrationsResponse, error) {
+	out := new(ListTicketIntegrationsResponse)
+	err := c.cc.Invoke(ctx, Orchestrator_ListTicketIntegrations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedOrchestratorServer) ListMetrics(context.Context, *ListMetricsRequest) (*ListMetricsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListMetrics not implemented")
+
+func (c *orchestratorClient) UpdateTicketIntegration(ctx context.Context, in *UpdateTicketIntegrationRequest, opts ...grpc.CallOption) (*TicketIntegration, error) {
+	out := new(TicketIntegration)
+	err := c.cc.Invoke(ctx, Orchestrator_UpdateTicketIntegration_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedOrchestratorServer) RemoveMetric(context.Context, *RemoveMetricRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveMetric not implemented")
+
+func (c *orchestratorClient) RemoveTicketIntegration(ctx context.Context, in *RemoveTicketIntegrationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Orchestrator_RemoveTicketIntegration_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedOrchestratorServer) RegisterCloudService(context.Context, *RegisterCloudServiceRequest) (*CloudService, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisterCloudService not implemented")
+
+func (c *orchestratorClient) ListTickets(ctx context.Context, in *ListTicketsRequest, opts ...grpc.CallOption) (*ListTicketsResponse, error) {
+	out := new(ListTicketsResponse)
+	err := c.cc.Invoke(ctx, Orchestrator_ListTickets_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedOrchestratorServer) UpdateCloudService(context.Context, *UpdateCloudServiceRequest) (*CloudService, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateCloudService not implemented")
+
+func (c *orchestratorClient) CreateWaiver(ctx context.Context, in *CreateWaiverRequest, opts ...grpc.CallOption) (*Waiver, error) {
+	out := new(Waiver)
+	err := c.cc.Invoke(ctx, Orchestrator_CreateWaiver_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedOrchestratorServer) GetCloudService(context.Context, *GetCloudServiceRequest) (*CloudService, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCloudService not implemented")
+
+func (c *orchestratorClient) GetWaiver(ctx context.Context, in *GetWaiverRequest, opts ...grpc.CallOption) (*Waiver, error) {
+	out := new(Waiver)
+	err := c.cc.Invoke(ctx, Orchestrator_GetWaiver_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedOrchestratorServer) ListCloudServices(context.Context, *ListCloudServicesRequest) (*ListCloudServicesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListCloudServices not implemented")
+
+func (c *orchestratorClient) ListWaivers(ctx context.Context, in *ListWaiversRequest, opts ...grpc.CallOption) (*ListWaiversResponse, error) {
+	out := new(ListWaiversResponse)
+	err := c.cc.Invoke(ctx, Orchestrator_ListWaivers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedOrchestratorServer) RemoveCloudService(context.Context, *RemoveCloudServiceRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveCloudService not implemented")
+
+func (c *orchestratorClient) RemoveWaiver(ctx context.Context, in *RemoveWaiverRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Orchestrator_RemoveWaiver_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedOrchestratorServer) GetCloudServiceStatistics(context.Context, *GetCloudServiceStatisticsRequest) (*GetCloudServiceStatisticsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCloudServiceStatistics not implemented")
+
+func (c *orchestratorClient) CreateOrganization(ctx context.Context, in *CreateOrganizationRequest, opts ...grpc.CallOption) (*Organization, error) {
+	out := new(Organization)
+	err := c.cc.Invoke(ctx, Orchestrator_CreateOrganization_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedOrchestratorServer) UpdateMetricConfiguration(context.Context, *UpdateMetricConfigurationRequest) (*assessment.MetricConfiguration, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateMetricConfiguration not implemented")
+
+func (c *orchestratorClient) GetOrganization(ctx context.Context, in *GetOrganizationRequest, opts ...grpc.CallOption) (*Organization, error) {
+	out := new(Organization)
+	err := c.cc.Invoke(ctx, Orchestrator_GetOrganization_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedOrchestratorServer) GetMetricConfiguration(context.Context, *GetMetricConfigurationRequest) (*assessment.MetricConfiguration, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetMetricConfiguration not implemented")
+
+func (c *orchestratorClient) ListOrganizations(ctx context.Context, in *ListOrganizationsRequest, opts ...grpc.CallOption) (*ListOrganizationsResponse, error) {
+	out := new(ListOrganizationsResponse)
+	err := c.cc.Invoke(ctx, Orchestrator_ListOrganizations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedOrchestratorServer) ListMetricConfigurations(context.Context, *ListMetricConfigurationRequest) (*ListMetricConfigurationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListMetricConfigurations not implemented")
+
+func (c *orchestratorClient) UpdateOrganization(ctx context.Context, in *UpdateOrganizationRequest, opts ...grpc.CallOption) (*Organization, error) {
+	out := new(Organization)
+	err := c.cc.Invoke(ctx, Orchestrator_UpdateOrganization_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedOrchestratorServer) UpdateMetricImplementation(context.Context, *UpdateMetricImplementationRequest) (*assessment.MetricImplementation, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateMetricImplementation not implemented")
+
+func (c *orchestratorClient) RemoveOrganization(ctx context.Context, in *RemoveOrganizationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Orchestrator_RemoveOrganization_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedOrchestratorServer) GetMetricImplementation(context.Context, *GetMetricImplementationRequest) (*assessment.MetricImplementation, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetMetricImplementation not implemented")
+
+func (c *orchestratorClient) CreateRoleAssignment(ctx context.Context, in *CreateRoleAssignmentRequest, opts ...grpc.CallOption) (*RoleAssignment, error) {
+	out := new(RoleAssignment)
+	err := c.cc.Invoke(ctx, Orchestrator_CreateRoleAssignment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedOrchestratorServer) SubscribeMetricChangeEvents(*SubscribeMetricChangeEventRequest, Orchestrator_SubscribeMetricChangeEventsServer) error {
-	return status.Errorf(codes.Unimplemented, "method SubscribeMetricChangeEvents not implemented")
+
+func (c *orchestratorClient) GetRoleAssignment(ctx context.Context, in *GetRoleAssignmentRequest, opts ...grpc.CallOption) (*RoleAssignment, error) {
+	out := new(RoleAssignment)
+	err := c.cc.Invoke(ctx, Orchestrator_GetRoleAssignment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedOrchestratorServer) CreateCertificate(context.Context, *CreateCertificateRequest) (*Certificate, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateCertificate not implemented")
+
+func (c *orchestratorClient) ListRoleAssignments(ctx context.Context, in *ListRoleAssignmentsRequest, opts ...grpc.CallOption) (*ListRoleAssignmentsResponse, error) {
+	out := new(ListRoleAssignmentsResponse)
+	err := c.cc.Invoke(ctx, Orchestrator_ListRoleAssignments_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedOrchestratorServer) GetCertificate(context.Context, *GetCertificateRequest) (*Certificate, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCertificate not implemented")
+
+func (c *orchestratorClient) RemoveRoleAssignment(ctx context.Context, in *RemoveRoleAssignmentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Orchestrator_RemoveRoleAssignment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedOrchestratorServer) ListCertificates(context.Context, *ListCertificatesRequest) (*ListCertificatesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListCertificates not implemented")
+
+func (c *orchestratorClient) CreateApiKey(ctx context.Context, in *CreateApiKeyRequest, opts ...grpc.CallOption) (*CreateApiKeyResponse, error) {
+	out := new(CreateApiKeyResponse)
+	err := c.cc.Invoke(ctx, Orchestrator_CreateApiKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedOrchestratorServer) ListPublicCertificates(context.Context, *ListPublicCertificatesRequest) (*ListPublicCertificatesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListPublicCertificates not implemented")
-}
-func (UnimplementedOrchestratorServer) UpdateCertificate(context.Context, *UpdateCertificateRequest) (*Certificate, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateCertificate not implemented")
+
+func (c *orchestratorClient) GetApiKey(ctx context.Context, in *GetApiKeyRequest, opts ...grpc.CallOption) (*ApiKey, error) {
+	out := new(ApiKey)
+	err := c.cc.Invoke(ctx, Orchestrator_GetApiKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedOrchestratorServer) RemoveCertificate(context.Context, *RemoveCertificateRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveCertificate not implemented")
+
+func (c *orchestratorClient) ListApiKeys(ctx context.Context, in *ListApiKeysRequest, opts ...grpc.CallOption) (*ListApiKeysResponse, error) {
+	out := new(ListApiKeysResponse)
+	err := c.cc.Invoke(ctx, Orchestrator_ListApiKeys_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedOrchestratorServer) CreateCatalog(context.Context, *CreateCatalogRequest) (*Catalog, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateCatalog not implemented")
+
+func (c *orchestratorClient) RotateApiKey(ctx context.Context, in *RotateApiKeyRequest, opts ...grpc.CallOption) (*CreateApiKeyResponse, error) {
+	out := new(CreateApiKeyResponse)
+	err := c.cc.Invoke(ctx, Orchestrator_RotateApiKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedOrchestratorServer) ListCatalogs(context.Context, *ListCatalogsRequest) (*ListCatalogsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListCatalogs not implemented")
+
+func (c *orchestratorClient) RemoveApiKey(ctx context.Context, in *RemoveApiKeyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Orchestrator_RemoveApiKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedOrchestratorServer) GetCatalog(context.Context, *GetCatalogRequest) (*Catalog, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCatalog not implemented")
+
+func (c *orchestratorClient) CreateAuditorGrant(ctx context.Context, in *CreateAuditorGrantRequest, opts ...grpc.CallOption) (*CreateAuditorGrantResponse, error) {
+	out := new(CreateAuditorGrantResponse)
+	err := c.cc.Invoke(ctx, Orchestrator_CreateAuditorGrant_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedOrchestratorServer) RemoveCatalog(context.Context, *RemoveCatalogRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveCatalog not implemented")
+
+func (c *orchestratorClient) GetAuditorGrant(ctx context.Context, in *GetAuditorGrantRequest, opts ...grpc.CallOption) (*AuditorGrant, error) {
+	out := new(AuditorGrant)
+	err := c.cc.Invoke(ctx, Orchestrator_GetAuditorGrant_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedOrchestratorServer) UpdateCatalog(context.Context, *UpdateCatalogRequest) (*Catalog, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateCatalog not implemented")
+
+func (c *orchestratorClient) ListAuditorGrants(ctx context.Context, in *ListAuditorGrantsRequest, opts ...grpc.CallOption) (*ListAuditorGrantsResponse, error) {
+	out := new(ListAuditorGrantsResponse)
+	err := c.cc.Invoke(ctx, Orchestrator_ListAuditorGrants_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedOrchestratorServer) GetCategory(context.Context, *GetCategoryRequest) (*Category, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCategory not implemented")
+
+func (c *orchestratorClient) RevokeAuditorGrant(ctx context.Context, in *RevokeAuditorGrantRequest, opts ...grpc.CallOption) (*AuditorGrant, error) {
+	out := new(AuditorGrant)
+	err := c.cc.Invoke(ctx, Orchestrator_RevokeAuditorGrant_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedOrchestratorServer) ListControls(context.Context, *ListControlsRequest) (*ListControlsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListControls not implemented")
+
+func (c *orchestratorClient) ListAuditLogEntries(ctx context.Context, in *ListAuditLogEntriesRequest, opts ...grpc.CallOption) (*ListAuditLogEntriesResponse, error) {
+	out := new(ListAuditLogEntriesResponse)
+	err := c.cc.Invoke(ctx, Orchestrator_ListAuditLogEntries_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedOrchestratorServer) GetControl(context.Context, *GetControlRequest) (*Control, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetControl not implemented")
+
+func (c *orchestratorClient) ExportAuditLog(ctx context.Context, in *ExportAuditLogRequest, opts ...grpc.CallOption) (*ExportAuditLogResponse, error) {
+	out := new(ExportAuditLogResponse)
+	err := c.cc.Invoke(ctx, Orchestrator_ExportAuditLog_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedOrchestratorServer) CreateTargetOfEvaluation(context.Context, *CreateTargetOfEvaluationRequest) (*TargetOfEvaluation, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateTargetOfEvaluation not implemented")
+
+func (c *orchestratorClient) RegisterAgent(ctx context.Context, in *RegisterAgentRequest, opts ...grpc.CallOption) (*Agent, error) {
+	out := new(Agent)
+	err := c.cc.Invoke(ctx, Orchestrator_RegisterAgent_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedOrchestratorServer) GetTargetOfEvaluation(context.Context, *GetTargetOfEvaluationRequest) (*TargetOfEvaluation, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTargetOfEvaluation not implemented")
+
+func (c *orchestratorClient) SendAgentHeartbeat(ctx context.Context, in *SendAgentHeartbeatRequest, opts ...grpc.CallOption) (*SendAgentHeartbeatResponse, error) {
+	out := new(SendAgentHeartbeatResponse)
+	err := c.cc.Invoke(ctx, Orchestrator_SendAgentHeartbeat_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedOrchestratorServer) ListTargetsOfEvaluation(context.Context, *ListTargetsOfEvaluationRequest) (*ListTargetsOfEvaluationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListTargetsOfEvaluation not implemented")
+
+func (c *orchestratorClient) ListAgents(ctx context.Context, in *ListAgentsRequest, opts ...grpc.CallOption) (*ListAgentsResponse, error) {
+	out := new(ListAgentsResponse)
+	err := c.cc.Invoke(ctx, Orchestrator_ListAgents_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedOrchestratorServer) UpdateTargetOfEvaluation(context.Context, *UpdateTargetOfEvaluationRequest) (*TargetOfEvaluation, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateTargetOfEvaluation not implemented")
+
+func (c *orchestratorClient) UpdateAgentConfiguration(ctx context.Context, in *UpdateAgentConfigurationRequest, opts ...grpc.CallOption) (*Agent, error) {
+	out := new(Agent)
+	err := c.cc.Invoke(ctx, Orchestrator_UpdateAgentConfiguration_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedOrchestratorServer) RemoveTargetOfEvaluation(context.Context, *RemoveTargetOfEvaluationRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveTargetOfEvaluation not implemented")
+
+func (c *orchestratorClient) DeregisterAgent(ctx context.Context, in *DeregisterAgentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Orchestrator_DeregisterAgent_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedOrchestratorServer) GetRuntimeInfo(context.Context, *runtime.GetRuntimeInfoRequest) (*runtime.Runtime, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRuntimeInfo not implemented")
+
+func (c *orchestratorClient) GetRuntimeInfo(ctx context.Context, in *runtime.GetRuntimeInfoRequest, opts ...grpc.CallOption) (*runtime.Runtime, error) {
+	out := new(runtime.Runtime)
+	err := c.cc.Invoke(ctx, Orchestrator_GetRuntimeInfo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedOrchestratorServer) mustEmbedUnimplementedOrchestratorServer() {}
 
-// UnsafeOrchestratorServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to OrchestratorServer will
-// result in compilation errors.
-type UnsafeOrchestratorServer interface {
+// OrchestratorServer is the server API for Orchestrator service.
+// All implementations must embed UnimplementedOrchestratorServer
+// for forward compatibility
+type OrchestratorServer interface {
+	// Registers the passed assessment tool
+	RegisterAssessmentTool(context.Context, *RegisterAssessmentToolRequest) (*AssessmentTool, error)
+	// Lists all assessment tools assessing evidences for the metric given by the
+	// passed metric id
+	ListAssessmentTools(context.Context, *ListAssessmentToolsRequest) (*ListAssessmentToolsResponse, error)
+	// Returns assessment tool given by the passed tool id
+	GetAssessmentTool(context.Context, *GetAssessmentToolRequest) (*AssessmentTool, error)
+	// Updates the assessment tool given by the passed id
+	UpdateAssessmentTool(context.Context, *UpdateAssessmentToolRequest) (*AssessmentTool, error)
+	// Remove assessment tool with passed id from the list of active assessment
+	// tools
+	DeregisterAssessmentTool(context.Context, *DeregisterAssessmentToolRequest) (*emptypb.Empty, error)
+	// Stores the assessment result provided by an assessment tool
+	StoreAssessmentResult(context.Context, *StoreAssessmentResultRequest) (*StoreAssessmentResultResponse, error)
+	// Stores stream of assessment results provided by an assessment tool and
+	// returns a response stream. Part of the public API, not exposed as REST.
+	StoreAssessmentResults(Orchestrator_StoreAssessmentResultsServer) error
+	// Get an assessment result by ID
+	GetAssessmentResult(context.Context, *GetAssessmentResultRequest) (*assessment.AssessmentResult, error)
+	// Retrieves the evidences an assessment result is based on, so that auditors
+	// can verify which data led to the result. The values of the properties used
+	// by the metric are contained in the result itself. Part of the public API,
+	// also exposed as REST.
+	GetAssessmentResultEvidence(context.Context, *GetAssessmentResultEvidenceRequest) (*GetAssessmentResultEvidenceResponse, error)
+	// List all assessment results. Part of the public API, also exposed as REST.
+	ListAssessmentResults(context.Context, *ListAssessmentResultsRequest) (*ListAssessmentResultsResponse, error)
+	// Aggregates the latest assessment results of a single resource, i.e., the
+	// latest result per metric and the number of (non-)compliant results. Part
+	// of the public API, also exposed as REST.
+	GetResourceAssessmentSummary(context.Context, *GetResourceAssessmentSummaryRequest) (*ResourceAssessmentSummary, error)
+	// Aggregates the latest assessment results of a cloud service per resource
+	// type. Part of the public API, also exposed as REST.
+	ListResourceTypeAssessmentSummaries(context.Context, *ListResourceTypeAssessmentSummariesRequest) (*ListResourceTypeAssessmentSummariesResponse, error)
+	// Retrieves statistics about the assessment results of a cloud service, such
+	// as the number of assessed resources, the results per metric and the
+	// compliance ratio. The statistics are updated incrementally whenever results
+	// are stored, so that they do not need to be aggregated on each request.
+	// Part of the public API, also exposed as REST.
+	GetAssessmentStatistics(context.Context, *GetAssessmentStatisticsRequest) (*AssessmentStatistics, error)
+	// Subscribes to a stream of new assessment results, optionally filtered by
+	// cloud service, metric and compliance state. Part of the public API, also
+	// exposed as REST (as a server-sent stream).
+	SubscribeAssessmentResults(*SubscribeAssessmentResultsRequest, Orchestrator_SubscribeAssessmentResultsServer) error
+	// Exports the latest assessment results of a cloud service as OSCAL
+	// assessment-results. The results are mapped to the controls of the
+	// specified catalog, which are reported as findings.
+	ExportOscalAssessmentResults(context.Context, *ExportOscalAssessmentResultsRequest) (*ExportOscalAssessmentResultsResponse, error)
+	// Creates a new metric
+	CreateMetric(context.Context, *CreateMetricRequest) (*assessment.Metric, error)
+	// Updates an existing metric
+	UpdateMetric(context.Context, *UpdateMetricRequest) (*assessment.Metric, error)
+	// Returns the metric with the passed metric id
+	GetMetric(context.Context, *GetMetricRequest) (*assessment.Metric, error)
+	// List all metrics provided by the metric catalog
+	ListMetrics(context.Context, *ListMetricsRequest) (*ListMetricsResponse, error)
+	// Removes a new metric
+	RemoveMetric(context.Context, *RemoveMetricRequest) (*emptypb.Empty, error)
+	// Restores a removed, i.e., deprecated metric
+	RestoreMetric(context.Context, *RestoreMetricRequest) (*assessment.Metric, error)
+	// Publishes a metric that is currently in the draft state. Only published
+	// metrics are used in assessments.
+	PublishMetric(context.Context, *PublishMetricRequest) (*assessment.Metric, error)
+	// Registers a new target cloud service
+	RegisterCloudService(context.Context, *RegisterCloudServiceRequest) (*CloudService, error)
+	// Registers a new target cloud service
+	UpdateCloudService(context.Context, *UpdateCloudServiceRequest) (*CloudService, error)
+	// Retrieves a target cloud service
+	GetCloudService(context.Context, *GetCloudServiceRequest) (*CloudService, error)
+	// Lists all target cloud services
+	ListCloudServices(context.Context, *ListCloudServicesRequest) (*ListCloudServicesResponse, error)
+	// Removes a target cloud service. It can be restored until it is purged.
+	RemoveCloudService(context.Context, *RemoveCloudServiceRequest) (*emptypb.Empty, error)
+	// Restores a removed target cloud service
+	RestoreCloudService(context.Context, *RestoreCloudServiceRequest) (*CloudService, error)
+	// Retrieves target cloud service statistics
+	GetCloudServiceStatistics(context.Context, *GetCloudServiceStatisticsRequest) (*GetCloudServiceStatisticsResponse, error)
+	// Updates a metric configuration (target value and operator) for a specific
+	// service and metric ID
+	UpdateMetricConfiguration(context.Context, *UpdateMetricConfigurationRequest) (*assessment.MetricConfiguration, error)
+	// Retrieves a metric configuration (target value and operator) for a specific
+	// service and metric ID.
+	GetMetricConfiguration(context.Context, *GetMetricConfigurationRequest) (*assessment.MetricConfiguration, error)
+	// Lists all a metric configurations (target value and operator) for a
+	// specific service ID
+	ListMetricConfigurations(context.Context, *ListMetricConfigurationRequest) (*ListMetricConfigurationResponse, error)
+	// Updates an existing metric implementation
+	UpdateMetricImplementation(context.Context, *UpdateMetricImplementationRequest) (*assessment.MetricImplementation, error)
+	// Tests a metric implementation without storing it. The Rego code of the
+	// implementation is compiled and evaluated against the resources of the
+	// supplied sample evidences. Part of the public API, also exposed as REST.
+	TestMetricImplementation(context.Context, *TestMetricImplementationRequest) (*TestMetricImplementationResponse, error)
+	// Returns the metric implementation of the passed metric id
+	GetMetricImplementation(context.Context, *GetMetricImplementationRequest) (*assessment.MetricImplementation, error)
+	// Lists the (immutable) history of the implementation of a metric.
+	ListMetricImplementationRevisions(context.Context, *ListMetricImplementationRevisionsRequest) (*ListMetricImplementationRevisionsResponse, error)
+	// Lists the (immutable) history of the configuration of a metric for a
+	// cloud service.
+	ListMetricConfigurationRevisions(context.Context, *ListMetricConfigurationRevisionsRequest) (*ListMetricConfigurationRevisionsResponse, error)
+	// Disables a metric for a specific cloud service. Disabled metrics are not
+	// assessed and controls that only consist of disabled metrics are evaluated
+	// as not applicable.
+	DisableMetric(context.Context, *DisableMetricRequest) (*DisabledMetric, error)
+	// Re-enables a previously disabled metric for a specific cloud service.
+	EnableMetric(context.Context, *EnableMetricRequest) (*emptypb.Empty, error)
+	// Lists all disabled metrics of a specific cloud service.
+	ListDisabledMetrics(context.Context, *ListDisabledMetricsRequest) (*ListDisabledMetricsResponse, error)
+	SubscribeMetricChangeEvents(*SubscribeMetricChangeEventRequest, Orchestrator_SubscribeMetricChangeEventsServer) error
+	// Creates a new certificate
+	CreateCertificate(context.Context, *CreateCertificateRequest) (*Certificate, error)
+	// Retrieves a certificate
+	GetCertificate(context.Context, *GetCertificateRequest) (*Certificate, error)
+	// Lists all target certificates
+	ListCertificates(context.Context, *ListCertificatesRequest) (*ListCertificatesResponse, error)
+	// Lists all target certificates without state history
+	ListPublicCertificates(context.Context, *ListPublicCertificatesRequest) (*ListPublicCertificatesResponse, error)
+	// Updates an existing certificate
+	UpdateCertificate(context.Context, *UpdateCertificateRequest) (*Certificate, error)
+	// Transitions an existing certificate into a new lifecycle state, e.g., to
+	// suspend or re-issue it
+	UpdateCertificateState(context.Context, *UpdateCertificateStateRequest) (*Certificate, error)
+	// Reports the compliance of a target of evaluation as observed by the
+	// continuous evaluation. The certification lifecycle engine derives state
+	// changes of the affected certificates, i.e., whether they continue, are
+	// suspended or are withdrawn, from the observations within its observation
+	// window.
+	ReportCertificationObservation(context.Context, *ReportCertificationObservationRequest) (*ReportCertificationObservationResponse, error)
+	// Removes a certificate. It can be restored until it is purged.
+	RemoveCertificate(context.Context, *RemoveCertificateRequest) (*emptypb.Empty, error)
+	// Restores a removed certificate
+	RestoreCertificate(context.Context, *RestoreCertificateRequest) (*Certificate, error)
+	// Creates a new security controls catalog
+	CreateCatalog(context.Context, *CreateCatalogRequest) (*Catalog, error)
+	// Lists all security controls catalogs. Each catalog includes a list of its
+	// categories but no additional sub-resources.
+	ListCatalogs(context.Context, *ListCatalogsRequest) (*ListCatalogsResponse, error)
+	// Retrieves a specific catalog by it's ID. The catalog includes a list of all
+	// of it categories as well as the first level of controls in each category.
+	GetCatalog(context.Context, *GetCatalogRequest) (*Catalog, error)
+	// Removes a catalog. It can be restored until it is purged.
+	RemoveCatalog(context.Context, *RemoveCatalogRequest) (*emptypb.Empty, error)
+	// Restores a removed catalog
+	RestoreCatalog(context.Context, *RestoreCatalogRequest) (*Catalog, error)
+	// Updates an existing certificate
+	UpdateCatalog(context.Context, *UpdateCatalogRequest) (*Catalog, error)
+	// Lists all catalogs that are built into Clouditor, e.g., EUCS or BSI C5.
+	// Each catalog only contains its meta-data, such as name and version, but no
+	// categories.
+	ListBuiltinCatalogs(context.Context, *ListBuiltinCatalogsRequest) (*ListBuiltinCatalogsResponse, error)
+	// Loads a built-in catalog into the orchestrator. If the catalog already
+	// exists, it is updated to the built-in version.
+	LoadBuiltinCatalog(context.Context, *LoadBuiltinCatalogRequest) (*Catalog, error)
+	// Imports an OSCAL catalog or profile as a new catalog. Since a profile
+	// only contains a selection of controls, it is resolved against an already
+	// existing catalog, specified by the base catalog ID.
+	ImportOscalCatalog(context.Context, *ImportOscalCatalogRequest) (*Catalog, error)
+	// Retrieves a category of a catalog specified by the catalog ID and the
+	// category name. It includes the first level of controls within each
+	// category.
+	GetCategory(context.Context, *GetCategoryRequest) (*Category, error)
+	// If no additional parameters are specified, this lists all controls. If a
+	// catalog ID and a category name is specified, then only controls containing
+	// in this category are returned.
+	ListControls(context.Context, *ListControlsRequest) (*ListControlsResponse, error)
+	// Retrieves a control specified by the catalog ID, the control's category
+	// name and the control ID. If present, it also includes a list of
+	// sub-controls if present or a list of metrics if no sub-controls but metrics
+	// are present.
+	GetControl(context.Context, *GetControlRequest) (*Control, error)
+	// Maps a metric to a control, i.e., the metric is used to assess the
+	// control. Returns the control including its metrics.
+	AddControlMetric(context.Context, *AddControlMetricRequest) (*Control, error)
+	// Removes the mapping of a metric to a control
+	RemoveControlMetric(context.Context, *RemoveControlMetricRequest) (*emptypb.Empty, error)
+	// Reports which controls of a catalog are covered by metrics. Controls
+	// without any mapped metric, neither directly nor through one of their
+	// sub-controls, are gaps in the assessment. Part of the public API, also
+	// exposed as REST.
+	GetCatalogCoverage(context.Context, *GetCatalogCoverageRequest) (*CatalogCoverage, error)
+	// Sets the strategy that specifies how the results of the sub-controls of a
+	// control are combined during the evaluation. If no strategy is given, the
+	// strategy of the catalog is used. Returns the updated control.
+	SetControlAggregationStrategy(context.Context, *SetControlAggregationStrategyRequest) (*Control, error)
+	// Creates a new Target of Evaluation
+	CreateTargetOfEvaluation(context.Context, *CreateTargetOfEvaluationRequest) (*TargetOfEvaluation, error)
+	// Retrieves a Target of Evaluation
+	GetTargetOfEvaluation(context.Context, *GetTargetOfEvaluationRequest) (*TargetOfEvaluation, error)
+	// Lists all Targets of Evaluation
+	ListTargetsOfEvaluation(context.Context, *ListTargetsOfEvaluationRequest) (*ListTargetsOfEvaluationResponse, error)
+	// Updates an existing Target of Evaluation
+	UpdateTargetOfEvaluation(context.Context, *UpdateTargetOfEvaluationRequest) (*TargetOfEvaluation, error)
+	// Removes a Target of Evaluation
+	RemoveTargetOfEvaluation(context.Context, *RemoveTargetOfEvaluationRequest) (*emptypb.Empty, error)
+	// Creates a new webhook, which is notified about events of a cloud service
+	CreateWebhook(context.Context, *CreateWebhookRequest) (*Webhook, error)
+	// Retrieves a webhook. The secret of the webhook is never returned.
+	GetWebhook(context.Context, *GetWebhookRequest) (*Webhook, error)
+	// Lists all webhooks. The secrets of the webhooks are never returned.
+	ListWebhooks(context.Context, *ListWebhooksRequest) (*ListWebhooksResponse, error)
+	// Updates an existing webhook. If no secret is specified, the existing
+	// secret is kept.
+	UpdateWebhook(context.Context, *UpdateWebhookRequest) (*Webhook, error)
+	// Removes a webhook
+	RemoveWebhook(context.Context, *RemoveWebhookRequest) (*emptypb.Empty, error)
+	// Creates a new notification channel, e.g., a Slack or Microsoft Teams
+	// webhook or a list of email recipients
+	CreateNotificationChannel(context.Context, *CreateNotificationChannelRequest) (*NotificationChannel, error)
+	// Retrieves a notification channel
+	GetNotificationChannel(context.Context, *GetNotificationChannelRequest) (*NotificationChannel, error)
+	// Lists all notification channels
+	ListNotificationChannels(context.Context, *ListNotificationChannelsRequest) (*ListNotificationChannelsResponse, error)
+	// Updates an existing notification channel
+	UpdateNotificationChannel(context.Context, *UpdateNotificationChannelRequest) (*NotificationChannel, error)
+	// Removes a notification channel
+	RemoveNotificationChannel(context.Context, *RemoveNotificationChannelRequest) (*emptypb.Empty, error)
+	// Sends a notification to all enabled notification channels of the cloud
+	// service whose severity threshold is met. This is used by the evaluation
+	// to inform about controls that became non-compliant.
+	SendNotification(context.Context, *SendNotificationRequest) (*emptypb.Empty, error)
+	// Creates a new ticket integration, which opens tickets in Jira or
+	// ServiceNow for persistent non-compliant assessment results
+	CreateTicketIntegration(context.Context, *CreateTicketIntegrationRequest) (*TicketIntegration, error)
+	// Retrieves a ticket integration. The token of the integration is never
+	// returned.
+	GetTicketIntegration(context.Context, *GetTicketIntegrationRequest) (*TicketIntegration, error)
+	// Lists all ticket integrations. The tokens of the integrations are never
+	// returned.
+	ListTicketIntegrations(context.Context, *ListTicketIntegrationsRequest) (*ListTicketIntegrationsResponse, error)
+	// Updates an existing ticket integration. If no token is specified, the
+	// existing token is kept.
+	UpdateTicketIntegration(context.Context, *UpdateTicketIntegrationRequest) (*TicketIntegration, error)
+	// Removes a ticket integration and all of its tickets. The tickets in the
+	// external system are left untouched.
+	RemoveTicketIntegration(context.Context, *RemoveTicketIntegrationRequest) (*emptypb.Empty, error)
+	// Lists all tickets that were opened by ticket integrations
+	ListTickets(context.Context, *ListTicketsRequest) (*ListTicketsResponse, error)
+	// Creates a new waiver, which accepts the risk of a non-compliant resource
+	// regarding a metric until it expires. This requires the waiver role, if
+	// one is configured.
+	CreateWaiver(context.Context, *CreateWaiverRequest) (*Waiver, error)
+	// Retrieves a waiver
+	GetWaiver(context.Context, *GetWaiverRequest) (*Waiver, error)
+	// Lists all waivers
+	ListWaivers(context.Context, *ListWaiversRequest) (*ListWaiversResponse, error)
+	// Removes (revokes) a waiver. This requires the waiver role, if one is
+	// configured.
+	RemoveWaiver(context.Context, *RemoveWaiverRequest) (*emptypb.Empty, error)
+	// Creates a new organization (tenant). This requires access to all cloud
+	// services.
+	CreateOrganization(context.Context, *CreateOrganizationRequest) (*Organization, error)
+	// Retrieves an organization
+	GetOrganization(context.Context, *GetOrganizationRequest) (*Organization, error)
+	// Lists all organizations the current user is a member of
+	ListOrganizations(context.Context, *ListOrganizationsRequest) (*ListOrganizationsResponse, error)
+	// Updates an organization. This requires access to all cloud services.
+	UpdateOrganization(context.Context, *UpdateOrganizationRequest) (*Organization, error)
+	// Removes an organization. This requires access to all cloud services and
+	// the organization must not contain any cloud services anymore.
+	RemoveOrganization(context.Context, *RemoveOrganizationRequest) (*emptypb.Empty, error)
+	// Creates a new role assignment, which grants a role to a user, either for
+	// all cloud services or only for a specific one. This requires the admin
+	// role for the cloud service of the role assignment.
+	CreateRoleAssignment(context.Context, *CreateRoleAssignmentRequest) (*RoleAssignment, error)
+	// Retrieves a role assignment
+	GetRoleAssignment(context.Context, *GetRoleAssignmentRequest) (*RoleAssignment, error)
+	// Lists all role assignments
+	ListRoleAssignments(context.Context, *ListRoleAssignmentsRequest) (*ListRoleAssignmentsResponse, error)
+	// Removes a role assignment. This requires the admin role for the cloud
+	// service of the role assignment.
+	RemoveRoleAssignment(context.Context, *RemoveRoleAssignmentRequest) (*emptypb.Empty, error)
+	// Creates a new API key, which allows a machine collector to submit
+	// evidences for specific cloud services without a full OAuth 2.0 flow. The
+	// key itself is only returned once. This requires the admin role for all
+	// cloud services of the API key.
+	CreateApiKey(context.Context, *CreateApiKeyRequest) (*CreateApiKeyResponse, error)
+	// Retrieves an API key (without the key itself)
+	GetApiKey(context.Context, *GetApiKeyRequest) (*ApiKey, error)
+	// Lists all API keys (without the keys themselves)
+	ListApiKeys(context.Context, *ListApiKeysRequest) (*ListApiKeysResponse, error)
+	// Rotates an API key, i.e., it issues a new key and invalidates the old
+	// one. This requires the admin role for all cloud services of the API key.
+	RotateApiKey(context.Context, *RotateApiKeyRequest) (*CreateApiKeyResponse, error)
+	// Removes (revokes) an API key. This requires the admin role for all cloud
+	// services of the API key.
+	RemoveApiKey(context.Context, *RemoveApiKeyRequest) (*emptypb.Empty, error)
+	// Creates a new auditor grant, which gives an external auditor time-limited,
+	// read-only access to a cloud service and a catalog. The token of the grant
+	// is only returned once. This requires the admin role for the cloud service.
+	CreateAuditorGrant(context.Context, *CreateAuditorGrantRequest) (*CreateAuditorGrantResponse, error)
+	// Retrieves an auditor grant (without its token)
+	GetAuditorGrant(context.Context, *GetAuditorGrantRequest) (*AuditorGrant, error)
+	// Lists all auditor grants (without their tokens)
+	ListAuditorGrants(context.Context, *ListAuditorGrantsRequest) (*ListAuditorGrantsResponse, error)
+	// Revokes an auditor grant before it expires. This requires the admin role
+	// for the cloud service of the grant.
+	RevokeAuditorGrant(context.Context, *RevokeAuditorGrantRequest) (*AuditorGrant, error)
+	// Lists all entries of the audit log, which records who called which RPC
+	// with which outcome. Only entries of the cloud services the user has
+	// access to are listed, unless the user has access to all cloud services.
+	ListAuditLogEntries(context.Context, *ListAuditLogEntriesRequest) (*ListAuditLogEntriesResponse, error)
+	// Exports the entries of the audit log as JSON or CSV.
+	ExportAuditLog(context.Context, *ExportAuditLogRequest) (*ExportAuditLogResponse, error)
+	// Registers an external evidence collector (agent) for a cloud service. If
+	// an agent with the same ID is already registered, its registration is
+	// updated but its configuration is kept. The agent receives its
+	// configuration (intervals, scopes) in the response.
+	RegisterAgent(context.Context, *RegisterAgentRequest) (*Agent, error)
+	// Records a heartbeat of an agent, which signals that the agent is still
+	// alive. The agent receives its current configuration in the response, so
+	// that configuration changes are picked up with the next heartbeat.
+	SendAgentHeartbeat(context.Context, *SendAgentHeartbeatRequest) (*SendAgentHeartbeatResponse, error)
+	// Lists all registered agents together with their health, which is derived
+	// from their last heartbeat
+	ListAgents(context.Context, *ListAgentsRequest) (*ListAgentsResponse, error)
+	// Updates the configuration of an agent. The agent receives the new
+	// configuration with its next heartbeat.
+	UpdateAgentConfiguration(context.Context, *UpdateAgentConfigurationRequest) (*Agent, error)
+	// Deregisters an agent
+	DeregisterAgent(context.Context, *DeregisterAgentRequest) (*emptypb.Empty, error)
+	// Get Runtime Information
+	GetRuntimeInfo(context.Context, *runtime.GetRuntimeInfoRequest) (*runtime.Runtime, error)
 	mustEmbedUnimplementedOrchestratorServer()
 }
 
-func RegisterOrchestratorServer(s grpc.ServiceRegistrar, srv OrchestratorServer) {
-	s.RegisterService(&Orchestrator_ServiceDesc, srv)
+// UnimplementedOrchestratorServer must be embedded to have forward compatible implementations.
+type UnimplementedOrchestratorServer struct {
+}
+
+func (UnimplementedOrchestratorServer) RegisterAssessmentTool(context.Context, *RegisterAssessmentToolRequest) (*AssessmentTool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterAssessmentTool not implemented")
+}
+func (UnimplementedOrchestratorServer) ListAssessmentTools(context.Context, *ListAssessmentToolsRequest) (*ListAssessmentToolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAssessmentTools not implemented")
+}
+func (UnimplementedOrchestratorServer) GetAssessmentTool(context.Context, *GetAssessmentToolRequest) (*AssessmentTool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAssessmentTool not implemented")
+}
+func (UnimplementedOrchestratorServer) UpdateAssessmentTool(context.Context, *UpdateAssessmentToolRequest) (*AssessmentTool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAssessmentTool not implemented")
+}
+func (UnimplementedOrchestratorServer) DeregisterAssessmentTool(context.Context, *DeregisterAssessmentToolRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeregisterAssessmentTool not implemented")
+}
+func (UnimplementedOrchestratorServer) StoreAssessmentResult(context.Context, *StoreAssessmentResultRequest) (*StoreAssessmentResultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StoreAssessmentResult not implemented")
+}
+func (UnimplementedOrchestratorServer) StoreAssessmentResults(Orchestrator_StoreAssessmentResultsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StoreAssessmentResults not implemented")
+}
+func (UnimplementedOrchestratorServer) GetAssessmentResult(context.Context, *GetAssessmentResultRequest) (*assessment.AssessmentResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAssessmentResult not implemented")
+}
+func (UnimplementedOrchestratorServer) GetAssessmentResultEvidence(context.Context, *GetAssessmentResultEvidenceRequest) (*GetAssessmentResultEvidenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAssessmentResultEvidence not implemented")
+}
+func (UnimplementedOrchestratorServer) ListAssessmentResults(context.Context, *ListAssessmentResultsRequest) (*ListAssessmentResultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAssessmentResults not implemented")
+}
+func (UnimplementedOrchestratorServer) GetResourceAssessmentSummary(context.Context, *GetResourceAssessmentSummaryRequest) (*ResourceAssessmentSummary, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetResourceAssessmentSummary not implemented")
+}
+func (UnimplementedOrchestratorServer) ListResourceTypeAssessmentSummaries(context.Context, *ListResourceTypeAssessmentSummariesRequest) (*ListResourceTypeAssessmentSummariesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListResourceTypeAssessmentSummaries not implemented")
+}
+func (UnimplementedOrchestratorServer) GetAssessmentStatistics(context.Context, *GetAssessmentStatisticsRequest) (*AssessmentStatistics, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAssessmentStatistics not implemented")
+}
+func (UnimplementedOrchestratorServer) SubscribeAssessmentResults(*SubscribeAssessmentResultsRequest, Orchestrator_SubscribeAssessmentResultsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeAssessmentResults not implemented")
+}
+func (UnimplementedOrchestratorServer) ExportOscalAssessmentResults(context.Context, *ExportOscalAssessmentResultsRequest) (*ExportOscalAssessmentResultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportOscalAssessmentResults not implemented")
+}
+func (UnimplementedOrchestratorServer) CreateMetric(context.Context, *CreateMetricRequest) (*assessment.Metric, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMetric not implemented")
+}
+func (UnimplementedOrchestratorServer) UpdateMetric(context.Context, *UpdateMetricRequest) (*assessment.Metric, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMetric not implemented")
+}
+func (UnimplementedOrchestratorServer) GetMetric(context.Context, *GetMetricRequest) (*assessment.Metric, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMetric not implemented")
+}
+func (UnimplementedOrchestratorServer) ListMetrics(context.Context, *ListMetricsRequest) (*ListMetricsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMetrics not implemented")
+}
+func (UnimplementedOrchestratorServer) RemoveMetric(context.Context, *RemoveMetricRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveMetric not implemented")
+}
+func (UnimplementedOrchestratorServer) RestoreMetric(context.Context, *RestoreMetricRequest) (*assessment.Metric, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreMetric not implemented")
+}
+func (UnimplementedOrchestratorServer) PublishMetric(context.Context, *PublishMetricRequest) (*assessment.Metric, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishMetric not implemented")
+}
+func (UnimplementedOrchestratorServer) RegisterCloudService(context.Context, *RegisterCloudServiceRequest) (*CloudService, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterCloudService not implemented")
+}
+func (UnimplementedOrchestratorServer) UpdateCloudService(context.Context, *UpdateCloudServiceRequest) (*CloudService, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCloudService not implemented")
+}
+func (UnimplementedOrchestratorServer) GetCloudService(context.Context, *GetCloudServiceRequest) (*CloudService, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCloudService not implemented")
+}
+func (UnimplementedOrchestratorServer) ListCloudServices(context.Context, *ListCloudServicesRequest) (*ListCloudServicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCloudServices not implemented")
+}
+func (UnimplementedOrchestratorServer) RemoveCloudService(context.Context, *RemoveCloudServiceRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveCloudService not implemented")
+}
+func (UnimplementedOrchestratorServer) RestoreCloudService(context.Context, *RestoreCloudServiceRequest) (*CloudService, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreCloudService not implemented")
+}
+func (UnimplementedOrchestratorServer) GetCloudServiceStatistics(context.Context, *GetCloudServiceStatisticsRequest) (*GetCloudServiceStatisticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCloudServiceStatistics not implemented")
+}
+func (UnimplementedOrchestratorServer) UpdateMetricConfiguration(context.Context, *UpdateMetricConfigurationRequest) (*assessment.MetricConfiguration, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMetricConfiguration not implemented")
+}
+func (UnimplementedOrchestratorServer) GetMetricConfiguration(context.Context, *GetMetricConfigurationRequest) (*assessment.MetricConfiguration, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMetricConfiguration not implemented")
+}
+func (UnimplementedOrchestratorServer) ListMetricConfigurations(context.Context, *ListMetricConfigurationRequest) (*ListMetricConfigurationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMetricConfigurations not implemented")
+}
+func (UnimplementedOrchestratorServer) UpdateMetricImplementation(context.Context, *UpdateMetricImplementationRequest) (*assessment.MetricImplementation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMetricImplementation not implemented")
+}
+func (UnimplementedOrchestratorServer) TestMetricImplementation(context.Context, *TestMetricImplementationRequest) (*TestMetricImplementationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TestMetricImplementation not implemented")
+}
+func (UnimplementedOrchestratorServer) GetMetricImplementation(context.Context, *GetMetricImplementationRequest) (*assessment.MetricImplementation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMetricImplementation not implemented")
+}
+func (UnimplementedOrchestratorServer) ListMetricImplementationRevisions(context.Context, *ListMetricImplementationRevisionsRequest) (*ListMetricImplementationRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMetricImplementationRevisions not implemented")
+}
+func (UnimplementedOrchestratorServer) ListMetricConfigurationRevisions(context.Context, *ListMetricConfigurationRevisionsRequest) (*ListMetricConfigurationRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMetricConfigurationRevisions not implemented")
+}
+func (UnimplementedOrchestratorServer) DisableMetric(context.Context, *DisableMetricRequest) (*DisabledMetric, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableMetric not implemented")
+}
+func (UnimplementedOrchestratorServer) EnableMetric(context.Context, *EnableMetricRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableMetric not implemented")
+}
+func (UnimplementedOrchestratorServer) ListDisabledMetrics(context.Context, *ListDisabledMetricsRequest) (*ListDisabledMetricsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDisabledMetrics not implemented")
+}
+func (UnimplementedOrchestratorServer) SubscribeMetricChangeEvents(*SubscribeMetricChangeEventRequest, Orchestrator_SubscribeMetricChangeEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeMetricChangeEvents not implemented")
+}
+func (UnimplementedOrchestratorServer) CreateCertificate(context.Context, *CreateCertificateRequest) (*Certificate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCertificate not implemented")
+}
+func (UnimplementedOrchestratorServer) GetCertificate(context.Context, *GetCertificateRequest) (*Certificate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCertificate not implemented")
+}
+func (UnimplementedOrchestratorServer) ListCertificates(context.Context, *ListCertificatesRequest) (*ListCertificatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCertificates not implemented")
+}
+func (UnimplementedOrchestratorServer) ListPublicCertificates(context.Context, *ListPublicCertificatesRequest) (*ListPublicCertificatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPublicCertificates not implemented")
+}
+func (UnimplementedOrchestratorServer) UpdateCertificate(context.Context, *UpdateCertificateRequest) (*Certificate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCertificate not implemented")
+}
+func (UnimplementedOrchestratorServer) UpdateCertificateState(context.Context, *UpdateCertificateStateRequest) (*Certificate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCertificateState not implemented")
+}
+func (UnimplementedOrchestratorServer) ReportCertificationObservation(context.Context, *ReportCertificationObservationRequest) (*ReportCertificationObservationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportCertificationObservation not implemented")
+}
+func (UnimplementedOrchestratorServer) RemoveCertificate(context.Context, *RemoveCertificateRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveCertificate not implemented")
+}
+func (UnimplementedOrchestratorServer) RestoreCertificate(context.Context, *RestoreCertificateRequest) (*Certificate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreCertificate not implemented")
+}
+func (UnimplementedOrchestratorServer) CreateCatalog(context.Context, *CreateCatalogRequest) (*Catalog, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCatalog not implemented")
+}
+func (UnimplementedOrchestratorServer) ListCatalogs(context.Context, *ListCatalogsRequest) (*ListCatalogsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCatalogs not implemented")
+}
+func (UnimplementedOrchestratorServer) GetCatalog(context.Context, *GetCatalogRequest) (*Catalog, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCatalog not implemented")
+}
+func (UnimplementedOrchestratorServer) RemoveCatalog(context.Context, *RemoveCatalogRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveCatalog not implemented")
+}
+func (UnimplementedOrchestratorServer) RestoreCatalog(context.Context, *RestoreCatalogRequest) (*Catalog, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreCatalog not implemented")
+}
+func (UnimplementedOrchestratorServer) UpdateCatalog(context.Context, *UpdateCatalogRequest) (*Catalog, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCatalog not implemented")
+}
+func (UnimplementedOrchestratorServer) ListBuiltinCatalogs(context.Context, *ListBuiltinCatalogsRequest) (*ListBuiltinCatalogsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBuiltinCatalogs not implemented")
+}
+func (UnimplementedOrchestratorServer) LoadBuiltinCatalog(context.Context, *LoadBuiltinCatalogRequest) (*Catalog, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoadBuiltinCatalog not implemented")
+}
+func (UnimplementedOrchestratorServer) ImportOscalCatalog(context.Context, *ImportOscalCatalogRequest) (*Catalog, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportOscalCatalog not implemented")
+}
+func (UnimplementedOrchestratorServer) GetCategory(context.Context, *GetCategoryRequest) (*Category, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCategory not implemented")
+}
+func (UnimplementedOrchestratorServer) ListControls(context.Context, *ListControlsRequest) (*ListControlsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListControls not implemented")
+}
+func (UnimplementedOrchestratorServer) GetControl(context.Context, *GetControlRequest) (*Control, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetControl not implemented")
+}
+func (UnimplementedOrchestratorServer) AddControlMetric(context.Context, *AddControlMetricRequest) (*Control, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddControlMetric not implemented")
+}
+func (UnimplementedOrchestratorServer) RemoveControlMetric(context.Context, *RemoveControlMetricRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveControlMetric not implemented")
+}
+func (UnimplementedOrchestratorServer) GetCatalogCoverage(context.Context, *GetCatalogCoverageRequest) (*CatalogCoverage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCatalogCoverage not implemented")
+}
+func (UnimplementedOrchestratorServer) SetControlAggregationStrategy(context.Context, *SetControlAggregationStrategyRequest) (*Control, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetControlAggregationStrategy not implemented")
+}
+func (UnimplementedOrchestratorServer) CreateTargetOfEvaluation(context.Context, *CreateTargetOfEvaluationRequest) (*TargetOfEvaluation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTargetOfEvaluation not implemented")
+}
+func (UnimplementedOrchestratorServer) GetTargetOfEvaluation(context.Context, *GetTargetOfEvaluationRequest) (*TargetOfEvaluation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTargetOfEvaluation not implemented")
+}
+func (UnimplementedOrchestratorServer) ListTargetsOfEvaluation(context.Context, *ListTargetsOfEvaluationRequest) (*ListTargetsOfEvaluationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTargetsOfEvaluation not implemented")
+}
+func (UnimplementedOrchestratorServer) UpdateTargetOfEvaluation(context.Context, *UpdateTargetOfEvaluationRequest) (*TargetOfEvaluation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTargetOfEvaluation not implemented")
+}
+func (UnimplementedOrchestratorServer) RemoveTargetOfEvaluation(context.Context, *RemoveTargetOfEvaluationRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveTargetOfEvaluation not implemented")
+}
+func (UnimplementedOrchestratorServer) CreateWebhook(context.Context, *CreateWebhookRequest) (*Webhook, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWebhook not implemented")
+}
+func (UnimplementedOrchestratorServer) GetWebhook(context.Context, *GetWebhookRequest) (*Webhook, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWebhook not implemented")
+}
+func (UnimplementedOrchestratorServer) ListWebhooks(context.Context, *ListWebhooksRequest) (*ListWebhooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhooks not implemented")
+}
+func (UnimplementedOrchestratorServer) UpdateWebhook(context.Context, *UpdateWebhookRequest) (*Webhook, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWebhook not implemented")
+}
+func (UnimplementedOrchestratorServer) RemoveWebhook(context.Context, *RemoveWebhookRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveWebhook not implemented")
+}
+func (UnimplementedOrchestratorServer) CreateNotificationChannel(context.Context, *CreateNotificationChannelRequest) (*NotificationChannel, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateNotificationChannel not implemented")
+}
+func (UnimplementedOrchestratorServer) GetNotificationChannel(context.Context, *GetNotificationChannelRequest) (*NotificationChannel, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNotificationChannel not implemented")
+}
+func (UnimplementedOrchestratorServer) ListNotificationChannels(context.Context, *ListNotificationChannelsRequest) (*ListNotificationChannelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNotificationChannels not implemented")
+}
+func (UnimplementedOrchestratorServer) UpdateNotificationChannel(context.Context, *UpdateNotificationChannelRequest) (*NotificationChannel, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNotificationChannel not implemented")
+}
+func (UnimplementedOrchestratorServer) RemoveNotificationChannel(context.Context, *RemoveNotificationChannelRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveNotificationChannel not implemented")
+}
+func (UnimplementedOrchestratorServer) SendNotification(context.Context, *SendNotificationRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendNotification not implemented")
+}
+func (UnimplementedOrchestratorServer) CreateTicketIntegration(context.Context, *CreateTicketIntegrationRequest) (*TicketIntegration, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTicketIntegration not implemented")
+}
+func (UnimplementedOrchestratorServer) GetTicketIntegration(context.Context, *GetTicketIntegrationRequest) (*TicketIntegration, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTicketIntegration not implemented")
+}
+func (UnimplementedOrchestratorServer) ListTicketIntegrations(context.Context, *ListTicketIntegrationsRequest) (*ListTicketIntegrationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTicketIntegrations not implemented")
+}
+func (UnimplementedOrchestratorServer) UpdateTicketIntegration(context.Context, *UpdateTicketIntegrationRequest) (*TicketIntegration, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTicketIntegration not implemented")
+}
+func (UnimplementedOrchestratorServer) RemoveTicketIntegration(context.Context, *RemoveTicketIntegrationRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveTicketIntegration not implemented")
+}
+func (UnimplementedOrchestratorServer) ListTickets(context.Context, *ListTicketsRequest) (*ListTicketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTickets not implemented")
+}
+func (UnimplementedOrchestratorServer) CreateWaiver(context.Context, *CreateWaiverRequest) (*Waiver, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWaiver not implemented")
+}
+func (UnimplementedOrchestratorServer) GetWaiver(context.Context, *GetWaiverRequest) (*Waiver, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWaiver not implemented")
+}
+func (UnimplementedOrchestratorServer) ListWaivers(context.Context, *ListWaiversRequest) (*ListWaiversResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWaivers not implemented")
+}
+func (UnimplementedOrchestratorServer) RemoveWaiver(context.Context, *RemoveWaiverRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveWaiver not implemented")
+}
+func (UnimplementedOrchestratorServer) CreateOrganization(context.Context, *CreateOrganizationRequest) (*Organization, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOrganization not implemented")
+}
+func (UnimplementedOrchestratorServer) GetOrganization(context.Context, *GetOrganizationRequest) (*Organization, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrganization not implemented")
+}
+func (UnimplementedOrchestratorServer) ListOrganizations(context.Context, *ListOrganizationsRequest) (*ListOrganizationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOrganizations not implemented")
+}
+func (UnimplementedOrchestratorServer) UpdateOrganization(context.Context, *UpdateOrganizationRequest) (*Organization, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOrganization not implemented")
+}
+func (UnimplementedOrchestratorServer) RemoveOrganization(context.Context, *RemoveOrganizationRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveOrganization not implemented")
+}
+func (UnimplementedOrchestratorServer) CreateRoleAssignment(context.Context, *CreateRoleAssignmentRequest) (*RoleAssignment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRoleAssignment not implemented")
+}
+func (UnimplementedOrchestratorServer) GetRoleAssignment(context.Context, *GetRoleAssignmentRequest) (*RoleAssignment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRoleAssignment not implemented")
+}
+func (UnimplementedOrchestratorServer) ListRoleAssignments(context.Context, *ListRoleAssignmentsRequest) (*ListRoleAssignmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRoleAssignments not implemented")
+}
+func (UnimplementedOrchestratorServer) RemoveRoleAssignment(context.Context, *RemoveRoleAssignmentRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveRoleAssignment not implemented")
+}
+func (UnimplementedOrchestratorServer) CreateApiKey(context.Context, *CreateApiKeyRequest) (*CreateApiKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateApiKey not implemented")
+}
+func (UnimplementedOrchestratorServer) GetApiKey(context.Context, *GetApiKeyRequest) (*ApiKey, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetApiKey not implemented")
+}
+func (UnimplementedOrchestratorServer) ListApiKeys(context.Context, *ListApiKeysRequest) (*ListApiKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListApiKeys not implemented")
+}
+func (UnimplementedOrchestratorServer) RotateApiKey(context.Context, *RotateApiKeyRequest) (*CreateApiKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateApiKey not implemented")
+}
+func (UnimplementedOrchestratorServer) RemoveApiKey(context.Context, *RemoveApiKeyRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveApiKey not implemented")
+}
+func (UnimplementedOrchestratorServer) CreateAuditorGrant(context.Context, *CreateAuditorGrantRequest) (*CreateAuditorGrantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAuditorGrant not implemented")
+}
+func (UnimplementedOrchestratorServer) GetAuditorGrant(context.Context, *GetAuditorGrantRequest) (*AuditorGrant, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuditorGrant not implemented")
+}
+func (UnimplementedOrchestratorServer) ListAuditorGrants(context.Context, *ListAuditorGrantsRequest) (*ListAuditorGrantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditorGrants not implemented")
+}
+func (UnimplementedOrchestratorServer) RevokeAuditorGrant(context.Context, *RevokeAuditorGrantRequest) (*AuditorGrant, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAuditorGrant not implemented")
+}
+func (UnimplementedOrchestratorServer) ListAuditLogEntries(context.Context, *ListAuditLogEntriesRequest) (*ListAuditLogEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditLogEntries not implemented")
+}
+func (UnimplementedOrchestratorServer) ExportAuditLog(context.Context, *ExportAuditLogRequest) (*ExportAuditLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportAuditLog not implemented")
+}
+func (UnimplementedOrchestratorServer) RegisterAgent(context.Context, *RegisterAgentRequest) (*Agent, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterAgent not implemented")
+}
+func (UnimplementedOrchestratorServer) SendAgentHeartbeat(context.Context, *SendAgentHeartbeatRequest) (*SendAgentHeartbeatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendAgentHeartbeat not implemented")
+}
+func (UnimplementedOrchestratorServer) ListAgents(context.Context, *ListAgentsRequest) (*ListAgentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAgents not implemented")
+}
+func (UnimplementedOrchestratorServer) UpdateAgentConfiguration(context.Context, *UpdateAgentConfigurationRequest) (*Agent, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAgentConfiguration not implemented")
+}
+func (UnimplementedOrchestratorServer) DeregisterAgent(context.Context, *DeregisterAgentRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeregisterAgent not implemented")
+}
+func (UnimplementedOrchestratorServer) GetRuntimeInfo(context.Context, *runtime.GetRuntimeInfoRequest) (*runtime.Runtime, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRuntimeInfo not implemented")
+}
+func (UnimplementedOrchestratorServer) mustEmbedUnimplementedOrchestratorServer() {}
+
+// UnsafeOrchestratorServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to OrchestratorServer will
+// result in compilation errors.
+type UnsafeOrchestratorServer interface {
+	mustEmbedUnimplementedOrchestratorServer()
+}
+
+func RegisterOrchestratorServer(s grpc.ServiceRegistrar, srv OrchestratorServer) {
+	s.RegisterService(&Orchestrator_ServiceDesc, srv)
+}
+
+func _Orchestrator_RegisterAssessmentTool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterAssessmentToolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServer).RegisterAssessmentTool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Orchestrator_RegisterAssessmentTool_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServer).RegisterAssessmentTool(ctx, req.(*RegisterAssessmentToolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Orchestrator_ListAssessmentTools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAssessmentToolsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServer).ListAssessmentTools(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Orchestrator_ListAssessmentTools_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServer).ListAssessmentTools(ctx, req.(*ListAssessmentToolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Orchestrator_GetAssessmentTool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAssessmentToolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServer).GetAssessmentTool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Orchestrator_GetAssessmentTool_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServer).GetAssessmentTool(ctx, req.(*GetAssessmentToolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Orchestrator_UpdateAssessmentTool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAssessmentToolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServer).UpdateAssessmentTool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Orchestrator_UpdateAssessmentTool_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServer).UpdateAssessmentTool(ctx, req.(*UpdateAssessmentToolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Orchestrator_DeregisterAssessmentTool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeregisterAssessmentToolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServer).DeregisterAssessmentTool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Orchestrator_DeregisterAssessmentTool_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServer).DeregisterAssessmentTool(ctx, req.(*DeregisterAssessmentToolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Orchestrator_StoreAssessmentResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StoreAssessmentResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServer).StoreAssessmentResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Orchestrator_StoreAssessmentResult_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServer).StoreAssessmentResult(ctx, req.(*StoreAssessmentResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Orchestrator_StoreAssessmentResults_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(OrchestratorServer).StoreAssessmentResults(&orchestratorStoreAssessmentResultsServer{stream})
+}
+
+type Orchestrator_StoreAssessmentResultsServer interface {
+	Send(*StoreAssessmentResultsResponse) error
+	Recv() (*StoreAssessmentResultRequest, error)
+	grpc.ServerStream
+}
+
+type orchestratorStoreAssessmentResultsServer struct {
+	grpc.ServerStream
+}
+
+func (x *orchestratorStoreAssessmentResultsServer) Send(m *StoreAssessmentResultsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *orchestratorStoreAssessmentResultsServer) Recv() (*StoreAssessmentResultRequest, error) {
+	m := new(StoreAssessmentResultRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Orchestrator_GetAssessmentResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAssessmentResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServer).GetAssessmentResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Orchestrator_GetAssessmentResult_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServer).GetAssessmentResult(ctx, req.(*GetAssessmentResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Orchestrator_GetAssessmentResultEvidence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAssessmentResultEvidenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServer).GetAssessmentResultEvidence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Orchestrator_GetAssessmentResultEvidence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServer).GetAssessmentResultEvidence(ctx, req.(*GetAssessmentResultEvidenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Orchestrator_ListAssessmentResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAssessmentResultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServer).ListAssessmentResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Orchestrator_ListAssessmentResults_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServer).ListAssessmentResults(ctx, req.(*ListAssessmentResultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Orchestrator_GetResourceAssessmentSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetResourceAssessmentSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServer).GetResourceAssessmentSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Orchestrator_GetResourceAssessmentSummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServer).GetResourceAssessmentSummary(ctx, req.(*GetResourceAssessmentSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Orchestrator_ListResourceTypeAssessmentSummaries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListResourceTypeAssessmentSummariesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServer).ListResourceTypeAssessmentSummaries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Orchestrator_ListResourceTypeAssessmentSummaries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServer).ListResourceTypeAssessmentSummaries(ctx, req.(*ListResourceTypeAssessmentSummariesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Orchestrator_GetAssessmentStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAssessmentStatisticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServer).GetAssessmentStatistics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Orchestrator_GetAssessmentStatistics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServer).GetAssessmentStatistics(ctx, req.(*GetAssessmentStatisticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Orchestrator_SubscribeAssessmentResults_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeAssessmentResultsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OrchestratorServer).SubscribeAssessmentResults(m, &orchestratorSubscribeAssessmentResultsServer{stream})
+}
+
+type Orchestrator_SubscribeAssessmentResultsServer interface {
+	Send(*AssessmentResultEvent) error
+	grpc.ServerStream
+}
+
+type orchestratorSubscribeAssessmentResultsServer struct {
+	grpc.ServerStream
+}
+
+func (x *orchestratorSubscribeAssessmentResultsServer) Send(m *AssessmentResultEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Orchestrator_ExportOscalAssessmentResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportOscalAssessmentResultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServer).ExportOscalAssessmentResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Orchestrator_ExportOscalAssessmentResults_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServer).ExportOscalAssessmentResults(ctx, req.(*ExportOscalAssessmentResultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Orchestrator_CreateMetric_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMetricRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServer).CreateMetric(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Orchestrator_CreateMetric_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServer).CreateMetric(ctx, req.(*CreateMetricRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Orchestrator_UpdateMetric_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMetricRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServer).UpdateMetric(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Orchestrator_UpdateMetric_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServer).UpdateMetric(ctx, req.(*UpdateMetricRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Orchestrator_GetMetric_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMetricRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServer).GetMetric(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Orchestrator_GetMetric_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServer).GetMetric(ctx, req.(*GetMetricRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Orchestrator_ListMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMetricsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServer).ListMetrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Orchestrator_ListMetrics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServer).ListMetrics(ctx, req.(*ListMetricsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Orchestrator_RemoveMetric_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveMetricRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServer).RemoveMetric(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Orchestrator_RemoveMetric_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServer).RemoveMetric(ctx, req.(*RemoveMetricRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Orchestrator_RestoreMetric_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreMetricRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServer).RestoreMetric(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Orchestrator_RestoreMetric_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServer).RestoreMetric(ctx, req.(*RestoreMetricRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Orchestrator_PublishMetric_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishMetricRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServer).PublishMetric(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Orchestrator_PublishMetric_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServer).PublishMetric(ctx, req.(*PublishMetricRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Orchestrator_RegisterCloudService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterCloudServiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServer).RegisterCloudService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Orchestrator_RegisterCloudService_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServer).RegisterCloudService(ctx, req.(*RegisterCloudServiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Orchestrator_UpdateCloudService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCloudServiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServer).UpdateCloudService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Orchestrator_UpdateCloudService_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServer).UpdateCloudService(ctx, req.(*UpdateCloudServiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Orchestrator_GetCloudService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCloudServiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServer).GetCloudService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Orchestrator_GetCloudService_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServer).GetCloudService(ctx, req.(*GetCloudServiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Orchestrator_ListCloudServices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCloudServicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServer).ListCloudServices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Orchestrator_ListCloudServices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServer).ListCloudServices(ctx, req.(*ListCloudServicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Orchestrator_RemoveCloudService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveCloudServiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServer).RemoveCloudService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Orchestrator_RemoveCloudService_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServer).RemoveCloudService(ctx, req.(*RemoveCloudServiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Orchestrator_RestoreCloudService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreCloudServiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServer).RestoreCloudService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Orchestrator_RestoreCloudService_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServer).RestoreCloudService(ctx, req.(*RestoreCloudServiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Orchestrator_GetCloudServiceStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCloudServiceStatisticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServer).GetCloudServiceStatistics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Orchestrator_GetCloudServiceStatistics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServer).GetCloudServiceStatistics(ctx, req.(*GetCloudServiceStatisticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Orchestrator_UpdateMetricConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMetricConfigurationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServer).UpdateMetricConfiguration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Orchestrator_UpdateMetricConfiguration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServer).UpdateMetricConfiguration(ctx, req.(*UpdateMetricConfigurationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Orchestrator_GetMetricConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMetricConfigurationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServer).GetMetricConfiguration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Orchestrator_GetMetricConfiguration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServer).GetMetricConfiguration(ctx, req.(*GetMetricConfigurationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Orchestrator_ListMetricConfigurations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMetricConfigurationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServer).ListMetricConfigurations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Orchestrator_ListMetricConfigurations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServer).ListMetricConfigurations(ctx, req.(*ListMetricConfigurationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Orchestrator_UpdateMetricImplementation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMetricImplementationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServer).UpdateMetricImplementation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Orchestrator_UpdateMetricImplementation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServer).UpdateMetricImplementation(ctx, req.(*UpdateMetricImplementationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Orchestrator_TestMetricImplementation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TestMetricImplementationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServer).TestMetricImplementation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Orchestrator_TestMetricImplementation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServer).TestMetricImplementation(ctx, req.(*TestMetricImplementationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Orchestrator_GetMetricImplementation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMetricImplementationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServer).GetMetricImplementation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Orchestrator_GetMetricImplementation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServer).GetMetricImplementation(ctx, req.(*GetMetricImplementationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Orchestrator_ListMetricImplementationRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMetricImplementationRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServer).ListMetricImplementationRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Orchestrator_ListMetricImplementationRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServer).ListMetricImplementationRevisions(ctx, req.(*ListMetricImplementationRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Orchestrator_ListMetricConfigurationRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMetricConfigurationRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServer).ListMetricConfigurationRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Orchestrator_ListMetricConfigurationRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServer).ListMetricConfigurationRevisions(ctx, req.(*ListMetricConfigurationRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Orchestrator_DisableMetric_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableMetricRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServer).DisableMetric(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Orchestrator_DisableMetric_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServer).DisableMetric(ctx, req.(*DisableMetricRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Orchestrator_EnableMetric_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnableMetricRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServer).EnableMetric(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Orchestrator_EnableMetric_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServer).EnableMetric(ctx, req.(*EnableMetricRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Orchestrator_ListDisabledMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDisabledMetricsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServer).ListDisabledMetrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Orchestrator_ListDisabledMetrics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServer).ListDisabledMetrics(ctx, req.(*ListDisabledMetricsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Orchestrator_SubscribeMetricChangeEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeMetricChangeEventRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OrchestratorServer).SubscribeMetricChangeEvents(m, &orchestratorSubscribeMetricChangeEventsServer{stream})
+}
+
+type Orchestrator_SubscribeMetricChangeEventsServer interface {
+	Send(*MetricChangeEvent) error
+	grpc.ServerStream
+}
+
+type orchestratorSubscribeMetricChangeEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *orchestratorSubscribeMetricChangeEventsServer) Send(m *MetricChangeEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Orchestrator_CreateCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCertificateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServer).CreateCertificate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Orchestrator_CreateCertificate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServer).CreateCertificate(ctx, req.(*CreateCertificateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Orchestrator_GetCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCertificateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServer).GetCertificate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Orchestrator_GetCertificate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServer).GetCertificate(ctx, req.(*GetCertificateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Orchestrator_ListCertificates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCertificatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServer).ListCertificates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Orchestrator_ListCertificates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServer).ListCertificates(ctx, req.(*ListCertificatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Orchestrator_ListPublicCertificates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPublicCertificatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServer).ListPublicCertificates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Orchestrator_ListPublicCertificates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServer).ListPublicCertificates(ctx, req.(*ListPublicCertificatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Orchestrator_UpdateCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCertificateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServer).UpdateCertificate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Orchestrator_UpdateCertificate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServer).UpdateCertificate(ctx, req.(*UpdateCertificateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Orchestrator_UpdateCertificateState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCertificateStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServer).UpdateCertificateState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Orchestrator_UpdateCertificateState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServer).UpdateCertificateState(ctx, req.(*UpdateCertificateStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Orchestrator_ReportCertificationObservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportCertificationObservationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServer).ReportCertificationObservation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Orchestrator_ReportCertificationObservation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServer).ReportCertificationObservation(ctx, req.(*ReportCertificationObservationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Orchestrator_RemoveCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveCertificateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServer).RemoveCertificate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Orchestrator_RemoveCertificate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServer).RemoveCertificate(ctx, req.(*RemoveCertificateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Orchestrator_RestoreCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreCertificateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServer).RestoreCertificate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Orchestrator_RestoreCertificate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServer).RestoreCertificate(ctx, req.(*RestoreCertificateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Orchestrator_CreateCatalog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCatalogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServer).CreateCatalog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Orchestrator_CreateCatalog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServer).CreateCatalog(ctx, req.(*CreateCatalogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Orchestrator_ListCatalogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCatalogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServer).ListCatalogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Orchestrator_ListCatalogs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServer).ListCatalogs(ctx, req.(*ListCatalogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Orchestrator_GetCatalog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCatalogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServer).GetCatalog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Orchestrator_GetCatalog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServer).GetCatalog(ctx, req.(*GetCatalogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Orchestrator_RemoveCatalog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveCatalogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServer).RemoveCatalog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Orchestrator_RemoveCatalog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServer).RemoveCatalog(ctx, req.(*RemoveCatalogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Orchestrator_RestoreCatalog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreCatalogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServer).RestoreCatalog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Orchestrator_RestoreCatalog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServer).RestoreCatalog(ctx, req.(*RestoreCatalogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Orchestrator_UpdateCatalog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCatalogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServer).UpdateCatalog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Orchestrator_UpdateCatalog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServer).UpdateCatalog(ctx, req.(*UpdateCatalogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Orchestrator_ListBuiltinCatalogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBuiltinCatalogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServer).ListBuiltinCatalogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Orchestrator_ListBuiltinCatalogs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServer).ListBuiltinCatalogs(ctx, req.(*ListBuiltinCatalogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Orchestrator_LoadBuiltinCatalog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoadBuiltinCatalogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServer).LoadBuiltinCatalog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Orchestrator_LoadBuiltinCatalog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServer).LoadBuiltinCatalog(ctx, req.(*LoadBuiltinCatalogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Orchestrator_ImportOscalCatalog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportOscalCatalogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServer).ImportOscalCatalog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Orchestrator_ImportOscalCatalog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServer).ImportOscalCatalog(ctx, req.(*ImportOscalCatalogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Orchestrator_GetCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServer).GetCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Orchestrator_GetCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServer).GetCategory(ctx, req.(*GetCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Orchestrator_ListControls_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListControlsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServer).ListControls(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Orchestrator_ListControls_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServer).ListControls(ctx, req.(*ListControlsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Orchestrator_GetControl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetControlRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServer).GetControl(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Orchestrator_GetControl_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServer).GetControl(ctx, req.(*GetControlRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Orchestrator_AddControlMetric_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddControlMetricRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServer).AddControlMetric(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Orchestrator_AddControlMetric_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServer).AddControlMetric(ctx, req.(*AddControlMetricRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Orchestrator_RemoveControlMetric_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveControlMetricRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServer).RemoveControlMetric(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Orchestrator_RemoveControlMetric_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServer).RemoveControlMetric(ctx, req.(*RemoveControlMetricRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Orchestrator_GetCatalogCoverage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCatalogCoverageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServer).GetCatalogCoverage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Orchestrator_GetCatalogCoverage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServer).GetCatalogCoverage(ctx, req.(*GetCatalogCoverageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Orchestrator_RegisterAssessmentTool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RegisterAssessmentToolRequest)
+func _Orchestrator_SetControlAggregationStrategy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetControlAggregationStrategyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrchestratorServer).RegisterAssessmentTool(ctx, in)
+		return srv.(OrchestratorServer).SetControlAggregationStrategy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Orchestrator_RegisterAssessmentTool_FullMethodName,
+		FullMethod: Orchestrator_SetControlAggregationStrategy_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrchestratorServer).RegisterAssessmentTool(ctx, req.(*RegisterAssessmentToolRequest))
+		return srv.(OrchestratorServer).SetControlAggregationStrategy(ctx, req.(*SetControlAggregationStrategyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Orchestrator_ListAssessmentTools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListAssessmentToolsRequest)
+func _Orchestrator_CreateTargetOfEvaluation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTargetOfEvaluationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrchestratorServer).ListAssessmentTools(ctx, in)
+		return srv.(OrchestratorServer).CreateTargetOfEvaluation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Orchestrator_ListAssessmentTools_FullMethodName,
+		FullMethod: Orchestrator_CreateTargetOfEvaluation_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrchestratorServer).ListAssessmentTools(ctx, req.(*ListAssessmentToolsRequest))
+		return srv.(OrchestratorServer).CreateTargetOfEvaluation(ctx, req.(*CreateTargetOfEvaluationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Orchestrator_GetAssessmentTool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAssessmentToolRequest)
+func _Orchestrator_GetTargetOfEvaluation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTargetOfEvaluationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrchestratorServer).GetAssessmentTool(ctx, in)
+		return srv.(OrchestratorServer).GetTargetOfEvaluation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Orchestrator_GetAssessmentTool_FullMethodName,
+		FullMethod: Orchestrator_GetTargetOfEvaluation_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrchestratorServer).GetAssessmentTool(ctx, req.(*GetAssessmentToolRequest))
+		return srv.(OrchestratorServer).GetTargetOfEvaluation(ctx, req.(*GetTargetOfEvaluationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Orchestrator_UpdateAssessmentTool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateAssessmentToolRequest)
+func _Orchestrator_ListTargetsOfEvaluation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTargetsOfEvaluationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrchestratorServer).UpdateAssessmentTool(ctx, in)
+		return srv.(OrchestratorServer).ListTargetsOfEvaluation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Orchestrator_UpdateAssessmentTool_FullMethodName,
+		FullMethod: Orchestrator_ListTargetsOfEvaluation_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrchestratorServer).UpdateAssessmentTool(ctx, req.(*UpdateAssessmentToolRequest))
+		return srv.(OrchestratorServer).ListTargetsOfEvaluation(ctx, req.(*ListTargetsOfEvaluationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Orchestrator_DeregisterAssessmentTool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeregisterAssessmentToolRequest)
+func _Orchestrator_UpdateTargetOfEvaluation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTargetOfEvaluationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrchestratorServer).DeregisterAssessmentTool(ctx, in)
+		return srv.(OrchestratorServer).UpdateTargetOfEvaluation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Orchestrator_DeregisterAssessmentTool_FullMethodName,
+		FullMethod: Orchestrator_UpdateTargetOfEvaluation_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrchestratorServer).DeregisterAssessmentTool(ctx, req.(*DeregisterAssessmentToolRequest))
+		return srv.(OrchestratorServer).UpdateTargetOfEvaluation(ctx, req.(*UpdateTargetOfEvaluationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Orchestrator_StoreAssessmentResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StoreAssessmentResultRequest)
+func _Orchestrator_RemoveTargetOfEvaluation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveTargetOfEvaluationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrchestratorServer).StoreAssessmentResult(ctx, in)
+		return srv.(OrchestratorServer).RemoveTargetOfEvaluation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Orchestrator_StoreAssessmentResult_FullMethodName,
+		FullMethod: Orchestrator_RemoveTargetOfEvaluation_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrchestratorServer).StoreAssessmentResult(ctx, req.(*StoreAssessmentResultRequest))
+		return srv.(OrchestratorServer).RemoveTargetOfEvaluation(ctx, req.(*RemoveTargetOfEvaluationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Orchestrator_StoreAssessmentResults_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(OrchestratorServer).StoreAssessmentResults(&orchestratorStoreAssessmentResultsServer{stream})
+func _Orchestrator_CreateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServer).CreateWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Orchestrator_CreateWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServer).CreateWebhook(ctx, req.(*CreateWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type Orchestrator_StoreAssessmentResultsServer interface {
-	Send(*StoreAssessmentResultsResponse) error
-	Recv() (*StoreAssessmentResultRequest, error)
-	grpc.ServerStream
+func _Orchestrator_GetWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServer).GetWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Orchestrator_GetWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServer).GetWebhook(ctx, req.(*GetWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type orchestratorStoreAssessmentResultsServer struct {
-	grpc.ServerStream
+func _Orchestrator_ListWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServer).ListWebhooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Orchestrator_ListWebhooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServer).ListWebhooks(ctx, req.(*ListWebhooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *orchestratorStoreAssessmentResultsServer) Send(m *StoreAssessmentResultsResponse) error {
-	return x.ServerStream.SendMsg(m)
+func _Orchestrator_UpdateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServer).UpdateWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Orchestrator_UpdateWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServer).UpdateWebhook(ctx, req.(*UpdateWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *orchestratorStoreAssessmentResultsServer) Recv() (*StoreAssessmentResultRequest, error) {
-	m := new(StoreAssessmentResultRequest)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
+func _Orchestrator_RemoveWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveWebhookRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return m, nil
+	if interceptor == nil {
+		return srv.(OrchestratorServer).RemoveWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Orchestrator_RemoveWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServer).RemoveWebhook(ctx, req.(*RemoveWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Orchestrator_GetAssessmentResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAssessmentResultRequest)
+func _Orchestrator_CreateNotificationChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateNotificationChannelRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrchestratorServer).GetAssessmentResult(ctx, in)
+		return srv.(OrchestratorServer).CreateNotificationChannel(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Orchestrator_GetAssessmentResult_FullMethodName,
+		FullMethod: Orchestrator_CreateNotificationChannel_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrchestratorServer).GetAssessmentResult(ctx, req.(*GetAssessmentResultRequest))
+		return srv.(OrchestratorServer).CreateNotificationChannel(ctx, req.(*CreateNotificationChannelRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Orchestrator_ListAssessmentResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListAssessmentResultsRequest)
+func _Orchestrator_GetNotificationChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNotificationChannelRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrchestratorServer).ListAssessmentResults(ctx, in)
+		return srv.(OrchestratorServer).GetNotificationChannel(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Orchestrator_ListAssessmentResults_FullMethodName,
+		FullMethod: Orchestrator_GetNotificationChannel_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrchestratorServer).ListAssessmentResults(ctx, req.(*ListAssessmentResultsRequest))
+		return srv.(OrchestratorServer).GetNotificationChannel(ctx, req.(*GetNotificationChannelRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Orchestrator_CreateMetric_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateMetricRequest)
+func _Orchestrator_ListNotificationChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNotificationChannelsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrchestratorServer).CreateMetric(ctx, in)
+		return srv.(OrchestratorServer).ListNotificationChannels(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Orchestrator_CreateMetric_FullMethodName,
+		FullMethod: Orchestrator_ListNotificationChannels_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrchestratorServer).CreateMetric(ctx, req.(*CreateMetricRequest))
+		return srv.(OrchestratorServer).ListNotificationChannels(ctx, req.(*ListNotificationChannelsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Orchestrator_UpdateMetric_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateMetricRequest)
+func _Orchestrator_UpdateNotificationChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNotificationChannelRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrchestratorServer).UpdateMetric(ctx, in)
+		return srv.(OrchestratorServer).UpdateNotificationChannel(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Orchestrator_UpdateMetric_FullMethodName,
+		FullMethod: Orchestrator_UpdateNotificationChannel_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrchestratorServer).UpdateMetric(ctx, req.(*UpdateMetricRequest))
+		return srv.(OrchestratorServer).UpdateNotificationChannel(ctx, req.(*UpdateNotificationChannelRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Orchestrator_GetMetric_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetMetricRequest)
+func _Orchestrator_RemoveNotificationChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveNotificationChannelRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrchestratorServer).GetMetric(ctx, in)
+		return srv.(OrchestratorServer).RemoveNotificationChannel(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Orchestrator_GetMetric_FullMethodName,
+		FullMethod: Orchestrator_RemoveNotificationChannel_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrchestratorServer).GetMetric(ctx, req.(*GetMetricRequest))
+		return srv.(OrchestratorServer).RemoveNotificationChannel(ctx, req.(*RemoveNotificationChannelRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Orchestrator_ListMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListMetricsRequest)
+func _Orchestrator_SendNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendNotificationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrchestratorServer).ListMetrics(ctx, in)
+		return srv.(OrchestratorServer).SendNotification(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Orchestrator_ListMetrics_FullMethodName,
+		FullMethod: Orchestrator_SendNotification_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrchestratorServer).ListMetrics(ctx, req.(*ListMetricsRequest))
+		return srv.(OrchestratorServer).SendNotification(ctx, req.(*SendNotificationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Orchestrator_RemoveMetric_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveMetricRequest)
+func _Orchestrator_CreateTicketIntegration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTicketIntegrationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrchestratorServer).RemoveMetric(ctx, in)
+		return srv.(OrchestratorServer).CreateTicketIntegration(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Orchestrator_RemoveMetric_FullMethodName,
+		FullMethod: Orchestrator_CreateTicketIntegration_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrchestratorServer).RemoveMetric(ctx, req.(*RemoveMetricRequest))
+		return srv.(OrchestratorServer).CreateTicketIntegration(ctx, req.(*CreateTicketIntegrationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Orchestrator_RegisterCloudService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RegisterCloudServiceRequest)
+func _Orchestrator_GetTicketIntegration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTicketIntegrationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrchestratorServer).RegisterCloudService(ctx, in)
+		return srv.(OrchestratorServer).GetTicketIntegration(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Orchestrator_RegisterCloudService_FullMethodName,
+		FullMethod: Orchestrator_GetTicketIntegration_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrchestratorServer).RegisterCloudService(ctx, req.(*RegisterCloudServiceRequest))
+		return srv.(OrchestratorServer).GetTicketIntegration(ctx, req.(*GetTicketIntegrationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Orchestrator_UpdateCloudService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateCloudServiceRequest)
+func _Orchestrator_ListTicketIntegrations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTicketIntegrationsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrchestratorServer).UpdateCloudService(ctx, in)
+		return srv.(OrchestratorServer).ListTicketIntegrations(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Orchestrator_UpdateCloudService_FullMethodName,
+		FullMethod: Orchestrator_ListTicketIntegrations_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrchestratorServer).UpdateCloudService(ctx, req.(*UpdateCloudServiceRequest))
+		return srv.(OrchestratorServer).ListTicketIntegrations(ctx, req.(*ListTicketIntegrationsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Orchestrator_GetCloudService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCloudServiceRequest)
+func _Orchestrator_UpdateTicketIntegration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTicketIntegrationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrchestratorServer).GetCloudService(ctx, in)
+		return srv.(OrchestratorServer).UpdateTicketIntegration(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Orchestrator_GetCloudService_FullMethodName,
+		FullMethod: Orchestrator_UpdateTicketIntegration_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrchestratorServer).GetCloudService(ctx, req.(*GetCloudServiceRequest))
+		return srv.(OrchestratorServer).UpdateTicketIntegration(ctx, req.(*UpdateTicketIntegrationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Orchestrator_ListCloudServices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListCloudServicesRequest)
+func _Orchestrator_RemoveTicketIntegration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveTicketIntegrationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrchestratorServer).ListCloudServices(ctx, in)
+		return srv.(OrchestratorServer).RemoveTicketIntegration(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Orchestrator_ListCloudServices_FullMethodName,
+		FullMethod: Orchestrator_RemoveTicketIntegration_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrchestratorServer).ListCloudServices(ctx, req.(*ListCloudServicesRequest))
+		return srv.(OrchestratorServer).RemoveTicketIntegration(ctx, req.(*RemoveTicketIntegrationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Orchestrator_RemoveCloudService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveCloudServiceRequest)
+func _Orchestrator_ListTickets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTicketsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrchestratorServer).RemoveCloudService(ctx, in)
+		return srv.(OrchestratorServer).ListTickets(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Orchestrator_RemoveCloudService_FullMethodName,
+		FullMethod: Orchestrator_ListTickets_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrchestratorServer).RemoveCloudService(ctx, req.(*RemoveCloudServiceRequest))
+		return srv.(OrchestratorServer).ListTickets(ctx, req.(*ListTicketsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Orchestrator_GetCloudServiceStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCloudServiceStatisticsRequest)
+func _Orchestrator_CreateWaiver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWaiverRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrchestratorServer).GetCloudServiceStatistics(ctx, in)
+		return srv.(OrchestratorServer).CreateWaiver(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Orchestrator_GetCloudServiceStatistics_FullMethodName,
+		FullMethod: Orchestrator_CreateWaiver_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrchestratorServer).GetCloudServiceStatistics(ctx, req.(*GetCloudServiceStatisticsRequest))
+		return srv.(OrchestratorServer).CreateWaiver(ctx, req.(*CreateWaiverRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Orchestrator_UpdateMetricConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateMetricConfigurationRequest)
+func _Orchestrator_GetWaiver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWaiverRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrchestratorServer).UpdateMetricConfiguration(ctx, in)
+		return srv.(OrchestratorServer).GetWaiver(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Orchestrator_UpdateMetricConfiguration_FullMethodName,
+		FullMethod: Orchestrator_GetWaiver_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrchestratorServer).UpdateMetricConfiguration(ctx, req.(*UpdateMetricConfigurationRequest))
+		return srv.(OrchestratorServer).GetWaiver(ctx, req.(*GetWaiverRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Orchestrator_GetMetricConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetMetricConfigurationRequest)
+func _Orchestrator_ListWaivers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWaiversRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrchestratorServer).GetMetricConfiguration(ctx, in)
+		return srv.(OrchestratorServer).ListWaivers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Orchestrator_GetMetricConfiguration_FullMethodName,
+		FullMethod: Orchestrator_ListWaivers_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrchestratorServer).GetMetricConfiguration(ctx, req.(*GetMetricConfigurationRequest))
+		return srv.(OrchestratorServer).ListWaivers(ctx, req.(*ListWaiversRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Orchestrator_ListMetricConfigurations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListMetricConfigurationRequest)
+func _Orchestrator_RemoveWaiver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveWaiverRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrchestratorServer).ListMetricConfigurations(ctx, in)
+		return srv.(OrchestratorServer).RemoveWaiver(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Orchestrator_ListMetricConfigurations_FullMethodName,
+		FullMethod: Orchestrator_RemoveWaiver_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrchestratorServer).ListMetricConfigurations(ctx, req.(*ListMetricConfigurationRequest))
+		return srv.(OrchestratorServer).RemoveWaiver(ctx, req.(*RemoveWaiverRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Orchestrator_UpdateMetricImplementation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateMetricImplementationRequest)
+func _Orchestrator_CreateOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOrganizationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrchestratorServer).UpdateMetricImplementation(ctx, in)
+		return srv.(OrchestratorServer).CreateOrganization(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Orchestrator_UpdateMetricImplementation_FullMethodName,
+		FullMethod: Orchestrator_CreateOrganization_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrchestratorServer).UpdateMetricImplementation(ctx, req.(*UpdateMetricImplementationRequest))
+		return srv.(OrchestratorServer).CreateOrganization(ctx, req.(*CreateOrganizationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Orchestrator_GetMetricImplementation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetMetricImplementationRequest)
+func _Orchestrator_GetOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrganizationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrchestratorServer).GetMetricImplementation(ctx, in)
+		return srv.(OrchestratorServer).GetOrganization(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Orchestrator_GetMetricImplementation_FullMethodName,
+		FullMethod: Orchestrator_GetOrganization_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrchestratorServer).GetMetricImplementation(ctx, req.(*GetMetricImplementationRequest))
+		return srv.(OrchestratorServer).GetOrganization(ctx, req.(*GetOrganizationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Orchestrator_SubscribeMetricChangeEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(SubscribeMetricChangeEventRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _Orchestrator_ListOrganizations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOrganizationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return srv.(OrchestratorServer).SubscribeMetricChangeEvents(m, &orchestratorSubscribeMetricChangeEventsServer{stream})
+	if interceptor == nil {
+		return srv.(OrchestratorServer).ListOrganizations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Orchestrator_ListOrganizations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServer).ListOrganizations(ctx, req.(*ListOrganizationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type Orchestrator_SubscribeMetricChangeEventsServer interface {
-	Send(*MetricChangeEvent) error
-	grpc.ServerStream
+func _Orchestrator_UpdateOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateOrganizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServer).UpdateOrganization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Orchestrator_UpdateOrganization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServer).UpdateOrganization(ctx, req.(*UpdateOrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type orchestratorSubscribeMetricChangeEventsServer struct {
-	grpc.ServerStream
+func _Orchestrator_RemoveOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveOrganizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServer).RemoveOrganization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Orchestrator_RemoveOrganization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServer).RemoveOrganization(ctx, req.(*RemoveOrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *orchestratorSubscribeMetricChangeEventsServer) Send(m *MetricChangeEvent) error {
-	return x.ServerStream.SendMsg(m)
+func _Orchestrator_CreateRoleAssignment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRoleAssignmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestratorServer).CreateRoleAssignment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Orchestrator_CreateRoleAssignment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestratorServer).CreateRoleAssignment(ctx, req.(*CreateRoleAssignmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Orchestrator_CreateCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateCertificateRequest)
+func _Orchestrator_GetRoleAssignment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRoleAssignmentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrchestratorServer).CreateCertificate(ctx, in)
+		return srv.(OrchestratorServer).GetRoleAssignment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Orchestrator_CreateCertificate_FullMethodName,
+		FullMethod: Orchestrator_GetRoleAssignment_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrchestratorServer).CreateCertificate(ctx, req.(*CreateCertificateRequest))
+		return srv.(OrchestratorServer).GetRoleAssignment(ctx, req.(*GetRoleAssignmentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Orchestrator_GetCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCertificateRequest)
+func _Orchestrator_ListRoleAssignments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRoleAssignmentsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrchestratorServer).GetCertificate(ctx, in)
+		return srv.(OrchestratorServer).ListRoleAssignments(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Orchestrator_GetCertificate_FullMethodName,
+		FullMethod: Orchestrator_ListRoleAssignments_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrchestratorServer).GetCertificate(ctx, req.(*GetCertificateRequest))
+		return srv.(OrchestratorServer).ListRoleAssignments(ctx, req.(*ListRoleAssignmentsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Orchestrator_ListCertificates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListCertificatesRequest)
+func _Orchestrator_RemoveRoleAssignment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveRoleAssignmentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrchestratorServer).ListCertificates(ctx, in)
+		return srv.(OrchestratorServer).RemoveRoleAssignment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Orchestrator_ListCertificates_FullMethodName,
+		FullMethod: Orchestrator_RemoveRoleAssignment_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrchestratorServer).ListCertificates(ctx, req.(*ListCertificatesRequest))
+		return srv.(OrchestratorServer).RemoveRoleAssignment(ctx, req.(*RemoveRoleAssignmentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Orchestrator_ListPublicCertificates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListPublicCertificatesRequest)
+func _Orchestrator_CreateApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateApiKeyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrchestratorServer).ListPublicCertificates(ctx, in)
+		return srv.(OrchestratorServer).CreateApiKey(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Orchestrator_ListPublicCertificates_FullMethodName,
+		FullMethod: Orchestrator_CreateApiKey_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrchestratorServer).ListPublicCertificates(ctx, req.(*ListPublicCertificatesRequest))
+		return srv.(OrchestratorServer).CreateApiKey(ctx, req.(*CreateApiKeyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Orchestrator_UpdateCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateCertificateRequest)
+func _Orchestrator_GetApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetApiKeyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrchestratorServer).UpdateCertificate(ctx, in)
+		return srv.(OrchestratorServer).GetApiKey(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Orchestrator_UpdateCertificate_FullMethodName,
+		FullMethod: Orchestrator_GetApiKey_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrchestratorServer).UpdateCertificate(ctx, req.(*UpdateCertificateRequest))
+		return srv.(OrchestratorServer).GetApiKey(ctx, req.(*GetApiKeyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Orchestrator_RemoveCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveCertificateRequest)
+func _Orchestrator_ListApiKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListApiKeysRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrchestratorServer).RemoveCertificate(ctx, in)
+		return srv.(OrchestratorServer).ListApiKeys(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Orchestrator_RemoveCertificate_FullMethodName,
+		FullMethod: Orchestrator_ListApiKeys_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrchestratorServer).RemoveCertificate(ctx, req.(*RemoveCertificateRequest))
+		return srv.(OrchestratorServer).ListApiKeys(ctx, req.(*ListApiKeysRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Orchestrator_CreateCatalog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateCatalogRequest)
+func _Orchestrator_RotateApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateApiKeyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrchestratorServer).CreateCatalog(ctx, in)
+		return srv.(OrchestratorServer).RotateApiKey(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Orchestrator_CreateCatalog_FullMethodName,
+		FullMethod: Orchestrator_RotateApiKey_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrchestratorServer).CreateCatalog(ctx, req.(*CreateCatalogRequest))
+		return srv.(OrchestratorServer).RotateApiKey(ctx, req.(*RotateApiKeyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Orchestrator_ListCatalogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListCatalogsRequest)
+func _Orchestrator_RemoveApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveApiKeyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrchestratorServer).ListCatalogs(ctx, in)
+		return srv.(OrchestratorServer).RemoveApiKey(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Orchestrator_ListCatalogs_FullMethodName,
+		FullMethod: Orchestrator_RemoveApiKey_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrchestratorServer).ListCatalogs(ctx, req.(*ListCatalogsRequest))
+		return srv.(OrchestratorServer).RemoveApiKey(ctx, req.(*RemoveApiKeyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Orchestrator_GetCatalog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCatalogRequest)
+func _Orchestrator_CreateAuditorGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAuditorGrantRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrchestratorServer).GetCatalog(ctx, in)
+		return srv.(OrchestratorServer).CreateAuditorGrant(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Orchestrator_GetCatalog_FullMethodName,
+		FullMethod: Orchestrator_CreateAuditorGrant_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrchestratorServer).GetCatalog(ctx, req.(*GetCatalogRequest))
+		return srv.(OrchestratorServer).CreateAuditorGrant(ctx, req.(*CreateAuditorGrantRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Orchestrator_RemoveCatalog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveCatalogRequest)
+func _Orchestrator_GetAuditorGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAuditorGrantRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrchestratorServer).RemoveCatalog(ctx, in)
+		return srv.(OrchestratorServer).GetAuditorGrant(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Orchestrator_RemoveCatalog_FullMethodName,
+		FullMethod: Orchestrator_GetAuditorGrant_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrchestratorServer).RemoveCatalog(ctx, req.(*RemoveCatalogRequest))
+		return srv.(OrchestratorServer).GetAuditorGrant(ctx, req.(*GetAuditorGrantRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Orchestrator_UpdateCatalog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateCatalogRequest)
+func _Orchestrator_ListAuditorGrants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditorGrantsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrchestratorServer).UpdateCatalog(ctx, in)
+		return srv.(OrchestratorServer).ListAuditorGrants(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Orchestrator_UpdateCatalog_FullMethodName,
+		FullMethod: Orchestrator_ListAuditorGrants_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrchestratorServer).UpdateCatalog(ctx, req.(*UpdateCatalogRequest))
+		return srv.(OrchestratorServer).ListAuditorGrants(ctx, req.(*ListAuditorGrantsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Orchestrator_GetCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCategoryRequest)
+func _Orchestrator_RevokeAuditorGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAuditorGrantRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrchestratorServer).GetCategory(ctx, in)
+		return srv.(OrchestratorServer).RevokeAuditorGrant(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Orchestrator_GetCategory_FullMethodName,
+		FullMethod: Orchestrator_RevokeAuditorGrant_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrchestratorServer).GetCategory(ctx, req.(*GetCategoryRequest))
+		return srv.(OrchestratorServer).RevokeAuditorGrant(ctx, req.(*RevokeAuditorGrantRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Orchestrator_ListControls_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListControlsRequest)
+func _Orchestrator_ListAuditLogEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditLogEntriesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrchestratorServer).ListControls(ctx, in)
+		return srv.(OrchestratorServer).ListAuditLogEntries(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Orchestrator_ListControls_FullMethodName,
+		FullMethod: Orchestrator_ListAuditLogEntries_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrchestratorServer).ListControls(ctx, req.(*ListControlsRequest))
+		return srv.(OrchestratorServer).ListAuditLogEntries(ctx, req.(*ListAuditLogEntriesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Orchestrator_GetControl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetControlRequest)
+func _Orchestrator_ExportAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportAuditLogRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrchestratorServer).GetControl(ctx, in)
+		return srv.(OrchestratorServer).ExportAuditLog(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Orchestrator_GetControl_FullMethodName,
+		FullMethod: Orchestrator_ExportAuditLog_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrchestratorServer).GetControl(ctx, req.(*GetControlRequest))
+		return srv.(OrchestratorServer).ExportAuditLog(ctx, req.(*ExportAuditLogRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Orchestrator_CreateTargetOfEvaluation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateTargetOfEvaluationRequest)
+func _Orchestrator_RegisterAgent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterAgentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrchestratorServer).CreateTargetOfEvaluation(ctx, in)
+		return srv.(OrchestratorServer).RegisterAgent(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Orchestrator_CreateTargetOfEvaluation_FullMethodName,
+		FullMethod: Orchestrator_RegisterAgent_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrchestratorServer).CreateTargetOfEvaluation(ctx, req.(*CreateTargetOfEvaluationRequest))
+		return srv.(OrchestratorServer).RegisterAgent(ctx, req.(*RegisterAgentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Orchestrator_GetTargetOfEvaluation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTargetOfEvaluationRequest)
+func _Orchestrator_SendAgentHeartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendAgentHeartbeatRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrchestratorServer).GetTargetOfEvaluation(ctx, in)
+		return srv.(OrchestratorServer).SendAgentHeartbeat(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Orchestrator_GetTargetOfEvaluation_FullMethodName,
+		FullMethod: Orchestrator_SendAgentHeartbeat_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrchestratorServer).GetTargetOfEvaluation(ctx, req.(*GetTargetOfEvaluationRequest))
+		return srv.(OrchestratorServer).SendAgentHeartbeat(ctx, req.(*SendAgentHeartbeatRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Orchestrator_ListTargetsOfEvaluation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTargetsOfEvaluationRequest)
+func _Orchestrator_ListAgents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAgentsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrchestratorServer).ListTargetsOfEvaluation(ctx, in)
+		return srv.(OrchestratorServer).ListAgents(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Orchestrator_ListTargetsOfEvaluation_FullMethodName,
+		FullMethod: Orchestrator_ListAgents_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrchestratorServer).ListTargetsOfEvaluation(ctx, req.(*ListTargetsOfEvaluationRequest))
+		return srv.(OrchestratorServer).ListAgents(ctx, req.(*ListAgentsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Orchestrator_UpdateTargetOfEvaluation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateTargetOfEvaluationRequest)
+func _Orchestrator_UpdateAgentConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAgentConfigurationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrchestratorServer).UpdateTargetOfEvaluation(ctx, in)
+		return srv.(OrchestratorServer).UpdateAgentConfiguration(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Orchestrator_UpdateTargetOfEvaluation_FullMethodName,
+		FullMethod: Orchestrator_UpdateAgentConfiguration_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrchestratorServer).UpdateTargetOfEvaluation(ctx, req.(*UpdateTargetOfEvaluationRequest))
+		return srv.(OrchestratorServer).UpdateAgentConfiguration(ctx, req.(*UpdateAgentConfigurationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Orchestrator_RemoveTargetOfEvaluation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveTargetOfEvaluationRequest)
+func _Orchestrator_DeregisterAgent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeregisterAgentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrchestratorServer).RemoveTargetOfEvaluation(ctx, in)
+		return srv.(OrchestratorServer).DeregisterAgent(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Orchestrator_RemoveTargetOfEvaluation_FullMethodName,
+		FullMethod: Orchestrator_DeregisterAgent_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrchestratorServer).RemoveTargetOfEvaluation(ctx, req.(*RemoveTargetOfEvaluationRequest))
+		return srv.(OrchestratorServer).DeregisterAgent(ctx, req.(*DeregisterAgentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1816,10 +4502,30 @@ var Orchestrator_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAssessmentResult",
 			Handler:    _Orchestrator_GetAssessmentResult_Handler,
 		},
+		{
+			MethodName: "GetAssessmentResultEvidence",
+			Handler:    _Orchestrator_GetAssessmentResultEvidence_Handler,
+		},
 		{
 			MethodName: "ListAssessmentResults",
 			Handler:    _Orchestrator_ListAssessmentResults_Handler,
 		},
+		{
+			MethodName: "GetResourceAssessmentSummary",
+			Handler:    _Orchestrator_GetResourceAssessmentSummary_Handler,
+		},
+		{
+			MethodName: "ListResourceTypeAssessmentSummaries",
+			Handler:    _Orchestrator_ListResourceTypeAssessmentSummaries_Handler,
+		},
+		{
+			MethodName: "GetAssessmentStatistics",
+			Handler:    _Orchestrator_GetAssessmentStatistics_Handler,
+		},
+		{
+			MethodName: "ExportOscalAssessmentResults",
+			Handler:    _Orchestrator_ExportOscalAssessmentResults_Handler,
+		},
 		{
 			MethodName: "CreateMetric",
 			Handler:    _Orchestrator_CreateMetric_Handler,
@@ -1840,6 +4546,14 @@ var Orchestrator_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveMetric",
 			Handler:    _Orchestrator_RemoveMetric_Handler,
 		},
+		{
+			MethodName: "RestoreMetric",
+			Handler:    _Orchestrator_RestoreMetric_Handler,
+		},
+		{
+			MethodName: "PublishMetric",
+			Handler:    _Orchestrator_PublishMetric_Handler,
+		},
 		{
 			MethodName: "RegisterCloudService",
 			Handler:    _Orchestrator_RegisterCloudService_Handler,
@@ -1860,6 +4574,10 @@ var Orchestrator_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveCloudService",
 			Handler:    _Orchestrator_RemoveCloudService_Handler,
 		},
+		{
+			MethodName: "RestoreCloudService",
+			Handler:    _Orchestrator_RestoreCloudService_Handler,
+		},
 		{
 			MethodName: "GetCloudServiceStatistics",
 			Handler:    _Orchestrator_GetCloudServiceStatistics_Handler,
@@ -1880,10 +4598,34 @@ var Orchestrator_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateMetricImplementation",
 			Handler:    _Orchestrator_UpdateMetricImplementation_Handler,
 		},
+		{
+			MethodName: "TestMetricImplementation",
+			Handler:    _Orchestrator_TestMetricImplementation_Handler,
+		},
 		{
 			MethodName: "GetMetricImplementation",
 			Handler:    _Orchestrator_GetMetricImplementation_Handler,
 		},
+		{
+			MethodName: "ListMetricImplementationRevisions",
+			Handler:    _Orchestrator_ListMetricImplementationRevisions_Handler,
+		},
+		{
+			MethodName: "ListMetricConfigurationRevisions",
+			Handler:    _Orchestrator_ListMetricConfigurationRevisions_Handler,
+		},
+		{
+			MethodName: "DisableMetric",
+			Handler:    _Orchestrator_DisableMetric_Handler,
+		},
+		{
+			MethodName: "EnableMetric",
+			Handler:    _Orchestrator_EnableMetric_Handler,
+		},
+		{
+			MethodName: "ListDisabledMetrics",
+			Handler:    _Orchestrator_ListDisabledMetrics_Handler,
+		},
 		{
 			MethodName: "CreateCertificate",
 			Handler:    _Orchestrator_CreateCertificate_Handler,
@@ -1904,10 +4646,22 @@ var Orchestrator_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateCertificate",
 			Handler:    _Orchestrator_UpdateCertificate_Handler,
 		},
+		{
+			MethodName: "UpdateCertificateState",
+			Handler:    _Orchestrator_UpdateCertificateState_Handler,
+		},
+		{
+			MethodName: "ReportCertificationObservation",
+			Handler:    _Orchestrator_ReportCertificationObservation_Handler,
+		},
 		{
 			MethodName: "RemoveCertificate",
 			Handler:    _Orchestrator_RemoveCertificate_Handler,
 		},
+		{
+			MethodName: "RestoreCertificate",
+			Handler:    _Orchestrator_RestoreCertificate_Handler,
+		},
 		{
 			MethodName: "CreateCatalog",
 			Handler:    _Orchestrator_CreateCatalog_Handler,
@@ -1924,10 +4678,26 @@ var Orchestrator_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveCatalog",
 			Handler:    _Orchestrator_RemoveCatalog_Handler,
 		},
+		{
+			MethodName: "RestoreCatalog",
+			Handler:    _Orchestrator_RestoreCatalog_Handler,
+		},
 		{
 			MethodName: "UpdateCatalog",
 			Handler:    _Orchestrator_UpdateCatalog_Handler,
 		},
+		{
+			MethodName: "ListBuiltinCatalogs",
+			Handler:    _Orchestrator_ListBuiltinCatalogs_Handler,
+		},
+		{
+			MethodName: "LoadBuiltinCatalog",
+			Handler:    _Orchestrator_LoadBuiltinCatalog_Handler,
+		},
+		{
+			MethodName: "ImportOscalCatalog",
+			Handler:    _Orchestrator_ImportOscalCatalog_Handler,
+		},
 		{
 			MethodName: "GetCategory",
 			Handler:    _Orchestrator_GetCategory_Handler,
@@ -1940,6 +4710,22 @@ var Orchestrator_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetControl",
 			Handler:    _Orchestrator_GetControl_Handler,
 		},
+		{
+			MethodName: "AddControlMetric",
+			Handler:    _Orchestrator_AddControlMetric_Handler,
+		},
+		{
+			MethodName: "RemoveControlMetric",
+			Handler:    _Orchestrator_RemoveControlMetric_Handler,
+		},
+		{
+			MethodName: "GetCatalogCoverage",
+			Handler:    _Orchestrator_GetCatalogCoverage_Handler,
+		},
+		{
+			MethodName: "SetControlAggregationStrategy",
+			Handler:    _Orchestrator_SetControlAggregationStrategy_Handler,
+		},
 		{
 			MethodName: "CreateTargetOfEvaluation",
 			Handler:    _Orchestrator_CreateTargetOfEvaluation_Handler,
@@ -1960,6 +4746,190 @@ var Orchestrator_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveTargetOfEvaluation",
 			Handler:    _Orchestrator_RemoveTargetOfEvaluation_Handler,
 		},
+		{
+			MethodName: "CreateWebhook",
+			Handler:    _Orchestrator_CreateWebhook_Handler,
+		},
+		{
+			MethodName: "GetWebhook",
+			Handler:    _Orchestrator_GetWebhook_Handler,
+		},
+		{
+			MethodName: "ListWebhooks",
+			Handler:    _Orchestrator_ListWebhooks_Handler,
+		},
+		{
+			MethodName: "UpdateWebhook",
+			Handler:    _Orchestrator_UpdateWebhook_Handler,
+		},
+		{
+			MethodName: "RemoveWebhook",
+			Handler:    _Orchestrator_RemoveWebhook_Handler,
+		},
+		{
+			MethodName: "CreateNotificationChannel",
+			Handler:    _Orchestrator_CreateNotificationChannel_Handler,
+		},
+		{
+			MethodName: "GetNotificationChannel",
+			Handler:    _Orchestrator_GetNotificationChannel_Handler,
+		},
+		{
+			MethodName: "ListNotificationChannels",
+			Handler:    _Orchestrator_ListNotificationChannels_Handler,
+		},
+		{
+			MethodName: "UpdateNotificationChannel",
+			Handler:    _Orchestrator_UpdateNotificationChannel_Handler,
+		},
+		{
+			MethodName: "RemoveNotificationChannel",
+			Handler:    _Orchestrator_RemoveNotificationChannel_Handler,
+		},
+		{
+			MethodName: "SendNotification",
+			Handler:    _Orchestrator_SendNotification_Handler,
+		},
+		{
+			MethodName: "CreateTicketIntegration",
+			Handler:    _Orchestrator_CreateTicketIntegration_Handler,
+		},
+		{
+			MethodName: "GetTicketIntegration",
+			Handler:    _Orchestrator_GetTicketIntegration_Handler,
+		},
+		{
+			MethodName: "ListTicketIntegrations",
+			Handler:    _Orchestrator_ListTicketIntegrations_Handler,
+		},
+		{
+			MethodName: "UpdateTicketIntegration",
+			Handler:    _Orchestrator_UpdateTicketIntegration_Handler,
+		},
+		{
+			MethodName: "RemoveTicketIntegration",
+			Handler:    _Orchestrator_RemoveTicketIntegration_Handler,
+		},
+		{
+			MethodName: "ListTickets",
+			Handler:    _Orchestrator_ListTickets_Handler,
+		},
+		{
+			MethodName: "CreateWaiver",
+			Handler:    _Orchestrator_CreateWaiver_Handler,
+		},
+		{
+			MethodName: "GetWaiver",
+			Handler:    _Orchestrator_GetWaiver_Handler,
+		},
+		{
+			MethodName: "ListWaivers",
+			Handler:    _Orchestrator_ListWaivers_Handler,
+		},
+		{
+			MethodName: "RemoveWaiver",
+			Handler:    _Orchestrator_RemoveWaiver_Handler,
+		},
+		{
+			MethodName: "CreateOrganization",
+			Handler:    _Orchestrator_CreateOrganization_Handler,
+		},
+		{
+			MethodName: "GetOrganization",
+			Handler:    _Orchestrator_GetOrganization_Handler,
+		},
+		{
+			MethodName: "ListOrganizations",
+			Handler:    _Orchestrator_ListOrganizations_Handler,
+		},
+		{
+			MethodName: "UpdateOrganization",
+			Handler:    _Orchestrator_UpdateOrganization_Handler,
+		},
+		{
+			MethodName: "RemoveOrganization",
+			Handler:    _Orchestrator_RemoveOrganization_Handler,
+		},
+		{
+			MethodName: "CreateRoleAssignment",
+			Handler:    _Orchestrator_CreateRoleAssignment_Handler,
+		},
+		{
+			MethodName: "GetRoleAssignment",
+			Handler:    _Orchestrator_GetRoleAssignment_Handler,
+		},
+		{
+			MethodName: "ListRoleAssignments",
+			Handler:    _Orchestrator_ListRoleAssignments_Handler,
+		},
+		{
+			MethodName: "RemoveRoleAssignment",
+			Handler:    _Orchestrator_RemoveRoleAssignment_Handler,
+		},
+		{
+			MethodName: "CreateApiKey",
+			Handler:    _Orchestrator_CreateApiKey_Handler,
+		},
+		{
+			MethodName: "GetApiKey",
+			Handler:    _Orchestrator_GetApiKey_Handler,
+		},
+		{
+			MethodName: "ListApiKeys",
+			Handler:    _Orchestrator_ListApiKeys_Handler,
+		},
+		{
+			MethodName: "RotateApiKey",
+			Handler:    _Orchestrator_RotateApiKey_Handler,
+		},
+		{
+			MethodName: "RemoveApiKey",
+			Handler:    _Orchestrator_RemoveApiKey_Handler,
+		},
+		{
+			MethodName: "CreateAuditorGrant",
+			Handler:    _Orchestrator_CreateAuditorGrant_Handler,
+		},
+		{
+			MethodName: "GetAuditorGrant",
+			Handler:    _Orchestrator_GetAuditorGrant_Handler,
+		},
+		{
+			MethodName: "ListAuditorGrants",
+			Handler:    _Orchestrator_ListAuditorGrants_Handler,
+		},
+		{
+			MethodName: "RevokeAuditorGrant",
+			Handler:    _Orchestrator_RevokeAuditorGrant_Handler,
+		},
+		{
+			MethodName: "ListAuditLogEntries",
+			Handler:    _Orchestrator_ListAuditLogEntries_Handler,
+		},
+		{
+			MethodName: "ExportAuditLog",
+			Handler:    _Orchestrator_ExportAuditLog_Handler,
+		},
+		{
+			MethodName: "RegisterAgent",
+			Handler:    _Orchestrator_RegisterAgent_Handler,
+		},
+		{
+			MethodName: "SendAgentHeartbeat",
+			Handler:    _Orchestrator_SendAgentHeartbeat_Handler,
+		},
+		{
+			MethodName: "ListAgents",
+			Handler:    _Orchestrator_ListAgents_Handler,
+		},
+		{
+			MethodName: "UpdateAgentConfiguration",
+			Handler:    _Orchestrator_UpdateAgentConfiguration_Handler,
+		},
+		{
+			MethodName: "DeregisterAgent",
+			Handler:    _Orchestrator_DeregisterAgent_Handler,
+		},
 		{
 			MethodName: "GetRuntimeInfo",
 			Handler:    _Orchestrator_GetRuntimeInfo_Handler,
@@ -1972,6 +4942,11 @@ var Orchestrator_ServiceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "SubscribeAssessmentResults",
+			Handler:       _Orchestrator_SubscribeAssessmentResults_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "SubscribeMetricChangeEvents",
 			Handler:       _Orchestrator_SubscribeMetricChangeEvents_Handler,
@@ -26,10 +26,15 @@
 package orchestrator
 
 import (
+	"slices"
 	"testing"
+	"time"
 
 	"clouditor.io/clouditor/v2/api/assessment"
 	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func TestUpdateCloudServiceRequest_GetCloudServiceId(t *testing.T) {
@@ -246,3 +251,521 @@ func TestUpdateTargetOfEvaluationRequest_GetCloudServiceId(t *testing.T) {
 		})
 	}
 }
+
+func TestTargetOfEvaluation_ValidateScope(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name    string
+		toe     *TargetOfEvaluation
+		wantErr assert.WantErr
+	}{
+		{
+			name:    "No time frame",
+			toe:     &TargetOfEvaluation{},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "Only start",
+			toe: &TargetOfEvaluation{
+				ScopeStart: timestamppb.New(now),
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "Valid time frame",
+			toe: &TargetOfEvaluation{
+				ScopeStart: timestamppb.New(now),
+				ScopeEnd:   timestamppb.New(now.Add(time.Hour)),
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "End before start",
+			toe: &TargetOfEvaluation{
+				ScopeStart: timestamppb.New(now),
+				ScopeEnd:   timestamppb.New(now.Add(-time.Hour)),
+			},
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, ErrInvalidScope)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.wantErr(t, tt.toe.ValidateScope())
+		})
+	}
+}
+
+func TestTargetOfEvaluation_InScope(t *testing.T) {
+	now := time.Now()
+
+	type args struct {
+		result *assessment.AssessmentResult
+	}
+	tests := []struct {
+		name string
+		toe  *TargetOfEvaluation
+		args args
+		want bool
+	}{
+		{
+			name: "No scope",
+			toe:  &TargetOfEvaluation{},
+			args: args{
+				result: &assessment.AssessmentResult{
+					ResourceTypes: []string{"VirtualMachine", "Compute", "Resource"},
+					Timestamp:     timestamppb.New(now),
+				},
+			},
+			want: true,
+		},
+		{
+			name: "Resource type in scope",
+			toe: &TargetOfEvaluation{
+				ResourceTypes: []string{"Storage", "Compute"},
+			},
+			args: args{
+				result: &assessment.AssessmentResult{
+					ResourceTypes: []string{"VirtualMachine", "Compute", "Resource"},
+					Timestamp:     timestamppb.New(now),
+				},
+			},
+			want: true,
+		},
+		{
+			name: "Resource type not in scope",
+			toe: &TargetOfEvaluation{
+				ResourceTypes: []string{"Storage"},
+			},
+			args: args{
+				result: &assessment.AssessmentResult{
+					ResourceTypes: []string{"VirtualMachine", "Compute", "Resource"},
+					Timestamp:     timestamppb.New(now),
+				},
+			},
+			want: false,
+		},
+		{
+			name: "Before time frame",
+			toe: &TargetOfEvaluation{
+				ScopeStart: timestamppb.New(now),
+			},
+			args: args{
+				result: &assessment.AssessmentResult{
+					Timestamp: timestamppb.New(now.Add(-time.Hour)),
+				},
+			},
+			want: false,
+		},
+		{
+			name: "After time frame",
+			toe: &TargetOfEvaluation{
+				ScopeStart: timestamppb.New(now.Add(-2 * time.Hour)),
+				ScopeEnd:   timestamppb.New(now.Add(-time.Hour)),
+			},
+			args: args{
+				result: &assessment.AssessmentResult{
+					Timestamp: timestamppb.New(now),
+				},
+			},
+			want: false,
+		},
+		{
+			name: "Within time frame",
+			toe: &TargetOfEvaluation{
+				ScopeStart: timestamppb.New(now.Add(-time.Hour)),
+				ScopeEnd:   timestamppb.New(now.Add(time.Hour)),
+			},
+			args: args{
+				result: &assessment.AssessmentResult{
+					Timestamp: timestamppb.New(now),
+				},
+			},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.toe.InScope(tt.args.result))
+		})
+	}
+}
+
+func TestWebhook_IsSubscribedTo(t *testing.T) {
+	type args struct {
+		typ WebhookEventType
+	}
+	tests := []struct {
+		name string
+		hook *Webhook
+		args args
+		want bool
+	}{
+		{
+			name: "Disabled",
+			hook: &Webhook{},
+			args: args{typ: WebhookEventType_WEBHOOK_EVENT_TYPE_CERTIFICATE_CHANGED},
+			want: false,
+		},
+		{
+			name: "All events",
+			hook: &Webhook{Enabled: true},
+			args: args{typ: WebhookEventType_WEBHOOK_EVENT_TYPE_CERTIFICATE_CHANGED},
+			want: true,
+		},
+		{
+			name: "Subscribed event",
+			hook: &Webhook{
+				Enabled: true,
+				Events:  []WebhookEventType{WebhookEventType_WEBHOOK_EVENT_TYPE_CERTIFICATE_CHANGED},
+			},
+			args: args{typ: WebhookEventType_WEBHOOK_EVENT_TYPE_CERTIFICATE_CHANGED},
+			want: true,
+		},
+		{
+			name: "Not subscribed event",
+			hook: &Webhook{
+				Enabled: true,
+				Events:  []WebhookEventType{WebhookEventType_WEBHOOK_EVENT_TYPE_CERTIFICATE_CHANGED},
+			},
+			args: args{typ: WebhookEventType_WEBHOOK_EVENT_TYPE_NON_COMPLIANT_ASSESSMENT_RESULT},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.hook.IsSubscribedTo(tt.args.typ))
+		})
+	}
+}
+
+func TestNotificationChannel_ValidateTarget(t *testing.T) {
+	tests := []struct {
+		name    string
+		channel *NotificationChannel
+		wantErr error
+	}{
+		{
+			name:    "Slack without URL",
+			channel: &NotificationChannel{Type: NotificationChannelType_NOTIFICATION_CHANNEL_TYPE_SLACK},
+			wantErr: ErrNotificationURLMissing,
+		},
+		{
+			name:    "Teams with URL",
+			channel: &NotificationChannel{Type: NotificationChannelType_NOTIFICATION_CHANNEL_TYPE_TEAMS, Url: "https://example.com"},
+		},
+		{
+			name:    "Email without recipients",
+			channel: &NotificationChannel{Type: NotificationChannelType_NOTIFICATION_CHANNEL_TYPE_EMAIL},
+			wantErr: ErrNotificationNoRecipients,
+		},
+		{
+			name:    "Email with recipients",
+			channel: &NotificationChannel{Type: NotificationChannelType_NOTIFICATION_CHANNEL_TYPE_EMAIL, Recipients: []string{"security@example.com"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.ErrorIs(t, tt.channel.ValidateTarget(), tt.wantErr)
+		})
+	}
+}
+
+func TestNotificationChannel_Accepts(t *testing.T) {
+	type args struct {
+		severity NotificationSeverity
+	}
+	tests := []struct {
+		name    string
+		channel *NotificationChannel
+		args    args
+		want    bool
+	}{
+		{
+			name:    "Disabled",
+			channel: &NotificationChannel{Enabled: false},
+			args:    args{severity: NotificationSeverity_NOTIFICATION_SEVERITY_CRITICAL},
+			want:    false,
+		},
+		{
+			name:    "Below threshold",
+			channel: &NotificationChannel{Enabled: true, MinSeverity: NotificationSeverity_NOTIFICATION_SEVERITY_HIGH},
+			args:    args{severity: NotificationSeverity_NOTIFICATION_SEVERITY_MEDIUM},
+			want:    false,
+		},
+		{
+			name:    "Matches threshold",
+			channel: &NotificationChannel{Enabled: true, MinSeverity: NotificationSeverity_NOTIFICATION_SEVERITY_HIGH},
+			args:    args{severity: NotificationSeverity_NOTIFICATION_SEVERITY_HIGH},
+			want:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.channel.Accepts(tt.args.severity))
+		})
+	}
+}
+
+func TestTicketIntegration_ProjectFor(t *testing.T) {
+	integration := &TicketIntegration{
+		Project:        "SEC",
+		MetricProjects: map[string]string{"Metric1": "OPS"},
+	}
+
+	assert.Equal(t, "OPS", integration.ProjectFor("Metric1"))
+	assert.Equal(t, "SEC", integration.ProjectFor("Metric2"))
+}
+
+func TestTicketIntegration_ConsecutiveResults(t *testing.T) {
+	assert.Equal(t, 1, (&TicketIntegration{}).ConsecutiveResults())
+	assert.Equal(t, 3, (&TicketIntegration{MinConsecutiveResults: 3}).ConsecutiveResults())
+}
+
+func TestWaiver_Covers(t *testing.T) {
+	var now = time.Now()
+
+	waiver := &Waiver{
+		CloudServiceId: "11111111-1111-1111-1111-111111111111",
+		ResourceId:     "my-resource",
+		MetricId:       "my-metric",
+		ExpiresAt:      timestamppb.New(now.Add(time.Hour)),
+	}
+
+	type args struct {
+		result *assessment.AssessmentResult
+		t      time.Time
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "Covered",
+			args: args{
+				result: &assessment.AssessmentResult{CloudServiceId: waiver.CloudServiceId, ResourceId: "my-resource", MetricId: "my-metric"},
+				t:      now,
+			},
+			want: true,
+		},
+		{
+			name: "Other metric",
+			args: args{
+				result: &assessment.AssessmentResult{CloudServiceId: waiver.CloudServiceId, ResourceId: "my-resource", MetricId: "other-metric"},
+				t:      now,
+			},
+			want: false,
+		},
+		{
+			name: "Expired",
+			args: args{
+				result: &assessment.AssessmentResult{CloudServiceId: waiver.CloudServiceId, ResourceId: "my-resource", MetricId: "my-metric"},
+				t:      now.Add(2 * time.Hour),
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, waiver.Covers(tt.args.result, tt.args.t))
+		})
+	}
+}
+
+func TestControl_EffectiveAggregationStrategy(t *testing.T) {
+	var (
+		percentage = &AggregationStrategy{Type: AggregationStrategyType_AGGREGATION_STRATEGY_TYPE_PERCENTAGE_THRESHOLD}
+		nOfM       = &AggregationStrategy{Type: AggregationStrategyType_AGGREGATION_STRATEGY_TYPE_N_OF_M}
+	)
+
+	type args struct {
+		control *Control
+		catalog *Catalog
+	}
+	tests := []struct {
+		name string
+		args args
+		want *AggregationStrategy
+	}{
+		{
+			name: "Default",
+			args: args{
+				control: &Control{},
+				catalog: &Catalog{},
+			},
+			want: &AggregationStrategy{Type: AggregationStrategyType_AGGREGATION_STRATEGY_TYPE_ALL_MUST_PASS},
+		},
+		{
+			name: "Catalog",
+			args: args{
+				control: &Control{},
+				catalog: &Catalog{AggregationStrategy: percentage},
+			},
+			want: percentage,
+		},
+		{
+			name: "Control overrides catalog",
+			args: args{
+				control: &Control{AggregationStrategy: nOfM},
+				catalog: &Catalog{AggregationStrategy: percentage},
+			},
+			want: nOfM,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.args.control.EffectiveAggregationStrategy(tt.args.catalog))
+		})
+	}
+}
+
+func TestControl_Weight(t *testing.T) {
+	assert.Equal(t, 1.0, (&Control{}).Weight())
+	assert.Equal(t, 1.0, (&Control{Severity: ControlSeverity_CONTROL_SEVERITY_LOW.Enum()}).Weight())
+	assert.Equal(t, 4.0, (&Control{Severity: ControlSeverity_CONTROL_SEVERITY_CRITICAL.Enum()}).Weight())
+}
+
+func TestCertificate_TransitionTo(t *testing.T) {
+	var now = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	type args struct {
+		state     CertificateState
+		automatic bool
+	}
+	tests := []struct {
+		name    string
+		current CertificateState
+		args    args
+		wantErr assert.WantErr
+	}{
+		{
+			name:    "Issued to suspended",
+			current: CertificateState_CERTIFICATE_STATE_ISSUED,
+			args:    args{state: CertificateState_CERTIFICATE_STATE_SUSPENDED, automatic: true},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name:    "Suspended to issued",
+			current: CertificateState_CERTIFICATE_STATE_SUSPENDED,
+			args:    args{state: CertificateState_CERTIFICATE_STATE_ISSUED},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name:    "Issued to issued",
+			current: CertificateState_CERTIFICATE_STATE_ISSUED,
+			args:    args{state: CertificateState_CERTIFICATE_STATE_ISSUED},
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, ErrInvalidStateTransition)
+			},
+		},
+		{
+			name:    "Suspended to withdrawn",
+			current: CertificateState_CERTIFICATE_STATE_SUSPENDED,
+			args:    args{state: CertificateState_CERTIFICATE_STATE_WITHDRAWN, automatic: true},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name:    "Withdrawn to issued",
+			current: CertificateState_CERTIFICATE_STATE_WITHDRAWN,
+			args:    args{state: CertificateState_CERTIFICATE_STATE_ISSUED},
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, ErrInvalidStateTransition)
+			},
+		},
+		{
+			name:    "Expired to suspended",
+			current: CertificateState_CERTIFICATE_STATE_EXPIRED,
+			args:    args{state: CertificateState_CERTIFICATE_STATE_SUSPENDED},
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, ErrInvalidStateTransition)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Certificate{Id: "my-cert", CurrentState: tt.current}
+
+			err := c.TransitionTo(tt.args.state, nil, tt.args.automatic, now)
+			if !tt.wantErr(t, err) || err != nil {
+				assert.Equal(t, tt.current, c.CurrentState)
+				assert.Empty(t, c.States)
+				return
+			}
+
+			assert.Equal(t, tt.args.state, c.CurrentState)
+			if assert.Equal(t, 1, len(c.States)) {
+				assert.Equal(t, tt.args.state.Name(), c.States[0].State)
+				assert.Equal(t, "my-cert", c.States[0].CertificateId)
+				assert.Equal(t, "2024-01-01T00:00:00Z", c.States[0].Timestamp)
+				assert.Equal(t, tt.args.automatic, c.States[0].Automatic)
+			}
+		})
+	}
+}
+
+func TestCertificate_SuspendedAutomatically(t *testing.T) {
+	var now = time.Now()
+
+	c := &Certificate{CurrentState: CertificateState_CERTIFICATE_STATE_ISSUED}
+	assert.False(t, c.SuspendedAutomatically())
+
+	// Manual suspension
+	assert.NoError(t, c.TransitionTo(CertificateState_CERTIFICATE_STATE_SUSPENDED, nil, false, now))
+	assert.False(t, c.SuspendedAutomatically())
+
+	// Automatic suspension after it was re-issued. We reverse the history to make sure the order does not matter.
+	assert.NoError(t, c.TransitionTo(CertificateState_CERTIFICATE_STATE_ISSUED, nil, false, now.Add(time.Second)))
+	assert.NoError(t, c.TransitionTo(CertificateState_CERTIFICATE_STATE_SUSPENDED, nil, true, now.Add(2*time.Second)))
+	slices.Reverse(c.States)
+	assert.True(t, c.SuspendedAutomatically())
+}
+
+func TestAgent_HealthAt(t *testing.T) {
+	var now = time.Now()
+
+	tests := []struct {
+		name  string
+		agent *Agent
+		want  AgentHealth
+	}{
+		{
+			name:  "Never sent a heartbeat",
+			agent: &Agent{},
+			want:  AgentHealth_AGENT_HEALTH_DEAD,
+		},
+		{
+			name:  "Alive with default interval",
+			agent: &Agent{LastHeartbeatAt: timestamppb.New(now.Add(-45 * time.Second))},
+			want:  AgentHealth_AGENT_HEALTH_ALIVE,
+		},
+		{
+			name:  "Stale with default interval",
+			agent: &Agent{LastHeartbeatAt: timestamppb.New(now.Add(-2 * time.Minute))},
+			want:  AgentHealth_AGENT_HEALTH_STALE,
+		},
+		{
+			name: "Alive with configured interval",
+			agent: &Agent{
+				LastHeartbeatAt: timestamppb.New(now.Add(-2 * time.Minute)),
+				Configuration:   &AgentConfiguration{HeartbeatInterval: 60},
+			},
+			want: AgentHealth_AGENT_HEALTH_ALIVE,
+		},
+		{
+			name: "Dead with configured interval",
+			agent: &Agent{
+				LastHeartbeatAt: timestamppb.New(now.Add(-time.Hour)),
+				Configuration:   &AgentConfiguration{HeartbeatInterval: 60},
+			},
+			want: AgentHealth_AGENT_HEALTH_DEAD,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.agent.HealthAt(now))
+		})
+	}
+}
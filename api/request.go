@@ -44,9 +44,13 @@ type PayloadRequest = api.PayloadRequest
 //   - orchestrator.ListControlsInScopeRequest
 //   - orchestrator.GetCloudServiceRequest
 //   - orchestrator.RemoveCloudServiceRequest
+//   - orchestrator.RestoreCloudServiceRequest
 //   - orchestrator.UpdateMetricConfigurationRequest
 //   - orchestrator.GetMetricConfigurationRequest
 //   - orchestrator.ListMetricConfigurationRequest
+//   - orchestrator.DisableMetricRequest
+//   - orchestrator.EnableMetricRequest
+//   - orchestrator.ListDisabledMetricsRequest
 //   - orchestrator.MetricChangeEvent
 //   - orchestrator.TargetOfEvaluation
 //   - orchestrator.RemoveTargetOfEvaluationRequest
@@ -26,12 +26,19 @@
 package api
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
+	"math/rand"
+	"slices"
+	"strings"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"clouditor.io/clouditor/v2/internal/logging"
+	"clouditor.io/clouditor/v2/internal/telemetry"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/proto"
@@ -45,6 +52,29 @@ var (
 // IDField is the name of the ID field used in protobuf messages
 const IDField = "id"
 
+const (
+	// DefaultStreamBackoff is the default initial delay before a lost stream is re-established. It is doubled with each
+	// failed attempt up to [DefaultStreamMaxBackoff].
+	DefaultStreamBackoff = time.Second
+
+	// DefaultStreamMaxBackoff is the default maximum delay between two attempts to re-establish a lost stream.
+	DefaultStreamMaxBackoff = 30 * time.Second
+
+	// DefaultStreamCloseTimeout is the default time [StreamsOf.CloseAll] waits for a stream to be closed gracefully,
+	// before its context is canceled, e.g., because a message is stuck in flow control.
+	DefaultStreamCloseTimeout = 5 * time.Second
+)
+
+// Events of the life-cycle of a stream. They are logged in the "event" field, together with the component and the
+// target of the stream in the "stream" and "target" fields, so that they can be easily processed by log collectors.
+const (
+	StreamEventEstablished    = "stream_established"
+	StreamEventLost           = "stream_lost"
+	StreamEventReconnecting   = "stream_reconnecting"
+	StreamEventReconnected    = "stream_reconnected"
+	StreamEventReconnectError = "stream_reconnect_failed"
+)
+
 // StreamChannelOf provides a channel around a connection to a grpc.ClientStream to send messages of type MsgType to
 // that particular stream, using an internal go routine. This is necessary, because gRPC does not allow sending to a
 // stream from multiple goroutines directly.
@@ -63,22 +93,94 @@ type StreamChannelOf[StreamType grpc.ClientStream, MsgType proto.Message] struct
 
 	// dead specifies that this channel lost connection and needs to be re-started
 	dead bool
+
+	// init and opts are used to re-establish the stream
+	init InitFuncOfContext[StreamType]
+	opts []grpc.DialOption
+
+	// restartMutex makes sure that the stream is only re-established once, if it is restarted by [StreamsOf.GetStream]
+	// and in the background at the same time
+	restartMutex sync.Mutex
+
+	// deadMutex synchronizes the access to dead
+	deadMutex sync.RWMutex
+
+	// sendMutex synchronizes the access to stream, so that it is not closed by [StreamsOf.CloseAll] while a message is
+	// sent, which gRPC does not allow. If a send blocks, CloseAll cancels the context of the stream after its close
+	// timeout, so that the mutex is released.
+	sendMutex sync.Mutex
+
+	// sent counts the messages that were sent to the stream
+	sent atomic.Uint64
+}
+
+// StreamState describes the state of a single stream of [StreamsOf] for diagnostic purposes, e.g., to find out which
+// stream causes a growing memory usage.
+type StreamState struct {
+	// Component is the name of the component the stream is connected to
+	Component string `json:"component"`
+
+	// Target is the target of the component (host and port usually)
+	Target string `json:"target"`
+
+	// Connected specifies whether the stream is connected or waits to be re-established
+	Connected bool `json:"connected"`
+
+	// Queued is the number of messages that wait to be sent to the stream
+	Queued int `json:"queued"`
+
+	// Sent is the number of messages that were sent to the stream
+	Sent uint64 `json:"sent"`
 }
 
 // InitFuncOf describes a function with type parameters that creates any kind of stream towards a gRPC server specified
 // in target and returns the stream or an error. Additional gRPC dial options can be specified in additionalOpts.
+//
+// Since the stream is not created with the context of [StreamsOf], a send that blocks cannot be canceled by
+// [StreamsOf.CloseAll]. New code should therefore use [InitFuncOfContext] instead.
 type InitFuncOf[StreamType grpc.ClientStream] func(target string, additionalOpts ...grpc.DialOption) (stream StreamType, err error)
 
+// InitFuncOfContext is like [InitFuncOf], but the stream must be created with ctx, so that it can be canceled by
+// [StreamsOf.CloseAll].
+type InitFuncOfContext[StreamType grpc.ClientStream] func(ctx context.Context, target string, additionalOpts ...grpc.DialOption) (stream StreamType, err error)
+
+// withContext returns an [InitFuncOfContext] that calls init and ignores the context.
+func (init InitFuncOf[StreamType]) withContext() InitFuncOfContext[StreamType] {
+	if init == nil {
+		return nil
+	}
+
+	return func(_ context.Context, target string, additionalOpts ...grpc.DialOption) (StreamType, error) {
+		return init(target, additionalOpts...)
+	}
+}
+
 // StreamsOf handles stream channels to multiple gRPC servers, identified by a unique target (usually host and port).
 // Since gRPC does only allow to send to a stream using one goroutine, each stream provides a go channel that can be
 // used to send messages to the particular stream.
 //
 // A stream for a given target can be retrieved with the GetStream function, which automatically initializes the stream
-// if it does not exist.
+// if it does not exist. If a stream is lost, e.g., because of a network failure, it is automatically re-established in
+// the background using an exponential backoff with jitter. Messages sent in the meantime are queued.
 type StreamsOf[StreamType grpc.ClientStream, MsgType proto.Message] struct {
 	mutex    sync.RWMutex
 	channels map[string]*StreamChannelOf[StreamType, MsgType]
 	log      *logrus.Entry
+
+	// backoff is the initial delay before a lost stream is re-established and maxBackoff the maximum delay between
+	// two attempts
+	backoff    time.Duration
+	maxBackoff time.Duration
+
+	// done is closed by CloseAll to stop re-establishing lost streams
+	done      chan struct{}
+	closeOnce sync.Once
+
+	// ctx is the context of all streams. It is canceled by CloseAll, if the streams cannot be closed gracefully
+	// within closeTimeout.
+	ctx          context.Context
+	cancel       context.CancelFunc
+	closeTimeout time.Duration
 }
 
 // StreamsOfOption is a functional option type to configure the StreamOf type.
@@ -92,11 +194,25 @@ func WithLogger[StreamType grpc.ClientStream, MsgType proto.Message](log *logrus
 	}
 }
 
+// WithBackoff can be used to specify the initial delay before a lost stream is re-established in the background and
+// the maximum delay between two attempts. Otherwise, [DefaultStreamBackoff] and [DefaultStreamMaxBackoff] are used.
+func WithBackoff[StreamType grpc.ClientStream, MsgType proto.Message](initial time.Duration, maxDelay time.Duration) StreamsOfOption[StreamType, MsgType] {
+	return func(s *StreamsOf[StreamType, MsgType]) {
+		s.backoff = initial
+		s.maxBackoff = maxDelay
+	}
+}
+
 // NewStreamsOf creates a new StreamsOf object and initializes all the necessary objects for it.
 func NewStreamsOf[StreamType grpc.ClientStream, MsgType proto.Message](opts ...StreamsOfOption[StreamType, MsgType]) (s *StreamsOf[StreamType, MsgType]) {
 	s = &StreamsOf[StreamType, MsgType]{
-		channels: map[string]*StreamChannelOf[StreamType, MsgType]{},
+		channels:     map[string]*StreamChannelOf[StreamType, MsgType]{},
+		backoff:      DefaultStreamBackoff,
+		maxBackoff:   DefaultStreamMaxBackoff,
+		done:         make(chan struct{}),
+		closeTimeout: DefaultStreamCloseTimeout,
 	}
+	s.ctx, s.cancel = context.WithCancel(context.Background())
 
 	// Apply options
 	for _, o := range opts {
@@ -113,8 +229,14 @@ func NewStreamsOf[StreamType grpc.ClientStream, MsgType proto.Message](opts ...S
 
 // GetStream tries to retrieve a stream for the given target and component. If no stream exists, it tries to
 // create a new stream using the supplied init function. An error is returned if the initialization is not
-// successful.
+// successful. Use [StreamsOf.GetStreamContext] to create streams that can be canceled by [StreamsOf.CloseAll].
 func (s *StreamsOf[StreamType, MsgType]) GetStream(target string, component string, init InitFuncOf[StreamType], opts ...grpc.DialOption) (c *StreamChannelOf[StreamType, MsgType], err error) {
+	return s.GetStreamContext(target, component, init.withContext(), opts...)
+}
+
+// GetStreamContext is like [StreamsOf.GetStream], but creates the stream with the context of the streams, so that a
+// blocked send can be canceled by [StreamsOf.CloseAll].
+func (s *StreamsOf[StreamType, MsgType]) GetStreamContext(target string, component string, init InitFuncOfContext[StreamType], opts ...grpc.DialOption) (c *StreamChannelOf[StreamType, MsgType], err error) {
 	var (
 		ok bool
 	)
@@ -130,8 +252,9 @@ func (s *StreamsOf[StreamType, MsgType]) GetStream(target string, component stri
 		if err != nil {
 			return nil, fmt.Errorf("could not add stream for %s with target '%s': %w", component, target, err)
 		}
-	} else if c.dead {
-		// We could have a dead stream that we need to restart. in this case, we can recycle a few things, e.g. the channel
+	} else if c.isDead() {
+		// We could have a dead stream that we need to restart. in this case, we can recycle a few things, e.g. the
+		// channel. If this fails, the stream is still re-established in the background.
 		c, err = s.restartStream(c, init, opts...)
 		if err != nil {
 			return nil, fmt.Errorf("could not restart stream for %s with target '%s': %w", c.component, c.target, err)
@@ -141,22 +264,80 @@ func (s *StreamsOf[StreamType, MsgType]) GetStream(target string, component stri
 	return c, nil
 }
 
-// CloseAll closes all streams
+// CloseAll closes all streams and stops re-establishing lost streams. If a stream cannot be closed within the close
+// timeout, because a message is still being sent, the context of the streams is canceled.
 func (s *StreamsOf[StreamType, MsgType]) CloseAll() {
+	s.closeOnce.Do(func() {
+		if s.done != nil {
+			close(s.done)
+		}
+	})
+
+	// We only hold the lock to retrieve the channels, so that closing them does not block GetStream
+	s.mutex.RLock()
+	channels := make([]*StreamChannelOf[StreamType, MsgType], 0, len(s.channels))
 	for _, channel := range s.channels {
-		_ = channel.stream.CloseSend()
+		channels = append(channels, channel)
 	}
+	s.mutex.RUnlock()
+
+	// Cancelling the context unblocks a pending SendMsg, so that closeSend can acquire the send mutex
+	if s.cancel != nil {
+		timer := time.AfterFunc(s.closeTimeout, s.cancel)
+		defer timer.Stop()
+	}
+
+	for _, channel := range channels {
+		_ = channel.closeSend()
+	}
+}
+
+// CheckHealth returns an error, if any of the streams lost its connection and has not been re-established yet.
+func (s *StreamsOf[StreamType, MsgType]) CheckHealth() (err error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	for _, channel := range s.channels {
+		if channel.isDead() {
+			err = errors.Join(err, fmt.Errorf("stream to %s (%s) is not connected", channel.component, channel.target))
+		}
+	}
+
+	return err
+}
+
+// States returns the current state of all streams, sorted by their target.
+func (s *StreamsOf[StreamType, MsgType]) States() (states []StreamState) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	states = make([]StreamState, 0, len(s.channels))
+	for _, channel := range s.channels {
+		states = append(states, StreamState{
+			Component: channel.component,
+			Target:    channel.target,
+			Connected: !channel.isDead(),
+			Queued:    len(channel.channel),
+			Sent:      channel.sent.Load(),
+		})
+	}
+
+	slices.SortFunc(states, func(a StreamState, b StreamState) int {
+		return strings.Compare(a.Target, b.Target)
+	})
+
+	return states
 }
 
 // addStream stores a stream to the given component and starts a goroutine for sending messages from the channel to the given component
-func (s *StreamsOf[StreamType, MsgType]) addStream(target string, component string, init InitFuncOf[StreamType], opts ...grpc.DialOption) (c *StreamChannelOf[StreamType, MsgType], err error) {
+func (s *StreamsOf[StreamType, MsgType]) addStream(target string, component string, init InitFuncOfContext[StreamType], opts ...grpc.DialOption) (c *StreamChannelOf[StreamType, MsgType], err error) {
 	// We need an init func
 	if init == nil {
 		return nil, ErrMissingInitFunc
 	}
 
 	// Initialize the stream using our init function
-	stream, err := init(target, opts...)
+	stream, err := init(s.context(), target, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("could not init stream: %w", err)
 	}
@@ -167,6 +348,8 @@ func (s *StreamsOf[StreamType, MsgType]) addStream(target string, component stri
 		component: component,
 		target:    target,
 		channel:   make(chan MsgType, 1000),
+		init:      init,
+		opts:      opts,
 	}
 
 	// Update the stream map. This time we need a real lock for an update
@@ -174,10 +357,11 @@ func (s *StreamsOf[StreamType, MsgType]) addStream(target string, component stri
 	s.channels[target] = c
 	s.mutex.Unlock()
 
-	s.log.Infof("Established stream to %s (%s)", component, target)
+	s.eventLog(c, StreamEventEstablished).Infof("Established stream to %s (%s)", component, target)
+	telemetry.StreamsConnected.WithLabelValues(component, target).Set(1)
 
 	// Start go routine for receiving messages from the stream (especially relevant for bi-directional streams).
-	go c.recvLoop(s)
+	go c.recvLoop(s, c.stream)
 
 	// Start go routine for sending messages from the channel to the stream
 	go c.sendLoop(s)
@@ -186,27 +370,45 @@ func (s *StreamsOf[StreamType, MsgType]) addStream(target string, component stri
 }
 
 // restartStream restarts a stream to the given component and starts a goroutine for sending messages from the channel to the given component
-func (s *StreamsOf[StreamType, MsgType]) restartStream(c *StreamChannelOf[StreamType, MsgType], init InitFuncOf[StreamType], opts ...grpc.DialOption) (*StreamChannelOf[StreamType, MsgType], error) {
+func (s *StreamsOf[StreamType, MsgType]) restartStream(c *StreamChannelOf[StreamType, MsgType], init InitFuncOfContext[StreamType], opts ...grpc.DialOption) (*StreamChannelOf[StreamType, MsgType], error) {
 	var err error
 
+	c.restartMutex.Lock()
+	defer c.restartMutex.Unlock()
+
+	// The stream might have been re-established in the meantime
+	if !c.isDead() {
+		return c, nil
+	}
+
 	// We need an init func
 	if init == nil {
 		return nil, ErrMissingInitFunc
 	}
 
-	// Initialize the stream using our init function
-	c.stream, err = init(c.target, opts...)
+	// Initialize the stream using our init function. We keep the old stream, if this fails.
+	stream, err := init(s.context(), c.target, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("could not init stream: %w", err)
 	}
 
+	c.sendMutex.Lock()
+	c.stream = stream
+	c.sendMutex.Unlock()
+
+	// Remember the init function for the next time the stream needs to be re-established
+	c.init = init
+	c.opts = opts
+
 	// Revive the stream
-	c.dead = false
+	c.setDead(false)
 
-	s.log.Infof("Re-Established stream to %s (%s)", c.component, c.target)
+	s.eventLog(c, StreamEventReconnected).Infof("Re-Established stream to %s (%s)", c.component, c.target)
+	telemetry.StreamReconnectsTotal.WithLabelValues(c.component).Inc()
+	telemetry.StreamsConnected.WithLabelValues(c.component, c.target).Set(1)
 
 	// Start go routine for receiving messages from the stream (especially relevant for bi-directional streams).
-	go c.recvLoop(s)
+	go c.recvLoop(s, stream)
 
 	// Start go routine for sending messages from the channel to the stream
 	go c.sendLoop(s)
@@ -226,43 +428,170 @@ func (c *StreamChannelOf[StreamType, MsgType]) sendLoop(s *StreamsOf[StreamType,
 		preq, _ := any(m).(PayloadRequest)
 
 		// Try to send the message in our stream
-		err = c.stream.SendMsg(m)
+		err = c.sendMsg(m)
 		if err != nil {
 			if errors.Is(err, io.EOF) {
-				s.log.Infof("Stream to %s (%s) closed with EOF", c.component, c.target)
+				s.eventLog(c, StreamEventLost).Infof("Stream to %s (%s) closed with EOF", c.component, c.target)
 			} else {
 				// Some other error than EOF occurred
-				s.log.Errorf("Error when sending message to %s (%s): %v", c.component, c.target, err)
+				s.eventLog(c, StreamEventLost).WithError(err).Errorf("Error when sending message to %s (%s): %v", c.component, c.target, err)
 
 				// Close the stream gracefully. We can ignore any error resulting from the close here
-				_ = c.stream.CloseSend()
+				_ = c.closeSend()
 			}
 
 			// Declare the stream as dead
-			c.dead = true
+			c.setDead(true)
+			telemetry.StreamsConnected.WithLabelValues(c.component, c.target).Set(0)
 
 			// Put the message back on the channel, so that it does not get lost
 			go func() {
 				logging.LogRequest(s.log, logrus.DebugLevel, logging.Store, preq, fmt.Sprintf("back into queue for %s (%s)", c.component, c.target))
 				c.channel <- m
 			}()
+
+			// Re-establish the stream in the background, so that the queued messages are sent as soon as possible
+			if init, _ := c.initFunc(); init != nil {
+				go s.reconnect(c)
+			}
+
 			return
 		}
 
+		c.sent.Add(1)
+
 		logging.LogRequest(s.log, logrus.DebugLevel, logging.Send, preq, fmt.Sprintf("to %s (%s)", c.component, c.target))
 	}
 }
 
+// reconnect tries to re-establish the (dead) stream of c until it succeeds or the streams are closed. Between the
+// attempts, it waits for an exponentially increasing delay with jitter, see [StreamsOf.backoffFor].
+func (s *StreamsOf[StreamType, MsgType]) reconnect(c *StreamChannelOf[StreamType, MsgType]) {
+	for attempt := 0; ; attempt++ {
+		delay := s.backoffFor(attempt)
+
+		s.eventLog(c, StreamEventReconnecting).WithFields(logrus.Fields{
+			"attempt": attempt + 1,
+			"backoff": delay.String(),
+		}).Infof("Trying to re-establish stream to %s (%s) in %v", c.component, c.target, delay)
+
+		select {
+		case <-time.After(delay):
+		case <-s.done:
+			return
+		}
+
+		// The stream might have been re-established by GetStream in the meantime
+		if !c.isDead() {
+			return
+		}
+
+		init, opts := c.initFunc()
+
+		_, err := s.restartStream(c, init, opts...)
+		if err == nil {
+			return
+		}
+
+		s.eventLog(c, StreamEventReconnectError).WithError(err).WithField("attempt", attempt+1).
+			Warnf("Could not re-establish stream to %s (%s): %v", c.component, c.target, err)
+	}
+}
+
+// backoffFor returns the delay before the given attempt (starting at 0) to re-establish a stream. The delay is doubled
+// with each attempt up to the maximum backoff. We use a random delay between half and the full backoff ("equal
+// jitter"), so that multiple clients do not reconnect at the same time, e.g., after a restart of the server.
+func (s *StreamsOf[StreamType, MsgType]) backoffFor(attempt int) time.Duration {
+	var (
+		initial  = s.backoff
+		maxDelay = s.maxBackoff
+	)
+
+	if initial <= 0 {
+		initial = DefaultStreamBackoff
+	}
+
+	if maxDelay < initial {
+		maxDelay = initial
+	}
+
+	d := maxDelay
+	if attempt < 32 && initial<<attempt > 0 && initial<<attempt < maxDelay {
+		d = initial << attempt
+	}
+
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)+1))
+}
+
+// context returns the context used to initialize the streams.
+func (s *StreamsOf[StreamType, MsgType]) context() context.Context {
+	if s.ctx == nil {
+		return context.Background()
+	}
+
+	return s.ctx
+}
+
+// eventLog returns a log entry containing the given life-cycle event and the component and target of the stream of c.
+func (s *StreamsOf[StreamType, MsgType]) eventLog(c *StreamChannelOf[StreamType, MsgType], event string) *logrus.Entry {
+	return s.log.WithFields(logrus.Fields{
+		"event":  event,
+		"stream": c.component,
+		"target": c.target,
+	})
+}
+
+// sendMsg sends the message m to the stream.
+func (c *StreamChannelOf[StreamType, MsgType]) sendMsg(m MsgType) error {
+	c.sendMutex.Lock()
+	defer c.sendMutex.Unlock()
+
+	return c.stream.SendMsg(m)
+}
+
+// closeSend closes the sending direction of the stream.
+func (c *StreamChannelOf[StreamType, MsgType]) closeSend() error {
+	c.sendMutex.Lock()
+	defer c.sendMutex.Unlock()
+
+	return c.stream.CloseSend()
+}
+
+// initFunc returns the init function and the dial options that are used to re-establish the stream. They are
+// replaced, if the stream is restarted by [StreamsOf.GetStreamContext].
+func (c *StreamChannelOf[StreamType, MsgType]) initFunc() (InitFuncOfContext[StreamType], []grpc.DialOption) {
+	c.restartMutex.Lock()
+	defer c.restartMutex.Unlock()
+
+	return c.init, c.opts
+}
+
+// isDead returns whether the stream lost its connection and has not been re-established yet.
+func (c *StreamChannelOf[StreamType, MsgType]) isDead() bool {
+	c.deadMutex.RLock()
+	defer c.deadMutex.RUnlock()
+
+	return c.dead
+}
+
+// setDead declares the stream as dead or alive.
+func (c *StreamChannelOf[StreamType, MsgType]) setDead(dead bool) {
+	c.deadMutex.Lock()
+	defer c.deadMutex.Unlock()
+
+	c.dead = dead
+}
+
 // recvLoop continuously receives message from the stream. Currently, they are just discarded. In the future, we might
 // want to send them back to the caller. But we need to receive them, otherwise the buffer of the stream gets congested.
-func (c *StreamChannelOf[StreamType, MsgType]) recvLoop(s *StreamsOf[StreamType, MsgType]) {
+func (c *StreamChannelOf[StreamType, MsgType]) recvLoop(s *StreamsOf[StreamType, MsgType], stream StreamType) {
 	for {
 		// TODO(oxisto): Check, if this also works for uni-directional streams
 		// emptypb.Empty is used for now to give a correctly typed message to RecvMsg. In the future, use
 		// types of response message of respective RPCs.
 
 		msg := new(emptypb.Empty)
-		err := c.stream.RecvMsg(msg)
+		err := stream.RecvMsg(msg)
 
 		if errors.Is(err, io.EOF) {
 			break
@@ -31,6 +31,7 @@ import (
 	"io"
 	sync "sync"
 	"testing"
+	"time"
 
 	"clouditor.io/clouditor/v2/api/assessment"
 	"clouditor.io/clouditor/v2/api/evidence"
@@ -154,6 +155,56 @@ func TestStreamsOf_GetStream(t *testing.T) {
 	}
 }
 
+func TestStreamsOf_CheckHealth(t *testing.T) {
+	type fields struct {
+		channels map[string]*StreamChannelOf[*recordedClientStream, proto.Message]
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		wantErr assert.WantErr
+	}{
+		{
+			name: "no streams",
+			fields: fields{
+				channels: map[string]*StreamChannelOf[*recordedClientStream, proto.Message]{},
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "alive stream",
+			fields: fields{
+				channels: map[string]*StreamChannelOf[*recordedClientStream, proto.Message]{
+					"mock:1234": {target: "mock:1234", component: "mock"},
+				},
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "dead stream",
+			fields: fields{
+				channels: map[string]*StreamChannelOf[*recordedClientStream, proto.Message]{
+					"mock:1234": {target: "mock:1234", component: "mock"},
+					"mock:5678": {target: "mock:5678", component: "other mock", dead: true},
+				},
+			},
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "stream to other mock (mock:5678) is not connected")
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &StreamsOf[*recordedClientStream, proto.Message]{
+				channels: tt.fields.channels,
+				log:      defaultLog(),
+			}
+			err := s.CheckHealth()
+			tt.wantErr(t, err)
+		})
+	}
+}
+
 func Test_StreamChannelOf_sendLoop(t *testing.T) {
 	type args struct {
 		s *StreamsOf[*mockClientStream, proto.Message]
@@ -238,6 +289,127 @@ func Test_StreamChannelOf_sendLoop(t *testing.T) {
 	}
 }
 
+func TestStreamsOf_reconnect(t *testing.T) {
+	var (
+		calls int
+		good  = &recordedClientStream{}
+		init  = func(target string, additionalOpts ...grpc.DialOption) (stream *recordedClientStream, err error) {
+			calls++
+
+			switch calls {
+			case 1:
+				// The first stream is lost on the first message
+				return &recordedClientStream{mockClientStream: mockClientStream{sendErr: ErrSomeError}}, nil
+			case 2:
+				// The first attempt to re-establish the stream fails
+				return nil, ErrSomeError
+			default:
+				return good, nil
+			}
+		}
+	)
+
+	s := NewStreamsOf(WithBackoff[*recordedClientStream, proto.Message](time.Millisecond, 2*time.Millisecond))
+	defer s.CloseAll()
+
+	c, err := s.GetStream("mock:1234", "mock", init)
+	assert.NoError(t, err)
+
+	// The message is sent once the stream is re-established in the background
+	good.wg.Add(1)
+	c.Send(&assessment.AssessEvidenceRequest{Evidence: &evidence.Evidence{Id: testdata.MockEvidenceID1}})
+	good.wg.Wait()
+
+	assert.Equal(t, 3, calls)
+	assert.Equal(t, 1, len(good.recvd))
+	assert.NoError(t, s.CheckHealth())
+}
+
+func TestStreamsOf_States(t *testing.T) {
+	var (
+		recorded = &recordedClientStream{}
+		init     = func(target string, additionalOpts ...grpc.DialOption) (stream *recordedClientStream, err error) {
+			return recorded, nil
+		}
+	)
+
+	s := NewStreamsOf[*recordedClientStream, proto.Message]()
+	defer s.CloseAll()
+
+	c, err := s.GetStream("mock:2", "mock", init)
+	assert.NoError(t, err)
+
+	_, err = s.GetStream("mock:1", "mock", init)
+	assert.NoError(t, err)
+
+	recorded.wg.Add(1)
+	c.Send(&assessment.AssessEvidenceRequest{Evidence: &evidence.Evidence{Id: testdata.MockEvidenceID1}})
+	recorded.wg.Wait()
+
+	// The message is counted right after it was sent
+	assert.Eventually(t, func() bool {
+		return c.sent.Load() == 1
+	}, time.Second, time.Millisecond)
+
+	assert.Equal(t, []StreamState{
+		{Component: "mock", Target: "mock:1", Connected: true},
+		{Component: "mock", Target: "mock:2", Connected: true, Sent: 1},
+	}, s.States())
+}
+
+func TestStreamsOf_CloseAll(t *testing.T) {
+	var (
+		blocking = &blockingClientStream{sending: make(chan struct{})}
+		init     = func(ctx context.Context, target string, additionalOpts ...grpc.DialOption) (stream *blockingClientStream, err error) {
+			blocking.ctx = ctx
+			return blocking, nil
+		}
+	)
+
+	s := NewStreamsOf[*blockingClientStream, proto.Message]()
+	s.closeTimeout = 10 * time.Millisecond
+
+	c, err := s.GetStreamContext("mock", "mock", init)
+	assert.NoError(t, err)
+
+	// The message is stuck in the stream, e.g., because of flow control
+	c.Send(&assessment.AssessEvidenceRequest{Evidence: &evidence.Evidence{Id: testdata.MockEvidenceID1}})
+	<-blocking.sending
+
+	closed := make(chan struct{})
+	go func() {
+		s.CloseAll()
+		close(closed)
+	}()
+
+	select {
+	case <-closed:
+	case <-time.After(time.Second):
+		t.Fatal("CloseAll did not return while a message was being sent")
+	}
+
+	assert.ErrorIs(t, blocking.ctx.Err(), context.Canceled)
+}
+
+func TestStreamsOf_backoffFor(t *testing.T) {
+	s := NewStreamsOf(WithBackoff[*recordedClientStream, proto.Message](time.Second, 4*time.Second))
+
+	tests := []struct {
+		attempt  int
+		min, max time.Duration
+	}{
+		{attempt: 0, min: 500 * time.Millisecond, max: time.Second},
+		{attempt: 1, min: time.Second, max: 2 * time.Second},
+		{attempt: 2, min: 2 * time.Second, max: 4 * time.Second},
+		{attempt: 3, min: 2 * time.Second, max: 4 * time.Second},
+		{attempt: 100, min: 2 * time.Second, max: 4 * time.Second},
+	}
+	for _, tt := range tests {
+		got := s.backoffFor(tt.attempt)
+		assert.True(t, got >= tt.min && got <= tt.max, "attempt %d: %v not in [%v, %v]", tt.attempt, got, tt.min, tt.max)
+	}
+}
+
 type mockClientStream struct {
 	sendErr error
 }
@@ -291,7 +463,25 @@ type recordedClientStream struct {
 }
 
 func (r *recordedClientStream) SendMsg(msg interface{}) error {
+	if r.sendErr != nil {
+		return r.sendErr
+	}
+
 	r.recvd = append(r.recvd, msg.(proto.Message))
 	r.wg.Done()
 	return nil
 }
+
+type blockingClientStream struct {
+	mockClientStream
+	ctx     context.Context
+	sending chan struct{}
+}
+
+// SendMsg blocks until the context of the stream is canceled.
+func (b *blockingClientStream) SendMsg(interface{}) error {
+	close(b.sending)
+	<-b.ctx.Done()
+
+	return b.ctx.Err()
+}
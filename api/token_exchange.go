@@ -0,0 +1,144 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+const (
+	// GrantTypeTokenExchange is the grant type of the OAuth 2.0 token exchange (RFC 8693).
+	GrantTypeTokenExchange = "urn:ietf:params:oauth:grant-type:token-exchange"
+
+	// TokenTypeAccessToken is the token type of an OAuth 2.0 access token in a token exchange.
+	TokenTypeAccessToken = "urn:ietf:params:oauth:token-type:access_token"
+)
+
+// tokenExchangeResponse is the response of a successful token exchange.
+type tokenExchangeResponse struct {
+	AccessToken     string `json:"access_token"`
+	IssuedTokenType string `json:"issued_token_type"`
+	TokenType       string `json:"token_type"`
+	ExpiresIn       int64  `json:"expires_in"`
+	Scope           string `json:"scope"`
+}
+
+// tokenExchangeSource is an [oauth2.TokenSource] that exchanges the token of the client credentials for a token that
+// is restricted to the given scopes.
+type tokenExchangeSource struct {
+	config  *clientcredentials.Config
+	subject oauth2.TokenSource
+	scopes  []string
+	client  *http.Client
+}
+
+// NewOAuthAuthorizerFromTokenExchange creates a new authorizer that retrieves a token using the OAuth 2.0 client
+// credentials and exchanges it at the same token endpoint for a token that is restricted to the given scopes, using
+// the OAuth 2.0 token exchange (RFC 8693). This way, a service that shares the client credentials with other services
+// only uses a token with the privileges it needs. The exchanged token is refreshed once it expires.
+func NewOAuthAuthorizerFromTokenExchange(config *clientcredentials.Config, scopes ...string) Authorizer {
+	var authorizer = &oauthAuthorizer{
+		TokenSource: oauth2.ReuseTokenSource(nil, &tokenExchangeSource{
+			config:  config,
+			subject: oauth2.ReuseTokenSource(nil, config.TokenSource(context.Background())),
+			scopes:  scopes,
+			client:  &http.Client{Timeout: 30 * time.Second},
+		}),
+	}
+
+	return authorizer
+}
+
+// Token implements [oauth2.TokenSource].
+func (s *tokenExchangeSource) Token() (token *oauth2.Token, err error) {
+	var res tokenExchangeResponse
+
+	subject, err := s.subject.Token()
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve subject token: %w", err)
+	}
+
+	form := url.Values{
+		"grant_type":           {GrantTypeTokenExchange},
+		"subject_token":        {subject.AccessToken},
+		"subject_token_type":   {TokenTypeAccessToken},
+		"requested_token_type": {TokenTypeAccessToken},
+		"scope":                {strings.Join(s.scopes, " ")},
+	}
+
+	req, err := http.NewRequest(http.MethodPost, s.config.TokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth(url.QueryEscape(s.config.ClientID), url.QueryEscape(s.config.ClientSecret))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("could not exchange token: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		var oauthErr struct {
+			Error string `json:"error"`
+		}
+
+		_ = json.NewDecoder(resp.Body).Decode(&oauthErr)
+
+		return nil, fmt.Errorf("could not exchange token: unexpected status code %d (%s)", resp.StatusCode, oauthErr.Error)
+	}
+
+	err = json.NewDecoder(resp.Body).Decode(&res)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode exchanged token: %w", err)
+	}
+
+	if res.AccessToken == "" {
+		return nil, errors.New("could not exchange token: empty access token")
+	}
+
+	token = &oauth2.Token{
+		AccessToken: res.AccessToken,
+		TokenType:   res.TokenType,
+	}
+
+	if res.ExpiresIn > 0 {
+		token.Expiry = time.Now().Add(time.Duration(res.ExpiresIn) * time.Second)
+	}
+
+	return token, nil
+}
@@ -26,9 +26,12 @@
 package api
 
 import (
+	"errors"
+
 	"clouditor.io/clouditor/v2/internal/util"
 
 	"github.com/bufbuild/protovalidate-go"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
@@ -46,6 +49,10 @@ func init() {
 //   - The request is validated according to the generated validation method
 //   - Lastly, if the request is a [api.PaginatedRequest], an additional check is performed to ensure only valid columns are listed
 //
+// If the validation fails, the individual constraint violations are attached to the error as
+// [errdetails.BadRequest] field violations, so that clients can retrieve them using [FieldViolations] instead of
+// parsing the error message.
+//
 // Note: This function already returns a gRPC error, so the error can be returned directly without any wrapping in a
 // request function.
 func Validate(req IncomingRequest) (err error) {
@@ -60,12 +67,59 @@ func Validate(req IncomingRequest) (err error) {
 	// Validate request
 	err = validator.Validate(req)
 	if err != nil {
-		return status.Errorf(codes.InvalidArgument, "%v: %v", ErrInvalidRequest, err)
+		return invalidRequestError(err)
 	}
 
 	return nil
 }
 
+// invalidRequestError converts a validation error into a gRPC error with code [codes.InvalidArgument]. If err is a
+// [protovalidate.ValidationError], its violations are added as [errdetails.BadRequest] to the status details.
+func invalidRequestError(err error) error {
+	var (
+		valErr *protovalidate.ValidationError
+		br     errdetails.BadRequest
+	)
+
+	st := status.Convert(ErrorCodeValidationFailed.Errorf("%v: %v", ErrInvalidRequest, err))
+
+	if !errors.As(err, &valErr) {
+		return st.Err()
+	}
+
+	for _, v := range valErr.Violations {
+		br.FieldViolations = append(br.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       v.GetFieldPath(),
+			Description: v.GetMessage(),
+		})
+	}
+
+	// Fall back to the plain status, if the details cannot be attached for some reason
+	if withDetails, err := st.WithDetails(&br); err == nil {
+		st = withDetails
+	}
+
+	return st.Err()
+}
+
+// FieldViolations returns the [errdetails.BadRequest] field violations contained in the details of the gRPC error
+// err, e.g., as returned by [Validate]. If err is not a gRPC error or does not contain any field violations, nil is
+// returned.
+func FieldViolations(err error) (violations []*errdetails.BadRequest_FieldViolation) {
+	st, ok := status.FromError(err)
+	if !ok {
+		return nil
+	}
+
+	for _, d := range st.Details() {
+		if br, ok := d.(*errdetails.BadRequest); ok {
+			violations = append(violations, br.GetFieldViolations()...)
+		}
+	}
+
+	return
+}
+
 type IncomingRequest interface {
 	proto.Message
 }
@@ -30,6 +30,9 @@ import (
 
 	"clouditor.io/clouditor/v2/api/orchestrator"
 	"clouditor.io/clouditor/v2/internal/testutil/assert"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestValidate(t *testing.T) {
@@ -70,6 +73,24 @@ func TestValidate(t *testing.T) {
 				return assert.Contains(t, err.Error(), "invalid request")
 			},
 		},
+		{
+			name: "Invalid request with field violations",
+			args: args{
+				req: &orchestrator.CreateTargetOfEvaluationRequest{
+					TargetOfEvaluation: &orchestrator.TargetOfEvaluation{
+						CatalogId: "0000",
+					},
+				},
+			},
+			wantErr: func(tt assert.TestingT, err error, i ...interface{}) bool {
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+				violations := FieldViolations(err)
+				return assert.Equal(t, 1, len(violations)) &&
+					assert.Equal(t, "target_of_evaluation.cloud_service_id", violations[0].Field) &&
+					assert.Equal(t, "value is empty, which is not a valid UUID", violations[0].Description)
+			},
+		},
 		{
 			name: "Happy path",
 			args: args{
@@ -90,3 +111,34 @@ func TestValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestFieldViolations(t *testing.T) {
+	type args struct {
+		err error
+	}
+	tests := []struct {
+		name string
+		args args
+		want int
+	}{
+		{
+			name: "no gRPC error",
+			args: args{
+				err: ErrInvalidRequest,
+			},
+			want: 0,
+		},
+		{
+			name: "gRPC error without details",
+			args: args{
+				err: status.Errorf(codes.InvalidArgument, "%v", ErrInvalidRequest),
+			},
+			want: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, len(FieldViolations(tt.args.err)))
+		})
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package agent
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"testing"
+
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/cli"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/testutil/clitest"
+	"clouditor.io/clouditor/v2/server"
+	service_orchestrator "clouditor.io/clouditor/v2/service/orchestrator"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func TestMain(m *testing.M) {
+	clitest.AutoChdir()
+
+	svc := service_orchestrator.NewService()
+	_, err := svc.RegisterAgent(context.Background(), &orchestrator.RegisterAgentRequest{
+		Agent: &orchestrator.Agent{
+			ToolId:         "my-collector",
+			CloudServiceId: testdata.MockCloudServiceID1,
+		},
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	os.Exit(clitest.RunCLITest(m, server.WithOrchestrator(svc)))
+}
+
+func TestListAgents(t *testing.T) {
+	var err error
+	var b bytes.Buffer
+
+	cli.Output = &b
+
+	cmd := NewListAgentsCommand()
+	err = cmd.RunE(nil, []string{})
+	assert.NoError(t, err)
+
+	var response = &orchestrator.ListAgentsResponse{}
+	err = protojson.Unmarshal(b.Bytes(), response)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(response.Agents))
+	assert.Equal(t, orchestrator.AgentHealth_AGENT_HEALTH_ALIVE, response.Agents[0].Health)
+}
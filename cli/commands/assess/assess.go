@@ -0,0 +1,268 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package assess
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"clouditor.io/clouditor/v2/api"
+	"clouditor.io/clouditor/v2/api/assessment"
+	"clouditor.io/clouditor/v2/api/discovery"
+	"clouditor.io/clouditor/v2/api/evidence"
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/cli"
+	"clouditor.io/clouditor/v2/internal/util"
+	"clouditor.io/clouditor/v2/policies"
+	service_orchestrator "clouditor.io/clouditor/v2/service/orchestrator"
+
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// NewAssessFileCommand returns a cobra command for the `file` subcommand
+func NewAssessFileCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "file [file]",
+		Short: "Assesses the evidences of a JSON or NDJSON file locally using the built-in metrics",
+		Long: "Assesses the evidences of a JSON or NDJSON file (or the standard input, if the file is -) locally " +
+			"using the built-in metrics, without connecting to a Clouditor instance. The metric implementations are " +
+			"loaded from the policies folder in the current directory. The command fails with exit code 1, if any " +
+			"assessment result is non-compliant.",
+		Args: cobra.ExactArgs(1),
+		// Non-compliant results are not an error in the usage of the command
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var (
+				err       error
+				evidences []*evidence.Evidence
+				results   []*assessment.AssessmentResult
+			)
+
+			evidences, err = readEvidences(args[0])
+			if err != nil {
+				return err
+			}
+
+			results, err = assessEvidences(evidences)
+			if err != nil {
+				return err
+			}
+
+			err = cli.PrintResponse(&orchestrator.ListAssessmentResultsResponse{Results: results})
+			if err != nil {
+				return err
+			}
+
+			var nonCompliant int
+			for _, r := range results {
+				if !r.Compliant {
+					nonCompliant++
+				}
+			}
+
+			if nonCompliant > 0 {
+				return fmt.Errorf("%w: %d of %d assessment results", cli.ErrNonCompliant, nonCompliant, len(results))
+			}
+
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// readEvidences reads the evidences of a file, which contains either a single evidence, a JSON array of evidences or
+// one evidence per line (NDJSON). If the file is "-", the evidences are read from the standard input.
+func readEvidences(file string) (evidences []*evidence.Evidence, err error) {
+	var r io.Reader
+
+	if file == "-" {
+		r = os.Stdin
+	} else {
+		f, err := os.Open(file)
+		if err != nil {
+			return nil, fmt.Errorf("could not open evidence file: %w", err)
+		}
+		defer f.Close()
+
+		r = f
+	}
+
+	dec := json.NewDecoder(r)
+	for {
+		var (
+			raw  json.RawMessage
+			list []json.RawMessage
+		)
+
+		err = dec.Decode(&raw)
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("could not decode evidence file: %w", err)
+		}
+
+		if bytes.HasPrefix(bytes.TrimSpace(raw), []byte("[")) {
+			err = json.Unmarshal(raw, &list)
+			if err != nil {
+				return nil, fmt.Errorf("could not decode evidence file: %w", err)
+			}
+		} else {
+			list = []json.RawMessage{raw}
+		}
+
+		for _, b := range list {
+			var ev evidence.Evidence
+
+			err = protojson.Unmarshal(b, &ev)
+			if err != nil {
+				return nil, fmt.Errorf("could not decode evidence %d: %w", len(evidences)+1, err)
+			}
+
+			evidences = append(evidences, &ev)
+		}
+	}
+
+	return evidences, nil
+}
+
+// assessEvidences assesses the evidences using the metrics of an in-memory orchestrator, which loads the built-in
+// metrics, in the same way as the assessment service.
+func assessEvidences(evidences []*evidence.Evidence) (results []*assessment.AssessmentResult, err error) {
+	var (
+		svc      = service_orchestrator.NewService()
+		src      = svc.MetricsSource()
+		pe       = policies.NewRegoEval()
+		metrics  []*assessment.Metric
+		versions = make(map[string]string)
+	)
+
+	metrics, err = src.Metrics()
+	if err != nil {
+		return nil, fmt.Errorf("could not load metrics: %w", err)
+	}
+
+	for _, m := range metrics {
+		versions[m.Id] = m.Version
+	}
+
+	for _, ev := range evidences {
+		r, err := assessEvidence(pe, src, ev)
+		if err != nil {
+			return nil, fmt.Errorf("could not assess evidence %s: %w", ev.Id, err)
+		}
+
+		for _, result := range r {
+			result.MetricVersion = versions[result.MetricId]
+		}
+
+		results = append(results, r...)
+	}
+
+	return results, nil
+}
+
+// assessEvidence assesses a single evidence. Evidences without a cloud service are assessed as part of the default
+// cloud service.
+func assessEvidence(pe policies.PolicyEval, src policies.MetricsSource, ev *evidence.Evidence) (results []*assessment.AssessmentResult, err error) {
+	if ev.CloudServiceId == "" {
+		ev.CloudServiceId = discovery.DefaultCloudServiceID
+	}
+
+	err = ontology.CheckVersion(ev.OntologyVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	if ev.Resource == nil {
+		return nil, errors.New("evidence does not contain a resource")
+	}
+
+	m, err := ev.Resource.UnmarshalNew()
+	if err != nil {
+		return nil, fmt.Errorf("could not unmarshal resource: %w", err)
+	}
+
+	err = api.Validate(m)
+	if err != nil {
+		return nil, err
+	}
+
+	resource, ok := m.(ontology.IsResource)
+	if !ok {
+		return nil, discovery.ErrNotOntologyResource
+	}
+
+	evaluations, err := pe.Eval(ev, resource, src)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, data := range evaluations {
+		results = append(results, &assessment.AssessmentResult{
+			Id:                    uuid.NewString(),
+			Timestamp:             timestamppb.Now(),
+			CloudServiceId:        ev.GetCloudServiceId(),
+			MetricId:              data.MetricID,
+			MetricConfiguration:   data.Config,
+			Compliant:             data.Compliant,
+			EvidenceId:            ev.GetId(),
+			ResourceId:            resource.GetId(),
+			ResourceTypes:         ontology.ResourceTypes(resource),
+			NonComplianceComments: "No comments so far",
+			ToolId:                util.Ref(assessment.AssessmentToolId),
+		})
+	}
+
+	return results, nil
+}
+
+// NewAssessCommand returns a cobra command for `assess` subcommands
+func NewAssessCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "assess",
+		Short: "Local assessment commands",
+	}
+
+	AddCommands(cmd)
+
+	return cmd
+}
+
+// AddCommands adds all subcommands
+func AddCommands(cmd *cobra.Command) {
+	cmd.AddCommand(
+		NewAssessFileCommand(),
+	)
+}
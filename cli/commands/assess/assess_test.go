@@ -0,0 +1,161 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package assess
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"clouditor.io/clouditor/v2/api/evidence"
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/cli"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/testutil/clitest"
+	"clouditor.io/clouditor/v2/internal/testutil/prototest"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func TestMain(m *testing.M) {
+	// The metric implementations are loaded relative to the root of the repository
+	clitest.AutoChdir()
+
+	os.Exit(m.Run())
+}
+
+// writeEvidences writes the evidences as NDJSON into a temporary file.
+func writeEvidences(t *testing.T, evidences ...*evidence.Evidence) (file string) {
+	var b bytes.Buffer
+
+	for _, ev := range evidences {
+		j, err := protojson.Marshal(ev)
+		assert.NoError(t, err)
+
+		b.Write(j)
+		b.WriteString("\n")
+	}
+
+	file = filepath.Join(t.TempDir(), "evidences.ndjson")
+	assert.NoError(t, os.WriteFile(file, b.Bytes(), 0600))
+
+	return file
+}
+
+func newMockEvidence(t *testing.T, enabled bool) *evidence.Evidence {
+	return &evidence.Evidence{
+		Id:             testdata.MockEvidenceID1,
+		CloudServiceId: testdata.MockCloudServiceID1,
+		ToolId:         testdata.MockEvidenceToolID1,
+		Resource: prototest.NewAny(t, &ontology.VirtualMachine{
+			Id:   testdata.MockResourceID1,
+			Name: testdata.MockResourceName1,
+			BootLogging: &ontology.BootLogging{
+				Enabled: enabled,
+			},
+		}),
+	}
+}
+
+func TestNewAssessFileCommand(t *testing.T) {
+	tests := []struct {
+		name      string
+		evidences []*evidence.Evidence
+		want      assert.Want[*orchestrator.ListAssessmentResultsResponse]
+		wantErr   assert.WantErr
+	}{
+		{
+			name:      "non-compliant",
+			evidences: []*evidence.Evidence{newMockEvidence(t, false)},
+			want: func(t *testing.T, got *orchestrator.ListAssessmentResultsResponse) bool {
+				for _, r := range got.Results {
+					if r.MetricId == "BootLoggingEnabled" {
+						return assert.False(t, r.Compliant) &&
+							assert.Equal(t, testdata.MockResourceID1, r.ResourceId) &&
+							assert.Equal(t, testdata.MockCloudServiceID1, r.CloudServiceId)
+					}
+				}
+
+				t.Errorf("No result for BootLoggingEnabled")
+				return false
+			},
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, cli.ErrNonCompliant) &&
+					assert.Equal(t, cli.ExitCodeNonCompliant, cli.ExitCode(err))
+			},
+		},
+		{
+			name:      "no evidences",
+			evidences: nil,
+			want: func(t *testing.T, got *orchestrator.ListAssessmentResultsResponse) bool {
+				return assert.Equal(t, 0, len(got.Results))
+			},
+			wantErr: assert.Nil[error],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var b bytes.Buffer
+
+			cli.Output = &b
+
+			cmd := NewAssessFileCommand()
+			err := cmd.RunE(nil, []string{writeEvidences(t, tt.evidences...)})
+			tt.wantErr(t, err)
+
+			var res orchestrator.ListAssessmentResultsResponse
+			assert.NoError(t, protojson.Unmarshal(b.Bytes(), &res))
+			tt.want(t, &res)
+		})
+	}
+}
+
+func Test_readEvidences(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "evidences.json")
+	assert.NoError(t, os.WriteFile(file, []byte(`[{"id": "1"}, {"id": "2"}]
+{"id": "3"}`), 0600))
+
+	got, err := readEvidences(file)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(got))
+	assert.Equal(t, "3", got[2].Id)
+
+	assert.NoError(t, os.WriteFile(file, []byte(`{"unknownField": true}`), 0600))
+
+	_, err = readEvidences(file)
+	assert.ErrorContains(t, err, "could not decode evidence 1")
+}
+
+func Test_assessEvidence(t *testing.T) {
+	_, err := assessEvidence(nil, nil, &evidence.Evidence{OntologyVersion: "0.1"})
+	assert.ErrorIs(t, err, ontology.ErrIncompatibleVersion)
+
+	_, err = assessEvidence(nil, nil, &evidence.Evidence{})
+	assert.ErrorContains(t, err, "evidence does not contain a resource")
+}
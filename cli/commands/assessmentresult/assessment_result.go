@@ -39,6 +39,14 @@ func NewListAssessmentResultsCommand() *cobra.Command {
 	return cmd
 }
 
+// NewWatchAssessmentResultsCommand returns a cobra command for the `watch` subcommand
+func NewWatchAssessmentResultsCommand() *cobra.Command {
+	// Use Orchestrator's function for subscribing to assessment results
+	cmd := orchestrator.NewSubscribeAssessmentResultsCommand()
+	cmd.Use = "watch"
+	return cmd
+}
+
 // NewAssessmentResultCommand returns a cobra command for `assessment` subcommands
 func NewAssessmentResultCommand() *cobra.Command {
 	cmd := &cobra.Command{
@@ -55,5 +63,6 @@ func NewAssessmentResultCommand() *cobra.Command {
 func AddCommands(cmd *cobra.Command) {
 	cmd.AddCommand(
 		NewListAssessmentResultsCommand(),
+		NewWatchAssessmentResultsCommand(),
 	)
 }
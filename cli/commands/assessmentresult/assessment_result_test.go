@@ -31,6 +31,7 @@ import (
 	"encoding/json"
 	"os"
 	"testing"
+	"time"
 
 	"clouditor.io/clouditor/v2/api/assessment"
 	"clouditor.io/clouditor/v2/api/orchestrator"
@@ -115,6 +116,22 @@ func TestNewListResultsCommand(t *testing.T) {
 	assert.NotEmpty(t, response.Results)
 }
 
+func TestNewWatchAssessmentResultsCommand(t *testing.T) {
+	var b bytes.Buffer
+
+	cli.Output = &b
+
+	// Stop watching after a short time, since no new results are stored
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	cmd := NewWatchAssessmentResultsCommand()
+	cmd.SetContext(ctx)
+	err := cmd.RunE(cmd, []string{})
+	assert.NoError(t, err)
+	assert.Equal(t, 0, b.Len())
+}
+
 func toStruct(f float32) (s *structpb.Value) {
 	var (
 		b   []byte
@@ -40,6 +40,46 @@ func NewListCatalogsCommand() *cobra.Command {
 	return cmd
 }
 
+// NewListBuiltinCatalogsCommand returns a cobra command for the `list-builtin` subcommand
+func NewListBuiltinCatalogsCommand() *cobra.Command {
+	// Use Orchestrator's function for listing built-in catalogs
+	cmd := orchestrator.NewListBuiltinCatalogsCommand()
+
+	// Change use for better readability
+	cmd.Use = "list-builtin"
+	return cmd
+}
+
+// NewLoadBuiltinCatalogCommand returns a cobra command for the `load` subcommand
+func NewLoadBuiltinCatalogCommand() *cobra.Command {
+	// Use Orchestrator's function for loading a built-in catalog
+	cmd := orchestrator.NewLoadBuiltinCatalogCommand()
+
+	// Change use for better readability
+	cmd.Use = "load [catalog ID]"
+	return cmd
+}
+
+// NewImportOscalCatalogCommand returns a cobra command for the `import-oscal` subcommand
+func NewImportOscalCatalogCommand() *cobra.Command {
+	// Use Orchestrator's function for importing an OSCAL catalog
+	cmd := orchestrator.NewImportOscalCatalogCommand()
+
+	// Change use for better readability
+	cmd.Use = "import-oscal [file]"
+	return cmd
+}
+
+// NewGetCatalogCoverageCommand returns a cobra command for the `coverage` subcommand
+func NewGetCatalogCoverageCommand() *cobra.Command {
+	// Use Orchestrator's function for reporting the coverage of a catalog
+	cmd := orchestrator.NewGetCatalogCoverageCommand()
+
+	// Change use for better readability
+	cmd.Use = "coverage [catalog ID]"
+	return cmd
+}
+
 // NewCatalogCommand returns a cobra command for `catalog` subcommands
 func NewCatalogCommand() *cobra.Command {
 	cmd := &cobra.Command{
@@ -56,5 +96,9 @@ func NewCatalogCommand() *cobra.Command {
 func AddCommands(cmd *cobra.Command) {
 	cmd.AddCommand(
 		NewListCatalogsCommand(),
+		NewListBuiltinCatalogsCommand(),
+		NewLoadBuiltinCatalogCommand(),
+		NewImportOscalCatalogCommand(),
+		NewGetCatalogCoverageCommand(),
 	)
 }
@@ -0,0 +1,195 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package certificate
+
+import (
+	"context"
+	"fmt"
+
+	"clouditor.io/clouditor/v2/api"
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/cli"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// NewListCertificatesCommand returns a cobra command for the `list` subcommand
+func NewListCertificatesCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "Lists all certificates",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var (
+				err     error
+				session *cli.Session
+				client  orchestrator.OrchestratorClient
+				res     *orchestrator.ListCertificatesResponse
+				certs   []*orchestrator.Certificate
+			)
+
+			if session, err = cli.ContinueSession(); err != nil {
+				fmt.Printf("Error while retrieving the session. Please re-authenticate.\n")
+				return nil
+			}
+
+			client = orchestrator.NewOrchestratorClient(session)
+
+			certs, err = api.ListAllPaginated(&orchestrator.ListCertificatesRequest{}, client.ListCertificates, func(res *orchestrator.ListCertificatesResponse) []*orchestrator.Certificate {
+				return res.Certificates
+			})
+
+			// Build a response with all certificates
+			res = &orchestrator.ListCertificatesResponse{
+				Certificates: certs,
+			}
+
+			return session.HandleResponse(res, err)
+		},
+		ValidArgsFunction: cli.DefaultArgsShellComp,
+	}
+
+	return cmd
+}
+
+// NewShowCertificateCommand returns a cobra command for the `show` subcommand
+func NewShowCertificateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show [id]",
+		Short: "Get details of a certificate, including its state history",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var (
+				err     error
+				session *cli.Session
+				client  orchestrator.OrchestratorClient
+				res     *orchestrator.Certificate
+			)
+
+			if session, err = cli.ContinueSession(); err != nil {
+				fmt.Printf("Error while retrieving the session. Please re-authenticate.\n")
+				return nil
+			}
+
+			client = orchestrator.NewOrchestratorClient(session)
+
+			res, err = client.GetCertificate(context.Background(), &orchestrator.GetCertificateRequest{
+				CertificateId: args[0],
+			})
+
+			return session.HandleResponse(res, err)
+		},
+		ValidArgsFunction: cli.DefaultArgsShellComp,
+	}
+
+	return cmd
+}
+
+// NewSuspendCertificateCommand returns a cobra command for the `suspend` subcommand
+func NewSuspendCertificateCommand() *cobra.Command {
+	return newUpdateCertificateStateCommand("suspend [id]", "Suspends a certificate",
+		orchestrator.CertificateState_CERTIFICATE_STATE_SUSPENDED)
+}
+
+// NewIssueCertificateCommand returns a cobra command for the `issue` subcommand
+func NewIssueCertificateCommand() *cobra.Command {
+	return newUpdateCertificateStateCommand("issue [id]", "(Re-)issues a suspended or renewed certificate",
+		orchestrator.CertificateState_CERTIFICATE_STATE_ISSUED)
+}
+
+// NewWithdrawCertificateCommand returns a cobra command for the `withdraw` subcommand
+func NewWithdrawCertificateCommand() *cobra.Command {
+	return newUpdateCertificateStateCommand("withdraw [id]", "Withdraws a certificate. This cannot be undone",
+		orchestrator.CertificateState_CERTIFICATE_STATE_WITHDRAWN)
+}
+
+// newUpdateCertificateStateCommand returns a cobra command that transitions a certificate into the given state
+func newUpdateCertificateStateCommand(use string, short string, state orchestrator.CertificateState) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   use,
+		Short: short,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var (
+				err     error
+				session *cli.Session
+				client  orchestrator.OrchestratorClient
+				res     *orchestrator.Certificate
+				req     *orchestrator.UpdateCertificateStateRequest
+			)
+
+			if session, err = cli.ContinueSession(); err != nil {
+				fmt.Printf("Error while retrieving the session. Please re-authenticate.\n")
+				return nil
+			}
+
+			client = orchestrator.NewOrchestratorClient(session)
+
+			req = &orchestrator.UpdateCertificateStateRequest{
+				CertificateId: args[0],
+				State:         state,
+			}
+
+			if reason := viper.GetString("reason"); reason != "" {
+				req.Reason = &reason
+			}
+
+			res, err = client.UpdateCertificateState(context.Background(), req)
+
+			return session.HandleResponse(res, err)
+		},
+		ValidArgsFunction: cli.DefaultArgsShellComp,
+	}
+
+	cmd.PersistentFlags().StringP("reason", "r", "", "an optional reason, which is recorded in the state history")
+	_ = viper.BindPFlag("reason", cmd.PersistentFlags().Lookup("reason"))
+	_ = cmd.RegisterFlagCompletionFunc("reason", cli.DefaultArgsShellComp)
+
+	return cmd
+}
+
+// NewCertificateCommand returns a cobra command for `certificate` subcommands
+func NewCertificateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "certificate",
+		Short: "Certificate commands",
+	}
+
+	AddCommands(cmd)
+
+	return cmd
+}
+
+// AddCommands adds all subcommands
+func AddCommands(cmd *cobra.Command) {
+	cmd.AddCommand(
+		NewListCertificatesCommand(),
+		NewShowCertificateCommand(),
+		NewSuspendCertificateCommand(),
+		NewIssueCertificateCommand(),
+		NewWithdrawCertificateCommand(),
+	)
+}
@@ -0,0 +1,122 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package certificate
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"testing"
+
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/cli"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/testutil/clitest"
+	"clouditor.io/clouditor/v2/internal/testutil/servicetest/orchestratortest"
+	"clouditor.io/clouditor/v2/server"
+	service_orchestrator "clouditor.io/clouditor/v2/service/orchestrator"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func TestMain(m *testing.M) {
+	clitest.AutoChdir()
+
+	svc := service_orchestrator.NewService()
+	_, err := svc.CreateCertificate(context.Background(), &orchestrator.CreateCertificateRequest{
+		Certificate: orchestratortest.NewCertificate(),
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	os.Exit(clitest.RunCLITest(m, server.WithOrchestrator(svc)))
+}
+
+func TestListCertificates(t *testing.T) {
+	var err error
+	var b bytes.Buffer
+
+	cli.Output = &b
+
+	cmd := NewListCertificatesCommand()
+	err = cmd.RunE(nil, []string{})
+	assert.NoError(t, err)
+
+	var response = &orchestrator.ListCertificatesResponse{}
+	err = protojson.Unmarshal(b.Bytes(), response)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(response.Certificates))
+}
+
+func TestShowCertificate(t *testing.T) {
+	var err error
+	var b bytes.Buffer
+
+	cli.Output = &b
+
+	cmd := NewShowCertificateCommand()
+	err = cmd.RunE(nil, []string{testdata.MockCertificateID})
+	assert.NoError(t, err)
+
+	var response = &orchestrator.Certificate{}
+	err = protojson.Unmarshal(b.Bytes(), response)
+	assert.NoError(t, err)
+	assert.Equal(t, testdata.MockCertificateName, response.Name)
+}
+
+func TestSuspendAndIssueCertificate(t *testing.T) {
+	var err error
+	var b bytes.Buffer
+
+	cli.Output = &b
+
+	cmd := NewSuspendCertificateCommand()
+	err = cmd.RunE(nil, []string{testdata.MockCertificateID})
+	assert.NoError(t, err)
+
+	var response = &orchestrator.Certificate{}
+	err = protojson.Unmarshal(b.Bytes(), response)
+	assert.NoError(t, err)
+	assert.Equal(t, orchestrator.CertificateState_CERTIFICATE_STATE_SUSPENDED, response.CurrentState)
+
+	// The mock certificate has already expired, so it cannot be re-issued
+	cmd = NewIssueCertificateCommand()
+	err = cmd.RunE(nil, []string{testdata.MockCertificateID})
+	assert.ErrorContains(t, err, "certificate has expired")
+
+	b.Reset()
+
+	cmd = NewWithdrawCertificateCommand()
+	err = cmd.RunE(nil, []string{testdata.MockCertificateID})
+	assert.NoError(t, err)
+
+	response = &orchestrator.Certificate{}
+	err = protojson.Unmarshal(b.Bytes(), response)
+	assert.NoError(t, err)
+	assert.Equal(t, orchestrator.CertificateState_CERTIFICATE_STATE_WITHDRAWN, response.CurrentState)
+}
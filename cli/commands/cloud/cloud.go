@@ -98,7 +98,14 @@ func NewListCloudServicesCommand() *cobra.Command {
 
 			client = orchestrator.NewOrchestratorClient(session)
 
-			services, err = api.ListAllPaginated(&orchestrator.ListCloudServicesRequest{}, client.ListCloudServices, func(res *orchestrator.ListCloudServicesResponse) []*orchestrator.CloudService {
+			req := &orchestrator.ListCloudServicesRequest{}
+
+			// Only list cloud services with specific tags, if requested
+			if tags := viper.GetStringSlice("tag"); len(tags) > 0 {
+				req.Filter = &orchestrator.ListCloudServicesRequest_Filter{Tags: tags}
+			}
+
+			services, err = api.ListAllPaginated(req, client.ListCloudServices, func(res *orchestrator.ListCloudServicesResponse) []*orchestrator.CloudService {
 				return res.Services
 			})
 
@@ -114,6 +121,9 @@ func NewListCloudServicesCommand() *cobra.Command {
 		},
 	}
 
+	cmd.PersistentFlags().StringSliceP("tag", "t", nil, "only list cloud services that have all of these tags")
+	_ = viper.BindPFlag("tag", cmd.PersistentFlags().Lookup("tag"))
+
 	return cmd
 }
 
@@ -183,6 +193,37 @@ func NewRemoveCloudServiceComand() *cobra.Command {
 	return cmd
 }
 
+// NewRestoreCloudServiceCommand returns a cobra command for the `restore` subcommand
+func NewRestoreCloudServiceCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "restore [id]",
+		Short: "Restores a removed target cloud service by its ID",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var (
+				err     error
+				session *cli.Session
+				client  orchestrator.OrchestratorClient
+				res     *orchestrator.CloudService
+			)
+
+			if session, err = cli.ContinueSession(); err != nil {
+				fmt.Printf("Error while retrieving the session. Please re-authenticate.\n")
+				return nil
+			}
+
+			client = orchestrator.NewOrchestratorClient(session)
+
+			res, err = client.RestoreCloudService(context.Background(), &orchestrator.RestoreCloudServiceRequest{CloudServiceId: args[0]})
+
+			return session.HandleResponse(res, err)
+		},
+		ValidArgsFunction: cli.DefaultArgsShellComp,
+	}
+
+	return cmd
+}
+
 // NewUpdateCloudServiceCommand returns a cobra command for the `update` subcommand
 func NewUpdateCloudServiceCommand() *cobra.Command {
 	cmd := &cobra.Command{
@@ -287,10 +328,12 @@ func NewCloudCommand() *cobra.Command {
 func AddCommands(cmd *cobra.Command) {
 	cmd.AddCommand(
 		NewRegisterCloudServiceCommand(),
+		NewInitCloudServiceCommand(),
 		NewListCloudServicesCommand(),
 		NewGetCloudServiceCommand(),
 		NewUpdateCloudServiceCommand(),
 		NewRemoveCloudServiceComand(),
+		NewRestoreCloudServiceCommand(),
 		NewGetMetricConfigurationCommand(),
 	)
 }
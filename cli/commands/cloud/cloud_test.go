@@ -30,12 +30,15 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strings"
 	"testing"
 
 	"clouditor.io/clouditor/v2/api/orchestrator"
 	"clouditor.io/clouditor/v2/cli"
+	"clouditor.io/clouditor/v2/internal/testdata"
 	"clouditor.io/clouditor/v2/internal/testutil/assert"
 	"clouditor.io/clouditor/v2/internal/testutil/clitest"
+	"clouditor.io/clouditor/v2/internal/testutil/servicetest/orchestratortest"
 	"clouditor.io/clouditor/v2/server"
 	service_orchestrator "clouditor.io/clouditor/v2/service/orchestrator"
 
@@ -117,6 +120,38 @@ func TestListCloudServicesCommand(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestListCloudServicesCommand_withTag(t *testing.T) {
+	var (
+		response orchestrator.ListCloudServicesResponse
+		svc      *service_orchestrator.Service
+
+		err error
+		b   bytes.Buffer
+	)
+
+	svc = service_orchestrator.NewService()
+	_, err = clitest.RunCLITestFunc(func() bool {
+		_, err = svc.CreateDefaultTargetCloudService()
+		assert.NoError(t, err)
+
+		cli.Output = &b
+
+		viper.Set("tag", []string{"team:payments"})
+		defer viper.Set("tag", nil)
+
+		cmd := NewListCloudServicesCommand()
+		err = cmd.RunE(nil, []string{})
+
+		assert.NoError(t, err)
+
+		err = protojson.Unmarshal(b.Bytes(), &response)
+
+		assert.NoError(t, err)
+		return assert.Empty(t, response.Services)
+	}, server.WithOrchestrator(svc))
+	assert.NoError(t, err)
+}
+
 func TestGetCloudServiceCommand(t *testing.T) {
 	var (
 		response orchestrator.CloudService
@@ -185,6 +220,39 @@ func TestRemoveCloudServicesCommand(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestRestoreCloudServiceCommand(t *testing.T) {
+	var (
+		response orchestrator.CloudService
+		target   *orchestrator.CloudService
+		svc      *service_orchestrator.Service
+
+		err error
+		b   bytes.Buffer
+	)
+
+	svc = service_orchestrator.NewService()
+	_, err = clitest.RunCLITestFunc(func() bool {
+		target, err = svc.CreateDefaultTargetCloudService()
+		assert.NoError(t, err)
+
+		_, err = svc.RemoveCloudService(context.Background(), &orchestrator.RemoveCloudServiceRequest{CloudServiceId: target.Id})
+		assert.NoError(t, err)
+
+		cli.Output = &b
+
+		cmd := NewRestoreCloudServiceCommand()
+		err = cmd.RunE(nil, []string{target.Id})
+
+		assert.NoError(t, err)
+
+		err = protojson.Unmarshal(b.Bytes(), &response)
+
+		assert.NoError(t, err)
+		return assert.Equal(t, target.Id, response.Id)
+	}, server.WithOrchestrator(svc))
+	assert.NoError(t, err)
+}
+
 func TestUpdateCloudServiceCommand(t *testing.T) {
 	var (
 		response orchestrator.CloudService
@@ -254,3 +322,79 @@ func TestGetMetricConfiguration(t *testing.T) {
 	}, server.WithOrchestrator(svc))
 	assert.NoError(t, err)
 }
+
+func TestInitCloudServiceCommand(t *testing.T) {
+	var (
+		svc *service_orchestrator.Service
+		err error
+		b   bytes.Buffer
+	)
+
+	svc = service_orchestrator.NewService(service_orchestrator.WithExternalCatalogs(func() ([]*orchestrator.Catalog, error) {
+		return []*orchestrator.Catalog{orchestratortest.NewCatalog()}, nil
+	}))
+	_, err = clitest.RunCLITestFunc(func() bool {
+		cli.Output = &b
+		cli.Input = strings.NewReader(strings.Join([]string{
+			"My Service",        // name
+			"",                  // description
+			"1",                 // catalog
+			"2",                 // assurance level
+			"azure,k8s",         // providers
+			"my-resource-group", // resource group
+			"1",                 // secret store (vault)
+			"https://vault:8200",
+			"", // Azure secret
+			"my-kubeconfig",
+		}, "\n"))
+		defer func() { cli.Input = os.Stdin }()
+
+		cmd := NewInitCloudServiceCommand()
+		err = cmd.RunE(nil, []string{})
+		assert.NoError(t, err)
+
+		res, err := svc.ListCloudServices(context.Background(), &orchestrator.ListCloudServicesRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(res.Services))
+		assert.Equal(t, "My Service", res.Services[0].Name)
+
+		toe, err := svc.GetTargetOfEvaluation(context.Background(), &orchestrator.GetTargetOfEvaluationRequest{
+			CloudServiceId: res.Services[0].Id,
+			CatalogId:      testdata.MockCatalogID,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, testdata.AssuranceLevelSubstantial, toe.GetAssuranceLevel())
+
+		return assert.Equal(t, `# Configuration of the engine for cloud service "My Service"
+CLOUDITOR_DISCOVERY_CLOUD_SERVICE_ID="`+res.Services[0].Id+`"
+CLOUDITOR_DISCOVERY_AUTO_START="true"
+CLOUDITOR_DISCOVERY_PROVIDER="azure k8s"
+CLOUDITOR_DISCOVERY_RESOURCE_GROUP="my-resource-group"
+CLOUDITOR_DISCOVERY_CREDENTIALS_STORE="vault"
+CLOUDITOR_DISCOVERY_CREDENTIALS_URL="https://vault:8200"
+# The token needs to be supplied separately
+CLOUDITOR_DISCOVERY_CREDENTIALS_VAULT_TOKEN=""
+CLOUDITOR_DISCOVERY_CREDENTIALS_AZURE_SECRET="azure"
+CLOUDITOR_DISCOVERY_CREDENTIALS_K8S_SECRET="my-kubeconfig"
+`, b.String())
+	}, server.WithOrchestrator(svc))
+	assert.NoError(t, err)
+}
+
+func Test_wizard_run(t *testing.T) {
+	_, err := newWizard(nil, strings.NewReader("\n")).run(context.Background())
+	assert.ErrorContains(t, err, "name of the cloud service must not be empty")
+}
+
+func Test_wizard_choose(t *testing.T) {
+	i, err := newWizard(nil, strings.NewReader("2\n")).choose("Option", []string{"a", "b"})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, i)
+
+	i, err = newWizard(nil, strings.NewReader("\n")).choose("Option", []string{"a", "b"})
+	assert.NoError(t, err)
+	assert.Equal(t, -1, i)
+
+	_, err = newWizard(nil, strings.NewReader("3\n")).choose("Option", []string{"a", "b"})
+	assert.ErrorContains(t, err, "invalid selection")
+}
@@ -0,0 +1,383 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package cloud
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	"clouditor.io/clouditor/v2/api"
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/cli"
+	service_discovery "clouditor.io/clouditor/v2/service/discovery"
+
+	"github.com/spf13/cobra"
+)
+
+// EngineEnvPrefix is the prefix of the environment variables of the engine configuration.
+const EngineEnvPrefix = "CLOUDITOR"
+
+var (
+	// providers contains the providers that can be selected for the discovery.
+	providers = []string{service_discovery.ProviderAWS, service_discovery.ProviderAzure, service_discovery.ProviderK8S}
+
+	// credentialsStores contains the secret stores that can be selected for the credentials of the discovery.
+	credentialsStores = []string{"vault", "azure-key-vault", "aws-secrets-manager"}
+)
+
+// NewInitCloudServiceCommand returns a cobra command for the `init` subcommand
+func NewInitCloudServiceCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "init",
+		Short: "Interactively sets up a new target cloud service",
+		Long: "Interactively registers a new target cloud service, creates a target of evaluation for a catalog and " +
+			"configures the discovery. Afterward, the configuration of the engine is printed as environment variables.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var (
+				err     error
+				session *cli.Session
+				client  orchestrator.OrchestratorClient
+				config  engineConfig
+			)
+
+			if session, err = cli.ContinueSession(); err != nil {
+				fmt.Printf("Error while retrieving the session. Please re-authenticate.\n")
+				return nil
+			}
+
+			client = orchestrator.NewOrchestratorClient(session)
+
+			config, err = newWizard(client, cli.Input).run(context.Background())
+			if err != nil {
+				return session.HandleResponse(nil, err)
+			}
+
+			_, err = fmt.Fprint(cli.Output, config)
+
+			return err
+		},
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			return []string{}, cobra.ShellCompDirectiveNoFileComp
+		},
+	}
+
+	return cmd
+}
+
+// wizard asks the user for the information needed to set up a cloud service.
+type wizard struct {
+	client orchestrator.OrchestratorClient
+	r      *bufio.Reader
+}
+
+func newWizard(client orchestrator.OrchestratorClient, r io.Reader) *wizard {
+	return &wizard{
+		client: client,
+		r:      bufio.NewReader(r),
+	}
+}
+
+// run registers the cloud service, creates its target of evaluation and returns the configuration of the engine.
+func (w *wizard) run(ctx context.Context) (config engineConfig, err error) {
+	var (
+		name        string
+		description string
+		service     *orchestrator.CloudService
+		toe         *orchestrator.TargetOfEvaluation
+	)
+
+	if name, err = w.ask("Name of the cloud service", ""); err != nil {
+		return nil, err
+	} else if name == "" {
+		return nil, errors.New("the name of the cloud service must not be empty")
+	}
+
+	if description, err = w.ask("Description (optional)", ""); err != nil {
+		return nil, err
+	}
+
+	if toe, err = w.askTargetOfEvaluation(ctx); err != nil {
+		return nil, err
+	}
+
+	service, err = w.client.RegisterCloudService(ctx, &orchestrator.RegisterCloudServiceRequest{
+		CloudService: &orchestrator.CloudService{
+			Name:        name,
+			Description: description,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not register cloud service: %w", err)
+	}
+
+	fmt.Printf("Registered cloud service %s (%s)\n", service.Name, service.Id)
+
+	if toe != nil {
+		toe.CloudServiceId = service.Id
+
+		_, err = w.client.CreateTargetOfEvaluation(ctx, &orchestrator.CreateTargetOfEvaluationRequest{
+			TargetOfEvaluation: toe,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("could not create target of evaluation: %w", err)
+		}
+
+		fmt.Printf("Created target of evaluation for catalog %s\n", toe.CatalogId)
+	}
+
+	config = engineConfig{
+		{comment: fmt.Sprintf("Configuration of the engine for cloud service %q", service.Name)},
+		{key: "discovery-cloud-service-id", value: service.Id},
+		{key: "discovery-auto-start", value: "true"},
+	}
+
+	if err = w.askDiscovery(&config); err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
+
+// askTargetOfEvaluation lets the user select one of the catalogs and its assurance level. It returns nil, if no
+// catalog was selected.
+func (w *wizard) askTargetOfEvaluation(ctx context.Context) (toe *orchestrator.TargetOfEvaluation, err error) {
+	var (
+		catalogs []*orchestrator.Catalog
+		catalog  *orchestrator.Catalog
+		options  []string
+		i        int
+		level    string
+	)
+
+	catalogs, err = api.ListAllPaginated(&orchestrator.ListCatalogsRequest{}, w.client.ListCatalogs,
+		func(res *orchestrator.ListCatalogsResponse) []*orchestrator.Catalog {
+			return res.Catalogs
+		})
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve catalogs: %w", err)
+	}
+
+	if len(catalogs) == 0 {
+		fmt.Printf("There are no catalogs, skipping the target of evaluation\n")
+		return nil, nil
+	}
+
+	for _, c := range catalogs {
+		options = append(options, fmt.Sprintf("%s (%s)", c.Name, c.Id))
+	}
+
+	if i, err = w.choose("Catalog to evaluate the cloud service against (empty to skip)", options); err != nil {
+		return nil, err
+	} else if i == -1 {
+		return nil, nil
+	}
+
+	catalog = catalogs[i]
+	toe = &orchestrator.TargetOfEvaluation{CatalogId: catalog.Id}
+
+	if len(catalog.AssuranceLevels) > 0 {
+		if i, err = w.choose("Assurance level", catalog.AssuranceLevels); err != nil {
+			return nil, err
+		} else if i != -1 {
+			level = catalog.AssuranceLevels[i]
+			toe.AssuranceLevel = &level
+		}
+	}
+
+	return toe, nil
+}
+
+// askDiscovery asks for the providers to discover and their credentials and adds them to the configuration.
+func (w *wizard) askDiscovery(config *engineConfig) (err error) {
+	var (
+		answer   string
+		selected []string
+		store    string
+		i        int
+	)
+
+	if answer, err = w.ask(fmt.Sprintf("Providers to discover, separated by comma (%s)", strings.Join(providers, ", ")),
+		strings.Join(providers, ",")); err != nil {
+		return err
+	}
+
+	for _, p := range strings.Split(answer, ",") {
+		p = strings.TrimSpace(p)
+		if !slices.Contains(providers, p) {
+			return fmt.Errorf("unknown provider %q", p)
+		}
+
+		selected = append(selected, p)
+	}
+
+	// Slices are separated by whitespace in environment variables
+	config.add("discovery-provider", strings.Join(selected, " "))
+
+	if slices.Contains(selected, service_discovery.ProviderAzure) {
+		if answer, err = w.ask("Azure resource group to limit the discovery to (optional)", ""); err != nil {
+			return err
+		} else if answer != "" {
+			config.add("discovery-resource-group", answer)
+		}
+	}
+
+	if i, err = w.choose("Secret store containing the credentials (empty to use the default credentials)", credentialsStores); err != nil {
+		return err
+	}
+
+	if i == -1 {
+		config.addDefaultCredentials(selected)
+		return nil
+	}
+
+	store = credentialsStores[i]
+	config.add("discovery-credentials-store", store)
+
+	if store != "aws-secrets-manager" {
+		if answer, err = w.ask("URL of the secret store", ""); err != nil {
+			return err
+		}
+
+		config.add("discovery-credentials-url", answer)
+	}
+
+	if store == "vault" {
+		config.addComment("The token needs to be supplied separately")
+		config.add("discovery-credentials-vault-token", "")
+	}
+
+	for _, p := range selected {
+		if answer, err = w.ask(fmt.Sprintf("Name of the secret containing the %s credentials", p), p); err != nil {
+			return err
+		}
+
+		config.add(fmt.Sprintf("discovery-credentials-%s-secret", p), answer)
+	}
+
+	return nil
+}
+
+// ask prints the question and returns the answer of the user or def, if the answer is empty.
+func (w *wizard) ask(question string, def string) (answer string, err error) {
+	if def != "" {
+		fmt.Printf("%s [%s]: ", question, def)
+	} else {
+		fmt.Printf("%s: ", question)
+	}
+
+	answer, err = w.r.ReadString('\n')
+	if err != nil && !(errors.Is(err, io.EOF) && answer != "") {
+		return "", fmt.Errorf("could not read answer: %w", err)
+	}
+
+	answer = strings.TrimSpace(answer)
+	if answer == "" {
+		answer = def
+	}
+
+	return answer, nil
+}
+
+// choose prints the numbered options and returns the index of the option selected by the user or -1, if the answer
+// is empty.
+func (w *wizard) choose(question string, options []string) (i int, err error) {
+	var answer string
+
+	for i, o := range options {
+		fmt.Printf("  %d) %s\n", i+1, o)
+	}
+
+	if answer, err = w.ask(question, ""); err != nil {
+		return -1, err
+	} else if answer == "" {
+		return -1, nil
+	}
+
+	i, err = strconv.Atoi(answer)
+	if err != nil || i < 1 || i > len(options) {
+		return -1, fmt.Errorf("invalid selection %q", answer)
+	}
+
+	return i - 1, nil
+}
+
+// engineConfig is the configuration of the engine, consisting of flags and comments, in the order they are printed.
+type engineConfig []configEntry
+
+// configEntry is either a flag of the engine and its value or a comment.
+type configEntry struct {
+	key     string
+	value   string
+	comment string
+}
+
+func (c *engineConfig) add(key string, value string) {
+	*c = append(*c, configEntry{key: key, value: value})
+}
+
+func (c *engineConfig) addComment(comment string) {
+	*c = append(*c, configEntry{comment: comment})
+}
+
+// addDefaultCredentials adds comments about the environment variables of the default credentials of the providers.
+func (c *engineConfig) addDefaultCredentials(providers []string) {
+	c.addComment("The default credentials of the providers are used, which are read from the environment")
+
+	for _, p := range providers {
+		switch p {
+		case service_discovery.ProviderAzure:
+			c.addComment("Azure: AZURE_TENANT_ID, AZURE_CLIENT_ID and AZURE_CLIENT_SECRET or a managed identity")
+		case service_discovery.ProviderAWS:
+			c.addComment("AWS: AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY and AWS_REGION or an instance role")
+		case service_discovery.ProviderK8S:
+			c.addComment("Kubernetes: KUBECONFIG or ~/.kube/config")
+		}
+	}
+}
+
+// String returns the configuration as environment variables, e.g., for an env file.
+func (c engineConfig) String() string {
+	var b strings.Builder
+
+	for _, e := range c {
+		if e.comment != "" {
+			fmt.Fprintf(&b, "# %s\n", e.comment)
+			continue
+		}
+
+		fmt.Fprintf(&b, "%s_%s=%s\n", EngineEnvPrefix, strings.ToUpper(strings.ReplaceAll(e.key, "-", "_")),
+			strconv.Quote(e.value))
+	}
+
+	return b.String()
+}
@@ -27,16 +27,22 @@ package commands
 
 import (
 	"clouditor.io/clouditor/v2/cli"
+	"clouditor.io/clouditor/v2/cli/commands/agent"
+	"clouditor.io/clouditor/v2/cli/commands/assess"
 	"clouditor.io/clouditor/v2/cli/commands/assessmentresult"
 	"clouditor.io/clouditor/v2/cli/commands/catalog"
+	"clouditor.io/clouditor/v2/cli/commands/certificate"
 	"clouditor.io/clouditor/v2/cli/commands/cloud"
 	"clouditor.io/clouditor/v2/cli/commands/completion"
+	"clouditor.io/clouditor/v2/cli/commands/evaluation"
 	"clouditor.io/clouditor/v2/cli/commands/evidence"
 	"clouditor.io/clouditor/v2/cli/commands/login"
 	"clouditor.io/clouditor/v2/cli/commands/metric"
+	"clouditor.io/clouditor/v2/cli/commands/report"
 	"clouditor.io/clouditor/v2/cli/commands/resource"
 	"clouditor.io/clouditor/v2/cli/commands/service"
 	"clouditor.io/clouditor/v2/cli/commands/tool"
+	"clouditor.io/clouditor/v2/cli/commands/tui"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -53,12 +59,23 @@ func AddCommands(cmd *cobra.Command) {
 		resource.NewResourceCommand(),
 		evidence.NewEvidenceCommand(),
 		assessmentresult.NewAssessmentResultCommand(),
+		assess.NewAssessCommand(),
+		evaluation.NewEvaluationCommand(),
 		completion.NewCompletionCommand(),
 		cloud.NewCloudCommand(),
+		certificate.NewCertificateCommand(),
+		agent.NewAgentCommand(),
+		report.NewReportCommand(),
+		tui.NewTUICommand(),
 		// command consisting of service commands
 		service.NewServiceCommand(),
 	)
 
 	cmd.PersistentFlags().StringP(cli.SessionFolderFlag, "s", cli.DefaultSessionFolder, "the directory where the session will be saved and loaded from")
 	_ = viper.BindPFlag(cli.SessionFolderFlag, cmd.PersistentFlags().Lookup(cli.SessionFolderFlag))
+
+	cmd.PersistentFlags().StringP(cli.OutputFlag, "o", cli.DefaultOutputFormat, "the output format of responses (json, yaml, table or csv)")
+	cmd.PersistentFlags().String(cli.QueryFlag, "", "a JSONPath-like query selecting the values of responses to output, e.g., '.results[?(@.compliant == false)].resourceId'")
+	_ = viper.BindPFlag(cli.OutputFormatKey, cmd.PersistentFlags().Lookup(cli.OutputFlag))
+	_ = viper.BindPFlag(cli.QueryFlag, cmd.PersistentFlags().Lookup(cli.QueryFlag))
 }
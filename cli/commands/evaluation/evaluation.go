@@ -0,0 +1,132 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package evaluation
+
+import (
+	"fmt"
+	"strconv"
+
+	"clouditor.io/clouditor/v2/api/evaluation"
+	"clouditor.io/clouditor/v2/cli"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"google.golang.org/protobuf/proto"
+)
+
+// NewWatchEvaluationResultsCommand returns a cobra command for the `watch` subcommand
+func NewWatchEvaluationResultsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "watch",
+		Short: "Continuously prints new evaluation results of the Evaluation service",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var (
+				err     error
+				session *cli.Session
+				client  evaluation.EvaluationClient
+				req     *evaluation.SubscribeEvaluationResultsRequest
+				stream  evaluation.Evaluation_SubscribeEvaluationResultsClient
+			)
+
+			req, err = subscribeEvaluationResultsRequest()
+			if err != nil {
+				return err
+			}
+
+			if session, err = cli.ContinueSession(); err != nil {
+				fmt.Printf("Error while retrieving the session. Please re-authenticate.\n")
+				return nil
+			}
+
+			client = evaluation.NewEvaluationClient(session)
+
+			stream, err = client.SubscribeEvaluationResults(cmd.Context(), req)
+
+			return session.HandleStream(stream, err, func() proto.Message {
+				return new(evaluation.EvaluationResult)
+			})
+		},
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			return []string{}, cobra.ShellCompDirectiveNoFileComp
+		},
+	}
+
+	cmd.PersistentFlags().String("cloud-service-id", "", "only print evaluation results of this cloud service")
+	cmd.PersistentFlags().String("catalog-id", "", "only print evaluation results of this catalog")
+	cmd.PersistentFlags().StringSlice("control-id", nil, "only print evaluation results of these controls")
+	cmd.PersistentFlags().String("compliant", "", "only print compliant (true) or non-compliant (false) evaluation results")
+	_ = viper.BindPFlag("evaluation-results-cloud-service-id", cmd.PersistentFlags().Lookup("cloud-service-id"))
+	_ = viper.BindPFlag("evaluation-results-catalog-id", cmd.PersistentFlags().Lookup("catalog-id"))
+	_ = viper.BindPFlag("evaluation-results-control-ids", cmd.PersistentFlags().Lookup("control-id"))
+	_ = viper.BindPFlag("evaluation-results-compliant", cmd.PersistentFlags().Lookup("compliant"))
+
+	return cmd
+}
+
+// subscribeEvaluationResultsRequest builds the request of the `watch` subcommand out of its flags.
+func subscribeEvaluationResultsRequest() (req *evaluation.SubscribeEvaluationResultsRequest, err error) {
+	var filter = &evaluation.SubscribeEvaluationResultsRequest_Filter{
+		ControlIds: viper.GetStringSlice("evaluation-results-control-ids"),
+	}
+
+	if id := viper.GetString("evaluation-results-cloud-service-id"); id != "" {
+		filter.CloudServiceId = &id
+	}
+
+	if id := viper.GetString("evaluation-results-catalog-id"); id != "" {
+		filter.CatalogId = &id
+	}
+
+	if s := viper.GetString("evaluation-results-compliant"); s != "" {
+		compliant, err := strconv.ParseBool(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value %q for compliant: %w", s, err)
+		}
+
+		filter.Compliant = &compliant
+	}
+
+	return &evaluation.SubscribeEvaluationResultsRequest{Filter: filter}, nil
+}
+
+// NewEvaluationCommand returns a cobra command for `evaluation` subcommands
+func NewEvaluationCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "evaluation",
+		Short: "Evaluation commands",
+	}
+
+	AddCommands(cmd)
+
+	return cmd
+}
+
+// AddCommands adds all subcommands
+func AddCommands(cmd *cobra.Command) {
+	cmd.AddCommand(
+		NewWatchEvaluationResultsCommand(),
+	)
+}
@@ -0,0 +1,158 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package evaluation
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"testing"
+
+	"clouditor.io/clouditor/v2/api/evaluation"
+	"clouditor.io/clouditor/v2/cli"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/testutil/clitest"
+	"clouditor.io/clouditor/v2/internal/util"
+	"clouditor.io/clouditor/v2/server"
+
+	"github.com/spf13/viper"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// mockEvaluationServer sends a single evaluation result for each requested control and ends the stream afterward, so
+// that we do not need a fully configured evaluation service.
+type mockEvaluationServer struct {
+	evaluation.UnimplementedEvaluationServer
+}
+
+func (mockEvaluationServer) SubscribeEvaluationResults(req *evaluation.SubscribeEvaluationResultsRequest, stream evaluation.Evaluation_SubscribeEvaluationResultsServer) error {
+	for _, id := range req.GetFilter().GetControlIds() {
+		err := stream.Send(&evaluation.EvaluationResult{
+			Id:               testdata.MockEvaluationResult1ID,
+			CloudServiceId:   req.GetFilter().GetCloudServiceId(),
+			ControlCatalogId: req.GetFilter().GetCatalogId(),
+			ControlId:        id,
+			Status:           evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func TestMain(m *testing.M) {
+	os.Exit(clitest.RunCLITest(m, server.WithEvaluation(mockEvaluationServer{})))
+}
+
+func TestAddCommands(t *testing.T) {
+	cmd := NewEvaluationCommand()
+
+	// Check if sub commands were added
+	assert.True(t, cmd.HasSubCommands())
+
+	// Check if NewWatchEvaluationResultsCommand was added
+	for _, v := range cmd.Commands() {
+		if v.Use == "watch" {
+			return
+		}
+	}
+	t.Errorf("No watch command was added")
+}
+
+func TestNewWatchEvaluationResultsCommand(t *testing.T) {
+	var b bytes.Buffer
+
+	cli.Output = &b
+
+	viper.Set("evaluation-results-cloud-service-id", testdata.MockCloudServiceID1)
+	viper.Set("evaluation-results-catalog-id", testdata.MockCatalogID)
+	viper.Set("evaluation-results-control-ids", []string{testdata.MockControlID1})
+	defer viper.Set("evaluation-results-cloud-service-id", "")
+	defer viper.Set("evaluation-results-catalog-id", "")
+	defer viper.Set("evaluation-results-control-ids", nil)
+
+	cmd := NewWatchEvaluationResultsCommand()
+	cmd.SetContext(context.Background())
+	err := cmd.RunE(cmd, []string{})
+	assert.NoError(t, err)
+
+	var result = &evaluation.EvaluationResult{}
+	err = protojson.Unmarshal(b.Bytes(), result)
+	assert.NoError(t, err)
+	assert.Equal(t, testdata.MockControlID1, result.ControlId)
+	assert.Equal(t, testdata.MockCloudServiceID1, result.CloudServiceId)
+	assert.Equal(t, testdata.MockCatalogID, result.ControlCatalogId)
+}
+
+func Test_subscribeEvaluationResultsRequest(t *testing.T) {
+	tests := []struct {
+		name      string
+		compliant string
+		want      assert.Want[*evaluation.SubscribeEvaluationResultsRequest]
+		wantErr   assert.WantErr
+	}{
+		{
+			name: "no filter",
+			want: func(t *testing.T, got *evaluation.SubscribeEvaluationResultsRequest) bool {
+				return assert.Equal(t, &evaluation.SubscribeEvaluationResultsRequest{
+					Filter: &evaluation.SubscribeEvaluationResultsRequest_Filter{},
+				}, got)
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name:      "non-compliant",
+			compliant: "false",
+			want: func(t *testing.T, got *evaluation.SubscribeEvaluationResultsRequest) bool {
+				return assert.Equal(t, &evaluation.SubscribeEvaluationResultsRequest{
+					Filter: &evaluation.SubscribeEvaluationResultsRequest_Filter{Compliant: util.Ref(false)},
+				}, got)
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name:      "invalid compliance state",
+			compliant: "maybe",
+			want:      assert.Nil[*evaluation.SubscribeEvaluationResultsRequest],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, `invalid value "maybe" for compliant`)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			viper.Set("evaluation-results-compliant", tt.compliant)
+			defer viper.Set("evaluation-results-compliant", "")
+
+			got, err := subscribeEvaluationResultsRequest()
+			tt.wantErr(t, err)
+			tt.want(t, got)
+		})
+	}
+}
@@ -26,12 +26,18 @@
 package evidence
 
 import (
+	"context"
 	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
 
 	"clouditor.io/clouditor/v2/api"
 	"clouditor.io/clouditor/v2/api/evidence"
 	"clouditor.io/clouditor/v2/cli"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"google.golang.org/protobuf/encoding/protojson"
 )
 
 // NewListEvidencesCommand returns a cobra command for the `list` subcommand
@@ -74,6 +80,102 @@ func NewListEvidencesCommand() *cobra.Command {
 	return cmd
 }
 
+// NewImportEvidencesCommand returns a cobra command for the `import` subcommand
+func NewImportEvidencesCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import [file]",
+		Short: "Imports evidences from a JSON or CSV file of a third-party tool",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var (
+				err     error
+				session *cli.Session
+				client  evidence.EvidenceStoreClient
+				res     *evidence.ImportEvidencesResponse
+				req     *evidence.ImportEvidencesRequest
+			)
+
+			req = &evidence.ImportEvidencesRequest{
+				CloudServiceId: viper.GetString("cloud-service-id"),
+				ToolId:         viper.GetString("tool-id"),
+				Mapping:        &evidence.EvidenceMapping{},
+			}
+
+			req.Data, err = os.ReadFile(args[0])
+			if err != nil {
+				return fmt.Errorf("could not read file: %w", err)
+			}
+
+			// Load the mapping of records into resources, if we have a mapping file
+			if file := viper.GetString("mapping"); file != "" {
+				b, err := os.ReadFile(file)
+				if err != nil {
+					return fmt.Errorf("could not read mapping file: %w", err)
+				}
+
+				err = protojson.Unmarshal(b, req.Mapping)
+				if err != nil {
+					return fmt.Errorf("could not parse mapping file: %w", err)
+				}
+			}
+
+			// The resource type flag takes precedence over the mapping file
+			if typ := viper.GetString("resource-type"); typ != "" {
+				req.Mapping.ResourceType = typ
+			}
+
+			req.Format, err = importFormat(viper.GetString("format"), args[0])
+			if err != nil {
+				return err
+			}
+
+			if session, err = cli.ContinueSession(); err != nil {
+				fmt.Printf("Error while retrieving the session. Please re-authenticate.\n")
+				return nil
+			}
+
+			client = evidence.NewEvidenceStoreClient(session)
+
+			res, err = client.ImportEvidences(context.Background(), req)
+
+			return session.HandleResponse(res, err)
+		},
+	}
+
+	cmd.PersistentFlags().StringP("cloud-service-id", "c", "", "the cloud service the evidences belong to")
+	cmd.PersistentFlags().StringP("tool-id", "t", "", "the tool that produced the imported file")
+	cmd.PersistentFlags().StringP("format", "f", "", "the format of the file (json or csv). Derived from the file extension if not set")
+	cmd.PersistentFlags().StringP("mapping", "m", "", "a JSON file containing the mapping of records into resources")
+	cmd.PersistentFlags().StringP("resource-type", "r", "", "the ontology resource type, e.g. ObjectStorage")
+	_ = cmd.MarkPersistentFlagRequired("cloud-service-id")
+	_ = cmd.MarkPersistentFlagRequired("tool-id")
+	_ = viper.BindPFlag("cloud-service-id", cmd.PersistentFlags().Lookup("cloud-service-id"))
+	_ = viper.BindPFlag("tool-id", cmd.PersistentFlags().Lookup("tool-id"))
+	_ = viper.BindPFlag("format", cmd.PersistentFlags().Lookup("format"))
+	_ = viper.BindPFlag("mapping", cmd.PersistentFlags().Lookup("mapping"))
+	_ = viper.BindPFlag("resource-type", cmd.PersistentFlags().Lookup("resource-type"))
+
+	_ = cmd.RegisterFlagCompletionFunc("cloud-service-id", cli.ValidArgsGetCloudServices)
+
+	return cmd
+}
+
+// importFormat returns the import format according to the format flag or, if not set, the extension of the file.
+func importFormat(format string, file string) (evidence.ImportFormat, error) {
+	if format == "" {
+		format = strings.TrimPrefix(filepath.Ext(file), ".")
+	}
+
+	switch strings.ToLower(format) {
+	case "json":
+		return evidence.ImportFormat_IMPORT_FORMAT_JSON, nil
+	case "csv":
+		return evidence.ImportFormat_IMPORT_FORMAT_CSV, nil
+	default:
+		return evidence.ImportFormat_IMPORT_FORMAT_UNSPECIFIED, fmt.Errorf("unsupported import format: %q", format)
+	}
+}
+
 // NewEvidenceCommand returns a cobra command for `assessment` subcommands
 func NewEvidenceCommand() *cobra.Command {
 	cmd := &cobra.Command{
@@ -90,5 +192,6 @@ func NewEvidenceCommand() *cobra.Command {
 func AddCommands(cmd *cobra.Command) {
 	cmd.AddCommand(
 		NewListEvidencesCommand(),
+		NewImportEvidencesCommand(),
 	)
 }
@@ -29,6 +29,7 @@ import (
 	"bytes"
 	"context"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"clouditor.io/clouditor/v2/api/evidence"
@@ -41,6 +42,7 @@ import (
 	"clouditor.io/clouditor/v2/server"
 	service_evidence "clouditor.io/clouditor/v2/service/evidence"
 
+	"github.com/spf13/viper"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -98,3 +100,79 @@ func TestNewListResultsCommand(t *testing.T) {
 	assert.NotNil(t, response)
 	assert.NotEmpty(t, response.Evidences)
 }
+
+func TestNewImportEvidencesCommand(t *testing.T) {
+	var (
+		b    bytes.Buffer
+		file = filepath.Join(t.TempDir(), "buckets.csv")
+	)
+
+	cli.Output = &b
+
+	err := os.WriteFile(file, []byte("id,name\nbucket-1,bucket-1\n"), 0600)
+	assert.NoError(t, err)
+
+	viper.Set("cloud-service-id", testdata.MockCloudServiceID1)
+	viper.Set("tool-id", testdata.MockEvidenceToolID1)
+	viper.Set("resource-type", "ObjectStorage")
+	defer viper.Reset()
+
+	cmd := NewImportEvidencesCommand()
+	err = cmd.RunE(nil, []string{file})
+	assert.NoError(t, err)
+
+	var response = &evidence.ImportEvidencesResponse{}
+	err = protojson.Unmarshal(b.Bytes(), response)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), response.ImportedCount)
+}
+
+func Test_importFormat(t *testing.T) {
+	type args struct {
+		format string
+		file   string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    evidence.ImportFormat
+		wantErr assert.WantErr
+	}{
+		{
+			name: "format from extension",
+			args: args{
+				file: "results.JSON",
+			},
+			want:    evidence.ImportFormat_IMPORT_FORMAT_JSON,
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "format flag takes precedence",
+			args: args{
+				format: "csv",
+				file:   "results.txt",
+			},
+			want:    evidence.ImportFormat_IMPORT_FORMAT_CSV,
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "unsupported format",
+			args: args{
+				file: "results.xml",
+			},
+			want: evidence.ImportFormat_IMPORT_FORMAT_UNSPECIFIED,
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "unsupported import format")
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := importFormat(tt.args.format, tt.args.file)
+
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
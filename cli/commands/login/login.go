@@ -30,12 +30,15 @@ import (
 	"fmt"
 	"net"
 	"net/http"
+	"os/exec"
+	"runtime"
 	"time"
 
 	"clouditor.io/clouditor/v2/cli"
 	oauth2 "github.com/oxisto/oauth2go"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	xoauth2 "golang.org/x/oauth2"
 )
 
 const (
@@ -45,9 +48,21 @@ const (
 	// OAuth2TokenURLFlag is the viper flag for the OAuth 2.0 token endpoint.
 	OAuth2TokenURLFlag = "oauth2-token-url"
 
+	// OAuth2DeviceAuthURLFlag is the viper flag for the OAuth 2.0 device authorization endpoint.
+	OAuth2DeviceAuthURLFlag = "oauth2-device-auth-url"
+
 	// OAuth2ClientIDFlag is the viper flag for the OAuth 2.0 client ID.
 	OAuth2ClientIDFlag = "oauth2-client-id"
 
+	// OAuth2ScopesFlag is the viper flag for the requested OAuth 2.0 scopes.
+	OAuth2ScopesFlag = "oauth2-scopes"
+
+	// DeviceFlag is the viper flag to log in using the device authorization grant instead of the browser.
+	DeviceFlag = "device"
+
+	// NoBrowserFlag is the viper flag to disable opening the browser automatically.
+	NoBrowserFlag = "no-browser"
+
 	// DefaultOAuth2Server is the default OAuth 2.0 authorization endpoint.
 	DefaultOAuth2AuthURL = "http://localhost:8080/v1/auth/authorize"
 
@@ -68,6 +83,9 @@ var (
 	// VerifierGenerator is a function that generates a new verifier.
 	VerifierGenerator = oauth2.GenerateSecret
 
+	// OpenBrowser is a function that opens the given URL in the browser of the user.
+	OpenBrowser = openBrowser
+
 	// callbackServerReady is an internally used channel to indicate that the callback server is ready.
 	callbackServerReady = make(chan bool)
 )
@@ -77,13 +95,15 @@ func NewLoginCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "login [login]",
 		Short: "Log in to Clouditor",
-		Args:  cobra.MinimumNArgs(1),
+		Long: "Log in to Clouditor using the OAuth 2.0 authorization code flow with PKCE in the local browser or, if " +
+			"--device is specified, using the device authorization grant, which does not need a browser on the same " +
+			"machine. The resulting token is cached in the session and refreshed automatically.",
+		Args: cobra.MinimumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			var (
 				err     error
 				session *cli.Session
-				sock    net.Listener
-				code    string
+				token   *oauth2.Token
 				config  *oauth2.Config
 			)
 
@@ -91,37 +111,19 @@ func NewLoginCommand() *cobra.Command {
 			config = &oauth2.Config{
 				ClientID: viper.GetString(OAuth2ClientIDFlag),
 				Endpoint: oauth2.Endpoint{
-					AuthURL:  viper.GetString(OAuth2AuthURLFlag),
-					TokenURL: viper.GetString(OAuth2TokenURLFlag),
+					AuthURL:       viper.GetString(OAuth2AuthURLFlag),
+					TokenURL:      viper.GetString(OAuth2TokenURLFlag),
+					DeviceAuthURL: viper.GetString(OAuth2DeviceAuthURLFlag),
 				},
 				RedirectURL: DefaultCallback,
+				Scopes:      viper.GetStringSlice(OAuth2ScopesFlag),
 			}
 
-			srv := newCallbackServer(config)
-
-			go func() {
-				sock, err = net.Listen("tcp", srv.Addr)
-				if err != nil {
-					fmt.Printf("Could not start web server for OAuth 2.0 authorization code flow: %v", err)
-				}
-				go func() {
-					callbackServerReady <- true
-				}()
-
-				err = srv.Serve(sock)
-				if err != http.ErrServerClosed {
-					fmt.Printf("Could not start web server for OAuth 2.0 authorization code flow: %v", err)
-					return
-				}
-			}()
-			defer srv.Close()
-
-			// waiting for our code
-			code = <-srv.code
-			token, err := srv.config.Exchange(context.Background(), code,
-				oauth2.SetAuthURLParam("code_verifier", srv.verifier),
-			)
-
+			if viper.GetBool(DeviceFlag) {
+				token, err = deviceLogin(context.Background(), config)
+			} else {
+				token, err = browserLogin(config)
+			}
 			if err != nil {
 				return err
 			}
@@ -146,12 +148,92 @@ func NewLoginCommand() *cobra.Command {
 	cmd.PersistentFlags().String(OAuth2TokenURLFlag, DefaultOAuth2TokenURL, "the token URL of the OAuth 2.0 server")
 	_ = viper.BindPFlag(OAuth2TokenURLFlag, cmd.PersistentFlags().Lookup(OAuth2TokenURLFlag))
 
+	cmd.PersistentFlags().String(OAuth2DeviceAuthURLFlag, "", "the device authorization URL of the OAuth 2.0 server, needed for --device")
+	_ = viper.BindPFlag(OAuth2DeviceAuthURLFlag, cmd.PersistentFlags().Lookup(OAuth2DeviceAuthURLFlag))
+
 	cmd.PersistentFlags().String(OAuth2ClientIDFlag, DefaultClientID, "the OAuth 2.0 client ID")
 	_ = viper.BindPFlag(OAuth2ClientIDFlag, cmd.PersistentFlags().Lookup(OAuth2ClientIDFlag))
 
+	cmd.PersistentFlags().StringSlice(OAuth2ScopesFlag, nil, "the OAuth 2.0 scopes to request, e.g., openid and offline_access")
+	_ = viper.BindPFlag(OAuth2ScopesFlag, cmd.PersistentFlags().Lookup(OAuth2ScopesFlag))
+
+	cmd.PersistentFlags().Bool(DeviceFlag, false, "log in using the OAuth 2.0 device authorization grant")
+	_ = viper.BindPFlag(DeviceFlag, cmd.PersistentFlags().Lookup(DeviceFlag))
+
+	cmd.PersistentFlags().Bool(NoBrowserFlag, false, "do not open the browser automatically")
+	_ = viper.BindPFlag(NoBrowserFlag, cmd.PersistentFlags().Lookup(NoBrowserFlag))
+
 	return cmd
 }
 
+// browserLogin retrieves a token using the authorization code flow with PKCE. The user authorizes the CLI in the
+// browser, which is redirected to our local callback server afterward.
+func browserLogin(config *oauth2.Config) (token *oauth2.Token, err error) {
+	var (
+		sock net.Listener
+		code string
+	)
+
+	srv := newCallbackServer(config)
+
+	go func() {
+		var err error
+
+		sock, err = net.Listen("tcp", srv.Addr)
+		if err != nil {
+			fmt.Printf("Could not start web server for OAuth 2.0 authorization code flow: %v", err)
+		}
+		go func() {
+			callbackServerReady <- true
+		}()
+
+		err = srv.Serve(sock)
+		if err != http.ErrServerClosed {
+			fmt.Printf("Could not start web server for OAuth 2.0 authorization code flow: %v", err)
+			return
+		}
+	}()
+	defer srv.Close()
+
+	// waiting for our code
+	code = <-srv.code
+
+	return srv.config.Exchange(context.Background(), code,
+		oauth2.SetAuthURLParam("code_verifier", srv.verifier),
+	)
+}
+
+// deviceLogin retrieves a token using the device authorization grant (RFC 8628). The user authorizes the CLI by
+// entering a code on the verification page of the authorization server, e.g., on another device, while we poll the
+// token endpoint.
+func deviceLogin(ctx context.Context, config *oauth2.Config) (token *oauth2.Token, err error) {
+	var da *xoauth2.DeviceAuthResponse
+
+	if config.Endpoint.DeviceAuthURL == "" {
+		return nil, fmt.Errorf("the device authorization URL needs to be specified using --%s", OAuth2DeviceAuthURLFlag)
+	}
+
+	da, err = config.DeviceAuth(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not start device authorization: %w", err)
+	}
+
+	if da.VerificationURIComplete != "" {
+		fmt.Printf("Please open %s in your browser 🚀 and confirm the code %s to continue\n",
+			da.VerificationURIComplete, da.UserCode)
+	} else {
+		fmt.Printf("Please open %s in your browser 🚀 and enter the code %s to continue\n",
+			da.VerificationURI, da.UserCode)
+	}
+
+	token, err = config.DeviceAccessToken(ctx, da)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve token: %w", err)
+	}
+
+	return token, nil
+}
+
 type callbackServer struct {
 	http.Server
 
@@ -184,6 +266,12 @@ func newCallbackServer(config *oauth2.Config) *callbackServer {
 
 	fmt.Printf("Please open %s in your browser 🚀 to continue\n", authURL)
 
+	if !viper.GetBool(NoBrowserFlag) {
+		if err := OpenBrowser(authURL); err != nil {
+			fmt.Printf("Could not open browser: %v\n", err)
+		}
+	}
+
 	return srv
 }
 
@@ -197,3 +285,19 @@ func (srv *callbackServer) handleCallback(w http.ResponseWriter, r *http.Request
 
 	srv.code <- r.URL.Query().Get("code")
 }
+
+// openBrowser opens the URL in the default browser, depending on the operating system.
+func openBrowser(url string) error {
+	var cmd *exec.Cmd
+
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("open", url)
+	case "windows":
+		cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", url)
+	default:
+		cmd = exec.Command("xdg-open", url)
+	}
+
+	return cmd.Start()
+}
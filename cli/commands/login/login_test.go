@@ -26,8 +26,11 @@
 package login
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"os"
 	"testing"
 	"time"
@@ -68,6 +71,9 @@ func TestLogin(t *testing.T) {
 	VerifierGenerator = func() string {
 		return verifier
 	}
+	OpenBrowser = func(url string) error {
+		return nil
+	}
 
 	// Issue a code that we can use in the callback
 	code := authSrv.IssueCode(oauth2.GenerateCodeChallenge(verifier))
@@ -101,3 +107,67 @@ func TestLogin(t *testing.T) {
 	case <-done:
 	}
 }
+
+func TestLogin_device(t *testing.T) {
+	var polls int
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/device", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"device_code":      "device-code",
+			"user_code":        "ABCD-EFGH",
+			"verification_uri": "http://localhost/device",
+			"expires_in":       60,
+			"interval":         1,
+		})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "device-code", r.FormValue("device_code"))
+		w.Header().Set("Content-Type", "application/json")
+
+		// The user needs to authorize the device first
+		polls++
+		if polls == 1 {
+			w.WriteHeader(http.StatusBadRequest)
+			_ = json.NewEncoder(w).Encode(map[string]any{"error": "authorization_pending"})
+			return
+		}
+
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"access_token":  "access-token",
+			"refresh_token": "refresh-token",
+			"token_type":    "Bearer",
+			"expires_in":    3600,
+		})
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	dir, err := os.MkdirTemp(os.TempDir(), ".clouditor")
+	assert.NoError(t, err)
+
+	viper.Set(OAuth2TokenURLFlag, srv.URL+"/token")
+	viper.Set(OAuth2DeviceAuthURLFlag, srv.URL+"/device")
+	viper.Set(DeviceFlag, true)
+	viper.Set(cli.SessionFolderFlag, dir)
+	defer viper.Reset()
+
+	cmd := NewLoginCommand()
+	err = cmd.RunE(nil, []string{"localhost:9090"})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, polls)
+
+	session, err := cli.ContinueSession()
+	assert.NoError(t, err)
+
+	token, err := session.Authorizer().Token()
+	assert.NoError(t, err)
+	assert.Equal(t, "access-token", token.AccessToken)
+	assert.Equal(t, "refresh-token", token.RefreshToken)
+}
+
+func Test_deviceLogin(t *testing.T) {
+	_, err := deviceLogin(context.Background(), &oauth2.Config{})
+	assert.ErrorContains(t, err, "device authorization URL needs to be specified")
+}
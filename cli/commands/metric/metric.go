@@ -28,12 +28,22 @@ package metric
 import (
 	"context"
 	"fmt"
+	"os"
 
 	"clouditor.io/clouditor/v2/api"
 	"clouditor.io/clouditor/v2/api/assessment"
+	"clouditor.io/clouditor/v2/api/evidence"
 	"clouditor.io/clouditor/v2/api/orchestrator"
 	"clouditor.io/clouditor/v2/cli"
+	"clouditor.io/clouditor/v2/policies"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	// Registers the ontology resources, so that they can be parsed as part of the evidences
+	_ "clouditor.io/clouditor/v2/api/ontology"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 // NewListMetricsCommand returns a cobra command for the `list` subcommand
@@ -109,6 +119,156 @@ func NewGetMetricCommand() *cobra.Command {
 	return cmd
 }
 
+// NewPublishMetricCommand returns a cobra command for the `publish` subcommand
+func NewPublishMetricCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "publish [metric ID]",
+		Short: "Publishes a draft metric",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var (
+				err     error
+				session *cli.Session
+				client  orchestrator.OrchestratorClient
+				res     *assessment.Metric
+			)
+
+			if session, err = cli.ContinueSession(); err != nil {
+				fmt.Printf("Error while retrieving the session. Please re-authenticate.\n")
+				return nil
+			}
+
+			client = orchestrator.NewOrchestratorClient(session)
+
+			res, err = client.PublishMetric(context.Background(), &orchestrator.PublishMetricRequest{MetricId: args[0]})
+
+			return session.HandleResponse(res, err)
+		},
+		ValidArgsFunction: cli.ValidArgsGetMetrics,
+	}
+
+	return cmd
+}
+
+// NewTestMetricCommand returns a cobra command for the `test` subcommand
+func NewTestMetricCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "test [metric ID] [Rego file] [evidence file...]",
+		Short: "Tests a metric implementation against sample evidences without storing it",
+		Long: "Compiles the metric implementation in the Rego file and evaluates it against the resources of the " +
+			"sample evidences. Each evidence file contains a single evidence in its JSON representation.",
+		Args: cobra.MinimumNArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var (
+				err     error
+				b       []byte
+				session *cli.Session
+				client  orchestrator.OrchestratorClient
+				res     *orchestrator.TestMetricImplementationResponse
+				req     *orchestrator.TestMetricImplementationRequest
+			)
+
+			req = &orchestrator.TestMetricImplementationRequest{
+				Implementation: &assessment.MetricImplementation{
+					MetricId: args[0],
+					Lang:     assessment.MetricImplementation_LANGUAGE_REGO,
+				},
+			}
+
+			b, err = os.ReadFile(args[1])
+			if err != nil {
+				return fmt.Errorf("could not read file: %w", err)
+			}
+			req.Implementation.Code = string(b)
+
+			for _, file := range args[2:] {
+				b, err = os.ReadFile(file)
+				if err != nil {
+					return fmt.Errorf("could not read file: %w", err)
+				}
+
+				ev := new(evidence.Evidence)
+				if err = protojson.Unmarshal(b, ev); err != nil {
+					return fmt.Errorf("could not parse evidence in %s: %w", file, err)
+				}
+
+				req.Evidences = append(req.Evidences, ev)
+			}
+
+			// Only use our own configuration, if a target value is specified
+			if value := viper.GetString("target-value"); value != "" {
+				req.Configuration = &orchestrator.DefaultMetricConfiguration{
+					Operator:    viper.GetString("operator"),
+					TargetValue: new(structpb.Value),
+				}
+
+				if err = protojson.Unmarshal([]byte(value), req.Configuration.TargetValue); err != nil {
+					return fmt.Errorf("could not parse target value: %w", err)
+				}
+			}
+
+			if session, err = cli.ContinueSession(); err != nil {
+				fmt.Printf("Error while retrieving the session. Please re-authenticate.\n")
+				return nil
+			}
+
+			client = orchestrator.NewOrchestratorClient(session)
+
+			res, err = client.TestMetricImplementation(context.Background(), req)
+
+			return session.HandleResponse(res, err)
+		},
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			if len(args) == 0 {
+				return cli.ValidArgsGetMetrics(cmd, args, toComplete)
+			}
+
+			return []string{}, cobra.ShellCompDirectiveDefault
+		},
+	}
+
+	cmd.PersistentFlags().String("operator", "", "the operator used for the evaluation, such as == or >")
+	cmd.PersistentFlags().String("target-value", "", "the target value used for the evaluation as JSON. Defaults to the configuration of the metric for the cloud service of each evidence")
+	_ = viper.BindPFlag("operator", cmd.PersistentFlags().Lookup("operator"))
+	_ = viper.BindPFlag("target-value", cmd.PersistentFlags().Lookup("target-value"))
+
+	return cmd
+}
+
+// NewScaffoldMetricCommand returns a cobra command for the `scaffold` subcommand
+func NewScaffoldMetricCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "scaffold [metric ID]",
+		Short: "Creates the skeleton of a new metric including its tests",
+		Long: "Creates the bundle directory of a new metric in the Clouditor source tree, containing a skeleton of its " +
+			"Rego implementation, its default configuration, a Rego unit test and a directory for golden evidence " +
+			"fixtures. The tests are run as part of `go test ./policies`.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			files, err := policies.ScaffoldMetric(viper.GetString("base-dir"), args[0])
+			if err != nil {
+				return fmt.Errorf("could not scaffold metric: %w", err)
+			}
+
+			for _, file := range files {
+				fmt.Fprintf(cli.Output, "Created %s\n", file)
+			}
+
+			fmt.Fprintf(cli.Output, "Do not forget to add the metric %s to service/orchestrator/metrics.json\n", args[0])
+
+			return nil
+		},
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			return []string{}, cobra.ShellCompDirectiveNoFileComp
+		},
+	}
+
+	cmd.PersistentFlags().String("base-dir", ".", "the root directory of the Clouditor source tree")
+	_ = viper.BindPFlag("base-dir", cmd.PersistentFlags().Lookup("base-dir"))
+
+	return cmd
+}
+
 // NewMetricCommand returns a cobra command for `metric` subcommands
 func NewMetricCommand() *cobra.Command {
 	cmd := &cobra.Command{
@@ -126,5 +286,8 @@ func AddCommands(cmd *cobra.Command) {
 	cmd.AddCommand(
 		NewListMetricsCommand(),
 		NewGetMetricCommand(),
+		NewPublishMetricCommand(),
+		NewTestMetricCommand(),
+		NewScaffoldMetricCommand(),
 	)
 }
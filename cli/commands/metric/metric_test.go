@@ -28,15 +28,18 @@ package metric
 import (
 	"bytes"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"clouditor.io/clouditor/v2/api/orchestrator"
 	"clouditor.io/clouditor/v2/cli"
 	"clouditor.io/clouditor/v2/internal/testutil/assert"
 	"clouditor.io/clouditor/v2/internal/testutil/clitest"
+	"clouditor.io/clouditor/v2/policies"
 	"clouditor.io/clouditor/v2/server"
 	service_orchestrator "clouditor.io/clouditor/v2/service/orchestrator"
 
+	"github.com/spf13/viper"
 	"google.golang.org/protobuf/encoding/protojson"
 )
 
@@ -77,3 +80,90 @@ func TestGetMetric(t *testing.T) {
 
 	assert.NoError(t, err)
 }
+
+func TestPublishMetric(t *testing.T) {
+	var err error
+	var b bytes.Buffer
+
+	cli.Output = &b
+
+	cmd := NewPublishMetricCommand()
+	err = cmd.RunE(nil, []string{"TransportEncryptionEnabled"})
+
+	// The metric is already published
+	assert.ErrorContains(t, err, "only draft metrics can be published")
+}
+
+func TestTestMetric(t *testing.T) {
+	var err error
+	var b bytes.Buffer
+
+	cli.Output = &b
+
+	dir := t.TempDir()
+
+	rego := filepath.Join(dir, "metric.rego")
+	err = os.WriteFile(rego, []byte(`package clouditor.metrics.automatic_updates_enabled
+
+import data.clouditor.compare
+
+default applicable = false
+
+default compliant = false
+
+applicable {
+	input.automaticUpdates
+}
+
+compliant {
+	compare(data.operator, data.target_value, input.automaticUpdates.enabled)
+}`), 0600)
+	assert.NoError(t, err)
+
+	ev := filepath.Join(dir, "evidence.json")
+	err = os.WriteFile(ev, []byte(`{
+	"id": "11111111-1111-1111-1111-111111111111",
+	"timestamp": "2024-01-01T00:00:00Z",
+	"cloudServiceId": "00000000-0000-0000-0000-000000000000",
+	"toolId": "my-tool",
+	"resource": {
+		"@type": "type.googleapis.com/clouditor.ontology.v1.VirtualMachine",
+		"id": "my-vm",
+		"automaticUpdates": {"enabled": true}
+	}
+}`), 0600)
+	assert.NoError(t, err)
+
+	cmd := NewTestMetricCommand()
+	err = cmd.RunE(nil, []string{"AutomaticUpdatesEnabled", rego, ev})
+	assert.NoError(t, err)
+
+	var response = &orchestrator.TestMetricImplementationResponse{}
+
+	err = protojson.Unmarshal(b.Bytes(), response)
+	assert.NoError(t, err)
+	assert.Empty(t, response.CompileErrors)
+	assert.Equal(t, 1, len(response.Results))
+	assert.True(t, response.Results[0].Applicable)
+	assert.True(t, response.Results[0].Compliant)
+
+	// Missing evidence file
+	err = cmd.RunE(nil, []string{"AutomaticUpdatesEnabled", rego, filepath.Join(dir, "missing.json")})
+	assert.ErrorContains(t, err, "could not read file")
+}
+
+func TestScaffoldMetric(t *testing.T) {
+	var b bytes.Buffer
+
+	cli.Output = &b
+	viper.Set("base-dir", t.TempDir())
+	defer viper.Set("base-dir", ".")
+
+	cmd := NewScaffoldMetricCommand()
+	err := cmd.RunE(nil, []string{"MyNewMetric"})
+	assert.NoError(t, err)
+	assert.Contains(t, b.String(), filepath.Join("policies", "bundles", "MyNewMetric", "metric.rego"))
+
+	err = cmd.RunE(nil, []string{"MyNewMetric"})
+	assert.ErrorIs(t, err, policies.ErrMetricExists)
+}
@@ -0,0 +1,146 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package report
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"clouditor.io/clouditor/v2/api/evaluation"
+	"clouditor.io/clouditor/v2/cli"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc/status"
+)
+
+// NewGenerateReportCommand returns a cobra command for the `generate` subcommand
+func NewGenerateReportCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "generate [cloud service ID] [catalog ID]",
+		Short: "Generates a compliance report of a target of evaluation as HTML or PDF",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var (
+				err     error
+				session *cli.Session
+				client  evaluation.EvaluationClient
+				res     *httpbody.HttpBody
+				format  evaluation.ReportFormat
+				output  = viper.GetString("output")
+			)
+
+			format, err = reportFormat(viper.GetString("format"), output)
+			if err != nil {
+				return err
+			}
+
+			if session, err = cli.ContinueSession(); err != nil {
+				fmt.Printf("Error while retrieving the session. Please re-authenticate.\n")
+				return nil
+			}
+
+			client = evaluation.NewEvaluationClient(session)
+
+			res, err = client.GenerateComplianceReport(context.Background(), &evaluation.GenerateComplianceReportRequest{
+				CloudServiceId: args[0],
+				CatalogId:      args[1],
+				Format:         format,
+			})
+			if err != nil {
+				// We only want to forward the message of a gRPC error
+				if s, ok := status.FromError(err); ok {
+					return errors.New(s.Message())
+				}
+
+				return err
+			}
+
+			// Without an output file, we directly output the report, so that it can be piped into other tools
+			if output == "" {
+				_, err = cli.Output.Write(res.Data)
+				return err
+			}
+
+			err = os.WriteFile(output, res.Data, 0600)
+			if err != nil {
+				return fmt.Errorf("could not write report: %w", err)
+			}
+
+			_, err = fmt.Fprintf(cli.Output, "Report written to %s\n", output)
+
+			return err
+		},
+	}
+
+	cmd.PersistentFlags().StringP("format", "f", "", "the format of the report (html or pdf). Derived from the output file extension if not set")
+	cmd.PersistentFlags().StringP("output", "o", "", "the file the report is written to. The report is written to the standard output if not set")
+	_ = viper.BindPFlag("format", cmd.PersistentFlags().Lookup("format"))
+	_ = viper.BindPFlag("output", cmd.PersistentFlags().Lookup("output"))
+
+	return cmd
+}
+
+// reportFormat returns the report format according to the format flag or, if not set, the extension of the output
+// file. It defaults to HTML.
+func reportFormat(format string, file string) (evaluation.ReportFormat, error) {
+	if format == "" {
+		format = strings.TrimPrefix(filepath.Ext(file), ".")
+	}
+
+	switch strings.ToLower(format) {
+	case "", "html", "htm":
+		return evaluation.ReportFormat_REPORT_FORMAT_HTML, nil
+	case "pdf":
+		return evaluation.ReportFormat_REPORT_FORMAT_PDF, nil
+	default:
+		return evaluation.ReportFormat_REPORT_FORMAT_UNSPECIFIED, fmt.Errorf("unsupported report format: %q", format)
+	}
+}
+
+// NewReportCommand returns a cobra command for `report` subcommands
+func NewReportCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "report",
+		Short: "Compliance report commands",
+	}
+
+	AddCommands(cmd)
+
+	return cmd
+}
+
+// AddCommands adds all subcommands
+func AddCommands(cmd *cobra.Command) {
+	cmd.AddCommand(
+		NewGenerateReportCommand(),
+	)
+}
@@ -0,0 +1,153 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package report
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"clouditor.io/clouditor/v2/api/evaluation"
+	"clouditor.io/clouditor/v2/cli"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/testutil/clitest"
+	"clouditor.io/clouditor/v2/server"
+
+	"github.com/spf13/viper"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+)
+
+// mockEvaluationServer returns the requested format as report, so that we do not need a fully configured evaluation
+// service.
+type mockEvaluationServer struct {
+	evaluation.UnimplementedEvaluationServer
+}
+
+func (mockEvaluationServer) GenerateComplianceReport(_ context.Context, req *evaluation.GenerateComplianceReportRequest) (*httpbody.HttpBody, error) {
+	return &httpbody.HttpBody{Data: []byte(req.Format.String())}, nil
+}
+
+func TestMain(m *testing.M) {
+	os.Exit(clitest.RunCLITest(m, server.WithEvaluation(mockEvaluationServer{})))
+}
+
+func TestAddCommands(t *testing.T) {
+	cmd := NewReportCommand()
+
+	// Check if sub commands were added
+	assert.True(t, cmd.HasSubCommands())
+
+	// Check if NewGenerateReportCommand was added
+	for _, v := range cmd.Commands() {
+		if v.Use == "generate [cloud service ID] [catalog ID]" {
+			return
+		}
+	}
+	t.Errorf("No generate command was added")
+}
+
+func TestNewGenerateReportCommand(t *testing.T) {
+	var b bytes.Buffer
+
+	cli.Output = &b
+
+	cmd := NewGenerateReportCommand()
+	err := cmd.RunE(nil, []string{testdata.MockCloudServiceID1, testdata.MockCatalogID})
+	assert.NoError(t, err)
+	assert.Equal(t, "REPORT_FORMAT_HTML", b.String())
+}
+
+func TestNewGenerateReportCommand_Output(t *testing.T) {
+	var (
+		b    bytes.Buffer
+		file = filepath.Join(t.TempDir(), "report.pdf")
+	)
+
+	cli.Output = &b
+
+	viper.Set("output", file)
+	defer viper.Reset()
+
+	cmd := NewGenerateReportCommand()
+	err := cmd.RunE(nil, []string{testdata.MockCloudServiceID1, testdata.MockCatalogID})
+	assert.NoError(t, err)
+	assert.Equal(t, "Report written to "+file+"\n", b.String())
+
+	data, err := os.ReadFile(file)
+	assert.NoError(t, err)
+	assert.Equal(t, "REPORT_FORMAT_PDF", string(data))
+}
+
+func Test_reportFormat(t *testing.T) {
+	type args struct {
+		format string
+		file   string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    evaluation.ReportFormat
+		wantErr assert.WantErr
+	}{
+		{
+			name:    "default",
+			args:    args{},
+			want:    evaluation.ReportFormat_REPORT_FORMAT_HTML,
+			wantErr: assert.Nil[error],
+		},
+		{
+			name:    "format flag takes precedence",
+			args:    args{format: "PDF", file: "report.html"},
+			want:    evaluation.ReportFormat_REPORT_FORMAT_PDF,
+			wantErr: assert.Nil[error],
+		},
+		{
+			name:    "from file extension",
+			args:    args{file: "report.pdf"},
+			want:    evaluation.ReportFormat_REPORT_FORMAT_PDF,
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "unsupported",
+			args: args{format: "docx"},
+			want: evaluation.ReportFormat_REPORT_FORMAT_UNSPECIFIED,
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, `unsupported report format: "docx"`)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := reportFormat(tt.args.format, tt.args.file)
+
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -27,11 +27,14 @@ package discovery
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"clouditor.io/clouditor/v2/api/discovery"
 	"clouditor.io/clouditor/v2/cli"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
 )
 
@@ -51,7 +54,11 @@ func NewExperimentalCommand() *cobra.Command {
 func AddExperimentalCommands(cmd *cobra.Command) {
 	cmd.AddCommand(
 		NewListGraphEdgesCommand(),
+		NewQueryGraphCommand(),
+		NewExportGraphCommand(),
 		NewUpdateResourceCommand(),
+		NewRegisterResourceTypeCommand(),
+		NewListResourceTypesCommand(),
 	)
 }
 
@@ -84,6 +91,82 @@ func NewListGraphEdgesCommand() *cobra.Command {
 	return cmd
 }
 
+// NewQueryGraphCommand returns a cobra command for the `query-graph` subcommand
+func NewQueryGraphCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "query-graph [query]",
+		Short: "Queries paths in the resource graph",
+		Long:  "Queries paths in the resource graph, e.g., 'LoadBalancer[internetAccessibleEndpoint] -[*]- VirtualMachine'",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var (
+				err     error
+				session *cli.Session
+				client  discovery.ExperimentalDiscoveryClient
+				res     *discovery.QueryGraphResponse
+			)
+
+			if session, err = cli.ContinueSession(); err != nil {
+				fmt.Printf("Error while retrieving the session. Please re-authenticate.\n")
+				return nil
+			}
+
+			client = discovery.NewExperimentalDiscoveryClient(session)
+
+			res, err = client.QueryGraph(context.Background(), &discovery.QueryGraphRequest{Query: args[0]})
+
+			return session.HandleResponse(res, err)
+		},
+	}
+
+	return cmd
+}
+
+// NewExportGraphCommand returns a cobra command for the `export-graph` subcommand
+func NewExportGraphCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-graph",
+		Short: "Exports the resource graph as DOT, Cytoscape JSON or Neo4j Cypher statements",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var (
+				err     error
+				session *cli.Session
+				client  discovery.ExperimentalDiscoveryClient
+				res     *discovery.ExportGraphResponse
+			)
+
+			if session, err = cli.ContinueSession(); err != nil {
+				fmt.Printf("Error while retrieving the session. Please re-authenticate.\n")
+				return nil
+			}
+
+			client = discovery.NewExperimentalDiscoveryClient(session)
+
+			res, err = client.ExportGraph(context.Background(), &discovery.ExportGraphRequest{
+				Format: viper.GetString("graph-format"),
+			})
+			if err != nil {
+				// We only want to forward the message of a gRPC error
+				if s, ok := status.FromError(err); ok {
+					return errors.New(s.Message())
+				}
+
+				return err
+			}
+
+			// We directly output the graph, so that it can be piped into other tools
+			_, err = cli.Output.Write(res.Data)
+
+			return err
+		},
+	}
+
+	cmd.PersistentFlags().StringP("format", "f", "", "the format of the graph (dot, cytoscape or cypher). Defaults to dot")
+	_ = viper.BindPFlag("graph-format", cmd.PersistentFlags().Lookup("format"))
+
+	return cmd
+}
+
 // NewUpdateResourceCommand returns a cobra command for the `list-graph-edges` subcommand
 func NewUpdateResourceCommand() *cobra.Command {
 	cmd := &cobra.Command{
@@ -122,3 +205,71 @@ func NewUpdateResourceCommand() *cobra.Command {
 
 	return cmd
 }
+
+// NewRegisterResourceTypeCommand returns a cobra command for the `register-resource-type` subcommand
+func NewRegisterResourceTypeCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-resource-type",
+		Short: "Registers a custom resource type",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var (
+				err     error
+				session *cli.Session
+				client  discovery.ExperimentalDiscoveryClient
+				res     *discovery.CustomResourceType
+				req     *discovery.RegisterResourceTypeRequest
+			)
+
+			if session, err = cli.ContinueSession(); err != nil {
+				fmt.Printf("Error while retrieving the session. Please re-authenticate.\n")
+				return nil
+			}
+
+			client = discovery.NewExperimentalDiscoveryClient(session)
+
+			req = new(discovery.RegisterResourceTypeRequest)
+			req.ResourceType = new(discovery.CustomResourceType)
+
+			err = protojson.Unmarshal([]byte(args[0]), req.ResourceType)
+			if err != nil {
+				return session.HandleResponse(nil, err)
+			}
+
+			res, err = client.RegisterResourceType(context.Background(), req)
+
+			return session.HandleResponse(res, err)
+		},
+	}
+
+	return cmd
+}
+
+// NewListResourceTypesCommand returns a cobra command for the `list-resource-types` subcommand
+func NewListResourceTypesCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-resource-types",
+		Short: "Lists custom resource types",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var (
+				err     error
+				session *cli.Session
+				client  discovery.ExperimentalDiscoveryClient
+				res     *discovery.ListResourceTypesResponse
+			)
+
+			if session, err = cli.ContinueSession(); err != nil {
+				fmt.Printf("Error while retrieving the session. Please re-authenticate.\n")
+				return nil
+			}
+
+			client = discovery.NewExperimentalDiscoveryClient(session)
+
+			res, err = client.ListResourceTypes(context.Background(), &discovery.ListResourceTypesRequest{})
+
+			return session.HandleResponse(res, err)
+		},
+	}
+
+	return cmd
+}
@@ -33,6 +33,7 @@ import (
 	"clouditor.io/clouditor/v2/cli"
 	"clouditor.io/clouditor/v2/internal/testutil/assert"
 
+	"github.com/spf13/viper"
 	"google.golang.org/protobuf/encoding/protojson"
 )
 
@@ -71,3 +72,65 @@ func TestNewUpdateResourceCommand(t *testing.T) {
 	assert.NotNil(t, response)
 	assert.NotEmpty(t, response)
 }
+
+func TestNewQueryGraphCommand(t *testing.T) {
+	var err error
+	var b bytes.Buffer
+
+	cli.Output = &b
+
+	cmd := NewQueryGraphCommand()
+	err = cmd.RunE(nil, []string{"*"})
+	assert.NoError(t, err)
+
+	var response = &discovery.QueryGraphResponse{}
+	err = protojson.Unmarshal(b.Bytes(), response)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, response)
+	assert.NotEmpty(t, response.Paths)
+}
+
+func TestNewExportGraphCommand(t *testing.T) {
+	var err error
+	var b bytes.Buffer
+
+	cli.Output = &b
+
+	viper.Set("graph-format", "cytoscape")
+	defer viper.Set("graph-format", "")
+
+	cmd := NewExportGraphCommand()
+	err = cmd.RunE(nil, []string{})
+	assert.NoError(t, err)
+	assert.Contains(t, b.String(), `"elements"`)
+}
+
+func TestNewRegisterResourceTypeCommand(t *testing.T) {
+	var err error
+	var b bytes.Buffer
+
+	cli.Output = &b
+
+	cmd := NewRegisterResourceTypeCommand()
+	err = cmd.RunE(nil, []string{`{"name": "Library", "superTypes": ["Resource"], "properties": [{"name": "name", "type": "PROPERTY_TYPE_STRING", "required": true}]}`})
+	assert.NoError(t, err)
+
+	var response = &discovery.CustomResourceType{}
+	err = protojson.Unmarshal(b.Bytes(), response)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Library", response.Name)
+
+	b.Reset()
+
+	cmd = NewListResourceTypesCommand()
+	err = cmd.RunE(nil, []string{})
+	assert.NoError(t, err)
+
+	var list = &discovery.ListResourceTypesResponse{}
+	err = protojson.Unmarshal(b.Bytes(), list)
+
+	assert.NoError(t, err)
+	assert.NotEmpty(t, list.ResourceTypes)
+}
@@ -27,14 +27,21 @@ package orchestrator
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"os"
+	"strconv"
 
 	"clouditor.io/clouditor/v2/api"
 	"clouditor.io/clouditor/v2/api/assessment"
 	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/internal/util"
 
 	"clouditor.io/clouditor/v2/cli"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
 // NewListAssessmentResultsCommand returns a cobra command for the `list-assessment-results` subcommand
@@ -77,6 +84,129 @@ func NewListAssessmentResultsCommand() *cobra.Command {
 	return cmd
 }
 
+// NewSubscribeAssessmentResultsCommand returns a cobra command for the `subscribe-assessment-results` subcommand
+func NewSubscribeAssessmentResultsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "subscribe-assessment-results",
+		Short: "Continuously prints new assessment results stored in the Orchestrator service",
+		// The command is re-used by `assessment-result watch`, so we can only bind the flags of the executed command
+		PreRun: func(cmd *cobra.Command, args []string) {
+			_ = viper.BindPFlag("assessment-results-cloud-service-id", cmd.PersistentFlags().Lookup("cloud-service-id"))
+			_ = viper.BindPFlag("assessment-results-metric-ids", cmd.PersistentFlags().Lookup("metric-id"))
+			_ = viper.BindPFlag("assessment-results-compliant", cmd.PersistentFlags().Lookup("compliant"))
+			_ = viper.BindPFlag("assessment-results-compliance-changed-only", cmd.PersistentFlags().Lookup("compliance-changed-only"))
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var (
+				err     error
+				session *cli.Session
+				client  orchestrator.OrchestratorClient
+				req     *orchestrator.SubscribeAssessmentResultsRequest
+				stream  orchestrator.Orchestrator_SubscribeAssessmentResultsClient
+			)
+
+			req, err = subscribeAssessmentResultsRequest()
+			if err != nil {
+				return err
+			}
+
+			if session, err = cli.ContinueSession(); err != nil {
+				fmt.Printf("Error while retrieving the session. Please re-authenticate.\n")
+				return nil
+			}
+
+			client = orchestrator.NewOrchestratorClient(session)
+
+			stream, err = client.SubscribeAssessmentResults(cmd.Context(), req)
+
+			return session.HandleStream(stream, err, func() proto.Message {
+				return new(orchestrator.AssessmentResultEvent)
+			})
+		},
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			return []string{}, cobra.ShellCompDirectiveNoFileComp
+		},
+	}
+
+	cmd.PersistentFlags().String("cloud-service-id", "", "only print assessment results of this cloud service")
+	cmd.PersistentFlags().StringSlice("metric-id", nil, "only print assessment results of these metrics")
+	cmd.PersistentFlags().String("compliant", "", "only print compliant (true) or non-compliant (false) assessment results")
+	cmd.PersistentFlags().Bool("compliance-changed-only", false, "only print assessment results that changed the compliance state of a resource")
+
+	return cmd
+}
+
+// subscribeAssessmentResultsRequest builds the request of the `subscribe-assessment-results` subcommand out of its
+// flags.
+func subscribeAssessmentResultsRequest() (req *orchestrator.SubscribeAssessmentResultsRequest, err error) {
+	var filter = &orchestrator.SubscribeAssessmentResultsRequest_Filter{
+		MetricIds: viper.GetStringSlice("assessment-results-metric-ids"),
+	}
+
+	if id := viper.GetString("assessment-results-cloud-service-id"); id != "" {
+		filter.CloudServiceId = &id
+	}
+
+	if s := viper.GetString("assessment-results-compliant"); s != "" {
+		compliant, err := strconv.ParseBool(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value %q for compliant: %w", s, err)
+		}
+
+		filter.Compliant = &compliant
+	}
+
+	if viper.GetBool("assessment-results-compliance-changed-only") {
+		filter.ComplianceChangedOnly = util.Ref(true)
+	}
+
+	return &orchestrator.SubscribeAssessmentResultsRequest{Filter: filter}, nil
+}
+
+// NewExportOscalAssessmentResultsCommand returns a cobra command for the `export-oscal-assessment-results` subcommand
+func NewExportOscalAssessmentResultsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-oscal-assessment-results [cloud service ID] [catalog ID]",
+		Short: "Exports the latest assessment results of a cloud service as OSCAL assessment-results",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var (
+				err     error
+				session *cli.Session
+				client  orchestrator.OrchestratorClient
+				res     *orchestrator.ExportOscalAssessmentResultsResponse
+			)
+
+			if session, err = cli.ContinueSession(); err != nil {
+				fmt.Printf("Error while retrieving the session. Please re-authenticate.\n")
+				return nil
+			}
+
+			client = orchestrator.NewOrchestratorClient(session)
+
+			res, err = client.ExportOscalAssessmentResults(context.Background(), &orchestrator.ExportOscalAssessmentResultsRequest{
+				CloudServiceId: args[0],
+				CatalogId:      args[1],
+			})
+			if err != nil {
+				// We only want to forward the message of a gRPC error
+				if s, ok := status.FromError(err); ok {
+					return errors.New(s.Message())
+				}
+
+				return err
+			}
+
+			// We directly output the OSCAL document, so that it can be used by other tools
+			_, err = fmt.Fprintf(cli.Output, "%s\n", string(res.Data))
+
+			return err
+		},
+	}
+
+	return cmd
+}
+
 // NewListCatalogsCommand returns a cobra command for the `list-requirements` subcommand
 func NewListCatalogsCommand() *cobra.Command {
 	cmd := &cobra.Command{
@@ -150,6 +280,120 @@ func NewGetCatalogCommand() *cobra.Command {
 	return cmd
 }
 
+// NewListBuiltinCatalogsCommand returns a cobra command for the `list-builtin-catalogs` subcommand
+func NewListBuiltinCatalogsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-builtin-catalogs",
+		Short: "Lists all catalogs that are built into Clouditor",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var (
+				err     error
+				session *cli.Session
+				client  orchestrator.OrchestratorClient
+				res     *orchestrator.ListBuiltinCatalogsResponse
+			)
+
+			if session, err = cli.ContinueSession(); err != nil {
+				fmt.Printf("Error while retrieving the session. Please re-authenticate.\n")
+				return nil
+			}
+
+			client = orchestrator.NewOrchestratorClient(session)
+
+			res, err = client.ListBuiltinCatalogs(context.Background(), &orchestrator.ListBuiltinCatalogsRequest{})
+
+			return session.HandleResponse(res, err)
+		},
+	}
+
+	return cmd
+}
+
+// NewLoadBuiltinCatalogCommand returns a cobra command for the `load-builtin-catalog` subcommand
+func NewLoadBuiltinCatalogCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "load-builtin-catalog [catalog ID]",
+		Short: "Loads a built-in catalog by its ID",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var (
+				err     error
+				session *cli.Session
+				client  orchestrator.OrchestratorClient
+				res     *orchestrator.Catalog
+			)
+
+			if session, err = cli.ContinueSession(); err != nil {
+				fmt.Printf("Error while retrieving the session. Please re-authenticate.\n")
+				return nil
+			}
+
+			client = orchestrator.NewOrchestratorClient(session)
+
+			catalogID := args[0]
+
+			res, err = client.LoadBuiltinCatalog(context.Background(), &orchestrator.LoadBuiltinCatalogRequest{CatalogId: catalogID})
+
+			return session.HandleResponse(res, err)
+		},
+	}
+
+	return cmd
+}
+
+// NewImportOscalCatalogCommand returns a cobra command for the `import-oscal-catalog` subcommand
+func NewImportOscalCatalogCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import-oscal-catalog [file]",
+		Short: "Imports an OSCAL catalog or profile as a new catalog",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var (
+				err     error
+				session *cli.Session
+				client  orchestrator.OrchestratorClient
+				res     *orchestrator.Catalog
+				req     *orchestrator.ImportOscalCatalogRequest
+			)
+
+			req = &orchestrator.ImportOscalCatalogRequest{}
+
+			req.Data, err = os.ReadFile(args[0])
+			if err != nil {
+				return fmt.Errorf("could not read file: %w", err)
+			}
+
+			if id := viper.GetString("catalog-id"); id != "" {
+				req.CatalogId = &id
+			}
+
+			if id := viper.GetString("base-catalog-id"); id != "" {
+				req.BaseCatalogId = &id
+			}
+
+			if session, err = cli.ContinueSession(); err != nil {
+				fmt.Printf("Error while retrieving the session. Please re-authenticate.\n")
+				return nil
+			}
+
+			client = orchestrator.NewOrchestratorClient(session)
+
+			res, err = client.ImportOscalCatalog(context.Background(), req)
+
+			return session.HandleResponse(res, err)
+		},
+	}
+
+	cmd.PersistentFlags().String("catalog-id", "", "the ID of the new catalog. Defaults to the UUID of the OSCAL document")
+	cmd.PersistentFlags().String("base-catalog-id", "", "the ID of the catalog that an OSCAL profile is resolved against")
+	_ = viper.BindPFlag("catalog-id", cmd.PersistentFlags().Lookup("catalog-id"))
+	_ = viper.BindPFlag("base-catalog-id", cmd.PersistentFlags().Lookup("base-catalog-id"))
+
+	_ = cmd.RegisterFlagCompletionFunc("base-catalog-id", cli.ValidArgsGetCatalogs)
+
+	return cmd
+}
+
 // NewGetCategoryCommand returns a cobra command for the `get-category` subcommand
 func NewGetCategoryCommand() *cobra.Command {
 	cmd := &cobra.Command{
@@ -219,6 +463,39 @@ func NewGetControlCommand() *cobra.Command {
 	return cmd
 }
 
+// NewGetCatalogCoverageCommand returns a cobra command for the `get-catalog-coverage` subcommand
+func NewGetCatalogCoverageCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get-catalog-coverage [catalog ID]",
+		Short: "Reports which controls of a catalog are not covered by any metric",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var (
+				err     error
+				session *cli.Session
+				client  orchestrator.OrchestratorClient
+				res     *orchestrator.CatalogCoverage
+			)
+
+			if session, err = cli.ContinueSession(); err != nil {
+				fmt.Printf("Error while retrieving the session. Please re-authenticate.\n")
+				return nil
+			}
+
+			client = orchestrator.NewOrchestratorClient(session)
+
+			catalogID := args[0]
+
+			res, err = client.GetCatalogCoverage(context.Background(), &orchestrator.GetCatalogCoverageRequest{CatalogId: catalogID})
+
+			return session.HandleResponse(res, err)
+		},
+		ValidArgsFunction: cli.ValidArgsGetCatalogs,
+	}
+
+	return cmd
+}
+
 // NewOrchestratorCommand returns a cobra command for `orchestrator` subcommands
 func NewOrchestratorCommand() *cobra.Command {
 	cmd := &cobra.Command{
@@ -235,9 +512,15 @@ func NewOrchestratorCommand() *cobra.Command {
 func AddCommands(cmd *cobra.Command) {
 	cmd.AddCommand(
 		NewListAssessmentResultsCommand(),
+		NewSubscribeAssessmentResultsCommand(),
+		NewExportOscalAssessmentResultsCommand(),
 		NewListCatalogsCommand(),
 		NewGetCatalogCommand(),
+		NewListBuiltinCatalogsCommand(),
+		NewLoadBuiltinCatalogCommand(),
+		NewImportOscalCatalogCommand(),
 		NewGetCategoryCommand(),
 		NewGetControlCommand(),
+		NewGetCatalogCoverageCommand(),
 	)
 }
@@ -30,8 +30,10 @@ import (
 	"context"
 	"encoding/json"
 	"os"
+	"path/filepath"
 	"testing"
 
+	"clouditor.io/clouditor/v2/internal/oscal"
 	"clouditor.io/clouditor/v2/internal/testdata"
 	"clouditor.io/clouditor/v2/internal/testutil/clitest"
 	"clouditor.io/clouditor/v2/internal/testutil/servicetest/orchestratortest"
@@ -44,6 +46,7 @@ import (
 	"clouditor.io/clouditor/v2/internal/testutil/assert"
 	service_orchestrator "clouditor.io/clouditor/v2/service/orchestrator"
 
+	"github.com/spf13/viper"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -123,6 +126,50 @@ func TestNewListResultsCommand(t *testing.T) {
 	assert.NotEmpty(t, response.Results)
 }
 
+func Test_subscribeAssessmentResultsRequest(t *testing.T) {
+	viper.Set("assessment-results-cloud-service-id", testdata.MockCloudServiceID1)
+	viper.Set("assessment-results-metric-ids", []string{testdata.MockMetricID1})
+	viper.Set("assessment-results-compliant", "false")
+	viper.Set("assessment-results-compliance-changed-only", true)
+	defer viper.Set("assessment-results-cloud-service-id", "")
+	defer viper.Set("assessment-results-metric-ids", nil)
+	defer viper.Set("assessment-results-compliant", "")
+	defer viper.Set("assessment-results-compliance-changed-only", false)
+
+	got, err := subscribeAssessmentResultsRequest()
+	assert.NoError(t, err)
+	assert.Equal(t, &orchestrator.SubscribeAssessmentResultsRequest{
+		Filter: &orchestrator.SubscribeAssessmentResultsRequest_Filter{
+			CloudServiceId:        util.Ref(testdata.MockCloudServiceID1),
+			MetricIds:             []string{testdata.MockMetricID1},
+			Compliant:             util.Ref(false),
+			ComplianceChangedOnly: util.Ref(true),
+		},
+	}, got)
+
+	viper.Set("assessment-results-compliant", "maybe")
+
+	_, err = subscribeAssessmentResultsRequest()
+	assert.ErrorContains(t, err, `invalid value "maybe" for compliant`)
+}
+
+func TestNewExportOscalAssessmentResultsCommand(t *testing.T) {
+	var b bytes.Buffer
+
+	cli.Output = &b
+
+	cmd := NewExportOscalAssessmentResultsCommand()
+	err := cmd.RunE(nil, []string{testdata.MockCloudServiceID1, testdata.MockCatalogID})
+	assert.NoError(t, err)
+
+	var doc oscal.Document
+	err = json.Unmarshal(b.Bytes(), &doc)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, doc.AssessmentResults)
+	assert.NotEmpty(t, doc.AssessmentResults.Results[0].Observations)
+}
+
 func TestNewListCatalogCommand(t *testing.T) {
 	var b bytes.Buffer
 
@@ -158,6 +205,67 @@ func TestNewGetCatalogCommand(t *testing.T) {
 	assert.Equal(t, testdata.MockCatalogID, response.Id)
 }
 
+func TestNewListBuiltinCatalogsCommand(t *testing.T) {
+	var b bytes.Buffer
+
+	cli.Output = &b
+
+	cmd := NewListBuiltinCatalogsCommand()
+	err := cmd.RunE(nil, []string{})
+	assert.NoError(t, err)
+
+	var response = &orchestrator.ListBuiltinCatalogsResponse{}
+	err = protojson.Unmarshal(b.Bytes(), response)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, response)
+	assert.NotEmpty(t, response.Catalogs)
+}
+
+func TestNewLoadBuiltinCatalogCommand(t *testing.T) {
+	var b bytes.Buffer
+
+	cli.Output = &b
+
+	cmd := NewLoadBuiltinCatalogCommand()
+	err := cmd.RunE(nil, []string{"EUCS"})
+	assert.NoError(t, err)
+
+	var response = &orchestrator.Catalog{}
+	err = protojson.Unmarshal(b.Bytes(), response)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, response)
+	assert.Equal(t, "EUCS", response.Id)
+}
+
+func TestNewImportOscalCatalogCommand(t *testing.T) {
+	var b bytes.Buffer
+
+	cli.Output = &b
+
+	file := filepath.Join(t.TempDir(), "catalog.json")
+	err := os.WriteFile(file, []byte(`{
+		"catalog": {
+			"uuid": "74c8ba1e-5cd4-4ad1-bbfd-d888e2f6c724",
+			"metadata": {"title": "OSCAL Catalog", "version": "1.0", "oscal-version": "1.1.2"},
+			"controls": [{"id": "ac-1", "title": "Policy and Procedures"}]
+		}
+	}`), 0600)
+	assert.NoError(t, err)
+
+	cmd := NewImportOscalCatalogCommand()
+	err = cmd.RunE(nil, []string{file})
+	assert.NoError(t, err)
+
+	var response = &orchestrator.Catalog{}
+	err = protojson.Unmarshal(b.Bytes(), response)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "74c8ba1e-5cd4-4ad1-bbfd-d888e2f6c724", response.Id)
+	assert.Equal(t, "OSCAL Catalog", response.Name)
+}
+
 func TestNewGetCategoryCommand(t *testing.T) {
 	var b bytes.Buffer
 
@@ -194,6 +302,24 @@ func TestNewGetControlCommand(t *testing.T) {
 	assert.Equal(t, testdata.MockControlID1, response.Id)
 }
 
+func TestNewGetCatalogCoverageCommand(t *testing.T) {
+	var b bytes.Buffer
+
+	cli.Output = &b
+
+	cmd := NewGetCatalogCoverageCommand()
+	err := cmd.RunE(nil, []string{testdata.MockCatalogID})
+	assert.NoError(t, err)
+
+	var response = &orchestrator.CatalogCoverage{}
+	err = protojson.Unmarshal(b.Bytes(), response)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, response)
+	assert.Equal(t, testdata.MockCatalogID, response.CatalogId)
+	assert.Equal(t, response.TotalControls, response.CoveredControls)
+}
+
 func toStruct(f float32) (s *structpb.Value) {
 	var (
 		b   []byte
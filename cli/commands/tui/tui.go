@@ -0,0 +1,434 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package tui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"clouditor.io/clouditor/v2/api"
+	"clouditor.io/clouditor/v2/api/assessment"
+	"clouditor.io/clouditor/v2/api/evaluation"
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/cli"
+	"clouditor.io/clouditor/v2/internal/util"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxRows is the maximum number of rows shown in each section of the dashboard.
+const maxRows = 10
+
+// NewTUICommand returns a cobra command for the `tui` command
+func NewTUICommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tui",
+		Short: "Shows an interactive dashboard of the cloud services and their compliance",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var (
+				err     error
+				session *cli.Session
+				ctx     context.Context
+			)
+
+			if session, err = cli.ContinueSession(); err != nil {
+				fmt.Printf("Error while retrieving the session. Please re-authenticate.\n")
+				return nil
+			}
+
+			ctx = cmd.Context()
+			if ctx == nil {
+				ctx = context.Background()
+			}
+
+			m := newModel(ctx, orchestrator.NewOrchestratorClient(session), evaluation.NewEvaluationClient(session))
+
+			_, err = tea.NewProgram(m, tea.WithAltScreen(), tea.WithContext(ctx)).Run()
+			if errors.Is(err, tea.ErrProgramKilled) {
+				return nil
+			}
+
+			return err
+		},
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			return []string{}, cobra.ShellCompDirectiveNoFileComp
+		},
+	}
+
+	return cmd
+}
+
+// model is the [tea.Model] of the dashboard. It shows the cloud services, the compliance of the controls and the
+// failing resources of the selected cloud service as well as the latest assessment results, which are received
+// using a subscription to the orchestrator.
+type model struct {
+	ctx          context.Context
+	orchestrator orchestrator.OrchestratorClient
+	evaluation   evaluation.EvaluationClient
+
+	services []*orchestrator.CloudService
+	selected int
+
+	// controls contains the latest evaluation results of the selected cloud service, one per control
+	controls []*evaluation.EvaluationResult
+
+	// failing contains the latest non-compliant assessment results of the selected cloud service
+	failing []*assessment.AssessmentResult
+
+	// activity contains the latest assessment results of all cloud services, the newest first
+	activity []*assessment.AssessmentResult
+
+	stream orchestrator.Orchestrator_SubscribeAssessmentResultsClient
+
+	err error
+}
+
+// cloudServicesMsg contains the cloud services retrieved from the orchestrator.
+type cloudServicesMsg []*orchestrator.CloudService
+
+// detailsMsg contains the evaluation and failing assessment results of a cloud service.
+type detailsMsg struct {
+	cloudServiceID string
+	controls       []*evaluation.EvaluationResult
+	failing        []*assessment.AssessmentResult
+}
+
+// streamMsg contains the established subscription to new assessment results.
+type streamMsg struct {
+	stream orchestrator.Orchestrator_SubscribeAssessmentResultsClient
+}
+
+// assessmentResultMsg contains an assessment result received from the subscription.
+type assessmentResultMsg struct {
+	result *assessment.AssessmentResult
+}
+
+// errMsg contains an error that occurred while retrieving data.
+type errMsg struct {
+	err error
+}
+
+func newModel(ctx context.Context, orchestratorClient orchestrator.OrchestratorClient, evaluationClient evaluation.EvaluationClient) *model {
+	return &model{
+		ctx:          ctx,
+		orchestrator: orchestratorClient,
+		evaluation:   evaluationClient,
+	}
+}
+
+// Init implements [tea.Model]. It loads the cloud services and subscribes to new assessment results.
+func (m *model) Init() tea.Cmd {
+	return tea.Batch(m.loadCloudServices, m.subscribe)
+}
+
+// Update implements [tea.Model].
+func (m *model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "q", "ctrl+c", "esc":
+			return m, tea.Quit
+		case "up", "k":
+			if m.selected > 0 {
+				m.selected--
+				return m, m.loadDetails
+			}
+		case "down", "j":
+			if m.selected < len(m.services)-1 {
+				m.selected++
+				return m, m.loadDetails
+			}
+		case "r":
+			return m, m.loadCloudServices
+		}
+	case cloudServicesMsg:
+		m.services = msg
+		m.err = nil
+		if m.selected >= len(m.services) {
+			m.selected = max(len(m.services)-1, 0)
+		}
+
+		return m, m.loadDetails
+	case detailsMsg:
+		// Ignore details of a previously selected cloud service
+		if msg.cloudServiceID == m.selectedID() {
+			m.controls = msg.controls
+			m.failing = msg.failing
+		}
+	case streamMsg:
+		m.stream = msg.stream
+
+		return m, m.receive
+	case assessmentResultMsg:
+		m.activity = append([]*assessment.AssessmentResult{msg.result}, m.activity...)
+		if len(m.activity) > maxRows {
+			m.activity = m.activity[:maxRows]
+		}
+
+		if msg.result.CloudServiceId == m.selectedID() {
+			m.updateFailing(msg.result)
+		}
+
+		return m, m.receive
+	case errMsg:
+		m.err = msg.err
+	}
+
+	return m, nil
+}
+
+// View implements [tea.Model].
+func (m *model) View() string {
+	var b strings.Builder
+
+	b.WriteString("Clouditor Dashboard\n\n")
+
+	b.WriteString("Cloud services\n")
+	if len(m.services) == 0 {
+		b.WriteString("  No cloud services\n")
+	}
+	for i, s := range m.services {
+		cursor := " "
+		if i == m.selected {
+			cursor = ">"
+		}
+
+		fmt.Fprintf(&b, "%s %s (%s)\n", cursor, s.Name, s.Id)
+	}
+
+	fmt.Fprintf(&b, "\nCompliance per control (%d of %d compliant)\n", m.compliantControls(), len(m.controls))
+	writeRows(&b, m.controls, func(r *evaluation.EvaluationResult) string {
+		return fmt.Sprintf("%s %s/%s", statusSymbol(r.Status), r.ControlCatalogId, r.ControlId)
+	})
+
+	fmt.Fprintf(&b, "\nFailing resources (%d)\n", len(m.failing))
+	writeRows(&b, m.failing, func(r *assessment.AssessmentResult) string {
+		return fmt.Sprintf("✘ %s (%s)", r.ResourceId, r.MetricId)
+	})
+
+	b.WriteString("\nActivity\n")
+	writeRows(&b, m.activity, func(r *assessment.AssessmentResult) string {
+		return fmt.Sprintf("%s %s %s (%s)", r.Timestamp.AsTime().Local().Format("15:04:05"),
+			complianceSymbol(r.Compliant), r.ResourceId, r.MetricId)
+	})
+
+	if m.err != nil {
+		fmt.Fprintf(&b, "\nError: %v\n", m.err)
+	}
+
+	b.WriteString("\n↑/↓: select cloud service • r: refresh • q: quit\n")
+
+	return b.String()
+}
+
+// selectedID returns the ID of the selected cloud service or an empty string, if there is none.
+func (m *model) selectedID() string {
+	if m.selected < len(m.services) {
+		return m.services[m.selected].Id
+	}
+
+	return ""
+}
+
+// compliantControls returns the number of compliant controls of the selected cloud service.
+func (m *model) compliantControls() (n int) {
+	for _, r := range m.controls {
+		if r.Status == evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT ||
+			r.Status == evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT_MANUALLY {
+			n++
+		}
+	}
+
+	return
+}
+
+// updateFailing updates the failing resources with a new assessment result, which replaces the previous result of
+// the same resource and metric.
+func (m *model) updateFailing(result *assessment.AssessmentResult) {
+	var failing []*assessment.AssessmentResult
+
+	for _, r := range m.failing {
+		if r.ResourceId != result.ResourceId || r.MetricId != result.MetricId {
+			failing = append(failing, r)
+		}
+	}
+
+	if !result.Compliant {
+		failing = append(failing, result)
+		sortResults(failing)
+	}
+
+	m.failing = failing
+}
+
+// loadCloudServices retrieves all cloud services.
+func (m *model) loadCloudServices() tea.Msg {
+	services, err := api.ListAllPaginated(&orchestrator.ListCloudServicesRequest{}, m.orchestrator.ListCloudServices,
+		func(res *orchestrator.ListCloudServicesResponse) []*orchestrator.CloudService {
+			return res.Services
+		})
+	if err != nil {
+		return errMsg{fmt.Errorf("could not retrieve cloud services: %w", err)}
+	}
+
+	return cloudServicesMsg(services)
+}
+
+// loadDetails retrieves the latest evaluation results of all controls and the latest non-compliant assessment
+// results of the selected cloud service.
+func (m *model) loadDetails() tea.Msg {
+	var (
+		id  = m.selectedID()
+		msg = detailsMsg{cloudServiceID: id}
+		err error
+	)
+
+	if id == "" {
+		return msg
+	}
+
+	msg.controls, err = api.ListAllPaginated(&evaluation.ListEvaluationResultsRequest{
+		Filter: &evaluation.ListEvaluationResultsRequest_Filter{
+			CloudServiceId: &id,
+			ParentsOnly:    util.Ref(true),
+		},
+		LatestByControlId: util.Ref(true),
+	}, m.evaluation.ListEvaluationResults,
+		func(res *evaluation.ListEvaluationResultsResponse) []*evaluation.EvaluationResult {
+			return res.Results
+		})
+	if err != nil {
+		return errMsg{fmt.Errorf("could not retrieve evaluation results: %w", err)}
+	}
+
+	sort.Slice(msg.controls, func(i, j int) bool {
+		if msg.controls[i].ControlCatalogId != msg.controls[j].ControlCatalogId {
+			return msg.controls[i].ControlCatalogId < msg.controls[j].ControlCatalogId
+		}
+
+		return msg.controls[i].ControlId < msg.controls[j].ControlId
+	})
+
+	msg.failing, err = api.ListAllPaginated(&orchestrator.ListAssessmentResultsRequest{
+		Filter: &orchestrator.Filter{
+			CloudServiceId: &id,
+			Compliant:      util.Ref(false),
+		},
+		LatestByResourceId: util.Ref(true),
+	}, m.orchestrator.ListAssessmentResults,
+		func(res *orchestrator.ListAssessmentResultsResponse) []*assessment.AssessmentResult {
+			return res.Results
+		})
+	if err != nil {
+		return errMsg{fmt.Errorf("could not retrieve assessment results: %w", err)}
+	}
+
+	sortResults(msg.failing)
+
+	return msg
+}
+
+// subscribe subscribes to new assessment results of all cloud services.
+func (m *model) subscribe() tea.Msg {
+	stream, err := m.orchestrator.SubscribeAssessmentResults(m.ctx, &orchestrator.SubscribeAssessmentResultsRequest{})
+	if err != nil {
+		return errMsg{fmt.Errorf("could not subscribe to assessment results: %w", err)}
+	}
+
+	return streamMsg{stream}
+}
+
+// receive waits for the next assessment result of the subscription.
+func (m *model) receive() tea.Msg {
+	event, err := m.stream.Recv()
+	if errors.Is(err, io.EOF) || status.Code(err) == codes.Canceled {
+		return nil
+	} else if err != nil {
+		return errMsg{fmt.Errorf("could not receive assessment results: %w", err)}
+	}
+
+	return assessmentResultMsg{event.Result}
+}
+
+// writeRows writes at most [maxRows] rows, formatted by format, to b.
+func writeRows[T any](b *strings.Builder, rows []T, format func(T) string) {
+	if len(rows) == 0 {
+		b.WriteString("  -\n")
+	}
+
+	for i, row := range rows {
+		if i == maxRows {
+			fmt.Fprintf(b, "  … and %d more\n", len(rows)-maxRows)
+			break
+		}
+
+		fmt.Fprintf(b, "  %s\n", format(row))
+	}
+}
+
+// sortResults sorts assessment results by their resource and metric.
+func sortResults(results []*assessment.AssessmentResult) {
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].ResourceId != results[j].ResourceId {
+			return results[i].ResourceId < results[j].ResourceId
+		}
+
+		return results[i].MetricId < results[j].MetricId
+	})
+}
+
+// statusSymbol returns a symbol for the status of an evaluation result.
+func statusSymbol(s evaluation.EvaluationStatus) string {
+	switch s {
+	case evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT,
+		evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT_MANUALLY:
+		return "✔"
+	case evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT,
+		evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT_MANUALLY:
+		return "✘"
+	case evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_APPLICABLE:
+		return "–"
+	default:
+		return "…"
+	}
+}
+
+// complianceSymbol returns a symbol for the compliance of an assessment result.
+func complianceSymbol(compliant bool) string {
+	if compliant {
+		return "✔"
+	}
+
+	return "✘"
+}
@@ -0,0 +1,145 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package tui
+
+import (
+	"context"
+	"testing"
+
+	"clouditor.io/clouditor/v2/api/assessment"
+	"clouditor.io/clouditor/v2/api/evaluation"
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// mockOrchestratorClient returns a single cloud service with a single non-compliant assessment result.
+type mockOrchestratorClient struct {
+	orchestrator.OrchestratorClient
+}
+
+func (mockOrchestratorClient) ListCloudServices(context.Context, *orchestrator.ListCloudServicesRequest, ...grpc.CallOption) (*orchestrator.ListCloudServicesResponse, error) {
+	return &orchestrator.ListCloudServicesResponse{
+		Services: []*orchestrator.CloudService{
+			{Id: testdata.MockCloudServiceID1, Name: testdata.MockCloudServiceName1},
+		},
+	}, nil
+}
+
+func (mockOrchestratorClient) ListAssessmentResults(_ context.Context, req *orchestrator.ListAssessmentResultsRequest, _ ...grpc.CallOption) (*orchestrator.ListAssessmentResultsResponse, error) {
+	return &orchestrator.ListAssessmentResultsResponse{
+		Results: []*assessment.AssessmentResult{
+			{
+				CloudServiceId: req.GetFilter().GetCloudServiceId(),
+				ResourceId:     testdata.MockResourceID1,
+				MetricId:       testdata.MockMetricID1,
+				Compliant:      false,
+			},
+		},
+	}, nil
+}
+
+// mockEvaluationClient returns the evaluation results of two controls, of which one is compliant.
+type mockEvaluationClient struct {
+	evaluation.EvaluationClient
+}
+
+func (mockEvaluationClient) ListEvaluationResults(_ context.Context, req *evaluation.ListEvaluationResultsRequest, _ ...grpc.CallOption) (*evaluation.ListEvaluationResultsResponse, error) {
+	return &evaluation.ListEvaluationResultsResponse{
+		Results: []*evaluation.EvaluationResult{
+			{
+				CloudServiceId:   req.GetFilter().GetCloudServiceId(),
+				ControlCatalogId: testdata.MockCatalogID,
+				ControlId:        testdata.MockControlID2,
+				Status:           evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT,
+			},
+			{
+				CloudServiceId:   req.GetFilter().GetCloudServiceId(),
+				ControlCatalogId: testdata.MockCatalogID,
+				ControlId:        testdata.MockControlID1,
+				Status:           evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT,
+			},
+		},
+	}, nil
+}
+
+func TestNewTUICommand(t *testing.T) {
+	cmd := NewTUICommand()
+	assert.Equal(t, "tui", cmd.Use)
+}
+
+func Test_model(t *testing.T) {
+	m := newModel(context.Background(), mockOrchestratorClient{}, mockEvaluationClient{})
+
+	// Load the cloud services, which triggers loading the details of the first one
+	_, cmd := m.Update(m.loadCloudServices())
+	assert.NotNil(t, cmd)
+	assert.Equal(t, testdata.MockCloudServiceID1, m.selectedID())
+
+	_, _ = m.Update(cmd())
+	assert.Equal(t, 2, len(m.controls))
+	assert.Equal(t, testdata.MockControlID1, m.controls[0].ControlId)
+	assert.Equal(t, 1, m.compliantControls())
+	assert.Equal(t, 1, len(m.failing))
+
+	view := m.View()
+	assert.Contains(t, view, "> "+testdata.MockCloudServiceName1)
+	assert.Contains(t, view, "Compliance per control (1 of 2 compliant)")
+	assert.Contains(t, view, "✘ "+testdata.MockResourceID1)
+
+	// A new compliant result of the failing resource removes it from the failing resources
+	_, cmd = m.Update(assessmentResultMsg{&assessment.AssessmentResult{
+		CloudServiceId: testdata.MockCloudServiceID1,
+		ResourceId:     testdata.MockResourceID1,
+		MetricId:       testdata.MockMetricID1,
+		Compliant:      true,
+		Timestamp:      timestamppb.Now(),
+	}})
+	assert.NotNil(t, cmd)
+	assert.Equal(t, 0, len(m.failing))
+	assert.Equal(t, 1, len(m.activity))
+	assert.Contains(t, m.View(), "Failing resources (0)")
+
+	// Results of other cloud services only show up in the activity
+	_, _ = m.Update(assessmentResultMsg{&assessment.AssessmentResult{
+		CloudServiceId: testdata.MockCloudServiceID2,
+		ResourceId:     testdata.MockResourceID2,
+		MetricId:       testdata.MockMetricID1,
+		Timestamp:      timestamppb.Now(),
+	}})
+	assert.Equal(t, 0, len(m.failing))
+	assert.Equal(t, 2, len(m.activity))
+	assert.Equal(t, testdata.MockResourceID2, m.activity[0].ResourceId)
+
+	// There is only one cloud service to select
+	_, cmd = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	assert.Nil(t, cmd)
+	assert.Equal(t, 0, m.selected)
+}
@@ -0,0 +1,52 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package cli
+
+import "errors"
+
+// ErrNonCompliant indicates that a command, e.g., a local assessment, found non-compliant results. In this case, the
+// CLI exits with [ExitCodeNonCompliant], so that it can be used as a check in CI pipelines.
+var ErrNonCompliant = errors.New("non-compliant")
+
+const (
+	// ExitCodeNonCompliant is the exit code of the CLI if a command returns [ErrNonCompliant].
+	ExitCodeNonCompliant = 1
+
+	// ExitCodeError is the exit code of the CLI if a command returns any other error.
+	ExitCodeError = 2
+)
+
+// ExitCode returns the exit code of the CLI for the error returned by a command.
+func ExitCode(err error) int {
+	switch {
+	case err == nil:
+		return 0
+	case errors.Is(err, ErrNonCompliant):
+		return ExitCodeNonCompliant
+	default:
+		return ExitCodeError
+	}
+}
@@ -0,0 +1,290 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package cli
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/viper"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// OutputFlag is the flag specifying the output format of responses, see [HandleResponse].
+	OutputFlag = "output"
+
+	// OutputFormatKey is the configuration key of [OutputFlag]. It differs from the flag, because some commands use
+	// their own output flag, e.g., to write a report to a file.
+	OutputFormatKey = "output-format"
+
+	// QueryFlag is the flag specifying a JSONPath-like query, which selects the values of a response to output.
+	QueryFlag = "query"
+)
+
+const (
+	OutputFormatJSON  = "json"
+	OutputFormatYAML  = "yaml"
+	OutputFormatTable = "table"
+	OutputFormatCSV   = "csv"
+
+	DefaultOutputFormat = OutputFormatJSON
+)
+
+// ErrInvalidOutputFormat indicates that an unsupported output format was requested.
+var ErrInvalidOutputFormat = errors.New("invalid output format")
+
+// PrintResponse writes msg to [Output] according to the configured output format and query. It is used by
+// [Session.HandleResponse], but can also be used by commands that do not need a session.
+func PrintResponse(msg proto.Message) (err error) {
+	var (
+		format = viper.GetString(OutputFormatKey)
+		expr   = viper.GetString(QueryFlag)
+		q      query
+		v      any
+		b      []byte
+	)
+
+	if format == "" {
+		format = DefaultOutputFormat
+	}
+
+	opt := protojson.MarshalOptions{
+		Multiline:       true,
+		Indent:          "  ",
+		EmitUnpopulated: true,
+	}
+
+	b, _ = opt.Marshal(msg)
+
+	// Without a query, we keep the JSON of the response as it is, since decoding it loses the order of its fields
+	if format == OutputFormatJSON && expr == "" {
+		_, err = fmt.Fprintf(Output, "%s\n", string(b))
+		return err
+	}
+
+	err = json.Unmarshal(b, &v)
+	if err != nil {
+		return fmt.Errorf("could not decode response: %w", err)
+	}
+
+	if expr != "" {
+		q, err = parseQuery(expr)
+		if err != nil {
+			return err
+		}
+
+		v = q.evaluate(v)
+	} else if format == OutputFormatTable || format == OutputFormatCSV {
+		// The rows of a list response are the listed entities rather than the response itself
+		if name := listField(msg); name != "" {
+			v = v.(map[string]any)[name]
+		}
+	}
+
+	switch format {
+	case OutputFormatJSON:
+		b, err = json.MarshalIndent(v, "", "  ")
+		if err != nil {
+			return err
+		}
+
+		_, err = fmt.Fprintf(Output, "%s\n", string(b))
+	case OutputFormatYAML:
+		b, err = yaml.Marshal(v)
+		if err != nil {
+			return err
+		}
+
+		_, err = Output.Write(b)
+	case OutputFormatTable:
+		err = writeTable(v)
+	case OutputFormatCSV:
+		err = writeCSV(v)
+	default:
+		return fmt.Errorf("%w: %q (expected one of json, yaml, table or csv)", ErrInvalidOutputFormat, format)
+	}
+
+	return err
+}
+
+// writeTable writes the rows of v as a table with aligned columns.
+func writeTable(v any) (err error) {
+	header, rows := tabulate(v)
+
+	w := tabwriter.NewWriter(Output, 0, 0, 2, ' ', 0)
+
+	if header != nil {
+		for i := range header {
+			header[i] = strings.ToUpper(header[i])
+		}
+
+		_, err = fmt.Fprintln(w, strings.Join(header, "\t"))
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, row := range rows {
+		_, err = fmt.Fprintln(w, strings.Join(row, "\t"))
+		if err != nil {
+			return err
+		}
+	}
+
+	return w.Flush()
+}
+
+// writeCSV writes the rows of v as comma-separated values.
+func writeCSV(v any) (err error) {
+	header, rows := tabulate(v)
+
+	w := csv.NewWriter(Output)
+
+	if header != nil {
+		err = w.Write(header)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = w.WriteAll(rows)
+	if err != nil {
+		return err
+	}
+
+	return w.Error()
+}
+
+// listField returns the JSON name of the only repeated field of a response message, e.g., the results of a
+// list call. Otherwise, it returns an empty string.
+func listField(msg proto.Message) (name string) {
+	if msg == nil {
+		return ""
+	}
+
+	md := msg.ProtoReflect().Descriptor()
+	if !strings.HasSuffix(string(md.Name()), "Response") {
+		return ""
+	}
+
+	for i := 0; i < md.Fields().Len(); i++ {
+		fd := md.Fields().Get(i)
+		if !fd.IsList() {
+			continue
+		} else if name != "" {
+			return ""
+		}
+
+		name = fd.JSONName()
+	}
+
+	return name
+}
+
+// tabulate converts v into rows of a table. The rows are the elements of v, if v is a list. Otherwise, v is a single
+// row. If the rows are objects, their keys are the columns and returned as header. Otherwise, the rows consist of a
+// single column without a header, so that, e.g., a list of IDs is written one per line.
+func tabulate(v any) (header []string, rows [][]string) {
+	var (
+		list    []any
+		objects = true
+		keys    = make(map[string]bool)
+	)
+
+	switch v := v.(type) {
+	case []any:
+		list = v
+	case nil:
+		return nil, nil
+	default:
+		list = []any{v}
+	}
+
+	for _, elem := range list {
+		m, ok := elem.(map[string]any)
+		if !ok {
+			objects = false
+			break
+		}
+
+		for k := range m {
+			keys[k] = true
+		}
+	}
+
+	if !objects {
+		for _, elem := range list {
+			rows = append(rows, []string{cell(elem)})
+		}
+
+		return nil, rows
+	}
+
+	for k := range keys {
+		header = append(header, k)
+	}
+	sort.Strings(header)
+
+	for _, elem := range list {
+		m := elem.(map[string]any)
+
+		row := make([]string, 0, len(header))
+		for _, k := range header {
+			row = append(row, cell(m[k]))
+		}
+
+		rows = append(rows, row)
+	}
+
+	return header, rows
+}
+
+// cell formats a single value of a table. Strings are written as they are, all other values as compact JSON.
+func cell(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	default:
+		var buf bytes.Buffer
+
+		enc := json.NewEncoder(&buf)
+		enc.SetEscapeHTML(false)
+		_ = enc.Encode(v)
+
+		return strings.TrimSpace(buf.String())
+	}
+}
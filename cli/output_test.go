@@ -0,0 +1,221 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package cli
+
+import (
+	"bytes"
+	"testing"
+
+	"clouditor.io/clouditor/v2/api/discovery"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+
+	"github.com/spf13/viper"
+	"google.golang.org/protobuf/proto"
+)
+
+var mockListResourcesResponse = &discovery.ListResourcesResponse{
+	Results: []*discovery.Resource{
+		{Id: "vm1", CloudServiceId: "cs1", ResourceType: "VirtualMachine,Compute,Resource"},
+		{Id: "storage1", CloudServiceId: "cs1", ResourceType: "ObjectStorage,Storage,Resource"},
+	},
+}
+
+func TestPrintResponse(t *testing.T) {
+	type args struct {
+		format string
+		query  string
+		msg    proto.Message
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr assert.WantErr
+	}{
+		{
+			name: "json with query",
+			args: args{
+				format: OutputFormatJSON,
+				query:  ".results[*].id",
+				msg:    mockListResourcesResponse,
+			},
+			want:    "[\n  \"vm1\",\n  \"storage1\"\n]\n",
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "yaml",
+			args: args{
+				format: OutputFormatYAML,
+				query:  ".results[0]",
+				msg:    mockListResourcesResponse,
+			},
+			want:    "cloudServiceId: cs1\nid: vm1\nproperties: null\nresourceType: VirtualMachine,Compute,Resource\n",
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "table of list response",
+			args: args{
+				format: OutputFormatTable,
+				msg:    mockListResourcesResponse,
+			},
+			want: "CLOUDSERVICEID  ID        PROPERTIES  RESOURCETYPE\n" +
+				"cs1             vm1                   VirtualMachine,Compute,Resource\n" +
+				"cs1             storage1              ObjectStorage,Storage,Resource\n",
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "csv with filter",
+			args: args{
+				format: OutputFormatCSV,
+				query:  ".results[?(@.resourceType == 'ObjectStorage,Storage,Resource')].id",
+				msg:    mockListResourcesResponse,
+			},
+			want:    "storage1\n",
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "invalid query",
+			args: args{
+				format: OutputFormatJSON,
+				query:  ".results[",
+				msg:    mockListResourcesResponse,
+			},
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, ErrInvalidQuery)
+			},
+		},
+		{
+			name: "invalid format",
+			args: args{
+				format: "xml",
+				msg:    mockListResourcesResponse,
+			},
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, ErrInvalidOutputFormat)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var b bytes.Buffer
+
+			Output = &b
+			viper.Set(OutputFormatKey, tt.args.format)
+			viper.Set(QueryFlag, tt.args.query)
+			defer viper.Set(OutputFormatKey, "")
+			defer viper.Set(QueryFlag, "")
+
+			err := PrintResponse(tt.args.msg)
+			tt.wantErr(t, err)
+			if err == nil {
+				assert.Equal(t, tt.want, b.String())
+			}
+		})
+	}
+}
+
+func Test_query_evaluate(t *testing.T) {
+	var v = map[string]any{
+		"results": []any{
+			map[string]any{"resourceId": "1", "compliant": true, "status": map[string]any{"severity": float64(1)}},
+			map[string]any{"resourceId": "2", "compliant": false, "status": map[string]any{"severity": float64(3)}},
+			map[string]any{"resourceId": "3", "compliant": false},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		query   string
+		want    any
+		wantErr assert.WantErr
+	}{
+		{
+			name:    "field",
+			query:   "$.results[1].resourceId",
+			want:    "2",
+			wantErr: assert.Nil[error],
+		},
+		{
+			name:    "negative index",
+			query:   ".results[-1]['resourceId']",
+			want:    "3",
+			wantErr: assert.Nil[error],
+		},
+		{
+			name:    "missing field",
+			query:   ".results[0].missing",
+			want:    nil,
+			wantErr: assert.Nil[error],
+		},
+		{
+			name:    "filter",
+			query:   ".results[?(@.compliant == false)].resourceId",
+			want:    []any{"2", "3"},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name:    "filter with nested path",
+			query:   ".results[?(@.status.severity >= 2)].resourceId",
+			want:    []any{"2"},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name:    "filter by existence",
+			query:   ".results[?@.status].resourceId",
+			want:    []any{"1", "2"},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name:    "no match",
+			query:   ".results[?(@.resourceId == \"4\")]",
+			want:    []any{},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name:  "invalid filter",
+			query: ".results[?(compliant)]",
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, ErrInvalidQuery)
+			},
+		},
+		{
+			name:  "invalid index",
+			query: ".results[a]",
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, ErrInvalidQuery)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, err := parseQuery(tt.query)
+			tt.wantErr(t, err)
+			if err == nil {
+				assert.Equal(t, tt.want, q.evaluate(v))
+			}
+		})
+	}
+}
@@ -0,0 +1,397 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidQuery indicates that a query expression could not be parsed.
+var ErrInvalidQuery = errors.New("invalid query")
+
+// query is a compiled JSONPath-like expression, which selects values of the JSON representation of a response. It
+// supports fields (`.name` or `['name']`), indices (`[0]`, `[-1]`), wildcards (`[*]` or `.*`) and filters
+// (`[?(@.compliant == false)]`), optionally prefixed with `$`. For example, `.results[?(@.compliant == false)].resourceId`
+// selects the IDs of all non-compliant resources of a list of assessment results.
+type query []segment
+
+// segment is a single step of a query, which maps each value to zero or more values.
+type segment struct {
+	field string
+	index *int
+	all   bool
+	cond  *condition
+}
+
+// condition is the expression of a filter, which compares a path relative to an element (`@`) with a JSON literal. If
+// op is empty, the condition holds if the path exists and is not false or null.
+type condition struct {
+	path  query
+	op    string
+	value any
+}
+
+// comparisonOperators are the operators supported in filters. Longer operators need to come first.
+var comparisonOperators = []string{"==", "!=", "<=", ">=", "<", ">"}
+
+// parseQuery compiles the query expression s.
+func parseQuery(s string) (q query, err error) {
+	s = strings.TrimSpace(s)
+	s = strings.TrimPrefix(s, "$")
+
+	for len(s) > 0 {
+		var seg segment
+
+		switch {
+		case strings.HasPrefix(s, "["):
+			end := closingBracket(s)
+			if end == -1 {
+				return nil, fmt.Errorf("%w: missing ] in %q", ErrInvalidQuery, s)
+			}
+
+			seg, err = parseBracket(strings.TrimSpace(s[1:end]))
+			if err != nil {
+				return nil, err
+			}
+
+			s = s[end+1:]
+		case strings.HasPrefix(s, "."):
+			s = s[1:]
+
+			end := strings.IndexAny(s, ".[")
+			if end == -1 {
+				end = len(s)
+			}
+
+			name := s[:end]
+			if name == "" {
+				// Allow a dot before a bracket, e.g., `.results.[0]`
+				if strings.HasPrefix(s, "[") {
+					continue
+				}
+
+				return nil, fmt.Errorf("%w: missing field name", ErrInvalidQuery)
+			} else if name == "*" {
+				seg.all = true
+			} else {
+				seg.field = name
+			}
+
+			s = s[end:]
+		default:
+			return nil, fmt.Errorf("%w: unexpected %q", ErrInvalidQuery, s)
+		}
+
+		q = append(q, seg)
+	}
+
+	return q, nil
+}
+
+// parseBracket parses the content of a bracket, i.e., a field name, an index, a wildcard or a filter.
+func parseBracket(s string) (seg segment, err error) {
+	switch {
+	case s == "*" || s == "":
+		seg.all = true
+	case isQuoted(s):
+		seg.field = s[1 : len(s)-1]
+	case strings.HasPrefix(s, "?"):
+		seg.cond, err = parseCondition(strings.TrimSpace(s[1:]))
+	default:
+		var i int
+
+		i, err = strconv.Atoi(s)
+		if err != nil {
+			return seg, fmt.Errorf("%w: invalid index %q", ErrInvalidQuery, s)
+		}
+
+		seg.index = &i
+	}
+
+	return
+}
+
+// parseCondition parses the expression of a filter, e.g., `(@.compliant == false)`.
+func parseCondition(s string) (c *condition, err error) {
+	if strings.HasPrefix(s, "(") && strings.HasSuffix(s, ")") {
+		s = strings.TrimSpace(s[1 : len(s)-1])
+	}
+
+	if !strings.HasPrefix(s, "@") {
+		return nil, fmt.Errorf("%w: filter %q needs to start with @", ErrInvalidQuery, s)
+	}
+
+	c = new(condition)
+
+	left, right := s[1:], ""
+	if i, op := findOperator(s); i != -1 {
+		c.op = op
+		left, right = s[1:i], strings.TrimSpace(s[i+len(op):])
+
+		// Also accept single-quoted strings, which are common in JSONPath
+		if strings.HasPrefix(right, "'") && strings.HasSuffix(right, "'") && len(right) > 1 {
+			right = strconv.Quote(right[1 : len(right)-1])
+		}
+
+		err = json.Unmarshal([]byte(right), &c.value)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid value %q", ErrInvalidQuery, right)
+		}
+	}
+
+	c.path, err = parseQuery(strings.TrimSpace(left))
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// evaluate applies the query to v. If the query can select several values, i.e., it contains a wildcard or a filter,
+// the selected values are returned as a list. Otherwise, the selected value is returned or nil, if it does not exist.
+func (q query) evaluate(v any) any {
+	var (
+		values = []any{v}
+		multi  bool
+	)
+
+	for _, seg := range q {
+		var next []any
+
+		multi = multi || seg.all || seg.cond != nil
+
+		for _, v := range values {
+			next = append(next, seg.apply(v)...)
+		}
+
+		values = next
+	}
+
+	if multi {
+		if values == nil {
+			return []any{}
+		}
+
+		return values
+	} else if len(values) == 0 {
+		return nil
+	}
+
+	return values[0]
+}
+
+// apply returns the values selected by the segment from v.
+func (seg *segment) apply(v any) (values []any) {
+	switch {
+	case seg.field != "":
+		if m, ok := v.(map[string]any); ok {
+			if child, ok := m[seg.field]; ok {
+				values = append(values, child)
+			}
+		}
+	case seg.index != nil:
+		if l, ok := v.([]any); ok {
+			i := *seg.index
+			if i < 0 {
+				i += len(l)
+			}
+
+			if i >= 0 && i < len(l) {
+				values = append(values, l[i])
+			}
+		}
+	default:
+		for _, child := range children(v) {
+			if seg.cond == nil || seg.cond.holds(child) {
+				values = append(values, child)
+			}
+		}
+	}
+
+	return
+}
+
+// holds checks, whether the condition holds for the element v.
+func (c *condition) holds(v any) bool {
+	got := c.path.evaluate(v)
+
+	switch c.op {
+	case "":
+		return got != nil && got != false
+	case "==":
+		return reflect.DeepEqual(got, c.value)
+	case "!=":
+		return !reflect.DeepEqual(got, c.value)
+	}
+
+	cmp, ok := compare(got, c.value)
+	if !ok {
+		return false
+	}
+
+	switch c.op {
+	case "<":
+		return cmp < 0
+	case "<=":
+		return cmp <= 0
+	case ">":
+		return cmp > 0
+	default:
+		return cmp >= 0
+	}
+}
+
+// compare compares two numbers or two strings. Numbers that are encoded as strings, e.g., 64-bit integers in
+// protojson, are compared as numbers.
+func compare(a any, b any) (cmp int, ok bool) {
+	fa, okA := number(a)
+	fb, okB := number(b)
+	if okA && okB {
+		return sign(fa - fb), true
+	}
+
+	sa, okA := a.(string)
+	sb, okB := b.(string)
+	if okA && okB {
+		return strings.Compare(sa, sb), true
+	}
+
+	return 0, false
+}
+
+// number returns v as number, if it is a number or a string containing a number.
+func number(v any) (f float64, ok bool) {
+	switch v := v.(type) {
+	case float64:
+		return v, true
+	case string:
+		f, err := strconv.ParseFloat(v, 64)
+		return f, err == nil
+	default:
+		return 0, false
+	}
+}
+
+// sign returns -1, 0 or 1 depending on the sign of f.
+func sign(f float64) int {
+	switch {
+	case f < 0:
+		return -1
+	case f > 0:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// children returns the elements of a list or the values of an object, ordered by their keys.
+func children(v any) []any {
+	switch v := v.(type) {
+	case []any:
+		return v
+	case map[string]any:
+		var (
+			keys   = make([]string, 0, len(v))
+			values = make([]any, 0, len(v))
+		)
+
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			values = append(values, v[k])
+		}
+
+		return values
+	default:
+		return nil
+	}
+}
+
+// closingBracket returns the index of the bracket closing the one at the start of s, ignoring brackets in quotes.
+func closingBracket(s string) int {
+	var (
+		depth int
+		quote rune
+	)
+
+	for i, r := range s {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+		case r == '"' || r == '\'':
+			quote = r
+		case r == '[':
+			depth++
+		case r == ']':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+
+	return -1
+}
+
+// findOperator returns the position of the first comparison operator in s, which is not within quotes.
+func findOperator(s string) (i int, op string) {
+	var quote rune
+
+	for i, r := range s {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+		case r == '"' || r == '\'':
+			quote = r
+		default:
+			for _, op := range comparisonOperators {
+				if strings.HasPrefix(s[i:], op) {
+					return i, op
+				}
+			}
+		}
+	}
+
+	return -1, ""
+}
+
+// isQuoted checks, whether s is enclosed in single or double quotes.
+func isQuoted(s string) bool {
+	return len(s) > 1 && (s[0] == '\'' || s[0] == '"') && s[len(s)-1] == s[0]
+}
@@ -41,7 +41,6 @@ import (
 	"golang.org/x/oauth2"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/status"
-	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -51,6 +50,9 @@ const SessionFolderFlag = "session-directory"
 
 var Output io.Writer = os.Stdout
 
+// Input is the reader from which interactive commands read the answers of the user.
+var Input io.Reader = os.Stdin
+
 type Session struct {
 	*grpc.ClientConn
 	*oauth2.Config
@@ -213,7 +215,8 @@ func (s *Session) UnmarshalJSON(data []byte) (err error) {
 	return
 }
 
-// HandleResponse handles the response and error message of an gRPC call
+// HandleResponse handles the response and error message of an gRPC call. The response is written to [Output] in the
+// format specified by [OutputFlag], after applying the query specified by [QueryFlag].
 func (*Session) HandleResponse(msg proto.Message, err error) error {
 	if err != nil {
 		// check, if it is a gRPC error
@@ -228,17 +231,34 @@ func (*Session) HandleResponse(msg proto.Message, err error) error {
 		return errors.New(s.Message())
 	}
 
-	opt := protojson.MarshalOptions{
-		Multiline:       true,
-		Indent:          "  ",
-		EmitUnpopulated: true,
+	return PrintResponse(msg)
+}
+
+// HandleStream handles the messages of a server-side stream until it ends or is canceled. Each message is created by
+// newMsg and handled by [Session.HandleResponse].
+func (s *Session) HandleStream(stream grpc.ClientStream, err error, newMsg func() proto.Message) error {
+	if err != nil {
+		return s.HandleResponse(nil, err)
 	}
 
-	b, _ := opt.Marshal(msg)
+	for {
+		msg := newMsg()
 
-	_, err = fmt.Fprintf(Output, "%s\n", string(b))
+		err = stream.RecvMsg(msg)
+		if errors.Is(err, io.EOF) {
+			return nil
+		} else if err != nil && stream.Context().Err() != nil {
+			// The stream was canceled by us, e.g., because the user stopped watching
+			return nil
+		} else if err != nil {
+			return s.HandleResponse(nil, err)
+		}
 
-	return err
+		err = s.HandleResponse(msg, nil)
+		if err != nil {
+			return err
+		}
+	}
 }
 
 func DefaultArgsShellComp(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
@@ -0,0 +1,170 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+// Package client provides a client for the gRPC API of Clouditor, which is intended for integrators. It wraps the
+// generated clients of all services with connection management, authentication including the refresh of tokens,
+// retries of failed calls and typed errors. Paginated List calls can be iterated with a [Pager].
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"clouditor.io/clouditor/v2/api"
+	"clouditor.io/clouditor/v2/api/assessment"
+	"clouditor.io/clouditor/v2/api/discovery"
+	"clouditor.io/clouditor/v2/api/evaluation"
+	"clouditor.io/clouditor/v2/api/evidence"
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/service"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+	"google.golang.org/grpc"
+)
+
+// Client is a client for all services of Clouditor that are reachable at the same target. All service clients share a
+// single connection, which is established lazily and re-established automatically after a failure. A Client is safe for
+// concurrent use.
+type Client struct {
+	Orchestrator  orchestrator.OrchestratorClient
+	Assessment    assessment.AssessmentClient
+	EvidenceStore evidence.EvidenceStoreClient
+	Evaluation    evaluation.EvaluationClient
+	Discovery     discovery.DiscoveryClient
+
+	target     string
+	cc         *grpc.ClientConn
+	authorizer api.Authorizer
+	retry      RetryPolicy
+	opts       []grpc.DialOption
+}
+
+// Option is a functional option to configure a [Client].
+type Option func(*Client)
+
+// WithClientCredentials is an option to authenticate using the OAuth 2.0 client credentials flow, e.g., for services
+// and collectors. A new token is requested, once the current one has expired.
+func WithClientCredentials(config *clientcredentials.Config) Option {
+	return func(c *Client) {
+		c.authorizer = api.NewOAuthAuthorizerFromClientCredentials(config)
+	}
+}
+
+// WithToken is an option to authenticate using an OAuth 2.0 token, e.g., one of a user that logged in. The token is
+// refreshed using its refresh token, once it has expired.
+func WithToken(config *oauth2.Config, token *oauth2.Token) Option {
+	return func(c *Client) {
+		c.authorizer = api.NewOAuthAuthorizerFromConfig(config, token)
+	}
+}
+
+// WithAPIKey is an option to authenticate using an API key of a machine collector instead of an OAuth 2.0 token.
+func WithAPIKey(key string) Option {
+	return func(c *Client) {
+		c.opts = append(c.opts, grpc.WithPerRPCCredentials(apiKeyCredentials(key)))
+	}
+}
+
+// WithRetryPolicy is an option to configure the retries of failed calls. The default is [DefaultRetryPolicy].
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(c *Client) {
+		c.retry = policy
+	}
+}
+
+// WithDialOptions is an option to add additional gRPC dial options, e.g., for transport credentials.
+func WithDialOptions(opts ...grpc.DialOption) Option {
+	return func(c *Client) {
+		c.opts = append(c.opts, opts...)
+	}
+}
+
+// New creates a new [Client] for the services reachable at the target, e.g., "localhost:9090". Targets on port 443 use
+// TLS. The connection is only established with the first call.
+func New(target string, opts ...Option) (c *Client, err error) {
+	c = &Client{
+		target: target,
+		retry:  DefaultRetryPolicy,
+	}
+
+	for _, o := range opts {
+		o(c)
+	}
+
+	// The typed errors are the outermost interceptor, so that the retries still see the original errors
+	c.opts = append(c.opts,
+		grpc.WithChainUnaryInterceptor(unaryErrorInterceptor, c.retry.unaryInterceptor),
+		grpc.WithChainStreamInterceptor(streamErrorInterceptor),
+	)
+
+	c.cc, err = grpc.Dial(target, api.DefaultGrpcDialOptions(target, c, c.opts...)...)
+	if err != nil {
+		return nil, fmt.Errorf("could not connect to %s: %w", target, err)
+	}
+
+	c.Orchestrator = orchestrator.NewOrchestratorClient(c.cc)
+	c.Assessment = assessment.NewAssessmentClient(c.cc)
+	c.EvidenceStore = evidence.NewEvidenceStoreClient(c.cc)
+	c.Evaluation = evaluation.NewEvaluationClient(c.cc)
+	c.Discovery = discovery.NewDiscoveryClient(c.cc)
+
+	return c, nil
+}
+
+// Conn returns the connection of the client, e.g., to create clients of other gRPC services reachable at the same
+// target.
+func (c *Client) Conn() grpc.ClientConnInterface {
+	return c.cc
+}
+
+// Close closes the connection of the client.
+func (c *Client) Close() error {
+	return c.cc.Close()
+}
+
+// SetAuthorizer implements [api.UsesAuthorizer].
+func (c *Client) SetAuthorizer(authorizer api.Authorizer) {
+	c.authorizer = authorizer
+}
+
+// Authorizer implements [api.UsesAuthorizer].
+func (c *Client) Authorizer() api.Authorizer {
+	return c.authorizer
+}
+
+// apiKeyCredentials sends an API key with each call.
+type apiKeyCredentials string
+
+// GetRequestMetadata implements [credentials.PerRPCCredentials].
+func (key apiKeyCredentials) GetRequestMetadata(_ context.Context, _ ...string) (map[string]string, error) {
+	return map[string]string{service.APIKeyHeader: string(key)}, nil
+}
+
+// RequireTransportSecurity implements [credentials.PerRPCCredentials].
+func (apiKeyCredentials) RequireTransportSecurity() bool {
+	// Like our OAuth 2.0 authorizer, we do not enforce TLS, e.g., for local installations
+	return false
+}
@@ -0,0 +1,129 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package client
+
+import (
+	"context"
+	"errors"
+	"net"
+	"os"
+	"testing"
+
+	"clouditor.io/clouditor/v2/api"
+	"clouditor.io/clouditor/v2/api/assessment"
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/internal/testutil"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/testutil/clitest"
+	"clouditor.io/clouditor/v2/server"
+	service_orchestrator "clouditor.io/clouditor/v2/service/orchestrator"
+
+	"google.golang.org/grpc"
+)
+
+var (
+	target   string
+	authPort uint16
+)
+
+func TestMain(m *testing.M) {
+	var (
+		err  error
+		srv  *grpc.Server
+		sock net.Listener
+	)
+
+	clitest.AutoChdir()
+
+	_, authPort, err = testutil.StartAuthenticationServer()
+	if err != nil {
+		panic(err)
+	}
+
+	sock, srv, err = server.StartGRPCServer("127.0.0.1:0",
+		server.WithJWKS(testutil.JWKSURL(authPort)),
+		server.WithOrchestrator(service_orchestrator.NewService()),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	target = sock.Addr().String()
+
+	exit := m.Run()
+
+	sock.Close()
+	srv.Stop()
+
+	os.Exit(exit)
+}
+
+func TestClient(t *testing.T) {
+	c, err := New(target, WithClientCredentials(testutil.AuthClientConfig(authPort)))
+	assert.NoError(t, err)
+	defer c.Close()
+
+	// Fetch all metrics with a small page size, so that we need several pages
+	metrics, err := ListAll(context.Background(), &orchestrator.ListMetricsRequest{PageSize: 5},
+		c.Orchestrator.ListMetrics, (*orchestrator.ListMetricsResponse).GetMetrics)
+	assert.NoError(t, err)
+	assert.True(t, len(metrics) > 5)
+
+	res, err := c.Orchestrator.ListMetrics(context.Background(), &orchestrator.ListMetricsRequest{PageSize: 1500})
+	assert.NoError(t, err)
+	assert.Equal(t, res.Metrics, metrics)
+
+	// Errors are typed and carry our error code
+	_, err = c.Orchestrator.GetCloudService(context.Background(), &orchestrator.GetCloudServiceRequest{
+		CloudServiceId: "00000000-0000-0000-0000-000000000001",
+	})
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	var e *Error
+	assert.True(t, errors.As(err, &e))
+	assert.Equal(t, api.ErrorCodeCloudServiceNotFound, e.ErrorCode)
+
+	// The assessment is not part of our server
+	_, err = c.Assessment.AssessEvidence(context.Background(), &assessment.AssessEvidenceRequest{})
+	assert.ErrorContains(t, err, "code = Unimplemented")
+}
+
+func TestClient_unauthenticated(t *testing.T) {
+	c, err := New(target, WithRetryPolicy(NoRetries))
+	assert.NoError(t, err)
+	defer c.Close()
+
+	_, err = c.Orchestrator.ListMetrics(context.Background(), &orchestrator.ListMetricsRequest{})
+	assert.ErrorIs(t, err, ErrUnauthenticated)
+
+	// An unknown API key is rejected as well
+	c, err = New(target, WithAPIKey("unknown"))
+	assert.NoError(t, err)
+	defer c.Close()
+
+	_, err = c.Orchestrator.ListMetrics(context.Background(), &orchestrator.ListMetricsRequest{})
+	assert.ErrorIs(t, err, ErrUnauthenticated)
+}
@@ -0,0 +1,142 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"clouditor.io/clouditor/v2/api"
+	"clouditor.io/clouditor/v2/service"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Typed errors for the common gRPC status codes, which can be checked using [errors.Is], e.g.,
+// errors.Is(err, client.ErrNotFound).
+var (
+	ErrInvalidArgument  = &Error{Code: codes.InvalidArgument}
+	ErrNotFound         = &Error{Code: codes.NotFound}
+	ErrAlreadyExists    = &Error{Code: codes.AlreadyExists}
+	ErrPermissionDenied = &Error{Code: codes.PermissionDenied}
+	ErrUnauthenticated  = &Error{Code: codes.Unauthenticated}
+	ErrUnavailable      = &Error{Code: codes.Unavailable}
+)
+
+// Error is the error of a failed call of a [Client]. Besides the gRPC status code, it contains the machine-readable
+// error code of the server, if any, e.g., to distinguish a missing cloud service from a missing metric. It can still
+// be converted into a gRPC status using [status.FromError].
+type Error struct {
+	// Code is the gRPC status code of the error.
+	Code codes.Code
+
+	// ErrorCode is the error code of the error, e.g., [api.ErrorCodeCloudServiceNotFound]. It is nil, if the server
+	// did not send a known error code.
+	ErrorCode *api.ErrorCode
+
+	// RetryDelay is the delay after which the call can be retried according to the server. It is zero, if the server
+	// did not send one.
+	RetryDelay time.Duration
+
+	status *status.Status
+}
+
+// Error implements error.
+func (e *Error) Error() string {
+	if e.status == nil {
+		return fmt.Sprintf("rpc error: code = %s", e.Code)
+	}
+
+	return e.status.Err().Error()
+}
+
+// Message returns the message of the error sent by the server.
+func (e *Error) Message() string {
+	return e.status.Message()
+}
+
+// Is checks, whether the target is an [Error] with the same gRPC status code, such as [ErrNotFound].
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+
+	return ok && t.Code == e.Code
+}
+
+// GRPCStatus returns the gRPC status of the error.
+func (e *Error) GRPCStatus() *status.Status {
+	return e.status
+}
+
+// fromError converts a gRPC error into an [Error]. Other errors are returned as-is.
+func fromError(err error) error {
+	if err == nil || errors.Is(err, io.EOF) {
+		return err
+	}
+
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+
+	e := &Error{Code: st.Code(), status: st}
+	e.ErrorCode, _ = api.ErrorCodeOf(err)
+	e.RetryDelay, _ = service.RetryDelay(err)
+
+	return e
+}
+
+// unaryErrorInterceptor converts the errors of unary calls into an [Error].
+func unaryErrorInterceptor(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	return fromError(invoker(ctx, method, req, reply, cc, opts...))
+}
+
+// streamErrorInterceptor converts the errors of streams into an [Error].
+func streamErrorInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	stream, err := streamer(ctx, desc, cc, method, opts...)
+	if err != nil {
+		return nil, fromError(err)
+	}
+
+	return &errorStream{ClientStream: stream}, nil
+}
+
+// errorStream converts the errors of a stream into an [Error].
+type errorStream struct {
+	grpc.ClientStream
+}
+
+func (s *errorStream) SendMsg(m any) error {
+	return fromError(s.ClientStream.SendMsg(m))
+}
+
+func (s *errorStream) RecvMsg(m any) error {
+	return fromError(s.ClientStream.RecvMsg(m))
+}
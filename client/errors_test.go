@@ -0,0 +1,104 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package client
+
+import (
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"clouditor.io/clouditor/v2/api"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/service"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_fromError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want assert.Want[error]
+	}{
+		{
+			name: "nil",
+			err:  nil,
+			want: func(t *testing.T, got error) bool {
+				return assert.NoError(t, got)
+			},
+		},
+		{
+			name: "EOF",
+			err:  io.EOF,
+			want: func(t *testing.T, got error) bool {
+				return assert.ErrorIs(t, got, io.EOF)
+			},
+		},
+		{
+			name: "no status",
+			err:  errors.New("some error"),
+			want: func(t *testing.T, got error) bool {
+				var e *Error
+				return assert.False(t, errors.As(got, &e))
+			},
+		},
+		{
+			name: "status",
+			err:  status.Error(codes.PermissionDenied, "access denied"),
+			want: func(t *testing.T, got error) bool {
+				var e *Error
+				return assert.ErrorIs(t, got, ErrPermissionDenied) &&
+					assert.True(t, errors.As(got, &e)) &&
+					assert.Equal(t, "access denied", e.Message()) &&
+					assert.Equal(t, "rpc error: code = PermissionDenied desc = access denied", e.Error()) &&
+					assert.Nil(t, e.ErrorCode) &&
+					assert.Equal(t, codes.PermissionDenied, status.Code(got))
+			},
+		},
+		{
+			name: "error code with retry info",
+			err:  service.ErrorCodeWithRetryInfo(api.ErrorCodeCloudServiceNotFound, time.Second, "not yet"),
+			want: func(t *testing.T, got error) bool {
+				var e *Error
+				return assert.ErrorIs(t, got, ErrNotFound) &&
+					assert.True(t, errors.As(got, &e)) &&
+					assert.Equal(t, api.ErrorCodeCloudServiceNotFound, e.ErrorCode) &&
+					assert.Equal(t, time.Second, e.RetryDelay)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.want(t, fromError(tt.err))
+		})
+	}
+}
+
+func TestError_Error(t *testing.T) {
+	assert.Equal(t, "rpc error: code = NotFound", ErrNotFound.Error())
+}
@@ -0,0 +1,124 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package client
+
+import (
+	"context"
+
+	"clouditor.io/clouditor/v2/api"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Pager iterates over the items of all pages of a List call. The next page is only fetched once all items of the
+// current page were consumed. It is used like a [bufio.Scanner]:
+//
+//	pager := client.NewPager(&orchestrator.ListCloudServicesRequest{}, c.Orchestrator.ListCloudServices,
+//		(*orchestrator.ListCloudServicesResponse).GetServices)
+//	for pager.Next(ctx) {
+//		fmt.Println(pager.Item().Name)
+//	}
+//	if err := pager.Err(); err != nil {
+//		...
+//	}
+type Pager[Req api.PaginatedRequest, Res api.PaginatedResponse, T any] struct {
+	req    Req
+	list   func(context.Context, Req, ...grpc.CallOption) (Res, error)
+	getter func(Res) []T
+
+	items []T
+	item  T
+	token string
+	done  bool
+	err   error
+}
+
+// NewPager creates a new [Pager] that calls list with the request, e.g., ListCloudServices, and extracts the items of
+// each page using getter. The request is not modified.
+func NewPager[Req api.PaginatedRequest, Res api.PaginatedResponse, T any](
+	req Req, list func(context.Context, Req, ...grpc.CallOption) (Res, error), getter func(Res) []T) *Pager[Req, Res, T] {
+	return &Pager[Req, Res, T]{
+		req:    proto.Clone(req).(Req),
+		list:   list,
+		getter: getter,
+	}
+}
+
+// Next advances to the next item and fetches the next page, if necessary. It returns false, once there are no items
+// left or an error occurred.
+func (p *Pager[Req, Res, T]) Next(ctx context.Context) bool {
+	for len(p.items) == 0 {
+		if p.done || p.err != nil {
+			return false
+		}
+
+		p.fetch(ctx)
+	}
+
+	p.item, p.items = p.items[0], p.items[1:]
+
+	return true
+}
+
+// Item returns the current item.
+func (p *Pager[Req, Res, T]) Item() T {
+	return p.item
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (p *Pager[Req, Res, T]) Err() error {
+	return p.err
+}
+
+// fetch fetches the next page.
+func (p *Pager[Req, Res, T]) fetch(ctx context.Context) {
+	m := p.req.ProtoReflect()
+	m.Set(m.Descriptor().Fields().ByName(api.PageTokenField), protoreflect.ValueOf(p.token))
+
+	res, err := p.list(ctx, p.req)
+	if err != nil {
+		p.err = err
+		return
+	}
+
+	p.items = p.getter(res)
+	p.token = res.GetNextPageToken()
+	p.done = p.token == ""
+}
+
+// ListAll fetches the items of all pages of a List call, see [NewPager].
+func ListAll[Req api.PaginatedRequest, Res api.PaginatedResponse, T any](ctx context.Context,
+	req Req, list func(context.Context, Req, ...grpc.CallOption) (Res, error), getter func(Res) []T) (items []T, err error) {
+	pager := NewPager(req, list, getter)
+
+	for pager.Next(ctx) {
+		items = append(items, pager.Item())
+	}
+
+	return items, pager.Err()
+}
@@ -0,0 +1,125 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+
+	"google.golang.org/grpc"
+)
+
+// listCatalogs returns n catalogs in pages of two. The page token is the index of the first catalog of the page. If
+// failAt is not -1, the page starting at this index fails.
+func listCatalogs(n, failAt int) func(context.Context, *orchestrator.ListCatalogsRequest, ...grpc.CallOption) (*orchestrator.ListCatalogsResponse, error) {
+	return func(_ context.Context, req *orchestrator.ListCatalogsRequest, _ ...grpc.CallOption) (res *orchestrator.ListCatalogsResponse, err error) {
+		start, _ := strconv.Atoi(req.PageToken)
+		if start == failAt {
+			return nil, errors.New("some error")
+		}
+
+		res = new(orchestrator.ListCatalogsResponse)
+		for i := start; i < min(start+2, n); i++ {
+			res.Catalogs = append(res.Catalogs, &orchestrator.Catalog{Id: fmt.Sprintf("catalog-%d", i)})
+		}
+
+		if start+2 < n {
+			res.NextPageToken = strconv.Itoa(start + 2)
+		}
+
+		return
+	}
+}
+
+func TestListAll(t *testing.T) {
+	tests := []struct {
+		name    string
+		n       int
+		failAt  int
+		want    []string
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name:    "empty",
+			n:       0,
+			failAt:  -1,
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "several pages",
+			n:       5,
+			failAt:  -1,
+			want:    []string{"catalog-0", "catalog-1", "catalog-2", "catalog-3", "catalog-4"},
+			wantErr: assert.NoError,
+		},
+		{
+			name:   "error on second page",
+			n:      5,
+			failAt: 2,
+			want:   []string{"catalog-0", "catalog-1"},
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorContains(t, err, "some error")
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := &orchestrator.ListCatalogsRequest{PageSize: 2}
+
+			got, err := ListAll(context.Background(), req, listCatalogs(tt.n, tt.failAt), (*orchestrator.ListCatalogsResponse).GetCatalogs)
+			tt.wantErr(t, err)
+
+			var ids []string
+			for _, c := range got {
+				ids = append(ids, c.Id)
+			}
+
+			assert.Equal(t, tt.want, ids)
+
+			// The request of the caller must not be modified
+			assert.Equal(t, &orchestrator.ListCatalogsRequest{PageSize: 2}, req)
+		})
+	}
+}
+
+func TestPager_Next(t *testing.T) {
+	pager := NewPager(&orchestrator.ListCatalogsRequest{}, listCatalogs(3, -1), (*orchestrator.ListCatalogsResponse).GetCatalogs)
+
+	assert.True(t, pager.Next(context.Background()))
+	assert.Equal(t, "catalog-0", pager.Item().Id)
+	assert.True(t, pager.Next(context.Background()))
+	assert.True(t, pager.Next(context.Background()))
+	assert.Equal(t, "catalog-2", pager.Item().Id)
+	assert.False(t, pager.Next(context.Background()))
+	assert.False(t, pager.Next(context.Background()))
+	assert.NoError(t, pager.Err())
+}
@@ -0,0 +1,113 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package client
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"clouditor.io/clouditor/v2/service"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// RetryPolicy configures the retries of failed unary calls. A call is retried with an exponential backoff, if it
+// failed with one of the retryable codes. If the server tells the client when to retry, e.g., because the assessment
+// cannot reach the orchestrator, the delay of the server is used instead, if it is longer.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts of a call including the first one. A value of 1 or less disables
+	// retries.
+	MaxAttempts int
+
+	// InitialBackoff is the delay before the first retry. It is doubled for each further retry.
+	InitialBackoff time.Duration
+
+	// MaxBackoff is the maximum delay between two attempts.
+	MaxBackoff time.Duration
+
+	// Codes contains the gRPC status codes of errors that are retried. They should only contain codes, which indicate
+	// that the call had no effect.
+	Codes []codes.Code
+}
+
+// DefaultRetryPolicy retries calls, if a service is temporarily unavailable or a rate limit is exceeded.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:    4,
+	InitialBackoff: 200 * time.Millisecond,
+	MaxBackoff:     5 * time.Second,
+	Codes:          []codes.Code{codes.Unavailable, codes.ResourceExhausted},
+}
+
+// NoRetries disables retries.
+var NoRetries = RetryPolicy{MaxAttempts: 1}
+
+// backoff returns the delay before the given retry, starting at 1.
+func (p RetryPolicy) backoff(retry int) time.Duration {
+	delay := p.InitialBackoff
+	for i := 1; i < retry && delay < p.MaxBackoff; i++ {
+		delay *= 2
+	}
+
+	return min(delay, p.MaxBackoff)
+}
+
+// unaryInterceptor retries failed calls according to the policy. Calls that submit an evidence are sent with an
+// idempotency key (see [service.IdempotencyKeyHeader]), unless they already have one, so that the evidence is only
+// submitted once, even if the first attempt actually reached the server.
+func (p RetryPolicy) unaryInterceptor(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) (err error) {
+	if p.MaxAttempts <= 1 {
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+
+	if slices.Contains(service.IdempotentMethods, method) {
+		if md, _ := metadata.FromOutgoingContext(ctx); len(md.Get(service.IdempotencyKeyHeader)) == 0 {
+			ctx = metadata.AppendToOutgoingContext(ctx, service.IdempotencyKeyHeader, uuid.NewString())
+		}
+	}
+
+	for retry := 1; ; retry++ {
+		err = invoker(ctx, method, req, reply, cc, opts...)
+		if err == nil || retry >= p.MaxAttempts || !slices.Contains(p.Codes, status.Code(err)) {
+			return err
+		}
+
+		delay := p.backoff(retry)
+		if d, ok := service.RetryDelay(err); ok && d > delay {
+			delay = d
+		}
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(delay):
+		}
+	}
+}
@@ -0,0 +1,164 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/service"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestRetryPolicy_unaryInterceptor(t *testing.T) {
+	policy := RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     time.Millisecond,
+		Codes:          []codes.Code{codes.Unavailable},
+	}
+
+	tests := []struct {
+		name     string
+		policy   RetryPolicy
+		method   string
+		errs     []error
+		wantErr  assert.ErrorAssertionFunc
+		wantKeys int
+		wantCall int
+	}{
+		{
+			name:     "success after retry",
+			policy:   policy,
+			errs:     []error{status.Error(codes.Unavailable, "down"), nil},
+			wantErr:  assert.NoError,
+			wantCall: 2,
+		},
+		{
+			name:   "too many attempts",
+			policy: policy,
+			errs: []error{
+				status.Error(codes.Unavailable, "down"),
+				status.Error(codes.Unavailable, "down"),
+				status.Error(codes.Unavailable, "still down"),
+			},
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorContains(t, err, "still down")
+			},
+			wantCall: 3,
+		},
+		{
+			name:   "not retryable",
+			policy: policy,
+			errs:   []error{status.Error(codes.NotFound, "not found")},
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.Equal(t, codes.NotFound, status.Code(err))
+			},
+			wantCall: 1,
+		},
+		{
+			name:   "retries disabled",
+			policy: NoRetries,
+			method: service.IdempotentMethods[0],
+			errs:   []error{status.Error(codes.Unavailable, "down")},
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.Equal(t, codes.Unavailable, status.Code(err))
+			},
+			wantCall: 1,
+		},
+		{
+			name:     "same idempotency key",
+			policy:   policy,
+			method:   service.IdempotentMethods[0],
+			errs:     []error{status.Error(codes.Unavailable, "down"), nil},
+			wantErr:  assert.NoError,
+			wantKeys: 1,
+			wantCall: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				calls int
+				keys  = map[string]bool{}
+			)
+
+			invoker := func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+				md, _ := metadata.FromOutgoingContext(ctx)
+				for _, key := range md.Get(service.IdempotencyKeyHeader) {
+					keys[key] = true
+				}
+
+				calls++
+				return tt.errs[calls-1]
+			}
+
+			err := tt.policy.unaryInterceptor(context.Background(), tt.method, nil, nil, nil, invoker)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.wantCall, calls)
+			assert.Equal(t, tt.wantKeys, len(keys))
+		})
+	}
+}
+
+func TestRetryPolicy_unaryInterceptor_retryDelay(t *testing.T) {
+	var (
+		calls int
+		start = time.Now()
+	)
+
+	invoker := func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		calls++
+		if calls == 1 {
+			return service.ErrorWithRetryInfo(codes.Unavailable, 50*time.Millisecond, "try again later")
+		}
+
+		return nil
+	}
+
+	policy := RetryPolicy{MaxAttempts: 2, InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond, Codes: []codes.Code{codes.Unavailable}}
+
+	err := policy.unaryInterceptor(context.Background(), "", nil, nil, nil, invoker)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, calls)
+	assert.True(t, time.Since(start) >= 50*time.Millisecond)
+}
+
+func TestRetryPolicy_backoff(t *testing.T) {
+	p := RetryPolicy{InitialBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+
+	assert.Equal(t, 100*time.Millisecond, p.backoff(1))
+	assert.Equal(t, 200*time.Millisecond, p.backoff(2))
+	assert.Equal(t, 800*time.Millisecond, p.backoff(4))
+	assert.Equal(t, time.Second, p.backoff(5))
+	assert.Equal(t, time.Second, p.backoff(100))
+}
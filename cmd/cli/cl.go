@@ -26,9 +26,11 @@
 package main
 
 import (
-	"strings"
+	"os"
 
+	"clouditor.io/clouditor/v2/cli"
 	"clouditor.io/clouditor/v2/cli/commands"
+	"clouditor.io/clouditor/v2/internal/config"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -39,14 +41,7 @@ func init() {
 }
 
 func initConfig() {
-	viper.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
-	viper.SetConfigName("config")
-	viper.SetConfigType("yaml")
-	viper.AddConfigPath("/etc/clouditor/")
-	viper.AddConfigPath("$HOME/.clouditor")
-	viper.AddConfigPath(".")
-
-	_ = viper.ReadInConfig()
+	config.Init("config", "CLOUDITOR", "/etc/clouditor/", "$HOME/.clouditor", ".")
 }
 
 func newRootCommand() *cobra.Command {
@@ -54,6 +49,11 @@ func newRootCommand() *cobra.Command {
 		Use:   "cl",
 		Short: "The Clouditor CLI",
 		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			// Read the configuration file, now that we know whether a specific file or profile was selected
+			if err := config.Read(); err != nil {
+				return err
+			}
+
 			// check, if server was specified either using config file or
 			// flags, otherwise we cannot continue
 			/*if viper.GetString(URLFlag) == "" {
@@ -66,12 +66,18 @@ func newRootCommand() *cobra.Command {
 
 	commands.AddCommands(cmd)
 
+	cmd.PersistentFlags().String(config.FileFlag, "", "the configuration file (YAML or TOML). If empty, config.yaml or config.toml is searched in /etc/clouditor, ~/.clouditor and the current directory")
+	cmd.PersistentFlags().String(config.ProfileFlag, "", "the profile of the configuration file, e.g., dev or prod")
+	_ = viper.BindPFlag(config.FileFlag, cmd.PersistentFlags().Lookup(config.FileFlag))
+	_ = viper.BindPFlag(config.ProfileFlag, cmd.PersistentFlags().Lookup(config.ProfileFlag))
+
 	return cmd
 }
 
 func main() {
 	var cmd = newRootCommand()
 
-	// ignore the error returned from execute, since it will already be printed out
-	_ = cmd.Execute()
+	// The error returned from execute is already printed out, so we only need to reflect it in the exit code
+	err := cmd.Execute()
+	os.Exit(cli.ExitCode(err))
 }
@@ -28,18 +28,27 @@ package main
 import (
 	"context"
 	"fmt"
+	"net"
 	"net/http"
 	"os"
-	"strings"
+	"slices"
+	"strconv"
+	"time"
 
+	"clouditor.io/clouditor/v2/api"
 	"clouditor.io/clouditor/v2/api/discovery"
-	"clouditor.io/clouditor/v2/api/evaluation"
-	"clouditor.io/clouditor/v2/api/evidence"
 	commands_login "clouditor.io/clouditor/v2/cli/commands/login"
 	"clouditor.io/clouditor/v2/internal/auth"
+	"clouditor.io/clouditor/v2/internal/certification"
+	"clouditor.io/clouditor/v2/internal/config"
+	"clouditor.io/clouditor/v2/internal/ingest"
+	"clouditor.io/clouditor/v2/internal/logging"
+	"clouditor.io/clouditor/v2/internal/pseudonym"
+	"clouditor.io/clouditor/v2/internal/telemetry"
 	"clouditor.io/clouditor/v2/internal/util"
 	"clouditor.io/clouditor/v2/logging/formatter"
 	"clouditor.io/clouditor/v2/persistence"
+	"clouditor.io/clouditor/v2/persistence/encryption"
 	"clouditor.io/clouditor/v2/persistence/gorm"
 	"clouditor.io/clouditor/v2/persistence/inmemory"
 	"clouditor.io/clouditor/v2/server"
@@ -47,12 +56,19 @@ import (
 	"clouditor.io/clouditor/v2/service"
 	service_assessment "clouditor.io/clouditor/v2/service/assessment"
 	service_discovery "clouditor.io/clouditor/v2/service/discovery"
+	"clouditor.io/clouditor/v2/service/discovery/credentials"
+	"clouditor.io/clouditor/v2/service/edge"
 	service_evaluation "clouditor.io/clouditor/v2/service/evaluation"
+	"clouditor.io/clouditor/v2/service/eventbus"
 	service_evidenceStore "clouditor.io/clouditor/v2/service/evidence"
 	service_orchestrator "clouditor.io/clouditor/v2/service/orchestrator"
+	"clouditor.io/clouditor/v2/service/siem"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	aws_config "github.com/aws/aws-sdk-go-v2/config"
 	oauth2 "github.com/oxisto/oauth2go"
 	"github.com/oxisto/oauth2go/login"
+	"github.com/redis/go-redis/v9"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -67,14 +83,33 @@ const (
 	APIKeySaveOnCreateFlag           = "api-key-save-on-create"
 	APIgRPCPortFlag                  = "api-grpc-port"
 	APIHTTPPortFlag                  = "api-http-port"
+	APIAdminPortFlag                 = "api-admin-port"
+	APIAdminHostFlag                 = "api-admin-host"
 	APICORSAllowedOriginsFlags       = "api-cors-allowed-origins"
 	APICORSAllowedHeadersFlags       = "api-cors-allowed-headers"
 	APICORSAllowedMethodsFlags       = "api-cors-allowed-methods"
 	APIJWKSURLFlag                   = "api-jwks-url"
+	APIOIDCIssuersFlag               = "api-oidc-issuers"
 	APIStartEmbeddedOAuth2ServerFlag = "api-start-embedded-oauth-server"
+	APIMetricsFlag                   = "api-metrics"
+	APIGraphQLFlag                   = "api-graphql"
+	APIGRPCWebFlag                   = "api-grpc-web"
+	APIOpenAPIFlag                   = "api-openapi"
+	APIIngestFlag                    = "api-ingest"
+	APIIngestTemplatesDirFlag        = "api-ingest-templates-dir"
+	APIRBACFlag                      = "api-rbac"
+	APIAuditLogFlag                  = "api-audit-log"
+	APIIdempotencyKeyTTLFlag         = "api-idempotency-key-ttl"
+	APIRBACAdminsFlag                = "api-rbac-admins"
+	APIInterceptorOrderFlag          = "api-interceptor-order"
+	TracingOTLPEndpointFlag          = "tracing-otlp-endpoint"
+	TracingOTLPInsecureFlag          = "tracing-otlp-insecure"
+	TracingServiceNameFlag           = "tracing-service-name"
+	TracingSampleRatioFlag           = "tracing-sample-ratio"
 	ServiceOAuth2EndpointFlag        = "service-oauth2-token-endpoint"
 	ServiceOAuth2ClientIDFlag        = "service-oauth2-client-id"
 	ServiceOAuth2ClientSecretFlag    = "service-oauth2-client-secret"
+	ServiceOAuth2TokenExchangeFlag   = "service-oauth2-token-exchange"
 	DBUserNameFlag                   = "db-user-name"
 	DBPasswordFlag                   = "db-password"
 	DBHostFlag                       = "db-host"
@@ -82,20 +117,101 @@ const (
 	DBPortFlag                       = "db-port"
 	DBSSLModeFlag                    = "db-ssl-mode"
 	DBInMemoryFlag                   = "db-in-memory"
+	DBEmbeddedPathFlag               = "db-embedded-path"
+	DBMaxOpenConnsFlag               = "db-max-open-conns"
+	DBMaxIdleConnsFlag               = "db-max-idle-conns"
+	DBConnMaxLifetimeFlag            = "db-conn-max-lifetime"
+	DBEncryptionFlag                 = "db-encryption"
+	DBEncryptionKeyFlag              = "db-encryption-key"
+	DBCompressionFlag                = "db-compression"
+	EvidenceCompressionFlag          = "evidence-compression"
+	DBPurgeAfterFlag                 = "db-purge-after"
 	CreateDefaultTarget              = "target-default-create"
 	DiscoveryAutoStartFlag           = "discovery-auto-start"
 	DiscoveryProviderFlag            = "discovery-provider"
 	DiscoveryResourceGroupFlag       = "discovery-resource-group"
+	DiscoveryCloudServiceIDFlag      = "discovery-cloud-service-id"
 	DashboardURLFlag                 = "dashboard-url"
+	DashboardPathFlag                = "dashboard-path"
 	LogLevelFlag                     = "log-level"
+	LogFormatFlag                    = "log-format"
+	LogComponentLevelsFlag           = "log-component-levels"
+	NotificationSMTPHostFlag         = "notification-smtp-host"
+	NotificationSMTPPortFlag         = "notification-smtp-port"
+	NotificationSMTPUserFlag         = "notification-smtp-user"
+	NotificationSMTPPasswordFlag     = "notification-smtp-password"
+	NotificationSMTPFromFlag         = "notification-smtp-from"
+	NotificationCertCheckFlag        = "notification-certificate-check-interval"
+	NotificationCertRemindersFlag    = "notification-certificate-reminder-days"
+	EvaluationSnapshotIntervalFlag   = "evaluation-compliance-snapshot-interval"
+	OrchestratorBatchSizeFlag        = "orchestrator-result-batch-size"
+	AssessmentCacheRedisURLFlag      = "assessment-cache-redis-url"
+	AssessmentResourceSnapshotFlag   = "assessment-resource-snapshot"
+	AssessmentBundleURLFlag          = "assessment-metric-bundle-url"
+	AssessmentBundleIntervalFlag     = "assessment-metric-bundle-interval"
+	AssessmentAssuranceLevelFlag     = "assessment-assurance-level"
+	AssessmentEvidenceMergeFlag      = "assessment-evidence-merge"
+	AssessmentPolicyDirFlag          = "assessment-policy-dir"
+	LeaderElectionFlag               = "leader-election"
+	LeaderElectionLeaseFlag          = "leader-election-lease-duration"
+	OrchestratorBatchIntervalFlag    = "orchestrator-result-batch-interval"
+	OrchestratorStaleAfterFlag       = "orchestrator-stale-resources-after"
+	OrchestratorStaleDeleteFlag      = "orchestrator-stale-resources-delete-after"
+	OrchestratorGrantURLFlag         = "orchestrator-auditor-grant-url"
+	OrchestratorGrantMaxDurationFlag = "orchestrator-auditor-grant-max-duration"
+	CertificationWindowFlag          = "certification-observation-window"
+	CertificationSuspendAfterFlag    = "certification-suspend-after"
+	CertificationContinueAfterFlag   = "certification-continue-after"
+	CertificationWithdrawAfterFlag   = "certification-withdraw-after"
+	EventBusTypeFlag                 = "event-bus-type"
+	EventBusURLFlag                  = "event-bus-url"
+	EventBusEncodingFlag             = "event-bus-encoding"
+	EventBusEvidenceTopicFlag        = "event-bus-evidence-topic"
+	EventBusResultTopicFlag          = "event-bus-result-topic"
+	EventBusEvaluationTopicFlag      = "event-bus-evaluation-topic"
+	EventBusDiscoveryTopicFlag       = "event-bus-discovery-topic"
+	EventBusSourceFlag               = "event-bus-source"
+	SIEMFormatFlag                   = "siem-format"
+	SIEMURLFlag                      = "siem-url"
+	SIEMHECTokenFlag                 = "siem-hec-token"
+	SIEMHECIndexFlag                 = "siem-hec-index"
+	SIEMRulesFlag                    = "siem-rules"
+	DiscoveryMQTTBrokerFlag          = "discovery-mqtt-broker"
+	DiscoveryMQTTTopicFlag           = "discovery-mqtt-topic"
+	DiscoveryCredentialsStoreFlag    = "discovery-credentials-store"
+	DiscoveryCredentialsURLFlag      = "discovery-credentials-url"
+	DiscoveryCredentialsTokenFlag    = "discovery-credentials-vault-token"
+	DiscoveryCredentialsAzureFlag    = "discovery-credentials-azure-secret"
+	DiscoveryCredentialsAWSFlag      = "discovery-credentials-aws-secret"
+	DiscoveryCredentialsK8SFlag      = "discovery-credentials-k8s-secret"
+	EdgeBridgeBrokerFlag             = "edge-bridge-broker"
+	EdgeBridgeTopicFlag              = "edge-bridge-topic"
+	ExportPseudonymSaltFlag          = "export-pseudonymization-salt"
+	ExportPseudonymFieldsFlag        = "export-pseudonymization-fields"
+	ConfigFileFlag                   = config.FileFlag
+	ConfigProfileFlag                = config.ProfileFlag
 
 	DefaultAPIDefaultUser                      = "clouditor"
 	DefaultAPIDefaultPassword                  = "clouditor"
 	DefaultAPIgRPCPort                  uint16 = 9090
+	DefaultAPIAdminPort                 uint16 = 0
+	DefaultAPIAdminHost                        = "127.0.0.1"
 	DefaultAPIStartEmbeddedOAuth2Server        = true
+	DefaultAPIMetrics                          = true
+	DefaultAPIGraphQL                          = false
+	DefaultAPIGRPCWeb                          = false
+	DefaultAPIOpenAPI                          = true
+	DefaultAPIIngest                           = false
+	DefaultAPIIngestTemplatesDir               = ""
+	DefaultAPIRBAC                             = false
+	DefaultAPIAuditLog                         = false
+	DefaultAPIIdempotencyKeyTTL                = service.DefaultIdempotencyKeyTTL
+	DefaultTracingOTLPEndpoint                 = ""
+	DefaultTracingOTLPInsecure                 = false
 	DefaultServiceOAuth2Endpoint               = "http://localhost:8080/v1/auth/token"
 	DefaultServiceOAuth2ClientID               = "clouditor"
 	DefaultServiceOAuth2ClientSecret           = "clouditor"
+	DefaultServiceOAuth2TokenExchange          = false
 	DefaultDBUserName                          = "postgres"
 	DefaultDBPassword                          = "postgres"
 	DefaultDBHost                              = "localhost"
@@ -103,26 +219,62 @@ const (
 	DefaultDBPort                       uint16 = 5432
 	DefaultDBSSLMode                           = "disable"
 	DefaultDBInMemory                          = false
+	DefaultDBEmbeddedPath                      = ""
+	DefaultLeaderElection                      = false
+	DefaultAssessmentResourceSnapshot          = false
+	DefaultDBMaxOpenConns                      = 0
+	DefaultDBMaxIdleConns                      = 0
+	DefaultDBConnMaxLifetime                   = time.Duration(0)
+	DefaultDBEncryption                        = ""
+	DefaultDBCompression                       = false
+	DefaultEvidenceCompression                 = ""
+	DefaultDBPurgeAfter                        = 30 * 24 * time.Hour
+	DefaultOrchestratorStaleAfter              = time.Duration(0)
+	DefaultOrchestratorStaleDelete             = time.Duration(0)
 	DefaultCreateDefaultTarget                 = true
 	DefaultDiscoveryAutoStart                  = false
 	DefaultDiscoveryResourceGroup              = ""
+	DefaultDiscoveryCloudServiceID             = discovery.DefaultCloudServiceID
 	DefaultDashboardURL                        = "http://localhost:8080"
+	DefaultDashboardPath                       = ""
 	DefaultLogLevel                            = "info"
+	DefaultLogFormat                           = string(logging.FormatText)
+	DefaultNotificationSMTPHost                = ""
+	DefaultNotificationSMTPPort         uint16 = 587
+	DefaultNotificationSMTPUser                = ""
+	DefaultNotificationSMTPPassword            = ""
+	DefaultNotificationSMTPFrom                = "clouditor@localhost"
+	DefaultNotificationCertCheck               = 24 * time.Hour
+	DefaultEventBusType                        = ""
+	DefaultEventBusURL                         = ""
+	DefaultSIEMFormat                          = ""
+	DefaultSIEMURL                             = ""
+	DefaultSIEMHECToken                        = ""
+	DefaultSIEMHECIndex                        = ""
+	DefaultDiscoveryMQTTBroker                 = ""
+	DefaultDiscoveryCredentialsStore           = ""
+	DefaultEdgeBridgeBroker                    = ""
 
 	EnvPrefix = "CLOUDITOR"
+
+	// shutdownTimeout is the time pending RPCs have to finish, once the engine is stopped
+	shutdownTimeout = 5 * time.Second
 )
 
 var (
 	srv                  *server.Server
+	adminSrv             *http.Server
 	discoveryService     *service_discovery.Service
 	orchestratorService  *service_orchestrator.Service
 	assessmentService    *service_assessment.Service
-	evidenceStoreService evidence.EvidenceStoreServer
-	evaluationService    evaluation.EvaluationServer
+	evidenceStoreService *service_evidenceStore.Service
+	evaluationService    *service_evaluation.Service
 	db                   persistence.Storage
 	providers            []string
 
 	log *logrus.Entry
+
+	DefaultNotificationCertReminders = []int{30, 7, 1}
 )
 
 var engineCmd = &cobra.Command{
@@ -137,6 +289,8 @@ func init() {
 	log.Logger.Formatter = formatter.CapitalizeFormatter{Formatter: &logrus.TextFormatter{ForceColors: true}}
 	cobra.OnInitialize(initConfig)
 
+	engineCmd.Flags().String(ConfigFileFlag, "", "Specifies the configuration file (YAML or TOML). If empty, clouditor.yaml or clouditor.toml is searched in the current directory and in /etc/clouditor")
+	engineCmd.Flags().String(ConfigProfileFlag, "", "Specifies the profile of the configuration file, e.g., dev or prod, whose settings override the ones at its top level")
 	engineCmd.Flags().String(APIDefaultUserFlag, DefaultAPIDefaultUser, "Specifies the default API username")
 	engineCmd.Flags().String(APIDefaultPasswordFlag, DefaultAPIDefaultPassword, "Specifies the default API password")
 	engineCmd.Flags().String(APIKeyPasswordFlag, auth.DefaultApiKeyPassword, "Specifies the password used to proctect the API private key")
@@ -144,11 +298,30 @@ func init() {
 	engineCmd.Flags().Bool(APIKeySaveOnCreateFlag, auth.DefaultApiKeySaveOnCreate, "Specifies whether the API key should be saved on creation. It will only created if the default location is used.")
 	engineCmd.Flags().Uint16(APIgRPCPortFlag, DefaultAPIgRPCPort, "Specifies the port used for the gRPC API")
 	engineCmd.Flags().Uint16(APIHTTPPortFlag, rest.DefaultAPIHTTPPort, "Specifies the port used for the HTTP API")
+	engineCmd.Flags().Uint16(APIAdminPortFlag, DefaultAPIAdminPort, "Specifies the port of the admin endpoints, which expose profiles, runtime variables and the state of the streams for debugging and require a token of an admin. A value of 0 disables the admin endpoints")
+	engineCmd.Flags().String(APIAdminHostFlag, DefaultAPIAdminHost, "Specifies the address the admin endpoints listen on. By default, they are only reachable from the local host")
 	engineCmd.Flags().String(APIJWKSURLFlag, server.DefaultJWKSURL, "Specifies the JWKS URL used to verify authentication tokens in the gRPC and HTTP API")
+	engineCmd.Flags().StringSlice(APIOIDCIssuersFlag, []string{}, "Specifies additional trusted OpenID Connect issuers in the form <issuer URL>[;<option>=<value>...], e.g., https://keycloak/realms/clouditor;audience=clouditor;roles-claim=realm_access.roles. The options are audience, jwks-url, cloud-services-claim, allow-all-claim, roles-claim, tags-claim and user-claim")
 	engineCmd.Flags().String(ServiceOAuth2EndpointFlag, DefaultServiceOAuth2Endpoint, "Specifies the OAuth 2.0 token endpoint")
 	engineCmd.Flags().String(ServiceOAuth2ClientIDFlag, DefaultServiceOAuth2ClientID, "Specifies the OAuth 2.0 client ID")
 	engineCmd.Flags().String(ServiceOAuth2ClientSecretFlag, DefaultServiceOAuth2ClientSecret, "Specifies the OAuth 2.0 client secret")
+	engineCmd.Flags().Bool(ServiceOAuth2TokenExchangeFlag, DefaultServiceOAuth2TokenExchange, "Specifies whether our services exchange the token of the OAuth 2.0 client for a token that is scoped to the RPCs they need (RFC 8693), which is enforced if role-based access control is enabled. The authorization server needs to support token exchange, which the embedded one does")
 	engineCmd.Flags().Bool(APIStartEmbeddedOAuth2ServerFlag, DefaultAPIStartEmbeddedOAuth2Server, "Specifies whether the embedded OAuth 2.0 authorization server is started as part of the REST gateway. For production workloads, an external authorization server is recommended.")
+	engineCmd.Flags().Bool(APIMetricsFlag, DefaultAPIMetrics, "Specifies whether Prometheus metrics are exposed on the /metrics endpoint of the HTTP API")
+	engineCmd.Flags().Bool(APIGraphQLFlag, DefaultAPIGraphQL, "Specifies whether a GraphQL API for resources, graph edges, evidences and assessment results is exposed on the /v1/graphql endpoint of the HTTP API")
+	engineCmd.Flags().Bool(APIGRPCWebFlag, DefaultAPIGRPCWeb, "Specifies whether gRPC-web requests, e.g., of browser-based UIs, are accepted by the HTTP API")
+	engineCmd.Flags().Bool(APIOpenAPIFlag, DefaultAPIOpenAPI, "Specifies whether the OpenAPI documents of the HTTP API and a Swagger UI are served on the /v1/openapi endpoint")
+	engineCmd.Flags().Bool(APIIngestFlag, DefaultAPIIngest, "Specifies whether webhooks of third-party scanners, e.g., Trivy, Falco or AWS EventBridge, are accepted on the /v1/evidence_store/ingest/{format} endpoint of the HTTP API and assessed as evidences")
+	engineCmd.Flags().String(APIIngestTemplatesDirFlag, DefaultAPIIngestTemplatesDir, "Specifies a directory with additional mapping templates (<format>.json.tmpl) of the webhook endpoint, which replace the built-in ones of the same format")
+	engineCmd.Flags().Bool(APIAuditLogFlag, DefaultAPIAuditLog, "Specifies whether each call of an RPC is recorded in the audit log of the orchestrator")
+	engineCmd.Flags().Duration(APIIdempotencyKeyTTLFlag, DefaultAPIIdempotencyKeyTTL, "Specifies how long the idempotency keys of submitted evidences are tracked, so that retries with the same key do not submit an evidence twice. A value of 0 disables idempotency keys")
+	engineCmd.Flags().Bool(APIRBACFlag, DefaultAPIRBAC, "Specifies whether role-based access control is enforced on all RPCs, using the roles of the token and the role assignments of the orchestrator")
+	engineCmd.Flags().StringSlice(APIRBACAdminsFlag, []string{}, "Specifies the users (subjects) that always have the admin role, if role-based access control is enforced. If empty, the default user and the service OAuth 2.0 client are admins")
+	engineCmd.Flags().StringSlice(APIInterceptorOrderFlag, []string{}, "Specifies the order of the gRPC interceptors, e.g., logging,metrics. The listed interceptors are executed first, all others follow in their default order. Available are metrics, tags, correlation, error-codes, logging, audit-log, auth, api-key-scope, auditor-grant-scope, rbac, validation and idempotency")
+	engineCmd.Flags().String(TracingOTLPEndpointFlag, DefaultTracingOTLPEndpoint, "Specifies the host and port of the OTLP gRPC collector to which traces are exported. If empty, the standard OTEL_EXPORTER_OTLP_ENDPOINT environment variable is used. If neither is set, no traces are exported")
+	engineCmd.Flags().Bool(TracingOTLPInsecureFlag, DefaultTracingOTLPInsecure, "Specifies whether TLS is disabled for the connection to the OTLP collector")
+	engineCmd.Flags().String(TracingServiceNameFlag, telemetry.DefaultTracingServiceName, "Specifies the service name under which traces are exported")
+	engineCmd.Flags().Float64(TracingSampleRatioFlag, telemetry.DefaultTracingSampleRatio, "Specifies the ratio (between 0 and 1) of traces that are sampled")
 	engineCmd.Flags().StringArray(APICORSAllowedOriginsFlags, rest.DefaultAllowedOrigins, "Specifies the origins allowed in CORS")
 	engineCmd.Flags().StringArray(APICORSAllowedHeadersFlags, rest.DefaultAllowedHeaders, "Specifies the headers allowed in CORS")
 	engineCmd.Flags().StringArray(APICORSAllowedMethodsFlags, rest.DefaultAllowedMethods, "Specifies the methods allowed in CORS")
@@ -159,12 +332,77 @@ func init() {
 	engineCmd.Flags().Uint16(DBPortFlag, DefaultDBPort, "Provides port for database")
 	engineCmd.Flags().String(DBSSLModeFlag, DefaultDBSSLMode, "The SSL mode for the database")
 	engineCmd.Flags().Bool(DBInMemoryFlag, DefaultDBInMemory, "Uses an in-memory database which is not persisted at all")
+	engineCmd.Flags().String(DBEmbeddedPathFlag, DefaultDBEmbeddedPath, "Uses an embedded database, which is persisted in the file at the given path, instead of PostgreSQL")
+	engineCmd.Flags().Int(DBMaxOpenConnsFlag, DefaultDBMaxOpenConns, "Specifies the maximum number of open connections to the database. A value of 0 means unlimited")
+	engineCmd.Flags().Int(DBMaxIdleConnsFlag, DefaultDBMaxIdleConns, "Specifies the maximum number of idle connections to the database. A value of 0 uses the default of 2")
+	engineCmd.Flags().Duration(DBConnMaxLifetimeFlag, DefaultDBConnMaxLifetime, "Specifies the maximum time a connection to the database is reused. A value of 0 means unlimited")
+	engineCmd.Flags().String(DBEncryptionFlag, DefaultDBEncryption, "Specifies the key provider used to encrypt raw evidences and credentials in the database. Possible values are: local, azure-key-vault, aws-kms. If empty, they are not encrypted")
+	engineCmd.Flags().String(DBEncryptionKeyFlag, "", "Specifies the key encryption key, i.e., a base64-encoded 32 byte key (local), the URL of the key in Azure Key Vault or the ID, ARN or alias of the key in AWS KMS")
+	engineCmd.Flags().Bool(DBCompressionFlag, DefaultDBCompression, "Specifies whether the resources of evidences are compressed in the database")
+	engineCmd.Flags().String(EvidenceCompressionFlag, DefaultEvidenceCompression, "Specifies the compressor used to stream evidences to the assessment and to the evidence store. Possible values are: gzip, zstd. If empty, they are not compressed")
+	engineCmd.Flags().Duration(DBPurgeAfterFlag, DefaultDBPurgeAfter, "Specifies the time after which removed cloud services, catalogs and certificates are permanently deleted. A value of 0 disables the purge")
 	engineCmd.Flags().Bool(CreateDefaultTarget, DefaultCreateDefaultTarget, "Creates a default target cloud service if it does not exist")
 	engineCmd.Flags().Bool(DiscoveryAutoStartFlag, DefaultDiscoveryAutoStart, "Automatically start the discovery when engine starts")
 	engineCmd.Flags().StringSliceP(DiscoveryProviderFlag, "p", []string{}, "Providers to discover, separated by comma")
 	engineCmd.Flags().String(DiscoveryResourceGroupFlag, DefaultDiscoveryResourceGroup, "Limit the scope of the discovery to a resource group (currently only used in the Azure discoverer")
+	engineCmd.Flags().String(DiscoveryCloudServiceIDFlag, DefaultDiscoveryCloudServiceID, "Specifies the cloud service for which resources are discovered")
 	engineCmd.Flags().String(DashboardURLFlag, DefaultDashboardURL, "The URL of the Clouditor Dashboard. If the embedded server is used, a public OAuth 2.0 client based on this URL will be added")
+	engineCmd.Flags().String(DashboardPathFlag, DefaultDashboardPath, "Specifies a directory containing a build of the Clouditor Dashboard, which is then served by the REST gateway. If empty, the dashboard is not served")
 	engineCmd.Flags().String(LogLevelFlag, DefaultLogLevel, "The default log level")
+	engineCmd.Flags().String(LogFormatFlag, DefaultLogFormat, "Specifies the log format, either text or json")
+	engineCmd.Flags().StringSlice(LogComponentLevelsFlag, []string{}, "Specifies the log levels of individual components in the form <component>=<level>, e.g., assessment=debug,storage=warn. All other components use the default log level")
+	engineCmd.Flags().String(NotificationSMTPHostFlag, DefaultNotificationSMTPHost, "Specifies the host of the SMTP server used for email notifications. If empty, no emails are sent")
+	engineCmd.Flags().Uint16(NotificationSMTPPortFlag, DefaultNotificationSMTPPort, "Specifies the port of the SMTP server used for email notifications")
+	engineCmd.Flags().String(NotificationSMTPUserFlag, DefaultNotificationSMTPUser, "Specifies the user name of the SMTP server used for email notifications")
+	engineCmd.Flags().String(NotificationSMTPPasswordFlag, DefaultNotificationSMTPPassword, "Specifies the password of the SMTP server used for email notifications")
+	engineCmd.Flags().String(NotificationSMTPFromFlag, DefaultNotificationSMTPFrom, "Specifies the sender address of email notifications")
+	engineCmd.Flags().Duration(NotificationCertCheckFlag, DefaultNotificationCertCheck, "Specifies the interval in which the expiration of certificates is checked. A value of 0 disables the check")
+	engineCmd.Flags().IntSlice(NotificationCertRemindersFlag, DefaultNotificationCertReminders, "Specifies the number of days before the expiration of a certificate at which reminders are sent, unless the certificate specifies its own reminders")
+	engineCmd.Flags().Duration(EvaluationSnapshotIntervalFlag, service_evaluation.DefaultComplianceSnapshotInterval, "Specifies the interval in which snapshots of the compliance status are taken for the compliance history. A value of 0 disables the snapshots")
+	engineCmd.Flags().String(AssessmentCacheRedisURLFlag, "", "Specifies the URL of a Redis (redis://[user:password@]host:port/db) in which metric configurations are cached, so that the cache is shared by multiple replicas of the assessment. If empty, a local cache is used")
+	engineCmd.Flags().Bool(AssessmentResourceSnapshotFlag, DefaultAssessmentResourceSnapshot, "Keeps a snapshot of all assessed resources, so that metrics can access related resources using clouditor.resource(id)")
+	engineCmd.Flags().String(AssessmentBundleURLFlag, "", "Specifies the URL of the metric bundle of the orchestrator, e.g., http://localhost:8080"+rest.MetricBundlePath+", from which the assessment retrieves metrics, their implementations and configurations. If empty, they are requested individually from the orchestrator")
+	engineCmd.Flags().Duration(AssessmentBundleIntervalFlag, service_assessment.DefaultMetricBundleInterval, "Specifies the interval in which the metric bundle is polled for changes")
+	engineCmd.Flags().String(AssessmentAssuranceLevelFlag, "", "Specifies the assurance level, e.g., basic, substantial or high, up to which metrics are assessed. If empty, all metrics are assessed")
+	engineCmd.Flags().StringSlice(AssessmentEvidenceMergeFlag, []string{}, "Specifies how the evidences of multiple tools for the same resource are merged in the form <resource type>=<strategy>, e.g., Storage=union or VirtualMachine=priority:<tool>><tool>. The strategies are freshest, priority and union. If empty, the evidences are assessed independently")
+	engineCmd.Flags().String(AssessmentPolicyDirFlag, "", "Specifies a local directory, e.g., policies/bundles, from which the metric implementations are loaded and reloaded once they change. If empty, they are retrieved from the orchestrator")
+	engineCmd.Flags().Bool(LeaderElectionFlag, DefaultLeaderElection, "Enables the election of a leader using the database, so that scheduled jobs, such as discoveries and evaluations, only run on one of multiple replicas")
+	engineCmd.Flags().Duration(LeaderElectionLeaseFlag, service.DefaultLeaseDuration, "Specifies the duration after which another replica takes over, if the leader does not renew its lease")
+	engineCmd.Flags().Int(OrchestratorBatchSizeFlag, service_orchestrator.DefaultResultBatchSize, "Specifies the maximum number of streamed assessment results that are stored together in a single transaction. A value of 1 disables the batching")
+	engineCmd.Flags().Duration(OrchestratorBatchIntervalFlag, service_orchestrator.DefaultResultBatchInterval, "Specifies the maximum time streamed assessment results are held back before they are stored")
+	engineCmd.Flags().Duration(OrchestratorStaleAfterFlag, DefaultOrchestratorStaleAfter, "Specifies the time after which resources without new assessment results are marked as decommissioned and excluded from the evaluation. A value of 0 disables the detection of stale resources")
+	engineCmd.Flags().Duration(OrchestratorStaleDeleteFlag, DefaultOrchestratorStaleDelete, "Specifies the time after which the assessment results of decommissioned resources are deleted. A value of 0 keeps them")
+	engineCmd.Flags().String(OrchestratorGrantURLFlag, "", "Specifies the URL of a UI, which is used to build shareable links for auditor grants. If empty, only the token of a grant is returned")
+	engineCmd.Flags().Duration(OrchestratorGrantMaxDurationFlag, service_orchestrator.DefaultMaxAuditorGrantDuration, "Specifies the maximum time an auditor grant can be valid for. A value of 0 removes the limit")
+	engineCmd.Flags().Duration(CertificationWindowFlag, certification.DefaultObservationWindow, "Specifies for how long the observed compliance of a target of evaluation is kept to derive state changes of its certificates")
+	engineCmd.Flags().Duration(CertificationSuspendAfterFlag, certification.DefaultSuspendAfter, "Specifies how long a target of evaluation needs to be continuously not compliant, before its certificates are suspended")
+	engineCmd.Flags().Duration(CertificationContinueAfterFlag, certification.DefaultContinueAfter, "Specifies how long a target of evaluation needs to be continuously compliant, before its automatically suspended certificates are issued again")
+	engineCmd.Flags().Duration(CertificationWithdrawAfterFlag, certification.DefaultWithdrawAfter, "Specifies how long a target of evaluation needs to be continuously not compliant, before its suspended certificates are withdrawn. A value of 0 disables the withdrawal")
+	engineCmd.Flags().String(EventBusTypeFlag, DefaultEventBusType, "Specifies the type of event bus (nats, kafka or http) to which new evidences, assessment results, evaluation results and discovery runs are published. If empty, nothing is published")
+	engineCmd.Flags().String(EventBusURLFlag, DefaultEventBusURL, "Specifies the URL of the event bus, i.e., the NATS server (nats://host:port), the Kafka REST proxy (http://host:port) or the HTTP endpoint receiving CloudEvents")
+	engineCmd.Flags().String(EventBusEncodingFlag, string(eventbus.EncodingProtobuf), "Specifies the serialization (protobuf, json or cloudevents) of the messages published to the event bus. The http event bus requires cloudevents")
+	engineCmd.Flags().String(EventBusEvidenceTopicFlag, eventbus.DefaultEvidenceTopic, "Specifies the topic to which new evidences are published")
+	engineCmd.Flags().String(EventBusResultTopicFlag, eventbus.DefaultResultTopic, "Specifies the topic to which new assessment results are published")
+	engineCmd.Flags().String(EventBusEvaluationTopicFlag, eventbus.DefaultEvaluationTopic, "Specifies the topic to which new evaluation results are published")
+	engineCmd.Flags().String(EventBusDiscoveryTopicFlag, eventbus.DefaultDiscoveryTopic, "Specifies the topic to which the start and end of discovery runs are published")
+	engineCmd.Flags().String(EventBusSourceFlag, eventbus.DefaultSource, "Specifies the source of the published CloudEvents, e.g., the URL of this instance")
+	engineCmd.Flags().String(SIEMFormatFlag, DefaultSIEMFormat, "Specifies the format (cef or splunk-hec) in which non-compliant assessment results are forwarded to a SIEM. If empty, nothing is forwarded")
+	engineCmd.Flags().String(SIEMURLFlag, DefaultSIEMURL, "Specifies the URL of the SIEM, i.e., the syslog server (tcp://host:port or udp://host:port) for CEF or the Splunk HTTP Event Collector (https://host:port)")
+	engineCmd.Flags().String(SIEMHECTokenFlag, DefaultSIEMHECToken, "Specifies the token of the Splunk HTTP Event Collector")
+	engineCmd.Flags().String(SIEMHECIndexFlag, DefaultSIEMHECIndex, "Specifies the Splunk index of the forwarded events. If empty, the default index of the token is used")
+	engineCmd.Flags().StringSlice(SIEMRulesFlag, []string{}, "Specifies which non-compliant assessment results are forwarded to the SIEM in the form <cloud service ID or *>=<minimum severity>, e.g., *=high. If empty, all are forwarded")
+	engineCmd.Flags().String(DiscoveryMQTTBrokerFlag, DefaultDiscoveryMQTTBroker, "Specifies the URL of an MQTT broker (mqtt://host:port or mqtts://host:port) to which discovered evidences are published instead of sending them to the assessment service. This is intended for collectors at edge sites")
+	engineCmd.Flags().String(DiscoveryMQTTTopicFlag, edge.DefaultTopic, "Specifies the MQTT topic to which discovered evidences are published")
+	engineCmd.Flags().String(DiscoveryCredentialsStoreFlag, DefaultDiscoveryCredentialsStore, "Specifies the secret store from which the credentials of the discoverers are retrieved. Possible values are: vault, azure-key-vault, aws-secrets-manager. If empty, the default credentials of the cloud providers are used")
+	engineCmd.Flags().String(DiscoveryCredentialsURLFlag, "", "Specifies the URL of the secret store, i.e., the address of Vault or the URL of the Azure Key Vault")
+	engineCmd.Flags().String(DiscoveryCredentialsTokenFlag, "", "Specifies the token used to authenticate to Vault")
+	engineCmd.Flags().String(DiscoveryCredentialsAzureFlag, "", "Specifies the name of the secret containing the Azure service principal (tenant_id, client_id, client_secret)")
+	engineCmd.Flags().String(DiscoveryCredentialsAWSFlag, "", "Specifies the name of the secret containing the AWS credentials (access_key_id, secret_access_key, session_token)")
+	engineCmd.Flags().String(DiscoveryCredentialsK8SFlag, "", "Specifies the name of the secret containing the kubeconfig")
+	engineCmd.Flags().String(EdgeBridgeBrokerFlag, DefaultEdgeBridgeBroker, "Specifies the URL of an MQTT broker (mqtt://host:port or mqtts://host:port) from which evidences of edge collectors are forwarded to the assessment service. If empty, no bridge is started")
+	engineCmd.Flags().String(EdgeBridgeTopicFlag, edge.DefaultTopic, "Specifies the MQTT topic (including its sub-topics) from which evidences are forwarded")
+	engineCmd.Flags().String(ExportPseudonymSaltFlag, "", "Specifies the salt of this deployment, which is used to pseudonymize reports and exports on request. It should be kept secret and set using the CLOUDITOR_EXPORT_PSEUDONYMIZATION_SALT environment variable. If empty, pseudonymized exports are not available")
+	engineCmd.Flags().StringSlice(ExportPseudonymFieldsFlag, []string{}, "Specifies the fields that are pseudonymized, i.e., resource-id, resource-name, ip and account-id. If empty, all fields are pseudonymized")
 
 	_ = viper.BindPFlag(APIDefaultUserFlag, engineCmd.Flags().Lookup(APIDefaultUserFlag))
 	_ = viper.BindPFlag(APIDefaultPasswordFlag, engineCmd.Flags().Lookup(APIDefaultPasswordFlag))
@@ -173,11 +411,30 @@ func init() {
 	_ = viper.BindPFlag(APIKeySaveOnCreateFlag, engineCmd.Flags().Lookup(APIKeySaveOnCreateFlag))
 	_ = viper.BindPFlag(APIgRPCPortFlag, engineCmd.Flags().Lookup(APIgRPCPortFlag))
 	_ = viper.BindPFlag(APIHTTPPortFlag, engineCmd.Flags().Lookup(APIHTTPPortFlag))
+	_ = viper.BindPFlag(APIAdminPortFlag, engineCmd.Flags().Lookup(APIAdminPortFlag))
+	_ = viper.BindPFlag(APIAdminHostFlag, engineCmd.Flags().Lookup(APIAdminHostFlag))
 	_ = viper.BindPFlag(APIJWKSURLFlag, engineCmd.Flags().Lookup(APIJWKSURLFlag))
+	_ = viper.BindPFlag(APIOIDCIssuersFlag, engineCmd.Flags().Lookup(APIOIDCIssuersFlag))
 	_ = viper.BindPFlag(ServiceOAuth2EndpointFlag, engineCmd.Flags().Lookup(ServiceOAuth2EndpointFlag))
 	_ = viper.BindPFlag(ServiceOAuth2ClientIDFlag, engineCmd.Flags().Lookup(ServiceOAuth2ClientIDFlag))
 	_ = viper.BindPFlag(ServiceOAuth2ClientSecretFlag, engineCmd.Flags().Lookup(ServiceOAuth2ClientSecretFlag))
+	_ = viper.BindPFlag(ServiceOAuth2TokenExchangeFlag, engineCmd.Flags().Lookup(ServiceOAuth2TokenExchangeFlag))
 	_ = viper.BindPFlag(APIStartEmbeddedOAuth2ServerFlag, engineCmd.Flags().Lookup(APIStartEmbeddedOAuth2ServerFlag))
+	_ = viper.BindPFlag(APIMetricsFlag, engineCmd.Flags().Lookup(APIMetricsFlag))
+	_ = viper.BindPFlag(APIGraphQLFlag, engineCmd.Flags().Lookup(APIGraphQLFlag))
+	_ = viper.BindPFlag(APIGRPCWebFlag, engineCmd.Flags().Lookup(APIGRPCWebFlag))
+	_ = viper.BindPFlag(APIOpenAPIFlag, engineCmd.Flags().Lookup(APIOpenAPIFlag))
+	_ = viper.BindPFlag(APIIngestFlag, engineCmd.Flags().Lookup(APIIngestFlag))
+	_ = viper.BindPFlag(APIIngestTemplatesDirFlag, engineCmd.Flags().Lookup(APIIngestTemplatesDirFlag))
+	_ = viper.BindPFlag(APIRBACFlag, engineCmd.Flags().Lookup(APIRBACFlag))
+	_ = viper.BindPFlag(APIAuditLogFlag, engineCmd.Flags().Lookup(APIAuditLogFlag))
+	_ = viper.BindPFlag(APIIdempotencyKeyTTLFlag, engineCmd.Flags().Lookup(APIIdempotencyKeyTTLFlag))
+	_ = viper.BindPFlag(APIRBACAdminsFlag, engineCmd.Flags().Lookup(APIRBACAdminsFlag))
+	_ = viper.BindPFlag(APIInterceptorOrderFlag, engineCmd.Flags().Lookup(APIInterceptorOrderFlag))
+	_ = viper.BindPFlag(TracingOTLPEndpointFlag, engineCmd.Flags().Lookup(TracingOTLPEndpointFlag))
+	_ = viper.BindPFlag(TracingOTLPInsecureFlag, engineCmd.Flags().Lookup(TracingOTLPInsecureFlag))
+	_ = viper.BindPFlag(TracingServiceNameFlag, engineCmd.Flags().Lookup(TracingServiceNameFlag))
+	_ = viper.BindPFlag(TracingSampleRatioFlag, engineCmd.Flags().Lookup(TracingSampleRatioFlag))
 	_ = viper.BindPFlag(APICORSAllowedOriginsFlags, engineCmd.Flags().Lookup(APICORSAllowedOriginsFlags))
 	_ = viper.BindPFlag(APICORSAllowedHeadersFlags, engineCmd.Flags().Lookup(APICORSAllowedHeadersFlags))
 	_ = viper.BindPFlag(APICORSAllowedMethodsFlags, engineCmd.Flags().Lookup(APICORSAllowedMethodsFlags))
@@ -188,27 +445,92 @@ func init() {
 	_ = viper.BindPFlag(DBPortFlag, engineCmd.Flags().Lookup(DBPortFlag))
 	_ = viper.BindPFlag(DBSSLModeFlag, engineCmd.Flags().Lookup(DBSSLModeFlag))
 	_ = viper.BindPFlag(DBInMemoryFlag, engineCmd.Flags().Lookup(DBInMemoryFlag))
+	_ = viper.BindPFlag(DBEmbeddedPathFlag, engineCmd.Flags().Lookup(DBEmbeddedPathFlag))
+	_ = viper.BindPFlag(DBMaxOpenConnsFlag, engineCmd.Flags().Lookup(DBMaxOpenConnsFlag))
+	_ = viper.BindPFlag(DBMaxIdleConnsFlag, engineCmd.Flags().Lookup(DBMaxIdleConnsFlag))
+	_ = viper.BindPFlag(DBConnMaxLifetimeFlag, engineCmd.Flags().Lookup(DBConnMaxLifetimeFlag))
+	_ = viper.BindPFlag(DBEncryptionFlag, engineCmd.Flags().Lookup(DBEncryptionFlag))
+	_ = viper.BindPFlag(DBEncryptionKeyFlag, engineCmd.Flags().Lookup(DBEncryptionKeyFlag))
+	_ = viper.BindPFlag(DBCompressionFlag, engineCmd.Flags().Lookup(DBCompressionFlag))
+	_ = viper.BindPFlag(EvidenceCompressionFlag, engineCmd.Flags().Lookup(EvidenceCompressionFlag))
+	_ = viper.BindPFlag(DBPurgeAfterFlag, engineCmd.Flags().Lookup(DBPurgeAfterFlag))
 	_ = viper.BindPFlag(CreateDefaultTarget, engineCmd.Flags().Lookup(CreateDefaultTarget))
 	_ = viper.BindPFlag(DiscoveryAutoStartFlag, engineCmd.Flags().Lookup(DiscoveryAutoStartFlag))
 	_ = viper.BindPFlag(DiscoveryProviderFlag, engineCmd.Flags().Lookup(DiscoveryProviderFlag))
 	_ = viper.BindPFlag(DiscoveryResourceGroupFlag, engineCmd.Flags().Lookup(DiscoveryResourceGroupFlag))
+	_ = viper.BindPFlag(DiscoveryCloudServiceIDFlag, engineCmd.Flags().Lookup(DiscoveryCloudServiceIDFlag))
 	_ = viper.BindPFlag(DashboardURLFlag, engineCmd.Flags().Lookup(DashboardURLFlag))
+	_ = viper.BindPFlag(DashboardPathFlag, engineCmd.Flags().Lookup(DashboardPathFlag))
 	_ = viper.BindPFlag(LogLevelFlag, engineCmd.Flags().Lookup(LogLevelFlag))
+	_ = viper.BindPFlag(LogFormatFlag, engineCmd.Flags().Lookup(LogFormatFlag))
+	_ = viper.BindPFlag(LogComponentLevelsFlag, engineCmd.Flags().Lookup(LogComponentLevelsFlag))
+	_ = viper.BindPFlag(NotificationSMTPHostFlag, engineCmd.Flags().Lookup(NotificationSMTPHostFlag))
+	_ = viper.BindPFlag(NotificationSMTPPortFlag, engineCmd.Flags().Lookup(NotificationSMTPPortFlag))
+	_ = viper.BindPFlag(NotificationSMTPUserFlag, engineCmd.Flags().Lookup(NotificationSMTPUserFlag))
+	_ = viper.BindPFlag(NotificationSMTPPasswordFlag, engineCmd.Flags().Lookup(NotificationSMTPPasswordFlag))
+	_ = viper.BindPFlag(NotificationSMTPFromFlag, engineCmd.Flags().Lookup(NotificationSMTPFromFlag))
+	_ = viper.BindPFlag(NotificationCertCheckFlag, engineCmd.Flags().Lookup(NotificationCertCheckFlag))
+	_ = viper.BindPFlag(NotificationCertRemindersFlag, engineCmd.Flags().Lookup(NotificationCertRemindersFlag))
+	_ = viper.BindPFlag(EvaluationSnapshotIntervalFlag, engineCmd.Flags().Lookup(EvaluationSnapshotIntervalFlag))
+	_ = viper.BindPFlag(AssessmentCacheRedisURLFlag, engineCmd.Flags().Lookup(AssessmentCacheRedisURLFlag))
+	_ = viper.BindPFlag(AssessmentResourceSnapshotFlag, engineCmd.Flags().Lookup(AssessmentResourceSnapshotFlag))
+	_ = viper.BindPFlag(AssessmentBundleURLFlag, engineCmd.Flags().Lookup(AssessmentBundleURLFlag))
+	_ = viper.BindPFlag(AssessmentBundleIntervalFlag, engineCmd.Flags().Lookup(AssessmentBundleIntervalFlag))
+	_ = viper.BindPFlag(AssessmentAssuranceLevelFlag, engineCmd.Flags().Lookup(AssessmentAssuranceLevelFlag))
+	_ = viper.BindPFlag(AssessmentEvidenceMergeFlag, engineCmd.Flags().Lookup(AssessmentEvidenceMergeFlag))
+	_ = viper.BindPFlag(AssessmentPolicyDirFlag, engineCmd.Flags().Lookup(AssessmentPolicyDirFlag))
+	_ = viper.BindPFlag(LeaderElectionFlag, engineCmd.Flags().Lookup(LeaderElectionFlag))
+	_ = viper.BindPFlag(LeaderElectionLeaseFlag, engineCmd.Flags().Lookup(LeaderElectionLeaseFlag))
+	_ = viper.BindPFlag(OrchestratorBatchSizeFlag, engineCmd.Flags().Lookup(OrchestratorBatchSizeFlag))
+	_ = viper.BindPFlag(OrchestratorBatchIntervalFlag, engineCmd.Flags().Lookup(OrchestratorBatchIntervalFlag))
+	_ = viper.BindPFlag(OrchestratorStaleAfterFlag, engineCmd.Flags().Lookup(OrchestratorStaleAfterFlag))
+	_ = viper.BindPFlag(OrchestratorStaleDeleteFlag, engineCmd.Flags().Lookup(OrchestratorStaleDeleteFlag))
+	_ = viper.BindPFlag(OrchestratorGrantURLFlag, engineCmd.Flags().Lookup(OrchestratorGrantURLFlag))
+	_ = viper.BindPFlag(OrchestratorGrantMaxDurationFlag, engineCmd.Flags().Lookup(OrchestratorGrantMaxDurationFlag))
+	_ = viper.BindPFlag(CertificationWindowFlag, engineCmd.Flags().Lookup(CertificationWindowFlag))
+	_ = viper.BindPFlag(CertificationSuspendAfterFlag, engineCmd.Flags().Lookup(CertificationSuspendAfterFlag))
+	_ = viper.BindPFlag(CertificationContinueAfterFlag, engineCmd.Flags().Lookup(CertificationContinueAfterFlag))
+	_ = viper.BindPFlag(CertificationWithdrawAfterFlag, engineCmd.Flags().Lookup(CertificationWithdrawAfterFlag))
+	_ = viper.BindPFlag(EventBusTypeFlag, engineCmd.Flags().Lookup(EventBusTypeFlag))
+	_ = viper.BindPFlag(EventBusURLFlag, engineCmd.Flags().Lookup(EventBusURLFlag))
+	_ = viper.BindPFlag(EventBusEncodingFlag, engineCmd.Flags().Lookup(EventBusEncodingFlag))
+	_ = viper.BindPFlag(EventBusEvidenceTopicFlag, engineCmd.Flags().Lookup(EventBusEvidenceTopicFlag))
+	_ = viper.BindPFlag(EventBusResultTopicFlag, engineCmd.Flags().Lookup(EventBusResultTopicFlag))
+	_ = viper.BindPFlag(EventBusEvaluationTopicFlag, engineCmd.Flags().Lookup(EventBusEvaluationTopicFlag))
+	_ = viper.BindPFlag(EventBusDiscoveryTopicFlag, engineCmd.Flags().Lookup(EventBusDiscoveryTopicFlag))
+	_ = viper.BindPFlag(EventBusSourceFlag, engineCmd.Flags().Lookup(EventBusSourceFlag))
+	_ = viper.BindPFlag(SIEMFormatFlag, engineCmd.Flags().Lookup(SIEMFormatFlag))
+	_ = viper.BindPFlag(SIEMURLFlag, engineCmd.Flags().Lookup(SIEMURLFlag))
+	_ = viper.BindPFlag(SIEMHECTokenFlag, engineCmd.Flags().Lookup(SIEMHECTokenFlag))
+	_ = viper.BindPFlag(SIEMHECIndexFlag, engineCmd.Flags().Lookup(SIEMHECIndexFlag))
+	_ = viper.BindPFlag(SIEMRulesFlag, engineCmd.Flags().Lookup(SIEMRulesFlag))
+	_ = viper.BindPFlag(DiscoveryMQTTBrokerFlag, engineCmd.Flags().Lookup(DiscoveryMQTTBrokerFlag))
+	_ = viper.BindPFlag(DiscoveryMQTTTopicFlag, engineCmd.Flags().Lookup(DiscoveryMQTTTopicFlag))
+	_ = viper.BindPFlag(DiscoveryCredentialsStoreFlag, engineCmd.Flags().Lookup(DiscoveryCredentialsStoreFlag))
+	_ = viper.BindPFlag(DiscoveryCredentialsURLFlag, engineCmd.Flags().Lookup(DiscoveryCredentialsURLFlag))
+	_ = viper.BindPFlag(DiscoveryCredentialsTokenFlag, engineCmd.Flags().Lookup(DiscoveryCredentialsTokenFlag))
+	_ = viper.BindPFlag(DiscoveryCredentialsAzureFlag, engineCmd.Flags().Lookup(DiscoveryCredentialsAzureFlag))
+	_ = viper.BindPFlag(DiscoveryCredentialsAWSFlag, engineCmd.Flags().Lookup(DiscoveryCredentialsAWSFlag))
+	_ = viper.BindPFlag(DiscoveryCredentialsK8SFlag, engineCmd.Flags().Lookup(DiscoveryCredentialsK8SFlag))
+	_ = viper.BindPFlag(EdgeBridgeBrokerFlag, engineCmd.Flags().Lookup(EdgeBridgeBrokerFlag))
+	_ = viper.BindPFlag(EdgeBridgeTopicFlag, engineCmd.Flags().Lookup(EdgeBridgeTopicFlag))
+	_ = viper.BindPFlag(ExportPseudonymSaltFlag, engineCmd.Flags().Lookup(ExportPseudonymSaltFlag))
+	_ = viper.BindPFlag(ExportPseudonymFieldsFlag, engineCmd.Flags().Lookup(ExportPseudonymFieldsFlag))
+	_ = viper.BindPFlag(ConfigFileFlag, engineCmd.Flags().Lookup(ConfigFileFlag))
+	_ = viper.BindPFlag(ConfigProfileFlag, engineCmd.Flags().Lookup(ConfigProfileFlag))
+
+	addStreamFaultFlags(engineCmd)
+
+	engineCmd.AddCommand(newStandaloneCommand())
 }
 
 func initConfig() {
-	viper.SetEnvKeyReplacer(strings.NewReplacer("-", "_"))
-	viper.SetEnvPrefix(EnvPrefix)
-	viper.SetConfigName("clouditor")
-	viper.SetConfigType("yaml")
-	viper.AddConfigPath(".")
-	viper.AutomaticEnv()
+	config.Init("clouditor", EnvPrefix, ".", "/etc/clouditor")
 }
 
 func doCmd(_ *cobra.Command, _ []string) (err error) {
 	var (
 		rt, _ = service.GetRuntimeInfo()
-		level logrus.Level
 	)
 
 	fmt.Printf(`
@@ -225,23 +547,70 @@ func doCmd(_ *cobra.Command, _ []string) (err error) {
   `, rt.VersionString())
 	fmt.Println()
 
-	level, err = logrus.ParseLevel(viper.GetString(LogLevelFlag))
+	if err = config.Read(); err != nil {
+		return err
+	}
+
+	logConfig, err := loggingConfig()
 	if err != nil {
 		return err
 	}
-	logrus.SetLevel(level)
+
+	err = logging.Configure(logrus.StandardLogger(), logConfig)
+	if err != nil {
+		return err
+	}
+
+	// Configure the export of traces, so that evidences can be traced across all services
+	shutdownTracing, err := telemetry.InitTracing(context.Background(), telemetry.TracingConfig{
+		Endpoint:    viper.GetString(TracingOTLPEndpointFlag),
+		Insecure:    viper.GetBool(TracingOTLPInsecureFlag),
+		ServiceName: viper.GetString(TracingServiceNameFlag),
+		SampleRatio: viper.GetFloat64(TracingSampleRatioFlag),
+	})
+	if err != nil {
+		return fmt.Errorf("could not initialize tracing: %w", err)
+	}
+	defer func() {
+		_ = shutdownTracing(context.Background())
+	}()
 
 	if viper.GetBool(DBInMemoryFlag) {
 		db, err = inmemory.NewStorage()
 	} else {
-		db, err = gorm.NewStorage(gorm.WithPostgres(
-			viper.GetString(DBHostFlag),
-			viper.GetUint16(DBPortFlag),
-			viper.GetString(DBUserNameFlag),
-			viper.GetString(DBPasswordFlag),
-			viper.GetString(DBNameFlag),
-			viper.GetString(DBSSLModeFlag),
-		))
+		var dbOpts = []gorm.StorageOption{
+			gorm.WithMaxOpenConns(viper.GetInt(DBMaxOpenConnsFlag)),
+			gorm.WithMaxIdleConns(viper.GetInt(DBMaxIdleConnsFlag)),
+			gorm.WithConnMaxLifetime(viper.GetDuration(DBConnMaxLifetimeFlag)),
+		}
+
+		if path := viper.GetString(DBEmbeddedPathFlag); path != "" {
+			dbOpts = append(dbOpts, gorm.WithEmbedded(path))
+		} else {
+			dbOpts = append(dbOpts, gorm.WithPostgres(
+				viper.GetString(DBHostFlag),
+				viper.GetUint16(DBPortFlag),
+				viper.GetString(DBUserNameFlag),
+				viper.GetString(DBPasswordFlag),
+				viper.GetString(DBNameFlag),
+				viper.GetString(DBSSLModeFlag),
+			))
+		}
+
+		if viper.GetString(DBEncryptionFlag) != "" {
+			keys, err := encryptionKeys()
+			if err != nil {
+				return fmt.Errorf("could not create key provider for database encryption: %w", err)
+			}
+
+			dbOpts = append(dbOpts, gorm.WithEncryption(keys))
+		}
+
+		if viper.GetBool(DBCompressionFlag) {
+			dbOpts = append(dbOpts, gorm.WithCompression())
+		}
+
+		db, err = gorm.NewStorage(dbOpts...)
 	}
 	if err != nil {
 		// We could also just log the error and forward db = nil which will result in inmemory storages for each service
@@ -249,6 +618,17 @@ func doCmd(_ *cobra.Command, _ []string) (err error) {
 		return fmt.Errorf("could not create storage: %w", err)
 	}
 
+	// Only run the scheduled jobs on the leader among multiple replicas, if configured
+	var leader service.LeaderElector
+	if viper.GetBool(LeaderElectionFlag) {
+		elector := service.NewStorageLeaderElector(db, "clouditor-engine", viper.GetDuration(LeaderElectionLeaseFlag))
+		leader = elector
+
+		ctx, cancel := context.WithCancel(context.Background())
+		go elector.Start(ctx)
+		defer cancel()
+	}
+
 	// If no CSPs for discovering is given, take all implemented discoverers
 	if len(viper.GetStringSlice(DiscoveryProviderFlag)) == 0 {
 		providers = []string{service_discovery.ProviderAWS, service_discovery.ProviderAzure, service_discovery.ProviderK8S}
@@ -256,43 +636,145 @@ func doCmd(_ *cobra.Command, _ []string) (err error) {
 		providers = viper.GetStringSlice(DiscoveryProviderFlag)
 	}
 
-	discoveryService = service_discovery.NewService(
+	// Otherwise, every stream of evidences would fail later on
+	if c := viper.GetString(EvidenceCompressionFlag); c != "" && !slices.Contains(api.Compressors, c) {
+		return fmt.Errorf("unknown compressor %q for evidences", c)
+	}
+
+	// Pseudonymize reports and exports on request, if a salt is configured
+	var pseudonymizer *pseudonym.Pseudonymizer
+	if salt := viper.GetString(ExportPseudonymSaltFlag); salt != "" {
+		fields, err := pseudonym.ParseFields(viper.GetStringSlice(ExportPseudonymFieldsFlag))
+		if err != nil {
+			return fmt.Errorf("could not parse pseudonymization fields: %w", err)
+		}
+
+		pseudonymizer, err = pseudonym.New(salt, fields...)
+		if err != nil {
+			return fmt.Errorf("could not configure pseudonymization: %w", err)
+		}
+	}
+
+	discoveryOpts := []service_discovery.ServiceOption{
 		service_discovery.WithProviders(providers),
+		service_discovery.WithCloudServiceID(viper.GetString(DiscoveryCloudServiceIDFlag)),
 		service_discovery.WithStorage(db),
-		service_discovery.WithOAuth2Authorizer(
-			// Configure the OAuth 2.0 client credentials for this service
-			&clientcredentials.Config{
-				ClientID:     viper.GetString(ServiceOAuth2ClientIDFlag),
-				ClientSecret: viper.GetString(ServiceOAuth2ClientSecretFlag),
-				TokenURL:     viper.GetString(ServiceOAuth2EndpointFlag),
-			}),
-	)
+		service_discovery.WithAuthorizer(serviceAuthorizer(service.RoleDiscoveryService)),
+		service_discovery.WithLeaderElector(leader),
+		service_discovery.WithAssessmentCompression(viper.GetString(EvidenceCompressionFlag)),
+		service_discovery.WithPseudonymizer(pseudonymizer),
+	}
 
-	orchestratorService = service_orchestrator.NewService(service_orchestrator.WithStorage(db))
+	// Publish discovered evidences over MQTT instead, if configured
+	if broker := viper.GetString(DiscoveryMQTTBrokerFlag); broker != "" {
+		discoveryOpts = append(discoveryOpts, service_discovery.WithEdgePublisher(
+			edge.NewPublisher(broker, viper.GetString(DiscoveryMQTTTopicFlag)),
+		))
+	}
 
-	assessmentService = service_assessment.NewService(
-		service_assessment.WithOAuth2Authorizer(
-			// Configure the OAuth 2.0 client credentials for this service
-			&clientcredentials.Config{
-				ClientID:     viper.GetString(ServiceOAuth2ClientIDFlag),
-				ClientSecret: viper.GetString(ServiceOAuth2ClientSecretFlag),
-				TokenURL:     viper.GetString(ServiceOAuth2EndpointFlag),
+	// Retrieve the credentials of the discoverers from a secret store, if configured
+	if viper.GetString(DiscoveryCredentialsStoreFlag) != "" {
+		store, err := secretStore()
+		if err != nil {
+			return fmt.Errorf("could not configure secret store: %w", err)
+		}
+
+		discoveryOpts = append(discoveryOpts, service_discovery.WithCredentialsProvider(
+			credentials.NewProvider(store),
+			credentials.SecretNames{
+				Azure:      viper.GetString(DiscoveryCredentialsAzureFlag),
+				AWS:        viper.GetString(DiscoveryCredentialsAWSFlag),
+				Kubernetes: viper.GetString(DiscoveryCredentialsK8SFlag),
 			},
-		),
+		))
+	}
+
+	discoveryService = service_discovery.NewService(discoveryOpts...)
+
+	orchestratorService = service_orchestrator.NewService(
+		service_orchestrator.WithStorage(db),
+		service_orchestrator.WithSMTP(smtpConfig()),
+		service_orchestrator.WithCertificateReminderDays(reminderDays()...),
+		service_orchestrator.WithAssessmentResultBatching(viper.GetInt(OrchestratorBatchSizeFlag), viper.GetDuration(OrchestratorBatchIntervalFlag)),
+		service_orchestrator.WithLeaderElector(leader),
+		service_orchestrator.WithStaleResourcePolicy(service_orchestrator.StaleResourcePolicy{
+			DecommissionAfter:  viper.GetDuration(OrchestratorStaleAfterFlag),
+			DeleteResultsAfter: viper.GetDuration(OrchestratorStaleDeleteFlag),
+		}),
+		service_orchestrator.WithCertificationPolicy(certification.Policy{
+			ObservationWindow: viper.GetDuration(CertificationWindowFlag),
+			SuspendAfter:      viper.GetDuration(CertificationSuspendAfterFlag),
+			ContinueAfter:     viper.GetDuration(CertificationContinueAfterFlag),
+			WithdrawAfter:     viper.GetDuration(CertificationWithdrawAfterFlag),
+		}),
+		service_orchestrator.WithAuditorGrantURL(viper.GetString(OrchestratorGrantURLFlag)),
+		service_orchestrator.WithMaxAuditorGrantDuration(viper.GetDuration(OrchestratorGrantMaxDurationFlag)),
+		service_orchestrator.WithPseudonymizer(pseudonymizer),
 	)
 
-	evidenceStoreService = service_evidenceStore.NewService(service_evidenceStore.WithStorage(db))
+	var assessmentOpts = []service.Option[service_assessment.Service]{
+		service_assessment.WithAuthorizer(serviceAuthorizer(service.RoleAssessmentService)),
+		service_assessment.WithEvidenceStoreCompression(viper.GetString(EvidenceCompressionFlag)),
+	}
+
+	// Share the cache of metric configurations between replicas of the assessment, if configured
+	if url := viper.GetString(AssessmentCacheRedisURLFlag); url != "" {
+		redisOpts, err := redis.ParseURL(url)
+		if err != nil {
+			return fmt.Errorf("could not parse Redis URL: %w", err)
+		}
+
+		assessmentOpts = append(assessmentOpts, service_assessment.WithConfigurationCache(
+			service_assessment.NewRedisCache(redis.NewClient(redisOpts))))
+	}
+
+	if viper.GetBool(AssessmentResourceSnapshotFlag) {
+		assessmentOpts = append(assessmentOpts, service_assessment.WithResourceSnapshot())
+	}
+
+	if url := viper.GetString(AssessmentBundleURLFlag); url != "" {
+		assessmentOpts = append(assessmentOpts, service_assessment.WithMetricBundle(url,
+			viper.GetDuration(AssessmentBundleIntervalFlag)))
+	}
+
+	if level := viper.GetString(AssessmentAssuranceLevelFlag); level != "" {
+		assessmentOpts = append(assessmentOpts, service_assessment.WithAssuranceLevel(level))
+	}
+
+	if dir := viper.GetString(AssessmentPolicyDirFlag); dir != "" {
+		assessmentOpts = append(assessmentOpts, service_assessment.WithPolicyDirectory(dir))
+	}
+
+	for _, s := range viper.GetStringSlice(AssessmentEvidenceMergeFlag) {
+		typ, policy, err := service_assessment.ParseMergePolicy(s)
+		if err != nil {
+			return fmt.Errorf("could not parse evidence merge policy: %w", err)
+		}
+
+		assessmentOpts = append(assessmentOpts, service_assessment.WithEvidenceMergePolicy(typ, policy))
+	}
+
+	// Faults can only be injected into the streams of engines built with the "chaos" build tag
+	faultOpts, err := streamFaultOptions()
+	if err != nil {
+		return err
+	}
+
+	assessmentOpts = append(assessmentOpts, faultOpts...)
+
+	assessmentService = service_assessment.NewService(assessmentOpts...)
+
+	// Imported evidences are assessed just like discovered ones, which stores them in the evidence store
+	evidenceStoreService = service_evidenceStore.NewService(
+		service_evidenceStore.WithStorage(db),
+		service_evidenceStore.WithAssessment(assessmentService),
+	)
 
 	evaluationService = service_evaluation.NewService(
-		service_evaluation.WithOAuth2Authorizer(
-			// Configure the OAuth 2.0 client credentials for this service
-			&clientcredentials.Config{
-				ClientID:     viper.GetString(ServiceOAuth2ClientIDFlag),
-				ClientSecret: viper.GetString(ServiceOAuth2ClientSecretFlag),
-				TokenURL:     viper.GetString(ServiceOAuth2EndpointFlag),
-			},
-		),
+		service_evaluation.WithAuthorizer(serviceAuthorizer(service.RoleEvaluationService)),
 		service_evaluation.WithStorage(db),
+		service_evaluation.WithLeaderElector(leader),
+		service_evaluation.WithPseudonymizer(pseudonymizer),
 	)
 
 	// It is possible to register hook functions for the orchestrator, evidenceStore and assessment service.
@@ -304,6 +786,54 @@ func doCmd(_ *cobra.Command, _ []string) (err error) {
 	// evidenceStoreService.RegisterEvidenceHook(func(result *evidence.Evidence, err error) {})
 	// assessmentService.RegisterAssessmentResultHook(func(result *assessment.AssessmentResult, err error) {}
 
+	// Publish new evidences, assessment results, evaluation results and discovery runs to an event bus, if configured
+	if typ := viper.GetString(EventBusTypeFlag); typ != "" {
+		bus, err := newEventBus(typ)
+		if err != nil {
+			return fmt.Errorf("could not configure event bus: %w", err)
+		}
+		defer bus.Close()
+
+		evidenceStoreService.RegisterEvidenceHook(bus.PublishEvidence)
+		orchestratorService.RegisterAssessmentResultHook(bus.PublishAssessmentResult)
+		evaluationService.RegisterEvaluationResultHook(bus.PublishEvaluationResult)
+
+		go func() {
+			for ev := range discoveryService.Events {
+				bus.PublishDiscoveryEvent(context.Background(), discoveryService.GetCloudServiceId(), ev)
+			}
+		}()
+	}
+
+	// Forward non-compliant assessment results to a SIEM, if configured
+	if format := viper.GetString(SIEMFormatFlag); format != "" {
+		forwarder, err := newSIEMForwarder(format)
+		if err != nil {
+			return fmt.Errorf("could not configure SIEM forwarder: %w", err)
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		forwarder.Start(ctx)
+		defer func() {
+			cancel()
+			forwarder.Wait()
+		}()
+
+		orchestratorService.RegisterAssessmentResultHook(forwarder.ForwardAssessmentResult)
+	}
+
+	// Forward evidences of edge collectors from an MQTT broker to the assessment service, if configured
+	if broker := viper.GetString(EdgeBridgeBrokerFlag); broker != "" {
+		bridge := edge.NewBridge(broker,
+			edge.WithBridgeTopic(viper.GetString(EdgeBridgeTopicFlag)),
+			edge.WithAuthorizer(serviceAuthorizer(service.RoleDiscoveryService)),
+		)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		go bridge.Start(ctx)
+		defer cancel()
+	}
+
 	if viper.GetBool(CreateDefaultTarget) {
 		_, err := orchestratorService.CreateDefaultTargetCloudService()
 		if err != nil {
@@ -311,6 +841,29 @@ func doCmd(_ *cobra.Command, _ []string) (err error) {
 		}
 	}
 
+	// Periodically check whether certificates are about to expire and notify the respective channels
+	certificateExpiryCheck = &periodicJob{run: orchestratorService.StartCertificateExpiryCheck}
+	certificateExpiryCheck.schedule(viper.GetDuration(NotificationCertCheckFlag))
+
+	// Periodically purge removed entities, once they can no longer be restored
+	if window := viper.GetDuration(DBPurgeAfterFlag); window > 0 {
+		go orchestratorService.StartPurge(context.Background(), window)
+	}
+
+	// Periodically decommission resources that are no longer discovered
+	if viper.GetDuration(OrchestratorStaleAfterFlag) > 0 {
+		go orchestratorService.StartStaleResourceCheck(context.Background())
+	}
+
+	// Periodically take snapshots of the compliance status for the compliance history
+	complianceSnapshots = &periodicJob{run: evaluationService.StartComplianceSnapshots}
+	complianceSnapshots.schedule(viper.GetDuration(EvaluationSnapshotIntervalFlag))
+
+	// Apply changes of the non-critical settings, once the configuration is reloaded
+	go config.WatchReload(context.Background(), reloadConfig, func(err error) {
+		log.Errorf("Could not reload configuration: %v", err)
+	})
+
 	grpcPort := viper.GetUint16(APIgRPCPortFlag)
 	httpPort := viper.GetUint16(APIHTTPPortFlag)
 
@@ -320,6 +873,41 @@ func doCmd(_ *cobra.Command, _ []string) (err error) {
 		rest.WithAllowedMethods(viper.GetStringSlice(APICORSAllowedMethodsFlags)),
 	}
 
+	// Expose our Prometheus metrics, if enabled
+	if viper.GetBool(APIMetricsFlag) {
+		opts = append(opts, rest.WithMetrics())
+	}
+
+	// Expose our GraphQL API, if enabled
+	if viper.GetBool(APIGraphQLFlag) {
+		opts = append(opts, rest.WithGraphQL())
+	}
+
+	// Serve the OpenAPI documents and the Swagger UI, if enabled
+	if viper.GetBool(APIOpenAPIFlag) {
+		opts = append(opts, rest.WithOpenAPI())
+	}
+
+	// Accept webhooks of third-party scanners, if enabled
+	if viper.GetBool(APIIngestFlag) {
+		mapper, err := ingest.NewMapper(viper.GetString(APIIngestTemplatesDirFlag))
+		if err != nil {
+			return fmt.Errorf("could not load mapping templates: %w", err)
+		}
+
+		opts = append(opts, rest.WithIngest(mapper))
+	}
+
+	// Accept gRPC-web requests, if enabled
+	if viper.GetBool(APIGRPCWebFlag) {
+		opts = append(opts, rest.WithGRPCWeb())
+	}
+
+	// Serve the dashboard, if a build of it is available
+	if path := viper.GetString(DashboardPathFlag); path != "" {
+		opts = append(opts, rest.WithDashboard(os.DirFS(path)))
+	}
+
 	// Let's check, if we are using our embedded OAuth 2.0 server, which we need to start (using additional arguments to
 	// our existing REST gateway). In a production scenario the usage of a dedicated (external) OAuth 2.0 server is
 	// recommended. In order to configure the external server, the flags ServiceOAuth2EndpointFlag and APIJWKSURLFlag
@@ -375,10 +963,15 @@ func doCmd(_ *cobra.Command, _ []string) (err error) {
 
 	log.Infof("Starting gRPC endpoint on :%d", grpcPort)
 
-	// Start the gRPC server
-	_, srv, err = server.StartGRPCServer(
-		fmt.Sprintf("0.0.0.0:%d", grpcPort),
+	issuers, err := oidcIssuers()
+	if err != nil {
+		return fmt.Errorf("could not configure OpenID Connect issuers: %w", err)
+	}
+
+	grpcOpts := []server.StartGRPCServerOption{
 		server.WithJWKS(viper.GetString(APIJWKSURLFlag)),
+		// Additionally trust tokens of external OpenID Connect providers, if configured
+		server.WithOIDCIssuers(issuers...),
 		server.WithDiscovery(discoveryService),
 		server.WithExperimentalDiscovery(discoveryService),
 		server.WithOrchestrator(orchestratorService),
@@ -386,12 +979,64 @@ func doCmd(_ *cobra.Command, _ []string) (err error) {
 		server.WithEvidenceStore(evidenceStoreService),
 		server.WithEvaluation(evaluationService),
 		server.WithReflection(),
+		// Allow machine collectors to submit evidences using an API key of our orchestrator
+		server.WithAPIKeys(orchestratorService),
+		// Allow external auditors to read the results of a cloud service using an auditor grant of our orchestrator
+		server.WithAuditorGrants(orchestratorService),
+	}
+
+	// Track the idempotency keys of submitted evidences, unless disabled
+	if ttl := viper.GetDuration(APIIdempotencyKeyTTLFlag); ttl > 0 {
+		grpcOpts = append(grpcOpts, server.WithIdempotencyKeys(
+			ttl,
+			&service.AuthorizationStrategyJWT{Issuers: server.ClaimMappings(issuers...)},
+		))
+	}
+
+	// Record all calls in the audit log of our orchestrator, if enabled
+	if viper.GetBool(APIAuditLogFlag) {
+		grpcOpts = append(grpcOpts, server.WithAuditLog(
+			orchestratorService,
+			&service.AuthorizationStrategyJWT{Issuers: server.ClaimMappings(issuers...)},
+		))
+	}
+
+	// Enforce role-based access control, if enabled. The roles are taken from the token, using the claim mapping of its
+	// issuer, as well as from the role assignments of our orchestrator.
+	rbac := service.NewRBAC(
+		&service.AuthorizationStrategyJWT{Issuers: server.ClaimMappings(issuers...)},
+		service.WithRoleAssignments(orchestratorService),
+		service.WithAdmins(rbacAdmins()...),
 	)
+	if viper.GetBool(APIRBACFlag) {
+		grpcOpts = append(grpcOpts, server.WithRBAC(rbac))
+	}
+
+	// Reorder the interceptors, if configured
+	if order := viper.GetStringSlice(APIInterceptorOrderFlag); len(order) > 0 {
+		grpcOpts = append(grpcOpts, server.WithInterceptorOrder(order...))
+	}
+
+	// Start the gRPC server
+	_, srv, err = server.StartGRPCServer(fmt.Sprintf("0.0.0.0:%d", grpcPort), grpcOpts...)
 	if err != nil {
 		log.Errorf("Failed to serve gRPC endpoint: %s", err)
 		return err
 	}
 
+	// Start the admin endpoints, if configured. They use the same services and authentication as the gRPC server, but
+	// always require the admin role, even if the role-based access control of our API is disabled
+	if adminPort := viper.GetUint16(APIAdminPortFlag); adminPort != 0 {
+		adminAddr := net.JoinHostPort(viper.GetString(APIAdminHostFlag), strconv.Itoa(int(adminPort)))
+		log.Infof("Starting admin endpoints on %s", adminAddr)
+
+		_, adminSrv, err = server.StartAdminServer(adminAddr, append(slices.Clip(grpcOpts), server.WithRBAC(rbac))...)
+		if err != nil {
+			log.Errorf("Failed to serve admin endpoints: %s", err)
+			return err
+		}
+	}
+
 	// Start the gRPC-HTTP gateway
 	err = rest.RunServer(context.Background(),
 		grpcPort,
@@ -403,17 +1048,240 @@ func doCmd(_ *cobra.Command, _ []string) (err error) {
 		return err
 	}
 
-	assessmentService.Shutdown()
-	discoveryService.Shutdown()
-
-	log.Infof("Stopping gRPC endpoint")
-	srv.Stop()
+	shutdown()
 
 	return nil
 }
 
+// shutdown stops our services, once the REST gateway is stopped. The order is important: First, the discovery and
+// the periodic jobs are stopped, so that no new evidences or results are produced. Afterward, the streams of the
+// assessment are closed, so that pending results still reach the evidence store and the orchestrator, before the
+// gRPC server finally stops.
+func shutdown() {
+	if discoveryService != nil {
+		discoveryService.Shutdown()
+	}
+
+	for _, job := range []*periodicJob{certificateExpiryCheck, complianceSnapshots} {
+		if job != nil {
+			job.schedule(0)
+		}
+	}
+
+	if assessmentService != nil {
+		assessmentService.Shutdown()
+	}
+
+	if adminSrv != nil {
+		_ = adminSrv.Close()
+	}
+
+	if srv != nil {
+		log.Infof("Stopping gRPC endpoint")
+
+		// Give pending RPCs some time to finish, but do not wait for long-running streams of external clients
+		stopped := make(chan struct{})
+		go func() {
+			srv.GracefulStop()
+			close(stopped)
+		}()
+
+		select {
+		case <-stopped:
+		case <-time.After(shutdownTimeout):
+			srv.Stop()
+		}
+	}
+}
+
+// serviceAuthorizer returns the authorizer of one of our services using the OAuth 2.0 client credentials for services.
+// If token exchange is enabled, the token is exchanged for one that is restricted to the given scope.
+func serviceAuthorizer(scope string) api.Authorizer {
+	// Configure the OAuth 2.0 client credentials for this service
+	config := &clientcredentials.Config{
+		ClientID:     viper.GetString(ServiceOAuth2ClientIDFlag),
+		ClientSecret: viper.GetString(ServiceOAuth2ClientSecretFlag),
+		TokenURL:     viper.GetString(ServiceOAuth2EndpointFlag),
+	}
+
+	if viper.GetBool(ServiceOAuth2TokenExchangeFlag) {
+		return api.NewOAuthAuthorizerFromTokenExchange(config, scope)
+	}
+
+	return api.NewOAuthAuthorizerFromClientCredentials(config)
+}
+
+// rbacAdmins returns the configured users that always have the admin role. If none are configured, the default user
+// and the OAuth 2.0 client of our services are admins, so that the embedded OAuth 2.0 server keeps working.
+func rbacAdmins() []string {
+	if admins := viper.GetStringSlice(APIRBACAdminsFlag); len(admins) > 0 {
+		return admins
+	}
+
+	return []string{viper.GetString(APIDefaultUserFlag), viper.GetString(ServiceOAuth2ClientIDFlag)}
+}
+
+// loggingConfig parses the configured format and levels of the log.
+func loggingConfig() (c logging.Config, err error) {
+	c.Format = logging.Format(viper.GetString(LogFormatFlag))
+
+	c.Level, err = logrus.ParseLevel(viper.GetString(LogLevelFlag))
+	if err != nil {
+		return c, err
+	}
+
+	c.ComponentLevels, err = logging.ParseComponentLevels(viper.GetStringSlice(LogComponentLevelsFlag))
+	if err != nil {
+		return c, err
+	}
+
+	return c, nil
+}
+
+// oidcIssuers parses the configured trusted OpenID Connect issuers.
+func oidcIssuers() (issuers []server.OIDCIssuer, err error) {
+	for _, s := range viper.GetStringSlice(APIOIDCIssuersFlag) {
+		iss, err := server.ParseOIDCIssuer(s)
+		if err != nil {
+			return nil, err
+		}
+
+		issuers = append(issuers, iss)
+	}
+
+	return issuers, nil
+}
+
+// secretStore creates the secret store for the credentials of the discoverers. Azure Key Vault and AWS Secrets
+// Manager are accessed using the default credentials of the respective cloud, e.g., a managed identity or an instance
+// role.
+func secretStore() (store credentials.SecretStore, err error) {
+	switch viper.GetString(DiscoveryCredentialsStoreFlag) {
+	case "vault":
+		return credentials.NewVault(viper.GetString(DiscoveryCredentialsURLFlag), viper.GetString(DiscoveryCredentialsTokenFlag)), nil
+	case "azure-key-vault":
+		cred, err := azidentity.NewDefaultAzureCredential(nil)
+		if err != nil {
+			return nil, err
+		}
+
+		return credentials.NewAzureKeyVault(viper.GetString(DiscoveryCredentialsURLFlag), cred), nil
+	case "aws-secrets-manager":
+		cfg, err := aws_config.LoadDefaultConfig(context.Background())
+		if err != nil {
+			return nil, err
+		}
+
+		return credentials.NewAWSSecretsManager(cfg), nil
+	default:
+		return nil, fmt.Errorf("unknown secret store %q", viper.GetString(DiscoveryCredentialsStoreFlag))
+	}
+}
+
+// encryptionKeys creates the key provider for the encryption of sensitive fields in the database. Azure Key Vault and
+// AWS KMS use the default credentials of the environment, e.g., a managed identity or an instance role.
+func encryptionKeys() (keys encryption.KeyProvider, err error) {
+	switch viper.GetString(DBEncryptionFlag) {
+	case "local":
+		return encryption.ParseLocalKey(viper.GetString(DBEncryptionKeyFlag))
+	case "azure-key-vault":
+		cred, err := azidentity.NewDefaultAzureCredential(nil)
+		if err != nil {
+			return nil, err
+		}
+
+		return encryption.NewAzureKeyVault(viper.GetString(DBEncryptionKeyFlag), cred), nil
+	case "aws-kms":
+		cfg, err := aws_config.LoadDefaultConfig(context.Background())
+		if err != nil {
+			return nil, err
+		}
+
+		return encryption.NewAWSKMS(cfg, viper.GetString(DBEncryptionKeyFlag)), nil
+	default:
+		return nil, fmt.Errorf("unknown key provider %q", viper.GetString(DBEncryptionFlag))
+	}
+}
+
+// smtpConfig returns the configured SMTP server used for email notifications.
+func smtpConfig() service_orchestrator.SMTPConfig {
+	return service_orchestrator.SMTPConfig{
+		Host:     viper.GetString(NotificationSMTPHostFlag),
+		Port:     viper.GetUint16(NotificationSMTPPortFlag),
+		Username: viper.GetString(NotificationSMTPUserFlag),
+		Password: viper.GetString(NotificationSMTPPasswordFlag),
+		From:     viper.GetString(NotificationSMTPFromFlag),
+	}
+}
+
+// reminderDays returns the configured number of days before the expiration of a certificate at which reminders are
+// sent. Invalid (non-positive) values are ignored.
+func reminderDays() (days []uint32) {
+	for _, d := range viper.GetIntSlice(NotificationCertRemindersFlag) {
+		if d > 0 {
+			days = append(days, uint32(d))
+		}
+	}
+
+	return
+}
+
 func main() {
 	if err := engineCmd.Execute(); err != nil {
 		os.Exit(1)
 	}
 }
+
+// newEventBus creates the event bus output of the given type according to the event bus flags.
+func newEventBus(typ string) (bus *eventbus.Bus, err error) {
+	pub, err := eventbus.NewPublisher(typ, viper.GetString(EventBusURLFlag))
+	if err != nil {
+		return nil, err
+	}
+
+	return eventbus.NewBus(pub,
+		eventbus.WithEncoding(eventbus.Encoding(viper.GetString(EventBusEncodingFlag))),
+		eventbus.WithEvidenceTopic(viper.GetString(EventBusEvidenceTopicFlag)),
+		eventbus.WithResultTopic(viper.GetString(EventBusResultTopicFlag)),
+		eventbus.WithEvaluationTopic(viper.GetString(EventBusEvaluationTopicFlag)),
+		eventbus.WithDiscoveryTopic(viper.GetString(EventBusDiscoveryTopicFlag)),
+		eventbus.WithSource(viper.GetString(EventBusSourceFlag)),
+	)
+}
+
+// newSIEMForwarder creates the SIEM forwarder for the given format according to the SIEM flags.
+func newSIEMForwarder(format string) (f *siem.Forwarder, err error) {
+	var (
+		formatter siem.Formatter
+		sender    siem.Sender
+		rules     []siem.Rule
+	)
+
+	switch format {
+	case "cef":
+		formatter = siem.NewSyslogFormatter(siem.NewCEFFormatter())
+		sender, err = siem.NewSyslogSender(viper.GetString(SIEMURLFlag))
+		if err != nil {
+			return nil, err
+		}
+	case "splunk-hec":
+		formatter = siem.NewHECFormatter(viper.GetString(SIEMHECIndexFlag))
+		sender = siem.NewHECSender(viper.GetString(SIEMURLFlag), viper.GetString(SIEMHECTokenFlag))
+	default:
+		return nil, fmt.Errorf("unknown format: %s", format)
+	}
+
+	for _, s := range viper.GetStringSlice(SIEMRulesFlag) {
+		r, err := siem.ParseRule(s)
+		if err != nil {
+			return nil, err
+		}
+
+		rules = append(rules, r)
+	}
+
+	return siem.NewForwarder(formatter, sender,
+		siem.WithRules(rules...),
+		siem.WithSeverityFunc(orchestratorService.ResultSeverity),
+	), nil
+}
@@ -1,8 +1,11 @@
 package main
 
 import (
+	"context"
 	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"clouditor.io/clouditor/v2/internal/testutil/clitest"
 	"clouditor.io/clouditor/v2/server/rest"
@@ -85,3 +88,58 @@ func Test_doCmd(t *testing.T) {
 		})
 	}
 }
+
+func Test_periodicJob_schedule(t *testing.T) {
+	var (
+		started = make(chan time.Duration, 3)
+		stopped = make(chan time.Duration, 3)
+	)
+
+	j := &periodicJob{run: func(ctx context.Context, interval time.Duration) {
+		started <- interval
+		<-ctx.Done()
+		stopped <- interval
+	}}
+
+	// A disabled job is not started
+	j.schedule(0)
+	assert.Nil(t, j.cancel)
+
+	j.schedule(time.Hour)
+	assert.Equal(t, time.Hour, <-started)
+
+	// An unchanged interval does not restart the job
+	j.schedule(time.Hour)
+	assert.Empty(t, started)
+
+	// A changed interval restarts the job
+	j.schedule(time.Minute)
+	assert.Equal(t, time.Hour, <-stopped)
+	assert.Equal(t, time.Minute, <-started)
+
+	j.schedule(0)
+	assert.Equal(t, time.Minute, <-stopped)
+	assert.Nil(t, j.cancel)
+}
+
+func Test_standaloneDefaults(t *testing.T) {
+	var dir = t.TempDir()
+
+	viper.Reset()
+	defer viper.Reset()
+
+	viper.Set(StandaloneDataDirFlag, dir)
+	viper.Set(APIHTTPPortFlag, 8081)
+	viper.Set(APIKeyPathFlag, "my.key")
+	assert.NoError(t, os.Mkdir(filepath.Join(dir, "dashboard"), 0700))
+
+	assert.NoError(t, standaloneDefaults())
+	assert.True(t, viper.GetBool(APIStartEmbeddedOAuth2ServerFlag))
+	assert.Equal(t, filepath.Join(dir, "clouditor.db"), viper.GetString(DBEmbeddedPathFlag))
+	assert.Equal(t, filepath.Join(dir, "dashboard"), viper.GetString(DashboardPathFlag))
+	assert.Equal(t, "http://localhost:8081/v1/auth/token", viper.GetString(ServiceOAuth2EndpointFlag))
+	assert.Equal(t, "http://localhost:8081/v1/auth/certs", viper.GetString(APIJWKSURLFlag))
+
+	// Explicitly configured settings are kept
+	assert.Equal(t, "my.key", viper.GetString(APIKeyPathFlag))
+}
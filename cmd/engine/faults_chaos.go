@@ -0,0 +1,72 @@
+//go:build chaos
+
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package main
+
+import (
+	"fmt"
+
+	"clouditor.io/clouditor/v2/internal/chaos"
+	"clouditor.io/clouditor/v2/service"
+	service_assessment "clouditor.io/clouditor/v2/service/assessment"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	StreamFaultsFlag    = "stream-faults"
+	DefaultStreamFaults = ""
+)
+
+// addStreamFaultFlags adds the flags to inject faults into the streams of the assessment.
+func addStreamFaultFlags(cmd *cobra.Command) {
+	cmd.Flags().String(StreamFaultsFlag, DefaultStreamFaults, "Injects faults into the streams of the assessment to the evidence store and the orchestrator to test their reconnect and buffering logic, e.g., latency=100ms,disconnect=0.01,drop=0.001. Must not be used in production")
+
+	_ = viper.BindPFlag(StreamFaultsFlag, cmd.Flags().Lookup(StreamFaultsFlag))
+}
+
+// streamFaultOptions returns the options of the assessment to inject the configured faults into its streams to the
+// evidence store and the orchestrator.
+func streamFaultOptions() (opts []service.Option[service_assessment.Service], err error) {
+	s := viper.GetString(StreamFaultsFlag)
+	if s == "" {
+		return nil, nil
+	}
+
+	faults, err := chaos.Parse(s)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse stream faults: %w", err)
+	}
+
+	log.Warnf("Injecting faults into the streams of the assessment: %v", faults)
+
+	return []service.Option[service_assessment.Service]{
+		service_assessment.WithEvidenceStoreAddress(service_assessment.DefaultEvidenceStoreAddress, faults.DialOption()),
+		service_assessment.WithOrchestratorAddress(service_assessment.DefaultOrchestratorAddress, faults.DialOption()),
+	}, nil
+}
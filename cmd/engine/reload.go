@@ -0,0 +1,102 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package main
+
+import (
+	"context"
+	"time"
+
+	"clouditor.io/clouditor/v2/internal/logging"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+)
+
+var (
+	// certificateExpiryCheck periodically checks whether certificates are about to expire
+	certificateExpiryCheck *periodicJob
+
+	// complianceSnapshots periodically takes snapshots of the compliance status
+	complianceSnapshots *periodicJob
+)
+
+// periodicJob runs a function in the background, which is executed periodically in the given interval until its
+// context is done. The interval can be changed at runtime.
+type periodicJob struct {
+	run func(ctx context.Context, interval time.Duration)
+
+	interval time.Duration
+	cancel   context.CancelFunc
+}
+
+// schedule (re-)starts the job with the given interval, unless the interval did not change. An interval of 0 stops
+// the job.
+func (j *periodicJob) schedule(interval time.Duration) {
+	var ctx context.Context
+
+	if interval == j.interval && j.cancel != nil {
+		return
+	}
+
+	if j.cancel != nil {
+		j.cancel()
+		j.cancel = nil
+	}
+
+	j.interval = interval
+	if interval <= 0 {
+		return
+	}
+
+	ctx, j.cancel = context.WithCancel(context.Background())
+	go j.run(ctx, interval)
+}
+
+// reloadConfig applies the non-critical settings of the reloaded configuration, i.e., the log format and levels, the intervals
+// of the periodic jobs and the notification targets. All other settings need a restart of the engine.
+func reloadConfig() {
+	logConfig, err := loggingConfig()
+	if err == nil {
+		err = logging.Configure(logrus.StandardLogger(), logConfig)
+	}
+	if err != nil {
+		log.Errorf("Could not change log configuration: %v", err)
+	}
+
+	if orchestratorService != nil {
+		orchestratorService.UpdateNotificationConfig(smtpConfig(), reminderDays()...)
+	}
+
+	if certificateExpiryCheck != nil {
+		certificateExpiryCheck.schedule(viper.GetDuration(NotificationCertCheckFlag))
+	}
+
+	if complianceSnapshots != nil {
+		complianceSnapshots.schedule(viper.GetDuration(EvaluationSnapshotIntervalFlag))
+	}
+
+	log.Info("Reloaded configuration")
+}
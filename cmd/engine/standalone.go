@@ -0,0 +1,106 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"clouditor.io/clouditor/v2/internal/auth"
+	"clouditor.io/clouditor/v2/internal/config"
+	"clouditor.io/clouditor/v2/internal/util"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	StandaloneDataDirFlag = "standalone-data-dir"
+
+	DefaultStandaloneDataDir = auth.DefaultConfigDirectory
+)
+
+// newStandaloneCommand creates the standalone command, which accepts the same flags as the engine.
+func newStandaloneCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "standalone",
+		Short: "standalone launches the Clouditor Engine in an all-in-one mode",
+		Long: "Launches all services of Clouditor, the REST gateway and the embedded OAuth 2.0 server in a single process, " +
+			"which keeps its embedded database and keys in a data directory. This is intended for demos and small installations.",
+		RunE: doStandaloneCmd,
+	}
+
+	cmd.Flags().AddFlagSet(engineCmd.Flags())
+	cmd.Flags().String(StandaloneDataDirFlag, DefaultStandaloneDataDir, "Specifies the directory in which the embedded database, the keys of the embedded OAuth 2.0 server and, optionally, a build of the dashboard (in the sub-directory dashboard) are located")
+
+	_ = viper.BindPFlag(StandaloneDataDirFlag, cmd.Flags().Lookup(StandaloneDataDirFlag))
+
+	return cmd
+}
+
+func doStandaloneCmd(cmd *cobra.Command, args []string) (err error) {
+	// We need to read the configuration already here, since it could contain the data directory
+	if err = config.Read(); err != nil {
+		return err
+	}
+
+	if err = standaloneDefaults(); err != nil {
+		return err
+	}
+
+	return doCmd(cmd, args)
+}
+
+// standaloneDefaults configures the engine to keep its state in the data directory and to authenticate using the
+// embedded OAuth 2.0 server. Explicitly configured settings still take precedence over the defaults set here.
+func standaloneDefaults() (err error) {
+	dir, err := util.ExpandPath(viper.GetString(StandaloneDataDirFlag))
+	if err != nil {
+		return fmt.Errorf("could not expand data directory: %w", err)
+	}
+
+	if err = os.MkdirAll(dir, 0700); err != nil {
+		return fmt.Errorf("could not create data directory: %w", err)
+	}
+
+	// All URLs need to point to our own REST gateway
+	url := fmt.Sprintf("http://localhost:%d", viper.GetUint16(APIHTTPPortFlag))
+
+	viper.Set(APIStartEmbeddedOAuth2ServerFlag, true)
+	viper.SetDefault(DBEmbeddedPathFlag, filepath.Join(dir, "clouditor.db"))
+	viper.SetDefault(APIKeyPathFlag, filepath.Join(dir, "api.key"))
+	viper.SetDefault(APIKeySaveOnCreateFlag, true)
+	viper.SetDefault(ServiceOAuth2EndpointFlag, url+"/v1/auth/token")
+	viper.SetDefault(APIJWKSURLFlag, url+"/v1/auth/certs")
+	viper.SetDefault(DashboardURLFlag, url)
+
+	if info, err := os.Stat(filepath.Join(dir, "dashboard")); err == nil && info.IsDir() {
+		viper.SetDefault(DashboardPathFlag, filepath.Join(dir, "dashboard"))
+	}
+
+	return nil
+}
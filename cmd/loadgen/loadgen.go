@@ -0,0 +1,251 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+// Command loadgen synthesizes evidences at a configurable rate and streams them to an assessment service, in order to
+// load test the evidence → assessment pipeline. At the end, it reports the achieved throughput and the latency of the
+// assessment of single evidences.
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"clouditor.io/clouditor/v2/api"
+	"clouditor.io/clouditor/v2/api/assessment"
+	"clouditor.io/clouditor/v2/api/discovery"
+	"clouditor.io/clouditor/v2/internal/testutil/servicetest/evidencetest"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+const (
+	AssessmentAddressFlag  = "assessment-address"
+	OAuth2EndpointFlag     = "oauth2-token-endpoint"
+	OAuth2ClientIDFlag     = "oauth2-client-id"
+	OAuth2ClientSecretFlag = "oauth2-client-secret"
+	RateFlag               = "rate"
+	DurationFlag           = "duration"
+	ResourcesFlag          = "resources"
+	StreamsFlag            = "streams"
+	CloudServiceIDFlag     = "cloud-service-id"
+	ToolIDFlag             = "tool-id"
+
+	DefaultAssessmentAddress  = "localhost:9090"
+	DefaultOAuth2Endpoint     = "http://localhost:8080/v1/auth/token"
+	DefaultOAuth2ClientID     = "clouditor"
+	DefaultOAuth2ClientSecret = "clouditor"
+	DefaultRate               = 100.0
+	DefaultDuration           = time.Minute
+	DefaultResources          = 1000
+	DefaultStreams            = 1
+	DefaultToolID             = "loadgen"
+
+	// drainTimeout is the maximum time we wait for outstanding responses after the last evidence was sent
+	drainTimeout = 30 * time.Second
+)
+
+// options contains the configuration of a load test
+type options struct {
+	address        string
+	auth           api.Authorizer
+	rate           float64
+	duration       time.Duration
+	resources      int
+	streams        int
+	cloudServiceID string
+	toolID         string
+}
+
+func newRootCommand() *cobra.Command {
+	var (
+		opts         options
+		tokenURL     string
+		clientID     string
+		clientSecret string
+	)
+
+	var cmd = &cobra.Command{
+		Use:   "loadgen",
+		Short: "Synthesizes evidences and streams them to an assessment service to measure its performance",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if opts.rate <= 0 || opts.resources <= 0 || opts.streams <= 0 {
+				return fmt.Errorf("--%s, --%s and --%s need to be positive", RateFlag, ResourcesFlag, StreamsFlag)
+			}
+
+			if tokenURL != "" {
+				opts.auth = api.NewOAuthAuthorizerFromClientCredentials(&clientcredentials.Config{
+					ClientID:     clientID,
+					ClientSecret: clientSecret,
+					TokenURL:     tokenURL,
+				})
+			}
+
+			r, err := run(cmd.Context(), opts)
+			if err != nil {
+				return err
+			}
+
+			r.Print(cmd.OutOrStdout())
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.address, AssessmentAddressFlag, DefaultAssessmentAddress, "the address of the assessment service")
+	cmd.Flags().StringVar(&tokenURL, OAuth2EndpointFlag, DefaultOAuth2Endpoint, "the OAuth 2.0 token endpoint. If empty, the evidences are sent without authentication")
+	cmd.Flags().StringVar(&clientID, OAuth2ClientIDFlag, DefaultOAuth2ClientID, "the OAuth 2.0 client ID")
+	cmd.Flags().StringVar(&clientSecret, OAuth2ClientSecretFlag, DefaultOAuth2ClientSecret, "the OAuth 2.0 client secret")
+	cmd.Flags().Float64Var(&opts.rate, RateFlag, DefaultRate, "the total number of evidences per second")
+	cmd.Flags().DurationVar(&opts.duration, DurationFlag, DefaultDuration, "the duration of the load test")
+	cmd.Flags().IntVar(&opts.resources, ResourcesFlag, DefaultResources, "the number of distinct resources the evidences describe")
+	cmd.Flags().IntVar(&opts.streams, StreamsFlag, DefaultStreams, "the number of parallel evidence streams, e.g., to simulate multiple discoverers")
+	cmd.Flags().StringVar(&opts.cloudServiceID, CloudServiceIDFlag, discovery.DefaultCloudServiceID, "the cloud service the evidences belong to")
+	cmd.Flags().StringVar(&opts.toolID, ToolIDFlag, DefaultToolID, "the tool ID of the evidences")
+
+	return cmd
+}
+
+// run executes the load test with the given options and returns its report
+func run(ctx context.Context, opts options) (r *report, err error) {
+	var (
+		wg    sync.WaitGroup
+		count atomic.Int64
+		errs  = make([]error, opts.streams)
+	)
+
+	conn := api.NewRPCConnection(opts.address, assessment.NewAssessmentClient)
+	conn.SetAuthorizer(opts.auth)
+
+	r = newReport()
+
+	ctx, cancel := context.WithTimeout(ctx, opts.duration+drainTimeout)
+	defer cancel()
+
+	// Every stream sends its share of the evidences in regular intervals
+	interval := time.Duration(float64(time.Second) * float64(opts.streams) / opts.rate)
+
+	start := time.Now()
+
+	for s := 0; s < opts.streams; s++ {
+		wg.Add(1)
+		go func(s int) {
+			defer wg.Done()
+
+			errs[s] = stream(ctx, conn, opts, interval, &count, r)
+		}(s)
+	}
+
+	wg.Wait()
+
+	r.elapsed = time.Since(start)
+
+	return r, errors.Join(errs...)
+}
+
+// stream sends evidences on a single stream until the duration of the load test is over and records the latency of
+// each response in the report.
+func stream(ctx context.Context, conn *api.RPCConnection[assessment.AssessmentClient], opts options, interval time.Duration, count *atomic.Int64, r *report) (err error) {
+	var (
+		// sent contains the send times of the evidences we are still waiting for. Since the assessment responds in the
+		// order of the requests, the first entry belongs to the next response.
+		sent = make(chan time.Time, 1024)
+		done = make(chan error, 1)
+	)
+
+	s, err := conn.Client.AssessEvidences(ctx)
+	if err != nil {
+		return fmt.Errorf("could not open stream to %s: %w", opts.address, err)
+	}
+
+	go func() {
+		for t := range sent {
+			res, err := s.Recv()
+			if err != nil {
+				done <- fmt.Errorf("could not receive response: %w", err)
+				return
+			}
+
+			r.Record(time.Since(t), res)
+		}
+
+		done <- nil
+	}()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	deadline := time.After(opts.duration)
+
+loop:
+	for {
+		select {
+		case <-deadline:
+			break loop
+		case <-ctx.Done():
+			break loop
+		case <-ticker.C:
+			ev, err := evidencetest.NewEvidence(opts.cloudServiceID, opts.toolID, int(count.Add(1)-1)%opts.resources)
+			if err != nil {
+				return err
+			}
+
+			t := time.Now()
+
+			err = s.Send(&assessment.AssessEvidenceRequest{Evidence: ev})
+			if errors.Is(err, io.EOF) {
+				// The actual error is returned by the receiver
+				break loop
+			} else if err != nil {
+				return fmt.Errorf("could not send evidence: %w", err)
+			}
+
+			sent <- t
+		}
+	}
+
+	close(sent)
+
+	if err = s.CloseSend(); err != nil {
+		return fmt.Errorf("could not close stream: %w", err)
+	}
+
+	return <-done
+}
+
+func main() {
+	var cmd = newRootCommand()
+
+	if err := cmd.Execute(); err != nil {
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package main
+
+import (
+	"context"
+	"net"
+	"os"
+	"testing"
+	"time"
+
+	"clouditor.io/clouditor/v2/api/assessment"
+	"clouditor.io/clouditor/v2/api/evidence"
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/testutil/clitest"
+	service_assessment "clouditor.io/clouditor/v2/service/assessment"
+	service_evidence "clouditor.io/clouditor/v2/service/evidence"
+	service_orchestrator "clouditor.io/clouditor/v2/service/orchestrator"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+)
+
+func TestMain(m *testing.M) {
+	clitest.AutoChdir()
+
+	os.Exit(m.Run())
+}
+
+func Test_run(t *testing.T) {
+	logrus.SetLevel(logrus.PanicLevel)
+
+	sock, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+
+	addr := sock.Addr().String()
+
+	srv := grpc.NewServer()
+	orchestrator.RegisterOrchestratorServer(srv, service_orchestrator.NewService())
+	evidence.RegisterEvidenceStoreServer(srv, service_evidence.NewService())
+	assessment.RegisterAssessmentServer(srv, service_assessment.NewService(
+		service_assessment.WithOrchestratorAddress(addr),
+		service_assessment.WithEvidenceStoreAddress(addr),
+	))
+
+	go func() {
+		_ = srv.Serve(sock)
+	}()
+	defer srv.Stop()
+
+	r, err := run(context.Background(), options{
+		address:        addr,
+		rate:           50,
+		duration:       time.Second,
+		resources:      10,
+		streams:        2,
+		cloudServiceID: testdata.MockCloudServiceID1,
+		toolID:         DefaultToolID,
+	})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, r.latencies)
+	assert.Equal(t, len(r.latencies), r.assessed)
+	assert.Equal(t, 0, r.failed)
+}
+
+func Test_report_Percentile(t *testing.T) {
+	r := newReport()
+
+	assert.Equal(t, time.Duration(0), r.Percentile(50))
+
+	for i := 1; i <= 100; i++ {
+		r.Record(time.Duration(101-i)*time.Millisecond, &assessment.AssessEvidencesResponse{Status: assessment.AssessEvidencesResponse_ASSESSED})
+	}
+
+	assert.Equal(t, 50*time.Millisecond, r.Percentile(50))
+	assert.Equal(t, 99*time.Millisecond, r.Percentile(99))
+	assert.Equal(t, 100*time.Millisecond, r.Percentile(100))
+	assert.Equal(t, 100, r.assessed)
+}
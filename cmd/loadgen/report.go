@@ -0,0 +1,120 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"sync"
+	"time"
+
+	"clouditor.io/clouditor/v2/api/assessment"
+)
+
+// report collects the responses of a load test
+type report struct {
+	mu sync.Mutex
+
+	latencies []time.Duration
+	assessed  int
+	failed    int
+	waiting   int
+
+	// lastError contains the status message of the last failed assessment
+	lastError string
+
+	elapsed time.Duration
+}
+
+func newReport() *report {
+	return &report{}
+}
+
+// Record records the response to an evidence that was sent latency ago
+func (r *report) Record(latency time.Duration, res *assessment.AssessEvidencesResponse) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.latencies = append(r.latencies, latency)
+
+	switch res.GetStatus() {
+	case assessment.AssessEvidencesResponse_ASSESSED:
+		r.assessed++
+	case assessment.AssessEvidencesResponse_WAITING_FOR_RELATED:
+		r.waiting++
+	default:
+		r.failed++
+		r.lastError = res.GetStatusMessage()
+	}
+}
+
+// Percentile returns the p-th percentile (0 < p <= 100) of the recorded latencies using the nearest-rank method
+func (r *report) Percentile(p float64) time.Duration {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if len(r.latencies) == 0 {
+		return 0
+	}
+
+	sorted := slices.Clone(r.latencies)
+	slices.Sort(sorted)
+
+	rank := int(p/100*float64(len(sorted))+0.5) - 1
+
+	return sorted[max(0, min(rank, len(sorted)-1))]
+}
+
+// Throughput returns the number of responses per second
+func (r *report) Throughput() float64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.elapsed == 0 {
+		return 0
+	}
+
+	return float64(len(r.latencies)) / r.elapsed.Seconds()
+}
+
+// Print prints the report in a human-readable form
+func (r *report) Print(w io.Writer) {
+	p50, p95, p99, p100 := r.Percentile(50), r.Percentile(95), r.Percentile(99), r.Percentile(100)
+	throughput := r.Throughput()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	fmt.Fprintf(w, "evidences:  %d (%d assessed, %d waiting for related, %d failed) in %s\n",
+		len(r.latencies), r.assessed, r.waiting, r.failed, r.elapsed.Round(time.Millisecond))
+	fmt.Fprintf(w, "throughput: %.1f evidences/s\n", throughput)
+	fmt.Fprintf(w, "latency:    p50 %s, p95 %s, p99 %s, max %s\n", p50, p95, p99, p100)
+
+	if r.lastError != "" {
+		fmt.Fprintf(w, "last error: %s\n", r.lastError)
+	}
+}
@@ -0,0 +1,213 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+// Package certification contains the certification lifecycle engine. It derives the state changes of a certificate,
+// i.e., whether it continues, is suspended or is withdrawn, from the compliance of the target of evaluation as observed
+// by the continuous evaluation. Different durations for suspending and continuing a certificate avoid that its state
+// flaps between issued and suspended, e.g., if a control is only briefly not compliant.
+package certification
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"clouditor.io/clouditor/v2/api/orchestrator"
+)
+
+const (
+	// DefaultObservationWindow is the default duration for which observations are considered.
+	DefaultObservationWindow = 90 * 24 * time.Hour
+
+	// DefaultSuspendAfter is the default duration a target of evaluation needs to be continuously not compliant,
+	// before its certificates are suspended. By default, they are suspended immediately.
+	DefaultSuspendAfter time.Duration = 0
+
+	// DefaultContinueAfter is the default duration a target of evaluation needs to be continuously compliant, before
+	// its automatically suspended certificates are continued. By default, they are continued immediately.
+	DefaultContinueAfter time.Duration = 0
+
+	// DefaultWithdrawAfter is the default duration a target of evaluation needs to be continuously not compliant,
+	// before its suspended certificates are withdrawn. By default, certificates are never withdrawn automatically.
+	DefaultWithdrawAfter time.Duration = 0
+)
+
+// Action is a state change of a certificate derived by the [Engine].
+type Action int
+
+const (
+	// ActionNone indicates that the state of the certificate does not change.
+	ActionNone Action = iota
+
+	// ActionContinue indicates that an automatically suspended certificate is issued again.
+	ActionContinue
+
+	// ActionSuspend indicates that the certificate is suspended.
+	ActionSuspend
+
+	// ActionWithdraw indicates that the certificate is withdrawn. This is final.
+	ActionWithdraw
+)
+
+// State returns the lifecycle state of a certificate after the action. It returns
+// [orchestrator.CertificateState_CERTIFICATE_STATE_UNSPECIFIED] for [ActionNone].
+func (a Action) State() orchestrator.CertificateState {
+	switch a {
+	case ActionContinue:
+		return orchestrator.CertificateState_CERTIFICATE_STATE_ISSUED
+	case ActionSuspend:
+		return orchestrator.CertificateState_CERTIFICATE_STATE_SUSPENDED
+	case ActionWithdraw:
+		return orchestrator.CertificateState_CERTIFICATE_STATE_WITHDRAWN
+	default:
+		return orchestrator.CertificateState_CERTIFICATE_STATE_UNSPECIFIED
+	}
+}
+
+// Policy configures the durations of the [Engine]. A zero duration for SuspendAfter or ContinueAfter means that the
+// state changes immediately, a zero duration for WithdrawAfter disables the withdrawal.
+type Policy struct {
+	// ObservationWindow is the duration for which observations are considered. Observations that are older can be
+	// discarded.
+	ObservationWindow time.Duration
+
+	// SuspendAfter is the duration a target of evaluation needs to be continuously not compliant, before its issued
+	// certificates are suspended.
+	SuspendAfter time.Duration
+
+	// ContinueAfter is the duration a target of evaluation needs to be continuously compliant, before its
+	// automatically suspended certificates are issued again.
+	ContinueAfter time.Duration
+
+	// WithdrawAfter is the duration a target of evaluation needs to be continuously not compliant, before its
+	// suspended certificates are withdrawn.
+	WithdrawAfter time.Duration
+}
+
+// DefaultPolicy returns a policy with the default durations, which suspends and continues certificates immediately
+// and never withdraws them.
+func DefaultPolicy() Policy {
+	return Policy{
+		ObservationWindow: DefaultObservationWindow,
+		SuspendAfter:      DefaultSuspendAfter,
+		ContinueAfter:     DefaultContinueAfter,
+		WithdrawAfter:     DefaultWithdrawAfter,
+	}
+}
+
+// Decision is the outcome of [Engine.Decide].
+type Decision struct {
+	Action Action
+
+	// Reason explains the decision. It is recorded in the state history of the certificate.
+	Reason string
+}
+
+// Engine derives state changes of certificates from observations according to its [Policy].
+type Engine struct {
+	policy Policy
+}
+
+// NewEngine creates a new lifecycle engine with the given policy.
+func NewEngine(policy Policy) *Engine {
+	return &Engine{policy: policy}
+}
+
+// Policy returns the policy of the engine.
+func (e *Engine) Policy() Policy {
+	return e.policy
+}
+
+// Since returns the time from which on observations are considered at the time now.
+func (e *Engine) Since(now time.Time) time.Time {
+	return now.Add(-e.policy.ObservationWindow)
+}
+
+// Decide derives the state change of the certificate from the observations of its target of evaluation at the time
+// now. The observations do not need to be ordered; observations outside the observation window are ignored.
+func (e *Engine) Decide(cert *orchestrator.Certificate, observations []*orchestrator.CertificationObservation, now time.Time) (d Decision) {
+	latest, held := e.streak(observations, now)
+	if latest == nil {
+		return
+	}
+
+	switch cert.CurrentState {
+	case orchestrator.CertificateState_CERTIFICATE_STATE_UNSPECIFIED, orchestrator.CertificateState_CERTIFICATE_STATE_ISSUED:
+		if !latest.Compliant && held >= e.policy.SuspendAfter {
+			d.Action = ActionSuspend
+			d.Reason = fmt.Sprintf("Control(s) %s are not compliant", strings.Join(latest.NonCompliantControls, ", "))
+		}
+	case orchestrator.CertificateState_CERTIFICATE_STATE_SUSPENDED:
+		switch {
+		case !latest.Compliant && e.policy.WithdrawAfter > 0 && held >= e.policy.WithdrawAfter:
+			d.Action = ActionWithdraw
+			d.Reason = fmt.Sprintf("Control(s) %s have not been compliant for %s", strings.Join(latest.NonCompliantControls, ", "), held.Round(time.Second))
+		case latest.Compliant && cert.SuspendedAutomatically() && held >= e.policy.ContinueAfter:
+			d.Action = ActionContinue
+			d.Reason = "All controls are compliant again"
+		}
+	}
+
+	return
+}
+
+// streak returns the latest observation within the observation window and for how long the target of evaluation has
+// been observed with the same compliance as in the latest observation.
+func (e *Engine) streak(observations []*orchestrator.CertificationObservation, now time.Time) (latest *orchestrator.CertificationObservation, held time.Duration) {
+	var (
+		since  = e.Since(now)
+		sorted []*orchestrator.CertificationObservation
+		start  time.Time
+	)
+
+	for _, o := range observations {
+		if o.GetTimestamp().AsTime().Before(since) {
+			continue
+		}
+
+		sorted = append(sorted, o)
+	}
+
+	if len(sorted) == 0 {
+		return nil, 0
+	}
+
+	// Sort the observations from the latest to the oldest
+	slices.SortFunc(sorted, func(a, b *orchestrator.CertificationObservation) int {
+		return b.GetTimestamp().AsTime().Compare(a.GetTimestamp().AsTime())
+	})
+
+	latest = sorted[0]
+	for _, o := range sorted {
+		if o.Compliant != latest.Compliant {
+			break
+		}
+
+		start = o.GetTimestamp().AsTime()
+	}
+
+	return latest, now.Sub(start)
+}
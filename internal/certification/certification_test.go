@@ -0,0 +1,196 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package certification
+
+import (
+	"testing"
+	"time"
+
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var now = time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+func observed(ago time.Duration, compliant bool) *orchestrator.CertificationObservation {
+	o := &orchestrator.CertificationObservation{
+		Timestamp: timestamppb.New(now.Add(-ago)),
+		Compliant: compliant,
+	}
+
+	if !compliant {
+		o.NonCompliantControls = []string{"OPS-13"}
+	}
+
+	return o
+}
+
+func suspended(automatic bool) *orchestrator.Certificate {
+	c := &orchestrator.Certificate{CurrentState: orchestrator.CertificateState_CERTIFICATE_STATE_ISSUED}
+	_ = c.TransitionTo(orchestrator.CertificateState_CERTIFICATE_STATE_SUSPENDED, nil, automatic, now.Add(-48*time.Hour))
+
+	return c
+}
+
+func TestEngine_Decide(t *testing.T) {
+	var (
+		issued = &orchestrator.Certificate{CurrentState: orchestrator.CertificateState_CERTIFICATE_STATE_ISSUED}
+		policy = Policy{
+			ObservationWindow: 30 * 24 * time.Hour,
+			SuspendAfter:      time.Hour,
+			ContinueAfter:     24 * time.Hour,
+			WithdrawAfter:     7 * 24 * time.Hour,
+		}
+	)
+
+	type args struct {
+		cert         *orchestrator.Certificate
+		observations []*orchestrator.CertificationObservation
+	}
+	tests := []struct {
+		name   string
+		policy Policy
+		args   args
+		want   Action
+	}{
+		{
+			name:   "No observations",
+			policy: policy,
+			args:   args{cert: issued},
+			want:   ActionNone,
+		},
+		{
+			name:   "Observations outside of the window",
+			policy: policy,
+			args: args{
+				cert:         issued,
+				observations: []*orchestrator.CertificationObservation{observed(60*24*time.Hour, false)},
+			},
+			want: ActionNone,
+		},
+		{
+			name:   "Not compliant, but not long enough to suspend",
+			policy: policy,
+			args: args{
+				cert:         issued,
+				observations: []*orchestrator.CertificationObservation{observed(2*time.Hour, true), observed(30*time.Minute, false)},
+			},
+			want: ActionNone,
+		},
+		{
+			name:   "Not compliant long enough to suspend",
+			policy: policy,
+			args: args{
+				cert:         issued,
+				observations: []*orchestrator.CertificationObservation{observed(time.Minute, false), observed(2*time.Hour, false), observed(3*time.Hour, true)},
+			},
+			want: ActionSuspend,
+		},
+		{
+			name:   "Default policy suspends immediately",
+			policy: DefaultPolicy(),
+			args: args{
+				cert:         issued,
+				observations: []*orchestrator.CertificationObservation{observed(0, false)},
+			},
+			want: ActionSuspend,
+		},
+		{
+			name:   "Compliant again, but not long enough to continue",
+			policy: policy,
+			args: args{
+				cert:         suspended(true),
+				observations: []*orchestrator.CertificationObservation{observed(2*time.Hour, false), observed(time.Hour, true)},
+			},
+			want: ActionNone,
+		},
+		{
+			name:   "Compliant long enough to continue",
+			policy: policy,
+			args: args{
+				cert:         suspended(true),
+				observations: []*orchestrator.CertificationObservation{observed(48*time.Hour, false), observed(25*time.Hour, true), observed(0, true)},
+			},
+			want: ActionContinue,
+		},
+		{
+			name:   "Manually suspended certificates are not continued",
+			policy: policy,
+			args: args{
+				cert:         suspended(false),
+				observations: []*orchestrator.CertificationObservation{observed(25*time.Hour, true)},
+			},
+			want: ActionNone,
+		},
+		{
+			name:   "Not compliant long enough to withdraw",
+			policy: policy,
+			args: args{
+				cert:         suspended(true),
+				observations: []*orchestrator.CertificationObservation{observed(8*24*time.Hour, false), observed(0, false)},
+			},
+			want: ActionWithdraw,
+		},
+		{
+			name:   "Withdrawal is disabled by default",
+			policy: DefaultPolicy(),
+			args: args{
+				cert:         suspended(true),
+				observations: []*orchestrator.CertificationObservation{observed(8*24*time.Hour, false), observed(0, false)},
+			},
+			want: ActionNone,
+		},
+		{
+			name:   "Withdrawn certificates do not change",
+			policy: policy,
+			args: args{
+				cert:         &orchestrator.Certificate{CurrentState: orchestrator.CertificateState_CERTIFICATE_STATE_WITHDRAWN},
+				observations: []*orchestrator.CertificationObservation{observed(25*time.Hour, true)},
+			},
+			want: ActionNone,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := NewEngine(tt.policy)
+
+			got := e.Decide(tt.args.cert, tt.args.observations, now)
+			assert.Equal(t, tt.want, got.Action)
+			if tt.want != ActionNone {
+				assert.NotEmpty(t, got.Reason)
+			}
+		})
+	}
+}
+
+func TestAction_State(t *testing.T) {
+	assert.Equal(t, orchestrator.CertificateState_CERTIFICATE_STATE_UNSPECIFIED, ActionNone.State())
+	assert.Equal(t, orchestrator.CertificateState_CERTIFICATE_STATE_ISSUED, ActionContinue.State())
+	assert.Equal(t, orchestrator.CertificateState_CERTIFICATE_STATE_SUSPENDED, ActionSuspend.State())
+	assert.Equal(t, orchestrator.CertificateState_CERTIFICATE_STATE_WITHDRAWN, ActionWithdraw.State())
+}
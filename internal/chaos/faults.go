@@ -0,0 +1,207 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+// Package chaos injects faults, i.e., artificial latency, disconnects and message drops, into the client streams
+// between our services, e.g., from the assessment to the evidence store and the orchestrator. It is used to validate
+// the reconnect and buffering logic of [api.StreamsOf] in integration tests and, using the stream-faults flag of an
+// engine built with the "chaos" build tag, in a running test system. It must never be enabled in production.
+package chaos
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	// ErrInjectedDisconnect is returned when sending a message to a stream that was disconnected by [Faults]
+	ErrInjectedDisconnect = status.Error(codes.Unavailable, "injected disconnect")
+
+	// ErrInvalidFaults indicates that the faults could not be parsed
+	ErrInvalidFaults = errors.New("invalid faults")
+)
+
+// Faults specifies the faults that are injected into each message sent to a client stream. Only streams, in which the
+// client sends messages, are affected, so that, e.g., subscriptions to events stay intact.
+type Faults struct {
+	// Latency is the maximum artificial delay before a message is sent. The actual delay is random between zero and
+	// Latency.
+	Latency time.Duration
+
+	// Disconnect is the probability (between 0 and 1) that the stream is disconnected before a message is sent. The
+	// message is not sent and the sender receives [ErrInjectedDisconnect], so that it needs to re-establish the
+	// stream. The messages sent before are still delivered, because our streams do not acknowledge messages and
+	// messages in flight would otherwise be lost in a way that the sender cannot detect.
+	Disconnect float64
+
+	// Drop is the probability (between 0 and 1) that a message is silently dropped instead of being sent.
+	Drop float64
+
+	// disconnects and drops count the injected faults
+	disconnects atomic.Uint64
+	drops       atomic.Uint64
+}
+
+// Parse parses faults in the form "latency=100ms,disconnect=0.01,drop=0.001". Omitted faults are not injected.
+func Parse(s string) (f *Faults, err error) {
+	f = new(Faults)
+
+	for _, kv := range strings.Split(s, ",") {
+		if strings.TrimSpace(kv) == "" {
+			continue
+		}
+
+		key, value, found := strings.Cut(kv, "=")
+		if !found {
+			return nil, fmt.Errorf("%w: missing value in %q", ErrInvalidFaults, kv)
+		}
+
+		key = strings.TrimSpace(key)
+		value = strings.TrimSpace(value)
+
+		switch key {
+		case "latency":
+			f.Latency, err = time.ParseDuration(value)
+		case "disconnect":
+			f.Disconnect, err = parseProbability(value)
+		case "drop":
+			f.Drop, err = parseProbability(value)
+		default:
+			return nil, fmt.Errorf("%w: unknown fault %q", ErrInvalidFaults, key)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidFaults, key, err)
+		}
+	}
+
+	return f, nil
+}
+
+// parseProbability parses a probability between 0 and 1.
+func parseProbability(s string) (p float64, err error) {
+	p, err = strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, err
+	}
+
+	if p < 0 || p > 1 {
+		return 0, fmt.Errorf("%v is not between 0 and 1", p)
+	}
+
+	return p, nil
+}
+
+// String returns the faults in the form accepted by [Parse].
+func (f *Faults) String() string {
+	return fmt.Sprintf("latency=%v,disconnect=%v,drop=%v", f.Latency, f.Disconnect, f.Drop)
+}
+
+// Disconnects returns the number of injected disconnects.
+func (f *Faults) Disconnects() uint64 {
+	return f.disconnects.Load()
+}
+
+// Drops returns the number of dropped messages.
+func (f *Faults) Drops() uint64 {
+	return f.drops.Load()
+}
+
+// DialOption returns a [grpc.DialOption] that injects the faults into all client streams of a connection, e.g., to be
+// used in the options of [api.RPCConnection].
+func (f *Faults) DialOption() grpc.DialOption {
+	return grpc.WithChainStreamInterceptor(f.StreamClientInterceptor)
+}
+
+// StreamClientInterceptor is a [grpc.StreamClientInterceptor] that injects the faults into client streams.
+func (f *Faults) StreamClientInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	if !desc.ClientStreams {
+		return streamer(ctx, desc, cc, method, opts...)
+	}
+
+	// We need to be able to release the stream once it ended
+	ctx, cancel := context.WithCancel(ctx)
+
+	stream, err := streamer(ctx, desc, cc, method, opts...)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	return &faultyStream{ClientStream: stream, faults: f, cancel: cancel}, nil
+}
+
+// faultyStream is a [grpc.ClientStream] that injects faults when sending messages.
+type faultyStream struct {
+	grpc.ClientStream
+
+	faults *Faults
+	cancel context.CancelFunc
+}
+
+// SendMsg sends the message to the wrapped stream, unless it is dropped or the stream is disconnected.
+func (s *faultyStream) SendMsg(m any) error {
+	if s.faults.Latency > 0 {
+		time.Sleep(time.Duration(rand.Int63n(int64(s.faults.Latency) + 1)))
+	}
+
+	if chance(s.faults.Disconnect) {
+		s.faults.disconnects.Add(1)
+		_ = s.ClientStream.CloseSend()
+
+		return ErrInjectedDisconnect
+	}
+
+	if chance(s.faults.Drop) {
+		s.faults.drops.Add(1)
+
+		return nil
+	}
+
+	return s.ClientStream.SendMsg(m)
+}
+
+// RecvMsg receives a message from the wrapped stream. Once the stream ended, its context is released.
+func (s *faultyStream) RecvMsg(m any) error {
+	err := s.ClientStream.RecvMsg(m)
+	if err != nil {
+		s.cancel()
+	}
+
+	return err
+}
+
+// chance returns true with the given probability.
+func chance(p float64) bool {
+	return p > 0 && rand.Float64() < p
+}
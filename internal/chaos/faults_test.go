@@ -0,0 +1,167 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package chaos
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+
+	"google.golang.org/grpc"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		want    *Faults
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name:    "empty",
+			s:       "",
+			want:    &Faults{},
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "all faults",
+			s:       "latency=100ms, disconnect=0.01,drop=0.001",
+			want:    &Faults{Latency: 100 * time.Millisecond, Disconnect: 0.01, Drop: 0.001},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "missing value",
+			s:    "latency",
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorIs(t, err, ErrInvalidFaults)
+			},
+		},
+		{
+			name: "unknown fault",
+			s:    "corrupt=0.1",
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorContains(t, err, "unknown fault")
+			},
+		},
+		{
+			name: "invalid probability",
+			s:    "drop=2",
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorContains(t, err, "not between 0 and 1")
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.s)
+			tt.wantErr(t, err)
+
+			if tt.want != nil {
+				assert.Equal(t, tt.want.String(), got.String())
+			}
+		})
+	}
+}
+
+func TestFaults_StreamClientInterceptor(t *testing.T) {
+	tests := []struct {
+		name            string
+		faults          *Faults
+		desc            *grpc.StreamDesc
+		wantErr         error
+		wantSent        int
+		wantDisconnects uint64
+		wantDrops       uint64
+	}{
+		{
+			name:     "no faults",
+			faults:   &Faults{Latency: time.Millisecond},
+			desc:     &grpc.StreamDesc{ClientStreams: true},
+			wantSent: 1,
+		},
+		{
+			name:            "disconnect",
+			faults:          &Faults{Disconnect: 1},
+			desc:            &grpc.StreamDesc{ClientStreams: true},
+			wantErr:         ErrInjectedDisconnect,
+			wantDisconnects: 1,
+		},
+		{
+			name:      "drop",
+			faults:    &Faults{Drop: 1},
+			desc:      &grpc.StreamDesc{ClientStreams: true},
+			wantDrops: 1,
+		},
+		{
+			name:     "server stream",
+			faults:   &Faults{Disconnect: 1, Drop: 1},
+			desc:     &grpc.StreamDesc{ServerStreams: true},
+			wantSent: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inner := &recordingStream{}
+
+			stream, err := tt.faults.StreamClientInterceptor(context.Background(), tt.desc, nil, "/test",
+				func(ctx context.Context, _ *grpc.StreamDesc, _ *grpc.ClientConn, _ string, _ ...grpc.CallOption) (grpc.ClientStream, error) {
+					inner.ctx = ctx
+					return inner, nil
+				})
+			assert.NoError(t, err)
+
+			err = stream.SendMsg("message")
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.wantSent, inner.sent)
+			assert.Equal(t, tt.wantDisconnects, tt.faults.Disconnects())
+			assert.Equal(t, tt.wantDrops, tt.faults.Drops())
+
+			// A disconnect closes the stream
+			assert.Equal(t, tt.wantDisconnects > 0, inner.closed)
+		})
+	}
+}
+
+// recordingStream is a [grpc.ClientStream] that counts the sent messages.
+type recordingStream struct {
+	grpc.ClientStream
+
+	ctx    context.Context
+	sent   int
+	closed bool
+}
+
+func (s *recordingStream) SendMsg(_ any) error {
+	s.sent++
+	return nil
+}
+
+func (s *recordingStream) CloseSend() error {
+	s.closed = true
+	return nil
+}
@@ -0,0 +1,128 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+// Package config reads the configuration of the Clouditor components from a configuration file, which can contain
+// named profiles, e.g., for development and production. Settings can be overridden by environment variables and flags.
+package config
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/spf13/viper"
+)
+
+const (
+	// FileFlag is the viper flag for the path of the configuration file. If it is empty, the file is searched in the
+	// paths given to [Init].
+	FileFlag = "config"
+
+	// ProfileFlag is the viper flag for the name of the profile, whose settings override the ones at the top level of
+	// the configuration file.
+	ProfileFlag = "profile"
+
+	// profilesKey is the key in the configuration file containing all profiles by their name.
+	profilesKey = "profiles"
+)
+
+// ErrUnknownProfile indicates that the selected profile is not contained in the configuration file.
+var ErrUnknownProfile = errors.New("unknown profile")
+
+// Init configures viper to read the configuration file with the given name (without extension) from the given paths
+// as well as environment variables with the given prefix. The file can be in any format supported by viper, e.g.,
+// YAML or TOML, which is derived from its extension.
+func Init(name string, envPrefix string, paths ...string) {
+	viper.SetEnvKeyReplacer(strings.NewReplacer("-", "_", ".", "_"))
+	viper.SetEnvPrefix(envPrefix)
+	viper.SetConfigName(name)
+
+	for _, path := range paths {
+		viper.AddConfigPath(path)
+	}
+
+	viper.AutomaticEnv()
+}
+
+// Read reads the configuration file and applies the selected profile. The precedence is: flags, environment variables,
+// the profile, the top level of the configuration file and the defaults. It is not an error, if no configuration file
+// is found, unless it was explicitly specified using [FileFlag].
+func Read() (err error) {
+	if file := viper.GetString(FileFlag); file != "" {
+		viper.SetConfigFile(file)
+	}
+
+	err = viper.ReadInConfig()
+	if errors.As(err, &viper.ConfigFileNotFoundError{}) {
+		err = nil
+	} else if err != nil {
+		return fmt.Errorf("could not read configuration file: %w", err)
+	}
+
+	profile := viper.GetString(ProfileFlag)
+	if profile == "" {
+		return nil
+	}
+
+	key := profilesKey + "." + profile
+	if !viper.IsSet(key) {
+		return fmt.Errorf("%w: %s", ErrUnknownProfile, profile)
+	}
+
+	err = viper.MergeConfigMap(viper.GetStringMap(key))
+	if err != nil {
+		return fmt.Errorf("could not apply profile %s: %w", profile, err)
+	}
+
+	return nil
+}
+
+// WatchReload reads the configuration again each time the process receives a SIGHUP, until the context is done.
+// Afterward, reload is called, which needs to apply the settings that can be changed at runtime. If the configuration
+// cannot be read, onError is called instead and the previous settings are kept as far as possible.
+func WatchReload(ctx context.Context, reload func(), onError func(err error)) {
+	var c = make(chan os.Signal, 1)
+
+	signal.Notify(c, syscall.SIGHUP)
+	defer signal.Stop(c)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-c:
+			if err := Read(); err != nil {
+				onError(err)
+				continue
+			}
+
+			reload()
+		}
+	}
+}
@@ -0,0 +1,243 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package config
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+	"time"
+
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+
+	"github.com/spf13/viper"
+)
+
+const testYAML = `log-level: info
+db-in-memory: true
+profiles:
+  dev:
+    log-level: debug
+  prod:
+    log-level: warn
+    db-in-memory: false
+`
+
+const testTOML = `log-level = "info"
+
+[profiles.prod]
+log-level = "warn"
+`
+
+// writeConfig writes the configuration file with the given name and content to a temporary directory.
+func writeConfig(t *testing.T, name string, content string) (dir string) {
+	dir = t.TempDir()
+
+	err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0600)
+	assert.NoError(t, err)
+
+	return dir
+}
+
+func TestRead(t *testing.T) {
+	type args struct {
+		file    string
+		content string
+		profile string
+		env     map[string]string
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantKeys map[string]any
+		wantErr  assert.WantErr
+	}{
+		{
+			name: "no configuration file",
+			wantKeys: map[string]any{
+				"log-level": nil,
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "top level only",
+			args: args{
+				file:    "clouditor.yaml",
+				content: testYAML,
+			},
+			wantKeys: map[string]any{
+				"log-level":    "info",
+				"db-in-memory": true,
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "profile",
+			args: args{
+				file:    "clouditor.yaml",
+				content: testYAML,
+				profile: "prod",
+			},
+			wantKeys: map[string]any{
+				"log-level":    "warn",
+				"db-in-memory": false,
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "profile and environment variable",
+			args: args{
+				file:    "clouditor.yaml",
+				content: testYAML,
+				profile: "dev",
+				env:     map[string]string{"CLOUDITOR_TEST_LOG_LEVEL": "error"},
+			},
+			wantKeys: map[string]any{
+				"log-level":    "error",
+				"db-in-memory": true,
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "TOML",
+			args: args{
+				file:    "clouditor.toml",
+				content: testTOML,
+				profile: "prod",
+			},
+			wantKeys: map[string]any{
+				"log-level": "warn",
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "unknown profile",
+			args: args{
+				file:    "clouditor.yaml",
+				content: testYAML,
+				profile: "staging",
+			},
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, ErrUnknownProfile)
+			},
+		},
+		{
+			name: "invalid file",
+			args: args{
+				file:    "clouditor.yaml",
+				content: "log-level: [",
+			},
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "could not read configuration file")
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			viper.Reset()
+			defer viper.Reset()
+
+			for k, v := range tt.args.env {
+				t.Setenv(k, v)
+			}
+
+			dir := t.TempDir()
+			if tt.args.file != "" {
+				dir = writeConfig(t, tt.args.file, tt.args.content)
+			}
+
+			Init("clouditor", "CLOUDITOR_TEST", dir)
+			viper.Set(ProfileFlag, tt.args.profile)
+
+			err := Read()
+			tt.wantErr(t, err)
+
+			for k, v := range tt.wantKeys {
+				assert.Equal(t, v, viper.Get(k))
+			}
+		})
+	}
+}
+
+func TestRead_file(t *testing.T) {
+	viper.Reset()
+	defer viper.Reset()
+
+	dir := writeConfig(t, "other.yaml", testYAML)
+
+	viper.Set(FileFlag, filepath.Join(dir, "other.yaml"))
+	assert.NoError(t, Read())
+	assert.Equal(t, "info", viper.GetString("log-level"))
+
+	// An explicitly specified file needs to exist
+	viper.Set(FileFlag, filepath.Join(dir, "missing.yaml"))
+	assert.Error(t, Read())
+}
+
+func TestWatchReload(t *testing.T) {
+	var (
+		reloaded = make(chan string)
+		failed   = make(chan error)
+	)
+
+	viper.Reset()
+	defer viper.Reset()
+
+	dir := writeConfig(t, "clouditor.yaml", testYAML)
+	Init("clouditor", "CLOUDITOR_TEST", dir)
+	assert.NoError(t, Read())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go WatchReload(ctx, func() {
+		reloaded <- viper.GetString("log-level")
+	}, func(err error) {
+		failed <- err
+	})
+
+	// Give the watcher some time to register for the signal
+	time.Sleep(100 * time.Millisecond)
+
+	err := os.WriteFile(filepath.Join(dir, "clouditor.yaml"), []byte("log-level: trace\n"), 0600)
+	assert.NoError(t, err)
+
+	p, err := os.FindProcess(os.Getpid())
+	assert.NoError(t, err)
+	assert.NoError(t, p.Signal(syscall.SIGHUP))
+
+	select {
+	case level := <-reloaded:
+		assert.Equal(t, "trace", level)
+	case err := <-failed:
+		t.Fatalf("could not reload: %v", err)
+	case <-time.After(5 * time.Second):
+		t.Fatal(errors.New("configuration was not reloaded"))
+	}
+}
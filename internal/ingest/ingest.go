@@ -0,0 +1,268 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+// Package ingest converts the webhook payloads of third-party scanners and event sources, e.g., Trivy reports or Falco
+// alerts, into resources of our ontology, so that they can be stored as evidences without a custom collector.
+//
+// The conversion is specified by a mapping template per format. A mapping template is a [text/template] that is
+// executed with the decoded JSON payload and renders the resources in the JSON representation of the ontology, i.e.,
+// either a single resource or an array of resources, each containing its type in the "@type" field. A resource can
+// contain the part of the payload it was mapped from in the "@raw" field. Otherwise, this is the whole payload, if the
+// template rendered a single resource, or the rendered resource itself. Besides the built-in functions, templates can
+// use:
+//   - json: encodes a value as JSON, e.g., {{ json .name }}
+//   - get: returns the value at the path of keys or nil, e.g., {{ get . "output_fields" "container.id" }}
+//
+// Templates for Trivy reports ("trivy"), Falco alerts forwarded by falcosidekick ("falco") and AWS EventBridge events
+// ("eventbridge") are built in. They can be overridden and further formats can be added with templates in a directory.
+package ingest
+
+import (
+	"bytes"
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"slices"
+	"strings"
+	"text/template"
+
+	_ "clouditor.io/clouditor/v2/api/ontology"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+const (
+	// TemplateExtension is the file extension of mapping templates. The name of the file without it is the name of the
+	// format, e.g., "trivy.json.tmpl" for the format "trivy".
+	TemplateExtension = ".json.tmpl"
+
+	// RawField is the field of a rendered resource that contains the part of the payload it was mapped from.
+	RawField = "@raw"
+)
+
+var (
+	// ErrUnknownFormat indicates that there is no mapping template for a format.
+	ErrUnknownFormat = errors.New("unknown format")
+
+	// ErrInvalidPayload indicates that a payload is not valid JSON or could not be mapped.
+	ErrInvalidPayload = errors.New("invalid payload")
+)
+
+//go:embed templates/*.json.tmpl
+var builtin embed.FS
+
+// funcs contains the additional functions available in mapping templates.
+var funcs = template.FuncMap{
+	"json": func(v any) (string, error) {
+		b, err := json.Marshal(v)
+		return string(b), err
+	},
+	"get": get,
+}
+
+// Resource is a resource of our ontology together with the part of the payload it was mapped from.
+type Resource struct {
+	// Resource is the resource in the JSON representation of the ontology
+	Resource *anypb.Any
+
+	// Raw is the part of the payload the resource was mapped from in JSON
+	Raw []byte
+}
+
+// Mapper converts payloads into resources using the mapping template of their format.
+type Mapper struct {
+	templates map[string]*template.Template
+}
+
+// NewMapper creates a new [Mapper] with the built-in mapping templates. If dir is not empty, all templates in it are
+// loaded as well, replacing built-in templates of the same format.
+func NewMapper(dir string) (m *Mapper, err error) {
+	m = &Mapper{templates: make(map[string]*template.Template)}
+
+	err = m.load(builtin, "templates")
+	if err != nil {
+		return nil, err
+	}
+
+	if dir != "" {
+		err = m.load(os.DirFS(dir), ".")
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// load parses all mapping templates in dir of fsys.
+func (m *Mapper) load(fsys fs.FS, dir string) error {
+	files, err := fs.Glob(fsys, path.Join(dir, "*"+TemplateExtension))
+	if err != nil {
+		return err
+	}
+
+	for _, file := range files {
+		b, err := fs.ReadFile(fsys, file)
+		if err != nil {
+			return fmt.Errorf("could not read mapping template: %w", err)
+		}
+
+		format := strings.TrimSuffix(path.Base(file), TemplateExtension)
+
+		tmpl, err := template.New(format).Option("missingkey=zero").Funcs(funcs).Parse(string(b))
+		if err != nil {
+			return fmt.Errorf("could not parse mapping template %s: %w", file, err)
+		}
+
+		m.templates[format] = tmpl
+	}
+
+	return nil
+}
+
+// Formats returns the names of all formats the mapper supports in alphabetical order.
+func (m *Mapper) Formats() (formats []string) {
+	for format := range m.templates {
+		formats = append(formats, format)
+	}
+
+	slices.Sort(formats)
+
+	return
+}
+
+// Resources converts the JSON payload of the given format into resources of our ontology. A payload that does not
+// describe any resource, e.g., an event about an unsupported service, results in no resources.
+func (m *Mapper) Resources(format string, payload []byte) (resources []*Resource, err error) {
+	var (
+		data any
+		buf  bytes.Buffer
+		raw  []json.RawMessage
+	)
+
+	tmpl, ok := m.templates[format]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownFormat, format)
+	}
+
+	err = json.Unmarshal(payload, &data)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPayload, err)
+	}
+
+	err = tmpl.Execute(&buf, data)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPayload, err)
+	}
+
+	// Templates can either render a single resource or an array of them
+	out := bytes.TrimSpace(buf.Bytes())
+	if bytes.HasPrefix(out, []byte("{")) {
+		raw = []json.RawMessage{out}
+	} else if err = json.Unmarshal(out, &raw); err != nil {
+		return nil, fmt.Errorf("%w: mapping template did not render resources: %v", ErrInvalidPayload, err)
+	}
+
+	for _, r := range raw {
+		var (
+			resource anypb.Any
+			part     []byte
+		)
+
+		r, part, err = splitRaw(r)
+		if err != nil {
+			return nil, fmt.Errorf("%w: mapping template rendered an invalid resource: %v", ErrInvalidPayload, err)
+		}
+
+		// Without an explicit part, a single resource is mapped from the whole payload
+		if part == nil && len(raw) == 1 {
+			part = payload
+		} else if part == nil {
+			part = r
+		}
+
+		err = protojson.Unmarshal(r, &resource)
+		if err != nil {
+			return nil, fmt.Errorf("%w: mapping template rendered an invalid resource: %v", ErrInvalidPayload, err)
+		}
+
+		resources = append(resources, &Resource{Resource: &resource, Raw: part})
+	}
+
+	return resources, nil
+}
+
+// splitRaw removes the [RawField] from the rendered resource r and returns it in compact form. If r does not contain
+// the field, part is nil.
+func splitRaw(r json.RawMessage) (resource json.RawMessage, part []byte, err error) {
+	var (
+		fields map[string]json.RawMessage
+		buf    bytes.Buffer
+	)
+
+	err = json.Unmarshal(r, &fields)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	value, ok := fields[RawField]
+	if !ok {
+		return r, nil, nil
+	}
+
+	err = json.Compact(&buf, value)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	delete(fields, RawField)
+
+	resource, err = json.Marshal(fields)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resource, buf.Bytes(), nil
+}
+
+// get returns the value at the path of keys in v, which needs to consist of nested JSON objects. If there is no such
+// value, nil is returned.
+func get(v any, keys ...string) any {
+	for _, key := range keys {
+		obj, ok := v.(map[string]any)
+		if !ok {
+			return nil
+		}
+
+		v = obj[key]
+	}
+
+	return v
+}
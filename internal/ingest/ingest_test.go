@@ -0,0 +1,196 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package ingest
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/testutil/prototest"
+	"clouditor.io/clouditor/v2/internal/util"
+)
+
+func TestMapper_Resources(t *testing.T) {
+	const (
+		trivyPayload = `{
+			"ArtifactName": "nginx:1.25",
+			"ArtifactType": "container_image",
+			"Metadata": {"ImageID": "sha256:1234"},
+			"Results": [{"Target": "nginx:1.25 (debian 12.4)", "Vulnerabilities": [{"VulnerabilityID": "CVE-2023-0001"}]}]
+		}`
+		falcoPayload = `{
+			"output": "Shell spawned in a container",
+			"priority": "Warning",
+			"rule": "Terminal shell in container",
+			"time": "2024-03-01T12:00:00.000000000Z",
+			"output_fields": {"container.id": "a1b2c3", "container.name": "web", "container.image.repository": "nginx"}
+		}`
+	)
+
+	m, err := NewMapper("")
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		format  string
+		payload string
+		want    []*Resource
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name:    "trivy",
+			format:  "trivy",
+			payload: trivyPayload,
+			want: []*Resource{{
+				Resource: prototest.NewAny(t, &ontology.ContainerImage{
+					Id:     "sha256:1234",
+					Name:   "nginx:1.25",
+					Labels: map[string]string{"scanner": "trivy"},
+				}),
+				Raw: []byte(trivyPayload),
+			}},
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "falco",
+			format:  "falco",
+			payload: falcoPayload,
+			want: []*Resource{{
+				Resource: prototest.NewAny(t, &ontology.Container{
+					Id:      "a1b2c3",
+					Name:    "web",
+					ImageId: util.Ref("nginx"),
+					Labels:  map[string]string{"falco.rule": "Terminal shell in container", "falco.priority": "Warning"},
+				}),
+				Raw: []byte(falcoPayload),
+			}},
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "falco alert of the host",
+			format:  "falco",
+			payload: `{"rule": "Read sensitive file untrusted", "priority": "Warning", "output_fields": {"container.id": "host"}}`,
+			wantErr: assert.NoError,
+		},
+		{
+			name:   "eventbridge",
+			format: "eventbridge",
+			payload: `{
+				"version": "0",
+				"id": "event-1",
+				"detail-type": "EC2 Instance State-change Notification",
+				"source": "aws.ec2",
+				"account": "123456789012",
+				"region": "eu-central-1",
+				"resources": ["arn:aws:ec2:eu-central-1:123456789012:instance/i-1", "arn:aws:ec2:eu-central-1:123456789012:instance/i-2"],
+				"detail": {"state": "running"}
+			}`,
+			want: []*Resource{
+				{
+					Resource: prototest.NewAny(t, &ontology.VirtualMachine{
+						Id:          "arn:aws:ec2:eu-central-1:123456789012:instance/i-1",
+						Name:        "arn:aws:ec2:eu-central-1:123456789012:instance/i-1",
+						GeoLocation: &ontology.GeoLocation{Region: "eu-central-1"},
+						Labels:      map[string]string{"aws.account": "123456789012", "aws.detail-type": "EC2 Instance State-change Notification"},
+					}),
+					Raw: []byte(`{"id":"event-1","detail-type":"EC2 Instance State-change Notification","source":"aws.ec2","account":"123456789012","time":null,"region":"eu-central-1","resources":["arn:aws:ec2:eu-central-1:123456789012:instance/i-1"],"detail":{"state":"running"}}`),
+				},
+				{
+					Resource: prototest.NewAny(t, &ontology.VirtualMachine{
+						Id:          "arn:aws:ec2:eu-central-1:123456789012:instance/i-2",
+						Name:        "arn:aws:ec2:eu-central-1:123456789012:instance/i-2",
+						GeoLocation: &ontology.GeoLocation{Region: "eu-central-1"},
+						Labels:      map[string]string{"aws.account": "123456789012", "aws.detail-type": "EC2 Instance State-change Notification"},
+					}),
+					Raw: []byte(`{"id":"event-1","detail-type":"EC2 Instance State-change Notification","source":"aws.ec2","account":"123456789012","time":null,"region":"eu-central-1","resources":["arn:aws:ec2:eu-central-1:123456789012:instance/i-2"],"detail":{"state":"running"}}`),
+				},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "eventbridge event of an unsupported service",
+			format:  "eventbridge",
+			payload: `{"source": "aws.iam", "resources": ["arn:aws:iam::123456789012:user/test"]}`,
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "unknown format",
+			format:  "unknown",
+			payload: `{}`,
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorIs(t, err, ErrUnknownFormat)
+			},
+		},
+		{
+			name:    "invalid JSON",
+			format:  "trivy",
+			payload: `{`,
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorIs(t, err, ErrInvalidPayload)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := m.Resources(tt.format, []byte(tt.payload))
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNewMapper(t *testing.T) {
+	dir := t.TempDir()
+
+	// Add a new format and replace a built-in one
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "custom.json.tmpl"),
+		[]byte(`{"@type": "type.googleapis.com/clouditor.ontology.v1.VirtualMachine", "id": {{ json .id }}, "name": {{ json .id }}}`), 0600))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "trivy.json.tmpl"),
+		[]byte(`{"@type": "type.googleapis.com/clouditor.ontology.v1.Unknown", "id": "1"}`), 0600))
+
+	m, err := NewMapper(dir)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"custom", "eventbridge", "falco", "trivy"}, m.Formats())
+
+	got, err := m.Resources("custom", []byte(`{"id": "my-vm"}`))
+	assert.NoError(t, err)
+	assert.Equal(t, []*Resource{{
+		Resource: prototest.NewAny(t, &ontology.VirtualMachine{Id: "my-vm", Name: "my-vm"}),
+		Raw:      []byte(`{"id": "my-vm"}`),
+	}}, got)
+
+	_, err = m.Resources("trivy", []byte(`{}`))
+	assert.ErrorContains(t, err, "invalid resource")
+
+	// Invalid templates are rejected
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "invalid.json.tmpl"), []byte(`{{ if }}`), 0600))
+
+	_, err = NewMapper(dir)
+	assert.ErrorContains(t, err, "could not parse mapping template")
+}
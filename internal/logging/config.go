@@ -0,0 +1,191 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package logging
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"clouditor.io/clouditor/v2/logging/formatter"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Format is the output format of the log.
+type Format string
+
+const (
+	// FormatText formats log entries as human-readable (and colored) text, which is the default.
+	FormatText Format = "text"
+
+	// FormatJSON formats each log entry as a JSON object, so that it can be processed by log aggregators.
+	FormatJSON Format = "json"
+)
+
+// ComponentField is the field that identifies the component (or subsystem) that created a log entry.
+const ComponentField = "component"
+
+var (
+	// ErrUnknownFormat indicates that the log format is not supported
+	ErrUnknownFormat = errors.New("unknown log format")
+
+	// ErrInvalidComponentLevel indicates that the level of a component is not specified as <component>=<level>
+	ErrInvalidComponentLevel = errors.New("invalid component level")
+)
+
+// Config contains the configuration of the log output of all components.
+type Config struct {
+	// Format is the output format. It defaults to [FormatText].
+	Format Format
+
+	// Level is the level of components that have no level of their own.
+	Level logrus.Level
+
+	// ComponentLevels contains the levels of individual components by the value of their [ComponentField], e.g.,
+	// "assessment". They can be more or less verbose than Level.
+	ComponentLevels map[string]logrus.Level
+}
+
+var (
+	// current is the configuration that is currently applied
+	current = Config{
+		Format: FormatText,
+		Level:  logrus.InfoLevel,
+	}
+
+	// currentMutex synchronizes the access to current
+	currentMutex sync.RWMutex
+)
+
+// Configure applies the configuration to logger, which is usually the [logrus.StandardLogger] used by all components.
+// It can be called again, e.g., if the configuration is reloaded. Loggers that use a formatter created by
+// [NewFormatter] follow the new configuration as well.
+func Configure(logger *logrus.Logger, c Config) (err error) {
+	if c.Format == "" {
+		c.Format = FormatText
+	}
+
+	if c.Format != FormatText && c.Format != FormatJSON {
+		return fmt.Errorf("%w: %s", ErrUnknownFormat, c.Format)
+	}
+
+	currentMutex.Lock()
+	current = c
+	currentMutex.Unlock()
+
+	// The logger needs to let pass the entries of the most verbose component. The others are filtered by our formatter.
+	logger.SetLevel(c.maxLevel())
+	logger.SetFormatter(NewFormatter(formatter.CapitalizeFormatter{Formatter: &logrus.TextFormatter{ForceColors: true}}))
+
+	// Add the correlation IDs of the context to every entry, but only once
+	for _, hook := range logger.Hooks[logrus.InfoLevel] {
+		if _, ok := hook.(correlationHook); ok {
+			return nil
+		}
+	}
+
+	logger.AddHook(correlationHook{})
+
+	return nil
+}
+
+// ParseComponentLevels parses the levels of components that are specified as <component>=<level>, e.g.,
+// "assessment=debug".
+func ParseComponentLevels(specs []string) (levels map[string]logrus.Level, err error) {
+	levels = make(map[string]logrus.Level, len(specs))
+
+	for _, spec := range specs {
+		component, name, ok := strings.Cut(spec, "=")
+		if !ok || component == "" {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidComponentLevel, spec)
+		}
+
+		levels[component], err = logrus.ParseLevel(name)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidComponentLevel, err)
+		}
+	}
+
+	return levels, nil
+}
+
+// NewFormatter returns a formatter that formats entries in the configured format and drops the ones that are below
+// the level of their component. For [FormatText], the entries are formatted by text, which allows a logger to
+// customize its text output, e.g., for gRPC calls.
+func NewFormatter(text logrus.Formatter) logrus.Formatter {
+	return &levelFormatter{
+		text: text,
+		json: &logrus.JSONFormatter{},
+	}
+}
+
+// levelFormatter formats entries according to the current configuration.
+type levelFormatter struct {
+	text logrus.Formatter
+	json logrus.Formatter
+}
+
+// Format implements [logrus.Formatter]. Entries that are below the level of their component are dropped, i.e., an
+// empty output is returned.
+func (f *levelFormatter) Format(entry *logrus.Entry) ([]byte, error) {
+	currentMutex.RLock()
+	c := current
+	currentMutex.RUnlock()
+
+	if entry.Level > c.levelOf(entry) {
+		return nil, nil
+	}
+
+	if c.Format == FormatJSON {
+		return f.json.Format(entry)
+	}
+
+	return f.text.Format(entry)
+}
+
+// levelOf returns the level of the component of the entry.
+func (c *Config) levelOf(entry *logrus.Entry) logrus.Level {
+	if component, ok := entry.Data[ComponentField].(string); ok {
+		if level, ok := c.ComponentLevels[component]; ok {
+			return level
+		}
+	}
+
+	return c.Level
+}
+
+// maxLevel returns the most verbose level of all components.
+func (c *Config) maxLevel() (level logrus.Level) {
+	level = c.Level
+
+	for _, l := range c.ComponentLevels {
+		level = max(level, l)
+	}
+
+	return level
+}
@@ -0,0 +1,179 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package logging
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+
+	"github.com/sirupsen/logrus"
+)
+
+func TestConfigure(t *testing.T) {
+	type args struct {
+		c Config
+	}
+	tests := []struct {
+		name      string
+		args      args
+		wantLines []string
+		wantErr   assert.WantErr
+	}{
+		{
+			name: "unknown format",
+			args: args{
+				c: Config{Format: "xml"},
+			},
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, ErrUnknownFormat)
+			},
+		},
+		{
+			name: "default level",
+			args: args{
+				c: Config{Format: FormatJSON, Level: logrus.InfoLevel},
+			},
+			wantLines: []string{"assessment info", "storage info"},
+			wantErr:   assert.Nil[error],
+		},
+		{
+			name: "component levels",
+			args: args{
+				c: Config{
+					Format:          FormatJSON,
+					Level:           logrus.InfoLevel,
+					ComponentLevels: map[string]logrus.Level{"assessment": logrus.DebugLevel, "storage": logrus.WarnLevel},
+				},
+			},
+			wantLines: []string{"assessment debug", "assessment info"},
+			wantErr:   assert.Nil[error],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+
+			t.Cleanup(func() {
+				current = Config{Format: FormatText, Level: logrus.InfoLevel}
+			})
+
+			logger := logrus.New()
+			logger.SetOutput(&buf)
+
+			err := Configure(logger, tt.args.c)
+			tt.wantErr(t, err)
+			if err != nil {
+				return
+			}
+
+			for _, component := range []string{"assessment", "storage"} {
+				log := logger.WithField(ComponentField, component)
+				log.Debugf("%s debug", component)
+				log.Infof("%s info", component)
+			}
+
+			var lines []string
+			for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+				var entry map[string]any
+				assert.NoError(t, json.Unmarshal([]byte(line), &entry))
+
+				lines = append(lines, entry["msg"].(string))
+			}
+
+			assert.Equal(t, tt.wantLines, lines)
+		})
+	}
+}
+
+func TestConfigure_reload(t *testing.T) {
+	t.Cleanup(func() {
+		current = Config{Format: FormatText, Level: logrus.InfoLevel}
+	})
+
+	logger := logrus.New()
+
+	assert.NoError(t, Configure(logger, Config{Level: logrus.InfoLevel}))
+	assert.NoError(t, Configure(logger, Config{Level: logrus.WarnLevel, ComponentLevels: map[string]logrus.Level{"assessment": logrus.TraceLevel}}))
+
+	// The hook is only added once and the logger lets pass the most verbose level
+	assert.Equal(t, 1, len(logger.Hooks[logrus.InfoLevel]))
+	assert.Equal(t, logrus.TraceLevel, logger.GetLevel())
+}
+
+func TestParseComponentLevels(t *testing.T) {
+	type args struct {
+		specs []string
+	}
+	tests := []struct {
+		name       string
+		args       args
+		wantLevels map[string]logrus.Level
+		wantErr    assert.WantErr
+	}{
+		{
+			name:       "empty",
+			wantLevels: map[string]logrus.Level{},
+			wantErr:    assert.Nil[error],
+		},
+		{
+			name: "valid",
+			args: args{
+				specs: []string{"assessment=debug", "Evidence Store=warn"},
+			},
+			wantLevels: map[string]logrus.Level{"assessment": logrus.DebugLevel, "Evidence Store": logrus.WarnLevel},
+			wantErr:    assert.Nil[error],
+		},
+		{
+			name: "missing level",
+			args: args{
+				specs: []string{"assessment"},
+			},
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, ErrInvalidComponentLevel)
+			},
+		},
+		{
+			name: "invalid level",
+			args: args{
+				specs: []string{"assessment=verbose"},
+			},
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, ErrInvalidComponentLevel)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotLevels, err := ParseComponentLevels(tt.args.specs)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.wantLevels, gotLevels)
+		})
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package logging
+
+import (
+	"context"
+	"maps"
+
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// RequestIDHeader is the (lower-case) header that contains the ID of a request. If a client does not set it, the
+// server generates one. Either way, it is returned in the header of the response, so that a request can be correlated
+// with its log entries.
+const RequestIDHeader = "x-request-id"
+
+// Fields that correlate the log entries of a request across our services. They are added to every entry that is
+// logged with a context containing them, e.g., by log.WithContext(ctx).
+const (
+	RequestIDField      = "request_id"
+	EvidenceIDField     = "evidence_id"
+	CloudServiceIDField = "cloud_service_id"
+	TraceIDField        = "trace_id"
+)
+
+// fieldsKey is the key of the correlation fields in a context.
+type fieldsKey struct{}
+
+// WithFields returns a copy of ctx that contains the given correlation fields in addition to the ones of ctx. Empty
+// values are ignored.
+func WithFields(ctx context.Context, fields logrus.Fields) context.Context {
+	var merged = maps.Clone(Fields(ctx))
+	if merged == nil {
+		merged = logrus.Fields{}
+	}
+
+	for k, v := range fields {
+		if v == nil || v == "" {
+			continue
+		}
+
+		merged[k] = v
+	}
+
+	return context.WithValue(ctx, fieldsKey{}, merged)
+}
+
+// WithEvidence returns a copy of ctx that contains the ID and the cloud service of the evidence ev as correlation
+// fields.
+func WithEvidence(ctx context.Context, ev interface {
+	GetId() string
+	GetCloudServiceId() string
+}) context.Context {
+	return WithFields(ctx, logrus.Fields{
+		EvidenceIDField:     ev.GetId(),
+		CloudServiceIDField: ev.GetCloudServiceId(),
+	})
+}
+
+// Fields returns the correlation fields of ctx. The returned fields must not be modified.
+func Fields(ctx context.Context) logrus.Fields {
+	if ctx == nil {
+		return nil
+	}
+
+	fields, _ := ctx.Value(fieldsKey{}).(logrus.Fields)
+
+	return fields
+}
+
+// correlationHook adds the correlation fields and the ID of the trace of the context of an entry to the entry.
+type correlationHook struct{}
+
+// Levels implements [logrus.Hook].
+func (correlationHook) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+// Fire implements [logrus.Hook]. Fields that are set explicitly on the entry take precedence.
+func (correlationHook) Fire(entry *logrus.Entry) error {
+	if entry.Context == nil {
+		return nil
+	}
+
+	for k, v := range Fields(entry.Context) {
+		if _, ok := entry.Data[k]; !ok {
+			entry.Data[k] = v
+		}
+	}
+
+	if sc := trace.SpanContextFromContext(entry.Context); sc.HasTraceID() {
+		if _, ok := entry.Data[TraceIDField]; !ok {
+			entry.Data[TraceIDField] = sc.TraceID().String()
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package logging
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"clouditor.io/clouditor/v2/api/evidence"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestWithFields(t *testing.T) {
+	ctx := WithFields(context.Background(), logrus.Fields{RequestIDField: "1", CloudServiceIDField: ""})
+	child := WithEvidence(ctx, &evidence.Evidence{Id: testdata.MockEvidenceID1, CloudServiceId: testdata.MockCloudServiceID1})
+
+	assert.Equal(t, logrus.Fields{RequestIDField: "1"}, Fields(ctx))
+	assert.Equal(t, logrus.Fields{
+		RequestIDField:      "1",
+		EvidenceIDField:     testdata.MockEvidenceID1,
+		CloudServiceIDField: testdata.MockCloudServiceID1,
+	}, Fields(child))
+	assert.Empty(t, Fields(context.Background()))
+}
+
+func Test_correlationHook_Fire(t *testing.T) {
+	var (
+		buf   bytes.Buffer
+		entry map[string]any
+	)
+
+	logger := logrus.New()
+	logger.SetOutput(&buf)
+	logger.SetFormatter(&logrus.JSONFormatter{})
+	logger.AddHook(correlationHook{})
+
+	traceID := trace.TraceID{1}
+	ctx := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{TraceID: traceID}))
+	ctx = WithFields(ctx, logrus.Fields{RequestIDField: "1", EvidenceIDField: "2"})
+
+	logger.WithContext(ctx).WithField(EvidenceIDField, "3").Info("test")
+
+	assert.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+	assert.Equal[any](t, "1", entry[RequestIDField])
+	assert.Equal[any](t, "3", entry[EvidenceIDField])
+	assert.Equal[any](t, traceID.String(), entry[TraceIDField])
+}
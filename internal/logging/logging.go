@@ -44,6 +44,7 @@ const (
 	Create
 	Register
 	Remove
+	Restore
 	Store
 	Send
 	Update
@@ -62,6 +63,8 @@ func (r RequestType) String() string {
 		return "registered"
 	case Remove:
 		return "removed"
+	case Restore:
+		return "restored"
 	case Store:
 		return "stored"
 	case Send:
@@ -71,6 +71,11 @@ func TestRequestType_String(t *testing.T) {
 			r:    Remove,
 			want: "removed",
 		},
+		{
+			name: "Happy path: Restore",
+			r:    Restore,
+			want: "restored",
+		},
 		{
 			name: "Happy path: Store",
 			r:    Store,
@@ -0,0 +1,197 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package mqtt
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"net"
+	"sync"
+)
+
+// Broker is a minimal in-memory MQTT broker without persistence or retained messages. Messages are delivered with
+// QoS 0 to all subscribers. It is intended for tests and local development only.
+type Broker struct {
+	mu       sync.Mutex
+	sessions map[*brokerSession]struct{}
+	listener net.Listener
+}
+
+type brokerSession struct {
+	conn    net.Conn
+	writeMu sync.Mutex
+	filters []string
+}
+
+// NewBroker starts a new [Broker] listening on addr, e.g., "127.0.0.1:0".
+func NewBroker(addr string) (b *Broker, err error) {
+	b = &Broker{sessions: make(map[*brokerSession]struct{})}
+
+	b.listener, err = net.Listen("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+
+	go b.serve()
+
+	return b, nil
+}
+
+// URL returns the URL of the broker.
+func (b *Broker) URL() string {
+	return "mqtt://" + b.listener.Addr().String()
+}
+
+// Close stops the broker and closes all connections.
+func (b *Broker) Close() error {
+	err := b.listener.Close()
+
+	b.mu.Lock()
+	for s := range b.sessions {
+		_ = s.conn.Close()
+	}
+	b.mu.Unlock()
+
+	return err
+}
+
+func (b *Broker) serve() {
+	for {
+		conn, err := b.listener.Accept()
+		if errors.Is(err, net.ErrClosed) {
+			return
+		} else if err != nil {
+			continue
+		}
+
+		go b.handle(&brokerSession{conn: conn})
+	}
+}
+
+func (b *Broker) handle(s *brokerSession) {
+	defer func() {
+		b.mu.Lock()
+		delete(b.sessions, s)
+		b.mu.Unlock()
+
+		_ = s.conn.Close()
+	}()
+
+	r := bufio.NewReader(s.conn)
+
+	p, err := readPacket(r)
+	if err != nil || p.typ != packetConnect {
+		return
+	}
+
+	b.mu.Lock()
+	b.sessions[s] = struct{}{}
+	b.mu.Unlock()
+
+	_ = s.write(&packet{typ: packetConnack, body: []byte{0, 0}})
+
+	for {
+		p, err = readPacket(r)
+		if err != nil {
+			return
+		}
+
+		switch p.typ {
+		case packetSubscribe:
+			id, rest, err := readPacketID(p.body)
+			if err != nil {
+				return
+			}
+
+			filter, _, err := readString(rest)
+			if err != nil {
+				return
+			}
+
+			b.mu.Lock()
+			s.filters = append(s.filters, filter)
+			b.mu.Unlock()
+
+			_ = s.write(&packet{typ: packetSuback, body: append(binary.BigEndian.AppendUint16(nil, id), 0)})
+		case packetPublish:
+			topic, rest, err := readString(p.body)
+			if err != nil {
+				return
+			}
+
+			if p.flags>>1&0x03 > 0 {
+				var id uint16
+
+				id, rest, err = readPacketID(rest)
+				if err != nil {
+					return
+				}
+
+				_ = s.write(pubackPacket(id))
+			}
+
+			b.deliver(topic, rest)
+		case packetPingreq:
+			_ = s.write(&packet{typ: packetPingresp})
+		case packetDisconnect:
+			return
+		}
+	}
+}
+
+// deliver sends the message to all sessions with a matching subscription.
+func (b *Broker) deliver(topic string, payload []byte) {
+	var targets []*brokerSession
+
+	b.mu.Lock()
+	for s := range b.sessions {
+		for _, f := range s.filters {
+			if MatchTopic(f, topic) {
+				targets = append(targets, s)
+				break
+			}
+		}
+	}
+	b.mu.Unlock()
+
+	for _, s := range targets {
+		_ = s.write(publishPacket(topic, 0, 0, payload))
+	}
+}
+
+func (s *brokerSession) write(p *packet) error {
+	b, err := p.encode()
+	if err != nil {
+		return err
+	}
+
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	_, err = s.conn.Write(b)
+	return err
+}
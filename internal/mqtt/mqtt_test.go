@@ -0,0 +1,201 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package mqtt
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+)
+
+func TestMatchTopic(t *testing.T) {
+	tests := []struct {
+		filter string
+		topic  string
+		want   bool
+	}{
+		{filter: "clouditor/evidences", topic: "clouditor/evidences", want: true},
+		{filter: "clouditor/evidences", topic: "clouditor/results", want: false},
+		{filter: "clouditor/+", topic: "clouditor/evidences", want: true},
+		{filter: "clouditor/+", topic: "clouditor/evidences/site-1", want: false},
+		{filter: "clouditor/+/site-1", topic: "clouditor/evidences/site-1", want: true},
+		{filter: "clouditor/#", topic: "clouditor/evidences/site-1", want: true},
+		{filter: "clouditor/#", topic: "clouditor", want: true},
+		{filter: "#", topic: "clouditor/evidences", want: true},
+		{filter: "clouditor/evidences/site-1", topic: "clouditor/evidences", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.filter+" "+tt.topic, func(t *testing.T) {
+			assert.Equal(t, tt.want, MatchTopic(tt.filter, tt.topic))
+		})
+	}
+}
+
+func Test_validFilter(t *testing.T) {
+	assert.True(t, validFilter("clouditor/+/#"))
+	assert.True(t, validFilter("#"))
+	assert.False(t, validFilter(""))
+	assert.False(t, validFilter("clouditor/#/evidences"))
+	assert.False(t, validFilter("clouditor/evi+"))
+}
+
+func TestConnect(t *testing.T) {
+	tests := []struct {
+		name    string
+		rawURL  string
+		wantErr assert.WantErr
+	}{
+		{
+			name:   "unsupported scheme",
+			rawURL: "http://localhost",
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "unsupported scheme")
+			},
+		},
+		{
+			name:   "invalid URL",
+			rawURL: "mqtt://%",
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "invalid MQTT URL")
+			},
+		},
+		{
+			name:   "connection refused",
+			rawURL: "mqtt://127.0.0.1:1",
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "could not connect to MQTT broker")
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := Connect(context.Background(), tt.rawURL)
+			tt.wantErr(t, err)
+			assert.Nil(t, c)
+		})
+	}
+}
+
+func TestConnect_refused(t *testing.T) {
+	sock, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	defer sock.Close()
+
+	go func() {
+		conn, err := sock.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		r := bufio.NewReader(conn)
+
+		p, err := readPacket(r)
+		if err != nil || p.typ != packetConnect {
+			return
+		}
+
+		// Return code 5: not authorized
+		b, _ := (&packet{typ: packetConnack, body: []byte{0, 5}}).encode()
+		_, _ = conn.Write(b)
+	}()
+
+	_, err = Connect(context.Background(), "mqtt://user:wrong@"+sock.Addr().String())
+	assert.ErrorIs(t, err, ErrConnectionRefused)
+}
+
+func TestClient_PublishSubscribe(t *testing.T) {
+	broker, err := NewBroker("127.0.0.1:0")
+	assert.NoError(t, err)
+	defer broker.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	sub, err := Connect(ctx, broker.URL(), WithClientID("bridge"))
+	assert.NoError(t, err)
+	defer sub.Close()
+
+	messages := make(chan *Message, 2)
+	err = sub.Subscribe(ctx, "clouditor/evidences/+", AtLeastOnce, func(msg *Message) {
+		messages <- msg
+	})
+	assert.NoError(t, err)
+
+	pub, err := Connect(ctx, broker.URL(), WithClientID("edge-1"), WithKeepAlive(time.Second))
+	assert.NoError(t, err)
+	defer pub.Close()
+
+	err = pub.Publish(ctx, "clouditor/evidences/site-1", AtLeastOnce, []byte("hello"))
+	assert.NoError(t, err)
+
+	err = pub.Publish(ctx, "clouditor/other", AtMostOnce, []byte("ignored"))
+	assert.NoError(t, err)
+
+	err = pub.Publish(ctx, "clouditor/evidences/site-2", AtMostOnce, []byte("world"))
+	assert.NoError(t, err)
+
+	for _, want := range []string{"hello", "world"} {
+		select {
+		case msg := <-messages:
+			assert.Equal(t, want, string(msg.Payload))
+		case <-ctx.Done():
+			t.Fatal("did not receive message")
+		}
+	}
+
+	err = pub.Publish(ctx, "clouditor/+", AtMostOnce, nil)
+	assert.ErrorContains(t, err, "invalid MQTT topic")
+
+	err = sub.Subscribe(ctx, "clouditor/#/evidences", AtMostOnce, nil)
+	assert.ErrorContains(t, err, "invalid MQTT topic filter")
+}
+
+func TestClient_Done(t *testing.T) {
+	broker, err := NewBroker("127.0.0.1:0")
+	assert.NoError(t, err)
+
+	c, err := Connect(context.Background(), broker.URL())
+	assert.NoError(t, err)
+	assert.NoError(t, c.Err())
+
+	// Losing the broker must close the client
+	_ = broker.Close()
+
+	select {
+	case <-c.Done():
+		assert.ErrorIs(t, c.Err(), ErrClientClosed)
+	case <-time.After(5 * time.Second):
+		t.Fatal("client was not closed")
+	}
+
+	err = c.Publish(context.Background(), "clouditor/evidences", AtLeastOnce, nil)
+	assert.Error(t, err)
+}
@@ -0,0 +1,204 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package mqtt
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// Packet types of the MQTT 3.1.1 protocol that are used by the [Client].
+const (
+	packetConnect    byte = 1
+	packetConnack    byte = 2
+	packetPublish    byte = 3
+	packetPuback     byte = 4
+	packetSubscribe  byte = 8
+	packetSuback     byte = 9
+	packetPingreq    byte = 12
+	packetPingresp   byte = 13
+	packetDisconnect byte = 14
+)
+
+// maxRemainingLength is the maximum remaining length of a packet that can be encoded in 4 bytes.
+const maxRemainingLength = 268435455
+
+// ErrMalformedPacket is returned if a packet cannot be decoded.
+var ErrMalformedPacket = errors.New("malformed packet")
+
+// packet is a single MQTT control packet, consisting of its type, the flags of the fixed header and the remaining
+// bytes (variable header and payload).
+type packet struct {
+	typ   byte
+	flags byte
+	body  []byte
+}
+
+// readPacket reads a single packet from r.
+func readPacket(r *bufio.Reader) (p *packet, err error) {
+	var (
+		header byte
+		length int
+	)
+
+	header, err = r.ReadByte()
+	if err != nil {
+		return nil, err
+	}
+
+	// The remaining length is encoded in up to 4 bytes, 7 bits each
+	for i, shift := 0, 0; ; i, shift = i+1, shift+7 {
+		if i == 4 {
+			return nil, fmt.Errorf("%w: remaining length too long", ErrMalformedPacket)
+		}
+
+		b, err := r.ReadByte()
+		if err != nil {
+			return nil, err
+		}
+
+		length |= int(b&0x7f) << shift
+		if b&0x80 == 0 {
+			break
+		}
+	}
+
+	p = &packet{typ: header >> 4, flags: header & 0x0f, body: make([]byte, length)}
+
+	_, err = io.ReadFull(r, p.body)
+	if err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// encode encodes the packet including its fixed header.
+func (p *packet) encode() ([]byte, error) {
+	length := len(p.body)
+	if length > maxRemainingLength {
+		return nil, fmt.Errorf("%w: packet too large", ErrMalformedPacket)
+	}
+
+	b := []byte{p.typ<<4 | p.flags}
+	for {
+		digit := byte(length % 128)
+		length /= 128
+		if length > 0 {
+			digit |= 0x80
+		}
+
+		b = append(b, digit)
+		if length == 0 {
+			break
+		}
+	}
+
+	return append(b, p.body...), nil
+}
+
+// appendString appends a length-prefixed UTF-8 string.
+func appendString(b []byte, s string) []byte {
+	b = binary.BigEndian.AppendUint16(b, uint16(len(s)))
+	return append(b, s...)
+}
+
+// readString reads a length-prefixed UTF-8 string from b and returns the rest of b.
+func readString(b []byte) (s string, rest []byte, err error) {
+	if len(b) < 2 {
+		return "", nil, ErrMalformedPacket
+	}
+
+	n := int(binary.BigEndian.Uint16(b))
+	if len(b) < 2+n {
+		return "", nil, ErrMalformedPacket
+	}
+
+	return string(b[2 : 2+n]), b[2+n:], nil
+}
+
+// readPacketID reads the packet identifier from b and returns the rest of b.
+func readPacketID(b []byte) (id uint16, rest []byte, err error) {
+	if len(b) < 2 {
+		return 0, nil, ErrMalformedPacket
+	}
+
+	return binary.BigEndian.Uint16(b), b[2:], nil
+}
+
+// connectPacket builds a CONNECT packet. A clean session is always requested.
+func connectPacket(clientID string, username string, password string, keepAlive uint16) *packet {
+	var flags byte = 0x02
+
+	body := appendString(nil, "MQTT")
+	body = append(body, 4) // protocol level 3.1.1
+
+	if username != "" {
+		flags |= 0x80
+	}
+	if password != "" {
+		flags |= 0x40
+	}
+
+	body = append(body, flags)
+	body = binary.BigEndian.AppendUint16(body, keepAlive)
+	body = appendString(body, clientID)
+
+	if username != "" {
+		body = appendString(body, username)
+	}
+	if password != "" {
+		body = appendString(body, password)
+	}
+
+	return &packet{typ: packetConnect, body: body}
+}
+
+// publishPacket builds a PUBLISH packet. The packet identifier is only included for QoS > 0.
+func publishPacket(topic string, qos byte, id uint16, payload []byte) *packet {
+	body := appendString(nil, topic)
+	if qos > 0 {
+		body = binary.BigEndian.AppendUint16(body, id)
+	}
+
+	return &packet{typ: packetPublish, flags: qos << 1, body: append(body, payload...)}
+}
+
+// subscribePacket builds a SUBSCRIBE packet for a single topic filter.
+func subscribePacket(id uint16, filter string, qos byte) *packet {
+	body := binary.BigEndian.AppendUint16(nil, id)
+	body = appendString(body, filter)
+
+	return &packet{typ: packetSubscribe, flags: 0x02, body: append(body, qos)}
+}
+
+// pubackPacket builds a PUBACK packet.
+func pubackPacket(id uint16) *packet {
+	return &packet{typ: packetPuback, body: binary.BigEndian.AppendUint16(nil, id)}
+}
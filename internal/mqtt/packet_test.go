@@ -0,0 +1,142 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package mqtt
+
+import (
+	"bufio"
+	"bytes"
+	"testing"
+
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+)
+
+func Test_packet_encode(t *testing.T) {
+	tests := []struct {
+		name    string
+		length  int
+		want    []byte
+		wantErr assert.WantErr
+	}{
+		{
+			name:    "one byte",
+			length:  127,
+			want:    []byte{0x30, 0x7f},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name:    "two bytes",
+			length:  128,
+			want:    []byte{0x30, 0x80, 0x01},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name:    "four bytes",
+			length:  2097152,
+			want:    []byte{0x30, 0x80, 0x80, 0x80, 0x01},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name:   "too large",
+			length: maxRemainingLength + 1,
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, ErrMalformedPacket)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &packet{typ: packetPublish, body: make([]byte, tt.length)}
+
+			got, err := p.encode()
+			tt.wantErr(t, err)
+
+			if tt.want != nil {
+				assert.Equal(t, tt.want, got[:len(tt.want)])
+				assert.Equal(t, len(tt.want)+tt.length, len(got))
+
+				// Decoding must yield the original packet
+				decoded, err := readPacket(bufio.NewReader(bytes.NewReader(got)))
+				assert.NoError(t, err)
+				assert.Equal(t, packetPublish, decoded.typ)
+				assert.Equal(t, tt.length, len(decoded.body))
+			}
+		})
+	}
+}
+
+func Test_readPacket(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    []byte
+		want    *packet
+		wantErr assert.WantErr
+	}{
+		{
+			name:    "publish with flags",
+			data:    []byte{0x32, 0x02, 0xaa, 0xbb},
+			want:    &packet{typ: packetPublish, flags: 0x02, body: []byte{0xaa, 0xbb}},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "remaining length too long",
+			data: []byte{0x30, 0x80, 0x80, 0x80, 0x80, 0x01},
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, ErrMalformedPacket)
+			},
+		},
+		{
+			name: "truncated body",
+			data: []byte{0x30, 0x05, 0x01},
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.Error(t, err)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := readPacket(bufio.NewReader(bytes.NewReader(tt.data)))
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got, assert.CompareAllUnexported())
+		})
+	}
+}
+
+func Test_connectPacket(t *testing.T) {
+	p := connectPacket("edge-1", "user", "pass", 30)
+
+	want := []byte{
+		0x00, 0x04, 'M', 'Q', 'T', 'T', // protocol name
+		0x04,       // protocol level
+		0xc2,       // flags: username, password, clean session
+		0x00, 0x1e, // keep alive
+		0x00, 0x06, 'e', 'd', 'g', 'e', '-', '1',
+		0x00, 0x04, 'u', 's', 'e', 'r',
+		0x00, 0x04, 'p', 'a', 's', 's',
+	}
+
+	assert.Equal(t, packetConnect, p.typ)
+	assert.Equal(t, want, p.body)
+}
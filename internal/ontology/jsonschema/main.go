@@ -0,0 +1,54 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+// Command jsonschema exports the ontology as JSON Schema into the file given as its argument. It is run by go
+// generate after the ontology was generated.
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"clouditor.io/clouditor/v2/api/ontology"
+)
+
+func main() {
+	if len(os.Args) != 2 {
+		fmt.Fprintln(os.Stderr, "Usage: jsonschema <output file>")
+		os.Exit(1)
+	}
+
+	b, err := ontology.JSONSchema()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Could not export ontology as JSON Schema: %v\n", err)
+		os.Exit(1)
+	}
+
+	err = os.WriteFile(os.Args[1], append(b, '\n'), 0644)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Could not write JSON Schema: %v\n", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,217 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+// Package oscal contains a (partial) model of the Open Security Controls Assessment Language (OSCAL) in its JSON
+// representation. It only contains the parts of the catalog, profile and assessment-results models that are needed to
+// exchange catalogs and assessment results with other tools.
+package oscal
+
+import "time"
+
+const (
+	// Version is the OSCAL version that we produce.
+	Version = "1.1.2"
+
+	// ClouditorNamespace is the namespace used for Clouditor-specific properties, e.g., the mapping of controls to
+	// metrics.
+	ClouditorNamespace = "https://clouditor.io/ns/oscal"
+
+	// PropertyMetric is the name of the property that maps a control to a Clouditor metric.
+	PropertyMetric = "metric"
+
+	// PropertyAssuranceLevel is the name of the property that contains the assurance level of a control.
+	PropertyAssuranceLevel = "assurance-level"
+
+	// PropertyResourceID is the name of the property that contains the ID of an assessed resource.
+	PropertyResourceID = "resource-id"
+
+	// PropertyCompliant is the name of the property that contains the compliance status of an assessment result.
+	PropertyCompliant = "compliant"
+
+	// StateSatisfied is the state of an objective that is satisfied.
+	StateSatisfied = "satisfied"
+
+	// StateNotSatisfied is the state of an objective that is not satisfied.
+	StateNotSatisfied = "not-satisfied"
+)
+
+// Document is the top-level JSON object of an OSCAL document. Exactly one of its fields is set.
+type Document struct {
+	Catalog           *Catalog           `json:"catalog,omitempty"`
+	Profile           *Profile           `json:"profile,omitempty"`
+	AssessmentResults *AssessmentResults `json:"assessment-results,omitempty"`
+}
+
+// Metadata contains the meta-data of an OSCAL document.
+type Metadata struct {
+	Title        string    `json:"title"`
+	LastModified time.Time `json:"last-modified"`
+	Version      string    `json:"version"`
+	OscalVersion string    `json:"oscal-version"`
+	Remarks      string    `json:"remarks,omitempty"`
+}
+
+// Property is a name/value pair with an optional namespace.
+type Property struct {
+	Name  string `json:"name"`
+	Ns    string `json:"ns,omitempty"`
+	Value string `json:"value"`
+}
+
+// Part is a (possibly nested) textual part of a control or group, e.g., its statement.
+type Part struct {
+	ID    string `json:"id,omitempty"`
+	Name  string `json:"name"`
+	Prose string `json:"prose,omitempty"`
+	Parts []Part `json:"parts,omitempty"`
+}
+
+// Catalog is an OSCAL catalog, containing controls, which are optionally organized in groups.
+type Catalog struct {
+	UUID     string    `json:"uuid"`
+	Metadata Metadata  `json:"metadata"`
+	Groups   []Group   `json:"groups,omitempty"`
+	Controls []Control `json:"controls,omitempty"`
+}
+
+// Group is a group of controls in an OSCAL catalog.
+type Group struct {
+	ID       string    `json:"id,omitempty"`
+	Title    string    `json:"title"`
+	Parts    []Part    `json:"parts,omitempty"`
+	Groups   []Group   `json:"groups,omitempty"`
+	Controls []Control `json:"controls,omitempty"`
+}
+
+// Control is a control in an OSCAL catalog. Controls can contain control enhancements, modelled as sub-controls.
+type Control struct {
+	ID       string     `json:"id"`
+	Class    string     `json:"class,omitempty"`
+	Title    string     `json:"title"`
+	Props    []Property `json:"props,omitempty"`
+	Parts    []Part     `json:"parts,omitempty"`
+	Controls []Control  `json:"controls,omitempty"`
+}
+
+// Profile is an OSCAL profile, which selects controls out of one or more catalogs.
+type Profile struct {
+	UUID     string   `json:"uuid"`
+	Metadata Metadata `json:"metadata"`
+	Imports  []Import `json:"imports"`
+}
+
+// Import selects controls out of an imported catalog or profile.
+type Import struct {
+	Href            string              `json:"href"`
+	IncludeAll      *struct{}           `json:"include-all,omitempty"`
+	IncludeControls []SelectControlByID `json:"include-controls,omitempty"`
+	ExcludeControls []SelectControlByID `json:"exclude-controls,omitempty"`
+}
+
+// SelectControlByID selects controls by their ID.
+type SelectControlByID struct {
+	WithChildControls string   `json:"with-child-controls,omitempty"`
+	WithIDs           []string `json:"with-ids,omitempty"`
+}
+
+// AssessmentResults contains the results of one or more assessments.
+type AssessmentResults struct {
+	UUID     string   `json:"uuid"`
+	Metadata Metadata `json:"metadata"`
+	ImportAP ImportAP `json:"import-ap"`
+	Results  []Result `json:"results"`
+}
+
+// ImportAP references the assessment plan that the assessment results belong to.
+type ImportAP struct {
+	Href string `json:"href"`
+}
+
+// Result contains the observations and findings of a single assessment.
+type Result struct {
+	UUID             string           `json:"uuid"`
+	Title            string           `json:"title"`
+	Description      string           `json:"description"`
+	Start            time.Time        `json:"start"`
+	End              *time.Time       `json:"end,omitempty"`
+	Props            []Property       `json:"props,omitempty"`
+	ReviewedControls ReviewedControls `json:"reviewed-controls"`
+	Observations     []Observation    `json:"observations,omitempty"`
+	Findings         []Finding        `json:"findings,omitempty"`
+}
+
+// ReviewedControls specifies the controls that were reviewed in an assessment.
+type ReviewedControls struct {
+	ControlSelections []ControlSelection `json:"control-selections"`
+}
+
+// ControlSelection selects controls that were reviewed.
+type ControlSelection struct {
+	IncludeAll      *struct{}           `json:"include-all,omitempty"`
+	IncludeControls []SelectControlByID `json:"include-controls,omitempty"`
+}
+
+// Observation describes an individual observation made during an assessment, e.g., an assessment result.
+type Observation struct {
+	UUID             string             `json:"uuid"`
+	Title            string             `json:"title,omitempty"`
+	Description      string             `json:"description"`
+	Props            []Property         `json:"props,omitempty"`
+	Methods          []string           `json:"methods"`
+	RelevantEvidence []RelevantEvidence `json:"relevant-evidence,omitempty"`
+	Collected        time.Time          `json:"collected"`
+}
+
+// RelevantEvidence links an observation to the evidence it is based on.
+type RelevantEvidence struct {
+	Href        string `json:"href,omitempty"`
+	Description string `json:"description"`
+}
+
+// Finding describes the conclusion about a control (objective) based on a set of observations.
+type Finding struct {
+	UUID                string               `json:"uuid"`
+	Title               string               `json:"title"`
+	Description         string               `json:"description"`
+	Target              FindingTarget        `json:"target"`
+	RelatedObservations []RelatedObservation `json:"related-observations,omitempty"`
+}
+
+// FindingTarget is the control or objective a finding is about.
+type FindingTarget struct {
+	Type     string          `json:"type"`
+	TargetID string          `json:"target-id"`
+	Status   ObjectiveStatus `json:"status"`
+}
+
+// ObjectiveStatus is the status of a control objective, e.g., satisfied.
+type ObjectiveStatus struct {
+	State string `json:"state"`
+}
+
+// RelatedObservation references an observation by its UUID.
+type RelatedObservation struct {
+	ObservationUUID string `json:"observation-uuid"`
+}
@@ -0,0 +1,200 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+// Package pseudonym pseudonymizes identifying information, i.e., resource IDs and names, IP addresses and account IDs,
+// in reports and exports that are shared outside the organization. Pseudonyms are derived from the original value with
+// a keyed hash (HMAC-SHA256) using a per-deployment salt. Thus, the same value always results in the same pseudonym,
+// so that the references within an export (and across exports of the same deployment) are kept intact, but the
+// original value cannot be recovered without the salt.
+package pseudonym
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/netip"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// Field is a kind of identifying information that can be pseudonymized.
+type Field string
+
+const (
+	// FieldResourceID pseudonymizes the IDs of resources as a whole. If it is not enabled, only the IP addresses and
+	// account IDs contained in a resource ID are pseudonymized (if enabled).
+	FieldResourceID Field = "resource-id"
+
+	// FieldResourceName pseudonymizes the names of resources.
+	FieldResourceName Field = "resource-name"
+
+	// FieldIP pseudonymizes IPv4 and IPv6 addresses.
+	FieldIP Field = "ip"
+
+	// FieldAccountID pseudonymizes account IDs, i.e., AWS account IDs and Azure subscription IDs.
+	FieldAccountID Field = "account-id"
+)
+
+// pseudonymLength is the number of hex characters of the hash that are part of a pseudonym.
+const pseudonymLength = 12
+
+// AllFields contains all fields that can be pseudonymized.
+var AllFields = []Field{FieldResourceID, FieldResourceName, FieldIP, FieldAccountID}
+
+var (
+	// ErrUnknownField indicates that a field cannot be pseudonymized.
+	ErrUnknownField = errors.New("unknown pseudonymization field")
+
+	// ErrMissingSalt indicates that no salt was specified.
+	ErrMissingSalt = errors.New("pseudonymization salt is missing")
+
+	// ErrNotConfigured indicates that a pseudonymized export was requested, but pseudonymization is not configured.
+	ErrNotConfigured = errors.New("pseudonymization is not configured")
+)
+
+var (
+	// ipCandidate matches strings that could be an IPv4 or IPv6 address. The candidates are validated with
+	// [netip.ParseAddr].
+	ipCandidate = regexp.MustCompile(`[0-9A-Fa-f:.]*[:.][0-9A-Fa-f:.]*`)
+
+	// awsAccount matches the account ID in an AWS ARN, e.g., arn:aws:ec2:eu-central-1:123456789012:instance/i-1.
+	awsAccount = regexp.MustCompile(`(arn:[^:\s]+:[^:\s]*:[^:\s]*:)(\d{12})`)
+
+	// azureSubscription matches the subscription ID in an Azure resource ID, e.g.,
+	// /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg.
+	azureSubscription = regexp.MustCompile(`(?i)(/subscriptions/)([0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12})`)
+)
+
+// Pseudonymizer replaces identifying information with pseudonyms. A nil Pseudonymizer leaves all values as they are.
+type Pseudonymizer struct {
+	salt   []byte
+	fields []Field
+}
+
+// ParseFields parses the names of fields, e.g., from a configuration flag.
+func ParseFields(names []string) (fields []Field, err error) {
+	for _, name := range names {
+		f := Field(strings.TrimSpace(name))
+		if !slices.Contains(AllFields, f) {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownField, name)
+		}
+
+		fields = append(fields, f)
+	}
+
+	return
+}
+
+// New creates a new [Pseudonymizer] that pseudonymizes the given fields with the salt. If no fields are specified,
+// all fields are pseudonymized.
+func New(salt string, fields ...Field) (p *Pseudonymizer, err error) {
+	if salt == "" {
+		return nil, ErrMissingSalt
+	}
+
+	for _, f := range fields {
+		if !slices.Contains(AllFields, f) {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownField, f)
+		}
+	}
+
+	if len(fields) == 0 {
+		fields = AllFields
+	}
+
+	return &Pseudonymizer{salt: []byte(salt), fields: fields}, nil
+}
+
+// Enabled returns true, if the field is pseudonymized.
+func (p *Pseudonymizer) Enabled(f Field) bool {
+	return p != nil && slices.Contains(p.fields, f)
+}
+
+// Pseudonym returns the pseudonym of the value, consisting of the field as prefix and the (shortened) keyed hash of
+// the value. Empty values are returned as they are.
+func (p *Pseudonymizer) Pseudonym(f Field, value string) string {
+	if value == "" {
+		return value
+	}
+
+	mac := hmac.New(sha256.New, p.salt)
+	mac.Write([]byte(string(f) + ":" + value))
+
+	return fmt.Sprintf("%s-%s", f, hex.EncodeToString(mac.Sum(nil))[:pseudonymLength])
+}
+
+// ResourceID pseudonymizes the ID of a resource. If resource IDs are pseudonymized, the whole ID is replaced.
+// Otherwise, the IP addresses and account IDs contained in the ID are replaced according to [Pseudonymizer.Text].
+func (p *Pseudonymizer) ResourceID(id string) string {
+	if p.Enabled(FieldResourceID) {
+		return p.Pseudonym(FieldResourceID, id)
+	}
+
+	return p.Text(id)
+}
+
+// ResourceName pseudonymizes the name of a resource, if resource names are pseudonymized.
+func (p *Pseudonymizer) ResourceName(name string) string {
+	if p.Enabled(FieldResourceName) {
+		return p.Pseudonym(FieldResourceName, name)
+	}
+
+	return p.Text(name)
+}
+
+// Text replaces the IP addresses and account IDs contained in a free text, e.g., a message or a justification, if
+// they are pseudonymized.
+func (p *Pseudonymizer) Text(s string) string {
+	if p.Enabled(FieldAccountID) {
+		s = awsAccount.ReplaceAllStringFunc(s, func(match string) string {
+			m := awsAccount.FindStringSubmatch(match)
+			return m[1] + p.Pseudonym(FieldAccountID, m[2])
+		})
+		s = azureSubscription.ReplaceAllStringFunc(s, func(match string) string {
+			m := azureSubscription.FindStringSubmatch(match)
+			return m[1] + p.Pseudonym(FieldAccountID, strings.ToLower(m[2]))
+		})
+	}
+
+	if p.Enabled(FieldIP) {
+		s = ipCandidate.ReplaceAllStringFunc(s, func(match string) string {
+			if addr, err := netip.ParseAddr(match); err == nil {
+				return p.Pseudonym(FieldIP, addr.String())
+			}
+
+			// Peer addresses usually contain a port, which we keep
+			if ap, err := netip.ParseAddrPort(match); err == nil {
+				return fmt.Sprintf("%s:%d", p.Pseudonym(FieldIP, ap.Addr().String()), ap.Port())
+			}
+
+			return match
+		})
+	}
+
+	return s
+}
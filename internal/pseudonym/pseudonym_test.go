@@ -0,0 +1,211 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package pseudonym
+
+import (
+	"strings"
+	"testing"
+
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+)
+
+const mockSalt = "my-salt"
+
+func TestParseFields(t *testing.T) {
+	tests := []struct {
+		name    string
+		names   []string
+		want    []Field
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name:    "valid fields",
+			names:   []string{"ip", " account-id"},
+			want:    []Field{FieldIP, FieldAccountID},
+			wantErr: assert.NoError,
+		},
+		{
+			name:  "unknown field",
+			names: []string{"ip", "email"},
+			want:  nil,
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorIs(t, err, ErrUnknownField)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseFields(tt.names)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNew(t *testing.T) {
+	type args struct {
+		salt   string
+		fields []Field
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    assert.Want[*Pseudonymizer]
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "missing salt",
+			args: args{},
+			want: assert.Nil[*Pseudonymizer],
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorIs(t, err, ErrMissingSalt)
+			},
+		},
+		{
+			name: "unknown field",
+			args: args{salt: mockSalt, fields: []Field{"email"}},
+			want: assert.Nil[*Pseudonymizer],
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorIs(t, err, ErrUnknownField)
+			},
+		},
+		{
+			name: "all fields by default",
+			args: args{salt: mockSalt},
+			want: func(t *testing.T, got *Pseudonymizer) bool {
+				return assert.Equal(t, AllFields, got.fields)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "selected fields",
+			args: args{salt: mockSalt, fields: []Field{FieldIP}},
+			want: func(t *testing.T, got *Pseudonymizer) bool {
+				return assert.True(t, got.Enabled(FieldIP)) && assert.False(t, got.Enabled(FieldResourceName))
+			},
+			wantErr: assert.NoError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := New(tt.args.salt, tt.args.fields...)
+			tt.wantErr(t, err)
+			tt.want(t, got)
+		})
+	}
+}
+
+func TestPseudonymizer_Pseudonym(t *testing.T) {
+	p, _ := New(mockSalt)
+	other, _ := New("other-salt")
+
+	got := p.Pseudonym(FieldResourceName, "my-vm")
+	assert.True(t, strings.HasPrefix(got, "resource-name-"))
+	assert.Equal(t, len("resource-name-")+pseudonymLength, len(got))
+
+	// The same value results in the same pseudonym, but not across salts or fields
+	assert.Equal(t, got, p.Pseudonym(FieldResourceName, "my-vm"))
+	assert.NotEqual(t, got, other.Pseudonym(FieldResourceName, "my-vm"))
+	assert.NotEqual(t, strings.TrimPrefix(got, "resource-name-"), strings.TrimPrefix(p.Pseudonym(FieldResourceID, "my-vm"), "resource-id-"))
+
+	// Empty values stay empty
+	assert.Equal(t, "", p.Pseudonym(FieldResourceName, ""))
+}
+
+func TestPseudonymizer_Text(t *testing.T) {
+	var (
+		all, _    = New(mockSalt)
+		onlyIP, _ = New(mockSalt, FieldIP)
+	)
+
+	tests := []struct {
+		name string
+		p    *Pseudonymizer
+		s    string
+		want string
+	}{
+		{
+			name: "nil pseudonymizer",
+			p:    nil,
+			s:    "Call from 10.0.0.1",
+			want: "Call from 10.0.0.1",
+		},
+		{
+			name: "IPv4 and IPv6 addresses",
+			p:    all,
+			s:    "Call from 10.0.0.1 and 2001:db8::1",
+			want: "Call from " + all.Pseudonym(FieldIP, "10.0.0.1") + " and " + all.Pseudonym(FieldIP, "2001:db8::1"),
+		},
+		{
+			name: "address with port",
+			p:    all,
+			s:    "10.0.0.1:443",
+			want: all.Pseudonym(FieldIP, "10.0.0.1") + ":443",
+		},
+		{
+			name: "no address",
+			p:    all,
+			s:    "Version 1.2 at 12:30",
+			want: "Version 1.2 at 12:30",
+		},
+		{
+			name: "AWS account ID",
+			p:    all,
+			s:    "arn:aws:ec2:eu-central-1:123456789012:instance/i-1",
+			want: "arn:aws:ec2:eu-central-1:" + all.Pseudonym(FieldAccountID, "123456789012") + ":instance/i-1",
+		},
+		{
+			name: "Azure subscription ID",
+			p:    all,
+			s:    "/subscriptions/00000000-0000-0000-0000-00000000000A/resourceGroups/rg",
+			want: "/subscriptions/" + all.Pseudonym(FieldAccountID, "00000000-0000-0000-0000-00000000000a") + "/resourceGroups/rg",
+		},
+		{
+			name: "account ID not enabled",
+			p:    onlyIP,
+			s:    "arn:aws:ec2:eu-central-1:123456789012:instance/i-1",
+			want: "arn:aws:ec2:eu-central-1:123456789012:instance/i-1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.p.Text(tt.s))
+		})
+	}
+}
+
+func TestPseudonymizer_ResourceID(t *testing.T) {
+	var (
+		all, _         = New(mockSalt)
+		onlyAccount, _ = New(mockSalt, FieldAccountID)
+		id             = "arn:aws:ec2:eu-central-1:123456789012:instance/i-1"
+	)
+
+	assert.Equal(t, all.Pseudonym(FieldResourceID, id), all.ResourceID(id))
+	assert.Equal(t, "arn:aws:ec2:eu-central-1:"+onlyAccount.Pseudonym(FieldAccountID, "123456789012")+":instance/i-1", onlyAccount.ResourceID(id))
+	assert.Equal(t, "my-vm", onlyAccount.ResourceName("my-vm"))
+	assert.Equal(t, all.Pseudonym(FieldResourceName, "my-vm"), all.ResourceName("my-vm"))
+}
@@ -0,0 +1,156 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package report
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// The layout of a PDF page (A4, in points). We use the standard font Courier, so that every character has the same
+// width and we can lay out the text without any font metrics.
+const (
+	pageWidth    = 595
+	pageHeight   = 842
+	pageMargin   = 50
+	fontSize     = 9
+	lineHeight   = 12
+	charsPerLine = (pageWidth - 2*pageMargin) * 10 / (fontSize * 6)
+	linesPerPage = (pageHeight - 2*pageMargin) / lineHeight
+)
+
+// pdfWriter writes the objects of a PDF document and keeps track of their offsets for the cross-reference table.
+type pdfWriter struct {
+	buf     bytes.Buffer
+	offsets []int
+}
+
+// object writes the next object of the document. Objects are numbered in the order they are written, starting with 1.
+func (p *pdfWriter) object(content string) {
+	p.offsets = append(p.offsets, p.buf.Len())
+	fmt.Fprintf(&p.buf, "%d 0 obj\n%s\nendobj\n", len(p.offsets), content)
+}
+
+// writePDF writes the text as a (plain text) PDF document with the given title. Lines that are too long for a page
+// are wrapped and the text is split into as many pages as needed.
+func writePDF(w io.Writer, title string, text string) (err error) {
+	var (
+		p     pdfWriter
+		pages = paginate(wrapLines(text))
+		kids  []string
+	)
+
+	// The object numbers are fixed: 1 is the catalog, 2 the page tree, 3 the font, 4 the info dictionary and each
+	// page consists of its page object followed by its content stream.
+	for i := range pages {
+		kids = append(kids, fmt.Sprintf("%d 0 R", 5+2*i))
+	}
+
+	p.buf.WriteString("%PDF-1.4\n")
+	p.object("<< /Type /Catalog /Pages 2 0 R >>")
+	p.object(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(pages)))
+	p.object("<< /Type /Font /Subtype /Type1 /BaseFont /Courier /Encoding /WinAnsiEncoding >>")
+	p.object(fmt.Sprintf("<< /Title (%s) /Producer (Clouditor) >>", escapePDF(title)))
+
+	for i, lines := range pages {
+		var content strings.Builder
+
+		fmt.Fprintf(&content, "BT\n/F1 %d Tf\n%d TL\n%d %d Td\n", fontSize, lineHeight, pageMargin, pageHeight-pageMargin)
+		for _, line := range lines {
+			fmt.Fprintf(&content, "(%s) Tj T*\n", escapePDF(line))
+		}
+		content.WriteString("ET")
+
+		p.object(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %d %d] /Resources << /Font << /F1 3 0 R >> >> /Contents %d 0 R >>",
+			pageWidth, pageHeight, 6+2*i))
+		p.object(fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", content.Len(), content.String()))
+	}
+
+	// Write the cross-reference table and the trailer
+	xref := p.buf.Len()
+	fmt.Fprintf(&p.buf, "xref\n0 %d\n0000000000 65535 f \n", len(p.offsets)+1)
+	for _, offset := range p.offsets {
+		fmt.Fprintf(&p.buf, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&p.buf, "trailer\n<< /Size %d /Root 1 0 R /Info 4 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(p.offsets)+1, xref)
+
+	_, err = p.buf.WriteTo(w)
+	return err
+}
+
+// wrapLines splits the text into lines that fit onto a page.
+func wrapLines(text string) (lines []string) {
+	scanner := bufio.NewScanner(strings.NewReader(text))
+	for scanner.Scan() {
+		line := []rune(scanner.Text())
+
+		for len(line) > charsPerLine {
+			lines = append(lines, string(line[:charsPerLine]))
+			line = line[charsPerLine:]
+		}
+
+		lines = append(lines, string(line))
+	}
+
+	return
+}
+
+// paginate splits the lines into pages. A document always has at least one (possibly empty) page.
+func paginate(lines []string) (pages [][]string) {
+	for len(lines) > linesPerPage {
+		pages = append(pages, lines[:linesPerPage])
+		lines = lines[linesPerPage:]
+	}
+
+	return append(pages, lines)
+}
+
+// escapePDF escapes the text so that it can be used as a literal string in a PDF. Since we use the WinAnsiEncoding,
+// characters beyond Latin-1 cannot be represented and are replaced.
+func escapePDF(text string) string {
+	var b strings.Builder
+
+	for _, r := range text {
+		switch {
+		case r == '\\' || r == '(' || r == ')':
+			b.WriteRune('\\')
+			b.WriteRune(r)
+		case r < 0x20:
+			b.WriteRune(' ')
+		case r < 0x80:
+			b.WriteRune(r)
+		case r <= 0xff:
+			fmt.Fprintf(&b, "\\%03o", r)
+		default:
+			b.WriteRune('?')
+		}
+	}
+
+	return b.String()
+}
@@ -0,0 +1,231 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+// Package report renders audit-ready compliance reports of a target of evaluation. The reports are rendered from
+// templates, either as HTML or as (plain text) PDF.
+package report
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"io"
+	"slices"
+	"text/template"
+	"time"
+
+	"clouditor.io/clouditor/v2/api/evaluation"
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/internal/pseudonym"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// ContentTypeHTML is the content type of a report rendered as HTML.
+	ContentTypeHTML = "text/html; charset=utf-8"
+
+	// ContentTypePDF is the content type of a report rendered as PDF.
+	ContentTypePDF = "application/pdf"
+
+	dateLayout = "2006-01-02 15:04 MST"
+)
+
+//go:embed templates
+var templates embed.FS
+
+var funcs = map[string]any{
+	"date":        formatDate,
+	"status":      formatStatus,
+	"statusClass": statusClass,
+}
+
+var (
+	htmlTemplate = htmltemplate.Must(htmltemplate.New("report.html.tmpl").Funcs(funcs).ParseFS(templates, "templates/report.html.tmpl"))
+	textTemplate = template.Must(template.New("report.txt.tmpl").Funcs(funcs).ParseFS(templates, "templates/report.txt.tmpl"))
+)
+
+// Report contains all information that is part of a compliance report.
+type Report struct {
+	GeneratedAt        time.Time
+	CloudService       *orchestrator.CloudService
+	Catalog            *orchestrator.Catalog
+	TargetOfEvaluation *orchestrator.TargetOfEvaluation
+
+	// Controls contains the controls of the catalog (including their sub-controls) in the order of the catalog
+	Controls []*Control
+
+	// Waivers contains the waivers that were active at the time the report was generated
+	Waivers []*orchestrator.Waiver
+}
+
+// Control contains the compliance information of a single control in a report.
+type Control struct {
+	*orchestrator.Control
+
+	// Result is the latest evaluation result of the control. It is nil, if the control was not evaluated (yet).
+	Result *evaluation.EvaluationResult
+
+	// FailingResources contains the resources that caused the control to be non-compliant
+	FailingResources []*evaluation.FailingResource
+}
+
+// IsSubControl returns true, if the control is a sub-control of another control.
+func (c *Control) IsSubControl() bool {
+	return c.ParentControlId != nil
+}
+
+// Summary contains the number of controls per compliance status.
+type Summary struct {
+	Compliant    int
+	NotCompliant int
+	Pending      int
+	NotEvaluated int
+}
+
+// Summary counts the (top-level) controls of the report per compliance status.
+func (r *Report) Summary() (s Summary) {
+	for _, c := range r.Controls {
+		if c.IsSubControl() {
+			continue
+		}
+
+		switch c.Result.GetStatus() {
+		case evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT,
+			evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT_MANUALLY:
+			s.Compliant++
+		case evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT,
+			evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT_MANUALLY:
+			s.NotCompliant++
+		case evaluation.EvaluationStatus_EVALUATION_STATUS_PENDING:
+			s.Pending++
+		default:
+			s.NotEvaluated++
+		}
+	}
+
+	return
+}
+
+// EvidenceIDs returns the (sorted) IDs of all evidences that are referenced by failing resources of the report.
+func (r *Report) EvidenceIDs() (ids []string) {
+	for _, c := range r.Controls {
+		for _, res := range c.FailingResources {
+			for _, id := range res.EvidenceIds {
+				if !slices.Contains(ids, id) {
+					ids = append(ids, id)
+				}
+			}
+		}
+	}
+
+	slices.Sort(ids)
+
+	return
+}
+
+// Pseudonymize replaces the IDs of the failing and waived resources as well as the IP addresses and account IDs
+// contained in the justifications of waivers with pseudonyms, so that the report can be shared outside the
+// organization. The report is modified in place.
+func (r *Report) Pseudonymize(p *pseudonym.Pseudonymizer) {
+	for _, c := range r.Controls {
+		for _, res := range c.FailingResources {
+			res.ResourceId = p.ResourceID(res.ResourceId)
+		}
+	}
+
+	for _, w := range r.Waivers {
+		w.ResourceId = p.ResourceID(w.ResourceId)
+		w.Justification = p.Text(w.Justification)
+	}
+}
+
+// RenderHTML renders the report as HTML document.
+func RenderHTML(w io.Writer, r *Report) error {
+	return htmlTemplate.Execute(w, r)
+}
+
+// RenderPDF renders the report as PDF document. The PDF contains the report as plain text.
+func RenderPDF(w io.Writer, r *Report) (err error) {
+	var b bytes.Buffer
+
+	err = textTemplate.Execute(&b, r)
+	if err != nil {
+		return err
+	}
+
+	return writePDF(w, fmt.Sprintf("Compliance report of %s", r.CloudService.GetName()), b.String())
+}
+
+// formatDate formats a time or timestamp as date and time in UTC. It returns "-", if no time is set.
+func formatDate(t any) string {
+	switch v := t.(type) {
+	case time.Time:
+		return v.UTC().Format(dateLayout)
+	case *timestamppb.Timestamp:
+		if v == nil {
+			return "-"
+		}
+
+		return v.AsTime().UTC().Format(dateLayout)
+	default:
+		return "-"
+	}
+}
+
+// formatStatus returns a human-readable representation of the evaluation status of a control.
+func formatStatus(result *evaluation.EvaluationResult) string {
+	switch result.GetStatus() {
+	case evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT:
+		return "Compliant"
+	case evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT_MANUALLY:
+		return "Compliant (manually)"
+	case evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT:
+		return "Not compliant"
+	case evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT_MANUALLY:
+		return "Not compliant (manually)"
+	case evaluation.EvaluationStatus_EVALUATION_STATUS_PENDING:
+		return "Pending"
+	case evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_APPLICABLE:
+		return "Not applicable"
+	default:
+		return "Not evaluated"
+	}
+}
+
+// statusClass returns the CSS class of the evaluation status of a control.
+func statusClass(result *evaluation.EvaluationResult) string {
+	switch result.GetStatus() {
+	case evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT,
+		evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT_MANUALLY:
+		return "status-compliant"
+	case evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT,
+		evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT_MANUALLY:
+		return "status-not-compliant"
+	default:
+		return ""
+	}
+}
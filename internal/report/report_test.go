@@ -0,0 +1,175 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package report
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"clouditor.io/clouditor/v2/api/evaluation"
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/internal/pseudonym"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/util"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func newReport() *Report {
+	return &Report{
+		GeneratedAt: time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+		CloudService: &orchestrator.CloudService{
+			Id:   testdata.MockCloudServiceID1,
+			Name: testdata.MockCloudServiceName1,
+		},
+		Catalog: &orchestrator.Catalog{
+			Id:   testdata.MockCatalogID,
+			Name: testdata.MockCatalogName,
+		},
+		TargetOfEvaluation: &orchestrator.TargetOfEvaluation{
+			CloudServiceId: testdata.MockCloudServiceID1,
+			CatalogId:      testdata.MockCatalogID,
+			AssuranceLevel: &testdata.AssuranceLevelBasic,
+		},
+		Controls: []*Control{
+			{
+				Control: &orchestrator.Control{Id: testdata.MockControlID1, Name: "<Control 1>", CategoryName: testdata.MockCategoryName},
+				Result: &evaluation.EvaluationResult{
+					Status:    evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT,
+					Timestamp: timestamppb.New(time.Date(2024, 1, 1, 11, 0, 0, 0, time.UTC)),
+				},
+				FailingResources: []*evaluation.FailingResource{{
+					ResourceId:  testdata.MockResourceID1,
+					MetricIds:   []string{testdata.MockMetricID1},
+					EvidenceIds: []string{testdata.MockEvidenceID2, testdata.MockEvidenceID1},
+				}},
+			},
+			{
+				Control: &orchestrator.Control{
+					Id:              testdata.MockSubControlID11,
+					CategoryName:    testdata.MockCategoryName,
+					ParentControlId: util.Ref(testdata.MockControlID1),
+				},
+				Result: &evaluation.EvaluationResult{Status: evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT},
+				FailingResources: []*evaluation.FailingResource{{
+					ResourceId:  testdata.MockResourceID1,
+					EvidenceIds: []string{testdata.MockEvidenceID1},
+				}},
+			},
+			{
+				Control: &orchestrator.Control{Id: testdata.MockControlID2, CategoryName: testdata.MockCategoryName},
+				Result:  &evaluation.EvaluationResult{Status: evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT_MANUALLY},
+			},
+			{
+				Control: &orchestrator.Control{Id: testdata.MockControlID5, CategoryName: testdata.MockCategoryName},
+			},
+		},
+		Waivers: []*orchestrator.Waiver{{
+			ResourceId:    testdata.MockResourceID2,
+			MetricId:      testdata.MockMetricID2,
+			Justification: "accepted risk",
+			Approver:      "auditor",
+			ExpiresAt:     timestamppb.New(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)),
+		}},
+	}
+}
+
+func TestReport_Summary(t *testing.T) {
+	got := newReport().Summary()
+	assert.Equal(t, Summary{Compliant: 1, NotCompliant: 1, NotEvaluated: 1}, got)
+}
+
+func TestReport_EvidenceIDs(t *testing.T) {
+	got := newReport().EvidenceIDs()
+	assert.Equal(t, []string{testdata.MockEvidenceID1, testdata.MockEvidenceID2}, got)
+}
+
+func TestReport_Pseudonymize(t *testing.T) {
+	p, _ := pseudonym.New("my-salt")
+
+	r := newReport()
+	r.Waivers[0].Justification = "only reachable from 10.0.0.1"
+	r.Pseudonymize(p)
+
+	assert.Equal(t, p.Pseudonym(pseudonym.FieldResourceID, testdata.MockResourceID1), r.Controls[0].FailingResources[0].ResourceId)
+	assert.Equal(t, r.Controls[0].FailingResources[0].ResourceId, r.Controls[1].FailingResources[0].ResourceId)
+	assert.Equal(t, p.Pseudonym(pseudonym.FieldResourceID, testdata.MockResourceID2), r.Waivers[0].ResourceId)
+	assert.Equal(t, "only reachable from "+p.Pseudonym(pseudonym.FieldIP, "10.0.0.1"), r.Waivers[0].Justification)
+}
+
+func TestRenderHTML(t *testing.T) {
+	var b bytes.Buffer
+
+	err := RenderHTML(&b, newReport())
+	assert.NoError(t, err)
+
+	html := b.String()
+	assert.Contains(t, html, "<title>Compliance report of "+testdata.MockCloudServiceName1+"</title>")
+	assert.Contains(t, html, "Generated at 2024-01-01 12:00 UTC")
+	// Names must be escaped
+	assert.Contains(t, html, "&lt;Control 1&gt;")
+	assert.Contains(t, html, `<td class="status-not-compliant">Not compliant</td>`)
+	assert.Contains(t, html, "Compliant (manually)")
+	assert.Contains(t, html, "Not evaluated")
+	assert.Contains(t, html, "accepted risk")
+	assert.Contains(t, html, "<li>"+testdata.MockEvidenceID2+"</li>")
+}
+
+func TestRenderPDF(t *testing.T) {
+	var b bytes.Buffer
+
+	err := RenderPDF(&b, newReport())
+	assert.NoError(t, err)
+
+	pdf := b.String()
+	assert.True(t, strings.HasPrefix(pdf, "%PDF-1.4\n"))
+	assert.True(t, strings.HasSuffix(pdf, "%%EOF\n"))
+	assert.Contains(t, pdf, "/Title (Compliance report of "+testdata.MockCloudServiceName1+")")
+	assert.Contains(t, pdf, "/Count 1")
+	assert.Contains(t, pdf, "(    Status: Not compliant, evaluated at 2024-01-01 11:00 UTC) Tj T*")
+	assert.Contains(t, pdf, "(      - Failing resource "+testdata.MockResourceID1+") Tj T*")
+}
+
+func Test_wrapLines(t *testing.T) {
+	got := wrapLines("short\n" + strings.Repeat("a", charsPerLine+1))
+	assert.Equal(t, []string{"short", strings.Repeat("a", charsPerLine), "a"}, got)
+}
+
+func Test_paginate(t *testing.T) {
+	assert.Equal(t, [][]string{nil}, paginate(nil))
+
+	got := paginate(make([]string, linesPerPage+1))
+	assert.Equal(t, 2, len(got))
+	assert.Equal(t, linesPerPage, len(got[0]))
+	assert.Equal(t, 1, len(got[1]))
+}
+
+func Test_escapePDF(t *testing.T) {
+	assert.Equal(t, `a \(b\) \\ c ? \344`, escapePDF("a (b) \\ c ✓ ä"))
+}
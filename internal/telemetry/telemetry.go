@@ -0,0 +1,151 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+// Package telemetry contains the Prometheus metrics and the OpenTelemetry tracing of all Clouditor services. The
+// metrics are registered in a dedicated [Registry] and can be exposed using [Handler].
+package telemetry
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Namespace is the namespace of all Clouditor metrics.
+const Namespace = "clouditor"
+
+// Registry contains all Clouditor metrics as well as the default Go and process metrics.
+var Registry = prometheus.NewRegistry()
+
+var (
+	// GRPCRequestsTotal counts the handled gRPC requests by service, method and status code.
+	GRPCRequestsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: "grpc",
+		Name:      "requests_total",
+		Help:      "Total number of gRPC requests handled by the server.",
+	}, []string{"service", "method", "code"})
+
+	// GRPCRequestDuration observes the duration of the handled gRPC requests by service and method.
+	GRPCRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: Namespace,
+		Subsystem: "grpc",
+		Name:      "request_duration_seconds",
+		Help:      "Duration of gRPC requests handled by the server.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"service", "method"})
+
+	// EvidencesAssessedTotal counts the evidences assessed by the assessment service.
+	EvidencesAssessedTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: "assessment",
+		Name:      "evidences_assessed_total",
+		Help:      "Total number of evidences assessed.",
+	})
+
+	// RegoEvalDuration observes the duration of the evaluation of a single Rego policy.
+	RegoEvalDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: Namespace,
+		Subsystem: "policies",
+		Name:      "rego_eval_duration_seconds",
+		Help:      "Duration of the evaluation of a Rego policy.",
+		Buckets:   []float64{.0001, .0005, .001, .005, .01, .05, .1, .5, 1},
+	})
+
+	// StreamReconnectsTotal counts the re-established streams to other components.
+	StreamReconnectsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: "streams",
+		Name:      "reconnects_total",
+		Help:      "Total number of re-established streams to other components.",
+	}, []string{"component"})
+
+	// StreamsConnected indicates whether the streams to other components are currently connected (1) or whether they
+	// are being re-established (0).
+	StreamsConnected = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: Namespace,
+		Subsystem: "streams",
+		Name:      "connected",
+		Help:      "Whether the stream to another component is currently connected.",
+	}, []string{"component", "target"})
+
+	// AssessmentResultsStoredTotal counts the assessment results stored by the orchestrator by compliance.
+	AssessmentResultsStoredTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: "orchestrator",
+		Name:      "assessment_results_total",
+		Help:      "Total number of assessment results stored by the orchestrator.",
+	}, []string{"compliant"})
+
+	// SubscriberEvidencesDroppedTotal counts the evidences that were not delivered to a subscriber of the evidence
+	// store, because it could not keep up with new evidences.
+	SubscriberEvidencesDroppedTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: "evidence_store",
+		Name:      "subscriber_evidences_dropped_total",
+		Help:      "Total number of evidences dropped, because the channel of a subscriber was full.",
+	})
+
+	// ComplianceRatio contains the ratio of compliant controls of the latest evaluation of a target of evaluation.
+	ComplianceRatio = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: Namespace,
+		Subsystem: "evaluation",
+		Name:      "compliance_ratio",
+		Help:      "Ratio of compliant controls of the latest evaluation of a target of evaluation.",
+	}, []string{"cloud_service_id", "catalog_id"})
+
+	// DBQueryDuration observes the duration of database queries by operation.
+	DBQueryDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: Namespace,
+		Subsystem: "db",
+		Name:      "query_duration_seconds",
+		Help:      "Duration of database queries.",
+		Buckets:   []float64{.0005, .001, .005, .01, .05, .1, .5, 1, 5},
+	}, []string{"operation"})
+)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		GRPCRequestsTotal,
+		GRPCRequestDuration,
+		EvidencesAssessedTotal,
+		RegoEvalDuration,
+		StreamReconnectsTotal,
+		StreamsConnected,
+		AssessmentResultsStoredTotal,
+		SubscriberEvidencesDroppedTotal,
+		ComplianceRatio,
+		DBQueryDuration,
+	)
+}
+
+// Handler returns an HTTP handler that exposes the metrics of the [Registry] in the Prometheus text format.
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{})
+}
@@ -0,0 +1,177 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package telemetry
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// TracerName is the name of the tracer used by all Clouditor services.
+	TracerName = "clouditor.io/clouditor/v2"
+
+	// DefaultTracingServiceName is the default service name under which the traces are exported.
+	DefaultTracingServiceName = "clouditor"
+
+	// DefaultTracingSampleRatio is the default ratio of traces that are sampled.
+	DefaultTracingSampleRatio = 1.0
+)
+
+// Attribute keys that are used to annotate the spans of Clouditor services.
+const (
+	CloudServiceIDKey     = attribute.Key("clouditor.cloud_service.id")
+	EvidenceIDKey         = attribute.Key("clouditor.evidence.id")
+	ResourceIDKey         = attribute.Key("clouditor.resource.id")
+	AssessmentResultIDKey = attribute.Key("clouditor.assessment_result.id")
+)
+
+// TracingConfig contains the configuration of the OTLP trace exporter.
+type TracingConfig struct {
+	// Endpoint is the host and port of the OTLP gRPC collector. If it is empty, the endpoint is taken from the standard
+	// OTEL_EXPORTER_OTLP_ENDPOINT or OTEL_EXPORTER_OTLP_TRACES_ENDPOINT environment variables. If neither is set, no
+	// traces are exported.
+	Endpoint string
+
+	// Insecure disables TLS for the connection to the collector.
+	Insecure bool
+
+	// ServiceName is the name of the service under which the traces are exported.
+	ServiceName string
+
+	// SampleRatio is the ratio (between 0 and 1) of traces that are sampled. Traces that are started by another
+	// service follow the sampling decision of their parent.
+	SampleRatio float64
+}
+
+// Enabled returns true, if traces are exported according to this configuration.
+func (cfg *TracingConfig) Enabled() bool {
+	return cfg.Endpoint != "" ||
+		os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT") != "" ||
+		os.Getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT") != ""
+}
+
+// InitTracing configures the global OpenTelemetry propagator and, if enabled, a global tracer provider that exports
+// the traces to an OTLP collector. The returned function flushes the remaining traces and must be called before the
+// application exits.
+func InitTracing(ctx context.Context, cfg TracingConfig) (shutdown func(context.Context) error, err error) {
+	var (
+		exporter *otlptrace.Exporter
+		res      *resource.Resource
+		opts     []otlptracegrpc.Option
+	)
+
+	// We always propagate the trace context, even if we do not export traces ourselves
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	if !cfg.Enabled() {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	if cfg.Endpoint != "" {
+		opts = append(opts, otlptracegrpc.WithEndpoint(cfg.Endpoint))
+	}
+
+	if cfg.Insecure {
+		opts = append(opts, otlptracegrpc.WithInsecure())
+	}
+
+	if cfg.ServiceName == "" {
+		cfg.ServiceName = DefaultTracingServiceName
+	}
+
+	exporter, err = otlptracegrpc.New(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("could not create OTLP trace exporter: %w", err)
+	}
+
+	res, err = resource.Merge(resource.Default(), resource.NewWithAttributes(semconv.SchemaURL,
+		semconv.ServiceName(cfg.ServiceName),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("could not create trace resource: %w", err)
+	}
+
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+	)
+	otel.SetTracerProvider(tp)
+
+	return tp.Shutdown, nil
+}
+
+// StartSpan starts a new span with the given name and attributes using the global tracer provider.
+func StartSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(TracerName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// EndSpan ends the span and marks it as failed, if err is not nil.
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
+
+// InjectTraceContext returns the trace context of ctx as a map, so that it can be embedded into a message that is sent
+// over a (long-lived) stream. It returns nil, if ctx does not contain a trace context.
+func InjectTraceContext(ctx context.Context) map[string]string {
+	var carrier = propagation.MapCarrier{}
+
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+
+	if len(carrier) == 0 {
+		return nil
+	}
+
+	return carrier
+}
+
+// ExtractTraceContext returns a copy of ctx that contains the trace context embedded in a message by
+// [InjectTraceContext]. If carrier does not contain a trace context, ctx is returned unchanged.
+func ExtractTraceContext(ctx context.Context, carrier map[string]string) context.Context {
+	if len(carrier) == 0 {
+		return ctx
+	}
+
+	return otel.GetTextMapPropagator().Extract(ctx, propagation.MapCarrier(carrier))
+}
@@ -0,0 +1,118 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package telemetry
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestInitTracing(t *testing.T) {
+	type args struct {
+		cfg TracingConfig
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr assert.WantErr
+	}{
+		{
+			name: "disabled",
+			args: args{
+				cfg: TracingConfig{},
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "enabled",
+			args: args{
+				cfg: TracingConfig{
+					Endpoint:    "localhost:4317",
+					Insecure:    true,
+					SampleRatio: 1,
+				},
+			},
+			wantErr: assert.Nil[error],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", "")
+			t.Setenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT", "")
+
+			gotShutdown, err := InitTracing(context.Background(), tt.args.cfg)
+			tt.wantErr(t, err)
+			assert.NotNil(t, gotShutdown)
+			assert.NotNil(t, otel.GetTextMapPropagator())
+
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+			_ = gotShutdown(ctx)
+		})
+	}
+}
+
+func TestTraceContext(t *testing.T) {
+	var (
+		recorder = tracetest.NewSpanRecorder()
+		tp       = sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	)
+
+	otel.SetTracerProvider(tp)
+	_, err := InitTracing(context.Background(), TracingConfig{})
+	assert.NoError(t, err)
+
+	// Without a span, there is no trace context to inject
+	assert.Nil(t, InjectTraceContext(context.Background()))
+	ctx := context.Background()
+	assert.True(t, ExtractTraceContext(ctx, nil) == ctx)
+
+	ctx, parent := StartSpan(ctx, "parent", EvidenceIDKey.String("evidence1"))
+	carrier := InjectTraceContext(ctx)
+	assert.NotNil(t, carrier)
+	parent.End()
+
+	// Continue the trace, e.g., in another service
+	ctx, child := StartSpan(ExtractTraceContext(context.Background(), carrier), "child")
+	EndSpan(child, errors.New("some error"))
+
+	assert.Equal(t, parent.SpanContext().TraceID(), trace.SpanContextFromContext(ctx).TraceID())
+
+	spans := recorder.Ended()
+	assert.Equal(t, 2, len(spans))
+	assert.Equal(t, parent.SpanContext().SpanID(), spans[1].Parent().SpanID())
+	assert.Equal(t, codes.Error, spans[1].Status().Code)
+	assert.Equal(t, "some error", spans[1].Status().Description)
+}
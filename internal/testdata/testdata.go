@@ -28,6 +28,11 @@ const (
 	MockCloudServiceName2        = "Another Mock Cloud Service"
 	MockCloudServiceDescription2 = "This is another mock cloud service"
 
+	// Organization
+	MockOrganizationID   = "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa"
+	MockOrganizationName = "Mock Organization"
+	MockOrganizationUser = "org-user"
+
 	// Catalog
 	MockCatalogID             = "Cat1234"
 	MockCatalogName           = "Mock Catalog"
@@ -105,6 +110,9 @@ const (
 	MockAssessmentResult3ID = "33333333-3333-3333-3333-333333333333"
 	MockAssessmentResult4ID = "44444444-4444-4444-4444-444444444444"
 
+	// Metric Configuration Revisions
+	MockMetricConfigurationRevisionID1 = "11111111-1111-1111-1111-111111111111"
+
 	// Evaluation Results
 	MockEvaluationResult1ID = "11111111-1111-1111-1111-111111111111"
 	MockEvaluationResult2ID = "22222222-2222-2222-2222-222222222222"
@@ -115,6 +123,12 @@ const (
 	MockEvaluationResult7ID = "77777777-7777-7777-7777-777777777777"
 	MockEvaluationResult8ID = "88888888-8888-8888-8888-888888888888"
 	MockEvaluationResult9ID = "99999999-9999-9999-9999-999999999999"
+
+	// Attestations
+	MockAttestationID1      = "11111111-1111-1111-1111-111111111111"
+	MockAttestationID2      = "22222222-2222-2222-2222-222222222222"
+	MockAttestationReviewer = "compliance-manager"
+	MockAttestationDocument = "policy.pdf"
 )
 
 var (
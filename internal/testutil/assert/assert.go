@@ -22,6 +22,7 @@ var NoError = assert.NoError
 var Error = assert.Error
 var ErrorIs = assert.ErrorIs
 var Fail = assert.Fail
+var Eventually = assert.Eventually
 var Same = assert.Same
 
 type TestingT = assert.TestingT
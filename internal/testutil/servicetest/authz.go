@@ -18,9 +18,45 @@ func NewAuthorizationStrategy(all bool, cloudServiceIDs ...string) service.Autho
 	}
 }
 
+// NewAuthorizationStrategyWithRoles contains a mock for a
+// [service.AuthorizationStrategy] like [NewAuthorizationStrategy], which
+// additionally implements [service.RoleChecker] with the given roles.
+func NewAuthorizationStrategyWithRoles(all bool, roles []string, cloudServiceIDs ...string) service.AuthorizationStrategy {
+	return &AuthorizationStrategyMock{
+		all:             all,
+		cloudServiceIDs: cloudServiceIDs,
+		roles:           roles,
+	}
+}
+
+// NewAuthorizationStrategyWithUser contains a mock for a
+// [service.AuthorizationStrategy] like [NewAuthorizationStrategy], which
+// additionally implements [service.UserIdentifier] with the given user.
+func NewAuthorizationStrategyWithUser(all bool, user string, cloudServiceIDs ...string) service.AuthorizationStrategy {
+	return &AuthorizationStrategyMock{
+		all:             all,
+		cloudServiceIDs: cloudServiceIDs,
+		user:            user,
+	}
+}
+
+// NewAuthorizationStrategyWithTags contains a mock for a
+// [service.AuthorizationStrategy] like [NewAuthorizationStrategy], which
+// additionally implements [service.TagRetriever] with the given tags.
+func NewAuthorizationStrategyWithTags(all bool, tags []string, cloudServiceIDs ...string) service.AuthorizationStrategy {
+	return &AuthorizationStrategyMock{
+		all:             all,
+		cloudServiceIDs: cloudServiceIDs,
+		tags:            tags,
+	}
+}
+
 type AuthorizationStrategyMock struct {
 	all             bool
 	cloudServiceIDs []string
+	roles           []string
+	user            string
+	tags            []string
 }
 
 func (a *AuthorizationStrategyMock) CheckAccess(ctx context.Context, _ service.RequestType, req api.CloudServiceRequest) bool {
@@ -41,3 +77,15 @@ func (a *AuthorizationStrategyMock) CheckAccess(ctx context.Context, _ service.R
 func (a *AuthorizationStrategyMock) AllowedCloudServices(_ context.Context) (all bool, IDs []string) {
 	return a.all, a.cloudServiceIDs
 }
+
+func (a *AuthorizationStrategyMock) HasRole(_ context.Context, role string) bool {
+	return slices.Contains(a.roles, role)
+}
+
+func (a *AuthorizationStrategyMock) CurrentUser(_ context.Context) (user string, ok bool) {
+	return a.user, a.user != ""
+}
+
+func (a *AuthorizationStrategyMock) AllowedTags(_ context.Context) (tags []string) {
+	return a.tags
+}
@@ -0,0 +1,156 @@
+package evidencetest
+
+import (
+	"fmt"
+	"time"
+
+	"clouditor.io/clouditor/v2/api/evidence"
+	"clouditor.io/clouditor/v2/api/ontology"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// regions contains the regions the synthesized resources are spread across
+var regions = []string{"eu-central-1", "westeurope", "us-east-1"}
+
+// NewResource synthesizes the i-th resource of a realistic cloud service. The resources cycle through virtual
+// machines, block storages, object storages, functions and network interfaces, and every third resource of a type is
+// configured in a non-compliant way, so that the evaluated metrics take both branches of their Rego code. The result
+// is deterministic for a given i, so that repeated evidences for the same i describe the same resource.
+func NewResource(i int) ontology.IsResource {
+	var (
+		id        = fmt.Sprintf("resource-%d", i)
+		name      = fmt.Sprintf("Resource %d", i)
+		compliant = (i/5)%3 != 0
+		created   = timestamppb.New(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC).Add(time.Duration(i) * time.Minute))
+		location  = &ontology.GeoLocation{Region: regions[i%len(regions)]}
+		retention = durationpb.New(90 * 24 * time.Hour)
+	)
+
+	if !compliant {
+		retention = durationpb.New(7 * 24 * time.Hour)
+	}
+
+	switch i % 5 {
+	case 0:
+		return &ontology.VirtualMachine{
+			Id:                  id,
+			Name:                name,
+			CreationTime:        created,
+			GeoLocation:         location,
+			BlockStorageIds:     []string{fmt.Sprintf("resource-%d", i+1)},
+			NetworkInterfaceIds: []string{fmt.Sprintf("resource-%d", i+4)},
+			BootLogging: &ontology.BootLogging{
+				Enabled:           compliant,
+				RetentionPeriod:   retention,
+				LoggingServiceIds: []string{},
+			},
+			OsLogging: &ontology.OSLogging{
+				Enabled:           compliant,
+				RetentionPeriod:   retention,
+				LoggingServiceIds: []string{},
+			},
+			ActivityLogging: &ontology.ActivityLogging{
+				Enabled:           true,
+				RetentionPeriod:   retention,
+				LoggingServiceIds: []string{},
+			},
+			AutomaticUpdates: &ontology.AutomaticUpdates{
+				Enabled:  compliant,
+				Interval: durationpb.New(30 * 24 * time.Hour),
+			},
+			MalwareProtection: &ontology.MalwareProtection{
+				Enabled:         compliant,
+				DaysSinceActive: durationpb.New(24 * time.Hour),
+			},
+		}
+	case 1:
+		return &ontology.BlockStorage{
+			Id:           id,
+			Name:         name,
+			CreationTime: created,
+			GeoLocation:  location,
+			AtRestEncryption: &ontology.AtRestEncryption{
+				Type: &ontology.AtRestEncryption_ManagedKeyEncryption{
+					ManagedKeyEncryption: &ontology.ManagedKeyEncryption{
+						Enabled:   compliant,
+						Algorithm: "AES256",
+					},
+				},
+			},
+		}
+	case 2:
+		return &ontology.ObjectStorage{
+			Id:           id,
+			Name:         name,
+			CreationTime: created,
+			GeoLocation:  location,
+			PublicAccess: !compliant,
+			AtRestEncryption: &ontology.AtRestEncryption{
+				Type: &ontology.AtRestEncryption_CustomerKeyEncryption{
+					CustomerKeyEncryption: &ontology.CustomerKeyEncryption{
+						Enabled:   compliant,
+						Algorithm: "AES256",
+						KeyUrl:    fmt.Sprintf("https://vault/keys/%d", i),
+					},
+				},
+			},
+		}
+	case 3:
+		return &ontology.Function{
+			Id:              id,
+			Name:            name,
+			CreationTime:    created,
+			GeoLocation:     location,
+			RuntimeLanguage: "Python",
+			RuntimeVersion:  "3.12",
+			ResourceLogging: &ontology.ResourceLogging{
+				Enabled:           compliant,
+				RetentionPeriod:   retention,
+				LoggingServiceIds: []string{},
+			},
+		}
+	default:
+		return &ontology.NetworkInterface{
+			Id:           id,
+			Name:         name,
+			CreationTime: created,
+			GeoLocation:  location,
+			AccessRestriction: &ontology.AccessRestriction{
+				Type: &ontology.AccessRestriction_L3Firewall{
+					L3Firewall: &ontology.L3Firewall{
+						Enabled: compliant,
+						Inbound: true,
+					},
+				},
+			},
+		}
+	}
+}
+
+// NewEvidence synthesizes a new evidence for the i-th resource of the given cloud service (see [NewResource]),
+// collected by the given tool.
+func NewEvidence(cloudServiceID string, toolID string, i int) (ev *evidence.Evidence, err error) {
+	var (
+		resource = NewResource(i)
+		a        *anypb.Any
+	)
+
+	a, err = anypb.New(resource)
+	if err != nil {
+		return nil, fmt.Errorf("could not wrap resource: %w", err)
+	}
+
+	return &evidence.Evidence{
+		Id:              uuid.NewString(),
+		CloudServiceId:  cloudServiceID,
+		Timestamp:       timestamppb.Now(),
+		ToolId:          toolID,
+		Resource:        a,
+		Relationships:   evidence.Relationships(resource),
+		OntologyVersion: ontology.Version,
+	}, nil
+}
@@ -0,0 +1,32 @@
+package servicetest
+
+import (
+	"context"
+
+	"clouditor.io/clouditor/v2/api"
+)
+
+// Validated wraps an RPC handler so that its request is validated before the
+// handler is invoked, like the validation interceptor of our gRPC server does.
+// This allows to test the validation of requests when calling the handler
+// directly.
+func Validated[Req api.IncomingRequest, Res any](handler func(context.Context, Req) (Res, error)) func(context.Context, Req) (Res, error) {
+	return func(ctx context.Context, req Req) (res Res, err error) {
+		if err = api.Validate(req); err != nil {
+			return
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// ValidatedStream wraps a handler of a server-streaming RPC like [Validated].
+func ValidatedStream[Req api.IncomingRequest, Stream any](handler func(Req, Stream) error) func(Req, Stream) error {
+	return func(req Req, stream Stream) error {
+		if err := api.Validate(req); err != nil {
+			return err
+		}
+
+		return handler(req, stream)
+	}
+}
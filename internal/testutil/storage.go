@@ -1,7 +1,9 @@
 package testutil
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	"clouditor.io/clouditor/v2/persistence"
 	"clouditor.io/clouditor/v2/persistence/inmemory"
@@ -26,19 +28,23 @@ func NewInMemoryStorage(t *testing.T, funcs ...func(s persistence.Storage)) (s p
 
 // StorageWithError can be used to introduce various errors in a storage operation during unit testing.
 type StorageWithError struct {
-	CreateErr error
-	SaveErr   error
-	UpdateErr error
-	GetErr    error
-	ListErr   error
-	RawErr    error
-	CountRes  int64
-	CountErr  error
-	DeleteErr error
-}
-
-func (s *StorageWithError) Create(_ any) error         { return s.CreateErr }
-func (s *StorageWithError) Save(_ any, _ ...any) error { return s.SaveErr }
+	CreateErr  error
+	SaveErr    error
+	UpdateErr  error
+	GetErr     error
+	ListErr    error
+	RawErr     error
+	CountRes   int64
+	CountErr   error
+	DeleteErr  error
+	RestoreErr error
+	PurgeErr   error
+	PingErr    error
+}
+
+func (s *StorageWithError) Create(_ any) error                 { return s.CreateErr }
+func (s *StorageWithError) CreateInBatches(_ any, _ int) error { return s.CreateErr }
+func (s *StorageWithError) Save(_ any, _ ...any) error         { return s.SaveErr }
 func (*StorageWithError) Update(_ any, _ ...any) error {
 	return nil
 }
@@ -46,10 +52,18 @@ func (s *StorageWithError) Get(_ any, _ ...any) error { return s.GetErr }
 func (s *StorageWithError) List(_ any, _ string, _ bool, _ int, _ int, _ ...any) error {
 	return s.ListErr
 }
+func (s *StorageWithError) ListAfter(_ any, _ string, _ bool, _ string, _ int, _ ...any) error {
+	return s.ListErr
+}
 func (s *StorageWithError) Raw(_ any, _ string, _ ...any) error {
 	return s.RawErr
 }
 func (s *StorageWithError) Count(_ any, _ ...any) (int64, error) {
 	return s.CountRes, s.CountErr
 }
-func (s *StorageWithError) Delete(_ any, _ ...any) error { return s.DeleteErr }
+func (s *StorageWithError) Delete(_ any, _ ...any) error  { return s.DeleteErr }
+func (s *StorageWithError) Restore(_ any, _ ...any) error { return s.RestoreErr }
+func (s *StorageWithError) Purge(_ any, _ time.Time) (int64, error) {
+	return 0, s.PurgeErr
+}
+func (s *StorageWithError) Ping(_ context.Context) error { return s.PingErr }
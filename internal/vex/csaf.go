@@ -0,0 +1,206 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package vex
+
+import (
+	"time"
+)
+
+// CSAFVersion is the version of the CSAF specification that we produce.
+const CSAFVersion = "2.0"
+
+// CSAFNamespace is the namespace of Clouditor as publisher of CSAF documents.
+const CSAFNamespace = "https://clouditor.io"
+
+// CSAF is a (partial) CSAF 2.0 document with the VEX profile ("csaf_vex"), see
+// https://docs.oasis-open.org/csaf/csaf/v2.0/os/csaf-v2.0-os.html#45-profile-5-vex.
+type CSAF struct {
+	Document        CSAFDocument        `json:"document"`
+	ProductTree     CSAFProductTree     `json:"product_tree"`
+	Vulnerabilities []CSAFVulnerability `json:"vulnerabilities"`
+}
+
+// CSAFDocument contains the document-level meta-data.
+type CSAFDocument struct {
+	Category    string        `json:"category"`
+	CSAFVersion string        `json:"csaf_version"`
+	Publisher   CSAFPublisher `json:"publisher"`
+	Title       string        `json:"title"`
+	Tracking    CSAFTracking  `json:"tracking"`
+}
+
+// CSAFPublisher is the publisher of the document.
+type CSAFPublisher struct {
+	Category  string `json:"category"`
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+}
+
+// CSAFTracking contains the tracking information of the document.
+type CSAFTracking struct {
+	ID                 string         `json:"id"`
+	Status             string         `json:"status"`
+	Version            string         `json:"version"`
+	InitialReleaseDate time.Time      `json:"initial_release_date"`
+	CurrentReleaseDate time.Time      `json:"current_release_date"`
+	RevisionHistory    []CSAFRevision `json:"revision_history"`
+}
+
+// CSAFRevision is an entry of the revision history.
+type CSAFRevision struct {
+	Date    time.Time `json:"date"`
+	Number  string    `json:"number"`
+	Summary string    `json:"summary"`
+}
+
+// CSAFProductTree contains the products referenced by the vulnerabilities.
+type CSAFProductTree struct {
+	FullProductNames []CSAFProduct `json:"full_product_names"`
+}
+
+// CSAFProduct is a single product, i.e., an assessed resource.
+type CSAFProduct struct {
+	ProductID string `json:"product_id"`
+	Name      string `json:"name"`
+}
+
+// CSAFVulnerability is a vulnerability together with the status of the products.
+type CSAFVulnerability struct {
+	CVE           string            `json:"cve,omitempty"`
+	IDs           []CSAFID          `json:"ids,omitempty"`
+	Notes         []CSAFNote        `json:"notes"`
+	ProductStatus CSAFProductStatus `json:"product_status"`
+	Flags         []CSAFFlag        `json:"flags,omitempty"`
+	Remediations  []CSAFRemediation `json:"remediations,omitempty"`
+}
+
+// CSAFID is a non-CVE identifier of a vulnerability.
+type CSAFID struct {
+	SystemName string `json:"system_name"`
+	Text       string `json:"text"`
+}
+
+// CSAFNote is a note of a vulnerability.
+type CSAFNote struct {
+	Category string `json:"category"`
+	Text     string `json:"text"`
+}
+
+// CSAFProductStatus contains the products that are (not) affected by a vulnerability.
+type CSAFProductStatus struct {
+	KnownAffected    []string `json:"known_affected,omitempty"`
+	KnownNotAffected []string `json:"known_not_affected,omitempty"`
+}
+
+// CSAFFlag is the justification why products are not affected.
+type CSAFFlag struct {
+	Label      string   `json:"label"`
+	ProductIDs []string `json:"product_ids"`
+}
+
+// CSAFRemediation is the action statement for affected products, which is required by the VEX profile.
+type CSAFRemediation struct {
+	Category   string   `json:"category"`
+	Details    string   `json:"details"`
+	ProductIDs []string `json:"product_ids"`
+}
+
+// NewCSAF creates a CSAF VEX document out of the statements.
+func NewCSAF(meta Metadata, statements []Statement) (doc *CSAF) {
+	doc = &CSAF{
+		Document: CSAFDocument{
+			Category:    "csaf_vex",
+			CSAFVersion: CSAFVersion,
+			Publisher: CSAFPublisher{
+				Category:  "other",
+				Name:      "Clouditor",
+				Namespace: CSAFNamespace,
+			},
+			Title: meta.Title,
+			Tracking: CSAFTracking{
+				ID:                 meta.ID,
+				Status:             "final",
+				Version:            "1",
+				InitialReleaseDate: meta.Timestamp,
+				CurrentReleaseDate: meta.Timestamp,
+				RevisionHistory: []CSAFRevision{
+					{Date: meta.Timestamp, Number: "1", Summary: "Exported from Clouditor"},
+				},
+			},
+		},
+		ProductTree: CSAFProductTree{
+			FullProductNames: []CSAFProduct{},
+		},
+		Vulnerabilities: []CSAFVulnerability{},
+	}
+
+	ids, _ := products(statements)
+	for _, id := range ids {
+		doc.ProductTree.FullProductNames = append(doc.ProductTree.FullProductNames, CSAFProduct{ProductID: id, Name: id})
+	}
+
+	vulns, m := group(statements)
+	for _, v := range vulns {
+		vuln := CSAFVulnerability{
+			Notes: []CSAFNote{
+				{Category: "description", Text: "Status of " + v + " according to the latest vulnerability scans of the assessed resources."},
+			},
+		}
+
+		if isCVE(v) {
+			vuln.CVE = v
+		} else {
+			vuln.IDs = []CSAFID{{SystemName: "Scanner", Text: v}}
+		}
+
+		for _, s := range m[v] {
+			if s.Affected {
+				vuln.ProductStatus.KnownAffected = append(vuln.ProductStatus.KnownAffected, s.ProductID)
+			} else {
+				vuln.ProductStatus.KnownNotAffected = append(vuln.ProductStatus.KnownNotAffected, s.ProductID)
+			}
+		}
+
+		if len(vuln.ProductStatus.KnownAffected) > 0 {
+			vuln.Remediations = []CSAFRemediation{{
+				Category:   "none_available",
+				Details:    "The resource is non-compliant to the " + MetricNoKnownVulnerabilities + " metric and should be updated.",
+				ProductIDs: vuln.ProductStatus.KnownAffected,
+			}}
+		}
+
+		if len(vuln.ProductStatus.KnownNotAffected) > 0 {
+			vuln.Flags = []CSAFFlag{{
+				Label:      "vulnerable_code_not_present",
+				ProductIDs: vuln.ProductStatus.KnownNotAffected,
+			}}
+		}
+
+		doc.Vulnerabilities = append(doc.Vulnerabilities, vuln)
+	}
+
+	return doc
+}
@@ -0,0 +1,189 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package vex
+
+import (
+	"slices"
+	"time"
+)
+
+// CycloneDXSpecVersion is the version of the CycloneDX specification that we produce.
+const CycloneDXSpecVersion = "1.5"
+
+// CycloneDX analysis states, see https://cyclonedx.org/docs/1.5/json/#vulnerabilities_items_analysis_state.
+const (
+	CycloneDXStateExploitable = "exploitable"
+	CycloneDXStateNotAffected = "not_affected"
+)
+
+// CycloneDX is a (partial) CycloneDX BOM that only contains VEX information, i.e., the affected components and the
+// analysis of their vulnerabilities.
+type CycloneDX struct {
+	BOMFormat       string                   `json:"bomFormat"`
+	SpecVersion     string                   `json:"specVersion"`
+	SerialNumber    string                   `json:"serialNumber"`
+	Version         int                      `json:"version"`
+	Metadata        CycloneDXMetadata        `json:"metadata"`
+	Components      []CycloneDXComponent     `json:"components"`
+	Vulnerabilities []CycloneDXVulnerability `json:"vulnerabilities"`
+}
+
+// CycloneDXMetadata contains the meta-data of the BOM.
+type CycloneDXMetadata struct {
+	Timestamp time.Time       `json:"timestamp"`
+	Tools     []CycloneDXTool `json:"tools,omitempty"`
+}
+
+// CycloneDXTool is the tool that created the BOM.
+type CycloneDXTool struct {
+	Vendor string `json:"vendor"`
+	Name   string `json:"name"`
+}
+
+// CycloneDXComponent is a component of the BOM, i.e., an assessed resource.
+type CycloneDXComponent struct {
+	BOMRef string `json:"bom-ref"`
+	Type   string `json:"type"`
+	Name   string `json:"name"`
+}
+
+// CycloneDXVulnerability is a vulnerability together with its analysis for the affected components.
+type CycloneDXVulnerability struct {
+	ID       string            `json:"id"`
+	Source   *CycloneDXSource  `json:"source,omitempty"`
+	Analysis CycloneDXAnalysis `json:"analysis"`
+	Affects  []CycloneDXAffect `json:"affects"`
+}
+
+// CycloneDXSource is the source of a vulnerability.
+type CycloneDXSource struct {
+	Name string `json:"name"`
+	URL  string `json:"url,omitempty"`
+}
+
+// CycloneDXAnalysis is the analysis of a vulnerability.
+type CycloneDXAnalysis struct {
+	State         string     `json:"state"`
+	Justification string     `json:"justification,omitempty"`
+	Detail        string     `json:"detail,omitempty"`
+	LastUpdated   *time.Time `json:"lastUpdated,omitempty"`
+}
+
+// CycloneDXAffect references an affected component.
+type CycloneDXAffect struct {
+	Ref string `json:"ref"`
+}
+
+// NewCycloneDX creates a CycloneDX VEX BOM out of the statements. Since the analysis of a CycloneDX vulnerability
+// holds for all its affected components, a vulnerability is listed once for the affected and once for the not affected
+// components.
+func NewCycloneDX(meta Metadata, statements []Statement) (bom *CycloneDX) {
+	bom = &CycloneDX{
+		BOMFormat:    "CycloneDX",
+		SpecVersion:  CycloneDXSpecVersion,
+		SerialNumber: "urn:uuid:" + meta.ID,
+		Version:      1,
+		Metadata: CycloneDXMetadata{
+			Timestamp: meta.Timestamp,
+			Tools:     []CycloneDXTool{{Vendor: "Clouditor", Name: "Clouditor"}},
+		},
+		Components:      []CycloneDXComponent{},
+		Vulnerabilities: []CycloneDXVulnerability{},
+	}
+
+	ids, types := products(statements)
+	for _, id := range ids {
+		bom.Components = append(bom.Components, CycloneDXComponent{
+			BOMRef: id,
+			Type:   componentType(types[id]),
+			Name:   id,
+		})
+	}
+
+	vulns, m := group(statements)
+	for _, v := range vulns {
+		for _, affected := range []bool{true, false} {
+			var (
+				affects []CycloneDXAffect
+				updated time.Time
+			)
+
+			for _, s := range m[v] {
+				if s.Affected == affected {
+					affects = append(affects, CycloneDXAffect{Ref: s.ProductID})
+					if s.Timestamp.After(updated) {
+						updated = s.Timestamp
+					}
+				}
+			}
+
+			if len(affects) == 0 {
+				continue
+			}
+
+			vuln := CycloneDXVulnerability{
+				ID:      v,
+				Affects: affects,
+				Analysis: CycloneDXAnalysis{
+					State:  CycloneDXStateExploitable,
+					Detail: "The vulnerability was reported by the latest scan of the resource.",
+				},
+			}
+
+			if !updated.IsZero() {
+				vuln.Analysis.LastUpdated = &updated
+			}
+
+			if !affected {
+				vuln.Analysis.State = CycloneDXStateNotAffected
+				vuln.Analysis.Justification = "code_not_present"
+				vuln.Analysis.Detail = "The vulnerability was not reported by the latest scan of the resource."
+			}
+
+			if isCVE(v) {
+				vuln.Source = &CycloneDXSource{Name: "NVD", URL: "https://nvd.nist.gov/vuln/detail/" + v}
+			}
+
+			bom.Vulnerabilities = append(bom.Vulnerabilities, vuln)
+		}
+	}
+
+	return bom
+}
+
+// componentType returns the CycloneDX component type of a resource with the given ontology types.
+func componentType(types []string) string {
+	switch {
+	case slices.Contains(types, "ContainerImage") || slices.Contains(types, "Container"):
+		return "container"
+	case slices.Contains(types, "VMImage") || slices.Contains(types, "VirtualMachine"):
+		return "operating-system"
+	case slices.Contains(types, "Library"):
+		return "library"
+	default:
+		return "application"
+	}
+}
@@ -0,0 +1,226 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+// Package vex creates Vulnerability Exploitability eXchange (VEX) documents out of the vulnerabilities contained in
+// the evidences of image and OS scanners. A [Statement] declares whether a product, i.e., an assessed resource, is
+// affected by a vulnerability. Statements can be exported as CycloneDX VEX ([NewCycloneDX]) or as CSAF document with
+// the VEX profile ([NewCSAF]).
+package vex
+
+import (
+	"encoding/json"
+	"slices"
+	"strings"
+	"time"
+)
+
+// MetricNoKnownVulnerabilities is the metric that assesses the vulnerabilities of a resource.
+const MetricNoKnownVulnerabilities = "NoKnownVulnerabilities"
+
+// Format is the format of an exported VEX document.
+type Format string
+
+const (
+	// FormatCycloneDX is a CycloneDX BOM in JSON containing only VEX information.
+	FormatCycloneDX Format = "cyclonedx"
+
+	// FormatCSAF is a CSAF 2.0 document with the VEX profile.
+	FormatCSAF Format = "csaf"
+)
+
+// Metadata contains the meta-data of an exported VEX document.
+type Metadata struct {
+	// ID is the unique identifier of the document.
+	ID string
+
+	// Title is a human-readable title of the document.
+	Title string
+
+	// Timestamp is the time of the export.
+	Timestamp time.Time
+}
+
+// Statement declares whether a product is affected by a vulnerability.
+type Statement struct {
+	// VulnerabilityID is the identifier of the vulnerability, e.g., a CVE or GHSA identifier.
+	VulnerabilityID string
+
+	// ProductID is the identifier of the product, i.e., the resource ID.
+	ProductID string
+
+	// ProductTypes are the ontology types of the product.
+	ProductTypes []string
+
+	// Affected specifies whether the product is affected. Otherwise, the vulnerability is not present in the product.
+	Affected bool
+
+	// Timestamp is the time of the assessment the statement is based on.
+	Timestamp time.Time
+}
+
+// Scan is the latest vulnerability scan of a product.
+type Scan struct {
+	// ProductID is the identifier of the product, i.e., the resource ID.
+	ProductID string
+
+	// ProductTypes are the ontology types of the product.
+	ProductTypes []string
+
+	// Vulnerabilities are the identifiers of the vulnerabilities reported by the scan.
+	Vulnerabilities []string
+
+	// Timestamp is the time of the scan.
+	Timestamp time.Time
+}
+
+// NewStatements creates the statements out of the latest scans of all products. A product is affected by the
+// vulnerabilities reported by its scan and not affected by all other vulnerabilities that are reported for any of the
+// products.
+func NewStatements(scans []Scan) (statements []Statement) {
+	var all []string
+
+	for _, scan := range scans {
+		all = append(all, scan.Vulnerabilities...)
+	}
+
+	slices.Sort(all)
+	all = slices.Compact(all)
+
+	for _, scan := range scans {
+		for _, v := range all {
+			statements = append(statements, Statement{
+				VulnerabilityID: v,
+				ProductID:       scan.ProductID,
+				ProductTypes:    scan.ProductTypes,
+				Affected:        slices.Contains(scan.Vulnerabilities, v),
+				Timestamp:       scan.Timestamp,
+			})
+		}
+	}
+
+	return
+}
+
+// vulnerabilityKeys are the keys of the JSON arrays that contain vulnerabilities in the output of common scanners,
+// e.g., Trivy ("Vulnerabilities") and Grype ("matches").
+var vulnerabilityKeys = []string{"vulnerabilities", "Vulnerabilities", "matches"}
+
+// idKeys are the keys of JSON objects that contain the identifier of a vulnerability.
+var idKeys = []string{"id", "VulnerabilityID", "cve", "vulnerability"}
+
+// Vulnerabilities extracts the (unique and sorted) vulnerability identifiers of raw JSON data, e.g., the raw output of
+// a scanner contained in an evidence. It searches for arrays of vulnerabilities on any level of the data. Such an array
+// either consists of identifiers or objects containing the identifier. Data that is not JSON is ignored.
+func Vulnerabilities(raw []byte) (ids []string) {
+	var v any
+
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return nil
+	}
+
+	collect(v, false, &ids)
+
+	slices.Sort(ids)
+	return slices.Compact(ids)
+}
+
+// collect walks through v and appends all vulnerability identifiers. If inList is true, v is an element of an array of
+// vulnerabilities.
+func collect(v any, inList bool, ids *[]string) {
+	switch v := v.(type) {
+	case string:
+		if inList && v != "" {
+			*ids = append(*ids, v)
+		}
+	case []any:
+		for _, e := range v {
+			collect(e, inList, ids)
+		}
+	case map[string]any:
+		if inList {
+			if id := identifier(v); id != "" {
+				*ids = append(*ids, id)
+				return
+			}
+		}
+
+		for key, e := range v {
+			collect(e, slices.Contains(vulnerabilityKeys, key), ids)
+		}
+	}
+}
+
+// identifier returns the identifier of a vulnerability object. The identifier can also be nested, e.g., in the
+// "vulnerability" object of a Grype match.
+func identifier(obj map[string]any) string {
+	for _, key := range idKeys {
+		switch id := obj[key].(type) {
+		case string:
+			return id
+		case map[string]any:
+			return identifier(id)
+		}
+	}
+
+	return ""
+}
+
+// isCVE checks, whether id is a CVE identifier.
+func isCVE(id string) bool {
+	return strings.HasPrefix(id, "CVE-")
+}
+
+// group groups the statements by their vulnerability and returns the vulnerabilities in a stable order.
+func group(statements []Statement) (ids []string, m map[string][]Statement) {
+	m = make(map[string][]Statement)
+
+	for _, s := range statements {
+		if _, ok := m[s.VulnerabilityID]; !ok {
+			ids = append(ids, s.VulnerabilityID)
+		}
+
+		m[s.VulnerabilityID] = append(m[s.VulnerabilityID], s)
+	}
+
+	slices.Sort(ids)
+
+	return
+}
+
+// products returns the unique products of the statements in a stable order.
+func products(statements []Statement) (ids []string, types map[string][]string) {
+	types = make(map[string][]string)
+
+	for _, s := range statements {
+		if _, ok := types[s.ProductID]; !ok {
+			ids = append(ids, s.ProductID)
+			types[s.ProductID] = s.ProductTypes
+		}
+	}
+
+	slices.Sort(ids)
+
+	return
+}
@@ -0,0 +1,178 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package vex
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+)
+
+var (
+	mockTimestamp = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	mockStatements = []Statement{
+		{VulnerabilityID: "CVE-2024-0001", ProductID: "image-1", ProductTypes: []string{"ContainerImage", "Image", "Resource"}, Affected: true, Timestamp: mockTimestamp},
+		{VulnerabilityID: "CVE-2024-0001", ProductID: "vm-1", ProductTypes: []string{"VirtualMachine", "Compute", "Resource"}, Affected: false, Timestamp: mockTimestamp},
+		{VulnerabilityID: "GHSA-xxxx-yyyy-zzzz", ProductID: "vm-1", ProductTypes: []string{"VirtualMachine", "Compute", "Resource"}, Affected: true, Timestamp: mockTimestamp},
+	}
+
+	mockMetadata = Metadata{
+		ID:        "00000000-0000-0000-0000-000000000000",
+		Title:     "Vulnerability status of MyService",
+		Timestamp: mockTimestamp,
+	}
+)
+
+func TestVulnerabilities(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  string
+		want []string
+	}{
+		{
+			name: "trivy",
+			raw:  `{"Results":[{"Target":"alpine","Vulnerabilities":[{"VulnerabilityID":"CVE-2024-0002"},{"VulnerabilityID":"CVE-2024-0001"}]}]}`,
+			want: []string{"CVE-2024-0001", "CVE-2024-0002"},
+		},
+		{
+			name: "grype",
+			raw:  `{"matches":[{"vulnerability":{"id":"GHSA-xxxx-yyyy-zzzz"},"artifact":{"name":"lib"}}]}`,
+			want: []string{"GHSA-xxxx-yyyy-zzzz"},
+		},
+		{
+			name: "list of identifiers with duplicates",
+			raw:  `{"vulnerabilities":["CVE-2024-0001","CVE-2024-0001"]}`,
+			want: []string{"CVE-2024-0001"},
+		},
+		{
+			name: "no vulnerabilities",
+			raw:  `{"id":"CVE-2024-0001","name":"not in a list of vulnerabilities"}`,
+			want: nil,
+		},
+		{
+			name: "not JSON",
+			raw:  "CVE-2024-0001",
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Vulnerabilities([]byte(tt.raw)))
+		})
+	}
+}
+
+func TestNewStatements(t *testing.T) {
+	got := NewStatements([]Scan{
+		{ProductID: "image-1", Vulnerabilities: []string{"CVE-2024-0001"}, Timestamp: mockTimestamp},
+		{ProductID: "vm-1", Timestamp: mockTimestamp},
+	})
+
+	assert.Equal(t, []Statement{
+		{VulnerabilityID: "CVE-2024-0001", ProductID: "image-1", Affected: true, Timestamp: mockTimestamp},
+		{VulnerabilityID: "CVE-2024-0001", ProductID: "vm-1", Affected: false, Timestamp: mockTimestamp},
+	}, got)
+
+	// Without any reported vulnerability, there is nothing to state
+	assert.Empty(t, NewStatements([]Scan{{ProductID: "vm-1"}}))
+}
+
+func TestNewCycloneDX(t *testing.T) {
+	bom := NewCycloneDX(mockMetadata, mockStatements)
+
+	assert.Equal(t, "CycloneDX", bom.BOMFormat)
+	assert.Equal(t, "urn:uuid:"+mockMetadata.ID, bom.SerialNumber)
+	assert.Equal(t, []CycloneDXComponent{
+		{BOMRef: "image-1", Type: "container", Name: "image-1"},
+		{BOMRef: "vm-1", Type: "operating-system", Name: "vm-1"},
+	}, bom.Components)
+
+	assert.Equal(t, []CycloneDXVulnerability{
+		{
+			ID:      "CVE-2024-0001",
+			Source:  &CycloneDXSource{Name: "NVD", URL: "https://nvd.nist.gov/vuln/detail/CVE-2024-0001"},
+			Affects: []CycloneDXAffect{{Ref: "image-1"}},
+			Analysis: CycloneDXAnalysis{
+				State:       CycloneDXStateExploitable,
+				Detail:      "The vulnerability was reported by the latest scan of the resource.",
+				LastUpdated: &mockTimestamp,
+			},
+		},
+		{
+			ID:      "CVE-2024-0001",
+			Source:  &CycloneDXSource{Name: "NVD", URL: "https://nvd.nist.gov/vuln/detail/CVE-2024-0001"},
+			Affects: []CycloneDXAffect{{Ref: "vm-1"}},
+			Analysis: CycloneDXAnalysis{
+				State:         CycloneDXStateNotAffected,
+				Justification: "code_not_present",
+				Detail:        "The vulnerability was not reported by the latest scan of the resource.",
+				LastUpdated:   &mockTimestamp,
+			},
+		},
+		{
+			ID:      "GHSA-xxxx-yyyy-zzzz",
+			Affects: []CycloneDXAffect{{Ref: "vm-1"}},
+			Analysis: CycloneDXAnalysis{
+				State:       CycloneDXStateExploitable,
+				Detail:      "The vulnerability was reported by the latest scan of the resource.",
+				LastUpdated: &mockTimestamp,
+			},
+		},
+	}, bom.Vulnerabilities)
+
+	// An empty BOM must still contain (empty) arrays
+	b, err := json.Marshal(NewCycloneDX(mockMetadata, nil))
+	assert.NoError(t, err)
+	assert.Contains(t, string(b), `"components":[],"vulnerabilities":[]`)
+}
+
+func TestNewCSAF(t *testing.T) {
+	doc := NewCSAF(mockMetadata, mockStatements)
+
+	assert.Equal(t, "csaf_vex", doc.Document.Category)
+	assert.Equal(t, mockMetadata.Title, doc.Document.Title)
+	assert.Equal(t, mockMetadata.ID, doc.Document.Tracking.ID)
+	assert.Equal(t, []CSAFProduct{
+		{ProductID: "image-1", Name: "image-1"},
+		{ProductID: "vm-1", Name: "vm-1"},
+	}, doc.ProductTree.FullProductNames)
+
+	assert.Equal(t, 2, len(doc.Vulnerabilities))
+
+	cve := doc.Vulnerabilities[0]
+	assert.Equal(t, "CVE-2024-0001", cve.CVE)
+	assert.Equal(t, CSAFProductStatus{KnownAffected: []string{"image-1"}, KnownNotAffected: []string{"vm-1"}}, cve.ProductStatus)
+	assert.Equal(t, []CSAFFlag{{Label: "vulnerable_code_not_present", ProductIDs: []string{"vm-1"}}}, cve.Flags)
+	assert.Equal(t, []string{"image-1"}, cve.Remediations[0].ProductIDs)
+
+	ghsa := doc.Vulnerabilities[1]
+	assert.Equal(t, "", ghsa.CVE)
+	assert.Equal(t, []CSAFID{{SystemName: "Scanner", Text: "GHSA-xxxx-yyyy-zzzz"}}, ghsa.IDs)
+	assert.Empty(t, ghsa.Flags)
+}
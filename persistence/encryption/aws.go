@@ -0,0 +1,138 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package encryption
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+)
+
+// AWSKMS is a [KeyProvider] that wraps data encryption keys with a symmetric key in AWS KMS using the Encrypt and
+// Decrypt actions of its API.
+type AWSKMS struct {
+	cfg      aws.Config
+	keyID    string
+	endpoint string
+	client   *http.Client
+}
+
+// NewAWSKMS creates a new [AWSKMS] for the key with the given ID, ARN or alias, e.g., "alias/clouditor", using the
+// region and credentials of cfg.
+func NewAWSKMS(cfg aws.Config, keyID string) *AWSKMS {
+	return &AWSKMS{
+		cfg:      cfg,
+		keyID:    keyID,
+		endpoint: fmt.Sprintf("https://kms.%s.amazonaws.com", cfg.Region),
+		client:   &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// WrapKey implements [KeyProvider]. The ciphertext of AWS KMS already contains the key and its version.
+func (k *AWSKMS) WrapKey(ctx context.Context, key []byte) (wrapped []byte, err error) {
+	var res struct {
+		CiphertextBlob []byte
+	}
+
+	err = k.do(ctx, "TrentService.Encrypt", map[string]any{"KeyId": k.keyID, "Plaintext": key}, &res)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.CiphertextBlob, nil
+}
+
+// UnwrapKey implements [KeyProvider].
+func (k *AWSKMS) UnwrapKey(ctx context.Context, wrapped []byte) (key []byte, err error) {
+	var res struct {
+		Plaintext []byte
+	}
+
+	err = k.do(ctx, "TrentService.Decrypt", map[string]any{"KeyId": k.keyID, "CiphertextBlob": wrapped}, &res)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Plaintext, nil
+}
+
+// do performs the given action of the AWS KMS API and decodes its response into res.
+func (k *AWSKMS) do(ctx context.Context, target string, in any, res any) (err error) {
+	body, err := json.Marshal(in)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, k.endpoint+"/", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-amz-json-1.1")
+	req.Header.Set("X-Amz-Target", target)
+
+	creds, err := k.cfg.Credentials.Retrieve(ctx)
+	if err != nil {
+		return fmt.Errorf("could not authenticate to AWS KMS: %w", err)
+	}
+
+	hash := sha256.Sum256(body)
+
+	err = v4.NewSigner().SignHTTP(ctx, creds, req, hex.EncodeToString(hash[:]), "kms", k.cfg.Region, time.Now())
+	if err != nil {
+		return fmt.Errorf("could not sign request: %w", err)
+	}
+
+	resp, err := k.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("could not reach AWS KMS: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		var apiErr struct {
+			Type string `json:"__type"`
+		}
+
+		_ = json.NewDecoder(resp.Body).Decode(&apiErr)
+
+		return fmt.Errorf("key operation in AWS KMS failed: unexpected status code %d (%s)", resp.StatusCode, apiErr.Type)
+	}
+
+	err = json.NewDecoder(resp.Body).Decode(res)
+	if err != nil {
+		return fmt.Errorf("could not decode response of AWS KMS: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,142 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package encryption
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+)
+
+// azureKeyVaultScope is the scope of the access token for Azure Key Vault.
+const azureKeyVaultScope = "https://vault.azure.net/.default"
+
+// azureKeyVaultAPIVersion is the version of the Azure Key Vault API.
+const azureKeyVaultAPIVersion = "7.4"
+
+// azureKeyWrapAlgorithm is the algorithm used to wrap data encryption keys with an RSA key.
+const azureKeyWrapAlgorithm = "RSA-OAEP-256"
+
+// AzureKeyVault is a [KeyProvider] that wraps data encryption keys with an RSA key in Azure Key Vault using the
+// wrapkey and unwrapkey operations of its REST API.
+type AzureKeyVault struct {
+	keyURL string
+	cred   azcore.TokenCredential
+	client *http.Client
+}
+
+// azureKeyOperation is the request and response of the wrapkey and unwrapkey operations.
+type azureKeyOperation struct {
+	KeyID string `json:"kid,omitempty"`
+	Alg   string `json:"alg,omitempty"`
+	Value string `json:"value"`
+}
+
+// NewAzureKeyVault creates a new [AzureKeyVault] for the key at keyURL, e.g.,
+// "https://my-vault.vault.azure.net/keys/clouditor", which authenticates using cred, e.g., a managed identity. If the
+// URL does not contain a version, the current version of the key is used for wrapping.
+func NewAzureKeyVault(keyURL string, cred azcore.TokenCredential) *AzureKeyVault {
+	return &AzureKeyVault{
+		keyURL: strings.TrimSuffix(keyURL, "/"),
+		cred:   cred,
+		client: &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// WrapKey implements [KeyProvider]. The wrapped key contains the ID of the key version, so that the key can be
+// rotated.
+func (kv *AzureKeyVault) WrapKey(ctx context.Context, key []byte) (wrapped []byte, err error) {
+	res, err := kv.do(ctx, kv.keyURL+"/wrapkey", base64.RawURLEncoding.EncodeToString(key))
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(azureKeyOperation{KeyID: res.KeyID, Value: res.Value})
+}
+
+// UnwrapKey implements [KeyProvider].
+func (kv *AzureKeyVault) UnwrapKey(ctx context.Context, wrapped []byte) (key []byte, err error) {
+	var w azureKeyOperation
+
+	if err = json.Unmarshal(wrapped, &w); err != nil || w.KeyID == "" {
+		return nil, ErrInvalidCiphertext
+	}
+
+	res, err := kv.do(ctx, w.KeyID+"/unwrapkey", w.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	return base64.RawURLEncoding.DecodeString(res.Value)
+}
+
+// do performs the key operation at the given URL with the base64url-encoded value.
+func (kv *AzureKeyVault) do(ctx context.Context, url string, value string) (res *azureKeyOperation, err error) {
+	token, err := kv.cred.GetToken(ctx, policy.TokenRequestOptions{Scopes: []string{azureKeyVaultScope}})
+	if err != nil {
+		return nil, fmt.Errorf("could not authenticate to Azure Key Vault: %w", err)
+	}
+
+	body, err := json.Marshal(azureKeyOperation{Alg: azureKeyWrapAlgorithm, Value: value})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url+"?api-version="+azureKeyVaultAPIVersion,
+		bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+token.Token)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := kv.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("could not reach Azure Key Vault: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("key operation in Azure Key Vault failed: unexpected status code %d", resp.StatusCode)
+	}
+
+	res = new(azureKeyOperation)
+
+	err = json.NewDecoder(resp.Body).Decode(res)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode response of Azure Key Vault: %w", err)
+	}
+
+	return res, nil
+}
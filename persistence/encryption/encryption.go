@@ -0,0 +1,207 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+// Package encryption provides envelope encryption of sensitive values stored in the database. Each value is encrypted
+// with AES-256-GCM using a data encryption key (DEK), which itself is encrypted ("wrapped") by a key encryption key
+// (KEK) managed by a [KeyProvider], e.g., a local key, Azure Key Vault or AWS KMS. The wrapped DEK is stored alongside
+// the value, so that the KEK can be rotated in the key management system without re-encrypting the database.
+package encryption
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// Prefix is the prefix of all encrypted values. Values without it are treated as plaintext, e.g., values that were
+// stored before encryption was enabled.
+const Prefix = "enc:v1:"
+
+// dataKeyLength is the length of a data encryption key, which is used for AES-256.
+const dataKeyLength = 32
+
+// ErrInvalidCiphertext indicates that an encrypted value is malformed or could not be decrypted.
+var ErrInvalidCiphertext = errors.New("invalid ciphertext")
+
+// KeyProvider wraps and unwraps data encryption keys using a key encryption key, which usually never leaves the key
+// management system.
+type KeyProvider interface {
+	// WrapKey encrypts the data encryption key. The result needs to contain everything needed to unwrap it again, e.g.,
+	// the version of the key encryption key.
+	WrapKey(ctx context.Context, key []byte) (wrapped []byte, err error)
+
+	// UnwrapKey decrypts a data encryption key that was encrypted by WrapKey.
+	UnwrapKey(ctx context.Context, wrapped []byte) (key []byte, err error)
+}
+
+// Encryptor encrypts and decrypts values using envelope encryption. To avoid a call to the key management system for
+// each value, a single DEK is generated per Encryptor and unwrapped DEKs are cached.
+type Encryptor struct {
+	keys KeyProvider
+
+	mu      sync.Mutex
+	wrapped string
+	aead    cipher.AEAD
+
+	// cache contains the ciphers of already unwrapped DEKs by their wrapped form
+	cache map[string]cipher.AEAD
+}
+
+// NewEncryptor creates a new [Encryptor], which wraps its DEKs using the given key provider.
+func NewEncryptor(keys KeyProvider) *Encryptor {
+	return &Encryptor{
+		keys:  keys,
+		cache: make(map[string]cipher.AEAD),
+	}
+}
+
+// IsEncrypted checks, whether the value was encrypted by an [Encryptor].
+func IsEncrypted(value string) bool {
+	return strings.HasPrefix(value, Prefix)
+}
+
+// Encrypt encrypts the plaintext. The result has the form "enc:v1:<wrapped DEK>:<nonce and ciphertext>", both
+// base64-encoded.
+func (e *Encryptor) Encrypt(ctx context.Context, plaintext []byte) (value string, err error) {
+	wrapped, aead, err := e.dataKey(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(plaintext)+aead.Overhead())
+	if _, err = rand.Read(nonce); err != nil {
+		return "", err
+	}
+
+	// We use the wrapped DEK as additional data, so that it cannot be exchanged
+	ciphertext := aead.Seal(nonce, nonce, plaintext, []byte(wrapped))
+
+	return Prefix + wrapped + ":" + base64.StdEncoding.EncodeToString(ciphertext), nil
+}
+
+// Decrypt decrypts a value, which was encrypted by [Encryptor.Encrypt].
+func (e *Encryptor) Decrypt(ctx context.Context, value string) (plaintext []byte, err error) {
+	wrapped, encoded, ok := strings.Cut(strings.TrimPrefix(value, Prefix), ":")
+	if !ok || !IsEncrypted(value) {
+		return nil, ErrInvalidCiphertext
+	}
+
+	ciphertext, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCiphertext, err)
+	}
+
+	aead, err := e.unwrap(ctx, wrapped)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(ciphertext) < aead.NonceSize() {
+		return nil, ErrInvalidCiphertext
+	}
+
+	plaintext, err = aead.Open(nil, ciphertext[:aead.NonceSize()], ciphertext[aead.NonceSize():], []byte(wrapped))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCiphertext, err)
+	}
+
+	return plaintext, nil
+}
+
+// dataKey returns the current DEK in its wrapped form as well as its cipher. It is generated on first use.
+func (e *Encryptor) dataKey(ctx context.Context) (wrapped string, aead cipher.AEAD, err error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.aead != nil {
+		return e.wrapped, e.aead, nil
+	}
+
+	key := make([]byte, dataKeyLength)
+	if _, err = rand.Read(key); err != nil {
+		return "", nil, err
+	}
+
+	w, err := e.keys.WrapKey(ctx, key)
+	if err != nil {
+		return "", nil, fmt.Errorf("could not wrap data encryption key: %w", err)
+	}
+
+	aead, err = newAEAD(key)
+	if err != nil {
+		return "", nil, err
+	}
+
+	e.wrapped = base64.StdEncoding.EncodeToString(w)
+	e.aead = aead
+	e.cache[e.wrapped] = aead
+
+	return e.wrapped, e.aead, nil
+}
+
+// unwrap returns the cipher of the wrapped DEK, which is unwrapped by the key provider, if it is not cached yet.
+func (e *Encryptor) unwrap(ctx context.Context, wrapped string) (aead cipher.AEAD, err error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if aead, ok := e.cache[wrapped]; ok {
+		return aead, nil
+	}
+
+	w, err := base64.StdEncoding.DecodeString(wrapped)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCiphertext, err)
+	}
+
+	key, err := e.keys.UnwrapKey(ctx, w)
+	if err != nil {
+		return nil, fmt.Errorf("could not unwrap data encryption key: %w", err)
+	}
+
+	aead, err = newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	e.cache[wrapped] = aead
+
+	return aead, nil
+}
+
+// newAEAD creates a new AES-GCM cipher with the given key.
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
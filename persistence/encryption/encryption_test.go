@@ -0,0 +1,116 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package encryption
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+)
+
+// countingKeyProvider counts the calls to the wrapped key provider.
+type countingKeyProvider struct {
+	KeyProvider
+
+	wraps   int
+	unwraps int
+}
+
+func (c *countingKeyProvider) WrapKey(ctx context.Context, key []byte) ([]byte, error) {
+	c.wraps++
+	return c.KeyProvider.WrapKey(ctx, key)
+}
+
+func (c *countingKeyProvider) UnwrapKey(ctx context.Context, wrapped []byte) ([]byte, error) {
+	c.unwraps++
+	return c.KeyProvider.UnwrapKey(ctx, wrapped)
+}
+
+func newTestLocalKey(t *testing.T, b byte) *LocalKey {
+	key, err := NewLocalKey([]byte(strings.Repeat(string(b), dataKeyLength)))
+	assert.NoError(t, err)
+
+	return key
+}
+
+func TestEncryptor(t *testing.T) {
+	var (
+		ctx  = context.Background()
+		keys = &countingKeyProvider{KeyProvider: newTestLocalKey(t, 'a')}
+		enc  = NewEncryptor(keys)
+	)
+
+	v1, err := enc.Encrypt(ctx, []byte("my secret"))
+	assert.NoError(t, err)
+	assert.True(t, IsEncrypted(v1))
+	assert.False(t, strings.Contains(v1, "my secret"))
+
+	v2, err := enc.Encrypt(ctx, []byte("my secret"))
+	assert.NoError(t, err)
+	assert.True(t, v1 != v2)
+
+	// The DEK is only wrapped once
+	assert.Equal(t, 1, keys.wraps)
+
+	got, err := enc.Decrypt(ctx, v1)
+	assert.NoError(t, err)
+	assert.Equal(t, "my secret", string(got))
+	assert.Equal(t, 0, keys.unwraps)
+
+	// Another encryptor, e.g., after a restart, needs to unwrap the DEK once
+	other := NewEncryptor(keys)
+
+	for _, v := range []string{v1, v2} {
+		got, err = other.Decrypt(ctx, v)
+		assert.NoError(t, err)
+		assert.Equal(t, "my secret", string(got))
+	}
+	assert.Equal(t, 1, keys.unwraps)
+
+	// An encryptor with another KEK cannot decrypt the value
+	_, err = NewEncryptor(newTestLocalKey(t, 'b')).Decrypt(ctx, v1)
+	assert.ErrorContains(t, err, "could not unwrap data encryption key")
+
+	// Tampered values are detected
+	_, err = other.Decrypt(ctx, v1[:len(v1)-4]+"AAA=")
+	assert.ErrorIs(t, err, ErrInvalidCiphertext)
+
+	_, err = other.Decrypt(ctx, "plaintext")
+	assert.ErrorIs(t, err, ErrInvalidCiphertext)
+}
+
+func TestParseLocalKey(t *testing.T) {
+	_, err := ParseLocalKey("YWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWE=")
+	assert.NoError(t, err)
+
+	_, err = ParseLocalKey("YWFh")
+	assert.ErrorContains(t, err, "invalid key length 3")
+
+	_, err = ParseLocalKey("not base64")
+	assert.ErrorContains(t, err, "could not decode key")
+}
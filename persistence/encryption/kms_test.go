@@ -0,0 +1,149 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package encryption
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+)
+
+// mockAzureCredential is an [azcore.TokenCredential] that always returns the same token.
+type mockAzureCredential struct{}
+
+func (mockAzureCredential) GetToken(_ context.Context, _ policy.TokenRequestOptions) (azcore.AccessToken, error) {
+	return azcore.AccessToken{Token: "token", ExpiresOn: time.Now().Add(time.Hour)}, nil
+}
+
+func TestAzureKeyVault(t *testing.T) {
+	var srv *httptest.Server
+
+	// The mock "wraps" keys by reversing them
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req azureKeyOperation
+
+		if r.Header.Get("Authorization") != "Bearer token" || r.URL.Query().Get("api-version") != azureKeyVaultAPIVersion {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		if req.Alg != azureKeyWrapAlgorithm {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		switch r.URL.Path {
+		case "/keys/clouditor/wrapkey":
+			_ = json.NewEncoder(w).Encode(azureKeyOperation{KeyID: srv.URL + "/keys/clouditor/v1", Value: reverse(req.Value)})
+		case "/keys/clouditor/v1/unwrapkey":
+			_ = json.NewEncoder(w).Encode(azureKeyOperation{KeyID: srv.URL + "/keys/clouditor/v1", Value: reverse(req.Value)})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	kv := NewAzureKeyVault(srv.URL+"/keys/clouditor/", mockAzureCredential{})
+
+	testKeyProvider(t, kv)
+}
+
+func TestAWSKMS(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			KeyId          string
+			Plaintext      []byte
+			CiphertextBlob []byte
+		}
+
+		if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=AKIA/") {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		if req.KeyId != "alias/clouditor" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"__type": "NotFoundException"}`))
+			return
+		}
+
+		switch r.Header.Get("X-Amz-Target") {
+		case "TrentService.Encrypt":
+			_ = json.NewEncoder(w).Encode(map[string]any{"CiphertextBlob": append([]byte("wrapped:"), req.Plaintext...)})
+		case "TrentService.Decrypt":
+			_ = json.NewEncoder(w).Encode(map[string]any{"Plaintext": req.CiphertextBlob[len("wrapped:"):]})
+		}
+	}))
+	defer srv.Close()
+
+	cfg := aws.Config{
+		Region:      "eu-central-1",
+		Credentials: credentials.NewStaticCredentialsProvider("AKIA", "secret", ""),
+	}
+
+	k := NewAWSKMS(cfg, "alias/clouditor")
+	k.endpoint = srv.URL
+
+	testKeyProvider(t, k)
+
+	k = NewAWSKMS(cfg, "alias/other")
+	k.endpoint = srv.URL
+
+	_, err := k.WrapKey(context.Background(), []byte("key"))
+	assert.ErrorContains(t, err, "NotFoundException")
+}
+
+// testKeyProvider checks that a value encrypted using the key provider can be decrypted again.
+func testKeyProvider(t *testing.T, keys KeyProvider) {
+	v, err := NewEncryptor(keys).Encrypt(context.Background(), []byte("my secret"))
+	assert.NoError(t, err)
+
+	got, err := NewEncryptor(keys).Decrypt(context.Background(), v)
+	assert.NoError(t, err)
+	assert.Equal(t, "my secret", string(got))
+}
+
+func reverse(s string) string {
+	r := []byte(s)
+	for i, j := 0, len(r)-1; i < j; i, j = i+1, j-1 {
+		r[i], r[j] = r[j], r[i]
+	}
+
+	return string(r)
+}
@@ -0,0 +1,88 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package encryption
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+)
+
+// LocalKey is a [KeyProvider] that wraps data encryption keys with a local AES-256 key. It is mainly intended for
+// development and deployments without a key management system.
+type LocalKey struct {
+	key []byte
+}
+
+// NewLocalKey creates a new [LocalKey] from a 32 byte key.
+func NewLocalKey(key []byte) (*LocalKey, error) {
+	if len(key) != dataKeyLength {
+		return nil, fmt.Errorf("invalid key length %d, expected %d bytes", len(key), dataKeyLength)
+	}
+
+	return &LocalKey{key: key}, nil
+}
+
+// ParseLocalKey creates a new [LocalKey] from a base64-encoded 32 byte key, e.g., generated by "openssl rand -base64
+// 32".
+func ParseLocalKey(s string) (*LocalKey, error) {
+	key, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode key: %w", err)
+	}
+
+	return NewLocalKey(key)
+}
+
+// WrapKey implements [KeyProvider].
+func (l *LocalKey) WrapKey(_ context.Context, key []byte) (wrapped []byte, err error) {
+	aead, err := newAEAD(l.key)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(key)+aead.Overhead())
+	if _, err = rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	return aead.Seal(nonce, nonce, key, nil), nil
+}
+
+// UnwrapKey implements [KeyProvider].
+func (l *LocalKey) UnwrapKey(_ context.Context, wrapped []byte) (key []byte, err error) {
+	aead, err := newAEAD(l.key)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(wrapped) < aead.NonceSize() {
+		return nil, ErrInvalidCiphertext
+	}
+
+	return aead.Open(nil, wrapped[:aead.NonceSize()], wrapped[aead.NonceSize():], nil)
+}
@@ -0,0 +1,122 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package gorm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"clouditor.io/clouditor/v2/persistence"
+
+	"github.com/klauspost/compress/zstd"
+	"gorm.io/gorm/schema"
+)
+
+var (
+	// zstdEncoder and zstdDecoder are shared by all statements, since their EncodeAll and DecodeAll methods can be
+	// used concurrently.
+	zstdEncoder, _ = zstd.NewWriter(nil)
+	zstdDecoder, _ = zstd.NewReader(nil)
+
+	// compressedPrefix is the beginning of a compressed value in the database, see [compressedValue].
+	compressedPrefix = []byte(`{"zstd":`)
+)
+
+// compressionKey is the key in the context of the statements, which specifies whether compression is enabled.
+type compressionKey struct{}
+
+// compressedValue is the representation of a compressed value in the database. It is a JSON object itself, so that
+// the type of the column does not need to be changed.
+type compressedValue struct {
+	Zstd []byte `json:"zstd"`
+}
+
+// WithCompression is an option to compress large payloads, i.e., the fields using the "compressedanypb" serializer
+// such as the resource of an evidence, with zstd. Values stored before compression was enabled are still readable and
+// are compressed the next time they are saved.
+func WithCompression() StorageOption {
+	return func(s *storage) {
+		s.compression = true
+	}
+}
+
+// CompressedAnySerializer is a GORM serializer that serializes google.protobuf.Any messages like the
+// [AnySerializer], but additionally compresses them, if the storage was configured [WithCompression]. Compressed and
+// uncompressed values can be read regardless of the configuration.
+type CompressedAnySerializer struct {
+	AnySerializer
+}
+
+// Value implements https://pkg.go.dev/gorm.io/gorm/schema#SerializerValuerInterface to indicate
+// how this struct will be saved into an SQL database field.
+func (s CompressedAnySerializer) Value(ctx context.Context, field *schema.Field, dst reflect.Value, fieldValue interface{}) (interface{}, error) {
+	v, err := s.AnySerializer.Value(ctx, field, dst, fieldValue)
+	if err != nil || v == nil {
+		return v, err
+	}
+
+	if enabled, _ := ctx.Value(compressionKey{}).(bool); !enabled {
+		return v, nil
+	}
+
+	return json.Marshal(compressedValue{Zstd: zstdEncoder.EncodeAll(v.([]byte), nil)})
+}
+
+// Scan implements https://pkg.go.dev/gorm.io/gorm/schema#SerializerInterface to indicate how
+// this struct can be loaded from an SQL database field.
+func (s CompressedAnySerializer) Scan(ctx context.Context, field *schema.Field, dst reflect.Value, dbValue interface{}) (err error) {
+	var (
+		b  []byte
+		cv compressedValue
+	)
+
+	switch v := dbValue.(type) {
+	case []byte:
+		b = v
+	case string:
+		b = []byte(v)
+	case nil:
+		return s.AnySerializer.Scan(ctx, field, dst, dbValue)
+	default:
+		return persistence.ErrUnsupportedType
+	}
+
+	if bytes.HasPrefix(b, compressedPrefix) {
+		if err = json.Unmarshal(b, &cv); err != nil {
+			return fmt.Errorf("could not unmarshal compressed value: %w", err)
+		}
+
+		b, err = zstdDecoder.DecodeAll(cv.Zstd, nil)
+		if err != nil {
+			return fmt.Errorf("could not decompress value: %w", err)
+		}
+	}
+
+	return s.AnySerializer.Scan(ctx, field, dst, b)
+}
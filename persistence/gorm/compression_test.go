@@ -0,0 +1,89 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package gorm
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"clouditor.io/clouditor/v2/api/evidence"
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/testutil/prototest"
+	"clouditor.io/clouditor/v2/persistence"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestWithCompression(t *testing.T) {
+	var (
+		path = filepath.Join(t.TempDir(), "clouditor.db")
+		vm   = &ontology.VirtualMachine{
+			Id:   testdata.MockResourceID1,
+			Name: testdata.MockResourceName1,
+			Raw:  strings.Repeat(`{"large": "payload"}`, 100),
+		}
+		newEvidence = func(id string) *evidence.Evidence {
+			return &evidence.Evidence{
+				Id:             id,
+				Timestamp:      timestamppb.Now(),
+				CloudServiceId: testdata.MockCloudServiceID1,
+				ToolId:         testdata.MockEvidenceToolID1,
+				Resource:       prototest.NewAny(t, vm),
+			}
+		}
+		raw []string
+	)
+
+	// Store an uncompressed value before compression is enabled
+	plain, err := NewStorage(withSQLiteFile(path))
+	assert.NoError(t, err)
+	assert.NoError(t, plain.Create(newEvidence(testdata.MockEvidenceID1)))
+
+	s, err := NewStorage(withSQLiteFile(path), WithCompression())
+	assert.NoError(t, err)
+	assert.NoError(t, s.Create(newEvidence(testdata.MockEvidenceID2)))
+
+	// The new value needs to be compressed in the database
+	assert.NoError(t, s.Raw(&raw, "SELECT resource FROM evidences WHERE id = ?", testdata.MockEvidenceID2))
+	assert.Equal(t, 1, len(raw))
+	assert.True(t, strings.HasPrefix(raw[0], string(compressedPrefix)))
+	assert.True(t, len(raw[0]) < len(vm.Raw))
+
+	// Both values are read transparently, regardless of the configuration
+	for _, db := range []persistence.Storage{s, plain} {
+		for _, id := range []string{testdata.MockEvidenceID1, testdata.MockEvidenceID2} {
+			var got evidence.Evidence
+			assert.NoError(t, db.Get(&got, "id = ?", id))
+
+			gotVM, err := got.Resource.UnmarshalNew()
+			assert.NoError(t, err)
+			assert.Equal(t, vm.Raw, gotVM.(*ontology.VirtualMachine).Raw)
+		}
+	}
+}
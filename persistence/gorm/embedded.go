@@ -0,0 +1,55 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package gorm
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/glebarez/sqlite"
+)
+
+// embeddedPragmas configure the embedded database. Foreign keys are not enforced by SQLite by default. The
+// write-ahead log allows readers to continue while a transaction writes and the busy timeout as well as immediate
+// transactions let concurrent writers wait for each other instead of failing with "database is locked".
+const embeddedPragmas = "?_pragma=foreign_keys(1)&_pragma=journal_mode(WAL)&_pragma=busy_timeout(5000)&_txlock=immediate"
+
+// WithEmbedded is an option to configure Storage to use an embedded database, which is stored in the file at the given
+// path. In contrast to WithInMemory, the data is persisted across restarts and in contrast to WithPostgres, no external
+// database is needed. The directory of the file is created, if it does not exist.
+//
+// The embedded database is a pure-Go SQLite, so it supports the same queries as the other options. A key-value store,
+// such as Badger or Bolt, cannot serve the SQL conditions and raw queries of [persistence.Storage] without a query layer
+// of its own. It is meant for single-instance deployments, e.g., in air-gapped environments, since only one process can
+// write to the file.
+func WithEmbedded(path string) StorageOption {
+	return func(s *storage) {
+		// If this fails, opening the database fails with a more descriptive error
+		_ = os.MkdirAll(filepath.Dir(path), 0700)
+
+		s.dialector = sqlite.Open(path + embeddedPragmas)
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package gorm
+
+import (
+	"path/filepath"
+	"testing"
+
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/testutil/servicetest/orchestratortest"
+)
+
+func TestWithEmbedded(t *testing.T) {
+	var path = filepath.Join(t.TempDir(), "data", "clouditor.db")
+
+	s, err := NewStorage(WithEmbedded(path))
+	assert.NoError(t, err)
+	assert.NoError(t, s.Create(orchestratortest.NewCloudService()))
+
+	sql, err := s.(*storage).db.DB()
+	assert.NoError(t, err)
+	assert.NoError(t, sql.Close())
+
+	// The cloud service is still there after re-opening the database
+	s, err = NewStorage(WithEmbedded(path))
+	assert.NoError(t, err)
+
+	var cs orchestrator.CloudService
+	assert.NoError(t, s.Get(&cs, "id = ?", testdata.MockCloudServiceID1))
+	assert.Equal(t, testdata.MockCloudServiceName1, cs.Name)
+}
@@ -0,0 +1,120 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package gorm
+
+import (
+	"context"
+	"errors"
+	"reflect"
+
+	"clouditor.io/clouditor/v2/persistence"
+	"clouditor.io/clouditor/v2/persistence/encryption"
+
+	"gorm.io/gorm/schema"
+)
+
+// ErrEncryptionNotConfigured indicates that an encrypted value was read, but the storage has no encryption configured.
+var ErrEncryptionNotConfigured = errors.New("value is encrypted, but encryption is not configured")
+
+// encryptorKey is the key of the [encryption.Encryptor] of the storage in the context of its statements.
+type encryptorKey struct{}
+
+// WithEncryption is an option to encrypt sensitive fields, i.e., the ones using the "encrypted" serializer, with
+// envelope encryption using the given key provider. Values stored before encryption was enabled are still readable and
+// are encrypted the next time they are saved.
+func WithEncryption(keys encryption.KeyProvider) StorageOption {
+	return func(s *storage) {
+		s.encryptor = encryption.NewEncryptor(keys)
+	}
+}
+
+// EncryptedSerializer is a GORM serializer that encrypts string fields, if the storage was configured
+// [WithEncryption]. Otherwise, the values are stored in plaintext.
+type EncryptedSerializer struct{}
+
+// Value implements https://pkg.go.dev/gorm.io/gorm/schema#SerializerValuerInterface to indicate
+// how this struct will be saved into an SQL database field.
+func (EncryptedSerializer) Value(ctx context.Context, _ *schema.Field, _ reflect.Value, fieldValue interface{}) (interface{}, error) {
+	var s string
+
+	switch v := fieldValue.(type) {
+	case string:
+		s = v
+	case *string:
+		if v == nil {
+			return nil, nil
+		}
+		s = *v
+	default:
+		return nil, persistence.ErrUnsupportedType
+	}
+
+	enc, ok := ctx.Value(encryptorKey{}).(*encryption.Encryptor)
+	if !ok || s == "" {
+		return s, nil
+	}
+
+	return enc.Encrypt(ctx, []byte(s))
+}
+
+// Scan implements https://pkg.go.dev/gorm.io/gorm/schema#SerializerInterface to indicate how
+// this struct can be loaded from an SQL database field.
+func (EncryptedSerializer) Scan(ctx context.Context, field *schema.Field, dst reflect.Value, dbValue interface{}) (err error) {
+	var s string
+
+	switch v := dbValue.(type) {
+	case nil:
+		return nil
+	case []byte:
+		s = string(v)
+	case string:
+		s = v
+	default:
+		return persistence.ErrUnsupportedType
+	}
+
+	if encryption.IsEncrypted(s) {
+		enc, ok := ctx.Value(encryptorKey{}).(*encryption.Encryptor)
+		if !ok {
+			return ErrEncryptionNotConfigured
+		}
+
+		plaintext, err := enc.Decrypt(ctx, s)
+		if err != nil {
+			return err
+		}
+
+		s = string(plaintext)
+	}
+
+	if fv := field.ReflectValueOf(ctx, dst); fv.Kind() == reflect.Ptr {
+		fv.Set(reflect.ValueOf(&s))
+	} else {
+		fv.SetString(s)
+	}
+
+	return nil
+}
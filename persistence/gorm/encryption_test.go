@@ -0,0 +1,98 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package gorm
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"clouditor.io/clouditor/v2/api/evidence"
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/util"
+	"clouditor.io/clouditor/v2/persistence/encryption"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestWithEncryption(t *testing.T) {
+	var (
+		path = filepath.Join(t.TempDir(), "clouditor.db")
+		ev   = &evidence.Evidence{
+			Id:             testdata.MockEvidenceID1,
+			Timestamp:      timestamppb.Now(),
+			CloudServiceId: testdata.MockCloudServiceID1,
+			ToolId:         testdata.MockEvidenceToolID1,
+			Raw:            util.Ref(`{"password": "my secret"}`),
+		}
+		webhook = &orchestrator.Webhook{
+			Id:             testdata.MockCloudServiceID2,
+			CloudServiceId: testdata.MockCloudServiceID1,
+			Name:           "webhook",
+			Url:            "https://example.com",
+			Secret:         "my secret",
+		}
+	)
+
+	key, err := encryption.NewLocalKey([]byte(strings.Repeat("a", 32)))
+	assert.NoError(t, err)
+
+	// Store a plaintext value before encryption is enabled
+	plain, err := NewStorage(withSQLiteFile(path))
+	assert.NoError(t, err)
+	assert.NoError(t, plain.Create(webhook))
+
+	s, err := NewStorage(withSQLiteFile(path), WithEncryption(key))
+	assert.NoError(t, err)
+	assert.NoError(t, s.Create(ev))
+
+	// The values need to be encrypted in the database
+	var raw []string
+	assert.NoError(t, s.Raw(&raw, "SELECT raw FROM evidences"))
+	assert.Equal(t, 1, len(raw))
+	assert.True(t, encryption.IsEncrypted(raw[0]))
+	assert.False(t, strings.Contains(raw[0], "my secret"))
+
+	// They are decrypted transparently
+	var gotEvidence evidence.Evidence
+	assert.NoError(t, s.Get(&gotEvidence, "id = ?", ev.Id))
+	assert.Equal(t, ev.GetRaw(), gotEvidence.GetRaw())
+
+	// Plaintext values remain readable and are encrypted when saved again
+	var gotWebhook orchestrator.Webhook
+	assert.NoError(t, s.Get(&gotWebhook, "id = ?", webhook.Id))
+	assert.Equal(t, "my secret", gotWebhook.Secret)
+
+	assert.NoError(t, s.Save(&gotWebhook, "id = ?", webhook.Id))
+	assert.NoError(t, s.Raw(&raw, "SELECT secret FROM webhooks"))
+	assert.True(t, encryption.IsEncrypted(raw[0]))
+
+	// Without encryption, the encrypted values cannot be read
+	err = plain.Get(&gotEvidence, "id = ?", ev.Id)
+	assert.ErrorIs(t, err, ErrEncryptionNotConfigured)
+}
@@ -26,9 +26,11 @@
 package gorm
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"clouditor.io/clouditor/v2/api/assessment"
 	"clouditor.io/clouditor/v2/api/discovery"
@@ -36,10 +38,10 @@ import (
 	"clouditor.io/clouditor/v2/api/evidence"
 	"clouditor.io/clouditor/v2/api/orchestrator"
 	"clouditor.io/clouditor/v2/persistence"
+	"clouditor.io/clouditor/v2/persistence/encryption"
 
 	"github.com/glebarez/sqlite"
 	"github.com/sirupsen/logrus"
-	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 	"gorm.io/gorm/logger"
@@ -59,17 +61,40 @@ type storage struct {
 	// types contains all types that we need to auto-migrate into database tables
 	types []any
 
+	// migrations contains all versioned migrations that are applied after the auto-migration
+	migrations []Migration
+
 	// maxConn is the maximum number of connections. 0 means unlimited.
 	maxConn int
+
+	// maxIdleConns is the maximum number of idle connections. 0 means the default of database/sql.
+	maxIdleConns int
+
+	// connMaxLifetime is the maximum time a connection is reused. 0 means unlimited.
+	connMaxLifetime time.Duration
+
+	// connMaxIdleTime is the maximum time a connection may be idle. 0 means unlimited.
+	connMaxIdleTime time.Duration
+
+	// encryptor encrypts sensitive fields, if configured
+	encryptor *encryption.Encryptor
+
+	// compression specifies whether large payloads are compressed
+	compression bool
 }
 
 // DefaultTypes contains a list of internal types that need to be migrated by default
 var DefaultTypes = []any{
 	&assessment.MetricImplementation{},
+	&assessment.MetricImplementationRevision{},
+	&assessment.MetricConfigurationRevision{},
 	&assessment.Metric{},
 	&assessment.AssessmentResult{},
 	&discovery.Resource{},
+	&discovery.GraphEdge{},
+	&discovery.CustomResourceType{},
 	&evidence.Evidence{},
+	&orchestrator.Organization{},
 	&orchestrator.CloudService{},
 	&orchestrator.Certificate{},
 	&orchestrator.State{},
@@ -77,7 +102,28 @@ var DefaultTypes = []any{
 	&orchestrator.Category{},
 	&orchestrator.Control{},
 	&orchestrator.TargetOfEvaluation{},
+	&orchestrator.Webhook{},
+	&orchestrator.NotificationChannel{},
+	&orchestrator.TicketIntegration{},
+	&orchestrator.Ticket{},
+	&orchestrator.Waiver{},
+	&orchestrator.DisabledMetric{},
+	&orchestrator.Agent{},
+	&orchestrator.RoleAssignment{},
+	&orchestrator.ApiKey{},
+	&orchestrator.AuditorGrant{},
+	&orchestrator.AuditLogEntry{},
+	&orchestrator.CertificationObservation{},
+	&orchestrator.AssessmentStatistics{},
+	&orchestrator.MetricAssessmentStatistics{},
+	&orchestrator.ToolAssessmentStatistics{},
+	&orchestrator.AssessedResource{},
 	&evaluation.EvaluationResult{},
+	&evaluation.ComplianceSnapshot{},
+	&evaluation.Attestation{},
+	&evaluation.AttestationDocument{},
+	&evaluation.EvaluationRun{},
+	&persistence.Lease{},
 }
 
 // StorageOption is a functional option type to configure the GORM storage. E.g. WithInMemory or WithPostgres
@@ -97,10 +143,38 @@ func WithMaxOpenConns(max int) StorageOption {
 	}
 }
 
+// WithMaxIdleConns is an option to configure the maximum number of idle connections
+func WithMaxIdleConns(max int) StorageOption {
+	return func(s *storage) {
+		s.maxIdleConns = max
+	}
+}
+
+// WithConnMaxLifetime is an option to configure the maximum time a connection is reused, e.g., to balance connections
+// after a failover of the database
+func WithConnMaxLifetime(d time.Duration) StorageOption {
+	return func(s *storage) {
+		s.connMaxLifetime = d
+	}
+}
+
+// WithConnMaxIdleTime is an option to configure the maximum time a connection may be idle before it is closed
+func WithConnMaxIdleTime(d time.Duration) StorageOption {
+	return func(s *storage) {
+		s.connMaxIdleTime = d
+	}
+}
+
 // WithPostgres is an option to configure Storage to use a Postgres DB
 func WithPostgres(host string, port uint16, user string, pw string, db string, sslmode string) StorageOption {
+	return WithPostgresDSN(fmt.Sprintf("postgres://%s:%s@%s:%d/%s?sslmode=%s", user, pw, host, port, db, sslmode))
+}
+
+// WithPostgresDSN is an option to configure Storage to use a Postgres DB with the given DSN, either as URL or as
+// key/value pairs. This allows to specify further connection parameters, such as sslrootcert.
+func WithPostgresDSN(dsn string) StorageOption {
 	return func(s *storage) {
-		s.dialector = postgres.Open(fmt.Sprintf("postgres://%s:%s@%s:%d/%s?sslmode=%s", user, pw, host, port, db, sslmode))
+		s.dialector = newPostgresDialector(dsn)
 	}
 }
 
@@ -131,7 +205,8 @@ func NewStorage(opts ...StorageOption) (s persistence.Storage, err error) {
 		config: gorm.Config{
 			Logger: logger.Default.LogMode(logger.Silent),
 		},
-		types: DefaultTypes,
+		types:      DefaultTypes,
+		migrations: DefaultMigrations,
 	}
 
 	// Add options and/or override default ones
@@ -148,18 +223,35 @@ func NewStorage(opts ...StorageOption) (s persistence.Storage, err error) {
 		return nil, err
 	}
 
-	if g.maxConn > 0 {
-		sql, err := g.db.DB()
-		if err != nil {
-			return nil, fmt.Errorf("could not retrieve sql.DB: %v", err)
+	// Supply the encryptor and the compression setting to the serializers in all statements
+	if g.encryptor != nil || g.compression {
+		ctx := context.Background()
+
+		if g.encryptor != nil {
+			ctx = context.WithValue(ctx, encryptorKey{}, g.encryptor)
 		}
 
-		sql.SetMaxOpenConns(g.maxConn)
+		if g.compression {
+			ctx = context.WithValue(ctx, compressionKey{}, true)
+		}
+
+		g.db = g.db.WithContext(ctx)
+	}
+
+	// Observe the duration of all queries
+	if err = g.db.Use(metricsPlugin{}); err != nil {
+		return nil, fmt.Errorf("could not register metrics plugin: %w", err)
+	}
+
+	if err = g.configurePool(); err != nil {
+		return nil, err
 	}
 
 	schema.RegisterSerializer("timestamppb", &TimestampSerializer{})
 	schema.RegisterSerializer("valuepb", &ValueSerializer{})
 	schema.RegisterSerializer("anypb", &AnySerializer{})
+	schema.RegisterSerializer("encrypted", &EncryptedSerializer{})
+	schema.RegisterSerializer("compressedanypb", &CompressedAnySerializer{})
 
 	if err = g.db.SetupJoinTable(&orchestrator.CloudService{}, "CatalogsInScope", &orchestrator.TargetOfEvaluation{}); err != nil {
 		err = fmt.Errorf("error during join-table: %w", err)
@@ -171,9 +263,12 @@ func NewStorage(opts ...StorageOption) (s persistence.Storage, err error) {
 		return
 	}
 
+	if err = g.setupSoftDelete(); err != nil {
+		return
+	}
+
 	// After successful DB initialization, migrate the schema
-	if err = g.db.AutoMigrate(g.types...); err != nil {
-		err = fmt.Errorf("error during auto-migration: %w", err)
+	if err = g.migrate(); err != nil {
 		return
 	}
 
@@ -181,9 +276,46 @@ func NewStorage(opts ...StorageOption) (s persistence.Storage, err error) {
 	return
 }
 
+// configurePool configures the connection pool of the underlying sql.DB according to our options.
+func (s *storage) configurePool() error {
+	if s.maxConn <= 0 && s.maxIdleConns <= 0 && s.connMaxLifetime <= 0 && s.connMaxIdleTime <= 0 {
+		return nil
+	}
+
+	sql, err := s.db.DB()
+	if err != nil {
+		return fmt.Errorf("could not retrieve sql.DB: %v", err)
+	}
+
+	if s.maxConn > 0 {
+		sql.SetMaxOpenConns(s.maxConn)
+	}
+
+	if s.maxIdleConns > 0 {
+		sql.SetMaxIdleConns(s.maxIdleConns)
+	}
+
+	if s.connMaxLifetime > 0 {
+		sql.SetConnMaxLifetime(s.connMaxLifetime)
+	}
+
+	if s.connMaxIdleTime > 0 {
+		sql.SetConnMaxIdleTime(s.connMaxIdleTime)
+	}
+
+	return nil
+}
+
 func (s *storage) Create(r any) (err error) {
-	err = s.db.Create(r).Error
+	return constraintError(s.db.Create(r).Error)
+}
+
+func (s *storage) CreateInBatches(r any, batchSize int) (err error) {
+	return constraintError(s.db.CreateInBatches(r, batchSize).Error)
+}
 
+// constraintError translates errors of violated constraints into our persistence errors.
+func constraintError(err error) error {
 	if err != nil && (strings.Contains(err.Error(), "constraint failed: UNIQUE constraint failed") ||
 		strings.Contains(err.Error(), "duplicate key value violates unique constraint")) {
 		return persistence.ErrUniqueConstraintFailed
@@ -193,7 +325,7 @@ func (s *storage) Create(r any) (err error) {
 		return persistence.ErrConstraintFailed
 	}
 
-	return
+	return err
 }
 
 type preload struct {
@@ -242,8 +374,14 @@ func applyWhere(db *gorm.DB, conds ...any) *gorm.DB {
 }
 
 // applyPreload checks for any preload options and prepends them to the DB query. If no extra option is specified,
-// "clause.Associations" is used as the default preload.
+// "clause.Associations" is used as the default preload. Additionally, it applies a leading [WithDeleted] option.
 func applyPreload(db *gorm.DB, conds ...any) (*gorm.DB, []any) {
+	if len(conds) > 0 {
+		if _, ok := conds[0].(*withDeleted); ok {
+			db, conds = db.Unscoped(), conds[1:]
+		}
+	}
+
 	if len(conds) > 0 {
 		if preload, ok := conds[0].(*preload); ok {
 			if preload.query != "" {
@@ -282,10 +420,58 @@ func (s *storage) List(r any, orderBy string, asc bool, offset int, limit int, c
 	return query.Order(orderStmt).Find(r, conds...).Error
 }
 
+func (s *storage) ListAfter(r any, orderBy string, asc bool, after string, limit int, conds ...any) error {
+	var query = s.db
+	// Set default direction and comparison to "ascending"
+	var orderDirection = "asc"
+	var cmp = ">"
+
+	if limit != -1 {
+		query = query.Limit(limit)
+	}
+
+	// Set direction and comparison to "descending". Without an explicit ordering, we always sort ascending by ID.
+	if !asc && orderBy != "" {
+		orderDirection = "desc"
+		cmp = "<"
+	}
+
+	if orderBy == "" || orderBy == "id" {
+		query = query.Order("id " + orderDirection)
+
+		if after != "" {
+			query = query.Where("id "+cmp+" ?", after)
+		}
+	} else {
+		query = query.Order(orderBy + " " + orderDirection).Order("id " + orderDirection)
+
+		// Compare the (column, id) tuple with the one of the cursor record, so that we do not need to know the type of
+		// the column
+		if after != "" {
+			query = query.Where(fmt.Sprintf("(%s, id) %s (?)", orderBy, cmp),
+				s.db.Model(r).Select(orderBy, "id").Where("id = ?", after))
+		}
+	}
+
+	// Preload all associations of r if necessary
+	query, conds = applyPreload(query, conds...)
+
+	return query.Find(r, conds...).Error
+}
+
 func (s *storage) Raw(r any, query string, args ...any) error {
 	return s.db.Raw(query, args...).Scan(r).Error
 }
 
+func (s *storage) Ping(ctx context.Context) error {
+	db, err := s.db.DB()
+	if err != nil {
+		return err
+	}
+
+	return db.PingContext(ctx)
+}
+
 func (s *storage) Count(r any, conds ...any) (count int64, err error) {
 	db := applyWhere(s.db.Model(r), conds...)
 
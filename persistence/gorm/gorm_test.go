@@ -27,6 +27,7 @@
 package gorm
 
 import (
+	"context"
 	"fmt"
 	"testing"
 	"time"
@@ -140,6 +141,43 @@ func Test_storage_Create(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func Test_storage_CreateInBatches(t *testing.T) {
+	var (
+		err     error
+		s       persistence.Storage
+		metrics []*assessment.Metric
+		got     []*assessment.Metric
+	)
+
+	metrics = []*assessment.Metric{
+		{Id: testdata.MockMetricID1, Name: testdata.MockMetricName1, Range: mockMetricRange},
+		{Id: testdata.MockMetricID2, Name: testdata.MockMetricName2, Range: mockMetricRange},
+		{Id: "Mock Metric 3", Name: "Mock Metric Name 3", Range: mockMetricRange},
+	}
+
+	// Create storage
+	s, err = NewStorage()
+	assert.NoError(t, err)
+
+	err = s.CreateInBatches(metrics, 2)
+	assert.NoError(t, err)
+
+	err = s.List(&got, "", true, 0, -1)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(got))
+
+	// Creating a batch with an already existing metric should fail as a whole
+	err = s.CreateInBatches([]*assessment.Metric{
+		{Id: "Mock Metric 4", Name: "Mock Metric Name 4", Range: mockMetricRange},
+		metrics[0],
+	}, 1)
+	assert.ErrorIs(t, err, persistence.ErrUniqueConstraintFailed)
+
+	err = s.List(&got, "", true, 0, -1)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(got))
+}
+
 func Test_storage_Get(t *testing.T) {
 	var (
 		err     error
@@ -299,6 +337,62 @@ func Test_storage_List(t *testing.T) {
 	assert.Fail(t, "condition failed")
 }
 
+func Test_storage_ListAfter(t *testing.T) {
+	var (
+		err      error
+		s        persistence.Storage
+		services []*orchestrator.CloudService
+	)
+
+	ids := func(services []*orchestrator.CloudService) (ids []string) {
+		for _, s := range services {
+			ids = append(ids, s.Id)
+		}
+		return
+	}
+
+	// Create storage
+	s, err = NewStorage()
+	assert.NoError(t, err)
+
+	// Two of our services share the same name, so that the ID is needed as tie-breaker
+	assert.NoError(t, s.Create(&orchestrator.CloudService{Id: testdata.MockCloudServiceID1, Name: "b"}))
+	assert.NoError(t, s.Create(&orchestrator.CloudService{Id: testdata.MockCloudServiceID2, Name: "a"}))
+	assert.NoError(t, s.Create(&orchestrator.CloudService{Id: "33333333-3333-3333-3333-333333333333", Name: "b"}))
+
+	// Without explicit ordering, we should get all services ascending by ID, regardless of asc
+	err = s.ListAfter(&services, "", false, "", -1)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{testdata.MockCloudServiceID1, testdata.MockCloudServiceID2, "33333333-3333-3333-3333-333333333333"}, ids(services))
+
+	err = s.ListAfter(&services, "", false, testdata.MockCloudServiceID1, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{testdata.MockCloudServiceID2}, ids(services))
+
+	// Ascending by name
+	err = s.ListAfter(&services, "name", true, testdata.MockCloudServiceID2, -1)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{testdata.MockCloudServiceID1, "33333333-3333-3333-3333-333333333333"}, ids(services))
+
+	err = s.ListAfter(&services, "name", true, testdata.MockCloudServiceID1, -1)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"33333333-3333-3333-3333-333333333333"}, ids(services))
+
+	// Descending by name
+	err = s.ListAfter(&services, "name", false, "33333333-3333-3333-3333-333333333333", 1)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{testdata.MockCloudServiceID1}, ids(services))
+
+	// Additional conditions
+	err = s.ListAfter(&services, "name", false, "", -1, "name = ?", "b")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"33333333-3333-3333-3333-333333333333", testdata.MockCloudServiceID1}, ids(services))
+
+	// Invalid column
+	err = s.ListAfter(&services, "no_column", true, testdata.MockCloudServiceID1, -1)
+	assert.Error(t, err)
+}
+
 func Test_storage_Count(t *testing.T) {
 	var (
 		count    int64
@@ -469,3 +563,19 @@ func Test_storage_Delete(t *testing.T) {
 	// Should return DB error since a non-supported type is passed (just a string instead of, e.g., &orchestrator.CloudService{})
 	assert.Contains(t, s.Delete("Unsupported Type").Error(), "unsupported data type")
 }
+
+func Test_storage_Ping(t *testing.T) {
+	s, err := NewStorage()
+	assert.NoError(t, err)
+
+	err = s.Ping(context.Background())
+	assert.NoError(t, err)
+
+	// Close the underlying connection, afterwards the DB should not be reachable anymore
+	db, err := s.(*storage).db.DB()
+	assert.NoError(t, err)
+	assert.NoError(t, db.Close())
+
+	err = s.Ping(context.Background())
+	assert.Error(t, err)
+}
@@ -0,0 +1,99 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package gorm
+
+import (
+	"time"
+
+	"clouditor.io/clouditor/v2/internal/telemetry"
+
+	"gorm.io/gorm"
+)
+
+// startKey is the key under which the start time of a query is stored in the statement instance.
+const startKey = "clouditor:query_start"
+
+// metricsPlugin is a [gorm.Plugin] that observes the duration of all database queries by operation.
+type metricsPlugin struct{}
+
+// Name returns the name of the plugin.
+func (metricsPlugin) Name() string {
+	return "clouditor:metrics"
+}
+
+// registerer is a GORM callback that a function can be registered to.
+type registerer interface {
+	Register(name string, fn func(*gorm.DB)) error
+}
+
+// Initialize registers callbacks before and after each operation of GORM.
+func (metricsPlugin) Initialize(db *gorm.DB) (err error) {
+	var cb = db.Callback()
+
+	for _, op := range []struct {
+		name   string
+		before registerer
+		after  registerer
+	}{
+		{"create", cb.Create().Before("*"), cb.Create().After("*")},
+		{"query", cb.Query().Before("*"), cb.Query().After("*")},
+		{"update", cb.Update().Before("*"), cb.Update().After("*")},
+		{"delete", cb.Delete().Before("*"), cb.Delete().After("*")},
+		{"row", cb.Row().Before("*"), cb.Row().After("*")},
+		{"raw", cb.Raw().Before("*"), cb.Raw().After("*")},
+	} {
+		err = op.before.Register("clouditor:before_"+op.name, beforeQuery)
+		if err != nil {
+			return err
+		}
+
+		err = op.after.Register("clouditor:after_"+op.name, afterQuery(op.name))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// beforeQuery stores the start time of the query.
+func beforeQuery(db *gorm.DB) {
+	db.InstanceSet(startKey, time.Now())
+}
+
+// afterQuery observes the duration of the query of the given operation.
+func afterQuery(operation string) func(db *gorm.DB) {
+	return func(db *gorm.DB) {
+		v, ok := db.InstanceGet(startKey)
+		if !ok {
+			return
+		}
+
+		if start, ok := v.(time.Time); ok {
+			telemetry.DBQueryDuration.WithLabelValues(operation).Observe(time.Since(start).Seconds())
+		}
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package gorm
+
+import (
+	"testing"
+
+	"clouditor.io/clouditor/v2/api/assessment"
+	"clouditor.io/clouditor/v2/internal/telemetry"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+)
+
+func Test_metricsPlugin(t *testing.T) {
+	before := map[string]uint64{
+		"create": sampleCount(t, "create"),
+		"query":  sampleCount(t, "query"),
+	}
+
+	s, err := NewStorage()
+	assert.NoError(t, err)
+
+	err = s.Create(&assessment.Metric{Id: testdata.MockMetricID1, Name: testdata.MockMetricName1})
+	assert.NoError(t, err)
+
+	err = s.Get(&assessment.Metric{}, "id = ?", testdata.MockMetricID1)
+	assert.NoError(t, err)
+
+	for operation, count := range before {
+		assert.True(t, sampleCount(t, operation) > count)
+	}
+}
+
+// sampleCount returns the current number of observed queries of the given operation.
+func sampleCount(t *testing.T, operation string) uint64 {
+	var m dto.Metric
+
+	err := telemetry.DBQueryDuration.WithLabelValues(operation).(prometheus.Metric).Write(&m)
+	assert.NoError(t, err)
+
+	return m.GetHistogram().GetSampleCount()
+}
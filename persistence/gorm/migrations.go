@@ -0,0 +1,170 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package gorm
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// migrationLockID is the key of the PostgreSQL advisory lock, which makes sure that only one replica migrates the
+// database at a time.
+const migrationLockID = 0x636c6f7564 // "cloud"
+
+// Migration is a versioned change of the database schema or its data, which cannot be expressed using GORM's
+// auto-migration, e.g., the creation of composite indices or the transformation of existing records. Migrations are
+// applied in the order of their version after the auto-migration and each migration is only applied once.
+type Migration struct {
+	// Version identifies the migration. It needs to be unique and must never be changed once released.
+	Version uint
+
+	// Description is a short human-readable summary of the migration.
+	Description string
+
+	// Migrate applies the migration. It is run in its own transaction, together with recording the migration.
+	Migrate func(tx *gorm.DB) error
+}
+
+// schemaMigration records an applied [Migration] in the database.
+type schemaMigration struct {
+	Version     uint `gorm:"primaryKey;autoIncrement:false"`
+	Description string
+	AppliedAt   time.Time
+}
+
+// TableName implements schema.Tabler.
+func (schemaMigration) TableName() string {
+	return "schema_migrations"
+}
+
+// DefaultMigrations contains the migrations of our internal types that are applied by default.
+var DefaultMigrations = []Migration{
+	{
+		Version:     1,
+		Description: "Add indices for listing evidences and results of a cloud service by time",
+		Migrate: func(tx *gorm.DB) error {
+			return execAll(tx,
+				"CREATE INDEX IF NOT EXISTS idx_evidences_cloud_service_timestamp ON evidences (cloud_service_id, timestamp)",
+				"CREATE INDEX IF NOT EXISTS idx_assessment_results_cloud_service_timestamp ON assessment_results (cloud_service_id, timestamp)",
+				"CREATE INDEX IF NOT EXISTS idx_evaluation_results_cloud_service_control_timestamp ON evaluation_results (cloud_service_id, control_catalog_id, control_id, timestamp)",
+				"CREATE INDEX IF NOT EXISTS idx_audit_log_entries_timestamp ON audit_log_entries (timestamp)",
+			)
+		},
+	},
+}
+
+// WithAdditionalMigrations is an option to add additional migrations, e.g., for types added with
+// [WithAdditionalAutoMigration]. Their versions must not collide with the ones of [DefaultMigrations].
+func WithAdditionalMigrations(migrations ...Migration) StorageOption {
+	return func(s *storage) {
+		s.migrations = append(s.migrations, migrations...)
+	}
+}
+
+// migrate auto-migrates the types of the storage and applies all migrations that were not applied yet. In PostgreSQL,
+// this happens in a transaction holding an advisory lock, so that multiple replicas starting at the same time do not
+// interfere with each other.
+func (s *storage) migrate() (err error) {
+	if s.db.Dialector.Name() != "postgres" {
+		return migrate(s.db, s.types, s.migrations)
+	}
+
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("SELECT pg_advisory_xact_lock(?)", migrationLockID).Error; err != nil {
+			return fmt.Errorf("could not acquire migration lock: %w", err)
+		}
+
+		return migrate(tx, s.types, s.migrations)
+	})
+}
+
+// migrate auto-migrates the types and afterward applies the migrations, which are not yet recorded in the database.
+func migrate(db *gorm.DB, types []any, migrations []Migration) (err error) {
+	var applied []uint
+
+	if err = db.AutoMigrate(types...); err != nil {
+		return fmt.Errorf("error during auto-migration: %w", err)
+	}
+
+	if err = db.AutoMigrate(&schemaMigration{}); err != nil {
+		return fmt.Errorf("error during auto-migration: %w", err)
+	}
+
+	migrations = slices.Clone(migrations)
+	slices.SortFunc(migrations, func(a, b Migration) int {
+		return cmp.Compare(a.Version, b.Version)
+	})
+
+	for i := 1; i < len(migrations); i++ {
+		if migrations[i].Version == migrations[i-1].Version {
+			return fmt.Errorf("duplicate migration version %d", migrations[i].Version)
+		}
+	}
+
+	if err = db.Model(&schemaMigration{}).Pluck("version", &applied).Error; err != nil {
+		return fmt.Errorf("could not retrieve applied migrations: %w", err)
+	}
+
+	for _, m := range migrations {
+		if slices.Contains(applied, m.Version) {
+			continue
+		}
+
+		log.Infof("Applying database migration %d: %s", m.Version, m.Description)
+
+		err = db.Transaction(func(tx *gorm.DB) error {
+			if err := m.Migrate(tx); err != nil {
+				return err
+			}
+
+			return tx.Create(&schemaMigration{
+				Version:     m.Version,
+				Description: m.Description,
+				AppliedAt:   time.Now(),
+			}).Error
+		})
+		if err != nil {
+			return fmt.Errorf("error during migration %d: %w", m.Version, err)
+		}
+	}
+
+	return nil
+}
+
+// execAll executes the given SQL statements one after another.
+func execAll(tx *gorm.DB, statements ...string) (err error) {
+	for _, stmt := range statements {
+		if err = tx.Exec(stmt).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
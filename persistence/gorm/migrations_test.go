@@ -0,0 +1,108 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package gorm
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+
+	"github.com/glebarez/sqlite"
+	"gorm.io/gorm"
+)
+
+// withSQLiteFile is an option to use a file-based SQLite database, so that we can re-open it.
+func withSQLiteFile(path string) StorageOption {
+	return func(s *storage) {
+		s.dialector = sqlite.Open(path)
+	}
+}
+
+func TestNewStorage_migrations(t *testing.T) {
+	var (
+		path  = filepath.Join(t.TempDir(), "clouditor.db")
+		calls int
+		m     = Migration{
+			Version:     1000,
+			Description: "test migration",
+			Migrate: func(tx *gorm.DB) error {
+				calls++
+				return tx.Exec("CREATE TABLE test_migration (id INTEGER)").Error
+			},
+		}
+	)
+
+	s, err := NewStorage(withSQLiteFile(path), WithAdditionalMigrations(m))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, calls)
+
+	var applied []schemaMigration
+	err = s.List(&applied, "version", true, 0, -1)
+	assert.NoError(t, err)
+	assert.Equal(t, len(DefaultMigrations)+1, len(applied))
+	assert.Equal(t, uint(1000), applied[len(applied)-1].Version)
+	assert.True(t, s.(*storage).db.Migrator().HasIndex("evidences", "idx_evidences_cloud_service_timestamp"))
+
+	// Re-opening the database must not apply the migrations again
+	_, err = NewStorage(withSQLiteFile(path), WithAdditionalMigrations(m))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, calls)
+}
+
+func TestNewStorage_failedMigration(t *testing.T) {
+	var path = filepath.Join(t.TempDir(), "clouditor.db")
+
+	_, err := NewStorage(withSQLiteFile(path), WithAdditionalMigrations(Migration{
+		Version: 1000,
+		Migrate: func(tx *gorm.DB) error {
+			if err := tx.Exec("CREATE TABLE test_migration (id INTEGER)").Error; err != nil {
+				return err
+			}
+
+			return errors.New("some error")
+		},
+	}))
+	assert.ErrorContains(t, err, "error during migration 1000: some error")
+
+	// The migration needs to be rolled back and not recorded
+	s, err := NewStorage(withSQLiteFile(path))
+	assert.NoError(t, err)
+
+	count, err := s.Count(&schemaMigration{}, "version = ?", 1000)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), count)
+	assert.False(t, s.(*storage).db.Migrator().HasTable("test_migration"))
+}
+
+func TestNewStorage_duplicateMigration(t *testing.T) {
+	_, err := NewStorage(WithAdditionalMigrations(Migration{
+		Version: 1,
+		Migrate: func(tx *gorm.DB) error { return nil },
+	}))
+	assert.ErrorContains(t, err, "duplicate migration version 1")
+}
@@ -0,0 +1,64 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package gorm
+
+import (
+	"strings"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/migrator"
+	"gorm.io/gorm/schema"
+)
+
+// postgresDialector is a PostgreSQL dialector, which translates the column types of our struct tags that are not
+// known to PostgreSQL. Most notably, timestamps are tagged with "datetime", which only exists in SQLite and MySQL.
+type postgresDialector struct {
+	postgres.Dialector
+}
+
+// newPostgresDialector creates a new [postgresDialector] for the given DSN.
+func newPostgresDialector(dsn string) gorm.Dialector {
+	return &postgresDialector{Dialector: postgres.Dialector{Config: &postgres.Config{DSN: dsn}}}
+}
+
+// Migrator returns the PostgreSQL migrator, which needs to use our dialector, so that our column types are used.
+func (d postgresDialector) Migrator(db *gorm.DB) gorm.Migrator {
+	return postgres.Migrator{Migrator: migrator.Migrator{Config: migrator.Config{
+		DB:                          db,
+		Dialector:                   d,
+		CreateIndexAfterCreateTable: true,
+	}}}
+}
+
+// DataTypeOf returns the column type of the field.
+func (d postgresDialector) DataTypeOf(field *schema.Field) string {
+	if strings.EqualFold(string(field.DataType), "datetime") {
+		return "timestamptz"
+	}
+
+	return d.Dialector.DataTypeOf(field)
+}
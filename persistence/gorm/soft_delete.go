@@ -0,0 +1,144 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package gorm
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+
+	"clouditor.io/clouditor/v2/persistence"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+)
+
+// softDeleteColumn is the column of types that are soft-deleted. Instead of deleting their records, the time of the
+// deletion is stored in this column and the records are excluded from all queries, unless [WithDeleted] is used.
+const softDeleteColumn = "deleted_at"
+
+// withDeleted is a [QueryOption] to include soft-deleted records.
+type withDeleted struct{}
+
+// WithDeleted includes soft-deleted records in the query.
+func WithDeleted() QueryOption {
+	return &withDeleted{}
+}
+
+// setupSoftDelete enables soft deletion for all types having a [softDeleteColumn]. Since our types are generated out
+// of protobuf messages, they cannot use [gorm.DeletedAt]. Instead, we add its clauses to the parsed schemas, which
+// are cached.
+func (s *storage) setupSoftDelete() (err error) {
+	for _, t := range s.types {
+		stmt := &gorm.Statement{DB: s.db}
+		if err = stmt.Parse(t); err != nil {
+			return fmt.Errorf("could not parse schema: %w", err)
+		}
+
+		field := stmt.Schema.LookUpField(softDeleteColumn)
+		if field == nil {
+			continue
+		}
+
+		stmt.Schema.QueryClauses = append(stmt.Schema.QueryClauses, gorm.SoftDeleteQueryClause{Field: field})
+		stmt.Schema.UpdateClauses = append(stmt.Schema.UpdateClauses, gorm.SoftDeleteUpdateClause{Field: field})
+		stmt.Schema.DeleteClauses = append(stmt.Schema.DeleteClauses, softDeleteDeleteClause{Field: field})
+	}
+
+	return nil
+}
+
+// softDeleteDeleteClause turns a DELETE into an UPDATE of the [softDeleteColumn]. It corresponds to
+// [gorm.SoftDeleteDeleteClause], but sets a [timestamppb.Timestamp] instead of a [time.Time] in the deleted record.
+type softDeleteDeleteClause struct {
+	Field *schema.Field
+}
+
+func (sd softDeleteDeleteClause) Name() string {
+	return ""
+}
+
+func (sd softDeleteDeleteClause) Build(clause.Builder) {
+}
+
+func (sd softDeleteDeleteClause) MergeClause(*clause.Clause) {
+}
+
+func (sd softDeleteDeleteClause) ModifyStatement(stmt *gorm.Statement) {
+	if stmt.SQL.Len() != 0 || stmt.Statement.Unscoped {
+		return
+	}
+
+	now := stmt.DB.NowFunc()
+	stmt.AddClause(clause.Set{{Column: clause.Column{Name: sd.Field.DBName}, Value: now}})
+	stmt.SetColumn(sd.Field.DBName, timestamppb.New(now), true)
+
+	// Restrict the update to the primary keys of the record(s), if specified
+	if stmt.Schema != nil {
+		_, pks := schema.GetIdentityFieldValuesMap(stmt.Context, stmt.ReflectValue, stmt.Schema.PrimaryFields)
+		column, values := schema.ToQueryValues(stmt.Table, stmt.Schema.PrimaryFieldDBNames, pks)
+		if len(values) > 0 {
+			stmt.AddClause(clause.Where{Exprs: []clause.Expression{clause.IN{Column: column, Values: values}}})
+		}
+
+		if stmt.ReflectValue.CanAddr() && stmt.Dest != stmt.Model && stmt.Model != nil {
+			_, pks = schema.GetIdentityFieldValuesMap(stmt.Context, reflect.ValueOf(stmt.Model), stmt.Schema.PrimaryFields)
+			column, values = schema.ToQueryValues(stmt.Table, stmt.Schema.PrimaryFieldDBNames, pks)
+			if len(values) > 0 {
+				stmt.AddClause(clause.Where{Exprs: []clause.Expression{clause.IN{Column: column, Values: values}}})
+			}
+		}
+	}
+
+	// Records that are already deleted must not be deleted again
+	gorm.SoftDeleteQueryClause{Field: sd.Field}.ModifyStatement(stmt)
+	stmt.AddClauseIfNotExists(clause.Update{})
+	stmt.Build(stmt.DB.Callback().Update().Clauses...)
+}
+
+func (s *storage) Restore(r any, conds ...any) error {
+	tx := applyWhere(s.db.Unscoped().Model(r), conds...).
+		Where(softDeleteColumn+" IS NOT NULL").
+		Update(softDeleteColumn, nil)
+	if err := tx.Error; err != nil {
+		return err
+	}
+
+	// No soft-deleted record found
+	if tx.RowsAffected == 0 {
+		return persistence.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+func (s *storage) Purge(r any, before time.Time) (count int64, err error) {
+	tx := s.db.Unscoped().Where(softDeleteColumn+" < ?", before).Delete(r)
+
+	return tx.RowsAffected, constraintError(tx.Error)
+}
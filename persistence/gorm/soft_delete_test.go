@@ -0,0 +1,85 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package gorm
+
+import (
+	"testing"
+	"time"
+
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/testutil/servicetest/orchestratortest"
+	"clouditor.io/clouditor/v2/persistence"
+)
+
+func Test_storage_softDelete(t *testing.T) {
+	s, err := NewStorage()
+	assert.NoError(t, err)
+	assert.NoError(t, s.Create(orchestratortest.NewCloudService()))
+
+	// Delete only marks the record as deleted
+	assert.NoError(t, s.Delete(&orchestrator.CloudService{Id: testdata.MockCloudServiceID1}))
+
+	var cs orchestrator.CloudService
+	assert.ErrorIs(t, s.Get(&cs, "id = ?", testdata.MockCloudServiceID1), persistence.ErrRecordNotFound)
+
+	count, err := s.Count(&orchestrator.CloudService{})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), count)
+
+	assert.NoError(t, s.Get(&cs, WithDeleted(), "id = ?", testdata.MockCloudServiceID1))
+	assert.NotNil(t, cs.DeletedAt)
+
+	var list []*orchestrator.CloudService
+	assert.NoError(t, s.List(&list, "", true, 0, -1, WithDeleted()))
+	assert.Equal(t, 1, len(list))
+
+	// Deleted records can neither be deleted nor updated again
+	assert.ErrorIs(t, s.Delete(&orchestrator.CloudService{Id: testdata.MockCloudServiceID1}), persistence.ErrRecordNotFound)
+	assert.ErrorIs(t, s.Update(&orchestrator.CloudService{Name: "other"}, "id = ?", testdata.MockCloudServiceID1),
+		persistence.ErrRecordNotFound)
+
+	// Restore the record
+	assert.NoError(t, s.Restore(&orchestrator.CloudService{}, "id = ?", testdata.MockCloudServiceID1))
+	var restored orchestrator.CloudService
+	assert.NoError(t, s.Get(&restored, "id = ?", testdata.MockCloudServiceID1))
+	assert.Nil(t, restored.DeletedAt)
+	assert.ErrorIs(t, s.Restore(&orchestrator.CloudService{}, "id = ?", testdata.MockCloudServiceID1),
+		persistence.ErrRecordNotFound)
+
+	// Purge only permanently deletes records that were deleted before the given time
+	assert.NoError(t, s.Delete(&orchestrator.CloudService{Id: testdata.MockCloudServiceID1}))
+
+	count, err = s.Purge(&orchestrator.CloudService{}, time.Now().Add(-time.Hour))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), count)
+
+	count, err = s.Purge(&orchestrator.CloudService{}, time.Now().Add(time.Second))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+	assert.ErrorIs(t, s.Get(&cs, WithDeleted(), "id = ?", testdata.MockCloudServiceID1), persistence.ErrRecordNotFound)
+}
@@ -26,8 +26,10 @@
 package persistence
 
 import (
+	"context"
 	"errors"
 	"strings"
+	"time"
 )
 
 var (
@@ -45,6 +47,10 @@ type Storage interface {
 	// Create creates a new object and put it into the DB
 	Create(r any) error
 
+	// CreateInBatches creates all objects of the slice r in the DB within a single transaction. The objects are
+	// inserted in batches of the given size, so that large slices do not exceed the limits of the DB.
+	CreateInBatches(r any, batchSize int) error
+
 	// Save updates the record r (workaround with conds needed that e.g. user has no specific ID, and we can not touch
 	// the generated (gRPC) code s.t. user.username has primary key annotation)
 	Save(r any, conds ...any) error
@@ -61,14 +67,32 @@ type Storage interface {
 	// Whitelist the set of possible column names to avoid injections.
 	List(r any, orderBy string, asc bool, offset int, limit int, conds ...any) error
 
+	// ListAfter lists all records in database which meet the (optionally) given conditions with a certain limit. In
+	// contrast to List, it does not skip an offset but only returns records that come after the record with the ID
+	// after in the order of orderBy (column) and asc. The ID is used as a tie-breaker, so that the order is stable.
+	// If after is empty, the records are listed from the beginning. If orderBy is empty, the records are ordered
+	// ascending by their ID. The column names need to be whitelisted as well.
+	ListAfter(r any, orderBy string, asc bool, after string, limit int, conds ...any) error
+
 	// Count counts the number of records which meet the (optionally) given conditions
 	Count(r any, conds ...any) (int64, error)
 
-	// Delete deletes the record with given id of the DB
+	// Delete deletes the record with given id of the DB. Records of types supporting soft deletion, i.e., having a
+	// deleted_at column, are only marked as deleted and can be restored.
 	Delete(r any, conds ...any) error
 
+	// Restore restores the soft-deleted records which meet the given conditions
+	Restore(r any, conds ...any) error
+
+	// Purge permanently deletes all records of the type of r which were soft-deleted before the given time and
+	// returns their number
+	Purge(r any, before time.Time) (int64, error)
+
 	// Raw executes a raw SQL statement and stores the result in r
 	Raw(r any, query string, args ...any) error
+
+	// Ping checks whether the DB is reachable
+	Ping(ctx context.Context) error
 }
 
 // BuildConds prepares the conds used in [Storage.List] out of arrays of query and args.
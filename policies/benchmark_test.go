@@ -0,0 +1,137 @@
+// Copyright 2021 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package policies
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"clouditor.io/clouditor/v2/api/assessment"
+	"clouditor.io/clouditor/v2/api/evidence"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil/servicetest/evidencetest"
+
+	"github.com/sirupsen/logrus"
+)
+
+// cachedMetricsSource caches the metric configurations of [mockMetricsSource], like the assessment does, so that
+// the benchmarks measure the Rego evaluation instead of reading the configuration files.
+type cachedMetricsSource struct {
+	mockMetricsSource
+
+	mu      sync.Mutex
+	configs map[string]*assessment.MetricConfiguration
+}
+
+func (c *cachedMetricsSource) MetricConfiguration(serviceID, metricID string) (config *assessment.MetricConfiguration, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if config, ok := c.configs[serviceID+metricID]; ok {
+		return config, nil
+	}
+
+	config, err = c.mockMetricsSource.MetricConfiguration(serviceID, metricID)
+	if err == nil {
+		c.configs[serviceID+metricID] = config
+	}
+
+	return
+}
+
+// BenchmarkRegoEval measures the evaluation of all applicable metrics for a single resource of each type synthesized
+// by [evidencetest.NewResource]. The applicable metrics and the prepared queries are cached before the timer starts.
+func BenchmarkRegoEval(b *testing.B) {
+	logrus.SetLevel(logrus.PanicLevel)
+
+	src := &cachedMetricsSource{
+		mockMetricsSource: mockMetricsSource{t: b},
+		configs:           make(map[string]*assessment.MetricConfiguration),
+	}
+	pe := NewRegoEval()
+
+	for i := 0; i < 5; i++ {
+		var (
+			r  = evidencetest.NewResource(i)
+			ev = &evidence.Evidence{Id: fmt.Sprintf("%d", i), CloudServiceId: testdata.MockCloudServiceID1, ToolId: testdata.MockEvidenceToolID1}
+		)
+
+		b.Run(string(r.ProtoReflect().Descriptor().Name()), func(b *testing.B) {
+			results, err := pe.Eval(ev, r, src)
+			if err != nil {
+				b.Fatalf("Error while calling Eval: %v", err)
+			}
+
+			b.ReportAllocs()
+			b.ResetTimer()
+
+			for n := 0; n < b.N; n++ {
+				_, err = pe.Eval(ev, r, src)
+				if err != nil {
+					b.Errorf("Error while calling Eval: %v", err)
+				}
+			}
+
+			b.ReportMetric(float64(len(results)), "metrics/op")
+		})
+	}
+}
+
+// BenchmarkRegoEvalParallel measures the throughput of the evaluation of resources of all types by concurrent
+// callers, e.g., multiple evidence streams of the assessment.
+func BenchmarkRegoEvalParallel(b *testing.B) {
+	logrus.SetLevel(logrus.PanicLevel)
+
+	src := &cachedMetricsSource{
+		mockMetricsSource: mockMetricsSource{t: b},
+		configs:           make(map[string]*assessment.MetricConfiguration),
+	}
+	pe := NewRegoEval()
+	ev := &evidence.Evidence{Id: "1", CloudServiceId: testdata.MockCloudServiceID1, ToolId: testdata.MockEvidenceToolID1}
+
+	for i := 0; i < 5; i++ {
+		if _, err := pe.Eval(ev, evidencetest.NewResource(i), src); err != nil {
+			b.Fatalf("Error while calling Eval: %v", err)
+		}
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	b.RunParallel(func(pb *testing.PB) {
+		var i int
+
+		for pb.Next() {
+			if _, err := pe.Eval(ev, evidencetest.NewResource(i), src); err != nil {
+				b.Errorf("Error while calling Eval: %v", err)
+			}
+			i++
+		}
+	})
+
+	b.ReportMetric(float64(b.N)/b.Elapsed().Seconds(), "resources/s")
+}
@@ -0,0 +1,277 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package policies
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strings"
+
+	"clouditor.io/clouditor/v2/api/assessment"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	// bundleManifestFile is the name of the manifest of a bundle.
+	bundleManifestFile = ".manifest"
+
+	// bundleDataFile is the name of the file containing the metrics and metric configurations of a bundle. According to
+	// the OPA bundle format, its contents are available as data.clouditor.
+	bundleDataFile = "clouditor/data.json"
+
+	// bundleMetricsDir is the directory containing the Rego implementations of the metrics of a bundle. Each file is
+	// named after its metric.
+	bundleMetricsDir = "clouditor/metrics/"
+)
+
+// ErrInvalidBundle indicates that a bundle could not be read.
+var ErrInvalidBundle = errors.New("invalid bundle")
+
+// Bundle contains all metrics as well as their implementations and configurations, so that they can be distributed to
+// assessment services. It is (de-)serialized as gzipped tarball according to the OPA bundle format
+// (https://www.openpolicyagent.org/docs/latest/management-bundles/#bundle-file-format).
+type Bundle struct {
+	// Metrics contains all metrics.
+	Metrics []*assessment.Metric
+
+	// Implementations contains the Rego implementation of each metric with key being the metric ID.
+	Implementations map[string]*assessment.MetricImplementation
+
+	// Configurations contains the configuration of each metric per cloud service, with the keys being the cloud
+	// service ID and the metric ID.
+	Configurations map[string]map[string]*assessment.MetricConfiguration
+}
+
+// bundleManifest is the manifest of a bundle.
+type bundleManifest struct {
+	Revision string   `json:"revision"`
+	Roots    []string `json:"roots"`
+}
+
+// bundleData is the content of the data file of a bundle. The messages are serialized using protojson.
+type bundleData struct {
+	Metrics        []json.RawMessage                     `json:"metrics"`
+	Configurations map[string]map[string]json.RawMessage `json:"configurations"`
+}
+
+// Revision returns the revision of the bundle, which is a hash of its contents. It changes if, and only if, a metric,
+// an implementation or a configuration changes.
+func (b *Bundle) Revision() (revision string, err error) {
+	files, err := b.files()
+	if err != nil {
+		return "", err
+	}
+
+	return files.hash(), nil
+}
+
+// Write writes the bundle as gzipped tarball to w. The tarball is reproducible, i.e., the same bundle always results
+// in the same bytes.
+func (b *Bundle) Write(w io.Writer) (err error) {
+	files, err := b.files()
+	if err != nil {
+		return err
+	}
+
+	manifest, err := json.Marshal(bundleManifest{Revision: files.hash(), Roots: []string{"clouditor"}})
+	if err != nil {
+		return err
+	}
+
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+
+	for _, f := range append([]bundleFile{{name: bundleManifestFile, data: manifest}}, files...) {
+		err = tw.WriteHeader(&tar.Header{
+			Typeflag: tar.TypeReg,
+			Name:     f.name,
+			Mode:     0644,
+			Size:     int64(len(f.data)),
+		})
+		if err != nil {
+			return err
+		}
+
+		if _, err = tw.Write(f.data); err != nil {
+			return err
+		}
+	}
+
+	if err = tw.Close(); err != nil {
+		return err
+	}
+
+	return gz.Close()
+}
+
+// ReadBundle reads a bundle written by [Bundle.Write] from r.
+func ReadBundle(r io.Reader) (b *Bundle, err error) {
+	var data bundleData
+
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidBundle, err)
+	}
+	defer gz.Close()
+
+	b = &Bundle{
+		Implementations: make(map[string]*assessment.MetricImplementation),
+		Configurations:  make(map[string]map[string]*assessment.MetricConfiguration),
+	}
+
+	tr := tar.NewReader(gz)
+	for {
+		h, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidBundle, err)
+		}
+
+		content, err := io.ReadAll(tr)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidBundle, err)
+		}
+
+		name := strings.TrimPrefix(h.Name, "/")
+		switch {
+		case name == bundleDataFile:
+			if err = json.Unmarshal(content, &data); err != nil {
+				return nil, fmt.Errorf("%w: %w", ErrInvalidBundle, err)
+			}
+		case strings.HasPrefix(name, bundleMetricsDir) && path.Ext(name) == ".rego":
+			metricID := strings.TrimSuffix(path.Base(name), ".rego")
+			b.Implementations[metricID] = &assessment.MetricImplementation{
+				MetricId: metricID,
+				Lang:     assessment.MetricImplementation_LANGUAGE_REGO,
+				Code:     string(content),
+			}
+		}
+	}
+
+	for _, raw := range data.Metrics {
+		var metric assessment.Metric
+
+		if err = protojson.Unmarshal(raw, &metric); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidBundle, err)
+		}
+
+		b.Metrics = append(b.Metrics, &metric)
+	}
+
+	for serviceID, configs := range data.Configurations {
+		b.Configurations[serviceID] = make(map[string]*assessment.MetricConfiguration)
+
+		for metricID, raw := range configs {
+			var config assessment.MetricConfiguration
+
+			if err = protojson.Unmarshal(raw, &config); err != nil {
+				return nil, fmt.Errorf("%w: %w", ErrInvalidBundle, err)
+			}
+
+			b.Configurations[serviceID][metricID] = &config
+		}
+	}
+
+	return b, nil
+}
+
+// bundleFile is a single file of a bundle.
+type bundleFile struct {
+	name string
+	data []byte
+}
+
+type bundleFiles []bundleFile
+
+// files returns the files of the bundle (except for the manifest) sorted by their name.
+func (b *Bundle) files() (files bundleFiles, err error) {
+	var (
+		data = bundleData{Configurations: make(map[string]map[string]json.RawMessage)}
+		opts = protojson.MarshalOptions{}
+	)
+
+	for _, metric := range b.Metrics {
+		raw, err := opts.Marshal(metric)
+		if err != nil {
+			return nil, err
+		}
+
+		data.Metrics = append(data.Metrics, raw)
+	}
+
+	for serviceID, configs := range b.Configurations {
+		data.Configurations[serviceID] = make(map[string]json.RawMessage)
+
+		for metricID, config := range configs {
+			raw, err := opts.Marshal(config)
+			if err != nil {
+				return nil, err
+			}
+
+			data.Configurations[serviceID][metricID] = raw
+		}
+	}
+
+	// The keys of maps are sorted by encoding/json, so that the data file is reproducible
+	content, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	files = append(files, bundleFile{name: bundleDataFile, data: content})
+
+	for metricID, impl := range b.Implementations {
+		files = append(files, bundleFile{name: bundleMetricsDir + metricID + ".rego", data: []byte(impl.Code)})
+	}
+
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].name < files[j].name
+	})
+
+	return files, nil
+}
+
+// hash returns the hex-encoded SHA-256 hash of the names and contents of all files.
+func (files bundleFiles) hash() string {
+	h := sha256.New()
+
+	for _, f := range files {
+		fmt.Fprintf(h, "%s\x00%d\x00", f.name, len(f.data))
+		h.Write(f.data)
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
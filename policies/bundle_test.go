@@ -0,0 +1,102 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package policies
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"clouditor.io/clouditor/v2/api/assessment"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func newTestBundle() *Bundle {
+	return &Bundle{
+		Metrics: []*assessment.Metric{
+			{Id: testdata.MockMetricID1, Version: "1.0"},
+			{Id: testdata.MockMetricID2, Version: "1.0"},
+		},
+		Implementations: map[string]*assessment.MetricImplementation{
+			testdata.MockMetricID1: {
+				MetricId: testdata.MockMetricID1,
+				Lang:     assessment.MetricImplementation_LANGUAGE_REGO,
+				Code:     "package clouditor.metrics.mock_metric_1",
+			},
+		},
+		Configurations: map[string]map[string]*assessment.MetricConfiguration{
+			testdata.MockCloudServiceID1: {
+				testdata.MockMetricID1: {
+					MetricId:       testdata.MockMetricID1,
+					CloudServiceId: testdata.MockCloudServiceID1,
+					Operator:       "==",
+					TargetValue:    structpb.NewBoolValue(true),
+				},
+			},
+		},
+	}
+}
+
+func TestBundle_Write(t *testing.T) {
+	var buf, buf2 bytes.Buffer
+
+	b := newTestBundle()
+	assert.NoError(t, b.Write(&buf))
+
+	got, err := ReadBundle(bytes.NewReader(buf.Bytes()))
+	assert.NoError(t, err)
+	assert.Equal(t, b, got)
+
+	// The same bundle always results in the same tarball
+	assert.NoError(t, b.Write(&buf2))
+	assert.Equal(t, buf.Bytes(), buf2.Bytes())
+}
+
+func TestBundle_Revision(t *testing.T) {
+	b := newTestBundle()
+
+	revision, err := b.Revision()
+	assert.NoError(t, err)
+	assert.NotEmpty(t, revision)
+
+	same, err := newTestBundle().Revision()
+	assert.NoError(t, err)
+	assert.Equal(t, revision, same)
+
+	b.Configurations[testdata.MockCloudServiceID1][testdata.MockMetricID1].Operator = "!="
+
+	changed, err := b.Revision()
+	assert.NoError(t, err)
+	assert.True(t, revision != changed)
+}
+
+func TestReadBundle(t *testing.T) {
+	_, err := ReadBundle(strings.NewReader("not a bundle"))
+	assert.ErrorIs(t, err, ErrInvalidBundle)
+}
@@ -0,0 +1,26 @@
+package clouditor.metrics.at_rest_encryption_enabled
+
+test_applicable {
+	applicable with input as {"atRestEncryption": {"managedKeyEncryption": {"enabled": true}}}
+}
+
+test_not_applicable {
+	not applicable with input as {"id": "vm1"}
+}
+
+test_compliant {
+	compliant with input as {"atRestEncryption": {"managedKeyEncryption": {"enabled": true}}}
+}
+
+test_compliant_customer_key {
+	compliant with input as {"atRestEncryption": {"customerKeyEncryption": {"enabled": true}}}
+}
+
+test_not_compliant {
+	not compliant with input as {"atRestEncryption": {"managedKeyEncryption": {"enabled": false}}}
+}
+
+test_not_compliant_other_target_value {
+	not compliant with input as {"atRestEncryption": {"managedKeyEncryption": {"enabled": true}}}
+		with data.target_value as false
+}
@@ -0,0 +1,39 @@
+package clouditor.metrics.confidential_data_in_allowed_regions
+
+import data.clouditor.compare
+import future.keywords.every
+import future.keywords.in
+import input as asset
+
+default applicable = false
+
+default compliant = false
+
+applicable {
+	# the resource type should be a DataAsset, which is classified as confidential or restricted
+	asset.type[_] == "DataAsset"
+	asset.dataClassification.level in {"confidential", "restricted"}
+}
+
+# Each location needs to be allowed by its region, its country or, if the target value contains "EEA", by being
+# located in the European Economic Area
+compliant {
+	count(asset.geoLocations) > 0
+	every l in asset.geoLocations {
+		some location in locations(l)
+		compare(data.operator, data.target_value, location)
+	}
+}
+
+# The identifiers of a location, i.e., its region, its country and "EEA" for locations in the European Economic Area.
+# Locations of discoverers that do not normalize them yet are normalized using the built-in functions.
+locations(l) = ids {
+	ids := {l.region} | {c | c := country(l)} | {"EEA" | clouditor.is_eea(country(l))}
+}
+
+country(l) = c {
+	c := l.country
+	c != ""
+} else = c {
+	c := clouditor.region_country(l.region)
+}
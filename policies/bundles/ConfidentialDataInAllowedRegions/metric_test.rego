@@ -0,0 +1,51 @@
+package clouditor.metrics.confidential_data_in_allowed_regions
+
+test_compliant_eea {
+	compliant with input as {
+		"type": ["DataAsset"],
+		"dataClassification": {"level": "confidential"},
+		"geoLocations": [{"region": "westeurope", "country": "NL", "eea": true}, {"region": "europe-west3", "country": "DE", "eea": true}],
+	}
+}
+
+test_compliant_not_normalized {
+	compliant with input as {
+		"type": ["DataAsset"],
+		"dataClassification": {"level": "confidential"},
+		"geoLocations": [{"region": "eu-central-1"}, {"region": "europe-north1"}],
+	}
+}
+
+test_not_compliant_outside_eea {
+	not compliant with input as {
+		"type": ["DataAsset"],
+		"dataClassification": {"level": "confidential"},
+		"geoLocations": [{"region": "westeurope", "country": "NL", "eea": true}, {"region": "eu-west-2", "country": "GB", "eea": false}],
+	}
+}
+
+test_not_compliant_unknown_region {
+	not compliant with input as {
+		"type": ["DataAsset"],
+		"dataClassification": {"level": "confidential"},
+		"geoLocations": [{"region": "on-premises"}],
+	}
+}
+
+test_compliant_country {
+	compliant with input as {
+		"type": ["DataAsset"],
+		"dataClassification": {"level": "restricted"},
+		"geoLocations": [{"region": "eu-west-2", "country": "GB", "eea": false}],
+	}
+		with data.target_value as ["EEA", "GB"]
+}
+
+test_compliant_region {
+	compliant with input as {
+		"type": ["DataAsset"],
+		"dataClassification": {"level": "restricted"},
+		"geoLocations": [{"region": "germanywestcentral"}],
+	}
+		with data.target_value as ["germanywestcentral"]
+}
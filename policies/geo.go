@@ -0,0 +1,81 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package policies
+
+import (
+	"clouditor.io/clouditor/v2/api/ontology"
+
+	"github.com/open-policy-agent/opa/ast"
+	"github.com/open-policy-agent/opa/rego"
+	"github.com/open-policy-agent/opa/types"
+)
+
+// regionCountryBuiltin is the declaration of the clouditor.region_country built-in function, which returns the ISO
+// 3166-1 alpha-2 code of the country of a region of Azure, AWS or GCP, e.g., clouditor.region_country("westeurope")
+// is "NL". The result is undefined, if the region is unknown.
+var regionCountryBuiltin = &rego.Function{
+	Name:    "clouditor.region_country",
+	Decl:    types.NewFunction(types.Args(types.S), types.S),
+	Memoize: true,
+}
+
+// isEEABuiltin is the declaration of the clouditor.is_eea built-in function, which returns whether a country, given as
+// ISO 3166-1 alpha-2 code, is a member of the European Economic Area, e.g., clouditor.is_eea("DE") is true.
+var isEEABuiltin = &rego.Function{
+	Name:    "clouditor.is_eea",
+	Decl:    types.NewFunction(types.Args(types.S), types.B),
+	Memoize: true,
+}
+
+// regionCountry implements the clouditor.region_country built-in function.
+func regionCountry(_ rego.BuiltinContext, op *ast.Term) (*ast.Term, error) {
+	var region string
+
+	err := ast.As(op.Value, &region)
+	if err != nil {
+		return nil, err
+	}
+
+	country, ok := ontology.RegionCountry(region)
+	if !ok {
+		// Undefined, since we do not know the region
+		return nil, nil
+	}
+
+	return ast.StringTerm(country), nil
+}
+
+// isEEA implements the clouditor.is_eea built-in function.
+func isEEA(_ rego.BuiltinContext, op *ast.Term) (*ast.Term, error) {
+	var country string
+
+	err := ast.As(op.Value, &country)
+	if err != nil {
+		return nil, err
+	}
+
+	return ast.BooleanTerm(ontology.IsEEA(country)), nil
+}
@@ -0,0 +1,357 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package policies
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"clouditor.io/clouditor/v2/api/assessment"
+	"clouditor.io/clouditor/v2/api/evidence"
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/util"
+
+	"github.com/open-policy-agent/opa/ast"
+	"github.com/open-policy-agent/opa/rego"
+	"github.com/open-policy-agent/opa/storage/inmem"
+	"github.com/open-policy-agent/opa/tester"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	// MetricTestSuffix is the suffix of the files containing the Rego unit tests of a metric. They are placed next to
+	// the metric.rego in the bundle directory of the metric and share its package.
+	MetricTestSuffix = "_test.rego"
+
+	// FixturesDir is the directory within the bundle directory of a metric that contains its golden evidence fixtures.
+	// Each fixture consists of an evidence in its JSON representation (<name>.evidence.json) and the expected result of
+	// the metric (<name>.golden.json).
+	FixturesDir = "testdata"
+
+	fixtureEvidenceSuffix = ".evidence.json"
+	fixtureGoldenSuffix   = ".golden.json"
+)
+
+// ErrMetricExists indicates that a metric cannot be scaffolded, because its bundle directory already exists.
+var ErrMetricExists = errors.New("metric already exists")
+
+// Fixture is a golden evidence fixture of a metric.
+type Fixture struct {
+	// Name is the name of the fixture, i.e., the file name without suffix.
+	Name string
+
+	// Evidence is the evidence the metric is evaluated against.
+	Evidence *evidence.Evidence
+
+	// GoldenFile is the path of the file containing the expected result.
+	GoldenFile string
+}
+
+// GoldenResult is the expected result of a metric for a fixture.
+type GoldenResult struct {
+	Applicable bool `json:"applicable"`
+	Compliant  bool `json:"compliant"`
+}
+
+// MetricBundleDir returns the bundle directory of the metric relative to baseDir.
+func MetricBundleDir(baseDir string, metricID string) string {
+	return filepath.Join(baseDir, "policies", "bundles", metricID)
+}
+
+// RunMetricTests runs the Rego unit tests of the metric, i.e., all rules starting with test_ in the *_test.rego files
+// of its bundle directory. The default configuration of the metric (data.json) is available as data, so that tests
+// only need to override the operator or the target value if necessary. If the metric has no tests, no results are
+// returned.
+func RunMetricTests(ctx context.Context, baseDir string, metricID string) (results []*tester.Result, err error) {
+	var (
+		dir     = MetricBundleDir(baseDir, metricID)
+		files   []string
+		modules = make(map[string]*ast.Module)
+		config  map[string]any
+		ch      chan *tester.Result
+	)
+
+	files, err = filepath.Glob(filepath.Join(dir, "*"+MetricTestSuffix))
+	if err != nil || len(files) == 0 {
+		return nil, err
+	}
+
+	files = append(files, filepath.Join(dir, "metric.rego"), filepath.Join(baseDir, "policies", "operators.rego"))
+	for _, file := range files {
+		var b []byte
+
+		b, err = os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("could not read %s: %w", file, err)
+		}
+
+		modules[file], err = ast.ParseModule(file, string(b))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	b, err := os.ReadFile(filepath.Join(dir, "data.json"))
+	if err != nil {
+		return nil, fmt.Errorf("could not read configuration: %w", err)
+	}
+
+	if err = json.Unmarshal(b, &config); err != nil {
+		return nil, fmt.Errorf("could not parse configuration: %w", err)
+	}
+
+	ch, err = tester.NewRunner().
+		SetModules(modules).
+		SetStore(inmem.NewFromObject(map[string]any{
+			"operator":     config["operator"],
+			"target_value": config["target_value"],
+			"config":       config,
+		})).
+		AddCustomBuiltins([]*tester.Builtin{
+			{
+				Decl: &ast.Builtin{Name: resourceBuiltin.Name, Decl: resourceBuiltin.Decl},
+				Func: rego.Function1(resourceBuiltin, lookupResource),
+			},
+			{
+				Decl: &ast.Builtin{Name: regionCountryBuiltin.Name, Decl: regionCountryBuiltin.Decl},
+				Func: rego.Function1(regionCountryBuiltin, regionCountry),
+			},
+			{
+				Decl: &ast.Builtin{Name: isEEABuiltin.Name, Decl: isEEABuiltin.Decl},
+				Func: rego.Function1(isEEABuiltin, isEEA),
+			},
+		}).
+		Run(ctx, modules)
+	if err != nil {
+		return nil, err
+	}
+
+	for result := range ch {
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// LoadFixtures loads the golden evidence fixtures of the metric, sorted by their name.
+func LoadFixtures(baseDir string, metricID string) (fixtures []*Fixture, err error) {
+	var (
+		dir   = filepath.Join(MetricBundleDir(baseDir, metricID), FixturesDir)
+		files []string
+	)
+
+	files, err = filepath.Glob(filepath.Join(dir, "*"+fixtureEvidenceSuffix))
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Strings(files)
+
+	for _, file := range files {
+		var b []byte
+
+		b, err = os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("could not read %s: %w", file, err)
+		}
+
+		f := &Fixture{
+			Name:       strings.TrimSuffix(filepath.Base(file), fixtureEvidenceSuffix),
+			Evidence:   new(evidence.Evidence),
+			GoldenFile: strings.TrimSuffix(file, fixtureEvidenceSuffix) + fixtureGoldenSuffix,
+		}
+
+		if err = protojson.Unmarshal(b, f.Evidence); err != nil {
+			return nil, fmt.Errorf("could not parse evidence in %s: %w", file, err)
+		}
+
+		fixtures = append(fixtures, f)
+	}
+
+	return
+}
+
+// Eval evaluates the metric against the resource of the fixture using the default configuration of the metric.
+func (f *Fixture) Eval(baseDir string, metricID string) (got *GoldenResult, err error) {
+	var (
+		dir    = MetricBundleDir(baseDir, metricID)
+		b      []byte
+		config = new(assessment.MetricConfiguration)
+		r      ontology.IsResource
+		result *Result
+	)
+
+	b, err = os.ReadFile(filepath.Join(dir, "data.json"))
+	if err != nil {
+		return nil, fmt.Errorf("could not read configuration: %w", err)
+	}
+
+	if err = protojson.Unmarshal(b, config); err != nil {
+		return nil, fmt.Errorf("could not parse configuration: %w", err)
+	}
+
+	b, err = os.ReadFile(filepath.Join(dir, "metric.rego"))
+	if err != nil {
+		return nil, fmt.Errorf("could not read implementation: %w", err)
+	}
+
+	m, err := f.Evidence.GetResource().UnmarshalNew()
+	if err != nil {
+		return nil, fmt.Errorf("could not unmarshal resource: %w", err)
+	}
+
+	r, ok := m.(ontology.IsResource)
+	if !ok {
+		return nil, fmt.Errorf("invalid resource in fixture %s", f.Name)
+	}
+
+	result, err = EvalImplementation(&assessment.MetricImplementation{
+		MetricId: metricID,
+		Lang:     assessment.MetricImplementation_LANGUAGE_REGO,
+		Code:     string(b),
+	}, config, r)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GoldenResult{Applicable: result.Applicable, Compliant: result.Compliant}, nil
+}
+
+// Golden reads the expected result of the fixture.
+func (f *Fixture) Golden() (want *GoldenResult, err error) {
+	b, err := os.ReadFile(f.GoldenFile)
+	if err != nil {
+		return nil, err
+	}
+
+	want = new(GoldenResult)
+	if err = json.Unmarshal(b, want); err != nil {
+		return nil, fmt.Errorf("could not parse %s: %w", f.GoldenFile, err)
+	}
+
+	return
+}
+
+// UpdateGolden writes result as the expected result of the fixture.
+func (f *Fixture) UpdateGolden(result *GoldenResult) error {
+	b, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(f.GoldenFile, append(b, '\n'), 0644)
+}
+
+// ScaffoldMetric creates the bundle directory of a new metric relative to baseDir, containing a skeleton of its
+// implementation, its default configuration, a Rego unit test and an empty fixtures directory. The metric itself still
+// needs to be added to the list of metrics.
+func ScaffoldMetric(baseDir string, metricID string) (files []string, err error) {
+	var (
+		dir = MetricBundleDir(baseDir, metricID)
+		pkg = fmt.Sprintf("%s.%s", DefaultRegoPackage, util.CamelCaseToSnakeCase(metricID))
+	)
+
+	if _, err = os.Stat(dir); err == nil {
+		return nil, fmt.Errorf("%w: %s", ErrMetricExists, dir)
+	}
+
+	err = os.MkdirAll(filepath.Join(dir, FixturesDir), 0755)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, c := range []struct {
+		name    string
+		content string
+	}{
+		{"metric.rego", fmt.Sprintf(scaffoldMetric, pkg)},
+		{"data.json", scaffoldData},
+		{"metric" + MetricTestSuffix, fmt.Sprintf(scaffoldTest, pkg)},
+		{filepath.Join(FixturesDir, ".gitkeep"), ""},
+	} {
+		file := filepath.Join(dir, c.name)
+
+		err = os.WriteFile(file, []byte(c.content), 0644)
+		if err != nil {
+			return nil, err
+		}
+
+		files = append(files, file)
+	}
+
+	return
+}
+
+const scaffoldMetric = `package %s
+
+import data.clouditor.compare
+
+# TODO: Replace with the property of the resource that this metric checks
+import input.property as property
+
+default applicable = false
+
+default compliant = false
+
+applicable {
+	property != null
+}
+
+compliant {
+	compare(data.operator, data.target_value, property)
+}
+`
+
+const scaffoldData = `{
+  "operator": "==",
+  "target_value": true
+}
+`
+
+const scaffoldTest = `package %s
+
+test_applicable {
+	applicable with input as {"property": true}
+}
+
+test_not_applicable {
+	not applicable with input as {}
+}
+
+test_compliant {
+	compliant with input as {"property": true}
+}
+
+test_not_compliant {
+	not compliant with input as {"property": false}
+}
+`
@@ -0,0 +1,109 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package policies
+
+import (
+	"context"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+)
+
+var update = flag.Bool("update", false, "update the golden files of the metric fixtures")
+
+// TestMetrics runs the Rego unit tests and the golden evidence fixtures of all metrics in our bundle directory. Use
+// `go test ./policies -run TestMetrics -update` to update the golden files after changing a metric.
+func TestMetrics(t *testing.T) {
+	dirs, err := os.ReadDir("policies/bundles")
+	assert.NoError(t, err)
+
+	for _, dir := range dirs {
+		if !dir.IsDir() {
+			continue
+		}
+
+		metricID := dir.Name()
+
+		t.Run(metricID, func(t *testing.T) {
+			results, err := RunMetricTests(context.Background(), ".", metricID)
+			assert.NoError(t, err)
+
+			for _, result := range results {
+				if !result.Pass() {
+					t.Errorf("%s: %s", result.Location, result.String())
+				}
+			}
+
+			fixtures, err := LoadFixtures(".", metricID)
+			assert.NoError(t, err)
+
+			for _, f := range fixtures {
+				got, err := f.Eval(".", metricID)
+				assert.NoError(t, err)
+
+				if *update {
+					assert.NoError(t, f.UpdateGolden(got))
+					continue
+				}
+
+				want, err := f.Golden()
+				assert.NoError(t, err)
+				if !assert.Equal(t, want, got) {
+					t.Errorf("unexpected result of fixture %s", f.Name)
+				}
+			}
+		})
+	}
+}
+
+func TestScaffoldMetric(t *testing.T) {
+	var baseDir = t.TempDir()
+
+	// The scaffolded tests need our operators
+	b, err := os.ReadFile("policies/operators.rego")
+	assert.NoError(t, err)
+	assert.NoError(t, os.MkdirAll(filepath.Join(baseDir, "policies"), 0755))
+	assert.NoError(t, os.WriteFile(filepath.Join(baseDir, "policies", "operators.rego"), b, 0644))
+
+	files, err := ScaffoldMetric(baseDir, "MyNewMetric")
+	assert.NoError(t, err)
+	assert.Equal(t, 4, len(files))
+
+	// The scaffolded tests should pass out of the box
+	results, err := RunMetricTests(context.Background(), baseDir, "MyNewMetric")
+	assert.NoError(t, err)
+	assert.Equal(t, 4, len(results))
+	for _, result := range results {
+		assert.True(t, result.Pass())
+	}
+
+	// Scaffolding the same metric again fails
+	_, err = ScaffoldMetric(baseDir, "MyNewMetric")
+	assert.ErrorIs(t, err, ErrMetricExists)
+}
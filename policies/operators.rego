@@ -96,4 +96,140 @@ compare(operator, target_values, actual_values) {
     every act_val in actual_values {
     	act_val in target_values
     }
-}
\ No newline at end of file
+}
+# Checks if the actual_value (number) lies within the (inclusive) bounds of target_value (object with min and/or max)
+compare(operator, target_value, actual_value) {
+	operator == "inRange"
+	is_number(actual_value)
+	in_range(target_value, actual_value)
+}
+
+# Checks if all elements of actual_values (array) lie within the bounds of target_value
+compare(operator, target_value, actual_values) {
+	operator == "inRange"
+	is_array(actual_values)
+	every act_val in actual_values {
+		is_number(act_val)
+		in_range(target_value, act_val)
+	}
+}
+
+# Checks if the actual_value (of any type) equals one of the target_values (array)
+compare(operator, target_values, actual_value) {
+	operator == "oneOf"
+	not is_array(actual_value)
+	actual_value in target_values
+}
+
+# Checks if all elements of actual_values (array) equal one of the target_values (array)
+compare(operator, target_values, actual_values) {
+	operator == "oneOf"
+	is_array(actual_values)
+	every act_val in actual_values {
+		act_val in target_values
+	}
+}
+
+# Checks if the actual_value (of any type) equals none of the target_values (array)
+compare(operator, target_values, actual_value) {
+	operator == "noneOf"
+	not is_array(actual_value)
+	not actual_value in target_values
+}
+
+# Checks if no element of actual_values (array) equals one of the target_values (array)
+compare(operator, target_values, actual_values) {
+	operator == "noneOf"
+	is_array(actual_values)
+	every act_val in actual_values {
+		not act_val in target_values
+	}
+}
+
+# Checks if the actual_value (string) matches the regular expression target_value (string) or one of the regular
+# expressions of target_value (array)
+compare(operator, target_value, actual_value) {
+	operator == "matches"
+	is_string(actual_value)
+	matches_any(target_value, actual_value)
+}
+
+# Checks if all elements of actual_values (array) match the regular expression(s) of target_value
+compare(operator, target_value, actual_values) {
+	operator == "matches"
+	is_array(actual_values)
+	every act_val in actual_values {
+		is_string(act_val)
+		matches_any(target_value, act_val)
+	}
+}
+
+# Checks if the actual_value (duration string, e.g., 3600s) is at least as long as target_value (duration string, e.g.,
+# 720h). Durations of the ontology are serialized in seconds, so they can be compared directly.
+compare(operator, target_value, actual_value) {
+	operator == "durationAtLeast"
+	is_string(actual_value)
+	time.parse_duration_ns(actual_value) >= time.parse_duration_ns(target_value)
+}
+
+# Checks if all elements of actual_values (array of duration strings) are at least as long as target_value
+compare(operator, target_value, actual_values) {
+	operator == "durationAtLeast"
+	is_array(actual_values)
+	every act_val in actual_values {
+		time.parse_duration_ns(act_val) >= time.parse_duration_ns(target_value)
+	}
+}
+
+# Checks if the actual_value (duration string, e.g., 3600s) is at most as long as target_value (duration string, e.g.,
+# 720h)
+compare(operator, target_value, actual_value) {
+	operator == "durationAtMost"
+	is_string(actual_value)
+	time.parse_duration_ns(actual_value) <= time.parse_duration_ns(target_value)
+}
+
+# Checks if all elements of actual_values (array of duration strings) are at most as long as target_value
+compare(operator, target_value, actual_values) {
+	operator == "durationAtMost"
+	is_array(actual_values)
+	every act_val in actual_values {
+		time.parse_duration_ns(act_val) <= time.parse_duration_ns(target_value)
+	}
+}
+
+# Checks if value is greater than or equal to the min of bounds, if there is one, and less than or equal to its max,
+# if there is one
+in_range(bounds, value) {
+	is_object(bounds)
+	above_min(bounds, value)
+	below_max(bounds, value)
+}
+
+above_min(bounds, value) {
+	not "min" in object.keys(bounds)
+}
+
+above_min(bounds, value) {
+	value >= bounds.min
+}
+
+below_max(bounds, value) {
+	not "max" in object.keys(bounds)
+}
+
+below_max(bounds, value) {
+	value <= bounds.max
+}
+
+# Checks if value matches the regular expression pattern (string) or one of the regular expressions of patterns (array)
+matches_any(pattern, value) {
+	is_string(pattern)
+	regex.match(pattern, value)
+}
+
+matches_any(patterns, value) {
+	is_array(patterns)
+	some pattern in patterns
+	regex.match(pattern, value)
+}
@@ -34,6 +34,7 @@ import (
 	"clouditor.io/clouditor/v2/api/ontology"
 	"clouditor.io/clouditor/v2/api/orchestrator"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 var (
@@ -63,6 +64,10 @@ type Result struct {
 	Operator    string
 	MetricID    string
 	Config      *assessment.MetricConfiguration
+
+	// Properties contains the values of the properties of the resource that the metric refers to, keyed by their path,
+	// e.g., "automaticUpdates.enabled"
+	Properties *structpb.Struct
 }
 
 // MetricsSource is used to retrieve a list of metrics and to retrieve a metric
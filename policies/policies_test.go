@@ -33,6 +33,7 @@ import (
 	"time"
 
 	"clouditor.io/clouditor/v2/api/assessment"
+	"clouditor.io/clouditor/v2/internal/testdata"
 	"clouditor.io/clouditor/v2/internal/testutil/assert"
 	"clouditor.io/clouditor/v2/internal/testutil/clitest"
 
@@ -50,6 +51,8 @@ const (
 	mockVM1ResourceID         = "/mockresources/compute/vm1"
 	mockVM2EvidenceID         = "4"
 	mockVM2ResourceID         = "/mockresources/compute/vm2"
+	mockDataAssetEvidenceID   = "5"
+	mockDataAssetResourceID   = "data-asset:customers"
 )
 
 func TestMain(m *testing.M) {
@@ -59,7 +62,7 @@ func TestMain(m *testing.M) {
 }
 
 type mockMetricsSource struct {
-	t *testing.T
+	t testing.TB
 }
 
 func (*mockMetricsSource) Metrics() (metrics []*assessment.Metric, err error) {
@@ -135,5 +138,6 @@ func (*updatedMockMetricsSource) MetricConfiguration(serviceID, metricID string)
 		UpdatedAt:      timestamppb.New(time.Date(2022, 12, 1, 0, 0, 0, 0, time.Local)),
 		MetricId:       metricID,
 		CloudServiceId: serviceID,
+		RevisionId:     testdata.MockMetricConfigurationRevisionID1,
 	}, nil
 }
@@ -0,0 +1,161 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package policies
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/open-policy-agent/opa/ast"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// inputPaths returns the paths of the properties of the input, i.e., the resource, that are referenced in the rules of
+// the Rego module, either directly, e.g., input.automaticUpdates.enabled, or using an imported alias, e.g., am.enabled
+// for "import input.automaticUpdates as am". A path ends before the first dynamic part of a reference, e.g., an index
+// into an array. Paths that are a prefix of another path are omitted, because the more specific path already contains
+// the value that the metric uses.
+func inputPaths(module *ast.Module) [][]string {
+	var (
+		paths   [][]string
+		aliases = map[ast.Var][]string{
+			ast.InputRootDocument.Value.(ast.Var): {},
+		}
+	)
+
+	for _, imp := range module.Imports {
+		ref, ok := imp.Path.Value.(ast.Ref)
+		if !ok || !ref.HasPrefix(ast.InputRootRef) {
+			continue
+		}
+
+		path, ok := propertyPath(ref, aliases)
+		if !ok {
+			continue
+		}
+
+		alias := imp.Alias
+		if alias == "" {
+			alias = imp.Name()
+		}
+
+		aliases[alias] = path
+	}
+
+	for _, rule := range module.Rules {
+		ast.WalkRefs(rule, func(ref ast.Ref) bool {
+			if path, ok := propertyPath(ref, aliases); ok && len(path) > 0 {
+				paths = append(paths, path)
+			}
+
+			return false
+		})
+	}
+
+	// After sorting, the paths that begin with a path directly follow it
+	slices.SortFunc(paths, slices.Compare[[]string])
+
+	// Omit duplicates and paths that are a prefix of the following (more specific) path
+	var specific [][]string
+	for i, path := range paths {
+		if i+1 < len(paths) && len(path) <= len(paths[i+1]) && slices.Equal(path, paths[i+1][:len(path)]) {
+			continue
+		}
+
+		specific = append(specific, path)
+	}
+
+	return specific
+}
+
+// propertyPath returns the path of the property of the input that ref refers to, if its head is the input or an alias
+// of it.
+func propertyPath(ref ast.Ref, aliases map[ast.Var][]string) (path []string, ok bool) {
+	head, ok := ref[0].Value.(ast.Var)
+	if !ok {
+		return nil, false
+	}
+
+	prefix, ok := aliases[head]
+	if !ok {
+		return nil, false
+	}
+
+	path = slices.Clone(prefix)
+	for _, term := range ref[1:] {
+		s, ok := term.Value.(ast.String)
+		if !ok {
+			break
+		}
+
+		path = append(path, string(s))
+	}
+
+	return path, true
+}
+
+// inputProperties returns the values of the properties at the given paths of the input m as an object, keyed by their
+// path joined with dots, e.g., "automaticUpdates.enabled". Properties that are not set have a null value.
+func inputProperties(m map[string]any, paths [][]string) (props *structpb.Struct, err error) {
+	var values = make(map[string]any, len(paths))
+
+	for _, path := range paths {
+		values[strings.Join(path, ".")] = lookupPath(m, path)
+	}
+
+	// The input contains values, e.g., the resource types, that cannot be converted to a struct directly, so we take
+	// the detour via JSON
+	b, err := json.Marshal(values)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal properties: %w", err)
+	}
+
+	props = new(structpb.Struct)
+	if err = protojson.Unmarshal(b, props); err != nil {
+		return nil, fmt.Errorf("could not unmarshal properties: %w", err)
+	}
+
+	return props, nil
+}
+
+// lookupPath returns the value at the given path of m or nil, if there is no such value.
+func lookupPath(m map[string]any, path []string) (value any) {
+	value = m
+
+	for _, key := range path {
+		obj, ok := value.(map[string]any)
+		if !ok {
+			return nil
+		}
+
+		value = obj[key]
+	}
+
+	return value
+}
@@ -0,0 +1,117 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package policies
+
+import (
+	"testing"
+
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+
+	"github.com/open-policy-agent/opa/ast"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func Test_inputPaths(t *testing.T) {
+	tests := []struct {
+		name string
+		code string
+		want [][]string
+	}{
+		{
+			name: "alias",
+			code: `package clouditor.metrics.automatic_updates_interval
+
+import data.clouditor.compare
+import input.automaticUpdates as am
+
+applicable {
+	am
+}
+
+compliant {
+	compare(">", 0, am.interval)
+	am.enabled == true
+}`,
+			want: [][]string{{"automaticUpdates", "enabled"}, {"automaticUpdates", "interval"}},
+		},
+		{
+			name: "input alias and direct references",
+			code: `package clouditor.metrics.admin_mixed_duties
+
+import input as identity
+
+applicable {
+	identity.privileged
+	input.type[_] == "Identity"
+}
+
+compliant {
+	identity.authorization.mixedDuties == false
+	input.authorization.mixedDuties == false
+}`,
+			want: [][]string{{"authorization", "mixedDuties"}, {"privileged"}, {"type"}},
+		},
+		{
+			name: "import without alias",
+			code: `package clouditor.metrics.tls_version
+
+import input.transportEncryption
+
+compliant {
+	transportEncryption.protocolVersion >= 1.2
+}`,
+			want: [][]string{{"transportEncryption", "protocolVersion"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			module, err := ast.ParseModule("metric.rego", tt.code)
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.want, inputPaths(module))
+		})
+	}
+}
+
+func Test_inputProperties(t *testing.T) {
+	m := map[string]any{
+		"type": []string{"VirtualMachine", "Compute", "Resource"},
+		"automaticUpdates": map[string]any{
+			"enabled": true,
+		},
+	}
+
+	got, err := inputProperties(m, [][]string{{"type"}, {"automaticUpdates", "enabled"}, {"bootLogging", "enabled"}})
+	assert.NoError(t, err)
+
+	want, err := structpb.NewStruct(map[string]any{
+		"type":                     []any{"VirtualMachine", "Compute", "Resource"},
+		"automaticUpdates.enabled": true,
+		"bootLogging.enabled":      nil,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, want, got)
+}
@@ -31,12 +31,15 @@ import (
 	"fmt"
 	"strings"
 	"sync"
+	"time"
 
 	"clouditor.io/clouditor/v2/api/assessment"
 	"clouditor.io/clouditor/v2/api/evidence"
 	"clouditor.io/clouditor/v2/api/ontology"
 	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/internal/telemetry"
 	"clouditor.io/clouditor/v2/internal/util"
+	"github.com/open-policy-agent/opa/ast"
 	"github.com/open-policy-agent/opa/rego"
 	"github.com/open-policy-agent/opa/storage"
 	"github.com/open-policy-agent/opa/storage/inmem"
@@ -56,14 +59,26 @@ type regoEval struct {
 
 	// pkg is the base package name that is used in the Rego files
 	pkg string
+
+	// snapshot contains all evaluated resources, so that metrics can access related resources. It is nil, unless
+	// configured using [WithResourceSnapshot].
+	snapshot *ResourceSnapshot
 }
 
 type queryCache struct {
 	sync.Mutex
-	cache map[string]*rego.PreparedEvalQuery
+	cache map[string]*preparedQuery
+}
+
+// preparedQuery is the compiled Rego code of a metric implementation together with the paths of the properties of the
+// input it refers to (see [inputPaths]).
+type preparedQuery struct {
+	rego.PreparedEvalQuery
+
+	inputPaths [][]string
 }
 
-type orElseFunc func(key string) (query *rego.PreparedEvalQuery, err error)
+type orElseFunc func(key string) (query *preparedQuery, err error)
 
 type RegoEvalOption func(re *regoEval)
 
@@ -105,6 +120,11 @@ func (re *regoEval) Eval(evidence *evidence.Evidence, r ontology.IsResource, src
 		return nil, err
 	}
 
+	// Add the resource to the snapshot before evaluating it, so that related resources can also access it
+	if re.snapshot != nil {
+		re.snapshot.Put(evidence.CloudServiceId, r.GetId(), m)
+	}
+
 	types = ontology.ResourceTypes(r)
 	key := createKey(evidence, types)
 
@@ -198,15 +218,21 @@ func (re *regoEval) HandleMetricEvent(event *orchestrator.MetricChangeEvent) (er
 	// Evict the cache for the given metric
 	re.qc.Evict(event.MetricId)
 
+	// A changed implementation might also change the resource types the metric is applicable to, so we need to determine
+	// the applicable metrics again
+	if event.Type == orchestrator.MetricChangeEvent_TYPE_IMPLEMENTATION_CHANGED {
+		re.mrtc.Lock()
+		re.mrtc.m = make(map[string][]string)
+		re.mrtc.Unlock()
+	}
+
 	return nil
 }
 
 func (re *regoEval) evalMap(baseDir string, serviceID, metricID string, m map[string]interface{}, src MetricsSource) (result *Result, err error) {
 	var (
-		query  *rego.PreparedEvalQuery
-		key    string
-		pkg    string
-		prefix string
+		query *preparedQuery
+		key   string
 	)
 
 	// We need to check, if the metric configuration has been changed.
@@ -221,84 +247,121 @@ func (re *regoEval) evalMap(baseDir string, serviceID, metricID string, m map[st
 
 	// Try to fetch a cached prepared query for the specified key. If the key is not found, we create a new query with
 	// the function specified as the second parameter
-	query, err = re.qc.Get(key, func(key string) (*rego.PreparedEvalQuery, error) {
-		var (
-			tx   storage.Transaction
-			impl *assessment.MetricImplementation
-		)
-
-		// Create paths for bundle directory and utility functions file
-		bundle := fmt.Sprintf("%s/policies/bundles/%s/", baseDir, metricID)
-		operators := fmt.Sprintf("%s/policies/operators.rego", baseDir)
-
-		// The contents of the data map is available as the data variable within the Rego evaluation
-		data := map[string]interface{}{
-			"target_value": config.TargetValue.AsInterface(),
-			"operator":     config.Operator,
-			"config":       config,
+	query, err = re.qc.Get(key, func(key string) (*preparedQuery, error) {
+		// Fetch the metric implementation, i.e., the Rego code from the metric source
+		impl, err := src.MetricImplementation(assessment.MetricImplementation_LANGUAGE_REGO, metricID)
+		if err != nil {
+			return nil, fmt.Errorf("could not fetch policy for metric %s: %w", metricID, err)
 		}
 
-		// Create a new in-memory Rego store based on our data map
-		store := inmem.NewFromObject(data)
-		ctx := context.Background()
+		return re.prepareQuery(baseDir, metricID, impl.Code, config)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch cached query for metric %s: %w", metricID, err)
+	}
 
-		// Create a new transaction in the store
-		tx, err = store.NewTransaction(ctx, storage.WriteParams)
-		if err != nil {
-			return nil, fmt.Errorf("could not create transaction: %w", err)
-		}
+	result, err = evalQuery(withSnapshotLookup(context.Background(), re.snapshot, serviceID), query, metricID, m)
+	if err != nil {
+		return nil, err
+	}
 
-		prefix = re.pkg
+	if !result.Applicable {
+		return nil, nil
+	} else {
+		return result, nil
+	}
+}
 
-		// Convert camelCase metric in under_score_style for package name
-		pkg = util.CamelCaseToSnakeCase(metricID)
+// prepareQuery compiles the Rego code of a metric implementation into a query, which can be evaluated against any
+// object (input) using the given metric configuration.
+func (re *regoEval) prepareQuery(baseDir string, metricID string, code string, config *assessment.MetricConfiguration) (*preparedQuery, error) {
+	var (
+		tx     storage.Transaction
+		module *ast.Module
+		pkg    string
+		prefix string
+		err    error
+	)
 
-		// Fetch the metric implementation, i.e., the Rego code from the metric source
-		impl, err = src.MetricImplementation(assessment.MetricImplementation_LANGUAGE_REGO, metricID)
-		if err != nil {
-			return nil, fmt.Errorf("could not fetch policy for metric %s: %w", metricID, err)
-		}
+	// Create paths for bundle directory and utility functions file
+	bundle := fmt.Sprintf("%s/policies/bundles/%s/", baseDir, metricID)
+	operators := fmt.Sprintf("%s/policies/operators.rego", baseDir)
 
-		// Insert/Update the policy. The bundle path depends on the metric ID
-		err = store.UpsertPolicy(context.Background(), tx, bundle+"metric.rego", []byte(impl.Code))
-		if err != nil {
-			return nil, fmt.Errorf("could not upsert policy: %w", err)
-		}
+	// The contents of the data map is available as the data variable within the Rego evaluation
+	data := map[string]interface{}{
+		"target_value": config.TargetValue.AsInterface(),
+		"operator":     config.Operator,
+		"config":       config,
+	}
+
+	// Create a new in-memory Rego store based on our data map
+	store := inmem.NewFromObject(data)
+	ctx := context.Background()
+
+	// Create a new transaction in the store
+	tx, err = store.NewTransaction(ctx, storage.WriteParams)
+	if err != nil {
+		return nil, fmt.Errorf("could not create transaction: %w", err)
+	}
 
-		// Create a new Rego prepared query evaluation, which can later be used to query the metric on any object (input)
-		query, err := rego.New(
-			rego.Query(fmt.Sprintf(`
+	prefix = re.pkg
+
+	// Convert camelCase metric in under_score_style for package name
+	pkg = util.CamelCaseToSnakeCase(metricID)
+
+	// Insert/Update the policy. The bundle path depends on the metric ID
+	err = store.UpsertPolicy(context.Background(), tx, bundle+"metric.rego", []byte(code))
+	if err != nil {
+		return nil, fmt.Errorf("could not upsert policy: %w", err)
+	}
+
+	// Create a new Rego prepared query evaluation, which can later be used to query the metric on any object (input)
+	query, err := rego.New(
+		rego.Query(fmt.Sprintf(`
 			applicable = data.%s.%s.applicable;
 			compliant = data.%s.%s.compliant;
 			operator = data.clouditor.operator;
 			target_value = data.clouditor.target_value;
 			config = data.clouditor.config`, prefix, pkg, prefix, pkg)),
-			rego.Package(prefix),
-			rego.Store(store),
-			rego.Transaction(tx),
-			rego.Load(
-				[]string{
-					operators,
-				},
-				nil),
-		).PrepareForEval(ctx)
-		if err != nil {
-			return nil, fmt.Errorf("could not prepare rego evaluation for metric %s: %w", metricID, err)
-		}
+		rego.Package(prefix),
+		rego.Store(store),
+		rego.Transaction(tx),
+		rego.Load(
+			[]string{
+				operators,
+			},
+			nil),
+		rego.Function1(resourceBuiltin, lookupResource),
+		rego.Function1(regionCountryBuiltin, regionCountry),
+		rego.Function1(isEEABuiltin, isEEA),
+	).PrepareForEval(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not prepare rego evaluation for metric %s: %w", metricID, err)
+	}
 
-		// Commit the transaction into the store
-		err = store.Commit(ctx, tx)
-		if err != nil {
-			return nil, fmt.Errorf("could not commit transaction: %w", err)
-		}
+	// Commit the transaction into the store
+	err = store.Commit(ctx, tx)
+	if err != nil {
+		return nil, fmt.Errorf("could not commit transaction: %w", err)
+	}
 
-		return &query, nil
-	})
+	// Remember the properties the metric refers to, so that they can be attached to the result
+	module, err = ast.ParseModule(bundle+"metric.rego", code)
 	if err != nil {
-		return nil, fmt.Errorf("could not fetch cached query for metric %s: %w", metricID, err)
+		return nil, fmt.Errorf("could not parse rego code of metric %s: %w", metricID, err)
 	}
 
-	results, err := query.Eval(context.Background(), rego.EvalInput(m))
+	return &preparedQuery{PreparedEvalQuery: query, inputPaths: inputPaths(module)}, nil
+}
+
+// evalQuery evaluates a prepared query of a metric against the object m. The result is also returned if the metric is
+// not applicable. Resources looked up by the metric are retrieved from the snapshot contained in ctx, if any.
+func evalQuery(ctx context.Context, query *preparedQuery, metricID string, m map[string]interface{}) (result *Result, err error) {
+	var ok bool
+
+	start := time.Now()
+	results, err := query.Eval(ctx, rego.EvalInput(m))
+	telemetry.RegoEvalDuration.Observe(time.Since(start).Seconds())
 	if err != nil {
 		return nil, fmt.Errorf("could not evaluate rego policy: %w", err)
 	}
@@ -308,13 +371,22 @@ func (re *regoEval) evalMap(baseDir string, serviceID, metricID string, m map[st
 	}
 
 	result = &Result{
-		Applicable:  results[0].Bindings["applicable"].(bool),
-		Compliant:   results[0].Bindings["compliant"].(bool),
-		Operator:    results[0].Bindings["operator"].(string),
 		TargetValue: results[0].Bindings["target_value"],
 		MetricID:    metricID,
 	}
 
+	if result.Applicable, ok = results[0].Bindings["applicable"].(bool); !ok {
+		return nil, fmt.Errorf("applicable of metric %s is not a boolean", metricID)
+	}
+
+	if result.Compliant, ok = results[0].Bindings["compliant"].(bool); !ok {
+		return nil, fmt.Errorf("compliant of metric %s is not a boolean", metricID)
+	}
+
+	if result.Operator, ok = results[0].Bindings["operator"].(string); !ok {
+		return nil, fmt.Errorf("operator of metric %s is not a string", metricID)
+	}
+
 	// A little trick to convert the map-based metric configuration back to a real object
 	var b []byte
 	if b, err = json.Marshal(results[0].Bindings["config"]); err != nil {
@@ -326,22 +398,48 @@ func (re *regoEval) evalMap(baseDir string, serviceID, metricID string, m map[st
 		return nil, fmt.Errorf("JSON unmarshal failed: %w", err)
 	}
 
-	if !result.Applicable {
-		return nil, nil
-	} else {
-		return result, nil
+	result.Properties, err = inputProperties(m, query.inputPaths)
+	if err != nil {
+		return nil, err
 	}
+
+	return result, nil
+}
+
+// EvalImplementation compiles the Rego code of a metric implementation and evaluates it against the resource r using
+// the given metric configuration. In contrast to [PolicyEval.Eval], nothing is cached and a result is also returned if
+// the metric is not applicable to the resource. This allows to test a metric implementation before it is stored.
+// Errors in the Rego code itself wrap the ast.Errors of the Rego compiler.
+func EvalImplementation(impl *assessment.MetricImplementation, config *assessment.MetricConfiguration, r ontology.IsResource, opts ...RegoEvalOption) (result *Result, err error) {
+	var (
+		query *preparedQuery
+		m     map[string]interface{}
+	)
+
+	re := NewRegoEval(opts...).(*regoEval)
+
+	m, err = ontology.ResourceMap(r)
+	if err != nil {
+		return nil, err
+	}
+
+	query, err = re.prepareQuery(".", impl.MetricId, impl.Code, config)
+	if err != nil {
+		return nil, err
+	}
+
+	return evalQuery(context.Background(), query, impl.MetricId, m)
 }
 
 func newQueryCache() *queryCache {
 	return &queryCache{
-		cache: make(map[string]*rego.PreparedEvalQuery),
+		cache: make(map[string]*preparedQuery),
 	}
 }
 
 // Get returns the prepared query for the given key. If the key was not found in the cache,
 // the orElse function is executed to populate the cache.
-func (qc *queryCache) Get(key string, orElse orElseFunc) (query *rego.PreparedEvalQuery, err error) {
+func (qc *queryCache) Get(key string, orElse orElseFunc) (query *preparedQuery, err error) {
 	var (
 		ok bool
 	)
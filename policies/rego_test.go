@@ -26,6 +26,8 @@
 package policies
 
 import (
+	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -38,6 +40,8 @@ import (
 	"clouditor.io/clouditor/v2/internal/testutil/prototest"
 	"clouditor.io/clouditor/v2/persistence"
 
+	"github.com/open-policy-agent/opa/ast"
+	"github.com/open-policy-agent/opa/rego"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -267,6 +271,54 @@ func Test_regoEval_Eval(t *testing.T) {
 			},
 			wantErr: assert.Nil[error],
 		},
+		{
+			name: "DataAsset: Compliant Case",
+			fields: fields{
+				qc:      newQueryCache(),
+				mrtc:    &metricsCache{m: make(map[string][]string)},
+				storage: testutil.NewInMemoryStorage(t),
+				pkg:     DefaultRegoPackage,
+			},
+			args: args{
+				resource: &ontology.DataAsset{
+					Id:                 mockDataAssetResourceID,
+					DataClassification: &ontology.DataClassification{Level: "confidential"},
+					GeoLocations:       []*ontology.GeoLocation{{Region: "westeurope"}, {Region: "eu-central-1"}},
+				},
+				evidenceID: mockDataAssetEvidenceID,
+				src:        &mockMetricsSource{t: t},
+			},
+			applicable: true,
+			compliant: map[string]bool{
+				"ConfidentialDataInAllowedRegions": true,
+				"ResourceInventory":                true,
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "DataAsset: Non-Compliant Case",
+			fields: fields{
+				qc:      newQueryCache(),
+				mrtc:    &metricsCache{m: make(map[string][]string)},
+				storage: testutil.NewInMemoryStorage(t),
+				pkg:     DefaultRegoPackage,
+			},
+			args: args{
+				resource: &ontology.DataAsset{
+					Id:                 mockDataAssetResourceID,
+					DataClassification: &ontology.DataClassification{Level: "restricted"},
+					GeoLocations:       []*ontology.GeoLocation{{Region: "westeurope"}, {Region: "eastus"}},
+				},
+				evidenceID: mockDataAssetEvidenceID,
+				src:        &mockMetricsSource{t: t},
+			},
+			applicable: true,
+			compliant: map[string]bool{
+				"ConfidentialDataInAllowedRegions": false,
+				"ResourceInventory":                true,
+			},
+			wantErr: assert.Nil[error],
+		},
 	}
 
 	for _, tt := range tests {
@@ -351,6 +403,9 @@ func Test_regoEval_evalMap(t *testing.T) {
 						MetricId:       "AutomaticUpdatesEnabled",
 						CloudServiceId: testdata.MockCloudServiceID1,
 					},
+					Properties: &structpb.Struct{Fields: map[string]*structpb.Value{
+						"automaticUpdates.enabled": structpb.NewBoolValue(true),
+					}},
 				}
 
 				return assert.Equal(t, want, got)
@@ -389,7 +444,11 @@ func Test_regoEval_evalMap(t *testing.T) {
 						UpdatedAt:      timestamppb.New(time.Date(2022, 12, 1, 0, 0, 0, 0, time.Local)),
 						MetricId:       "AutomaticUpdatesEnabled",
 						CloudServiceId: testdata.MockCloudServiceID1,
+						RevisionId:     testdata.MockMetricConfigurationRevisionID1,
 					},
+					Properties: &structpb.Struct{Fields: map[string]*structpb.Value{
+						"automaticUpdates.enabled": structpb.NewBoolValue(true),
+					}},
 				}
 
 				return assert.Equal(t, want, got)
@@ -411,3 +470,218 @@ func Test_regoEval_evalMap(t *testing.T) {
 		})
 	}
 }
+
+func TestEvalImplementation(t *testing.T) {
+	const code = `package clouditor.metrics.automatic_updates_enabled
+
+import data.clouditor.compare
+
+default applicable = false
+
+default compliant = false
+
+applicable {
+	input.automaticUpdates
+}
+
+compliant {
+	compare(data.operator, data.target_value, input.automaticUpdates.enabled)
+}`
+
+	config := &assessment.MetricConfiguration{
+		Operator:       "==",
+		TargetValue:    structpb.NewBoolValue(true),
+		MetricId:       "AutomaticUpdatesEnabled",
+		CloudServiceId: testdata.MockCloudServiceID1,
+	}
+
+	type args struct {
+		impl     *assessment.MetricImplementation
+		config   *assessment.MetricConfiguration
+		resource ontology.IsResource
+	}
+	tests := []struct {
+		name       string
+		args       args
+		wantResult assert.Want[*Result]
+		wantErr    assert.WantErr
+	}{
+		{
+			name: "compliant",
+			args: args{
+				impl:   &assessment.MetricImplementation{MetricId: "AutomaticUpdatesEnabled", Code: code},
+				config: config,
+				resource: &ontology.VirtualMachine{
+					Id:               mockVM1ResourceID,
+					AutomaticUpdates: &ontology.AutomaticUpdates{Enabled: true},
+				},
+			},
+			wantResult: func(t *testing.T, got *Result) bool {
+				assert.True(t, got.Applicable)
+				assert.True(t, got.Compliant)
+				assert.Equal(t, "==", got.Operator)
+				return assert.Equal(t, true, got.TargetValue)
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "not applicable",
+			args: args{
+				impl:     &assessment.MetricImplementation{MetricId: "AutomaticUpdatesEnabled", Code: code},
+				config:   config,
+				resource: &ontology.ObjectStorage{Id: mockObjStorage1ResourceID},
+			},
+			wantResult: func(t *testing.T, got *Result) bool {
+				assert.False(t, got.Applicable)
+				return assert.False(t, got.Compliant)
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "syntax error",
+			args: args{
+				impl:     &assessment.MetricImplementation{MetricId: "AutomaticUpdatesEnabled", Code: "package clouditor.metrics.automatic_updates_enabled\n\napplicable {"},
+				config:   config,
+				resource: &ontology.VirtualMachine{Id: mockVM1ResourceID},
+			},
+			wantResult: assert.Nil[*Result],
+			wantErr: func(t *testing.T, err error) bool {
+				var errs ast.Errors
+				return assert.True(t, errors.As(err, &errs))
+			},
+		},
+		{
+			name: "wrong package",
+			args: args{
+				impl:     &assessment.MetricImplementation{MetricId: "AutomaticUpdatesEnabled", Code: "package clouditor.metrics.other\n\napplicable = true\n\ncompliant = true"},
+				config:   config,
+				resource: &ontology.VirtualMachine{Id: mockVM1ResourceID},
+			},
+			wantResult: assert.Nil[*Result],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "probably the package name of metric AutomaticUpdatesEnabled is wrong")
+			},
+		},
+		{
+			name: "applicable is not a boolean",
+			args: args{
+				impl:     &assessment.MetricImplementation{MetricId: "AutomaticUpdatesEnabled", Code: "package clouditor.metrics.automatic_updates_enabled\n\napplicable = \"yes\"\n\ncompliant = true"},
+				config:   config,
+				resource: &ontology.VirtualMachine{Id: mockVM1ResourceID},
+			},
+			wantResult: assert.Nil[*Result],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "applicable of metric AutomaticUpdatesEnabled is not a boolean")
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotResult, err := EvalImplementation(tt.args.impl, tt.args.config, tt.args.resource)
+
+			tt.wantErr(t, err)
+			tt.wantResult(t, gotResult)
+		})
+	}
+}
+
+func Test_compare(t *testing.T) {
+	type args struct {
+		operator    string
+		targetValue any
+		actualValue any
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "inRange",
+			args: args{operator: "inRange", targetValue: map[string]any{"min": 1, "max": 10}, actualValue: 10},
+			want: true,
+		},
+		{
+			name: "inRange below min",
+			args: args{operator: "inRange", targetValue: map[string]any{"min": 1, "max": 10}, actualValue: 0},
+			want: false,
+		},
+		{
+			name: "inRange without min",
+			args: args{operator: "inRange", targetValue: map[string]any{"max": 10}, actualValue: -5},
+			want: true,
+		},
+		{
+			name: "inRange list",
+			args: args{operator: "inRange", targetValue: map[string]any{"min": 1}, actualValue: []any{1, 2, 0}},
+			want: false,
+		},
+		{
+			name: "oneOf number",
+			args: args{operator: "oneOf", targetValue: []any{443, 8443}, actualValue: 443},
+			want: true,
+		},
+		{
+			name: "oneOf list",
+			args: args{operator: "oneOf", targetValue: []any{"TLS1_2", "TLS1_3"}, actualValue: []any{"TLS1_2", "TLS1_1"}},
+			want: false,
+		},
+		{
+			name: "noneOf",
+			args: args{operator: "noneOf", targetValue: []any{22, 3389}, actualValue: 443},
+			want: true,
+		},
+		{
+			name: "noneOf list",
+			args: args{operator: "noneOf", targetValue: []any{22, 3389}, actualValue: []any{443, 22}},
+			want: false,
+		},
+		{
+			name: "matches",
+			args: args{operator: "matches", targetValue: "^Standard_D", actualValue: "Standard_D2s_v3"},
+			want: true,
+		},
+		{
+			name: "matches one of the patterns",
+			args: args{operator: "matches", targetValue: []any{"^Standard_D", "^Standard_E"}, actualValue: "Standard_E4s_v3"},
+			want: true,
+		},
+		{
+			name: "matches list",
+			args: args{operator: "matches", targetValue: "^Standard_D", actualValue: []any{"Standard_D2s_v3", "Basic_A1"}},
+			want: false,
+		},
+		{
+			name: "durationAtLeast",
+			args: args{operator: "durationAtLeast", targetValue: "720h", actualValue: "3024000s"},
+			want: true,
+		},
+		{
+			name: "durationAtLeast too short",
+			args: args{operator: "durationAtLeast", targetValue: "720h", actualValue: "3600s"},
+			want: false,
+		},
+		{
+			name: "durationAtMost list",
+			args: args{operator: "durationAtMost", targetValue: "24h", actualValue: []any{"3600s", "86400s"}},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query, err := rego.New(
+				rego.Query("data.clouditor.compare(input.operator, input.target_value, input.actual_value)"),
+				rego.Load([]string{"policies/operators.rego"}, nil),
+			).PrepareForEval(context.Background())
+			assert.NoError(t, err)
+
+			rs, err := query.Eval(context.Background(), rego.EvalInput(map[string]any{
+				"operator":     tt.args.operator,
+				"target_value": tt.args.targetValue,
+				"actual_value": tt.args.actualValue,
+			}))
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, rs.Allowed())
+		})
+	}
+}
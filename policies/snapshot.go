@@ -0,0 +1,142 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package policies
+
+import (
+	"context"
+	"sync"
+
+	"github.com/open-policy-agent/opa/ast"
+	"github.com/open-policy-agent/opa/rego"
+	"github.com/open-policy-agent/opa/types"
+)
+
+// ResourceSnapshot contains the latest state of all resources that were evaluated, so that metrics can access resources
+// related to the one they are evaluated against, e.g., the block storage of a virtual machine. It is populated by
+// [PolicyEval.Eval], if configured using [WithResourceSnapshot].
+//
+// Metrics can look up a resource of the same cloud service in the snapshot using the built-in function
+// clouditor.resource(id), e.g., clouditor.resource(input.blockStorageIds[_]). The result is undefined, if there is no
+// evidence for the resource (yet), which is the case if its evidence arrives after the one of the evaluated resource.
+type ResourceSnapshot struct {
+	mu sync.RWMutex
+
+	// resources contains the resources in the form of their Rego input, indexed by cloud service and resource ID
+	resources map[string]map[string]map[string]any
+}
+
+// snapshotKey is the context key for the [ResourceSnapshot] lookup of the current evaluation.
+type snapshotKey struct{}
+
+// snapshotLookup contains the snapshot and the cloud service, whose resources can be looked up in an evaluation.
+type snapshotLookup struct {
+	snapshot       *ResourceSnapshot
+	cloudServiceID string
+}
+
+// resourceBuiltin is the declaration of the clouditor.resource built-in function, which looks up a resource in the
+// [ResourceSnapshot].
+var resourceBuiltin = &rego.Function{
+	Name:             "clouditor.resource",
+	Decl:             types.NewFunction(types.Args(types.S), types.A),
+	Memoize:          true,
+	Nondeterministic: true,
+}
+
+// NewResourceSnapshot creates a new, empty [ResourceSnapshot].
+func NewResourceSnapshot() *ResourceSnapshot {
+	return &ResourceSnapshot{
+		resources: make(map[string]map[string]map[string]any),
+	}
+}
+
+// WithResourceSnapshot is an option to populate the given snapshot with all evaluated resources and to make them
+// available to metrics.
+func WithResourceSnapshot(snapshot *ResourceSnapshot) RegoEvalOption {
+	return func(re *regoEval) {
+		re.snapshot = snapshot
+	}
+}
+
+// Put adds the resource with the given ID (in the form of its Rego input) to the snapshot or replaces its previous
+// state.
+func (s *ResourceSnapshot) Put(cloudServiceID string, id string, m map[string]any) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.resources[cloudServiceID] == nil {
+		s.resources[cloudServiceID] = make(map[string]map[string]any)
+	}
+
+	s.resources[cloudServiceID][id] = m
+}
+
+// Get returns the resource with the given ID of the cloud service, if it is contained in the snapshot.
+func (s *ResourceSnapshot) Get(cloudServiceID string, id string) (m map[string]any, ok bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	m, ok = s.resources[cloudServiceID][id]
+	return
+}
+
+// withSnapshotLookup returns a context, in which clouditor.resource looks up resources of the cloud service in the
+// snapshot. If the snapshot is nil, no resources are found.
+func withSnapshotLookup(ctx context.Context, snapshot *ResourceSnapshot, cloudServiceID string) context.Context {
+	if snapshot == nil {
+		return ctx
+	}
+
+	return context.WithValue(ctx, snapshotKey{}, &snapshotLookup{snapshot: snapshot, cloudServiceID: cloudServiceID})
+}
+
+// lookupResource implements the clouditor.resource built-in function.
+func lookupResource(bctx rego.BuiltinContext, op *ast.Term) (*ast.Term, error) {
+	var id string
+
+	err := ast.As(op.Value, &id)
+	if err != nil {
+		return nil, err
+	}
+
+	lookup, ok := bctx.Context.Value(snapshotKey{}).(*snapshotLookup)
+	if !ok {
+		// Undefined, since we do not have any snapshot
+		return nil, nil
+	}
+
+	m, ok := lookup.snapshot.Get(lookup.cloudServiceID, id)
+	if !ok {
+		return nil, nil
+	}
+
+	v, err := ast.InterfaceToValue(m)
+	if err != nil {
+		return nil, err
+	}
+
+	return ast.NewTerm(v), nil
+}
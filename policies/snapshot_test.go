@@ -0,0 +1,140 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package policies
+
+import (
+	"testing"
+
+	"clouditor.io/clouditor/v2/api/assessment"
+	"clouditor.io/clouditor/v2/api/evidence"
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// crossResourceMetricsSource contains a single metric, which checks that all block storages of a virtual machine are
+// encrypted.
+type crossResourceMetricsSource struct{}
+
+func (crossResourceMetricsSource) Metrics() ([]*assessment.Metric, error) {
+	return []*assessment.Metric{{Id: "VMDiskEncryptionEnabled"}}, nil
+}
+
+func (crossResourceMetricsSource) MetricConfiguration(serviceID, metricID string) (*assessment.MetricConfiguration, error) {
+	return &assessment.MetricConfiguration{
+		Operator:       "==",
+		TargetValue:    structpb.NewBoolValue(true),
+		MetricId:       metricID,
+		CloudServiceId: serviceID,
+	}, nil
+}
+
+func (crossResourceMetricsSource) MetricImplementation(_ assessment.MetricImplementation_Language, metric string) (*assessment.MetricImplementation, error) {
+	return &assessment.MetricImplementation{
+		MetricId: metric,
+		Lang:     assessment.MetricImplementation_LANGUAGE_REGO,
+		Code: `package clouditor.metrics.vm_disk_encryption_enabled
+
+import data.clouditor.compare
+import future.keywords.every
+
+default applicable = false
+
+default compliant = false
+
+applicable {
+	count(input.blockStorageIds) > 0
+}
+
+compliant {
+	every id in input.blockStorageIds {
+		disk := clouditor.resource(id)
+		compare(data.operator, data.target_value, disk.atRestEncryption.managedKeyEncryption.enabled)
+	}
+}`,
+	}, nil
+}
+
+func Test_regoEval_Eval_resourceSnapshot(t *testing.T) {
+	var (
+		disk = &ontology.BlockStorage{
+			Id: "my-disk",
+			AtRestEncryption: &ontology.AtRestEncryption{
+				Type: &ontology.AtRestEncryption_ManagedKeyEncryption{
+					ManagedKeyEncryption: &ontology.ManagedKeyEncryption{Enabled: true},
+				},
+			},
+		}
+		vm = &ontology.VirtualMachine{Id: "my-vm", BlockStorageIds: []string{"my-disk"}}
+	)
+
+	tests := []struct {
+		name          string
+		snapshot      *ResourceSnapshot
+		diskServiceID string
+		wantCompliant bool
+	}{
+		{
+			name:          "related resource in snapshot",
+			snapshot:      NewResourceSnapshot(),
+			diskServiceID: testdata.MockCloudServiceID1,
+			wantCompliant: true,
+		},
+		{
+			name:          "related resource of other cloud service",
+			snapshot:      NewResourceSnapshot(),
+			diskServiceID: testdata.MockCloudServiceID2,
+			wantCompliant: false,
+		},
+		{
+			name:          "without snapshot",
+			diskServiceID: testdata.MockCloudServiceID1,
+			wantCompliant: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var opts []RegoEvalOption
+			if tt.snapshot != nil {
+				opts = append(opts, WithResourceSnapshot(tt.snapshot))
+			}
+
+			pe := NewRegoEval(opts...)
+
+			// The disk itself is not applicable, but ends up in the snapshot
+			results, err := pe.Eval(&evidence.Evidence{CloudServiceId: tt.diskServiceID, ToolId: "disk"}, disk, crossResourceMetricsSource{})
+			assert.NoError(t, err)
+			assert.Equal(t, 0, len(results))
+
+			results, err = pe.Eval(&evidence.Evidence{CloudServiceId: testdata.MockCloudServiceID1, ToolId: "vm"}, vm, crossResourceMetricsSource{})
+			assert.NoError(t, err)
+			assert.Equal(t, 1, len(results))
+			assert.Equal(t, tt.wantCompliant, results[0].Compliant)
+		})
+	}
+}
@@ -36,9 +36,18 @@ package clouditor
 //go:generate buf format -w
 //go:generate buf generate --exclude-path="internal/ontology/clouditor_header.proto"
 //go:generate buf generate --exclude-path="internal/ontology/clouditor_header.proto" --template buf.gotag.gen.yaml
+//go:generate go run ./internal/ontology/validate
+//go:generate go run ./internal/ontology/jsonschema api/ontology/ontology.schema.json
+//go:generate go run ./internal/errorcatalog api/errorcodes.json
 //go:generate buf generate --template buf.openapi.gen.yaml --path api/assessment -o openapi/assessment
 //go:generate buf generate --template buf.openapi.gen.yaml --path api/evaluation -o openapi/evaluation
 //go:generate buf generate --template buf.openapi.gen.yaml --path api/discovery -o openapi/discovery
 //go:generate buf generate --template buf.openapi.gen.yaml --path api/evidence -o openapi/evidence
 //go:generate buf generate --template buf.openapi.gen.yaml --path api/orchestrator -o openapi/orchestrator
 //go:generate buf generate --template buf.openapi.gen.yaml --path api/ontology -o openapi/ontology
+
+// Generate the Python stubs of our API and of the tagger extension, which is not published on PyPI. The other
+// dependencies are provided by the packages googleapis-common-protos and protovalidate.
+//go:generate buf generate --exclude-path="internal/ontology/clouditor_header.proto" --template buf.python.gen.yaml
+//go:generate buf generate buf.build/srikrsna/protoc-gen-gotag --template buf.python.gen.yaml
+//go:generate go run ./internal/ontology/jsonschema python/src/clouditor/ontology.schema.json
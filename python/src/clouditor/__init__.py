@@ -0,0 +1,37 @@
+# Copyright 2024 Fraunhofer AISEC
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+#
+# This file is part of Clouditor Community Edition.
+
+"""Helpers for the Python stubs of the Clouditor API.
+
+The stubs themselves are generated from the protobuf definitions into the
+packages ``api`` and ``tagger``, e.g., ``api.evidence.evidence_store_pb2_grpc``.
+"""
+
+from clouditor.evidence import (
+    EvidenceBuilder,
+    ValidationError,
+    ontology_version,
+    store_evidence,
+)
+
+__version__ = "0.0.0"
+
+__all__ = [
+    "EvidenceBuilder",
+    "ValidationError",
+    "ontology_version",
+    "store_evidence",
+]
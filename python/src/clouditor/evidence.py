@@ -0,0 +1,152 @@
+# Copyright 2024 Fraunhofer AISEC
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+#
+# This file is part of Clouditor Community Edition.
+
+"""Building and submitting evidences.
+
+Resources are described as dictionaries in the JSON representation of the
+ontology, e.g., ``{"id": "...", "name": "my-vm", "bootLogging": {...}}``, and
+validated against the JSON schema of the ontology before they are sent.
+"""
+
+import datetime
+import functools
+import json
+import uuid
+from importlib import resources
+
+import jsonschema
+from google.protobuf import json_format
+
+TYPE_URL_PREFIX = "type.googleapis.com/clouditor.ontology.v1."
+
+IDEMPOTENCY_KEY_HEADER = "idempotency-key"
+
+
+class ValidationError(ValueError):
+    """Indicates that an evidence does not conform to the ontology."""
+
+
+@functools.lru_cache(maxsize=None)
+def _schema():
+    text = resources.files("clouditor").joinpath("ontology.schema.json").read_text()
+    return json.loads(text)
+
+
+@functools.lru_cache(maxsize=None)
+def _validator():
+    return jsonschema.Draft202012Validator(_schema())
+
+
+def ontology_version():
+    """Returns the version of the ontology, e.g., "1.6", of the schema."""
+    return _schema()["$comment"].removeprefix("Ontology version ")
+
+
+class EvidenceBuilder:
+    """Builds an evidence about a single resource.
+
+    Example::
+
+        evidence = (
+            EvidenceBuilder(cloud_service_id, "my-scanner")
+            .resource("VirtualMachine", id=vm_id, name="my-vm")
+            .raw(scan_result)
+            .build()
+        )
+    """
+
+    def __init__(self, cloud_service_id, tool_id):
+        self._evidence = {
+            "id": str(uuid.uuid4()),
+            "cloudServiceId": cloud_service_id,
+            "toolId": tool_id,
+            "ontologyVersion": ontology_version(),
+        }
+
+    def resource(self, resource_type, **properties):
+        """Sets the resource of the given type of the ontology, e.g.,
+        "VirtualMachine". The properties use the names of the JSON
+        representation, e.g., ``bootLogging``."""
+        self._evidence["resource"] = {
+            "@type": TYPE_URL_PREFIX + resource_type,
+            **properties,
+        }
+        return self
+
+    def raw(self, raw):
+        """Sets the evidence in its original form, e.g., the output of a
+        scanner. Anything other than a string is encoded as JSON."""
+        self._evidence["raw"] = raw if isinstance(raw, str) else json.dumps(raw)
+        return self
+
+    def relationship(self, type, property, resource_id):
+        """Adds a typed relationship of the resource to another resource."""
+        self._evidence.setdefault("relationships", []).append(
+            {"type": type, "property": property, "resourceId": resource_id}
+        )
+        return self
+
+    def to_dict(self):
+        """Validates the evidence and returns its JSON representation. The
+        timestamp is set to the current time, unless it was set before."""
+        evidence = dict(self._evidence)
+        evidence.setdefault(
+            "timestamp",
+            datetime.datetime.now(datetime.timezone.utc)
+            .isoformat(timespec="milliseconds")
+            .replace("+00:00", "Z"),
+        )
+
+        if "resource" not in evidence:
+            raise ValidationError("evidence has no resource")
+
+        # The schema is a oneOf over all resource types, so we pick the most
+        # relevant of the nested errors to point to the actual problem
+        error = jsonschema.exceptions.best_match(_validator().iter_errors(evidence["resource"]))
+        if error is not None:
+            raise ValidationError(f"invalid resource: {error.message}")
+
+        return evidence
+
+    def build(self):
+        """Validates the evidence and returns it as an
+        ``api.evidence.evidence_pb2.Evidence``."""
+        # Import the stubs only here, so that evidences can be validated
+        # without them
+        from api.evidence import evidence_pb2
+
+        return json_format.ParseDict(self.to_dict(), evidence_pb2.Evidence())
+
+
+def store_evidence(channel, evidence, metadata=(), idempotency_key=None, **kwargs):
+    """Submits the evidence to the evidence store reachable via the gRPC
+    channel. An idempotency key is sent with the call, so that it can be
+    retried safely; further keyword arguments, e.g., ``timeout``, are passed
+    to the stub."""
+    from api.evidence import evidence_store_pb2, evidence_store_pb2_grpc
+
+    if isinstance(evidence, EvidenceBuilder):
+        evidence = evidence.build()
+
+    key = idempotency_key or str(uuid.uuid4())
+    metadata = tuple(metadata) + ((IDEMPOTENCY_KEY_HEADER, key),)
+
+    stub = evidence_store_pb2_grpc.EvidenceStoreStub(channel)
+    return stub.StoreEvidence(
+        evidence_store_pb2.StoreEvidenceRequest(evidence=evidence),
+        metadata=metadata,
+        **kwargs,
+    )
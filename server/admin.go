@@ -0,0 +1,158 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package server
+
+import (
+	"encoding/json"
+	"expvar"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/pprof"
+	"runtime"
+	"time"
+
+	"clouditor.io/clouditor/v2/api"
+	"clouditor.io/clouditor/v2/service"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// AdminMethod is the pseudo full method name that the role-based access control authorizes requests to the admin
+// endpoints for. Since it does not belong to any gRPC service, only [service.RoleAdmin] permits it by default.
+const AdminMethod = "/clouditor.admin/Debug"
+
+// StreamsPath is the path of the admin endpoint that dumps the state of the streams of all services
+const StreamsPath = "/debug/streams"
+
+// streamsDump is the response of the [StreamsPath] endpoint
+type streamsDump struct {
+	// Goroutines is the current number of goroutines
+	Goroutines int `json:"goroutines"`
+
+	// Streams contains the state of the streams by the full name of the service that maintains them
+	Streams map[string][]api.StreamState `json:"streams"`
+}
+
+// StartAdminServer starts an HTTP server on addr that exposes runtime diagnostics to debug the services in production,
+// e.g., a memory growth caused by long-lived streams:
+//   - the profiles of [net/http/pprof] on /debug/pprof/, including a dump of all goroutines,
+//   - the variables of [expvar] on /debug/vars and
+//   - the state of the streams of all services that implement [service.StreamReporter] on [StreamsPath].
+//
+// The server uses the same options as [StartGRPCServer] to retrieve the registered services and to authenticate the
+// requests using a bearer token. Since the endpoints disclose internals of the services, e.g., the command line of the
+// engine including its secrets, the user additionally needs to be permitted to call [AdminMethod] by the role-based
+// access control of [WithRBAC]. This is also enforced if the option is not set, in which case only tokens that carry
+// the admin role in their roles claim are accepted. The admin server should nevertheless only be reachable from within
+// the cluster. The server is started in a separate Go routine, therefore this function will NOT block.
+func StartAdminServer(addr string, opts ...StartGRPCServerOption) (sock net.Listener, srv *http.Server, err error) {
+	c := newConfig(opts...)
+
+	// Never expose the admin endpoints to any authenticated user
+	if c.rbac == nil {
+		c.rbac = service.NewRBAC(&service.AuthorizationStrategyJWT{Issuers: c.ac.claimMappings()})
+	}
+
+	publishGoroutines()
+
+	sock, err = net.Listen("tcp", addr)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not listen: %w", err)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	mux.Handle("/debug/vars", expvar.Handler())
+	mux.HandleFunc(StreamsPath, c.handleStreams)
+
+	srv = &http.Server{
+		Handler:           c.adminAuth(mux),
+		ReadHeaderTimeout: 2 * time.Second,
+	}
+
+	go func() {
+		_ = srv.Serve(sock)
+	}()
+
+	return sock, srv, nil
+}
+
+// adminAuth authenticates the requests to the admin endpoints using the bearer token in the Authorization header and
+// authorizes them using the role-based access control. API keys are not accepted.
+func (c *config) adminAuth(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// We re-use the authentication of our gRPC API, which expects the token in the incoming metadata
+		ctx := metadata.NewIncomingContext(r.Context(), metadata.Pairs("authorization", r.Header.Get("Authorization")))
+
+		ctx, err := c.ac.AuthFunc()(ctx)
+		if err != nil {
+			http.Error(w, "invalid auth token", http.StatusUnauthorized)
+			return
+		}
+
+		if c.rbac.Authorize(ctx, AdminMethod, nil) != nil {
+			http.Error(w, service.ErrPermissionDenied.Error(), http.StatusForbidden)
+			return
+		}
+
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// handleStreams dumps the number of goroutines and the state of the streams of all services as JSON.
+func (c *config) handleStreams(w http.ResponseWriter, _ *http.Request) {
+	var dump = streamsDump{
+		Goroutines: runtime.NumGoroutine(),
+		Streams:    map[string][]api.StreamState{},
+	}
+
+	for sd, svc := range c.services {
+		if reporter, ok := svc.(service.StreamReporter); ok {
+			dump.Streams[sd.ServiceName] = reporter.StreamStates()
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	_ = enc.Encode(dump)
+}
+
+// publishGoroutines publishes the number of goroutines as expvar, so that it can be monitored together with the memory
+// statistics that are published by default.
+func publishGoroutines() {
+	if expvar.Get("goroutines") == nil {
+		expvar.Publish("goroutines", expvar.Func(func() any {
+			return runtime.NumGoroutine()
+		}))
+	}
+}
@@ -0,0 +1,166 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"clouditor.io/clouditor/v2/api"
+	"clouditor.io/clouditor/v2/api/assessment"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/testutil/servicetest"
+	"clouditor.io/clouditor/v2/service"
+
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc/metadata"
+)
+
+// mockStreamReporter is an assessment service that reports a single stream.
+type mockStreamReporter struct {
+	assessment.UnimplementedAssessmentServer
+}
+
+func (*mockStreamReporter) StreamStates() []api.StreamState {
+	return []api.StreamState{{Component: "Orchestrator", Target: "localhost:9090", Connected: true, Queued: 1, Sent: 2}}
+}
+
+func TestStartAdminServer(t *testing.T) {
+	issuer, key := newMockIssuer(t)
+	exp := time.Now().Add(time.Hour).Unix()
+
+	md, _ := metadata.FromIncomingContext(newTokenContext(t, key, jwt.MapClaims{"iss": issuer.URL, "sub": "me", "exp": exp}))
+	token := md.Get("authorization")[0]
+
+	md, _ = metadata.FromIncomingContext(newTokenContext(t, key, jwt.MapClaims{"iss": issuer.URL, "sub": "admin", "exp": exp, "roles": []string{service.RoleAdmin}}))
+	adminToken := md.Get("authorization")[0]
+
+	tests := []struct {
+		name       string
+		opts       []StartGRPCServerOption
+		path       string
+		token      string
+		wantStatus int
+		wantDump   bool
+	}{
+		{
+			name:       "no token",
+			path:       StreamsPath,
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "invalid token",
+			path:       StreamsPath,
+			token:      "bearer invalid",
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "not admin",
+			path:       StreamsPath,
+			token:      token,
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "not admin pprof",
+			path:       "/debug/pprof/cmdline",
+			token:      token,
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "streams",
+			path:       StreamsPath,
+			token:      adminToken,
+			wantStatus: http.StatusOK,
+			wantDump:   true,
+		},
+		{
+			name:       "pprof",
+			path:       "/debug/pprof/goroutine?debug=1",
+			token:      adminToken,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "expvar",
+			path:       "/debug/vars",
+			token:      adminToken,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name: "RBAC admin",
+			opts: []StartGRPCServerOption{
+				WithRBAC(service.NewRBAC(servicetest.NewAuthorizationStrategyWithRoles(true, []string{service.RoleAdmin}))),
+			},
+			path:       StreamsPath,
+			token:      token,
+			wantStatus: http.StatusOK,
+			wantDump:   true,
+		},
+		{
+			name: "RBAC not admin",
+			opts: []StartGRPCServerOption{
+				WithRBAC(service.NewRBAC(servicetest.NewAuthorizationStrategyWithRoles(true, []string{service.RoleReadOnly}))),
+			},
+			path:       StreamsPath,
+			token:      token,
+			wantStatus: http.StatusForbidden,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := append([]StartGRPCServerOption{
+				WithOIDCIssuers(OIDCIssuer{URL: issuer.URL}),
+				WithAssessment(&mockStreamReporter{}),
+			}, tt.opts...)
+
+			sock, srv, err := StartAdminServer("127.0.0.1:0", opts...)
+			assert.NoError(t, err)
+			defer srv.Close()
+
+			req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("http://%s%s", sock.Addr().String(), tt.path), nil)
+			assert.NoError(t, err)
+
+			if tt.token != "" {
+				req.Header.Set("Authorization", tt.token)
+			}
+
+			res, err := http.DefaultClient.Do(req)
+			assert.NoError(t, err)
+			defer res.Body.Close()
+
+			assert.Equal(t, tt.wantStatus, res.StatusCode)
+
+			if tt.wantDump {
+				var dump streamsDump
+				assert.NoError(t, json.NewDecoder(res.Body).Decode(&dump))
+				assert.True(t, dump.Goroutines > 0)
+				assert.Equal(t, (&mockStreamReporter{}).StreamStates(), dump.Streams[assessment.Assessment_ServiceDesc.ServiceName])
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package server
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"clouditor.io/clouditor/v2/api"
+	"clouditor.io/clouditor/v2/service"
+
+	"google.golang.org/grpc"
+)
+
+// WithAPIKeys is an option for [StartGRPCServer] to additionally accept API keys in the [service.APIKeyHeader] header
+// for the RPCs in [service.APIKeyMethods]. This allows simple machine collectors to submit evidences without a full
+// OAuth 2.0 flow.
+func WithAPIKeys(verifier service.APIKeyVerifier) StartGRPCServerOption {
+	return func(c *config) {
+		c.ac.apiKeys = verifier
+	}
+}
+
+// authenticateAPIKey authenticates a request using an API key. API keys are only accepted for the RPCs in
+// [service.APIKeyMethods] and the cloud services of the API key are stored in the returned context.
+func (config *AuthConfig) authenticateAPIKey(ctx context.Context, key string) (newCtx context.Context, err error) {
+	method, _ := grpc.Method(ctx)
+	if !slices.Contains(service.APIKeyMethods, method) {
+		log.Debugf("API key used for %s, which does not accept API keys", method)
+		return nil, api.ErrorCodeAPIKeyNotAccepted.Err()
+	}
+
+	cloudServiceIDs, err := config.apiKeys.VerifyAPIKey(ctx, key)
+	if err != nil {
+		log.Debugf("Could not verify API key: %v", err)
+
+		// We do not want to disclose any error details which could be security related,
+		// so we do not wrap the original error
+		return nil, api.ErrorCodeInvalidAPIKey.Err()
+	}
+
+	id, _, _ := strings.Cut(key, ".")
+
+	return service.WithAPIKeyScope(withVerifiedCaller(ctx, "api-key:"+id), cloudServiceIDs), nil
+}
+
+// UnaryAPIKeyScopeInterceptor is a [grpc.UnaryServerInterceptor] that checks whether a request that was authenticated
+// by an API key is for one of the cloud services of the API key.
+func UnaryAPIKeyScopeInterceptor(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+	if err = service.CheckAPIKeyScope(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+// StreamAPIKeyScopeInterceptor is a [grpc.StreamServerInterceptor] that checks whether each message of a stream that
+// was authenticated by an API key is for one of the cloud services of the API key.
+func StreamAPIKeyScopeInterceptor(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if _, ok := service.APIKeyScopeFromContext(ss.Context()); !ok {
+		return handler(srv, ss)
+	}
+
+	return handler(srv, &authorizingServerStream{ServerStream: ss, authorize: func(m any) error {
+		return service.CheckAPIKeyScope(ss.Context(), m)
+	}})
+}
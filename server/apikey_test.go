@@ -0,0 +1,174 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"clouditor.io/clouditor/v2/api/assessment"
+	"clouditor.io/clouditor/v2/api/evidence"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/service"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const mockAPIKey = "my-key"
+
+// mockAPIKeyVerifier only accepts mockAPIKey, which is valid for cloud service 1.
+type mockAPIKeyVerifier struct{}
+
+func (mockAPIKeyVerifier) VerifyAPIKey(_ context.Context, key string) (cloudServiceIDs []string, err error) {
+	if key != mockAPIKey {
+		return nil, errors.New("invalid API key")
+	}
+
+	return []string{testdata.MockCloudServiceID1}, nil
+}
+
+// mockServerTransportStream is a [grpc.ServerTransportStream], which is needed to supply the method to the context.
+type mockServerTransportStream struct {
+	grpc.ServerTransportStream
+
+	method string
+}
+
+func (s *mockServerTransportStream) Method() string {
+	return s.method
+}
+
+// newAPIKeyContext creates an incoming context for the given method with the given API key.
+func newAPIKeyContext(method string, key string) context.Context {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(service.APIKeyHeader, key))
+
+	return grpc.NewContextWithServerTransportStream(ctx, &mockServerTransportStream{method: method})
+}
+
+func TestAuthConfig_AuthFunc_apiKey(t *testing.T) {
+	type fields struct {
+		apiKeys service.APIKeyVerifier
+	}
+	type args struct {
+		ctx context.Context
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantCtx assert.Want[context.Context]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "valid API key",
+			fields: fields{
+				apiKeys: mockAPIKeyVerifier{},
+			},
+			args: args{
+				ctx: newAPIKeyContext("/clouditor.assessment.v1.Assessment/AssessEvidence", mockAPIKey),
+			},
+			wantCtx: func(t *testing.T, got context.Context) bool {
+				scope, ok := service.APIKeyScopeFromContext(got)
+				assert.True(t, ok)
+				assert.Equal(t, "api-key:"+mockAPIKey, caller(got, &service.AuthorizationStrategyJWT{}))
+				return assert.Equal(t, []string{testdata.MockCloudServiceID1}, scope)
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "invalid API key",
+			fields: fields{
+				apiKeys: mockAPIKeyVerifier{},
+			},
+			args: args{
+				ctx: newAPIKeyContext("/clouditor.assessment.v1.Assessment/AssessEvidence", "other-key"),
+			},
+			wantCtx: assert.Nil[context.Context],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.Equal(t, codes.Unauthenticated, status.Code(err))
+			},
+		},
+		{
+			name: "RPC does not accept API keys",
+			fields: fields{
+				apiKeys: mockAPIKeyVerifier{},
+			},
+			args: args{
+				ctx: newAPIKeyContext("/clouditor.orchestrator.v1.Orchestrator/ListCloudServices", mockAPIKey),
+			},
+			wantCtx: assert.Nil[context.Context],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.Equal(t, codes.Unauthenticated, status.Code(err))
+			},
+		},
+		{
+			name: "API keys not enabled",
+			args: args{
+				ctx: newAPIKeyContext("/clouditor.assessment.v1.Assessment/AssessEvidence", mockAPIKey),
+			},
+			wantCtx: assert.Nil[context.Context],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.Equal(t, codes.Unauthenticated, status.Code(err))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := &AuthConfig{apiKeys: tt.fields.apiKeys}
+
+			gotCtx, err := config.AuthFunc()(tt.args.ctx)
+			tt.wantErr(t, err)
+			tt.wantCtx(t, gotCtx)
+		})
+	}
+}
+
+func TestUnaryAPIKeyScopeInterceptor(t *testing.T) {
+	var (
+		info    = &grpc.UnaryServerInfo{FullMethod: "/clouditor.assessment.v1.Assessment/AssessEvidence"}
+		handler = func(ctx context.Context, req any) (any, error) {
+			return "response", nil
+		}
+		ctx = service.WithAPIKeyScope(context.Background(), []string{testdata.MockCloudServiceID1})
+	)
+
+	resp, err := UnaryAPIKeyScopeInterceptor(ctx, &assessment.AssessEvidenceRequest{
+		Evidence: &evidence.Evidence{CloudServiceId: testdata.MockCloudServiceID1},
+	}, info, handler)
+	assert.NoError(t, err)
+	assert.Equal[any](t, "response", resp)
+
+	resp, err = UnaryAPIKeyScopeInterceptor(ctx, &assessment.AssessEvidenceRequest{
+		Evidence: &evidence.Evidence{CloudServiceId: testdata.MockCloudServiceID2},
+	}, info, handler)
+	assert.ErrorIs(t, err, service.ErrPermissionDenied)
+	assert.Nil(t, resp)
+}
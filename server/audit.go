@@ -0,0 +1,188 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package server
+
+import (
+	"context"
+	"time"
+
+	"clouditor.io/clouditor/v2/api"
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/service"
+
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// AuditLogRecorder is an interface that persists entries of the audit log, e.g., the Orchestrator.
+type AuditLogRecorder interface {
+	RecordAuditLogEntry(ctx context.Context, entry *orchestrator.AuditLogEntry) error
+}
+
+// auditLog contains the configuration of the audit log.
+type auditLog struct {
+	recorder AuditLogRecorder
+	users    service.UserIdentifier
+}
+
+// WithAuditLog is an option for [StartGRPCServer] to record each call of an RPC, except the reflection, health and
+// public endpoints, in the audit log. The user is identified by users, which defaults to
+// [service.AuthorizationStrategyJWT].
+func WithAuditLog(recorder AuditLogRecorder, users service.UserIdentifier) StartGRPCServerOption {
+	return func(c *config) {
+		if users == nil {
+			users = &service.AuthorizationStrategyJWT{}
+		}
+
+		c.audit = &auditLog{recorder: recorder, users: users}
+	}
+}
+
+// UnaryAuditLogInterceptor returns a [grpc.UnaryServerInterceptor] that records the call in the audit log of the
+// config, including its cloud service, if the request implements [api.CloudServiceRequest]. Since it is invoked before
+// the authentication, calls that fail to authenticate are recorded as well. If no audit log is configured, nothing is
+// recorded.
+func UnaryAuditLogInterceptor(c *config) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+		if c.audit == nil {
+			return handler(ctx, req)
+		}
+
+		ctx = withCallerSlot(ctx)
+
+		start := time.Now()
+		resp, err = handler(ctx, req)
+
+		entry := c.audit.newEntry(ctx, info.FullMethod, start, err)
+		if csr, ok := req.(api.CloudServiceRequest); ok && csr.GetCloudServiceId() != "" {
+			id := csr.GetCloudServiceId()
+			entry.CloudServiceId = &id
+		}
+
+		c.audit.record(ctx, entry)
+
+		return resp, err
+	}
+}
+
+// StreamAuditLogInterceptor returns a [grpc.StreamServerInterceptor] that records the call in the audit log of the
+// config once the stream has ended. If no audit log is configured, nothing is recorded.
+func StreamAuditLogInterceptor(c *config) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		if c.audit == nil {
+			return handler(srv, ss)
+		}
+
+		wrapped := grpc_middleware.WrapServerStream(ss)
+		wrapped.WrappedContext = withCallerSlot(ss.Context())
+
+		start := time.Now()
+		err = handler(srv, wrapped)
+
+		c.audit.record(wrapped.Context(), c.audit.newEntry(wrapped.Context(), info.FullMethod, start, err))
+
+		return err
+	}
+}
+
+// newEntry creates an audit log entry for a call of the method that started at start and returned err.
+func (a *auditLog) newEntry(ctx context.Context, method string, start time.Time, err error) *orchestrator.AuditLogEntry {
+	s := status.Convert(err)
+
+	entry := &orchestrator.AuditLogEntry{
+		Method:     method,
+		UserId:     a.user(ctx),
+		Code:       s.Code().String(),
+		DurationMs: time.Since(start).Milliseconds(),
+	}
+
+	if err != nil {
+		msg := s.Message()
+		entry.Message = &msg
+	}
+
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		addr := p.Addr.String()
+		entry.Peer = &addr
+	}
+
+	return entry
+}
+
+// user identifies the caller. Note that the token is not validated yet, if the authentication failed.
+func (a *auditLog) user(ctx context.Context) string {
+	return caller(ctx, a.users)
+}
+
+// verifiedCallerKey is the context key for the caller that was verified by the authentication using an API key or an
+// auditor grant.
+type verifiedCallerKey struct{}
+
+// callerSlotKey is the context key for a slot, in which the authentication stores the verified caller. Interceptors
+// that run before the authentication, e.g., the audit log, do not see the context of the authentication and use the
+// slot instead.
+type callerSlotKey struct{}
+
+// withCallerSlot returns a copy of ctx that contains an empty slot for the verified caller.
+func withCallerSlot(ctx context.Context) context.Context {
+	return context.WithValue(ctx, callerSlotKey{}, new(string))
+}
+
+// withVerifiedCaller returns a copy of ctx that contains the caller, which was verified by the authentication. The
+// caller is also stored in the slot of ctx, if there is one.
+func withVerifiedCaller(ctx context.Context, caller string) context.Context {
+	if slot, ok := ctx.Value(callerSlotKey{}).(*string); ok {
+		*slot = caller
+	}
+
+	return context.WithValue(ctx, verifiedCallerKey{}, caller)
+}
+
+// caller identifies the caller either by the ID of their API key or auditor grant or by the user of the token. API keys
+// and auditor grants are only taken into account once they are verified by the authentication, so that a caller cannot
+// pose as one by merely sending the header.
+func caller(ctx context.Context, users service.UserIdentifier) string {
+	if c, ok := ctx.Value(verifiedCallerKey{}).(string); ok {
+		return c
+	}
+
+	if slot, ok := ctx.Value(callerSlotKey{}).(*string); ok && *slot != "" {
+		return *slot
+	}
+
+	user, _ := users.CurrentUser(ctx)
+
+	return user
+}
+
+// record persists the entry. A failure does not fail the call, but is logged.
+func (a *auditLog) record(ctx context.Context, entry *orchestrator.AuditLogEntry) {
+	if err := a.recorder.RecordAuditLogEntry(ctx, entry); err != nil {
+		log.Errorf("Could not record audit log entry for %s: %v", entry.Method, err)
+	}
+}
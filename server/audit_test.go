@@ -0,0 +1,153 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package server
+
+import (
+	"context"
+	"testing"
+
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/testutil/servicetest"
+	"clouditor.io/clouditor/v2/service"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// mockAuditLogRecorder keeps the recorded entries in memory.
+type mockAuditLogRecorder struct {
+	entries []*orchestrator.AuditLogEntry
+}
+
+func (m *mockAuditLogRecorder) RecordAuditLogEntry(_ context.Context, entry *orchestrator.AuditLogEntry) error {
+	m.entries = append(m.entries, entry)
+	return nil
+}
+
+func TestUnaryAuditLogInterceptor(t *testing.T) {
+	var (
+		recorder = &mockAuditLogRecorder{}
+		c        = &config{}
+		info     = &grpc.UnaryServerInfo{FullMethod: "/clouditor.orchestrator.v1.Orchestrator/RemoveCloudService"}
+	)
+
+	WithAuditLog(recorder, servicetest.NewAuthorizationStrategyWithUser(true, "alice").(service.UserIdentifier))(c)
+
+	interceptor := UnaryAuditLogInterceptor(c)
+
+	// A denied call of a user
+	_, err := interceptor(context.Background(), &orchestrator.RemoveCloudServiceRequest{CloudServiceId: testdata.MockCloudServiceID1}, info,
+		func(ctx context.Context, req any) (any, error) {
+			return nil, service.ErrPermissionDenied
+		})
+	assert.ErrorIs(t, err, service.ErrPermissionDenied)
+
+	// A successful call with an API key, which is verified by the authentication
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(service.APIKeyHeader, "1234.secret"))
+	resp, err := interceptor(ctx, &orchestrator.ListCloudServicesRequest{}, info,
+		func(ctx context.Context, req any) (any, error) {
+			withVerifiedCaller(ctx, "api-key:1234")
+			return "response", nil
+		})
+	assert.NoError(t, err)
+	assert.Equal[any](t, "response", resp)
+
+	// A call of an external auditor with an auditor grant
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(service.AuditorGrantHeader, "5678.secret"))
+	_, err = interceptor(ctx, &orchestrator.GetCloudServiceRequest{CloudServiceId: testdata.MockCloudServiceID1}, info,
+		func(ctx context.Context, req any) (any, error) {
+			withVerifiedCaller(ctx, "auditor-grant:5678")
+			return "response", nil
+		})
+	assert.NoError(t, err)
+
+	// A call of a user, who merely sends the headers of an API key and an auditor grant, which are not verified
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		service.APIKeyHeader, "1234.secret",
+		service.AuditorGrantHeader, "5678.secret",
+	))
+	_, err = interceptor(ctx, &orchestrator.ListCloudServicesRequest{}, info,
+		func(ctx context.Context, req any) (any, error) {
+			return "response", nil
+		})
+	assert.NoError(t, err)
+
+	assert.Equal(t, 4, len(recorder.entries))
+
+	assert.Equal(t, "alice", recorder.entries[0].UserId)
+	assert.Equal(t, info.FullMethod, recorder.entries[0].Method)
+	assert.Equal(t, testdata.MockCloudServiceID1, recorder.entries[0].GetCloudServiceId())
+	assert.Equal(t, codes.PermissionDenied.String(), recorder.entries[0].Code)
+	assert.Equal(t, "access denied", recorder.entries[0].GetMessage())
+
+	assert.Equal(t, "api-key:1234", recorder.entries[1].UserId)
+	assert.Nil(t, recorder.entries[1].CloudServiceId)
+	assert.Equal(t, codes.OK.String(), recorder.entries[1].Code)
+	assert.Nil(t, recorder.entries[1].Message)
+
+	assert.Equal(t, "auditor-grant:5678", recorder.entries[2].UserId)
+	assert.Equal(t, testdata.MockCloudServiceID1, recorder.entries[2].GetCloudServiceId())
+
+	assert.Equal(t, "alice", recorder.entries[3].UserId)
+}
+
+func TestStreamAuditLogInterceptor(t *testing.T) {
+	var (
+		recorder = &mockAuditLogRecorder{}
+		c        = &config{}
+		info     = &grpc.StreamServerInfo{FullMethod: "/clouditor.assessment.v1.Assessment/AssessEvidences"}
+	)
+
+	// Without an audit log, nothing is recorded
+	err := StreamAuditLogInterceptor(c)(nil, &mockServerStream{}, info, func(srv any, stream grpc.ServerStream) error {
+		return nil
+	})
+	assert.NoError(t, err)
+
+	WithAuditLog(recorder, nil)(c)
+
+	err = StreamAuditLogInterceptor(c)(nil, &mockServerStream{}, info, func(srv any, stream grpc.ServerStream) error {
+		return status.Error(codes.Canceled, "canceled")
+	})
+	assert.Equal(t, codes.Canceled, status.Code(err))
+
+	// The API key is verified by the authentication further down the chain
+	err = StreamAuditLogInterceptor(c)(nil, &mockServerStream{}, info, func(srv any, stream grpc.ServerStream) error {
+		withVerifiedCaller(stream.Context(), "api-key:1234")
+		return nil
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, 2, len(recorder.entries))
+	assert.Equal(t, info.FullMethod, recorder.entries[0].Method)
+	assert.Equal(t, "", recorder.entries[0].UserId)
+	assert.Equal(t, codes.Canceled.String(), recorder.entries[0].Code)
+	assert.Equal(t, "api-key:1234", recorder.entries[1].UserId)
+}
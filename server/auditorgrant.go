@@ -0,0 +1,88 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package server
+
+import (
+	"context"
+	"slices"
+
+	"clouditor.io/clouditor/v2/api"
+	"clouditor.io/clouditor/v2/service"
+
+	"google.golang.org/grpc"
+)
+
+// WithAuditorGrants is an option for [StartGRPCServer] to additionally accept the tokens of auditor grants in the
+// [service.AuditorGrantHeader] header for the RPCs in [service.AuditorGrantMethods]. This allows external auditors to
+// read the results of a cloud service for a limited time without an account.
+func WithAuditorGrants(verifier service.AuditorGrantVerifier) StartGRPCServerOption {
+	return func(c *config) {
+		c.ac.auditorGrants = verifier
+	}
+}
+
+// authenticateAuditorGrant authenticates a request using the token of an auditor grant. Auditor grants are only
+// accepted for the RPCs in [service.AuditorGrantMethods] and the scope of the grant is stored in the returned context.
+func (config *AuthConfig) authenticateAuditorGrant(ctx context.Context, token string) (newCtx context.Context, err error) {
+	method, _ := grpc.Method(ctx)
+	if !slices.Contains(service.AuditorGrantMethods, method) {
+		log.Debugf("Auditor grant used for %s, which does not accept auditor grants", method)
+		return nil, api.ErrorCodeAuditorGrantNotAccepted.Err()
+	}
+
+	scope, err := config.auditorGrants.VerifyAuditorGrant(ctx, token)
+	if err != nil {
+		log.Debugf("Could not verify auditor grant: %v", err)
+
+		// We do not want to disclose any error details which could be security related,
+		// so we do not wrap the original error
+		return nil, api.ErrorCodeInvalidAuditorGrant.Err()
+	}
+
+	return service.WithAuditorGrantScope(withVerifiedCaller(ctx, "auditor-grant:"+scope.GrantID), scope), nil
+}
+
+// UnaryAuditorGrantScopeInterceptor is a [grpc.UnaryServerInterceptor] that checks whether a request that was
+// authenticated by an auditor grant is for the cloud service and catalog of the grant.
+func UnaryAuditorGrantScopeInterceptor(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+	if err = service.CheckAuditorGrantScope(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+// StreamAuditorGrantScopeInterceptor is a [grpc.StreamServerInterceptor] that checks whether each message of a stream
+// that was authenticated by an auditor grant is for the cloud service and catalog of the grant.
+func StreamAuditorGrantScopeInterceptor(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if _, ok := service.AuditorGrantScopeFromContext(ss.Context()); !ok {
+		return handler(srv, ss)
+	}
+
+	return handler(srv, &authorizingServerStream{ServerStream: ss, authorize: func(m any) error {
+		return service.CheckAuditorGrantScope(ss.Context(), m)
+	}})
+}
@@ -0,0 +1,165 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/service"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const mockAuditorGrantToken = "my-grant"
+
+// mockAuditorGrantVerifier only accepts mockAuditorGrantToken, which is valid for cloud service 1 and the mock catalog.
+type mockAuditorGrantVerifier struct{}
+
+func (mockAuditorGrantVerifier) VerifyAuditorGrant(_ context.Context, token string) (scope *service.AuditorGrantScope, err error) {
+	if token != mockAuditorGrantToken {
+		return nil, errors.New("invalid auditor grant")
+	}
+
+	return &service.AuditorGrantScope{GrantID: "my-grant-id", CloudServiceID: testdata.MockCloudServiceID1, CatalogID: testdata.MockCatalogID}, nil
+}
+
+// newAuditorGrantContext creates an incoming context for the given method with the given token of an auditor grant.
+func newAuditorGrantContext(method string, token string) context.Context {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(service.AuditorGrantHeader, token))
+
+	return grpc.NewContextWithServerTransportStream(ctx, &mockServerTransportStream{method: method})
+}
+
+func TestAuthConfig_AuthFunc_auditorGrant(t *testing.T) {
+	type fields struct {
+		auditorGrants service.AuditorGrantVerifier
+	}
+	type args struct {
+		ctx context.Context
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantCtx assert.Want[context.Context]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "valid auditor grant",
+			fields: fields{
+				auditorGrants: mockAuditorGrantVerifier{},
+			},
+			args: args{
+				ctx: newAuditorGrantContext("/clouditor.orchestrator.v1.Orchestrator/ListAssessmentResults", mockAuditorGrantToken),
+			},
+			wantCtx: func(t *testing.T, got context.Context) bool {
+				scope, ok := service.AuditorGrantScopeFromContext(got)
+				assert.True(t, ok)
+				assert.Equal(t, "auditor-grant:my-grant-id", caller(got, &service.AuthorizationStrategyJWT{}))
+				return assert.Equal(t, testdata.MockCloudServiceID1, scope.CloudServiceID)
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "invalid auditor grant",
+			fields: fields{
+				auditorGrants: mockAuditorGrantVerifier{},
+			},
+			args: args{
+				ctx: newAuditorGrantContext("/clouditor.orchestrator.v1.Orchestrator/ListAssessmentResults", "other-grant"),
+			},
+			wantCtx: assert.Nil[context.Context],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.Equal(t, codes.Unauthenticated, status.Code(err))
+			},
+		},
+		{
+			name: "RPC does not accept auditor grants",
+			fields: fields{
+				auditorGrants: mockAuditorGrantVerifier{},
+			},
+			args: args{
+				ctx: newAuditorGrantContext("/clouditor.orchestrator.v1.Orchestrator/RemoveCloudService", mockAuditorGrantToken),
+			},
+			wantCtx: assert.Nil[context.Context],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.Equal(t, codes.Unauthenticated, status.Code(err))
+			},
+		},
+		{
+			name: "auditor grants not enabled",
+			args: args{
+				ctx: newAuditorGrantContext("/clouditor.orchestrator.v1.Orchestrator/ListAssessmentResults", mockAuditorGrantToken),
+			},
+			wantCtx: assert.Nil[context.Context],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.Equal(t, codes.Unauthenticated, status.Code(err))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := &AuthConfig{auditorGrants: tt.fields.auditorGrants}
+
+			gotCtx, err := config.AuthFunc()(tt.args.ctx)
+			tt.wantErr(t, err)
+			tt.wantCtx(t, gotCtx)
+		})
+	}
+}
+
+func TestUnaryAuditorGrantScopeInterceptor(t *testing.T) {
+	var (
+		info    = &grpc.UnaryServerInfo{FullMethod: "/clouditor.orchestrator.v1.Orchestrator/GetCloudService"}
+		handler = func(ctx context.Context, req any) (any, error) {
+			return "response", nil
+		}
+		ctx = service.WithAuditorGrantScope(context.Background(), &service.AuditorGrantScope{
+			CloudServiceID: testdata.MockCloudServiceID1,
+			CatalogID:      testdata.MockCatalogID,
+		})
+	)
+
+	resp, err := UnaryAuditorGrantScopeInterceptor(ctx, &orchestrator.GetCloudServiceRequest{
+		CloudServiceId: testdata.MockCloudServiceID1,
+	}, info, handler)
+	assert.NoError(t, err)
+	assert.Equal[any](t, "response", resp)
+
+	resp, err = UnaryAuditorGrantScopeInterceptor(ctx, &orchestrator.GetCloudServiceRequest{
+		CloudServiceId: testdata.MockCloudServiceID2,
+	}, info, handler)
+	assert.ErrorIs(t, err, service.ErrPermissionDenied)
+	assert.Nil(t, resp)
+}
@@ -31,9 +31,13 @@ import (
 	"fmt"
 	"time"
 
+	"clouditor.io/clouditor/v2/api"
+	"clouditor.io/clouditor/v2/service"
+
 	"github.com/MicahParks/keyfunc/v2"
 	"github.com/golang-jwt/jwt/v5"
 	grpc_auth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
+	"github.com/grpc-ecosystem/go-grpc-middleware/util/metautils"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -72,6 +76,15 @@ type AuthConfig struct {
 
 	// publicKey will be used to validate API tokens, if JWKS is not enabled
 	publicKey *ecdsa.PublicKey
+
+	// apiKeys verifies the API keys of machine collectors. If nil, API keys are not accepted.
+	apiKeys service.APIKeyVerifier
+
+	// auditorGrants verifies the tokens of auditor grants. If nil, auditor grants are not accepted.
+	auditorGrants service.AuditorGrantVerifier
+
+	// issuers contains trusted OpenID Connect issuers. Tokens of these issuers are validated using their own JWKS.
+	issuers []*trustedIssuer
 }
 
 // DefaultJWKSURL is the default JWKS url pointing to a local authentication server.
@@ -106,8 +119,30 @@ const AuthContextKey = AuthContextKeyType("token")
 // properties.
 func (config *AuthConfig) AuthFunc() grpc_auth.AuthFunc {
 	return func(ctx context.Context) (newCtx context.Context, err error) {
+		// Machine collectors can authenticate using an API key instead of a token
+		if key := metautils.ExtractIncoming(ctx).Get(service.APIKeyHeader); key != "" && config.apiKeys != nil {
+			return config.authenticateAPIKey(ctx, key)
+		}
+
+		// External auditors can authenticate using the token of an auditor grant
+		if token := metautils.ExtractIncoming(ctx).Get(service.AuditorGrantHeader); token != "" && config.auditorGrants != nil {
+			return config.authenticateAuditorGrant(ctx, token)
+		}
+
+		token, err := grpc_auth.AuthFromMD(ctx, "bearer")
+		if err != nil {
+			log.Debugf("Could not retrieve bearer token from header metadata: %v", err)
+
+			// We do not want to disclose any error details which could be security related,
+			// so we do not wrap the original error
+			return nil, api.ErrorCodeInvalidAuthToken.Err()
+		}
+
+		// Tokens of trusted OpenID Connect issuers are validated using the JWKS of the issuer
+		iss := config.issuer(unverifiedIssuer(token))
+
 		// Lazy loading of JWKS
-		if config.jwks == nil && config.useJWKS {
+		if iss == nil && config.jwks == nil && config.useJWKS {
 			log.Debugf("Trying to retrieve JWKS from %s", config.jwksURL)
 			config.jwks, err = keyfunc.Get(config.jwksURL, keyfunc.Options{
 				RefreshInterval: time.Hour,
@@ -118,22 +153,13 @@ func (config *AuthConfig) AuthFunc() grpc_auth.AuthFunc {
 			}
 		}
 
-		token, err := grpc_auth.AuthFromMD(ctx, "bearer")
-		if err != nil {
-			log.Debugf("Could not retrieve bearer token from header metadata: %v", err)
-
-			// We do not want to disclose any error details which could be security related,
-			// so we do not wrap the original error
-			return nil, status.Error(codes.Unauthenticated, "invalid auth token")
-		}
-
-		tokenInfo, err := parseToken(token, config)
+		tokenInfo, err := parseToken(token, iss, config)
 		if err != nil {
 			log.Debugf("Could not parse token in request: %v", err)
 
 			// We do not want to disclose any error details which could be security related,
 			// so we do not wrap the original error
-			return nil, status.Errorf(codes.Unauthenticated, "invalid auth token")
+			return nil, api.ErrorCodeInvalidAuthToken.Err()
 		}
 
 		newCtx = context.WithValue(ctx, AuthContextKey, tokenInfo)
@@ -142,12 +168,15 @@ func (config *AuthConfig) AuthFunc() grpc_auth.AuthFunc {
 	}
 }
 
-func parseToken(token string, authConfig *AuthConfig) (jwt.Claims, error) {
+func parseToken(token string, iss *trustedIssuer, authConfig *AuthConfig) (jwt.Claims, error) {
 	var parsedToken *jwt.Token
 	var err error
 
-	// Use JWKS, if enabled
-	if authConfig.useJWKS {
+	// Use the trusted issuer, if the token was issued by one
+	if iss != nil {
+		parsedToken, err = iss.parse(token)
+	} else if authConfig.useJWKS {
+		// Use JWKS, if enabled
 		parsedToken, err = jwt.ParseWithClaims(token, &OpenIDConnectClaim{}, authConfig.jwks.Keyfunc)
 	} else {
 		// Otherwise, we will use the supplied public key
@@ -162,3 +191,16 @@ func parseToken(token string, authConfig *AuthConfig) (jwt.Claims, error) {
 
 	return parsedToken.Claims, nil
 }
+
+// unverifiedIssuer retrieves the "iss" claim of the token without validating it. It is only used to select the issuer
+// that the token is validated against.
+func unverifiedIssuer(token string) string {
+	var claims jwt.RegisteredClaims
+
+	_, _, err := jwt.NewParser().ParseUnverified(token, &claims)
+	if err != nil {
+		return ""
+	}
+
+	return claims.Issuer
+}
@@ -31,14 +31,13 @@ import (
 	"fmt"
 	"testing"
 
+	"clouditor.io/clouditor/v2/api"
 	"clouditor.io/clouditor/v2/internal/testdata"
 	"clouditor.io/clouditor/v2/internal/testutil"
 	"clouditor.io/clouditor/v2/internal/testutil/assert"
 
 	oauth2 "github.com/oxisto/oauth2go"
-	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
-	"google.golang.org/grpc/status"
 )
 
 func ValidClaimAssertion(t *testing.T, ctx context.Context) bool {
@@ -110,7 +109,7 @@ func TestAuthConfig_AuthFunc(t *testing.T) {
 				ctx: metadata.NewIncomingContext(context.TODO(), metadata.MD{"Authorization": []string{"bearer not_really"}}),
 			},
 			wantErr: func(tt assert.TestingT, e error, i ...interface{}) bool {
-				return assert.ErrorIs(tt, e, status.Error(codes.Unauthenticated, "invalid auth token"))
+				return assert.ErrorIs(tt, e, api.ErrorCodeInvalidAuthToken.Err())
 			},
 		},
 		{
@@ -123,7 +122,7 @@ func TestAuthConfig_AuthFunc(t *testing.T) {
 				ctx: context.TODO(),
 			},
 			wantErr: func(tt assert.TestingT, e error, i ...interface{}) bool {
-				return assert.ErrorIs(tt, e, status.Error(codes.Unauthenticated, "invalid auth token"))
+				return assert.ErrorIs(tt, e, api.ErrorCodeInvalidAuthToken.Err())
 			},
 		},
 		{
@@ -145,7 +144,7 @@ func TestAuthConfig_AuthFunc(t *testing.T) {
 				ctx: context.TODO(),
 			},
 			wantErr: func(tt assert.TestingT, e error, i ...interface{}) bool {
-				return assert.ErrorIs(tt, e, status.Error(codes.Unauthenticated, "invalid auth token"))
+				return assert.ErrorIs(tt, e, api.ErrorCodeInvalidAuthToken.Err())
 			},
 		},
 	}
@@ -0,0 +1,113 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package server
+
+import (
+	"context"
+
+	"clouditor.io/clouditor/v2/api"
+	"clouditor.io/clouditor/v2/api/evidence"
+	"clouditor.io/clouditor/v2/internal/logging"
+
+	"github.com/google/uuid"
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	grpc_ctxtags "github.com/grpc-ecosystem/go-grpc-middleware/tags"
+	"github.com/grpc-ecosystem/go-grpc-middleware/util/metautils"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// maxRequestIDLength is the maximum length of a request ID supplied by a client. Longer IDs are replaced.
+const maxRequestIDLength = 128
+
+// UnaryCorrelationInterceptor is a [grpc.UnaryServerInterceptor] that adds the correlation fields of a request to its
+// context (see [logging.WithFields]), so that they are contained in every log entry of the request. These are the
+// request ID (see [logging.RequestIDHeader]) as well as the cloud service and the evidence of the request, if any. The
+// request ID is returned in the header of the response.
+func UnaryCorrelationInterceptor(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+	ctx, id := correlate(ctx, req)
+
+	// Return the request ID to the client. This fails for calls without a transport, e.g., in tests.
+	_ = grpc.SetHeader(ctx, metadata.Pairs(logging.RequestIDHeader, id))
+
+	return handler(ctx, req)
+}
+
+// StreamCorrelationInterceptor is a [grpc.StreamServerInterceptor] that adds the request ID of a stream to its context
+// like [UnaryCorrelationInterceptor]. Since a stream can contain messages of several cloud services and evidences, the
+// stream handlers need to add them to the context of each message themselves.
+func StreamCorrelationInterceptor(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, id := correlate(ss.Context(), nil)
+
+	_ = ss.SetHeader(metadata.Pairs(logging.RequestIDHeader, id))
+
+	wrapped := grpc_middleware.WrapServerStream(ss)
+	wrapped.WrappedContext = ctx
+
+	return handler(srv, wrapped)
+}
+
+// correlate adds the correlation fields of the request to ctx and returns the request ID. The fields are also added to
+// the tags of the call, so that they are contained in the log entry of the call itself.
+func correlate(ctx context.Context, req any) (newCtx context.Context, id string) {
+	id = requestID(ctx)
+
+	var fields = logrus.Fields{
+		logging.RequestIDField: id,
+	}
+
+	if csreq, ok := req.(api.CloudServiceRequest); ok {
+		fields[logging.CloudServiceIDField] = csreq.GetCloudServiceId()
+	}
+
+	if evreq, ok := req.(interface{ GetEvidenceId() string }); ok {
+		fields[logging.EvidenceIDField] = evreq.GetEvidenceId()
+	}
+
+	ctx = logging.WithFields(ctx, fields)
+
+	if evreq, ok := req.(interface{ GetEvidence() *evidence.Evidence }); ok {
+		ctx = logging.WithEvidence(ctx, evreq.GetEvidence())
+	}
+
+	tags := grpc_ctxtags.Extract(ctx)
+	for k, v := range logging.Fields(ctx) {
+		tags.Set(k, v)
+	}
+
+	return ctx, id
+}
+
+// requestID returns the request ID supplied by the client or, if there is none, generates a new one.
+func requestID(ctx context.Context) string {
+	id := metautils.ExtractIncoming(ctx).Get(logging.RequestIDHeader)
+	if id == "" || len(id) > maxRequestIDLength {
+		id = uuid.NewString()
+	}
+
+	return id
+}
@@ -0,0 +1,150 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package server
+
+import (
+	"context"
+	"testing"
+
+	"clouditor.io/clouditor/v2/api/assessment"
+	"clouditor.io/clouditor/v2/api/evidence"
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/internal/logging"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+
+	"github.com/google/uuid"
+	grpc_ctxtags "github.com/grpc-ecosystem/go-grpc-middleware/tags"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestUnaryCorrelationInterceptor(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req any
+	}
+	tests := []struct {
+		name       string
+		args       args
+		wantFields assert.Want[logrus.Fields]
+	}{
+		{
+			name: "generated request ID",
+			args: args{
+				ctx: context.Background(),
+				req: &orchestrator.ListCloudServicesRequest{},
+			},
+			wantFields: func(t *testing.T, got logrus.Fields) bool {
+				_, err := uuid.Parse(got[logging.RequestIDField].(string))
+				return assert.NoError(t, err) && assert.Equal(t, 1, len(got))
+			},
+		},
+		{
+			name: "request ID of client and cloud service",
+			args: args{
+				ctx: metadata.NewIncomingContext(context.Background(), metadata.Pairs(logging.RequestIDHeader, "my-request")),
+				req: &orchestrator.GetCloudServiceRequest{CloudServiceId: testdata.MockCloudServiceID1},
+			},
+			wantFields: func(t *testing.T, got logrus.Fields) bool {
+				return assert.Equal(t, logrus.Fields{
+					logging.RequestIDField:      "my-request",
+					logging.CloudServiceIDField: testdata.MockCloudServiceID1,
+				}, got)
+			},
+		},
+		{
+			name: "evidence",
+			args: args{
+				ctx: metadata.NewIncomingContext(context.Background(), metadata.Pairs(logging.RequestIDHeader, "my-request")),
+				req: &assessment.AssessEvidenceRequest{Evidence: &evidence.Evidence{
+					Id:             testdata.MockEvidenceID1,
+					CloudServiceId: testdata.MockCloudServiceID1,
+				}},
+			},
+			wantFields: func(t *testing.T, got logrus.Fields) bool {
+				return assert.Equal(t, logrus.Fields{
+					logging.RequestIDField:      "my-request",
+					logging.EvidenceIDField:     testdata.MockEvidenceID1,
+					logging.CloudServiceIDField: testdata.MockCloudServiceID1,
+				}, got)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				gotFields logrus.Fields
+				tags      = grpc_ctxtags.NewTags()
+			)
+
+			ctx := grpc_ctxtags.SetInContext(tt.args.ctx, tags)
+
+			_, err := UnaryCorrelationInterceptor(ctx, tt.args.req, &grpc.UnaryServerInfo{}, func(ctx context.Context, req any) (any, error) {
+				gotFields = logging.Fields(ctx)
+				return nil, nil
+			})
+			assert.NoError(t, err)
+			tt.wantFields(t, gotFields)
+
+			// The fields are also tagged, so that they are contained in the log entry of the call
+			assert.Equal(t, len(gotFields), len(tags.Values()))
+		})
+	}
+}
+
+// mockContextServerStream is a [grpc.ServerStream] with a context.
+type mockContextServerStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	header metadata.MD
+}
+
+func (s *mockContextServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *mockContextServerStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func TestStreamCorrelationInterceptor(t *testing.T) {
+	var gotFields logrus.Fields
+
+	ss := &mockContextServerStream{
+		ctx: metadata.NewIncomingContext(context.Background(), metadata.Pairs(logging.RequestIDHeader, "my-stream")),
+	}
+
+	err := StreamCorrelationInterceptor(nil, ss, &grpc.StreamServerInfo{}, func(srv any, stream grpc.ServerStream) error {
+		gotFields = logging.Fields(stream.Context())
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, logrus.Fields{logging.RequestIDField: "my-stream"}, gotFields)
+	assert.Equal(t, []string{"my-stream"}, ss.header.Get(logging.RequestIDHeader))
+}
@@ -0,0 +1,81 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package server
+
+import (
+	"context"
+	"strings"
+
+	"clouditor.io/clouditor/v2/api"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware/util/metautils"
+	"google.golang.org/grpc"
+)
+
+// UnaryErrorCodeInterceptor is a [grpc.UnaryServerInterceptor] that makes sure that every error contains an error code
+// (see [api.WithErrorCode]), so that clients can map errors without matching their messages. If the client prefers
+// other languages using the Accept-Language header, a translation of the error code is added as well.
+func UnaryErrorCodeInterceptor(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+	resp, err = handler(ctx, req)
+	if err != nil {
+		return nil, api.WithErrorCode(err, languages(ctx)...)
+	}
+
+	return resp, nil
+}
+
+// StreamErrorCodeInterceptor is a [grpc.StreamServerInterceptor] that adds an error code to the error that ends a
+// stream like [UnaryErrorCodeInterceptor].
+func StreamErrorCodeInterceptor(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	err := handler(srv, ss)
+	if err != nil {
+		return api.WithErrorCode(err, languages(ss.Context())...)
+	}
+
+	return nil
+}
+
+// languages returns the ISO 639-1 codes of the languages in the Accept-Language header of the request in their order,
+// e.g., "de" for "de-DE,de;q=0.9,en;q=0.8". The REST gateway forwards the header with a prefix.
+func languages(ctx context.Context) (langs []string) {
+	md := metautils.ExtractIncoming(ctx)
+
+	header := md.Get("accept-language")
+	if header == "" {
+		header = md.Get("grpcgateway-accept-language")
+	}
+
+	for _, tag := range strings.Split(header, ",") {
+		tag, _, _ = strings.Cut(tag, ";")
+		lang, _, _ := strings.Cut(strings.TrimSpace(tag), "-")
+
+		if lang != "" && lang != "*" {
+			langs = append(langs, strings.ToLower(lang))
+		}
+	}
+
+	return langs
+}
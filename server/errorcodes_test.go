@@ -0,0 +1,89 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package server
+
+import (
+	"context"
+	"testing"
+
+	"clouditor.io/clouditor/v2/api"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestUnaryErrorCodeInterceptor(t *testing.T) {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("accept-language", "de-DE"))
+
+	_, err := UnaryErrorCodeInterceptor(ctx, nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, req any) (any, error) {
+		return nil, status.Error(codes.NotFound, "not found")
+	})
+
+	c, ok := api.ErrorCodeOf(err)
+	assert.True(t, ok)
+	assert.Equal(t, "CLOUDITOR-GEN-005", c.ID)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.Equal(t, 2, len(status.Convert(err).Details()))
+
+	resp, err := UnaryErrorCodeInterceptor(ctx, nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, req any) (any, error) {
+		return "ok", nil
+	})
+	assert.NoError(t, err)
+	assert.Equal[any](t, "ok", resp)
+}
+
+func Test_languages(t *testing.T) {
+	tests := []struct {
+		name string
+		md   metadata.MD
+		want []string
+	}{
+		{
+			name: "no header",
+			md:   metadata.MD{},
+			want: nil,
+		},
+		{
+			name: "gRPC header",
+			md:   metadata.Pairs("accept-language", "de-DE,de;q=0.9,en;q=0.8,*;q=0.5"),
+			want: []string{"de", "de", "en"},
+		},
+		{
+			name: "REST gateway header",
+			md:   metadata.Pairs("grpcgateway-accept-language", "EN"),
+			want: []string{"en"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := languages(metadata.NewIncomingContext(context.Background(), tt.md))
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
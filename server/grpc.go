@@ -29,19 +29,21 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"slices"
 
 	"clouditor.io/clouditor/v2/api/assessment"
 	"clouditor.io/clouditor/v2/api/discovery"
 	"clouditor.io/clouditor/v2/api/evaluation"
 	"clouditor.io/clouditor/v2/api/evidence"
 	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/internal/logging"
 	"clouditor.io/clouditor/v2/logging/formatter"
+	"clouditor.io/clouditor/v2/service"
 
-	grpc_auth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
-	grpc_logrus "github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus"
-	grpc_ctxtags "github.com/grpc-ecosystem/go-grpc-middleware/tags"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 )
 
@@ -58,7 +60,12 @@ type config struct {
 	services        map[*grpc.ServiceDesc]any
 	publicEndpoints []string
 	ac              AuthConfig
+	rbac            *service.RBAC
+	audit           *auditLog
+	idempotency     *idempotency
 	reflection      bool
+	interceptors    []Interceptor
+	err             error
 }
 
 // WithOrchestrator is an option for [StartGRPCServer] to register a [orchestrator.OrchestratorServer] at start.
@@ -136,29 +143,20 @@ func StartGRPCServer(addr string, opts ...StartGRPCServerOption) (sock net.Liste
 		return nil, nil, fmt.Errorf("could not listen: %w", err)
 	}
 
-	var c config
+	c := newConfig(opts...)
 
-	grpcLogger := logrus.New()
-	grpcLogger.Formatter = &formatter.GRPCFormatter{TextFormatter: logrus.TextFormatter{ForceColors: true}}
-	grpcLoggerEntry := grpcLogger.WithField("component", "grpc")
-
-	c.grpcOpts = []grpc.ServerOption{
-		grpc.ChainUnaryInterceptor(
-			grpc_ctxtags.UnaryServerInterceptor(grpc_ctxtags.WithFieldExtractor(grpc_ctxtags.CodeGenRequestFieldExtractor)),
-			grpc_logrus.UnaryServerInterceptor(grpcLoggerEntry),
-			UnaryServerInterceptorWithFilter(&c, grpc_auth.UnaryServerInterceptor(c.ac.AuthFunc()), UnaryReflectionFilter, UnaryPublicEndpointFilter),
-		),
-		grpc.ChainStreamInterceptor(
-			grpc_ctxtags.StreamServerInterceptor(grpc_ctxtags.WithFieldExtractor(grpc_ctxtags.CodeGenRequestFieldExtractor)),
-			grpc_logrus.StreamServerInterceptor(grpcLoggerEntry),
-			StreamServerInterceptorWithFilter(&c, grpc_auth.StreamServerInterceptor(c.ac.AuthFunc()), StreamReflectionFilter, StreamPublicEndpointFilter),
-		),
+	// Custom interceptors or a custom order that do not fit the chain are a configuration error
+	if c.err != nil {
+		_ = sock.Close()
+		return nil, nil, fmt.Errorf("could not configure interceptors: %w", c.err)
 	}
-	c.services = map[*grpc.ServiceDesc]any{}
 
-	for _, o := range opts {
-		o(&c)
-	}
+	// The interceptor chain comes first, so that additional gRPC options with interceptors are executed afterwards
+	c.grpcOpts = append([]grpc.ServerOption{
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.ChainUnaryInterceptor(c.unaryInterceptors()...),
+		grpc.ChainStreamInterceptor(c.streamInterceptors()...),
+	}, c.grpcOpts...)
 
 	srv = grpc.NewServer(
 		c.grpcOpts...,
@@ -169,6 +167,9 @@ func StartGRPCServer(addr string, opts ...StartGRPCServerOption) (sock net.Liste
 		srv.RegisterService(sd, svc)
 	}
 
+	// Register the standard health service, which reports the health of the registered services
+	grpc_health_v1.RegisterHealthServer(srv, newHealthServer(c.services))
+
 	// Enable reflection
 	if c.reflection {
 		reflection.Register(srv)
@@ -182,6 +183,29 @@ func StartGRPCServer(addr string, opts ...StartGRPCServerOption) (sock net.Liste
 	return sock, srv, nil
 }
 
+// newConfig creates a new server config with the default interceptor chain and applies the given options to it.
+func newConfig(opts ...StartGRPCServerOption) (c *config) {
+	c = &config{
+		services: map[*grpc.ServiceDesc]any{},
+	}
+
+	// The level of the gRPC calls is filtered by our formatter, according to the level of the "grpc" component
+	grpcLogger := logrus.New()
+	grpcLogger.SetLevel(logrus.TraceLevel)
+	grpcLogger.Formatter = logging.NewFormatter(&formatter.GRPCFormatter{TextFormatter: logrus.TextFormatter{ForceColors: true}})
+	grpcLoggerEntry := grpcLogger.WithField("component", "grpc")
+
+	c.interceptors = defaultInterceptors(c, grpcLoggerEntry)
+
+	for _, o := range opts {
+		o(c)
+	}
+
+	c.checkInterceptorOrder()
+
+	return
+}
+
 // UnaryServerInterceptorWithFilter wraps a grpc.UnaryServerInterceptor and only invokes the interceptor, if the filter
 // function does not return true.
 func UnaryServerInterceptorWithFilter(c *config, in grpc.UnaryServerInterceptor, filter ...func(c *config, info *grpc.UnaryServerInfo) bool) grpc.UnaryServerInterceptor {
@@ -212,14 +236,21 @@ func StreamServerInterceptorWithFilter(c *config, in grpc.StreamServerIntercepto
 	}
 }
 
+// reflectionMethods contains the methods of the reflection endpoint. Both the v1 and the (older) v1alpha versions are
+// registered by [reflection.Register].
+var reflectionMethods = []string{
+	"/grpc.reflection.v1.ServerReflection/ServerReflectionInfo",
+	"/grpc.reflection.v1alpha.ServerReflection/ServerReflectionInfo",
+}
+
 // UnaryReflectionFilter is a filter that ignores calls to the reflection endpoint
 func UnaryReflectionFilter(_ *config, info *grpc.UnaryServerInfo) bool {
-	return info.FullMethod == "/grpc.reflection.v1.ServerReflection/ServerReflectionInfo"
+	return slices.Contains(reflectionMethods, info.FullMethod)
 }
 
 // StreamReflectionFilter is a filter that ignores calls to the reflection endpoint
 func StreamReflectionFilter(_ *config, info *grpc.StreamServerInfo) bool {
-	return info.FullMethod == "/grpc.reflection.v1.ServerReflection/ServerReflectionInfo"
+	return slices.Contains(reflectionMethods, info.FullMethod)
 }
 
 // UnaryPublicEndpointFilter is a filter that ignores calls to the public endpoints
@@ -243,3 +274,20 @@ func StreamPublicEndpointFilter(c *config, info *grpc.StreamServerInfo) bool {
 
 	return false
 }
+
+// authorizingServerStream wraps a [grpc.ServerStream] and authorizes each received message. This is needed for
+// authorization decisions that depend on the request, e.g., on its cloud service.
+type authorizingServerStream struct {
+	grpc.ServerStream
+
+	authorize func(m any) error
+}
+
+// RecvMsg receives a message and checks whether it is authorized.
+func (s *authorizingServerStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	return s.authorize(m)
+}
@@ -38,7 +38,9 @@ import (
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection/grpc_reflection_v1"
+	"google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
 )
 
 func TestMain(m *testing.M) {
@@ -79,3 +81,58 @@ func TestReflectionNoAuth(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, res)
 }
+
+func TestReflectionV1AlphaNoAuth(t *testing.T) {
+	var (
+		session *cli.Session
+		conn    *grpc.ClientConn
+		client  grpc_reflection_v1alpha.ServerReflectionClient
+		sclient grpc_reflection_v1alpha.ServerReflection_ServerReflectionInfoClient
+		res     *grpc_reflection_v1alpha.ServerReflectionResponse
+		err     error
+	)
+
+	session, err = cli.ContinueSession()
+	assert.NoError(t, err)
+
+	// Older clients, such as some versions of grpcurl, still use the v1alpha version of the reflection API
+	conn, err = grpc.Dial(session.URL, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	assert.NoError(t, err)
+
+	client = grpc_reflection_v1alpha.NewServerReflectionClient(conn)
+	sclient, err = client.ServerReflectionInfo(context.TODO())
+	assert.NoError(t, err)
+
+	err = sclient.Send(&grpc_reflection_v1alpha.ServerReflectionRequest{
+		Host:           "localhost",
+		MessageRequest: &grpc_reflection_v1alpha.ServerReflectionRequest_ListServices{},
+	})
+	assert.NoError(t, err)
+
+	res, err = sclient.Recv()
+	assert.NoError(t, err)
+	assert.NotNil(t, res.GetListServicesResponse())
+}
+
+func TestHealthNoAuth(t *testing.T) {
+	var (
+		session *cli.Session
+		conn    *grpc.ClientConn
+		res     *grpc_health_v1.HealthCheckResponse
+		err     error
+	)
+
+	session, err = cli.ContinueSession()
+	assert.NoError(t, err)
+
+	// Only use the host from the session, but not the (authentication) connection, since we want to test, whether
+	// probes can access the health service without authentication
+	conn, err = grpc.Dial(session.URL, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	assert.NoError(t, err)
+
+	res, err = grpc_health_v1.NewHealthClient(conn).Check(context.TODO(), &grpc_health_v1.HealthCheckRequest{
+		Service: "clouditor.orchestrator.v1.Orchestrator",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, res.Status)
+}
@@ -0,0 +1,157 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package server
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"clouditor.io/clouditor/v2/service"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+// DefaultHealthWatchInterval is the interval in which the health of the services is re-evaluated for clients that
+// watch it.
+const DefaultHealthWatchInterval = 5 * time.Second
+
+// healthServer implements the standard gRPC health checking protocol (grpc.health.v1). Instead of keeping a status that
+// needs to be updated, the health of a service is determined on demand using [service.HealthChecker], if the service
+// implements it. The empty service name refers to the health of all registered services.
+type healthServer struct {
+	grpc_health_v1.UnimplementedHealthServer
+
+	// services contains the registered services by their full service name
+	services map[string]any
+
+	// interval in which the health is re-evaluated in Watch
+	interval time.Duration
+}
+
+// newHealthServer creates a new health server for the given registered services.
+func newHealthServer(services map[*grpc.ServiceDesc]any) *healthServer {
+	h := &healthServer{
+		services: make(map[string]any, len(services)),
+		interval: DefaultHealthWatchInterval,
+	}
+
+	for sd, svc := range services {
+		h.services[sd.ServiceName] = svc
+	}
+
+	return h
+}
+
+// Check implements [grpc_health_v1.HealthServer].
+func (h *healthServer) Check(ctx context.Context, req *grpc_health_v1.HealthCheckRequest) (*grpc_health_v1.HealthCheckResponse, error) {
+	s, err := h.status(ctx, req.Service)
+	if err != nil {
+		return nil, err
+	}
+
+	return &grpc_health_v1.HealthCheckResponse{Status: s}, nil
+}
+
+// Watch implements [grpc_health_v1.HealthServer]. It sends the current status and then a new status, whenever it
+// changes. As specified by the protocol, an unknown service is reported as SERVICE_UNKNOWN instead of an error.
+func (h *healthServer) Watch(req *grpc_health_v1.HealthCheckRequest, stream grpc_health_v1.Health_WatchServer) error {
+	var (
+		last   grpc_health_v1.HealthCheckResponse_ServingStatus = -1
+		ticker                                                  = time.NewTicker(h.interval)
+	)
+
+	defer ticker.Stop()
+
+	for {
+		s, err := h.status(stream.Context(), req.Service)
+		if status.Code(err) == codes.NotFound {
+			s = grpc_health_v1.HealthCheckResponse_SERVICE_UNKNOWN
+		}
+
+		if s != last {
+			err = stream.Send(&grpc_health_v1.HealthCheckResponse{Status: s})
+			if err != nil {
+				return err
+			}
+
+			last = s
+		}
+
+		select {
+		case <-stream.Context().Done():
+			return status.FromContextError(stream.Context().Err()).Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// status determines the serving status of the service with the given name. If name is empty, all registered services
+// need to be healthy.
+func (h *healthServer) status(ctx context.Context, name string) (grpc_health_v1.HealthCheckResponse_ServingStatus, error) {
+	var names []string
+
+	if name == "" {
+		for n := range h.services {
+			names = append(names, n)
+		}
+
+		// Make sure, we check (and log) the services in a stable order
+		sort.Strings(names)
+	} else if _, ok := h.services[name]; ok {
+		names = []string{name}
+	} else {
+		return grpc_health_v1.HealthCheckResponse_SERVICE_UNKNOWN, status.Errorf(codes.NotFound, "unknown service %q", name)
+	}
+
+	for _, n := range names {
+		checker, ok := h.services[n].(service.HealthChecker)
+		if !ok {
+			continue
+		}
+
+		if err := checker.CheckHealth(ctx); err != nil {
+			log.WithFields(logrus.Fields{"service": n}).Warnf("Service is not healthy: %v", err)
+			return grpc_health_v1.HealthCheckResponse_NOT_SERVING, nil
+		}
+	}
+
+	return grpc_health_v1.HealthCheckResponse_SERVING, nil
+}
+
+// UnaryHealthFilter is a filter that ignores calls to the health endpoint, so that probes do not need to authenticate
+func UnaryHealthFilter(_ *config, info *grpc.UnaryServerInfo) bool {
+	return info.FullMethod == grpc_health_v1.Health_Check_FullMethodName
+}
+
+// StreamHealthFilter is a filter that ignores calls to the health endpoint, so that probes do not need to authenticate
+func StreamHealthFilter(_ *config, info *grpc.StreamServerInfo) bool {
+	return info.FullMethod == grpc_health_v1.Health_Watch_FullMethodName
+}
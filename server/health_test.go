@@ -0,0 +1,186 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+// mockHealthChecker is a service that reports the health given in err.
+type mockHealthChecker struct {
+	err error
+}
+
+func (m *mockHealthChecker) CheckHealth(_ context.Context) error {
+	return m.err
+}
+
+func newMockHealthServer(healthy bool) *healthServer {
+	var err error
+	if !healthy {
+		err = errors.New("storage is not reachable")
+	}
+
+	return newHealthServer(map[*grpc.ServiceDesc]any{
+		{ServiceName: "test.Healthy"}:   &mockHealthChecker{},
+		{ServiceName: "test.NoChecker"}: struct{}{},
+		{ServiceName: "test.Other"}:     &mockHealthChecker{err: err},
+	})
+}
+
+func Test_healthServer_Check(t *testing.T) {
+	type args struct {
+		req *grpc_health_v1.HealthCheckRequest
+	}
+	tests := []struct {
+		name    string
+		h       *healthServer
+		args    args
+		want    assert.Want[*grpc_health_v1.HealthCheckResponse]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "all services healthy",
+			h:    newMockHealthServer(true),
+			args: args{
+				req: &grpc_health_v1.HealthCheckRequest{},
+			},
+			want: func(t *testing.T, got *grpc_health_v1.HealthCheckResponse) bool {
+				return assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, got.Status)
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "one service unhealthy",
+			h:    newMockHealthServer(false),
+			args: args{
+				req: &grpc_health_v1.HealthCheckRequest{},
+			},
+			want: func(t *testing.T, got *grpc_health_v1.HealthCheckResponse) bool {
+				return assert.Equal(t, grpc_health_v1.HealthCheckResponse_NOT_SERVING, got.Status)
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "single healthy service",
+			h:    newMockHealthServer(false),
+			args: args{
+				req: &grpc_health_v1.HealthCheckRequest{Service: "test.Healthy"},
+			},
+			want: func(t *testing.T, got *grpc_health_v1.HealthCheckResponse) bool {
+				return assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, got.Status)
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "service without checker",
+			h:    newMockHealthServer(false),
+			args: args{
+				req: &grpc_health_v1.HealthCheckRequest{Service: "test.NoChecker"},
+			},
+			want: func(t *testing.T, got *grpc_health_v1.HealthCheckResponse) bool {
+				return assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, got.Status)
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "unknown service",
+			h:    newMockHealthServer(true),
+			args: args{
+				req: &grpc_health_v1.HealthCheckRequest{Service: "test.Unknown"},
+			},
+			want: assert.Nil[*grpc_health_v1.HealthCheckResponse],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.Equal(t, codes.NotFound, status.Code(err))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.h.Check(context.Background(), tt.args.req)
+			tt.want(t, got)
+			tt.wantErr(t, err)
+		})
+	}
+}
+
+// mockHealthWatchServer records the sent responses and cancels its context after the first one.
+type mockHealthWatchServer struct {
+	grpc.ServerStream
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	sent   []*grpc_health_v1.HealthCheckResponse
+}
+
+func (m *mockHealthWatchServer) Context() context.Context {
+	return m.ctx
+}
+
+func (m *mockHealthWatchServer) Send(res *grpc_health_v1.HealthCheckResponse) error {
+	m.sent = append(m.sent, res)
+	m.cancel()
+	return nil
+}
+
+func Test_healthServer_Watch(t *testing.T) {
+	tests := []struct {
+		name string
+		req  *grpc_health_v1.HealthCheckRequest
+		want grpc_health_v1.HealthCheckResponse_ServingStatus
+	}{
+		{
+			name: "known service",
+			req:  &grpc_health_v1.HealthCheckRequest{Service: "test.Other"},
+			want: grpc_health_v1.HealthCheckResponse_NOT_SERVING,
+		},
+		{
+			name: "unknown service",
+			req:  &grpc_health_v1.HealthCheckRequest{Service: "test.Unknown"},
+			want: grpc_health_v1.HealthCheckResponse_SERVICE_UNKNOWN,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			stream := &mockHealthWatchServer{ctx: ctx, cancel: cancel}
+
+			err := newMockHealthServer(false).Watch(tt.req, stream)
+			assert.Equal(t, codes.Canceled, status.Code(err))
+			assert.Equal(t, 1, len(stream.sent))
+			assert.Equal(t, tt.want, stream.sent[0].Status)
+		})
+	}
+}
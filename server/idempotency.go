@@ -0,0 +1,98 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package server
+
+import (
+	"context"
+	"crypto/sha256"
+	"slices"
+	"strings"
+	"time"
+
+	"clouditor.io/clouditor/v2/service"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware/util/metautils"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// idempotency contains the configuration of the idempotency keys.
+type idempotency struct {
+	keys  *service.IdempotencyKeys
+	users service.UserIdentifier
+}
+
+// WithIdempotencyKeys is an option for [StartGRPCServer] to support idempotency keys for the RPCs in
+// [service.IdempotentMethods]. Keys are tracked for ttl per caller, which is identified by users (defaulting to
+// [service.AuthorizationStrategyJWT]) or by the API key.
+func WithIdempotencyKeys(ttl time.Duration, users service.UserIdentifier) StartGRPCServerOption {
+	return func(c *config) {
+		if users == nil {
+			users = &service.AuthorizationStrategyJWT{}
+		}
+
+		c.idempotency = &idempotency{keys: service.NewIdempotencyKeys(ttl), users: users}
+	}
+}
+
+// UnaryIdempotencyInterceptor returns a [grpc.UnaryServerInterceptor] that executes a request with an idempotency key
+// (see [service.IdempotencyKeyHeader]) only once. A retry with the same key returns the response of the first
+// request, while reusing the key for a different request fails. Requests without a key, of other RPCs or if no
+// idempotency keys are configured, are executed as usual.
+func UnaryIdempotencyInterceptor(c *config) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+		key := metautils.ExtractIncoming(ctx).Get(service.IdempotencyKeyHeader)
+		if c.idempotency == nil || key == "" || !slices.Contains(service.IdempotentMethods, info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		if len(key) > service.MaxIdempotencyKeyLength {
+			return nil, status.Errorf(codes.InvalidArgument, "idempotency key must not be longer than %d characters",
+				service.MaxIdempotencyKeyLength)
+		}
+
+		m, ok := req.(proto.Message)
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		b, err := proto.MarshalOptions{Deterministic: true}.Marshal(m)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "could not fingerprint request: %v", err)
+		}
+
+		fingerprint := sha256.Sum256(b)
+
+		// Keys are scoped to the caller and the RPC, so that different collectors cannot interfere
+		scoped := strings.Join([]string{info.FullMethod, caller(ctx, c.idempotency.users), key}, "\x00")
+
+		return c.idempotency.keys.Do(ctx, scoped, fingerprint[:], func() (any, error) {
+			return handler(ctx, req)
+		})
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"clouditor.io/clouditor/v2/api/evidence"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/service"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestUnaryIdempotencyInterceptor(t *testing.T) {
+	var (
+		c     config
+		calls int
+		info  = &grpc.UnaryServerInfo{FullMethod: "/clouditor.evidence.v1.EvidenceStore/StoreEvidence"}
+		req   = &evidence.StoreEvidenceRequest{Evidence: &evidence.Evidence{Id: testdata.MockEvidenceID1}}
+		ctx   = withVerifiedCaller(metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+			service.IdempotencyKeyHeader, "my-key",
+		)), "api-key:00000000-0000-0000-0000-000000000001")
+		handler = func(ctx context.Context, req any) (any, error) {
+			calls++
+			return &evidence.StoreEvidenceResponse{}, nil
+		}
+	)
+
+	WithIdempotencyKeys(time.Hour, nil)(&c)
+	interceptor := UnaryIdempotencyInterceptor(&c)
+
+	_, err := interceptor(ctx, req, info, handler)
+	assert.NoError(t, err)
+
+	// The retry is not executed again
+	_, err = interceptor(ctx, req, info, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, calls)
+
+	// Reusing the key for another evidence fails
+	_, err = interceptor(ctx, &evidence.StoreEvidenceRequest{Evidence: &evidence.Evidence{Id: testdata.MockEvidenceID2}}, info, handler)
+	assert.ErrorIs(t, err, service.ErrIdempotencyKeyReused)
+
+	// The same key of another API key is independent
+	other := withVerifiedCaller(metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		service.IdempotencyKeyHeader, "my-key",
+	)), "api-key:00000000-0000-0000-0000-000000000002")
+
+	_, err = interceptor(other, req, info, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, calls)
+
+	// Requests without a key are always executed
+	_, err = interceptor(context.Background(), req, info, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, calls)
+
+	// Keys that are too long are rejected
+	long := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		service.IdempotencyKeyHeader, string(make([]byte, service.MaxIdempotencyKeyLength+1)),
+	))
+
+	_, err = interceptor(long, req, info, handler)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,293 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package server
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	grpc_auth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
+	grpc_logrus "github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus"
+	grpc_ctxtags "github.com/grpc-ecosystem/go-grpc-middleware/tags"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+)
+
+// Names of the built-in interceptors, in the default order of the interceptor chain. They can be used to insert custom
+// interceptors relative to a built-in one or to reorder the built-in interceptors.
+const (
+	InterceptorMetrics           = "metrics"
+	InterceptorTags              = "tags"
+	InterceptorCorrelation       = "correlation"
+	InterceptorErrorCodes        = "error-codes"
+	InterceptorLogging           = "logging"
+	InterceptorAuditLog          = "audit-log"
+	InterceptorAuth              = "auth"
+	InterceptorAPIKeyScope       = "api-key-scope"
+	InterceptorAuditorGrantScope = "auditor-grant-scope"
+	InterceptorRBAC              = "rbac"
+	InterceptorValidation        = "validation"
+	InterceptorIdempotency       = "idempotency"
+)
+
+var (
+	// ErrUnknownInterceptor indicates that an interceptor with the given name is not part of the interceptor chain
+	ErrUnknownInterceptor = errors.New("unknown interceptor")
+
+	// ErrDuplicateInterceptor indicates that an interceptor with the given name is already part of the interceptor
+	// chain
+	ErrDuplicateInterceptor = errors.New("duplicate interceptor")
+
+	// ErrInterceptorBeforeAuth indicates that an interceptor, which relies on the authentication, is placed before
+	// [InterceptorAuth] in the interceptor chain
+	ErrInterceptorBeforeAuth = errors.New("interceptor must be placed after the authentication")
+)
+
+// scopeInterceptors are the built-in interceptors that restrict the requests of callers that were authenticated by an
+// API key or an auditor grant. The scope is only known after the authentication, so they would let all requests pass,
+// if they were placed before [InterceptorAuth].
+var scopeInterceptors = []string{InterceptorAPIKeyScope, InterceptorAuditorGrantScope}
+
+// Interceptor is a named middleware in the interceptor chain of the gRPC server. It consists of a unary and a stream
+// interceptor, either of which can be nil if the middleware only applies to one kind of RPC.
+type Interceptor struct {
+	// Name identifies the interceptor in the chain. It must be unique.
+	Name string
+
+	Unary  grpc.UnaryServerInterceptor
+	Stream grpc.StreamServerInterceptor
+
+	// SkipPublic specifies that calls to the reflection, the health and the public endpoints bypass the interceptor,
+	// like they bypass the built-in authentication. This is useful for interceptors that need an authenticated user.
+	SkipPublic bool
+}
+
+// WithInterceptors is an option for [StartGRPCServer] to append custom interceptors, e.g., for quotas or tenant
+// resolution, to the end of the interceptor chain, i.e., after all built-in interceptors.
+func WithInterceptors(in ...Interceptor) StartGRPCServerOption {
+	return func(c *config) {
+		for _, i := range in {
+			c.insertInterceptor(len(c.interceptors), i)
+		}
+	}
+}
+
+// WithInterceptorBefore is an option for [StartGRPCServer] to insert a custom interceptor directly before the
+// interceptor with the given name, e.g., before [InterceptorAuth].
+func WithInterceptorBefore(name string, in Interceptor) StartGRPCServerOption {
+	return func(c *config) {
+		if idx := c.interceptorIndex(name); idx != -1 {
+			c.insertInterceptor(idx, in)
+		}
+	}
+}
+
+// WithInterceptorAfter is an option for [StartGRPCServer] to insert a custom interceptor directly after the interceptor
+// with the given name, e.g., after [InterceptorAuth] so that it can access the authenticated user.
+func WithInterceptorAfter(name string, in Interceptor) StartGRPCServerOption {
+	return func(c *config) {
+		if idx := c.interceptorIndex(name); idx != -1 {
+			c.insertInterceptor(idx+1, in)
+		}
+	}
+}
+
+// WithInterceptorOrder is an option for [StartGRPCServer] to reorder the interceptor chain. The interceptors with the
+// given names are moved to the front of the chain in the given order. All other interceptors follow in their previous
+// order, so that no interceptor is dropped by accident. The scope checks of API keys and auditor grants need to stay
+// after [InterceptorAuth], otherwise [StartGRPCServer] fails.
+func WithInterceptorOrder(names ...string) StartGRPCServerOption {
+	return func(c *config) {
+		var ordered = make([]Interceptor, 0, len(c.interceptors))
+
+		// Make sure that all names are known before we touch the chain
+		for _, name := range names {
+			if c.interceptorIndex(name) == -1 {
+				return
+			}
+		}
+
+		for _, name := range names {
+			idx := slices.IndexFunc(c.interceptors, func(in Interceptor) bool {
+				return in.Name == name
+			})
+			if idx == -1 {
+				// The name was listed twice
+				continue
+			}
+
+			ordered = append(ordered, c.interceptors[idx])
+			c.interceptors = slices.Delete(c.interceptors, idx, idx+1)
+		}
+
+		c.interceptors = append(ordered, c.interceptors...)
+	}
+}
+
+// defaultInterceptors returns the built-in interceptors in their default order.
+func defaultInterceptors(c *config, logger *logrus.Entry) []Interceptor {
+	return []Interceptor{
+		{
+			Name:   InterceptorMetrics,
+			Unary:  UnaryMetricsInterceptor,
+			Stream: StreamMetricsInterceptor,
+		},
+		{
+			Name:   InterceptorTags,
+			Unary:  grpc_ctxtags.UnaryServerInterceptor(grpc_ctxtags.WithFieldExtractor(grpc_ctxtags.CodeGenRequestFieldExtractor)),
+			Stream: grpc_ctxtags.StreamServerInterceptor(grpc_ctxtags.WithFieldExtractor(grpc_ctxtags.CodeGenRequestFieldExtractor)),
+		},
+		{
+			Name:   InterceptorCorrelation,
+			Unary:  UnaryCorrelationInterceptor,
+			Stream: StreamCorrelationInterceptor,
+		},
+		{
+			Name:   InterceptorErrorCodes,
+			Unary:  UnaryErrorCodeInterceptor,
+			Stream: StreamErrorCodeInterceptor,
+		},
+		{
+			Name:   InterceptorLogging,
+			Unary:  grpc_logrus.UnaryServerInterceptor(logger),
+			Stream: grpc_logrus.StreamServerInterceptor(logger),
+		},
+		{
+			Name:       InterceptorAuditLog,
+			Unary:      UnaryAuditLogInterceptor(c),
+			Stream:     StreamAuditLogInterceptor(c),
+			SkipPublic: true,
+		},
+		{
+			Name:       InterceptorAuth,
+			Unary:      grpc_auth.UnaryServerInterceptor(c.ac.AuthFunc()),
+			Stream:     grpc_auth.StreamServerInterceptor(c.ac.AuthFunc()),
+			SkipPublic: true,
+		},
+		{
+			Name:   InterceptorAPIKeyScope,
+			Unary:  UnaryAPIKeyScopeInterceptor,
+			Stream: StreamAPIKeyScopeInterceptor,
+		},
+		{
+			Name:   InterceptorAuditorGrantScope,
+			Unary:  UnaryAuditorGrantScopeInterceptor,
+			Stream: StreamAuditorGrantScopeInterceptor,
+		},
+		{
+			Name:       InterceptorRBAC,
+			Unary:      UnaryRBACInterceptor(c),
+			Stream:     StreamRBACInterceptor(c),
+			SkipPublic: true,
+		},
+		{
+			Name:   InterceptorValidation,
+			Unary:  UnaryValidationInterceptor,
+			Stream: StreamValidationInterceptor,
+		},
+		{
+			Name:  InterceptorIdempotency,
+			Unary: UnaryIdempotencyInterceptor(c),
+		},
+	}
+}
+
+// checkInterceptorOrder records an error in the config, if one of the [scopeInterceptors] is placed before
+// [InterceptorAuth].
+func (c *config) checkInterceptorOrder() {
+	auth := slices.IndexFunc(c.interceptors, func(in Interceptor) bool {
+		return in.Name == InterceptorAuth
+	})
+
+	for _, name := range scopeInterceptors {
+		idx := slices.IndexFunc(c.interceptors, func(in Interceptor) bool {
+			return in.Name == name
+		})
+		if idx < auth {
+			c.err = errors.Join(c.err, fmt.Errorf("%w: %s", ErrInterceptorBeforeAuth, name))
+		}
+	}
+}
+
+// interceptorIndex returns the position of the interceptor with the given name in the chain. If there is no such
+// interceptor, an error is recorded in the config and -1 is returned.
+func (c *config) interceptorIndex(name string) int {
+	idx := slices.IndexFunc(c.interceptors, func(in Interceptor) bool {
+		return in.Name == name
+	})
+	if idx == -1 {
+		c.err = errors.Join(c.err, fmt.Errorf("%w: %s", ErrUnknownInterceptor, name))
+	}
+
+	return idx
+}
+
+// insertInterceptor inserts the interceptor at the given position of the chain, unless its name is already taken.
+func (c *config) insertInterceptor(idx int, in Interceptor) {
+	if slices.ContainsFunc(c.interceptors, func(other Interceptor) bool {
+		return other.Name == in.Name
+	}) {
+		c.err = errors.Join(c.err, fmt.Errorf("%w: %s", ErrDuplicateInterceptor, in.Name))
+		return
+	}
+
+	c.interceptors = slices.Insert(c.interceptors, idx, in)
+}
+
+// unaryInterceptors returns the unary interceptors of the chain in their order.
+func (c *config) unaryInterceptors() (chain []grpc.UnaryServerInterceptor) {
+	for _, in := range c.interceptors {
+		if in.Unary == nil {
+			continue
+		}
+
+		if in.SkipPublic {
+			chain = append(chain, UnaryServerInterceptorWithFilter(c, in.Unary, UnaryReflectionFilter, UnaryHealthFilter, UnaryPublicEndpointFilter))
+		} else {
+			chain = append(chain, in.Unary)
+		}
+	}
+
+	return
+}
+
+// streamInterceptors returns the stream interceptors of the chain in their order.
+func (c *config) streamInterceptors() (chain []grpc.StreamServerInterceptor) {
+	for _, in := range c.interceptors {
+		if in.Stream == nil {
+			continue
+		}
+
+		if in.SkipPublic {
+			chain = append(chain, StreamServerInterceptorWithFilter(c, in.Stream, StreamReflectionFilter, StreamHealthFilter, StreamPublicEndpointFilter))
+		} else {
+			chain = append(chain, in.Stream)
+		}
+	}
+
+	return
+}
@@ -0,0 +1,190 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package server
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func newTestInterceptor(name string, calls *[]string) Interceptor {
+	return Interceptor{
+		Name: name,
+		Unary: func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+			*calls = append(*calls, name)
+			return handler(ctx, req)
+		},
+	}
+}
+
+func interceptorNames(c *config) (names []string) {
+	for _, in := range c.interceptors {
+		names = append(names, in.Name)
+	}
+
+	return
+}
+
+func TestInterceptorOptions(t *testing.T) {
+	var calls []string
+
+	tests := []struct {
+		name      string
+		opts      []StartGRPCServerOption
+		wantNames []string
+		wantErr   assert.WantErr
+	}{
+		{
+			name: "Default chain",
+			wantNames: []string{InterceptorMetrics, InterceptorTags, InterceptorCorrelation, InterceptorErrorCodes, InterceptorLogging, InterceptorAuditLog,
+				InterceptorAuth, InterceptorAPIKeyScope, InterceptorAuditorGrantScope, InterceptorRBAC, InterceptorValidation, InterceptorIdempotency},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "Custom interceptors",
+			opts: []StartGRPCServerOption{
+				WithInterceptorBefore(InterceptorAuth, newTestInterceptor("tenant", &calls)),
+				WithInterceptorAfter(InterceptorAuth, newTestInterceptor("quota", &calls)),
+				WithInterceptors(newTestInterceptor("last", &calls)),
+			},
+			wantNames: []string{InterceptorMetrics, InterceptorTags, InterceptorCorrelation, InterceptorErrorCodes, InterceptorLogging, InterceptorAuditLog,
+				"tenant", InterceptorAuth, "quota", InterceptorAPIKeyScope, InterceptorAuditorGrantScope, InterceptorRBAC, InterceptorValidation, InterceptorIdempotency, "last"},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "Reorder",
+			opts: []StartGRPCServerOption{
+				WithInterceptorOrder(InterceptorLogging, InterceptorMetrics, InterceptorLogging),
+			},
+			wantNames: []string{InterceptorLogging, InterceptorMetrics, InterceptorTags, InterceptorCorrelation, InterceptorErrorCodes, InterceptorAuditLog,
+				InterceptorAuth, InterceptorAPIKeyScope, InterceptorAuditorGrantScope, InterceptorRBAC, InterceptorValidation, InterceptorIdempotency},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "Unknown interceptor",
+			opts: []StartGRPCServerOption{
+				WithInterceptorOrder(InterceptorLogging, "authz"),
+			},
+			wantNames: []string{InterceptorMetrics, InterceptorTags, InterceptorCorrelation, InterceptorErrorCodes, InterceptorLogging, InterceptorAuditLog,
+				InterceptorAuth, InterceptorAPIKeyScope, InterceptorAuditorGrantScope, InterceptorRBAC, InterceptorValidation, InterceptorIdempotency},
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, ErrUnknownInterceptor)
+			},
+		},
+		{
+			name: "Duplicate interceptor",
+			opts: []StartGRPCServerOption{
+				WithInterceptors(newTestInterceptor(InterceptorAuth, &calls)),
+			},
+			wantNames: []string{InterceptorMetrics, InterceptorTags, InterceptorCorrelation, InterceptorErrorCodes, InterceptorLogging, InterceptorAuditLog,
+				InterceptorAuth, InterceptorAPIKeyScope, InterceptorAuditorGrantScope, InterceptorRBAC, InterceptorValidation, InterceptorIdempotency},
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, ErrDuplicateInterceptor)
+			},
+		},
+		{
+			name: "Scope check before authentication",
+			opts: []StartGRPCServerOption{
+				WithInterceptorOrder(InterceptorAuditorGrantScope, InterceptorAuth),
+			},
+			wantNames: []string{InterceptorAuditorGrantScope, InterceptorAuth, InterceptorMetrics, InterceptorTags, InterceptorCorrelation, InterceptorErrorCodes,
+				InterceptorLogging, InterceptorAuditLog, InterceptorAPIKeyScope, InterceptorRBAC, InterceptorValidation, InterceptorIdempotency},
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, ErrInterceptorBeforeAuth) &&
+					assert.ErrorContains(t, err, InterceptorAuditorGrantScope) &&
+					!strings.Contains(err.Error(), InterceptorAPIKeyScope)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &config{}
+			c.interceptors = defaultInterceptors(c, logrus.NewEntry(logrus.New()))
+
+			for _, o := range tt.opts {
+				o(c)
+			}
+
+			c.checkInterceptorOrder()
+
+			tt.wantErr(t, c.err)
+			assert.Equal(t, tt.wantNames, interceptorNames(c))
+		})
+	}
+}
+
+func Test_config_unaryInterceptors(t *testing.T) {
+	var (
+		calls   []string
+		handler = func(ctx context.Context, req any) (any, error) {
+			return nil, nil
+		}
+	)
+
+	protected := newTestInterceptor("protected", &calls)
+	protected.SkipPublic = true
+
+	c := &config{
+		interceptors: []Interceptor{
+			newTestInterceptor("first", &calls),
+			protected,
+			{Name: "stream-only", Stream: StreamMetricsInterceptor},
+			newTestInterceptor("last", &calls),
+		},
+	}
+
+	chain := c.unaryInterceptors()
+	assert.Equal(t, 3, len(chain))
+
+	// Execute the chain in its order, like grpc.ChainUnaryInterceptor does
+	call := func(info *grpc.UnaryServerInfo) {
+		next := handler
+		for i := len(chain) - 1; i >= 0; i-- {
+			in, h := chain[i], next
+			next = func(ctx context.Context, req any) (any, error) {
+				return in(ctx, req, info, h)
+			}
+		}
+
+		_, err := next(context.Background(), nil)
+		assert.NoError(t, err)
+	}
+
+	call(&grpc.UnaryServerInfo{FullMethod: "/clouditor.orchestrator.v1.Orchestrator/ListMetrics"})
+	assert.Equal(t, []string{"first", "protected", "last"}, calls)
+
+	// Calls to the health endpoint bypass the protected interceptor
+	calls = nil
+	call(&grpc.UnaryServerInfo{FullMethod: grpc_health_v1.Health_Check_FullMethodName})
+	assert.Equal(t, []string{"first", "last"}, calls)
+}
@@ -0,0 +1,81 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package server
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"clouditor.io/clouditor/v2/internal/telemetry"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryMetricsInterceptor is a [grpc.UnaryServerInterceptor] that records the number and the duration of the handled
+// unary gRPC requests.
+func UnaryMetricsInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+	start := time.Now()
+
+	resp, err = handler(ctx, req)
+
+	observeRequest(info.FullMethod, start, err)
+
+	return
+}
+
+// StreamMetricsInterceptor is a [grpc.StreamServerInterceptor] that records the number and the duration of the handled
+// gRPC streams.
+func StreamMetricsInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+	start := time.Now()
+
+	err = handler(srv, ss)
+
+	observeRequest(info.FullMethod, start, err)
+
+	return
+}
+
+// observeRequest records a handled gRPC request of the given full method name, e.g.,
+// "/clouditor.orchestrator.v1.Orchestrator/ListCatalogs".
+func observeRequest(fullMethod string, start time.Time, err error) {
+	service, method := splitMethodName(fullMethod)
+
+	telemetry.GRPCRequestsTotal.WithLabelValues(service, method, status.Code(err).String()).Inc()
+	telemetry.GRPCRequestDuration.WithLabelValues(service, method).Observe(time.Since(start).Seconds())
+}
+
+// splitMethodName splits a full gRPC method name into the service and the method name.
+func splitMethodName(fullMethod string) (service string, method string) {
+	fullMethod = strings.TrimPrefix(fullMethod, "/")
+
+	if i := strings.Index(fullMethod, "/"); i >= 0 {
+		return fullMethod[:i], fullMethod[i+1:]
+	}
+
+	return "unknown", fullMethod
+}
@@ -0,0 +1,144 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package server
+
+import (
+	"context"
+	"testing"
+
+	"clouditor.io/clouditor/v2/internal/telemetry"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_splitMethodName(t *testing.T) {
+	type args struct {
+		fullMethod string
+	}
+	tests := []struct {
+		name        string
+		args        args
+		wantService string
+		wantMethod  string
+	}{
+		{
+			name: "full method",
+			args: args{
+				fullMethod: "/clouditor.orchestrator.v1.Orchestrator/ListCatalogs",
+			},
+			wantService: "clouditor.orchestrator.v1.Orchestrator",
+			wantMethod:  "ListCatalogs",
+		},
+		{
+			name: "method only",
+			args: args{
+				fullMethod: "ListCatalogs",
+			},
+			wantService: "unknown",
+			wantMethod:  "ListCatalogs",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotService, gotMethod := splitMethodName(tt.args.fullMethod)
+			assert.Equal(t, tt.wantService, gotService)
+			assert.Equal(t, tt.wantMethod, gotMethod)
+		})
+	}
+}
+
+func TestUnaryMetricsInterceptor(t *testing.T) {
+	type args struct {
+		info    *grpc.UnaryServerInfo
+		handler grpc.UnaryHandler
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantResp assert.Want[any]
+		wantErr  assert.WantErr
+		wantCode codes.Code
+	}{
+		{
+			name: "successful request",
+			args: args{
+				info: &grpc.UnaryServerInfo{FullMethod: "/test.Service/Success"},
+				handler: func(ctx context.Context, req any) (any, error) {
+					return "response", nil
+				},
+			},
+			wantResp: func(t *testing.T, got any) bool {
+				return assert.Equal[any](t, "response", got)
+			},
+			wantErr:  assert.Nil[error],
+			wantCode: codes.OK,
+		},
+		{
+			name: "failed request",
+			args: args{
+				info: &grpc.UnaryServerInfo{FullMethod: "/test.Service/Failure"},
+				handler: func(ctx context.Context, req any) (any, error) {
+					return nil, status.Error(codes.NotFound, "not found")
+				},
+			},
+			wantResp: assert.Nil[any],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.Equal(t, codes.NotFound, status.Code(err))
+			},
+			wantCode: codes.NotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, method := splitMethodName(tt.args.info.FullMethod)
+			counter := telemetry.GRPCRequestsTotal.WithLabelValues(service, method, tt.wantCode.String())
+			before := testutil.ToFloat64(counter)
+
+			gotResp, err := UnaryMetricsInterceptor(context.Background(), nil, tt.args.info, tt.args.handler)
+			tt.wantResp(t, gotResp)
+			tt.wantErr(t, err)
+
+			assert.Equal(t, before+1, testutil.ToFloat64(counter))
+		})
+	}
+}
+
+func TestStreamMetricsInterceptor(t *testing.T) {
+	var info = &grpc.StreamServerInfo{FullMethod: "/test.Service/Stream"}
+
+	counter := telemetry.GRPCRequestsTotal.WithLabelValues("test.Service", "Stream", codes.Canceled.String())
+	before := testutil.ToFloat64(counter)
+
+	err := StreamMetricsInterceptor(nil, nil, info, func(srv any, stream grpc.ServerStream) error {
+		return status.Error(codes.Canceled, "canceled")
+	})
+	assert.Equal(t, codes.Canceled, status.Code(err))
+	assert.Equal(t, before+1, testutil.ToFloat64(counter))
+}
@@ -0,0 +1,259 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"clouditor.io/clouditor/v2/service"
+
+	"github.com/MicahParks/keyfunc/v2"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// ErrInvalidOIDCIssuer indicates that the configuration of an OpenID Connect issuer could not be parsed.
+var ErrInvalidOIDCIssuer = errors.New("invalid OpenID Connect issuer")
+
+// jwksRefreshInterval is the interval in which the JWKS of an issuer is refreshed in the background. Additionally, the
+// JWKS is refreshed if a token with an unknown key ID is encountered, which happens after a key rotation.
+const jwksRefreshInterval = time.Hour
+
+// jwksRefreshRateLimit limits how often the JWKS of an issuer is refreshed because of unknown key IDs.
+const jwksRefreshRateLimit = 5 * time.Minute
+
+// OIDCIssuer is a trusted OpenID Connect provider, e.g., Keycloak, Entra ID or Auth0, whose tokens are accepted.
+type OIDCIssuer struct {
+	// URL is the issuer identifier, which needs to match the "iss" claim of the token.
+	URL string
+
+	// JWKSURL is the URL of the JSON Web Key Set of the issuer. If empty, it is retrieved from the OpenID Connect
+	// discovery document of the issuer.
+	JWKSURL string
+
+	// Audience needs to be contained in the "aud" claim of the token, if not empty.
+	Audience string
+
+	// Claims specifies the claim keys used by this issuer. They are not needed for authentication, but need to be
+	// supplied to [service.AuthorizationStrategyJWT], e.g., using [ClaimMappings].
+	Claims service.ClaimMapping
+}
+
+// trustedIssuer is an [OIDCIssuer] together with its lazily loaded JWKS.
+type trustedIssuer struct {
+	OIDCIssuer
+
+	mu   sync.Mutex
+	jwks *keyfunc.JWKS
+}
+
+// WithOIDCIssuers is an option to trust tokens of the given OpenID Connect issuers. Tokens of other issuers are still
+// validated using the JWKS or public key configured by [WithJWKS] or [WithPublicKey].
+func WithOIDCIssuers(issuers ...OIDCIssuer) StartGRPCServerOption {
+	return func(c *config) {
+		for _, iss := range issuers {
+			c.ac.issuers = append(c.ac.issuers, &trustedIssuer{OIDCIssuer: iss})
+		}
+	}
+}
+
+// ParseOIDCIssuer parses an issuer in the form "<issuer URL>[;<option>=<value>...]". The options are "audience",
+// "jwks-url" as well as "cloud-services-claim", "allow-all-claim", "roles-claim", "tags-claim" and "user-claim" for the
+// claim mapping, e.g., "https://keycloak/realms/clouditor;audience=clouditor;roles-claim=realm_access.roles".
+func ParseOIDCIssuer(s string) (iss OIDCIssuer, err error) {
+	parts := strings.Split(s, ";")
+
+	iss.URL = strings.TrimSpace(parts[0])
+	if iss.URL == "" {
+		return iss, fmt.Errorf("%w: missing issuer URL in %q", ErrInvalidOIDCIssuer, s)
+	}
+
+	for _, part := range parts[1:] {
+		key, value, found := strings.Cut(part, "=")
+		if !found {
+			return iss, fmt.Errorf("%w: missing value of option %q", ErrInvalidOIDCIssuer, part)
+		}
+
+		value = strings.TrimSpace(value)
+
+		switch strings.TrimSpace(key) {
+		case "audience":
+			iss.Audience = value
+		case "jwks-url":
+			iss.JWKSURL = value
+		case "cloud-services-claim":
+			iss.Claims.CloudServicesKey = value
+		case "allow-all-claim":
+			iss.Claims.AllowAllKey = value
+		case "roles-claim":
+			iss.Claims.RolesKey = value
+		case "tags-claim":
+			iss.Claims.TagsKey = value
+		case "user-claim":
+			iss.Claims.UserKey = value
+		default:
+			return iss, fmt.Errorf("%w: unknown option %q", ErrInvalidOIDCIssuer, key)
+		}
+	}
+
+	return iss, nil
+}
+
+// ClaimMappings returns the claim mappings of the given issuers, which can be used for
+// [service.AuthorizationStrategyJWT.Issuers].
+func ClaimMappings(issuers ...OIDCIssuer) map[string]service.ClaimMapping {
+	var m = make(map[string]service.ClaimMapping)
+
+	for _, iss := range issuers {
+		m[iss.URL] = iss.Claims
+	}
+
+	return m
+}
+
+// claimMappings returns the claim mappings of the trusted issuers of this config.
+func (config *AuthConfig) claimMappings() map[string]service.ClaimMapping {
+	var issuers []OIDCIssuer
+
+	for _, iss := range config.issuers {
+		issuers = append(issuers, iss.OIDCIssuer)
+	}
+
+	return ClaimMappings(issuers...)
+}
+
+// issuer returns the trusted issuer with the given issuer identifier, if any.
+func (config *AuthConfig) issuer(url string) *trustedIssuer {
+	if url == "" {
+		return nil
+	}
+
+	for _, iss := range config.issuers {
+		if iss.URL == url {
+			return iss
+		}
+	}
+
+	return nil
+}
+
+// parse validates the token against the JWKS of the issuer as well as its issuer and audience claims.
+func (iss *trustedIssuer) parse(token string) (*jwt.Token, error) {
+	jwks, err := iss.keySet()
+	if err != nil {
+		return nil, err
+	}
+
+	opts := []jwt.ParserOption{jwt.WithIssuer(iss.URL)}
+	if iss.Audience != "" {
+		opts = append(opts, jwt.WithAudience(iss.Audience))
+	}
+
+	return jwt.ParseWithClaims(token, &OpenIDConnectClaim{}, jwks.Keyfunc, opts...)
+}
+
+// keySet retrieves the JWKS of the issuer, if it was not retrieved yet. The JWKS is refreshed automatically afterward.
+func (iss *trustedIssuer) keySet() (jwks *keyfunc.JWKS, err error) {
+	iss.mu.Lock()
+	defer iss.mu.Unlock()
+
+	if iss.jwks != nil {
+		return iss.jwks, nil
+	}
+
+	url := iss.JWKSURL
+	if url == "" {
+		url, err = discoverJWKSURL(iss.URL)
+		if err != nil {
+			return nil, fmt.Errorf("could not discover JWKS of issuer %s: %w", iss.URL, err)
+		}
+	}
+
+	log.Debugf("Trying to retrieve JWKS of issuer %s from %s", iss.URL, url)
+
+	iss.jwks, err = keyfunc.Get(url, keyfunc.Options{
+		RefreshInterval:   jwksRefreshInterval,
+		RefreshRateLimit:  jwksRefreshRateLimit,
+		RefreshUnknownKID: true,
+		RefreshErrorHandler: func(err error) {
+			log.Warnf("Could not refresh JWKS of issuer %s: %v", iss.URL, err)
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve JWKS of issuer %s: %w", iss.URL, err)
+	}
+
+	return iss.jwks, nil
+}
+
+// discoverJWKSURL retrieves the JWKS URL from the OpenID Connect discovery document of the issuer.
+func discoverJWKSURL(issuer string) (url string, err error) {
+	var doc struct {
+		Issuer  string `json:"issuer"`
+		JWKSURI string `json:"jwks_uri"`
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet,
+		strings.TrimSuffix(issuer, "/")+"/.well-known/openid-configuration", nil)
+	if err != nil {
+		return "", err
+	}
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected status code %d", res.StatusCode)
+	}
+
+	err = json.NewDecoder(res.Body).Decode(&doc)
+	if err != nil {
+		return "", err
+	}
+
+	// According to OpenID Connect Discovery, the issuer in the document needs to be identical to the issuer URL
+	if doc.Issuer != issuer {
+		return "", fmt.Errorf("issuer %s of discovery document does not match", doc.Issuer)
+	}
+
+	if doc.JWKSURI == "" {
+		return "", errors.New("discovery document does not contain a JWKS URI")
+	}
+
+	return doc.JWKSURI, nil
+}
@@ -0,0 +1,223 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package server
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/service"
+
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// newMockIssuer starts an OpenID Connect issuer that serves a discovery document and a JWKS containing the public
+// key of the returned private key.
+func newMockIssuer(t *testing.T) (srv *httptest.Server, key *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"issuer":   srv.URL,
+			"jwks_uri": srv.URL + "/certs",
+		})
+	})
+	mux.HandleFunc("/certs", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"keys": []map[string]string{{
+				"kid": "1",
+				"kty": "EC",
+				"crv": "P-256",
+				"x":   base64.RawURLEncoding.EncodeToString(key.X.FillBytes(make([]byte, 32))),
+				"y":   base64.RawURLEncoding.EncodeToString(key.Y.FillBytes(make([]byte, 32))),
+			}},
+		})
+	})
+
+	srv = httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	return srv, key
+}
+
+// newTokenContext creates an incoming context with a token containing the given claims signed by key.
+func newTokenContext(t *testing.T, key *ecdsa.PrivateKey, claims jwt.MapClaims) context.Context {
+	token := jwt.NewWithClaims(jwt.SigningMethodES256, claims)
+	token.Header["kid"] = "1"
+
+	s, err := token.SignedString(key)
+	assert.NoError(t, err)
+
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "bearer "+s))
+}
+
+func TestAuthConfig_AuthFunc_oidcIssuers(t *testing.T) {
+	keycloak, keycloakKey := newMockIssuer(t)
+	entra, entraKey := newMockIssuer(t)
+	exp := time.Now().Add(time.Hour).Unix()
+
+	c := &config{}
+	WithOIDCIssuers(
+		OIDCIssuer{URL: keycloak.URL, Audience: "clouditor"},
+		OIDCIssuer{URL: entra.URL, JWKSURL: entra.URL + "/certs"},
+	)(c)
+
+	// Our own public key, which is used for tokens of other issuers
+	ownKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	c.ac.publicKey = &ownKey.PublicKey
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		wantErr assert.WantErr
+	}{
+		{
+			name:    "Keycloak token with audience",
+			ctx:     newTokenContext(t, keycloakKey, jwt.MapClaims{"iss": keycloak.URL, "aud": "clouditor", "sub": "me", "exp": exp}),
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "Keycloak token with wrong audience",
+			ctx:  newTokenContext(t, keycloakKey, jwt.MapClaims{"iss": keycloak.URL, "aud": "other", "sub": "me", "exp": exp}),
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.Equal(t, codes.Unauthenticated, status.Code(err))
+			},
+		},
+		{
+			name:    "Entra ID token",
+			ctx:     newTokenContext(t, entraKey, jwt.MapClaims{"iss": entra.URL, "sub": "me", "exp": exp}),
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "Entra ID token signed by Keycloak key",
+			ctx:  newTokenContext(t, keycloakKey, jwt.MapClaims{"iss": entra.URL, "sub": "me", "exp": exp}),
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.Equal(t, codes.Unauthenticated, status.Code(err))
+			},
+		},
+		{
+			name: "Expired Entra ID token",
+			ctx:  newTokenContext(t, entraKey, jwt.MapClaims{"iss": entra.URL, "sub": "me", "exp": time.Now().Add(-time.Hour).Unix()}),
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.Equal(t, codes.Unauthenticated, status.Code(err))
+			},
+		},
+		{
+			name:    "Token of other issuer signed by our own key",
+			ctx:     newTokenContext(t, ownKey, jwt.MapClaims{"iss": "clouditor", "sub": "me", "exp": exp}),
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "Token of untrusted issuer",
+			ctx:  newTokenContext(t, keycloakKey, jwt.MapClaims{"iss": "https://evil", "sub": "me", "exp": exp}),
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.Equal(t, codes.Unauthenticated, status.Code(err))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := c.ac.AuthFunc()(tt.ctx)
+			tt.wantErr(t, err)
+		})
+	}
+}
+
+func TestParseOIDCIssuer(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		want    OIDCIssuer
+		wantErr assert.WantErr
+	}{
+		{
+			name: "only URL",
+			s:    "https://login.microsoftonline.com/tenant/v2.0",
+			want: OIDCIssuer{URL: "https://login.microsoftonline.com/tenant/v2.0"},
+		},
+		{
+			name: "with options",
+			s:    "https://keycloak/realms/clouditor; audience=clouditor;jwks-url=https://keycloak/certs;roles-claim=realm_access.roles;user-claim=preferred_username",
+			want: OIDCIssuer{
+				URL:      "https://keycloak/realms/clouditor",
+				Audience: "clouditor",
+				JWKSURL:  "https://keycloak/certs",
+				Claims: service.ClaimMapping{
+					RolesKey: "realm_access.roles",
+					UserKey:  "preferred_username",
+				},
+			},
+		},
+		{
+			name: "missing URL",
+			s:    ";audience=clouditor",
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, ErrInvalidOIDCIssuer)
+			},
+		},
+		{
+			name: "unknown option",
+			s:    "https://auth0;scope=openid",
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, ErrInvalidOIDCIssuer)
+			},
+		},
+		{
+			name: "missing value",
+			s:    "https://auth0;audience",
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, ErrInvalidOIDCIssuer)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseOIDCIssuer(tt.s)
+			if tt.wantErr != nil {
+				tt.wantErr(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package server
+
+import (
+	"context"
+
+	"clouditor.io/clouditor/v2/service"
+
+	"google.golang.org/grpc"
+)
+
+// WithRBAC is an option for [StartGRPCServer] to enforce role-based access control on all RPCs, except the reflection,
+// health and public endpoints.
+func WithRBAC(rbac *service.RBAC) StartGRPCServerOption {
+	return func(c *config) {
+		c.rbac = rbac
+	}
+}
+
+// UnaryRBACInterceptor returns a [grpc.UnaryServerInterceptor] that checks whether the current user is permitted to
+// call the RPC according to the RBAC of the config. If no RBAC is configured, all calls are permitted.
+func UnaryRBACInterceptor(c *config) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+		if c.rbac != nil {
+			if err = c.rbac.Authorize(ctx, info.FullMethod, req); err != nil {
+				return nil, err
+			}
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamRBACInterceptor returns a [grpc.StreamServerInterceptor] that checks whether the current user is permitted
+// to call the RPC according to the RBAC of the config. Since role assignments can be specific to a cloud service, each
+// received message is checked individually. If no RBAC is configured, all calls are permitted.
+func StreamRBACInterceptor(c *config) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if c.rbac == nil {
+			return handler(srv, ss)
+		}
+
+		return handler(srv, &authorizingServerStream{ServerStream: ss, authorize: func(m any) error {
+			return c.rbac.Authorize(ss.Context(), info.FullMethod, m)
+		}})
+	}
+}
@@ -0,0 +1,169 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package server
+
+import (
+	"context"
+	"testing"
+
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/testutil/servicetest"
+	"clouditor.io/clouditor/v2/service"
+
+	"google.golang.org/grpc"
+)
+
+// mockServerStream is a [grpc.ServerStream] that receives a single message.
+type mockServerStream struct {
+	grpc.ServerStream
+}
+
+func (*mockServerStream) Context() context.Context {
+	return context.Background()
+}
+
+func (*mockServerStream) RecvMsg(_ any) error {
+	return nil
+}
+
+func TestUnaryRBACInterceptor(t *testing.T) {
+	type fields struct {
+		rbac *service.RBAC
+	}
+	type args struct {
+		info *grpc.UnaryServerInfo
+	}
+	tests := []struct {
+		name     string
+		fields   fields
+		args     args
+		wantResp assert.Want[any]
+		wantErr  assert.WantErr
+	}{
+		{
+			name: "no RBAC",
+			args: args{
+				info: &grpc.UnaryServerInfo{FullMethod: "/clouditor.orchestrator.v1.Orchestrator/RemoveCloudService"},
+			},
+			wantResp: func(t *testing.T, got any) bool {
+				return assert.Equal[any](t, "response", got)
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "permitted",
+			fields: fields{
+				rbac: service.NewRBAC(servicetest.NewAuthorizationStrategyWithRoles(true, []string{service.RoleReadOnly})),
+			},
+			args: args{
+				info: &grpc.UnaryServerInfo{FullMethod: "/clouditor.orchestrator.v1.Orchestrator/ListCloudServices"},
+			},
+			wantResp: func(t *testing.T, got any) bool {
+				return assert.Equal[any](t, "response", got)
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "denied",
+			fields: fields{
+				rbac: service.NewRBAC(servicetest.NewAuthorizationStrategyWithRoles(true, []string{service.RoleReadOnly})),
+			},
+			args: args{
+				info: &grpc.UnaryServerInfo{FullMethod: "/clouditor.orchestrator.v1.Orchestrator/RemoveCloudService"},
+			},
+			wantResp: assert.Nil[any],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, service.ErrPermissionDenied)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &config{rbac: tt.fields.rbac}
+
+			gotResp, err := UnaryRBACInterceptor(c)(context.Background(), nil, tt.args.info, func(ctx context.Context, req any) (any, error) {
+				return "response", nil
+			})
+			tt.wantResp(t, gotResp)
+			tt.wantErr(t, err)
+		})
+	}
+}
+
+func TestStreamRBACInterceptor(t *testing.T) {
+	type fields struct {
+		rbac *service.RBAC
+	}
+	type args struct {
+		info *grpc.StreamServerInfo
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr assert.WantErr
+	}{
+		{
+			name: "no RBAC",
+			args: args{
+				info: &grpc.StreamServerInfo{FullMethod: "/clouditor.assessment.v1.Assessment/AssessEvidences"},
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "permitted",
+			fields: fields{
+				rbac: service.NewRBAC(servicetest.NewAuthorizationStrategyWithRoles(true, []string{service.RoleAdmin})),
+			},
+			args: args{
+				info: &grpc.StreamServerInfo{FullMethod: "/clouditor.assessment.v1.Assessment/AssessEvidences"},
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "denied",
+			fields: fields{
+				rbac: service.NewRBAC(servicetest.NewAuthorizationStrategyWithRoles(true, []string{service.RoleReadOnly})),
+			},
+			args: args{
+				info: &grpc.StreamServerInfo{FullMethod: "/clouditor.assessment.v1.Assessment/AssessEvidences"},
+			},
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, service.ErrPermissionDenied)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &config{rbac: tt.fields.rbac}
+
+			err := StreamRBACInterceptor(c)(nil, &mockServerStream{}, tt.args.info, func(srv any, stream grpc.ServerStream) error {
+				return stream.RecvMsg(nil)
+			})
+			tt.wantErr(t, err)
+		})
+	}
+}
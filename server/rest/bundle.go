@@ -0,0 +1,158 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package rest
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"clouditor.io/clouditor/v2/api"
+	"clouditor.io/clouditor/v2/api/assessment"
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/policies"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// MetricBundlePath is the path of the bundle containing all metrics as well as their implementations and
+// configurations in the REST server. It is compatible with the OPA bundle protocol, i.e., clients can poll it using
+// the ETag of the last bundle in the If-None-Match header.
+const MetricBundlePath = "/v1/orchestrator/bundles/metrics.tar.gz"
+
+// handleMetricBundle returns the handler of our metric bundle. It forwards the authorization of the incoming request to
+// the gRPC backend reachable over cc.
+func handleMetricBundle(cc grpc.ClientConnInterface) func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	return metricBundleHandler(orchestrator.NewOrchestratorClient(cc))
+}
+
+// metricBundleHandler returns the handler of our metric bundle using the given orchestrator client.
+func metricBundleHandler(client orchestrator.OrchestratorClient) func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	return func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		ctx := r.Context()
+		if auth := r.Header.Get("Authorization"); auth != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, "authorization", auth)
+		}
+
+		b, err := metricBundle(ctx, client)
+		if err != nil {
+			writeStatusError(w, err)
+			return
+		}
+
+		revision, err := b.Revision()
+		if err != nil {
+			http.Error(w, fmt.Sprintf("could not create bundle: %v", err), http.StatusInternalServerError)
+			return
+		}
+
+		etag := fmt.Sprintf("%q", revision)
+		w.Header().Set("ETag", etag)
+
+		if etagMatches(r.Header.Get("If-None-Match"), etag) {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		var buf bytes.Buffer
+		if err = b.Write(&buf); err != nil {
+			http.Error(w, fmt.Sprintf("could not create bundle: %v", err), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/gzip")
+		_, _ = w.Write(buf.Bytes())
+	}
+}
+
+// metricBundle retrieves all metrics, their implementations and their configurations for all cloud services the
+// caller has access to from the orchestrator.
+func metricBundle(ctx context.Context, client orchestrator.OrchestratorClient) (b *policies.Bundle, err error) {
+	b = &policies.Bundle{
+		Implementations: make(map[string]*assessment.MetricImplementation),
+		Configurations:  make(map[string]map[string]*assessment.MetricConfiguration),
+	}
+
+	b.Metrics, err = api.ListAllPaginated(&orchestrator.ListMetricsRequest{},
+		func(_ context.Context, req *orchestrator.ListMetricsRequest, opts ...grpc.CallOption) (*orchestrator.ListMetricsResponse, error) {
+			return client.ListMetrics(ctx, req, opts...)
+		}, func(res *orchestrator.ListMetricsResponse) []*assessment.Metric {
+			return res.Metrics
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, metric := range b.Metrics {
+		impl, err := client.GetMetricImplementation(ctx, &orchestrator.GetMetricImplementationRequest{MetricId: metric.Id})
+		if status.Code(err) == codes.NotFound {
+			// Metrics without implementation are assessed by external tools
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		b.Implementations[metric.Id] = impl
+	}
+
+	services, err := api.ListAllPaginated(&orchestrator.ListCloudServicesRequest{},
+		func(_ context.Context, req *orchestrator.ListCloudServicesRequest, opts ...grpc.CallOption) (*orchestrator.ListCloudServicesResponse, error) {
+			return client.ListCloudServices(ctx, req, opts...)
+		}, func(res *orchestrator.ListCloudServicesResponse) []*orchestrator.CloudService {
+			return res.Services
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, cs := range services {
+		res, err := client.ListMetricConfigurations(ctx, &orchestrator.ListMetricConfigurationRequest{CloudServiceId: cs.Id})
+		if err != nil {
+			return nil, err
+		}
+
+		b.Configurations[cs.Id] = res.Configurations
+	}
+
+	return b, nil
+}
+
+// etagMatches checks, whether the If-None-Match header contains the ETag.
+func etagMatches(header string, etag string) bool {
+	for _, s := range strings.Split(header, ",") {
+		s = strings.TrimPrefix(strings.TrimSpace(s), "W/")
+		if s == etag || s == "*" {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,130 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package rest
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"clouditor.io/clouditor/v2/api/assessment"
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/policies"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// mockBundleBackend implements the orchestrator client used by the metric bundle. It contains two metrics, of which
+// only the first one has an implementation, and a single cloud service.
+type mockBundleBackend struct {
+	orchestrator.OrchestratorClient
+
+	authorization []string
+}
+
+func (m *mockBundleBackend) ListMetrics(ctx context.Context, _ *orchestrator.ListMetricsRequest, _ ...grpc.CallOption) (*orchestrator.ListMetricsResponse, error) {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	m.authorization = append(m.authorization, md.Get("authorization")...)
+
+	return &orchestrator.ListMetricsResponse{Metrics: []*assessment.Metric{
+		{Id: testdata.MockMetricID1},
+		{Id: testdata.MockMetricID2},
+	}}, nil
+}
+
+func (m *mockBundleBackend) GetMetricImplementation(_ context.Context, req *orchestrator.GetMetricImplementationRequest, _ ...grpc.CallOption) (*assessment.MetricImplementation, error) {
+	if req.MetricId != testdata.MockMetricID1 {
+		return nil, status.Error(codes.NotFound, "implementation for metric not found")
+	}
+
+	return &assessment.MetricImplementation{
+		MetricId: testdata.MockMetricID1,
+		Lang:     assessment.MetricImplementation_LANGUAGE_REGO,
+		Code:     "package clouditor.metrics.mock_metric_1",
+	}, nil
+}
+
+func (m *mockBundleBackend) ListCloudServices(_ context.Context, _ *orchestrator.ListCloudServicesRequest, _ ...grpc.CallOption) (*orchestrator.ListCloudServicesResponse, error) {
+	return &orchestrator.ListCloudServicesResponse{Services: []*orchestrator.CloudService{{Id: testdata.MockCloudServiceID1}}}, nil
+}
+
+func (m *mockBundleBackend) ListMetricConfigurations(_ context.Context, req *orchestrator.ListMetricConfigurationRequest, _ ...grpc.CallOption) (*orchestrator.ListMetricConfigurationResponse, error) {
+	return &orchestrator.ListMetricConfigurationResponse{Configurations: map[string]*assessment.MetricConfiguration{
+		testdata.MockMetricID1: {MetricId: testdata.MockMetricID1, CloudServiceId: req.CloudServiceId, Operator: "=="},
+	}}, nil
+}
+
+func Test_metricBundleHandler(t *testing.T) {
+	var (
+		backend = &mockBundleBackend{}
+		handler = metricBundleHandler(backend)
+		rec     = httptest.NewRecorder()
+		req     = httptest.NewRequest(http.MethodGet, MetricBundlePath, nil)
+	)
+
+	req.Header.Set("Authorization", "Bearer token")
+
+	handler(rec, req, nil)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/gzip", rec.Header().Get("Content-Type"))
+	assert.Equal(t, []string{"Bearer token"}, backend.authorization)
+
+	b, err := policies.ReadBundle(bytes.NewReader(rec.Body.Bytes()))
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(b.Metrics))
+	assert.Equal(t, 1, len(b.Implementations))
+	assert.Equal(t, "==", b.Configurations[testdata.MockCloudServiceID1][testdata.MockMetricID1].Operator)
+
+	revision, err := b.Revision()
+	assert.NoError(t, err)
+
+	etag := rec.Header().Get("ETag")
+	assert.Equal(t, `"`+revision+`"`, etag)
+
+	// The bundle is not transferred again, if it did not change
+	rec = httptest.NewRecorder()
+	req = httptest.NewRequest(http.MethodGet, MetricBundlePath, nil)
+	req.Header.Set("If-None-Match", etag)
+
+	handler(rec, req, nil)
+	assert.Equal(t, http.StatusNotModified, rec.Code)
+	assert.Equal(t, 0, rec.Body.Len())
+}
+
+func Test_etagMatches(t *testing.T) {
+	assert.True(t, etagMatches(`"a"`, `"a"`))
+	assert.True(t, etagMatches(`"b", W/"a"`, `"a"`))
+	assert.True(t, etagMatches(`*`, `"a"`))
+	assert.False(t, etagMatches(``, `"a"`))
+	assert.False(t, etagMatches(`"b"`, `"a"`))
+}
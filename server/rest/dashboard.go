@@ -0,0 +1,81 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package rest
+
+import (
+	"io/fs"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+)
+
+// gatewayPaths contains the path prefixes that are handled by the REST gateway itself and are therefore never
+// served from the assets of the dashboard.
+var gatewayPaths = []string{"/v1/", "/.well-known/", "/metrics", "/healthz", "/readyz"}
+
+// WithDashboard is an option to serve the (pre-built) assets of the Clouditor Dashboard from the given file system on
+// all paths, which are not part of the REST API. Paths that do not correspond to an asset are answered with the
+// index.html of the dashboard, so that its client-side routing works.
+func WithDashboard(assets fs.FS) ServerConfigOption {
+	return func(c *config, _ *runtime.ServeMux) {
+		c.dashboard = assets
+	}
+}
+
+// handleDashboard serves the assets of the dashboard for all GET and HEAD requests, which are not meant for the REST
+// gateway, and passes all other requests to next.
+func handleDashboard(assets fs.FS, next http.Handler) http.Handler {
+	files := http.FileServer(http.FS(assets))
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if (r.Method != http.MethodGet && r.Method != http.MethodHead) || isGatewayPath(r.URL.Path) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		// Fall back to our index.html for all routes of the dashboard
+		name := strings.TrimPrefix(path.Clean(r.URL.Path), "/")
+		if _, err := fs.Stat(assets, name); name != "" && err != nil {
+			r = r.Clone(r.Context())
+			r.URL.Path = "/"
+		}
+
+		files.ServeHTTP(w, r)
+	})
+}
+
+// isGatewayPath checks, whether the path is handled by the REST gateway.
+func isGatewayPath(p string) bool {
+	for _, prefix := range gatewayPaths {
+		if strings.HasPrefix(p, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,103 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package rest
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+)
+
+func Test_handleDashboard(t *testing.T) {
+	var (
+		assets = fstest.MapFS{
+			"index.html":     {Data: []byte("index")},
+			"_app/script.js": {Data: []byte("script")},
+		}
+		next = http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = io.WriteString(w, "gateway")
+		})
+	)
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		want   string
+	}{
+		{
+			name:   "root",
+			method: http.MethodGet,
+			path:   "/",
+			want:   "index",
+		},
+		{
+			name:   "asset",
+			method: http.MethodGet,
+			path:   "/_app/script.js",
+			want:   "script",
+		},
+		{
+			name:   "route of dashboard",
+			method: http.MethodGet,
+			path:   "/cloud/my-service",
+			want:   "index",
+		},
+		{
+			name:   "API",
+			method: http.MethodGet,
+			path:   "/v1/orchestrator/cloud_services",
+			want:   "gateway",
+		},
+		{
+			name:   "health",
+			method: http.MethodGet,
+			path:   "/healthz",
+			want:   "gateway",
+		},
+		{
+			name:   "other method",
+			method: http.MethodPost,
+			path:   "/cloud/my-service",
+			want:   "gateway",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+
+			handleDashboard(assets, next).ServeHTTP(rr, httptest.NewRequest(tt.method, tt.path, nil))
+
+			assert.Equal(t, http.StatusOK, rr.Code)
+			assert.Equal(t, tt.want, strings.TrimSpace(rr.Body.String()))
+		})
+	}
+}
@@ -0,0 +1,554 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"clouditor.io/clouditor/v2/api"
+	"clouditor.io/clouditor/v2/api/assessment"
+	"clouditor.io/clouditor/v2/api/discovery"
+	"clouditor.io/clouditor/v2/api/evidence"
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/internal/util"
+
+	"github.com/graphql-go/graphql"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// GraphQLPath is the path of the GraphQL endpoint in the REST server.
+const GraphQLPath = "/v1/graphql"
+
+// ErrMissingGraphQLQuery is returned if a GraphQL request does not contain a query.
+var ErrMissingGraphQLQuery = errors.New("missing query")
+
+// jsonScalar is a GraphQL scalar holding arbitrary JSON, such as the ontology properties of a resource.
+var jsonScalar = graphql.NewScalar(graphql.ScalarConfig{
+	Name:        "JSON",
+	Description: "The `JSON` scalar type represents arbitrary JSON values.",
+	Serialize: func(value any) any {
+		return value
+	},
+})
+
+// graphQLRequest is a GraphQL request, either supplied as JSON body or as query parameters.
+type graphQLRequest struct {
+	Query         string         `json:"query"`
+	Variables     map[string]any `json:"variables"`
+	OperationName string         `json:"operationName"`
+}
+
+// graphQLResolver resolves GraphQL queries against the gRPC services of the backend.
+type graphQLResolver struct {
+	discovery    discovery.DiscoveryClient
+	experimental discovery.ExperimentalDiscoveryClient
+	evidence     evidence.EvidenceStoreClient
+	orchestrator orchestrator.OrchestratorClient
+}
+
+// graphQLLoader caches resources and graph edges for the lifetime of a single GraphQL request, so that nested
+// queries, e.g., the source and target resources of graph edges, do not need a round-trip each.
+type graphQLLoader struct {
+	mu        sync.Mutex
+	resources map[string]*discovery.Resource
+	edges     []*discovery.GraphEdge
+}
+
+// graphQLLoaderKey is the context key of the graphQLLoader.
+type graphQLLoaderKey struct{}
+
+// handleGraphQL returns the handler of our GraphQL endpoint. It forwards the authorization of the incoming request to
+// the gRPC backend reachable over cc.
+func handleGraphQL(cc grpc.ClientConnInterface) (func(w http.ResponseWriter, r *http.Request, _ map[string]string), error) {
+	res := &graphQLResolver{
+		discovery:    discovery.NewDiscoveryClient(cc),
+		experimental: discovery.NewExperimentalDiscoveryClient(cc),
+		evidence:     evidence.NewEvidenceStoreClient(cc),
+		orchestrator: orchestrator.NewOrchestratorClient(cc),
+	}
+
+	return res.handler()
+}
+
+// handler returns the HTTP handler executing GraphQL requests against our schema.
+func (res *graphQLResolver) handler() (func(w http.ResponseWriter, r *http.Request, _ map[string]string), error) {
+	schema, err := res.schema()
+	if err != nil {
+		return nil, fmt.Errorf("could not create GraphQL schema: %w", err)
+	}
+
+	return func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		req, err := parseGraphQLRequest(r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		ctx := context.WithValue(r.Context(), graphQLLoaderKey{}, &graphQLLoader{})
+		if auth := r.Header.Get("Authorization"); auth != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, "authorization", auth)
+		}
+
+		result := graphql.Do(graphql.Params{
+			Schema:         schema,
+			RequestString:  req.Query,
+			VariableValues: req.Variables,
+			OperationName:  req.OperationName,
+			Context:        ctx,
+		})
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(result)
+	}, nil
+}
+
+// parseGraphQLRequest parses a GraphQL request either from the JSON body of a POST request or from the query
+// parameters of a GET request.
+func parseGraphQLRequest(r *http.Request) (req *graphQLRequest, err error) {
+	req = new(graphQLRequest)
+
+	if r.Method == http.MethodPost {
+		if err = json.NewDecoder(r.Body).Decode(req); err != nil {
+			return nil, fmt.Errorf("invalid request body: %w", err)
+		}
+	} else {
+		req.Query = r.URL.Query().Get("query")
+		req.OperationName = r.URL.Query().Get("operationName")
+
+		if v := r.URL.Query().Get("variables"); v != "" {
+			if err = json.Unmarshal([]byte(v), &req.Variables); err != nil {
+				return nil, fmt.Errorf("invalid variables: %w", err)
+			}
+		}
+	}
+
+	if req.Query == "" {
+		return nil, ErrMissingGraphQLQuery
+	}
+
+	return req, nil
+}
+
+// schema builds the GraphQL schema. Nested fields, e.g., the assessment results of a resource, are resolved lazily
+// and only if they are part of the query.
+func (res *graphQLResolver) schema() (graphql.Schema, error) {
+	var (
+		resourceType         *graphql.Object
+		graphEdgeType        *graphql.Object
+		evidenceType         *graphql.Object
+		assessmentResultType *graphql.Object
+	)
+
+	resourceType = graphql.NewObject(graphql.ObjectConfig{
+		Name:        "Resource",
+		Description: "A resource discovered in a cloud service.",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"id":             field(graphql.NewNonNull(graphql.String), (*discovery.Resource).GetId),
+				"cloudServiceId": field(graphql.String, (*discovery.Resource).GetCloudServiceId),
+				"resourceType":   field(graphql.String, (*discovery.Resource).GetResourceType),
+				"properties": &graphql.Field{
+					Type: jsonScalar,
+					Resolve: func(p graphql.ResolveParams) (any, error) {
+						return anyToJSON(p.Source.(*discovery.Resource).GetProperties())
+					},
+				},
+				"edges": &graphql.Field{
+					Type:        graphql.NewList(graphEdgeType),
+					Description: "The graph edges that have this resource as source or target.",
+					Resolve: func(p graphql.ResolveParams) (any, error) {
+						var (
+							r     = p.Source.(*discovery.Resource)
+							edges []*discovery.GraphEdge
+						)
+
+						all, err := res.graphEdges(p.Context)
+						if err != nil {
+							return nil, err
+						}
+
+						for _, e := range all {
+							if e.GetSource() == r.GetId() || e.GetTarget() == r.GetId() {
+								edges = append(edges, e)
+							}
+						}
+
+						return edges, nil
+					},
+				},
+				"assessmentResults": &graphql.Field{
+					Type: graphql.NewList(assessmentResultType),
+					Resolve: func(p graphql.ResolveParams) (any, error) {
+						return res.assessmentResults(p.Context, &orchestrator.Filter{
+							ResourceId: util.Ref(p.Source.(*discovery.Resource).GetId()),
+						})
+					},
+				},
+			}
+		}),
+	})
+
+	graphEdgeType = graphql.NewObject(graphql.ObjectConfig{
+		Name:        "GraphEdge",
+		Description: "An edge between two resources in the resource graph.",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"id":     field(graphql.NewNonNull(graphql.String), (*discovery.GraphEdge).GetId),
+				"source": field(graphql.String, (*discovery.GraphEdge).GetSource),
+				"target": field(graphql.String, (*discovery.GraphEdge).GetTarget),
+				"type":   field(graphql.String, (*discovery.GraphEdge).GetType),
+				"sourceResource": &graphql.Field{
+					Type: resourceType,
+					Resolve: func(p graphql.ResolveParams) (any, error) {
+						return res.getResource(p.Context, p.Source.(*discovery.GraphEdge).GetSource())
+					},
+				},
+				"targetResource": &graphql.Field{
+					Type: resourceType,
+					Resolve: func(p graphql.ResolveParams) (any, error) {
+						return res.getResource(p.Context, p.Source.(*discovery.GraphEdge).GetTarget())
+					},
+				},
+			}
+		}),
+	})
+
+	evidenceType = graphql.NewObject(graphql.ObjectConfig{
+		Name:        "Evidence",
+		Description: "An evidence gathered by a discoverer.",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"id":             field(graphql.NewNonNull(graphql.String), (*evidence.Evidence).GetId),
+				"timestamp":      timestampField((*evidence.Evidence).GetTimestamp),
+				"cloudServiceId": field(graphql.String, (*evidence.Evidence).GetCloudServiceId),
+				"toolId":         field(graphql.String, (*evidence.Evidence).GetToolId),
+				"raw":            field(graphql.String, (*evidence.Evidence).GetRaw),
+				"resource": &graphql.Field{
+					Type: jsonScalar,
+					Resolve: func(p graphql.ResolveParams) (any, error) {
+						return anyToJSON(p.Source.(*evidence.Evidence).GetResource())
+					},
+				},
+				"assessmentResults": &graphql.Field{
+					Type: graphql.NewList(assessmentResultType),
+					Resolve: func(p graphql.ResolveParams) (any, error) {
+						return res.assessmentResults(p.Context, &orchestrator.Filter{
+							EvidenceId: util.Ref(p.Source.(*evidence.Evidence).GetId()),
+						})
+					},
+				},
+			}
+		}),
+	})
+
+	assessmentResultType = graphql.NewObject(graphql.ObjectConfig{
+		Name:        "AssessmentResult",
+		Description: "The result of the assessment of an evidence against a metric.",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"id":                    field(graphql.NewNonNull(graphql.String), (*assessment.AssessmentResult).GetId),
+				"timestamp":             timestampField((*assessment.AssessmentResult).GetTimestamp),
+				"metricId":              field(graphql.String, (*assessment.AssessmentResult).GetMetricId),
+				"metricVersion":         field(graphql.String, (*assessment.AssessmentResult).GetMetricVersion),
+				"compliant":             field(graphql.Boolean, (*assessment.AssessmentResult).GetCompliant),
+				"evidenceId":            field(graphql.String, (*assessment.AssessmentResult).GetEvidenceId),
+				"resourceId":            field(graphql.String, (*assessment.AssessmentResult).GetResourceId),
+				"resourceTypes":         field(graphql.NewList(graphql.String), (*assessment.AssessmentResult).GetResourceTypes),
+				"nonComplianceComments": field(graphql.String, (*assessment.AssessmentResult).GetNonComplianceComments),
+				"cloudServiceId":        field(graphql.String, (*assessment.AssessmentResult).GetCloudServiceId),
+				"toolId":                field(graphql.String, (*assessment.AssessmentResult).GetToolId),
+				"evidenceToolIds":       field(graphql.NewList(graphql.String), (*assessment.AssessmentResult).GetEvidenceToolIds),
+				"evidence": &graphql.Field{
+					Type: evidenceType,
+					Resolve: func(p graphql.ResolveParams) (any, error) {
+						return res.getEvidence(p.Context, p.Source.(*assessment.AssessmentResult).GetEvidenceId())
+					},
+				},
+				"resource": &graphql.Field{
+					Type: resourceType,
+					Resolve: func(p graphql.ResolveParams) (any, error) {
+						return res.getResource(p.Context, p.Source.(*assessment.AssessmentResult).GetResourceId())
+					},
+				},
+			}
+		}),
+	})
+
+	query := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"resources": &graphql.Field{
+				Type: graphql.NewList(resourceType),
+				Args: graphql.FieldConfigArgument{
+					"cloudServiceId": &graphql.ArgumentConfig{Type: graphql.String},
+					"type":           &graphql.ArgumentConfig{Type: graphql.String},
+				},
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return res.resources(p.Context, &discovery.ListResourcesRequest_Filter{
+						CloudServiceId: stringArg(p.Args, "cloudServiceId"),
+						Type:           stringArg(p.Args, "type"),
+					})
+				},
+			},
+			"resource": &graphql.Field{
+				Type: resourceType,
+				Args: graphql.FieldConfigArgument{
+					"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				},
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return res.getResource(p.Context, p.Args["id"].(string))
+				},
+			},
+			"graphEdges": &graphql.Field{
+				Type: graphql.NewList(graphEdgeType),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return res.graphEdges(p.Context)
+				},
+			},
+			"evidences": &graphql.Field{
+				Type: graphql.NewList(evidenceType),
+				Args: graphql.FieldConfigArgument{
+					"cloudServiceId": &graphql.ArgumentConfig{Type: graphql.String},
+					"toolId":         &graphql.ArgumentConfig{Type: graphql.String},
+				},
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return listAll(p.Context, &evidence.ListEvidencesRequest{
+						Filter: &evidence.Filter{
+							CloudServiceId: stringArg(p.Args, "cloudServiceId"),
+							ToolId:         stringArg(p.Args, "toolId"),
+						},
+					}, res.evidence.ListEvidences, func(res *evidence.ListEvidencesResponse) []*evidence.Evidence {
+						return res.Evidences
+					})
+				},
+			},
+			"evidence": &graphql.Field{
+				Type: evidenceType,
+				Args: graphql.FieldConfigArgument{
+					"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				},
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return res.getEvidence(p.Context, p.Args["id"].(string))
+				},
+			},
+			"assessmentResults": &graphql.Field{
+				Type: graphql.NewList(assessmentResultType),
+				Args: graphql.FieldConfigArgument{
+					"cloudServiceId": &graphql.ArgumentConfig{Type: graphql.String},
+					"compliant":      &graphql.ArgumentConfig{Type: graphql.Boolean},
+					"metricId":       &graphql.ArgumentConfig{Type: graphql.String},
+					"resourceId":     &graphql.ArgumentConfig{Type: graphql.String},
+					"evidenceId":     &graphql.ArgumentConfig{Type: graphql.String},
+				},
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					filter := &orchestrator.Filter{
+						CloudServiceId: stringArg(p.Args, "cloudServiceId"),
+						MetricId:       stringArg(p.Args, "metricId"),
+						ResourceId:     stringArg(p.Args, "resourceId"),
+						EvidenceId:     stringArg(p.Args, "evidenceId"),
+					}
+					if compliant, ok := p.Args["compliant"].(bool); ok {
+						filter.Compliant = &compliant
+					}
+
+					return res.assessmentResults(p.Context, filter)
+				},
+			},
+			"assessmentResult": &graphql.Field{
+				Type: assessmentResultType,
+				Args: graphql.FieldConfigArgument{
+					"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				},
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					r, err := res.orchestrator.GetAssessmentResult(p.Context, &orchestrator.GetAssessmentResultRequest{Id: p.Args["id"].(string)})
+					if status.Code(err) == codes.NotFound {
+						return nil, nil
+					}
+
+					return r, err
+				},
+			},
+		},
+	})
+
+	return graphql.NewSchema(graphql.SchemaConfig{Query: query})
+}
+
+// resources lists all resources matching the filter.
+func (res *graphQLResolver) resources(ctx context.Context, filter *discovery.ListResourcesRequest_Filter) ([]*discovery.Resource, error) {
+	return listAll(ctx, &discovery.ListResourcesRequest{Filter: filter}, res.discovery.ListResources,
+		func(res *discovery.ListResourcesResponse) []*discovery.Resource {
+			return res.Results
+		})
+}
+
+// getResource returns the resource with the given ID or nil, if it does not exist. Since the discovery does not offer a
+// dedicated call to retrieve a single resource, all resources are fetched once per request.
+func (res *graphQLResolver) getResource(ctx context.Context, id string) (*discovery.Resource, error) {
+	l := loader(ctx)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.resources == nil {
+		all, err := res.resources(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		l.resources = make(map[string]*discovery.Resource, len(all))
+		for _, r := range all {
+			l.resources[r.GetId()] = r
+		}
+	}
+
+	return l.resources[id], nil
+}
+
+// graphEdges returns all graph edges. They are fetched once per request.
+func (res *graphQLResolver) graphEdges(ctx context.Context) ([]*discovery.GraphEdge, error) {
+	var err error
+
+	l := loader(ctx)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.edges == nil {
+		l.edges, err = listAll(ctx, &discovery.ListGraphEdgesRequest{}, res.experimental.ListGraphEdges,
+			func(res *discovery.ListGraphEdgesResponse) []*discovery.GraphEdge {
+				return res.Edges
+			})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return l.edges, nil
+}
+
+// getEvidence returns the evidence with the given ID or nil, if it does not exist.
+func (res *graphQLResolver) getEvidence(ctx context.Context, id string) (*evidence.Evidence, error) {
+	e, err := res.evidence.GetEvidence(ctx, &evidence.GetEvidenceRequest{EvidenceId: id})
+	if status.Code(err) == codes.NotFound {
+		return nil, nil
+	}
+
+	return e, err
+}
+
+// assessmentResults lists all assessment results matching the filter.
+func (res *graphQLResolver) assessmentResults(ctx context.Context, filter *orchestrator.Filter) ([]*assessment.AssessmentResult, error) {
+	return listAll(ctx, &orchestrator.ListAssessmentResultsRequest{Filter: filter}, res.orchestrator.ListAssessmentResults,
+		func(res *orchestrator.ListAssessmentResultsResponse) []*assessment.AssessmentResult {
+			return res.Results
+		})
+}
+
+// listAll wraps [api.ListAllPaginated] so that all pages are requested within ctx, which carries the authorization of
+// the GraphQL request.
+func listAll[ResponseType api.PaginatedResponse, RequestType api.PaginatedRequest, ResultType any](
+	ctx context.Context,
+	req RequestType,
+	list func(context.Context, RequestType, ...grpc.CallOption) (ResponseType, error),
+	getter func(res ResponseType) []ResultType,
+) ([]ResultType, error) {
+	return api.ListAllPaginated(req, func(_ context.Context, req RequestType, opts ...grpc.CallOption) (ResponseType, error) {
+		return list(ctx, req, opts...)
+	}, getter)
+}
+
+// loader returns the graphQLLoader of the current request.
+func loader(ctx context.Context) *graphQLLoader {
+	l, ok := ctx.Value(graphQLLoaderKey{}).(*graphQLLoader)
+	if !ok {
+		return &graphQLLoader{}
+	}
+
+	return l
+}
+
+// field returns a GraphQL field of type typ whose value is resolved by calling get on the source object.
+func field[T any, V any](typ graphql.Output, get func(T) V) *graphql.Field {
+	return &graphql.Field{
+		Type: typ,
+		Resolve: func(p graphql.ResolveParams) (any, error) {
+			return get(p.Source.(T)), nil
+		},
+	}
+}
+
+// timestampField returns a GraphQL date time field whose value is resolved by calling get on the source object.
+func timestampField[T any](get func(T) *timestamppb.Timestamp) *graphql.Field {
+	return &graphql.Field{
+		Type: graphql.DateTime,
+		Resolve: func(p graphql.ResolveParams) (any, error) {
+			ts := get(p.Source.(T))
+			if ts == nil {
+				return nil, nil
+			}
+
+			return ts.AsTime(), nil
+		},
+	}
+}
+
+// stringArg returns a pointer to the string argument name or nil, if it was not supplied.
+func stringArg(args map[string]any, name string) *string {
+	if s, ok := args[name].(string); ok {
+		return &s
+	}
+
+	return nil
+}
+
+// anyToJSON converts a to its generic JSON representation.
+func anyToJSON(a *anypb.Any) (v any, err error) {
+	var b []byte
+
+	if a == nil {
+		return nil, nil
+	}
+
+	b, err = protojson.Marshal(a)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal %s: %w", a.GetTypeUrl(), err)
+	}
+
+	err = json.Unmarshal(b, &v)
+	return
+}
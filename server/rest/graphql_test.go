@@ -0,0 +1,401 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package rest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"clouditor.io/clouditor/v2/api/assessment"
+	"clouditor.io/clouditor/v2/api/discovery"
+	"clouditor.io/clouditor/v2/api/evidence"
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/testutil/prototest"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	mockGraphQLResources = []*discovery.Resource{
+		{
+			Id:             testdata.MockResourceID1,
+			CloudServiceId: testdata.MockCloudServiceID1,
+			ResourceType:   "VirtualMachine,Compute,Resource",
+			Properties:     prototest.NewAnyWithPanic(&ontology.VirtualMachine{Id: testdata.MockResourceID1, Name: "vm"}),
+		},
+		{
+			Id:             testdata.MockResourceID2,
+			CloudServiceId: testdata.MockCloudServiceID1,
+			ResourceType:   "BlockStorage,Storage,Resource",
+		},
+	}
+
+	mockGraphQLEdges = []*discovery.GraphEdge{
+		{
+			Id:     "edge-1",
+			Source: testdata.MockResourceID1,
+			Target: testdata.MockResourceID2,
+			Type:   "blockStorage",
+		},
+	}
+
+	mockGraphQLEvidences = []*evidence.Evidence{
+		{
+			Id:             testdata.MockEvidenceID1,
+			CloudServiceId: testdata.MockCloudServiceID1,
+			ToolId:         testdata.MockEvidenceToolID1,
+		},
+	}
+
+	mockGraphQLResults = []*assessment.AssessmentResult{
+		{
+			Id:             testdata.MockAssessmentResult1ID,
+			MetricId:       testdata.MockMetricID1,
+			Compliant:      true,
+			EvidenceId:     testdata.MockEvidenceID1,
+			ResourceId:     testdata.MockResourceID1,
+			CloudServiceId: testdata.MockCloudServiceID1,
+		},
+		{
+			Id:             testdata.MockAssessmentResult2ID,
+			MetricId:       testdata.MockMetricID1,
+			Compliant:      false,
+			EvidenceId:     testdata.MockEvidenceID1,
+			ResourceId:     testdata.MockResourceID2,
+			CloudServiceId: testdata.MockCloudServiceID1,
+		},
+	}
+)
+
+// mockGraphQLBackend implements the gRPC clients used by the GraphQL resolver on top of the mock data above. It
+// records the authorization that was forwarded to the backend.
+type mockGraphQLBackend struct {
+	discovery.DiscoveryClient
+	discovery.ExperimentalDiscoveryClient
+	evidence.EvidenceStoreClient
+	orchestrator.OrchestratorClient
+
+	err           error
+	authorization []string
+}
+
+func (m *mockGraphQLBackend) record(ctx context.Context) error {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	m.authorization = append(m.authorization, md.Get("authorization")...)
+
+	return m.err
+}
+
+func (m *mockGraphQLBackend) ListResources(ctx context.Context, req *discovery.ListResourcesRequest, _ ...grpc.CallOption) (*discovery.ListResourcesResponse, error) {
+	var res = new(discovery.ListResourcesResponse)
+
+	if err := m.record(ctx); err != nil {
+		return nil, err
+	}
+
+	for _, r := range mockGraphQLResources {
+		if typ := req.GetFilter().GetType(); typ != "" && !strings.Contains(r.ResourceType, typ) {
+			continue
+		}
+
+		res.Results = append(res.Results, r)
+	}
+
+	return res, nil
+}
+
+func (m *mockGraphQLBackend) ListGraphEdges(ctx context.Context, _ *discovery.ListGraphEdgesRequest, _ ...grpc.CallOption) (*discovery.ListGraphEdgesResponse, error) {
+	if err := m.record(ctx); err != nil {
+		return nil, err
+	}
+
+	return &discovery.ListGraphEdgesResponse{Edges: mockGraphQLEdges}, nil
+}
+
+func (m *mockGraphQLBackend) ListEvidences(ctx context.Context, _ *evidence.ListEvidencesRequest, _ ...grpc.CallOption) (*evidence.ListEvidencesResponse, error) {
+	if err := m.record(ctx); err != nil {
+		return nil, err
+	}
+
+	return &evidence.ListEvidencesResponse{Evidences: mockGraphQLEvidences}, nil
+}
+
+func (m *mockGraphQLBackend) GetEvidence(ctx context.Context, req *evidence.GetEvidenceRequest, _ ...grpc.CallOption) (*evidence.Evidence, error) {
+	if err := m.record(ctx); err != nil {
+		return nil, err
+	}
+
+	for _, e := range mockGraphQLEvidences {
+		if e.Id == req.EvidenceId {
+			return e, nil
+		}
+	}
+
+	return nil, status.Error(codes.NotFound, "evidence not found")
+}
+
+func (m *mockGraphQLBackend) ListAssessmentResults(ctx context.Context, req *orchestrator.ListAssessmentResultsRequest, _ ...grpc.CallOption) (*orchestrator.ListAssessmentResultsResponse, error) {
+	var (
+		res    = new(orchestrator.ListAssessmentResultsResponse)
+		filter = req.GetFilter()
+	)
+
+	if err := m.record(ctx); err != nil {
+		return nil, err
+	}
+
+	for _, r := range mockGraphQLResults {
+		if (filter.ResourceId != nil && r.ResourceId != filter.GetResourceId()) ||
+			(filter.EvidenceId != nil && r.EvidenceId != filter.GetEvidenceId()) ||
+			(filter.Compliant != nil && r.Compliant != filter.GetCompliant()) {
+			continue
+		}
+
+		res.Results = append(res.Results, r)
+	}
+
+	return res, nil
+}
+
+func (m *mockGraphQLBackend) GetAssessmentResult(ctx context.Context, req *orchestrator.GetAssessmentResultRequest, _ ...grpc.CallOption) (*assessment.AssessmentResult, error) {
+	if err := m.record(ctx); err != nil {
+		return nil, err
+	}
+
+	for _, r := range mockGraphQLResults {
+		if r.Id == req.Id {
+			return r, nil
+		}
+	}
+
+	return nil, status.Error(codes.NotFound, "assessment result not found")
+}
+
+func Test_graphQLResolver_handler(t *testing.T) {
+	type args struct {
+		req *http.Request
+	}
+	tests := []struct {
+		name     string
+		backend  *mockGraphQLBackend
+		args     args
+		wantCode int
+		want     string
+		wantAuth []string
+	}{
+		{
+			name:    "graph edges with nested resources",
+			backend: &mockGraphQLBackend{},
+			args: args{
+				req: httptest.NewRequest(http.MethodPost, GraphQLPath, strings.NewReader(
+					`{"query": "{ graphEdges { id type sourceResource { id properties } targetResource { id resourceType } } }"}`,
+				)),
+			},
+			wantCode: http.StatusOK,
+			want:     `{"data":{"graphEdges":[{"id":"edge-1","sourceResource":{"id":"my-resource-id","properties":{"@type":"type.googleapis.com/clouditor.ontology.v1.VirtualMachine","id":"my-resource-id","name":"vm"}},"targetResource":{"id":"my-other-resource","resourceType":"BlockStorage,Storage,Resource"},"type":"blockStorage"}]}}`,
+		},
+		{
+			name:    "resource with edges, assessment results and evidence",
+			backend: &mockGraphQLBackend{},
+			args: args{
+				req: httptest.NewRequest(http.MethodGet, GraphQLPath+"?"+url.Values{
+					"query":     []string{`query($id: String!) { resource(id: $id) { id edges { id } assessmentResults { id compliant evidence { id toolId } } } }`},
+					"variables": []string{`{"id": "my-other-resource"}`},
+				}.Encode(), nil),
+			},
+			wantCode: http.StatusOK,
+			want:     `{"data":{"resource":{"assessmentResults":[{"compliant":false,"evidence":{"id":"11111111-1111-1111-1111-111111111111","toolId":"39d85e98-c3da-11ed-afa1-0242ac120002"},"id":"22222222-2222-2222-2222-222222222222"}],"edges":[{"id":"edge-1"}],"id":"my-other-resource"}}}`,
+		},
+		{
+			name:    "filtered assessment results with resource and authorization",
+			backend: &mockGraphQLBackend{},
+			args: args{
+				req: func() *http.Request {
+					r := httptest.NewRequest(http.MethodPost, GraphQLPath, strings.NewReader(
+						`{"query": "{ assessmentResults(compliant: true) { id resource { id } } }"}`,
+					))
+					r.Header.Set("Authorization", "Bearer token")
+					return r
+				}(),
+			},
+			wantCode: http.StatusOK,
+			want:     `{"data":{"assessmentResults":[{"id":"11111111-1111-1111-1111-111111111111","resource":{"id":"my-resource-id"}}]}}`,
+			wantAuth: []string{"Bearer token", "Bearer token"},
+		},
+		{
+			name:    "evidences with assessment results",
+			backend: &mockGraphQLBackend{},
+			args: args{
+				req: httptest.NewRequest(http.MethodPost, GraphQLPath, strings.NewReader(
+					`{"query": "{ evidences { id assessmentResults { id } } }"}`,
+				)),
+			},
+			wantCode: http.StatusOK,
+			want:     `{"data":{"evidences":[{"assessmentResults":[{"id":"11111111-1111-1111-1111-111111111111"},{"id":"22222222-2222-2222-2222-222222222222"}],"id":"11111111-1111-1111-1111-111111111111"}]}}`,
+		},
+		{
+			name:    "resources of a type",
+			backend: &mockGraphQLBackend{},
+			args: args{
+				req: httptest.NewRequest(http.MethodPost, GraphQLPath, strings.NewReader(
+					`{"query": "{ resources(type: \"Storage\") { id cloudServiceId } }"}`,
+				)),
+			},
+			wantCode: http.StatusOK,
+			want:     `{"data":{"resources":[{"cloudServiceId":"11111111-1111-1111-1111-111111111111","id":"my-other-resource"}]}}`,
+		},
+		{
+			name:    "unknown objects",
+			backend: &mockGraphQLBackend{},
+			args: args{
+				req: httptest.NewRequest(http.MethodPost, GraphQLPath, strings.NewReader(
+					`{"query": "{ resource(id: \"unknown\") { id } evidence(id: \"unknown\") { id } assessmentResult(id: \"unknown\") { id } }"}`,
+				)),
+			},
+			wantCode: http.StatusOK,
+			want:     `{"data":{"assessmentResult":null,"evidence":null,"resource":null}}`,
+		},
+		{
+			name:    "backend error",
+			backend: &mockGraphQLBackend{err: status.Error(codes.PermissionDenied, "access denied")},
+			args: args{
+				req: httptest.NewRequest(http.MethodPost, GraphQLPath, strings.NewReader(
+					`{"query": "{ graphEdges { id } }"}`,
+				)),
+			},
+			wantCode: http.StatusOK,
+			want:     `{"data":{"graphEdges":null},"errors":[{"message":"rpc error: code = PermissionDenied desc = access denied","locations":[{"line":1,"column":3}],"path":["graphEdges"]}]}`,
+		},
+		{
+			name:    "invalid query",
+			backend: &mockGraphQLBackend{},
+			args: args{
+				req: httptest.NewRequest(http.MethodPost, GraphQLPath, strings.NewReader(
+					`{"query": "{ unknown }"}`,
+				)),
+			},
+			wantCode: http.StatusOK,
+			want:     `{"data":null,"errors":[{"message":"Cannot query field \"unknown\" on type \"Query\".","locations":[{"line":1,"column":3}]}]}`,
+		},
+		{
+			name:    "missing query",
+			backend: &mockGraphQLBackend{},
+			args: args{
+				req: httptest.NewRequest(http.MethodGet, GraphQLPath, nil),
+			},
+			wantCode: http.StatusBadRequest,
+			want:     ErrMissingGraphQLQuery.Error(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := &graphQLResolver{
+				discovery:    tt.backend,
+				experimental: tt.backend,
+				evidence:     tt.backend,
+				orchestrator: tt.backend,
+			}
+
+			h, err := res.handler()
+			assert.NoError(t, err)
+
+			rec := httptest.NewRecorder()
+			h(rec, tt.args.req, nil)
+
+			assert.Equal(t, tt.wantCode, rec.Code)
+			assert.Equal(t, tt.want, strings.TrimSpace(rec.Body.String()))
+			if tt.wantAuth != nil {
+				assert.Equal(t, tt.wantAuth, tt.backend.authorization)
+			}
+		})
+	}
+}
+
+func Test_parseGraphQLRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		r       *http.Request
+		want    assert.Want[*graphQLRequest]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "POST request",
+			r: httptest.NewRequest(http.MethodPost, GraphQLPath, strings.NewReader(
+				`{"query": "query Edges { graphEdges { id } }", "variables": {"a": 1}, "operationName": "Edges"}`,
+			)),
+			want: func(t *testing.T, got *graphQLRequest) bool {
+				return assert.Equal(t, &graphQLRequest{
+					Query:         "query Edges { graphEdges { id } }",
+					Variables:     map[string]any{"a": float64(1)},
+					OperationName: "Edges",
+				}, got)
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "invalid body",
+			r:    httptest.NewRequest(http.MethodPost, GraphQLPath, strings.NewReader(`{`)),
+			want: assert.Nil[*graphQLRequest],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "invalid request body")
+			},
+		},
+		{
+			name: "invalid variables",
+			r:    httptest.NewRequest(http.MethodGet, GraphQLPath+"?query=%7B%7D&variables=%7B", nil),
+			want: assert.Nil[*graphQLRequest],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "invalid variables")
+			},
+		},
+		{
+			name: "missing query",
+			r:    httptest.NewRequest(http.MethodPost, GraphQLPath, strings.NewReader(`{}`)),
+			want: assert.Nil[*graphQLRequest],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, ErrMissingGraphQLQuery)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseGraphQLRequest(tt.r)
+
+			tt.wantErr(t, err)
+			tt.want(t, got)
+		})
+	}
+}
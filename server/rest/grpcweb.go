@@ -0,0 +1,349 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package rest
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// grpcWebContentType is the content type of gRPC-web requests with binary messages.
+	grpcWebContentType = "application/grpc-web"
+
+	// grpcWebTextContentType is the content type of gRPC-web requests with base64 encoded messages, which is used by
+	// browsers that cannot handle binary streams.
+	grpcWebTextContentType = "application/grpc-web-text"
+
+	// grpcWebTrailerFlag marks the frame that contains the trailers in the body of a gRPC-web response.
+	grpcWebTrailerFlag byte = 0x80
+)
+
+var (
+	// grpcWebAllowedHeaders contains the request headers of gRPC-web clients, which need to be allowed in CORS.
+	grpcWebAllowedHeaders = []string{"X-Grpc-Web", "X-User-Agent", "Grpc-Timeout"}
+
+	// grpcWebExposedHeaders contains the response headers, which gRPC-web clients need to read in CORS.
+	grpcWebExposedHeaders = []string{"Grpc-Status", "Grpc-Message", "X-Request-Id"}
+
+	// grpcWebSkippedHeaders contains the HTTP headers of a gRPC-web request, which are not forwarded as metadata to the
+	// gRPC backend.
+	grpcWebSkippedHeaders = []string{
+		"accept", "accept-encoding", "accept-language", "connection", "content-length", "content-type", "cookie",
+		"grpc-timeout", "host", "origin", "referer", "te", "user-agent", "x-grpc-web", "x-user-agent",
+	}
+
+	// errInvalidGRPCWebFrame indicates that the body of a gRPC-web request cannot be parsed.
+	errInvalidGRPCWebFrame = errors.New("invalid gRPC-web frame")
+)
+
+// WithGRPCWeb is an option to accept gRPC-web requests on the REST gateway and to forward them to the gRPC backend.
+// This allows browser-based UIs to use server-streaming RPCs, such as SubscribeMetricChangeEvents, directly instead of
+// polling REST endpoints. The headers used by gRPC-web are added to the CORS configuration.
+func WithGRPCWeb() ServerConfigOption {
+	return func(c *config, _ *runtime.ServeMux) {
+		c.grpcWeb = true
+	}
+}
+
+// isGRPCWebRequest checks, whether the request is a gRPC-web request.
+func isGRPCWebRequest(r *http.Request) bool {
+	return r.Method == http.MethodPost && strings.HasPrefix(r.Header.Get("Content-Type"), grpcWebContentType)
+}
+
+// handleGRPCWeb forwards gRPC-web requests to the gRPC backend using the given connection. All other requests are
+// handled by h. Only unary and server-streaming RPCs are supported, since browsers cannot stream the request body.
+func handleGRPCWeb(conn grpc.ClientConnInterface, h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !isGRPCWebRequest(r) {
+			h.ServeHTTP(w, r)
+			return
+		}
+
+		serveGRPCWeb(conn, w, r)
+	})
+}
+
+// serveGRPCWeb forwards a single gRPC-web request to the gRPC backend and streams the messages of the response back
+// to the client. The status of the call is sent as trailer frame at the end of the body.
+func serveGRPCWeb(conn grpc.ClientConnInterface, w http.ResponseWriter, r *http.Request) {
+	var (
+		ctx         = r.Context()
+		contentType = r.Header.Get("Content-Type")
+		text        = strings.HasPrefix(contentType, grpcWebTextContentType)
+		body        io.Reader
+		msgs        [][]byte
+		header      metadata.MD
+		err         error
+	)
+
+	body = r.Body
+	if text {
+		body = base64.NewDecoder(base64.StdEncoding, body)
+	}
+
+	msgs, err = readGRPCWebFrames(body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if timeout, ok := parseGRPCTimeout(r.Header.Get("Grpc-Timeout")); ok {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	ctx = metadata.NewOutgoingContext(ctx, grpcWebMetadata(r.Header))
+
+	stream, err := conn.NewStream(ctx, &grpc.StreamDesc{ServerStreams: true, ClientStreams: true}, r.URL.Path,
+		grpc.ForceCodec(rawCodec{}))
+	if err == nil {
+		for _, msg := range msgs {
+			if err = stream.SendMsg(msg); err != nil {
+				break
+			}
+		}
+
+		if err == nil {
+			err = stream.CloseSend()
+		}
+	}
+
+	if err == nil {
+		// Headers are only available, if the backend could be reached
+		header, _ = stream.Header()
+	}
+
+	for key, values := range header {
+		for _, v := range values {
+			w.Header().Add(key, v)
+		}
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(http.StatusOK)
+
+	fw := &grpcWebFrameWriter{w: w, text: text, rc: http.NewResponseController(w)}
+
+	for err == nil {
+		var msg []byte
+
+		if err = stream.RecvMsg(&msg); err != nil {
+			break
+		}
+
+		err = fw.write(0, msg)
+	}
+
+	var trailer metadata.MD
+	if stream != nil {
+		trailer = stream.Trailer()
+	}
+
+	if errors.Is(err, io.EOF) {
+		err = nil
+	}
+
+	_ = fw.write(grpcWebTrailerFlag, grpcWebTrailer(status.Convert(err), trailer))
+}
+
+// readGRPCWebFrames reads the messages of all data frames of a gRPC-web request body.
+func readGRPCWebFrames(r io.Reader) (msgs [][]byte, err error) {
+	var (
+		br     = bufio.NewReader(r)
+		prefix [5]byte
+	)
+
+	for {
+		if _, err = io.ReadFull(br, prefix[:]); errors.Is(err, io.EOF) {
+			return msgs, nil
+		} else if err != nil {
+			return nil, fmt.Errorf("%w: %v", errInvalidGRPCWebFrame, err)
+		}
+
+		if prefix[0] != 0 {
+			return nil, fmt.Errorf("%w: unsupported flags %#x", errInvalidGRPCWebFrame, prefix[0])
+		}
+
+		msg := make([]byte, binary.BigEndian.Uint32(prefix[1:]))
+		if _, err = io.ReadFull(br, msg); err != nil {
+			return nil, fmt.Errorf("%w: %v", errInvalidGRPCWebFrame, err)
+		}
+
+		msgs = append(msgs, msg)
+	}
+}
+
+// grpcWebMetadata converts the headers of a gRPC-web request into the metadata of the call to the gRPC backend.
+func grpcWebMetadata(h http.Header) metadata.MD {
+	md := metadata.MD{}
+
+	for key, values := range h {
+		key = strings.ToLower(key)
+		if slices.Contains(grpcWebSkippedHeaders, key) {
+			continue
+		}
+
+		md.Append(key, values...)
+	}
+
+	return md
+}
+
+// grpcWebTrailer encodes the status and the trailers of a call in the format of the trailer frame of gRPC-web, which
+// is the same as HTTP/1 headers.
+func grpcWebTrailer(s *status.Status, trailer metadata.MD) []byte {
+	var b bytes.Buffer
+
+	fmt.Fprintf(&b, "grpc-status: %d\r\n", s.Code())
+	if s.Message() != "" {
+		fmt.Fprintf(&b, "grpc-message: %s\r\n", encodeGRPCMessage(s.Message()))
+	}
+
+	for key, values := range trailer {
+		for _, v := range values {
+			fmt.Fprintf(&b, "%s: %s\r\n", key, v)
+		}
+	}
+
+	return b.Bytes()
+}
+
+// encodeGRPCMessage percent-encodes the status message like gRPC does for the grpc-message header.
+func encodeGRPCMessage(msg string) string {
+	var b strings.Builder
+
+	for _, c := range []byte(msg) {
+		if c >= ' ' && c <= '~' && c != '%' {
+			b.WriteByte(c)
+		} else {
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+
+	return b.String()
+}
+
+// parseGRPCTimeout parses the value of the grpc-timeout header, e.g., "100m" for 100 milliseconds.
+func parseGRPCTimeout(s string) (timeout time.Duration, ok bool) {
+	var units = map[byte]time.Duration{
+		'H': time.Hour,
+		'M': time.Minute,
+		'S': time.Second,
+		'm': time.Millisecond,
+		'u': time.Microsecond,
+		'n': time.Nanosecond,
+	}
+
+	if len(s) < 2 {
+		return 0, false
+	}
+
+	unit, ok := units[s[len(s)-1]]
+	if !ok {
+		return 0, false
+	}
+
+	n, err := strconv.ParseInt(s[:len(s)-1], 10, 64)
+	if err != nil || n < 0 {
+		return 0, false
+	}
+
+	return time.Duration(n) * unit, true
+}
+
+// grpcWebFrameWriter writes the frames of a gRPC-web response. Each frame is flushed immediately, so that the messages
+// of a stream reach the client without delay.
+type grpcWebFrameWriter struct {
+	w    io.Writer
+	rc   *http.ResponseController
+	text bool
+}
+
+// write writes a single frame with the given flags.
+func (fw *grpcWebFrameWriter) write(flags byte, data []byte) (err error) {
+	frame := make([]byte, 5+len(data))
+	frame[0] = flags
+	binary.BigEndian.PutUint32(frame[1:], uint32(len(data)))
+	copy(frame[5:], data)
+
+	// In text mode, each frame is encoded separately, so that it can be decoded as soon as it is received
+	if fw.text {
+		frame = []byte(base64.StdEncoding.EncodeToString(frame))
+	}
+
+	if _, err = fw.w.Write(frame); err != nil {
+		return err
+	}
+
+	return fw.rc.Flush()
+}
+
+// rawCodec passes the already encoded messages of gRPC-web through to the gRPC backend and back without decoding them.
+type rawCodec struct{}
+
+func (rawCodec) Marshal(v any) ([]byte, error) {
+	b, ok := v.([]byte)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "unexpected message of type %T", v)
+	}
+
+	return b, nil
+}
+
+func (rawCodec) Unmarshal(data []byte, v any) error {
+	b, ok := v.(*[]byte)
+	if !ok {
+		return status.Errorf(codes.Internal, "unexpected message of type %T", v)
+	}
+
+	*b = append((*b)[:0], data...)
+
+	return nil
+}
+
+func (rawCodec) Name() string {
+	return "proto"
+}
@@ -0,0 +1,267 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package rest
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/protobuf/proto"
+)
+
+func Test_handleGRPCWeb(t *testing.T) {
+	conn, err := grpc.Dial(fmt.Sprintf("localhost:%d", grpcPort), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	assert.NoError(t, err)
+	defer conn.Close()
+
+	srv := httptest.NewServer(handleGRPCWeb(conn, http.NotFoundHandler()))
+	defer srv.Close()
+
+	type args struct {
+		method      string
+		contentType string
+		timeout     string
+		req         proto.Message
+	}
+	tests := []struct {
+		name       string
+		args       args
+		statusCode int
+		wantMsgs   int
+		wantStatus codes.Code
+	}{
+		{
+			name: "unary",
+			args: args{
+				method:      grpc_health_v1.Health_Check_FullMethodName,
+				contentType: grpcWebContentType + "+proto",
+				req:         &grpc_health_v1.HealthCheckRequest{},
+			},
+			statusCode: http.StatusOK,
+			wantMsgs:   1,
+			wantStatus: codes.OK,
+		},
+		{
+			name: "server streaming in text mode",
+			args: args{
+				method:      grpc_health_v1.Health_Watch_FullMethodName,
+				contentType: grpcWebTextContentType,
+				timeout:     "200m",
+				req:         &grpc_health_v1.HealthCheckRequest{},
+			},
+			statusCode: http.StatusOK,
+			wantMsgs:   1,
+			wantStatus: codes.DeadlineExceeded,
+		},
+		{
+			name: "unauthenticated",
+			args: args{
+				method:      orchestrator.Orchestrator_ListCloudServices_FullMethodName,
+				contentType: grpcWebContentType,
+				req:         &orchestrator.ListCloudServicesRequest{},
+			},
+			statusCode: http.StatusOK,
+			wantStatus: codes.Unauthenticated,
+		},
+		{
+			name: "no gRPC-web request",
+			args: args{
+				method:      grpc_health_v1.Health_Check_FullMethodName,
+				contentType: "application/json",
+			},
+			statusCode: http.StatusNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var body []byte
+
+			if tt.args.req != nil {
+				b, err := proto.Marshal(tt.args.req)
+				assert.NoError(t, err)
+
+				body = grpcWebFrame(0, b)
+				if strings.HasPrefix(tt.args.contentType, grpcWebTextContentType) {
+					body = []byte(base64.StdEncoding.EncodeToString(body))
+				}
+			}
+
+			req, err := http.NewRequest(http.MethodPost, srv.URL+tt.args.method, bytes.NewReader(body))
+			assert.NoError(t, err)
+			req.Header.Set("Content-Type", tt.args.contentType)
+			req.Header.Set("X-Grpc-Web", "1")
+			if tt.args.timeout != "" {
+				req.Header.Set("Grpc-Timeout", tt.args.timeout)
+			}
+
+			res, err := http.DefaultClient.Do(req)
+			assert.NoError(t, err)
+			defer res.Body.Close()
+
+			assert.Equal(t, tt.statusCode, res.StatusCode)
+			if res.StatusCode != http.StatusOK {
+				return
+			}
+
+			assert.Equal(t, tt.args.contentType, res.Header.Get("Content-Type"))
+
+			msgs, trailer := readGRPCWebResponse(t, res.Body, strings.HasPrefix(tt.args.contentType, grpcWebTextContentType))
+			assert.True(t, len(msgs) >= tt.wantMsgs)
+			assert.Contains(t, trailer, fmt.Sprintf("grpc-status: %d\r\n", tt.wantStatus))
+
+			for _, msg := range msgs {
+				var res grpc_health_v1.HealthCheckResponse
+
+				assert.NoError(t, proto.Unmarshal(msg, &res))
+				assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, res.Status)
+			}
+		})
+	}
+
+	t.Run("invalid frame", func(t *testing.T) {
+		res, err := http.Post(srv.URL+grpc_health_v1.Health_Check_FullMethodName, grpcWebContentType, strings.NewReader("invalid"))
+		assert.NoError(t, err)
+		defer res.Body.Close()
+
+		assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+	})
+}
+
+func Test_handleCORS_grpcWeb(t *testing.T) {
+	prev := cnf
+	defer func() { cnf = prev }()
+
+	cnf.cors = &corsConfig{allowedOrigins: origins, allowedHeaders: headers, allowedMethods: methods}
+	cnf.grpcWeb = true
+
+	h := handleCORS(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	t.Run("preflight", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodOptions, "/grpc.health.v1.Health/Check", nil)
+		req.Header.Set("Origin", "clouditor.io")
+		req.Header.Set("Access-Control-Request-Method", http.MethodPost)
+
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+
+		assert.Equal(t, "Content-Type,Accept,Authorization,X-Grpc-Web,X-User-Agent,Grpc-Timeout", rec.Header().Get("Access-Control-Allow-Headers"))
+
+		// The configured headers are not modified
+		assert.Equal(t, DefaultAllowedHeaders, cnf.cors.allowedHeaders)
+	})
+
+	t.Run("actual request", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/grpc.health.v1.Health/Check", nil)
+		req.Header.Set("Origin", "clouditor.io")
+		req.Header.Set("Content-Type", grpcWebContentType)
+
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+
+		assert.Equal(t, "Grpc-Status,Grpc-Message,X-Request-Id", rec.Header().Get("Access-Control-Expose-Headers"))
+	})
+}
+
+func Test_parseGRPCTimeout(t *testing.T) {
+	tests := []struct {
+		s           string
+		wantTimeout time.Duration
+		wantOk      bool
+	}{
+		{s: "100m", wantTimeout: 100 * time.Millisecond, wantOk: true},
+		{s: "2S", wantTimeout: 2 * time.Second, wantOk: true},
+		{s: "1H", wantTimeout: time.Hour, wantOk: true},
+		{s: ""},
+		{s: "m"},
+		{s: "10x"},
+		{s: "-1S"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			gotTimeout, gotOk := parseGRPCTimeout(tt.s)
+			assert.Equal(t, tt.wantTimeout, gotTimeout)
+			assert.Equal(t, tt.wantOk, gotOk)
+		})
+	}
+}
+
+// grpcWebFrame encodes a single gRPC-web frame.
+func grpcWebFrame(flags byte, data []byte) []byte {
+	frame := make([]byte, 5+len(data))
+	frame[0] = flags
+	binary.BigEndian.PutUint32(frame[1:], uint32(len(data)))
+	copy(frame[5:], data)
+
+	return frame
+}
+
+// readGRPCWebResponse reads the messages and the trailer of a gRPC-web response. In text mode, each frame is expected
+// to be encoded separately.
+func readGRPCWebResponse(t *testing.T, r io.Reader, text bool) (msgs [][]byte, trailer string) {
+	b, err := io.ReadAll(r)
+	assert.NoError(t, err)
+
+	for len(b) > 0 {
+		var frame []byte
+
+		if text {
+			// The first 8 characters contain the prefix of the frame, which tells us the length of the encoded frame
+			prefix, err := base64.StdEncoding.DecodeString(string(b[:8]))
+			assert.NoError(t, err)
+
+			n := base64.StdEncoding.EncodedLen(5 + int(binary.BigEndian.Uint32(prefix[1:5])))
+			frame, err = base64.StdEncoding.DecodeString(string(b[:n]))
+			assert.NoError(t, err)
+			b = b[n:]
+		} else {
+			n := 5 + int(binary.BigEndian.Uint32(b[1:5]))
+			frame, b = b[:n], b[n:]
+		}
+
+		if frame[0]&grpcWebTrailerFlag != 0 {
+			trailer = string(frame[5:])
+		} else {
+			msgs = append(msgs, frame[5:])
+		}
+	}
+
+	return
+}
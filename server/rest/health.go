@@ -0,0 +1,103 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"clouditor.io/clouditor/v2/api/assessment"
+	"clouditor.io/clouditor/v2/api/discovery"
+	"clouditor.io/clouditor/v2/api/evaluation"
+	"clouditor.io/clouditor/v2/api/evidence"
+	"clouditor.io/clouditor/v2/api/orchestrator"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+// readinessTimeout is the maximum time a readiness probe waits for the health of a service.
+const readinessTimeout = 5 * time.Second
+
+// healthServices contains the names of the services that are considered by the readiness probe, if they are
+// registered in the gRPC server.
+var healthServices = []string{
+	assessment.Assessment_ServiceDesc.ServiceName,
+	discovery.Discovery_ServiceDesc.ServiceName,
+	evaluation.Evaluation_ServiceDesc.ServiceName,
+	evidence.EvidenceStore_ServiceDesc.ServiceName,
+	orchestrator.Orchestrator_ServiceDesc.ServiceName,
+}
+
+// readiness is the response of the readiness probe.
+type readiness struct {
+	Status   string            `json:"status"`
+	Services map[string]string `json:"services,omitempty"`
+}
+
+// handleHealthz is the liveness probe. It only reports that the REST gateway itself is running.
+func handleHealthz(w http.ResponseWriter, _ *http.Request, _ map[string]string) {
+	w.Header().Set("Content-Type", "text/plain")
+	_, _ = w.Write([]byte("ok"))
+}
+
+// handleReadyz returns the readiness probe. It reports the health of all services registered in the gRPC server,
+// including their dependencies, and only succeeds if all of them are serving.
+func handleReadyz(client grpc_health_v1.HealthClient) func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	return func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		var (
+			res  = readiness{Status: grpc_health_v1.HealthCheckResponse_SERVING.String(), Services: map[string]string{}}
+			code = http.StatusOK
+		)
+
+		ctx, cancel := context.WithTimeout(r.Context(), readinessTimeout)
+		defer cancel()
+
+		for _, name := range healthServices {
+			s, err := client.Check(ctx, &grpc_health_v1.HealthCheckRequest{Service: name})
+			if status.Code(err) == codes.NotFound {
+				// The service is not part of this deployment
+				continue
+			} else if err != nil {
+				res.Services[name] = grpc_health_v1.HealthCheckResponse_UNKNOWN.String()
+			} else {
+				res.Services[name] = s.Status.String()
+			}
+
+			if res.Services[name] != grpc_health_v1.HealthCheckResponse_SERVING.String() {
+				res.Status = grpc_health_v1.HealthCheckResponse_NOT_SERVING.String()
+				code = http.StatusServiceUnavailable
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(code)
+		_ = json.NewEncoder(w).Encode(res)
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+// mockHealthClient returns the configured status or error for each service. Services that are not configured are
+// unknown.
+type mockHealthClient struct {
+	grpc_health_v1.HealthClient
+
+	statuses map[string]grpc_health_v1.HealthCheckResponse_ServingStatus
+	errs     map[string]error
+}
+
+func (m *mockHealthClient) Check(_ context.Context, req *grpc_health_v1.HealthCheckRequest, _ ...grpc.CallOption) (*grpc_health_v1.HealthCheckResponse, error) {
+	if err, ok := m.errs[req.Service]; ok {
+		return nil, err
+	}
+
+	if s, ok := m.statuses[req.Service]; ok {
+		return &grpc_health_v1.HealthCheckResponse{Status: s}, nil
+	}
+
+	return nil, status.Error(codes.NotFound, "unknown service")
+}
+
+func Test_handleReadyz(t *testing.T) {
+	tests := []struct {
+		name     string
+		client   grpc_health_v1.HealthClient
+		wantCode int
+		want     readiness
+	}{
+		{
+			name: "all serving",
+			client: &mockHealthClient{
+				statuses: map[string]grpc_health_v1.HealthCheckResponse_ServingStatus{
+					"clouditor.orchestrator.v1.Orchestrator": grpc_health_v1.HealthCheckResponse_SERVING,
+					"clouditor.assessment.v1.Assessment":     grpc_health_v1.HealthCheckResponse_SERVING,
+				},
+			},
+			wantCode: http.StatusOK,
+			want: readiness{
+				Status: "SERVING",
+				Services: map[string]string{
+					"clouditor.orchestrator.v1.Orchestrator": "SERVING",
+					"clouditor.assessment.v1.Assessment":     "SERVING",
+				},
+			},
+		},
+		{
+			name: "not serving",
+			client: &mockHealthClient{
+				statuses: map[string]grpc_health_v1.HealthCheckResponse_ServingStatus{
+					"clouditor.orchestrator.v1.Orchestrator": grpc_health_v1.HealthCheckResponse_NOT_SERVING,
+				},
+				errs: map[string]error{
+					"clouditor.assessment.v1.Assessment": errors.New("connection refused"),
+				},
+			},
+			wantCode: http.StatusServiceUnavailable,
+			want: readiness{
+				Status: "NOT_SERVING",
+				Services: map[string]string{
+					"clouditor.orchestrator.v1.Orchestrator": "NOT_SERVING",
+					"clouditor.assessment.v1.Assessment":     "UNKNOWN",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got readiness
+
+			rec := httptest.NewRecorder()
+			handleReadyz(tt.client)(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil), nil)
+
+			assert.Equal(t, tt.wantCode, rec.Code)
+			assert.NoError(t, json.NewDecoder(rec.Body).Decode(&got))
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
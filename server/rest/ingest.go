@@ -0,0 +1,192 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package rest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"clouditor.io/clouditor/v2/api"
+	"clouditor.io/clouditor/v2/api/assessment"
+	"clouditor.io/clouditor/v2/api/discovery"
+	"clouditor.io/clouditor/v2/api/evidence"
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/ingest"
+	"clouditor.io/clouditor/v2/internal/util"
+	"clouditor.io/clouditor/v2/service"
+
+	"github.com/google/uuid"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// IngestPath is the path of the webhook endpoint in the REST server, which converts the payloads of third-party
+	// scanners into evidences. The format of the payload is part of the path, e.g., /v1/evidence_store/ingest/trivy.
+	// The cloud service and tool of the evidences can be specified in the "cloud_service_id" and "tool_id" query
+	// parameters; the tool defaults to the format.
+	IngestPath = "/v1/evidence_store/ingest/{format}"
+
+	// maxIngestPayloadSize is the maximum size of an ingested payload.
+	maxIngestPayloadSize = 10 << 20
+)
+
+// ingestNamespace is the namespace of the name-based UUIDs of ingested evidences.
+var ingestNamespace = uuid.MustParse("d0314c87-6e6b-47a2-bf9e-ad32e63ff90d")
+
+// ingestResponse is the response of the webhook endpoint.
+type ingestResponse struct {
+	EvidenceIDs []string `json:"evidenceIds"`
+}
+
+// WithIngest is an option to accept webhooks of third-party scanners and event sources, e.g., Trivy, Falco or AWS
+// EventBridge, on /v1/evidence_store/ingest/{format}. Their payloads are converted into evidences using the mapping
+// templates of the mapper and sent to the assessment, just like the evidences of our discovery. The assessment then
+// stores them in the evidence store.
+func WithIngest(m *ingest.Mapper) ServerConfigOption {
+	return func(c *config, sm *runtime.ServeMux) {
+		c.ingest = m
+	}
+}
+
+// ingester assesses the resources contained in webhook payloads as evidences.
+type ingester struct {
+	mapper     *ingest.Mapper
+	assessment assessment.AssessmentClient
+}
+
+// handleIngest returns the handler of our webhook endpoint. It forwards the authorization of the incoming request to
+// the gRPC backend reachable over cc.
+func handleIngest(m *ingest.Mapper, cc grpc.ClientConnInterface) func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	ing := &ingester{
+		mapper:     m,
+		assessment: assessment.NewAssessmentClient(cc),
+	}
+
+	return ing.handler
+}
+
+func (ing *ingester) handler(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	var (
+		format = params["format"]
+		query  = r.URL.Query()
+		res    = ingestResponse{EvidenceIDs: []string{}}
+	)
+
+	payload, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxIngestPayloadSize))
+	if err != nil {
+		http.Error(w, fmt.Sprintf("could not read payload: %v", err), http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	resources, err := ing.mapper.Resources(format, payload)
+	if errors.Is(err, ingest.ErrUnknownFormat) {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	} else if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	cloudServiceID := query.Get("cloud_service_id")
+	if cloudServiceID == "" {
+		cloudServiceID = discovery.DefaultCloudServiceID
+	}
+
+	toolID := query.Get("tool_id")
+	if toolID == "" {
+		toolID = format
+	}
+
+	ctx := r.Context()
+	if auth := r.Header.Get("Authorization"); auth != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", auth)
+	}
+	if key := r.Header.Get(service.APIKeyHeader); key != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, service.APIKeyHeader, key)
+	}
+
+	// Webhooks are usually retried on failures. If the sender supplies an idempotency key, we derive the IDs of the
+	// evidences as well as an idempotency key for each of them from it, so that a retry results in the same evidences,
+	// which are only assessed and stored once. Otherwise, each request results in new evidences, even if the payload is the same.
+	key := r.Header.Get(service.IdempotencyKeyHeader)
+
+	// We build and validate all evidences first, so that an invalid resource does not leave the others assessed
+	evidences := make([]*evidence.Evidence, 0, len(resources))
+	for i, resource := range resources {
+		ev := &evidence.Evidence{
+			Id:              ingestEvidenceID(cloudServiceID, toolID, key, i),
+			Timestamp:       timestamppb.Now(),
+			CloudServiceId:  cloudServiceID,
+			ToolId:          toolID,
+			Raw:             util.Ref(string(resource.Raw)),
+			Resource:        resource.Resource,
+			OntologyVersion: ontology.Version,
+		}
+
+		err = api.Validate(&assessment.AssessEvidenceRequest{Evidence: ev})
+		if err != nil {
+			writeStatusError(w, err)
+			return
+		}
+
+		evidences = append(evidences, ev)
+	}
+
+	for i, ev := range evidences {
+		callCtx := ctx
+		if key != "" {
+			callCtx = metadata.AppendToOutgoingContext(ctx, service.IdempotencyKeyHeader, fmt.Sprintf("%s-%d", key, i))
+		}
+
+		_, err = ing.assessment.AssessEvidence(callCtx, &assessment.AssessEvidenceRequest{Evidence: ev})
+		if err != nil {
+			writeStatusError(w, err)
+			return
+		}
+
+		res.EvidenceIDs = append(res.EvidenceIDs, ev.Id)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(res)
+}
+
+// ingestEvidenceID returns the ID of the i-th evidence of a webhook. If the webhook has an idempotency key, the ID is
+// derived from it as well as the cloud service and the tool, so that a retried webhook results in the same evidences.
+// Otherwise, a random ID is returned.
+func ingestEvidenceID(cloudServiceID string, toolID string, idempotencyKey string, i int) string {
+	if idempotencyKey == "" {
+		return uuid.NewString()
+	}
+
+	return uuid.NewSHA1(ingestNamespace, []byte(fmt.Sprintf("%s/%s/%s/%d", cloudServiceID, toolID, idempotencyKey, i))).String()
+}
@@ -0,0 +1,256 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"clouditor.io/clouditor/v2/api/assessment"
+	"clouditor.io/clouditor/v2/api/discovery"
+	"clouditor.io/clouditor/v2/api/evidence"
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/ingest"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/service"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// mockIngestBackend implements the assessment client used by the webhook endpoint. Like our idempotency interceptor,
+// it only executes the first request with an idempotency key. It rejects evidences of MockCloudServiceID2 and evidences
+// that were already assessed.
+type mockIngestBackend struct {
+	assessment.AssessmentClient
+
+	evidences       []*evidence.Evidence
+	authorization   []string
+	idempotencyKeys []string
+}
+
+func (m *mockIngestBackend) AssessEvidence(ctx context.Context, req *assessment.AssessEvidenceRequest, _ ...grpc.CallOption) (*assessment.AssessEvidenceResponse, error) {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	m.authorization = append(m.authorization, md.Get("authorization")...)
+
+	for _, key := range md.Get(service.IdempotencyKeyHeader) {
+		if slices.Contains(m.idempotencyKeys, key) {
+			return &assessment.AssessEvidenceResponse{}, nil
+		}
+
+		m.idempotencyKeys = append(m.idempotencyKeys, key)
+	}
+
+	if req.Evidence.CloudServiceId == testdata.MockCloudServiceID2 {
+		return nil, status.Error(codes.PermissionDenied, "access denied")
+	}
+
+	for _, ev := range m.evidences {
+		if ev.Id == req.Evidence.Id {
+			return nil, status.Error(codes.AlreadyExists, "entry already exists")
+		}
+	}
+
+	m.evidences = append(m.evidences, req.Evidence)
+
+	return &assessment.AssessEvidenceResponse{}, nil
+}
+
+func Test_ingester_handler(t *testing.T) {
+	const eventBridgePayload = `{
+		"source": "aws.s3",
+		"account": "123456789012",
+		"detail-type": "Object Created",
+		"resources": ["arn:aws:s3:::bucket-1", "arn:aws:s3:::bucket-2"]
+	}`
+
+	m, err := ingest.NewMapper("")
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		format   string
+		query    string
+		payload  string
+		wantCode int
+		want     func(t *testing.T, backend *mockIngestBackend, body string) bool
+	}{
+		{
+			name:     "eventbridge",
+			format:   "eventbridge",
+			query:    "?cloud_service_id=" + testdata.MockCloudServiceID1,
+			payload:  eventBridgePayload,
+			wantCode: http.StatusOK,
+			want: func(t *testing.T, backend *mockIngestBackend, body string) bool {
+				var res ingestResponse
+
+				assert.NoError(t, json.Unmarshal([]byte(body), &res))
+				assert.Equal(t, 2, len(res.EvidenceIDs))
+				assert.Equal(t, 2, len(backend.evidences))
+				assert.Equal(t, res.EvidenceIDs[0], backend.evidences[0].Id)
+				assert.Equal(t, testdata.MockCloudServiceID1, backend.evidences[0].CloudServiceId)
+				assert.Equal(t, "eventbridge", backend.evidences[0].ToolId)
+				assert.Equal(t, ontology.Version, backend.evidences[0].OntologyVersion)
+				assert.Equal(t, `{"id":null,"detail-type":"Object Created","source":"aws.s3","account":"123456789012","time":null,"region":null,"resources":["arn:aws:s3:::bucket-1"],"detail":null}`, backend.evidences[0].GetRaw())
+				assert.Equal(t, `{"id":null,"detail-type":"Object Created","source":"aws.s3","account":"123456789012","time":null,"region":null,"resources":["arn:aws:s3:::bucket-2"],"detail":null}`, backend.evidences[1].GetRaw())
+				assert.Equal(t, []string{"Bearer token", "Bearer token"}, backend.authorization)
+				return assert.Equal(t, []string{"delivery-1-0", "delivery-1-1"}, backend.idempotencyKeys)
+			},
+		},
+		{
+			name:     "no resources",
+			format:   "eventbridge",
+			query:    "?tool_id=my-tool",
+			payload:  `{"source": "aws.iam"}`,
+			wantCode: http.StatusOK,
+			want: func(t *testing.T, backend *mockIngestBackend, body string) bool {
+				assert.Equal(t, 0, len(backend.evidences))
+				return assert.Equal(t, "{\"evidenceIds\":[]}\n", body)
+			},
+		},
+		{
+			name:     "default cloud service and tool",
+			format:   "trivy",
+			payload:  `{"ArtifactName": "nginx:1.25"}`,
+			wantCode: http.StatusOK,
+			want: func(t *testing.T, backend *mockIngestBackend, body string) bool {
+				assert.Equal(t, discovery.DefaultCloudServiceID, backend.evidences[0].CloudServiceId)
+				return assert.Equal(t, "trivy", backend.evidences[0].ToolId)
+			},
+		},
+		{
+			name:     "unknown format",
+			format:   "unknown",
+			payload:  `{}`,
+			wantCode: http.StatusNotFound,
+			want: func(t *testing.T, _ *mockIngestBackend, body string) bool {
+				return assert.Contains(t, body, "unknown format")
+			},
+		},
+		{
+			name:     "invalid payload",
+			format:   "trivy",
+			payload:  `not JSON`,
+			wantCode: http.StatusBadRequest,
+			want: func(t *testing.T, _ *mockIngestBackend, body string) bool {
+				return assert.Contains(t, body, "invalid payload")
+			},
+		},
+		{
+			name:     "invalid evidence",
+			format:   "eventbridge",
+			query:    "?cloud_service_id=not-a-uuid",
+			payload:  eventBridgePayload,
+			wantCode: http.StatusBadRequest,
+			want: func(t *testing.T, backend *mockIngestBackend, body string) bool {
+				assert.Contains(t, body, "cloud_service_id")
+				return assert.Equal(t, 0, len(backend.evidences))
+			},
+		},
+		{
+			name:     "error of the assessment",
+			format:   "trivy",
+			query:    "?cloud_service_id=" + testdata.MockCloudServiceID2,
+			payload:  `{"ArtifactName": "nginx:1.25"}`,
+			wantCode: http.StatusForbidden,
+			want: func(t *testing.T, _ *mockIngestBackend, body string) bool {
+				return assert.Contains(t, body, "access denied")
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				backend = &mockIngestBackend{}
+				ing     = &ingester{mapper: m, assessment: backend}
+				rec     = httptest.NewRecorder()
+				req     = httptest.NewRequest(http.MethodPost, strings.ReplaceAll(IngestPath, "{format}", tt.format)+tt.query, strings.NewReader(tt.payload))
+			)
+
+			req.Header.Set("Authorization", "Bearer token")
+			req.Header.Set(service.IdempotencyKeyHeader, "delivery-1")
+
+			ing.handler(rec, req, map[string]string{"format": tt.format})
+
+			assert.Equal(t, tt.wantCode, rec.Code)
+			tt.want(t, backend, rec.Body.String())
+		})
+	}
+}
+
+func Test_ingester_handler_retry(t *testing.T) {
+	const payload = `{"source": "aws.s3", "resources": ["arn:aws:s3:::bucket-1", "arn:aws:s3:::bucket-2"]}`
+
+	m, err := ingest.NewMapper("")
+	assert.NoError(t, err)
+
+	var (
+		backend = &mockIngestBackend{}
+		ing     = &ingester{mapper: m, assessment: backend}
+		ids     [][]string
+	)
+
+	// The first delivery only assessed the first evidence before it failed
+	backend.evidences = []*evidence.Evidence{{Id: ingestEvidenceID(discovery.DefaultCloudServiceID, "eventbridge", "delivery-1", 0)}}
+	backend.idempotencyKeys = []string{"delivery-1-0"}
+
+	// The delivery is retried, afterwards the same payload is sent again as a new delivery and without a key
+	for _, key := range []string{"delivery-1", "delivery-1", "delivery-2", ""} {
+		var (
+			rec = httptest.NewRecorder()
+			req = httptest.NewRequest(http.MethodPost, strings.ReplaceAll(IngestPath, "{format}", "eventbridge"), strings.NewReader(payload))
+			res ingestResponse
+		)
+
+		if key != "" {
+			req.Header.Set(service.IdempotencyKeyHeader, key)
+		}
+
+		ing.handler(rec, req, map[string]string{"format": "eventbridge"})
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+
+		ids = append(ids, res.EvidenceIDs)
+	}
+
+	// Retries result in the same evidences, which are only assessed once
+	assert.Equal(t, ids[0], ids[1])
+	assert.Equal(t, backend.evidences[0].Id, ids[0][0])
+	assert.Equal(t, backend.evidences[1].Id, ids[0][1])
+
+	// New deliveries result in new evidences
+	assert.Equal(t, 6, len(backend.evidences))
+	assert.NotEqual(t, ids[0], ids[2])
+	assert.NotEqual(t, ids[2], ids[3])
+}
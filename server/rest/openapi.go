@@ -0,0 +1,175 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package rest
+
+import (
+	"compress/gzip"
+	"html/template"
+	"io"
+	"io/fs"
+	"mime"
+	"net/http"
+	"path"
+	"slices"
+	"strings"
+
+	"clouditor.io/clouditor/v2/openapi"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	swaggerui "github.com/swaggest/swgui/v5/static"
+)
+
+const (
+	// OpenAPIPath is the path of the Swagger UI in the REST server. The OpenAPI documents of the services are served
+	// below it, e.g., /v1/openapi/orchestrator/openapi.yaml.
+	OpenAPIPath = "/v1/openapi"
+
+	// swaggerUIAssetsPath is the path below which the assets of the Swagger UI are served. They are embedded into our
+	// binary (see [swaggerui.FS]), so that the Swagger UI does not load any code from a third-party CDN.
+	swaggerUIAssetsPath = OpenAPIPath + "/assets"
+)
+
+// openAPIServices contains the names of the services whose OpenAPI documents are served, in the order they are listed
+// in the Swagger UI.
+var openAPIServices = []string{"orchestrator", "assessment", "evidence", "evaluation", "discovery"}
+
+// swaggerUIAssets contains the (gzipped) assets of the Swagger UI that our page needs.
+var swaggerUIAssets = []string{"swagger-ui.css", "swagger-ui-bundle.js", "swagger-ui-standalone-preset.js"}
+
+// swaggerUI is the page of the Swagger UI, which lists the OpenAPI documents of all services.
+var swaggerUI = template.Must(template.New("swagger-ui").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+  <meta charset="utf-8">
+  <title>Clouditor API</title>
+  <link rel="stylesheet" href="{{.Assets}}/swagger-ui.css">
+</head>
+<body>
+  <div id="swagger-ui"></div>
+  <script src="{{.Assets}}/swagger-ui-bundle.js"></script>
+  <script src="{{.Assets}}/swagger-ui-standalone-preset.js"></script>
+  <script>
+    window.ui = SwaggerUIBundle({
+      urls: {{.URLs}},
+      dom_id: "#swagger-ui",
+      presets: [SwaggerUIBundle.presets.apis, SwaggerUIStandalonePreset],
+      layout: "StandaloneLayout",
+    });
+  </script>
+</body>
+</html>
+`))
+
+// openAPIURL is an entry of the list of OpenAPI documents in the Swagger UI.
+type openAPIURL struct {
+	URL  string `json:"url"`
+	Name string `json:"name"`
+}
+
+// WithOpenAPI is an option to serve the OpenAPI v3 documents of the HTTP API of all services together with a Swagger
+// UI on /v1/openapi, so that integrators can explore the API and generate clients for it.
+func WithOpenAPI() ServerConfigOption {
+	return func(c *config, sm *runtime.ServeMux) {
+		WithAdditionalHandler("GET", OpenAPIPath, handleSwaggerUI)(c, sm)
+		WithAdditionalHandler("GET", swaggerUIAssetsPath+"/{file}", handleSwaggerUIAsset)(c, sm)
+		WithAdditionalHandler("GET", OpenAPIPath+"/{service}/openapi.yaml", handleOpenAPI)(c, sm)
+	}
+}
+
+// handleSwaggerUI serves the Swagger UI for the OpenAPI documents of all services.
+func handleSwaggerUI(w http.ResponseWriter, _ *http.Request, _ map[string]string) {
+	var urls []openAPIURL
+
+	for _, service := range openAPIServices {
+		urls = append(urls, openAPIURL{
+			URL:  path.Join(OpenAPIPath, service, "openapi.yaml"),
+			Name: service,
+		})
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+
+	err := swaggerUI.Execute(w, struct {
+		Assets string
+		URLs   []openAPIURL
+	}{swaggerUIAssetsPath, urls})
+	if err != nil {
+		log.Errorf("Could not render Swagger UI: %v", err)
+	}
+}
+
+// handleSwaggerUIAsset serves an embedded asset of the Swagger UI. The assets are stored gzipped, so they are sent as-is
+// to clients that accept it and decompressed otherwise.
+func handleSwaggerUIAsset(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	var (
+		file = pathParams["file"]
+		f    fs.File
+		rd   io.Reader
+		err  error
+	)
+
+	if !slices.Contains(swaggerUIAssets, file) {
+		http.NotFound(w, r)
+		return
+	}
+
+	f, err = swaggerui.FS.Open(file + ".gz")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer f.Close()
+
+	rd = f
+	if strings.Contains(r.Header.Get("Accept-Encoding"), "gzip") {
+		w.Header().Set("Content-Encoding", "gzip")
+	} else if rd, err = gzip.NewReader(f); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", mime.TypeByExtension(path.Ext(file)))
+	w.Header().Set("Vary", "Accept-Encoding")
+	_, _ = io.Copy(w, rd)
+}
+
+// handleOpenAPI serves the OpenAPI document of the service given in the path.
+func handleOpenAPI(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	service := pathParams["service"]
+	if !slices.Contains(openAPIServices, service) {
+		http.NotFound(w, r)
+		return
+	}
+
+	b, err := openapi.FS.ReadFile(path.Join(service, "openapi.yaml"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/yaml")
+	_, _ = w.Write(b)
+}
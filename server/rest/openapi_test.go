@@ -0,0 +1,117 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package rest
+
+import (
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+)
+
+func TestWithOpenAPI(t *testing.T) {
+	mux := runtime.NewServeMux()
+	WithOpenAPI()(&config{}, mux)
+
+	tests := []struct {
+		name            string
+		path            string
+		wantStatus      int
+		wantContentType string
+		wantBody        string
+	}{
+		{
+			name:            "Swagger UI",
+			path:            OpenAPIPath,
+			wantStatus:      http.StatusOK,
+			wantContentType: "text/html; charset=utf-8",
+			wantBody:        `{"url":"/v1/openapi/orchestrator/openapi.yaml","name":"orchestrator"}`,
+		},
+		{
+			name:            "OpenAPI document",
+			path:            OpenAPIPath + "/evidence/openapi.yaml",
+			wantStatus:      http.StatusOK,
+			wantContentType: "application/yaml",
+			wantBody:        "title: EvidenceStore API",
+		},
+		{
+			name:            "Swagger UI asset",
+			path:            swaggerUIAssetsPath + "/swagger-ui-bundle.js",
+			wantStatus:      http.StatusOK,
+			wantContentType: "text/javascript; charset=utf-8",
+			wantBody:        "SwaggerUIBundle",
+		},
+		{
+			name:       "unknown asset",
+			path:       swaggerUIAssetsPath + "/index.html",
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "unknown service",
+			path:       OpenAPIPath + "/ontology/openapi.yaml",
+			wantStatus: http.StatusNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tt.path, nil))
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+
+			b, err := io.ReadAll(rec.Body)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantContentType, rec.Header().Get("Content-Type"))
+			assert.Contains(t, string(b), tt.wantBody)
+		})
+	}
+}
+
+func Test_handleSwaggerUIAsset_gzip(t *testing.T) {
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, swaggerUIAssetsPath+"/swagger-ui.css", nil)
+	req.Header.Set("Accept-Encoding", "gzip, deflate")
+
+	handleSwaggerUIAsset(rec, req, map[string]string{"file": "swagger-ui.css"})
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "gzip", rec.Header().Get("Content-Encoding"))
+
+	r, err := gzip.NewReader(rec.Body)
+	assert.NoError(t, err)
+
+	b, err := io.ReadAll(r)
+	assert.NoError(t, err)
+	assert.Contains(t, string(b), ".swagger-ui")
+}
@@ -30,11 +30,15 @@ import (
 	"crypto/ecdsa"
 	"errors"
 	"fmt"
+	"io/fs"
 	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"slices"
 	"strings"
+	"sync"
+	"syscall"
 	"time"
 
 	"clouditor.io/clouditor/v2/api/assessment"
@@ -42,14 +46,20 @@ import (
 	"clouditor.io/clouditor/v2/api/evaluation"
 	"clouditor.io/clouditor/v2/api/evidence"
 	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/internal/ingest"
+	"clouditor.io/clouditor/v2/internal/logging"
+	"clouditor.io/clouditor/v2/internal/telemetry"
 	"clouditor.io/clouditor/v2/internal/util"
+	"clouditor.io/clouditor/v2/service"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	oauth2 "github.com/oxisto/oauth2go"
 	"github.com/oxisto/oauth2go/storage"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health/grpc_health_v1"
 )
 
 var (
@@ -76,6 +86,18 @@ type config struct {
 
 	// opts contains the gRPC options used for the gateway-to-backend calls.
 	opts []grpc.DialOption
+
+	// graphql specifies whether the GraphQL endpoint is exposed.
+	graphql bool
+
+	// dashboard contains the assets of the dashboard, if it is served by the REST gateway.
+	dashboard fs.FS
+
+	// grpcWeb specifies whether gRPC-web requests are accepted.
+	grpcWeb bool
+
+	// ingest contains the mapping templates of the webhook endpoint, if it is exposed.
+	ingest *ingest.Mapper
 }
 
 // corsConfig holds all necessary configuration options for Cross-Origin Resource Sharing of our REST API.
@@ -148,6 +170,22 @@ func WithAdditionalHandler(method string, path string, h runtime.HandlerFunc) Se
 	}
 }
 
+// WithMetrics is an option to expose the Prometheus metrics of all Clouditor services on the /metrics endpoint of the
+// REST server.
+func WithMetrics() ServerConfigOption {
+	return WithAdditionalHandler("GET", "/metrics", func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		telemetry.Handler().ServeHTTP(w, r)
+	})
+}
+
+// WithGraphQL is an option to expose a GraphQL endpoint on /v1/graphql, which allows to query resources, graph
+// edges, evidences and assessment results including their relations in a single request.
+func WithGraphQL() ServerConfigOption {
+	return func(c *config, _ *runtime.ServeMux) {
+		c.graphql = true
+	}
+}
+
 // WithAdditionalGRPCOpts is an option to add an additional gRPC dial options in the REST server communication to the
 // backend.
 func WithAdditionalGRPCOpts(opts []grpc.DialOption) ServerConfigOption {
@@ -164,6 +202,9 @@ func WithAdditionalGRPCOpts(opts []grpc.DialOption) ServerConfigOption {
 // For the various options to configure the OAuth 2.0 server, please refer to
 // https://pkg.go.dev/github.com/oxisto/oauth2go#AuthorizationServerOption.
 //
+// Additionally, the token endpoint supports the OAuth 2.0 token exchange (RFC 8693), so that
+// our micro-services can exchange their token for one that is restricted to specific scopes.
+//
 // In production scenarios, the usage of a dedicated authentication and authorization server is
 // recommended.
 func WithEmbeddedOAuth2Server(keyPath string, keyPassword string, saveOnCreate bool, opts ...oauth2.AuthorizationServerOption) ServerConfigOption {
@@ -172,18 +213,21 @@ func WithEmbeddedOAuth2Server(keyPath string, keyPassword string, saveOnCreate b
 
 		log.Infof("Using embedded OAuth2.0 server on %s", publicURL)
 
+		// The signing keys are loaded only once, since they are also needed for the token exchange
+		keys := sync.OnceValue(func() map[int]*ecdsa.PrivateKey {
+			// Expand path, because this could contain ~
+			path, err := util.ExpandPath(keyPath)
+			if err != nil {
+				// Just use the current working dir if it fails
+				path = "."
+			}
+
+			return storage.LoadSigningKeys(path, keyPassword, saveOnCreate)
+		})
+
 		// Configure the options for the embedded auth server
 		opts = append(opts,
-			oauth2.WithSigningKeysFunc(func() map[int]*ecdsa.PrivateKey {
-				// Expand path, because this could contain ~
-				path, err := util.ExpandPath(keyPath)
-				if err != nil {
-					// Just use the current working dir if it fails
-					path = "."
-				}
-
-				return storage.LoadSigningKeys(path, keyPassword, saveOnCreate)
-			}),
+			oauth2.WithSigningKeysFunc(keys),
 			oauth2.WithPublicURL(publicURL),
 		)
 
@@ -201,10 +245,49 @@ func WithEmbeddedOAuth2Server(keyPath string, keyPassword string, saveOnCreate b
 		WithAdditionalHandler("GET", "/v1/auth/login", authHandler)(c, sm)
 		WithAdditionalHandler("GET", "/v1/auth/authorize", authHandler)(c, sm)
 		WithAdditionalHandler("POST", "/v1/auth/login", authHandler)(c, sm)
-		WithAdditionalHandler("POST", "/v1/auth/token", authHandler)(c, sm)
+		WithAdditionalHandler("POST", "/v1/auth/token", func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+			(&tokenExchange{
+				srv:  authSrv,
+				keys: keys,
+				next: http.StripPrefix("/v1/auth", authSrv.Handler),
+			}).ServeHTTP(w, r)
+		})(c, sm)
 	}
 }
 
+// incomingHeaderMatcher forwards the API key and idempotency key headers of machine collectors, the token of auditor
+// grants as well as the request ID to the gRPC backend in addition to the headers forwarded by
+// [runtime.DefaultHeaderMatcher].
+func incomingHeaderMatcher(key string) (string, bool) {
+	if strings.EqualFold(key, service.APIKeyHeader) {
+		return service.APIKeyHeader, true
+	}
+
+	if strings.EqualFold(key, service.AuditorGrantHeader) {
+		return service.AuditorGrantHeader, true
+	}
+
+	if strings.EqualFold(key, service.IdempotencyKeyHeader) {
+		return service.IdempotencyKeyHeader, true
+	}
+
+	if strings.EqualFold(key, logging.RequestIDHeader) {
+		return logging.RequestIDHeader, true
+	}
+
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// outgoingHeaderMatcher returns the request ID of the gRPC backend as X-Request-Id header. All other headers are
+// prefixed with [runtime.MetadataHeaderPrefix], like grpc-gateway does by default.
+func outgoingHeaderMatcher(key string) (string, bool) {
+	if key == logging.RequestIDHeader {
+		return http.CanonicalHeaderKey(logging.RequestIDHeader), true
+	}
+
+	return runtime.MetadataHeaderPrefix + key, true
+}
+
 // RunServer starts our REST API. The REST API is a reverse proxy using grpc-gateway that
 // exposes certain gRPC calls as RESTful HTTP methods.
 func RunServer(ctx context.Context, grpcPort uint16, port uint16, serverOpts ...ServerConfigOption) (err error) {
@@ -213,14 +296,57 @@ func RunServer(ctx context.Context, grpcPort uint16, port uint16, serverOpts ...
 
 	httpPort = port
 
-	mux := runtime.NewServeMux()
+	mux := runtime.NewServeMux(
+		runtime.WithIncomingHeaderMatcher(incomingHeaderMatcher),
+		runtime.WithOutgoingHeaderMatcher(outgoingHeaderMatcher),
+	)
 
-	cnf.opts = []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	cnf.opts = []grpc.DialOption{
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+	}
+	cnf.graphql = false
+	cnf.dashboard = nil
+	cnf.grpcWeb = false
+	cnf.ingest = nil
 
 	for _, o := range serverOpts {
 		o(&cnf, mux)
 	}
 
+	// backendConn is used by all handlers that are not generated by grpc-gateway
+	backendConn, err := grpc.DialContext(ctx, fmt.Sprintf("localhost:%d", grpcPort), cnf.opts...)
+	if err != nil {
+		return fmt.Errorf("failed to connect to gRPC backend %w", err)
+	}
+	defer backendConn.Close()
+
+	// Expose the liveness and readiness probes. The latter is backed by the gRPC health service.
+	WithAdditionalHandler("GET", "/healthz", handleHealthz)(&cnf, mux)
+	WithAdditionalHandler("GET", "/readyz", handleReadyz(grpc_health_v1.NewHealthClient(backendConn)))(&cnf, mux)
+
+	// Expose the VEX export of the vulnerability-related assessment results of a cloud service
+	WithAdditionalHandler("GET", VEXPath, handleVEX(backendConn))(&cnf, mux)
+
+	// Expose the bundle of all metrics, from which assessment services can pull their implementations and
+	// configurations
+	WithAdditionalHandler("GET", MetricBundlePath, handleMetricBundle(backendConn))(&cnf, mux)
+
+	// Expose the webhook endpoint for third-party scanners, if enabled
+	if cnf.ingest != nil {
+		WithAdditionalHandler("POST", IngestPath, handleIngest(cnf.ingest, backendConn))(&cnf, mux)
+	}
+
+	if cnf.graphql {
+		h, err := handleGraphQL(backendConn)
+		if err != nil {
+			return err
+		}
+
+		WithAdditionalHandler("GET", GraphQLPath, h)(&cnf, mux)
+		WithAdditionalHandler("POST", GraphQLPath, h)(&cnf, mux)
+	}
+
 	if err := discovery.RegisterDiscoveryHandlerFromEndpoint(ctx, mux, fmt.Sprintf("localhost:%d", grpcPort), cnf.opts); err != nil {
 		return fmt.Errorf("failed to connect to discovery gRPC service %w", err)
 	}
@@ -245,15 +371,25 @@ func RunServer(ctx context.Context, grpcPort uint16, port uint16, serverOpts ...
 		return fmt.Errorf("failed to connect to evidence gRPC service %w", err)
 	}
 
+	var handler http.Handler = mux
+	if cnf.grpcWeb {
+		handler = handleGRPCWeb(backendConn, handler)
+	}
+
+	handler = handleCORS(handler)
+	if cnf.dashboard != nil {
+		handler = handleDashboard(cnf.dashboard, handler)
+	}
+
 	srv = &http.Server{
 		Addr:              fmt.Sprintf(":%d", httpPort),
-		Handler:           handleCORS(mux),
+		Handler:           handler,
 		ReadHeaderTimeout: 2 * time.Second,
 	}
 
 	// graceful shutdown
 	c := make(chan os.Signal, 1)
-	signal.Notify(c, os.Interrupt)
+	signal.Notify(c, os.Interrupt, syscall.SIGTERM)
 	go func() {
 		for range c {
 			// sig is a ^C, handle it
@@ -332,10 +468,21 @@ func handleCORS(h http.Handler) http.Handler {
 
 			// Additionally, we need to handle preflight (OPTIONS) requests to specify allowed headers and methods
 			if r.Method == "OPTIONS" && r.Header.Get("Access-Control-Request-Method") != "" {
-				w.Header().Set("Access-Control-Allow-Headers", strings.Join(cnf.cors.allowedHeaders, ","))
+				headers := cnf.cors.allowedHeaders
+				if cnf.grpcWeb {
+					// Also allow the headers sent by gRPC-web clients
+					headers = append(slices.Clip(headers), grpcWebAllowedHeaders...)
+				}
+
+				w.Header().Set("Access-Control-Allow-Headers", strings.Join(headers, ","))
 				w.Header().Set("Access-Control-Allow-Methods", strings.Join(cnf.cors.allowedMethods, ","))
 				return
 			}
+
+			// gRPC-web clients need to read the status of the call from the response headers
+			if cnf.grpcWeb && isGRPCWebRequest(r) {
+				w.Header().Set("Access-Control-Expose-Headers", strings.Join(grpcWebExposedHeaders, ","))
+			}
 		}
 
 		h.ServeHTTP(w, r)
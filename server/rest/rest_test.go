@@ -27,6 +27,7 @@ package rest
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -37,10 +38,12 @@ import (
 	"testing"
 	"time"
 
+	"clouditor.io/clouditor/v2/internal/testdata"
 	"clouditor.io/clouditor/v2/internal/testutil"
 	"clouditor.io/clouditor/v2/internal/testutil/assert"
 	"clouditor.io/clouditor/v2/internal/testutil/clitest"
 	"clouditor.io/clouditor/v2/server"
+	service_discovery "clouditor.io/clouditor/v2/service/discovery"
 	service_orchestrator "clouditor.io/clouditor/v2/service/orchestrator"
 
 	"google.golang.org/grpc"
@@ -52,14 +55,14 @@ var (
 	headers = DefaultAllowedHeaders
 
 	grpcPort uint16 = 0
+	authPort uint16 = 0
 )
 
 func TestMain(m *testing.M) {
 	var (
-		err      error
-		srv      *grpc.Server
-		sock     net.Listener
-		authPort uint16
+		err  error
+		srv  *grpc.Server
+		sock net.Listener
 	)
 
 	clitest.AutoChdir()
@@ -73,6 +76,7 @@ func TestMain(m *testing.M) {
 	sock, srv, err = server.StartGRPCServer("127.0.0.1:0",
 		server.WithJWKS(testutil.JWKSURL(authPort)),
 		server.WithOrchestrator(service_orchestrator.NewService()),
+		server.WithExperimentalDiscovery(service_discovery.NewService()),
 	)
 	if err != nil {
 		panic(err)
@@ -97,6 +101,8 @@ func TestREST(t *testing.T) {
 			WithAllowedOrigins(origins),
 			WithAllowedMethods(methods),
 			WithAllowedHeaders(headers),
+			WithMetrics(),
+			WithGraphQL(),
 			WithAdditionalHandler("GET", "/test", func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
 				_, err := w.Write([]byte("just a test"))
 				if err != nil {
@@ -125,13 +131,17 @@ func TestREST(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotEqual(t, 0, port)
 
+	token, err := testutil.AuthClientConfig(authPort).Token(context.Background())
+	assert.NoError(t, err)
+
 	type args struct {
-		origin      string
-		contentType string
-		method      string
-		url         string
-		body        io.Reader
-		preflight   bool
+		origin        string
+		contentType   string
+		method        string
+		url           string
+		body          io.Reader
+		preflight     bool
+		authenticated bool
 	}
 	tests := []struct {
 		name         string
@@ -216,6 +226,125 @@ func TestREST(t *testing.T) {
 				return assert.Equal(t, []byte("just a test"), content)
 			},
 		},
+		{
+			name: "Actual request to experimental discovery to update a resource",
+			args: args{
+				contentType: "application/json",
+				method:      "POST",
+				url:         "v1experimental/discovery/resources/my-vm",
+				body: strings.NewReader(fmt.Sprintf(`{"resource": {"cloudServiceId": "%s", "resourceType": "VirtualMachine,Compute,Resource", "properties": {"@type": "type.googleapis.com/clouditor.ontology.v1.VirtualMachine", "id": "my-vm", "name": "my-vm"}}}`,
+					testdata.MockCloudServiceID1)),
+				authenticated: true,
+			},
+			statusCode: 200,
+			wantResponse: func(t *testing.T, res *http.Response) bool {
+				content, err := io.ReadAll(res.Body)
+				if !assert.ErrorIs(t, err, nil) {
+					return false
+				}
+
+				return assert.Contains(t, string(content), `"id":"my-vm"`)
+			},
+		},
+		{
+			name: "Actual request to experimental discovery to update an invalid resource",
+			args: args{
+				contentType:   "application/json",
+				method:        "POST",
+				url:           "v1experimental/discovery/resources/my-vm",
+				body:          strings.NewReader(`{}`),
+				authenticated: true,
+			},
+			statusCode:   400,
+			wantResponse: assert.AnyValue[*http.Response],
+		},
+		{
+			name: "Actual request to experimental discovery to list graph edges",
+			args: args{
+				method:        "GET",
+				url:           "v1experimental/discovery/graph/edges",
+				authenticated: true,
+			},
+			statusCode:   200,
+			wantResponse: assert.AnyValue[*http.Response],
+		},
+		{
+			name: "Actual request to GraphQL",
+			args: args{
+				contentType:   "application/json",
+				method:        "POST",
+				url:           "v1/graphql",
+				body:          strings.NewReader(`{"query": "{ graphEdges { id } assessmentResults { id } }"}`),
+				authenticated: true,
+			},
+			statusCode: 200,
+			wantResponse: func(t *testing.T, res *http.Response) bool {
+				content, err := io.ReadAll(res.Body)
+				if !assert.ErrorIs(t, err, nil) {
+					return false
+				}
+
+				return assert.Equal(t, `{"data":{"assessmentResults":[],"graphEdges":[]}}`, strings.TrimSpace(string(content)))
+			},
+		},
+		{
+			name: "Liveness probe",
+			args: args{
+				method:    "GET",
+				url:       "healthz",
+				preflight: false,
+			},
+			statusCode: 200,
+			wantResponse: func(t *testing.T, res *http.Response) bool {
+				content, err := io.ReadAll(res.Body)
+				if !assert.ErrorIs(t, err, nil) {
+					return false
+				}
+
+				return assert.Equal(t, []byte("ok"), content)
+			},
+		},
+		{
+			name: "Readiness probe",
+			args: args{
+				method:    "GET",
+				url:       "readyz",
+				preflight: false,
+			},
+			statusCode: 200,
+			wantResponse: func(t *testing.T, res *http.Response) bool {
+				var r readiness
+
+				err := json.NewDecoder(res.Body).Decode(&r)
+				if !assert.ErrorIs(t, err, nil) {
+					return false
+				}
+
+				return assert.Equal(t, readiness{
+					Status: "SERVING",
+					Services: map[string]string{
+						"clouditor.orchestrator.v1.Orchestrator": "SERVING",
+					},
+				}, r)
+			},
+		},
+		{
+			name: "Actual request to metrics",
+			args: args{
+				method:    "GET",
+				url:       "metrics",
+				preflight: false,
+			},
+			statusCode: 200,
+			wantResponse: func(t *testing.T, res *http.Response) bool {
+				content, err := io.ReadAll(res.Body)
+				if !assert.ErrorIs(t, err, nil) {
+					return false
+				}
+
+				return assert.Contains(t, string(content), "clouditor_assessment_evidences_assessed_total")
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -236,6 +365,10 @@ func TestREST(t *testing.T) {
 			req.Header.Add("Origin", tt.args.origin)
 			req.Header.Add("Content-Type", tt.args.contentType)
 
+			if tt.args.authenticated {
+				token.SetAuthHeader(req)
+			}
+
 			if tt.args.preflight {
 				req.Header.Add("Access-Control-Request-Method", tt.args.method)
 			}
@@ -314,3 +447,29 @@ func Test_corsConfig_OriginAllowed(t *testing.T) {
 		})
 	}
 }
+
+func Test_outgoingHeaderMatcher(t *testing.T) {
+	tests := []struct {
+		name    string
+		key     string
+		wantKey string
+	}{
+		{
+			name:    "request ID",
+			key:     "x-request-id",
+			wantKey: "X-Request-Id",
+		},
+		{
+			name:    "other metadata",
+			key:     "retry-delay",
+			wantKey: "Grpc-Metadata-retry-delay",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotKey, ok := outgoingHeaderMatcher(tt.key)
+			assert.True(t, ok)
+			assert.Equal(t, tt.wantKey, gotKey)
+		})
+	}
+}
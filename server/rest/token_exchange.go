@@ -0,0 +1,215 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package rest
+
+import (
+	"crypto/ecdsa"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"clouditor.io/clouditor/v2/api"
+
+	"github.com/golang-jwt/jwt/v5"
+	oauth2 "github.com/oxisto/oauth2go"
+)
+
+// tokenExchange adds the OAuth 2.0 token exchange (RFC 8693) to the token endpoint of the embedded OAuth 2.0 server,
+// which does not support it by itself. A confidential client can exchange a token issued by the embedded server for
+// a token with fewer scopes, e.g., to restrict a service token to the RPCs this service needs. The client is recorded
+// as actor in the "act" claim of the new token. All other grants are handled by the embedded server.
+type tokenExchange struct {
+	srv  *oauth2.AuthorizationServer
+	keys func() map[int]*ecdsa.PrivateKey
+	next http.Handler
+}
+
+// ServeHTTP implements [http.Handler].
+func (te *tokenExchange) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		oauth2.Error(w, oauth2.ErrorInvalidRequest, http.StatusBadRequest)
+		return
+	}
+
+	if r.PostForm.Get("grant_type") != api.GrantTypeTokenExchange {
+		te.next.ServeHTTP(w, r)
+		return
+	}
+
+	client, err := te.client(r)
+	if err != nil {
+		w.Header().Set("WWW-Authenticate", "Basic")
+		oauth2.Error(w, oauth2.ErrorInvalidClient, http.StatusUnauthorized)
+		return
+	}
+
+	if r.PostForm.Get("subject_token_type") != api.TokenTypeAccessToken {
+		oauth2.Error(w, oauth2.ErrorInvalidRequest, http.StatusBadRequest)
+		return
+	}
+
+	subject, err := te.parse(r.PostForm.Get("subject_token"))
+	if err != nil {
+		log.Debugf("Rejecting token exchange of client %s: %v", client.ClientID, err)
+		oauth2.Error(w, oauth2.ErrorInvalidGrant, http.StatusBadRequest)
+		return
+	}
+
+	scopes, ok := exchangedScopes(subject, strings.Fields(r.PostForm.Get("scope")))
+	if !ok {
+		oauth2.Error(w, "invalid_scope", http.StatusBadRequest)
+		return
+	}
+
+	token, expiry, err := te.issue(subject, client.ClientID, scopes)
+	if err != nil {
+		http.Error(w, "error while creating JWT", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"access_token":      token,
+		"issued_token_type": api.TokenTypeAccessToken,
+		"token_type":        "Bearer",
+		"expires_in":        int(time.Until(expiry).Seconds()),
+		"scope":             strings.Join(scopes, " "),
+	})
+}
+
+// client authenticates the confidential client using HTTP basic authentication.
+func (te *tokenExchange) client(r *http.Request) (client *oauth2.Client, err error) {
+	id, secret, ok := r.BasicAuth()
+	if !ok {
+		return nil, oauth2.ErrInvalidBasicAuthentication
+	}
+
+	// Clients should URL-encode their credentials according to RFC 6749
+	if s, err := url.QueryUnescape(id); err == nil {
+		id = s
+	}
+	if s, err := url.QueryUnescape(secret); err == nil {
+		secret = s
+	}
+
+	client, err = te.srv.GetClient(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if client.Public() || client.ClientSecret != secret {
+		return nil, oauth2.ErrClientNotFound
+	}
+
+	return client, nil
+}
+
+// parse validates a token issued by the embedded server and returns its claims.
+func (te *tokenExchange) parse(token string) (claims jwt.MapClaims, err error) {
+	_, err = jwt.ParseWithClaims(token, &claims, func(t *jwt.Token) (interface{}, error) {
+		kid, _ := t.Header["kid"].(string)
+
+		id, err := strconv.Atoi(kid)
+		if err != nil {
+			return nil, fmt.Errorf("invalid key ID %q", kid)
+		}
+
+		key, ok := te.srv.PublicKeys()[id]
+		if !ok {
+			return nil, fmt.Errorf("unknown key ID %q", kid)
+		}
+
+		return key, nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodES256.Alg()}), jwt.WithExpirationRequired())
+
+	return claims, err
+}
+
+// issue creates a new token for the subject of the claims with the given scopes. The new token does not outlive the
+// subject token.
+func (te *tokenExchange) issue(subject jwt.MapClaims, actor string, scopes []string) (token string, expiry time.Time, err error) {
+	const kid = 0
+
+	key, ok := te.keys()[kid]
+	if !ok {
+		return "", expiry, fmt.Errorf("missing signing key %d", kid)
+	}
+
+	expiry = time.Now().Add(oauth2.DefaultExpireIn)
+	if exp, err := subject.GetExpirationTime(); err == nil && exp != nil && exp.Before(expiry) {
+		expiry = exp.Time
+	}
+
+	act := map[string]any{"sub": actor}
+	if prior, ok := subject["act"]; ok {
+		act["act"] = prior
+	}
+
+	sub, _ := subject.GetSubject()
+
+	t := jwt.NewWithClaims(jwt.SigningMethodES256, jwt.MapClaims{
+		"sub":   sub,
+		"exp":   jwt.NewNumericDate(expiry),
+		"iat":   jwt.NewNumericDate(time.Now()),
+		"scope": strings.Join(scopes, " "),
+		"act":   act,
+	})
+	t.Header["kid"] = strconv.Itoa(kid)
+
+	token, err = t.SignedString(key)
+
+	return token, expiry, err
+}
+
+// exchangedScopes returns the scopes of the exchanged token. If the subject token is already restricted to scopes,
+// only a subset of them can be requested, so that a token can never be exchanged for one with more privileges.
+func exchangedScopes(subject jwt.MapClaims, requested []string) (scopes []string, ok bool) {
+	s, _ := subject["scope"].(string)
+	current := strings.Fields(s)
+
+	if len(requested) == 0 {
+		return current, true
+	}
+
+	if len(current) == 0 {
+		return requested, true
+	}
+
+	for _, scope := range requested {
+		if !slices.Contains(current, scope) {
+			return nil, false
+		}
+	}
+
+	return requested, true
+}
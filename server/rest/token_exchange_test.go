@@ -0,0 +1,133 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package rest
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"clouditor.io/clouditor/v2/api"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/service"
+
+	"github.com/golang-jwt/jwt/v5"
+	oauth2 "github.com/oxisto/oauth2go"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+// newTokenExchangeServer starts an embedded OAuth 2.0 server with token exchange and a confidential client.
+func newTokenExchangeServer(t *testing.T) (ts *httptest.Server, key *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	keys := func() map[int]*ecdsa.PrivateKey {
+		return map[int]*ecdsa.PrivateKey{0: key}
+	}
+
+	authSrv := oauth2.NewServer("",
+		oauth2.WithSigningKeysFunc(keys),
+		oauth2.WithClient("clouditor", "secret", ""),
+	)
+
+	ts = httptest.NewServer(&tokenExchange{srv: authSrv, keys: keys, next: authSrv.Handler})
+	t.Cleanup(ts.Close)
+
+	return ts, key
+}
+
+func TestTokenExchange(t *testing.T) {
+	ts, key := newTokenExchangeServer(t)
+
+	authorizer := api.NewOAuthAuthorizerFromTokenExchange(&clientcredentials.Config{
+		ClientID:     "clouditor",
+		ClientSecret: "secret",
+		TokenURL:     ts.URL + "/token",
+	}, service.RoleAssessmentService)
+
+	token, err := authorizer.Token()
+	assert.NoError(t, err)
+
+	var claims jwt.MapClaims
+	_, err = jwt.ParseWithClaims(token.AccessToken, &claims, func(t *jwt.Token) (interface{}, error) {
+		return &key.PublicKey, nil
+	})
+	assert.NoError(t, err)
+	assert.Equal[any](t, "clouditor", claims["sub"])
+	assert.Equal[any](t, service.RoleAssessmentService, claims["scope"])
+	assert.Equal[any](t, map[string]any{"sub": "clouditor"}, claims["act"])
+	assert.True(t, token.Valid())
+}
+
+func TestTokenExchange_invalid(t *testing.T) {
+	ts, _ := newTokenExchangeServer(t)
+
+	// Wrong client secret
+	_, err := api.NewOAuthAuthorizerFromTokenExchange(&clientcredentials.Config{
+		ClientID:     "clouditor",
+		ClientSecret: "other",
+		TokenURL:     ts.URL + "/token",
+	}, service.RoleAssessmentService).Token()
+	assert.Error(t, err)
+
+	// Invalid subject token
+	req, err := http.NewRequest(http.MethodPost, ts.URL+"/token", strings.NewReader(url.Values{
+		"grant_type":         {api.GrantTypeTokenExchange},
+		"subject_token":      {"what"},
+		"subject_token_type": {api.TokenTypeAccessToken},
+	}.Encode()))
+	assert.NoError(t, err)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth("clouditor", "secret")
+
+	res, err := http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	defer res.Body.Close()
+
+	var body map[string]string
+	assert.NoError(t, json.NewDecoder(res.Body).Decode(&body))
+	assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+	assert.Equal(t, oauth2.ErrorInvalidGrant, body["error"])
+}
+
+func Test_exchangedScopes(t *testing.T) {
+	scopes, ok := exchangedScopes(jwt.MapClaims{}, []string{"assessment-service"})
+	assert.True(t, ok)
+	assert.Equal(t, []string{"assessment-service"}, scopes)
+
+	scopes, ok = exchangedScopes(jwt.MapClaims{"scope": "assessment-service discovery-service"}, nil)
+	assert.True(t, ok)
+	assert.Equal(t, []string{"assessment-service", "discovery-service"}, scopes)
+
+	_, ok = exchangedScopes(jwt.MapClaims{"scope": "discovery-service"}, []string{"assessment-service"})
+	assert.False(t, ok)
+}
@@ -0,0 +1,198 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	"clouditor.io/clouditor/v2/api/assessment"
+	"clouditor.io/clouditor/v2/api/evidence"
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/internal/util"
+	"clouditor.io/clouditor/v2/internal/vex"
+
+	"github.com/google/uuid"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// VEXPath is the path of the VEX export of a cloud service in the REST server. The format is specified in the
+// "format" query parameter, which is either "cyclonedx" (default) or "csaf".
+const VEXPath = "/v1/orchestrator/cloud_services/{cloud_service_id}/vex"
+
+// vexExporter creates VEX documents out of the assessment results of the vulnerability metric and their evidences.
+type vexExporter struct {
+	orchestrator orchestrator.OrchestratorClient
+	evidence     evidence.EvidenceStoreClient
+}
+
+// handleVEX returns the handler of our VEX export. It forwards the authorization of the incoming request to the gRPC
+// backend reachable over cc.
+func handleVEX(cc grpc.ClientConnInterface) func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	exp := &vexExporter{
+		orchestrator: orchestrator.NewOrchestratorClient(cc),
+		evidence:     evidence.NewEvidenceStoreClient(cc),
+	}
+
+	return exp.handler
+}
+
+func (exp *vexExporter) handler(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	var (
+		format = vex.Format(r.URL.Query().Get("format"))
+		doc    any
+	)
+
+	if format == "" {
+		format = vex.FormatCycloneDX
+	} else if format != vex.FormatCycloneDX && format != vex.FormatCSAF {
+		http.Error(w, fmt.Sprintf("invalid format %q: must be %q or %q", format, vex.FormatCycloneDX, vex.FormatCSAF), http.StatusBadRequest)
+		return
+	}
+
+	ctx := r.Context()
+	if auth := r.Header.Get("Authorization"); auth != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", auth)
+	}
+
+	cs, err := exp.orchestrator.GetCloudService(ctx, &orchestrator.GetCloudServiceRequest{CloudServiceId: params["cloud_service_id"]})
+	if err != nil {
+		writeStatusError(w, err)
+		return
+	}
+
+	scans, err := exp.scans(ctx, cs.Id)
+	if err != nil {
+		writeStatusError(w, err)
+		return
+	}
+
+	var (
+		statements = vex.NewStatements(scans)
+		meta       = vex.Metadata{
+			ID:        uuid.NewString(),
+			Title:     fmt.Sprintf("Vulnerability status of %s", cs.Name),
+			Timestamp: time.Now().UTC().Truncate(time.Second),
+		}
+	)
+
+	switch format {
+	case vex.FormatCSAF:
+		doc = vex.NewCSAF(meta, statements)
+		w.Header().Set("Content-Type", "application/json")
+	default:
+		doc = vex.NewCycloneDX(meta, statements)
+		w.Header().Set("Content-Type", "application/vnd.cyclonedx+json")
+	}
+
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", fmt.Sprintf("%s.%s.json", cs.Id, format)))
+	_ = json.NewEncoder(w).Encode(doc)
+}
+
+// scans retrieves the latest assessment results of the vulnerability metric for each resource of the cloud service
+// and extracts the reported vulnerabilities out of their evidences.
+func (exp *vexExporter) scans(ctx context.Context, cloudServiceID string) (scans []vex.Scan, err error) {
+	var (
+		req = &orchestrator.ListAssessmentResultsRequest{
+			Filter: &orchestrator.Filter{
+				CloudServiceId: &cloudServiceID,
+				MetricId:       util.Ref(vex.MetricNoKnownVulnerabilities),
+			},
+			LatestByResourceId: util.Ref(true),
+		}
+		res *orchestrator.ListAssessmentResultsResponse
+	)
+
+	for {
+		res, err = exp.orchestrator.ListAssessmentResults(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, result := range res.Results {
+			scan, err := exp.scan(ctx, result)
+			if err != nil {
+				return nil, err
+			}
+
+			scans = append(scans, scan)
+		}
+
+		req.PageToken = res.NextPageToken
+		if req.PageToken == "" {
+			return scans, nil
+		}
+	}
+}
+
+// scan extracts the reported vulnerabilities from the evidence of the result. Vulnerabilities can either be part of
+// the resource properties or of the raw output of the scanner.
+func (exp *vexExporter) scan(ctx context.Context, result *assessment.AssessmentResult) (scan vex.Scan, err error) {
+	scan = vex.Scan{
+		ProductID:    result.ResourceId,
+		ProductTypes: result.ResourceTypes,
+		Timestamp:    result.Timestamp.AsTime(),
+	}
+
+	// Compliant resources have no known vulnerabilities
+	if result.Compliant {
+		return scan, nil
+	}
+
+	ev, err := exp.evidence.GetEvidence(ctx, &evidence.GetEvidenceRequest{EvidenceId: result.EvidenceId})
+	if err != nil {
+		return scan, err
+	}
+
+	if ev.Resource != nil {
+		if b, err := protojson.Marshal(ev.Resource); err == nil {
+			scan.Vulnerabilities = vex.Vulnerabilities(b)
+		}
+	}
+
+	if raw := ev.GetRaw(); raw != "" {
+		scan.Vulnerabilities = append(scan.Vulnerabilities, vex.Vulnerabilities([]byte(raw))...)
+	}
+
+	slices.Sort(scan.Vulnerabilities)
+	scan.Vulnerabilities = slices.Compact(scan.Vulnerabilities)
+
+	return scan, nil
+}
+
+// writeStatusError writes the gRPC status of err as HTTP error.
+func writeStatusError(w http.ResponseWriter, err error) {
+	st := status.Convert(err)
+	http.Error(w, st.Message(), runtime.HTTPStatusFromCode(st.Code()))
+}
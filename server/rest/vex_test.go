@@ -0,0 +1,195 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"clouditor.io/clouditor/v2/api/assessment"
+	"clouditor.io/clouditor/v2/api/evidence"
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/util"
+	"clouditor.io/clouditor/v2/internal/vex"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// mockVEXBackend implements the gRPC clients used by the VEX export. The first result is compliant, the second one
+// reports a vulnerability in the raw output of its evidence. Results are returned in pages of one.
+type mockVEXBackend struct {
+	orchestrator.OrchestratorClient
+	evidence.EvidenceStoreClient
+
+	authorization []string
+	filter        *orchestrator.Filter
+}
+
+var mockVEXResults = []*assessment.AssessmentResult{
+	{
+		Id:             testdata.MockAssessmentResult1ID,
+		Timestamp:      timestamppb.Now(),
+		MetricId:       vex.MetricNoKnownVulnerabilities,
+		Compliant:      true,
+		EvidenceId:     testdata.MockEvidenceID1,
+		ResourceId:     testdata.MockResourceID1,
+		ResourceTypes:  []string{"VirtualMachine", "Compute", "Resource"},
+		CloudServiceId: testdata.MockCloudServiceID1,
+	},
+	{
+		Id:             testdata.MockAssessmentResult2ID,
+		Timestamp:      timestamppb.Now(),
+		MetricId:       vex.MetricNoKnownVulnerabilities,
+		Compliant:      false,
+		EvidenceId:     testdata.MockEvidenceID2,
+		ResourceId:     testdata.MockResourceID2,
+		ResourceTypes:  []string{"ContainerImage", "Image", "Resource"},
+		CloudServiceId: testdata.MockCloudServiceID1,
+	},
+}
+
+func (m *mockVEXBackend) GetCloudService(ctx context.Context, req *orchestrator.GetCloudServiceRequest, _ ...grpc.CallOption) (*orchestrator.CloudService, error) {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	m.authorization = append(m.authorization, md.Get("authorization")...)
+
+	if req.CloudServiceId != testdata.MockCloudServiceID1 {
+		return nil, status.Error(codes.NotFound, "service not found")
+	}
+
+	return &orchestrator.CloudService{Id: testdata.MockCloudServiceID1, Name: testdata.MockCloudServiceName1}, nil
+}
+
+func (m *mockVEXBackend) ListAssessmentResults(_ context.Context, req *orchestrator.ListAssessmentResultsRequest, _ ...grpc.CallOption) (*orchestrator.ListAssessmentResultsResponse, error) {
+	m.filter = req.Filter
+
+	if req.PageToken == "" {
+		return &orchestrator.ListAssessmentResultsResponse{Results: mockVEXResults[:1], NextPageToken: "next"}, nil
+	}
+
+	return &orchestrator.ListAssessmentResultsResponse{Results: mockVEXResults[1:]}, nil
+}
+
+func (m *mockVEXBackend) GetEvidence(_ context.Context, req *evidence.GetEvidenceRequest, _ ...grpc.CallOption) (*evidence.Evidence, error) {
+	if req.EvidenceId != testdata.MockEvidenceID2 {
+		return nil, status.Error(codes.NotFound, "evidence not found")
+	}
+
+	return &evidence.Evidence{
+		Id:  testdata.MockEvidenceID2,
+		Raw: util.Ref(`{"Results":[{"Vulnerabilities":[{"VulnerabilityID":"CVE-2024-0001"}]}]}`),
+	}, nil
+}
+
+func Test_vexExporter_handler(t *testing.T) {
+	tests := []struct {
+		name     string
+		id       string
+		query    string
+		wantCode int
+		want     func(t *testing.T, body string) bool
+	}{
+		{
+			name:     "cyclonedx",
+			id:       testdata.MockCloudServiceID1,
+			wantCode: http.StatusOK,
+			want: func(t *testing.T, body string) bool {
+				var bom vex.CycloneDX
+
+				assert.NoError(t, json.Unmarshal([]byte(body), &bom))
+				assert.Equal(t, 2, len(bom.Components))
+				assert.Equal(t, 2, len(bom.Vulnerabilities))
+				assert.Equal(t, vex.CycloneDXStateExploitable, bom.Vulnerabilities[0].Analysis.State)
+				assert.Equal(t, testdata.MockResourceID2, bom.Vulnerabilities[0].Affects[0].Ref)
+				return assert.Equal(t, vex.CycloneDXStateNotAffected, bom.Vulnerabilities[1].Analysis.State)
+			},
+		},
+		{
+			name:     "csaf",
+			id:       testdata.MockCloudServiceID1,
+			query:    "?format=csaf",
+			wantCode: http.StatusOK,
+			want: func(t *testing.T, body string) bool {
+				var doc vex.CSAF
+
+				assert.NoError(t, json.Unmarshal([]byte(body), &doc))
+				assert.Equal(t, "Vulnerability status of "+testdata.MockCloudServiceName1, doc.Document.Title)
+				return assert.Equal(t, vex.CSAFProductStatus{
+					KnownAffected:    []string{testdata.MockResourceID2},
+					KnownNotAffected: []string{testdata.MockResourceID1},
+				}, doc.Vulnerabilities[0].ProductStatus)
+			},
+		},
+		{
+			name:     "invalid format",
+			id:       testdata.MockCloudServiceID1,
+			query:    "?format=spdx",
+			wantCode: http.StatusBadRequest,
+			want: func(t *testing.T, body string) bool {
+				return assert.Contains(t, body, "invalid format")
+			},
+		},
+		{
+			name:     "unknown cloud service",
+			id:       testdata.MockCloudServiceID2,
+			wantCode: http.StatusNotFound,
+			want: func(t *testing.T, body string) bool {
+				return assert.Contains(t, body, "service not found")
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				backend = &mockVEXBackend{}
+				exp     = &vexExporter{orchestrator: backend, evidence: backend}
+				rec     = httptest.NewRecorder()
+				req     = httptest.NewRequest(http.MethodGet, strings.ReplaceAll(VEXPath, "{cloud_service_id}", tt.id)+tt.query, nil)
+			)
+
+			req.Header.Set("Authorization", "Bearer token")
+
+			exp.handler(rec, req, map[string]string{"cloud_service_id": tt.id})
+
+			assert.Equal(t, tt.wantCode, rec.Code)
+			tt.want(t, rec.Body.String())
+
+			if tt.wantCode == http.StatusOK {
+				assert.Equal(t, []string{"Bearer token"}, backend.authorization)
+				assert.Equal(t, vex.MetricNoKnownVulnerabilities, backend.filter.GetMetricId())
+			}
+		})
+	}
+}
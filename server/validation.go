@@ -0,0 +1,84 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package server
+
+import (
+	"context"
+
+	"clouditor.io/clouditor/v2/api"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+// UnaryValidationInterceptor is a [grpc.UnaryServerInterceptor] that validates each request according to its
+// protovalidate constraints before the handler is invoked. All constraint violations of the request are returned at
+// once as [errdetails.BadRequest] field violations, see [api.Validate]. Therefore, the handlers do not need to validate
+// their requests themselves.
+//
+// [errdetails.BadRequest]: https://pkg.go.dev/google.golang.org/genproto/googleapis/rpc/errdetails#BadRequest
+func UnaryValidationInterceptor(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+	m, ok := req.(proto.Message)
+	if !ok {
+		return handler(ctx, req)
+	}
+
+	if err = api.Validate(m); err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+// StreamValidationInterceptor is a [grpc.StreamServerInterceptor] that validates the request of server-streaming RPCs,
+// e.g., subscriptions, in the same way as [UnaryValidationInterceptor]. Messages of client-streaming RPCs are not
+// validated, since our stream handlers report invalid messages within the stream instead of aborting it. They validate
+// each message themselves.
+func StreamValidationInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if info.IsClientStream {
+		return handler(srv, ss)
+	}
+
+	return handler(srv, &validatingServerStream{ServerStream: ss})
+}
+
+// validatingServerStream wraps a [grpc.ServerStream] and validates each received message.
+type validatingServerStream struct {
+	grpc.ServerStream
+}
+
+// RecvMsg receives a message and validates it.
+func (s *validatingServerStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	if msg, ok := m.(proto.Message); ok {
+		return api.Validate(msg)
+	}
+
+	return nil
+}
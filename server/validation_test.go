@@ -0,0 +1,81 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package server
+
+import (
+	"context"
+	"testing"
+
+	"clouditor.io/clouditor/v2/api"
+	"clouditor.io/clouditor/v2/api/evidence"
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUnaryValidationInterceptor(t *testing.T) {
+	var (
+		calls   int
+		handler = func(ctx context.Context, req any) (any, error) {
+			calls++
+			return nil, nil
+		}
+	)
+
+	// All violations are returned at once and the handler is not called
+	_, err := UnaryValidationInterceptor(context.Background(), &orchestrator.UpdateAssessmentToolRequest{
+		Tool: &orchestrator.AssessmentTool{Id: "not-a-uuid"},
+	}, &grpc.UnaryServerInfo{}, handler)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Equal(t, 2, len(api.FieldViolations(err)))
+	assert.Equal(t, 0, calls)
+
+	// Valid requests are passed to the handler
+	_, err = UnaryValidationInterceptor(context.Background(), &evidence.GetEvidenceRequest{
+		EvidenceId: testdata.MockEvidenceID1,
+	}, &grpc.UnaryServerInfo{}, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, calls)
+}
+
+func TestStreamValidationInterceptor(t *testing.T) {
+	var handler = func(srv any, stream grpc.ServerStream) error {
+		// The mock stream leaves the message empty, which is invalid
+		return stream.RecvMsg(&evidence.GetEvidenceRequest{})
+	}
+
+	// The request of server-streaming RPCs is validated
+	err := StreamValidationInterceptor(nil, &mockServerStream{}, &grpc.StreamServerInfo{IsServerStream: true}, handler)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Messages of client-streaming RPCs are validated by the handler itself
+	err = StreamValidationInterceptor(nil, &mockServerStream{}, &grpc.StreamServerInfo{IsClientStream: true}, handler)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,88 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package service
+
+import (
+	"context"
+	"slices"
+
+	"clouditor.io/clouditor/v2/api"
+)
+
+// APIKeyHeader is the (lower-case) header that contains the API key of a machine collector.
+const APIKeyHeader = "x-api-key"
+
+// APIKeyMethods contains the RPCs that can be called using an API key. These are only the ones that submit evidences.
+var APIKeyMethods = []string{
+	"/clouditor.assessment.v1.Assessment/AssessEvidence",
+	"/clouditor.assessment.v1.Assessment/AssessEvidences",
+	"/clouditor.evidence.v1.EvidenceStore/StoreEvidence",
+	"/clouditor.evidence.v1.EvidenceStore/StoreEvidences",
+}
+
+// APIKeyVerifier is an interface that verifies an API key, e.g., by the Orchestrator, and returns the cloud services
+// the API key is allowed to submit evidences for.
+type APIKeyVerifier interface {
+	VerifyAPIKey(ctx context.Context, key string) (cloudServiceIDs []string, err error)
+}
+
+// apiKeyScopeKey is the context key for the cloud services of a verified API key.
+type apiKeyScopeKey struct{}
+
+// WithAPIKeyScope returns a copy of ctx, which marks the request as authenticated by an API key for the given cloud
+// services.
+func WithAPIKeyScope(ctx context.Context, cloudServiceIDs []string) context.Context {
+	return context.WithValue(ctx, apiKeyScopeKey{}, cloudServiceIDs)
+}
+
+// APIKeyScopeFromContext retrieves the cloud services of the API key the request was authenticated with. If the
+// request was not authenticated by an API key, ok is false.
+func APIKeyScopeFromContext(ctx context.Context) (cloudServiceIDs []string, ok bool) {
+	if ctx == nil {
+		return nil, false
+	}
+
+	cloudServiceIDs, ok = ctx.Value(apiKeyScopeKey{}).([]string)
+
+	return
+}
+
+// CheckAPIKeyScope checks whether the request is within the scope of the API key the context was authenticated with.
+// The request needs to implement [api.CloudServiceRequest] and its cloud service needs to be one of the API key. If
+// the context was not authenticated by an API key, all requests are within scope.
+func CheckAPIKeyScope(ctx context.Context, req any) error {
+	scope, ok := APIKeyScopeFromContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	csr, ok := req.(api.CloudServiceRequest)
+	if !ok || !slices.Contains(scope, csr.GetCloudServiceId()) {
+		return ErrPermissionDenied
+	}
+
+	return nil
+}
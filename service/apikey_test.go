@@ -0,0 +1,110 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	"clouditor.io/clouditor/v2/api/assessment"
+	"clouditor.io/clouditor/v2/api/evidence"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+)
+
+func TestCheckAPIKeyScope(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req any
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr assert.WantErr
+	}{
+		{
+			name: "not authenticated by API key",
+			args: args{
+				ctx: context.Background(),
+				req: &assessment.AssessEvidenceRequest{},
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "cloud service of API key",
+			args: args{
+				ctx: WithAPIKeyScope(context.Background(), []string{testdata.MockCloudServiceID1}),
+				req: &assessment.AssessEvidenceRequest{
+					Evidence: &evidence.Evidence{CloudServiceId: testdata.MockCloudServiceID1},
+				},
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "other cloud service",
+			args: args{
+				ctx: WithAPIKeyScope(context.Background(), []string{testdata.MockCloudServiceID1}),
+				req: &assessment.AssessEvidenceRequest{
+					Evidence: &evidence.Evidence{CloudServiceId: testdata.MockCloudServiceID2},
+				},
+			},
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, ErrPermissionDenied)
+			},
+		},
+		{
+			name: "no cloud service request",
+			args: args{
+				ctx: WithAPIKeyScope(context.Background(), []string{testdata.MockCloudServiceID1}),
+				req: &assessment.CalculateComplianceRequest{},
+			},
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, ErrPermissionDenied)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.wantErr(t, CheckAPIKeyScope(tt.args.ctx, tt.args.req))
+		})
+	}
+}
+
+func TestAuthorizationStrategyJWT_AllowedCloudServices_apiKey(t *testing.T) {
+	a := &AuthorizationStrategyJWT{CloudServicesKey: TestCustomClaims, AllowAllKey: TestAllowAllClaims}
+
+	all, list := a.AllowedCloudServices(WithAPIKeyScope(TestContextAllowAll, []string{testdata.MockCloudServiceID2}))
+	assert.False(t, all)
+	assert.Equal(t, []string{testdata.MockCloudServiceID2}, list)
+}
+
+func TestRBAC_Authorize_apiKey(t *testing.T) {
+	r := NewRBAC(&AuthorizationStrategyJWT{})
+	ctx := WithAPIKeyScope(context.Background(), []string{testdata.MockCloudServiceID1})
+
+	assert.NoError(t, r.Authorize(ctx, "/clouditor.assessment.v1.Assessment/AssessEvidence", nil))
+	assert.ErrorIs(t, r.Authorize(ctx, "/clouditor.orchestrator.v1.Orchestrator/ListCloudServices", nil), ErrPermissionDenied)
+}
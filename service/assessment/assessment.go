@@ -40,6 +40,7 @@ import (
 	"clouditor.io/clouditor/v2/api/ontology"
 	"clouditor.io/clouditor/v2/api/orchestrator"
 	"clouditor.io/clouditor/v2/internal/logging"
+	"clouditor.io/clouditor/v2/internal/telemetry"
 	"clouditor.io/clouditor/v2/internal/util"
 	"clouditor.io/clouditor/v2/policies"
 	"clouditor.io/clouditor/v2/service"
@@ -51,6 +52,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -67,11 +69,6 @@ const (
 	EvictionTime = time.Hour * 1
 )
 
-type cachedConfiguration struct {
-	cachedAt time.Time
-	*assessment.MetricConfiguration
-}
-
 // Service is an implementation of the Clouditor Assessment service. It should not be used directly,
 // but rather the NewService constructor should be used. It implements the AssessmentServer interface.
 type Service struct {
@@ -83,6 +80,8 @@ type Service struct {
 	// evidenceStoreStream sends evidences to the Evidence Store
 	evidenceStoreStreams *api.StreamsOf[evidence.EvidenceStore_StoreEvidencesClient, *evidence.StoreEvidenceRequest]
 	evidenceStore        *api.RPCConnection[evidence.EvidenceStoreClient]
+	// evidenceCompressor is the name of the gRPC compressor used for the evidence store stream, if any
+	evidenceCompressor string
 
 	// orchestratorStream sends assessment results to the Orchestrator
 	orchestratorStreams *api.StreamsOf[orchestrator.Orchestrator_StoreAssessmentResultsClient, *orchestrator.StoreAssessmentResultRequest]
@@ -95,11 +94,9 @@ type Service struct {
 	// hookMutex is used for (un)locking result hook calls
 	hookMutex sync.RWMutex
 
-	// cachedConfigurations holds cached metric configurations for faster access with key being the corresponding
-	// metric name
-	cachedConfigurations map[string]cachedConfiguration
-	// TODO(oxisto): combine with hookMutex and replace with a generic version of a mutex'd map
-	confMutex sync.Mutex
+	// cache holds cached metric configurations and metric versions for faster access, so that we can record the
+	// version in the assessment results
+	cache ConfigurationCache
 
 	authz service.AuthorizationStrategy
 
@@ -108,6 +105,29 @@ type Service struct {
 
 	// evalPkg specifies the package used for the evaluation engine
 	evalPkg string
+
+	// snapshot contains the assessed resources, so that metrics can access related resources. It is only set, if
+	// configured using [WithResourceSnapshot].
+	snapshot *policies.ResourceSnapshot
+
+	// bundle contains the latest metric bundle of the orchestrator. It is only set, if configured using
+	// [WithMetricBundle].
+	bundle *metricBundle
+
+	// assuranceLevel is the selected assurance level. Metrics with a higher assurance level are skipped. It is only set,
+	// if configured using [WithAssuranceLevel].
+	assuranceLevel string
+
+	// mergePolicies contains the merge policy of each resource type, whose evidences of multiple tools are merged.
+	// They are only set, if configured using [WithEvidenceMergePolicy].
+	mergePolicies map[string]MergePolicy
+
+	// tracks contains the latest evidence of each tool for resources with a merge policy
+	tracks *evidenceTracks
+
+	// policies contains the metric implementations of a local policies directory. It is only set, if configured using
+	// [WithPolicyDirectory].
+	policies *policyWatcher
 }
 
 const (
@@ -133,6 +153,14 @@ func WithEvidenceStoreAddress(address string, opts ...grpc.DialOption) service.O
 	}
 }
 
+// WithEvidenceStoreCompression is an option to compress the evidences sent to the evidence store using the gRPC
+// compressor with the given name, e.g., [api.CompressorZstd].
+func WithEvidenceStoreCompression(compressor string) service.Option[Service] {
+	return func(svc *Service) {
+		svc.evidenceCompressor = compressor
+	}
+}
+
 // WithOrchestratorAddress is an option to configure the orchestrator gRPC address.
 func WithOrchestratorAddress(target string, opts ...grpc.DialOption) service.Option[Service] {
 	return func(svc *Service) {
@@ -158,6 +186,14 @@ func WithAuthorizer(auth api.Authorizer) service.Option[Service] {
 	}
 }
 
+// WithConfigurationCache is an option to configure the cache of metric configurations and metric versions, e.g., a
+// cache shared by multiple replicas created using [NewRedisCache]. By default, a local in-memory cache is used.
+func WithConfigurationCache(cache ConfigurationCache) service.Option[Service] {
+	return func(svc *Service) {
+		svc.cache = cache
+	}
+}
+
 // WithRegoPackageName is an option to configure the Rego package name
 func WithRegoPackageName(pkg string) service.Option[Service] {
 	return func(s *Service) {
@@ -165,6 +201,23 @@ func WithRegoPackageName(pkg string) service.Option[Service] {
 	}
 }
 
+// WithResourceSnapshot is an option to keep a snapshot of all assessed resources, so that metrics spanning multiple
+// resources can access the resources related to the assessed one, e.g., the block storage of a virtual machine, using
+// the Rego function clouditor.resource(id).
+func WithResourceSnapshot() service.Option[Service] {
+	return func(svc *Service) {
+		svc.snapshot = policies.NewResourceSnapshot()
+	}
+}
+
+// WithAssuranceLevel is an option to only assess metrics up to the given assurance level, e.g., "substantial" for the
+// EUCS. Metrics without an assurance level are always assessed.
+func WithAssuranceLevel(level string) service.Option[Service] {
+	return func(svc *Service) {
+		svc.assuranceLevel = level
+	}
+}
+
 // WithAuthorizationStrategy is an option that configures an authorization strategy.
 func WithAuthorizationStrategy(authz service.AuthorizationStrategy) service.Option[Service] {
 	return func(svc *Service) {
@@ -177,7 +230,7 @@ func NewService(opts ...service.Option[Service]) *Service {
 	svc := &Service{
 		evidenceStoreStreams: api.NewStreamsOf(api.WithLogger[evidence.EvidenceStore_StoreEvidencesClient, *evidence.StoreEvidenceRequest](log)),
 		orchestratorStreams:  api.NewStreamsOf(api.WithLogger[orchestrator.Orchestrator_StoreAssessmentResultsClient, *orchestrator.StoreAssessmentResultRequest](log)),
-		cachedConfigurations: make(map[string]cachedConfiguration),
+		cache:                newMemoryCache(),
 		evidenceStore:        api.NewRPCConnection(DefaultEvidenceStoreAddress, evidence.NewEvidenceStoreClient),
 		orchestrator:         api.NewRPCConnection(DefaultOrchestratorAddress, orchestrator.NewOrchestratorClient),
 	}
@@ -193,13 +246,28 @@ func NewService(opts ...service.Option[Service]) *Service {
 	}
 
 	// Initialize the policy evaluator after options are set
-	svc.pe = policies.NewRegoEval(policies.WithPackageName(svc.evalPkg))
+	peOpts := []policies.RegoEvalOption{policies.WithPackageName(svc.evalPkg)}
+	if svc.snapshot != nil {
+		peOpts = append(peOpts, policies.WithResourceSnapshot(svc.snapshot))
+	}
+
+	svc.pe = policies.NewRegoEval(peOpts...)
 
 	// Default to an allow-all authorization strategy
 	if svc.authz == nil {
 		svc.authz = &service.AuthorizationStrategyAllowAll{}
 	}
 
+	if svc.bundle != nil {
+		svc.startMetricBundle()
+	}
+
+	if svc.policies != nil {
+		if err := svc.startPolicyWatcher(); err != nil {
+			log.Errorf("Could not watch policies directory %s: %v", svc.policies.dir, err)
+		}
+	}
+
 	return svc
 }
 
@@ -207,11 +275,17 @@ func NewService(opts ...service.Option[Service]) *Service {
 func (svc *Service) AssessEvidence(ctx context.Context, req *assessment.AssessEvidenceRequest) (resp *assessment.AssessEvidenceResponse, err error) {
 	resp = &assessment.AssessEvidenceResponse{}
 
-	// Validate request
-	err = api.Validate(req)
-	if err != nil {
-		return nil, err
-	}
+	// Continue the trace of the sender, e.g., the discovery
+	ctx, span := telemetry.StartSpan(telemetry.ExtractTraceContext(ctx, req.GetTraceContext()), "assessment.AssessEvidence",
+		telemetry.CloudServiceIDKey.String(req.GetEvidence().GetCloudServiceId()),
+		telemetry.EvidenceIDKey.String(req.GetEvidence().GetId()),
+	)
+	defer func() {
+		telemetry.EndSpan(span, err)
+	}()
+
+	// Correlate all log entries of the assessment with the evidence, also if it was received in a stream
+	ctx = logging.WithEvidence(ctx, req.GetEvidence())
 
 	// Check if cloud_service_id in the service is within allowed or one can access *all* the cloud services
 	if !svc.authz.CheckAccess(ctx, service.AccessUpdate, req) {
@@ -221,11 +295,11 @@ func (svc *Service) AssessEvidence(ctx context.Context, req *assessment.AssessEv
 	// Assess evidence. This also validates the embedded resource and returns a gRPC error if validation fails.
 	_, err = svc.handleEvidence(ctx, req.Evidence)
 	if err != nil {
-		log.Error(err)
+		log.WithContext(ctx).Error(err)
 		return nil, err
 	}
 
-	logging.LogRequest(log, logrus.DebugLevel, logging.Assess, req)
+	logging.LogRequest(log.WithContext(ctx), logrus.DebugLevel, logging.Assess, req)
 
 	return resp, nil
 }
@@ -247,15 +321,20 @@ func (svc *Service) AssessEvidences(stream assessment.Assessment_AssessEvidences
 		}
 		if err != nil {
 			newError := fmt.Errorf("cannot receive stream request: %w", err)
-			log.Error(newError)
+			log.WithContext(stream.Context()).Error(newError)
 			return status.Errorf(codes.Unknown, "%v", newError)
 		}
 
 		// Call AssessEvidence for assessing a single evidence
 		assessEvidencesReq := &assessment.AssessEvidenceRequest{
-			Evidence: req.Evidence,
+			Evidence:     req.Evidence,
+			TraceContext: req.TraceContext,
+		}
+		// Streamed requests are not validated by an interceptor, so that we can report invalid ones within the stream
+		err = api.Validate(assessEvidencesReq)
+		if err == nil {
+			_, err = svc.AssessEvidence(stream.Context(), assessEvidencesReq)
 		}
-		_, err = svc.AssessEvidence(stream.Context(), assessEvidencesReq)
 		if err != nil {
 			// Create response message. The AssessEvidence method does not need that message, so we have to create it here for the stream response.
 			res = &assessment.AssessEvidencesResponse{
@@ -277,7 +356,7 @@ func (svc *Service) AssessEvidences(stream assessment.Assessment_AssessEvidences
 		}
 		if err != nil {
 			err = fmt.Errorf("cannot send response to the client: %w", err)
-			log.Error(err)
+			log.WithContext(stream.Context()).Error(err)
 			return status.Errorf(codes.Unknown, "%v", err)
 		}
 	}
@@ -291,8 +370,16 @@ func (svc *Service) handleEvidence(ctx context.Context, ev *evidence.Evidence) (
 		types    []string
 		m        proto.Message
 		resource ontology.IsResource
+		assessed *evidence.Evidence
+		sources  []*evidence.Evidence
 	)
 
+	// Make sure, that we understand the ontology version of the resource
+	err = ontology.CheckVersion(ev.OntologyVersion)
+	if err != nil {
+		return nil, api.ErrorCodeUnsupportedOntology.Errorf("%v", err)
+	}
+
 	// First, try to extract the resource out of the evidence and validate it
 	m, err = ev.Resource.UnmarshalNew()
 	if err != nil {
@@ -306,55 +393,73 @@ func (svc *Service) handleEvidence(ctx context.Context, ev *evidence.Evidence) (
 
 	resource, ok := m.(ontology.IsResource)
 	if !ok {
-		return nil, status.Errorf(codes.Internal, "invalid embedded resource: %v", discovery.ErrNotOntologyResource)
+		return nil, api.ErrorCodeInvalidResource.Errorf("invalid embedded resource: %v", discovery.ErrNotOntologyResource)
+	}
+
+	log.WithContext(ctx).Debugf("Evaluating evidence %s (%s) collected by %s at %s", ev.Id, resource.GetId(), ev.ToolId, ev.Timestamp.AsTime())
+	log.WithContext(ctx).Tracef("Evidence: %+v", ev)
+
+	// Merge the evidence with the ones of other tools for the same resource, if configured
+	assessed, resource, sources, err = svc.mergeEvidence(ev, resource)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not merge evidence: %v", err)
 	}
 
-	log.Debugf("Evaluating evidence %s (%s) collected by %s at %s", ev.Id, resource.GetId(), ev.ToolId, ev.Timestamp.AsTime())
-	log.Tracef("Evidence: %+v", ev)
+	evidenceIDs, toolIDs := sourceIDs(sources)
 
-	evaluations, err := svc.pe.Eval(ev, resource, svc)
+	_, span := telemetry.StartSpan(ctx, "assessment.EvaluatePolicies", telemetry.ResourceIDKey.String(resource.GetId()))
+	evaluations, err := svc.pe.Eval(assessed, resource, svc)
+	telemetry.EndSpan(span, err)
+	telemetry.EvidencesAssessedTotal.Inc()
 	if err != nil {
 		newError := fmt.Errorf("could not evaluate evidence: %w", err)
 
 		go svc.informHooks(ctx, nil, newError)
 
-		return nil, status.Errorf(codes.Internal, "%v", err)
+		return nil, api.ErrorCodeEvaluationFailed.Errorf("%v", err)
 	}
 
 	// Send evidence via Evidence Store stream if sending evidences is not disabled
 	if !svc.isEvidenceStoreDisabled {
 		// Get Evidence Store stream
-		channelEvidenceStore, err := svc.evidenceStoreStreams.GetStream(svc.evidenceStore.Target, "Evidence Store", svc.initEvidenceStoreStream, svc.evidenceStore.Opts...)
+		channelEvidenceStore, err := svc.evidenceStoreStreams.GetStreamContext(svc.evidenceStore.Target, "Evidence Store", svc.initEvidenceStoreStream, svc.evidenceStore.Opts...)
 		if err != nil {
 			err = fmt.Errorf("could not get stream to evidence store (%s): %w", svc.evidenceStore.Target, err)
 
 			go svc.informHooks(ctx, nil, err)
 
-			return nil, status.Errorf(codes.Internal, "%v", err)
+			return nil, service.ErrorCodeWithRetryInfo(api.ErrorCodeEvidenceStoreUnavailable, service.DefaultRetryDelay, "%v", err)
 		}
-		channelEvidenceStore.Send(&evidence.StoreEvidenceRequest{Evidence: ev})
+		channelEvidenceStore.Send(&evidence.StoreEvidenceRequest{Evidence: ev, TraceContext: telemetry.InjectTraceContext(ctx)})
 	}
 
 	// Get Orchestrator stream
-	channelOrchestrator, err := svc.orchestratorStreams.GetStream(svc.orchestrator.Target, "Orchestrator", svc.initOrchestratorStream, svc.orchestrator.Opts...)
+	channelOrchestrator, err := svc.orchestratorStreams.GetStreamContext(svc.orchestrator.Target, "Orchestrator", svc.initOrchestratorStream, svc.orchestrator.Opts...)
 	if err != nil {
 		err = fmt.Errorf("could not get stream to orchestrator (%s): %w", svc.orchestrator.Target, err)
 
 		go svc.informHooks(ctx, nil, err)
 
-		return nil, status.Errorf(codes.Internal, "%v", err)
+		return nil, service.ErrorCodeWithRetryInfo(api.ErrorCodeOrchestratorUnavailable, service.DefaultRetryDelay, "%v", err)
 	}
 
 	for _, data := range evaluations {
 		// That there is an empty (nil) evaluation should be caught beforehand, but you never know.
 		if data == nil {
-			log.Errorf("One empty policy evaluation detected for evidence '%s'. That should not happen.",
-				ev.GetId())
+			log.WithContext(ctx).Errorf("One empty policy evaluation detected for evidence '%s'. That should not happen.",
+				assessed.GetId())
 			continue
 		}
 		metricID := data.MetricID
 
-		log.Debugf("Evaluated evidence %v with metric '%v' as %v", ev.Id, metricID, data.Compliant)
+		// Skip metrics that are disabled for the cloud service. The configuration is already cached by the policy
+		// evaluation, so this does not cause an additional request to the orchestrator.
+		if config, err := svc.MetricConfiguration(ev.GetCloudServiceId(), metricID); err == nil && config.GetDisabled() {
+			log.WithContext(ctx).Debugf("Skipping metric '%v' for evidence %v, because it is disabled", metricID, assessed.Id)
+			continue
+		}
+
+		log.WithContext(ctx).Debugf("Evaluated evidence %v with metric '%v' as %v", assessed.Id, metricID, data.Compliant)
 
 		types = ontology.ResourceTypes(resource)
 
@@ -363,20 +468,28 @@ func (svc *Service) handleEvidence(ctx context.Context, ev *evidence.Evidence) (
 			Timestamp:             timestamppb.Now(),
 			CloudServiceId:        ev.GetCloudServiceId(),
 			MetricId:              metricID,
+			MetricVersion:         svc.metricVersion(metricID),
 			MetricConfiguration:   data.Config,
 			Compliant:             data.Compliant,
-			EvidenceId:            ev.GetId(),
+			EvidenceId:            assessed.GetId(),
 			ResourceId:            resource.GetId(),
 			ResourceTypes:         types,
 			NonComplianceComments: "No comments so far",
 			ToolId:                util.Ref(assessment.AssessmentToolId),
+			EvidenceToolIds:       toolIDs,
+			EvidenceIds:           evidenceIDs,
+		}
+
+		// Keep the properties of the resource the metric was evaluated on, so that the result can be verified later
+		if data.Properties != nil {
+			result.EvidenceProperties = structpb.NewStructValue(data.Properties)
 		}
 
 		// Inform hooks about new assessment result
 		go svc.informHooks(ctx, result, nil)
 
 		// Send assessment result in orchestratorChannel
-		channelOrchestrator.Send(&orchestrator.StoreAssessmentResultRequest{Result: result})
+		channelOrchestrator.Send(&orchestrator.StoreAssessmentResultRequest{Result: result, TraceContext: telemetry.InjectTraceContext(ctx)})
 
 		results = append(results, result)
 	}
@@ -406,13 +519,18 @@ func (svc *Service) RegisterAssessmentResultHook(assessmentResultsHook func(ctx
 }
 
 // initEvidenceStoreStream initializes the stream to the Evidence Store
-func (svc *Service) initEvidenceStoreStream(target string, _ ...grpc.DialOption) (stream evidence.EvidenceStore_StoreEvidencesClient, err error) {
+func (svc *Service) initEvidenceStoreStream(ctx context.Context, target string, _ ...grpc.DialOption) (stream evidence.EvidenceStore_StoreEvidencesClient, err error) {
 	log.Infof("Trying to establish a stream to evidence store service @ %v", target)
 
 	// Make sure, that we re-connect
 	svc.evidenceStore.ForceReconnect()
 
-	stream, err = svc.evidenceStore.Client.StoreEvidences(context.Background())
+	var opts []grpc.CallOption
+	if svc.evidenceCompressor != "" {
+		opts = append(opts, grpc.UseCompressor(svc.evidenceCompressor))
+	}
+
+	stream, err = svc.evidenceStore.Client.StoreEvidences(ctx, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("could not set up stream to evidence store for storing evidences: %w", err)
 	}
@@ -423,13 +541,13 @@ func (svc *Service) initEvidenceStoreStream(target string, _ ...grpc.DialOption)
 }
 
 // initOrchestratorStream initializes the stream to the Orchestrator
-func (svc *Service) initOrchestratorStream(target string, _ ...grpc.DialOption) (stream orchestrator.Orchestrator_StoreAssessmentResultsClient, err error) {
+func (svc *Service) initOrchestratorStream(ctx context.Context, target string, _ ...grpc.DialOption) (stream orchestrator.Orchestrator_StoreAssessmentResultsClient, err error) {
 	log.Infof("Trying to establish a stream to orchestrator service @ %v", target)
 
 	// Make sure, that we re-connect
 	svc.orchestrator.ForceReconnect()
 
-	stream, err = svc.orchestrator.Client.StoreAssessmentResults(context.Background())
+	stream, err = svc.orchestrator.Client.StoreAssessmentResults(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("could not set up stream to orchestrator for storing assessment results: %w", err)
 	}
@@ -437,7 +555,7 @@ func (svc *Service) initOrchestratorStream(target string, _ ...grpc.DialOption)
 	log.Infof("Stream to StoreAssessmentResults established")
 
 	// TODO(oxisto): We should rewrite our generic StreamsOf to deal with incoming messages
-	svc.metricEventStream, err = svc.orchestrator.Client.SubscribeMetricChangeEvents(context.Background(), &orchestrator.SubscribeMetricChangeEventRequest{})
+	svc.metricEventStream, err = svc.orchestrator.Client.SubscribeMetricChangeEvents(ctx, &orchestrator.SubscribeMetricChangeEventRequest{})
 	if err != nil {
 		return nil, fmt.Errorf("could not set up stream for listening to metric change events: %w", err)
 	}
@@ -449,8 +567,12 @@ func (svc *Service) initOrchestratorStream(target string, _ ...grpc.DialOption)
 	return
 }
 
-// Metrics implements MetricsSource by retrieving the metric list from the orchestrator.
+// Metrics implements MetricsSource by retrieving the metric list from the metric bundle or the orchestrator.
 func (svc *Service) Metrics() (metrics []*assessment.Metric, err error) {
+	if b := svc.currentBundle(); b != nil {
+		return svc.relevantMetrics(b.Metrics), nil
+	}
+
 	metrics, err = api.ListAllPaginated(&orchestrator.ListMetricsRequest{}, svc.orchestrator.Client.ListMetrics, func(res *orchestrator.ListMetricsResponse) []*assessment.Metric {
 		return res.Metrics
 	})
@@ -458,17 +580,45 @@ func (svc *Service) Metrics() (metrics []*assessment.Metric, err error) {
 		return nil, fmt.Errorf("could not retrieve metric list from orchestrator: %w", err)
 	}
 
-	return metrics, nil
+	return svc.relevantMetrics(metrics), nil
+}
+
+// relevantMetrics returns the metrics that are relevant for the selected assurance level of the service.
+func (svc *Service) relevantMetrics(metrics []*assessment.Metric) (relevant []*assessment.Metric) {
+	if svc.assuranceLevel == "" {
+		return metrics
+	}
+
+	for _, metric := range metrics {
+		if metric.IsRelevantFor(svc.assuranceLevel) {
+			relevant = append(relevant, metric)
+		} else {
+			log.Debugf("Skipping metric %s, which is only relevant for assurance level %s", metric.Id, metric.GetAssuranceLevel())
+		}
+	}
+
+	return relevant
 }
 
 // MetricImplementation implements MetricsSource by retrieving the metric implementation
-// from the orchestrator.
+// from the metric bundle or the orchestrator.
 func (svc *Service) MetricImplementation(lang assessment.MetricImplementation_Language, metric string) (impl *assessment.MetricImplementation, err error) {
 	// For now, the orchestrator only supports the Rego language.
 	if lang != assessment.MetricImplementation_LANGUAGE_REGO {
 		return nil, errors.New("unsupported language")
 	}
 
+	// Implementations of a local policies directory take precedence
+	if impl, ok := svc.localImplementation(metric); ok {
+		return impl, nil
+	}
+
+	if b := svc.currentBundle(); b != nil {
+		if impl, ok := b.Implementations[metric]; ok {
+			return impl, nil
+		}
+	}
+
 	// Retrieve it from the orchestrator
 	impl, err = svc.orchestrator.Client.GetMetricImplementation(context.Background(), &orchestrator.GetMetricImplementationRequest{
 		MetricId: metric,
@@ -483,48 +633,75 @@ func (svc *Service) MetricImplementation(lang assessment.MetricImplementation_La
 // MetricConfiguration implements MetricsSource by getting the corresponding metric configuration for the
 // default target cloud service
 func (svc *Service) MetricConfiguration(cloudServiceID, metricID string) (config *assessment.MetricConfiguration, err error) {
-	var (
-		ok    bool
-		cache cachedConfiguration
-		key   string
-	)
+	var ok bool
 
-	// Calculate the cache key
-	key = fmt.Sprintf("%s-%s", cloudServiceID, metricID)
+	if b := svc.currentBundle(); b != nil {
+		if config, ok = b.Configurations[cloudServiceID][metricID]; ok {
+			return config, nil
+		}
+	}
 
-	// Retrieve our cached entry
-	svc.confMutex.Lock()
-	cache, ok = svc.cachedConfigurations[key]
-	svc.confMutex.Unlock()
+	// Retrieve our cached entry, unless it is not there or is expired
+	config, ok = svc.cache.Configuration(cloudServiceID, metricID)
+	if ok {
+		return config, nil
+	}
 
-	// Check if entry is not there or is expired
-	if !ok || cache.cachedAt.After(time.Now().Add(EvictionTime)) {
-		config, err = svc.orchestrator.Client.GetMetricConfiguration(context.Background(), &orchestrator.GetMetricConfigurationRequest{
-			CloudServiceId: cloudServiceID,
-			MetricId:       metricID,
-		})
+	config, err = svc.orchestrator.Client.GetMetricConfiguration(context.Background(), &orchestrator.GetMetricConfigurationRequest{
+		CloudServiceId: cloudServiceID,
+		MetricId:       metricID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve metric configuration for %s: %w", metricID, err)
+	}
 
-		if err != nil {
-			return nil, fmt.Errorf("could not retrieve metric configuration for %s: %w", metricID, err)
-		}
+	// Update the metric configuration
+	svc.cache.SetConfiguration(cloudServiceID, metricID, config)
+
+	return config, nil
+}
 
-		cache = cachedConfiguration{
-			cachedAt:            time.Now(),
-			MetricConfiguration: config,
+// metricVersion retrieves the version of the metric with the given ID from the orchestrator, so that it can be recorded
+// in the assessment result. Since the version is only informational, errors are logged and an empty version is
+// returned.
+func (svc *Service) metricVersion(metricID string) string {
+	if b := svc.currentBundle(); b != nil {
+		for _, metric := range b.Metrics {
+			if metric.Id == metricID {
+				return metric.GetVersion()
+			}
 		}
+	}
+
+	version, ok := svc.cache.MetricVersion(metricID)
+	if ok {
+		return version
+	}
 
-		svc.confMutex.Lock()
-		// Update the metric configuration
-		svc.cachedConfigurations[key] = cache
-		defer svc.confMutex.Unlock()
+	metric, err := svc.orchestrator.Client.GetMetric(context.Background(), &orchestrator.GetMetricRequest{
+		MetricId: metricID,
+	})
+	if err != nil {
+		log.Warnf("Could not retrieve version of metric %s: %v", metricID, err)
+		return ""
 	}
 
-	return cache.MetricConfiguration, nil
+	svc.cache.SetMetricVersion(metricID, metric.GetVersion())
+
+	return metric.GetVersion()
 }
 
 func (svc *Service) Shutdown() {
 	svc.evidenceStoreStreams.CloseAll()
 	svc.orchestratorStreams.CloseAll()
+
+	if svc.bundle != nil {
+		svc.bundle.cancel()
+	}
+
+	if svc.policies != nil && svc.policies.watcher != nil {
+		_ = svc.policies.watcher.Close()
+	}
 }
 
 // recvEventsLoop continuously tries to receive events on the metricEventStream
@@ -551,22 +728,33 @@ func (svc *Service) recvEventsLoop() {
 }
 
 func (svc *Service) handleMetricEvent(event *orchestrator.MetricChangeEvent) {
-	var key string
-
 	// In case the configuration has changed, we need to clear our configuration cache. Otherwise the policy evaluation
 	// will clear the Rego cache, but still refer to the old metric configuration (until it expires). Handle metric event in our policy
 	// evaluation
 	if event.GetType() == orchestrator.MetricChangeEvent_TYPE_CONFIG_CHANGED {
 		// Evict the metric configuration from cache
-		svc.confMutex.Lock()
-
-		// Calculate the cache key
-		key = fmt.Sprintf("%s-%s", event.CloudServiceId, event.MetricId)
-
-		delete(svc.cachedConfigurations, key)
-		svc.confMutex.Unlock()
+		svc.cache.EvictConfiguration(event.CloudServiceId, event.MetricId)
+	} else if event.GetType() == orchestrator.MetricChangeEvent_TYPE_METADATA_CHANGED ||
+		event.GetType() == orchestrator.MetricChangeEvent_TYPE_IMPLEMENTATION_CHANGED {
+		// The version of the metric might have changed, so we evict it from our cache
+		svc.cache.EvictMetricVersion(event.MetricId)
 	}
 
 	// Forward the event to the policy evaluator
 	_ = svc.pe.HandleMetricEvent(event)
 }
+
+// CheckHealth implements [service.HealthChecker]. The assessment depends on the streams to the orchestrator and (if
+// not disabled) the evidence store.
+func (svc *Service) CheckHealth(_ context.Context) (err error) {
+	if !svc.isEvidenceStoreDisabled {
+		err = svc.evidenceStoreStreams.CheckHealth()
+	}
+
+	return errors.Join(err, svc.orchestratorStreams.CheckHealth(), svc.orchestrator.CheckHealth())
+}
+
+// StreamStates implements [service.StreamReporter].
+func (svc *Service) StreamStates() []api.StreamState {
+	return append(svc.evidenceStoreStreams.States(), svc.orchestratorStreams.States()...)
+}
@@ -29,10 +29,12 @@ import (
 	"context"
 	"errors"
 	"io"
+	"net"
 	"os"
 	"reflect"
 	"runtime"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -42,6 +44,7 @@ import (
 	"clouditor.io/clouditor/v2/api/evidence"
 	"clouditor.io/clouditor/v2/api/ontology"
 	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/internal/chaos"
 	"clouditor.io/clouditor/v2/internal/testdata"
 	"clouditor.io/clouditor/v2/internal/testutil"
 	"clouditor.io/clouditor/v2/internal/testutil/assert"
@@ -49,8 +52,11 @@ import (
 	"clouditor.io/clouditor/v2/internal/testutil/prototest"
 	"clouditor.io/clouditor/v2/internal/testutil/servicetest"
 	"clouditor.io/clouditor/v2/internal/testutil/servicetest/evidencetest"
+	"clouditor.io/clouditor/v2/internal/util"
 	"clouditor.io/clouditor/v2/policies"
 	"clouditor.io/clouditor/v2/service"
+	service_evidence "clouditor.io/clouditor/v2/service/evidence"
+	service_orchestrator "clouditor.io/clouditor/v2/service/orchestrator"
 
 	"github.com/google/uuid"
 	"golang.org/x/oauth2/clientcredentials"
@@ -58,6 +64,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
 	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -289,6 +296,30 @@ func TestService_AssessEvidence(t *testing.T) {
 			wantResp: &assessment.AssessEvidenceResponse{},
 			wantErr:  assert.NoError,
 		},
+		{
+			name: "Assess resource of incompatible ontology version",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				in0: context.TODO(),
+				evidence: &evidence.Evidence{
+					Id:        testdata.MockEvidenceID1,
+					ToolId:    testdata.MockEvidenceToolID1,
+					Timestamp: timestamppb.Now(),
+					Resource: prototest.NewAny(t, &ontology.VirtualMachine{
+						Id:   testdata.MockResourceID1,
+						Name: testdata.MockResourceName1,
+					}),
+					CloudServiceId:  testdata.MockCloudServiceID1,
+					OntologyVersion: "0.1",
+				},
+			},
+			wantResp: nil,
+			wantErr: func(tt assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorContains(t, err, ontology.ErrIncompatibleVersion.Error())
+			},
+		},
 		{
 			name: "Assess resource of wrong could service",
 			fields: fields{
@@ -367,11 +398,11 @@ func TestService_AssessEvidence(t *testing.T) {
 				orchestrator:         tt.fields.orchestrator,
 				evidenceStoreStreams: api.NewStreamsOf(api.WithLogger[evidence.EvidenceStore_StoreEvidencesClient, *evidence.StoreEvidenceRequest](log)),
 				orchestratorStreams:  api.NewStreamsOf(api.WithLogger[orchestrator.Orchestrator_StoreAssessmentResultsClient, *orchestrator.StoreAssessmentResultRequest](log)),
-				cachedConfigurations: make(map[string]cachedConfiguration),
+				cache:                newMemoryCache(),
 				pe:                   policies.NewRegoEval(policies.WithPackageName(policies.DefaultRegoPackage)),
 				authz:                tt.fields.authz,
 			}
-			gotResp, err := s.AssessEvidence(tt.args.in0, &assessment.AssessEvidenceRequest{Evidence: tt.args.evidence})
+			gotResp, err := servicetest.Validated(s.AssessEvidence)(tt.args.in0, &assessment.AssessEvidenceRequest{Evidence: tt.args.evidence})
 
 			tt.wantErr(t, err)
 
@@ -399,8 +430,8 @@ func TestService_AssessEvidence_DetectMisconfiguredEvidenceEvenWhenAlreadyCached
 		orchestratorStreams: api.NewStreamsOf(
 			api.WithLogger[orchestrator.Orchestrator_StoreAssessmentResultsClient,
 				*orchestrator.StoreAssessmentResultRequest](log)),
-		cachedConfigurations: make(map[string]cachedConfiguration),
-		pe:                   policies.NewRegoEval(policies.WithPackageName(policies.DefaultRegoPackage)),
+		cache: newMemoryCache(),
+		pe:    policies.NewRegoEval(policies.WithPackageName(policies.DefaultRegoPackage)),
 	}
 	// First assess evidence with a valid VM resource s.t. the cache is created for the combination of resource type and
 	// tool id (="VirtualMachine-{testdata.MockEvidenceToolID}")
@@ -563,7 +594,7 @@ func TestService_AssessEvidences(t *testing.T) {
 			)
 			s := Service{
 				resultHooks:          tt.fields.ResultHooks,
-				cachedConfigurations: make(map[string]cachedConfiguration),
+				cache:                newMemoryCache(),
 				evidenceStoreStreams: tt.fields.evidenceStoreStreams,
 				evidenceStore:        api.NewRPCConnection("bufnet", evidence.NewEvidenceStoreClient, grpc.WithContextDialer(bufConnDialer)),
 				orchestrator:         api.NewRPCConnection("bufnet", orchestrator.NewOrchestratorClient, grpc.WithContextDialer(bufConnDialer)),
@@ -587,6 +618,80 @@ func TestService_AssessEvidences(t *testing.T) {
 	}
 }
 
+// TestService_AssessEvidence_StreamFaults sends evidences through streams with injected faults to a real evidence
+// store and orchestrator to make sure that lost streams are re-established and queued messages are not lost.
+func TestService_AssessEvidence_StreamFaults(t *testing.T) {
+	const n = 20
+
+	tests := []struct {
+		name   string
+		faults *chaos.Faults
+	}{
+		{
+			name:   "latency and disconnects",
+			faults: &chaos.Faults{Latency: time.Millisecond, Disconnect: 0.3},
+		},
+		{
+			name:   "drops",
+			faults: &chaos.Faults{Drop: 0.3},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				stored atomic.Int64
+				lis    = bufconn.Listen(DefaultBufferSize)
+				srv    = grpc.NewServer()
+			)
+
+			evidenceService := service_evidence.NewService()
+			evidenceService.RegisterEvidenceHook(func(_ context.Context, _ *evidence.Evidence, err error) {
+				if err == nil {
+					stored.Add(1)
+				}
+			})
+
+			evidence.RegisterEvidenceStoreServer(srv, evidenceService)
+			orchestrator.RegisterOrchestratorServer(srv, service_orchestrator.NewService())
+
+			go func() {
+				_ = srv.Serve(lis)
+			}()
+			defer srv.Stop()
+
+			dialer := grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+				return lis.Dial()
+			})
+
+			// Only the stream to the evidence store is faulty, so that we can count the evidences that arrive
+			svc := NewService(
+				WithEvidenceStoreAddress("bufnet", dialer, tt.faults.DialOption()),
+				WithOrchestratorAddress("bufnet", dialer),
+			)
+			svc.evidenceStoreStreams = api.NewStreamsOf(
+				api.WithLogger[evidence.EvidenceStore_StoreEvidencesClient, *evidence.StoreEvidenceRequest](log),
+				api.WithBackoff[evidence.EvidenceStore_StoreEvidencesClient, *evidence.StoreEvidenceRequest](time.Millisecond, 10*time.Millisecond),
+			)
+			defer svc.evidenceStoreStreams.CloseAll()
+			defer svc.orchestratorStreams.CloseAll()
+
+			for i := 0; i < n; i++ {
+				ev, err := evidencetest.NewEvidence(testdata.MockCloudServiceID1, testdata.MockEvidenceToolID1, i)
+				assert.NoError(t, err)
+
+				_, err = svc.AssessEvidence(context.Background(), &assessment.AssessEvidenceRequest{Evidence: ev})
+				assert.NoError(t, err)
+			}
+
+			// Every evidence arrives at the evidence store, unless it was dropped
+			assert.Eventually(t, func() bool {
+				return stored.Load()+int64(tt.faults.Drops()) == n
+			}, 10*time.Second, 10*time.Millisecond)
+			assert.True(t, tt.faults.Disconnects()+tt.faults.Drops() > 0)
+		})
+	}
+}
+
 func TestService_AssessmentResultHooks(t *testing.T) {
 	var (
 		hookCallCounter = 0
@@ -866,6 +971,9 @@ func TestService_handleEvidence(t *testing.T) {
 				for _, result := range got {
 					err := api.Validate(result)
 					assert.NoError(t, err)
+					assert.Equal(t, assessment.DefaultMetricVersion, result.MetricVersion)
+					assert.Equal(t, []string{testdata.MockEvidenceID1}, result.EvidenceIds)
+					assert.NotNil(t, result.EvidenceProperties)
 				}
 				return assert.Equal(t, 11, len(got))
 			},
@@ -949,7 +1057,7 @@ func TestService_handleEvidence(t *testing.T) {
 				orchestrator:         tt.fields.orchestrator,
 				evidenceStoreStreams: api.NewStreamsOf(api.WithLogger[evidence.EvidenceStore_StoreEvidencesClient, *evidence.StoreEvidenceRequest](log)),
 				orchestratorStreams:  api.NewStreamsOf(api.WithLogger[orchestrator.Orchestrator_StoreAssessmentResultsClient, *orchestrator.StoreAssessmentResultRequest](log)),
-				cachedConfigurations: make(map[string]cachedConfiguration),
+				cache:                newMemoryCache(),
 				pe:                   policies.NewRegoEval(policies.WithPackageName(policies.DefaultRegoPackage)),
 				authz:                tt.fields.authz,
 			}
@@ -1014,7 +1122,7 @@ func TestService_initOrchestratorStoreStream(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			s := NewService(tt.fields.opts...)
-			stream, err := s.initOrchestratorStream(tt.args.url, s.orchestrator.Opts...)
+			stream, err := s.initOrchestratorStream(context.Background(), tt.args.url, s.orchestrator.Opts...)
 
 			tt.wantErr(t, err)
 			tt.want(t, stream)
@@ -1030,7 +1138,7 @@ func TestService_recvEventsLoop(t *testing.T) {
 		evidenceStore        *api.RPCConnection[evidence.EvidenceStoreClient]
 		metricEventStream    orchestrator.Orchestrator_SubscribeMetricChangeEventsClient
 		resultHooks          []assessment.ResultHookFunc
-		cachedConfigurations map[string]cachedConfiguration
+		cache                ConfigurationCache
 	}
 	tests := []struct {
 		name      string
@@ -1047,6 +1155,7 @@ func TestService_recvEventsLoop(t *testing.T) {
 				}},
 				evidenceStore: api.NewRPCConnection(DefaultEvidenceStoreAddress, evidence.NewEvidenceStoreClient),
 				orchestrator:  api.NewRPCConnection(DefaultOrchestratorAddress, orchestrator.NewOrchestratorClient),
+				cache:         newMemoryCache(),
 			},
 			wantEvent: &orchestrator.MetricChangeEvent{
 				Type: orchestrator.MetricChangeEvent_TYPE_CONFIG_CHANGED,
@@ -1063,7 +1172,7 @@ func TestService_recvEventsLoop(t *testing.T) {
 				orchestratorStreams:  tt.fields.orchestratorStreams,
 				metricEventStream:    tt.fields.metricEventStream,
 				resultHooks:          tt.fields.resultHooks,
-				cachedConfigurations: tt.fields.cachedConfigurations,
+				cache:                tt.fields.cache,
 			}
 			rec := &eventRecorder{}
 			svc.pe = rec
@@ -1102,7 +1211,7 @@ func TestService_MetricImplementation(t *testing.T) {
 		orchestrator            *api.RPCConnection[orchestrator.OrchestratorClient]
 		metricEventStream       orchestrator.Orchestrator_SubscribeMetricChangeEventsClient
 		resultHooks             []assessment.ResultHookFunc
-		cachedConfigurations    map[string]cachedConfiguration
+		cache                   ConfigurationCache
 		pe                      policies.PolicyEval
 		evalPkg                 string
 	}
@@ -1138,7 +1247,7 @@ func TestService_MetricImplementation(t *testing.T) {
 				orchestrator:            tt.fields.orchestrator,
 				metricEventStream:       tt.fields.metricEventStream,
 				resultHooks:             tt.fields.resultHooks,
-				cachedConfigurations:    tt.fields.cachedConfigurations,
+				cache:                   tt.fields.cache,
 				pe:                      tt.fields.pe,
 				evalPkg:                 tt.fields.evalPkg,
 			}
@@ -1149,3 +1258,96 @@ func TestService_MetricImplementation(t *testing.T) {
 		})
 	}
 }
+
+func TestService_handleMetricEvent(t *testing.T) {
+	// newCache creates a cache containing a configuration and a version of the mock metric
+	newCache := func() ConfigurationCache {
+		cache := newMemoryCache()
+		cache.SetConfiguration(testdata.MockCloudServiceID1, testdata.MockMetricID1, &assessment.MetricConfiguration{})
+		cache.SetMetricVersion(testdata.MockMetricID1, "1.0.0")
+		return cache
+	}
+
+	type fields struct {
+		cache ConfigurationCache
+	}
+	type args struct {
+		event *orchestrator.MetricChangeEvent
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantSvc assert.Want[*Service]
+	}{
+		{
+			name: "Config changed",
+			fields: fields{
+				cache: newCache(),
+			},
+			args: args{
+				event: &orchestrator.MetricChangeEvent{
+					Type:           orchestrator.MetricChangeEvent_TYPE_CONFIG_CHANGED,
+					MetricId:       testdata.MockMetricID1,
+					CloudServiceId: testdata.MockCloudServiceID1,
+				},
+			},
+			wantSvc: func(t *testing.T, got *Service) bool {
+				_, ok := got.cache.Configuration(testdata.MockCloudServiceID1, testdata.MockMetricID1)
+				assert.False(t, ok)
+
+				version, _ := got.cache.MetricVersion(testdata.MockMetricID1)
+				return assert.Equal(t, "1.0.0", version)
+			},
+		},
+		{
+			name: "Metadata changed",
+			fields: fields{
+				cache: newCache(),
+			},
+			args: args{
+				event: &orchestrator.MetricChangeEvent{
+					Type:           orchestrator.MetricChangeEvent_TYPE_METADATA_CHANGED,
+					MetricId:       testdata.MockMetricID1,
+					CloudServiceId: testdata.MockCloudServiceID1,
+				},
+			},
+			wantSvc: func(t *testing.T, got *Service) bool {
+				_, ok := got.cache.Configuration(testdata.MockCloudServiceID1, testdata.MockMetricID1)
+				assert.True(t, ok)
+
+				_, ok = got.cache.MetricVersion(testdata.MockMetricID1)
+				return assert.False(t, ok)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				cache: tt.fields.cache,
+				pe:    policies.NewRegoEval(),
+			}
+
+			svc.handleMetricEvent(tt.args.event)
+
+			tt.wantSvc(t, svc)
+		})
+	}
+}
+
+func TestService_relevantMetrics(t *testing.T) {
+	metrics := []*assessment.Metric{
+		{Id: testdata.MockMetricID1},
+		{Id: testdata.MockMetricID2, AssuranceLevel: util.Ref(testdata.AssuranceLevelSubstantial)},
+		{Id: "Test3", AssuranceLevel: util.Ref(testdata.AssuranceLevelHigh)},
+	}
+
+	svc := NewService()
+	assert.Equal(t, metrics, svc.relevantMetrics(metrics))
+
+	svc = NewService(WithAssuranceLevel(testdata.AssuranceLevelSubstantial))
+	assert.Equal(t, metrics[:2], svc.relevantMetrics(metrics))
+
+	svc = NewService(WithAssuranceLevel(testdata.AssuranceLevelBasic))
+	assert.Equal(t, metrics[:1], svc.relevantMetrics(metrics))
+}
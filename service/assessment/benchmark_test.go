@@ -14,6 +14,8 @@ import (
 	"clouditor.io/clouditor/v2/api/evidence"
 	"clouditor.io/clouditor/v2/api/ontology"
 	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil/servicetest/evidencetest"
 	service_evidence "clouditor.io/clouditor/v2/service/evidence"
 	service_orchestrator "clouditor.io/clouditor/v2/service/orchestrator"
 	"github.com/google/uuid"
@@ -165,3 +167,53 @@ func BenchmarkAssessEvidence3000(b *testing.B) {
 func BenchmarkAssessEvidence10000(b *testing.B) {
 	benchmarkAssessEvidenceInternal(10000, 1, b)
 }
+
+// numResources contains the numbers of distinct resources used by BenchmarkHandleEvidence. Since the applicable
+// metrics are cached per resource type, the number of resources mainly affects the size of the evidence merge cache.
+var numResources = []int{10, 1000}
+
+// BenchmarkHandleEvidence measures the assessment of a single evidence, i.e., the validation of the resource, the Rego
+// evaluation of all applicable metrics and the sending of the results, without the overhead of an RPC call. The policy
+// queries are prepared before the timer starts, so that the numbers reflect the steady state of the assessment.
+func BenchmarkHandleEvidence(b *testing.B) {
+	logrus.SetLevel(logrus.PanicLevel)
+
+	for _, k := range numResources {
+		b.Run(fmt.Sprintf("%d", k), func(b *testing.B) {
+			svc := NewService(
+				WithEvidenceStoreAddress("bufnet", grpc.WithContextDialer(bufConnDialer)),
+				WithOrchestratorAddress("bufnet", grpc.WithContextDialer(bufConnDialer)),
+			)
+
+			evidences := make([]*evidence.Evidence, k)
+			for i := range evidences {
+				ev, err := evidencetest.NewEvidence(testdata.MockCloudServiceID1, testdata.MockEvidenceToolID1, i)
+				if err != nil {
+					b.Fatalf("could not create evidence: %v", err)
+				}
+
+				evidences[i] = ev
+
+				// Warm up the caches of the policy evaluation
+				results, err := svc.handleEvidence(context.Background(), ev)
+				if err != nil {
+					b.Fatalf("Error while calling handleEvidence: %v", err)
+				} else if len(results) == 0 {
+					b.Fatalf("No metric is applicable to evidence %d", i)
+				}
+			}
+
+			b.ReportAllocs()
+			b.ResetTimer()
+
+			for n := 0; n < b.N; n++ {
+				_, err := svc.handleEvidence(context.Background(), evidences[n%k])
+				if err != nil {
+					b.Errorf("Error while calling handleEvidence: %v", err)
+				}
+			}
+
+			b.ReportMetric(float64(b.N)/b.Elapsed().Seconds(), "evidences/s")
+		})
+	}
+}
@@ -0,0 +1,232 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package assessment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	"clouditor.io/clouditor/v2/api/assessment"
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/policies"
+	"clouditor.io/clouditor/v2/service"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/protobuf/proto"
+)
+
+// DefaultMetricBundleInterval is the default interval in which the metric bundle is polled.
+const DefaultMetricBundleInterval = time.Minute
+
+// metricBundle holds the latest metric bundle retrieved from the orchestrator.
+type metricBundle struct {
+	mu sync.RWMutex
+
+	// url is the URL of the bundle, e.g., http://localhost:8080/v1/orchestrator/bundles/metrics.tar.gz
+	url string
+
+	// interval is the interval in which the bundle is polled
+	interval time.Duration
+
+	// client is used to retrieve the bundle
+	client *http.Client
+
+	// cancel stops the polling
+	cancel context.CancelFunc
+
+	bundle *policies.Bundle
+	etag   string
+}
+
+// WithMetricBundle is an option to retrieve the metrics as well as their implementations and configurations from the
+// bundle of the orchestrator at the given URL, instead of requesting them individually. The bundle is polled in the
+// given interval using its ETag, so that changes propagate to all assessment services without needing to subscribe to
+// metric change events. Entries that are not part of the bundle, e.g., configurations of a new cloud service, are
+// still requested from the orchestrator.
+func WithMetricBundle(url string, interval time.Duration) service.Option[Service] {
+	return func(svc *Service) {
+		if interval <= 0 {
+			interval = DefaultMetricBundleInterval
+		}
+
+		svc.bundle = &metricBundle{url: url, interval: interval}
+	}
+}
+
+// startMetricBundle starts polling the metric bundle in the background. The authorizer of the orchestrator is used to
+// authenticate the requests.
+func (svc *Service) startMetricBundle() {
+	var ctx context.Context
+
+	svc.bundle.client = &http.Client{Timeout: 30 * time.Second}
+	if auth := svc.orchestrator.Authorizer(); auth != nil {
+		svc.bundle.client.Transport = &oauth2.Transport{Source: auth}
+	}
+
+	ctx, svc.bundle.cancel = context.WithCancel(context.Background())
+
+	go func() {
+		ticker := time.NewTicker(svc.bundle.interval)
+		defer ticker.Stop()
+
+		for {
+			if err := svc.updateMetricBundle(ctx); err != nil && !errors.Is(err, context.Canceled) {
+				log.Warnf("Could not update metric bundle: %v", err)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// updateMetricBundle retrieves the metric bundle, unless it did not change. The caches of all metrics that changed
+// compared to the previous bundle are evicted.
+func (svc *Service) updateMetricBundle(ctx context.Context) (err error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, svc.bundle.url, nil)
+	if err != nil {
+		return err
+	}
+
+	svc.bundle.mu.RLock()
+	if svc.bundle.etag != "" {
+		req.Header.Set("If-None-Match", svc.bundle.etag)
+	}
+	svc.bundle.mu.RUnlock()
+
+	res, err := svc.bundle.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	switch res.StatusCode {
+	case http.StatusNotModified:
+		return nil
+	case http.StatusOK:
+	default:
+		return fmt.Errorf("unexpected status code %d", res.StatusCode)
+	}
+
+	b, err := policies.ReadBundle(res.Body)
+	if err != nil {
+		return err
+	}
+
+	svc.bundle.mu.Lock()
+	old := svc.bundle.bundle
+	svc.bundle.bundle = b
+	svc.bundle.etag = res.Header.Get("ETag")
+	svc.bundle.mu.Unlock()
+
+	for _, event := range bundleChanges(old, b) {
+		svc.handleMetricEvent(event)
+	}
+
+	log.Infof("Updated metric bundle (ETag %s)", res.Header.Get("ETag"))
+
+	return nil
+}
+
+// currentBundle returns the latest metric bundle, if it is enabled and was already retrieved, otherwise nil.
+func (svc *Service) currentBundle() *policies.Bundle {
+	if svc.bundle == nil {
+		return nil
+	}
+
+	svc.bundle.mu.RLock()
+	defer svc.bundle.mu.RUnlock()
+
+	return svc.bundle.bundle
+}
+
+// bundleChanges returns the metric change events that describe the differences between the old bundle, which can be
+// nil, and the new bundle.
+func bundleChanges(old *policies.Bundle, b *policies.Bundle) (events []*orchestrator.MetricChangeEvent) {
+	if old == nil {
+		old = new(policies.Bundle)
+	}
+
+	var oldMetrics = make(map[string]*assessment.Metric)
+	for _, metric := range old.Metrics {
+		oldMetrics[metric.Id] = metric
+	}
+
+	for _, metric := range b.Metrics {
+		if !proto.Equal(metric, oldMetrics[metric.Id]) {
+			events = append(events, &orchestrator.MetricChangeEvent{
+				Type:     orchestrator.MetricChangeEvent_TYPE_METADATA_CHANGED,
+				MetricId: metric.Id,
+			})
+		}
+	}
+
+	for _, metricID := range changedKeys(old.Implementations, b.Implementations) {
+		events = append(events, &orchestrator.MetricChangeEvent{
+			Type:     orchestrator.MetricChangeEvent_TYPE_IMPLEMENTATION_CHANGED,
+			MetricId: metricID,
+		})
+	}
+
+	for serviceID, configs := range b.Configurations {
+		for _, metricID := range changedKeys(old.Configurations[serviceID], configs) {
+			events = append(events, &orchestrator.MetricChangeEvent{
+				Type:           orchestrator.MetricChangeEvent_TYPE_CONFIG_CHANGED,
+				MetricId:       metricID,
+				CloudServiceId: serviceID,
+			})
+		}
+	}
+
+	return events
+}
+
+// changedKeys returns the sorted keys of all entries, which were added, changed or removed in m compared to old.
+func changedKeys[T proto.Message](old map[string]T, m map[string]T) (keys []string) {
+	for key, v := range m {
+		if o, ok := old[key]; !ok || !proto.Equal(o, v) {
+			keys = append(keys, key)
+		}
+	}
+
+	for key := range old {
+		if _, ok := m[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+
+	slices.Sort(keys)
+
+	return keys
+}
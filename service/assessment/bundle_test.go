@@ -0,0 +1,150 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package assessment
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"clouditor.io/clouditor/v2/api/assessment"
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/policies"
+)
+
+// bundleServer serves a metric bundle, which can be changed during the test, according to the OPA bundle protocol.
+type bundleServer struct {
+	mu       sync.Mutex
+	bundle   *policies.Bundle
+	requests int
+	notMod   int
+}
+
+func (s *bundleServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.requests++
+
+	revision, _ := s.bundle.Revision()
+	etag := fmt.Sprintf("%q", revision)
+	w.Header().Set("ETag", etag)
+
+	if r.Header.Get("If-None-Match") == etag {
+		s.notMod++
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	_ = s.bundle.Write(w)
+}
+
+func newTestBundle(code string) *policies.Bundle {
+	return &policies.Bundle{
+		Metrics: []*assessment.Metric{{Id: testdata.MockMetricID1, Version: "1.0"}},
+		Implementations: map[string]*assessment.MetricImplementation{
+			testdata.MockMetricID1: {MetricId: testdata.MockMetricID1, Lang: assessment.MetricImplementation_LANGUAGE_REGO, Code: code},
+		},
+		Configurations: map[string]map[string]*assessment.MetricConfiguration{
+			testdata.MockCloudServiceID1: {
+				testdata.MockMetricID1: {MetricId: testdata.MockMetricID1, CloudServiceId: testdata.MockCloudServiceID1, Operator: "=="},
+			},
+		},
+	}
+}
+
+func TestService_updateMetricBundle(t *testing.T) {
+	var bs = &bundleServer{bundle: newTestBundle("package clouditor.metrics.mock_metric_1")}
+
+	srv := httptest.NewServer(bs)
+	defer srv.Close()
+
+	svc := NewService(WithMetricBundle(srv.URL, time.Hour))
+	defer svc.Shutdown()
+
+	// Wait for the initial retrieval in the background
+	for i := 0; i < 100 && svc.currentBundle() == nil; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	impl, err := svc.MetricImplementation(assessment.MetricImplementation_LANGUAGE_REGO, testdata.MockMetricID1)
+	assert.NoError(t, err)
+	assert.Equal(t, "package clouditor.metrics.mock_metric_1", impl.Code)
+
+	config, err := svc.MetricConfiguration(testdata.MockCloudServiceID1, testdata.MockMetricID1)
+	assert.NoError(t, err)
+	assert.Equal(t, "==", config.Operator)
+
+	metrics, err := svc.Metrics()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(metrics))
+	assert.Equal(t, "1.0", svc.metricVersion(testdata.MockMetricID1))
+
+	// An unchanged bundle is not transferred again
+	assert.NoError(t, svc.updateMetricBundle(context.Background()))
+	assert.Equal(t, 1, bs.notMod)
+
+	// A changed bundle replaces the previous one
+	bs.mu.Lock()
+	bs.bundle = newTestBundle("package clouditor.metrics.mock_metric_1\n\napplicable := true")
+	bs.mu.Unlock()
+
+	assert.NoError(t, svc.updateMetricBundle(context.Background()))
+
+	impl, err = svc.MetricImplementation(assessment.MetricImplementation_LANGUAGE_REGO, testdata.MockMetricID1)
+	assert.NoError(t, err)
+	assert.Equal(t, "package clouditor.metrics.mock_metric_1\n\napplicable := true", impl.Code)
+	assert.Equal(t, 3, bs.requests)
+}
+
+func Test_bundleChanges(t *testing.T) {
+	var (
+		old = newTestBundle("old")
+		b   = newTestBundle("new")
+	)
+
+	// All entries of the first bundle are new
+	assert.Equal(t, 3, len(bundleChanges(nil, old)))
+
+	// Nothing changed
+	assert.Empty(t, bundleChanges(old, newTestBundle("old")))
+
+	// Changed implementation and configuration as well as a removed implementation
+	b.Configurations[testdata.MockCloudServiceID1][testdata.MockMetricID1].Operator = "!="
+	old.Implementations[testdata.MockMetricID2] = &assessment.MetricImplementation{MetricId: testdata.MockMetricID2}
+
+	assert.Equal(t, []*orchestrator.MetricChangeEvent{
+		{Type: orchestrator.MetricChangeEvent_TYPE_IMPLEMENTATION_CHANGED, MetricId: testdata.MockMetricID1},
+		{Type: orchestrator.MetricChangeEvent_TYPE_IMPLEMENTATION_CHANGED, MetricId: testdata.MockMetricID2},
+		{Type: orchestrator.MetricChangeEvent_TYPE_CONFIG_CHANGED, MetricId: testdata.MockMetricID1, CloudServiceId: testdata.MockCloudServiceID1},
+	}, bundleChanges(old, b))
+}
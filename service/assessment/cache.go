@@ -0,0 +1,233 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package assessment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"clouditor.io/clouditor/v2/api/assessment"
+
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/protobuf/proto"
+)
+
+// ConfigurationCache caches the metric configurations and metric versions retrieved from the orchestrator. Entries
+// are evicted by the assessment service once it receives a corresponding [orchestrator.MetricChangeEvent]. Since every
+// replica of the assessment service subscribes to these events, a cache can safely be shared between replicas, e.g.,
+// using [NewRedisCache], so that replicas behind a load balancer do not need to retrieve the same entries.
+//
+// Errors of the underlying store are not returned, but treated as cache misses, so that the assessment continues using
+// the orchestrator.
+type ConfigurationCache interface {
+	// Configuration returns the cached metric configuration of the cloud service and metric, if present.
+	Configuration(cloudServiceID, metricID string) (config *assessment.MetricConfiguration, ok bool)
+	// SetConfiguration caches the metric configuration of the cloud service and metric.
+	SetConfiguration(cloudServiceID, metricID string, config *assessment.MetricConfiguration)
+	// EvictConfiguration evicts the metric configuration of the cloud service and metric.
+	EvictConfiguration(cloudServiceID, metricID string)
+
+	// MetricVersion returns the cached version of the metric, if present.
+	MetricVersion(metricID string) (version string, ok bool)
+	// SetMetricVersion caches the version of the metric.
+	SetMetricVersion(metricID, version string)
+	// EvictMetricVersion evicts the version of the metric.
+	EvictMetricVersion(metricID string)
+}
+
+// configurationKey returns the key of the metric configuration of the cloud service and metric.
+func configurationKey(cloudServiceID, metricID string) string {
+	return fmt.Sprintf("%s-%s", cloudServiceID, metricID)
+}
+
+type cachedConfiguration struct {
+	cachedAt time.Time
+	*assessment.MetricConfiguration
+}
+
+// memoryCache is a [ConfigurationCache] that is local to a single replica. This is the default.
+type memoryCache struct {
+	mu sync.Mutex
+
+	// configurations holds cached metric configurations with key being the one returned by configurationKey
+	configurations map[string]cachedConfiguration
+
+	// versions holds the cached version of each metric with key being the metric ID
+	versions map[string]string
+}
+
+// newMemoryCache creates a new [ConfigurationCache] that is local to this replica.
+func newMemoryCache() *memoryCache {
+	return &memoryCache{
+		configurations: make(map[string]cachedConfiguration),
+		versions:       make(map[string]string),
+	}
+}
+
+func (c *memoryCache) Configuration(cloudServiceID, metricID string) (config *assessment.MetricConfiguration, ok bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	cache, ok := c.configurations[configurationKey(cloudServiceID, metricID)]
+	if !ok || time.Since(cache.cachedAt) > EvictionTime {
+		return nil, false
+	}
+
+	return cache.MetricConfiguration, true
+}
+
+func (c *memoryCache) SetConfiguration(cloudServiceID, metricID string, config *assessment.MetricConfiguration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.configurations[configurationKey(cloudServiceID, metricID)] = cachedConfiguration{
+		cachedAt:            time.Now(),
+		MetricConfiguration: config,
+	}
+}
+
+func (c *memoryCache) EvictConfiguration(cloudServiceID, metricID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.configurations, configurationKey(cloudServiceID, metricID))
+}
+
+func (c *memoryCache) MetricVersion(metricID string) (version string, ok bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	version, ok = c.versions[metricID]
+	return
+}
+
+func (c *memoryCache) SetMetricVersion(metricID, version string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.versions[metricID] = version
+}
+
+func (c *memoryCache) EvictMetricVersion(metricID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.versions, metricID)
+}
+
+// redisKeyPrefix is the prefix of all keys used by the Redis cache.
+const redisKeyPrefix = "clouditor:assessment:"
+
+// redisTimeout is the timeout of a single Redis command. If Redis is not available, we do not want to block the
+// assessment of evidences for long.
+const redisTimeout = 2 * time.Second
+
+// redisCache is a [ConfigurationCache] that is shared between all replicas using the same Redis.
+type redisCache struct {
+	client redis.UniversalClient
+}
+
+// NewRedisCache creates a new [ConfigurationCache] backed by Redis, which can be shared by multiple replicas of the
+// assessment service. Entries expire after [EvictionTime].
+func NewRedisCache(client redis.UniversalClient) ConfigurationCache {
+	return &redisCache{client: client}
+}
+
+func (c *redisCache) Configuration(cloudServiceID, metricID string) (config *assessment.MetricConfiguration, ok bool) {
+	ctx, cancel := context.WithTimeout(context.Background(), redisTimeout)
+	defer cancel()
+
+	b, err := c.client.Get(ctx, redisKeyPrefix+"config:"+configurationKey(cloudServiceID, metricID)).Bytes()
+	if err != nil {
+		c.warn(err)
+		return nil, false
+	}
+
+	config = new(assessment.MetricConfiguration)
+	if err = proto.Unmarshal(b, config); err != nil {
+		log.Warnf("Could not unmarshal cached metric configuration: %v", err)
+		return nil, false
+	}
+
+	return config, true
+}
+
+func (c *redisCache) SetConfiguration(cloudServiceID, metricID string, config *assessment.MetricConfiguration) {
+	ctx, cancel := context.WithTimeout(context.Background(), redisTimeout)
+	defer cancel()
+
+	b, err := proto.Marshal(config)
+	if err != nil {
+		log.Warnf("Could not marshal metric configuration: %v", err)
+		return
+	}
+
+	c.warn(c.client.Set(ctx, redisKeyPrefix+"config:"+configurationKey(cloudServiceID, metricID), b, EvictionTime).Err())
+}
+
+func (c *redisCache) EvictConfiguration(cloudServiceID, metricID string) {
+	ctx, cancel := context.WithTimeout(context.Background(), redisTimeout)
+	defer cancel()
+
+	c.warn(c.client.Del(ctx, redisKeyPrefix+"config:"+configurationKey(cloudServiceID, metricID)).Err())
+}
+
+func (c *redisCache) MetricVersion(metricID string) (version string, ok bool) {
+	ctx, cancel := context.WithTimeout(context.Background(), redisTimeout)
+	defer cancel()
+
+	version, err := c.client.Get(ctx, redisKeyPrefix+"version:"+metricID).Result()
+	if err != nil {
+		c.warn(err)
+		return "", false
+	}
+
+	return version, true
+}
+
+func (c *redisCache) SetMetricVersion(metricID, version string) {
+	ctx, cancel := context.WithTimeout(context.Background(), redisTimeout)
+	defer cancel()
+
+	c.warn(c.client.Set(ctx, redisKeyPrefix+"version:"+metricID, version, EvictionTime).Err())
+}
+
+func (c *redisCache) EvictMetricVersion(metricID string) {
+	ctx, cancel := context.WithTimeout(context.Background(), redisTimeout)
+	defer cancel()
+
+	c.warn(c.client.Del(ctx, redisKeyPrefix+"version:"+metricID).Err())
+}
+
+// warn logs errors of Redis, except for missing keys.
+func (*redisCache) warn(err error) {
+	if err != nil && !errors.Is(err, redis.Nil) {
+		log.Warnf("Could not access configuration cache: %v", err)
+	}
+}
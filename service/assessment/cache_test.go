@@ -0,0 +1,125 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package assessment
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"clouditor.io/clouditor/v2/api/assessment"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// redisURLEnv is the environment variable containing the URL of the Redis used for the integration tests, e.g.,
+// "redis://localhost:6379/0". If it is not set, the integration tests are skipped.
+const redisURLEnv = "CLOUDITOR_TEST_REDIS_URL"
+
+func Test_memoryCache(t *testing.T) {
+	cache := newMemoryCache()
+	testConfigurationCache(t, cache)
+
+	// Expired configurations are not returned
+	cache.configurations[configurationKey(testdata.MockCloudServiceID1, testdata.MockMetricID1)] = cachedConfiguration{
+		cachedAt:            time.Now().Add(-2 * EvictionTime),
+		MetricConfiguration: &assessment.MetricConfiguration{},
+	}
+
+	_, ok := cache.Configuration(testdata.MockCloudServiceID1, testdata.MockMetricID1)
+	assert.False(t, ok)
+}
+
+func Test_redisCache(t *testing.T) {
+	url := os.Getenv(redisURLEnv)
+	if url == "" {
+		t.Skipf("%s not set", redisURLEnv)
+	}
+
+	opts, err := redis.ParseURL(url)
+	assert.NoError(t, err)
+
+	client := redis.NewClient(opts)
+	defer client.Close()
+
+	testConfigurationCache(t, NewRedisCache(client))
+}
+
+func Test_redisCache_unavailable(t *testing.T) {
+	client := redis.NewClient(&redis.Options{Addr: "localhost:1", MaxRetries: -1})
+	defer client.Close()
+
+	cache := NewRedisCache(client)
+
+	// Errors are treated as cache misses
+	cache.SetConfiguration(testdata.MockCloudServiceID1, testdata.MockMetricID1, &assessment.MetricConfiguration{})
+	_, ok := cache.Configuration(testdata.MockCloudServiceID1, testdata.MockMetricID1)
+	assert.False(t, ok)
+
+	cache.SetMetricVersion(testdata.MockMetricID1, "1.0.0")
+	_, ok = cache.MetricVersion(testdata.MockMetricID1)
+	assert.False(t, ok)
+}
+
+// testConfigurationCache tests the common behaviour of all [ConfigurationCache] implementations.
+func testConfigurationCache(t *testing.T, cache ConfigurationCache) {
+	config := &assessment.MetricConfiguration{
+		Operator:       "==",
+		TargetValue:    structpb.NewBoolValue(true),
+		IsDefault:      true,
+		MetricId:       testdata.MockMetricID1,
+		CloudServiceId: testdata.MockCloudServiceID1,
+	}
+
+	cache.EvictConfiguration(testdata.MockCloudServiceID1, testdata.MockMetricID1)
+	_, ok := cache.Configuration(testdata.MockCloudServiceID1, testdata.MockMetricID1)
+	assert.False(t, ok)
+
+	cache.SetConfiguration(testdata.MockCloudServiceID1, testdata.MockMetricID1, config)
+	got, ok := cache.Configuration(testdata.MockCloudServiceID1, testdata.MockMetricID1)
+	assert.True(t, ok)
+	assert.Equal(t, config, got)
+
+	// Other cloud services are not affected
+	_, ok = cache.Configuration(testdata.MockCloudServiceID2, testdata.MockMetricID1)
+	assert.False(t, ok)
+
+	cache.EvictConfiguration(testdata.MockCloudServiceID1, testdata.MockMetricID1)
+	_, ok = cache.Configuration(testdata.MockCloudServiceID1, testdata.MockMetricID1)
+	assert.False(t, ok)
+
+	cache.SetMetricVersion(testdata.MockMetricID1, "1.0.0")
+	version, ok := cache.MetricVersion(testdata.MockMetricID1)
+	assert.True(t, ok)
+	assert.Equal(t, "1.0.0", version)
+
+	cache.EvictMetricVersion(testdata.MockMetricID1)
+	_, ok = cache.MetricVersion(testdata.MockMetricID1)
+	assert.False(t, ok)
+}
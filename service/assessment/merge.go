@@ -0,0 +1,304 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package assessment
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"clouditor.io/clouditor/v2/api/evidence"
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/service"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// MergeStrategy specifies how the evidences of multiple tools for the same resource are combined before the
+// resource is assessed.
+type MergeStrategy string
+
+const (
+	// MergeFreshest assesses the resource of the most recent evidence of all tools.
+	MergeFreshest MergeStrategy = "freshest"
+
+	// MergePriority assesses the resource of the tool with the highest priority.
+	MergePriority MergeStrategy = "priority"
+
+	// MergeUnion assesses the union of the properties of the resource of all tools. If multiple tools report the same
+	// property, the value of the most recent evidence wins.
+	MergeUnion MergeStrategy = "union"
+)
+
+// ErrInvalidMergePolicy is returned if a merge policy cannot be parsed.
+var ErrInvalidMergePolicy = errors.New("invalid merge policy")
+
+// MergePolicy configures how the evidences of multiple tools for the same resource are merged.
+type MergePolicy struct {
+	Strategy MergeStrategy
+
+	// Priority contains the IDs of the tools in descending priority. Tools that are not listed have the lowest
+	// priority. It is only used by [MergePriority].
+	Priority []string
+}
+
+// ParseMergePolicy parses a merge policy of a resource type in the form "<resource type>=<strategy>", e.g.,
+// "Storage=union". The priority of the tools follows the strategy separated by a colon, e.g.,
+// "VirtualMachine=priority:tool-a>tool-b".
+func ParseMergePolicy(s string) (resourceType string, policy MergePolicy, err error) {
+	typ, strategy, found := strings.Cut(s, "=")
+
+	resourceType = strings.TrimSpace(typ)
+	if resourceType == "" || !found {
+		return "", MergePolicy{}, fmt.Errorf("%w: missing resource type in %q", ErrInvalidMergePolicy, s)
+	}
+
+	strategy, tools, _ := strings.Cut(strategy, ":")
+
+	policy.Strategy = MergeStrategy(strings.TrimSpace(strategy))
+	switch policy.Strategy {
+	case MergeFreshest, MergeUnion:
+	case MergePriority:
+		for _, tool := range strings.Split(tools, ">") {
+			if tool = strings.TrimSpace(tool); tool != "" {
+				policy.Priority = append(policy.Priority, tool)
+			}
+		}
+
+		if len(policy.Priority) == 0 {
+			return "", MergePolicy{}, fmt.Errorf("%w: missing tools in %q", ErrInvalidMergePolicy, s)
+		}
+	default:
+		return "", MergePolicy{}, fmt.Errorf("%w: unknown strategy in %q", ErrInvalidMergePolicy, s)
+	}
+
+	return resourceType, policy, nil
+}
+
+// WithEvidenceMergePolicy is an option to keep a separate track of the latest evidence of each tool for resources of
+// the given type, e.g., "VirtualMachine", and to assess the resource merged from all tracks according to the policy.
+// Otherwise, the evidences of multiple tools for the same resource are assessed independently, so that their results
+// overwrite each other. If a resource has multiple types with a policy, the most specific type is used. The tracks are
+// local to each replica of the assessment.
+func WithEvidenceMergePolicy(resourceType string, policy MergePolicy) service.Option[Service] {
+	return func(svc *Service) {
+		if svc.mergePolicies == nil {
+			svc.mergePolicies = make(map[string]MergePolicy)
+			svc.tracks = newEvidenceTracks()
+		}
+
+		svc.mergePolicies[resourceType] = policy
+	}
+}
+
+// evidenceTracks contains the latest evidence of each tool for each resource.
+type evidenceTracks struct {
+	mu sync.Mutex
+
+	// evidences contains the latest evidence, indexed by cloud service and resource ID and by tool ID
+	evidences map[[2]string]map[string]*evidence.Evidence
+}
+
+func newEvidenceTracks() *evidenceTracks {
+	return &evidenceTracks{
+		evidences: make(map[[2]string]map[string]*evidence.Evidence),
+	}
+}
+
+// put adds the evidence to the track of its tool, unless the track already contains a more recent one. It returns the
+// latest evidences of all tools for the resource, ordered from the oldest to the most recent one.
+func (t *evidenceTracks) put(ev *evidence.Evidence, resourceID string) (tracks []*evidence.Evidence) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	key := [2]string{ev.GetCloudServiceId(), resourceID}
+	if t.evidences[key] == nil {
+		t.evidences[key] = make(map[string]*evidence.Evidence)
+	}
+
+	if prev, ok := t.evidences[key][ev.GetToolId()]; !ok || !prev.GetTimestamp().AsTime().After(ev.GetTimestamp().AsTime()) {
+		t.evidences[key][ev.GetToolId()] = ev
+	}
+
+	for _, track := range t.evidences[key] {
+		tracks = append(tracks, track)
+	}
+
+	slices.SortFunc(tracks, func(a, b *evidence.Evidence) int {
+		return a.GetTimestamp().AsTime().Compare(b.GetTimestamp().AsTime())
+	})
+
+	return
+}
+
+// mergePolicy returns the merge policy of the most specific type of the resource, if any.
+func (svc *Service) mergePolicy(resource ontology.IsResource) (policy MergePolicy, ok bool) {
+	for _, typ := range ontology.ResourceTypes(resource) {
+		if policy, ok = svc.mergePolicies[typ]; ok {
+			return
+		}
+	}
+
+	return
+}
+
+// mergeEvidence returns the evidence and the resource to assess for the received evidence as well as the evidences
+// they are based on. Without a merge policy for the resource, these are the received ones.
+func (svc *Service) mergeEvidence(ev *evidence.Evidence, resource ontology.IsResource) (merged *evidence.Evidence, mergedResource ontology.IsResource, sources []*evidence.Evidence, err error) {
+	policy, ok := svc.mergePolicy(resource)
+	if !ok {
+		return ev, resource, []*evidence.Evidence{ev}, nil
+	}
+
+	tracks := svc.tracks.put(ev, resource.GetId())
+
+	switch policy.Strategy {
+	case MergePriority:
+		// The evidence of the tool with the highest priority. Among tools with the same priority, the most recent one
+		for i := len(tracks) - 1; i >= 0; i-- {
+			if merged == nil || priority(policy, tracks[i]) < priority(policy, merged) {
+				merged = tracks[i]
+			}
+		}
+	case MergeUnion:
+		return mergeUnion(tracks)
+	default:
+		merged = tracks[len(tracks)-1]
+	}
+
+	if merged == ev {
+		return ev, resource, []*evidence.Evidence{ev}, nil
+	}
+
+	mergedResource, err = evidenceResource(merged)
+
+	return merged, mergedResource, []*evidence.Evidence{merged}, err
+}
+
+// sourceIDs returns the sorted IDs of the source evidences and of their tools.
+func sourceIDs(sources []*evidence.Evidence) (evidenceIDs []string, toolIDs []string) {
+	for _, ev := range sources {
+		evidenceIDs = append(evidenceIDs, ev.GetId())
+		toolIDs = append(toolIDs, ev.GetToolId())
+	}
+
+	slices.Sort(evidenceIDs)
+	slices.Sort(toolIDs)
+
+	return
+}
+
+// priority returns the rank of the tool of the evidence according to the policy. A lower rank has a higher priority.
+func priority(policy MergePolicy, ev *evidence.Evidence) int {
+	if idx := slices.Index(policy.Priority, ev.GetToolId()); idx != -1 {
+		return idx
+	}
+
+	return len(policy.Priority)
+}
+
+// mergeUnion merges the properties of the resources of all tracks, which are of the same type as the most recent one.
+// The merged evidence is a copy of the most recent evidence containing the merged resource.
+func mergeUnion(tracks []*evidence.Evidence) (merged *evidence.Evidence, mergedResource ontology.IsResource, sources []*evidence.Evidence, err error) {
+	var (
+		latest = tracks[len(tracks)-1]
+		props  = make(map[string]any)
+		b      []byte
+	)
+
+	for _, ev := range tracks {
+		if ev.GetResource().GetTypeUrl() != latest.GetResource().GetTypeUrl() {
+			continue
+		}
+
+		m, err := ev.GetResource().UnmarshalNew()
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("could not unmarshal resource of tool %s: %w", ev.GetToolId(), err)
+		}
+
+		// Only populated properties are contained, so that they do not overwrite the ones of other tools
+		b, err = protojson.Marshal(m)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("could not marshal resource of tool %s: %w", ev.GetToolId(), err)
+		}
+
+		// Properties of more recent evidences overwrite the ones of older evidences
+		err = json.Unmarshal(b, &props)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("could not merge resource of tool %s: %w", ev.GetToolId(), err)
+		}
+
+		sources = append(sources, ev)
+	}
+
+	m, err := latest.GetResource().UnmarshalNew()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	b, err = json.Marshal(props)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("could not marshal merged resource: %w", err)
+	}
+
+	proto.Reset(m)
+	if err = protojson.Unmarshal(b, m); err != nil {
+		return nil, nil, nil, fmt.Errorf("could not unmarshal merged resource: %w", err)
+	}
+
+	mergedResource, ok := m.(ontology.IsResource)
+	if !ok {
+		return nil, nil, nil, fmt.Errorf("invalid merged resource of type %T", m)
+	}
+
+	merged = proto.Clone(latest).(*evidence.Evidence)
+	merged.Resource, err = anypb.New(m)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return merged, mergedResource, sources, nil
+}
+
+// evidenceResource extracts the resource of the evidence.
+func evidenceResource(ev *evidence.Evidence) (resource ontology.IsResource, err error) {
+	m, err := ev.GetResource().UnmarshalNew()
+	if err != nil {
+		return nil, fmt.Errorf("could not unmarshal resource of tool %s: %w", ev.GetToolId(), err)
+	}
+
+	resource, ok := m.(ontology.IsResource)
+	if !ok {
+		return nil, fmt.Errorf("invalid resource of type %T", m)
+	}
+
+	return resource, nil
+}
@@ -0,0 +1,181 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package assessment
+
+import (
+	"testing"
+	"time"
+
+	"clouditor.io/clouditor/v2/api/evidence"
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/testutil/prototest"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestParseMergePolicy(t *testing.T) {
+	type args struct {
+		s string
+	}
+	tests := []struct {
+		name             string
+		args             args
+		wantResourceType string
+		wantPolicy       MergePolicy
+		wantErr          assert.WantErr
+	}{
+		{
+			name:             "union",
+			args:             args{s: "Storage=union"},
+			wantResourceType: "Storage",
+			wantPolicy:       MergePolicy{Strategy: MergeUnion},
+			wantErr:          assert.Nil[error],
+		},
+		{
+			name:             "priority",
+			args:             args{s: "VirtualMachine=priority:tool-a>tool-b"},
+			wantResourceType: "VirtualMachine",
+			wantPolicy:       MergePolicy{Strategy: MergePriority, Priority: []string{"tool-a", "tool-b"}},
+			wantErr:          assert.Nil[error],
+		},
+		{
+			name: "priority without tools",
+			args: args{s: "VirtualMachine=priority"},
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "missing tools")
+			},
+		},
+		{
+			name: "missing resource type",
+			args: args{s: "freshest"},
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, ErrInvalidMergePolicy)
+			},
+		},
+		{
+			name: "unknown strategy",
+			args: args{s: "Storage=oldest"},
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "unknown strategy")
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotResourceType, gotPolicy, err := ParseMergePolicy(tt.args.s)
+			assert.Equal(t, tt.wantResourceType, gotResourceType)
+			assert.Equal(t, tt.wantPolicy, gotPolicy)
+			tt.wantErr(t, err)
+		})
+	}
+}
+
+func TestService_mergeEvidence(t *testing.T) {
+	var (
+		older = &evidence.Evidence{
+			Id:             testdata.MockEvidenceID1,
+			CloudServiceId: testdata.MockCloudServiceID1,
+			ToolId:         "tool-a",
+			Timestamp:      timestamppb.New(time.Unix(1, 0)),
+			Resource: prototest.NewAny(t, &ontology.VirtualMachine{
+				Id:          testdata.MockResourceID1,
+				Name:        "vm-a",
+				BootLogging: &ontology.BootLogging{Enabled: true},
+			}),
+		}
+		newer = &evidence.Evidence{
+			Id:             testdata.MockEvidenceID2,
+			CloudServiceId: testdata.MockCloudServiceID1,
+			ToolId:         "tool-b",
+			Timestamp:      timestamppb.New(time.Unix(2, 0)),
+			Resource: prototest.NewAny(t, &ontology.VirtualMachine{
+				Id:        testdata.MockResourceID1,
+				Name:      "vm-b",
+				OsLogging: &ontology.OSLogging{Enabled: true},
+			}),
+		}
+	)
+
+	// merge assesses older after newer, like an evidence that arrives late, and returns the assessed evidence
+	merge := func(t *testing.T, svc *Service) (*evidence.Evidence, ontology.IsResource, []string) {
+		for _, ev := range []*evidence.Evidence{newer, older} {
+			resource, err := evidenceResource(ev)
+			assert.NoError(t, err)
+
+			merged, mergedResource, sources, err := svc.mergeEvidence(ev, resource)
+			assert.NoError(t, err)
+
+			if ev == older {
+				_, tools := sourceIDs(sources)
+				return merged, mergedResource, tools
+			}
+		}
+
+		return nil, nil, nil
+	}
+
+	t.Run("without policy", func(t *testing.T) {
+		merged, _, tools := merge(t, NewService(WithEvidenceMergePolicy("Storage", MergePolicy{Strategy: MergeUnion})))
+		assert.Equal(t, older, merged)
+		assert.Equal(t, []string{"tool-a"}, tools)
+	})
+
+	t.Run("freshest", func(t *testing.T) {
+		merged, resource, tools := merge(t, NewService(WithEvidenceMergePolicy("VirtualMachine", MergePolicy{Strategy: MergeFreshest})))
+		assert.Equal(t, newer, merged)
+		assert.Equal(t, "vm-b", resource.GetName())
+		assert.Equal(t, []string{"tool-b"}, tools)
+	})
+
+	t.Run("priority", func(t *testing.T) {
+		merged, resource, tools := merge(t, NewService(WithEvidenceMergePolicy("Compute", MergePolicy{
+			Strategy: MergePriority,
+			Priority: []string{"tool-a"},
+		})))
+		assert.Equal(t, older, merged)
+		assert.Equal(t, "vm-a", resource.GetName())
+		assert.Equal(t, []string{"tool-a"}, tools)
+	})
+
+	t.Run("union", func(t *testing.T) {
+		merged, resource, tools := merge(t, NewService(WithEvidenceMergePolicy("VirtualMachine", MergePolicy{Strategy: MergeUnion})))
+		assert.Equal(t, newer.Id, merged.Id)
+		assert.Equal(t, []string{"tool-a", "tool-b"}, tools)
+
+		vm, ok := resource.(*ontology.VirtualMachine)
+		assert.True(t, ok)
+		assert.Equal(t, "vm-b", vm.Name)
+		assert.True(t, vm.GetBootLogging().GetEnabled())
+		assert.True(t, vm.GetOsLogging().GetEnabled())
+
+		// The merged evidence contains the merged resource
+		got, err := evidenceResource(merged)
+		assert.NoError(t, err)
+		assert.Equal(t, resource, got)
+	})
+}
@@ -0,0 +1,192 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package assessment
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"clouditor.io/clouditor/v2/api/assessment"
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/service"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// policyFile is the name of the file containing the Rego implementation of a metric within its directory.
+const policyFile = "metric.rego"
+
+// policyWatcher holds the metric implementations that are loaded from a local policies directory, which is watched for
+// changes.
+type policyWatcher struct {
+	mu sync.RWMutex
+
+	// dir is the watched directory, containing one directory per metric, e.g., policies/bundles
+	dir string
+
+	watcher *fsnotify.Watcher
+
+	// impls contains the implementations loaded from dir, with the key being the metric ID
+	impls map[string]*assessment.MetricImplementation
+}
+
+// WithPolicyDirectory is an option to load the Rego implementations of the metrics from a local directory, e.g.,
+// policies/bundles, instead of retrieving them from the orchestrator or the metric bundle. The directory contains one
+// directory per metric with its implementation in a metric.rego file. It is watched for changes, so that changed
+// implementations are used without restarting the service. This is mainly intended to speed up the development of
+// metrics against live evidences.
+func WithPolicyDirectory(dir string) service.Option[Service] {
+	return func(svc *Service) {
+		svc.policies = &policyWatcher{
+			dir:   dir,
+			impls: make(map[string]*assessment.MetricImplementation),
+		}
+	}
+}
+
+// startPolicyWatcher loads all implementations of the policies directory and starts watching it in the background.
+func (svc *Service) startPolicyWatcher() (err error) {
+	var entries []os.DirEntry
+
+	svc.policies.watcher, err = fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+
+	// fsnotify does not watch recursively, so we need to watch each metric directory as well
+	err = svc.policies.watcher.Add(svc.policies.dir)
+	if err != nil {
+		return err
+	}
+
+	entries, err = os.ReadDir(svc.policies.dir)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() {
+			svc.watchPolicy(filepath.Join(svc.policies.dir, entry.Name()))
+		}
+	}
+
+	go func() {
+		for {
+			select {
+			case event, ok := <-svc.policies.watcher.Events:
+				if !ok {
+					return
+				}
+
+				svc.handlePolicyEvent(event)
+			case err, ok := <-svc.policies.watcher.Errors:
+				if !ok {
+					return
+				}
+
+				log.Warnf("Could not watch policies directory: %v", err)
+			}
+		}
+	}()
+
+	log.Infof("Watching %s for changes of metric implementations", svc.policies.dir)
+
+	return nil
+}
+
+// handlePolicyEvent handles a file system event in the policies directory. New metric directories are watched and the
+// implementations of changed metric.rego files are reloaded.
+func (svc *Service) handlePolicyEvent(event fsnotify.Event) {
+	// A new metric directory was created
+	if filepath.Dir(event.Name) == filepath.Clean(svc.policies.dir) {
+		if fi, err := os.Stat(event.Name); err == nil && fi.IsDir() {
+			svc.watchPolicy(event.Name)
+		}
+
+		return
+	}
+
+	if filepath.Base(event.Name) != policyFile || event.Op == fsnotify.Chmod {
+		return
+	}
+
+	svc.reloadPolicy(filepath.Base(filepath.Dir(event.Name)))
+}
+
+// watchPolicy watches the given metric directory and loads its implementation.
+func (svc *Service) watchPolicy(dir string) {
+	if err := svc.policies.watcher.Add(dir); err != nil {
+		log.Warnf("Could not watch %s: %v", dir, err)
+	}
+
+	svc.reloadPolicy(filepath.Base(dir))
+}
+
+// reloadPolicy (re-)loads the implementation of the metric from the policies directory and evicts the compiled queries
+// of the metric. If the implementation was removed, the metric falls back to the implementation of the orchestrator.
+func (svc *Service) reloadPolicy(metricID string) {
+	b, err := os.ReadFile(filepath.Join(svc.policies.dir, metricID, policyFile))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		log.Warnf("Could not read implementation of metric %s: %v", metricID, err)
+		return
+	}
+
+	svc.policies.mu.Lock()
+	if err != nil {
+		delete(svc.policies.impls, metricID)
+	} else {
+		svc.policies.impls[metricID] = &assessment.MetricImplementation{
+			MetricId: metricID,
+			Lang:     assessment.MetricImplementation_LANGUAGE_REGO,
+			Code:     string(b),
+		}
+	}
+	svc.policies.mu.Unlock()
+
+	log.Debugf("Reloaded implementation of metric %s from %s", metricID, svc.policies.dir)
+
+	svc.handleMetricEvent(&orchestrator.MetricChangeEvent{
+		Type:     orchestrator.MetricChangeEvent_TYPE_IMPLEMENTATION_CHANGED,
+		MetricId: metricID,
+	})
+}
+
+// localImplementation returns the implementation of the metric loaded from the policies directory, if it is configured
+// and contains the metric.
+func (svc *Service) localImplementation(metricID string) (impl *assessment.MetricImplementation, ok bool) {
+	if svc.policies == nil {
+		return nil, false
+	}
+
+	svc.policies.mu.RLock()
+	defer svc.policies.mu.RUnlock()
+
+	impl, ok = svc.policies.impls[metricID]
+
+	return
+}
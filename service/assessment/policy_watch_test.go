@@ -0,0 +1,91 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package assessment
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"clouditor.io/clouditor/v2/api/assessment"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+)
+
+func TestService_policyWatcher(t *testing.T) {
+	var (
+		dir  = t.TempDir()
+		file = filepath.Join(dir, testdata.MockMetricID1, policyFile)
+	)
+
+	assert.NoError(t, os.Mkdir(filepath.Dir(file), 0755))
+	assert.NoError(t, os.WriteFile(file, []byte("package clouditor.metrics.mock_metric_1"), 0644))
+
+	svc := NewService(WithPolicyDirectory(dir))
+	defer svc.Shutdown()
+
+	// The implementation is loaded on start
+	impl, err := svc.MetricImplementation(assessment.MetricImplementation_LANGUAGE_REGO, testdata.MockMetricID1)
+	assert.NoError(t, err)
+	assert.Equal(t, "package clouditor.metrics.mock_metric_1", impl.Code)
+
+	// A changed implementation is reloaded in the background
+	assert.NoError(t, os.WriteFile(file, []byte("package clouditor.metrics.mock_metric_1\n\napplicable := true"), 0644))
+	waitForImplementation(t, svc, testdata.MockMetricID1, "package clouditor.metrics.mock_metric_1\n\napplicable := true")
+
+	// Implementations of new metric directories are loaded as well
+	file = filepath.Join(dir, testdata.MockMetricID2, policyFile)
+	assert.NoError(t, os.Mkdir(filepath.Dir(file), 0755))
+	time.Sleep(50 * time.Millisecond)
+	assert.NoError(t, os.WriteFile(file, []byte("package clouditor.metrics.mock_metric_2"), 0644))
+	waitForImplementation(t, svc, testdata.MockMetricID2, "package clouditor.metrics.mock_metric_2")
+
+	// A removed implementation is no longer used
+	assert.NoError(t, os.Remove(file))
+	for i := 0; i < 100; i++ {
+		if _, ok := svc.localImplementation(testdata.MockMetricID2); !ok {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	t.Errorf("implementation of %s was not removed", testdata.MockMetricID2)
+}
+
+// waitForImplementation waits until the local implementation of the metric has the expected code.
+func waitForImplementation(t *testing.T, svc *Service, metricID string, code string) {
+	t.Helper()
+
+	for i := 0; i < 100; i++ {
+		if impl, ok := svc.localImplementation(metricID); ok && impl.Code == code {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	t.Errorf("implementation of %s was not reloaded", metricID)
+}
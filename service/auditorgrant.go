@@ -0,0 +1,144 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package service
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// AuditorGrantHeader is the (lower-case) header that contains the token of an auditor grant.
+const AuditorGrantHeader = "x-auditor-grant"
+
+// AuditorGrantMethods contains the RPCs that can be called using an auditor grant. These only read the cloud service,
+// its catalog and the assessment and evaluation results.
+var AuditorGrantMethods = []string{
+	"/clouditor.orchestrator.v1.Orchestrator/GetCloudService",
+	"/clouditor.orchestrator.v1.Orchestrator/GetCloudServiceStatistics",
+	"/clouditor.orchestrator.v1.Orchestrator/GetTargetOfEvaluation",
+	"/clouditor.orchestrator.v1.Orchestrator/GetCatalog",
+	"/clouditor.orchestrator.v1.Orchestrator/GetCategory",
+	"/clouditor.orchestrator.v1.Orchestrator/GetControl",
+	"/clouditor.orchestrator.v1.Orchestrator/ListControls",
+	"/clouditor.orchestrator.v1.Orchestrator/GetMetric",
+	"/clouditor.orchestrator.v1.Orchestrator/ListMetrics",
+	"/clouditor.orchestrator.v1.Orchestrator/GetMetricConfiguration",
+	"/clouditor.orchestrator.v1.Orchestrator/ListMetricConfigurations",
+	"/clouditor.orchestrator.v1.Orchestrator/GetAssessmentResult",
+	"/clouditor.orchestrator.v1.Orchestrator/ListAssessmentResults",
+	"/clouditor.orchestrator.v1.Orchestrator/GetAssessmentStatistics",
+	"/clouditor.orchestrator.v1.Orchestrator/GetWaiver",
+	"/clouditor.orchestrator.v1.Orchestrator/ListWaivers",
+	"/clouditor.evaluation.v1.Evaluation/ListEvaluationResults",
+}
+
+// AuditorGrantScope contains what a verified auditor grant permits to read.
+type AuditorGrantScope struct {
+	// GrantID is the ID of the auditor grant, which identifies the auditor, e.g., in the audit log.
+	GrantID string
+
+	// CloudServiceID is the cloud service the auditor is allowed to read.
+	CloudServiceID string
+
+	// CatalogID is the catalog the auditor is allowed to read.
+	CatalogID string
+}
+
+// AuditorGrantVerifier is an interface that verifies the token of an auditor grant, e.g., by the Orchestrator, and
+// returns the scope of the grant.
+type AuditorGrantVerifier interface {
+	VerifyAuditorGrant(ctx context.Context, token string) (scope *AuditorGrantScope, err error)
+}
+
+// auditorGrantScopeKey is the context key for the scope of a verified auditor grant.
+type auditorGrantScopeKey struct{}
+
+// WithAuditorGrantScope returns a copy of ctx, which marks the request as authenticated by an auditor grant with the
+// given scope.
+func WithAuditorGrantScope(ctx context.Context, scope *AuditorGrantScope) context.Context {
+	return context.WithValue(ctx, auditorGrantScopeKey{}, scope)
+}
+
+// AuditorGrantScopeFromContext retrieves the scope of the auditor grant the request was authenticated with. If the
+// request was not authenticated by an auditor grant, ok is false.
+func AuditorGrantScopeFromContext(ctx context.Context) (scope *AuditorGrantScope, ok bool) {
+	if ctx == nil {
+		return nil, false
+	}
+
+	scope, ok = ctx.Value(auditorGrantScopeKey{}).(*AuditorGrantScope)
+
+	return
+}
+
+// CheckAuditorGrantScope checks whether the request is within the scope of the auditor grant the context was
+// authenticated with. The cloud_service_id and catalog_id fields of the request and of its filter need to match the
+// scope. Empty fields are set to the scope, so that list requests are narrowed down to it. If the context was not
+// authenticated by an auditor grant, all requests are within scope.
+func CheckAuditorGrantScope(ctx context.Context, req any) error {
+	scope, ok := AuditorGrantScopeFromContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return ErrPermissionDenied
+	}
+
+	m := msg.ProtoReflect()
+	if !restrictField(m, "cloud_service_id", scope.CloudServiceID) || !restrictField(m, "catalog_id", scope.CatalogID) {
+		return ErrPermissionDenied
+	}
+
+	if fd := m.Descriptor().Fields().ByName("filter"); fd != nil && fd.Message() != nil && !fd.IsList() {
+		filter := m.Mutable(fd).Message()
+		if !restrictField(filter, "cloud_service_id", scope.CloudServiceID) || !restrictField(filter, "catalog_id", scope.CatalogID) {
+			return ErrPermissionDenied
+		}
+	}
+
+	return nil
+}
+
+// restrictField restricts the string field with the given name of m to value. An empty field is set to value. It
+// returns false, if the field is set to a different value.
+func restrictField(m protoreflect.Message, name protoreflect.Name, value string) bool {
+	fd := m.Descriptor().Fields().ByName(name)
+	if fd == nil || fd.Kind() != protoreflect.StringKind || fd.IsList() {
+		return true
+	}
+
+	if current := m.Get(fd).String(); current != "" {
+		return current == value
+	}
+
+	m.Set(fd, protoreflect.ValueOfString(value))
+
+	return true
+}
@@ -0,0 +1,165 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	"clouditor.io/clouditor/v2/api/evaluation"
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/util"
+)
+
+var mockAuditorGrantScope = &AuditorGrantScope{
+	GrantID:        testdata.MockCloudServiceID2,
+	CloudServiceID: testdata.MockCloudServiceID1,
+	CatalogID:      testdata.MockCatalogID,
+}
+
+func TestCheckAuditorGrantScope(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req any
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantReq assert.Want[any]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "not authenticated by auditor grant",
+			args: args{
+				ctx: context.Background(),
+				req: &orchestrator.GetCloudServiceRequest{CloudServiceId: testdata.MockCloudServiceID2},
+			},
+			wantReq: func(t *testing.T, got any) bool {
+				return assert.Equal[any](t, &orchestrator.GetCloudServiceRequest{CloudServiceId: testdata.MockCloudServiceID2}, got)
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "cloud service and catalog of grant",
+			args: args{
+				ctx: WithAuditorGrantScope(context.Background(), mockAuditorGrantScope),
+				req: &orchestrator.GetTargetOfEvaluationRequest{
+					CloudServiceId: testdata.MockCloudServiceID1,
+					CatalogId:      testdata.MockCatalogID,
+				},
+			},
+			wantReq: assert.NotNil[any],
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "other cloud service",
+			args: args{
+				ctx: WithAuditorGrantScope(context.Background(), mockAuditorGrantScope),
+				req: &orchestrator.GetCloudServiceRequest{CloudServiceId: testdata.MockCloudServiceID2},
+			},
+			wantReq: assert.NotNil[any],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, ErrPermissionDenied)
+			},
+		},
+		{
+			name: "other catalog",
+			args: args{
+				ctx: WithAuditorGrantScope(context.Background(), mockAuditorGrantScope),
+				req: &orchestrator.GetCatalogRequest{CatalogId: "other"},
+			},
+			wantReq: assert.NotNil[any],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, ErrPermissionDenied)
+			},
+		},
+		{
+			name: "other catalog in filter",
+			args: args{
+				ctx: WithAuditorGrantScope(context.Background(), mockAuditorGrantScope),
+				req: &evaluation.ListEvaluationResultsRequest{
+					Filter: &evaluation.ListEvaluationResultsRequest_Filter{CatalogId: util.Ref("other")},
+				},
+			},
+			wantReq: assert.NotNil[any],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, ErrPermissionDenied)
+			},
+		},
+		{
+			name: "list request is narrowed down",
+			args: args{
+				ctx: WithAuditorGrantScope(context.Background(), mockAuditorGrantScope),
+				req: &evaluation.ListEvaluationResultsRequest{},
+			},
+			wantReq: func(t *testing.T, got any) bool {
+				return assert.Equal[any](t, &evaluation.ListEvaluationResultsRequest{
+					Filter: &evaluation.ListEvaluationResultsRequest_Filter{
+						CloudServiceId: util.Ref(testdata.MockCloudServiceID1),
+						CatalogId:      util.Ref(testdata.MockCatalogID),
+					},
+				}, got)
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "no proto message",
+			args: args{
+				ctx: WithAuditorGrantScope(context.Background(), mockAuditorGrantScope),
+				req: "request",
+			},
+			wantReq: assert.NotNil[any],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, ErrPermissionDenied)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.wantErr(t, CheckAuditorGrantScope(tt.args.ctx, tt.args.req))
+			tt.wantReq(t, tt.args.req)
+		})
+	}
+}
+
+func TestAuthorizationStrategyJWT_AllowedCloudServices_auditorGrant(t *testing.T) {
+	a := &AuthorizationStrategyJWT{CloudServicesKey: TestCustomClaims, AllowAllKey: TestAllowAllClaims}
+
+	all, list := a.AllowedCloudServices(WithAuditorGrantScope(TestContextAllowAll, mockAuditorGrantScope))
+	assert.False(t, all)
+	assert.Equal(t, []string{testdata.MockCloudServiceID1}, list)
+}
+
+func TestRBAC_Authorize_auditorGrant(t *testing.T) {
+	r := NewRBAC(&AuthorizationStrategyJWT{})
+	ctx := WithAuditorGrantScope(context.Background(), mockAuditorGrantScope)
+
+	assert.NoError(t, r.Authorize(ctx, "/clouditor.evaluation.v1.Evaluation/ListEvaluationResults", nil))
+	assert.ErrorIs(t, r.Authorize(ctx, "/clouditor.orchestrator.v1.Orchestrator/ListCloudServices", nil), ErrPermissionDenied)
+	assert.ErrorIs(t, r.Authorize(ctx, "/clouditor.orchestrator.v1.Orchestrator/RemoveCloudService", nil), ErrPermissionDenied)
+}
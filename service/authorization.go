@@ -27,13 +27,13 @@ package service
 
 import (
 	"context"
+	"errors"
 	"slices"
+	"strings"
 
 	"clouditor.io/clouditor/v2/api"
 	"github.com/golang-jwt/jwt/v5"
 	grpc_auth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 // RequestType specifies the type of request, usually CRUD.
@@ -46,8 +46,11 @@ const (
 	AccessDelete
 )
 
+// DefaultRolesKey is the default JWT claim key that contains the roles of a user.
+const DefaultRolesKey = "roles"
+
 // ErrPermissionDenied represents an error, where permission to fulfill the request is denied.
-var ErrPermissionDenied = status.Errorf(codes.PermissionDenied, "access denied")
+var ErrPermissionDenied = api.ErrorCodePermissionDenied.Err()
 
 // AuthorizationStrategy is an interface that implements a function which
 // checkers whether the current cloud service request can be fulfilled using the
@@ -57,11 +60,71 @@ type AuthorizationStrategy interface {
 	AllowedCloudServices(ctx context.Context) (all bool, IDs []string)
 }
 
+// RoleChecker is an optional interface of an AuthorizationStrategy, which checks whether the current user (supplied
+// by the context) has a specific role. This is used for actions that need more than access to a cloud service, such as
+// accepting a risk.
+type RoleChecker interface {
+	HasRole(ctx context.Context, role string) bool
+}
+
+// UserIdentifier is an optional interface of an AuthorizationStrategy, which retrieves the identifier of the current
+// user (supplied by the context). This is used to look up the organizations (tenants) a user belongs to.
+type UserIdentifier interface {
+	CurrentUser(ctx context.Context) (user string, ok bool)
+}
+
+// TagRetriever is an optional interface of an AuthorizationStrategy, which retrieves the tags of the cloud services the
+// current user (supplied by the context) is allowed to access. This allows to grant access to a whole fleet of cloud
+// services at once.
+type TagRetriever interface {
+	AllowedTags(ctx context.Context) (tags []string)
+}
+
+// ScopeRetriever is an optional interface of an AuthorizationStrategy, which retrieves the OAuth 2.0 scopes of the
+// current token (supplied by the context). This is used to restrict narrowly scoped tokens, e.g., of our own services,
+// to the RPCs of their scopes.
+type ScopeRetriever interface {
+	Scopes(ctx context.Context) (scopes []string)
+}
+
+// DefaultScopeKey is the JWT claim key that contains the space-separated scopes of a token according to RFC 8693.
+// Additionally, the "scp" claim that is used by some providers, e.g., Entra ID, is considered.
+const DefaultScopeKey = "scope"
+
+// DefaultUserKey is the default JWT claim key that contains the identifier of a user.
+const DefaultUserKey = "sub"
+
 // AuthorizationStrategyJWT is an AuthorizationStrategy that expects a list of cloud service IDs to be in a specific JWT
 // claim key.
 type AuthorizationStrategyJWT struct {
 	CloudServicesKey string
 	AllowAllKey      string
+
+	// RolesKey is the claim key that contains the roles of the user. If empty, DefaultRolesKey is used.
+	RolesKey string
+
+	// TagsKey is the claim key that contains the tags of the cloud services the user is allowed to access. If empty,
+	// no tag-based access is granted.
+	TagsKey string
+
+	// UserKey is the claim key that contains the identifier of the user. If empty, DefaultUserKey is used.
+	UserKey string
+
+	// Issuers maps the issuer ("iss" claim) of a token to the claim keys that are used by this issuer. Non-empty keys of
+	// the mapping take precedence over the keys above. This way, tokens of several OpenID Connect providers, e.g.,
+	// Keycloak and Entra ID, can be used at the same time.
+	Issuers map[string]ClaimMapping
+}
+
+// ClaimMapping specifies the claim keys that an issuer uses for the information needed by [AuthorizationStrategyJWT].
+// A key can refer to a nested claim using dots, e.g., "realm_access.roles" for the realm roles of Keycloak, unless a
+// claim with exactly this key exists, which is common for namespaced claims of Auth0.
+type ClaimMapping struct {
+	CloudServicesKey string
+	AllowAllKey      string
+	RolesKey         string
+	TagsKey          string
+	UserKey          string
 }
 
 // CheckAccess checks whether the current request can be fulfilled using the current access strategy.
@@ -86,40 +149,36 @@ func (a *AuthorizationStrategyJWT) AllowedCloudServices(ctx context.Context) (al
 	var (
 		err    error
 		ok     bool
-		token  string
 		claims jwt.MapClaims
 		l      []interface{}
 		s      string
 	)
 
-	// Check, if the context is nil
-	if ctx == nil {
-		log.Debugf("Retrieving allowed cloud services failed because of an empty context")
-		return false, nil
+	// Requests authenticated by an API key are restricted to the cloud services of the API key
+	if list, ok = APIKeyScopeFromContext(ctx); ok {
+		return false, list
 	}
 
-	// Retrieve the raw token from the context
-	token, err = grpc_auth.AuthFromMD(ctx, "bearer")
-	if err != nil {
-		log.Debugf("Retrieving allowed cloud services from token failed: %v", err)
-		return false, nil
+	// Requests authenticated by an auditor grant are restricted to the cloud service of the grant
+	if scope, ok := AuditorGrantScopeFromContext(ctx); ok {
+		return false, []string{scope.CloudServiceID}
 	}
 
-	// We need to re-parse the already validated claim to get a specific key from the claims map.
-	parser := jwt.NewParser()
-	_, _, err = parser.ParseUnverified(token, &claims)
+	claims, err = claimsFromContext(ctx)
 	if err != nil {
-		log.Debugf("Retrieving allowed cloud services from token failed: %v", err)
+		log.Debugf("Retrieving allowed cloud services failed: %v", err)
 		return false, nil
 	}
 
+	keys := a.keys(claims)
+
 	// Let's look for an allow all key
-	if b, ok := claims[a.AllowAllKey].(bool); ok && b {
+	if b, ok := claimValue(claims, keys.AllowAllKey).(bool); ok && b {
 		return true, nil
 	}
 
 	// We are looking for an array claim
-	if l, ok = claims[a.CloudServicesKey].([]interface{}); !ok {
+	if l, ok = claimValue(claims, keys.CloudServicesKey).([]interface{}); !ok {
 		log.Debug("Retrieving allowed cloud services from token failed: specified claims key is not an array", err)
 		return false, nil
 	}
@@ -136,6 +195,177 @@ func (a *AuthorizationStrategyJWT) AllowedCloudServices(ctx context.Context) (al
 	return false, list
 }
 
+// AllowedTags retrieves the tags of the cloud services the user is allowed to access from the array claim specified
+// by TagsKey.
+func (a *AuthorizationStrategyJWT) AllowedTags(ctx context.Context) (tags []string) {
+	claims, err := claimsFromContext(ctx)
+	if err != nil {
+		log.Debugf("Retrieving allowed tags failed: %v", err)
+		return nil
+	}
+
+	keys := a.keys(claims)
+	if keys.TagsKey == "" {
+		return nil
+	}
+
+	l, ok := claimValue(claims, keys.TagsKey).([]interface{})
+	if !ok {
+		return nil
+	}
+
+	for _, item := range l {
+		if s, ok := item.(string); ok {
+			tags = append(tags, s)
+		}
+	}
+
+	return tags
+}
+
+// HasRole checks whether the roles claim of the current user contains the given role. The claim can either be a single
+// string or an array of strings.
+func (a *AuthorizationStrategyJWT) HasRole(ctx context.Context, role string) bool {
+	claims, err := claimsFromContext(ctx)
+	if err != nil {
+		log.Debugf("Retrieving roles failed: %v", err)
+		return false
+	}
+
+	switch v := claimValue(claims, a.keys(claims).RolesKey).(type) {
+	case string:
+		return v == role
+	case []interface{}:
+		return slices.Contains(v, interface{}(role))
+	default:
+		return false
+	}
+}
+
+// CurrentUser retrieves the identifier of the current user from the claim specified by UserKey, which is the "sub"
+// claim by default.
+func (a *AuthorizationStrategyJWT) CurrentUser(ctx context.Context) (user string, ok bool) {
+	claims, err := claimsFromContext(ctx)
+	if err != nil {
+		log.Debugf("Retrieving current user failed: %v", err)
+		return "", false
+	}
+
+	user, ok = claimValue(claims, a.keys(claims).UserKey).(string)
+	if !ok || user == "" {
+		return "", false
+	}
+
+	return user, true
+}
+
+// Scopes retrieves the scopes of the current token from the "scope" claim or, if not present, the "scp" claim. The
+// claims can either be a space-separated string or an array of strings.
+func (a *AuthorizationStrategyJWT) Scopes(ctx context.Context) (scopes []string) {
+	claims, err := claimsFromContext(ctx)
+	if err != nil {
+		log.Debugf("Retrieving scopes failed: %v", err)
+		return nil
+	}
+
+	v, ok := claims[DefaultScopeKey]
+	if !ok {
+		v = claims["scp"]
+	}
+
+	switch v := v.(type) {
+	case string:
+		return strings.Fields(v)
+	case []interface{}:
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				scopes = append(scopes, s)
+			}
+		}
+	}
+
+	return scopes
+}
+
+// keys returns the claim keys that apply to the issuer of the claims.
+func (a *AuthorizationStrategyJWT) keys(claims jwt.MapClaims) (keys ClaimMapping) {
+	keys = ClaimMapping{
+		CloudServicesKey: a.CloudServicesKey,
+		AllowAllKey:      a.AllowAllKey,
+		RolesKey:         firstNonEmpty(a.RolesKey, DefaultRolesKey),
+		TagsKey:          a.TagsKey,
+		UserKey:          firstNonEmpty(a.UserKey, DefaultUserKey),
+	}
+
+	iss, _ := claims.GetIssuer()
+	m, ok := a.Issuers[iss]
+	if !ok {
+		return keys
+	}
+
+	keys.CloudServicesKey = firstNonEmpty(m.CloudServicesKey, keys.CloudServicesKey)
+	keys.AllowAllKey = firstNonEmpty(m.AllowAllKey, keys.AllowAllKey)
+	keys.RolesKey = firstNonEmpty(m.RolesKey, keys.RolesKey)
+	keys.TagsKey = firstNonEmpty(m.TagsKey, keys.TagsKey)
+	keys.UserKey = firstNonEmpty(m.UserKey, keys.UserKey)
+
+	return keys
+}
+
+// firstNonEmpty returns the first of the given values that is not empty.
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+
+	return ""
+}
+
+// claimValue retrieves the value of the claim with the given key. If there is no such claim, the key is treated as
+// a dot-separated path to a nested claim.
+func claimValue(claims jwt.MapClaims, key string) any {
+	if v, ok := claims[key]; ok || !strings.Contains(key, ".") {
+		return v
+	}
+
+	var v any = map[string]any(claims)
+	for _, part := range strings.Split(key, ".") {
+		m, ok := v.(map[string]any)
+		if !ok {
+			return nil
+		}
+
+		v = m[part]
+	}
+
+	return v
+}
+
+// claimsFromContext parses the claims of the (already validated) bearer token in the context.
+func claimsFromContext(ctx context.Context) (claims jwt.MapClaims, err error) {
+	// Check, if the context is nil
+	if ctx == nil {
+		return nil, errors.New("empty context")
+	}
+
+	// Retrieve the raw token from the context
+	token, err := grpc_auth.AuthFromMD(ctx, "bearer")
+	if err != nil {
+		return nil, err
+	}
+
+	// We need to re-parse the already validated claim to get a specific key from the claims map.
+	parser := jwt.NewParser()
+	_, _, err = parser.ParseUnverified(token, &claims)
+	if err != nil {
+		return nil, err
+	}
+
+	return claims, nil
+}
+
 // AuthorizationStrategyAllowAll is an AuthorizationStrategy that allows all requests.
 type AuthorizationStrategyAllowAll struct{}
 
@@ -150,3 +380,9 @@ func (*AuthorizationStrategyAllowAll) CheckAccess(_ context.Context, _ RequestTy
 func (*AuthorizationStrategyAllowAll) AllowedCloudServices(_ context.Context) (all bool, list []string) {
 	return true, nil
 }
+
+// HasRole checks whether the current user has the given role. Returns true since strategy is
+// `AuthorizationStrategyAllowAll`
+func (*AuthorizationStrategyAllowAll) HasRole(_ context.Context, _ string) bool {
+	return true
+}
@@ -64,12 +64,15 @@ var (
 			testdata.MockCloudServiceID1,
 		},
 		"other": []int{1, 2},
+		"roles": []string{"risk-manager"},
+		"tags":  []string{"team:payments"},
 	}
 
 	// TestClaimsOnlyService1 contains claims that authorize the user for all cloud services.
 	TestClaimsAllowAll = jwt.MapClaims{
 		"sub":     "me",
 		"cladmin": true,
+		"groups":  "admin",
 	}
 )
 
@@ -370,3 +373,268 @@ func TestAuthorizationStrategyJWT_AllowedCloudServices(t *testing.T) {
 		})
 	}
 }
+
+func TestAuthorizationStrategyJWT_HasRole(t *testing.T) {
+	type fields struct {
+		RolesKey string
+	}
+	type args struct {
+		ctx  context.Context
+		role string
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		want   bool
+	}{
+		{
+			name: "role in default array claim",
+			args: args{
+				ctx:  TestContextOnlyService1,
+				role: "risk-manager",
+			},
+			want: true,
+		},
+		{
+			name: "role not in array claim",
+			args: args{
+				ctx:  TestContextOnlyService1,
+				role: "admin",
+			},
+			want: false,
+		},
+		{
+			name: "role in custom string claim",
+			fields: fields{
+				RolesKey: "groups",
+			},
+			args: args{
+				ctx:  TestContextAllowAll,
+				role: "admin",
+			},
+			want: true,
+		},
+		{
+			name: "missing claim",
+			args: args{
+				ctx:  TestContextAllowAll,
+				role: "admin",
+			},
+			want: false,
+		},
+		{
+			name: "broken token",
+			args: args{
+				ctx:  TestBrokenContext,
+				role: "risk-manager",
+			},
+			want: false,
+		},
+		{
+			name: "no context",
+			args: args{
+				role: "risk-manager",
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := &AuthorizationStrategyJWT{
+				RolesKey: tt.fields.RolesKey,
+			}
+
+			assert.Equal(t, tt.want, a.HasRole(tt.args.ctx, tt.args.role))
+		})
+	}
+}
+
+func TestAuthorizationStrategyJWT_CurrentUser(t *testing.T) {
+	type args struct {
+		ctx context.Context
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantUser string
+		wantOk   bool
+	}{
+		{
+			name: "subject in claims",
+			args: args{
+				ctx: TestContextOnlyService1,
+			},
+			wantUser: "me",
+			wantOk:   true,
+		},
+		{
+			name: "broken token",
+			args: args{
+				ctx: TestBrokenContext,
+			},
+			wantUser: "",
+			wantOk:   false,
+		},
+		{
+			name:     "no context",
+			args:     args{},
+			wantUser: "",
+			wantOk:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := &AuthorizationStrategyJWT{}
+
+			gotUser, gotOk := a.CurrentUser(tt.args.ctx)
+			assert.Equal(t, tt.wantUser, gotUser)
+			assert.Equal(t, tt.wantOk, gotOk)
+		})
+	}
+}
+
+func TestAuthorizationStrategyJWT_AllowedTags(t *testing.T) {
+	type fields struct {
+		TagsKey string
+	}
+	type args struct {
+		ctx context.Context
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		want   []string
+	}{
+		{
+			name: "no tags key",
+			args: args{
+				ctx: TestContextOnlyService1,
+			},
+			want: nil,
+		},
+		{
+			name: "tags in claim",
+			fields: fields{
+				TagsKey: "tags",
+			},
+			args: args{
+				ctx: TestContextOnlyService1,
+			},
+			want: []string{"team:payments"},
+		},
+		{
+			name: "missing claim",
+			fields: fields{
+				TagsKey: "tags",
+			},
+			args: args{
+				ctx: TestContextAllowAll,
+			},
+			want: nil,
+		},
+		{
+			name: "broken token",
+			fields: fields{
+				TagsKey: "tags",
+			},
+			args: args{
+				ctx: TestBrokenContext,
+			},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := &AuthorizationStrategyJWT{
+				TagsKey: tt.fields.TagsKey,
+			}
+
+			assert.Equal(t, tt.want, a.AllowedTags(tt.args.ctx))
+		})
+	}
+}
+
+func TestAuthorizationStrategyJWT_Issuers(t *testing.T) {
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"iss": "https://keycloak/realms/clouditor",
+		"sub": "f81d4fae",
+		"realm_access": map[string]any{
+			"roles": []string{"auditor"},
+		},
+		"https://clouditor.io/cloud_services": []string{testdata.MockCloudServiceID2},
+		"preferred_username":                  "alice",
+	})
+	s, err := token.SignedString([]byte("mykey"))
+	assert.NoError(t, err)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.New(map[string]string{
+		"authorization": "bearer " + s,
+	}))
+
+	a := &AuthorizationStrategyJWT{
+		CloudServicesKey: TestCustomClaims,
+		Issuers: map[string]ClaimMapping{
+			"https://keycloak/realms/clouditor": {
+				CloudServicesKey: "https://clouditor.io/cloud_services",
+				RolesKey:         "realm_access.roles",
+				UserKey:          "preferred_username",
+			},
+		},
+	}
+
+	all, list := a.AllowedCloudServices(ctx)
+	assert.False(t, all)
+	assert.Equal(t, []string{testdata.MockCloudServiceID2}, list)
+	assert.True(t, a.HasRole(ctx, "auditor"))
+
+	user, ok := a.CurrentUser(ctx)
+	assert.True(t, ok)
+	assert.Equal(t, "alice", user)
+
+	// Tokens of other issuers still use the default keys
+	all, list = a.AllowedCloudServices(TestContextOnlyService1)
+	assert.False(t, all)
+	assert.Equal(t, []string{testdata.MockCloudServiceID1}, list)
+
+	user, ok = a.CurrentUser(TestContextOnlyService1)
+	assert.True(t, ok)
+	assert.Equal(t, "me", user)
+}
+
+func TestAuthorizationStrategyJWT_Scopes(t *testing.T) {
+	tests := []struct {
+		name   string
+		claims jwt.MapClaims
+		want   []string
+	}{
+		{
+			name:   "space-separated scope claim",
+			claims: jwt.MapClaims{"sub": "clouditor", "scope": "openid assessment-service"},
+			want:   []string{"openid", "assessment-service"},
+		},
+		{
+			name:   "scp claim array",
+			claims: jwt.MapClaims{"sub": "clouditor", "scp": []string{"evaluation-service"}},
+			want:   []string{"evaluation-service"},
+		},
+		{
+			name:   "no scopes",
+			claims: jwt.MapClaims{"sub": "clouditor"},
+			want:   nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := jwt.NewWithClaims(jwt.SigningMethodHS256, tt.claims).SignedString([]byte("mykey"))
+			assert.NoError(t, err)
+
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.New(map[string]string{
+				"authorization": "bearer " + s,
+			}))
+
+			assert.Equal(t, tt.want, (&AuthorizationStrategyJWT{}).Scopes(ctx))
+		})
+	}
+}
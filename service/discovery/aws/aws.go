@@ -59,13 +59,13 @@ type STSAPI interface {
 	GetCallerIdentity(ctx context.Context, params *sts.GetCallerIdentityInput, optFns ...func(*sts.Options)) (*sts.GetCallerIdentityOutput, error)
 }
 
-// NewClient constructs a new AwsClient
-// TODO(lebogg): "Overload" (switch) with staticCredentialsProvider
-func NewClient() (*Client, error) {
+// NewClient constructs a new AwsClient. Additional options, e.g., [config.WithCredentialsProvider], are applied when
+// loading the default configuration.
+func NewClient(optFns ...func(*config.LoadOptions) error) (*Client, error) {
 	c := &Client{}
 
 	// load configuration
-	cfg, err := loadDefaultConfig(context.TODO())
+	cfg, err := loadDefaultConfig(context.TODO(), optFns...)
 	if err != nil {
 		return nil, fmt.Errorf("could not load default config: %w", err)
 	}
@@ -47,6 +47,7 @@ import (
 type computeDiscovery struct {
 	virtualMachineAPI EC2API
 	functionAPI       LambdaAPI
+	keyAPI            KMSAPI
 	isDiscovering     bool
 	awsConfig         *Client
 	csID              string
@@ -65,6 +66,14 @@ type EC2API interface {
 	DescribeNetworkInterfaces(ctx context.Context,
 		params *ec2.DescribeNetworkInterfacesInput,
 		optFns ...func(options *ec2.Options)) (*ec2.DescribeNetworkInterfacesOutput, error)
+
+	GetEbsEncryptionByDefault(ctx context.Context,
+		params *ec2.GetEbsEncryptionByDefaultInput,
+		optFns ...func(options *ec2.Options)) (*ec2.GetEbsEncryptionByDefaultOutput, error)
+
+	GetEbsDefaultKmsKeyId(ctx context.Context,
+		params *ec2.GetEbsDefaultKmsKeyIdInput,
+		optFns ...func(options *ec2.Options)) (*ec2.GetEbsDefaultKmsKeyIdOutput, error)
 }
 
 // LambdaAPI describes the lambda api interface which is implemented by the official AWS client and mock clients in tests
@@ -84,6 +93,7 @@ func NewAwsComputeDiscovery(client *Client, cloudServiceID string) discovery.Dis
 	return &computeDiscovery{
 		virtualMachineAPI: newFromConfigEC2(client.cfg),
 		functionAPI:       newFromConfigLambda(client.cfg),
+		keyAPI:            newFromConfigKMS(client.cfg),
 		isDiscovering:     true,
 		awsConfig:         client,
 		csID:              cloudServiceID,
@@ -99,6 +109,14 @@ func (*computeDiscovery) Name() string {
 func (d *computeDiscovery) List() (resources []ontology.IsResource, err error) {
 	log.Infof("Collecting evidences in %s", d.Name())
 
+	// The default encryption of EBS volumes is a setting of the account (in the current region), which is part of the
+	// EC2 API and therefore discovered here
+	account, err := d.discoverAccount()
+	if err != nil {
+		return nil, fmt.Errorf("could not discover account: %w", err)
+	}
+	resources = append(resources, account)
+
 	// Even though technically volumes are "storage", they are part of the EC2 API and therefore discovered here
 	volumes, err := d.discoverVolumes()
 	if err != nil {
@@ -140,6 +158,39 @@ func (d *computeDiscovery) CloudServiceID() string {
 	return d.csID
 }
 
+// discoverAccount discovers the account including its default encryption of new EBS volumes (in the current region)
+func (d *computeDiscovery) discoverAccount() (*ontology.Account, error) {
+	res, err := d.virtualMachineAPI.GetEbsEncryptionByDefault(context.TODO(), &ec2.GetEbsEncryptionByDefaultInput{})
+	if err != nil {
+		return nil, prettyError(err)
+	}
+
+	var keyID *string
+	if util.Deref(res.EbsEncryptionByDefault) {
+		key, err := d.virtualMachineAPI.GetEbsDefaultKmsKeyId(context.TODO(), &ec2.GetEbsDefaultKmsKeyIdInput{})
+		if err != nil {
+			return nil, prettyError(err)
+		}
+
+		keyID = key.KmsKeyId
+	}
+
+	atRest, err := d.ebsEncryption(util.Deref(res.EbsEncryptionByDefault), keyID)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve default key of EBS volumes: %w", err)
+	}
+
+	return &ontology.Account{
+		Id:   "arn:aws:iam::" + aws.ToString(d.awsConfig.accountID) + ":root",
+		Name: aws.ToString(d.awsConfig.accountID),
+		GeoLocation: &ontology.GeoLocation{
+			Region: d.awsConfig.cfg.Region,
+		},
+		AtRestEncryption: atRest,
+		Raw:              discovery.Raw(res),
+	}, nil
+}
+
 // discoverVolumes discovers all volumes (in the current region)
 func (d *computeDiscovery) discoverVolumes() ([]*ontology.BlockStorage, error) {
 	res, err := d.virtualMachineAPI.DescribeVolumes(context.TODO(), &ec2.DescribeVolumesInput{})
@@ -151,13 +202,9 @@ func (d *computeDiscovery) discoverVolumes() ([]*ontology.BlockStorage, error) {
 	for i := range res.Volumes {
 		volume := &res.Volumes[i]
 
-		atRest := &ontology.ManagedKeyEncryption{
-			Enabled: util.Deref(volume.Encrypted),
-		}
-
-		// AWS uses a fixed algorithm, if enabled
-		if atRest.Enabled {
-			atRest.Algorithm = "AES-256"
+		atRest, err := d.volumeAtRestEncryption(volume)
+		if err != nil {
+			return nil, err
 		}
 
 		blocks = append(blocks, &ontology.BlockStorage{
@@ -167,19 +214,49 @@ func (d *computeDiscovery) discoverVolumes() ([]*ontology.BlockStorage, error) {
 			GeoLocation: &ontology.GeoLocation{
 				Region: d.awsConfig.cfg.Region,
 			},
-			Labels: d.labels(volume.Tags),
-			AtRestEncryption: &ontology.AtRestEncryption{
-				Type: &ontology.AtRestEncryption_ManagedKeyEncryption{
-					ManagedKeyEncryption: atRest,
-				},
-			},
-			Raw: discovery.Raw(&res.Volumes[i]),
+			Labels:           d.labels(volume.Tags),
+			AtRestEncryption: atRest,
+			Raw:              discovery.Raw(&res.Volumes[i]),
 		})
 	}
 
 	return blocks, nil
 }
 
+// volumeAtRestEncryption returns the at-rest encryption of the volume. If the volume is encrypted, the details of its
+// KMS key are retrieved, so that we know, whether it is managed by AWS or by the customer.
+func (d *computeDiscovery) volumeAtRestEncryption(volume *typesEC2.Volume) (*ontology.AtRestEncryption, error) {
+	enc, err := d.ebsEncryption(util.Deref(volume.Encrypted), volume.KmsKeyId)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve key of volume %s: %w", util.Deref(volume.VolumeId), err)
+	}
+
+	return enc, nil
+}
+
+// ebsEncryption returns the at-rest encryption of EBS volumes with the given KMS key. This is used for existing volumes
+// as well as for the default encryption of new volumes.
+func (d *computeDiscovery) ebsEncryption(encrypted bool, keyID *string) (*ontology.AtRestEncryption, error) {
+	if encrypted && keyID != nil && d.keyAPI != nil {
+		return kmsEncryption(d.keyAPI, util.Deref(keyID))
+	}
+
+	atRest := &ontology.ManagedKeyEncryption{
+		Enabled: encrypted,
+	}
+
+	// AWS uses a fixed algorithm, if enabled
+	if atRest.Enabled {
+		atRest.Algorithm = "AES-256"
+	}
+
+	return &ontology.AtRestEncryption{
+		Type: &ontology.AtRestEncryption_ManagedKeyEncryption{
+			ManagedKeyEncryption: atRest,
+		},
+	}, nil
+}
+
 // discoverNetworkInterfaces discovers all network interfaces (in the current region)
 func (d *computeDiscovery) discoverNetworkInterfaces() ([]*ontology.NetworkInterface, error) {
 	res, err := d.virtualMachineAPI.DescribeNetworkInterfaces(context.TODO(), &ec2.DescribeNetworkInterfacesInput{})
@@ -222,12 +299,15 @@ func (d *computeDiscovery) discoverVirtualMachines() ([]*ontology.VirtualMachine
 				GeoLocation: &ontology.GeoLocation{
 					Region: d.awsConfig.cfg.Region,
 				},
-				Labels:              d.labels(vm.Tags),
-				NetworkInterfaceIds: d.getNetworkInterfacesOfVM(vm),
-				BlockStorageIds:     d.mapBlockStorageIDsOfVM(vm),
-				BootLogging:         d.getBootLog(vm),
-				OsLogging:           d.getOSLog(vm),
-				Raw:                 discovery.Raw(&reservation),
+				Labels:                  d.labels(vm.Tags),
+				NetworkInterfaceIds:     d.getNetworkInterfacesOfVM(vm),
+				BlockStorageIds:         d.mapBlockStorageIDsOfVM(vm),
+				BootLogging:             d.getBootLog(vm),
+				OsLogging:               d.getOSLog(vm),
+				ResourceLogging:         d.getResourceLogging(vm),
+				InstanceMetadataService: d.getInstanceMetadataService(vm),
+				IdentityId:              d.getInstanceProfile(vm),
+				Raw:                     discovery.Raw(&reservation),
 			})
 		}
 	}
@@ -269,12 +349,29 @@ func (d *computeDiscovery) mapFunctionResources(functions []typesLambda.Function
 			GeoLocation: &ontology.GeoLocation{
 				Region: d.awsConfig.cfg.Region,
 			},
-			Raw: discovery.Raw(&functions[i]),
+			ResourceLogging: d.getFunctionLogging(function),
+			Raw:             discovery.Raw(&functions[i]),
 		})
 	}
 	return
 }
 
+// getFunctionLogging returns the logging of the function. Lambda sends the logs of a function to the CloudWatch log
+// group in its logging configuration, which defaults to /aws/lambda/<function name>.
+func (d *computeDiscovery) getFunctionLogging(function *typesLambda.FunctionConfiguration) *ontology.ResourceLogging {
+	name := "/aws/lambda/" + aws.ToString(function.FunctionName)
+	if function.LoggingConfig != nil && function.LoggingConfig.LogGroup != nil {
+		name = aws.ToString(function.LoggingConfig.LogGroup)
+	}
+
+	return &ontology.ResourceLogging{
+		Enabled: true,
+		LoggingServiceIds: []string{
+			logGroupARN(d.awsConfig.cfg.Region, aws.ToString(d.awsConfig.accountID), name),
+		},
+	}
+}
+
 // getBootLog checks if boot logging is enabled
 // Currently there is no option to find out if any logs are enabled -> Assign default zero values
 func (*computeDiscovery) getBootLog(_ *typesEC2.Instance) (l *ontology.BootLogging) {
@@ -293,6 +390,38 @@ func (*computeDiscovery) getOSLog(_ *typesEC2.Instance) (l *ontology.OSLogging)
 	return
 }
 
+// getResourceLogging checks if detailed monitoring is enabled, i.e., whether metrics are collected every minute
+// instead of every five minutes
+func (*computeDiscovery) getResourceLogging(vm *typesEC2.Instance) (l *ontology.ResourceLogging) {
+	l = &ontology.ResourceLogging{
+		MonitoringEnabled: vm.Monitoring != nil && vm.Monitoring.State == typesEC2.MonitoringStateEnabled,
+	}
+	return
+}
+
+// getInstanceMetadataService checks if the instance metadata service is enabled and if it requires session tokens,
+// i.e., whether only IMDSv2 can be used
+func (*computeDiscovery) getInstanceMetadataService(vm *typesEC2.Instance) (imds *ontology.InstanceMetadataService) {
+	if vm.MetadataOptions == nil {
+		return nil
+	}
+
+	imds = &ontology.InstanceMetadataService{
+		Enabled:       vm.MetadataOptions.HttpEndpoint == typesEC2.InstanceMetadataEndpointStateEnabled,
+		TokenRequired: vm.MetadataOptions.HttpTokens == typesEC2.HttpTokensStateRequired,
+	}
+	return
+}
+
+// getInstanceProfile returns the ARN of the instance profile attached to the VM, if any
+func (*computeDiscovery) getInstanceProfile(vm *typesEC2.Instance) *string {
+	if vm.IamInstanceProfile == nil {
+		return nil
+	}
+
+	return vm.IamInstanceProfile.Arn
+}
+
 // mapBlockStorageIDsOfVM returns block storages IDs by iterating the VMs block storages
 func (d *computeDiscovery) mapBlockStorageIDsOfVM(vm *typesEC2.Instance) (blockStorageIDs []string) {
 	// Loop through mappings using an index, since BlockDeviceMappings is an array of a struct
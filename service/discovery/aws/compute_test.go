@@ -40,12 +40,14 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
 	lambdaTypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
 	"github.com/aws/smithy-go"
 	"github.com/aws/smithy-go/middleware"
 	"github.com/google/go-cmp/cmp"
 	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 const (
@@ -59,6 +61,7 @@ const (
 	mockFunction1             = "MockFunction1"
 	mockFunction1Region       = "eu-central-1"
 	mockFunction1CreationTime = "2012-11-01T22:08:41.0+00:00"
+	mockInstanceProfile       = "arn:aws:iam::MockAccountID1234:instance-profile/mock-profile"
 )
 
 // mockEC2API implements the EC2API interface for mock testing
@@ -177,6 +180,16 @@ func (mockEC2API) DescribeInstances(_ context.Context, _ *ec2.DescribeInstancesI
 				NetworkInterfaces:   networkInterfaces,
 				Tags:                tags,
 				LaunchTime:          aws.Time(launchTime),
+				MetadataOptions: &types.InstanceMetadataOptionsResponse{
+					HttpEndpoint: types.InstanceMetadataEndpointStateEnabled,
+					HttpTokens:   types.HttpTokensStateRequired,
+				},
+				Monitoring: &types.Monitoring{
+					State: types.MonitoringStateEnabled,
+				},
+				IamInstanceProfile: &types.IamInstanceProfile{
+					Arn: aws.String(mockInstanceProfile),
+				},
 			}},
 			OwnerId:       nil,
 			RequesterId:   nil,
@@ -228,6 +241,26 @@ func (mockEC2API) DescribeNetworkInterfaces(_ context.Context, _ *ec2.DescribeNe
 	return output, nil
 }
 
+// GetEbsEncryptionByDefault is the method implementation of the EC2API interface
+func (mockEC2API) GetEbsEncryptionByDefault(_ context.Context, _ *ec2.GetEbsEncryptionByDefaultInput, _ ...func(options *ec2.Options)) (*ec2.GetEbsEncryptionByDefaultOutput, error) {
+	output := &ec2.GetEbsEncryptionByDefaultOutput{
+		EbsEncryptionByDefault: aws.Bool(true),
+		ResultMetadata:         middleware.Metadata{},
+	}
+
+	return output, nil
+}
+
+// GetEbsDefaultKmsKeyId is the method implementation of the EC2API interface
+func (mockEC2API) GetEbsDefaultKmsKeyId(_ context.Context, _ *ec2.GetEbsDefaultKmsKeyIdInput, _ ...func(options *ec2.Options)) (*ec2.GetEbsDefaultKmsKeyIdOutput, error) {
+	output := &ec2.GetEbsDefaultKmsKeyIdOutput{
+		KmsKeyId:       aws.String(mockCustomerKey),
+		ResultMetadata: middleware.Metadata{},
+	}
+
+	return output, nil
+}
+
 // DescribeInstances is the method implementation of the EC2API interface
 func (mockEC2APIWithErrors) DescribeInstances(_ context.Context, _ *ec2.DescribeInstancesInput, _ ...func(options *ec2.Options)) (*ec2.DescribeInstancesOutput, error) {
 	err := &smithy.GenericAPIError{
@@ -255,6 +288,24 @@ func (mockEC2APIWithErrors) DescribeNetworkInterfaces(_ context.Context, _ *ec2.
 	return nil, err
 }
 
+// GetEbsEncryptionByDefault is the method implementation of the EC2API interface
+func (mockEC2APIWithErrors) GetEbsEncryptionByDefault(_ context.Context, _ *ec2.GetEbsEncryptionByDefaultInput, _ ...func(options *ec2.Options)) (*ec2.GetEbsEncryptionByDefaultOutput, error) {
+	err := &smithy.GenericAPIError{
+		Code:    "ConnectionError",
+		Message: "Couldn't resolve host. Bad connection?",
+	}
+	return nil, err
+}
+
+// GetEbsDefaultKmsKeyId is the method implementation of the EC2API interface
+func (mockEC2APIWithErrors) GetEbsDefaultKmsKeyId(_ context.Context, _ *ec2.GetEbsDefaultKmsKeyIdInput, _ ...func(options *ec2.Options)) (*ec2.GetEbsDefaultKmsKeyIdOutput, error) {
+	err := &smithy.GenericAPIError{
+		Code:    "ConnectionError",
+		Message: "Couldn't resolve host. Bad connection?",
+	}
+	return nil, err
+}
+
 func TestComputeDiscovery_List(t *testing.T) {
 	d := computeDiscovery{
 		virtualMachineAPI: mockEC2API{},
@@ -312,6 +363,10 @@ func TestComputeDiscovery_discoverVirtualMachines(t *testing.T) {
 	assert.False(t, testMachine.OsLogging.Enabled)
 	assert.Nil(t, testMachine.CreationTime)
 	assert.Equal(t, mockFunction1Region, testMachine.GeoLocation.Region)
+	assert.True(t, testMachine.ResourceLogging.MonitoringEnabled)
+	assert.True(t, testMachine.InstanceMetadataService.Enabled)
+	assert.True(t, testMachine.InstanceMetadataService.TokenRequired)
+	assert.Equal(t, mockInstanceProfile, testMachine.GetIdentityId())
 
 	d = computeDiscovery{
 		virtualMachineAPI: mockEC2APIWithErrors{},
@@ -415,6 +470,10 @@ func TestComputeDiscovery_discoverFunctions(t *testing.T) {
 						Region: mockFunction1Region,
 					},
 					Raw: "{\"*types.FunctionConfiguration\":[{\"Architectures\":null,\"CodeSha256\":null,\"CodeSize\":0,\"DeadLetterConfig\":null,\"Description\":null,\"Environment\":null,\"EphemeralStorage\":null,\"FileSystemConfigs\":null,\"FunctionArn\":\"arn:aws:lambda:eu-central-1:123456789:function:mock-function:1\",\"FunctionName\":\"MockFunction1\",\"Handler\":null,\"ImageConfigResponse\":null,\"KMSKeyArn\":null,\"LastModified\":\"2012-11-01T22:08:41.0+00:00\",\"LastUpdateStatus\":\"\",\"LastUpdateStatusReason\":null,\"LastUpdateStatusReasonCode\":\"\",\"Layers\":null,\"LoggingConfig\":null,\"MasterArn\":null,\"MemorySize\":null,\"PackageType\":\"\",\"RevisionId\":null,\"Role\":null,\"Runtime\":\"\",\"RuntimeVersionConfig\":null,\"SigningJobArn\":null,\"SigningProfileVersionArn\":null,\"SnapStart\":null,\"State\":\"\",\"StateReason\":null,\"StateReasonCode\":\"\",\"Timeout\":null,\"TracingConfig\":null,\"Version\":null,\"VpcConfig\":null}]}",
+					ResourceLogging: &ontology.ResourceLogging{
+						Enabled:           true,
+						LoggingServiceIds: []string{"arn:aws:logs:eu-central-1::log-group:/aws/lambda/MockFunction1"},
+					},
 				},
 			},
 			assert.Nil[error],
@@ -464,6 +523,8 @@ func TestComputeDiscovery_NewComputeDiscovery(t *testing.T) {
 	defer func() { newFromConfigEC2 = oldEC2 }()
 	oldLambda := newFromConfigLambda
 	defer func() { newFromConfigLambda = oldLambda }()
+	oldKMS := newFromConfigKMS
+	defer func() { newFromConfigKMS = oldKMS }()
 
 	newFromConfigEC2 = func(cfg aws.Config, optFns ...func(*ec2.Options)) *ec2.Client {
 		return &ec2.Client{}
@@ -471,6 +532,9 @@ func TestComputeDiscovery_NewComputeDiscovery(t *testing.T) {
 	newFromConfigLambda = func(cfg aws.Config, optFns ...func(*lambda.Options)) *lambda.Client {
 		return &lambda.Client{}
 	}
+	newFromConfigKMS = func(cfg aws.Config, optFns ...func(*kms.Options)) *kms.Client {
+		return &kms.Client{}
+	}
 
 	type args struct {
 		client *Client
@@ -492,6 +556,7 @@ func TestComputeDiscovery_NewComputeDiscovery(t *testing.T) {
 			want: &computeDiscovery{
 				virtualMachineAPI: &ec2.Client{},
 				functionAPI:       &lambda.Client{},
+				keyAPI:            &kms.Client{},
 				isDiscovering:     true,
 				awsConfig:         mockClient,
 				csID:              testdata.MockCloudServiceID1,
@@ -505,3 +570,275 @@ func TestComputeDiscovery_NewComputeDiscovery(t *testing.T) {
 		})
 	}
 }
+
+func Test_computeDiscovery_volumeAtRestEncryption(t *testing.T) {
+	type fields struct {
+		keyAPI KMSAPI
+	}
+	type args struct {
+		volume *types.Volume
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *ontology.AtRestEncryption
+		wantErr assert.WantErr
+	}{
+		{
+			name: "not encrypted",
+			fields: fields{
+				keyAPI: mockKMSAPI{},
+			},
+			args: args{
+				volume: &types.Volume{Encrypted: aws.Bool(false)},
+			},
+			want: &ontology.AtRestEncryption{
+				Type: &ontology.AtRestEncryption_ManagedKeyEncryption{
+					ManagedKeyEncryption: &ontology.ManagedKeyEncryption{},
+				},
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "customer managed key",
+			fields: fields{
+				keyAPI: mockKMSAPI{},
+			},
+			args: args{
+				volume: &types.Volume{Encrypted: aws.Bool(true), KmsKeyId: aws.String(mockCustomerKey)},
+			},
+			want: &ontology.AtRestEncryption{
+				Type: &ontology.AtRestEncryption_CustomerKeyEncryption{
+					CustomerKeyEncryption: &ontology.CustomerKeyEncryption{
+						Algorithm:         "AES256",
+						Enabled:           true,
+						KeyUrl:            mockCustomerKey,
+						KeyManager:        mockKeyManager,
+						KeyRotationPeriod: durationpb.New(DefaultKeyRotationPeriod),
+					},
+				},
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "unknown key",
+			fields: fields{
+				keyAPI: mockKMSAPI{},
+			},
+			args: args{
+				volume: &types.Volume{Encrypted: aws.Bool(true), KmsKeyId: aws.String("unknown"), VolumeId: aws.String("vol-1")},
+			},
+			want: nil,
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "could not retrieve key of volume vol-1")
+			},
+		},
+		{
+			name: "without KMS client",
+			args: args{
+				volume: &types.Volume{Encrypted: aws.Bool(true), KmsKeyId: aws.String(mockCustomerKey)},
+			},
+			want: &ontology.AtRestEncryption{
+				Type: &ontology.AtRestEncryption_ManagedKeyEncryption{
+					ManagedKeyEncryption: &ontology.ManagedKeyEncryption{
+						Algorithm: "AES-256",
+						Enabled:   true,
+					},
+				},
+			},
+			wantErr: assert.Nil[error],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &computeDiscovery{
+				keyAPI: tt.fields.keyAPI,
+			}
+			got, err := d.volumeAtRestEncryption(tt.args.volume)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_computeDiscovery_discoverAccount(t *testing.T) {
+	type fields struct {
+		virtualMachineAPI EC2API
+		keyAPI            KMSAPI
+		awsConfig         *Client
+	}
+	mockClient := &Client{
+		cfg: aws.Config{
+			Region: "eu-central-1",
+		},
+		accountID: aws.String("MockAccountID1234"),
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		want    *ontology.Account
+		wantErr assert.WantErr
+	}{
+		{
+			name: "default encryption with customer managed key",
+			fields: fields{
+				virtualMachineAPI: mockEC2API{},
+				keyAPI:            mockKMSAPI{},
+				awsConfig:         mockClient,
+			},
+			want: &ontology.Account{
+				Id:   "arn:aws:iam::MockAccountID1234:root",
+				Name: "MockAccountID1234",
+				GeoLocation: &ontology.GeoLocation{
+					Region: "eu-central-1",
+				},
+				AtRestEncryption: &ontology.AtRestEncryption{
+					Type: &ontology.AtRestEncryption_CustomerKeyEncryption{
+						CustomerKeyEncryption: &ontology.CustomerKeyEncryption{
+							Algorithm:         "AES256",
+							Enabled:           true,
+							KeyUrl:            mockCustomerKey,
+							KeyManager:        mockKeyManager,
+							KeyRotationPeriod: durationpb.New(DefaultKeyRotationPeriod),
+						},
+					},
+				},
+				Raw: "{\"*ec2.GetEbsEncryptionByDefaultOutput\":[{\"EbsEncryptionByDefault\":true,\"SseType\":\"\",\"ResultMetadata\":{}}]}",
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "API error",
+			fields: fields{
+				virtualMachineAPI: mockEC2APIWithErrors{},
+				awsConfig:         mockClient,
+			},
+			want: nil,
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "Couldn't resolve host")
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &computeDiscovery{
+				virtualMachineAPI: tt.fields.virtualMachineAPI,
+				keyAPI:            tt.fields.keyAPI,
+				awsConfig:         tt.fields.awsConfig,
+			}
+			got, err := d.discoverAccount()
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_computeDiscovery_getInstanceMetadataService(t *testing.T) {
+	type args struct {
+		vm *types.Instance
+	}
+	tests := []struct {
+		name string
+		args args
+		want *ontology.InstanceMetadataService
+	}{
+		{
+			name: "no metadata options",
+			args: args{
+				vm: &types.Instance{},
+			},
+			want: nil,
+		},
+		{
+			name: "IMDSv1 allowed",
+			args: args{
+				vm: &types.Instance{
+					MetadataOptions: &types.InstanceMetadataOptionsResponse{
+						HttpEndpoint: types.InstanceMetadataEndpointStateEnabled,
+						HttpTokens:   types.HttpTokensStateOptional,
+					},
+				},
+			},
+			want: &ontology.InstanceMetadataService{
+				Enabled:       true,
+				TokenRequired: false,
+			},
+		},
+		{
+			name: "disabled",
+			args: args{
+				vm: &types.Instance{
+					MetadataOptions: &types.InstanceMetadataOptionsResponse{
+						HttpEndpoint: types.InstanceMetadataEndpointStateDisabled,
+						HttpTokens:   types.HttpTokensStateRequired,
+					},
+				},
+			},
+			want: &ontology.InstanceMetadataService{
+				Enabled:       false,
+				TokenRequired: true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &computeDiscovery{}
+			got := d.getInstanceMetadataService(tt.args.vm)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_computeDiscovery_getFunctionLogging(t *testing.T) {
+	type args struct {
+		function *lambdaTypes.FunctionConfiguration
+	}
+	tests := []struct {
+		name string
+		args args
+		want *ontology.ResourceLogging
+	}{
+		{
+			name: "default log group",
+			args: args{
+				function: &lambdaTypes.FunctionConfiguration{
+					FunctionName: aws.String(mockFunction1),
+				},
+			},
+			want: &ontology.ResourceLogging{
+				Enabled:           true,
+				LoggingServiceIds: []string{"arn:aws:logs:eu-central-1:MockAccountID1234:log-group:/aws/lambda/MockFunction1"},
+			},
+		},
+		{
+			name: "custom log group",
+			args: args{
+				function: &lambdaTypes.FunctionConfiguration{
+					FunctionName: aws.String(mockFunction1),
+					LoggingConfig: &lambdaTypes.LoggingConfig{
+						LogGroup: aws.String("central-logs"),
+					},
+				},
+			},
+			want: &ontology.ResourceLogging{
+				Enabled:           true,
+				LoggingServiceIds: []string{"arn:aws:logs:eu-central-1:MockAccountID1234:log-group:central-logs"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &computeDiscovery{
+				awsConfig: &Client{
+					cfg: aws.Config{
+						Region: "eu-central-1",
+					},
+					accountID: aws.String("MockAccountID1234"),
+				},
+			}
+			got := d.getFunctionLogging(tt.args.function)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
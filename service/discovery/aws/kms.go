@@ -0,0 +1,129 @@
+/*
+ * Copyright 2021 Fraunhofer AISEC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ *           $$\                           $$\ $$\   $$\
+ *           $$ |                          $$ |\__|  $$ |
+ *  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+ * $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+ * $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+ * $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+ * \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+ *  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+ *
+ * This file is part of Clouditor Community Edition.
+ */
+
+package aws
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/constants"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	typesKMS "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// DefaultKeyRotationPeriod is the period in which KMS rotates customer managed keys, if automatic rotation is enabled.
+const DefaultKeyRotationPeriod = 365 * 24 * time.Hour
+
+// KMSAPI describes the KMS api interface which is implemented by the official AWS client and mock clients in tests
+type KMSAPI interface {
+	DescribeKey(ctx context.Context,
+		params *kms.DescribeKeyInput,
+		optFns ...func(*kms.Options)) (*kms.DescribeKeyOutput, error)
+	GetKeyRotationStatus(ctx context.Context,
+		params *kms.GetKeyRotationStatusInput,
+		optFns ...func(*kms.Options)) (*kms.GetKeyRotationStatusOutput, error)
+}
+
+// newFromConfigKMS holds kms.NewFromConfig(...) allowing a test function to mock it
+var newFromConfigKMS = kms.NewFromConfig
+
+// kmsEncryption retrieves the KMS key with the given ID (or ARN) and converts it into our respective ontology object.
+// Keys managed by AWS, e.g., aws/s3, result in a managed key encryption, keys managed by the customer in a customer key
+// encryption including its key manager and rotation period.
+func kmsEncryption(api KMSAPI, keyID string) (enc *ontology.AtRestEncryption, err error) {
+	var (
+		key      *kms.DescribeKeyOutput
+		rotation *kms.GetKeyRotationStatusOutput
+	)
+
+	key, err = api.DescribeKey(context.TODO(), &kms.DescribeKeyInput{KeyId: aws.String(keyID)})
+	if err != nil {
+		return nil, prettyError(err)
+	}
+
+	arn := aws.ToString(key.KeyMetadata.Arn)
+
+	if key.KeyMetadata.KeyManager == typesKMS.KeyManagerTypeAws {
+		return &ontology.AtRestEncryption{
+			Type: &ontology.AtRestEncryption_ManagedKeyEncryption{
+				ManagedKeyEncryption: &ontology.ManagedKeyEncryption{
+					Algorithm: kmsAlgorithm(key.KeyMetadata.KeySpec),
+					Enabled:   true,
+					KeyUrl:    arn,
+				},
+			},
+		}, nil
+	}
+
+	cke := &ontology.CustomerKeyEncryption{
+		Algorithm:  kmsAlgorithm(key.KeyMetadata.KeySpec),
+		Enabled:    key.KeyMetadata.Enabled,
+		KeyUrl:     arn,
+		KeyManager: kmsKeyManager(arn),
+	}
+
+	// The rotation status is only available for symmetric keys managed by KMS itself
+	rotation, err = api.GetKeyRotationStatus(context.TODO(), &kms.GetKeyRotationStatusInput{KeyId: aws.String(arn)})
+	if err != nil {
+		log.Debugf("Could not retrieve rotation status of key %s: %v", arn, prettyError(err))
+	} else if rotation.KeyRotationEnabled {
+		cke.KeyRotationPeriod = durationpb.New(DefaultKeyRotationPeriod)
+	}
+
+	return &ontology.AtRestEncryption{
+		Type: &ontology.AtRestEncryption_CustomerKeyEncryption{
+			CustomerKeyEncryption: cke,
+		},
+	}, nil
+}
+
+// kmsKeyManager returns the KMS of the account and region of the key ARN, e.g., arn:aws:kms:eu-central-1:123456789012
+// for the key arn:aws:kms:eu-central-1:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab.
+func kmsKeyManager(arn string) string {
+	i := strings.LastIndex(arn, ":")
+	if i == -1 {
+		return ""
+	}
+
+	return arn[:i]
+}
+
+// kmsAlgorithm returns the algorithm of the key spec. Symmetric keys, which are used for encrypting storages, use
+// AES-256-GCM.
+func kmsAlgorithm(spec typesKMS.KeySpec) string {
+	if spec == typesKMS.KeySpecSymmetricDefault {
+		return constants.AES256
+	}
+
+	return string(spec)
+}
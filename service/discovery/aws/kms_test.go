@@ -0,0 +1,191 @@
+/*
+ * Copyright 2021 Fraunhofer AISEC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ *           $$\                           $$\ $$\   $$\
+ *           $$ |                          $$ |\__|  $$ |
+ *  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+ * $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+ * $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+ * $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+ * \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+ *  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+ *
+ * This file is part of Clouditor Community Edition.
+ */
+
+package aws
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/constants"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	typesKMS "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+const (
+	mockKeyManager    = "arn:aws:kms:eu-central-1:123456789012"
+	mockCustomerKeyID = mockBucket2KeyId
+	mockCustomerKey   = mockKeyManager + ":key/" + mockCustomerKeyID
+	mockAWSKey        = mockKeyManager + ":key/abcd1234-ab12-cd34-ef56-abcdef123456"
+	mockUnrotatedKey  = mockKeyManager + ":key/00000000-0000-0000-0000-000000000000"
+)
+
+type mockKMSAPI struct{}
+
+func (mockKMSAPI) DescribeKey(_ context.Context,
+	params *kms.DescribeKeyInput,
+	_ ...func(*kms.Options)) (*kms.DescribeKeyOutput, error) {
+	switch aws.ToString(params.KeyId) {
+	case "alias/aws/s3", mockAWSKey:
+		return &kms.DescribeKeyOutput{
+			KeyMetadata: &typesKMS.KeyMetadata{
+				Arn:        aws.String(mockAWSKey),
+				Enabled:    true,
+				KeyManager: typesKMS.KeyManagerTypeAws,
+				KeySpec:    typesKMS.KeySpecSymmetricDefault,
+			},
+		}, nil
+	case mockCustomerKeyID, mockCustomerKey:
+		return &kms.DescribeKeyOutput{
+			KeyMetadata: &typesKMS.KeyMetadata{
+				Arn:        aws.String(mockCustomerKey),
+				Enabled:    true,
+				KeyManager: typesKMS.KeyManagerTypeCustomer,
+				KeySpec:    typesKMS.KeySpecSymmetricDefault,
+			},
+		}, nil
+	case mockUnrotatedKey:
+		return &kms.DescribeKeyOutput{
+			KeyMetadata: &typesKMS.KeyMetadata{
+				Arn:        aws.String(mockUnrotatedKey),
+				Enabled:    false,
+				KeyManager: typesKMS.KeyManagerTypeCustomer,
+				KeySpec:    typesKMS.KeySpecSymmetricDefault,
+			},
+		}, nil
+	default:
+		return nil, errors.New("key not found")
+	}
+}
+
+func (mockKMSAPI) GetKeyRotationStatus(_ context.Context,
+	params *kms.GetKeyRotationStatusInput,
+	_ ...func(*kms.Options)) (*kms.GetKeyRotationStatusOutput, error) {
+	switch aws.ToString(params.KeyId) {
+	case mockCustomerKey:
+		return &kms.GetKeyRotationStatusOutput{KeyRotationEnabled: true}, nil
+	default:
+		return nil, errors.New("access denied")
+	}
+}
+
+func Test_kmsEncryption(t *testing.T) {
+	type args struct {
+		api   KMSAPI
+		keyID string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantEnc *ontology.AtRestEncryption
+		wantErr assert.WantErr
+	}{
+		{
+			name: "AWS managed key",
+			args: args{
+				api:   mockKMSAPI{},
+				keyID: "alias/aws/s3",
+			},
+			wantEnc: &ontology.AtRestEncryption{
+				Type: &ontology.AtRestEncryption_ManagedKeyEncryption{
+					ManagedKeyEncryption: &ontology.ManagedKeyEncryption{
+						Algorithm: constants.AES256,
+						Enabled:   true,
+						KeyUrl:    mockAWSKey,
+					},
+				},
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "customer managed key with rotation",
+			args: args{
+				api:   mockKMSAPI{},
+				keyID: mockCustomerKeyID,
+			},
+			wantEnc: &ontology.AtRestEncryption{
+				Type: &ontology.AtRestEncryption_CustomerKeyEncryption{
+					CustomerKeyEncryption: &ontology.CustomerKeyEncryption{
+						Algorithm:         constants.AES256,
+						Enabled:           true,
+						KeyUrl:            mockCustomerKey,
+						KeyManager:        mockKeyManager,
+						KeyRotationPeriod: durationpb.New(DefaultKeyRotationPeriod),
+					},
+				},
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "customer managed key without rotation status",
+			args: args{
+				api:   mockKMSAPI{},
+				keyID: mockUnrotatedKey,
+			},
+			wantEnc: &ontology.AtRestEncryption{
+				Type: &ontology.AtRestEncryption_CustomerKeyEncryption{
+					CustomerKeyEncryption: &ontology.CustomerKeyEncryption{
+						Algorithm:  constants.AES256,
+						Enabled:    false,
+						KeyUrl:     mockUnrotatedKey,
+						KeyManager: mockKeyManager,
+					},
+				},
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "unknown key",
+			args: args{
+				api:   mockKMSAPI{},
+				keyID: "unknown",
+			},
+			wantEnc: nil,
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "key not found")
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotEnc, err := kmsEncryption(tt.args.api, tt.args.keyID)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.wantEnc, gotEnc)
+		})
+	}
+}
+
+func Test_kmsKeyManager(t *testing.T) {
+	assert.Equal(t, mockKeyManager, kmsKeyManager(mockCustomerKey))
+	assert.Equal(t, "", kmsKeyManager("invalid"))
+}
@@ -0,0 +1,152 @@
+/*
+ * Copyright 2021 Fraunhofer AISEC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ *           $$\                           $$\ $$\   $$\
+ *           $$ |                          $$ |\__|  $$ |
+ *  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+ * $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+ * $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+ * $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+ * \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+ *  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+ *
+ * This file is part of Clouditor Community Edition.
+ */
+
+package aws
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"clouditor.io/clouditor/v2/api/discovery"
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/util"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	typesLogs "github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// loggingDiscovery handles the AWS API requests regarding the logging services (CloudWatch Logs)
+type loggingDiscovery struct {
+	logsAPI       CloudWatchLogsAPI
+	isDiscovering bool
+	awsConfig     *Client
+	csID          string
+}
+
+// CloudWatchLogsAPI describes the CloudWatch Logs api interface which is implemented by the official AWS client and
+// mock clients in tests
+type CloudWatchLogsAPI interface {
+	DescribeLogGroups(ctx context.Context,
+		params *cloudwatchlogs.DescribeLogGroupsInput,
+		optFns ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.DescribeLogGroupsOutput, error)
+}
+
+// newFromConfigCloudWatchLogs holds cloudwatchlogs.NewFromConfig(...) allowing a test function to mock it
+var newFromConfigCloudWatchLogs = cloudwatchlogs.NewFromConfig
+
+// NewAwsLoggingDiscovery constructs a new loggingDiscovery initializing the CloudWatch Logs API and isDiscovering with
+// true
+func NewAwsLoggingDiscovery(client *Client, cloudServiceID string) discovery.Discoverer {
+	return &loggingDiscovery{
+		logsAPI:       newFromConfigCloudWatchLogs(client.cfg),
+		isDiscovering: true,
+		awsConfig:     client,
+		csID:          cloudServiceID,
+	}
+}
+
+// Name is the method implementation defined in the discovery.Discoverer interface
+func (*loggingDiscovery) Name() string {
+	return "AWS Logging"
+}
+
+// List is the method implementation defined in the discovery.Discoverer interface
+func (d *loggingDiscovery) List() (resources []ontology.IsResource, err error) {
+	log.Infof("Collecting evidences in %s", d.Name())
+
+	groups, err := d.discoverLogGroups()
+	if err != nil {
+		return nil, fmt.Errorf("could not discover log groups: %w", err)
+	}
+	for _, group := range groups {
+		resources = append(resources, group)
+	}
+
+	return
+}
+
+func (d *loggingDiscovery) CloudServiceID() string {
+	return d.csID
+}
+
+// discoverLogGroups discovers all CloudWatch log groups (in the current region). They are the sinks of the logs of
+// other resources, e.g., Lambda functions, and determine how long the logs are kept.
+func (d *loggingDiscovery) discoverLogGroups() (groups []*ontology.LoggingService, err error) {
+	var (
+		resp      *cloudwatchlogs.DescribeLogGroupsOutput
+		nextToken *string
+	)
+
+	for {
+		resp, err = d.logsAPI.DescribeLogGroups(context.TODO(), &cloudwatchlogs.DescribeLogGroupsInput{
+			NextToken: nextToken,
+		})
+		if err != nil {
+			return nil, prettyError(err)
+		}
+
+		for i := range resp.LogGroups {
+			groups = append(groups, d.handleLogGroup(&resp.LogGroups[i]))
+		}
+
+		if nextToken = resp.NextToken; nextToken == nil {
+			break
+		}
+	}
+
+	return
+}
+
+// handleLogGroup converts a CloudWatch log group into a [ontology.LoggingService]
+func (d *loggingDiscovery) handleLogGroup(group *typesLogs.LogGroup) *ontology.LoggingService {
+	id := aws.ToString(group.LogGroupArn)
+	if id == "" {
+		id = logGroupARN(d.awsConfig.cfg.Region, aws.ToString(d.awsConfig.accountID), aws.ToString(group.LogGroupName))
+	}
+
+	return &ontology.LoggingService{
+		Id:           id,
+		Name:         aws.ToString(group.LogGroupName),
+		CreationTime: timestamppb.New(time.UnixMilli(util.Deref(group.CreationTime))),
+		GeoLocation: &ontology.GeoLocation{
+			Region: d.awsConfig.cfg.Region,
+		},
+		// Log groups without a retention period keep their logs forever, which results in a zero duration
+		RetentionPeriod: durationpb.New(time.Duration(util.Deref(group.RetentionInDays)) * time.Hour * 24),
+		Raw:             discovery.Raw(group),
+	}
+}
+
+// logGroupARN returns the ARN of the CloudWatch log group with the given name, which is used to reference the log group
+// from the resources that send their logs to it.
+func logGroupARN(region string, accountID string, name string) string {
+	return "arn:aws:logs:" + region + ":" + accountID + ":log-group:" + name
+}
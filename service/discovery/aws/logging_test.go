@@ -0,0 +1,196 @@
+/*
+ * Copyright 2021 Fraunhofer AISEC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ *           $$\                           $$\ $$\   $$\
+ *           $$ |                          $$ |\__|  $$ |
+ *  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+ * $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+ * $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+ * $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+ * \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+ *  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+ *
+ * This file is part of Clouditor Community Edition.
+ */
+
+package aws
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"clouditor.io/clouditor/v2/api/discovery"
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	typesLogs "github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	"github.com/aws/smithy-go"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	mockLogGroup1    = "/aws/lambda/MockFunction1"
+	mockLogGroup1ARN = "arn:aws:logs:eu-central-1:MockAccountID1234:log-group:/aws/lambda/MockFunction1"
+	mockLogGroup2    = "central-logs"
+)
+
+// mockCloudWatchLogsAPI implements the CloudWatchLogsAPI interface for mock testing. It returns one log group per page.
+type mockCloudWatchLogsAPI struct{}
+
+// DescribeLogGroups is the method implementation of the CloudWatchLogsAPI interface
+func (mockCloudWatchLogsAPI) DescribeLogGroups(_ context.Context, params *cloudwatchlogs.DescribeLogGroupsInput, _ ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.DescribeLogGroupsOutput, error) {
+	if params.NextToken == nil {
+		return &cloudwatchlogs.DescribeLogGroupsOutput{
+			LogGroups: []typesLogs.LogGroup{
+				{
+					LogGroupName:    aws.String(mockLogGroup1),
+					LogGroupArn:     aws.String(mockLogGroup1ARN),
+					CreationTime:    aws.Int64(1704067200000),
+					RetentionInDays: aws.Int32(30),
+				},
+			},
+			NextToken: aws.String("next"),
+		}, nil
+	}
+
+	return &cloudwatchlogs.DescribeLogGroupsOutput{
+		LogGroups: []typesLogs.LogGroup{
+			{
+				LogGroupName: aws.String(mockLogGroup2),
+				CreationTime: aws.Int64(1704067200000),
+			},
+		},
+	}, nil
+}
+
+// mockCloudWatchLogsAPIWithErrors implements the CloudWatchLogsAPI interface (API call returning error) for mock
+// testing
+type mockCloudWatchLogsAPIWithErrors struct{}
+
+// DescribeLogGroups is the method implementation of the CloudWatchLogsAPI interface
+func (mockCloudWatchLogsAPIWithErrors) DescribeLogGroups(_ context.Context, _ *cloudwatchlogs.DescribeLogGroupsInput, _ ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.DescribeLogGroupsOutput, error) {
+	return nil, &smithy.OperationError{
+		ServiceID:     "MockCloudWatchLogsAPI",
+		OperationName: "DescribeLogGroups",
+		Err:           errors.New("failed to resolve service endpoint"),
+	}
+}
+
+func TestLoggingDiscovery_NewLoggingDiscovery(t *testing.T) {
+	// Mock newFromConfig and store the original function back at the end of the test
+	old := newFromConfigCloudWatchLogs
+	defer func() { newFromConfigCloudWatchLogs = old }()
+
+	newFromConfigCloudWatchLogs = func(cfg aws.Config, optFns ...func(*cloudwatchlogs.Options)) *cloudwatchlogs.Client {
+		return &cloudwatchlogs.Client{}
+	}
+
+	mockClient := &Client{
+		cfg: aws.Config{
+			Region: "eu-central-1",
+		},
+		accountID: aws.String("1234"),
+	}
+
+	got := NewAwsLoggingDiscovery(mockClient, testdata.MockCloudServiceID1)
+	want := &loggingDiscovery{
+		logsAPI:       &cloudwatchlogs.Client{},
+		isDiscovering: true,
+		awsConfig:     mockClient,
+		csID:          testdata.MockCloudServiceID1,
+	}
+	assert.Equal[discovery.Discoverer](t, want, got, assert.CompareAllUnexported())
+	assert.Equal(t, "AWS Logging", got.Name())
+	assert.Equal(t, testdata.MockCloudServiceID1, got.CloudServiceID())
+}
+
+func TestLoggingDiscovery_List(t *testing.T) {
+	mockClient := &Client{
+		cfg: aws.Config{
+			Region: "eu-central-1",
+		},
+		accountID: aws.String("MockAccountID1234"),
+	}
+
+	type fields struct {
+		logsAPI CloudWatchLogsAPI
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		want    []ontology.IsResource
+		wantErr assert.WantErr
+	}{
+		{
+			name: "happy path",
+			fields: fields{
+				logsAPI: mockCloudWatchLogsAPI{},
+			},
+			want: []ontology.IsResource{
+				&ontology.LoggingService{
+					Id:           mockLogGroup1ARN,
+					Name:         mockLogGroup1,
+					CreationTime: timestamppb.New(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+					GeoLocation: &ontology.GeoLocation{
+						Region: "eu-central-1",
+					},
+					RetentionPeriod: durationpb.New(30 * 24 * time.Hour),
+				},
+				&ontology.LoggingService{
+					Id:           "arn:aws:logs:eu-central-1:MockAccountID1234:log-group:" + mockLogGroup2,
+					Name:         mockLogGroup2,
+					CreationTime: timestamppb.New(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+					GeoLocation: &ontology.GeoLocation{
+						Region: "eu-central-1",
+					},
+					RetentionPeriod: durationpb.New(0),
+				},
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "API error",
+			fields: fields{
+				logsAPI: mockCloudWatchLogsAPIWithErrors{},
+			},
+			want: nil,
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "could not discover log groups")
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &loggingDiscovery{
+				logsAPI:   tt.fields.logsAPI,
+				awsConfig: mockClient,
+			}
+			got, err := d.List()
+			tt.wantErr(t, err)
+
+			// We do not want to compare the raw field
+			for _, r := range got {
+				r.(*ontology.LoggingService).Raw = ""
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
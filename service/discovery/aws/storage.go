@@ -47,6 +47,7 @@ import (
 // awsS3Discovery handles the AWS API requests regarding the S3 service
 type awsS3Discovery struct {
 	storageAPI    S3API
+	keyAPI        KMSAPI
 	isDiscovering bool
 	awsConfig     *Client
 	csID          string
@@ -85,6 +86,9 @@ type S3API interface {
 	GetBucketLifecycleConfiguration(ctx context.Context,
 		params *s3.GetBucketLifecycleConfigurationInput,
 		optFns ...func(*s3.Options)) (*s3.GetBucketLifecycleConfigurationOutput, error)
+	GetBucketLogging(ctx context.Context,
+		params *s3.GetBucketLoggingInput,
+		optFns ...func(*s3.Options)) (*s3.GetBucketLoggingOutput, error)
 }
 
 // BucketPolicy matches the returned bucket policy in JSON from AWS
@@ -116,8 +120,10 @@ func (d *awsS3Discovery) List() (resources []ontology.IsResource, err error) {
 	var (
 		rawBucketEncOutput  *s3.GetBucketEncryptionOutput
 		rawBucketTranspEnc  *s3.GetBucketPolicyOutput
+		rawBucketLogging    *s3.GetBucketLoggingOutput
 		encryptionAtTransit *ontology.TransportEncryption
 		encryptionAtRest    *ontology.AtRestEncryption
+		resourceLogging     *ontology.ResourceLogging
 	)
 
 	log.Infof("Collecting evidences in %s", d.Name())
@@ -136,6 +142,10 @@ func (d *awsS3Discovery) List() (resources []ontology.IsResource, err error) {
 		if err != nil {
 			return
 		}
+		resourceLogging, rawBucketLogging, err = d.getResourceLogging(b.name)
+		if err != nil {
+			return
+		}
 
 		resources = append(resources,
 			// Add ObjectStorage
@@ -147,7 +157,8 @@ func (d *awsS3Discovery) List() (resources []ontology.IsResource, err error) {
 					Region: b.region,
 				},
 				AtRestEncryption: encryptionAtRest,
-				Raw:              discovery.Raw(&b, &rawBucketEncOutput, &rawBucketTranspEnc, &b.raw),
+				ResourceLogging:  resourceLogging,
+				Raw:              discovery.Raw(&b, &rawBucketEncOutput, &rawBucketTranspEnc, &rawBucketLogging, &b.raw),
 			},
 			// Add ObjectStorageService
 			&ontology.ObjectStorageService{
@@ -180,6 +191,7 @@ func (b *bucket) String() string {
 func NewAwsStorageDiscovery(client *Client, cloudServiceID string) discovery.Discoverer {
 	return &awsS3Discovery{
 		storageAPI:    s3.NewFromConfig(client.cfg),
+		keyAPI:        newFromConfigKMS(client.cfg),
 		isDiscovering: true,
 		awsConfig:     client,
 		csID:          cloudServiceID,
@@ -253,6 +265,14 @@ func (d *awsS3Discovery) getEncryptionAtRest(bucket *bucket) (e *ontology.AtRest
 				},
 			},
 		}
+	} else if d.keyAPI != nil {
+		// Buckets without a key ID are encrypted using the AWS managed key
+		keyID := aws.ToString(resp.ServerSideEncryptionConfiguration.Rules[0].ApplyServerSideEncryptionByDefault.KMSMasterKeyID)
+		if keyID == "" {
+			keyID = "alias/aws/s3"
+		}
+
+		e, err = kmsEncryption(d.keyAPI, keyID)
 	} else {
 		e = &ontology.AtRestEncryption{
 			Type: &ontology.AtRestEncryption_CustomerKeyEncryption{
@@ -345,6 +365,28 @@ func (d *awsS3Discovery) getTransportEncryption(bucket string) (*ontology.Transp
 
 }
 
+// getResourceLogging returns whether server access logging is enabled for the bucket. If so, the bucket the access logs
+// are delivered to is referenced as the logging service.
+func (d *awsS3Discovery) getResourceLogging(bucket string) (*ontology.ResourceLogging, *s3.GetBucketLoggingOutput, error) {
+	resp, err := d.storageAPI.GetBucketLogging(context.TODO(), &s3.GetBucketLoggingInput{
+		Bucket: aws.String(bucket),
+	})
+	if err != nil {
+		return nil, resp, prettyError(err)
+	}
+
+	rl := &ontology.ResourceLogging{
+		LoggingServiceIds: []string{},
+	}
+
+	if resp.LoggingEnabled != nil && resp.LoggingEnabled.TargetBucket != nil {
+		rl.Enabled = true
+		rl.LoggingServiceIds = append(rl.LoggingServiceIds, "arn:aws:s3:::"+aws.ToString(resp.LoggingEnabled.TargetBucket))
+	}
+
+	return rl, resp, nil
+}
+
 // getRegion returns the region where the bucket resides
 func (d *awsS3Discovery) getRegion(bucket string) (region string, resp *s3.GetBucketLocationOutput, err error) {
 	input := s3.GetBucketLocationInput{
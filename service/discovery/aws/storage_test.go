@@ -223,6 +223,22 @@ func (mockS3APINew) GetBucketLifecycleConfiguration(_ context.Context,
 	panic("implement me")
 }
 
+func (mockS3APINew) GetBucketLogging(_ context.Context,
+	params *s3.GetBucketLoggingInput,
+	_ ...func(*s3.Options)) (*s3.GetBucketLoggingOutput, error) {
+	switch aws.ToString(params.Bucket) {
+	case mockBucket1:
+		return &s3.GetBucketLoggingOutput{
+			LoggingEnabled: &types.LoggingEnabled{
+				TargetBucket: aws.String(mockBucket2),
+				TargetPrefix: aws.String("logs/"),
+			},
+		}, nil
+	default:
+		return &s3.GetBucketLoggingOutput{}, nil
+	}
+}
+
 type mockS3APIWitHErrors struct{}
 
 func (mockS3APIWitHErrors) ListBuckets(_ context.Context,
@@ -286,6 +302,15 @@ func (mockS3APIWitHErrors) GetBucketLifecycleConfiguration(_ context.Context, _
 	return nil, oe
 }
 
+func (mockS3APIWitHErrors) GetBucketLogging(_ context.Context, _ *s3.GetBucketLoggingInput, _ ...func(*s3.Options)) (*s3.GetBucketLoggingOutput, error) {
+	oe := &smithy.OperationError{
+		ServiceID:     "MockS3API",
+		OperationName: "GetBucketLogging",
+		Err:           errors.New("failed to resolve service endpoint"),
+	}
+	return nil, oe
+}
+
 // TestGetBuckets tests the getBuckets method (with other form of mocking implementation)
 func TestAwsS3Discovery_getBuckets(t *testing.T) {
 	d := awsS3Discovery{
@@ -384,6 +409,19 @@ func TestAwsS3Discovery_getEncryptionAtRest(t *testing.T) {
 	}
 	_, _, err = d.getEncryptionAtRest(&bucket{name: "mockbucket4"})
 	assert.Error(t, err)
+
+	// 5th case: SSE-KMS encryption with key details from KMS
+	d = awsS3Discovery{
+		storageAPI:    mockS3APINew{},
+		keyAPI:        mockKMSAPI{},
+		isDiscovering: false,
+	}
+	encryptionAtRest, _, err = d.getEncryptionAtRest(&bucket{name: mockBucket2, region: mockBucket2Region})
+	assert.NoError(t, err)
+	customerEncryption = encryptionAtRest.GetCustomerKeyEncryption()
+	assert.Equal(t, mockCustomerKey, customerEncryption.KeyUrl)
+	assert.Equal(t, mockKeyManager, customerEncryption.KeyManager)
+	assert.Equal(t, DefaultKeyRotationPeriod, customerEncryption.KeyRotationPeriod.AsDuration())
 }
 
 // TestGetTransportEncryption tests the getTransportEncryption method
@@ -500,8 +538,10 @@ func TestAwsS3Discovery_List(t *testing.T) {
 	assert.Equal(t, expectedResourceNames[0], resources[0].GetName())
 	log.Println("Testing type of resource", 1)
 	assert.True(t, ontology.HasType(resources[0], "ObjectStorage"))
-	expectedRaw := "{\"**s3.GetBucketEncryptionOutput\":[{\"ServerSideEncryptionConfiguration\":{\"Rules\":[{\"ApplyServerSideEncryptionByDefault\":{\"SSEAlgorithm\":\"AES256\",\"KMSMasterKeyID\":null},\"BucketKeyEnabled\":false}]},\"ResultMetadata\":{}}],\"**s3.GetBucketPolicyOutput\":[{\"Policy\":\"{\\\"id\\\":\\\"Mock BucketPolicy ID 1234\\\",\\\"Version\\\":\\\"2012-10-17\\\",\\\"Statement\\\":[{\\\"Action\\\":\\\"s3:*\\\",\\\"Effect\\\":\\\"Deny\\\",\\\"Resource\\\":\\\"*\\\",\\\"Condition\\\":{\\\"aws:SecureTransport\\\":false}}]}\",\"ResultMetadata\":{}}],\"*[]interface {}\":[[{\"CreationDate\":\"2012-11-01T22:08:41Z\",\"Name\":\"mockbucket1\"},{\"LocationConstraint\":\"eu-central-1\",\"ResultMetadata\":{}}]],\"*aws.bucket\":[{}]}"
+	expectedRaw := "{\"**s3.GetBucketEncryptionOutput\":[{\"ServerSideEncryptionConfiguration\":{\"Rules\":[{\"ApplyServerSideEncryptionByDefault\":{\"SSEAlgorithm\":\"AES256\",\"KMSMasterKeyID\":null},\"BucketKeyEnabled\":false}]},\"ResultMetadata\":{}}],\"**s3.GetBucketLoggingOutput\":[{\"LoggingEnabled\":{\"TargetBucket\":\"mockbucket2\",\"TargetPrefix\":\"logs/\",\"TargetGrants\":null,\"TargetObjectKeyFormat\":null},\"ResultMetadata\":{}}],\"**s3.GetBucketPolicyOutput\":[{\"Policy\":\"{\\\"id\\\":\\\"Mock BucketPolicy ID 1234\\\",\\\"Version\\\":\\\"2012-10-17\\\",\\\"Statement\\\":[{\\\"Action\\\":\\\"s3:*\\\",\\\"Effect\\\":\\\"Deny\\\",\\\"Resource\\\":\\\"*\\\",\\\"Condition\\\":{\\\"aws:SecureTransport\\\":false}}]}\",\"ResultMetadata\":{}}],\"*[]interface {}\":[[{\"CreationDate\":\"2012-11-01T22:08:41Z\",\"Name\":\"mockbucket1\"},{\"LocationConstraint\":\"eu-central-1\",\"ResultMetadata\":{}}]],\"*aws.bucket\":[{}]}"
 	assert.Equal(t, expectedRaw, resources[0].GetRaw())
+	log.Println("Testing resource logging of resource", 1)
+	assert.Equal(t, []string{"arn:aws:s3:::mockbucket2"}, resources[0].(*ontology.ObjectStorage).GetResourceLogging().GetLoggingServiceIds())
 
 	// Check second element: voc.ObjectStorageService
 	log.Println("Testing name for resource (bucket)", 2)
@@ -44,7 +44,9 @@ import (
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v3"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/cosmos/armcosmos"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/dataprotection/armdataprotection"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/monitor/armmonitor"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/operationalinsights/armoperationalinsights"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/security/armsecurity"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/sql/armsql"
@@ -134,6 +136,11 @@ type azureDiscovery struct {
 	csID                string
 	backupMap           map[string]*backup
 	defenderProperties  map[string]*defenderProperties
+	// remoteAdministrationProperties contains the network configuration needed to describe the remote administration
+	// of virtual machines
+	remoteAdministrationProperties *remoteAdministrationProperties
+	// activityLogging contains the IDs of the logging services the activity log of the subscription is sent to
+	activityLogging []string
 }
 
 type defenderProperties struct {
@@ -141,6 +148,16 @@ type defenderProperties struct {
 	securityAlertsEnabled    bool
 }
 
+// remoteAdministrationProperties contains the network configuration of the (scoped) subscription, which is needed to
+// describe the remote administration of virtual machines. All keys are lower-case resource IDs.
+type remoteAdministrationProperties struct {
+	interfaces                  map[string]*armnetwork.Interface
+	networkSecurityGroups       map[string]*armnetwork.SecurityGroup
+	subnetNetworkSecurityGroups map[string]string
+	bastionNetworks             map[string]bool
+	jitVirtualMachines          map[string]bool
+}
+
 type backup struct {
 	// backup is a list of all ontology.Backup objects
 	backup map[string][]*ontology.Backup
@@ -155,17 +172,20 @@ type clients struct {
 	accountsClient      *armstorage.AccountsClient
 
 	// DB
-	databasesClient        *armsql.DatabasesClient
-	sqlServersClient       *armsql.ServersClient
-	threatProtectionClient *armsql.DatabaseAdvancedThreatProtectionSettingsClient
-	cosmosDBClient         *armcosmos.DatabaseAccountsClient
-	mongoDBResourcesClient *armcosmos.MongoDBResourcesClient
+	databasesClient            *armsql.DatabasesClient
+	sqlServersClient           *armsql.ServersClient
+	threatProtectionClient     *armsql.DatabaseAdvancedThreatProtectionSettingsClient
+	encryptionProtectorsClient *armsql.EncryptionProtectorsClient
+	cosmosDBClient             *armcosmos.DatabaseAccountsClient
+	mongoDBResourcesClient     *armcosmos.MongoDBResourcesClient
 
 	// Network
 	networkInterfacesClient     *armnetwork.InterfacesClient
 	loadBalancerClient          *armnetwork.LoadBalancersClient
 	applicationGatewayClient    *armnetwork.ApplicationGatewaysClient
 	networkSecurityGroupsClient *armnetwork.SecurityGroupsClient
+	virtualNetworksClient       *armnetwork.VirtualNetworksClient
+	bastionHostsClient          *armnetwork.BastionHostsClient
 
 	// AppService
 	webAppsClient *armappservice.WebAppsClient
@@ -176,7 +196,8 @@ type clients struct {
 	diskEncSetClient      *armcompute.DiskEncryptionSetsClient
 
 	// Security
-	defenderClient *armsecurity.PricingsClient
+	defenderClient    *armsecurity.PricingsClient
+	jitPoliciesClient *armsecurity.JitNetworkAccessPoliciesClient
 
 	// Data protection
 	backupPoliciesClient  *armdataprotection.BackupPoliciesClient
@@ -185,6 +206,10 @@ type clients struct {
 
 	// Resource groups
 	rgClient *armresources.ResourceGroupsClient
+
+	// Monitor
+	workspacesClient         *armoperationalinsights.WorkspacesClient
+	diagnosticSettingsClient *armmonitor.DiagnosticSettingsClient
 }
 
 func NewAzureDiscovery(opts ...DiscoveryOption) discovery.Discoverer {
@@ -207,11 +232,15 @@ func NewAzureDiscovery(opts ...DiscoveryOption) discovery.Discoverer {
 // - Storage resource
 // - Compute resource
 // - Network resource
+// - Logging resource
 func (d *azureDiscovery) List() (list []ontology.IsResource, err error) {
 	if err = d.authorize(); err != nil {
 		return nil, fmt.Errorf("%s: %w", ErrCouldNotAuthenticate, err)
 	}
 
+	// The diagnostic settings of the activity log might have changed since the last discovery
+	d.activityLogging = nil
+
 	// Discover resource group resources
 	log.Info("Discover Azure resource group resources...")
 	rg, err := d.discoverResourceGroups()
@@ -271,6 +300,12 @@ func (d *azureDiscovery) List() (list []ontology.IsResource, err error) {
 		list = append(list, d.backupMap[DataSourceTypeDisc].backupStorages...)
 	}
 
+	// Discover the network configuration of virtual machines to add it to their remote administration
+	d.remoteAdministrationProperties, err = d.discoverRemoteAdministration()
+	if err != nil {
+		log.Errorf("could not discover remote administration of virtual machines: %v", err)
+	}
+
 	// Discover virtual machines
 	virtualMachines, err := d.discoverVirtualMachines()
 	if err != nil {
@@ -310,6 +345,16 @@ func (d *azureDiscovery) List() (list []ontology.IsResource, err error) {
 	}
 	list = append(list, ag...)
 
+	// Discover logging resources
+	log.Info("Discover Azure logging resources...")
+
+	// Discover Log Analytics workspaces
+	workspaces, err := d.discoverLogAnalyticsWorkspaces()
+	if err != nil {
+		log.Errorf("could not discover Log Analytics workspaces: %v", err)
+	}
+	list = append(list, workspaces...)
+
 	return list, nil
 }
 
@@ -36,7 +36,9 @@ import (
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v3"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/cosmos/armcosmos"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/dataprotection/armdataprotection"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/monitor/armmonitor"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/operationalinsights/armoperationalinsights"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/security/armsecurity"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/sql/armsql"
@@ -102,6 +104,13 @@ func (d *azureDiscovery) initBackupVaultsClient() (err error) {
 	return
 }
 
+// initBastionHostsClient creates the client if not already exists
+func (d *azureDiscovery) initBastionHostsClient() (err error) {
+	d.clients.bastionHostsClient, err = initClient(d.clients.bastionHostsClient, d, armnetwork.NewBastionHostsClient)
+
+	return
+}
+
 // initBlobContainerClient creates the client if not already exists
 func (d *azureDiscovery) initBlobContainerClient() (err error) {
 	d.clients.blobContainerClient, err = initClient(d.clients.blobContainerClient, d, armstorage.NewBlobContainersClient)
@@ -156,6 +165,24 @@ func (d *azureDiscovery) initFileStorageClient() (err error) {
 	return
 }
 
+// initDiagnosticSettingsClient creates the client if not already exists. Since diagnostic settings are scoped to a
+// resource rather than a subscription, the client does not need a subscription ID.
+func (d *azureDiscovery) initDiagnosticSettingsClient() (err error) {
+	d.clients.diagnosticSettingsClient, err = initClient(d.clients.diagnosticSettingsClient, d,
+		func(_ string, credential azcore.TokenCredential, options *arm.ClientOptions) (*armmonitor.DiagnosticSettingsClient, error) {
+			return armmonitor.NewDiagnosticSettingsClient(credential, options)
+		})
+
+	return
+}
+
+// initJitPoliciesClient creates the client if not already exists
+func (d *azureDiscovery) initJitPoliciesClient() (err error) {
+	d.clients.jitPoliciesClient, err = initClient(d.clients.jitPoliciesClient, d, armsecurity.NewJitNetworkAccessPoliciesClient)
+
+	return
+}
+
 // initLoadBalancersClient creates the client if not already exists
 func (d *azureDiscovery) initLoadBalancersClient() (err error) {
 	d.clients.loadBalancerClient, err = initClient(d.clients.loadBalancerClient, d, armnetwork.NewLoadBalancersClient)
@@ -198,6 +225,13 @@ func (d *azureDiscovery) initThreatProtectionClient() (err error) {
 	return
 }
 
+// initEncryptionProtectorsClient creates the client if not already exists
+func (d *azureDiscovery) initEncryptionProtectorsClient() (err error) {
+	d.clients.encryptionProtectorsClient, err = initClient(d.clients.encryptionProtectorsClient, d, armsql.NewEncryptionProtectorsClient)
+
+	return
+}
+
 // initVirtualMachinesClient creates the client if not already exists
 func (d *azureDiscovery) initVirtualMachinesClient() (err error) {
 	d.clients.virtualMachinesClient, err = initClient(d.clients.virtualMachinesClient, d, armcompute.NewVirtualMachinesClient)
@@ -205,9 +239,23 @@ func (d *azureDiscovery) initVirtualMachinesClient() (err error) {
 	return
 }
 
+// initVirtualNetworksClient creates the client if not already exists
+func (d *azureDiscovery) initVirtualNetworksClient() (err error) {
+	d.clients.virtualNetworksClient, err = initClient(d.clients.virtualNetworksClient, d, armnetwork.NewVirtualNetworksClient)
+
+	return
+}
+
 // initWebAppsClient creates the client if not already exists
 func (d *azureDiscovery) initWebAppsClient() (err error) {
 	d.clients.webAppsClient, err = initClient(d.clients.webAppsClient, d, armappservice.NewWebAppsClient)
 
 	return
 }
+
+// initWorkspacesClient creates the client if not already exists
+func (d *azureDiscovery) initWorkspacesClient() (err error) {
+	d.clients.workspacesClient, err = initClient(d.clients.workspacesClient, d, armoperationalinsights.NewWorkspacesClient)
+
+	return
+}
@@ -26,6 +26,7 @@
 package azure
 
 import (
+	"net/url"
 	"slices"
 	"strconv"
 	"strings"
@@ -190,6 +191,46 @@ func resourceGroupName(id string) string {
 	return strings.Split(id, "/")[4]
 }
 
+// virtualNetworkID returns the ID of the virtual network of a given subnet ID
+func virtualNetworkID(subnetID string) string {
+	id, _, found := strings.Cut(subnetID, "/subnets/")
+	if !found {
+		return ""
+	}
+
+	return id
+}
+
+// keyVaultURL returns the URL of the key vault that manages the key with the given key URL, e.g.,
+// https://myvault.vault.azure.net for the key https://myvault.vault.azure.net/keys/mykey/123456.
+func keyVaultURL(keyURL string) string {
+	u, err := url.Parse(keyURL)
+	if err != nil || u.Host == "" {
+		return ""
+	}
+
+	return u.Scheme + "://" + u.Host
+}
+
+// keyVaultKeyURL returns the URL of the key specified in the key vault properties of a storage account. The version is
+// only part of the URL, if the account is pinned to a specific key version.
+func keyVaultKeyURL(props *armstorage.KeyVaultProperties) string {
+	if props == nil {
+		return ""
+	}
+
+	if util.Deref(props.KeyName) == "" {
+		return util.Deref(props.KeyVaultURI)
+	}
+
+	keyURL := strings.TrimSuffix(util.Deref(props.KeyVaultURI), "/") + "/keys/" + util.Deref(props.KeyName)
+	if version := util.Deref(props.KeyVersion); version != "" {
+		keyURL += "/" + version
+	}
+
+	return keyURL
+}
+
 // resourceGroupID builds a resource group ID out of the resource ID. It will also be lowercase (see resourceID function
 // for reasoning).
 func resourceGroupID(ID *string) *string {
@@ -32,6 +32,7 @@ import (
 	"clouditor.io/clouditor/v2/internal/testutil/assert"
 	"clouditor.io/clouditor/v2/internal/util"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/appservice/armappservice/v2"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
 )
 
 func Test_tlsCipherSuites(t *testing.T) {
@@ -152,3 +153,88 @@ func Test_tlsVersion(t *testing.T) {
 		})
 	}
 }
+
+func Test_keyVaultURL(t *testing.T) {
+	type args struct {
+		keyURL string
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			name: "invalid",
+			args: args{
+				keyURL: "not a key URL",
+			},
+			want: "",
+		},
+		{
+			name: "happy path",
+			args: args{
+				keyURL: "https://testvault.vault.azure.net/keys/testkey/123456",
+			},
+			want: "https://testvault.vault.azure.net",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := keyVaultURL(tt.args.keyURL)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_keyVaultKeyURL(t *testing.T) {
+	type args struct {
+		props *armstorage.KeyVaultProperties
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			name: "empty properties",
+			args: args{},
+			want: "",
+		},
+		{
+			name: "without key name",
+			args: args{
+				props: &armstorage.KeyVaultProperties{
+					KeyVaultURI: util.Ref("https://testvault.vault.azure.net/"),
+				},
+			},
+			want: "https://testvault.vault.azure.net/",
+		},
+		{
+			name: "latest key version",
+			args: args{
+				props: &armstorage.KeyVaultProperties{
+					KeyVaultURI: util.Ref("https://testvault.vault.azure.net/"),
+					KeyName:     util.Ref("testkey"),
+				},
+			},
+			want: "https://testvault.vault.azure.net/keys/testkey",
+		},
+		{
+			name: "pinned key version",
+			args: args{
+				props: &armstorage.KeyVaultProperties{
+					KeyVaultURI: util.Ref("https://testvault.vault.azure.net"),
+					KeyName:     util.Ref("testkey"),
+					KeyVersion:  util.Ref("123456"),
+				},
+			},
+			want: "https://testvault.vault.azure.net/keys/testkey/123456",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := keyVaultKeyURL(tt.args.props)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
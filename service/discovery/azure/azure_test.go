@@ -372,6 +372,16 @@ func (mockSender) Do(req *http.Request) (res *http.Response, err error) {
 				},
 			},
 		}, 200)
+	} else if req.URL.Path == "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/res1/providers/Microsoft.Sql/servers/SQLServer1/encryptionProtector/current" {
+		return createResponse(req, map[string]interface{}{
+			"id":   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/res1/providers/Microsoft.Sql/servers/SQLServer1/encryptionProtector/current",
+			"name": "current",
+			"properties": map[string]interface{}{
+				"serverKeyType": "AzureKeyVault",
+				"serverKeyName": "testvault_testkey_123456",
+				"uri":           "https://testvault.vault.azure.net/keys/testkey/123456",
+			},
+		}, 200)
 	} else if req.URL.Path == "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/res1/providers/Microsoft.Sql/servers/SQLServer1/databases/SqlDatabase1/advancedThreatProtectionSettings" {
 		return createResponse(req, map[string]interface{}{
 			"value": &[]map[string]interface{}{
@@ -1035,6 +1045,138 @@ func (mockSender) Do(req *http.Request) (res *http.Response, err error) {
 				},
 			},
 		}, 200)
+	} else if req.URL.Path == "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Network/networkSecurityGroups" {
+		return createResponse(req, map[string]interface{}{
+			"value": &[]map[string]interface{}{
+				{
+					"id":       "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/res1/providers/Microsoft.Network/networkSecurityGroups/nsg1",
+					"name":     "nsg1",
+					"location": "eastus",
+					"properties": map[string]interface{}{
+						"securityRules": []map[string]interface{}{
+							{
+								"name": "AllowSSH",
+								"properties": map[string]interface{}{
+									"access":               "Allow",
+									"direction":            "Inbound",
+									"priority":             100,
+									"protocol":             "Tcp",
+									"sourceAddressPrefix":  "Internet",
+									"destinationPortRange": "22",
+								},
+							},
+						},
+					},
+				},
+			},
+		}, 200)
+	} else if req.URL.Path == "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Network/virtualNetworks" {
+		return createResponse(req, map[string]interface{}{
+			"value": &[]map[string]interface{}{
+				{
+					"id":       "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/res1/providers/Microsoft.Network/virtualNetworks/vnet1",
+					"name":     "vnet1",
+					"location": "eastus",
+					"properties": map[string]interface{}{
+						"subnets": []map[string]interface{}{
+							{
+								"id":   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/res1/providers/Microsoft.Network/virtualNetworks/vnet1/subnets/default",
+								"name": "default",
+								"properties": map[string]interface{}{
+									"networkSecurityGroup": map[string]interface{}{
+										"id": "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/res1/providers/Microsoft.Network/networkSecurityGroups/nsg1",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}, 200)
+	} else if req.URL.Path == "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Network/bastionHosts" {
+		return createResponse(req, map[string]interface{}{
+			"value": &[]map[string]interface{}{
+				{
+					"id":       "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/res1/providers/Microsoft.Network/bastionHosts/bastion1",
+					"name":     "bastion1",
+					"location": "eastus",
+					"properties": map[string]interface{}{
+						"ipConfigurations": []map[string]interface{}{
+							{
+								"properties": map[string]interface{}{
+									"subnet": map[string]interface{}{
+										"id": "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/res1/providers/Microsoft.Network/virtualNetworks/vnet1/subnets/AzureBastionSubnet",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}, 200)
+	} else if req.URL.Path == "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Security/jitNetworkAccessPolicies" {
+		return createResponse(req, map[string]interface{}{
+			"value": &[]map[string]interface{}{
+				{
+					"id":   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/res1/providers/Microsoft.Security/locations/eastus/jitNetworkAccessPolicies/default",
+					"name": "default",
+					"properties": map[string]interface{}{
+						"virtualMachines": []map[string]interface{}{
+							{
+								"id": "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/res1/providers/Microsoft.Compute/virtualMachines/vm1",
+								"ports": []map[string]interface{}{
+									{
+										"number":                     22,
+										"protocol":                   "*",
+										"allowedSourceAddressPrefix": "*",
+										"maxRequestAccessDuration":   "PT3H",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}, 200)
+	} else if req.URL.Path == "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.OperationalInsights/workspaces" {
+		return createResponse(req, map[string]interface{}{
+			"value": &[]map[string]interface{}{
+				{
+					"id":       "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/res1/providers/Microsoft.OperationalInsights/workspaces/workspace1",
+					"name":     "workspace1",
+					"location": "eastus",
+					"properties": map[string]interface{}{
+						"createdDate":     "2017-05-24T13:28:53.004540398Z",
+						"retentionInDays": 30,
+					},
+				},
+			},
+		}, 200)
+	} else if req.URL.Path == "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Insights/diagnosticSettings" {
+		return createResponse(req, map[string]interface{}{
+			"value": &[]map[string]interface{}{
+				{
+					"id":   "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Insights/diagnosticSettings/activitylog",
+					"name": "activitylog",
+					"properties": map[string]interface{}{
+						"workspaceId": "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/res1/providers/Microsoft.OperationalInsights/workspaces/workspace1",
+					},
+				},
+			},
+		}, 200)
+	} else if req.URL.Path == "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/res1/providers/Microsoft.Compute/virtualMachines/vm1/providers/Microsoft.Insights/diagnosticSettings" {
+		return createResponse(req, map[string]interface{}{
+			"value": &[]map[string]interface{}{
+				{
+					"id":   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/res1/providers/Microsoft.Compute/virtualMachines/vm1/providers/Microsoft.Insights/diagnosticSettings/vmlogs",
+					"name": "vmlogs",
+					"properties": map[string]interface{}{
+						"workspaceId":      "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/res1/providers/Microsoft.OperationalInsights/workspaces/workspace1",
+						"storageAccountId": "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/res1/providers/Microsoft.Storage/storageAccounts/account1",
+					},
+				},
+			},
+		}, 200)
 	} else {
 		res, err = createResponse(req, map[string]interface{}{}, 404)
 		log.Errorf("Not handling mock for %s yet", req.URL.Path)
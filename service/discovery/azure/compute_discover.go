@@ -35,6 +35,8 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/appservice/armappservice/v2"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v3"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/security/armsecurity"
 )
 
 var (
@@ -79,6 +81,139 @@ func (d *azureDiscovery) discoverVirtualMachines() ([]ontology.IsResource, error
 	return list, nil
 }
 
+// discoverRemoteAdministration discovers the network interfaces, network security groups, virtual networks, bastion
+// hosts and JIT network access policies, which are needed to describe the remote administration of virtual machines. If
+// some of them cannot be listed, e.g., JIT network access policies without Defender for Servers, the others are
+// returned nevertheless.
+func (d *azureDiscovery) discoverRemoteAdministration() (props *remoteAdministrationProperties, err error) {
+	props = &remoteAdministrationProperties{
+		interfaces:                  make(map[string]*armnetwork.Interface),
+		networkSecurityGroups:       make(map[string]*armnetwork.SecurityGroup),
+		subnetNetworkSecurityGroups: make(map[string]string),
+		bastionNetworks:             make(map[string]bool),
+		jitVirtualMachines:          make(map[string]bool),
+	}
+
+	// initialize clients
+	if err = d.initNetworkInterfacesClient(); err != nil {
+		return nil, err
+	}
+	if err = d.initNetworkSecurityGroupClient(); err != nil {
+		return nil, err
+	}
+	if err = d.initVirtualNetworksClient(); err != nil {
+		return nil, err
+	}
+	if err = d.initBastionHostsClient(); err != nil {
+		return nil, err
+	}
+	if err = d.initJitPoliciesClient(); err != nil {
+		return nil, err
+	}
+
+	// List all network interfaces
+	err = errors.Join(err, listPager(d,
+		d.clients.networkInterfacesClient.NewListAllPager,
+		d.clients.networkInterfacesClient.NewListPager,
+		func(res armnetwork.InterfacesClientListAllResponse) []*armnetwork.Interface {
+			return res.Value
+		},
+		func(res armnetwork.InterfacesClientListResponse) []*armnetwork.Interface {
+			return res.Value
+		},
+		func(ni *armnetwork.Interface) error {
+			props.interfaces[resourceID(ni.ID)] = ni
+			return nil
+		}))
+
+	// List all network security groups
+	err = errors.Join(err, listPager(d,
+		d.clients.networkSecurityGroupsClient.NewListAllPager,
+		d.clients.networkSecurityGroupsClient.NewListPager,
+		func(res armnetwork.SecurityGroupsClientListAllResponse) []*armnetwork.SecurityGroup {
+			return res.Value
+		},
+		func(res armnetwork.SecurityGroupsClientListResponse) []*armnetwork.SecurityGroup {
+			return res.Value
+		},
+		func(nsg *armnetwork.SecurityGroup) error {
+			props.networkSecurityGroups[resourceID(nsg.ID)] = nsg
+			return nil
+		}))
+
+	// List all virtual networks to retrieve the network security groups of their subnets
+	err = errors.Join(err, listPager(d,
+		d.clients.virtualNetworksClient.NewListAllPager,
+		d.clients.virtualNetworksClient.NewListPager,
+		func(res armnetwork.VirtualNetworksClientListAllResponse) []*armnetwork.VirtualNetwork {
+			return res.Value
+		},
+		func(res armnetwork.VirtualNetworksClientListResponse) []*armnetwork.VirtualNetwork {
+			return res.Value
+		},
+		func(vnet *armnetwork.VirtualNetwork) error {
+			if vnet.Properties == nil {
+				return nil
+			}
+
+			for _, subnet := range vnet.Properties.Subnets {
+				if subnet.Properties != nil && subnet.Properties.NetworkSecurityGroup != nil {
+					props.subnetNetworkSecurityGroups[resourceID(subnet.ID)] = resourceID(subnet.Properties.NetworkSecurityGroup.ID)
+				}
+			}
+
+			return nil
+		}))
+
+	// List all bastion hosts to retrieve the virtual networks they are deployed in
+	err = errors.Join(err, listPager(d,
+		d.clients.bastionHostsClient.NewListPager,
+		d.clients.bastionHostsClient.NewListByResourceGroupPager,
+		func(res armnetwork.BastionHostsClientListResponse) []*armnetwork.BastionHost {
+			return res.Value
+		},
+		func(res armnetwork.BastionHostsClientListByResourceGroupResponse) []*armnetwork.BastionHost {
+			return res.Value
+		},
+		func(bastion *armnetwork.BastionHost) error {
+			if bastion.Properties == nil {
+				return nil
+			}
+
+			for _, ipc := range bastion.Properties.IPConfigurations {
+				if ipc.Properties != nil && ipc.Properties.Subnet != nil {
+					props.bastionNetworks[virtualNetworkID(resourceID(ipc.Properties.Subnet.ID))] = true
+				}
+			}
+
+			return nil
+		}))
+
+	// List all JIT network access policies of Defender for Servers
+	err = errors.Join(err, listPager(d,
+		d.clients.jitPoliciesClient.NewListPager,
+		d.clients.jitPoliciesClient.NewListByResourceGroupPager,
+		func(res armsecurity.JitNetworkAccessPoliciesClientListResponse) []*armsecurity.JitNetworkAccessPolicy {
+			return res.Value
+		},
+		func(res armsecurity.JitNetworkAccessPoliciesClientListByResourceGroupResponse) []*armsecurity.JitNetworkAccessPolicy {
+			return res.Value
+		},
+		func(policy *armsecurity.JitNetworkAccessPolicy) error {
+			if policy.Properties == nil {
+				return nil
+			}
+
+			for _, vm := range policy.Properties.VirtualMachines {
+				props.jitVirtualMachines[resourceID(vm.ID)] = true
+			}
+
+			return nil
+		}))
+
+	return props, err
+}
+
 func (d *azureDiscovery) discoverBlockStorages() ([]ontology.IsResource, error) {
 	var list []ontology.IsResource
 
@@ -105,9 +105,11 @@ func (d *azureDiscovery) handleVirtualMachines(vm *armcompute.VirtualMachine) (o
 		ActivityLogging: &ontology.ActivityLogging{
 			Enabled:           true, // is always enabled
 			RetentionPeriod:   durationpb.New(RetentionPeriod90Days),
-			LoggingServiceIds: []string{}, // TODO(all): TBD
+			LoggingServiceIds: d.activityLoggingServices(),
 		},
-		AutomaticUpdates: autoUpdates,
+		ResourceLogging:      d.resourceLogging(vm.ID),
+		AutomaticUpdates:     autoUpdates,
+		RemoteAdministration: d.remoteAdministration(vm),
 	}
 
 	// Reference to networkInterfaces
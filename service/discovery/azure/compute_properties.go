@@ -29,6 +29,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 
 	"clouditor.io/clouditor/v2/api/ontology"
@@ -37,6 +38,7 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/appservice/armappservice/v2"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v3"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork"
 	"google.golang.org/protobuf/types/known/durationpb"
 )
 
@@ -78,9 +80,10 @@ func (d *azureDiscovery) blockStorageAtRestEncryption(disk *armcompute.Disk) (en
 		enc = &ontology.AtRestEncryption{
 			Type: &ontology.AtRestEncryption_CustomerKeyEncryption{
 				CustomerKeyEncryption: &ontology.CustomerKeyEncryption{
-					Algorithm: "", // TODO(all): TBD
-					Enabled:   true,
-					KeyUrl:    keyUrl,
+					Algorithm:  "", // TODO(all): TBD
+					Enabled:    true,
+					KeyUrl:     keyUrl,
+					KeyManager: keyVaultURL(keyUrl),
 				},
 			},
 		}
@@ -175,6 +178,13 @@ func (d *azureDiscovery) getResourceLoggingWebApps(site *armappservice.Site) (rl
 		// TODO: Get id of logging service and add it (currently not possible via app settings): rl.LoggingService
 
 	}
+
+	// Logs can also be sent to a Log Analytics workspace or storage account using diagnostic settings
+	rl.LoggingServiceIds = d.diagnosticLoggingServices(site.ID)
+	if len(rl.LoggingServiceIds) > 0 {
+		rl.Enabled = true
+	}
+
 	return
 
 }
@@ -290,3 +300,86 @@ func bootLogOutput(vm *armcompute.VirtualMachine) string {
 	}
 	return ""
 }
+
+// managementPorts are the ports used for the remote administration of virtual machines, i.e., SSH and RDP
+var managementPorts = []uint32{22, 3389}
+
+// remoteAdministration returns the remote administration of the virtual machine, i.e., the management ports that are
+// accessible from the internet and whether it is protected by a JIT network access policy or accessible via a bastion
+// host in its virtual network.
+func (d *azureDiscovery) remoteAdministration(vm *armcompute.VirtualMachine) *ontology.RemoteAdministration {
+	var (
+		ra    = &ontology.RemoteAdministration{PublicManagementPorts: []uint32{}}
+		props = d.remoteAdministrationProperties
+	)
+
+	if props == nil || vm.Properties == nil || vm.Properties.NetworkProfile == nil {
+		return ra
+	}
+
+	ra.JustInTimeAccessEnabled = props.jitVirtualMachines[resourceID(vm.ID)]
+
+	for _, ref := range vm.Properties.NetworkProfile.NetworkInterfaces {
+		ni, ok := props.interfaces[resourceID(ref.ID)]
+		if !ok || ni.Properties == nil {
+			continue
+		}
+
+		for _, ipc := range ni.Properties.IPConfigurations {
+			var subnetID string
+
+			if ipc.Properties == nil {
+				continue
+			}
+
+			if ipc.Properties.Subnet != nil {
+				subnetID = resourceID(ipc.Properties.Subnet.ID)
+				ra.BastionEnabled = ra.BastionEnabled || props.bastionNetworks[virtualNetworkID(subnetID)]
+			}
+
+			// Management ports can only be accessed from the internet using a public IP address
+			if ipc.Properties.PublicIPAddress == nil {
+				continue
+			}
+
+			nsgs, ok := props.effectiveNetworkSecurityGroups(ni, subnetID)
+			if !ok {
+				log.Debugf("Could not retrieve network security groups of network interface %s", util.Deref(ni.ID))
+				continue
+			}
+
+			for _, port := range managementPorts {
+				if !slices.Contains(ra.PublicManagementPorts, port) && internetAccessAllowed(nsgs, port) {
+					ra.PublicManagementPorts = append(ra.PublicManagementPorts, port)
+				}
+			}
+		}
+	}
+
+	return ra
+}
+
+// effectiveNetworkSecurityGroups returns the network security groups that filter the traffic of the network interface,
+// i.e., the one of the network interface itself and the one of its subnet. It returns false, if one of them is unknown.
+func (p *remoteAdministrationProperties) effectiveNetworkSecurityGroups(ni *armnetwork.Interface, subnetID string) (nsgs []*armnetwork.SecurityGroup, ok bool) {
+	var ids []string
+
+	if ni.Properties.NetworkSecurityGroup != nil {
+		ids = append(ids, resourceID(ni.Properties.NetworkSecurityGroup.ID))
+	}
+
+	if id, ok := p.subnetNetworkSecurityGroups[subnetID]; ok {
+		ids = append(ids, id)
+	}
+
+	for _, id := range ids {
+		nsg, ok := p.networkSecurityGroups[id]
+		if !ok {
+			return nil, false
+		}
+
+		nsgs = append(nsgs, nsg)
+	}
+
+	return nsgs, true
+}
@@ -37,6 +37,7 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/appservice/armappservice/v2"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v3"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -425,10 +426,20 @@ func Test_azureComputeDiscovery_discoverVirtualMachines(t *testing.T) {
 						Interval: durationpb.New(Duration30Days),
 					},
 					MalwareProtection: &ontology.MalwareProtection{},
+					RemoteAdministration: &ontology.RemoteAdministration{
+						PublicManagementPorts: []uint32{},
+					},
 					ActivityLogging: &ontology.ActivityLogging{
 						Enabled:           true,
 						RetentionPeriod:   durationpb.New(RetentionPeriod90Days),
-						LoggingServiceIds: []string{},
+						LoggingServiceIds: []string{"/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/res1/providers/microsoft.operationalinsights/workspaces/workspace1"},
+					},
+					ResourceLogging: &ontology.ResourceLogging{
+						Enabled: true,
+						LoggingServiceIds: []string{
+							"/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/res1/providers/microsoft.operationalinsights/workspaces/workspace1",
+							"/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/res1/providers/microsoft.storage/storageaccounts/account1",
+						},
 					},
 				},
 				&ontology.VirtualMachine{
@@ -458,9 +469,15 @@ func Test_azureComputeDiscovery_discoverVirtualMachines(t *testing.T) {
 						Interval: durationpb.New(Duration30Days),
 					},
 					MalwareProtection: &ontology.MalwareProtection{},
+					RemoteAdministration: &ontology.RemoteAdministration{
+						PublicManagementPorts: []uint32{},
+					},
 					ActivityLogging: &ontology.ActivityLogging{
 						Enabled:           true,
 						RetentionPeriod:   durationpb.New(RetentionPeriod90Days),
+						LoggingServiceIds: []string{"/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/res1/providers/microsoft.operationalinsights/workspaces/workspace1"},
+					},
+					ResourceLogging: &ontology.ResourceLogging{
 						LoggingServiceIds: []string{},
 					},
 				},
@@ -491,9 +508,15 @@ func Test_azureComputeDiscovery_discoverVirtualMachines(t *testing.T) {
 						Interval: nil,
 					},
 					MalwareProtection: &ontology.MalwareProtection{},
+					RemoteAdministration: &ontology.RemoteAdministration{
+						PublicManagementPorts: []uint32{},
+					},
 					ActivityLogging: &ontology.ActivityLogging{
 						Enabled:           true,
 						RetentionPeriod:   durationpb.New(RetentionPeriod90Days),
+						LoggingServiceIds: []string{"/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/res1/providers/microsoft.operationalinsights/workspaces/workspace1"},
+					},
+					ResourceLogging: &ontology.ResourceLogging{
 						LoggingServiceIds: []string{},
 					},
 				},
@@ -624,10 +647,20 @@ func Test_azureComputeDiscovery_handleVirtualMachines(t *testing.T) {
 					Interval: nil,
 				},
 				MalwareProtection: &ontology.MalwareProtection{},
+				RemoteAdministration: &ontology.RemoteAdministration{
+					PublicManagementPorts: []uint32{},
+				},
 				ActivityLogging: &ontology.ActivityLogging{
 					Enabled:           true,
 					RetentionPeriod:   durationpb.New(RetentionPeriod90Days),
-					LoggingServiceIds: []string{},
+					LoggingServiceIds: []string{"/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/res1/providers/microsoft.operationalinsights/workspaces/workspace1"},
+				},
+				ResourceLogging: &ontology.ResourceLogging{
+					Enabled: true,
+					LoggingServiceIds: []string{
+						"/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/res1/providers/microsoft.operationalinsights/workspaces/workspace1",
+						"/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/res1/providers/microsoft.storage/storageaccounts/account1",
+					},
 				},
 			},
 			wantErr: assert.NoError,
@@ -884,9 +917,10 @@ func Test_azureComputeDiscovery_discoverBlockStorage(t *testing.T) {
 					AtRestEncryption: &ontology.AtRestEncryption{
 						Type: &ontology.AtRestEncryption_CustomerKeyEncryption{
 							CustomerKeyEncryption: &ontology.CustomerKeyEncryption{
-								Algorithm: "",
-								Enabled:   true,
-								KeyUrl:    "https://keyvault1.vault.azure.net/keys/customer-key/6273gdb374jz789hjm17819283748382",
+								Algorithm:  "",
+								Enabled:    true,
+								KeyUrl:     "https://keyvault1.vault.azure.net/keys/customer-key/6273gdb374jz789hjm17819283748382",
+								KeyManager: "https://keyvault1.vault.azure.net",
 							},
 						},
 					},
@@ -1042,9 +1076,10 @@ func Test_azureComputeDiscovery_handleBlockStorage(t *testing.T) {
 				AtRestEncryption: &ontology.AtRestEncryption{
 					Type: &ontology.AtRestEncryption_CustomerKeyEncryption{
 						CustomerKeyEncryption: &ontology.CustomerKeyEncryption{
-							Algorithm: "",
-							Enabled:   true,
-							KeyUrl:    "https://keyvault1.vault.azure.net/keys/customer-key/6273gdb374jz789hjm17819283748382",
+							Algorithm:  "",
+							Enabled:    true,
+							KeyUrl:     "https://keyvault1.vault.azure.net/keys/customer-key/6273gdb374jz789hjm17819283748382",
+							KeyManager: "https://keyvault1.vault.azure.net",
 						},
 					},
 				},
@@ -1145,9 +1180,10 @@ func Test_azureComputeDiscovery_blockStorageAtRestEncryption(t *testing.T) {
 			want: &ontology.AtRestEncryption{
 				Type: &ontology.AtRestEncryption_CustomerKeyEncryption{
 					CustomerKeyEncryption: &ontology.CustomerKeyEncryption{
-						Algorithm: "",
-						Enabled:   true,
-						KeyUrl:    "https://keyvault1.vault.azure.net/keys/customer-key/6273gdb374jz789hjm17819283748382",
+						Algorithm:  "",
+						Enabled:    true,
+						KeyUrl:     "https://keyvault1.vault.azure.net/keys/customer-key/6273gdb374jz789hjm17819283748382",
+						KeyManager: "https://keyvault1.vault.azure.net",
 					},
 				},
 			},
@@ -1922,3 +1958,181 @@ func Test_getVirtualNetworkSubnetId(t *testing.T) {
 		})
 	}
 }
+
+func Test_azureDiscovery_discoverRemoteAdministration(t *testing.T) {
+	type fields struct {
+		azureDiscovery *azureDiscovery
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		want    assert.Want[*remoteAdministrationProperties]
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "Happy path",
+			fields: fields{
+				azureDiscovery: NewMockAzureDiscovery(newMockSender()),
+			},
+			want: func(t *testing.T, got *remoteAdministrationProperties) bool {
+				return assert.NotNil(t, got.interfaces["/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/res1/providers/microsoft.network/networkinterfaces/iface1"]) &&
+					assert.NotNil(t, got.networkSecurityGroups["/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/res1/providers/microsoft.network/networksecuritygroups/nsg1"]) &&
+					assert.Equal(t, map[string]string{
+						"/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/res1/providers/microsoft.network/virtualnetworks/vnet1/subnets/default": "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/res1/providers/microsoft.network/networksecuritygroups/nsg1",
+					}, got.subnetNetworkSecurityGroups) &&
+					assert.Equal(t, map[string]bool{
+						"/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/res1/providers/microsoft.network/virtualnetworks/vnet1": true,
+					}, got.bastionNetworks) &&
+					assert.Equal(t, map[string]bool{
+						"/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/res1/providers/microsoft.compute/virtualmachines/vm1": true,
+					}, got.jitVirtualMachines)
+			},
+			wantErr: assert.NoError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := tt.fields.azureDiscovery
+
+			got, err := d.discoverRemoteAdministration()
+
+			tt.wantErr(t, err)
+
+			if tt.want != nil {
+				tt.want(t, got)
+			}
+		})
+	}
+}
+
+func Test_azureDiscovery_remoteAdministration(t *testing.T) {
+	var (
+		vmID     = "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/res1/providers/microsoft.compute/virtualmachines/vm1"
+		niID     = "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/res1/providers/microsoft.network/networkinterfaces/iface1"
+		nsgID    = "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/res1/providers/microsoft.network/networksecuritygroups/nsg1"
+		vnetID   = "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/res1/providers/microsoft.network/virtualnetworks/vnet1"
+		subnetID = vnetID + "/subnets/default"
+		vm       = &armcompute.VirtualMachine{
+			ID: util.Ref(vmID),
+			Properties: &armcompute.VirtualMachineProperties{
+				NetworkProfile: &armcompute.NetworkProfile{
+					NetworkInterfaces: []*armcompute.NetworkInterfaceReference{
+						{ID: util.Ref(niID)},
+					},
+				},
+			},
+		}
+		ni = func(publicIP bool) *armnetwork.Interface {
+			ipc := &armnetwork.InterfaceIPConfiguration{
+				Properties: &armnetwork.InterfaceIPConfigurationPropertiesFormat{
+					Subnet: &armnetwork.Subnet{ID: util.Ref(subnetID)},
+				},
+			}
+			if publicIP {
+				ipc.Properties.PublicIPAddress = &armnetwork.PublicIPAddress{ID: util.Ref("ip1")}
+			}
+
+			return &armnetwork.Interface{
+				ID: util.Ref(niID),
+				Properties: &armnetwork.InterfacePropertiesFormat{
+					IPConfigurations: []*armnetwork.InterfaceIPConfiguration{ipc},
+				},
+			}
+		}
+		allowSSH = &armnetwork.SecurityGroup{
+			ID: util.Ref(nsgID),
+			Properties: &armnetwork.SecurityGroupPropertiesFormat{
+				SecurityRules: []*armnetwork.SecurityRule{
+					{
+						Properties: &armnetwork.SecurityRulePropertiesFormat{
+							Access:               util.Ref(armnetwork.SecurityRuleAccessAllow),
+							Direction:            util.Ref(armnetwork.SecurityRuleDirectionInbound),
+							Priority:             util.Ref(int32(100)),
+							Protocol:             util.Ref(armnetwork.SecurityRuleProtocolTCP),
+							SourceAddressPrefix:  util.Ref("Internet"),
+							DestinationPortRange: util.Ref("22"),
+						},
+					},
+				},
+			},
+		}
+	)
+
+	type fields struct {
+		remoteAdministrationProperties *remoteAdministrationProperties
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   *ontology.RemoteAdministration
+	}{
+		{
+			name:   "no network configuration",
+			fields: fields{},
+			want: &ontology.RemoteAdministration{
+				PublicManagementPorts: []uint32{},
+			},
+		},
+		{
+			name: "public IP without network security group",
+			fields: fields{
+				remoteAdministrationProperties: &remoteAdministrationProperties{
+					interfaces: map[string]*armnetwork.Interface{niID: ni(true)},
+				},
+			},
+			want: &ontology.RemoteAdministration{
+				PublicManagementPorts: []uint32{22, 3389},
+			},
+		},
+		{
+			name: "public IP with network security group allowing SSH",
+			fields: fields{
+				remoteAdministrationProperties: &remoteAdministrationProperties{
+					interfaces:                  map[string]*armnetwork.Interface{niID: ni(true)},
+					networkSecurityGroups:       map[string]*armnetwork.SecurityGroup{nsgID: allowSSH},
+					subnetNetworkSecurityGroups: map[string]string{subnetID: nsgID},
+				},
+			},
+			want: &ontology.RemoteAdministration{
+				PublicManagementPorts: []uint32{22},
+			},
+		},
+		{
+			name: "unknown network security group",
+			fields: fields{
+				remoteAdministrationProperties: &remoteAdministrationProperties{
+					interfaces:                  map[string]*armnetwork.Interface{niID: ni(true)},
+					subnetNetworkSecurityGroups: map[string]string{subnetID: nsgID},
+				},
+			},
+			want: &ontology.RemoteAdministration{
+				PublicManagementPorts: []uint32{},
+			},
+		},
+		{
+			name: "private IP with JIT and bastion",
+			fields: fields{
+				remoteAdministrationProperties: &remoteAdministrationProperties{
+					interfaces:         map[string]*armnetwork.Interface{niID: ni(false)},
+					bastionNetworks:    map[string]bool{vnetID: true},
+					jitVirtualMachines: map[string]bool{vmID: true},
+				},
+			},
+			want: &ontology.RemoteAdministration{
+				BastionEnabled:          true,
+				JustInTimeAccessEnabled: true,
+				PublicManagementPorts:   []uint32{},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &azureDiscovery{
+				remoteAdministrationProperties: tt.fields.remoteAdministrationProperties,
+			}
+
+			got := d.remoteAdministration(vm)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
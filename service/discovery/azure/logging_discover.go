@@ -0,0 +1,133 @@
+// Copyright 2021 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package azure
+
+import (
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/util"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/monitor/armmonitor"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/operationalinsights/armoperationalinsights"
+)
+
+// discoverLogAnalyticsWorkspaces discovers the Log Analytics workspaces, which are the central sinks of the logs of
+// other resources according to their diagnostic settings
+func (d *azureDiscovery) discoverLogAnalyticsWorkspaces() ([]ontology.IsResource, error) {
+	var list []ontology.IsResource
+
+	// initialize workspaces client
+	if err := d.initWorkspacesClient(); err != nil {
+		return nil, err
+	}
+
+	// List all workspaces
+	err := listPager(d,
+		d.clients.workspacesClient.NewListPager,
+		d.clients.workspacesClient.NewListByResourceGroupPager,
+		func(res armoperationalinsights.WorkspacesClientListResponse) []*armoperationalinsights.Workspace {
+			return res.Value
+		},
+		func(res armoperationalinsights.WorkspacesClientListByResourceGroupResponse) []*armoperationalinsights.Workspace {
+			return res.Value
+		},
+		func(workspace *armoperationalinsights.Workspace) error {
+			r := d.handleLogAnalyticsWorkspace(workspace)
+
+			log.Infof("Adding Log Analytics workspace '%s'", r.GetName())
+
+			list = append(list, r)
+
+			return nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+// diagnosticLoggingServices returns the IDs of the logging services, i.e., Log Analytics workspaces and storage
+// accounts, the logs of the resource with the given ID are sent to according to its diagnostic settings. If the
+// diagnostic settings cannot be retrieved, e.g., because the resource type does not support them, an empty list is
+// returned.
+func (d *azureDiscovery) diagnosticLoggingServices(id *string) (ids []string) {
+	ids = []string{}
+
+	if id == nil {
+		return
+	}
+
+	// initialize diagnostic settings client
+	if err := d.initDiagnosticSettingsClient(); err != nil {
+		return
+	}
+
+	err := allPages(d.clients.diagnosticSettingsClient.NewListPager(util.Deref(id), nil),
+		func(page armmonitor.DiagnosticSettingsClientListResponse) error {
+			for _, setting := range page.Value {
+				if setting.Properties == nil {
+					continue
+				}
+
+				if setting.Properties.WorkspaceID != nil {
+					ids = append(ids, resourceID(setting.Properties.WorkspaceID))
+				}
+
+				if setting.Properties.StorageAccountID != nil {
+					ids = append(ids, resourceID(setting.Properties.StorageAccountID))
+				}
+			}
+
+			return nil
+		})
+	if err != nil {
+		log.Debugf("Could not retrieve diagnostic settings of %s: %v", util.Deref(id), err)
+		return []string{}
+	}
+
+	return
+}
+
+// resourceLogging returns the resource logging of the resource with the given ID, which is enabled if its logs are sent
+// to at least one logging service according to its diagnostic settings
+func (d *azureDiscovery) resourceLogging(id *string) *ontology.ResourceLogging {
+	ids := d.diagnosticLoggingServices(id)
+
+	return &ontology.ResourceLogging{
+		Enabled:           len(ids) > 0,
+		LoggingServiceIds: ids,
+	}
+}
+
+// activityLoggingServices returns the IDs of the logging services the activity log of the subscription is sent to. They
+// are only retrieved once per discovery, since they are the same for all resources of the subscription.
+func (d *azureDiscovery) activityLoggingServices() []string {
+	if d.activityLogging == nil && d.sub != nil {
+		d.activityLogging = d.diagnosticLoggingServices(d.sub.ID)
+	}
+
+	return d.activityLogging
+}
@@ -0,0 +1,69 @@
+// Copyright 2021 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package azure
+
+import (
+	"time"
+
+	"clouditor.io/clouditor/v2/api/discovery"
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/util"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/operationalinsights/armoperationalinsights"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// handleLogAnalyticsWorkspace returns a [ontology.LoggingService] out of an existing
+// [armoperationalinsights.Workspace].
+func (d *azureDiscovery) handleLogAnalyticsWorkspace(workspace *armoperationalinsights.Workspace) *ontology.LoggingService {
+	var r = &ontology.LoggingService{
+		Id:          resourceID(workspace.ID),
+		Name:        util.Deref(workspace.Name),
+		GeoLocation: location(workspace.Location),
+		Labels:      labels(workspace.Tags),
+		ParentId:    resourceGroupID(workspace.ID),
+		Raw:         discovery.Raw(workspace),
+		StorageIds:  []string{},
+	}
+
+	if workspace.Properties != nil {
+		r.CreationTime = workspaceCreationTime(workspace.Properties.CreatedDate)
+		r.RetentionPeriod = durationpb.New(time.Duration(util.Deref(workspace.Properties.RetentionInDays)) * time.Hour * 24)
+	}
+
+	return r
+}
+
+// workspaceCreationTime parses the creation date of a workspace, which is returned as a string in RFC 3339 format
+func workspaceCreationTime(date *string) *timestamppb.Timestamp {
+	t, err := time.Parse(time.RFC3339, util.Deref(date))
+	if err != nil {
+		return nil
+	}
+
+	return timestamppb.New(t)
+}
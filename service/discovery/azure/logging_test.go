@@ -0,0 +1,200 @@
+// Copyright 2021 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package azure
+
+import (
+	"testing"
+	"time"
+
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/util"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func Test_azureDiscovery_discoverLogAnalyticsWorkspaces(t *testing.T) {
+	creationTime := time.Date(2017, 05, 24, 13, 28, 53, 4540398, time.UTC)
+
+	type fields struct {
+		azureDiscovery *azureDiscovery
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		want    []ontology.IsResource
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "Error list pages",
+			fields: fields{
+				azureDiscovery: &azureDiscovery{
+					cred: nil,
+				},
+			},
+			want: nil,
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorContains(t, err, ErrGettingNextPage.Error())
+			},
+		},
+		{
+			name: "Happy path",
+			fields: fields{
+				azureDiscovery: NewMockAzureDiscovery(newMockSender()),
+			},
+			want: []ontology.IsResource{
+				&ontology.LoggingService{
+					Id:           "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/res1/providers/microsoft.operationalinsights/workspaces/workspace1",
+					Name:         "workspace1",
+					CreationTime: timestamppb.New(creationTime),
+					GeoLocation: &ontology.GeoLocation{
+						Region: "eastus",
+					},
+					Labels:          map[string]string{},
+					ParentId:        util.Ref("/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/res1"),
+					Raw:             "{\"*armoperationalinsights.Workspace\":[{\"id\":\"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/res1/providers/Microsoft.OperationalInsights/workspaces/workspace1\",\"location\":\"eastus\",\"name\":\"workspace1\",\"properties\":{\"createdDate\":\"2017-05-24T13:28:53.004540398Z\",\"retentionInDays\":30}}]}",
+					StorageIds:      []string{},
+					RetentionPeriod: durationpb.New(30 * 24 * time.Hour),
+				},
+			},
+			wantErr: assert.NoError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := tt.fields.azureDiscovery
+
+			got, err := d.discoverLogAnalyticsWorkspaces()
+			if !tt.wantErr(t, err) {
+				return
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_azureDiscovery_diagnosticLoggingServices(t *testing.T) {
+	type fields struct {
+		azureDiscovery *azureDiscovery
+	}
+	type args struct {
+		id *string
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		want   []string
+	}{
+		{
+			name: "Empty input",
+			fields: fields{
+				azureDiscovery: NewMockAzureDiscovery(newMockSender()),
+			},
+			args: args{},
+			want: []string{},
+		},
+		{
+			name: "Error getting diagnostic settings",
+			fields: fields{
+				azureDiscovery: NewMockAzureDiscovery(newMockSender()),
+			},
+			args: args{
+				id: util.Ref("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/res1/providers/Microsoft.Compute/virtualMachines/vm2"),
+			},
+			want: []string{},
+		},
+		{
+			name: "Happy path",
+			fields: fields{
+				azureDiscovery: NewMockAzureDiscovery(newMockSender()),
+			},
+			args: args{
+				id: util.Ref("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/res1/providers/Microsoft.Compute/virtualMachines/vm1"),
+			},
+			want: []string{
+				"/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/res1/providers/microsoft.operationalinsights/workspaces/workspace1",
+				"/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/res1/providers/microsoft.storage/storageaccounts/account1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := tt.fields.azureDiscovery
+
+			got := d.diagnosticLoggingServices(tt.args.id)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_azureDiscovery_activityLoggingServices(t *testing.T) {
+	d := NewMockAzureDiscovery(newMockSender())
+
+	got := d.activityLoggingServices()
+	assert.Equal(t, []string{"/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/res1/providers/microsoft.operationalinsights/workspaces/workspace1"}, got)
+
+	// The result is cached for the remainder of the discovery
+	assert.Equal(t, got, d.activityLogging)
+}
+
+func Test_workspaceCreationTime(t *testing.T) {
+	type args struct {
+		date *string
+	}
+	tests := []struct {
+		name string
+		args args
+		want *timestamppb.Timestamp
+	}{
+		{
+			name: "Empty input",
+			args: args{},
+			want: nil,
+		},
+		{
+			name: "Invalid date",
+			args: args{
+				date: util.Ref("yesterday"),
+			},
+			want: nil,
+		},
+		{
+			name: "Happy path",
+			args: args{
+				date: util.Ref("2017-05-24T13:28:53Z"),
+			},
+			want: timestamppb.New(time.Date(2017, 05, 24, 13, 28, 53, 0, time.UTC)),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := workspaceCreationTime(tt.args.date)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -26,7 +26,11 @@
 package azure
 
 import (
+	"cmp"
 	"context"
+	"slices"
+	"strconv"
+	"strings"
 
 	"clouditor.io/clouditor/v2/internal/util"
 
@@ -58,6 +62,96 @@ func (d *azureDiscovery) nsgFirewallEnabled(ni *armnetwork.Interface) bool {
 	return false
 }
 
+// internetAccessAllowed checks if inbound TCP traffic from the internet to the given port is allowed by all of the given
+// network security groups, e.g., the ones of a network interface and of its subnet. Without any network security group,
+// all traffic is allowed.
+func internetAccessAllowed(nsgs []*armnetwork.SecurityGroup, port uint32) bool {
+	for _, nsg := range nsgs {
+		if !nsgAllowsInternetAccess(nsg, port) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// nsgAllowsInternetAccess checks if inbound TCP traffic from the internet to the given port is allowed by the network
+// security group. The matching inbound rule with the lowest priority number decides, including the default rules, which
+// deny all inbound traffic from the internet.
+func nsgAllowsInternetAccess(nsg *armnetwork.SecurityGroup, port uint32) bool {
+	var rules []*armnetwork.SecurityRule
+
+	if nsg == nil || nsg.Properties == nil {
+		return false
+	}
+
+	for _, rule := range append(nsg.Properties.SecurityRules, nsg.Properties.DefaultSecurityRules...) {
+		if rule.Properties != nil && util.Deref(rule.Properties.Direction) == armnetwork.SecurityRuleDirectionInbound {
+			rules = append(rules, rule)
+		}
+	}
+
+	slices.SortStableFunc(rules, func(a *armnetwork.SecurityRule, b *armnetwork.SecurityRule) int {
+		return cmp.Compare(util.Deref(a.Properties.Priority), util.Deref(b.Properties.Priority))
+	})
+
+	for _, rule := range rules {
+		if securityRuleMatches(rule.Properties, port) {
+			return util.Deref(rule.Properties.Access) == armnetwork.SecurityRuleAccessAllow
+		}
+	}
+
+	return false
+}
+
+// securityRuleMatches checks if the security rule applies to TCP traffic from the internet to the given port
+func securityRuleMatches(rule *armnetwork.SecurityRulePropertiesFormat, port uint32) bool {
+	var (
+		protocol = util.Deref(rule.Protocol)
+		sources  = append([]*string{rule.SourceAddressPrefix}, rule.SourceAddressPrefixes...)
+		ports    = append([]*string{rule.DestinationPortRange}, rule.DestinationPortRanges...)
+	)
+
+	if protocol != armnetwork.SecurityRuleProtocolAsterisk && protocol != armnetwork.SecurityRuleProtocolTCP {
+		return false
+	}
+
+	return slices.ContainsFunc(sources, func(source *string) bool {
+		switch strings.ToLower(util.Deref(source)) {
+		case "*", "internet", "0.0.0.0/0", "::/0":
+			return true
+		default:
+			return false
+		}
+	}) && slices.ContainsFunc(ports, func(r *string) bool {
+		return portInRange(util.Deref(r), port)
+	})
+}
+
+// portInRange checks if the port is part of the port range of a security rule, e.g., "*", "22" or "3380-3390".
+func portInRange(r string, port uint32) bool {
+	if r == "*" {
+		return true
+	}
+
+	from, to, found := strings.Cut(r, "-")
+	if !found {
+		to = from
+	}
+
+	lower, err := strconv.ParseUint(strings.TrimSpace(from), 10, 32)
+	if err != nil {
+		return false
+	}
+
+	upper, err := strconv.ParseUint(strings.TrimSpace(to), 10, 32)
+	if err != nil {
+		return false
+	}
+
+	return uint64(port) >= lower && uint64(port) <= upper
+}
+
 // loadBalancerPorts returns the external endpoint ports
 func loadBalancerPorts(lb *armnetwork.LoadBalancer) (loadBalancerPorts []uint32) {
 	for _, item := range lb.Properties.LoadBalancingRules {
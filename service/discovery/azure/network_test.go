@@ -725,3 +725,103 @@ func Test_azureDiscovery_handleNetworkInterfaces(t *testing.T) {
 		})
 	}
 }
+
+func Test_nsgAllowsInternetAccess(t *testing.T) {
+	rule := func(priority int32, access armnetwork.SecurityRuleAccess, source string, ports string) *armnetwork.SecurityRule {
+		return &armnetwork.SecurityRule{
+			Properties: &armnetwork.SecurityRulePropertiesFormat{
+				Access:               util.Ref(access),
+				Direction:            util.Ref(armnetwork.SecurityRuleDirectionInbound),
+				Priority:             util.Ref(priority),
+				Protocol:             util.Ref(armnetwork.SecurityRuleProtocolAsterisk),
+				SourceAddressPrefix:  util.Ref(source),
+				DestinationPortRange: util.Ref(ports),
+			},
+		}
+	}
+	denyAll := rule(65500, armnetwork.SecurityRuleAccessDeny, "*", "*")
+
+	type args struct {
+		nsg  *armnetwork.SecurityGroup
+		port uint32
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "empty",
+			args: args{},
+			want: false,
+		},
+		{
+			name: "default rules only",
+			args: args{
+				nsg: &armnetwork.SecurityGroup{
+					Properties: &armnetwork.SecurityGroupPropertiesFormat{
+						DefaultSecurityRules: []*armnetwork.SecurityRule{denyAll},
+					},
+				},
+				port: 22,
+			},
+			want: false,
+		},
+		{
+			name: "allowed port range",
+			args: args{
+				nsg: &armnetwork.SecurityGroup{
+					Properties: &armnetwork.SecurityGroupPropertiesFormat{
+						SecurityRules:        []*armnetwork.SecurityRule{rule(100, armnetwork.SecurityRuleAccessAllow, "0.0.0.0/0", "3380-3390")},
+						DefaultSecurityRules: []*armnetwork.SecurityRule{denyAll},
+					},
+				},
+				port: 3389,
+			},
+			want: true,
+		},
+		{
+			name: "allowed from a specific address only",
+			args: args{
+				nsg: &armnetwork.SecurityGroup{
+					Properties: &armnetwork.SecurityGroupPropertiesFormat{
+						SecurityRules:        []*armnetwork.SecurityRule{rule(100, armnetwork.SecurityRuleAccessAllow, "203.0.113.1", "22")},
+						DefaultSecurityRules: []*armnetwork.SecurityRule{denyAll},
+					},
+				},
+				port: 22,
+			},
+			want: false,
+		},
+		{
+			name: "denied with higher priority",
+			args: args{
+				nsg: &armnetwork.SecurityGroup{
+					Properties: &armnetwork.SecurityGroupPropertiesFormat{
+						SecurityRules: []*armnetwork.SecurityRule{
+							rule(200, armnetwork.SecurityRuleAccessAllow, "Internet", "*"),
+							rule(100, armnetwork.SecurityRuleAccessDeny, "*", "22"),
+						},
+						DefaultSecurityRules: []*armnetwork.SecurityRule{denyAll},
+					},
+				},
+				port: 22,
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := nsgAllowsInternetAccess(tt.args.nsg, tt.args.port)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_portInRange(t *testing.T) {
+	assert.True(t, portInRange("*", 22))
+	assert.True(t, portInRange("22", 22))
+	assert.True(t, portInRange("20-25", 22))
+	assert.False(t, portInRange("3389", 22))
+	assert.False(t, portInRange("invalid", 22))
+}
@@ -32,7 +32,6 @@ import (
 
 	"clouditor.io/clouditor/v2/api/discovery"
 	"clouditor.io/clouditor/v2/api/ontology"
-	"clouditor.io/clouditor/v2/internal/constants"
 	"clouditor.io/clouditor/v2/internal/util"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/cosmos/armcosmos"
@@ -176,6 +175,12 @@ func (d *azureDiscovery) getSqlDBs(server *armsql.Server) ([]ontology.IsResource
 		return list, nil
 	}
 
+	// The TDE protector of the server specifies, which key encrypts its databases
+	protector, err := d.encryptionProtector(server)
+	if err != nil {
+		log.Errorf("error getting encryption protector for server '%s': %v", util.Deref(server.Name), err)
+	}
+
 	// Get databases for given server
 	serverlistPager := d.clients.databasesClient.NewListByServerPager(resourceGroupName(util.Deref(server.ID)), *server.Name, &armsql.DatabasesClientListByServerOptions{})
 	for serverlistPager.More() {
@@ -202,21 +207,14 @@ func (d *azureDiscovery) getSqlDBs(server *armsql.Server) ([]ontology.IsResource
 
 			// Create database storage voc object
 			sqlDB := &ontology.DatabaseStorage{
-				Id:           resourceID(value.ID),
-				Name:         util.Deref(value.Name),
-				CreationTime: creationTime(value.Properties.CreationDate),
-				GeoLocation:  location(value.Location),
-				Labels:       labels(value.Tags),
-				ParentId:     resourceID2(server.ID),
-				Raw:          discovery.Raw(value),
-				AtRestEncryption: &ontology.AtRestEncryption{
-					Type: &ontology.AtRestEncryption_ManagedKeyEncryption{
-						ManagedKeyEncryption: &ontology.ManagedKeyEncryption{
-							Enabled:   *value.Properties.IsInfraEncryptionEnabled,
-							Algorithm: constants.AES256,
-						},
-					},
-				},
+				Id:               resourceID(value.ID),
+				Name:             util.Deref(value.Name),
+				CreationTime:     creationTime(value.Properties.CreationDate),
+				GeoLocation:      location(value.Location),
+				Labels:           labels(value.Tags),
+				ParentId:         resourceID2(server.ID),
+				Raw:              discovery.Raw(value),
+				AtRestEncryption: sqlAtRestEncryption(protector, value),
 				// TODO(all): Backups
 			}
 			list = append(list, sqlDB)
@@ -56,13 +56,15 @@ func storageAtRestEncryption(account *armstorage.Account) (enc *ontology.AtRestE
 			},
 		}
 	} else if util.Deref(account.Properties.Encryption.KeySource) == armstorage.KeySourceMicrosoftKeyvault {
+		keyURL := keyVaultKeyURL(account.Properties.Encryption.KeyVaultProperties)
+
 		enc = &ontology.AtRestEncryption{
 			Type: &ontology.AtRestEncryption_CustomerKeyEncryption{
 				CustomerKeyEncryption: &ontology.CustomerKeyEncryption{
-					Algorithm: "", // TODO(all): TBD
-					Enabled:   true,
-					// TODO(oxisto): This should also include the key!
-					KeyUrl: util.Deref(account.Properties.Encryption.KeyVaultProperties.KeyVaultURI),
+					Algorithm:  "", // TODO(all): TBD
+					Enabled:    true,
+					KeyUrl:     keyURL,
+					KeyManager: keyVaultURL(keyURL),
 				},
 			},
 		}
@@ -71,6 +73,56 @@ func storageAtRestEncryption(account *armstorage.Account) (enc *ontology.AtRestE
 	return enc, nil
 }
 
+// encryptionProtector returns the current TDE protector of the SQL server.
+func (d *azureDiscovery) encryptionProtector(server *armsql.Server) (*armsql.EncryptionProtector, error) {
+	// initialize encryption protectors client
+	if err := d.initEncryptionProtectorsClient(); err != nil {
+		return nil, err
+	}
+
+	res, err := d.clients.encryptionProtectorsClient.Get(context.TODO(), resourceGroupName(util.Deref(server.ID)), util.Deref(server.Name), armsql.EncryptionProtectorNameCurrent, &armsql.EncryptionProtectorsClientGetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &res.EncryptionProtector, nil
+}
+
+// sqlAtRestEncryption converts the TDE protector of the server of the SQL database into our respective ontology
+// object. The encryption is unknown, if the protector could not be retrieved.
+func sqlAtRestEncryption(protector *armsql.EncryptionProtector, db *armsql.Database) *ontology.AtRestEncryption {
+	if protector == nil || protector.Properties == nil {
+		return nil
+	}
+
+	if util.Deref(protector.Properties.ServerKeyType) == armsql.ServerKeyTypeAzureKeyVault {
+		return &ontology.AtRestEncryption{
+			Type: &ontology.AtRestEncryption_CustomerKeyEncryption{
+				CustomerKeyEncryption: &ontology.CustomerKeyEncryption{
+					Algorithm:  constants.AES256,
+					Enabled:    true,
+					KeyUrl:     util.Deref(protector.Properties.URI),
+					KeyManager: keyVaultURL(util.Deref(protector.Properties.URI)),
+				},
+			},
+		}
+	}
+
+	var enabled bool
+	if db != nil && db.Properties != nil {
+		enabled = util.Deref(db.Properties.IsInfraEncryptionEnabled)
+	}
+
+	return &ontology.AtRestEncryption{
+		Type: &ontology.AtRestEncryption_ManagedKeyEncryption{
+			ManagedKeyEncryption: &ontology.ManagedKeyEncryption{
+				Enabled:   enabled,
+				Algorithm: constants.AES256,
+			},
+		},
+	}
+}
+
 // anomalyDetectionEnabled returns true if Azure Advanced Threat Protection is enabled for the database.
 func (d *azureDiscovery) anomalyDetectionEnabled(server *armsql.Server, db *armsql.Database) (bool, error) {
 	// initialize threat protection client
@@ -907,10 +907,12 @@ func Test_azureStorageDiscovery_handleSqlServer(t *testing.T) {
 					ParentId: util.Ref("/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/res1/providers/microsoft.sql/servers/sqlserver1"),
 					Raw:      "{\"*armsql.Database\":[{\"id\":\"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/res1/providers/Microsoft.Sql/servers/SQLServer1/databases/SqlDatabase1\",\"location\":\"eastus\",\"name\":\"SqlDatabase1\",\"properties\":{\"isInfraEncryptionEnabled\":true}}]}",
 					AtRestEncryption: &ontology.AtRestEncryption{
-						Type: &ontology.AtRestEncryption_ManagedKeyEncryption{
-							ManagedKeyEncryption: &ontology.ManagedKeyEncryption{
-								Algorithm: "AES256",
-								Enabled:   true,
+						Type: &ontology.AtRestEncryption_CustomerKeyEncryption{
+							CustomerKeyEncryption: &ontology.CustomerKeyEncryption{
+								Algorithm:  "AES256",
+								Enabled:    true,
+								KeyUrl:     "https://testvault.vault.azure.net/keys/testkey/123456",
+								KeyManager: "https://testvault.vault.azure.net",
 							},
 						},
 					},
@@ -933,6 +935,74 @@ func Test_azureStorageDiscovery_handleSqlServer(t *testing.T) {
 	}
 }
 
+func Test_sqlAtRestEncryption(t *testing.T) {
+	type args struct {
+		protector *armsql.EncryptionProtector
+		db        *armsql.Database
+	}
+	tests := []struct {
+		name string
+		args args
+		want *ontology.AtRestEncryption
+	}{
+		{
+			name: "unknown protector",
+			args: args{},
+			want: nil,
+		},
+		{
+			name: "service-managed key",
+			args: args{
+				protector: &armsql.EncryptionProtector{
+					Properties: &armsql.EncryptionProtectorProperties{
+						ServerKeyType: util.Ref(armsql.ServerKeyTypeServiceManaged),
+					},
+				},
+				db: &armsql.Database{
+					Properties: &armsql.DatabaseProperties{
+						IsInfraEncryptionEnabled: util.Ref(true),
+					},
+				},
+			},
+			want: &ontology.AtRestEncryption{
+				Type: &ontology.AtRestEncryption_ManagedKeyEncryption{
+					ManagedKeyEncryption: &ontology.ManagedKeyEncryption{
+						Algorithm: "AES256",
+						Enabled:   true,
+					},
+				},
+			},
+		},
+		{
+			name: "customer-managed key",
+			args: args{
+				protector: &armsql.EncryptionProtector{
+					Properties: &armsql.EncryptionProtectorProperties{
+						ServerKeyType: util.Ref(armsql.ServerKeyTypeAzureKeyVault),
+						URI:           util.Ref("https://testvault.vault.azure.net/keys/testkey/123456"),
+					},
+				},
+			},
+			want: &ontology.AtRestEncryption{
+				Type: &ontology.AtRestEncryption_CustomerKeyEncryption{
+					CustomerKeyEncryption: &ontology.CustomerKeyEncryption{
+						Algorithm:  "AES256",
+						Enabled:    true,
+						KeyUrl:     "https://testvault.vault.azure.net/keys/testkey/123456",
+						KeyManager: "https://testvault.vault.azure.net",
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := sqlAtRestEncryption(tt.args.protector, tt.args.db)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
 func Test_azureStorageDiscovery_anomalyDetectionEnabled(t *testing.T) {
 	type fields struct {
 		azureDiscovery *azureDiscovery
@@ -1091,9 +1161,10 @@ func Test_azureStorageDiscovery_discoverCosmosDB(t *testing.T) {
 					AtRestEncryption: &ontology.AtRestEncryption{
 						Type: &ontology.AtRestEncryption_CustomerKeyEncryption{
 							CustomerKeyEncryption: &ontology.CustomerKeyEncryption{
-								Enabled:   true,
-								Algorithm: "",
-								KeyUrl:    "https://testvault.vault.azure.net/keys/testkey/123456",
+								Enabled:    true,
+								Algorithm:  "",
+								KeyUrl:     "https://testvault.vault.azure.net/keys/testkey/123456",
+								KeyManager: "https://testvault.vault.azure.net",
 							},
 						},
 					},
@@ -1113,9 +1184,10 @@ func Test_azureStorageDiscovery_discoverCosmosDB(t *testing.T) {
 					AtRestEncryption: &ontology.AtRestEncryption{
 						Type: &ontology.AtRestEncryption_CustomerKeyEncryption{
 							CustomerKeyEncryption: &ontology.CustomerKeyEncryption{
-								Enabled:   true,
-								Algorithm: "",
-								KeyUrl:    "https://testvault.vault.azure.net/keys/testkey/123456",
+								Enabled:    true,
+								Algorithm:  "",
+								KeyUrl:     "https://testvault.vault.azure.net/keys/testkey/123456",
+								KeyManager: "https://testvault.vault.azure.net",
 							},
 						},
 					},
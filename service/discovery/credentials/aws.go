@@ -0,0 +1,174 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package credentials
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+)
+
+// AWSSecretsManager is a [SecretStore] that retrieves secrets from AWS Secrets Manager using the GetSecretValue
+// action of its API.
+type AWSSecretsManager struct {
+	cfg      aws.Config
+	endpoint string
+	client   *http.Client
+}
+
+// NewAWSSecretsManager creates a new [AWSSecretsManager] for the region and credentials of cfg, e.g., an instance
+// role.
+func NewAWSSecretsManager(cfg aws.Config) *AWSSecretsManager {
+	return &AWSSecretsManager{
+		cfg:      cfg,
+		endpoint: fmt.Sprintf("https://secretsmanager.%s.amazonaws.com", cfg.Region),
+		client:   &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// GetSecret implements [SecretStore]. It retrieves the current version of the secret, which needs to be a string.
+func (sm *AWSSecretsManager) GetSecret(ctx context.Context, name string) (secret *Secret, err error) {
+	var res struct {
+		SecretString string `json:"SecretString"`
+	}
+
+	body, err := json.Marshal(map[string]string{"SecretId": name})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, sm.endpoint+"/", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-amz-json-1.1")
+	req.Header.Set("X-Amz-Target", "secretsmanager.GetSecretValue")
+
+	creds, err := sm.cfg.Credentials.Retrieve(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not authenticate to AWS Secrets Manager: %w", err)
+	}
+
+	hash := sha256.Sum256(body)
+
+	err = v4.NewSigner().SignHTTP(ctx, creds, req, hex.EncodeToString(hash[:]), "secretsmanager", sm.cfg.Region, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("could not sign request: %w", err)
+	}
+
+	resp, err := sm.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve secret from AWS Secrets Manager: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		var apiErr struct {
+			Type string `json:"__type"`
+		}
+
+		_ = json.NewDecoder(resp.Body).Decode(&apiErr)
+		if apiErr.Type == "ResourceNotFoundException" {
+			return nil, ErrSecretNotFound
+		}
+
+		return nil, fmt.Errorf("could not retrieve secret from AWS Secrets Manager: unexpected status code %d (%s)",
+			resp.StatusCode, apiErr.Type)
+	}
+
+	err = json.NewDecoder(resp.Body).Decode(&res)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode secret from AWS Secrets Manager: %w", err)
+	}
+
+	return &Secret{Value: res.SecretString}, nil
+}
+
+// awsAccessKey is the content of the secret of AWS credentials. The alternative names are used by the AWS secrets
+// engine of Vault.
+type awsAccessKey struct {
+	AccessKeyID     string `json:"access_key_id"`
+	AccessKey       string `json:"access_key"`
+	SecretAccessKey string `json:"secret_access_key"`
+	SecretKey       string `json:"secret_key"`
+	SessionToken    string `json:"session_token"`
+	SecurityToken   string `json:"security_token"`
+}
+
+// awsCredentials is an [aws.CredentialsProvider] that retrieves the AWS credentials contained in a secret.
+type awsCredentials struct {
+	provider *Provider
+	name     string
+}
+
+// AWSCredentials returns an [aws.CredentialsProvider] for the AWS credentials in the secret with the given name. The
+// credentials are cached until the secret needs to be refreshed.
+func (p *Provider) AWSCredentials(name string) aws.CredentialsProvider {
+	return aws.NewCredentialsCache(&awsCredentials{provider: p, name: name})
+}
+
+// Retrieve implements [aws.CredentialsProvider].
+func (c *awsCredentials) Retrieve(ctx context.Context) (creds aws.Credentials, err error) {
+	var key awsAccessKey
+
+	s, err := c.provider.secret(ctx, c.name)
+	if err != nil {
+		return creds, fmt.Errorf("could not retrieve AWS credentials: %w", err)
+	}
+
+	err = json.Unmarshal([]byte(s.Value), &key)
+	if err != nil {
+		return creds, fmt.Errorf("could not parse AWS credentials: %w", err)
+	}
+
+	return aws.Credentials{
+		AccessKeyID:     firstNonEmpty(key.AccessKeyID, key.AccessKey),
+		SecretAccessKey: firstNonEmpty(key.SecretAccessKey, key.SecretKey),
+		SessionToken:    firstNonEmpty(key.SessionToken, key.SecurityToken),
+		Source:          "clouditor:" + c.name,
+		CanExpire:       true,
+		Expires:         s.refreshAt,
+	}, nil
+}
+
+// firstNonEmpty returns the first of the given values that is not empty.
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+
+	return ""
+}
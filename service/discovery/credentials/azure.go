@@ -0,0 +1,167 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package credentials
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+)
+
+// azureKeyVaultScope is the scope of the access token for Azure Key Vault.
+const azureKeyVaultScope = "https://vault.azure.net/.default"
+
+// azureKeyVaultAPIVersion is the version of the Azure Key Vault API.
+const azureKeyVaultAPIVersion = "7.4"
+
+// AzureKeyVault is a [SecretStore] that retrieves secrets from Azure Key Vault using its REST API. The expiry of a
+// secret is derived from its expiration date.
+type AzureKeyVault struct {
+	vaultURL string
+	cred     azcore.TokenCredential
+	client   *http.Client
+}
+
+// azureKeyVaultSecret is the response of Azure Key Vault when reading a secret.
+type azureKeyVaultSecret struct {
+	Value      string `json:"value"`
+	Attributes struct {
+		Expires int64 `json:"exp"`
+	} `json:"attributes"`
+}
+
+// NewAzureKeyVault creates a new [AzureKeyVault] for the key vault at vaultURL, e.g.,
+// "https://my-vault.vault.azure.net", which authenticates using cred, e.g., a managed identity.
+func NewAzureKeyVault(vaultURL string, cred azcore.TokenCredential) *AzureKeyVault {
+	return &AzureKeyVault{
+		vaultURL: strings.TrimSuffix(vaultURL, "/"),
+		cred:     cred,
+		client:   &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// GetSecret implements [SecretStore]. It retrieves the current version of the secret.
+func (kv *AzureKeyVault) GetSecret(ctx context.Context, name string) (secret *Secret, err error) {
+	var res azureKeyVaultSecret
+
+	token, err := kv.cred.GetToken(ctx, policy.TokenRequestOptions{Scopes: []string{azureKeyVaultScope}})
+	if err != nil {
+		return nil, fmt.Errorf("could not authenticate to Azure Key Vault: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet,
+		fmt.Sprintf("%s/secrets/%s?api-version=%s", kv.vaultURL, url.PathEscape(name), azureKeyVaultAPIVersion), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+token.Token)
+
+	resp, err := kv.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve secret from Azure Key Vault: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, ErrSecretNotFound
+	} else if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not retrieve secret from Azure Key Vault: unexpected status code %d", resp.StatusCode)
+	}
+
+	err = json.NewDecoder(resp.Body).Decode(&res)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode secret from Azure Key Vault: %w", err)
+	}
+
+	secret = &Secret{Value: res.Value}
+	if res.Attributes.Expires > 0 {
+		secret.ExpiresAt = time.Unix(res.Attributes.Expires, 0)
+	}
+
+	return secret, nil
+}
+
+// azureServicePrincipal is the content of the secret of an Azure service principal.
+type azureServicePrincipal struct {
+	TenantID     string `json:"tenant_id"`
+	ClientID     string `json:"client_id"`
+	ClientSecret string `json:"client_secret"`
+}
+
+// azureCredential is an [azcore.TokenCredential] that authenticates as the service principal contained in a secret.
+// If the secret changes, e.g., because the client secret was rotated, a new credential is created.
+type azureCredential struct {
+	provider *Provider
+	name     string
+
+	mu        sync.Mutex
+	principal azureServicePrincipal
+	cred      azcore.TokenCredential
+}
+
+// AzureCredential returns an [azcore.TokenCredential] for the Azure service principal in the secret with the given
+// name.
+func (p *Provider) AzureCredential(name string) azcore.TokenCredential {
+	return &azureCredential{provider: p, name: name}
+}
+
+// GetToken implements [azcore.TokenCredential].
+func (c *azureCredential) GetToken(ctx context.Context, opts policy.TokenRequestOptions) (token azcore.AccessToken, err error) {
+	var principal azureServicePrincipal
+
+	value, err := c.provider.Secret(ctx, c.name)
+	if err != nil {
+		return token, fmt.Errorf("could not retrieve Azure credentials: %w", err)
+	}
+
+	err = json.Unmarshal([]byte(value), &principal)
+	if err != nil {
+		return token, fmt.Errorf("could not parse Azure credentials: %w", err)
+	}
+
+	c.mu.Lock()
+	if c.cred == nil || principal != c.principal {
+		c.cred, err = azidentity.NewClientSecretCredential(principal.TenantID, principal.ClientID, principal.ClientSecret, nil)
+		c.principal = principal
+	}
+	cred := c.cred
+	c.mu.Unlock()
+
+	if err != nil {
+		return token, fmt.Errorf("could not create Azure credentials: %w", err)
+	}
+
+	return cred.GetToken(ctx, opts)
+}
@@ -0,0 +1,168 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+// Package credentials contains a provider of the credentials that are used by the discoverers, e.g., Azure client
+// secrets, AWS access keys or kubeconfigs. Instead of supplying them using environment variables or flags, they are
+// retrieved from a secret store, such as HashiCorp Vault, Azure Key Vault or AWS Secrets Manager, and refreshed
+// automatically, which allows to use short-lived credentials.
+package credentials
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"clouditor.io/clouditor/v2/service"
+
+	"github.com/sirupsen/logrus"
+)
+
+// DefaultRefreshInterval is the default interval after which a secret is retrieved again from the secret store, so
+// that rotated secrets are picked up.
+const DefaultRefreshInterval = 15 * time.Minute
+
+// expiryMargin is the time before the expiry of a secret at which it is already refreshed.
+const expiryMargin = time.Minute
+
+var log = logrus.WithField("component", "discovery-credentials")
+
+// ErrSecretNotFound is returned by a [SecretStore], if the secret does not exist.
+var ErrSecretNotFound = errors.New("secret not found")
+
+// Secret is a secret retrieved from a [SecretStore].
+type Secret struct {
+	// Value contains the value of the secret. Secrets that consist of several values, e.g., an access key and a secret
+	// key, are JSON objects.
+	Value string
+
+	// ExpiresAt is the time at which the secret expires, e.g., the end of the lease of dynamic credentials. It is zero,
+	// if the secret does not expire.
+	ExpiresAt time.Time
+}
+
+// SecretStore is an interface for backends that store secrets, e.g., [Vault], [AzureKeyVault] or
+// [AWSSecretsManager].
+type SecretStore interface {
+	GetSecret(ctx context.Context, name string) (secret *Secret, err error)
+}
+
+// SecretNames contains the names of the secrets that contain the credentials of the individual cloud providers. If a
+// name is empty, the default credentials of the cloud provider are used instead, e.g., from environment variables.
+type SecretNames struct {
+	// Azure is the name of a JSON secret containing "tenant_id", "client_id" and "client_secret" of a service
+	// principal.
+	Azure string
+
+	// AWS is the name of a JSON secret containing "access_key_id", "secret_access_key" and optionally
+	// "session_token".
+	AWS string
+
+	// Kubernetes is the name of a secret containing a kubeconfig.
+	Kubernetes string
+}
+
+// cachedSecret is a secret together with the time at which it needs to be refreshed.
+type cachedSecret struct {
+	*Secret
+
+	refreshAt time.Time
+}
+
+// Provider provides the credentials of the discoverers using a [SecretStore]. Secrets are cached until they are about
+// to expire, but at most for the refresh interval.
+type Provider struct {
+	store           SecretStore
+	refreshInterval time.Duration
+
+	mu    sync.Mutex
+	cache map[string]*cachedSecret
+
+	// now returns the current time. It defaults to [time.Now].
+	now func() time.Time
+}
+
+// WithRefreshInterval is an option to configure the interval after which a secret is retrieved again, if it does
+// not expire earlier. The default is [DefaultRefreshInterval].
+func WithRefreshInterval(interval time.Duration) service.Option[Provider] {
+	return func(p *Provider) {
+		p.refreshInterval = interval
+	}
+}
+
+// NewProvider creates a new [Provider] that retrieves secrets from store.
+func NewProvider(store SecretStore, opts ...service.Option[Provider]) *Provider {
+	p := &Provider{
+		store:           store,
+		refreshInterval: DefaultRefreshInterval,
+		cache:           make(map[string]*cachedSecret),
+		now:             time.Now,
+	}
+
+	for _, o := range opts {
+		o(p)
+	}
+
+	return p
+}
+
+// Secret retrieves the value of the secret with the given name. It is only retrieved from the secret store, if it
+// was not retrieved yet or needs to be refreshed.
+func (p *Provider) Secret(ctx context.Context, name string) (value string, err error) {
+	s, err := p.secret(ctx, name)
+	if err != nil {
+		return "", err
+	}
+
+	return s.Value, nil
+}
+
+// secret retrieves the cached secret with the given name or refreshes it.
+func (p *Provider) secret(ctx context.Context, name string) (s *cachedSecret, err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := p.now()
+
+	if s, ok := p.cache[name]; ok && now.Before(s.refreshAt) {
+		return s, nil
+	}
+
+	log.Debugf("Retrieving secret %s from the secret store", name)
+
+	secret, err := p.store.GetSecret(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	s = &cachedSecret{Secret: secret, refreshAt: now.Add(p.refreshInterval)}
+	if !secret.ExpiresAt.IsZero() && secret.ExpiresAt.Add(-expiryMargin).Before(s.refreshAt) {
+		s.refreshAt = secret.ExpiresAt.Add(-expiryMargin)
+	}
+
+	p.cache[name] = s
+
+	return s, nil
+}
@@ -0,0 +1,159 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package credentials
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+)
+
+// mockSecretStore returns secrets with the given expiry and counts how often they are retrieved.
+type mockSecretStore struct {
+	secrets   map[string]string
+	expiresAt time.Time
+	calls     int
+}
+
+func (m *mockSecretStore) GetSecret(_ context.Context, name string) (secret *Secret, err error) {
+	m.calls++
+
+	value, ok := m.secrets[name]
+	if !ok {
+		return nil, ErrSecretNotFound
+	}
+
+	return &Secret{Value: value, ExpiresAt: m.expiresAt}, nil
+}
+
+func TestProvider_Secret(t *testing.T) {
+	var now = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	type fields struct {
+		store *mockSecretStore
+	}
+	type args struct {
+		name    string
+		elapsed time.Duration
+	}
+	tests := []struct {
+		name      string
+		fields    fields
+		args      args
+		wantValue string
+		wantCalls int
+		wantErr   assert.WantErr
+	}{
+		{
+			name: "cached",
+			fields: fields{
+				store: &mockSecretStore{secrets: map[string]string{"azure": "v1"}},
+			},
+			args: args{
+				name:    "azure",
+				elapsed: 10 * time.Minute,
+			},
+			wantValue: "v1",
+			wantCalls: 1,
+			wantErr:   assert.Nil[error],
+		},
+		{
+			name: "refresh interval elapsed",
+			fields: fields{
+				store: &mockSecretStore{secrets: map[string]string{"azure": "v1"}},
+			},
+			args: args{
+				name:    "azure",
+				elapsed: DefaultRefreshInterval,
+			},
+			wantValue: "v1",
+			wantCalls: 2,
+			wantErr:   assert.Nil[error],
+		},
+		{
+			name: "about to expire",
+			fields: fields{
+				store: &mockSecretStore{
+					secrets:   map[string]string{"aws": "v1"},
+					expiresAt: now.Add(5 * time.Minute),
+				},
+			},
+			args: args{
+				name:    "aws",
+				elapsed: 4 * time.Minute,
+			},
+			wantValue: "v1",
+			wantCalls: 2,
+			wantErr:   assert.Nil[error],
+		},
+		{
+			name: "not found",
+			fields: fields{
+				store: &mockSecretStore{},
+			},
+			args: args{
+				name: "k8s",
+			},
+			wantCalls: 1,
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, ErrSecretNotFound)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewProvider(tt.fields.store)
+			p.now = func() time.Time { return now }
+
+			_, err := p.Secret(context.Background(), tt.args.name)
+			if err == nil {
+				p.now = func() time.Time { return now.Add(tt.args.elapsed) }
+
+				var value string
+				value, err = p.Secret(context.Background(), tt.args.name)
+				assert.Equal(t, tt.wantValue, value)
+			}
+
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.wantCalls, tt.fields.store.calls)
+		})
+	}
+}
+
+func TestProvider_AWSCredentials(t *testing.T) {
+	p := NewProvider(&mockSecretStore{secrets: map[string]string{
+		"aws": `{"access_key": "AKIA", "secret_key": "secret", "security_token": "token"}`,
+	}})
+
+	creds, err := p.AWSCredentials("aws").Retrieve(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "AKIA", creds.AccessKeyID)
+	assert.Equal(t, "secret", creds.SecretAccessKey)
+	assert.Equal(t, "token", creds.SessionToken)
+	assert.True(t, creds.CanExpire)
+}
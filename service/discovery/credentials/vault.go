@@ -0,0 +1,117 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package credentials
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Vault is a [SecretStore] that retrieves secrets from HashiCorp Vault using its HTTP API. The name of a secret is its
+// path, e.g., "secret/data/clouditor/azure" for a secret in a KV version 2 secrets engine or "aws/creds/discovery" for
+// dynamic credentials of the AWS secrets engine. The expiry of dynamic secrets is derived from their lease.
+type Vault struct {
+	addr   string
+	token  string
+	client *http.Client
+}
+
+// vaultResponse is the response of Vault when reading a secret.
+type vaultResponse struct {
+	LeaseDuration int64          `json:"lease_duration"`
+	Data          map[string]any `json:"data"`
+}
+
+// NewVault creates a new [Vault] for the Vault server at addr, e.g., "https://vault:8200", which authenticates using
+// token.
+func NewVault(addr string, token string) *Vault {
+	return &Vault{
+		addr:   strings.TrimSuffix(addr, "/"),
+		token:  token,
+		client: &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// GetSecret implements [SecretStore]. Secrets with a single "value" key are returned as is, all others as JSON
+// object.
+func (v *Vault) GetSecret(ctx context.Context, name string) (secret *Secret, err error) {
+	var res vaultResponse
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, v.addr+"/v1/"+strings.TrimPrefix(name, "/"), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("X-Vault-Token", v.token)
+
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve secret from Vault: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, ErrSecretNotFound
+	} else if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not retrieve secret from Vault: unexpected status code %d", resp.StatusCode)
+	}
+
+	err = json.NewDecoder(resp.Body).Decode(&res)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode secret from Vault: %w", err)
+	}
+
+	data := res.Data
+
+	// The KV version 2 secrets engine wraps the secret together with its metadata
+	if inner, ok := data["data"].(map[string]any); ok {
+		if _, ok := data["metadata"]; ok {
+			data = inner
+		}
+	}
+
+	secret = new(Secret)
+
+	if value, ok := data["value"].(string); ok && len(data) == 1 {
+		secret.Value = value
+	} else {
+		b, err := json.Marshal(data)
+		if err != nil {
+			return nil, err
+		}
+
+		secret.Value = string(b)
+	}
+
+	if res.LeaseDuration > 0 {
+		secret.ExpiresAt = time.Now().Add(time.Duration(res.LeaseDuration) * time.Second)
+	}
+
+	return secret, nil
+}
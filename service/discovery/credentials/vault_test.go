@@ -0,0 +1,107 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package credentials
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+)
+
+func TestVault_GetSecret(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != "my-token" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		switch r.URL.Path {
+		case "/v1/secret/data/kubeconfig":
+			_, _ = w.Write([]byte(`{"data": {"data": {"value": "apiVersion: v1"}, "metadata": {"version": 1}}}`))
+		case "/v1/aws/creds/discovery":
+			_, _ = w.Write([]byte(`{"lease_duration": 900, "data": {"access_key": "AKIA", "secret_key": "secret"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	type args struct {
+		name string
+	}
+	tests := []struct {
+		name    string
+		token   string
+		args    args
+		want    assert.Want[*Secret]
+		wantErr assert.WantErr
+	}{
+		{
+			name:  "KV version 2",
+			token: "my-token",
+			args:  args{name: "secret/data/kubeconfig"},
+			want: func(t *testing.T, got *Secret) bool {
+				return assert.Equal(t, "apiVersion: v1", got.Value) && assert.True(t, got.ExpiresAt.IsZero())
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name:  "dynamic secret",
+			token: "my-token",
+			args:  args{name: "aws/creds/discovery"},
+			want: func(t *testing.T, got *Secret) bool {
+				return assert.Equal(t, `{"access_key":"AKIA","secret_key":"secret"}`, got.Value) &&
+					assert.True(t, got.ExpiresAt.After(time.Now().Add(14*time.Minute)))
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name:    "not found",
+			token:   "my-token",
+			args:    args{name: "secret/data/other"},
+			want:    assert.Nil[*Secret],
+			wantErr: func(t *testing.T, err error) bool { return assert.ErrorIs(t, err, ErrSecretNotFound) },
+		},
+		{
+			name:    "wrong token",
+			token:   "other-token",
+			args:    args{name: "secret/data/kubeconfig"},
+			want:    assert.Nil[*Secret],
+			wantErr: func(t *testing.T, err error) bool { return assert.ErrorContains(t, err, "403") },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewVault(srv.URL, tt.token).GetSecret(context.Background(), tt.args.name)
+			tt.wantErr(t, err)
+			tt.want(t, got)
+		})
+	}
+}
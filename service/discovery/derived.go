@@ -0,0 +1,226 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package discovery
+
+import (
+	"errors"
+	"fmt"
+
+	"clouditor.io/clouditor/v2/api/discovery"
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/persistence"
+)
+
+const (
+	// EdgeTypePubliclyReachable is the type of derived edges from [InternetNodeID] to resources that are reachable
+	// from the internet, either directly or through other resources, e.g., a load balancer.
+	EdgeTypePubliclyReachable = "publicly_reachable"
+
+	// EdgeTypePrivilegedAccess is the type of derived edges from privileged identities to the resources they have
+	// access to.
+	EdgeTypePrivilegedAccess = "has_privileged_access_to"
+
+	// InternetNodeID is the source of all [EdgeTypePubliclyReachable] edges. It is not a resource itself.
+	InternetNodeID = "internet"
+)
+
+// reachableVia contains the edge types along which the reachability from the internet is propagated, together with
+// the direction in which it is propagated. For example, a service is reachable via a load balancer pointing to it
+// and a virtual machine is reachable via its network interface.
+var reachableVia = map[string]direction{
+	"network_service":   outgoing,
+	"compute":           outgoing,
+	"container":         outgoing,
+	"network_interface": incoming,
+}
+
+// updateDerivedEdges computes the derived edges of all resources of the given cloud service and replaces the
+// previously stored ones.
+func (svc *Service) updateDerivedEdges(cloudServiceID string) (err error) {
+	var resources []*discovery.Resource
+
+	svc.derivedMu.Lock()
+	defer svc.derivedMu.Unlock()
+
+	err = svc.storage.List(&resources, "", true, 0, -1, "cloud_service_id = ?", cloudServiceID)
+	if err != nil {
+		return fmt.Errorf("could not retrieve resources: %w", err)
+	}
+
+	edges := deriveEdges(resources)
+	for _, e := range edges {
+		e.CloudServiceId = cloudServiceID
+	}
+
+	err = svc.storage.Delete(&discovery.GraphEdge{}, "cloud_service_id = ?", cloudServiceID)
+	if err != nil && !errors.Is(err, persistence.ErrRecordNotFound) {
+		return fmt.Errorf("could not remove derived edges: %w", err)
+	}
+
+	if len(edges) == 0 {
+		return nil
+	}
+
+	err = svc.storage.CreateInBatches(edges, 100)
+	if err != nil {
+		return fmt.Errorf("could not store derived edges: %w", err)
+	}
+
+	return nil
+}
+
+// deriveEdges computes the derived edges of the given resources:
+//   - a resource with an internet accessible endpoint is publicly reachable. The reachability is propagated along the
+//     edges in reachableVia, unless the resource is protected by an enabled firewall, e.g., a web application firewall
+//     in front of a load balancer.
+//   - a privileged identity has privileged access to its parent, e.g., its account or resource group, and to all
+//     resources below it.
+func deriveEdges(resources []*discovery.Resource) (edges []*discovery.GraphEdge) {
+	var (
+		nodes    = make(map[string]ontology.IsResource)
+		out      = make(map[string][]*discovery.GraphEdge)
+		in       = make(map[string][]*discovery.GraphEdge)
+		children = make(map[string][]string)
+		seen     = make(map[string]bool)
+	)
+
+	for _, resource := range resources {
+		r, err := resource.ToOntologyResource()
+		if err != nil {
+			continue
+		}
+
+		nodes[resource.Id] = r
+		for _, e := range graphEdges(resource.Id, r) {
+			out[e.Source] = append(out[e.Source], e)
+			in[e.Target] = append(in[e.Target], e)
+
+			if e.Type == "parent" {
+				children[e.Target] = append(children[e.Target], e.Source)
+			}
+		}
+	}
+
+	add := func(source, typ, target string) {
+		id := source + "-" + typ + "-" + target
+		if seen[id] || source == target {
+			return
+		}
+
+		seen[id] = true
+		edges = append(edges, &discovery.GraphEdge{
+			Id:      id,
+			Source:  source,
+			Target:  target,
+			Type:    typ,
+			Derived: true,
+		})
+	}
+
+	// Breadth-first search from all resources with an internet accessible endpoint
+	var (
+		queue     []string
+		reachable = make(map[string]bool)
+	)
+
+	for _, resource := range resources {
+		if r, ok := nodes[resource.Id].(interface{ GetInternetAccessibleEndpoint() bool }); ok && r.GetInternetAccessibleEndpoint() {
+			reachable[resource.Id] = true
+			queue = append(queue, resource.Id)
+			add(InternetNodeID, EdgeTypePubliclyReachable, resource.Id)
+		}
+	}
+
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+
+		if isRestricted(nodes[id]) {
+			continue
+		}
+
+		var next []string
+		for _, e := range out[id] {
+			if dir, ok := reachableVia[e.Type]; ok && dir == outgoing {
+				next = append(next, e.Target)
+			}
+		}
+		for _, e := range in[id] {
+			if dir, ok := reachableVia[e.Type]; ok && dir == incoming {
+				next = append(next, e.Source)
+			}
+		}
+
+		for _, n := range next {
+			if _, ok := nodes[n]; !ok || reachable[n] {
+				continue
+			}
+
+			reachable[n] = true
+			queue = append(queue, n)
+			add(InternetNodeID, EdgeTypePubliclyReachable, n)
+		}
+	}
+
+	// Privileged identities have access to their parent and everything below it
+	for _, resource := range resources {
+		identity, ok := nodes[resource.Id].(*ontology.Identity)
+		if !ok || !identity.GetPrivileged() || identity.GetParentId() == "" {
+			continue
+		}
+
+		scope := []string{identity.GetParentId()}
+		visited := make(map[string]bool)
+		for len(scope) > 0 {
+			id := scope[0]
+			scope = scope[1:]
+
+			if visited[id] {
+				continue
+			}
+
+			visited[id] = true
+			add(resource.Id, EdgeTypePrivilegedAccess, id)
+			scope = append(scope, children[id]...)
+		}
+	}
+
+	return
+}
+
+// isRestricted checks whether the access to the resource is restricted by an enabled firewall.
+func isRestricted(r ontology.IsResource) bool {
+	rr, ok := r.(interface {
+		GetAccessRestriction() *ontology.AccessRestriction
+	})
+	if !ok {
+		return false
+	}
+
+	ar := rr.GetAccessRestriction()
+
+	return ar.GetL3Firewall().GetEnabled() || ar.GetWebApplicationFirewall().GetEnabled()
+}
@@ -0,0 +1,177 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package discovery
+
+import (
+	"slices"
+	"testing"
+
+	"clouditor.io/clouditor/v2/api/discovery"
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/util"
+	"clouditor.io/clouditor/v2/persistence"
+)
+
+func Test_deriveEdges(t *testing.T) {
+	tests := []struct {
+		name      string
+		resources []ontology.IsResource
+		want      []string
+	}{
+		{
+			name: "reachable through load balancer",
+			resources: []ontology.IsResource{
+				&ontology.LoadBalancer{Id: "lb", InternetAccessibleEndpoint: true, NetworkServiceIds: []string{"svc"}},
+				&ontology.GenericNetworkService{Id: "svc", ComputeId: util.Ref("vm")},
+				&ontology.VirtualMachine{Id: "vm", BlockStorageIds: []string{"disk"}},
+				&ontology.BlockStorage{Id: "disk"},
+			},
+			want: []string{
+				"internet-publicly_reachable-lb",
+				"internet-publicly_reachable-svc",
+				"internet-publicly_reachable-vm",
+			},
+		},
+		{
+			name: "reachable through network interface",
+			resources: []ontology.IsResource{
+				&ontology.NetworkInterface{Id: "nic", InternetAccessibleEndpoint: true},
+				&ontology.VirtualMachine{Id: "vm", NetworkInterfaceIds: []string{"nic"}},
+			},
+			want: []string{
+				"internet-publicly_reachable-nic",
+				"internet-publicly_reachable-vm",
+			},
+		},
+		{
+			name: "protected by web application firewall",
+			resources: []ontology.IsResource{
+				&ontology.LoadBalancer{
+					Id:                         "lb",
+					InternetAccessibleEndpoint: true,
+					ComputeId:                  util.Ref("vm"),
+					AccessRestriction: &ontology.AccessRestriction{
+						Type: &ontology.AccessRestriction_WebApplicationFirewall{
+							WebApplicationFirewall: &ontology.WebApplicationFirewall{Enabled: true},
+						},
+					},
+				},
+				&ontology.VirtualMachine{Id: "vm"},
+			},
+			want: []string{
+				"internet-publicly_reachable-lb",
+			},
+		},
+		{
+			name: "privileged identity",
+			resources: []ontology.IsResource{
+				&ontology.ResourceGroup{Id: "rg"},
+				&ontology.VirtualMachine{Id: "vm", ParentId: util.Ref("rg")},
+				&ontology.BlockStorage{Id: "disk", ParentId: util.Ref("vm")},
+				&ontology.VirtualMachine{Id: "other-vm"},
+				&ontology.Identity{Id: "admin", Privileged: true, ParentId: util.Ref("rg")},
+				&ontology.Identity{Id: "user", ParentId: util.Ref("rg")},
+			},
+			want: []string{
+				"admin-has_privileged_access_to-disk",
+				"admin-has_privileged_access_to-rg",
+				"admin-has_privileged_access_to-user",
+				"admin-has_privileged_access_to-vm",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				resources []*discovery.Resource
+				got       []string
+			)
+
+			for _, r := range tt.resources {
+				resources = append(resources, panicToDiscoveryResource(t, r, testdata.MockCloudServiceID1))
+			}
+
+			for _, e := range deriveEdges(resources) {
+				assert.True(t, e.Derived)
+				got = append(got, e.Id)
+			}
+
+			slices.Sort(got)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestService_updateDerivedEdges(t *testing.T) {
+	var edges []*discovery.GraphEdge
+
+	svc := &Service{
+		storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+			assert.NoError(t, s.Create(panicToDiscoveryResource(t, &ontology.VirtualMachine{
+				Id:                         "vm",
+				InternetAccessibleEndpoint: true,
+			}, testdata.MockCloudServiceID1)))
+			assert.NoError(t, s.Create(panicToDiscoveryResource(t, &ontology.VirtualMachine{
+				Id:                         "other-vm",
+				InternetAccessibleEndpoint: true,
+			}, testdata.MockCloudServiceID2)))
+
+			// A stale edge, which needs to be removed
+			assert.NoError(t, s.Create(&discovery.GraphEdge{
+				Id:             "internet-publicly_reachable-old-vm",
+				Source:         InternetNodeID,
+				Target:         "old-vm",
+				Type:           EdgeTypePubliclyReachable,
+				Derived:        true,
+				CloudServiceId: testdata.MockCloudServiceID1,
+			}))
+		}),
+	}
+
+	assert.NoError(t, svc.updateDerivedEdges(testdata.MockCloudServiceID1))
+
+	assert.NoError(t, svc.storage.List(&edges, "id", true, 0, -1))
+	assert.Equal(t, []*discovery.GraphEdge{
+		{
+			Id:             "internet-publicly_reachable-vm",
+			Source:         InternetNodeID,
+			Target:         "vm",
+			Type:           EdgeTypePubliclyReachable,
+			Derived:        true,
+			CloudServiceId: testdata.MockCloudServiceID1,
+		},
+	}, edges)
+
+	// Nothing to derive anymore
+	svc.storage = testutil.NewInMemoryStorage(t)
+	assert.NoError(t, svc.updateDerivedEdges(testdata.MockCloudServiceID1))
+
+	svc.storage = &testutil.StorageWithError{ListErr: persistence.ErrUnsupportedType}
+	assert.ErrorIs(t, svc.updateDerivedEdges(testdata.MockCloudServiceID1), persistence.ErrUnsupportedType)
+}
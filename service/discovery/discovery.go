@@ -30,6 +30,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	"clouditor.io/clouditor/v2/api"
@@ -37,14 +38,21 @@ import (
 	"clouditor.io/clouditor/v2/api/discovery"
 	"clouditor.io/clouditor/v2/api/evidence"
 	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/logging"
+	"clouditor.io/clouditor/v2/internal/pseudonym"
+	"clouditor.io/clouditor/v2/internal/telemetry"
 	"clouditor.io/clouditor/v2/internal/util"
 	"clouditor.io/clouditor/v2/persistence"
 	"clouditor.io/clouditor/v2/persistence/inmemory"
 	"clouditor.io/clouditor/v2/service"
 	"clouditor.io/clouditor/v2/service/discovery/aws"
 	"clouditor.io/clouditor/v2/service/discovery/azure"
+	"clouditor.io/clouditor/v2/service/discovery/credentials"
 	"clouditor.io/clouditor/v2/service/discovery/k8s"
+	"clouditor.io/clouditor/v2/service/edge"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/go-co-op/gocron"
 	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
@@ -54,6 +62,7 @@ import (
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"k8s.io/client-go/kubernetes"
 )
 
 const (
@@ -93,6 +102,12 @@ type Service struct {
 	assessmentStreams *api.StreamsOf[assessment.Assessment_AssessEvidencesClient, *assessment.AssessEvidenceRequest]
 	assessment        *api.RPCConnection[assessment.AssessmentClient]
 
+	// compressor is the name of the gRPC compressor used for the assessment stream, if any
+	compressor string
+
+	// edge is used instead of the assessment stream, if evidences are sent over MQTT
+	edge *edge.Publisher
+
 	storage persistence.Storage
 
 	scheduler *gocron.Scheduler
@@ -108,6 +123,22 @@ type Service struct {
 
 	// csID is the cloud service ID for which we are gathering resources.
 	csID string
+
+	// credentials provides the credentials of the cloud providers from a secret store, if configured
+	credentials *credentials.Provider
+	secrets     credentials.SecretNames
+
+	// graph is the in-memory index of the resource graph used by QueryGraph
+	graph *graphIndex
+
+	// derivedMu protects the replacement of the derived edges in the storage
+	derivedMu sync.Mutex
+
+	// hooks are invoked with the resources of each discovery, see [ResourceHook]
+	hooks []ResourceHook
+
+	// pseudonymizer pseudonymizes the exported resource graph on request. If nil, pseudonymized exports are not available.
+	pseudonymizer *pseudonym.Pseudonymizer
 }
 
 func init() {
@@ -130,6 +161,31 @@ func WithAssessmentAddress(target string, opts ...grpc.DialOption) ServiceOption
 	}
 }
 
+// WithAssessmentCompression is an option to compress the evidences sent to the assessment service using the gRPC
+// compressor with the given name, e.g., [api.CompressorZstd].
+func WithAssessmentCompression(compressor string) ServiceOption {
+	return func(s *Service) {
+		s.compressor = compressor
+	}
+}
+
+// WithEdgePublisher is an option to send evidences over MQTT using the [edge.Publisher] instead of directly streaming
+// them to the assessment service. This is intended for collectors at edge sites, which can only reach an MQTT broker.
+func WithEdgePublisher(pub *edge.Publisher) ServiceOption {
+	return func(s *Service) {
+		s.edge = pub
+	}
+}
+
+// WithCredentialsProvider is an option to retrieve the credentials of the cloud providers from a secret store instead
+// of using their default credentials. Only the providers for which secrets contains a name are affected.
+func WithCredentialsProvider(p *credentials.Provider, secrets credentials.SecretNames) ServiceOption {
+	return func(svc *Service) {
+		svc.credentials = p
+		svc.secrets = secrets
+	}
+}
+
 // WithCloudServiceID is an option to configure the cloud service ID for which resources will be discovered.
 func WithCloudServiceID(ID string) ServiceOption {
 	return func(svc *Service) {
@@ -144,6 +200,13 @@ func WithOAuth2Authorizer(config *clientcredentials.Config) ServiceOption {
 	}
 }
 
+// WithAuthorizer is an option to use a pre-created authorizer
+func WithAuthorizer(auth api.Authorizer) ServiceOption {
+	return func(svc *Service) {
+		svc.assessment.SetAuthorizer(auth)
+	}
+}
+
 // WithProviders is an option to set providers for discovering
 func WithProviders(providersList []string) ServiceOption {
 	if len(providersList) == 0 {
@@ -185,6 +248,21 @@ func WithAuthorizationStrategy(authz service.AuthorizationStrategy) ServiceOptio
 	}
 }
 
+// WithPseudonymizer is an option to configure the pseudonymizer that is used for pseudonymized exports of the resource
+// graph.
+func WithPseudonymizer(p *pseudonym.Pseudonymizer) ServiceOption {
+	return func(s *Service) {
+		s.pseudonymizer = p
+	}
+}
+
+// WithLeaderElector is an option to only run the scheduled discoveries on the replica that is elected as leader.
+func WithLeaderElector(e service.LeaderElector) ServiceOption {
+	return func(s *Service) {
+		s.scheduler.WithDistributedElector(e)
+	}
+}
+
 func NewService(opts ...ServiceOption) *Service {
 	var err error
 	s := &Service{
@@ -195,6 +273,8 @@ func NewService(opts ...ServiceOption) *Service {
 		csID:              discovery.DefaultCloudServiceID,
 		authz:             &service.AuthorizationStrategyAllowAll{},
 		discoveryInterval: 5 * time.Minute, // Default discovery interval is 5 minutes
+		graph:             newGraphIndex(),
+		hooks:             []ResourceHook{NormalizeGeoLocations, DataAssetsFromLabels},
 	}
 
 	// Apply any options
@@ -215,15 +295,20 @@ func NewService(opts ...ServiceOption) *Service {
 
 // initAssessmentStream initializes the stream that is used to send evidences to the assessment service.
 // If configured, it uses the Authorizer of the discovery service to authenticate requests to the assessment.
-func (svc *Service) initAssessmentStream(target string, _ ...grpc.DialOption) (stream assessment.Assessment_AssessEvidencesClient, err error) {
+func (svc *Service) initAssessmentStream(ctx context.Context, target string, _ ...grpc.DialOption) (stream assessment.Assessment_AssessEvidencesClient, err error) {
 	log.Infof("Trying to establish a connection to assessment service @ %v", target)
 
 	// Make sure, that we re-connect
 	svc.assessment.ForceReconnect()
 
+	var opts []grpc.CallOption
+	if svc.compressor != "" {
+		opts = append(opts, grpc.UseCompressor(svc.compressor))
+	}
+
 	// Set up the stream and store it in our service struct, so we can access it later to actually
 	// send the evidence data
-	stream, err = svc.assessment.Client.AssessEvidences(context.Background())
+	stream, err = svc.assessment.Client.AssessEvidences(ctx, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("could not set up stream for assessing evidences: %w", err)
 	}
@@ -233,18 +318,45 @@ func (svc *Service) initAssessmentStream(target string, _ ...grpc.DialOption) (s
 	return
 }
 
+// azureAuthorizer returns the credential for Azure, either from the secret store or the default one.
+func (svc *Service) azureAuthorizer() (authorizer azcore.TokenCredential, err error) {
+	if svc.credentials != nil && svc.secrets.Azure != "" {
+		return svc.credentials.AzureCredential(svc.secrets.Azure), nil
+	}
+
+	return azure.NewAuthorizer()
+}
+
+// k8sClient returns the Kubernetes client, either using a kubeconfig from the secret store or from the home
+// directory.
+func (svc *Service) k8sClient(ctx context.Context) (client kubernetes.Interface, err error) {
+	if svc.credentials != nil && svc.secrets.Kubernetes != "" {
+		data, err := svc.credentials.KubeConfig(ctx, svc.secrets.Kubernetes)
+		if err != nil {
+			return nil, err
+		}
+
+		return k8s.AuthFromKubeConfigData(data)
+	}
+
+	return k8s.AuthFromKubeConfig()
+}
+
+// awsClient returns the AWS client, either using the credentials from the secret store or the default ones.
+func (svc *Service) awsClient() (client *aws.Client, err error) {
+	if svc.credentials != nil && svc.secrets.AWS != "" {
+		return aws.NewClient(config.WithCredentialsProvider(svc.credentials.AWSCredentials(svc.secrets.AWS)))
+	}
+
+	return aws.NewClient()
+}
+
 // Start starts discovery
 func (svc *Service) Start(ctx context.Context, req *discovery.StartDiscoveryRequest) (resp *discovery.StartDiscoveryResponse, err error) {
 	var (
 		opts = []azure.DiscoveryOption{}
 	)
 
-	// Validate request
-	err = api.Validate(req)
-	if err != nil {
-		return nil, err
-	}
-
 	// Check if cloud_service_id in the service is within allowed or one can access *all* the cloud services
 	if !svc.authz.CheckAccess(ctx, service.AccessUpdate, svc) {
 		return nil, service.ErrPermissionDenied
@@ -259,7 +371,7 @@ func (svc *Service) Start(ctx context.Context, req *discovery.StartDiscoveryRequ
 	for _, provider := range svc.providers {
 		switch {
 		case provider == ProviderAzure:
-			authorizer, err := azure.NewAuthorizer()
+			authorizer, err := svc.azureAuthorizer()
 			if err != nil {
 				log.Errorf("Could not authenticate to Azure: %v", err)
 				return nil, status.Errorf(codes.FailedPrecondition, "could not authenticate to Azure: %v", err)
@@ -272,7 +384,7 @@ func (svc *Service) Start(ctx context.Context, req *discovery.StartDiscoveryRequ
 			}
 			svc.discoverers = append(svc.discoverers, azure.NewAzureDiscovery(opts...))
 		case provider == ProviderK8S:
-			k8sClient, err := k8s.AuthFromKubeConfig()
+			k8sClient, err := svc.k8sClient(ctx)
 			if err != nil {
 				log.Errorf("Could not authenticate to Kubernetes: %v", err)
 				return nil, status.Errorf(codes.FailedPrecondition, "could not authenticate to Kubernetes: %v", err)
@@ -282,14 +394,15 @@ func (svc *Service) Start(ctx context.Context, req *discovery.StartDiscoveryRequ
 				k8s.NewKubernetesNetworkDiscovery(k8sClient, svc.csID),
 				k8s.NewKubernetesStorageDiscovery(k8sClient, svc.csID))
 		case provider == ProviderAWS:
-			awsClient, err := aws.NewClient()
+			awsClient, err := svc.awsClient()
 			if err != nil {
 				log.Errorf("Could not authenticate to AWS: %v", err)
 				return nil, status.Errorf(codes.FailedPrecondition, "could not authenticate to AWS: %v", err)
 			}
 			svc.discoverers = append(svc.discoverers,
 				aws.NewAwsStorageDiscovery(awsClient, svc.csID),
-				aws.NewAwsComputeDiscovery(awsClient, svc.csID))
+				aws.NewAwsComputeDiscovery(awsClient, svc.csID),
+				aws.NewAwsLoggingDiscovery(awsClient, svc.csID))
 		default:
 			newError := fmt.Errorf("provider %s not known", provider)
 			log.Error(newError)
@@ -321,6 +434,10 @@ func (svc *Service) Shutdown() {
 
 	svc.assessmentStreams.CloseAll()
 	svc.scheduler.Stop()
+
+	if svc.edge != nil {
+		_ = svc.edge.Close()
+	}
 }
 
 func (svc *Service) StartDiscovery(discoverer discovery.Discoverer) {
@@ -344,6 +461,10 @@ func (svc *Service) StartDiscovery(discoverer discovery.Discoverer) {
 		return
 	}
 
+	for _, hook := range svc.hooks {
+		list = hook(list)
+	}
+
 	// Notify event listeners that the discoverer is finished
 	go func() {
 		svc.Events <- &DiscoveryEvent{
@@ -367,6 +488,8 @@ func (svc *Service) StartDiscovery(discoverer discovery.Discoverer) {
 		err = svc.storage.Save(&r, "id = ?", r.Id)
 		if err != nil {
 			log.Errorf("Could not save resource with ID '%s' to storage: %v", r.Id, err)
+		} else {
+			svc.graph.put(r)
 		}
 
 		a, err := anypb.New(resource)
@@ -376,23 +499,54 @@ func (svc *Service) StartDiscovery(discoverer discovery.Discoverer) {
 		}
 
 		e := &evidence.Evidence{
-			Id:             uuid.New().String(),
-			CloudServiceId: svc.GetCloudServiceId(),
-			Timestamp:      timestamppb.Now(),
-			Raw:            util.Ref(resource.GetRaw()),
-			ToolId:         discovery.EvidenceCollectorToolId,
-			Resource:       a,
+			Id:              uuid.New().String(),
+			CloudServiceId:  svc.GetCloudServiceId(),
+			Timestamp:       timestamppb.Now(),
+			Raw:             util.Ref(resource.GetRaw()),
+			ToolId:          discovery.EvidenceCollectorToolId,
+			Resource:        a,
+			Relationships:   evidence.Relationships(resource),
+			OntologyVersion: ontology.Version,
+		}
+
+		// Start a new trace for this evidence, which is continued by the assessment and all subsequent services
+		ctx, span := telemetry.StartSpan(context.Background(), "discovery.CollectEvidence",
+			telemetry.CloudServiceIDKey.String(e.CloudServiceId),
+			telemetry.EvidenceIDKey.String(e.Id),
+			telemetry.ResourceIDKey.String(r.Id),
+		)
+		ctx = logging.WithEvidence(ctx, e)
+
+		req := &assessment.AssessEvidenceRequest{Evidence: e, TraceContext: telemetry.InjectTraceContext(ctx)}
+
+		// Send the evidence over MQTT, if configured
+		if svc.edge != nil {
+			err = svc.edge.Publish(ctx, req)
+			if err != nil {
+				log.WithContext(ctx).Errorf("could not send evidence %s over MQTT: %v", e.Id, err)
+			}
+
+			telemetry.EndSpan(span, err)
+			continue
 		}
 
 		// Get Evidence Store stream
-		channel, err := svc.assessmentStreams.GetStream(svc.assessment.Target, "Assessment", svc.initAssessmentStream, svc.assessment.Opts...)
+		channel, err := svc.assessmentStreams.GetStreamContext(svc.assessment.Target, "Assessment", svc.initAssessmentStream, svc.assessment.Opts...)
 		if err != nil {
 			err = fmt.Errorf("could not get stream to assessment service (%s): %w", svc.assessment.Target, err)
-			log.Error(err)
+			log.WithContext(ctx).Error(err)
+			telemetry.EndSpan(span, err)
 			continue
 		}
 
-		channel.Send(&assessment.AssessEvidenceRequest{Evidence: e})
+		channel.Send(req)
+		span.End()
+	}
+
+	// Update the derived edges, e.g., the reachability from the internet, of the resource graph
+	err = svc.updateDerivedEdges(svc.GetCloudServiceId())
+	if err != nil {
+		log.Errorf("Could not update derived edges of the resource graph: %v", err)
 	}
 }
 
@@ -404,8 +558,7 @@ func (svc *Service) ListResources(ctx context.Context, req *discovery.ListResour
 		allowed []string
 	)
 
-	// Validate request
-	err = api.Validate(req)
+	err = api.ValidateFieldMask(req.ReadMask, &discovery.Resource{})
 	if err != nil {
 		return nil, err
 	}
@@ -419,14 +572,7 @@ func (svc *Service) ListResources(ctx context.Context, req *discovery.ListResour
 			return nil, service.ErrPermissionDenied
 		}
 
-		if req.Filter.CloudServiceId != nil {
-			query = append(query, "cloud_service_id = ?")
-			args = append(args, req.Filter.GetCloudServiceId())
-		}
-		if req.Filter.Type != nil {
-			query = append(query, "(resource_type LIKE ? OR resource_type LIKE ? OR resource_type LIKE ?)")
-			args = append(args, req.Filter.GetType()+",%", "%,"+req.Filter.GetType()+",%", "%,"+req.Filter.GetType())
-		}
+		query, args = applyResourceFilter(req.Filter, query, args)
 	}
 
 	// We need to further restrict our query according to the cloud service we are allowed to "see".
@@ -444,14 +590,49 @@ func (svc *Service) ListResources(ctx context.Context, req *discovery.ListResour
 	// Join query with AND and prepend the query
 	args = append([]any{strings.Join(query, " AND ")}, args...)
 
-	res.Results, res.NextPageToken, err = service.PaginateStorage[*discovery.Resource](req, svc.storage, service.DefaultPaginationOpts, args...)
+	res.Results, res.NextPageToken, err = service.PaginateStorageWithCursor[*discovery.Resource](req, svc.storage, service.DefaultPaginationOpts, args...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not paginate results: %v", err)
+	}
+
+	// Only return the requested fields
+	api.ApplyFieldMask(req.ReadMask, res.Results...)
 
 	return
 }
 
+// applyResourceFilter appends the conditions of filter to the query and its args.
+func applyResourceFilter(filter *discovery.ListResourcesRequest_Filter, query []string, args []any) ([]string, []any) {
+	if filter.CloudServiceId != nil {
+		query = append(query, "cloud_service_id = ?")
+		args = append(args, filter.GetCloudServiceId())
+	}
+	if filter.Type != nil {
+		query = append(query, "(resource_type LIKE ? OR resource_type LIKE ? OR resource_type LIKE ?)")
+		args = append(args, filter.GetType()+",%", "%,"+filter.GetType()+",%", "%,"+filter.GetType())
+	}
+
+	return query, args
+}
+
 // GetCloudServiceId implements CloudServiceRequest for this service. This is a little trick, so that we can call
 // CheckAccess directly on the service. This is necessary because the discovery service itself is tied to a specific
 // cloud service ID, instead of the individual requests that are made against the service.
 func (svc *Service) GetCloudServiceId() string {
 	return svc.csID
 }
+
+// CheckHealth implements [service.HealthChecker]. The discovery depends on its storage and the stream to the
+// assessment.
+func (svc *Service) CheckHealth(ctx context.Context) error {
+	if err := svc.storage.Ping(ctx); err != nil {
+		return fmt.Errorf("storage is not reachable: %w", err)
+	}
+
+	return svc.assessmentStreams.CheckHealth()
+}
+
+// StreamStates implements [service.StreamReporter].
+func (svc *Service) StreamStates() []api.StreamState {
+	return svc.assessmentStreams.States()
+}
@@ -39,6 +39,7 @@ import (
 	"clouditor.io/clouditor/v2/api/discovery"
 	"clouditor.io/clouditor/v2/api/evidence"
 	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/mqtt"
 	"clouditor.io/clouditor/v2/internal/testdata"
 	"clouditor.io/clouditor/v2/internal/testutil"
 	"clouditor.io/clouditor/v2/internal/testutil/assert"
@@ -48,11 +49,13 @@ import (
 	"clouditor.io/clouditor/v2/internal/util"
 	"clouditor.io/clouditor/v2/persistence"
 	"clouditor.io/clouditor/v2/service"
+	"clouditor.io/clouditor/v2/service/edge"
 
 	"github.com/go-co-op/gocron"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
 func TestMain(m *testing.M) {
@@ -195,7 +198,7 @@ func TestService_StartDiscovery(t *testing.T) {
 			svc := NewService()
 			svc.csID = tt.fields.csID
 			svc.assessmentStreams = api.NewStreamsOf[assessment.Assessment_AssessEvidencesClient, *assessment.AssessEvidenceRequest]()
-			_, _ = svc.assessmentStreams.GetStream("mock", "Assessment", func(target string, additionalOpts ...grpc.DialOption) (stream assessment.Assessment_AssessEvidencesClient, err error) {
+			_, _ = svc.assessmentStreams.GetStreamContext("mock", "Assessment", func(_ context.Context, target string, additionalOpts ...grpc.DialOption) (stream assessment.Assessment_AssessEvidencesClient, err error) {
 				return mockStream, nil
 			})
 			svc.assessment = &api.RPCConnection[assessment.AssessmentClient]{Target: "mock"}
@@ -273,6 +276,35 @@ func TestService_ListResources(t *testing.T) {
 			numberOfQueriedResources: 2,
 			wantErr:                  assert.Nil[error],
 		},
+		{
+			name: "Filter cloud service with read mask, allow",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategy(false, testdata.MockCloudServiceID1),
+				csID:  testdata.MockCloudServiceID1,
+			},
+			args: args{req: &discovery.ListResourcesRequest{
+				Filter: &discovery.ListResourcesRequest_Filter{
+					CloudServiceId: util.Ref(testdata.MockCloudServiceID1),
+				},
+				ReadMask: &fieldmaskpb.FieldMask{Paths: []string{"id", "resource_type"}},
+			}},
+			numberOfQueriedResources: 2,
+			wantErr:                  assert.Nil[error],
+		},
+		{
+			name: "Invalid read mask",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategy(true),
+				csID:  testdata.MockCloudServiceID1,
+			},
+			args: args{req: &discovery.ListResourcesRequest{
+				ReadMask: &fieldmaskpb.FieldMask{Paths: []string{"properties.name"}},
+			}},
+			numberOfQueriedResources: 0,
+			wantErr: func(t *testing.T, gotErr error) bool {
+				return assert.ErrorContains(t, gotErr, api.ErrInvalidFieldMask.Error())
+			},
+		},
 		{
 			name: "Filter cloud service, not allowed",
 			fields: fields{
@@ -328,6 +360,39 @@ func TestService_ListResources(t *testing.T) {
 	}
 }
 
+func TestService_StartDiscovery_edge(t *testing.T) {
+	broker, err := mqtt.NewBroker("127.0.0.1:0")
+	assert.NoError(t, err)
+	defer broker.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	sub, err := mqtt.Connect(ctx, broker.URL())
+	assert.NoError(t, err)
+	defer sub.Close()
+
+	messages := make(chan *mqtt.Message, 10)
+	err = sub.Subscribe(ctx, edge.DefaultTopic, mqtt.AtMostOnce, func(msg *mqtt.Message) {
+		messages <- msg
+	})
+	assert.NoError(t, err)
+
+	s := NewService(WithEdgePublisher(edge.NewPublisher(broker.URL(), edge.DefaultTopic)))
+	defer s.Shutdown()
+
+	s.StartDiscovery(&discoverytest.TestDiscoverer{TestCase: 2, ServiceId: discovery.DefaultCloudServiceID})
+
+	select {
+	case msg := <-messages:
+		req, err := edge.DecodeRequest(msg.Payload)
+		assert.NoError(t, err)
+		assert.Equal(t, discovery.DefaultCloudServiceID, req.Evidence.CloudServiceId)
+	case <-ctx.Done():
+		t.Fatal("evidence was not published over MQTT")
+	}
+}
+
 func TestService_Shutdown(t *testing.T) {
 	service := NewService()
 	service.Shutdown()
@@ -674,7 +739,7 @@ func TestService_Start(t *testing.T) {
 				}
 			}
 
-			gotRes, err := svc.Start(tt.args.ctx, tt.args.req)
+			gotRes, err := servicetest.Validated(svc.Start)(tt.args.ctx, tt.args.req)
 
 			tt.want(t, gotRes)
 			tt.wantErr(t, err)
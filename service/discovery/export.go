@@ -0,0 +1,266 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package discovery
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"clouditor.io/clouditor/v2/api/discovery"
+	"clouditor.io/clouditor/v2/internal/pseudonym"
+	"clouditor.io/clouditor/v2/persistence"
+	"clouditor.io/clouditor/v2/service"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// exportedGraph contains the resources and edges of an export of the resource graph.
+type exportedGraph struct {
+	nodes []*exportedNode
+	edges []*discovery.GraphEdge
+}
+
+// exportedNode is a resource in an export of the resource graph.
+type exportedNode struct {
+	id             string
+	name           string
+	cloudServiceID string
+	types          []string
+}
+
+// ExportGraph exports the resources that match the filter and the edges between them as DOT, Cytoscape JSON or Neo4j
+// Cypher statements. Edges to resources that are not exported, e.g., because they were not discovered, are omitted,
+// so that the export can be imported as it is. If requested, the IDs and names of the resources are pseudonymized.
+func (svc *Service) ExportGraph(ctx context.Context, req *discovery.ExportGraphRequest) (res *discovery.ExportGraphResponse, err error) {
+	var (
+		resources []*discovery.Resource
+		types     map[string]*discovery.CustomResourceType
+		all       bool
+		allowed   []string
+		query     []string
+		args      []any
+		g         exportedGraph
+	)
+
+	if req.Pseudonymize && svc.pseudonymizer == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "%v", pseudonym.ErrNotConfigured)
+	}
+
+	// Filtering the resources, the same way as in ListResources
+	if req.Filter != nil {
+		if !svc.authz.CheckAccess(ctx, service.AccessRead, req.Filter) {
+			return nil, service.ErrPermissionDenied
+		}
+
+		query, args = applyResourceFilter(req.Filter, query, args)
+	}
+
+	all, allowed = svc.authz.AllowedCloudServices(ctx)
+	if !all {
+		query = append(query, "cloud_service_id IN ?")
+		args = append(args, allowed)
+	}
+
+	var conds []any
+	if len(query) > 0 {
+		conds = persistence.BuildConds(query, args)
+	}
+
+	err = svc.storage.List(&resources, "id", true, 0, -1, conds...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
+	var exported = make(map[string]bool)
+	for _, r := range resources {
+		exported[r.Id] = true
+	}
+
+	for _, r := range resources {
+		node := &exportedNode{id: r.Id, cloudServiceID: r.CloudServiceId, types: strings.Split(r.ResourceType, ",")}
+		g.nodes = append(g.nodes, node)
+
+		var edges []*discovery.GraphEdge
+
+		or, err := r.ToOntologyResource()
+		if err == nil {
+			node.name = or.GetName()
+			edges = graphEdges(r.Id, or)
+		} else if props, ok := r.CustomProperties(); ok {
+			if types == nil {
+				types, err = svc.customResourceTypes()
+				if err != nil {
+					return nil, status.Errorf(codes.Internal, "database error: %v", err)
+				}
+			}
+
+			node.name = props.GetFields()["name"].GetStringValue()
+			edges = customEdges(r, types)
+		}
+
+		for _, e := range edges {
+			if exported[e.Target] {
+				g.edges = append(g.edges, e)
+			}
+		}
+	}
+
+	if req.Pseudonymize {
+		g.pseudonymize(svc.pseudonymizer)
+	}
+
+	res = new(discovery.ExportGraphResponse)
+
+	switch req.Format {
+	case "cytoscape":
+		res.Data, err = g.cytoscape()
+	case "cypher":
+		res.Data = g.cypher()
+	default:
+		res.Data = g.dot()
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not export graph: %v", err)
+	}
+
+	return res, nil
+}
+
+// pseudonymize replaces the IDs and names of the resources with pseudonyms. Since the same ID always results in the
+// same pseudonym, the edges still connect the same resources.
+func (g *exportedGraph) pseudonymize(p *pseudonym.Pseudonymizer) {
+	for _, node := range g.nodes {
+		node.id = p.ResourceID(node.id)
+		node.name = p.ResourceName(node.name)
+	}
+
+	for _, e := range g.edges {
+		e.Source = p.ResourceID(e.Source)
+		e.Target = p.ResourceID(e.Target)
+		e.Id = e.Source + "-" + e.Target
+	}
+}
+
+// label returns the label of the node, i.e., its name (or ID) and its most specific type.
+func (node *exportedNode) label() string {
+	name := node.name
+	if name == "" {
+		name = node.id
+	}
+
+	return fmt.Sprintf("%s (%s)", name, node.types[0])
+}
+
+// dot returns the graph in the DOT language of Graphviz.
+func (g *exportedGraph) dot() []byte {
+	var b bytes.Buffer
+
+	b.WriteString("digraph resources {\n")
+
+	for _, node := range g.nodes {
+		fmt.Fprintf(&b, "  %s [label=%s];\n", strconv.Quote(node.id), strconv.Quote(node.label()))
+	}
+
+	for _, e := range g.edges {
+		fmt.Fprintf(&b, "  %s -> %s [label=%s];\n", strconv.Quote(e.Source), strconv.Quote(e.Target), strconv.Quote(e.Type))
+	}
+
+	b.WriteString("}\n")
+
+	return b.Bytes()
+}
+
+// cytoscapeElement is a node or edge in the Cytoscape JSON format.
+type cytoscapeElement struct {
+	Data map[string]any `json:"data"`
+}
+
+// cytoscape returns the graph in the Cytoscape JSON format, which can be imported by Cytoscape and Cytoscape.js.
+func (g *exportedGraph) cytoscape() ([]byte, error) {
+	var elements struct {
+		Nodes []cytoscapeElement `json:"nodes"`
+		Edges []cytoscapeElement `json:"edges"`
+	}
+
+	elements.Nodes = []cytoscapeElement{}
+	elements.Edges = []cytoscapeElement{}
+
+	for _, node := range g.nodes {
+		elements.Nodes = append(elements.Nodes, cytoscapeElement{Data: map[string]any{
+			"id":             node.id,
+			"name":           node.label(),
+			"type":           node.types,
+			"cloudServiceId": node.cloudServiceID,
+		}})
+	}
+
+	for _, e := range g.edges {
+		elements.Edges = append(elements.Edges, cytoscapeElement{Data: map[string]any{
+			"id":     e.Id,
+			"source": e.Source,
+			"target": e.Target,
+			"type":   e.Type,
+		}})
+	}
+
+	return json.MarshalIndent(map[string]any{"elements": elements}, "", "  ")
+}
+
+// cypher returns the graph as Neo4j Cypher statements. The statements use MERGE, so that they can be executed
+// repeatedly, e.g., after each discovery.
+func (g *exportedGraph) cypher() []byte {
+	var b bytes.Buffer
+
+	for _, node := range g.nodes {
+		fmt.Fprintf(&b, "MERGE (n:Resource {id: %s}) SET n.name = %s, n.cloudServiceId = %s",
+			strconv.Quote(node.id), strconv.Quote(node.name), strconv.Quote(node.cloudServiceID))
+
+		for _, typ := range node.types {
+			if typ != "Resource" {
+				fmt.Fprintf(&b, ", n:%s", cypherName(typ))
+			}
+		}
+
+		b.WriteString(";\n")
+	}
+
+	for _, e := range g.edges {
+		fmt.Fprintf(&b, "MATCH (a:Resource {id: %s}), (b:Resource {id: %s}) MERGE (a)-[:%s]->(b);\n",
+			strconv.Quote(e.Source), strconv.Quote(e.Target), cypherName(strings.ToUpper(e.Type)))
+	}
+
+	return b.Bytes()
+}
+
+// cypherName escapes a label or relationship type in Cypher.
+func cypherName(name string) string {
+	return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+}
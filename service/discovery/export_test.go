@@ -0,0 +1,226 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package discovery
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"clouditor.io/clouditor/v2/api/discovery"
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/pseudonym"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/testutil/servicetest"
+	"clouditor.io/clouditor/v2/internal/util"
+	"clouditor.io/clouditor/v2/persistence"
+	"clouditor.io/clouditor/v2/service"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestService_ExportGraph(t *testing.T) {
+	storage := testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+		assert.NoError(t, s.Create(panicToDiscoveryResource(t,
+			&ontology.VirtualMachine{Id: "vm1", Name: "My VM", BlockStorageIds: []string{"disk1", "unknown"}},
+			testdata.MockCloudServiceID1)))
+		assert.NoError(t, s.Create(panicToDiscoveryResource(t,
+			&ontology.BlockStorage{Id: "disk1", Name: `My "Disk"`},
+			testdata.MockCloudServiceID1)))
+		assert.NoError(t, s.Create(panicToDiscoveryResource(t,
+			&ontology.VirtualMachine{Id: "vm2", Name: "Other VM", BlockStorageIds: []string{"disk1"}},
+			testdata.MockCloudServiceID2)))
+	})
+
+	type args struct {
+		req *discovery.ExportGraphRequest
+	}
+	tests := []struct {
+		name    string
+		authz   service.AuthorizationStrategy
+		args    args
+		want    assert.Want[*discovery.ExportGraphResponse]
+		wantErr assert.WantErr
+	}{
+		{
+			name:  "invalid format",
+			authz: servicetest.NewAuthorizationStrategy(true),
+			args: args{
+				req: &discovery.ExportGraphRequest{Format: "svg"},
+			},
+			want: assert.Nil[*discovery.ExportGraphResponse],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			name:  "permission denied",
+			authz: servicetest.NewAuthorizationStrategy(false, testdata.MockCloudServiceID1),
+			args: args{
+				req: &discovery.ExportGraphRequest{Filter: &discovery.ListResourcesRequest_Filter{
+					CloudServiceId: util.Ref(testdata.MockCloudServiceID2),
+				}},
+			},
+			want: assert.Nil[*discovery.ExportGraphResponse],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, service.ErrPermissionDenied)
+			},
+		},
+		{
+			name:  "dot",
+			authz: servicetest.NewAuthorizationStrategy(false, testdata.MockCloudServiceID1),
+			args: args{
+				req: &discovery.ExportGraphRequest{},
+			},
+			want: func(t *testing.T, got *discovery.ExportGraphResponse) bool {
+				return assert.Equal(t, `digraph resources {
+  "disk1" [label="My \"Disk\" (BlockStorage)"];
+  "vm1" [label="My VM (VirtualMachine)"];
+  "vm1" -> "disk1" [label="block_storage"];
+}
+`, string(got.Data))
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name:  "cytoscape",
+			authz: servicetest.NewAuthorizationStrategy(true),
+			args: args{
+				req: &discovery.ExportGraphRequest{Format: "cytoscape", Filter: &discovery.ListResourcesRequest_Filter{
+					Type: util.Ref("VirtualMachine"),
+				}},
+			},
+			want: func(t *testing.T, got *discovery.ExportGraphResponse) bool {
+				// Only the VMs are exported, so there are no edges
+				return assert.Equal(t, `{
+  "elements": {
+    "nodes": [
+      {
+        "data": {
+          "cloudServiceId": "11111111-1111-1111-1111-111111111111",
+          "id": "vm1",
+          "name": "My VM (VirtualMachine)",
+          "type": [
+            "VirtualMachine",
+            "Compute",
+            "CloudResource",
+            "Resource"
+          ]
+        }
+      },
+      {
+        "data": {
+          "cloudServiceId": "22222222-2222-2222-2222-222222222222",
+          "id": "vm2",
+          "name": "Other VM (VirtualMachine)",
+          "type": [
+            "VirtualMachine",
+            "Compute",
+            "CloudResource",
+            "Resource"
+          ]
+        }
+      }
+    ],
+    "edges": []
+  }
+}`, string(got.Data))
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name:  "cypher",
+			authz: servicetest.NewAuthorizationStrategy(false, testdata.MockCloudServiceID1),
+			args: args{
+				req: &discovery.ExportGraphRequest{Format: "cypher"},
+			},
+			want: func(t *testing.T, got *discovery.ExportGraphResponse) bool {
+				return assert.Equal(t, `MERGE (n:Resource {id: "disk1"}) SET n.name = "My \"Disk\"", n.cloudServiceId = "11111111-1111-1111-1111-111111111111", n:`+"`BlockStorage`, n:`Storage`, n:`CloudResource`"+`;
+MERGE (n:Resource {id: "vm1"}) SET n.name = "My VM", n.cloudServiceId = "11111111-1111-1111-1111-111111111111", n:`+"`VirtualMachine`, n:`Compute`, n:`CloudResource`"+`;
+MATCH (a:Resource {id: "vm1"}), (b:Resource {id: "disk1"}) MERGE (a)-[:`+"`BLOCK_STORAGE`"+`]->(b);
+`, string(got.Data))
+			},
+			wantErr: assert.Nil[error],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := NewService(WithStorage(storage), WithAuthorizationStrategy(tt.authz))
+
+			got, err := servicetest.Validated(svc.ExportGraph)(context.Background(), tt.args.req)
+			tt.wantErr(t, err)
+			tt.want(t, got)
+		})
+	}
+}
+
+func TestService_ExportGraph_databaseError(t *testing.T) {
+	svc := NewService(WithStorage(&testutil.StorageWithError{ListErr: persistence.ErrUnsupportedType}))
+
+	_, err := svc.ExportGraph(context.Background(), &discovery.ExportGraphRequest{})
+	assert.Equal(t, codes.Internal, status.Code(err))
+}
+
+func TestService_ExportGraph_pseudonymize(t *testing.T) {
+	storage := testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+		assert.NoError(t, s.Create(panicToDiscoveryResource(t,
+			&ontology.VirtualMachine{Id: "vm1", Name: "My VM", BlockStorageIds: []string{"disk1"}},
+			testdata.MockCloudServiceID1)))
+		assert.NoError(t, s.Create(panicToDiscoveryResource(t,
+			&ontology.BlockStorage{Id: "disk1", Name: "My Disk"},
+			testdata.MockCloudServiceID1)))
+	})
+
+	// Pseudonymization needs to be configured
+	svc := NewService(WithStorage(storage), WithAuthorizationStrategy(servicetest.NewAuthorizationStrategy(true)))
+
+	_, err := svc.ExportGraph(context.Background(), &discovery.ExportGraphRequest{Pseudonymize: true})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.ErrorContains(t, err, pseudonym.ErrNotConfigured.Error())
+
+	p, err := pseudonym.New("my-salt", pseudonym.FieldResourceID, pseudonym.FieldResourceName)
+	assert.NoError(t, err)
+
+	svc = NewService(WithStorage(storage), WithAuthorizationStrategy(servicetest.NewAuthorizationStrategy(true)), WithPseudonymizer(p))
+
+	got, err := svc.ExportGraph(context.Background(), &discovery.ExportGraphRequest{Pseudonymize: true})
+	assert.NoError(t, err)
+
+	var (
+		vm   = strconv.Quote(p.Pseudonym(pseudonym.FieldResourceID, "vm1"))
+		disk = strconv.Quote(p.Pseudonym(pseudonym.FieldResourceID, "disk1"))
+	)
+
+	assert.Equal(t, `digraph resources {
+  `+disk+` [label="`+p.Pseudonym(pseudonym.FieldResourceName, "My Disk")+` (BlockStorage)"];
+  `+vm+` [label="`+p.Pseudonym(pseudonym.FieldResourceName, "My VM")+` (VirtualMachine)"];
+  `+vm+` -> `+disk+` [label="block_storage"];
+}
+`, string(got.Data))
+}
@@ -27,29 +27,42 @@ package discovery
 
 import (
 	"context"
+	"slices"
 
-	"clouditor.io/clouditor/v2/api"
 	"clouditor.io/clouditor/v2/api/discovery"
-	"clouditor.io/clouditor/v2/api/ontology"
 	"clouditor.io/clouditor/v2/persistence"
 	"clouditor.io/clouditor/v2/service"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+const (
+	// defaultQueryLimit is the default maximum number of paths returned by QueryGraph
+	defaultQueryLimit = 100
+
+	// defaultQueryMaxDepth is the default maximum number of edges matched by a variable-length edge in QueryGraph
+	defaultQueryMaxDepth = 5
+)
+
 func (svc *Service) ListGraphEdges(ctx context.Context, req *discovery.ListGraphEdgesRequest) (res *discovery.ListGraphEdgesResponse, err error) {
 	var (
 		results []*discovery.Resource
+		derived []*discovery.GraphEdge
+		types   map[string]*discovery.CustomResourceType
+		ids     []string
 		all     bool
 		allowed []string
 		query   []string
 		args    []any
 	)
 
-	// Validate request
-	err = api.Validate(req)
-	if err != nil {
-		return nil, err
+	// Filtering the underlying resources, the same way as in ListResources
+	if req.Filter != nil {
+		if !svc.authz.CheckAccess(ctx, service.AccessRead, req.Filter) {
+			return nil, service.ErrPermissionDenied
+		}
+
+		query, args = applyResourceFilter(req.Filter, query, args)
 	}
 
 	all, allowed = svc.authz.AllowedCloudServices(ctx)
@@ -61,8 +74,8 @@ func (svc *Service) ListGraphEdges(ctx context.Context, req *discovery.ListGraph
 	res = new(discovery.ListGraphEdgesResponse)
 
 	// This is a little problematic, since we are actually paginating the underlying resources and not the edges, but it
-	// is probably the best we can do for now while we are not storing the edges in the database.
-	results, res.NextPageToken, err = service.PaginateStorage[*discovery.Resource](req,
+	// is probably the best we can do for now while we are only storing the derived edges in the database.
+	results, res.NextPageToken, err = service.PaginateStorageWithCursor[*discovery.Resource](req,
 		svc.storage,
 		service.DefaultPaginationOpts,
 		persistence.BuildConds(query, args)...,
@@ -73,37 +86,96 @@ func (svc *Service) ListGraphEdges(ctx context.Context, req *discovery.ListGraph
 
 	// Loop through all resources and find edges to others
 	for _, resource := range results {
+		ids = append(ids, resource.Id)
+
 		r, _ := resource.ToOntologyResource()
-		if r == nil {
+		if r != nil {
+			res.Edges = append(res.Edges, graphEdges(resource.Id, r)...)
 			continue
 		}
 
-		for _, rel := range ontology.Related(r) {
-			edge := &discovery.GraphEdge{
-				Id:     resource.Id + "-" + rel.Value,
-				Source: resource.Id,
-				Target: rel.Value,
-				Type:   rel.Property,
+		// Otherwise, the resource might be of a custom resource type, whose relationships we need to look up
+		if _, ok := resource.CustomProperties(); ok && types == nil {
+			types, err = svc.customResourceTypes()
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "database error: %v", err)
 			}
+		}
 
-			res.Edges = append(res.Edges, edge)
+		res.Edges = append(res.Edges, customEdges(resource, types)...)
+	}
+
+	// Add the stored derived edges, e.g., "publicly_reachable", pointing to the resources
+	if len(ids) > 0 {
+		err = svc.storage.List(&derived, "id", true, 0, -1, "target IN ?", ids)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "database error: %v", err)
 		}
+
+		res.Edges = append(res.Edges, derived...)
 	}
 
 	return
 }
 
-func (svc *Service) UpdateResource(ctx context.Context, req *discovery.UpdateResourceRequest) (res *discovery.Resource, err error) {
-	// Validate request
-	err = api.Validate(req)
+// QueryGraph returns the paths in the resource graph that match the query. The query is executed on an in-memory
+// index of the resource graph, see [parseGraphQuery] for its syntax. Only resources of cloud services the user has
+// access to are part of the paths.
+func (svc *Service) QueryGraph(ctx context.Context, req *discovery.QueryGraphRequest) (res *discovery.QueryGraphResponse, err error) {
+	var (
+		q        *graphQuery
+		all      bool
+		allowed  []string
+		limit    = defaultQueryLimit
+		maxDepth = defaultQueryMaxDepth
+	)
+
+	if req.CloudServiceId != nil && !svc.authz.CheckAccess(ctx, service.AccessRead, req) {
+		return nil, service.ErrPermissionDenied
+	}
+
+	q, err = parseGraphQuery(req.Query)
 	if err != nil {
-		return nil, err
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
 
+	if req.Limit > 0 {
+		limit = int(req.Limit)
+	}
+
+	if req.MaxDepth > 0 {
+		maxDepth = int(req.MaxDepth)
+	}
+
+	err = svc.graph.load(svc.storage)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
+	all, allowed = svc.authz.AllowedCloudServices(ctx)
+
+	res = new(discovery.QueryGraphResponse)
+	res.Paths, res.Truncated = svc.graph.query(q, func(r *discovery.Resource) bool {
+		if req.CloudServiceId != nil && r.CloudServiceId != req.GetCloudServiceId() {
+			return false
+		}
+
+		return all || slices.Contains(allowed, r.CloudServiceId)
+	}, maxDepth, limit)
+
+	return
+}
+
+func (svc *Service) UpdateResource(ctx context.Context, req *discovery.UpdateResourceRequest) (res *discovery.Resource, err error) {
 	if !svc.authz.CheckAccess(ctx, service.AccessRead, req) {
 		return nil, service.ErrPermissionDenied
 	}
 
+	err = svc.checkCustomResource(req.Resource)
+	if err != nil {
+		return nil, err
+	}
+
 	err = svc.storage.Save(&req.Resource, "id = ?", req.Resource.Id)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "database error: %v", err)
@@ -111,5 +183,13 @@ func (svc *Service) UpdateResource(ctx context.Context, req *discovery.UpdateRes
 
 	res = req.Resource
 
+	svc.graph.put(res)
+
+	err = svc.updateDerivedEdges(res.CloudServiceId)
+	if err != nil {
+		log.Errorf("Could not update derived edges of the resource graph: %v", err)
+		err = nil
+	}
+
 	return
 }
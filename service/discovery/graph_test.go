@@ -76,6 +76,72 @@ func TestService_ListGraphEdges(t *testing.T) {
 				return assert.ErrorContains(t, err, "empty request")
 			},
 		},
+		{
+			name:   "invalid order by",
+			fields: fields{},
+			args: args{
+				req: &discovery.ListGraphEdgesRequest{OrderBy: "properties"},
+			},
+			wantErr: func(tt assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorContains(t, err, "order_by: value must be in list")
+			},
+		},
+		{
+			name: "filter not allowed",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategy(false, testdata.MockCloudServiceID1),
+			},
+			args: args{
+				req: &discovery.ListGraphEdgesRequest{
+					Filter: &discovery.ListResourcesRequest_Filter{
+						CloudServiceId: util.Ref(testdata.MockCloudServiceID2),
+					},
+				},
+			},
+			wantErr: func(tt assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorIs(t, err, service.ErrPermissionDenied)
+			},
+		},
+		{
+			name: "filter by cloud service and type",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategy(true),
+				storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					assert.NoError(t, s.Create(
+						panicToDiscoveryResource(t, &ontology.ObjectStorage{
+							Id:       "some-id",
+							Name:     "some-name",
+							ParentId: util.Ref("some-storage-account-id"),
+						}, testdata.MockCloudServiceID1)))
+					assert.NoError(t, s.Create(
+						panicToDiscoveryResource(t, &ontology.ObjectStorage{
+							Id:       "some-other-id",
+							Name:     "some-other-name",
+							ParentId: util.Ref("some-other-storage-account-id"),
+						}, testdata.MockCloudServiceID2)))
+				}),
+			},
+			args: args{
+				req: &discovery.ListGraphEdgesRequest{
+					Filter: &discovery.ListResourcesRequest_Filter{
+						CloudServiceId: util.Ref(testdata.MockCloudServiceID1),
+						Type:           util.Ref("ObjectStorage"),
+					},
+				},
+			},
+			wantRes: &discovery.ListGraphEdgesResponse{
+				Edges: []*discovery.GraphEdge{
+					{
+						Id:           "some-id-some-storage-account-id",
+						Source:       "some-id",
+						Target:       "some-storage-account-id",
+						Type:         "parent",
+						Relationship: "parent",
+					},
+				},
+			},
+			wantErr: assert.NoError,
+		},
 		{
 			name: "only allowed cloud service",
 			fields: fields{
@@ -106,7 +172,15 @@ func TestService_ListGraphEdges(t *testing.T) {
 				req: &discovery.ListGraphEdgesRequest{},
 			},
 			wantRes: &discovery.ListGraphEdgesResponse{
-				Edges: []*discovery.GraphEdge{},
+				Edges: []*discovery.GraphEdge{
+					{
+						Id:           "some-storage-account-id-some-id",
+						Source:       "some-storage-account-id",
+						Target:       "some-id",
+						Type:         "storage",
+						Relationship: "stores_data_in",
+					},
+				},
 			},
 			wantErr: assert.NoError,
 		},
@@ -142,10 +216,56 @@ func TestService_ListGraphEdges(t *testing.T) {
 			wantRes: &discovery.ListGraphEdgesResponse{
 				Edges: []*discovery.GraphEdge{
 					{
-						Id:     "some-id-some-storage-account-id",
-						Source: "some-id",
-						Target: "some-storage-account-id",
-						Type:   "parent",
+						Id:           "some-id-some-storage-account-id",
+						Source:       "some-id",
+						Target:       "some-storage-account-id",
+						Type:         "parent",
+						Relationship: "parent",
+					},
+					{
+						Id:           "some-storage-account-id-some-id",
+						Source:       "some-storage-account-id",
+						Target:       "some-id",
+						Type:         "storage",
+						Relationship: "stores_data_in",
+					},
+				},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "with derived edges",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategy(true),
+				storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					assert.NoError(t, s.Create(
+						panicToDiscoveryResource(t, &ontology.VirtualMachine{
+							Id:                         "my-vm",
+							Name:                       "my-vm",
+							InternetAccessibleEndpoint: true,
+						}, testdata.MockCloudServiceID1)))
+					assert.NoError(t, s.Create(&discovery.GraphEdge{
+						Id:             "internet-publicly_reachable-my-vm",
+						Source:         InternetNodeID,
+						Target:         "my-vm",
+						Type:           EdgeTypePubliclyReachable,
+						Derived:        true,
+						CloudServiceId: testdata.MockCloudServiceID1,
+					}))
+				}),
+			},
+			args: args{
+				req: &discovery.ListGraphEdgesRequest{},
+			},
+			wantRes: &discovery.ListGraphEdgesResponse{
+				Edges: []*discovery.GraphEdge{
+					{
+						Id:             "internet-publicly_reachable-my-vm",
+						Source:         InternetNodeID,
+						Target:         "my-vm",
+						Type:           EdgeTypePubliclyReachable,
+						Derived:        true,
+						CloudServiceId: testdata.MockCloudServiceID1,
 					},
 				},
 			},
@@ -164,7 +284,7 @@ func TestService_ListGraphEdges(t *testing.T) {
 				Events:            tt.fields.Events,
 				csID:              tt.fields.csID,
 			}
-			gotRes, err := svc.ListGraphEdges(tt.args.ctx, tt.args.req)
+			gotRes, err := servicetest.Validated(svc.ListGraphEdges)(tt.args.ctx, tt.args.req)
 
 			assert.Empty(t, cmp.Diff(gotRes, tt.wantRes, protocmp.Transform()))
 			tt.wantErr(t, err)
@@ -201,6 +321,18 @@ func TestService_UpdateResource(t *testing.T) {
 		wantRes *discovery.Resource
 		wantErr assert.ErrorAssertionFunc
 	}{
+		{
+			name: "missing resource",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &discovery.UpdateResourceRequest{},
+			},
+			wantErr: func(tt assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorContains(t, err, "resource: value is required")
+			},
+		},
 		{
 			name: "validation failed",
 			fields: fields{
@@ -267,7 +399,7 @@ func TestService_UpdateResource(t *testing.T) {
 				Events:            tt.fields.Events,
 				csID:              tt.fields.csID,
 			}
-			gotRes, err := svc.UpdateResource(tt.args.ctx, tt.args.req)
+			gotRes, err := servicetest.Validated(svc.UpdateResource)(tt.args.ctx, tt.args.req)
 			assert.Empty(t, cmp.Diff(gotRes, tt.wantRes, protocmp.Transform()))
 			tt.wantErr(t, err)
 		})
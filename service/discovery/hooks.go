@@ -0,0 +1,180 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package discovery
+
+import (
+	"slices"
+	"strings"
+
+	"clouditor.io/clouditor/v2/api/ontology"
+)
+
+const (
+	// LabelDataClassification is the label (or tag) of a storage containing the classification level of its data,
+	// e.g., "confidential".
+	LabelDataClassification = "data-classification"
+
+	// LabelDataCategories is the label of a storage containing a comma-separated list of the categories of its data,
+	// e.g., "personal,health".
+	LabelDataCategories = "data-categories"
+
+	// LabelDataAsset is the label of a storage containing the name of the data asset stored in it. Storages with the
+	// same data asset are combined into one [ontology.DataAsset]. If it is missing, each classified storage contains
+	// its own data asset.
+	LabelDataAsset = "data-asset"
+)
+
+// dataClassificationLevels orders the well-known classification levels from least to most restrictive. If the
+// storages of a data asset are classified differently, the most restrictive level is used.
+var dataClassificationLevels = []string{"public", "internal", "confidential", "restricted"}
+
+// ResourceHook is invoked with the resources of a discoverer after each discovery, before they are stored and sent to
+// the assessment. It returns the resources, which can be enriched or extended by additional resources, e.g., data
+// assets derived from the discovered ones.
+type ResourceHook func(list []ontology.IsResource) []ontology.IsResource
+
+// WithResourceHooks is an option to add hooks, which are invoked after each discovery in addition to
+// [NormalizeGeoLocations] and [DataAssetsFromLabels].
+func WithResourceHooks(hooks ...ResourceHook) ServiceOption {
+	return func(s *Service) {
+		s.hooks = append(s.hooks, hooks...)
+	}
+}
+
+// NormalizeGeoLocations is a [ResourceHook] that sets the country of the geo location of each resource and whether it
+// is located in the European Economic Area, so that data residency can be assessed independently of the region names
+// of the cloud providers. See [ontology.GeoLocation.Normalize].
+func NormalizeGeoLocations(list []ontology.IsResource) []ontology.IsResource {
+	for _, r := range list {
+		if l, ok := r.(interface{ GetGeoLocation() *ontology.GeoLocation }); ok {
+			l.GetGeoLocation().Normalize()
+		}
+
+		// Data assets are located in all locations of their storages
+		if a, ok := r.(*ontology.DataAsset); ok {
+			for _, g := range a.GetGeoLocations() {
+				g.Normalize()
+			}
+		}
+	}
+
+	return list
+}
+
+// DataAssetsFromLabels is a [ResourceHook] that adds a [ontology.DataAsset] for the storages that are classified using
+// the [LabelDataClassification] label. The data asset contains the classification, the storages and their
+// locations.
+func DataAssetsFromLabels(list []ontology.IsResource) []ontology.IsResource {
+	var (
+		assets = make(map[string]*ontology.DataAsset)
+		order  []string
+	)
+
+	for _, r := range list {
+		if !ontology.HasType(r, "Storage") {
+			continue
+		}
+
+		labels := labelsOf(r)
+
+		level, ok := labels[LabelDataClassification]
+		if !ok {
+			continue
+		}
+
+		name := labels[LabelDataAsset]
+		id := "data-asset:" + name
+		if name == "" {
+			id, name = "data-asset:"+r.GetId(), r.GetName()
+		}
+
+		asset, ok := assets[id]
+		if !ok {
+			asset = &ontology.DataAsset{
+				Id:                 id,
+				Name:               name,
+				DataClassification: &ontology.DataClassification{},
+			}
+			assets[id] = asset
+			order = append(order, id)
+		}
+
+		asset.StorageIds = append(asset.StorageIds, r.GetId())
+		asset.DataClassification.Level = moreRestrictive(asset.DataClassification.Level, strings.TrimSpace(level))
+
+		for _, c := range strings.Split(labels[LabelDataCategories], ",") {
+			c = strings.TrimSpace(c)
+			if c != "" && !slices.Contains(asset.DataClassification.Categories, c) {
+				asset.DataClassification.Categories = append(asset.DataClassification.Categories, c)
+			}
+		}
+
+		if l, ok := r.(interface{ GetGeoLocation() *ontology.GeoLocation }); ok && l.GetGeoLocation() != nil &&
+			!slices.ContainsFunc(asset.GeoLocations, func(g *ontology.GeoLocation) bool {
+				return g.Region == l.GetGeoLocation().Region
+			}) {
+			asset.GeoLocations = append(asset.GeoLocations, l.GetGeoLocation())
+		}
+	}
+
+	for _, id := range order {
+		list = append(list, assets[id])
+	}
+
+	return list
+}
+
+// labelsOf returns the labels of the resource, if it has any.
+func labelsOf(r ontology.IsResource) map[string]string {
+	if l, ok := r.(interface{ GetLabels() map[string]string }); ok {
+		return l.GetLabels()
+	}
+
+	return nil
+}
+
+// moreRestrictive returns the more restrictive of both classification levels. Unknown levels are considered to be
+// more restrictive than the well-known ones, so they are not lost.
+func moreRestrictive(a string, b string) string {
+	rank := func(level string) int {
+		if level == "" {
+			return -1
+		}
+
+		i := slices.Index(dataClassificationLevels, level)
+		if i == -1 {
+			return len(dataClassificationLevels)
+		}
+
+		return i
+	}
+
+	if rank(b) > rank(a) {
+		return b
+	}
+
+	return a
+}
@@ -0,0 +1,152 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package discovery
+
+import (
+	"testing"
+
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+)
+
+func TestNormalizeGeoLocations(t *testing.T) {
+	list := []ontology.IsResource{
+		&ontology.ObjectStorage{Id: "bucket", GeoLocation: &ontology.GeoLocation{Region: "eu-central-1"}},
+		&ontology.VirtualMachine{Id: "vm", GeoLocation: &ontology.GeoLocation{Region: "eastus"}},
+		&ontology.DataAsset{Id: "asset", GeoLocations: []*ontology.GeoLocation{{Region: "europe-west1"}}},
+		&ontology.Account{Id: "account"},
+	}
+
+	got := NormalizeGeoLocations(list)
+	assert.Equal(t, []ontology.IsResource{
+		&ontology.ObjectStorage{Id: "bucket", GeoLocation: &ontology.GeoLocation{Region: "eu-central-1", Country: "DE", Eea: true}},
+		&ontology.VirtualMachine{Id: "vm", GeoLocation: &ontology.GeoLocation{Region: "eastus", Country: "US"}},
+		&ontology.DataAsset{Id: "asset", GeoLocations: []*ontology.GeoLocation{{Region: "europe-west1", Country: "BE", Eea: true}}},
+		&ontology.Account{Id: "account"},
+	}, got)
+}
+
+func TestDataAssetsFromLabels(t *testing.T) {
+	var (
+		eu = &ontology.GeoLocation{Region: "westeurope"}
+		us = &ontology.GeoLocation{Region: "eastus"}
+	)
+
+	tests := []struct {
+		name string
+		list []ontology.IsResource
+		want []ontology.IsResource
+	}{
+		{
+			name: "no classified storages",
+			list: []ontology.IsResource{
+				&ontology.ObjectStorage{Id: "bucket", Labels: map[string]string{"env": "prod"}},
+				&ontology.VirtualMachine{Id: "vm", Labels: map[string]string{LabelDataClassification: "confidential"}},
+			},
+			want: []ontology.IsResource{
+				&ontology.ObjectStorage{Id: "bucket", Labels: map[string]string{"env": "prod"}},
+				&ontology.VirtualMachine{Id: "vm", Labels: map[string]string{LabelDataClassification: "confidential"}},
+			},
+		},
+		{
+			name: "storage without data asset label",
+			list: []ontology.IsResource{
+				&ontology.ObjectStorage{Id: "bucket", Name: "Bucket", GeoLocation: eu, Labels: map[string]string{
+					LabelDataClassification: "internal",
+					LabelDataCategories:     "personal, health",
+				}},
+			},
+			want: []ontology.IsResource{
+				&ontology.ObjectStorage{Id: "bucket", Name: "Bucket", GeoLocation: eu, Labels: map[string]string{
+					LabelDataClassification: "internal",
+					LabelDataCategories:     "personal, health",
+				}},
+				&ontology.DataAsset{
+					Id:   "data-asset:bucket",
+					Name: "Bucket",
+					DataClassification: &ontology.DataClassification{
+						Level:      "internal",
+						Categories: []string{"personal", "health"},
+					},
+					GeoLocations: []*ontology.GeoLocation{eu},
+					StorageIds:   []string{"bucket"},
+				},
+			},
+		},
+		{
+			name: "storages of the same data asset",
+			list: []ontology.IsResource{
+				&ontology.ObjectStorage{Id: "bucket", GeoLocation: eu, Labels: map[string]string{
+					LabelDataClassification: "internal",
+					LabelDataAsset:          "customers",
+				}},
+				&ontology.DatabaseStorage{Id: "db", GeoLocation: us, Labels: map[string]string{
+					LabelDataClassification: "confidential",
+					LabelDataAsset:          "customers",
+				}},
+				&ontology.BlockStorage{Id: "disk", GeoLocation: eu, Labels: map[string]string{
+					LabelDataClassification: "public",
+					LabelDataAsset:          "customers",
+				}},
+			},
+			want: []ontology.IsResource{
+				&ontology.ObjectStorage{Id: "bucket", GeoLocation: eu, Labels: map[string]string{
+					LabelDataClassification: "internal",
+					LabelDataAsset:          "customers",
+				}},
+				&ontology.DatabaseStorage{Id: "db", GeoLocation: us, Labels: map[string]string{
+					LabelDataClassification: "confidential",
+					LabelDataAsset:          "customers",
+				}},
+				&ontology.BlockStorage{Id: "disk", GeoLocation: eu, Labels: map[string]string{
+					LabelDataClassification: "public",
+					LabelDataAsset:          "customers",
+				}},
+				&ontology.DataAsset{
+					Id:                 "data-asset:customers",
+					Name:               "customers",
+					DataClassification: &ontology.DataClassification{Level: "confidential"},
+					GeoLocations:       []*ontology.GeoLocation{eu, us},
+					StorageIds:         []string{"bucket", "db", "disk"},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := DataAssetsFromLabels(tt.list)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_moreRestrictive(t *testing.T) {
+	assert.Equal(t, "internal", moreRestrictive("", "internal"))
+	assert.Equal(t, "confidential", moreRestrictive("internal", "confidential"))
+	assert.Equal(t, "confidential", moreRestrictive("confidential", "public"))
+	assert.Equal(t, "top-secret", moreRestrictive("restricted", "top-secret"))
+	assert.Equal(t, "top-secret", moreRestrictive("top-secret", "restricted"))
+}
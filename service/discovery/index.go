@@ -0,0 +1,324 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package discovery
+
+import (
+	"slices"
+	"strings"
+	"sync"
+
+	"clouditor.io/clouditor/v2/api/discovery"
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/persistence"
+)
+
+// graphIndex is an in-memory index of the resource graph, i.e., the discovered resources and the edges between
+// them, which is used to execute graph queries. It is built from the storage once it is needed and afterward kept up
+// to date with the resources saved by the discovery.
+type graphIndex struct {
+	mu     sync.RWMutex
+	loaded bool
+
+	nodes map[string]*graphNode
+
+	// types contains the registered custom resource types, which are needed for the edges of their resources
+	types map[string]*discovery.CustomResourceType
+
+	// out contains the edges of a resource and in the edges of other resources that point to it
+	out map[string][]*discovery.GraphEdge
+	in  map[string][]*discovery.GraphEdge
+}
+
+// graphNode is a resource in the [graphIndex].
+type graphNode struct {
+	resource *discovery.Resource
+	types    []string
+
+	// props are the properties of the resource, based on its JSON representation
+	props map[string]any
+}
+
+// newGraphIndex creates a new, empty [graphIndex].
+func newGraphIndex() *graphIndex {
+	return &graphIndex{
+		nodes: make(map[string]*graphNode),
+		types: make(map[string]*discovery.CustomResourceType),
+		out:   make(map[string][]*discovery.GraphEdge),
+		in:    make(map[string][]*discovery.GraphEdge),
+	}
+}
+
+// load builds the index from all resources in the storage, if this was not done yet.
+func (idx *graphIndex) load(storage persistence.Storage) (err error) {
+	var (
+		resources []*discovery.Resource
+		types     []*discovery.CustomResourceType
+	)
+
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	if idx.loaded {
+		return nil
+	}
+
+	err = storage.List(&types, "", true, 0, -1)
+	if err != nil {
+		return err
+	}
+
+	for _, t := range types {
+		idx.types[t.Name] = t
+	}
+
+	err = storage.List(&resources, "", true, 0, -1)
+	if err != nil {
+		return err
+	}
+
+	for _, r := range resources {
+		idx.putLocked(r)
+	}
+
+	idx.loaded = true
+
+	return nil
+}
+
+// put adds the resource to the index or replaces its previous state, if the index was already built.
+func (idx *graphIndex) put(r *discovery.Resource) {
+	if idx == nil {
+		return
+	}
+
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	// Otherwise, the resource is added once the index is built from the storage
+	if !idx.loaded {
+		return
+	}
+
+	idx.putLocked(r)
+}
+
+// putType adds or replaces a custom resource type and updates the edges of its resources, if the index was already
+// built.
+func (idx *graphIndex) putType(t *discovery.CustomResourceType) {
+	if idx == nil {
+		return
+	}
+
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	if !idx.loaded {
+		return
+	}
+
+	idx.types[t.Name] = t
+
+	for _, node := range idx.nodes {
+		if len(node.types) > 0 && node.types[0] == t.Name {
+			idx.putLocked(node.resource)
+		}
+	}
+}
+
+// putLocked adds the resource to the index. It needs to be called while holding the lock.
+func (idx *graphIndex) putLocked(r *discovery.Resource) {
+	var (
+		node  = &graphNode{resource: r}
+		edges []*discovery.GraphEdge
+	)
+
+	// Remove the previous edges of the resource
+	for _, e := range idx.out[r.Id] {
+		idx.in[e.Target] = slices.DeleteFunc(idx.in[e.Target], func(other *discovery.GraphEdge) bool {
+			return other.Source == r.Id
+		})
+	}
+	delete(idx.out, r.Id)
+
+	or, err := r.ToOntologyResource()
+	if err == nil {
+		node.types = ontology.ResourceTypes(or)
+		node.props, _ = ontology.ResourceMap(or)
+		edges = graphEdges(r.Id, or)
+	} else if props, ok := r.CustomProperties(); ok {
+		// Resources of custom resource types can be matched by their properties as well
+		node.types = strings.Split(r.ResourceType, ",")
+		node.props = props.AsMap()
+		edges = customEdges(r, idx.types)
+	} else {
+		// We can still match the resource by its type, but not by its properties
+		log.Debugf("Could not convert resource %s for the graph index: %v", r.Id, err)
+		node.types = strings.Split(r.ResourceType, ",")
+	}
+
+	idx.nodes[r.Id] = node
+
+	for _, e := range edges {
+		idx.out[r.Id] = append(idx.out[r.Id], e)
+		idx.in[e.Target] = append(idx.in[e.Target], e)
+	}
+}
+
+// graphEdges returns the edges of the resource with the given ID to its related resources.
+func graphEdges(id string, r ontology.IsResource) (edges []*discovery.GraphEdge) {
+	for _, rel := range ontology.Related(r) {
+		edges = append(edges, &discovery.GraphEdge{
+			Id:           id + "-" + rel.Value,
+			Source:       id,
+			Target:       rel.Value,
+			Type:         rel.Property,
+			Relationship: string(rel.Type),
+		})
+	}
+
+	return
+}
+
+// querySearch contains the state of the search for the paths matching a query.
+type querySearch struct {
+	idx      *graphIndex
+	q        *graphQuery
+	visible  func(r *discovery.Resource) bool
+	maxDepth int
+	limit    int
+
+	// ids and edges describe the current path
+	ids   []string
+	edges []*discovery.GraphEdge
+
+	paths     []*discovery.GraphPath
+	truncated bool
+}
+
+// query returns the simple paths, i.e., paths without cycles, that match the query. Only resources for which visible
+// returns true are part of the paths. Variable-length edges match up to maxDepth edges. At most limit paths are
+// returned; truncated indicates whether more paths match.
+func (idx *graphIndex) query(q *graphQuery, visible func(r *discovery.Resource) bool, maxDepth int, limit int) (paths []*discovery.GraphPath, truncated bool) {
+	var ids []string
+
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	s := &querySearch{idx: idx, q: q, visible: visible, maxDepth: maxDepth, limit: limit}
+
+	// Start in a stable order, so that the results are stable as well
+	for id := range idx.nodes {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+
+	for _, id := range ids {
+		node := idx.nodes[id]
+		if !visible(node.resource) || !q.nodes[0].matches(node.types, node.props) {
+			continue
+		}
+
+		s.ids = []string{id}
+		if !s.match(0) {
+			break
+		}
+	}
+
+	return s.paths, s.truncated
+}
+
+// match continues the current path, whose last resource matches the i-th node pattern, with the i-th edge pattern.
+// It returns false, once the search is done because the limit is reached.
+func (s *querySearch) match(i int) bool {
+	if i == len(s.q.edges) {
+		if len(s.paths) == s.limit {
+			s.truncated = true
+			return false
+		}
+
+		s.paths = append(s.paths, &discovery.GraphPath{
+			ResourceIds: slices.Clone(s.ids),
+			Edges:       slices.Clone(s.edges),
+		})
+		return true
+	}
+
+	return s.follow(i, 1)
+}
+
+// follow continues the current path with the edges matching the i-th edge pattern. These are depth edges into a
+// variable-length edge pattern.
+func (s *querySearch) follow(i int, depth int) bool {
+	var (
+		edge = s.q.edges[i]
+		next = s.q.nodes[i+1]
+		last = s.ids[len(s.ids)-1]
+	)
+
+	for _, e := range s.idx.neighbors(last, edge.dir) {
+		if edge.typ != "" && e.Type != edge.typ {
+			continue
+		}
+
+		id := e.Target
+		if id == last {
+			id = e.Source
+		}
+
+		node, ok := s.idx.nodes[id]
+		if !ok || !s.visible(node.resource) || slices.Contains(s.ids, id) {
+			continue
+		}
+
+		s.ids = append(s.ids, id)
+		s.edges = append(s.edges, e)
+
+		if next.matches(node.types, node.props) && !s.match(i+1) {
+			return false
+		}
+
+		if edge.variable && depth < s.maxDepth && !s.follow(i, depth+1) {
+			return false
+		}
+
+		s.ids = s.ids[:len(s.ids)-1]
+		s.edges = s.edges[:len(s.edges)-1]
+	}
+
+	return true
+}
+
+// neighbors returns the edges of the resource with the given ID in the given direction.
+func (idx *graphIndex) neighbors(id string, dir direction) []*discovery.GraphEdge {
+	switch dir {
+	case outgoing:
+		return idx.out[id]
+	case incoming:
+		return idx.in[id]
+	default:
+		return append(slices.Clone(idx.out[id]), idx.in[id]...)
+	}
+}
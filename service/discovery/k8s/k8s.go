@@ -75,3 +75,19 @@ func AuthFromKubeConfig() (intf kubernetes.Interface, err error) {
 
 	return client, nil
 }
+
+// AuthFromKubeConfigData creates a client from the given kubeconfig, e.g., one retrieved from a secret store, instead
+// of reading it from the home directory.
+func AuthFromKubeConfigData(data []byte) (intf kubernetes.Interface, err error) {
+	config, err := clientcmd.RESTConfigFromKubeConfig(data)
+	if err != nil {
+		return nil, fmt.Errorf("could not read kubeconfig: %w", err)
+	}
+
+	client, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("could not create client: %w", err)
+	}
+
+	return client, nil
+}
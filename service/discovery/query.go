@@ -0,0 +1,440 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package discovery
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// ErrInvalidQuery indicates that a graph query could not be parsed.
+var ErrInvalidQuery = errors.New("invalid query")
+
+// direction is the direction in which an edge of the resource graph is traversed.
+type direction int
+
+const (
+	// outgoing follows an edge from its source to its target, e.g., from a VM to its block storage
+	outgoing direction = iota
+
+	// incoming follows an edge from its target to its source
+	incoming
+
+	// both follows an edge in either direction
+	both
+)
+
+// graphQuery is a parsed path expression. It consists of node patterns, each connected to the next one by an edge
+// pattern, so there is one edge less than nodes.
+type graphQuery struct {
+	nodes []*nodePattern
+	edges []*edgePattern
+}
+
+// nodePattern matches resources by their type and properties.
+type nodePattern struct {
+	// typ is one of the resource types, e.g., "VirtualMachine" or "Compute". If empty, all types match.
+	typ string
+
+	// conds need to be fulfilled all by the properties of the resource
+	conds []*condition
+}
+
+// edgePattern matches one or, if variable, multiple consecutive edges.
+type edgePattern struct {
+	dir direction
+
+	// typ is the type of the edge, e.g., "parent" or "network_interface". If empty, all types match.
+	typ string
+
+	// variable matches paths of one up to the maximum depth of edges
+	variable bool
+}
+
+// condition checks a property of a resource. Without an operator, the property needs to be set, i.e., not null,
+// false, zero or empty.
+type condition struct {
+	// path are the keys of the property in the JSON representation of the resource, e.g.,
+	// ["accessRestriction", "webApplicationFirewall", "enabled"]
+	path []string
+
+	// op is either empty, "=" or "!="
+	op string
+
+	// value is a string, float64, bool or nil
+	value any
+
+	negate bool
+}
+
+// parseGraphQuery parses a path expression of the following form:
+//
+//	query     = node { edge node }
+//	node      = ( type | "*" ) [ "[" condition { "and" condition } "]" ]
+//	condition = [ "not" ] property [ ( "=" | "!=" ) value ]
+//	edge      = "->" | "<-" | "--" | "-[" spec "]->" | "<-[" spec "]-" | "-[" spec "]-"
+//	spec      = [ edgeType ] [ "*" ]
+//
+// A property is a dot-separated path of the keys of the JSON representation of a resource, e.g.,
+// "accessRestriction.webApplicationFirewall.enabled". Values are double-quoted strings, numbers, true, false or
+// null. A "*" in an edge spec matches multiple consecutive edges of the given type, e.g.,
+// "LoadBalancer[internetAccessibleEndpoint] -[*]- VirtualMachine".
+func parseGraphQuery(s string) (q *graphQuery, err error) {
+	var (
+		p    = &queryParser{s: s}
+		node *nodePattern
+		edge *edgePattern
+	)
+
+	q = new(graphQuery)
+
+	if node, err = p.parseNode(); err != nil {
+		return nil, err
+	}
+	q.nodes = append(q.nodes, node)
+
+	for !p.eof() {
+		if edge, err = p.parseEdge(); err != nil {
+			return nil, err
+		}
+
+		if node, err = p.parseNode(); err != nil {
+			return nil, err
+		}
+
+		q.edges = append(q.edges, edge)
+		q.nodes = append(q.nodes, node)
+	}
+
+	return q, nil
+}
+
+// queryParser is a simple recursive descent parser for path expressions.
+type queryParser struct {
+	s   string
+	pos int
+}
+
+// errorf returns an [ErrInvalidQuery] error with the current position.
+func (p *queryParser) errorf(format string, args ...any) error {
+	return fmt.Errorf("%w: %s at position %d", ErrInvalidQuery, fmt.Sprintf(format, args...), p.pos+1)
+}
+
+// skipSpace skips all white space at the current position.
+func (p *queryParser) skipSpace() {
+	for p.pos < len(p.s) && unicode.IsSpace(rune(p.s[p.pos])) {
+		p.pos++
+	}
+}
+
+// eof skips all white space and checks, whether the end of the query is reached.
+func (p *queryParser) eof() bool {
+	p.skipSpace()
+
+	return p.pos >= len(p.s)
+}
+
+// consume skips all white space and consumes prefix, if the rest of the query starts with it.
+func (p *queryParser) consume(prefix string) bool {
+	p.skipSpace()
+
+	if strings.HasPrefix(p.s[p.pos:], prefix) {
+		p.pos += len(prefix)
+		return true
+	}
+
+	return false
+}
+
+// ident skips all white space and consumes an identifier, i.e., letters, digits, underscores and, if dotted,
+// dots. It returns an empty string, if there is none.
+func (p *queryParser) ident(dotted bool) string {
+	p.skipSpace()
+
+	start := p.pos
+	for p.pos < len(p.s) {
+		c := rune(p.s[p.pos])
+		if !unicode.IsLetter(c) && !unicode.IsDigit(c) && c != '_' && (!dotted || c != '.') {
+			break
+		}
+		p.pos++
+	}
+
+	return p.s[start:p.pos]
+}
+
+// keyword consumes the given keyword (case-insensitive), if it is the next identifier.
+func (p *queryParser) keyword(kw string) bool {
+	start := p.pos
+
+	if strings.EqualFold(p.ident(false), kw) {
+		return true
+	}
+
+	p.pos = start
+	return false
+}
+
+// parseNode parses a node pattern.
+func (p *queryParser) parseNode() (node *nodePattern, err error) {
+	var cond *condition
+
+	node = new(nodePattern)
+
+	if !p.consume("*") {
+		node.typ = p.ident(false)
+		if node.typ == "" {
+			return nil, p.errorf("expected resource type or *")
+		}
+	}
+
+	if !p.consume("[") {
+		return node, nil
+	}
+
+	for {
+		if cond, err = p.parseCondition(); err != nil {
+			return nil, err
+		}
+		node.conds = append(node.conds, cond)
+
+		if p.consume("]") {
+			return node, nil
+		} else if !p.keyword("and") {
+			return nil, p.errorf("expected \"and\" or ]")
+		}
+	}
+}
+
+// parseCondition parses a condition of a node pattern.
+func (p *queryParser) parseCondition() (cond *condition, err error) {
+	cond = new(condition)
+	cond.negate = p.keyword("not")
+
+	property := p.ident(true)
+	if property == "" {
+		return nil, p.errorf("expected property")
+	}
+	cond.path = strings.Split(property, ".")
+
+	if slices.Contains(cond.path, "") {
+		return nil, p.errorf("invalid property %q", property)
+	}
+
+	if p.consume("!=") {
+		cond.op = "!="
+	} else if p.consume("=") {
+		cond.op = "="
+	} else {
+		return cond, nil
+	}
+
+	if cond.value, err = p.parseValue(); err != nil {
+		return nil, err
+	}
+
+	return cond, nil
+}
+
+// parseValue parses a string, number, boolean or null value.
+func (p *queryParser) parseValue() (value any, err error) {
+	p.skipSpace()
+
+	if strings.HasPrefix(p.s[p.pos:], `"`) {
+		// Look for the closing quote, which is not escaped
+		end := p.pos + 1
+		for end < len(p.s) && p.s[end] != '"' {
+			if p.s[end] == '\\' {
+				end++
+			}
+			end++
+		}
+
+		if end >= len(p.s) {
+			return nil, p.errorf("unterminated string")
+		}
+
+		value, err = strconv.Unquote(p.s[p.pos : end+1])
+		if err != nil {
+			return nil, p.errorf("invalid string")
+		}
+
+		p.pos = end + 1
+		return value, nil
+	}
+
+	start := p.pos
+	for p.pos < len(p.s) && strings.ContainsRune("+-.0123456789eE", rune(p.s[p.pos])) {
+		p.pos++
+	}
+	if p.pos > start {
+		value, err = strconv.ParseFloat(p.s[start:p.pos], 64)
+		if err != nil {
+			p.pos = start
+			return nil, p.errorf("invalid number")
+		}
+
+		return value, nil
+	}
+
+	switch word := p.ident(false); word {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	case "null":
+		return nil, nil
+	default:
+		p.pos = start
+		return nil, p.errorf("expected value")
+	}
+}
+
+// parseEdge parses an edge pattern.
+func (p *queryParser) parseEdge() (edge *edgePattern, err error) {
+	edge = new(edgePattern)
+
+	switch {
+	case p.consume("<-["):
+		edge.dir = incoming
+		p.parseEdgeSpec(edge)
+
+		if !p.consume("]-") {
+			return nil, p.errorf("expected ]-")
+		}
+	case p.consume("-["):
+		p.parseEdgeSpec(edge)
+
+		if p.consume("]->") {
+			edge.dir = outgoing
+		} else if p.consume("]-") {
+			edge.dir = both
+		} else {
+			return nil, p.errorf("expected ]-> or ]-")
+		}
+	case p.consume("->"):
+		edge.dir = outgoing
+	case p.consume("<-"):
+		edge.dir = incoming
+	case p.consume("--"):
+		edge.dir = both
+	default:
+		return nil, p.errorf("expected edge")
+	}
+
+	return edge, nil
+}
+
+// parseEdgeSpec parses the optional type and variable length of an edge pattern.
+func (p *queryParser) parseEdgeSpec(edge *edgePattern) {
+	edge.typ = p.ident(false)
+	edge.variable = p.consume("*")
+}
+
+// matches checks, whether the resource with the given types and properties matches the node pattern.
+func (node *nodePattern) matches(types []string, props map[string]any) bool {
+	if node.typ != "" && !slices.Contains(types, node.typ) {
+		return false
+	}
+
+	for _, cond := range node.conds {
+		if !cond.matches(props) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// matches checks, whether the properties fulfill the condition.
+func (cond *condition) matches(props map[string]any) bool {
+	var (
+		v  any = props
+		ok bool
+	)
+
+	for _, key := range cond.path {
+		m, isMap := v.(map[string]any)
+		if !isMap {
+			v = nil
+			break
+		}
+
+		if v, ok = m[key]; !ok {
+			break
+		}
+	}
+
+	var result bool
+	switch cond.op {
+	case "=":
+		result = equals(v, cond.value)
+	case "!=":
+		result = !equals(v, cond.value)
+	default:
+		result = isSet(v)
+	}
+
+	return result != cond.negate
+}
+
+// equals checks, whether the property v equals value. Since numbers of protobuf 64-bit types are strings in JSON,
+// values are compared by their string representation. A list property equals the value, if one of its elements does.
+func equals(v any, value any) bool {
+	if value == nil {
+		return v == nil
+	}
+
+	if list, ok := v.([]any); ok {
+		return slices.ContainsFunc(list, func(elem any) bool {
+			return equals(elem, value)
+		})
+	}
+
+	return v != nil && fmt.Sprint(v) == fmt.Sprint(value)
+}
+
+// isSet checks, whether the property v is set, i.e., not null, false, zero or empty.
+func isSet(v any) bool {
+	switch v := v.(type) {
+	case nil:
+		return false
+	case bool:
+		return v
+	case string:
+		return v != ""
+	case float64:
+		return v != 0
+	case []any:
+		return len(v) > 0
+	default:
+		return true
+	}
+}
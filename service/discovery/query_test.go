@@ -0,0 +1,340 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package discovery
+
+import (
+	"context"
+	"testing"
+
+	"clouditor.io/clouditor/v2/api/discovery"
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/testutil/servicetest"
+	"clouditor.io/clouditor/v2/internal/util"
+	"clouditor.io/clouditor/v2/persistence"
+	"clouditor.io/clouditor/v2/service"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_parseGraphQuery(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   string
+		want    *graphQuery
+		wantErr assert.WantErr
+	}{
+		{
+			name:  "single node",
+			query: "VirtualMachine",
+			want: &graphQuery{
+				nodes: []*nodePattern{{typ: "VirtualMachine"}},
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name:  "conditions and edges",
+			query: `LoadBalancer[internetAccessibleEndpoint and not accessRestriction.webApplicationFirewall.enabled] -[*]- * <-[network_interface]- VirtualMachine[name != "test" AND ports = 22] -> *[parentId = null]`,
+			want: &graphQuery{
+				nodes: []*nodePattern{
+					{typ: "LoadBalancer", conds: []*condition{
+						{path: []string{"internetAccessibleEndpoint"}},
+						{path: []string{"accessRestriction", "webApplicationFirewall", "enabled"}, negate: true},
+					}},
+					{},
+					{typ: "VirtualMachine", conds: []*condition{
+						{path: []string{"name"}, op: "!=", value: "test"},
+						{path: []string{"ports"}, op: "=", value: float64(22)},
+					}},
+					{conds: []*condition{
+						{path: []string{"parentId"}, op: "=", value: nil},
+					}},
+				},
+				edges: []*edgePattern{
+					{dir: both, variable: true},
+					{dir: incoming, typ: "network_interface"},
+					{dir: outgoing},
+				},
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name:    "missing node",
+			query:   "VirtualMachine ->",
+			wantErr: func(t *testing.T, err error) bool { return assert.ErrorIs(t, err, ErrInvalidQuery) },
+		},
+		{
+			name:  "missing edge",
+			query: "VirtualMachine BlockStorage",
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "expected edge at position 16")
+			},
+		},
+		{
+			name:    "unterminated condition",
+			query:   "VirtualMachine[name",
+			wantErr: func(t *testing.T, err error) bool { return assert.ErrorIs(t, err, ErrInvalidQuery) },
+		},
+		{
+			name:    "unterminated string",
+			query:   `VirtualMachine[name = "test]`,
+			wantErr: func(t *testing.T, err error) bool { return assert.ErrorContains(t, err, "unterminated string") },
+		},
+		{
+			name:    "invalid value",
+			query:   "VirtualMachine[name = test]",
+			wantErr: func(t *testing.T, err error) bool { return assert.ErrorContains(t, err, "expected value") },
+		},
+		{
+			name:    "invalid edge spec",
+			query:   "VirtualMachine -[parent-> BlockStorage",
+			wantErr: func(t *testing.T, err error) bool { return assert.ErrorIs(t, err, ErrInvalidQuery) },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseGraphQuery(tt.query)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got, assert.CompareAllUnexported())
+		})
+	}
+}
+
+// newQueryGraphStorage creates a storage with two load balancers, of which only the first one is not protected by a
+// web application firewall. Each has a VM attached with a network interface.
+func newQueryGraphStorage(t *testing.T) persistence.Storage {
+	return testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+		for _, r := range []ontology.IsResource{
+			&ontology.LoadBalancer{Id: "lb1", InternetAccessibleEndpoint: true},
+			&ontology.LoadBalancer{Id: "lb2", InternetAccessibleEndpoint: true, AccessRestriction: &ontology.AccessRestriction{
+				Type: &ontology.AccessRestriction_WebApplicationFirewall{
+					WebApplicationFirewall: &ontology.WebApplicationFirewall{Enabled: true},
+				},
+			}},
+			&ontology.NetworkInterface{Id: "nic1", NetworkServiceId: util.Ref("lb1")},
+			&ontology.NetworkInterface{Id: "nic2", NetworkServiceId: util.Ref("lb2")},
+			&ontology.VirtualMachine{Id: "vm1", NetworkInterfaceIds: []string{"nic1"}},
+			&ontology.VirtualMachine{Id: "vm2", NetworkInterfaceIds: []string{"nic2"}},
+		} {
+			assert.NoError(t, s.Create(panicToDiscoveryResource(t, r, testdata.MockCloudServiceID1)))
+		}
+
+		// A VM of another cloud service, which is also attached to the first load balancer
+		assert.NoError(t, s.Create(panicToDiscoveryResource(t,
+			&ontology.NetworkInterface{Id: "nic3", NetworkServiceId: util.Ref("lb1")}, testdata.MockCloudServiceID2)))
+		assert.NoError(t, s.Create(panicToDiscoveryResource(t,
+			&ontology.VirtualMachine{Id: "vm3", NetworkInterfaceIds: []string{"nic3"}}, testdata.MockCloudServiceID2)))
+	})
+}
+
+func TestService_QueryGraph(t *testing.T) {
+	const exposedVMs = "LoadBalancer[internetAccessibleEndpoint and not accessRestriction.webApplicationFirewall.enabled] -[*]- VirtualMachine"
+
+	type fields struct {
+		storage persistence.Storage
+		authz   service.AuthorizationStrategy
+	}
+	type args struct {
+		req *discovery.QueryGraphRequest
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    assert.Want[*discovery.QueryGraphResponse]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "validation error",
+			fields: fields{
+				storage: newQueryGraphStorage(t),
+				authz:   servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &discovery.QueryGraphRequest{},
+			},
+			want: assert.Nil[*discovery.QueryGraphResponse],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			name: "invalid query",
+			fields: fields{
+				storage: newQueryGraphStorage(t),
+				authz:   servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &discovery.QueryGraphRequest{Query: "VirtualMachine ->"},
+			},
+			want: assert.Nil[*discovery.QueryGraphResponse],
+			wantErr: func(t *testing.T, err error) bool {
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+				return assert.ErrorContains(t, err, ErrInvalidQuery.Error())
+			},
+		},
+		{
+			name: "permission denied",
+			fields: fields{
+				storage: newQueryGraphStorage(t),
+				authz:   servicetest.NewAuthorizationStrategy(false, testdata.MockCloudServiceID1),
+			},
+			args: args{
+				req: &discovery.QueryGraphRequest{Query: "*", CloudServiceId: util.Ref(testdata.MockCloudServiceID2)},
+			},
+			want: assert.Nil[*discovery.QueryGraphResponse],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, service.ErrPermissionDenied)
+			},
+		},
+		{
+			name: "database error",
+			fields: fields{
+				storage: &testutil.StorageWithError{ListErr: persistence.ErrUnsupportedType},
+				authz:   servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &discovery.QueryGraphRequest{Query: "*"},
+			},
+			want: assert.Nil[*discovery.QueryGraphResponse],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.Equal(t, codes.Internal, status.Code(err))
+			},
+		},
+		{
+			name: "exposed VMs",
+			fields: fields{
+				storage: newQueryGraphStorage(t),
+				authz:   servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &discovery.QueryGraphRequest{Query: exposedVMs},
+			},
+			want: func(t *testing.T, got *discovery.QueryGraphResponse) bool {
+				assert.Equal(t, 2, len(got.Paths))
+				assert.Equal(t, []string{"lb1", "nic1", "vm1"}, got.Paths[0].ResourceIds)
+				assert.Equal(t, "nic1-lb1", got.Paths[0].Edges[0].Id)
+				assert.Equal(t, "vm1-nic1", got.Paths[0].Edges[1].Id)
+				assert.Equal(t, []string{"lb1", "nic3", "vm3"}, got.Paths[1].ResourceIds)
+				return assert.False(t, got.Truncated)
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "exposed VMs of allowed cloud service",
+			fields: fields{
+				storage: newQueryGraphStorage(t),
+				authz:   servicetest.NewAuthorizationStrategy(false, testdata.MockCloudServiceID1),
+			},
+			args: args{
+				req: &discovery.QueryGraphRequest{Query: exposedVMs},
+			},
+			want: func(t *testing.T, got *discovery.QueryGraphResponse) bool {
+				assert.Equal(t, 1, len(got.Paths))
+				return assert.Equal(t, []string{"lb1", "nic1", "vm1"}, got.Paths[0].ResourceIds)
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "exposed VMs of cloud service",
+			fields: fields{
+				storage: newQueryGraphStorage(t),
+				authz:   servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &discovery.QueryGraphRequest{Query: exposedVMs, CloudServiceId: util.Ref(testdata.MockCloudServiceID2)},
+			},
+			want: func(t *testing.T, got *discovery.QueryGraphResponse) bool {
+				// The load balancer belongs to another cloud service
+				return assert.Equal(t, 0, len(got.Paths))
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "max depth",
+			fields: fields{
+				storage: newQueryGraphStorage(t),
+				authz:   servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &discovery.QueryGraphRequest{Query: exposedVMs, MaxDepth: 1},
+			},
+			want: func(t *testing.T, got *discovery.QueryGraphResponse) bool {
+				return assert.Equal(t, 0, len(got.Paths))
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "directed edges with limit",
+			fields: fields{
+				storage: newQueryGraphStorage(t),
+				authz:   servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &discovery.QueryGraphRequest{Query: "VirtualMachine -[network_interface]-> NetworkInterface -> LoadBalancer", Limit: 2},
+			},
+			want: func(t *testing.T, got *discovery.QueryGraphResponse) bool {
+				assert.Equal(t, 2, len(got.Paths))
+				assert.Equal(t, []string{"vm1", "nic1", "lb1"}, got.Paths[0].ResourceIds)
+				assert.Equal(t, []string{"vm2", "nic2", "lb2"}, got.Paths[1].ResourceIds)
+				return assert.True(t, got.Truncated)
+			},
+			wantErr: assert.Nil[error],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := NewService(WithStorage(tt.fields.storage), WithAuthorizationStrategy(tt.fields.authz))
+
+			got, err := svc.QueryGraph(context.Background(), tt.args.req)
+			tt.wantErr(t, err)
+			tt.want(t, got)
+		})
+	}
+}
+
+func TestService_QueryGraph_update(t *testing.T) {
+	svc := NewService(WithStorage(newQueryGraphStorage(t)))
+
+	// Build the index
+	res, err := svc.QueryGraph(context.Background(), &discovery.QueryGraphRequest{Query: "VirtualMachine -> NetworkInterface"})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(res.Paths))
+
+	// Detach the first VM from its network interface
+	_, err = svc.UpdateResource(context.Background(), &discovery.UpdateResourceRequest{
+		Resource: panicToDiscoveryResource(t, &ontology.VirtualMachine{Id: "vm1"}, testdata.MockCloudServiceID1),
+	})
+	assert.NoError(t, err)
+
+	res, err = svc.QueryGraph(context.Background(), &discovery.QueryGraphRequest{Query: "VirtualMachine -> NetworkInterface"})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(res.Paths))
+	assert.Equal(t, "vm2", res.Paths[0].ResourceIds[0])
+}
@@ -0,0 +1,182 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package discovery
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"clouditor.io/clouditor/v2/api/discovery"
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/persistence"
+	"clouditor.io/clouditor/v2/service"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// RegisterResourceType registers a custom resource type, which is not part of the ontology. Since custom resource
+// types are shared by all cloud services, the user needs access to all cloud services.
+func (svc *Service) RegisterResourceType(ctx context.Context, req *discovery.RegisterResourceTypeRequest) (res *discovery.CustomResourceType, err error) {
+	if all, _ := svc.authz.AllowedCloudServices(ctx); !all {
+		return nil, service.ErrPermissionDenied
+	}
+
+	err = checkResourceType(req.ResourceType)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	err = svc.storage.Save(req.ResourceType, "name = ?", req.ResourceType.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
+	svc.graph.putType(req.ResourceType)
+
+	return req.ResourceType, nil
+}
+
+// ListResourceTypes lists all registered custom resource types.
+func (svc *Service) ListResourceTypes(_ context.Context, req *discovery.ListResourceTypesRequest) (res *discovery.ListResourceTypesResponse, err error) {
+	res = new(discovery.ListResourceTypesResponse)
+
+	res.ResourceTypes, res.NextPageToken, err = service.PaginateStorage[*discovery.CustomResourceType](req, svc.storage,
+		service.DefaultPaginationOpts)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not paginate results: %v", err)
+	}
+
+	return
+}
+
+// checkResourceType checks the parts of a custom resource type that cannot be expressed in the request validation,
+// i.e., that it does not shadow a type of the ontology, is derived from types of the ontology and that its
+// properties are unique.
+func checkResourceType(t *discovery.CustomResourceType) error {
+	var names = make(map[string]bool)
+
+	if isOntologyType(t.Name) {
+		return fmt.Errorf("%s is already a type of the ontology", t.Name)
+	}
+
+	for _, typ := range t.SuperTypes {
+		if !isOntologyType(typ) {
+			return fmt.Errorf("super type %s is not a type of the ontology", typ)
+		}
+	}
+
+	for _, p := range t.Properties {
+		if names[p.Name] {
+			return fmt.Errorf("property %q is declared more than once", p.Name)
+		}
+		names[p.Name] = true
+
+		if p.Relationship != "" && p.Type != discovery.CustomResourceProperty_PROPERTY_TYPE_STRING {
+			return fmt.Errorf("property %q needs to be a string to declare a relationship", p.Name)
+		}
+	}
+
+	return nil
+}
+
+// checkCustomResource checks whether a resource that is not an ontology resource is of a registered custom resource
+// type and validates its properties. Afterward, the resource type of the resource contains the super types of its
+// type.
+func (svc *Service) checkCustomResource(r *discovery.Resource) (err error) {
+	var t discovery.CustomResourceType
+
+	// Resources of the ontology are validated by the ontology itself
+	if _, err = r.ToOntologyResource(); err == nil {
+		return nil
+	}
+
+	name, _, _ := strings.Cut(r.ResourceType, ",")
+
+	err = svc.storage.Get(&t, "name = ?", name)
+	if errors.Is(err, persistence.ErrRecordNotFound) {
+		return status.Errorf(codes.InvalidArgument, "resource type %s is neither part of the ontology nor registered", name)
+	} else if err != nil {
+		return status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
+	props, ok := r.CustomProperties()
+	if !ok {
+		return status.Errorf(codes.InvalidArgument, "properties of resource type %s need to be a google.protobuf.Struct", name)
+	}
+
+	err = t.ValidateProperties(props)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	r.ResourceType = strings.Join(t.ResourceTypes(), ",")
+
+	return nil
+}
+
+// customResourceTypes returns all registered custom resource types by their name.
+func (svc *Service) customResourceTypes() (types map[string]*discovery.CustomResourceType, err error) {
+	var list []*discovery.CustomResourceType
+
+	err = svc.storage.List(&list, "", true, 0, -1)
+	if err != nil {
+		return nil, err
+	}
+
+	types = make(map[string]*discovery.CustomResourceType)
+	for _, t := range list {
+		types[t.Name] = t
+	}
+
+	return types, nil
+}
+
+// customEdges returns the edges of a resource of a custom resource type to its related resources. It returns nil, if
+// the resource is not of a custom resource type or its type is not contained in types.
+func customEdges(r *discovery.Resource, types map[string]*discovery.CustomResourceType) []*discovery.GraphEdge {
+	props, ok := r.CustomProperties()
+	if !ok {
+		return nil
+	}
+
+	name, _, _ := strings.Cut(r.ResourceType, ",")
+
+	t, ok := types[name]
+	if !ok {
+		return nil
+	}
+
+	return t.Edges(r.Id, props)
+}
+
+// isOntologyType checks whether a message with the given name is part of the ontology.
+func isOntologyType(name string) bool {
+	return ontology.File_api_ontology_ontology_proto.Messages().ByName(protoreflect.Name(name)) != nil
+}
@@ -0,0 +1,214 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package discovery
+
+import (
+	"context"
+	"testing"
+
+	"clouditor.io/clouditor/v2/api/discovery"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/testutil/prototest"
+	"clouditor.io/clouditor/v2/internal/testutil/servicetest"
+	"clouditor.io/clouditor/v2/persistence"
+	"clouditor.io/clouditor/v2/service"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// newMockLibraryType returns a custom resource type of a software library, which depends on other libraries.
+func newMockLibraryType() *discovery.CustomResourceType {
+	return &discovery.CustomResourceType{
+		Name:       "Library",
+		SuperTypes: []string{"Resource"},
+		Properties: []*discovery.CustomResourceProperty{
+			{Name: "name", Type: discovery.CustomResourceProperty_PROPERTY_TYPE_STRING, Required: true},
+			{Name: "dependencies", Type: discovery.CustomResourceProperty_PROPERTY_TYPE_STRING, Repeated: true, Relationship: "depends_on"},
+		},
+	}
+}
+
+// newLibrary returns a resource of the library type with the given dependencies.
+func newLibrary(t *testing.T, id string, dependencies ...any) *discovery.Resource {
+	props, err := structpb.NewStruct(map[string]any{"name": id, "dependencies": dependencies})
+	assert.NoError(t, err)
+
+	return &discovery.Resource{
+		Id:             id,
+		CloudServiceId: testdata.MockCloudServiceID1,
+		ResourceType:   "Library",
+		Properties:     prototest.NewAny(t, props),
+	}
+}
+
+func TestService_RegisterResourceType(t *testing.T) {
+	tests := []struct {
+		name    string
+		authz   service.AuthorizationStrategy
+		typ     *discovery.CustomResourceType
+		want    assert.Want[*discovery.CustomResourceType]
+		wantErr assert.WantErr
+	}{
+		{
+			name:  "validation error",
+			authz: servicetest.NewAuthorizationStrategy(true),
+			typ:   &discovery.CustomResourceType{Name: "library"},
+			want:  assert.Nil[*discovery.CustomResourceType],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "resource_type.name")
+			},
+		},
+		{
+			name:  "permission denied",
+			authz: servicetest.NewAuthorizationStrategy(false, testdata.MockCloudServiceID1),
+			typ:   newMockLibraryType(),
+			want:  assert.Nil[*discovery.CustomResourceType],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, service.ErrPermissionDenied)
+			},
+		},
+		{
+			name:  "type of the ontology",
+			authz: servicetest.NewAuthorizationStrategy(true),
+			typ:   &discovery.CustomResourceType{Name: "VirtualMachine"},
+			want:  assert.Nil[*discovery.CustomResourceType],
+			wantErr: func(t *testing.T, err error) bool {
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+				return assert.ErrorContains(t, err, "VirtualMachine is already a type of the ontology")
+			},
+		},
+		{
+			name:  "unknown super type",
+			authz: servicetest.NewAuthorizationStrategy(true),
+			typ:   &discovery.CustomResourceType{Name: "Library", SuperTypes: []string{"Artifact"}},
+			want:  assert.Nil[*discovery.CustomResourceType],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "super type Artifact is not a type of the ontology")
+			},
+		},
+		{
+			name:  "relationship of a number",
+			authz: servicetest.NewAuthorizationStrategy(true),
+			typ: &discovery.CustomResourceType{
+				Name: "Library",
+				Properties: []*discovery.CustomResourceProperty{
+					{Name: "stars", Type: discovery.CustomResourceProperty_PROPERTY_TYPE_NUMBER, Relationship: "depends_on"},
+				},
+			},
+			want: assert.Nil[*discovery.CustomResourceType],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, `property "stars" needs to be a string to declare a relationship`)
+			},
+		},
+		{
+			name:  "duplicate property",
+			authz: servicetest.NewAuthorizationStrategy(true),
+			typ: &discovery.CustomResourceType{
+				Name: "Library",
+				Properties: []*discovery.CustomResourceProperty{
+					{Name: "name", Type: discovery.CustomResourceProperty_PROPERTY_TYPE_STRING},
+					{Name: "name", Type: discovery.CustomResourceProperty_PROPERTY_TYPE_STRING},
+				},
+			},
+			want: assert.Nil[*discovery.CustomResourceType],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, `property "name" is declared more than once`)
+			},
+		},
+		{
+			name:  "happy path",
+			authz: servicetest.NewAuthorizationStrategy(true),
+			typ:   newMockLibraryType(),
+			want: func(t *testing.T, got *discovery.CustomResourceType) bool {
+				return assert.Equal(t, newMockLibraryType(), got)
+			},
+			wantErr: assert.Nil[error],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := NewService(WithStorage(testutil.NewInMemoryStorage(t)), WithAuthorizationStrategy(tt.authz))
+
+			got, err := servicetest.Validated(svc.RegisterResourceType)(context.Background(), &discovery.RegisterResourceTypeRequest{ResourceType: tt.typ})
+			tt.wantErr(t, err)
+			tt.want(t, got)
+		})
+	}
+}
+
+func TestService_ListResourceTypes(t *testing.T) {
+	svc := NewService(WithStorage(testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+		assert.NoError(t, s.Create(newMockLibraryType()))
+	})))
+
+	res, err := servicetest.Validated(svc.ListResourceTypes)(context.Background(), &discovery.ListResourceTypesRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, []*discovery.CustomResourceType{newMockLibraryType()}, res.ResourceTypes)
+
+	_, err = servicetest.Validated(svc.ListResourceTypes)(context.Background(), &discovery.ListResourceTypesRequest{OrderBy: "description"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestService_UpdateResource_custom(t *testing.T) {
+	svc := NewService(WithStorage(testutil.NewInMemoryStorage(t)))
+
+	// The type needs to be registered first
+	_, err := svc.UpdateResource(context.Background(), &discovery.UpdateResourceRequest{Resource: newLibrary(t, "log4j")})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.ErrorContains(t, err, "resource type Library is neither part of the ontology nor registered")
+
+	_, err = svc.RegisterResourceType(context.Background(), &discovery.RegisterResourceTypeRequest{ResourceType: newMockLibraryType()})
+	assert.NoError(t, err)
+
+	// Properties need to match the type
+	invalid := newLibrary(t, "log4j")
+	invalid.Properties = prototest.NewAny(t, &structpb.Struct{})
+	_, err = svc.UpdateResource(context.Background(), &discovery.UpdateResourceRequest{Resource: invalid})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.ErrorContains(t, err, `property "name" is required`)
+
+	res, err := svc.UpdateResource(context.Background(), &discovery.UpdateResourceRequest{Resource: newLibrary(t, "log4j", "log4j-api")})
+	assert.NoError(t, err)
+	assert.Equal(t, "Library,Resource", res.ResourceType)
+
+	_, err = svc.UpdateResource(context.Background(), &discovery.UpdateResourceRequest{Resource: newLibrary(t, "log4j-api")})
+	assert.NoError(t, err)
+
+	// The relationships of the custom type are edges in the resource graph
+	edges, err := svc.ListGraphEdges(context.Background(), &discovery.ListGraphEdgesRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(edges.Edges))
+	assert.Equal(t, "depends_on", edges.Edges[0].Relationship)
+
+	paths, err := svc.QueryGraph(context.Background(), &discovery.QueryGraphRequest{Query: `Library[name = "log4j"] -[dependencies]-> Resource`})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(paths.Paths))
+	assert.Equal(t, []string{"log4j", "log4j-api"}, paths.Paths[0].ResourceIds)
+}
@@ -0,0 +1,219 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package edge
+
+import (
+	"context"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"clouditor.io/clouditor/v2/api/assessment"
+	"clouditor.io/clouditor/v2/api/evidence"
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/mqtt"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/testutil/prototest"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// recordingAssessment is an assessment server that sends all evidences received on the AssessEvidences stream to a
+// channel.
+type recordingAssessment struct {
+	assessment.UnimplementedAssessmentServer
+
+	evidences chan *evidence.Evidence
+}
+
+func (r *recordingAssessment) AssessEvidences(stream assessment.Assessment_AssessEvidencesServer) error {
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		r.evidences <- req.Evidence
+	}
+}
+
+// startAssessment starts a recording assessment server on a bufconn listener and returns the dial option to reach it.
+func startAssessment(t *testing.T) (opt grpc.DialOption, evidences chan *evidence.Evidence) {
+	lis := bufconn.Listen(1024 * 1024)
+	evidences = make(chan *evidence.Evidence, 10)
+
+	srv := grpc.NewServer()
+	assessment.RegisterAssessmentServer(srv, &recordingAssessment{evidences: evidences})
+
+	go func() {
+		_ = srv.Serve(lis)
+	}()
+	t.Cleanup(srv.Stop)
+
+	return grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+		return lis.Dial()
+	}), evidences
+}
+
+func newRequest(t *testing.T) *assessment.AssessEvidenceRequest {
+	return &assessment.AssessEvidenceRequest{
+		Evidence: &evidence.Evidence{
+			Id:             testdata.MockEvidenceID1,
+			CloudServiceId: testdata.MockCloudServiceID1,
+			ToolId:         testdata.MockEvidenceToolID1,
+			Timestamp:      timestamppb.Now(),
+			Resource: prototest.NewAny(t, &ontology.VirtualMachine{
+				Id:   testdata.MockResourceID1,
+				Name: testdata.MockResourceID1,
+			}),
+		},
+	}
+}
+
+func TestBridge_Start(t *testing.T) {
+	broker, err := mqtt.NewBroker("127.0.0.1:0")
+	assert.NoError(t, err)
+	defer broker.Close()
+
+	opt, evidences := startAssessment(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	b := NewBridge(broker.URL(),
+		WithAssessmentAddress("bufnet", opt),
+		WithReconnectInterval(100*time.Millisecond),
+		WithMQTTOptions(mqtt.WithKeepAlive(time.Second)),
+	)
+	go b.Start(ctx)
+
+	pub := NewPublisher(broker.URL(), DefaultTopic+"/site-1")
+	defer pub.Close()
+
+	req := newRequest(t)
+
+	// The broker does not retain messages, so we publish until the bridge has subscribed and forwarded the evidence
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+
+	for {
+		err = pub.Publish(ctx, req)
+		assert.NoError(t, err)
+
+		select {
+		case got := <-evidences:
+			assert.Equal(t, req.Evidence, got)
+			return
+		case <-ticker.C:
+		case <-ctx.Done():
+			t.Fatal("evidence was not forwarded to the assessment")
+		}
+	}
+}
+
+func TestBridge_Start_reconnect(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	b := NewBridge("mqtt://127.0.0.1:1", WithReconnectInterval(10*time.Millisecond))
+
+	done := make(chan struct{})
+	go func() {
+		b.Start(ctx)
+		close(done)
+	}()
+
+	// The bridge keeps retrying until it is cancelled
+	time.Sleep(50 * time.Millisecond)
+	cancel()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("bridge did not stop")
+	}
+}
+
+func TestDecodeRequest(t *testing.T) {
+	req := newRequest(t)
+
+	protoPayload, err := proto.Marshal(req)
+	assert.NoError(t, err)
+
+	jsonPayload, err := protojson.Marshal(req)
+	assert.NoError(t, err)
+
+	invalid, err := proto.Marshal(&assessment.AssessEvidenceRequest{Evidence: &evidence.Evidence{Id: "not-a-uuid"}})
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		payload []byte
+		want    *assessment.AssessEvidenceRequest
+		wantErr assert.WantErr
+	}{
+		{
+			name:    "protobuf",
+			payload: protoPayload,
+			want:    req,
+			wantErr: assert.Nil[error],
+		},
+		{
+			name:    "json",
+			payload: jsonPayload,
+			want:    req,
+			wantErr: assert.Nil[error],
+		},
+		{
+			name:    "garbage",
+			payload: []byte("\xff\xff\xff"),
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, ErrInvalidPayload)
+			},
+		},
+		{
+			name:    "invalid evidence",
+			payload: invalid,
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, ErrInvalidPayload) && assert.ErrorContains(t, err, "evidence.id")
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DecodeRequest(tt.payload)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package edge
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"clouditor.io/clouditor/v2/api/assessment"
+	"clouditor.io/clouditor/v2/internal/mqtt"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// DefaultPublishTimeout is the default timeout of [Publisher.Publish], if the context has no deadline.
+const DefaultPublishTimeout = 10 * time.Second
+
+// Publisher publishes evidences of a collector to an MQTT broker, from where they are forwarded by the [Bridge]. The
+// connection is lazily (re-)established on publish.
+type Publisher struct {
+	brokerURL string
+	topic     string
+	mqttOpts  []mqtt.Option
+
+	mu     sync.Mutex
+	client *mqtt.Client
+}
+
+// NewPublisher creates a new [Publisher] for the MQTT broker at brokerURL. If topic is empty, [DefaultTopic] is used.
+func NewPublisher(brokerURL string, topic string, opts ...mqtt.Option) *Publisher {
+	if topic == "" {
+		topic = DefaultTopic
+	}
+
+	return &Publisher{
+		brokerURL: brokerURL,
+		topic:     topic,
+		mqttOpts:  opts,
+	}
+}
+
+// Topic returns the topic to which evidences are published.
+func (p *Publisher) Topic() string {
+	return p.topic
+}
+
+// Publish publishes req with QoS 1, i.e., it blocks until the broker acknowledged the evidence or the timeout expired. If the publish fails on
+// an existing connection, it is retried once on a new connection.
+func (p *Publisher) Publish(ctx context.Context, req *assessment.AssessEvidenceRequest) (err error) {
+	payload, err := proto.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("could not serialize evidence: %w", err)
+	}
+
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, DefaultPublishTimeout)
+		defer cancel()
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for attempt := 0; attempt < 2; attempt++ {
+		if p.client == nil {
+			p.client, err = mqtt.Connect(ctx, p.brokerURL, p.mqttOpts...)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = p.client.Publish(ctx, p.topic, mqtt.AtLeastOnce, payload)
+		if err == nil || ctx.Err() != nil {
+			break
+		}
+
+		// Discard the broken connection
+		_ = p.client.Close()
+		p.client = nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("could not publish evidence to MQTT broker: %w", err)
+	}
+
+	return nil
+}
+
+// Close closes the connection to the broker.
+func (p *Publisher) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.client != nil {
+		_ = p.client.Close()
+		p.client = nil
+	}
+
+	return nil
+}
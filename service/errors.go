@@ -0,0 +1,83 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package service
+
+import (
+	"time"
+
+	"clouditor.io/clouditor/v2/api"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// DefaultRetryDelay is the delay that is suggested to clients in case a dependent service is temporarily unavailable.
+const DefaultRetryDelay = 5 * time.Second
+
+// ErrorWithRetryInfo returns a gRPC error with the given code and message, which contains an [errdetails.RetryInfo]
+// in its details. This signals to clients that the request can safely be retried after the given delay.
+func ErrorWithRetryInfo(code codes.Code, delay time.Duration, format string, a ...any) error {
+	st := status.Newf(code, format, a...)
+
+	// Fall back to the plain status, if the details cannot be attached for some reason
+	if withDetails, err := st.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(delay)}); err == nil {
+		st = withDetails
+	}
+
+	return st.Err()
+}
+
+// ErrorCodeWithRetryInfo is like [ErrorWithRetryInfo], but the returned error additionally contains the error code c.
+// Its status code is the one of c.
+func ErrorCodeWithRetryInfo(c *api.ErrorCode, delay time.Duration, format string, a ...any) error {
+	st := status.Convert(c.Errorf(format, a...))
+
+	// Fall back to the status without retry information, if the details cannot be attached for some reason
+	if withDetails, err := st.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(delay)}); err == nil {
+		st = withDetails
+	}
+
+	return st.Err()
+}
+
+// RetryDelay returns the retry delay contained in the [errdetails.RetryInfo] of the gRPC error err. If err does not
+// contain any retry information, ok is false.
+func RetryDelay(err error) (delay time.Duration, ok bool) {
+	st, ok := status.FromError(err)
+	if !ok {
+		return 0, false
+	}
+
+	for _, d := range st.Details() {
+		if ri, ok := d.(*errdetails.RetryInfo); ok {
+			return ri.GetRetryDelay().AsDuration(), true
+		}
+	}
+
+	return 0, false
+}
@@ -0,0 +1,88 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package service
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestErrorWithRetryInfo(t *testing.T) {
+	err := ErrorWithRetryInfo(codes.Unavailable, DefaultRetryDelay, "could not get stream to %s", "orchestrator")
+
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.ErrorContains(t, err, "could not get stream to orchestrator")
+
+	delay, ok := RetryDelay(err)
+	assert.True(t, ok)
+	assert.Equal(t, DefaultRetryDelay, delay)
+}
+
+func TestRetryDelay(t *testing.T) {
+	type args struct {
+		err error
+	}
+	tests := []struct {
+		name      string
+		args      args
+		wantDelay time.Duration
+		wantOk    bool
+	}{
+		{
+			name: "no gRPC error",
+			args: args{
+				err: errors.New("some error"),
+			},
+		},
+		{
+			name: "gRPC error without retry info",
+			args: args{
+				err: status.Error(codes.Internal, "some error"),
+			},
+		},
+		{
+			name: "gRPC error with retry info",
+			args: args{
+				err: ErrorWithRetryInfo(codes.Unavailable, time.Minute, "some error"),
+			},
+			wantDelay: time.Minute,
+			wantOk:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotDelay, gotOk := RetryDelay(tt.args.err)
+			assert.Equal(t, tt.wantDelay, gotDelay)
+			assert.Equal(t, tt.wantOk, gotOk)
+		})
+	}
+}
@@ -0,0 +1,153 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package evaluation
+
+import (
+	"slices"
+
+	"clouditor.io/clouditor/v2/api/evaluation"
+	"clouditor.io/clouditor/v2/api/orchestrator"
+)
+
+// aggregateResults combines the evaluation results of the sub-controls of the control according to the aggregation
+// strategy. It returns the status of the control and, if it is not compliant, the IDs of the assessment results
+// because of which it is not compliant.
+func aggregateResults(strategy *orchestrator.AggregationStrategy, control *orchestrator.Control, results []*evaluation.EvaluationResult) (status evaluation.EvaluationStatus, failing []string) {
+	var (
+		compliant    float64
+		nonCompliant float64
+		weights      = subControlWeights(control)
+	)
+
+	// Sub-controls that are not applicable are not considered at all. If none of them is applicable, the control is not
+	// applicable either.
+	applicable := slices.DeleteFunc(slices.Clone(results), func(r *evaluation.EvaluationResult) bool {
+		return r.Status == evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_APPLICABLE
+	})
+	if len(results) > 0 && len(applicable) == 0 {
+		return evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_APPLICABLE, []string{}
+	}
+	results = applicable
+
+	if strategy.GetType() == orchestrator.AggregationStrategyType_AGGREGATION_STRATEGY_TYPE_UNSPECIFIED ||
+		strategy.GetType() == orchestrator.AggregationStrategyType_AGGREGATION_STRATEGY_TYPE_ALL_MUST_PASS {
+		return aggregateAllMustPass(results)
+	}
+
+	failing = []string{}
+
+	// Count the compliant and non-compliant sub-controls, weighted by their severity if requested. Pending
+	// sub-controls are not considered.
+	for _, r := range results {
+		var weight = 1.0
+
+		if strategy.GetType() == orchestrator.AggregationStrategyType_AGGREGATION_STRATEGY_TYPE_WEIGHTED_BY_SEVERITY {
+			if w, ok := weights[r.ControlId]; ok {
+				weight = w
+			}
+		}
+
+		switch r.Status {
+		case evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT,
+			evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT_MANUALLY:
+			compliant += weight
+		case evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT,
+			evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT_MANUALLY:
+			nonCompliant += weight
+		}
+	}
+
+	// If none of the sub-controls was evaluated (yet), we are stuck at pending
+	if compliant+nonCompliant == 0 {
+		return evaluation.EvaluationStatus_EVALUATION_STATUS_PENDING, failing
+	}
+
+	var fulfilled bool
+	switch strategy.GetType() {
+	case orchestrator.AggregationStrategyType_AGGREGATION_STRATEGY_TYPE_N_OF_M:
+		// By default, all sub-controls need to be compliant
+		var required = len(results)
+		if strategy.RequiredCompliant != nil {
+			required = int(strategy.GetRequiredCompliant())
+		}
+
+		fulfilled = int(compliant) >= required
+	default:
+		var threshold = 100.0
+		if strategy.Threshold != nil {
+			threshold = strategy.GetThreshold()
+		}
+
+		fulfilled = compliant/(compliant+nonCompliant)*100 >= threshold
+	}
+
+	if fulfilled {
+		return evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT, failing
+	}
+
+	for _, r := range results {
+		if r.Status == evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT ||
+			r.Status == evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT_MANUALLY {
+			failing = append(failing, r.GetFailingAssessmentResultIds()...)
+		}
+	}
+
+	return evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT, failing
+}
+
+// aggregateAllMustPass combines the evaluation results of the sub-controls, so that the control is only compliant, if
+// all sub-controls are compliant.
+func aggregateAllMustPass(results []*evaluation.EvaluationResult) (status evaluation.EvaluationStatus, failing []string) {
+	status = evaluation.EvaluationStatus_EVALUATION_STATUS_PENDING
+	failing = []string{}
+
+	for _, r := range results {
+		if (r.Status == evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT ||
+			r.Status == evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT_MANUALLY) &&
+			(status != evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT &&
+				status != evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT_MANUALLY) {
+			status = evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT
+		} else if r.Status == evaluation.EvaluationStatus_EVALUATION_STATUS_PENDING &&
+			status == evaluation.EvaluationStatus_EVALUATION_STATUS_PENDING {
+			status = evaluation.EvaluationStatus_EVALUATION_STATUS_PENDING
+		} else {
+			status = evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT
+			failing = append(failing, r.GetFailingAssessmentResultIds()...)
+		}
+	}
+
+	return
+}
+
+// subControlWeights returns the weights of the sub-controls of the control, indexed by their ID.
+func subControlWeights(control *orchestrator.Control) (weights map[string]float64) {
+	weights = make(map[string]float64, len(control.GetControls()))
+	for _, sub := range control.GetControls() {
+		weights[sub.Id] = sub.Weight()
+	}
+
+	return
+}
@@ -0,0 +1,185 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package evaluation
+
+import (
+	"testing"
+
+	"clouditor.io/clouditor/v2/api/evaluation"
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/util"
+)
+
+func Test_aggregateResults(t *testing.T) {
+	var (
+		control = &orchestrator.Control{
+			Id: "Cont1",
+			Controls: []*orchestrator.Control{
+				{Id: "Cont1.1", Severity: orchestrator.ControlSeverity_CONTROL_SEVERITY_CRITICAL.Enum()},
+				{Id: "Cont1.2"},
+				{Id: "Cont1.3"},
+			},
+		}
+		compliant = &evaluation.EvaluationResult{
+			ControlId: "Cont1.1",
+			Status:    evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT,
+		}
+		nonCompliant = &evaluation.EvaluationResult{
+			ControlId:                  "Cont1.2",
+			Status:                     evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT,
+			FailingAssessmentResultIds: []string{"result-1"},
+		}
+		pending = &evaluation.EvaluationResult{
+			ControlId: "Cont1.3",
+			Status:    evaluation.EvaluationStatus_EVALUATION_STATUS_PENDING,
+		}
+		notApplicable = &evaluation.EvaluationResult{
+			ControlId: "Cont1.3",
+			Status:    evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_APPLICABLE,
+		}
+		results = []*evaluation.EvaluationResult{compliant, nonCompliant, pending}
+	)
+
+	type args struct {
+		strategy *orchestrator.AggregationStrategy
+		results  []*evaluation.EvaluationResult
+	}
+	tests := []struct {
+		name        string
+		args        args
+		wantStatus  evaluation.EvaluationStatus
+		wantFailing []string
+	}{
+		{
+			name: "all must pass",
+			args: args{
+				strategy: &orchestrator.AggregationStrategy{},
+				results:  results,
+			},
+			wantStatus:  evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT,
+			wantFailing: []string{"result-1"},
+		},
+		{
+			name: "percentage threshold fulfilled",
+			args: args{
+				strategy: &orchestrator.AggregationStrategy{
+					Type:      orchestrator.AggregationStrategyType_AGGREGATION_STRATEGY_TYPE_PERCENTAGE_THRESHOLD,
+					Threshold: util.Ref(50.0),
+				},
+				results: results,
+			},
+			wantStatus:  evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT,
+			wantFailing: []string{},
+		},
+		{
+			name: "percentage threshold not fulfilled",
+			args: args{
+				strategy: &orchestrator.AggregationStrategy{
+					Type:      orchestrator.AggregationStrategyType_AGGREGATION_STRATEGY_TYPE_PERCENTAGE_THRESHOLD,
+					Threshold: util.Ref(75.0),
+				},
+				results: results,
+			},
+			wantStatus:  evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT,
+			wantFailing: []string{"result-1"},
+		},
+		{
+			name: "weighted by severity",
+			args: args{
+				strategy: &orchestrator.AggregationStrategy{
+					Type:      orchestrator.AggregationStrategyType_AGGREGATION_STRATEGY_TYPE_WEIGHTED_BY_SEVERITY,
+					Threshold: util.Ref(75.0),
+				},
+				results: results,
+			},
+			// The critical sub-control weighs 4 and the other one 1, so 80% are compliant
+			wantStatus:  evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT,
+			wantFailing: []string{},
+		},
+		{
+			name: "n of m fulfilled",
+			args: args{
+				strategy: &orchestrator.AggregationStrategy{
+					Type:              orchestrator.AggregationStrategyType_AGGREGATION_STRATEGY_TYPE_N_OF_M,
+					RequiredCompliant: util.Ref(int32(1)),
+				},
+				results: results,
+			},
+			wantStatus:  evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT,
+			wantFailing: []string{},
+		},
+		{
+			name: "n of m defaults to all",
+			args: args{
+				strategy: &orchestrator.AggregationStrategy{
+					Type: orchestrator.AggregationStrategyType_AGGREGATION_STRATEGY_TYPE_N_OF_M,
+				},
+				results: results,
+			},
+			wantStatus:  evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT,
+			wantFailing: []string{"result-1"},
+		},
+		{
+			name: "only pending",
+			args: args{
+				strategy: &orchestrator.AggregationStrategy{
+					Type: orchestrator.AggregationStrategyType_AGGREGATION_STRATEGY_TYPE_PERCENTAGE_THRESHOLD,
+				},
+				results: []*evaluation.EvaluationResult{pending},
+			},
+			wantStatus:  evaluation.EvaluationStatus_EVALUATION_STATUS_PENDING,
+			wantFailing: []string{},
+		},
+		{
+			name: "n of m ignores not applicable",
+			args: args{
+				strategy: &orchestrator.AggregationStrategy{
+					Type: orchestrator.AggregationStrategyType_AGGREGATION_STRATEGY_TYPE_N_OF_M,
+				},
+				results: []*evaluation.EvaluationResult{compliant, notApplicable},
+			},
+			wantStatus:  evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT,
+			wantFailing: []string{},
+		},
+		{
+			name: "only not applicable",
+			args: args{
+				strategy: &orchestrator.AggregationStrategy{},
+				results:  []*evaluation.EvaluationResult{notApplicable},
+			},
+			wantStatus:  evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_APPLICABLE,
+			wantFailing: []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotStatus, gotFailing := aggregateResults(tt.args.strategy, control, tt.args.results)
+			assert.Equal(t, tt.wantStatus, gotStatus)
+			assert.Equal(t, tt.wantFailing, gotFailing)
+		})
+	}
+}
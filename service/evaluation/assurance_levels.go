@@ -0,0 +1,123 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package evaluation
+
+import (
+	"context"
+
+	"clouditor.io/clouditor/v2/api/evaluation"
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/service"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetAssuranceLevelCompliance is a method implementation of the evaluation interface: It aggregates the latest
+// evaluation result of each control of the catalog for each of its assurance levels. A control is counted for its own
+// and all higher levels, so that a single catalog serves all levels.
+func (svc *Service) GetAssuranceLevelCompliance(ctx context.Context, req *evaluation.GetAssuranceLevelComplianceRequest) (res *evaluation.GetAssuranceLevelComplianceResponse, err error) {
+	var (
+		catalog *orchestrator.Catalog
+		results []*evaluation.EvaluationResult
+	)
+
+	// Check, if this request has access to the cloud service according to our authorization strategy.
+	if !svc.authz.CheckAccess(ctx, service.AccessRead, req) {
+		return nil, service.ErrPermissionDenied
+	}
+
+	_, catalog, err = svc.prepareEvaluation(req.CloudServiceId, req.CatalogId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Retrieve the latest evaluation result of each control
+	err = svc.storage.Raw(&results,
+		`WITH sorted_results AS (
+			SELECT *, ROW_NUMBER() OVER (PARTITION BY control_category_name, control_id ORDER BY timestamp DESC) AS row_number
+			FROM evaluation_results
+			WHERE cloud_service_id = ? AND control_catalog_id = ?
+		)
+		SELECT * FROM sorted_results WHERE row_number = 1;`, req.CloudServiceId, req.CatalogId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
+	res = &evaluation.GetAssuranceLevelComplianceResponse{
+		Levels: assuranceLevelCompliance(catalog, results),
+	}
+
+	return
+}
+
+// assuranceLevelCompliance aggregates the given evaluation results of the top-level controls of the catalog for each
+// assurance level of the catalog. Controls without an evaluation result are counted as pending.
+func assuranceLevelCompliance(catalog *orchestrator.Catalog, results []*evaluation.EvaluationResult) (levels []*evaluation.AssuranceLevelCompliance) {
+	for _, level := range catalog.AssuranceLevels {
+		var (
+			l   = &evaluation.AssuranceLevelCompliance{AssuranceLevel: level}
+			toe = &orchestrator.TargetOfEvaluation{AssuranceLevel: &level}
+		)
+
+		for _, category := range catalog.Categories {
+			for _, control := range category.Controls {
+				if !control.IsRelevantFor(toe, catalog) {
+					continue
+				}
+
+				switch latestStatus(control, results) {
+				case evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT,
+					evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT_MANUALLY:
+					l.Compliant++
+				case evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT,
+					evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT_MANUALLY:
+					l.NotCompliant++
+				case evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_APPLICABLE:
+					// Controls that are not applicable do not affect the assurance level
+				default:
+					l.Pending++
+				}
+			}
+		}
+
+		l.Fulfilled = l.NotCompliant == 0 && l.Pending == 0
+		levels = append(levels, l)
+	}
+
+	return
+}
+
+// latestStatus returns the status of the evaluation result of the given control, if any.
+func latestStatus(control *orchestrator.Control, results []*evaluation.EvaluationResult) evaluation.EvaluationStatus {
+	for _, result := range results {
+		if result.ControlCategoryName == control.CategoryName && result.ControlId == control.Id {
+			return result.Status
+		}
+	}
+
+	return evaluation.EvaluationStatus_EVALUATION_STATUS_PENDING
+}
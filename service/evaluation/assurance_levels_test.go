@@ -0,0 +1,89 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package evaluation
+
+import (
+	"testing"
+
+	"clouditor.io/clouditor/v2/api/evaluation"
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/util"
+)
+
+func Test_assuranceLevelCompliance(t *testing.T) {
+	type args struct {
+		catalog *orchestrator.Catalog
+		results []*evaluation.EvaluationResult
+	}
+	tests := []struct {
+		name string
+		args args
+		want []*evaluation.AssuranceLevelCompliance
+	}{
+		{
+			name: "catalog without assurance levels",
+			args: args{
+				catalog: &orchestrator.Catalog{},
+			},
+			want: nil,
+		},
+		{
+			name: "controls of higher levels are only counted for these levels",
+			args: args{
+				catalog: &orchestrator.Catalog{
+					AssuranceLevels: []string{testdata.AssuranceLevelBasic, testdata.AssuranceLevelSubstantial, testdata.AssuranceLevelHigh},
+					Categories: []*orchestrator.Category{
+						{
+							Name: testdata.MockCategoryName,
+							Controls: []*orchestrator.Control{
+								{Id: testdata.MockControlID1, CategoryName: testdata.MockCategoryName, AssuranceLevel: util.Ref(testdata.AssuranceLevelBasic)},
+								{Id: testdata.MockControlID2, CategoryName: testdata.MockCategoryName, AssuranceLevel: util.Ref(testdata.AssuranceLevelSubstantial)},
+								{Id: "Control-3", CategoryName: testdata.MockCategoryName, AssuranceLevel: util.Ref(testdata.AssuranceLevelHigh)},
+							},
+						},
+					},
+				},
+				results: []*evaluation.EvaluationResult{
+					newMockParentResult(testdata.MockControlID1, evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT),
+					newMockParentResult(testdata.MockControlID2, evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT_MANUALLY),
+				},
+			},
+			want: []*evaluation.AssuranceLevelCompliance{
+				{AssuranceLevel: testdata.AssuranceLevelBasic, Compliant: 1, Fulfilled: true},
+				{AssuranceLevel: testdata.AssuranceLevelSubstantial, Compliant: 1, NotCompliant: 1},
+				{AssuranceLevel: testdata.AssuranceLevelHigh, Compliant: 1, NotCompliant: 1, Pending: 1},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := assuranceLevelCompliance(tt.args.catalog, tt.args.results)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,262 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package evaluation
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"clouditor.io/clouditor/v2/api"
+	"clouditor.io/clouditor/v2/api/evaluation"
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/internal/logging"
+	"clouditor.io/clouditor/v2/persistence"
+	"clouditor.io/clouditor/v2/service"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ErrAttestationNotFound indicates the attestation was not found
+var ErrAttestationNotFound = api.ErrorCodeAttestationNotFound.Err()
+
+// CreateAttestation is a method implementation of the evaluation interface: It creates a new manual attestation of a
+// control, optionally including its documents.
+func (svc *Service) CreateAttestation(ctx context.Context, req *evaluation.CreateAttestationRequest) (res *evaluation.Attestation, err error) {
+	if !req.Attestation.IsActive(time.Now()) {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", evaluation.ErrAttestationExpired)
+	}
+
+	// Check, if this request has access to the cloud service according to our authorization strategy.
+	if !svc.authz.CheckAccess(ctx, service.AccessCreate, req) {
+		return nil, service.ErrPermissionDenied
+	}
+
+	res = req.Attestation
+	res.Id = uuid.NewString()
+	res.CreatedAt = timestamppb.Now()
+
+	for _, doc := range res.Documents {
+		prepareDocument(res.Id, doc)
+	}
+
+	err = svc.storage.Create(res)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
+	logging.LogRequest(log, logrus.DebugLevel, logging.Create, req)
+
+	return res, nil
+}
+
+// GetAttestation is a method implementation of the evaluation interface: It returns an attestation including the
+// content of its documents.
+func (svc *Service) GetAttestation(ctx context.Context, req *evaluation.GetAttestationRequest) (res *evaluation.Attestation, err error) {
+	return svc.getAttestation(ctx, req.AttestationId)
+}
+
+// ListAttestations is a method implementation of the evaluation interface: It lists all attestations the user is
+// authorized to see. The content of the documents is omitted.
+func (svc *Service) ListAttestations(ctx context.Context, req *evaluation.ListAttestationsRequest) (res *evaluation.ListAttestationsResponse, err error) {
+	var (
+		query []string
+		args  []any
+	)
+
+	// Retrieve list of allowed cloud service according to our authorization strategy
+	all, allowed := svc.authz.AllowedCloudServices(ctx)
+
+	if req.Filter != nil {
+		// The content of the filtered cloud service ID must be in the list of allowed cloud service IDs, unless one can
+		// access *all* the cloud services.
+		if !all && req.Filter.CloudServiceId != nil && !slices.Contains(allowed, req.Filter.GetCloudServiceId()) {
+			return nil, service.ErrPermissionDenied
+		}
+
+		if req.Filter.CloudServiceId != nil {
+			query = append(query, "cloud_service_id = ?")
+			args = append(args, req.Filter.GetCloudServiceId())
+		}
+
+		if req.Filter.CatalogId != nil {
+			query = append(query, "catalog_id = ?")
+			args = append(args, req.Filter.GetCatalogId())
+		}
+
+		if req.Filter.ControlId != nil {
+			query = append(query, "control_id = ?")
+			args = append(args, req.Filter.GetControlId())
+		}
+
+		if req.Filter.Active != nil {
+			if req.Filter.GetActive() {
+				query = append(query, "expires_at > ?")
+			} else {
+				query = append(query, "expires_at <= ?")
+			}
+			args = append(args, time.Now())
+		}
+	}
+
+	if !all {
+		query = append(query, "cloud_service_id IN ?")
+		args = append(args, allowed)
+	}
+
+	res = new(evaluation.ListAttestationsResponse)
+
+	res.Attestations, res.NextPageToken, err = service.PaginateStorage[*evaluation.Attestation](req, svc.storage,
+		service.DefaultPaginationOpts, persistence.BuildConds(query, args)...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not paginate results: %v", err)
+	}
+
+	// Documents can be rather large, so we only return their metadata in the list
+	for _, a := range res.Attestations {
+		for _, doc := range a.Documents {
+			doc.Content = nil
+		}
+	}
+
+	return
+}
+
+// AddAttestationDocument is a method implementation of the evaluation interface: It uploads a document as evidence
+// of an existing attestation.
+func (svc *Service) AddAttestationDocument(ctx context.Context, req *evaluation.AddAttestationDocumentRequest) (res *evaluation.AttestationDocument, err error) {
+	// Check, if the attestation exists and if we have access to it
+	_, err = svc.getAttestation(ctx, req.AttestationId)
+	if err != nil {
+		return nil, err
+	}
+
+	res = req.Document
+	prepareDocument(req.AttestationId, res)
+
+	err = svc.storage.Create(res)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
+	logging.LogRequest(log, logrus.DebugLevel, logging.Update, req, fmt.Sprintf("with added document '%s'", res.Filename))
+
+	return res, nil
+}
+
+// RemoveAttestation is a method implementation of the evaluation interface: It removes (revokes) an attestation
+// including its documents.
+func (svc *Service) RemoveAttestation(ctx context.Context, req *evaluation.RemoveAttestationRequest) (res *emptypb.Empty, err error) {
+	// Check, if the attestation exists and if we have access to it
+	_, err = svc.getAttestation(ctx, req.AttestationId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = svc.storage.Delete(&evaluation.Attestation{}, "id = ?", req.AttestationId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
+	logging.LogRequest(log, logrus.DebugLevel, logging.Remove, req)
+
+	return &emptypb.Empty{}, nil
+}
+
+// getAttestation retrieves the attestation with the given ID from the storage and checks, whether the user has access
+// to its cloud service.
+func (svc *Service) getAttestation(ctx context.Context, id string) (attestation *evaluation.Attestation, err error) {
+	attestation = new(evaluation.Attestation)
+
+	err = svc.storage.Get(attestation, "id = ?", id)
+	if errors.Is(err, persistence.ErrRecordNotFound) {
+		return nil, ErrAttestationNotFound
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
+	all, allowed := svc.authz.AllowedCloudServices(ctx)
+	if !all && !slices.Contains(allowed, attestation.CloudServiceId) {
+		return nil, service.ErrPermissionDenied
+	}
+
+	return attestation, nil
+}
+
+// activeAttestation retrieves the latest active attestation of the control for the target of evaluation. Errors are
+// only logged, in which case only the automated results are considered.
+func (svc *Service) activeAttestation(toe *orchestrator.TargetOfEvaluation, control *orchestrator.Control) *evaluation.Attestation {
+	var attestations []*evaluation.Attestation
+
+	err := svc.storage.List(&attestations, "created_at", false, 0, 1,
+		"cloud_service_id = ? AND catalog_id = ? AND control_category_name = ? AND control_id = ? AND expires_at > ?",
+		toe.GetCloudServiceId(), toe.GetCatalogId(), control.GetCategoryName(), control.GetId(), time.Now())
+	if err != nil {
+		log.Errorf("could not get attestations for control ID '%s' and Cloud Service '%s': %v", control.GetId(), toe.GetCloudServiceId(), err)
+		return nil
+	}
+
+	if len(attestations) == 0 {
+		return nil
+	}
+
+	return attestations[0]
+}
+
+// mergeAttestation merges the automated evaluation status of a control with the manual decision of an attestation. A
+// control is only compliant if neither the automated results nor the reviewer found it to be non-compliant. If there
+// are no automated results (yet) or the control is not applicable, the manual decision is used.
+func mergeAttestation(automated evaluation.EvaluationStatus, attestation *evaluation.Attestation) evaluation.EvaluationStatus {
+	if attestation == nil {
+		return automated
+	}
+
+	if !attestation.Compliant || automated == evaluation.EvaluationStatus_EVALUATION_STATUS_PENDING ||
+		automated == evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_APPLICABLE {
+		return attestation.Status()
+	}
+
+	return automated
+}
+
+// prepareDocument assigns a new ID and the checksum to an uploaded document of the attestation.
+func prepareDocument(attestationID string, doc *evaluation.AttestationDocument) {
+	sum := sha256.Sum256(doc.Content)
+
+	doc.Id = uuid.NewString()
+	doc.AttestationId = attestationID
+	doc.Sha256 = hex.EncodeToString(sum[:])
+	doc.CreatedAt = timestamppb.Now()
+}
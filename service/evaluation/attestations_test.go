@@ -0,0 +1,515 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package evaluation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"clouditor.io/clouditor/v2/api/evaluation"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/testutil/servicetest"
+	"clouditor.io/clouditor/v2/internal/util"
+	"clouditor.io/clouditor/v2/persistence"
+	"clouditor.io/clouditor/v2/service"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// newAttestation creates a new attestation of the first mock sub-control that expires at the given time.
+func newAttestation(id string, cloudServiceID string, compliant bool, expiresAt time.Time) *evaluation.Attestation {
+	return &evaluation.Attestation{
+		Id:                  id,
+		CloudServiceId:      cloudServiceID,
+		CatalogId:           testdata.MockCatalogID,
+		ControlCategoryName: testdata.MockCategoryName,
+		ControlId:           testdata.MockSubControlID11,
+		Compliant:           compliant,
+		Reviewer:            testdata.MockAttestationReviewer,
+		ExpiresAt:           timestamppb.New(expiresAt),
+		CreatedAt:           timestamppb.Now(),
+		Documents: []*evaluation.AttestationDocument{
+			{
+				Id:            testdata.MockAttestationID1,
+				AttestationId: id,
+				Filename:      testdata.MockAttestationDocument,
+				Content:       []byte("signed"),
+			},
+		},
+	}
+}
+
+// newAttestationStorage creates a new storage that contains an active attestation of cloud service 1 and an expired
+// attestation of cloud service 2.
+func newAttestationStorage(t *testing.T) persistence.Storage {
+	return testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+		assert.NoError(t, s.Create(newAttestation(testdata.MockAttestationID1, testdata.MockCloudServiceID1, true, time.Now().Add(time.Hour))))
+
+		expired := newAttestation(testdata.MockAttestationID2, testdata.MockCloudServiceID2, false, time.Now().Add(-time.Hour))
+		expired.Documents = nil
+		assert.NoError(t, s.Create(expired))
+	})
+}
+
+func TestService_CreateAttestation(t *testing.T) {
+	type fields struct {
+		authz service.AuthorizationStrategy
+	}
+	type args struct {
+		req *evaluation.CreateAttestationRequest
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    assert.Want[*evaluation.Attestation]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "validation error",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &evaluation.CreateAttestationRequest{Attestation: &evaluation.Attestation{}},
+			},
+			want: assert.Nil[*evaluation.Attestation],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "attestation.reviewer: value length must be at least 1 characters")
+			},
+		},
+		{
+			name: "expired",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &evaluation.CreateAttestationRequest{
+					Attestation: newAttestation("", testdata.MockCloudServiceID1, true, time.Now().Add(-time.Hour)),
+				},
+			},
+			want: assert.Nil[*evaluation.Attestation],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, evaluation.ErrAttestationExpired.Error())
+			},
+		},
+		{
+			name: "permission denied",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategy(false, testdata.MockCloudServiceID2),
+			},
+			args: args{
+				req: &evaluation.CreateAttestationRequest{
+					Attestation: newAttestation("", testdata.MockCloudServiceID1, true, time.Now().Add(time.Hour)),
+				},
+			},
+			want: assert.Nil[*evaluation.Attestation],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, service.ErrPermissionDenied)
+			},
+		},
+		{
+			name: "happy path",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategy(false, testdata.MockCloudServiceID1),
+			},
+			args: args{
+				req: &evaluation.CreateAttestationRequest{
+					Attestation: newAttestation("", testdata.MockCloudServiceID1, true, time.Now().Add(time.Hour)),
+				},
+			},
+			want: func(t *testing.T, got *evaluation.Attestation) bool {
+				return assert.NotEmpty(t, got.Id) &&
+					assert.NotNil(t, got.CreatedAt) &&
+					assert.Equal(t, 1, len(got.Documents)) &&
+					assert.Equal(t, got.Id, got.Documents[0].AttestationId) &&
+					// SHA-256 of "signed"
+					assert.Equal(t, "4a3cdfae6f291c8f544daea5b72905cf9e74c1ed427d831ad0d7ca00c73c794d", got.Documents[0].Sha256)
+			},
+			wantErr: assert.Nil[error],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				storage: testutil.NewInMemoryStorage(t),
+				authz:   tt.fields.authz,
+			}
+			got, err := servicetest.Validated(svc.CreateAttestation)(context.Background(), tt.args.req)
+
+			tt.wantErr(t, err)
+			tt.want(t, got)
+		})
+	}
+}
+
+func TestService_GetAttestation(t *testing.T) {
+	type fields struct {
+		authz service.AuthorizationStrategy
+	}
+	type args struct {
+		req *evaluation.GetAttestationRequest
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    assert.Want[*evaluation.Attestation]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "not found",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &evaluation.GetAttestationRequest{AttestationId: "00000000-0000-0000-0000-000000000000"},
+			},
+			want: assert.Nil[*evaluation.Attestation],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, ErrAttestationNotFound)
+			},
+		},
+		{
+			name: "permission denied",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategy(false, testdata.MockCloudServiceID2),
+			},
+			args: args{
+				req: &evaluation.GetAttestationRequest{AttestationId: testdata.MockAttestationID1},
+			},
+			want: assert.Nil[*evaluation.Attestation],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, service.ErrPermissionDenied)
+			},
+		},
+		{
+			name: "happy path",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategy(false, testdata.MockCloudServiceID1),
+			},
+			args: args{
+				req: &evaluation.GetAttestationRequest{AttestationId: testdata.MockAttestationID1},
+			},
+			want: func(t *testing.T, got *evaluation.Attestation) bool {
+				return assert.Equal(t, testdata.MockAttestationID1, got.Id) &&
+					assert.Equal(t, 1, len(got.Documents)) &&
+					assert.Equal(t, []byte("signed"), got.Documents[0].Content)
+			},
+			wantErr: assert.Nil[error],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				storage: newAttestationStorage(t),
+				authz:   tt.fields.authz,
+			}
+			got, err := svc.GetAttestation(context.Background(), tt.args.req)
+
+			tt.wantErr(t, err)
+			tt.want(t, got)
+		})
+	}
+}
+
+func TestService_ListAttestations(t *testing.T) {
+	type fields struct {
+		authz service.AuthorizationStrategy
+	}
+	type args struct {
+		req *evaluation.ListAttestationsRequest
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    assert.Want[*evaluation.ListAttestationsResponse]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "permission denied",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategy(false, testdata.MockCloudServiceID1),
+			},
+			args: args{
+				req: &evaluation.ListAttestationsRequest{
+					Filter: &evaluation.ListAttestationsRequest_Filter{
+						CloudServiceId: util.Ref(testdata.MockCloudServiceID2),
+					},
+				},
+			},
+			want: assert.Nil[*evaluation.ListAttestationsResponse],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, service.ErrPermissionDenied)
+			},
+		},
+		{
+			name: "only allowed cloud services",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategy(false, testdata.MockCloudServiceID1),
+			},
+			args: args{
+				req: &evaluation.ListAttestationsRequest{},
+			},
+			want: func(t *testing.T, got *evaluation.ListAttestationsResponse) bool {
+				return assert.Equal(t, 1, len(got.Attestations)) &&
+					assert.Equal(t, testdata.MockAttestationID1, got.Attestations[0].Id) &&
+					assert.Equal(t, 1, len(got.Attestations[0].Documents)) &&
+					assert.Equal(t, testdata.MockAttestationDocument, got.Attestations[0].Documents[0].Filename) &&
+					assert.Nil(t, got.Attestations[0].Documents[0].Content)
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "filter expired",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &evaluation.ListAttestationsRequest{
+					Filter: &evaluation.ListAttestationsRequest_Filter{
+						CatalogId: util.Ref(testdata.MockCatalogID),
+						ControlId: util.Ref(testdata.MockSubControlID11),
+						Active:    util.Ref(false),
+					},
+				},
+			},
+			want: func(t *testing.T, got *evaluation.ListAttestationsResponse) bool {
+				return assert.Equal(t, 1, len(got.Attestations)) &&
+					assert.Equal(t, testdata.MockAttestationID2, got.Attestations[0].Id)
+			},
+			wantErr: assert.Nil[error],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				storage: newAttestationStorage(t),
+				authz:   tt.fields.authz,
+			}
+			got, err := svc.ListAttestations(context.Background(), tt.args.req)
+
+			tt.wantErr(t, err)
+			tt.want(t, got)
+		})
+	}
+}
+
+func TestService_AddAttestationDocument(t *testing.T) {
+	type args struct {
+		req *evaluation.AddAttestationDocumentRequest
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    assert.Want[*evaluation.AttestationDocument]
+		wantSvc assert.Want[*Service]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "validation error",
+			args: args{
+				req: &evaluation.AddAttestationDocumentRequest{
+					AttestationId: testdata.MockAttestationID1,
+					Document:      &evaluation.AttestationDocument{Filename: testdata.MockAttestationDocument},
+				},
+			},
+			want: assert.Nil[*evaluation.AttestationDocument],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "document.content: value length must be at least 1 bytes")
+			},
+		},
+		{
+			name: "attestation not found",
+			args: args{
+				req: &evaluation.AddAttestationDocumentRequest{
+					AttestationId: "00000000-0000-0000-0000-000000000000",
+					Document:      &evaluation.AttestationDocument{Filename: testdata.MockAttestationDocument, Content: []byte("training")},
+				},
+			},
+			want: assert.Nil[*evaluation.AttestationDocument],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, ErrAttestationNotFound)
+			},
+		},
+		{
+			name: "happy path",
+			args: args{
+				req: &evaluation.AddAttestationDocumentRequest{
+					AttestationId: testdata.MockAttestationID2,
+					Document:      &evaluation.AttestationDocument{Filename: "training.csv", Content: []byte("training")},
+				},
+			},
+			want: func(t *testing.T, got *evaluation.AttestationDocument) bool {
+				return assert.NotEmpty(t, got.Id) &&
+					assert.Equal(t, testdata.MockAttestationID2, got.AttestationId) &&
+					assert.NotEmpty(t, got.Sha256)
+			},
+			wantSvc: func(t *testing.T, svc *Service) bool {
+				a, err := svc.getAttestation(context.Background(), testdata.MockAttestationID2)
+				return assert.NoError(t, err) &&
+					assert.Equal(t, 1, len(a.Documents)) &&
+					assert.Equal(t, "training.csv", a.Documents[0].Filename)
+			},
+			wantErr: assert.Nil[error],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				storage: newAttestationStorage(t),
+				authz:   servicetest.NewAuthorizationStrategy(true),
+			}
+			got, err := servicetest.Validated(svc.AddAttestationDocument)(context.Background(), tt.args.req)
+
+			tt.wantErr(t, err)
+			tt.want(t, got)
+			assert.Optional(t, tt.wantSvc, svc)
+		})
+	}
+}
+
+func TestService_RemoveAttestation(t *testing.T) {
+	type fields struct {
+		authz service.AuthorizationStrategy
+	}
+	type args struct {
+		req *evaluation.RemoveAttestationRequest
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    assert.Want[*emptypb.Empty]
+		wantSvc assert.Want[*Service]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "permission denied",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategy(false, testdata.MockCloudServiceID2),
+			},
+			args: args{
+				req: &evaluation.RemoveAttestationRequest{AttestationId: testdata.MockAttestationID1},
+			},
+			want: assert.Nil[*emptypb.Empty],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, service.ErrPermissionDenied)
+			},
+		},
+		{
+			name: "happy path",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &evaluation.RemoveAttestationRequest{AttestationId: testdata.MockAttestationID1},
+			},
+			want: assert.NotNil[*emptypb.Empty],
+			wantSvc: func(t *testing.T, svc *Service) bool {
+				count, err := svc.storage.Count(&evaluation.AttestationDocument{})
+				return assert.NoError(t, err) && assert.Equal(t, int64(0), count)
+			},
+			wantErr: assert.Nil[error],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				storage: newAttestationStorage(t),
+				authz:   tt.fields.authz,
+			}
+			got, err := svc.RemoveAttestation(context.Background(), tt.args.req)
+
+			tt.wantErr(t, err)
+			tt.want(t, got)
+			assert.Optional(t, tt.wantSvc, svc)
+		})
+	}
+}
+
+func Test_mergeAttestation(t *testing.T) {
+	type args struct {
+		automated   evaluation.EvaluationStatus
+		attestation *evaluation.Attestation
+	}
+	tests := []struct {
+		name string
+		args args
+		want evaluation.EvaluationStatus
+	}{
+		{
+			name: "no attestation",
+			args: args{
+				automated: evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT,
+			},
+			want: evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT,
+		},
+		{
+			name: "no automated results",
+			args: args{
+				automated:   evaluation.EvaluationStatus_EVALUATION_STATUS_PENDING,
+				attestation: &evaluation.Attestation{Compliant: true},
+			},
+			want: evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT_MANUALLY,
+		},
+		{
+			name: "both compliant",
+			args: args{
+				automated:   evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT,
+				attestation: &evaluation.Attestation{Compliant: true},
+			},
+			want: evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT,
+		},
+		{
+			name: "automated results not compliant",
+			args: args{
+				automated:   evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT,
+				attestation: &evaluation.Attestation{Compliant: true},
+			},
+			want: evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT,
+		},
+		{
+			name: "reviewer not compliant",
+			args: args{
+				automated:   evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT,
+				attestation: &evaluation.Attestation{Compliant: false},
+			},
+			want: evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT_MANUALLY,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := mergeAttestation(tt.args.automated, tt.args.attestation)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package evaluation
+
+import (
+	"context"
+	"slices"
+
+	"clouditor.io/clouditor/v2/api/evaluation"
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/internal/telemetry"
+)
+
+// updateCertificateStates reports the compliance of the target of evaluation to the certification lifecycle engine of
+// the Orchestrator, which derives the state changes of its certificates, e.g., it suspends them, if one of the (parent)
+// controls of its catalog is not compliant. Nothing is reported while controls are still pending. It also updates the
+// compliance ratio metric of the target of evaluation. Errors are only logged, since the state of the certificates is
+// not crucial for the evaluation.
+func (svc *Service) updateCertificateStates(toe *orchestrator.TargetOfEvaluation) {
+	var (
+		results      []*evaluation.EvaluationResult
+		nonCompliant []string
+		allCompliant bool
+	)
+
+	err := svc.storage.Raw(&results,
+		`WITH sorted_results AS (
+			SELECT *, ROW_NUMBER() OVER (PARTITION BY control_id ORDER BY timestamp DESC) AS row_number
+			FROM evaluation_results
+			WHERE cloud_service_id = ? AND control_catalog_id = ? AND parent_control_id IS NULL
+		)
+		SELECT * FROM sorted_results WHERE row_number = 1 ORDER BY control_id;`, toe.CloudServiceId, toe.CatalogId)
+	if err != nil {
+		log.Errorf("Could not retrieve latest evaluation results of Cloud Service '%s' and Catalog ID '%s': %v", toe.CloudServiceId, toe.CatalogId, err)
+		return
+	}
+
+	// Also expose the compliance ratio of the target of evaluation for monitoring purposes
+	telemetry.ComplianceRatio.WithLabelValues(toe.CloudServiceId, toe.CatalogId).Set(complianceRatio(results))
+
+	for _, result := range results {
+		switch result.Status {
+		case evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT, evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT_MANUALLY:
+			nonCompliant = append(nonCompliant, result.ControlId)
+		}
+	}
+
+	// Controls that are not applicable neither count towards nor against the certificate
+	applicable := slices.DeleteFunc(slices.Clone(results), func(result *evaluation.EvaluationResult) bool {
+		return result.Status == evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_APPLICABLE
+	})
+
+	allCompliant = len(applicable) > 0 && !slices.ContainsFunc(applicable, func(result *evaluation.EvaluationResult) bool {
+		return result.Status != evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT &&
+			result.Status != evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT_MANUALLY
+	})
+
+	// If some controls are still pending, we do not report anything
+	if len(nonCompliant) == 0 && !allCompliant {
+		return
+	}
+
+	res, err := svc.orchestrator.Client.ReportCertificationObservation(context.Background(), &orchestrator.ReportCertificationObservationRequest{
+		Observation: &orchestrator.CertificationObservation{
+			CloudServiceId:       toe.CloudServiceId,
+			CatalogId:            toe.CatalogId,
+			Compliant:            allCompliant,
+			NonCompliantControls: nonCompliant,
+		},
+	})
+	if err != nil {
+		log.Errorf("Could not report certification observation of Cloud Service '%s' to Orchestrator: %v", toe.CloudServiceId, err)
+		return
+	}
+
+	for _, cert := range res.ChangedCertificates {
+		log.Infof("Certificate %s of Cloud Service '%s' is now %s", cert.Id, toe.CloudServiceId, cert.CurrentState.Name())
+	}
+}
+
+// complianceRatio returns the ratio of compliant (top-level) controls in the given evaluation results. Pending controls
+// are not considered to be compliant, controls that are not applicable are not considered at all.
+func complianceRatio(results []*evaluation.EvaluationResult) float64 {
+	var compliant, applicable int
+
+	for _, result := range results {
+		switch result.Status {
+		case evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT, evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT_MANUALLY:
+			compliant++
+		case evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_APPLICABLE:
+			continue
+		}
+
+		applicable++
+	}
+
+	if applicable == 0 {
+		return 0
+	}
+
+	return float64(compliant) / float64(applicable)
+}
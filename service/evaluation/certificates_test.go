@@ -0,0 +1,195 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package evaluation
+
+import (
+	"testing"
+	"time"
+
+	"clouditor.io/clouditor/v2/api"
+	"clouditor.io/clouditor/v2/api/evaluation"
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/util"
+	"clouditor.io/clouditor/v2/persistence"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func newMockLifecycleCertificate(id string, catalogID *string, state orchestrator.CertificateState, automatic bool) *orchestrator.Certificate {
+	cert := &orchestrator.Certificate{
+		Id:             id,
+		Name:           id,
+		CloudServiceId: testdata.MockCloudServiceID1,
+		ExpirationDate: time.Now().AddDate(1, 0, 0).Format(time.RFC3339),
+		CatalogId:      catalogID,
+		CurrentState:   orchestrator.CertificateState_CERTIFICATE_STATE_ISSUED,
+	}
+
+	if state == orchestrator.CertificateState_CERTIFICATE_STATE_SUSPENDED {
+		_ = cert.TransitionTo(state, nil, automatic, time.Now().Add(-time.Hour))
+	}
+
+	return cert
+}
+
+func newMockParentResult(controlID string, status evaluation.EvaluationStatus) *evaluation.EvaluationResult {
+	return &evaluation.EvaluationResult{
+		Id:                  uuid.NewString(),
+		Timestamp:           timestamppb.Now(),
+		CloudServiceId:      testdata.MockCloudServiceID1,
+		ControlCatalogId:    testdata.MockCatalogID,
+		ControlCategoryName: testdata.MockCategoryName,
+		ControlId:           controlID,
+		Status:              status,
+	}
+}
+
+func TestService_updateCertificateStates(t *testing.T) {
+	type fields struct {
+		results []*evaluation.EvaluationResult
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   map[string]orchestrator.CertificateState
+	}{
+		{
+			name: "Non-compliant control suspends certificates of the catalog",
+			fields: fields{
+				results: []*evaluation.EvaluationResult{
+					newMockParentResult(testdata.MockControlID1, evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT),
+					newMockParentResult(testdata.MockControlID2, evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT),
+				},
+			},
+			want: map[string]orchestrator.CertificateState{
+				"issued":         orchestrator.CertificateState_CERTIFICATE_STATE_SUSPENDED,
+				"no-catalog":     orchestrator.CertificateState_CERTIFICATE_STATE_ISSUED,
+				"auto-suspended": orchestrator.CertificateState_CERTIFICATE_STATE_SUSPENDED,
+				"suspended":      orchestrator.CertificateState_CERTIFICATE_STATE_SUSPENDED,
+			},
+		},
+		{
+			name: "Compliant controls re-issue automatically suspended certificates",
+			fields: fields{
+				results: []*evaluation.EvaluationResult{
+					newMockParentResult(testdata.MockControlID1, evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT),
+					newMockParentResult(testdata.MockControlID2, evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT_MANUALLY),
+				},
+			},
+			want: map[string]orchestrator.CertificateState{
+				"issued":         orchestrator.CertificateState_CERTIFICATE_STATE_ISSUED,
+				"no-catalog":     orchestrator.CertificateState_CERTIFICATE_STATE_ISSUED,
+				"auto-suspended": orchestrator.CertificateState_CERTIFICATE_STATE_ISSUED,
+				"suspended":      orchestrator.CertificateState_CERTIFICATE_STATE_SUSPENDED,
+			},
+		},
+		{
+			name: "Pending controls do not change anything",
+			fields: fields{
+				results: []*evaluation.EvaluationResult{
+					newMockParentResult(testdata.MockControlID1, evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT),
+					newMockParentResult(testdata.MockControlID2, evaluation.EvaluationStatus_EVALUATION_STATUS_PENDING),
+				},
+			},
+			want: map[string]orchestrator.CertificateState{
+				"issued":         orchestrator.CertificateState_CERTIFICATE_STATE_ISSUED,
+				"no-catalog":     orchestrator.CertificateState_CERTIFICATE_STATE_ISSUED,
+				"auto-suspended": orchestrator.CertificateState_CERTIFICATE_STATE_SUSPENDED,
+				"suspended":      orchestrator.CertificateState_CERTIFICATE_STATE_SUSPENDED,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			orchestratorStorage := testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+				assert.NoError(t, s.Create(newMockLifecycleCertificate("issued", util.Ref(testdata.MockCatalogID), orchestrator.CertificateState_CERTIFICATE_STATE_ISSUED, false)))
+				assert.NoError(t, s.Create(newMockLifecycleCertificate("no-catalog", nil, orchestrator.CertificateState_CERTIFICATE_STATE_ISSUED, false)))
+				assert.NoError(t, s.Create(newMockLifecycleCertificate("auto-suspended", util.Ref(testdata.MockCatalogID), orchestrator.CertificateState_CERTIFICATE_STATE_SUSPENDED, true)))
+				assert.NoError(t, s.Create(newMockLifecycleCertificate("suspended", util.Ref(testdata.MockCatalogID), orchestrator.CertificateState_CERTIFICATE_STATE_SUSPENDED, false)))
+			})
+
+			svc := &Service{
+				orchestrator: api.NewRPCConnection("bufnet", orchestrator.NewOrchestratorClient, grpc.WithContextDialer(newBufConnDialer(orchestratorStorage))),
+				storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					assert.NoError(t, s.Create(tt.fields.results))
+				}),
+			}
+
+			svc.updateCertificateStates(&orchestrator.TargetOfEvaluation{
+				CloudServiceId: testdata.MockCloudServiceID1,
+				CatalogId:      testdata.MockCatalogID,
+			})
+
+			for id, want := range tt.want {
+				cert := new(orchestrator.Certificate)
+				assert.NoError(t, orchestratorStorage.Get(cert, "id = ?", id))
+				if cert.CurrentState != want {
+					t.Errorf("state of certificate %s = %v, want %v", id, cert.CurrentState, want)
+				}
+			}
+		})
+	}
+}
+
+func Test_complianceRatio(t *testing.T) {
+	type args struct {
+		results []*evaluation.EvaluationResult
+	}
+	tests := []struct {
+		name string
+		args args
+		want float64
+	}{
+		{
+			name: "no results",
+			args: args{},
+			want: 0,
+		},
+		{
+			name: "partially compliant",
+			args: args{
+				results: []*evaluation.EvaluationResult{
+					{Status: evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT},
+					{Status: evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT_MANUALLY},
+					{Status: evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT},
+					{Status: evaluation.EvaluationStatus_EVALUATION_STATUS_PENDING},
+				},
+			},
+			want: 0.5,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := complianceRatio(tt.args.results)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
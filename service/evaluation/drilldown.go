@@ -0,0 +1,179 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package evaluation
+
+import (
+	"context"
+	"slices"
+
+	"clouditor.io/clouditor/v2/api"
+	"clouditor.io/clouditor/v2/api/assessment"
+	"clouditor.io/clouditor/v2/api/evaluation"
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/internal/util"
+	"clouditor.io/clouditor/v2/persistence"
+	"clouditor.io/clouditor/v2/service"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrEvaluationResultNotFound indicates that no evaluation result of the control was found
+var ErrEvaluationResultNotFound = api.ErrorCodeEvaluationResultNotFound.Err()
+
+// DrillDownEvaluationResult is a method implementation of the evaluation interface: It retrieves the latest evaluation
+// result of a control (at or before the requested time) together with the assessment results that contributed to it
+// and the resources that are responsible for a non-compliant status.
+func (svc *Service) DrillDownEvaluationResult(ctx context.Context, req *evaluation.DrillDownEvaluationResultRequest) (res *evaluation.DrillDownEvaluationResultResponse, err error) {
+	var (
+		results []*evaluation.EvaluationResult
+		query   = []string{"cloud_service_id = ?", "control_catalog_id = ?", "control_category_name = ?", "control_id = ?"}
+		args    = []any{req.GetCloudServiceId(), req.GetCatalogId(), req.GetCategoryName(), req.GetControlId()}
+	)
+
+	// Check, if this request has access to the cloud service according to our authorization strategy.
+	if !svc.authz.CheckAccess(ctx, service.AccessRead, req) {
+		return nil, service.ErrPermissionDenied
+	}
+
+	if req.Timestamp != nil {
+		query = append(query, "timestamp <= ?")
+		args = append(args, req.Timestamp.AsTime())
+	}
+
+	err = svc.storage.List(&results, "timestamp", false, 0, 1, persistence.BuildConds(query, args)...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
+	if len(results) == 0 {
+		return nil, ErrEvaluationResultNotFound
+	}
+
+	res = &evaluation.DrillDownEvaluationResultResponse{
+		Result: results[0],
+	}
+
+	res.AssessmentResults, err = svc.contributingAssessmentResults(ctx, res.Result)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not retrieve assessment results: %v", err)
+	}
+
+	res.FailingResources = failingResources(res.AssessmentResults, res.Result.FailingAssessmentResultIds)
+
+	return
+}
+
+// contributingAssessmentResults retrieves the latest assessment results (per resource) of the metrics of the evaluated
+// control at the time of the evaluation. Failing or waived assessment results of the evaluation result that are not
+// part of them, e.g., because the metrics of the control changed in the meantime, are retrieved individually.
+func (svc *Service) contributingAssessmentResults(ctx context.Context, result *evaluation.EvaluationResult) (assessments []*assessment.AssessmentResult, err error) {
+	var (
+		metrics []*assessment.Metric
+		missing []string
+	)
+
+	if svc.catalogControls[result.GetControlCatalogId()] == nil {
+		err = svc.cacheControls(result.GetControlCatalogId())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	metrics, err = svc.getAllMetricsFromControl(result.GetControlCatalogId(), result.GetControlCategoryName(), result.GetControlId())
+	if err != nil {
+		return nil, err
+	}
+
+	if len(metrics) != 0 {
+		assessments, err = api.ListAllPaginated(&orchestrator.ListAssessmentResultsRequest{
+			Filter: &orchestrator.Filter{
+				CloudServiceId: &result.CloudServiceId,
+				MetricIds:      getMetricIds(metrics),
+				Until:          result.Timestamp,
+			},
+			LatestByResourceId: util.Ref(true),
+		}, svc.orchestrator.Client.ListAssessmentResults, func(res *orchestrator.ListAssessmentResultsResponse) []*assessment.AssessmentResult {
+			return res.Results
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, id := range append(slices.Clone(result.FailingAssessmentResultIds), result.WaivedAssessmentResultIds...) {
+		if !slices.ContainsFunc(assessments, func(a *assessment.AssessmentResult) bool { return a.GetId() == id }) {
+			missing = append(missing, id)
+		}
+	}
+
+	for _, id := range missing {
+		var a *assessment.AssessmentResult
+
+		a, err = svc.orchestrator.Client.GetAssessmentResult(ctx, &orchestrator.GetAssessmentResultRequest{Id: id})
+		if status.Code(err) == codes.NotFound {
+			log.Warnf("Failing assessment result '%s' of evaluation result '%s' is not available anymore", id, result.GetId())
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		assessments = append(assessments, a)
+	}
+
+	return
+}
+
+// failingResources groups the failing assessment results by their resource.
+func failingResources(assessments []*assessment.AssessmentResult, failing []string) (resources []*evaluation.FailingResource) {
+	var byResource = make(map[string]*evaluation.FailingResource)
+
+	for _, a := range assessments {
+		if !slices.Contains(failing, a.GetId()) {
+			continue
+		}
+
+		r, ok := byResource[a.GetResourceId()]
+		if !ok {
+			r = &evaluation.FailingResource{
+				ResourceId:    a.GetResourceId(),
+				ResourceTypes: a.GetResourceTypes(),
+			}
+			byResource[a.GetResourceId()] = r
+			resources = append(resources, r)
+		}
+
+		r.AssessmentResultIds = append(r.AssessmentResultIds, a.GetId())
+		if !slices.Contains(r.MetricIds, a.GetMetricId()) {
+			r.MetricIds = append(r.MetricIds, a.GetMetricId())
+		}
+		if !slices.Contains(r.EvidenceIds, a.GetEvidenceId()) {
+			r.EvidenceIds = append(r.EvidenceIds, a.GetEvidenceId())
+		}
+	}
+
+	return
+}
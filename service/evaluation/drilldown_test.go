@@ -0,0 +1,256 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package evaluation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"clouditor.io/clouditor/v2/api"
+	"clouditor.io/clouditor/v2/api/assessment"
+	"clouditor.io/clouditor/v2/api/evaluation"
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/testutil/servicetest"
+	"clouditor.io/clouditor/v2/internal/testutil/servicetest/orchestratortest"
+	"clouditor.io/clouditor/v2/persistence"
+	"clouditor.io/clouditor/v2/service"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestService_DrillDownEvaluationResult(t *testing.T) {
+	var (
+		olderResult = &evaluation.EvaluationResult{
+			Id:                  "11111111-1111-1111-1111-111111111111",
+			CloudServiceId:      testdata.MockCloudServiceID1,
+			ControlId:           testdata.MockControlID1,
+			ControlCategoryName: testdata.MockCategoryName,
+			ControlCatalogId:    testdata.MockCatalogID,
+			Status:              evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT,
+			Timestamp:           timestamppb.New(time.Unix(10, 0)),
+		}
+		latestResult = &evaluation.EvaluationResult{
+			Id:                         "22222222-2222-2222-2222-222222222222",
+			CloudServiceId:             testdata.MockCloudServiceID1,
+			ControlId:                  testdata.MockControlID1,
+			ControlCategoryName:        testdata.MockCategoryName,
+			ControlCatalogId:           testdata.MockCatalogID,
+			Status:                     evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT,
+			Timestamp:                  timestamppb.New(time.Unix(20, 0)),
+			FailingAssessmentResultIds: []string{testdata.MockAssessmentResult3ID},
+		}
+	)
+
+	type fields struct {
+		authz service.AuthorizationStrategy
+	}
+	type args struct {
+		req *evaluation.DrillDownEvaluationResultRequest
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    assert.Want[*evaluation.DrillDownEvaluationResultResponse]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "validation error",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &evaluation.DrillDownEvaluationResultRequest{
+					CloudServiceId: testdata.MockCloudServiceID1,
+					CatalogId:      testdata.MockCatalogID,
+					CategoryName:   testdata.MockCategoryName,
+				},
+			},
+			want: assert.Nil[*evaluation.DrillDownEvaluationResultResponse],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "control_id: value length must be at least 1 characters")
+			},
+		},
+		{
+			name: "permission denied",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategy(false, testdata.MockCloudServiceID2),
+			},
+			args: args{
+				req: &evaluation.DrillDownEvaluationResultRequest{
+					CloudServiceId: testdata.MockCloudServiceID1,
+					CatalogId:      testdata.MockCatalogID,
+					CategoryName:   testdata.MockCategoryName,
+					ControlId:      testdata.MockControlID1,
+				},
+			},
+			want: assert.Nil[*evaluation.DrillDownEvaluationResultResponse],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, service.ErrPermissionDenied)
+			},
+		},
+		{
+			name: "evaluation result not found",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &evaluation.DrillDownEvaluationResultRequest{
+					CloudServiceId: testdata.MockCloudServiceID1,
+					CatalogId:      testdata.MockCatalogID,
+					CategoryName:   testdata.MockCategoryName,
+					ControlId:      testdata.MockControlID2,
+				},
+			},
+			want: assert.Nil[*evaluation.DrillDownEvaluationResultResponse],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, ErrEvaluationResultNotFound)
+			},
+		},
+		{
+			name: "happy path: latest result",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &evaluation.DrillDownEvaluationResultRequest{
+					CloudServiceId: testdata.MockCloudServiceID1,
+					CatalogId:      testdata.MockCatalogID,
+					CategoryName:   testdata.MockCategoryName,
+					ControlId:      testdata.MockControlID1,
+				},
+			},
+			want: func(t *testing.T, got *evaluation.DrillDownEvaluationResultResponse) bool {
+				// The failing assessment result does not belong to the metrics of the control (anymore), so it is
+				// retrieved individually
+				return assert.Equal(t, latestResult.Id, got.Result.Id) &&
+					assert.Equal(t, 2, len(got.AssessmentResults)) &&
+					assert.Equal(t, []*evaluation.FailingResource{{
+						ResourceId:          testdata.MockResourceID1,
+						ResourceTypes:       []string{"Resource"},
+						AssessmentResultIds: []string{testdata.MockAssessmentResult3ID},
+						MetricIds:           []string{testdata.MockMetricID2},
+						EvidenceIds:         []string{testdata.MockEvidenceID1},
+					}}, got.FailingResources)
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "happy path: result at a point in time",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &evaluation.DrillDownEvaluationResultRequest{
+					CloudServiceId: testdata.MockCloudServiceID1,
+					CatalogId:      testdata.MockCatalogID,
+					CategoryName:   testdata.MockCategoryName,
+					ControlId:      testdata.MockControlID1,
+					Timestamp:      timestamppb.New(time.Unix(15, 0)),
+				},
+			},
+			want: func(t *testing.T, got *evaluation.DrillDownEvaluationResultResponse) bool {
+				return assert.Equal(t, olderResult.Id, got.Result.Id) &&
+					assert.Equal(t, 1, len(got.AssessmentResults)) &&
+					assert.Equal(t, testdata.MockAssessmentResult1ID, got.AssessmentResults[0].Id) &&
+					assert.Equal(t, 0, len(got.FailingResources))
+			},
+			wantErr: assert.Nil[error],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				orchestrator: api.NewRPCConnection("bufnet", orchestrator.NewOrchestratorClient, grpc.WithContextDialer(newBufConnDialer(testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					assert.NoError(t, s.Create(orchestratortest.NewCatalog()))
+					assert.NoError(t, s.Create(orchestratortest.MockAssessmentResults))
+				})))),
+				storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					assert.NoError(t, s.Create(olderResult))
+					assert.NoError(t, s.Create(latestResult))
+				}),
+				authz:           tt.fields.authz,
+				catalogControls: make(map[string]map[string]*orchestrator.Control),
+			}
+			got, err := servicetest.Validated(svc.DrillDownEvaluationResult)(context.Background(), tt.args.req)
+
+			tt.wantErr(t, err)
+			tt.want(t, got)
+		})
+	}
+}
+
+func Test_failingResources(t *testing.T) {
+	type args struct {
+		assessments []*assessment.AssessmentResult
+		failing     []string
+	}
+	tests := []struct {
+		name string
+		args args
+		want []*evaluation.FailingResource
+	}{
+		{
+			name: "empty",
+			args: args{},
+			want: nil,
+		},
+		{
+			name: "grouped by resource",
+			args: args{
+				assessments: orchestratortest.MockAssessmentResults,
+				failing:     []string{testdata.MockAssessmentResult1ID, testdata.MockAssessmentResult3ID, testdata.MockAssessmentResult4ID},
+			},
+			want: []*evaluation.FailingResource{
+				{
+					ResourceId:          testdata.MockResourceID1,
+					ResourceTypes:       []string{"Resource"},
+					AssessmentResultIds: []string{testdata.MockAssessmentResult1ID, testdata.MockAssessmentResult3ID},
+					MetricIds:           []string{testdata.MockMetricID1, testdata.MockMetricID2},
+					EvidenceIds:         []string{testdata.MockEvidenceID1},
+				},
+				{
+					ResourceId:          testdata.MockResourceID2,
+					ResourceTypes:       []string{"Resource"},
+					AssessmentResultIds: []string{testdata.MockAssessmentResult4ID},
+					MetricIds:           []string{testdata.MockMetricID2},
+					EvidenceIds:         []string{testdata.MockEvidenceID1},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := failingResources(tt.args.assessments, tt.args.failing)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
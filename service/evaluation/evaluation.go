@@ -31,12 +31,14 @@ import (
 	"fmt"
 	"slices"
 	"strings"
+	"sync"
 	"time"
 
 	"clouditor.io/clouditor/v2/api"
 	"clouditor.io/clouditor/v2/api/assessment"
 	"clouditor.io/clouditor/v2/api/evaluation"
 	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/internal/pseudonym"
 	"clouditor.io/clouditor/v2/internal/util"
 	"clouditor.io/clouditor/v2/persistence"
 	"clouditor.io/clouditor/v2/persistence/inmemory"
@@ -67,6 +69,10 @@ const (
 
 	// defaultInterval is the default interval time for the scheduler. If no interval is set in the StartEvaluationRequest, the default value is taken.
 	defaultInterval int = 5
+
+	// defaultTimeout is the timeout of an evaluation run that is not executed in a fixed interval, i.e., an evaluation
+	// run that is scheduled by a cron expression or triggered on-demand.
+	defaultTimeout = time.Duration(defaultInterval) * time.Minute / 2
 )
 
 // Service is an implementation of the Clouditor Evaluation service
@@ -86,6 +92,26 @@ type Service struct {
 	// controls stores the catalog controls so that they do not always have to be retrieved from Orchestrators getControl endpoint
 	// map[catalog_id][category_name-control_id]*orchestrator.Control
 	catalogControls map[string]map[string]*orchestrator.Control
+
+	// running contains the targets of evaluation (identified by cloud service and catalog ID) that are currently being
+	// evaluated, so that runs do not overlap
+	running      map[string]bool
+	runningMutex sync.Mutex
+
+	// leader decides whether this replica runs the scheduled jobs. If nil, they always run.
+	leader service.LeaderElector
+
+	// resultSubscribers contains the subscribers of evaluation results
+	resultSubscribers map[*resultSubscriber]struct{}
+
+	// subscriberMutex is used for (un)locking the subscribers of evaluation results
+	subscriberMutex sync.RWMutex
+
+	// resultHooks are informed about new evaluation results, see [Service.RegisterEvaluationResultHook]
+	resultHooks []evaluation.ResultHookFunc
+
+	// pseudonymizer pseudonymizes the compliance reports on request. If nil, pseudonymized exports are not available.
+	pseudonymizer *pseudonym.Pseudonymizer
 }
 
 func init() {
@@ -121,6 +147,22 @@ func WithOrchestratorAddress(target string, opts ...grpc.DialOption) service.Opt
 	}
 }
 
+// WithLeaderElector is an option to only run the scheduled evaluations and compliance snapshots on the replica that is
+// elected as leader.
+func WithLeaderElector(e service.LeaderElector) service.Option[Service] {
+	return func(svc *Service) {
+		svc.leader = e
+		svc.scheduler.WithDistributedElector(e)
+	}
+}
+
+// WithPseudonymizer is an option to configure the pseudonymizer that is used for pseudonymized compliance reports.
+func WithPseudonymizer(p *pseudonym.Pseudonymizer) service.Option[Service] {
+	return func(svc *Service) {
+		svc.pseudonymizer = p
+	}
+}
+
 // NewService creates a new Evaluation service
 func NewService(opts ...service.Option[Service]) *Service {
 	var err error
@@ -128,6 +170,7 @@ func NewService(opts ...service.Option[Service]) *Service {
 		orchestrator:    api.NewRPCConnection(DefaultOrchestratorAddress, orchestrator.NewOrchestratorClient),
 		scheduler:       gocron.NewScheduler(time.Local),
 		catalogControls: make(map[string]map[string]*orchestrator.Control),
+		running:         make(map[string]bool),
 	}
 
 	// Apply service options
@@ -152,8 +195,8 @@ func NewService(opts ...service.Option[Service]) *Service {
 }
 
 // StartEvaluation is a method implementation of the evaluation interface: It periodically starts the evaluation of a
-// cloud service and the given catalog in the target_of_evaluation. If no interval time is given, the default value is
-// used.
+// cloud service and the given catalog in the target_of_evaluation, either according to the given cron schedule or in
+// the given interval. If neither is given, the default interval is used.
 func (svc *Service) StartEvaluation(ctx context.Context, req *evaluation.StartEvaluationRequest) (resp *evaluation.StartEvaluationResponse, err error) {
 	var (
 		interval int
@@ -162,12 +205,6 @@ func (svc *Service) StartEvaluation(ctx context.Context, req *evaluation.StartEv
 		jobs     []*gocron.Job
 	)
 
-	// Validate request
-	err = api.Validate(req)
-	if err != nil {
-		return nil, err
-	}
-
 	// Check, if this request has access to the cloud service according to our authorization strategy.
 	if !svc.authz.CheckAccess(ctx, service.AccessCreate, req) {
 		return nil, service.ErrPermissionDenied
@@ -183,33 +220,10 @@ func (svc *Service) StartEvaluation(ctx context.Context, req *evaluation.StartEv
 		interval = int(req.GetInterval())
 	}
 
-	// Get all Controls from Orchestrator for the evaluation
-	err = svc.cacheControls(req.CatalogId)
+	// Retrieve the target of evaluation and its catalog
+	toe, catalog, err = svc.prepareEvaluation(req.CloudServiceId, req.CatalogId)
 	if err != nil {
-		err = fmt.Errorf("could not cache controls: %w", err)
-		log.Error(err)
-		return nil, status.Errorf(codes.Internal, "%s", err)
-	}
-
-	// Get Target of Evaluation
-	toe, err = svc.orchestrator.Client.GetTargetOfEvaluation(context.Background(), &orchestrator.GetTargetOfEvaluationRequest{
-		CloudServiceId: req.CloudServiceId,
-		CatalogId:      req.CatalogId,
-	})
-	if err != nil {
-		err = fmt.Errorf("could not get target of evaluation: %w", err)
-		log.Error(err)
-		return nil, status.Errorf(codes.Internal, "%s", err)
-	}
-
-	// Retrieve the catalog
-	catalog, err = svc.orchestrator.Client.GetCatalog(context.Background(), &orchestrator.GetCatalogRequest{
-		CatalogId: req.CatalogId,
-	})
-	if err != nil {
-		err = fmt.Errorf("could not get catalog: %w", err)
-		log.Error(err)
-		return nil, status.Errorf(codes.Internal, "%s", err)
+		return nil, err
 	}
 
 	// Check, if a previous job exists and/or is running
@@ -227,17 +241,25 @@ func (svc *Service) StartEvaluation(ctx context.Context, req *evaluation.StartEv
 	log.Info("Starting evaluation ...")
 
 	// Add job to scheduler
-	err = svc.addJobToScheduler(ctx, toe, catalog, interval)
+	err = svc.addJobToScheduler(ctx, toe, catalog, interval, req.GetSchedule())
 	// We can return the error as it is
 	if err != nil {
 		return nil, err
 	}
 
-	log.Infof("Scheduled to evaluate catalog ID '%s' for cloud service '%s' every %d minutes...",
-		catalog.GetId(),
-		toe.GetCloudServiceId(),
-		interval,
-	)
+	if req.Schedule != nil {
+		log.Infof("Scheduled to evaluate catalog ID '%s' for cloud service '%s' according to schedule '%s'...",
+			catalog.GetId(),
+			toe.GetCloudServiceId(),
+			req.GetSchedule(),
+		)
+	} else {
+		log.Infof("Scheduled to evaluate catalog ID '%s' for cloud service '%s' every %d minutes...",
+			catalog.GetId(),
+			toe.GetCloudServiceId(),
+			interval,
+		)
+	}
 
 	resp = &evaluation.StartEvaluationResponse{Successful: true}
 
@@ -247,12 +269,6 @@ func (svc *Service) StartEvaluation(ctx context.Context, req *evaluation.StartEv
 // StopEvaluation is a method implementation of the evaluation interface: It stops the evaluation for a
 // TargetOfEvaluation.
 func (svc *Service) StopEvaluation(ctx context.Context, req *evaluation.StopEvaluationRequest) (resp *evaluation.StopEvaluationResponse, err error) {
-	// Validate request
-	err = api.Validate(req)
-	if err != nil {
-		return nil, err
-	}
-
 	// Check, if this request has access to the cloud service according to our authorization strategy.
 	if !svc.authz.CheckAccess(ctx, service.AccessCreate, req) {
 		return nil, service.ErrPermissionDenied
@@ -284,12 +300,6 @@ func (svc *Service) ListEvaluationResults(ctx context.Context, req *evaluation.L
 		args      []any
 	)
 
-	// Validate request
-	err = api.Validate(req)
-	if err != nil {
-		return nil, err
-	}
-
 	// Retrieve list of allowed cloud service according to our authorization strategy. No need to specify any conditions
 	// to our storage request, if we are allowed to see all cloud services.
 	all, allowed = svc.authz.AllowedCloudServices(ctx)
@@ -393,12 +403,6 @@ func (svc *Service) ListEvaluationResults(ctx context.Context, req *evaluation.L
 
 // CreateEvaluationResult is a method implementation of the assessment interface
 func (svc *Service) CreateEvaluationResult(ctx context.Context, req *evaluation.CreateEvaluationResultRequest) (res *evaluation.EvaluationResult, err error) {
-	// Validate request
-	err = api.Validate(req)
-	if err != nil {
-		return nil, err
-	}
-
 	// We only allow manually created statuses
 	if req.Result.Status != evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT_MANUALLY &&
 		req.Result.Status != evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT_MANUALLY {
@@ -423,16 +427,56 @@ func (svc *Service) CreateEvaluationResult(ctx context.Context, req *evaluation.
 		return nil, status.Errorf(codes.Internal, "database error: %v", err)
 	}
 
+	svc.publishEvaluationResult(res)
+
 	return res, nil
 }
 
-// addJobToScheduler adds a job for the given control to the scheduler and sets the scheduler interval to the given interval
-func (svc *Service) addJobToScheduler(ctx context.Context, toe *orchestrator.TargetOfEvaluation, catalog *orchestrator.Catalog, interval int) (err error) {
+// prepareEvaluation caches the controls of the catalog and retrieves the target of evaluation as well as the catalog
+// from the orchestrator.
+func (svc *Service) prepareEvaluation(cloudServiceID, catalogID string) (toe *orchestrator.TargetOfEvaluation, catalog *orchestrator.Catalog, err error) {
+	// Get all Controls from Orchestrator for the evaluation
+	err = svc.cacheControls(catalogID)
+	if err != nil {
+		err = fmt.Errorf("could not cache controls: %w", err)
+		log.Error(err)
+		return nil, nil, status.Errorf(codes.Internal, "%s", err)
+	}
+
+	// Get Target of Evaluation
+	toe, err = svc.orchestrator.Client.GetTargetOfEvaluation(context.Background(), &orchestrator.GetTargetOfEvaluationRequest{
+		CloudServiceId: cloudServiceID,
+		CatalogId:      catalogID,
+	})
+	if err != nil {
+		err = fmt.Errorf("could not get target of evaluation: %w", err)
+		log.Error(err)
+		return nil, nil, status.Errorf(codes.Internal, "%s", err)
+	}
+
+	// Retrieve the catalog
+	catalog, err = svc.orchestrator.Client.GetCatalog(context.Background(), &orchestrator.GetCatalogRequest{
+		CatalogId: catalogID,
+	})
+	if err != nil {
+		err = fmt.Errorf("could not get catalog: %w", err)
+		log.Error(err)
+		return nil, nil, status.Errorf(codes.Internal, "%s", err)
+	}
+
+	return
+}
+
+// addJobToScheduler adds a job for the given control to the scheduler. The job is either executed according to the
+// given cron schedule or, if no schedule is given, in the given interval.
+func (svc *Service) addJobToScheduler(ctx context.Context, toe *orchestrator.TargetOfEvaluation, catalog *orchestrator.Catalog, interval int, schedule string) (err error) {
+	var timeout = defaultTimeout
+
 	// Check inputs and log error
 	if toe == nil {
 		err = errors.New("target of evaluation is invalid")
 	}
-	if interval == 0 {
+	if interval == 0 && schedule == "" {
 		err = errors.New("interval is invalid")
 	}
 	if err != nil {
@@ -440,11 +484,26 @@ func (svc *Service) addJobToScheduler(ctx context.Context, toe *orchestrator.Tar
 		return status.Errorf(codes.Internal, "evaluation cannot be scheduled: %v", err)
 	}
 
+	if schedule != "" {
+		_, err = svc.scheduler.
+			Cron(schedule).
+			Tag(toe.GetCloudServiceId(), toe.GetCatalogId()).
+			Do(svc.runEvaluation, ctx, toe, catalog, evaluation.EvaluationRunTrigger_EVALUATION_RUN_TRIGGER_SCHEDULED, timeout)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid schedule '%s': %v", schedule, err)
+		}
+
+		return nil
+	}
+
+	// We are using a timeout of half the interval, so that we are not running into overlapping executions
+	timeout = time.Duration(interval) * time.Minute / 2
+
 	_, err = svc.scheduler.
 		Every(interval).
 		Minute().
 		Tag(toe.GetCloudServiceId(), toe.GetCatalogId()).
-		Do(svc.evaluateCatalog, ctx, toe, catalog, interval)
+		Do(svc.runEvaluation, ctx, toe, catalog, evaluation.EvaluationRunTrigger_EVALUATION_RUN_TRIGGER_SCHEDULED, timeout)
 	if err != nil {
 		err = fmt.Errorf("evaluation for cloud service '%s' and catalog ID '%s' cannot be scheduled: %w", toe.GetCloudServiceId(), catalog.GetId(), err)
 		log.Error(err)
@@ -457,8 +516,8 @@ func (svc *Service) addJobToScheduler(ctx context.Context, toe *orchestrator.Tar
 }
 
 // evaluateCatalog evaluates all [orchestrator.Control] items in the catalog whether their associated metrics are
-// fulfilled or not.
-func (svc *Service) evaluateCatalog(ctx context.Context, toe *orchestrator.TargetOfEvaluation, catalog *orchestrator.Catalog, interval int) error {
+// fulfilled or not. The evaluation is cancelled after the given timeout.
+func (svc *Service) evaluateCatalog(ctx context.Context, toe *orchestrator.TargetOfEvaluation, catalog *orchestrator.Catalog, timeout time.Duration) error {
 	var (
 		controls []*orchestrator.Control
 		relevant []*orchestrator.Control
@@ -533,8 +592,7 @@ func (svc *Service) evaluateCatalog(ctx context.Context, toe *orchestrator.Targe
 		len(relevant),
 	)
 
-	// We are using a timeout of half the interval, so that we are not running into overlapping executions
-	ctx, cancel = context.WithTimeout(ctx, time.Duration(interval)*time.Minute/2)
+	ctx, cancel = context.WithTimeout(ctx, timeout)
 	defer cancel()
 
 	g, ctx = errgroup.WithContext(ctx)
@@ -557,6 +615,9 @@ func (svc *Service) evaluateCatalog(ctx context.Context, toe *orchestrator.Targe
 		return err
 	}
 
+	// Suspend or re-issue the certificates of the target of evaluation based on the results
+	svc.updateCertificateStates(toe)
+
 	return nil
 }
 
@@ -564,7 +625,7 @@ func (svc *Service) evaluateCatalog(ctx context.Context, toe *orchestrator.Targe
 // OPS-13.1) are evaluated.
 func (svc *Service) evaluateControl(ctx context.Context, toe *orchestrator.TargetOfEvaluation, catalog *orchestrator.Catalog, control *orchestrator.Control, manual []*evaluation.EvaluationResult) (err error) {
 	var (
-		status   = evaluation.EvaluationStatus_EVALUATION_STATUS_PENDING
+		status   evaluation.EvaluationStatus
 		result   *evaluation.EvaluationResult
 		results  []*evaluation.EvaluationResult
 		relevant []*orchestrator.Control
@@ -624,23 +685,23 @@ func (svc *Service) evaluateControl(ctx context.Context, toe *orchestrator.Targe
 	// Copy the manual results
 	copy(results[len(relevant):], manual)
 
-	var nonCompliantAssessmentResults = []string{}
+	var (
+		nonCompliantAssessmentResults []string
+		waivedAssessmentResults       []string
+	)
 
 	for _, r := range results {
-		if (r.Status == evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT ||
-			r.Status == evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT_MANUALLY) &&
-			(status != evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT &&
-				status != evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT_MANUALLY) {
-			status = evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT
-		} else if r.Status == evaluation.EvaluationStatus_EVALUATION_STATUS_PENDING &&
-			status == evaluation.EvaluationStatus_EVALUATION_STATUS_PENDING {
-			status = evaluation.EvaluationStatus_EVALUATION_STATUS_PENDING
-		} else {
-			status = evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT
-			nonCompliantAssessmentResults = append(nonCompliantAssessmentResults, r.GetFailingAssessmentResultIds()...)
-		}
+		waivedAssessmentResults = append(waivedAssessmentResults, r.GetWaivedAssessmentResultIds()...)
 	}
 
+	// Combine the results of the sub-controls according to the aggregation strategy of the control
+	status, nonCompliantAssessmentResults = aggregateResults(control.EffectiveAggregationStrategy(catalog), control, results)
+
+	// Merge the aggregated results of the sub-controls with an active attestation of the control itself, e.g., for
+	// controls about policies that do not have any (automated) sub-controls
+	attestation := svc.activeAttestation(toe, control)
+	status = mergeAttestation(status, attestation)
+
 	// Create evaluation result
 	result = &evaluation.EvaluationResult{
 		Id:                         uuid.NewString(),
@@ -651,8 +712,17 @@ func (svc *Service) evaluateControl(ctx context.Context, toe *orchestrator.Targe
 		ControlCatalogId:           toe.CatalogId,
 		Status:                     status,
 		FailingAssessmentResultIds: nonCompliantAssessmentResults,
+		WaivedAssessmentResultIds:  waivedAssessmentResults,
+	}
+
+	if attestation != nil {
+		result.AttestationId = &attestation.Id
 	}
 
+	// Inform the notification channels of the cloud service, if the control became non-compliant. This needs to
+	// happen before we store the new result, since we compare against the previous one.
+	svc.notifyIfBecameNonCompliant(result)
+
 	err = svc.storage.Create(result)
 	if err != nil {
 		log.Errorf("error storing evaluation result for control ID '%s' (in cloud service %s) in database: %v",
@@ -662,17 +732,98 @@ func (svc *Service) evaluateControl(ctx context.Context, toe *orchestrator.Targe
 		return
 	}
 
+	svc.publishEvaluationResult(result)
+
 	log.Infof("Evaluation result for control ID '%s' (in cloud service %s) was %s", control.Id, toe.CloudServiceId, result.Status.String())
 
 	return
 }
 
+// notifyIfBecameNonCompliant sends a notification to the orchestrator if the result is non-compliant and the previous
+// result of the same control was not. Errors are only logged, since the notification is not crucial for the
+// evaluation.
+func (svc *Service) notifyIfBecameNonCompliant(result *evaluation.EvaluationResult) {
+	var previous []*evaluation.EvaluationResult
+
+	if result.Status != evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT {
+		return
+	}
+
+	err := svc.storage.List(&previous, "timestamp", false, 0, 1,
+		"cloud_service_id = ? AND control_catalog_id = ? AND control_id = ?",
+		result.CloudServiceId, result.ControlCatalogId, result.ControlId)
+	if err != nil {
+		log.Errorf("Could not retrieve previous evaluation result of control ID '%s': %v", result.ControlId, err)
+		return
+	}
+
+	if len(previous) > 0 && previous[0].Status == evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT {
+		return
+	}
+
+	_, err = svc.orchestrator.Client.SendNotification(context.Background(), &orchestrator.SendNotificationRequest{
+		Notification: &orchestrator.Notification{
+			CloudServiceId: result.CloudServiceId,
+			Severity:       orchestrator.NotificationSeverity_NOTIFICATION_SEVERITY_HIGH,
+			Title:          fmt.Sprintf("Control %s became non-compliant", result.ControlId),
+			Text: fmt.Sprintf("The evaluation of control %s found %d non-compliant assessment result(s).",
+				result.ControlId, len(result.FailingAssessmentResultIds)),
+			ControlId: &result.ControlId,
+			CatalogId: &result.ControlCatalogId,
+			Timestamp: result.Timestamp,
+		},
+	})
+	if err != nil {
+		log.Warnf("Could not send notification about non-compliant control ID '%s': %v", result.ControlId, err)
+	}
+}
+
+// activeWaivers retrieves the active waivers of the cloud service from the orchestrator. Errors are only logged, in which
+// case no results are treated as accepted risk.
+func (svc *Service) activeWaivers(cloudServiceID string) (waivers []*orchestrator.Waiver) {
+	waivers, err := api.ListAllPaginated(&orchestrator.ListWaiversRequest{
+		Filter: &orchestrator.ListWaiversRequest_Filter{
+			CloudServiceId: &cloudServiceID,
+			Active:         util.Ref(true),
+		},
+	}, svc.orchestrator.Client.ListWaivers, func(res *orchestrator.ListWaiversResponse) []*orchestrator.Waiver {
+		return res.Waivers
+	})
+	if err != nil {
+		log.Errorf("could not get waivers for Cloud Service ID '%s' from Orchestrator: %v", cloudServiceID, err)
+		return nil
+	}
+
+	return waivers
+}
+
+// disabledMetrics retrieves the IDs of the metrics that are disabled for the cloud service from the orchestrator.
+// Errors are only logged, in which case all metrics are considered.
+func (svc *Service) disabledMetrics(cloudServiceID string) (ids []string) {
+	res, err := svc.orchestrator.Client.ListDisabledMetrics(context.Background(), &orchestrator.ListDisabledMetricsRequest{
+		CloudServiceId: cloudServiceID,
+	})
+	if err != nil {
+		log.Errorf("could not get disabled metrics for Cloud Service ID '%s' from Orchestrator: %v", cloudServiceID, err)
+		return nil
+	}
+
+	for _, disabled := range res.DisabledMetrics {
+		ids = append(ids, disabled.MetricId)
+	}
+
+	return
+}
+
 // evaluateSubcontrol evaluates the sub-controls, e.g., OPS-13.2
 func (svc *Service) evaluateSubcontrol(_ context.Context, toe *orchestrator.TargetOfEvaluation, control *orchestrator.Control) (eval *evaluation.EvaluationResult, err error) {
 	var (
 		assessments                   []*assessment.AssessmentResult
+		waivers                       []*orchestrator.Waiver
 		status                        evaluation.EvaluationStatus
 		nonCompliantAssessmentResults []string
+		waivedAssessmentResults       []string
+		notApplicable                 bool
 	)
 
 	if toe == nil || control == nil {
@@ -687,14 +838,32 @@ func (svc *Service) evaluateSubcontrol(_ context.Context, toe *orchestrator.Targ
 		return
 	}
 
+	// Only consider metrics that are relevant for the assurance level of the target of evaluation
+	metrics = slices.DeleteFunc(metrics, func(metric *assessment.Metric) bool {
+		return !metric.IsRelevantFor(toe.GetAssuranceLevel())
+	})
+
+	// Do not consider metrics that are disabled for the cloud service. If all metrics of the control are disabled, the
+	// control is not applicable.
+	if len(metrics) != 0 {
+		disabled := svc.disabledMetrics(toe.GetCloudServiceId())
+		metrics = slices.DeleteFunc(metrics, func(metric *assessment.Metric) bool {
+			return slices.Contains(disabled, metric.Id)
+		})
+		notApplicable = len(metrics) == 0
+	}
+
 	if len(metrics) != 0 {
 		// Get latest assessment_results by resource_id filtered by
 		// * cloud service id
 		// * metric ids
+		// * time frame of the audit scope
 		assessments, err = api.ListAllPaginated(&orchestrator.ListAssessmentResultsRequest{
 			Filter: &orchestrator.Filter{
 				CloudServiceId: &toe.CloudServiceId,
 				MetricIds:      getMetricIds(metrics),
+				Since:          toe.ScopeStart,
+				Until:          toe.ScopeEnd,
 			},
 			LatestByResourceId: util.Ref(true),
 		}, svc.orchestrator.Client.ListAssessmentResults, func(res *orchestrator.ListAssessmentResultsResponse) []*assessment.AssessmentResult {
@@ -705,7 +874,14 @@ func (svc *Service) evaluateSubcontrol(_ context.Context, toe *orchestrator.Targ
 			// We let the scheduler running if we do not get the assessment results from the orchestrator, maybe it is
 			// only a temporary network problem
 			log.Errorf("could not get assessment results for Cloud Service ID '%s' and MetricIds '%s' from Orchestrator: %v", toe.GetCloudServiceId(), getMetricIds(metrics), err)
-		} else if len(assessments) == 0 {
+		}
+
+		// Only consider assessment results of resources that are in the audit scope
+		assessments = slices.DeleteFunc(assessments, func(result *assessment.AssessmentResult) bool {
+			return !toe.InScope(result)
+		})
+
+		if err == nil && len(assessments) == 0 {
 			// We let the scheduler running if we do not get the assessment results from the orchestrator, maybe it is
 			// only a temporary network problem
 			log.Debugf("no assessment results for Cloud Service ID '%s' and MetricIds '%s' available", toe.GetCloudServiceId(), getMetricIds(metrics))
@@ -714,8 +890,10 @@ func (svc *Service) evaluateSubcontrol(_ context.Context, toe *orchestrator.Targ
 		log.Debugf("no metrics are available for the given control")
 	}
 
-	// If no assessment_results are available we are stuck at pending
-	if len(assessments) == 0 {
+	// If no assessment_results are available we are stuck at pending, unless the control is not applicable at all
+	if notApplicable {
+		status = evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_APPLICABLE
+	} else if len(assessments) == 0 {
 		status = evaluation.EvaluationStatus_EVALUATION_STATUS_PENDING
 	} else {
 		// Otherwise, there are some results and first we assume that everything is compliant, unless someone proves it
@@ -723,14 +901,33 @@ func (svc *Service) evaluateSubcontrol(_ context.Context, toe *orchestrator.Targ
 		status = evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT
 	}
 
-	// Here the actual evaluation takes place. We check if the assessment results are compliant.
+	// Retrieve the active waivers of the cloud service, if there are any non-compliant results
+	if slices.ContainsFunc(assessments, func(result *assessment.AssessmentResult) bool { return !result.Compliant }) {
+		waivers = svc.activeWaivers(toe.GetCloudServiceId())
+	}
+
+	// Here the actual evaluation takes place. We check if the assessment results are compliant. Non-compliant results
+	// that are covered by a waiver are treated as accepted risk and reported separately.
+	now := time.Now()
 	for _, results := range assessments {
-		if !results.Compliant {
-			nonCompliantAssessmentResults = append(nonCompliantAssessmentResults, results.GetId())
-			status = evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT
+		if results.Compliant {
+			continue
+		}
+
+		if slices.ContainsFunc(waivers, func(w *orchestrator.Waiver) bool { return w.Covers(results, now) }) {
+			waivedAssessmentResults = append(waivedAssessmentResults, results.GetId())
+			continue
 		}
+
+		nonCompliantAssessmentResults = append(nonCompliantAssessmentResults, results.GetId())
+		status = evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT
 	}
 
+	// Merge the automated results with an active attestation of the control, e.g., for controls that (also) need to
+	// be assessed manually
+	attestation := svc.activeAttestation(toe, control)
+	status = mergeAttestation(status, attestation)
+
 	// Create evaluation result
 	eval = &evaluation.EvaluationResult{
 		Id:                         uuid.NewString(),
@@ -742,6 +939,11 @@ func (svc *Service) evaluateSubcontrol(_ context.Context, toe *orchestrator.Targ
 		ControlCatalogId:           toe.GetCatalogId(),
 		Status:                     status,
 		FailingAssessmentResultIds: nonCompliantAssessmentResults,
+		WaivedAssessmentResultIds:  waivedAssessmentResults,
+	}
+
+	if attestation != nil {
+		eval.AttestationId = &attestation.Id
 	}
 
 	err = svc.storage.Create(eval)
@@ -750,6 +952,8 @@ func (svc *Service) evaluateSubcontrol(_ context.Context, toe *orchestrator.Targ
 		return nil, err
 	}
 
+	svc.publishEvaluationResult(eval)
+
 	log.Infof("Evaluation result for %s (in cloud service %s) was %s", control.Id, toe.GetCloudServiceId(), eval.Status.String())
 
 	return
@@ -873,6 +1077,16 @@ func (svc *Service) getControl(catalogId, categoryName, controlId string) (contr
 	return
 }
 
+// CheckHealth implements [service.HealthChecker]. The evaluation depends on its storage and the connection to the
+// orchestrator.
+func (svc *Service) CheckHealth(ctx context.Context) error {
+	if err := svc.storage.Ping(ctx); err != nil {
+		return fmt.Errorf("storage is not reachable: %w", err)
+	}
+
+	return svc.orchestrator.CheckHealth()
+}
+
 // TODO(oxisto): We can remove it with maps.Values in Go 1.22+
 func values[M ~map[K]V, K comparable, V any](m M) []V {
 	rr := make([]V, 0, len(m))
@@ -28,7 +28,10 @@ package evaluation
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -40,6 +43,7 @@ import (
 	"clouditor.io/clouditor/v2/internal/testutil"
 	"clouditor.io/clouditor/v2/internal/testutil/assert"
 	"clouditor.io/clouditor/v2/internal/testutil/clitest"
+	"clouditor.io/clouditor/v2/internal/testutil/servicetest"
 	"clouditor.io/clouditor/v2/internal/testutil/servicetest/evaluationtest"
 	"clouditor.io/clouditor/v2/internal/testutil/servicetest/orchestratortest"
 	"clouditor.io/clouditor/v2/internal/util"
@@ -47,6 +51,7 @@ import (
 	"clouditor.io/clouditor/v2/service"
 
 	"github.com/go-co-op/gocron"
+	"github.com/google/uuid"
 	"golang.org/x/oauth2/clientcredentials"
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/proto"
@@ -432,7 +437,7 @@ func TestService_ListEvaluationResults(t *testing.T) {
 				storage:                       tt.fields.storage,
 				authz:                         tt.fields.authz,
 			}
-			gotRes, err := s.ListEvaluationResults(tt.args.in0, tt.args.req)
+			gotRes, err := servicetest.Validated(s.ListEvaluationResults)(tt.args.in0, tt.args.req)
 
 			tt.wantErr(t, err)
 			assert.Equal(t, tt.wantRes, gotRes)
@@ -648,7 +653,7 @@ func TestService_StopEvaluation(t *testing.T) {
 				authz:                         tt.fields.authz,
 			}
 
-			gotRes, err := s.StopEvaluation(tt.args.in0, tt.args.req)
+			gotRes, err := servicetest.Validated(s.StopEvaluation)(tt.args.in0, tt.args.req)
 
 			tt.wantErr(t, err)
 			assert.Equal(t, tt.wantRes, gotRes)
@@ -832,6 +837,37 @@ func TestService_StartEvaluation(t *testing.T) {
 			},
 			wantErr: assert.NoError,
 		},
+		{
+			name: "Happy path: scheduler added with cron schedule",
+			fields: fields{
+				orchestrator: api.NewRPCConnection("bufnet", orchestrator.NewOrchestratorClient, grpc.WithContextDialer(newBufConnDialer(testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					assert.NoError(t, s.Create(orchestratortest.NewCatalog()))
+					assert.NoError(t, s.Create(&orchestrator.CloudService{Id: testdata.MockCloudServiceID1}))
+					assert.NoError(t, s.Create(orchestratortest.NewTargetOfEvaluation(testdata.AssuranceLevelBasic)))
+				})))),
+				scheduler:       gocron.NewScheduler(time.Local),
+				authz:           &service.AuthorizationStrategyAllowAll{},
+				storage:         testutil.NewInMemoryStorage(t),
+				catalogControls: make(map[string]map[string]*orchestrator.Control),
+			},
+			args: args{
+				in0: context.Background(),
+				req: &evaluation.StartEvaluationRequest{
+					CloudServiceId: testdata.MockCloudServiceID1,
+					CatalogId:      testdata.MockCatalogID,
+					Schedule:       util.Ref("0 3 * * *"),
+				},
+			},
+			want: func(t *testing.T, got *evaluation.StartEvaluationResponse) bool {
+				return assert.True(t, got.Successful)
+			},
+			wantSvc: func(t *testing.T, got *Service) bool {
+				jobs := got.scheduler.Jobs()
+				return assert.Equal(t, 1, len(jobs)) &&
+					assert.Equal(t, 3, jobs[0].NextRun().Hour())
+			},
+			wantErr: assert.NoError,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -843,7 +879,7 @@ func TestService_StartEvaluation(t *testing.T) {
 				catalogControls: tt.fields.catalogControls,
 			}
 
-			gotResp, err := svc.StartEvaluation(tt.args.in0, tt.args.req)
+			gotResp, err := servicetest.Validated(svc.StartEvaluation)(tt.args.in0, tt.args.req)
 			tt.wantErr(t, err)
 			assert.Optional(t, tt.want, gotResp)
 			assert.Optional(t, tt.wantSvc, svc)
@@ -1126,6 +1162,7 @@ func TestService_addJobToScheduler(t *testing.T) {
 		toe      *orchestrator.TargetOfEvaluation
 		catalog  *orchestrator.Catalog
 		interval int
+		schedule string
 	}
 	tests := []struct {
 		name    string
@@ -1189,6 +1226,40 @@ func TestService_addJobToScheduler(t *testing.T) {
 			},
 			wantErr: assert.NoError,
 		},
+		{
+			name: "Invalid schedule",
+			fields: fields{
+				scheduler: gocron.NewScheduler(time.Local),
+			},
+			args: args{
+				toe: &orchestrator.TargetOfEvaluation{
+					CloudServiceId: testdata.MockCloudServiceID1,
+					CatalogId:      testdata.MockCatalogID,
+					AssuranceLevel: &testdata.AssuranceLevelHigh,
+				},
+				catalog:  orchestratortest.NewCatalog(),
+				schedule: "every now and then",
+			},
+			wantErr: func(tt assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorContains(t, err, "invalid schedule")
+			},
+		},
+		{
+			name: "Happy path: Add cron scheduler job for catalog",
+			fields: fields{
+				scheduler: gocron.NewScheduler(time.Local),
+			},
+			args: args{
+				toe: &orchestrator.TargetOfEvaluation{
+					CloudServiceId: testdata.MockCloudServiceID1,
+					CatalogId:      testdata.MockCatalogID,
+					AssuranceLevel: &testdata.AssuranceLevelHigh,
+				},
+				catalog:  orchestratortest.NewCatalog(),
+				schedule: "0 3 * * *",
+			},
+			wantErr: assert.NoError,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1198,7 +1269,7 @@ func TestService_addJobToScheduler(t *testing.T) {
 				scheduler:                     tt.fields.scheduler,
 				storage:                       tt.fields.storage,
 			}
-			err := s.addJobToScheduler(tt.args.ctx, tt.args.toe, tt.args.catalog, tt.args.interval)
+			err := s.addJobToScheduler(tt.args.ctx, tt.args.toe, tt.args.catalog, tt.args.interval, tt.args.schedule)
 			tt.wantErr(t, err)
 		})
 	}
@@ -1348,6 +1419,91 @@ func TestService_evaluateControl(t *testing.T) {
 	}
 }
 
+func TestService_notifyIfBecameNonCompliant(t *testing.T) {
+	type args struct {
+		previous *evaluation.EvaluationResult
+		result   *evaluation.EvaluationResult
+	}
+	tests := []struct {
+		name      string
+		args      args
+		wantPosts int
+	}{
+		{
+			name: "Compliant",
+			args: args{
+				result: newMockEvaluationResult(evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT),
+			},
+			wantPosts: 0,
+		},
+		{
+			name: "Non-compliant without previous result",
+			args: args{
+				result: newMockEvaluationResult(evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT),
+			},
+			wantPosts: 1,
+		},
+		{
+			name: "Non-compliant after compliant result",
+			args: args{
+				previous: newMockEvaluationResult(evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT),
+				result:   newMockEvaluationResult(evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT),
+			},
+			wantPosts: 1,
+		},
+		{
+			name: "Still non-compliant",
+			args: args{
+				previous: newMockEvaluationResult(evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT),
+				result:   newMockEvaluationResult(evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT),
+			},
+			wantPosts: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var posts atomic.Int32
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				posts.Add(1)
+			}))
+			defer srv.Close()
+
+			s := &Service{
+				orchestrator: api.NewRPCConnection("bufnet", orchestrator.NewOrchestratorClient, grpc.WithContextDialer(newBufConnDialer(testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					assert.NoError(t, s.Create(&orchestrator.NotificationChannel{
+						Id:             uuid.NewString(),
+						CloudServiceId: testdata.MockCloudServiceID1,
+						Name:           "Slack",
+						Type:           orchestrator.NotificationChannelType_NOTIFICATION_CHANNEL_TYPE_SLACK,
+						Url:            srv.URL,
+						Enabled:        true,
+					}))
+				})))),
+				storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					if tt.args.previous != nil {
+						assert.NoError(t, s.Create(tt.args.previous))
+					}
+				}),
+			}
+
+			s.notifyIfBecameNonCompliant(tt.args.result)
+			assert.Equal(t, tt.wantPosts, int(posts.Load()))
+		})
+	}
+}
+
+func newMockEvaluationResult(status evaluation.EvaluationStatus) *evaluation.EvaluationResult {
+	return &evaluation.EvaluationResult{
+		Id:               uuid.NewString(),
+		Timestamp:        timestamppb.Now(),
+		CloudServiceId:   testdata.MockCloudServiceID1,
+		ControlCatalogId: testdata.MockCatalogID,
+		ControlId:        testdata.MockControlID1,
+		Status:           status,
+	}
+}
+
 func TestService_evaluateSubcontrol(t *testing.T) {
 	type fields struct {
 		orchestrator    *api.RPCConnection[orchestrator.OrchestratorClient]
@@ -1508,6 +1664,173 @@ func TestService_evaluateSubcontrol(t *testing.T) {
 				return assert.Equal(t, testdata.MockSubControlID11, got.ControlId)
 			},
 		},
+		{
+			name: "Happy path: all metrics are disabled",
+			fields: fields{
+				wgCounter: 1,
+				storage:   testutil.NewInMemoryStorage(t),
+				authz:     &service.AuthorizationStrategyAllowAll{},
+				orchestrator: api.NewRPCConnection("bufnet", orchestrator.NewOrchestratorClient, grpc.WithContextDialer(newBufConnDialer(testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					assert.NoError(t, s.Create(orchestratortest.NewCatalog()))
+					assert.NoError(t, s.Create(orchestratortest.MockAssessmentResults))
+					assert.NoError(t, s.Create(&orchestrator.DisabledMetric{
+						CloudServiceId: testdata.MockCloudServiceID1,
+						MetricId:       testdata.MockMetricID1,
+						Reason:         "Not relevant",
+					}))
+				})))),
+				catalogControls: map[string]map[string]*orchestrator.Control{
+					orchestratortest.MockControl1.GetCategoryCatalogId(): {
+						fmt.Sprintf("%s-%s", orchestratortest.MockControl11.GetCategoryName(), orchestratortest.MockControl11.GetId()): orchestratortest.MockControl11,
+					},
+				},
+			},
+			args: args{
+				toe: &orchestrator.TargetOfEvaluation{
+					CloudServiceId: testdata.MockCloudServiceID1,
+					CatalogId:      testdata.MockCatalogID,
+					AssuranceLevel: &testdata.AssuranceLevelHigh,
+				},
+				control: &orchestrator.Control{
+					Id:           testdata.MockSubControlID11,
+					CategoryName: testdata.MockCategoryName,
+				},
+			},
+			wantSvc: func(t *testing.T, got *Service) bool {
+				evalResults, err := got.ListEvaluationResults(context.Background(), &evaluation.ListEvaluationResultsRequest{})
+				assert.NoError(t, err)
+				return assert.Equal(t, 1, len(evalResults.Results))
+			},
+			want: func(t *testing.T, got *evaluation.EvaluationResult) bool {
+				return assert.Equal(t, evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_APPLICABLE, got.Status)
+			},
+		},
+		{
+			name: "Happy path: non-compliant assessment result is waived",
+			fields: fields{
+				wgCounter: 1,
+				storage:   testutil.NewInMemoryStorage(t),
+				authz:     &service.AuthorizationStrategyAllowAll{},
+				orchestrator: api.NewRPCConnection("bufnet", orchestrator.NewOrchestratorClient, grpc.WithContextDialer(newBufConnDialer(testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					assert.NoError(t, s.Create(orchestratortest.NewCatalog()))
+					assert.NoError(t, s.Create(&assessment.AssessmentResult{
+						Id:             testdata.MockAssessmentResult3ID,
+						Timestamp:      timestamppb.Now(),
+						CloudServiceId: testdata.MockCloudServiceID1,
+						MetricId:       testdata.MockMetricID1,
+						Compliant:      false,
+						EvidenceId:     testdata.MockEvidenceID1,
+						ResourceId:     testdata.MockResourceID2,
+						ResourceTypes:  []string{"Resource"},
+					}))
+					assert.NoError(t, s.Create(&orchestrator.Waiver{
+						Id:             testdata.MockEvaluationResult1ID,
+						CloudServiceId: testdata.MockCloudServiceID1,
+						ResourceId:     testdata.MockResourceID2,
+						MetricId:       testdata.MockMetricID1,
+						Justification:  "Accepted risk",
+						Approver:       "CISO",
+						ExpiresAt:      timestamppb.New(time.Now().Add(time.Hour)),
+					}))
+				})))),
+				catalogControls: map[string]map[string]*orchestrator.Control{
+					orchestratortest.MockControl1.GetCategoryCatalogId(): {
+						fmt.Sprintf("%s-%s", orchestratortest.MockControl11.GetCategoryName(), orchestratortest.MockControl11.GetId()): orchestratortest.MockControl11,
+					},
+				},
+			},
+			args: args{
+				toe: &orchestrator.TargetOfEvaluation{
+					CloudServiceId: testdata.MockCloudServiceID1,
+					CatalogId:      testdata.MockCatalogID,
+					AssuranceLevel: &testdata.AssuranceLevelHigh,
+				},
+				control: &orchestrator.Control{
+					Id:           testdata.MockSubControlID11,
+					CategoryName: testdata.MockCategoryName,
+				},
+			},
+			want: func(t *testing.T, got *evaluation.EvaluationResult) bool {
+				assert.Equal(t, evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT, got.Status)
+				assert.Empty(t, got.FailingAssessmentResultIds)
+				return assert.Equal(t, []string{testdata.MockAssessmentResult3ID}, got.WaivedAssessmentResultIds)
+			},
+		},
+		{
+			name: "Happy path: assessment results out of audit scope",
+			fields: fields{
+				wgCounter: 1,
+				storage:   testutil.NewInMemoryStorage(t),
+				authz:     &service.AuthorizationStrategyAllowAll{},
+				orchestrator: api.NewRPCConnection("bufnet", orchestrator.NewOrchestratorClient, grpc.WithContextDialer(newBufConnDialer(testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					assert.NoError(t, s.Create(orchestratortest.NewCatalog()))
+					assert.NoError(t, s.Create(orchestratortest.MockAssessmentResults))
+				})))),
+				catalogControls: map[string]map[string]*orchestrator.Control{
+					orchestratortest.MockControl1.GetCategoryCatalogId(): {
+						fmt.Sprintf("%s-%s", orchestratortest.MockControl11.GetCategoryName(), orchestratortest.MockControl11.GetId()): orchestratortest.MockControl11,
+					},
+				},
+			},
+			args: args{
+				toe: &orchestrator.TargetOfEvaluation{
+					CloudServiceId: testdata.MockCloudServiceID1,
+					CatalogId:      testdata.MockCatalogID,
+					AssuranceLevel: &testdata.AssuranceLevelHigh,
+					ResourceTypes:  []string{"Storage"},
+				},
+				control: &orchestrator.Control{
+					Id:           testdata.MockSubControlID11,
+					CategoryName: testdata.MockCategoryName,
+				},
+			},
+			want: func(t *testing.T, got *evaluation.EvaluationResult) bool {
+				return assert.Equal(t, evaluation.EvaluationStatus_EVALUATION_STATUS_PENDING, got.Status)
+			},
+		},
+		{
+			name: "Happy path: attestation without automated results",
+			fields: fields{
+				wgCounter: 1,
+				storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					assert.NoError(t, s.Create(&evaluation.Attestation{
+						Id:                  testdata.MockAttestationID1,
+						CloudServiceId:      testdata.MockCloudServiceID1,
+						CatalogId:           testdata.MockCatalogID,
+						ControlCategoryName: testdata.MockCategoryName,
+						ControlId:           testdata.MockSubControlID11,
+						Compliant:           true,
+						Reviewer:            testdata.MockAttestationReviewer,
+						ExpiresAt:           timestamppb.New(time.Now().Add(time.Hour)),
+						CreatedAt:           timestamppb.Now(),
+					}))
+				}),
+				authz: &service.AuthorizationStrategyAllowAll{},
+				orchestrator: api.NewRPCConnection("bufnet", orchestrator.NewOrchestratorClient, grpc.WithContextDialer(newBufConnDialer(testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					assert.NoError(t, s.Create(orchestratortest.NewCatalog()))
+				})))),
+				catalogControls: map[string]map[string]*orchestrator.Control{
+					orchestratortest.MockControl1.GetCategoryCatalogId(): {
+						fmt.Sprintf("%s-%s", orchestratortest.MockControl11.GetCategoryName(), orchestratortest.MockControl11.GetId()): orchestratortest.MockControl11,
+					},
+				},
+			},
+			args: args{
+				toe: &orchestrator.TargetOfEvaluation{
+					CloudServiceId: testdata.MockCloudServiceID1,
+					CatalogId:      testdata.MockCatalogID,
+					AssuranceLevel: &testdata.AssuranceLevelHigh,
+				},
+				control: &orchestrator.Control{
+					Id:           testdata.MockSubControlID11,
+					CategoryName: testdata.MockCategoryName,
+				},
+			},
+			want: func(t *testing.T, got *evaluation.EvaluationResult) bool {
+				assert.Equal(t, evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT_MANUALLY, got.Status)
+				return assert.Equal(t, testdata.MockAttestationID1, got.GetAttestationId())
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -0,0 +1,223 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package evaluation
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"clouditor.io/clouditor/v2/api/evaluation"
+	"clouditor.io/clouditor/v2/persistence"
+	"clouditor.io/clouditor/v2/service"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// DefaultComplianceSnapshotInterval is the default interval in which compliance snapshots are taken.
+const DefaultComplianceSnapshotInterval = 1 * time.Hour
+
+// GetComplianceHistory is a method implementation of the evaluation interface: It returns the compliance snapshots of
+// a cloud service as time-series per control as well as a summary of all controls per snapshot time.
+func (svc *Service) GetComplianceHistory(ctx context.Context, req *evaluation.GetComplianceHistoryRequest) (res *evaluation.GetComplianceHistoryResponse, err error) {
+	var (
+		snapshots []*evaluation.ComplianceSnapshot
+		query     = []string{"cloud_service_id = ?"}
+		args      = []any{req.GetCloudServiceId()}
+	)
+
+	// Check, if this request has access to the cloud service according to our authorization strategy.
+	if !svc.authz.CheckAccess(ctx, service.AccessRead, req) {
+		return nil, service.ErrPermissionDenied
+	}
+
+	if req.CatalogId != nil {
+		query = append(query, "catalog_id = ?")
+		args = append(args, req.GetCatalogId())
+	}
+
+	if req.ControlId != nil {
+		query = append(query, "control_id = ?")
+		args = append(args, req.GetControlId())
+	}
+
+	if req.Since != nil {
+		query = append(query, "timestamp >= ?")
+		args = append(args, req.Since.AsTime())
+	}
+
+	if req.Until != nil {
+		query = append(query, "timestamp <= ?")
+		args = append(args, req.Until.AsTime())
+	}
+
+	err = svc.storage.List(&snapshots, "timestamp", true, 0, -1, persistence.BuildConds(query, args)...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
+	res = &evaluation.GetComplianceHistoryResponse{
+		Series:  complianceSeries(snapshots),
+		Summary: complianceSummary(snapshots),
+	}
+
+	return
+}
+
+// TakeComplianceSnapshot persists the status of the latest evaluation result of each control of each cloud service.
+// All snapshots of a single run share the same timestamp, so that they can be aggregated later on.
+func (svc *Service) TakeComplianceSnapshot() (err error) {
+	var (
+		results   []*evaluation.EvaluationResult
+		snapshots []*evaluation.ComplianceSnapshot
+		now       = timestamppb.Now()
+	)
+
+	err = svc.storage.Raw(&results,
+		`WITH sorted_results AS (
+			SELECT *, ROW_NUMBER() OVER (PARTITION BY cloud_service_id, control_catalog_id, control_id ORDER BY timestamp DESC) AS row_number
+			FROM evaluation_results
+		)
+		SELECT * FROM sorted_results WHERE row_number = 1 ORDER BY cloud_service_id, control_catalog_id, control_id;`)
+	if err != nil {
+		return fmt.Errorf("could not retrieve latest evaluation results: %w", err)
+	}
+
+	if len(results) == 0 {
+		return nil
+	}
+
+	for _, result := range results {
+		snapshots = append(snapshots, &evaluation.ComplianceSnapshot{
+			Id:                  uuid.NewString(),
+			CloudServiceId:      result.GetCloudServiceId(),
+			CatalogId:           result.GetControlCatalogId(),
+			ControlCategoryName: result.GetControlCategoryName(),
+			ControlId:           result.GetControlId(),
+			Status:              result.GetStatus(),
+			Timestamp:           now,
+		})
+	}
+
+	err = svc.storage.Create(snapshots)
+	if err != nil {
+		return fmt.Errorf("could not store compliance snapshots: %w", err)
+	}
+
+	log.Debugf("Took %d compliance snapshot(s)", len(snapshots))
+
+	return nil
+}
+
+// StartComplianceSnapshots takes compliance snapshots in the given interval until the context is done. If a leader
+// elector is configured, only the leader takes them.
+func (svc *Service) StartComplianceSnapshots(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if !service.IsLeading(ctx, svc.leader) {
+			continue
+		}
+
+		if err := svc.TakeComplianceSnapshot(); err != nil {
+			log.Errorf("Could not take compliance snapshot: %v", err)
+		}
+	}
+}
+
+// complianceSeries groups the snapshots (which need to be ordered by time) by control.
+func complianceSeries(snapshots []*evaluation.ComplianceSnapshot) (series []*evaluation.ComplianceTimeSeries) {
+	var byControl = make(map[string]*evaluation.ComplianceTimeSeries)
+
+	for _, snapshot := range snapshots {
+		key := fmt.Sprintf("%s-%s-%s", snapshot.CatalogId, snapshot.ControlCategoryName, snapshot.ControlId)
+
+		s, ok := byControl[key]
+		if !ok {
+			s = &evaluation.ComplianceTimeSeries{
+				CatalogId:           snapshot.CatalogId,
+				ControlCategoryName: snapshot.ControlCategoryName,
+				ControlId:           snapshot.ControlId,
+			}
+			byControl[key] = s
+			series = append(series, s)
+		}
+
+		s.Points = append(s.Points, &evaluation.ComplianceDataPoint{
+			Timestamp: snapshot.Timestamp,
+			Status:    snapshot.Status,
+		})
+	}
+
+	slices.SortFunc(series, func(a, b *evaluation.ComplianceTimeSeries) int {
+		if c := strings.Compare(a.CatalogId, b.CatalogId); c != 0 {
+			return c
+		}
+
+		return strings.Compare(a.ControlId, b.ControlId)
+	})
+
+	return
+}
+
+// complianceSummary aggregates the snapshots (which need to be ordered by time) per snapshot time.
+func complianceSummary(snapshots []*evaluation.ComplianceSnapshot) (summary []*evaluation.ComplianceSummary) {
+	var current *evaluation.ComplianceSummary
+
+	for _, snapshot := range snapshots {
+		if current == nil || !current.Timestamp.AsTime().Equal(snapshot.Timestamp.AsTime()) {
+			current = &evaluation.ComplianceSummary{Timestamp: snapshot.Timestamp}
+			summary = append(summary, current)
+		}
+
+		switch snapshot.Status {
+		case evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT,
+			evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT_MANUALLY:
+			current.Compliant++
+		case evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT,
+			evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT_MANUALLY:
+			current.NotCompliant++
+		case evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_APPLICABLE:
+			// Controls that are not applicable are not counted
+		default:
+			current.Pending++
+		}
+	}
+
+	return
+}
@@ -0,0 +1,237 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package evaluation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"clouditor.io/clouditor/v2/api"
+	"clouditor.io/clouditor/v2/api/evaluation"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/testutil/servicetest"
+	"clouditor.io/clouditor/v2/internal/testutil/servicetest/evaluationtest"
+	"clouditor.io/clouditor/v2/internal/util"
+	"clouditor.io/clouditor/v2/persistence"
+	"clouditor.io/clouditor/v2/service"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func newMockComplianceSnapshot(controlID string, status evaluation.EvaluationStatus, t time.Time) *evaluation.ComplianceSnapshot {
+	return &evaluation.ComplianceSnapshot{
+		Id:                  uuid.NewString(),
+		CloudServiceId:      testdata.MockCloudServiceID1,
+		CatalogId:           testdata.MockCatalogID,
+		ControlCategoryName: testdata.MockCategoryName,
+		ControlId:           controlID,
+		Status:              status,
+		Timestamp:           timestamppb.New(t),
+	}
+}
+
+func TestService_GetComplianceHistory(t *testing.T) {
+	var (
+		t1 = time.Unix(100, 0)
+		t2 = time.Unix(200, 0)
+	)
+
+	type fields struct {
+		authz service.AuthorizationStrategy
+	}
+	type args struct {
+		req *evaluation.GetComplianceHistoryRequest
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantRes assert.Want[*evaluation.GetComplianceHistoryResponse]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "Validation error",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &evaluation.GetComplianceHistoryRequest{},
+			},
+			wantRes: assert.Nil[*evaluation.GetComplianceHistoryResponse],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, api.ErrInvalidRequest.Error())
+			},
+		},
+		{
+			name: "Permission denied",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategy(false, testdata.MockCloudServiceID2),
+			},
+			args: args{
+				req: &evaluation.GetComplianceHistoryRequest{CloudServiceId: testdata.MockCloudServiceID1},
+			},
+			wantRes: assert.Nil[*evaluation.GetComplianceHistoryResponse],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, service.ErrPermissionDenied)
+			},
+		},
+		{
+			name: "Happy path",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategy(false, testdata.MockCloudServiceID1),
+			},
+			args: args{
+				req: &evaluation.GetComplianceHistoryRequest{CloudServiceId: testdata.MockCloudServiceID1},
+			},
+			wantRes: func(t *testing.T, got *evaluation.GetComplianceHistoryResponse) bool {
+				if !assert.Equal(t, 2, len(got.Series)) {
+					return false
+				}
+
+				assert.Equal(t, testdata.MockControlID1, got.Series[0].ControlId)
+				assert.Equal(t, 2, len(got.Series[0].Points))
+				assert.Equal(t, evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT, got.Series[0].Points[0].Status)
+				assert.Equal(t, evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT, got.Series[0].Points[1].Status)
+
+				if !assert.Equal(t, 2, len(got.Summary)) {
+					return false
+				}
+
+				assert.Equal(t, int32(1), got.Summary[0].Compliant)
+				assert.Equal(t, int32(1), got.Summary[0].NotCompliant)
+				assert.Equal(t, int32(1), got.Summary[1].Compliant)
+				return assert.Equal(t, int32(1), got.Summary[1].Pending)
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "Happy path: filter by control and time",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &evaluation.GetComplianceHistoryRequest{
+					CloudServiceId: testdata.MockCloudServiceID1,
+					ControlId:      util.Ref(testdata.MockControlID2),
+					Since:          timestamppb.New(t2),
+				},
+			},
+			wantRes: func(t *testing.T, got *evaluation.GetComplianceHistoryResponse) bool {
+				if !assert.Equal(t, 1, len(got.Series)) {
+					return false
+				}
+
+				assert.Equal(t, 1, len(got.Series[0].Points))
+				return assert.Equal(t, evaluation.EvaluationStatus_EVALUATION_STATUS_PENDING, got.Series[0].Points[0].Status)
+			},
+			wantErr: assert.Nil[error],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					assert.NoError(t, s.Create(newMockComplianceSnapshot(testdata.MockControlID1, evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT, t1)))
+					assert.NoError(t, s.Create(newMockComplianceSnapshot(testdata.MockControlID2, evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT, t1)))
+					assert.NoError(t, s.Create(newMockComplianceSnapshot(testdata.MockControlID1, evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT, t2)))
+					assert.NoError(t, s.Create(newMockComplianceSnapshot(testdata.MockControlID2, evaluation.EvaluationStatus_EVALUATION_STATUS_PENDING, t2)))
+
+					snapshot := newMockComplianceSnapshot(testdata.MockControlID1, evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT, t1)
+					snapshot.CloudServiceId = testdata.MockCloudServiceID2
+					assert.NoError(t, s.Create(snapshot))
+				}),
+				authz: tt.fields.authz,
+			}
+
+			gotRes, err := servicetest.Validated(svc.GetComplianceHistory)(context.Background(), tt.args.req)
+			tt.wantErr(t, err)
+			tt.wantRes(t, gotRes)
+		})
+	}
+}
+
+func TestService_TakeComplianceSnapshot(t *testing.T) {
+	type fields struct {
+		storage persistence.Storage
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		wantSvc assert.Want[*Service]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "No evaluation results",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t),
+			},
+			wantSvc: func(t *testing.T, got *Service) bool {
+				var snapshots []*evaluation.ComplianceSnapshot
+				assert.NoError(t, got.storage.List(&snapshots, "", true, 0, -1))
+				return assert.Equal(t, 0, len(snapshots))
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "Happy path",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					assert.NoError(t, s.Create(evaluationtest.MockEvaluationResults))
+				}),
+			},
+			wantSvc: func(t *testing.T, got *Service) bool {
+				var snapshots []*evaluation.ComplianceSnapshot
+				assert.NoError(t, got.storage.List(&snapshots, "", true, 0, -1))
+
+				// We expect one snapshot for the latest result of each control
+				if !assert.Equal(t, 6, len(snapshots)) {
+					return false
+				}
+
+				summary := complianceSummary(snapshots)
+				assert.Equal(t, 1, len(summary))
+				assert.Equal(t, int32(4), summary[0].Compliant)
+				return assert.Equal(t, int32(2), summary[0].NotCompliant)
+			},
+			wantErr: assert.Nil[error],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				storage: tt.fields.storage,
+			}
+
+			err := svc.TakeComplianceSnapshot()
+			tt.wantErr(t, err)
+			tt.wantSvc(t, svc)
+		})
+	}
+}
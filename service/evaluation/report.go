@@ -0,0 +1,180 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package evaluation
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	"clouditor.io/clouditor/v2/api/assessment"
+	"clouditor.io/clouditor/v2/api/evaluation"
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/internal/pseudonym"
+	"clouditor.io/clouditor/v2/internal/report"
+	"clouditor.io/clouditor/v2/service"
+
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GenerateComplianceReport is a method implementation of the evaluation interface: It generates a compliance report
+// of a target of evaluation based on the latest evaluation result of each control and renders it in the requested
+// format. If requested, the failing and waived resources are pseudonymized.
+func (svc *Service) GenerateComplianceReport(ctx context.Context, req *evaluation.GenerateComplianceReportRequest) (res *httpbody.HttpBody, err error) {
+	var (
+		r   *report.Report
+		buf bytes.Buffer
+	)
+
+	// Check, if this request has access to the cloud service according to our authorization strategy.
+	if !svc.authz.CheckAccess(ctx, service.AccessRead, req) {
+		return nil, service.ErrPermissionDenied
+	}
+
+	if req.Pseudonymize && svc.pseudonymizer == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "%v", pseudonym.ErrNotConfigured)
+	}
+
+	r, err = svc.buildReport(ctx, req.CloudServiceId, req.CatalogId)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Pseudonymize {
+		r.Pseudonymize(svc.pseudonymizer)
+	}
+
+	res = new(httpbody.HttpBody)
+
+	switch req.Format {
+	case evaluation.ReportFormat_REPORT_FORMAT_PDF:
+		res.ContentType = report.ContentTypePDF
+		err = report.RenderPDF(&buf, r)
+	default:
+		res.ContentType = report.ContentTypeHTML
+		err = report.RenderHTML(&buf, r)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not render report: %v", err)
+	}
+
+	res.Data = buf.Bytes()
+
+	return
+}
+
+// buildReport gathers all information of a target of evaluation that is part of a compliance report.
+func (svc *Service) buildReport(ctx context.Context, cloudServiceID, catalogID string) (r *report.Report, err error) {
+	var (
+		results []*evaluation.EvaluationResult
+		cached  *orchestrator.Control
+	)
+
+	r = &report.Report{
+		GeneratedAt: time.Now(),
+	}
+
+	r.TargetOfEvaluation, r.Catalog, err = svc.prepareEvaluation(cloudServiceID, catalogID)
+	if err != nil {
+		return nil, err
+	}
+
+	r.CloudService, err = svc.orchestrator.Client.GetCloudService(ctx, &orchestrator.GetCloudServiceRequest{
+		CloudServiceId: cloudServiceID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not get cloud service: %v", err)
+	}
+
+	// Retrieve the latest evaluation result of each control
+	err = svc.storage.Raw(&results,
+		`WITH sorted_results AS (
+			SELECT *, ROW_NUMBER() OVER (PARTITION BY control_category_name, control_id ORDER BY timestamp DESC) AS row_number
+			FROM evaluation_results
+			WHERE cloud_service_id = ? AND control_catalog_id = ?
+		)
+		SELECT * FROM sorted_results WHERE row_number = 1;`, cloudServiceID, catalogID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
+	for _, category := range r.Catalog.Categories {
+		for _, control := range category.Controls {
+			err = svc.addReportControl(ctx, r, control, results)
+			if err != nil {
+				return nil, err
+			}
+
+			// The controls of the catalog do not contain their sub-controls, so we take them from the cached controls
+			cached, err = svc.getControl(catalogID, control.CategoryName, control.Id)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "could not get control: %v", err)
+			}
+
+			for _, sub := range cached.Controls {
+				err = svc.addReportControl(ctx, r, sub, results)
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	r.Waivers = svc.activeWaivers(cloudServiceID)
+
+	return
+}
+
+// addReportControl adds the control together with its latest evaluation result (if any) and its failing resources to
+// the report.
+func (svc *Service) addReportControl(ctx context.Context, r *report.Report, control *orchestrator.Control, results []*evaluation.EvaluationResult) (err error) {
+	var (
+		c           = &report.Control{Control: control}
+		assessments []*assessment.AssessmentResult
+	)
+
+	for _, result := range results {
+		if result.ControlCategoryName == control.CategoryName && result.ControlId == control.Id {
+			c.Result = result
+			break
+		}
+	}
+
+	if len(c.Result.GetFailingAssessmentResultIds()) > 0 {
+		assessments, err = svc.contributingAssessmentResults(ctx, c.Result)
+		if err != nil {
+			return status.Errorf(codes.Internal, "could not retrieve assessment results: %v", err)
+		}
+
+		c.FailingResources = failingResources(assessments, c.Result.FailingAssessmentResultIds)
+	}
+
+	r.Controls = append(r.Controls, c)
+
+	return nil
+}
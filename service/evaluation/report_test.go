@@ -0,0 +1,247 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package evaluation
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"clouditor.io/clouditor/v2/api"
+	"clouditor.io/clouditor/v2/api/evaluation"
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/internal/pseudonym"
+	"clouditor.io/clouditor/v2/internal/report"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/testutil/servicetest"
+	"clouditor.io/clouditor/v2/internal/testutil/servicetest/orchestratortest"
+	"clouditor.io/clouditor/v2/persistence"
+	"clouditor.io/clouditor/v2/service"
+
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestService_GenerateComplianceReport(t *testing.T) {
+	var (
+		orchestratorStorage = testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+			assert.NoError(t, s.Create(orchestratortest.NewCatalog()))
+			assert.NoError(t, s.Create(orchestratortest.NewCloudService()))
+			assert.NoError(t, s.Create(orchestratortest.NewTargetOfEvaluation(testdata.AssuranceLevelBasic)))
+			assert.NoError(t, s.Create(orchestratortest.MockAssessmentResults))
+		})
+		results = []*evaluation.EvaluationResult{
+			{
+				Id:                         "11111111-1111-1111-1111-111111111111",
+				CloudServiceId:             testdata.MockCloudServiceID1,
+				ControlId:                  testdata.MockControlID1,
+				ControlCategoryName:        testdata.MockCategoryName,
+				ControlCatalogId:           testdata.MockCatalogID,
+				Status:                     evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT,
+				Timestamp:                  timestamppb.New(time.Unix(20, 0)),
+				FailingAssessmentResultIds: []string{testdata.MockAssessmentResult3ID},
+			},
+			{
+				Id:                  "22222222-2222-2222-2222-222222222222",
+				CloudServiceId:      testdata.MockCloudServiceID1,
+				ControlId:           testdata.MockControlID1,
+				ControlCategoryName: testdata.MockCategoryName,
+				ControlCatalogId:    testdata.MockCatalogID,
+				Status:              evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT,
+				Timestamp:           timestamppb.New(time.Unix(10, 0)),
+			},
+		}
+		p, _ = pseudonym.New("my-salt")
+	)
+
+	type fields struct {
+		authz         service.AuthorizationStrategy
+		pseudonymizer *pseudonym.Pseudonymizer
+	}
+	type args struct {
+		req *evaluation.GenerateComplianceReportRequest
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    assert.Want[*httpbody.HttpBody]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "validation error",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &evaluation.GenerateComplianceReportRequest{CloudServiceId: testdata.MockCloudServiceID1},
+			},
+			want: assert.Nil[*httpbody.HttpBody],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "catalog_id: value length must be at least 1 characters")
+			},
+		},
+		{
+			name: "permission denied",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategy(false, testdata.MockCloudServiceID2),
+			},
+			args: args{
+				req: &evaluation.GenerateComplianceReportRequest{
+					CloudServiceId: testdata.MockCloudServiceID1,
+					CatalogId:      testdata.MockCatalogID,
+				},
+			},
+			want: assert.Nil[*httpbody.HttpBody],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, service.ErrPermissionDenied)
+			},
+		},
+		{
+			name: "target of evaluation not found",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &evaluation.GenerateComplianceReportRequest{
+					CloudServiceId: testdata.MockCloudServiceID2,
+					CatalogId:      testdata.MockCatalogID,
+				},
+			},
+			want: assert.Nil[*httpbody.HttpBody],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.Equal(t, codes.Internal, status.Code(err)) &&
+					assert.ErrorContains(t, err, "could not get target of evaluation")
+			},
+		},
+		{
+			name: "happy path: HTML",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &evaluation.GenerateComplianceReportRequest{
+					CloudServiceId: testdata.MockCloudServiceID1,
+					CatalogId:      testdata.MockCatalogID,
+				},
+			},
+			want: func(t *testing.T, got *httpbody.HttpBody) bool {
+				html := string(got.Data)
+
+				// Only the latest result of a control is part of the report
+				return assert.Equal(t, report.ContentTypeHTML, got.ContentType) &&
+					assert.Contains(t, html, testdata.MockCloudServiceName1) &&
+					assert.Contains(t, html, testdata.MockCatalogName) &&
+					assert.Contains(t, html, `<td class="status-not-compliant">Not compliant</td>`) &&
+					!strings.Contains(html, `<td class="status-compliant">Compliant</td>`) &&
+					assert.Contains(t, html, testdata.MockSubControlID11) &&
+					assert.Contains(t, html, testdata.MockResourceID1) &&
+					assert.Contains(t, html, "<li>"+testdata.MockEvidenceID1+"</li>")
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "happy path: PDF",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &evaluation.GenerateComplianceReportRequest{
+					CloudServiceId: testdata.MockCloudServiceID1,
+					CatalogId:      testdata.MockCatalogID,
+					Format:         evaluation.ReportFormat_REPORT_FORMAT_PDF,
+				},
+			},
+			want: func(t *testing.T, got *httpbody.HttpBody) bool {
+				return assert.Equal(t, report.ContentTypePDF, got.ContentType) &&
+					assert.True(t, strings.HasPrefix(string(got.Data), "%PDF-"))
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "pseudonymization not configured",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &evaluation.GenerateComplianceReportRequest{
+					CloudServiceId: testdata.MockCloudServiceID1,
+					CatalogId:      testdata.MockCatalogID,
+					Pseudonymize:   true,
+				},
+			},
+			want: assert.Nil[*httpbody.HttpBody],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.Equal(t, codes.FailedPrecondition, status.Code(err)) &&
+					assert.ErrorContains(t, err, pseudonym.ErrNotConfigured.Error())
+			},
+		},
+		{
+			name: "happy path: pseudonymized",
+			fields: fields{
+				authz:         servicetest.NewAuthorizationStrategy(true),
+				pseudonymizer: p,
+			},
+			args: args{
+				req: &evaluation.GenerateComplianceReportRequest{
+					CloudServiceId: testdata.MockCloudServiceID1,
+					CatalogId:      testdata.MockCatalogID,
+					Pseudonymize:   true,
+				},
+			},
+			want: func(t *testing.T, got *httpbody.HttpBody) bool {
+				html := string(got.Data)
+
+				return assert.Contains(t, html, p.Pseudonym(pseudonym.FieldResourceID, testdata.MockResourceID1)) &&
+					!strings.Contains(html, testdata.MockResourceID1)
+			},
+			wantErr: assert.Nil[error],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				orchestrator: api.NewRPCConnection("bufnet", orchestrator.NewOrchestratorClient, grpc.WithContextDialer(newBufConnDialer(orchestratorStorage))),
+				storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					assert.NoError(t, s.Create(results))
+				}),
+				authz:           tt.fields.authz,
+				catalogControls: make(map[string]map[string]*orchestrator.Control),
+				pseudonymizer:   tt.fields.pseudonymizer,
+			}
+			got, err := servicetest.Validated(svc.GenerateComplianceReport)(context.Background(), tt.args.req)
+
+			tt.wantErr(t, err)
+			tt.want(t, got)
+		})
+	}
+}
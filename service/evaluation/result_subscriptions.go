@@ -0,0 +1,198 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package evaluation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+
+	"clouditor.io/clouditor/v2/api/evaluation"
+	"clouditor.io/clouditor/v2/service"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// resultSubscriberBufferSize is the number of evaluation results that are buffered for each subscriber. Results are
+// dropped for a subscriber whose buffer is full.
+const resultSubscriberBufferSize = 1000
+
+// resultSubscriber is a subscriber of evaluation results, e.g., the CLI watching the results during a remediation.
+type resultSubscriber struct {
+	results chan *evaluation.EvaluationResult
+	filter  *evaluation.SubscribeEvaluationResultsRequest_Filter
+
+	// all and allowed are the cloud services the subscriber was allowed to access at the time of subscription
+	all     bool
+	allowed []string
+}
+
+// matches checks, whether the result is relevant for the subscriber according to its filter and allowed cloud
+// services.
+func (sub *resultSubscriber) matches(result *evaluation.EvaluationResult) bool {
+	var filter = sub.filter
+
+	if !sub.all && !slices.Contains(sub.allowed, result.GetCloudServiceId()) {
+		return false
+	}
+
+	if filter == nil {
+		return true
+	}
+
+	if filter.CloudServiceId != nil && filter.GetCloudServiceId() != result.GetCloudServiceId() {
+		return false
+	}
+
+	if filter.CatalogId != nil && filter.GetCatalogId() != result.GetControlCatalogId() {
+		return false
+	}
+
+	if len(filter.ControlIds) > 0 && !slices.Contains(filter.ControlIds, result.GetControlId()) {
+		return false
+	}
+
+	if filter.Compliant != nil {
+		switch result.GetStatus() {
+		case evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT,
+			evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT_MANUALLY:
+			return filter.GetCompliant()
+		case evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT,
+			evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT_MANUALLY:
+			return !filter.GetCompliant()
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+// SubscribeEvaluationResults implements a stream of new evaluation results to the subscribed client. The stream ends
+// when the client cancels it.
+func (svc *Service) SubscribeEvaluationResults(req *evaluation.SubscribeEvaluationResultsRequest, stream evaluation.Evaluation_SubscribeEvaluationResultsServer) (err error) {
+	var (
+		all     bool
+		allowed []string
+		result  *evaluation.EvaluationResult
+	)
+
+	// Retrieve list of allowed cloud service according to our authorization strategy. The content of the filtered
+	// cloud service ID must be in the list of allowed cloud service IDs, unless one can access *all* the cloud
+	// services.
+	all, allowed = svc.authz.AllowedCloudServices(stream.Context())
+	if !all && req.Filter != nil && req.Filter.CloudServiceId != nil && !slices.Contains(allowed, req.Filter.GetCloudServiceId()) {
+		return service.ErrPermissionDenied
+	}
+
+	sub := svc.subscribeEvaluationResults(req.Filter, all, allowed)
+	defer svc.unsubscribeEvaluationResults(sub)
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case result = <-sub.results:
+		}
+
+		err = stream.Send(result)
+
+		// Check for send errors
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			err = fmt.Errorf("cannot stream response to the client: %w", err)
+			log.Error(err)
+
+			return status.Errorf(codes.Unknown, "%v", err)
+		}
+	}
+}
+
+// subscribeEvaluationResults registers a new subscriber of evaluation results.
+func (svc *Service) subscribeEvaluationResults(filter *evaluation.SubscribeEvaluationResultsRequest_Filter, all bool, allowed []string) (sub *resultSubscriber) {
+	sub = &resultSubscriber{
+		results: make(chan *evaluation.EvaluationResult, resultSubscriberBufferSize),
+		filter:  filter,
+		all:     all,
+		allowed: allowed,
+	}
+
+	svc.subscriberMutex.Lock()
+	defer svc.subscriberMutex.Unlock()
+
+	if svc.resultSubscribers == nil {
+		svc.resultSubscribers = make(map[*resultSubscriber]struct{})
+	}
+
+	svc.resultSubscribers[sub] = struct{}{}
+
+	return
+}
+
+// unsubscribeEvaluationResults removes a subscriber of evaluation results.
+func (svc *Service) unsubscribeEvaluationResults(sub *resultSubscriber) {
+	svc.subscriberMutex.Lock()
+	defer svc.subscriberMutex.Unlock()
+
+	delete(svc.resultSubscribers, sub)
+}
+
+// RegisterEvaluationResultHook registers a hook function that is informed about each newly stored evaluation result,
+// e.g., to publish it to an event bus.
+func (svc *Service) RegisterEvaluationResultHook(hook evaluation.ResultHookFunc) {
+	svc.subscriberMutex.Lock()
+	defer svc.subscriberMutex.Unlock()
+
+	svc.resultHooks = append(svc.resultHooks, hook)
+}
+
+// publishEvaluationResult sends a newly stored evaluation result to all matching subscribers and informs the hooks.
+func (svc *Service) publishEvaluationResult(result *evaluation.EvaluationResult) {
+	svc.subscriberMutex.RLock()
+	defer svc.subscriberMutex.RUnlock()
+
+	for _, hook := range svc.resultHooks {
+		hook(context.Background(), result, nil)
+	}
+
+	for sub := range svc.resultSubscribers {
+		if !sub.matches(result) {
+			continue
+		}
+
+		// Do not block the evaluation because of a slow subscriber
+		select {
+		case sub.results <- result:
+		default:
+			log.Warnf("Dropping evaluation result %s for a subscriber that cannot keep up", result.Id)
+		}
+	}
+}
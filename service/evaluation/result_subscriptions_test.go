@@ -0,0 +1,322 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package evaluation
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"clouditor.io/clouditor/v2/api/evaluation"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/testutil/servicetest"
+	"clouditor.io/clouditor/v2/internal/util"
+	"clouditor.io/clouditor/v2/service"
+
+	"google.golang.org/grpc"
+)
+
+type mockResultSubscriptionStream struct {
+	grpc.ServerStream
+	ctx     context.Context
+	results chan *evaluation.EvaluationResult
+	sendErr error
+}
+
+func newMockResultSubscriptionStream(ctx context.Context) *mockResultSubscriptionStream {
+	return &mockResultSubscriptionStream{
+		ctx:     ctx,
+		results: make(chan *evaluation.EvaluationResult, 10),
+	}
+}
+
+func (m *mockResultSubscriptionStream) Send(result *evaluation.EvaluationResult) error {
+	if m.sendErr != nil {
+		return m.sendErr
+	}
+
+	m.results <- result
+	return nil
+}
+
+func (m *mockResultSubscriptionStream) Context() context.Context {
+	return m.ctx
+}
+
+// waitForSubscribers waits until the service has the specified number of result subscribers
+func waitForSubscribers(t *testing.T, svc *Service, n int) {
+	for i := 0; i < 100; i++ {
+		svc.subscriberMutex.RLock()
+		l := len(svc.resultSubscribers)
+		svc.subscriberMutex.RUnlock()
+
+		if l == n {
+			return
+		}
+
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	t.Fatalf("timeout while waiting for %d subscribers", n)
+}
+
+func Test_resultSubscriber_matches(t *testing.T) {
+	var (
+		compliant    = newMockEvaluationResult(evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT)
+		nonCompliant = newMockEvaluationResult(evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT_MANUALLY)
+		pending      = newMockEvaluationResult(evaluation.EvaluationStatus_EVALUATION_STATUS_PENDING)
+	)
+
+	type fields struct {
+		filter  *evaluation.SubscribeEvaluationResultsRequest_Filter
+		all     bool
+		allowed []string
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		result *evaluation.EvaluationResult
+		want   bool
+	}{
+		{
+			name:   "no filter, all allowed",
+			fields: fields{all: true},
+			result: compliant,
+			want:   true,
+		},
+		{
+			name:   "cloud service not allowed",
+			fields: fields{allowed: []string{testdata.MockCloudServiceID2}},
+			result: compliant,
+			want:   false,
+		},
+		{
+			name: "other cloud service",
+			fields: fields{
+				filter: &evaluation.SubscribeEvaluationResultsRequest_Filter{CloudServiceId: util.Ref(testdata.MockCloudServiceID2)},
+				all:    true,
+			},
+			result: compliant,
+			want:   false,
+		},
+		{
+			name: "other catalog",
+			fields: fields{
+				filter: &evaluation.SubscribeEvaluationResultsRequest_Filter{CatalogId: util.Ref("other")},
+				all:    true,
+			},
+			result: compliant,
+			want:   false,
+		},
+		{
+			name: "matching control",
+			fields: fields{
+				filter: &evaluation.SubscribeEvaluationResultsRequest_Filter{ControlIds: []string{testdata.MockControlID2, testdata.MockControlID1}},
+				all:    true,
+			},
+			result: compliant,
+			want:   true,
+		},
+		{
+			name: "other control",
+			fields: fields{
+				filter: &evaluation.SubscribeEvaluationResultsRequest_Filter{ControlIds: []string{testdata.MockControlID2}},
+				all:    true,
+			},
+			result: compliant,
+			want:   false,
+		},
+		{
+			name: "only non-compliant, compliant",
+			fields: fields{
+				filter: &evaluation.SubscribeEvaluationResultsRequest_Filter{Compliant: util.Ref(false)},
+				all:    true,
+			},
+			result: compliant,
+			want:   false,
+		},
+		{
+			name: "only non-compliant, manually non-compliant",
+			fields: fields{
+				filter: &evaluation.SubscribeEvaluationResultsRequest_Filter{Compliant: util.Ref(false)},
+				all:    true,
+			},
+			result: nonCompliant,
+			want:   true,
+		},
+		{
+			name: "only compliant, pending",
+			fields: fields{
+				filter: &evaluation.SubscribeEvaluationResultsRequest_Filter{Compliant: util.Ref(true)},
+				all:    true,
+			},
+			result: pending,
+			want:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sub := &resultSubscriber{
+				filter:  tt.fields.filter,
+				all:     tt.fields.all,
+				allowed: tt.fields.allowed,
+			}
+
+			assert.Equal(t, tt.want, sub.matches(tt.result))
+		})
+	}
+}
+
+func TestService_SubscribeEvaluationResults(t *testing.T) {
+	var (
+		result1 = newMockEvaluationResult(evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT)
+		result2 = newMockEvaluationResult(evaluation.EvaluationStatus_EVALUATION_STATUS_COMPLIANT)
+		result3 = newMockEvaluationResult(evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT)
+	)
+
+	result2.CloudServiceId = testdata.MockCloudServiceID2
+
+	type args struct {
+		req     *evaluation.SubscribeEvaluationResultsRequest
+		sendErr error
+	}
+	tests := []struct {
+		name        string
+		authz       service.AuthorizationStrategy
+		args        args
+		results     []*evaluation.EvaluationResult
+		wantResults []*evaluation.EvaluationResult
+		wantErr     assert.WantErr
+	}{
+		{
+			name:  "invalid request",
+			authz: servicetest.NewAuthorizationStrategy(true),
+			args: args{
+				req: &evaluation.SubscribeEvaluationResultsRequest{
+					Filter: &evaluation.SubscribeEvaluationResultsRequest_Filter{CloudServiceId: util.Ref("not-a-uuid")},
+				},
+			},
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "filter.cloud_service_id: value must be a valid UUID")
+			},
+		},
+		{
+			name:  "permission denied",
+			authz: servicetest.NewAuthorizationStrategy(false, testdata.MockCloudServiceID2),
+			args: args{
+				req: &evaluation.SubscribeEvaluationResultsRequest{
+					Filter: &evaluation.SubscribeEvaluationResultsRequest_Filter{CloudServiceId: util.Ref(testdata.MockCloudServiceID1)},
+				},
+			},
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, service.ErrPermissionDenied)
+			},
+		},
+		{
+			name:  "only allowed cloud services",
+			authz: servicetest.NewAuthorizationStrategy(false, testdata.MockCloudServiceID1),
+			args: args{
+				req: &evaluation.SubscribeEvaluationResultsRequest{},
+			},
+			results:     []*evaluation.EvaluationResult{result1, result2, result3},
+			wantResults: []*evaluation.EvaluationResult{result1, result3},
+			wantErr:     assert.Nil[error],
+		},
+		{
+			name:  "send error",
+			authz: servicetest.NewAuthorizationStrategy(true),
+			args: args{
+				req:     &evaluation.SubscribeEvaluationResultsRequest{},
+				sendErr: errors.New("some error"),
+			},
+			results: []*evaluation.EvaluationResult{result1},
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "cannot stream response to the client: some error")
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{authz: tt.authz}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			stream := newMockResultSubscriptionStream(ctx)
+			stream.sendErr = tt.args.sendErr
+
+			errc := make(chan error, 1)
+			go func() {
+				errc <- servicetest.ValidatedStream(svc.SubscribeEvaluationResults)(tt.args.req, stream)
+			}()
+
+			if len(tt.results) > 0 {
+				waitForSubscribers(t, svc, 1)
+			}
+
+			for _, r := range tt.results {
+				svc.publishEvaluationResult(r)
+			}
+
+			for _, want := range tt.wantResults {
+				select {
+				case got := <-stream.results:
+					assert.Equal(t, want, got)
+				case <-time.After(time.Second):
+					t.Fatal("timeout while waiting for result")
+				}
+			}
+
+			// End the subscription, unless it already returned
+			if tt.args.sendErr == nil {
+				cancel()
+			}
+
+			err := <-errc
+			tt.wantErr(t, err)
+
+			assert.Equal(t, 0, len(stream.results))
+			waitForSubscribers(t, svc, 0)
+		})
+	}
+}
+
+func TestService_RegisterEvaluationResultHook(t *testing.T) {
+	var got []*evaluation.EvaluationResult
+
+	svc := &Service{}
+	svc.RegisterEvaluationResultHook(func(_ context.Context, result *evaluation.EvaluationResult, err error) {
+		assert.NoError(t, err)
+		got = append(got, result)
+	})
+
+	result := &evaluation.EvaluationResult{Id: testdata.MockEvaluationResult1ID, CloudServiceId: testdata.MockCloudServiceID1}
+	svc.publishEvaluationResult(result)
+
+	assert.Equal(t, []*evaluation.EvaluationResult{result}, got)
+}
@@ -0,0 +1,221 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package evaluation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"clouditor.io/clouditor/v2/api/evaluation"
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/internal/util"
+	"clouditor.io/clouditor/v2/persistence"
+	"clouditor.io/clouditor/v2/service"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ErrEvaluationRunning indicates that an evaluation of the target of evaluation is still in progress
+var ErrEvaluationRunning = errors.New("evaluation is still in progress")
+
+// TriggerEvaluation is a method implementation of the evaluation interface: It starts an on-demand evaluation of a
+// cloud service and the given catalog in the background and returns the (running) evaluation run.
+func (svc *Service) TriggerEvaluation(ctx context.Context, req *evaluation.TriggerEvaluationRequest) (res *evaluation.EvaluationRun, err error) {
+	var (
+		toe     *orchestrator.TargetOfEvaluation
+		catalog *orchestrator.Catalog
+	)
+
+	// Check, if this request has access to the cloud service according to our authorization strategy.
+	if !svc.authz.CheckAccess(ctx, service.AccessCreate, req) {
+		return nil, service.ErrPermissionDenied
+	}
+
+	// Retrieve the target of evaluation and its catalog
+	toe, catalog, err = svc.prepareEvaluation(req.CloudServiceId, req.CatalogId)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err = svc.beginRun(toe, evaluation.EvaluationRunTrigger_EVALUATION_RUN_TRIGGER_ON_DEMAND)
+	if errors.Is(err, ErrEvaluationRunning) {
+		return nil, status.Errorf(codes.FailedPrecondition, "evaluation for cloud service '%s' and catalog '%s' is still in progress", req.CloudServiceId, req.CatalogId)
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
+	// The evaluation runs in the background, so it must not be cancelled once the request is finished. Since the run is
+	// returned to the caller, we need to record its outcome in a copy.
+	run := proto.Clone(res).(*evaluation.EvaluationRun)
+	go func() {
+		svc.finishRun(run, svc.evaluateCatalog(context.WithoutCancel(ctx), toe, catalog, defaultTimeout))
+	}()
+
+	return res, nil
+}
+
+// ListEvaluationRuns is a method implementation of the evaluation interface: It lists the evaluation runs the user is
+// authorized to see.
+func (svc *Service) ListEvaluationRuns(ctx context.Context, req *evaluation.ListEvaluationRunsRequest) (res *evaluation.ListEvaluationRunsResponse, err error) {
+	var (
+		query []string
+		args  []any
+	)
+
+	// Retrieve list of allowed cloud service according to our authorization strategy
+	all, allowed := svc.authz.AllowedCloudServices(ctx)
+
+	if req.Filter != nil {
+		// The content of the filtered cloud service ID must be in the list of allowed cloud service IDs, unless one can
+		// access *all* the cloud services.
+		if !all && req.Filter.CloudServiceId != nil && !slices.Contains(allowed, req.Filter.GetCloudServiceId()) {
+			return nil, service.ErrPermissionDenied
+		}
+
+		if req.Filter.CloudServiceId != nil {
+			query = append(query, "cloud_service_id = ?")
+			args = append(args, req.Filter.GetCloudServiceId())
+		}
+
+		if req.Filter.CatalogId != nil {
+			query = append(query, "catalog_id = ?")
+			args = append(args, req.Filter.GetCatalogId())
+		}
+	}
+
+	if !all {
+		query = append(query, "cloud_service_id IN ?")
+		args = append(args, allowed)
+	}
+
+	// Show the latest runs first, unless something else is requested
+	if req.OrderBy == "" {
+		req.OrderBy = "started_at"
+		req.Asc = false
+	}
+
+	res = new(evaluation.ListEvaluationRunsResponse)
+
+	res.Runs, res.NextPageToken, err = service.PaginateStorage[*evaluation.EvaluationRun](req, svc.storage,
+		service.DefaultPaginationOpts, persistence.BuildConds(query, args)...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not paginate results: %v", err)
+	}
+
+	return
+}
+
+// runEvaluation evaluates the catalog for the target of evaluation and records the run in the history. It is executed
+// by the scheduler. If another run of the same target of evaluation is still in progress, the run is skipped.
+func (svc *Service) runEvaluation(ctx context.Context, toe *orchestrator.TargetOfEvaluation, catalog *orchestrator.Catalog, trigger evaluation.EvaluationRunTrigger, timeout time.Duration) error {
+	run, err := svc.beginRun(toe, trigger)
+	if errors.Is(err, ErrEvaluationRunning) {
+		log.Warnf("Skipping evaluation of Cloud Service '%s' and Catalog ID '%s', since the previous one is still in progress",
+			toe.GetCloudServiceId(), toe.GetCatalogId())
+		return nil
+	} else if err != nil {
+		log.Errorf("Could not store evaluation run: %v", err)
+		return err
+	}
+
+	err = svc.evaluateCatalog(ctx, toe, catalog, timeout)
+	svc.finishRun(run, err)
+
+	return err
+}
+
+// beginRun marks the target of evaluation as running and stores a new evaluation run. If the target of evaluation is
+// already running, a skipped run is stored instead and [ErrEvaluationRunning] is returned.
+func (svc *Service) beginRun(toe *orchestrator.TargetOfEvaluation, trigger evaluation.EvaluationRunTrigger) (run *evaluation.EvaluationRun, err error) {
+	run = &evaluation.EvaluationRun{
+		Id:             uuid.NewString(),
+		CloudServiceId: toe.GetCloudServiceId(),
+		CatalogId:      toe.GetCatalogId(),
+		Trigger:        trigger,
+		Status:         evaluation.EvaluationRunStatus_EVALUATION_RUN_STATUS_RUNNING,
+		StartedAt:      timestamppb.Now(),
+	}
+
+	svc.runningMutex.Lock()
+	defer svc.runningMutex.Unlock()
+
+	if svc.running == nil {
+		svc.running = make(map[string]bool)
+	}
+
+	if svc.running[runKey(run.CloudServiceId, run.CatalogId)] {
+		run.Status = evaluation.EvaluationRunStatus_EVALUATION_RUN_STATUS_SKIPPED
+		run.FinishedAt = run.StartedAt
+
+		err = svc.storage.Create(run)
+		if err != nil {
+			return nil, err
+		}
+
+		return run, ErrEvaluationRunning
+	}
+
+	err = svc.storage.Create(run)
+	if err != nil {
+		return nil, err
+	}
+
+	svc.running[runKey(run.CloudServiceId, run.CatalogId)] = true
+
+	return run, nil
+}
+
+// finishRun marks the target of evaluation of the run as no longer running and stores the outcome of the run.
+func (svc *Service) finishRun(run *evaluation.EvaluationRun, err error) {
+	svc.runningMutex.Lock()
+	delete(svc.running, runKey(run.CloudServiceId, run.CatalogId))
+	svc.runningMutex.Unlock()
+
+	run.FinishedAt = timestamppb.Now()
+	if err != nil {
+		run.Status = evaluation.EvaluationRunStatus_EVALUATION_RUN_STATUS_FAILED
+		run.Error = util.Ref(err.Error())
+	} else {
+		run.Status = evaluation.EvaluationRunStatus_EVALUATION_RUN_STATUS_SUCCEEDED
+	}
+
+	err = svc.storage.Save(run, "id = ?", run.Id)
+	if err != nil {
+		log.Errorf("Could not store evaluation run: %v", err)
+	}
+}
+
+// runKey identifies the target of evaluation of a run.
+func runKey(cloudServiceID string, catalogID string) string {
+	return fmt.Sprintf("%s/%s", cloudServiceID, catalogID)
+}
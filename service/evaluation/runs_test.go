@@ -0,0 +1,290 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package evaluation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"clouditor.io/clouditor/v2/api"
+	"clouditor.io/clouditor/v2/api/evaluation"
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/testutil/servicetest"
+	"clouditor.io/clouditor/v2/internal/testutil/servicetest/orchestratortest"
+	"clouditor.io/clouditor/v2/internal/util"
+	"clouditor.io/clouditor/v2/persistence"
+	"clouditor.io/clouditor/v2/service"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestService_TriggerEvaluation(t *testing.T) {
+	type fields struct {
+		authz   service.AuthorizationStrategy
+		running map[string]bool
+	}
+	type args struct {
+		req *evaluation.TriggerEvaluationRequest
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    assert.Want[*evaluation.EvaluationRun]
+		wantSvc assert.Want[*Service]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "validation error",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &evaluation.TriggerEvaluationRequest{CatalogId: testdata.MockCatalogID},
+			},
+			want: assert.Nil[*evaluation.EvaluationRun],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "cloud_service_id: value is empty, which is not a valid UUID")
+			},
+		},
+		{
+			name: "permission denied",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategy(false, testdata.MockCloudServiceID2),
+			},
+			args: args{
+				req: &evaluation.TriggerEvaluationRequest{
+					CloudServiceId: testdata.MockCloudServiceID1,
+					CatalogId:      testdata.MockCatalogID,
+				},
+			},
+			want: assert.Nil[*evaluation.EvaluationRun],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, service.ErrPermissionDenied)
+			},
+		},
+		{
+			name: "still in progress",
+			fields: fields{
+				authz:   servicetest.NewAuthorizationStrategy(true),
+				running: map[string]bool{runKey(testdata.MockCloudServiceID1, testdata.MockCatalogID): true},
+			},
+			args: args{
+				req: &evaluation.TriggerEvaluationRequest{
+					CloudServiceId: testdata.MockCloudServiceID1,
+					CatalogId:      testdata.MockCatalogID,
+				},
+			},
+			want: assert.Nil[*evaluation.EvaluationRun],
+			wantSvc: func(t *testing.T, svc *Service) bool {
+				var runs []*evaluation.EvaluationRun
+				assert.NoError(t, svc.storage.List(&runs, "", true, 0, -1))
+				return assert.Equal(t, 1, len(runs)) &&
+					assert.Equal(t, evaluation.EvaluationRunStatus_EVALUATION_RUN_STATUS_SKIPPED, runs[0].Status)
+			},
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+			},
+		},
+		{
+			name: "happy path",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &evaluation.TriggerEvaluationRequest{
+					CloudServiceId: testdata.MockCloudServiceID1,
+					CatalogId:      testdata.MockCatalogID,
+				},
+			},
+			want: func(t *testing.T, got *evaluation.EvaluationRun) bool {
+				return assert.Equal(t, evaluation.EvaluationRunTrigger_EVALUATION_RUN_TRIGGER_ON_DEMAND, got.Trigger) &&
+					assert.Equal(t, evaluation.EvaluationRunStatus_EVALUATION_RUN_STATUS_RUNNING, got.Status)
+			},
+			wantSvc: func(t *testing.T, svc *Service) bool {
+				// Wait for the evaluation to finish in the background
+				var run evaluation.EvaluationRun
+				for i := 0; i < 100; i++ {
+					assert.NoError(t, svc.storage.Get(&run))
+					if run.Status != evaluation.EvaluationRunStatus_EVALUATION_RUN_STATUS_RUNNING {
+						break
+					}
+					time.Sleep(10 * time.Millisecond)
+				}
+
+				return assert.Equal(t, evaluation.EvaluationRunStatus_EVALUATION_RUN_STATUS_SUCCEEDED, run.Status) &&
+					assert.NotNil(t, run.FinishedAt)
+			},
+			wantErr: assert.Nil[error],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				orchestrator: api.NewRPCConnection("bufnet", orchestrator.NewOrchestratorClient, grpc.WithContextDialer(newBufConnDialer(testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					assert.NoError(t, s.Create(orchestratortest.NewCatalog()))
+					assert.NoError(t, s.Create(&orchestrator.CloudService{Id: testdata.MockCloudServiceID1}))
+					assert.NoError(t, s.Create(orchestratortest.NewTargetOfEvaluation(testdata.AssuranceLevelBasic)))
+				})))),
+				storage:         testutil.NewInMemoryStorage(t),
+				authz:           tt.fields.authz,
+				catalogControls: make(map[string]map[string]*orchestrator.Control),
+				running:         tt.fields.running,
+			}
+			got, err := servicetest.Validated(svc.TriggerEvaluation)(context.Background(), tt.args.req)
+
+			tt.wantErr(t, err)
+			tt.want(t, got)
+			assert.Optional(t, tt.wantSvc, svc)
+		})
+	}
+}
+
+func TestService_ListEvaluationRuns(t *testing.T) {
+	type fields struct {
+		authz service.AuthorizationStrategy
+	}
+	type args struct {
+		req *evaluation.ListEvaluationRunsRequest
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    assert.Want[*evaluation.ListEvaluationRunsResponse]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "permission denied",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategy(false, testdata.MockCloudServiceID1),
+			},
+			args: args{
+				req: &evaluation.ListEvaluationRunsRequest{
+					Filter: &evaluation.ListEvaluationRunsRequest_Filter{
+						CloudServiceId: util.Ref(testdata.MockCloudServiceID2),
+					},
+				},
+			},
+			want: assert.Nil[*evaluation.ListEvaluationRunsResponse],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, service.ErrPermissionDenied)
+			},
+		},
+		{
+			name: "latest runs of allowed cloud services first",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategy(false, testdata.MockCloudServiceID1),
+			},
+			args: args{
+				req: &evaluation.ListEvaluationRunsRequest{
+					Filter: &evaluation.ListEvaluationRunsRequest_Filter{
+						CatalogId: util.Ref(testdata.MockCatalogID),
+					},
+				},
+			},
+			want: func(t *testing.T, got *evaluation.ListEvaluationRunsResponse) bool {
+				return assert.Equal(t, 2, len(got.Runs)) &&
+					assert.Equal(t, testdata.MockEvaluationResult2ID, got.Runs[0].Id) &&
+					assert.Equal(t, testdata.MockEvaluationResult1ID, got.Runs[1].Id)
+			},
+			wantErr: assert.Nil[error],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					now := time.Now()
+					assert.NoError(t, s.Create(&evaluation.EvaluationRun{
+						Id:             testdata.MockEvaluationResult1ID,
+						CloudServiceId: testdata.MockCloudServiceID1,
+						CatalogId:      testdata.MockCatalogID,
+						Status:         evaluation.EvaluationRunStatus_EVALUATION_RUN_STATUS_SUCCEEDED,
+						StartedAt:      timestamppb.New(now.Add(-time.Hour)),
+					}))
+					assert.NoError(t, s.Create(&evaluation.EvaluationRun{
+						Id:             testdata.MockEvaluationResult2ID,
+						CloudServiceId: testdata.MockCloudServiceID1,
+						CatalogId:      testdata.MockCatalogID,
+						Status:         evaluation.EvaluationRunStatus_EVALUATION_RUN_STATUS_FAILED,
+						StartedAt:      timestamppb.New(now),
+					}))
+					assert.NoError(t, s.Create(&evaluation.EvaluationRun{
+						Id:             testdata.MockEvaluationResult3ID,
+						CloudServiceId: testdata.MockCloudServiceID2,
+						CatalogId:      testdata.MockCatalogID,
+						Status:         evaluation.EvaluationRunStatus_EVALUATION_RUN_STATUS_SUCCEEDED,
+						StartedAt:      timestamppb.New(now),
+					}))
+				}),
+				authz: tt.fields.authz,
+			}
+			got, err := svc.ListEvaluationRuns(context.Background(), tt.args.req)
+
+			tt.wantErr(t, err)
+			tt.want(t, got)
+		})
+	}
+}
+
+func TestService_runEvaluation(t *testing.T) {
+	toe := orchestratortest.NewTargetOfEvaluation(testdata.AssuranceLevelBasic)
+
+	svc := &Service{
+		orchestrator: api.NewRPCConnection("bufnet", orchestrator.NewOrchestratorClient, grpc.WithContextDialer(newBufConnDialer(testutil.NewInMemoryStorage(t)))),
+		storage:      testutil.NewInMemoryStorage(t),
+		authz:        servicetest.NewAuthorizationStrategy(true),
+	}
+
+	// A run that overlaps with a run in progress is skipped
+	running, err := svc.beginRun(toe, evaluation.EvaluationRunTrigger_EVALUATION_RUN_TRIGGER_ON_DEMAND)
+	assert.NoError(t, err)
+
+	err = svc.runEvaluation(context.Background(), toe, orchestratortest.NewCatalog(), evaluation.EvaluationRunTrigger_EVALUATION_RUN_TRIGGER_SCHEDULED, time.Minute)
+	assert.NoError(t, err)
+
+	count, err := svc.storage.Count(&evaluation.EvaluationRun{}, "status = ?", evaluation.EvaluationRunStatus_EVALUATION_RUN_STATUS_SKIPPED)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	// Once the run is finished, the next run is executed
+	svc.finishRun(running, nil)
+
+	err = svc.runEvaluation(context.Background(), toe, orchestratortest.NewCatalog(), evaluation.EvaluationRunTrigger_EVALUATION_RUN_TRIGGER_SCHEDULED, time.Minute)
+	assert.NoError(t, err)
+
+	count, err = svc.storage.Count(&evaluation.EvaluationRun{}, "status = ?", evaluation.EvaluationRunStatus_EVALUATION_RUN_STATUS_SUCCEEDED)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+}
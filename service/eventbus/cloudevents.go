@@ -0,0 +1,116 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package eventbus
+
+import (
+	"encoding/json"
+	"time"
+
+	"clouditor.io/clouditor/v2/api/evidence"
+	"clouditor.io/clouditor/v2/api/ontology"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// CloudEventsSpecVersion is the version of the CloudEvents specification of our events.
+	CloudEventsSpecVersion = "1.0"
+
+	// CloudEventsContentType is the content type of a CloudEvent in the structured content mode.
+	CloudEventsContentType = "application/cloudevents+json"
+
+	// DefaultSource is the default source of our CloudEvents.
+	DefaultSource = "/clouditor"
+)
+
+// The types of our CloudEvents.
+const (
+	EventTypeEvidence          = "io.clouditor.evidence.stored"
+	EventTypeAssessmentResult  = "io.clouditor.assessment_result.stored"
+	EventTypeEvaluationResult  = "io.clouditor.evaluation_result.stored"
+	EventTypeDiscoveryStarted  = "io.clouditor.discovery.started"
+	EventTypeDiscoveryFinished = "io.clouditor.discovery.finished"
+)
+
+// CloudEvent is a CloudEvent 1.0 in the structured content mode of the JSON event format. The data is the protobuf
+// JSON mapping of the published message. The cloud service it belongs to is contained in the extension attribute
+// "cloudserviceid".
+type CloudEvent struct {
+	SpecVersion     string          `json:"specversion"`
+	ID              string          `json:"id"`
+	Source          string          `json:"source"`
+	Type            string          `json:"type"`
+	Subject         string          `json:"subject,omitempty"`
+	Time            time.Time       `json:"time"`
+	DataContentType string          `json:"datacontenttype"`
+	CloudServiceID  string          `json:"cloudserviceid,omitempty"`
+	Data            json.RawMessage `json:"data"`
+}
+
+// event contains the attributes of a published message, which are used for its CloudEvent.
+type event struct {
+	typ            string
+	id             string
+	subject        string
+	cloudServiceID string
+	time           time.Time
+}
+
+// cloudEvent serializes m as data of a [CloudEvent] with the attributes of ev.
+func (b *Bus) cloudEvent(ev event, m proto.Message) ([]byte, error) {
+	data, err := protojson.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&CloudEvent{
+		SpecVersion:     CloudEventsSpecVersion,
+		ID:              ev.id,
+		Source:          b.source,
+		Type:            ev.typ,
+		Subject:         ev.subject,
+		Time:            ev.time.UTC(),
+		DataContentType: "application/json",
+		CloudServiceID:  ev.cloudServiceID,
+		Data:            data,
+	})
+}
+
+// resourceID returns the ID of the resource of the evidence or an empty string, if it cannot be determined.
+func resourceID(ev *evidence.Evidence) string {
+	m, err := ev.GetResource().UnmarshalNew()
+	if err != nil {
+		return ""
+	}
+
+	r, ok := m.(ontology.IsResource)
+	if !ok {
+		return ""
+	}
+
+	return r.GetId()
+}
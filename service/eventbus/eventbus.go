@@ -0,0 +1,331 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+// Package eventbus contains an output plugin that publishes new evidences, assessment results, evaluation results and
+// discovery runs to an event bus, such as Kafka or NATS, or to an HTTP endpoint, so that downstream data platforms can
+// consume them without polling the Clouditor APIs. Messages can be wrapped into CloudEvents (see
+// [EncodingCloudEvents]), e.g., for Knative or EventBridge-style consumers.
+package eventbus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"clouditor.io/clouditor/v2/api/assessment"
+	"clouditor.io/clouditor/v2/api/evaluation"
+	"clouditor.io/clouditor/v2/api/evidence"
+	"clouditor.io/clouditor/v2/service"
+	"clouditor.io/clouditor/v2/service/discovery"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const (
+	// DefaultEvidenceTopic is the default topic to which new evidences are published.
+	DefaultEvidenceTopic = "clouditor.evidences"
+
+	// DefaultResultTopic is the default topic to which new assessment results are published.
+	DefaultResultTopic = "clouditor.assessment-results"
+
+	// DefaultEvaluationTopic is the default topic to which new evaluation results are published.
+	DefaultEvaluationTopic = "clouditor.evaluation-results"
+
+	// DefaultDiscoveryTopic is the default topic to which the start and end of discovery runs are published.
+	DefaultDiscoveryTopic = "clouditor.discovery-runs"
+
+	// DefaultPublishTimeout is the default timeout of a single publish operation.
+	DefaultPublishTimeout = 10 * time.Second
+)
+
+// Encoding specifies how messages are serialized before they are published.
+type Encoding string
+
+const (
+	// EncodingProtobuf serializes messages using the protobuf binary wire format.
+	EncodingProtobuf Encoding = "protobuf"
+
+	// EncodingJSON serializes messages using the protobuf JSON mapping.
+	EncodingJSON Encoding = "json"
+
+	// EncodingCloudEvents wraps messages in their protobuf JSON mapping into CloudEvents 1.0 in the structured content
+	// mode, see [CloudEvent].
+	EncodingCloudEvents Encoding = "cloudevents"
+)
+
+var (
+	// ErrUnknownEncoding is returned if an encoding is neither [EncodingProtobuf] nor [EncodingJSON].
+	ErrUnknownEncoding = errors.New("unknown encoding")
+
+	// ErrUnknownPublisher is returned if the type of a publisher is unknown.
+	ErrUnknownPublisher = errors.New("unknown publisher type")
+
+	// ErrUnsupportedEncoding is returned if a publisher does not support an encoding.
+	ErrUnsupportedEncoding = errors.New("unsupported encoding")
+)
+
+var log *logrus.Entry
+
+func init() {
+	log = logrus.WithField("component", "eventbus")
+}
+
+// Publisher publishes serialized messages to a topic of an event bus. The key can be used by the event bus to
+// partition messages, e.g., by their cloud service.
+type Publisher interface {
+	Publish(ctx context.Context, topic string, key string, data []byte) error
+	Close() error
+}
+
+// Bus publishes evidences, assessment results, evaluation results and discovery runs to a [Publisher]. Its methods
+// [Bus.PublishEvidence], [Bus.PublishAssessmentResult] and [Bus.PublishEvaluationResult] can directly be registered
+// as hook functions of the evidence store, the orchestrator and the evaluation.
+type Bus struct {
+	pub             Publisher
+	encoding        Encoding
+	source          string
+	evidenceTopic   string
+	resultTopic     string
+	evaluationTopic string
+	discoveryTopic  string
+	timeout         time.Duration
+}
+
+// WithEncoding is an option to configure the serialization of the published messages.
+func WithEncoding(encoding Encoding) service.Option[Bus] {
+	return func(b *Bus) {
+		b.encoding = encoding
+	}
+}
+
+// WithEvidenceTopic is an option to configure the topic to which evidences are published.
+func WithEvidenceTopic(topic string) service.Option[Bus] {
+	return func(b *Bus) {
+		b.evidenceTopic = topic
+	}
+}
+
+// WithResultTopic is an option to configure the topic to which assessment results are published.
+func WithResultTopic(topic string) service.Option[Bus] {
+	return func(b *Bus) {
+		b.resultTopic = topic
+	}
+}
+
+// WithEvaluationTopic is an option to configure the topic to which evaluation results are published.
+func WithEvaluationTopic(topic string) service.Option[Bus] {
+	return func(b *Bus) {
+		b.evaluationTopic = topic
+	}
+}
+
+// WithDiscoveryTopic is an option to configure the topic to which the start and end of discovery runs are published.
+func WithDiscoveryTopic(topic string) service.Option[Bus] {
+	return func(b *Bus) {
+		b.discoveryTopic = topic
+	}
+}
+
+// WithSource is an option to configure the source of CloudEvents, e.g., the URL of the Clouditor instance. The default
+// is [DefaultSource].
+func WithSource(source string) service.Option[Bus] {
+	return func(b *Bus) {
+		b.source = source
+	}
+}
+
+// WithPublishTimeout is an option to configure the timeout of a single publish operation.
+func WithPublishTimeout(timeout time.Duration) service.Option[Bus] {
+	return func(b *Bus) {
+		b.timeout = timeout
+	}
+}
+
+// NewBus creates a new event bus output that publishes to pub.
+func NewBus(pub Publisher, opts ...service.Option[Bus]) (b *Bus, err error) {
+	b = &Bus{
+		pub:             pub,
+		encoding:        EncodingProtobuf,
+		source:          DefaultSource,
+		evidenceTopic:   DefaultEvidenceTopic,
+		resultTopic:     DefaultResultTopic,
+		evaluationTopic: DefaultEvaluationTopic,
+		discoveryTopic:  DefaultDiscoveryTopic,
+		timeout:         DefaultPublishTimeout,
+	}
+
+	for _, o := range opts {
+		o(b)
+	}
+
+	if b.encoding != EncodingProtobuf && b.encoding != EncodingJSON && b.encoding != EncodingCloudEvents {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownEncoding, b.encoding)
+	}
+
+	// HTTP endpoints only receive CloudEvents, since they have no notion of topics
+	if _, ok := pub.(*HTTPPublisher); ok && b.encoding != EncodingCloudEvents {
+		return nil, fmt.Errorf("%w: %s cannot be sent over HTTP", ErrUnsupportedEncoding, b.encoding)
+	}
+
+	return b, nil
+}
+
+// NewPublisher creates a new [Publisher] of the given type, which is either "nats", "kafka" or "http". For NATS, url is
+// the address of the NATS server, e.g., "nats://localhost:4222". For Kafka, url is the base URL of a Kafka REST proxy,
+// e.g., "http://localhost:8082". For HTTP, url is the endpoint to which CloudEvents are sent, e.g., a Knative broker.
+func NewPublisher(typ string, url string) (pub Publisher, err error) {
+	switch typ {
+	case "nats":
+		return NewNATSPublisher(url)
+	case "kafka":
+		return NewKafkaPublisher(url), nil
+	case "http":
+		return NewHTTPPublisher(url), nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownPublisher, typ)
+	}
+}
+
+// PublishEvidence publishes a new evidence to the evidence topic. It implements [evidence.EvidenceHookFunc]. Evidences
+// that could not be stored (err != nil) are not published.
+func (b *Bus) PublishEvidence(ctx context.Context, ev *evidence.Evidence, err error) {
+	if err != nil || ev == nil {
+		return
+	}
+
+	b.publish(ctx, b.evidenceTopic, event{
+		typ:            EventTypeEvidence,
+		id:             ev.GetId(),
+		subject:        resourceID(ev),
+		cloudServiceID: ev.GetCloudServiceId(),
+		time:           ev.GetTimestamp().AsTime(),
+	}, ev)
+}
+
+// PublishAssessmentResult publishes a new assessment result to the result topic. It implements
+// [assessment.ResultHookFunc]. Results that could not be stored (err != nil) are not published.
+func (b *Bus) PublishAssessmentResult(ctx context.Context, result *assessment.AssessmentResult, err error) {
+	if err != nil || result == nil {
+		return
+	}
+
+	b.publish(ctx, b.resultTopic, event{
+		typ:            EventTypeAssessmentResult,
+		id:             result.GetId(),
+		subject:        result.GetResourceId(),
+		cloudServiceID: result.GetCloudServiceId(),
+		time:           result.GetTimestamp().AsTime(),
+	}, result)
+}
+
+// PublishEvaluationResult publishes a new evaluation result to the evaluation topic. It implements
+// [evaluation.ResultHookFunc]. Results that could not be stored (err != nil) are not published.
+func (b *Bus) PublishEvaluationResult(ctx context.Context, result *evaluation.EvaluationResult, err error) {
+	if err != nil || result == nil {
+		return
+	}
+
+	b.publish(ctx, b.evaluationTopic, event{
+		typ:            EventTypeEvaluationResult,
+		id:             result.GetId(),
+		subject:        result.GetControlId(),
+		cloudServiceID: result.GetCloudServiceId(),
+		time:           result.GetTimestamp().AsTime(),
+	}, result)
+}
+
+// PublishDiscoveryEvent publishes the start or end of a discovery run of the given cloud service to the discovery
+// topic. The run is described by a [structpb.Struct] with the fields "discoverer", "type" ("started" or "finished")
+// and, once finished, "discoveredItems".
+func (b *Bus) PublishDiscoveryEvent(ctx context.Context, cloudServiceID string, ev *discovery.DiscoveryEvent) {
+	var (
+		typ  = EventTypeDiscoveryStarted
+		data = map[string]any{
+			"discoverer": ev.DiscovererName,
+			"type":       "started",
+		}
+	)
+
+	if ev.Type == discovery.DiscovererFinished {
+		typ = EventTypeDiscoveryFinished
+		data["type"] = "finished"
+		data["discoveredItems"] = ev.DiscoveredItems
+	}
+
+	m, err := structpb.NewStruct(data)
+	if err != nil {
+		log.Errorf("Could not convert discovery event: %v", err)
+		return
+	}
+
+	b.publish(ctx, b.discoveryTopic, event{
+		typ:            typ,
+		id:             uuid.NewString(),
+		subject:        ev.DiscovererName,
+		cloudServiceID: cloudServiceID,
+		time:           ev.Time,
+	}, m)
+}
+
+// Close closes the underlying publisher.
+func (b *Bus) Close() error {
+	return b.pub.Close()
+}
+
+// publish serializes m and publishes it to topic using the cloud service of ev as key. Errors are only logged, since
+// the hooks have no way to return them.
+func (b *Bus) publish(ctx context.Context, topic string, ev event, m proto.Message) {
+	data, err := b.marshal(ev, m)
+	if err != nil {
+		log.Errorf("Could not serialize message for topic %s: %v", topic, err)
+		return
+	}
+
+	// The request context might already be cancelled, since the hooks are called asynchronously
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), b.timeout)
+	defer cancel()
+
+	err = b.pub.Publish(ctx, topic, ev.cloudServiceID, data)
+	if err != nil {
+		log.Errorf("Could not publish message to topic %s: %v", topic, err)
+	}
+}
+
+// marshal serializes m according to the configured encoding.
+func (b *Bus) marshal(ev event, m proto.Message) ([]byte, error) {
+	switch b.encoding {
+	case EncodingJSON:
+		return protojson.Marshal(m)
+	case EncodingCloudEvents:
+		return b.cloudEvent(ev, m)
+	default:
+		return proto.Marshal(m)
+	}
+}
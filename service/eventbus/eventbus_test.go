@@ -0,0 +1,374 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package eventbus
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"clouditor.io/clouditor/v2/api/assessment"
+	"clouditor.io/clouditor/v2/api/evaluation"
+	"clouditor.io/clouditor/v2/api/evidence"
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/testutil/prototest"
+	"clouditor.io/clouditor/v2/internal/testutil/servicetest/evidencetest"
+	"clouditor.io/clouditor/v2/internal/testutil/servicetest/orchestratortest"
+	"clouditor.io/clouditor/v2/service"
+	"clouditor.io/clouditor/v2/service/discovery"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// message is a message that was published to the mockPublisher.
+type message struct {
+	topic string
+	key   string
+	data  []byte
+}
+
+// mockPublisher records all published messages.
+type mockPublisher struct {
+	mu       sync.Mutex
+	messages []message
+	err      error
+}
+
+func (p *mockPublisher) Publish(_ context.Context, topic string, key string, data []byte) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.err != nil {
+		return p.err
+	}
+
+	p.messages = append(p.messages, message{topic: topic, key: key, data: data})
+	return nil
+}
+
+func (*mockPublisher) Close() error {
+	return nil
+}
+
+func TestNewBus(t *testing.T) {
+	type args struct {
+		pub  Publisher
+		opts []service.Option[Bus]
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    assert.Want[*Bus]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "defaults",
+			want: func(t *testing.T, got *Bus) bool {
+				return assert.Equal(t, EncodingProtobuf, got.encoding) &&
+					assert.Equal(t, DefaultEvidenceTopic, got.evidenceTopic) &&
+					assert.Equal(t, DefaultResultTopic, got.resultTopic)
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "with options",
+			args: args{
+				opts: []service.Option[Bus]{
+					WithEncoding(EncodingJSON),
+					WithEvidenceTopic("evidences"),
+					WithResultTopic("results"),
+				},
+			},
+			want: func(t *testing.T, got *Bus) bool {
+				return assert.Equal(t, EncodingJSON, got.encoding) &&
+					assert.Equal(t, "evidences", got.evidenceTopic) &&
+					assert.Equal(t, "results", got.resultTopic)
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "unknown encoding",
+			args: args{
+				opts: []service.Option[Bus]{WithEncoding("xml")},
+			},
+			want: assert.Nil[*Bus],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, ErrUnknownEncoding)
+			},
+		},
+		{
+			name: "HTTP without CloudEvents",
+			args: args{
+				pub:  NewHTTPPublisher("http://localhost"),
+				opts: []service.Option[Bus]{WithEncoding(EncodingJSON)},
+			},
+			want: assert.Nil[*Bus],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, ErrUnsupportedEncoding)
+			},
+		},
+		{
+			name: "HTTP with CloudEvents",
+			args: args{
+				pub:  NewHTTPPublisher("http://localhost"),
+				opts: []service.Option[Bus]{WithEncoding(EncodingCloudEvents), WithSource("https://clouditor.example.com")},
+			},
+			want: func(t *testing.T, got *Bus) bool {
+				return assert.Equal(t, EncodingCloudEvents, got.encoding) &&
+					assert.Equal(t, "https://clouditor.example.com", got.source)
+			},
+			wantErr: assert.Nil[error],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pub := tt.args.pub
+			if pub == nil {
+				pub = &mockPublisher{}
+			}
+
+			got, err := NewBus(pub, tt.args.opts...)
+			tt.want(t, got)
+			tt.wantErr(t, err)
+		})
+	}
+}
+
+func TestBus_PublishEvidence(t *testing.T) {
+	type args struct {
+		ev  *evidence.Evidence
+		err error
+	}
+	tests := []struct {
+		name     string
+		encoding Encoding
+		pubErr   error
+		args     args
+		want     func(t *testing.T, got []message) bool
+	}{
+		{
+			name:     "protobuf",
+			encoding: EncodingProtobuf,
+			args: args{
+				ev: evidencetest.MockEvidence1,
+			},
+			want: func(t *testing.T, got []message) bool {
+				var ev evidence.Evidence
+
+				return assert.Equal(t, 1, len(got)) &&
+					assert.Equal(t, DefaultEvidenceTopic, got[0].topic) &&
+					assert.Equal(t, testdata.MockCloudServiceID1, got[0].key) &&
+					assert.NoError(t, proto.Unmarshal(got[0].data, &ev)) &&
+					assert.Equal(t, evidencetest.MockEvidence1.Id, ev.Id)
+			},
+		},
+		{
+			name:     "json",
+			encoding: EncodingJSON,
+			args: args{
+				ev: evidencetest.MockEvidence1,
+			},
+			want: func(t *testing.T, got []message) bool {
+				var ev evidence.Evidence
+
+				return assert.Equal(t, 1, len(got)) &&
+					assert.NoError(t, protojson.Unmarshal(got[0].data, &ev)) &&
+					assert.Equal(t, evidencetest.MockEvidence1.Id, ev.Id)
+			},
+		},
+		{
+			name:     "cloudevents",
+			encoding: EncodingCloudEvents,
+			args: args{
+				ev: &evidence.Evidence{
+					Id:             testdata.MockEvidenceID1,
+					Timestamp:      timestamppb.New(time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)),
+					CloudServiceId: testdata.MockCloudServiceID1,
+					ToolId:         testdata.MockEvidenceToolID1,
+					Resource:       prototest.NewAny(t, &ontology.VirtualMachine{Id: testdata.MockResourceID1, Name: testdata.MockResourceName1}),
+				},
+			},
+			want: func(t *testing.T, got []message) bool {
+				var (
+					ce CloudEvent
+					ev evidence.Evidence
+				)
+
+				return assert.Equal(t, 1, len(got)) &&
+					assert.NoError(t, json.Unmarshal(got[0].data, &ce)) &&
+					assert.Equal(t, CloudEvent{
+						SpecVersion:     "1.0",
+						ID:              testdata.MockEvidenceID1,
+						Source:          DefaultSource,
+						Type:            EventTypeEvidence,
+						Subject:         testdata.MockResourceID1,
+						Time:            time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC),
+						DataContentType: "application/json",
+						CloudServiceID:  testdata.MockCloudServiceID1,
+						Data:            ce.Data,
+					}, ce) &&
+					assert.NoError(t, protojson.Unmarshal(ce.Data, &ev)) &&
+					assert.Equal(t, testdata.MockEvidenceID1, ev.Id)
+			},
+		},
+		{
+			name:     "failed evidence is not published",
+			encoding: EncodingProtobuf,
+			args: args{
+				ev:  evidencetest.MockEvidence1,
+				err: errors.New("some error"),
+			},
+			want: func(t *testing.T, got []message) bool {
+				return assert.Empty(t, got)
+			},
+		},
+		{
+			name:     "publish error is only logged",
+			encoding: EncodingProtobuf,
+			pubErr:   errors.New("some error"),
+			args: args{
+				ev: evidencetest.MockEvidence1,
+			},
+			want: func(t *testing.T, got []message) bool {
+				return assert.Empty(t, got)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pub := &mockPublisher{err: tt.pubErr}
+
+			b, err := NewBus(pub, WithEncoding(tt.encoding))
+			assert.NoError(t, err)
+
+			b.PublishEvidence(context.Background(), tt.args.ev, tt.args.err)
+			tt.want(t, pub.messages)
+		})
+	}
+}
+
+func TestBus_PublishAssessmentResult(t *testing.T) {
+	pub := &mockPublisher{}
+
+	b, err := NewBus(pub, WithResultTopic("results"))
+	assert.NoError(t, err)
+
+	// Cancelled contexts of the original request must not prevent the publishing
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	b.PublishAssessmentResult(ctx, orchestratortest.MockAssessmentResult1, nil)
+
+	var result assessment.AssessmentResult
+
+	assert.Equal(t, 1, len(pub.messages))
+	assert.Equal(t, "results", pub.messages[0].topic)
+	assert.NoError(t, proto.Unmarshal(pub.messages[0].data, &result))
+	assert.Equal(t, orchestratortest.MockAssessmentResult1.Id, result.Id)
+}
+
+func TestBus_PublishEvaluationResult(t *testing.T) {
+	pub := &mockPublisher{}
+
+	b, err := NewBus(pub, WithEncoding(EncodingCloudEvents))
+	assert.NoError(t, err)
+
+	b.PublishEvaluationResult(context.Background(), &evaluation.EvaluationResult{
+		Id:             testdata.MockEvaluationResult1ID,
+		Timestamp:      timestamppb.Now(),
+		CloudServiceId: testdata.MockCloudServiceID1,
+		ControlId:      testdata.MockControlID1,
+		Status:         evaluation.EvaluationStatus_EVALUATION_STATUS_NOT_COMPLIANT,
+	}, nil)
+
+	var ce CloudEvent
+
+	assert.Equal(t, 1, len(pub.messages))
+	assert.Equal(t, DefaultEvaluationTopic, pub.messages[0].topic)
+	assert.Equal(t, testdata.MockCloudServiceID1, pub.messages[0].key)
+	assert.NoError(t, json.Unmarshal(pub.messages[0].data, &ce))
+	assert.Equal(t, EventTypeEvaluationResult, ce.Type)
+	assert.Equal(t, testdata.MockEvaluationResult1ID, ce.ID)
+	assert.Equal(t, testdata.MockControlID1, ce.Subject)
+
+	// Failed results are not published
+	b.PublishEvaluationResult(context.Background(), nil, errors.New("some error"))
+	assert.Equal(t, 1, len(pub.messages))
+}
+
+func TestBus_PublishDiscoveryEvent(t *testing.T) {
+	pub := &mockPublisher{}
+
+	b, err := NewBus(pub, WithDiscoveryTopic("runs"))
+	assert.NoError(t, err)
+
+	b.PublishDiscoveryEvent(context.Background(), testdata.MockCloudServiceID1, &discovery.DiscoveryEvent{
+		Type:           discovery.DiscovererStart,
+		DiscovererName: "Azure Compute",
+		Time:           time.Now(),
+	})
+	b.PublishDiscoveryEvent(context.Background(), testdata.MockCloudServiceID1, &discovery.DiscoveryEvent{
+		Type:            discovery.DiscovererFinished,
+		DiscovererName:  "Azure Compute",
+		DiscoveredItems: 3,
+		Time:            time.Now(),
+	})
+
+	var started, finished structpb.Struct
+
+	assert.Equal(t, 2, len(pub.messages))
+	assert.Equal(t, "runs", pub.messages[0].topic)
+	assert.NoError(t, proto.Unmarshal(pub.messages[0].data, &started))
+	assert.NoError(t, proto.Unmarshal(pub.messages[1].data, &finished))
+	assert.Equal(t, map[string]any{"discoverer": "Azure Compute", "type": "started"}, started.AsMap())
+	assert.Equal(t, map[string]any{"discoverer": "Azure Compute", "type": "finished", "discoveredItems": float64(3)}, finished.AsMap())
+}
+
+func TestNewPublisher(t *testing.T) {
+	pub, err := NewPublisher("kafka", "http://localhost:8082")
+	assert.NoError(t, err)
+	assert.NotNil(t, assert.Is[*KafkaPublisher](t, pub))
+
+	pub, err = NewPublisher("nats", "nats://localhost")
+	assert.NoError(t, err)
+	assert.NotNil(t, assert.Is[*NATSPublisher](t, pub))
+
+	pub, err = NewPublisher("http", "http://localhost:8080")
+	assert.NoError(t, err)
+	assert.NotNil(t, assert.Is[*HTTPPublisher](t, pub))
+
+	pub, err = NewPublisher("amqp", "amqp://localhost")
+	assert.ErrorIs(t, err, ErrUnknownPublisher)
+	assert.Nil(t, pub)
+}
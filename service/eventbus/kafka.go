@@ -0,0 +1,114 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package eventbus
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// KafkaContentType is the content type of records with binary (base64 encoded) keys and values of the Kafka REST proxy
+// API v2.
+const KafkaContentType = "application/vnd.kafka.binary.v2+json"
+
+// kafkaRecord is a single record that is produced to a topic using the Kafka REST proxy. Keys and values of the type
+// []byte are base64 encoded by encoding/json, as expected by the binary embedded format.
+type kafkaRecord struct {
+	Key   []byte `json:"key,omitempty"`
+	Value []byte `json:"value"`
+}
+
+// kafkaProduceRequest is the request body to produce records to a topic using the Kafka REST proxy.
+type kafkaProduceRequest struct {
+	Records []kafkaRecord `json:"records"`
+}
+
+// KafkaPublisher is a [Publisher] that produces messages to Kafka topics using a Kafka REST proxy, such as the Confluent
+// REST proxy. This avoids a direct dependency on the Kafka wire protocol.
+type KafkaPublisher struct {
+	baseURL string
+	client  *http.Client
+}
+
+// NewKafkaPublisher creates a new [KafkaPublisher] for the Kafka REST proxy at baseURL, e.g., "http://localhost:8082".
+func NewKafkaPublisher(baseURL string) *KafkaPublisher {
+	return &KafkaPublisher{
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		client:  http.DefaultClient,
+	}
+}
+
+// Publish produces a single record with the given key and data to topic. The key is used by Kafka to assign the record
+// to a partition.
+func (p *KafkaPublisher) Publish(ctx context.Context, topic string, key string, data []byte) (err error) {
+	var (
+		body []byte
+		req  *http.Request
+		res  *http.Response
+	)
+
+	record := kafkaRecord{Value: data}
+	if key != "" {
+		record.Key = []byte(key)
+	}
+
+	body, err = json.Marshal(kafkaProduceRequest{Records: []kafkaRecord{record}})
+	if err != nil {
+		return fmt.Errorf("could not serialize records: %w", err)
+	}
+
+	req, err = http.NewRequestWithContext(ctx, http.MethodPost,
+		fmt.Sprintf("%s/topics/%s", p.baseURL, url.PathEscape(topic)), bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("could not create request: %w", err)
+	}
+	req.Header.Set("Content-Type", KafkaContentType)
+	req.Header.Set("Accept", "application/vnd.kafka.v2+json")
+
+	res, err = p.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("could not produce records: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+		return fmt.Errorf("could not produce records: unexpected status %d: %s", res.StatusCode, bytes.TrimSpace(msg))
+	}
+
+	return nil
+}
+
+// Close does nothing, since the Kafka REST proxy is stateless.
+func (*KafkaPublisher) Close() error {
+	return nil
+}
@@ -32,10 +32,14 @@ import (
 	"io"
 	"slices"
 	"sync"
+	"sync/atomic"
 
 	"clouditor.io/clouditor/v2/api"
+	"clouditor.io/clouditor/v2/api/assessment"
 	"clouditor.io/clouditor/v2/api/evidence"
+	"clouditor.io/clouditor/v2/api/ontology"
 	"clouditor.io/clouditor/v2/internal/logging"
+	"clouditor.io/clouditor/v2/internal/telemetry"
 	"clouditor.io/clouditor/v2/persistence"
 	"clouditor.io/clouditor/v2/persistence/inmemory"
 	"clouditor.io/clouditor/v2/service"
@@ -57,24 +61,49 @@ type Service struct {
 	// mu is used for (un)locking result hook calls
 	mu sync.Mutex
 
+	// subscribers contains the channels of all clients that subscribed to new evidences using SubscribeEvidence
+	subscribers map[int64]*evidenceSubscriber
+	// nextSubscriberID is the ID assigned to the next subscriber
+	nextSubscriberID int64
+	// subscribersMutex is used for (un)locking the subscribers
+	subscribersMutex sync.RWMutex
+
 	// authz defines our authorization strategy, e.g., which user can access which cloud service and associated
 	// resources, such as evidences and assessment results.
 	authz service.AuthorizationStrategy
 
+	// assessment assesses imported evidences, if configured
+	assessment Assessor
+
 	evidence.UnimplementedEvidenceStoreServer
 }
 
+// Assessor assesses evidences, e.g., the assessment service.
+type Assessor interface {
+	AssessEvidence(ctx context.Context, req *assessment.AssessEvidenceRequest) (*assessment.AssessEvidenceResponse, error)
+}
+
 func WithStorage(storage persistence.Storage) service.Option[Service] {
 	return func(svc *Service) {
 		svc.storage = storage
 	}
 }
 
+// WithAssessment is an option to send imported evidences to an assessment, just like discovered evidences, instead of
+// only storing them. The assessment is then responsible for storing them, usually in this evidence store.
+func WithAssessment(a Assessor) service.Option[Service] {
+	return func(svc *Service) {
+		svc.assessment = a
+	}
+}
+
 func NewService(opts ...service.Option[Service]) (svc *Service) {
 	var (
 		err error
 	)
-	svc = new(Service)
+	svc = &Service{
+		subscribers: make(map[int64]*evidenceSubscriber),
+	}
 
 	for _, o := range opts {
 		o(svc)
@@ -101,11 +130,17 @@ func init() {
 
 // StoreEvidence is a method implementation of the evidenceServer interface: It receives a req and stores it
 func (svc *Service) StoreEvidence(ctx context.Context, req *evidence.StoreEvidenceRequest) (res *evidence.StoreEvidenceResponse, err error) {
-	// Validate request
-	err = api.Validate(req)
-	if err != nil {
-		return nil, err
-	}
+	// Continue the trace of the sender, e.g., the assessment
+	ctx, span := telemetry.StartSpan(telemetry.ExtractTraceContext(ctx, req.GetTraceContext()), "evidence.StoreEvidence",
+		telemetry.CloudServiceIDKey.String(req.GetEvidence().GetCloudServiceId()),
+		telemetry.EvidenceIDKey.String(req.GetEvidence().GetId()),
+	)
+	defer func() {
+		telemetry.EndSpan(span, err)
+	}()
+
+	// Correlate all log entries with the evidence, also if it was received in a stream
+	ctx = logging.WithEvidence(ctx, req.GetEvidence())
 
 	// Check, if this request has access to the cloud service according to our authorization strategy.
 	if !svc.authz.CheckAccess(ctx, service.AccessUpdate, req) {
@@ -121,9 +156,13 @@ func (svc *Service) StoreEvidence(ctx context.Context, req *evidence.StoreEviden
 
 	go svc.informHooks(ctx, req.Evidence, nil)
 
+	// We inform the subscribers synchronously, so that they receive the evidences in the order they were stored. This
+	// does not block, since evidences are dropped for subscribers that cannot keep up.
+	svc.informSubscribers(req.Evidence)
+
 	res = &evidence.StoreEvidenceResponse{}
 
-	logging.LogRequest(log, logrus.DebugLevel, logging.Store, req)
+	logging.LogRequest(log.WithContext(ctx), logrus.DebugLevel, logging.Store, req)
 
 	return res, nil
 }
@@ -144,17 +183,22 @@ func (svc *Service) StoreEvidences(stream evidence.EvidenceStore_StoreEvidencesS
 		}
 		if err != nil {
 			newError := fmt.Errorf("cannot receive stream request: %w", err)
-			log.Error(newError)
+			log.WithContext(stream.Context()).Error(newError)
 			return status.Errorf(codes.Unknown, "%v", newError)
 		}
 
 		// Call StoreEvidence() for storing a single evidence
 		evidenceRequest := &evidence.StoreEvidenceRequest{
-			Evidence: req.Evidence,
+			Evidence:     req.Evidence,
+			TraceContext: req.TraceContext,
+		}
+		// Streamed requests are not validated by an interceptor, so that we can report invalid ones within the stream
+		err = api.Validate(evidenceRequest)
+		if err == nil {
+			_, err = svc.StoreEvidence(stream.Context(), evidenceRequest)
 		}
-		_, err = svc.StoreEvidence(stream.Context(), evidenceRequest)
 		if err != nil {
-			log.Errorf("Error storing evidence: %v", err)
+			log.WithContext(logging.WithEvidence(stream.Context(), req.GetEvidence())).Errorf("Error storing evidence: %v", err)
 			// Create response message. The StoreEvidence method does not need that message, so we have to create it here for the stream response.
 			res = &evidence.StoreEvidencesResponse{
 				Status:        false,
@@ -175,7 +219,7 @@ func (svc *Service) StoreEvidences(stream evidence.EvidenceStore_StoreEvidencesS
 		}
 		if err != nil {
 			newError := fmt.Errorf("cannot send response to the client: %w", err)
-			log.Error(newError)
+			log.WithContext(stream.Context()).Error(newError)
 			return status.Errorf(codes.Unknown, "%v", newError)
 		}
 	}
@@ -189,8 +233,7 @@ func (svc *Service) ListEvidences(ctx context.Context, req *evidence.ListEvidenc
 		query   []string
 		args    []any
 	)
-	// Validate request
-	err = api.Validate(req)
+	err = api.ValidateFieldMask(req.ReadMask, &evidence.Evidence{})
 	if err != nil {
 		return nil, err
 	}
@@ -223,13 +266,16 @@ func (svc *Service) ListEvidences(ctx context.Context, req *evidence.ListEvidenc
 	}
 
 	// Paginate the evidences according to the request
-	res.Evidences, res.NextPageToken, err = service.PaginateStorage[*evidence.Evidence](req, svc.storage,
+	res.Evidences, res.NextPageToken, err = service.PaginateStorageWithCursor[*evidence.Evidence](req, svc.storage,
 		service.DefaultPaginationOpts, persistence.BuildConds(query, args)...)
 
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "could not paginate results: %v", err)
 	}
 
+	// Only return the requested fields
+	api.ApplyFieldMask(req.ReadMask, res.Evidences...)
+
 	return
 }
 
@@ -241,8 +287,7 @@ func (svc *Service) GetEvidence(ctx context.Context, req *evidence.GetEvidenceRe
 		conds   []any
 	)
 
-	// Validate request
-	err = api.Validate(req)
+	err = api.ValidateFieldMask(req.ReadMask, &evidence.Evidence{})
 	if err != nil {
 		return nil, err
 	}
@@ -260,14 +305,80 @@ func (svc *Service) GetEvidence(ctx context.Context, req *evidence.GetEvidenceRe
 
 	err = svc.storage.Get(res, conds...)
 	if errors.Is(err, persistence.ErrRecordNotFound) {
-		return nil, status.Errorf(codes.NotFound, "evidence not found")
+		return nil, api.ErrorCodeEvidenceNotFound.Err()
 	} else if err != nil {
 		return nil, status.Errorf(codes.Internal, "database error: %v", err)
 	}
 
+	// Only return the requested fields
+	api.ApplyFieldMask(req.ReadMask, res)
+
 	return
 }
 
+// SubscribeEvidence is a method implementation of the evidenceServer interface: It streams all newly stored evidences
+// that match the (optional) filter of the request to the client until the client closes the stream.
+func (svc *Service) SubscribeEvidence(req *evidence.SubscribeEvidenceRequest, stream evidence.EvidenceStore_SubscribeEvidenceServer) (err error) {
+	var (
+		all     bool
+		allowed []string
+		sub     *evidenceSubscriber
+		id      int64
+		ev      *evidence.Evidence
+	)
+
+	// Retrieve list of allowed cloud service according to our authorization strategy. The subscriber will only
+	// receive evidences of cloud services it has access to.
+	all, allowed = svc.authz.AllowedCloudServices(stream.Context())
+	if !all && req.GetFilter().GetCloudServiceId() != "" && !slices.Contains(allowed, req.GetFilter().GetCloudServiceId()) {
+		return service.ErrPermissionDenied
+	}
+
+	sub = &evidenceSubscriber{
+		filter:  req.GetFilter(),
+		all:     all,
+		allowed: allowed,
+		ch:      make(chan *evidence.Evidence, 1000),
+	}
+
+	// Register our subscriber
+	svc.subscribersMutex.Lock()
+	if svc.subscribers == nil {
+		svc.subscribers = make(map[int64]*evidenceSubscriber)
+	}
+	id = svc.nextSubscriberID
+	svc.nextSubscriberID++
+	svc.subscribers[id] = sub
+	svc.subscribersMutex.Unlock()
+
+	// Make sure to remove the subscriber once the stream ends
+	defer func() {
+		svc.subscribersMutex.Lock()
+		delete(svc.subscribers, id)
+		svc.subscribersMutex.Unlock()
+	}()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case ev = <-sub.ch:
+		}
+
+		err = stream.Send(ev)
+
+		// Check for send errors
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			newError := fmt.Errorf("cannot stream evidence to the client: %w", err)
+			log.Error(newError)
+			return status.Errorf(codes.Unknown, "%v", newError)
+		}
+	}
+}
+
 func (svc *Service) RegisterEvidenceHook(evidenceHook evidence.EvidenceHookFunc) {
 	svc.mu.Lock()
 	defer svc.mu.Unlock()
@@ -286,3 +397,76 @@ func (svc *Service) informHooks(ctx context.Context, result *evidence.Evidence,
 		}
 	}
 }
+
+// informSubscribers sends the evidence to all subscribers whose filter matches the evidence. Subscribers that cannot
+// keep up with new evidences will miss the evidence instead of blocking the evidence store.
+func (svc *Service) informSubscribers(ev *evidence.Evidence) {
+	svc.subscribersMutex.RLock()
+	defer svc.subscribersMutex.RUnlock()
+
+	for _, sub := range svc.subscribers {
+		if !sub.matches(ev) {
+			continue
+		}
+
+		select {
+		case sub.ch <- ev:
+		default:
+			dropped := sub.dropped.Add(1)
+			telemetry.SubscriberEvidencesDroppedTotal.Inc()
+			log.Warnf("Subscriber channel is full, dropping evidence %s (%d evidence(s) dropped so far)", ev.GetId(), dropped)
+		}
+	}
+}
+
+// evidenceSubscriber holds the channel and the filter of a single client subscribed with SubscribeEvidence.
+type evidenceSubscriber struct {
+	filter *evidence.SubscribeEvidenceFilter
+
+	// all and allowed contain the cloud services the subscriber has access to
+	all     bool
+	allowed []string
+
+	ch chan *evidence.Evidence
+
+	// dropped counts the evidences the subscriber missed, because its channel was full
+	dropped atomic.Uint64
+}
+
+// matches checks whether the evidence matches the filter and the allowed cloud services of the subscriber.
+func (sub *evidenceSubscriber) matches(ev *evidence.Evidence) bool {
+	if !sub.all && !slices.Contains(sub.allowed, ev.GetCloudServiceId()) {
+		return false
+	}
+
+	if sub.filter == nil {
+		return true
+	}
+
+	if sub.filter.CloudServiceId != nil && sub.filter.GetCloudServiceId() != ev.GetCloudServiceId() {
+		return false
+	}
+
+	if sub.filter.ResourceType != nil {
+		m, err := ev.GetResource().UnmarshalNew()
+		if err != nil {
+			return false
+		}
+
+		r, ok := m.(ontology.IsResource)
+		if !ok || !ontology.HasType(r, sub.filter.GetResourceType()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// CheckHealth implements [service.HealthChecker]. The evidence store only depends on its storage.
+func (svc *Service) CheckHealth(ctx context.Context) error {
+	if err := svc.storage.Ping(ctx); err != nil {
+		return fmt.Errorf("storage is not reachable: %w", err)
+	}
+
+	return nil
+}
@@ -34,6 +34,7 @@ import (
 	"runtime"
 	"sync"
 	"testing"
+	"time"
 
 	"clouditor.io/clouditor/v2/api"
 	"clouditor.io/clouditor/v2/api/evidence"
@@ -56,6 +57,7 @@ import (
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -162,7 +164,7 @@ func TestService_StoreEvidence(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			s := NewService()
-			gotRes, err := s.StoreEvidence(tt.args.in0, tt.args.req)
+			gotRes, err := servicetest.Validated(s.StoreEvidence)(tt.args.in0, tt.args.req)
 
 			tt.wantErr(t, err)
 			tt.wantRes(t, gotRes)
@@ -320,6 +322,47 @@ func TestService_ListEvidences(t *testing.T) {
 					assert.Equal(t, evidencetest.MockEvidence2.CloudServiceId, got.Evidences[1].CloudServiceId)
 			},
 		},
+		{
+			name: "Successful List Of Evidences with read mask",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategy(true),
+				storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					assert.NoError(t, s.Create(&evidencetest.MockEvidence1))
+				}),
+			},
+			args: args{
+				in0: context.TODO(),
+				req: &evidence.ListEvidencesRequest{
+					ReadMask: &fieldmaskpb.FieldMask{Paths: []string{"id", "timestamp"}},
+				},
+			},
+			wantErr: assert.NoError,
+			wantRes: func(t *testing.T, got *evidence.ListEvidencesResponse) bool {
+				return assert.Equal(t, 1, len(got.Evidences)) &&
+					assert.Equal(t, evidencetest.MockEvidence1.Id, got.Evidences[0].Id) &&
+					assert.NotNil(t, got.Evidences[0].Timestamp) &&
+					assert.Nil(t, got.Evidences[0].Resource) &&
+					assert.Equal(t, "", got.Evidences[0].CloudServiceId)
+			},
+		},
+		{
+			name: "Invalid read mask",
+			fields: fields{
+				authz:   servicetest.NewAuthorizationStrategy(true),
+				storage: testutil.NewInMemoryStorage(t),
+			},
+			args: args{
+				in0: context.TODO(),
+				req: &evidence.ListEvidencesRequest{
+					ReadMask: &fieldmaskpb.FieldMask{Paths: []string{"payload"}},
+				},
+			},
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+				return assert.ErrorContains(t, err, api.ErrInvalidFieldMask.Error())
+			},
+			wantRes: assert.Nil[*evidence.ListEvidencesResponse],
+		},
 		{
 			name: "Successful Filter Of Evidences (with allowed cloud service)",
 			fields: fields{
@@ -478,7 +521,7 @@ func TestService_ListEvidences(t *testing.T) {
 			wantRes: assert.Nil[*evidence.ListEvidencesResponse],
 		},
 		{
-			name: "DB (pagination) error",
+			name: "Invalid order by",
 			fields: fields{
 				authz: servicetest.NewAuthorizationStrategy(true),
 				storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
@@ -494,6 +537,22 @@ func TestService_ListEvidences(t *testing.T) {
 					Asc:       evidencetest.MockListEvidenceRequest2.Asc,
 				},
 			},
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				assert.ErrorContains(t, err, "order_by: value must be in list")
+				return assert.Equal(t, status.Code(err), codes.InvalidArgument)
+			},
+			wantRes: assert.Nil[*evidence.ListEvidencesResponse],
+		},
+		{
+			name: "DB (pagination) error",
+			fields: fields{
+				authz:   servicetest.NewAuthorizationStrategy(true),
+				storage: &testutil.StorageWithError{ListErr: errors.New("database error")},
+			},
+			args: args{
+				in0: context.TODO(),
+				req: &evidence.ListEvidencesRequest{},
+			},
 			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
 				assert.ErrorContains(t, err, "could not paginate results")
 				return assert.Equal(t, status.Code(err), codes.Internal)
@@ -508,7 +567,7 @@ func TestService_ListEvidences(t *testing.T) {
 				authz:   tt.fields.authz,
 			}
 
-			gotRes, err := svc.ListEvidences(tt.args.in0, tt.args.req)
+			gotRes, err := servicetest.Validated(svc.ListEvidences)(tt.args.in0, tt.args.req)
 			tt.wantErr(t, err)
 			tt.wantRes(t, gotRes)
 		})
@@ -605,6 +664,173 @@ func TestService_EvidenceHook(t *testing.T) {
 }
 
 // createStoreEvidenceRequestMocks creates store evidence requests with random evidence IDs
+func TestService_SubscribeEvidence(t *testing.T) {
+	var (
+		ctx, cancel = context.WithCancel(context.Background())
+		stream      = &mockSubscribeEvidenceStream{ctx: ctx, sent: make(chan *evidence.Evidence, 10)}
+		done        = make(chan error)
+	)
+	defer cancel()
+
+	svc := NewService()
+
+	go func() {
+		done <- svc.SubscribeEvidence(&evidence.SubscribeEvidenceRequest{
+			Filter: &evidence.SubscribeEvidenceFilter{
+				CloudServiceId: util.Ref(testdata.MockCloudServiceID1),
+				ResourceType:   util.Ref("VirtualMachine"),
+			},
+		}, stream)
+	}()
+
+	// Wait until our subscriber is registered
+	assert.Eventually(t, func() bool {
+		svc.subscribersMutex.RLock()
+		defer svc.subscribersMutex.RUnlock()
+		return len(svc.subscribers) == 1
+	}, time.Second, 10*time.Millisecond)
+
+	// This evidence belongs to a different cloud service and should be filtered
+	_, err := svc.StoreEvidence(context.TODO(), &evidence.StoreEvidenceRequest{Evidence: &evidence.Evidence{
+		Id:             testdata.MockEvidenceID2,
+		CloudServiceId: testdata.MockCloudServiceID2,
+		Timestamp:      timestamppb.Now(),
+		ToolId:         testdata.MockEvidenceToolID1,
+		Resource:       prototest.NewAny(t, &ontology.VirtualMachine{Id: "mock-id-2"}),
+	}})
+	assert.NoError(t, err)
+
+	_, err = svc.StoreEvidence(context.TODO(), &evidence.StoreEvidenceRequest{Evidence: &evidence.Evidence{
+		Id:             testdata.MockEvidenceID1,
+		CloudServiceId: testdata.MockCloudServiceID1,
+		Timestamp:      timestamppb.Now(),
+		ToolId:         testdata.MockEvidenceToolID1,
+		Resource:       prototest.NewAny(t, &ontology.VirtualMachine{Id: "mock-id-1"}),
+	}})
+	assert.NoError(t, err)
+
+	select {
+	case ev := <-stream.sent:
+		assert.Equal(t, testdata.MockEvidenceID1, ev.Id)
+	case <-time.After(time.Second):
+		t.Fatal("did not receive evidence")
+	}
+
+	// Closing the stream should remove the subscriber
+	cancel()
+	assert.NoError(t, <-done)
+	assert.Equal(t, 0, len(svc.subscribers))
+}
+
+func TestService_informSubscribers(t *testing.T) {
+	var (
+		svc = NewService()
+		sub = &evidenceSubscriber{
+			all: true,
+			ch:  make(chan *evidence.Evidence, 2),
+		}
+	)
+
+	svc.subscribers[0] = sub
+
+	for _, id := range []string{testdata.MockEvidenceID1, testdata.MockEvidenceID2, "33333333-3333-3333-3333-333333333333"} {
+		svc.informSubscribers(&evidence.Evidence{Id: id, CloudServiceId: testdata.MockCloudServiceID1})
+	}
+
+	// The evidences arrive in order and the one that did not fit into the channel is dropped
+	assert.Equal(t, testdata.MockEvidenceID1, (<-sub.ch).Id)
+	assert.Equal(t, testdata.MockEvidenceID2, (<-sub.ch).Id)
+	assert.Equal(t, 0, len(sub.ch))
+	assert.Equal(t, uint64(1), sub.dropped.Load())
+}
+
+func Test_evidenceSubscriber_matches(t *testing.T) {
+	type fields struct {
+		filter  *evidence.SubscribeEvidenceFilter
+		all     bool
+		allowed []string
+	}
+	type args struct {
+		ev *evidence.Evidence
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		want   bool
+	}{
+		{
+			name: "no filter",
+			fields: fields{
+				all: true,
+			},
+			args: args{
+				ev: &evidence.Evidence{CloudServiceId: testdata.MockCloudServiceID1},
+			},
+			want: true,
+		},
+		{
+			name: "cloud service not allowed",
+			fields: fields{
+				allowed: []string{testdata.MockCloudServiceID2},
+			},
+			args: args{
+				ev: &evidence.Evidence{CloudServiceId: testdata.MockCloudServiceID1},
+			},
+			want: false,
+		},
+		{
+			name: "cloud service does not match filter",
+			fields: fields{
+				all:    true,
+				filter: &evidence.SubscribeEvidenceFilter{CloudServiceId: util.Ref(testdata.MockCloudServiceID2)},
+			},
+			args: args{
+				ev: &evidence.Evidence{CloudServiceId: testdata.MockCloudServiceID1},
+			},
+			want: false,
+		},
+		{
+			name: "resource type does not match filter",
+			fields: fields{
+				all:    true,
+				filter: &evidence.SubscribeEvidenceFilter{ResourceType: util.Ref("Storage")},
+			},
+			args: args{
+				ev: &evidence.Evidence{
+					CloudServiceId: testdata.MockCloudServiceID1,
+					Resource:       prototest.NewAny(t, &ontology.VirtualMachine{Id: "mock-id"}),
+				},
+			},
+			want: false,
+		},
+		{
+			name: "resource type matches filter",
+			fields: fields{
+				all:    true,
+				filter: &evidence.SubscribeEvidenceFilter{ResourceType: util.Ref("Compute")},
+			},
+			args: args{
+				ev: &evidence.Evidence{
+					CloudServiceId: testdata.MockCloudServiceID1,
+					Resource:       prototest.NewAny(t, &ontology.VirtualMachine{Id: "mock-id"}),
+				},
+			},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sub := &evidenceSubscriber{
+				filter:  tt.fields.filter,
+				all:     tt.fields.all,
+				allowed: tt.fields.allowed,
+			}
+			assert.Equal(t, tt.want, sub.matches(tt.args.ev))
+		})
+	}
+}
+
 func createStoreEvidenceRequestMocks(t *testing.T, count int) []*evidence.StoreEvidenceRequest {
 	var mockRequests []*evidence.StoreEvidenceRequest
 
@@ -869,9 +1095,24 @@ func TestService_GetEvidence(t *testing.T) {
 				storage: tt.fields.storage,
 				authz:   tt.fields.authz,
 			}
-			gotRes, err := svc.GetEvidence(tt.args.ctx, tt.args.req)
+			gotRes, err := servicetest.Validated(svc.GetEvidence)(tt.args.ctx, tt.args.req)
 			tt.wantErr(t, err)
 			tt.want(t, gotRes)
 		})
 	}
 }
+
+type mockSubscribeEvidenceStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent chan *evidence.Evidence
+}
+
+func (m *mockSubscribeEvidenceStream) Send(ev *evidence.Evidence) error {
+	m.sent <- ev
+	return nil
+}
+
+func (m *mockSubscribeEvidenceStream) Context() context.Context {
+	return m.ctx
+}
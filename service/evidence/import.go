@@ -0,0 +1,367 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package evidences
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"clouditor.io/clouditor/v2/api"
+	"clouditor.io/clouditor/v2/api/assessment"
+	"clouditor.io/clouditor/v2/api/evidence"
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/util"
+	"clouditor.io/clouditor/v2/service"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ontologyPackage is the protobuf package of our ontology, which is used to look up imported resource types
+const ontologyPackage = "clouditor.ontology.v1"
+
+var (
+	ErrUnknownResourceType = errors.New("unknown resource type")
+	ErrUnknownField        = errors.New("unknown field")
+	ErrMissingColumn       = errors.New("missing column or key in record")
+)
+
+// importRecord is a single record of an imported file. It contains the values of the record by their key (JSON) or
+// column (CSV) as well as whether the values are still (untyped) strings.
+type importRecord struct {
+	values map[string]any
+	typed  bool
+}
+
+// ImportEvidences is a method implementation of the evidenceServer interface: It maps each record of the imported data
+// into an ontology resource and stores it as an evidence. If an assessment is configured, the evidences are assessed
+// instead, which in turn stores them.
+func (svc *Service) ImportEvidences(ctx context.Context, req *evidence.ImportEvidencesRequest) (res *evidence.ImportEvidencesResponse, err error) {
+	var (
+		records []importRecord
+		mt      protoreflect.MessageType
+	)
+
+	// Check, if this request has access to the cloud service according to our authorization strategy.
+	if !svc.authz.CheckAccess(ctx, service.AccessUpdate, req) {
+		return nil, service.ErrPermissionDenied
+	}
+
+	mt, err = resourceType(req.Mapping.ResourceType)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	switch req.Format {
+	case evidence.ImportFormat_IMPORT_FORMAT_JSON:
+		records, err = parseJSONRecords(req.Data)
+	case evidence.ImportFormat_IMPORT_FORMAT_CSV:
+		records, err = parseCSVRecords(req.Data)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "could not parse data: %v", err)
+	}
+
+	res = new(evidence.ImportEvidencesResponse)
+
+	for i, record := range records {
+		err = svc.importRecord(ctx, req, mt, record)
+		if err != nil {
+			res.Failures = append(res.Failures, &evidence.ImportEvidencesFailure{
+				Record:  int32(i),
+				Message: err.Error(),
+			})
+			continue
+		}
+
+		res.ImportedCount++
+	}
+
+	log.Infof("Imported %d of %d evidence(s) from tool %s", res.ImportedCount, len(records), req.ToolId)
+
+	return res, nil
+}
+
+// importRecord maps a single record into a resource and assesses or stores it as a new evidence
+func (svc *Service) importRecord(ctx context.Context, req *evidence.ImportEvidencesRequest, mt protoreflect.MessageType, record importRecord) (err error) {
+	var (
+		resource protoreflect.ProtoMessage
+		a        *anypb.Any
+		raw      []byte
+	)
+
+	resource, err = mapRecord(mt, req.Mapping, record)
+	if err != nil {
+		return err
+	}
+
+	// Make sure, that the mapped resource is a valid resource of our ontology
+	err = api.Validate(resource)
+	if err != nil {
+		return err
+	}
+
+	a, err = anypb.New(resource)
+	if err != nil {
+		return fmt.Errorf("could not wrap resource: %w", err)
+	}
+
+	raw, err = json.Marshal(record.values)
+	if err != nil {
+		return fmt.Errorf("could not marshal raw record: %w", err)
+	}
+
+	ev := &evidence.Evidence{
+		Id:              uuid.NewString(),
+		Timestamp:       timestamppb.Now(),
+		CloudServiceId:  req.CloudServiceId,
+		ToolId:          req.ToolId,
+		Raw:             util.Ref(string(raw)),
+		Resource:        a,
+		Relationships:   evidence.Relationships(resource.(ontology.IsResource)),
+		OntologyVersion: ontology.Version,
+	}
+
+	// We call AssessEvidence or StoreEvidence directly, so the request is not validated by an interceptor
+	if svc.assessment != nil {
+		assessReq := &assessment.AssessEvidenceRequest{Evidence: ev}
+
+		err = api.Validate(assessReq)
+		if err != nil {
+			return err
+		}
+
+		_, err = svc.assessment.AssessEvidence(ctx, assessReq)
+
+		return err
+	}
+
+	storeReq := &evidence.StoreEvidenceRequest{Evidence: ev}
+
+	err = api.Validate(storeReq)
+	if err != nil {
+		return err
+	}
+
+	_, err = svc.StoreEvidence(ctx, storeReq)
+
+	return err
+}
+
+// resourceType looks up the message type of an ontology resource by its name, e.g. "ObjectStorage".
+func resourceType(name string) (mt protoreflect.MessageType, err error) {
+	mt, err = protoregistry.GlobalTypes.FindMessageByName(protoreflect.FullName(ontologyPackage + "." + name))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownResourceType, name)
+	}
+
+	if _, ok := mt.New().Interface().(ontology.IsResource); !ok {
+		return nil, fmt.Errorf("%w: %s is not a resource", ErrUnknownResourceType, name)
+	}
+
+	return mt, nil
+}
+
+// parseJSONRecords parses a JSON array of objects
+func parseJSONRecords(data []byte) (records []importRecord, err error) {
+	var objects []map[string]any
+
+	err = json.Unmarshal(data, &objects)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, o := range objects {
+		records = append(records, importRecord{values: o, typed: true})
+	}
+
+	return
+}
+
+// parseCSVRecords parses a CSV file, in which the first row contains the column names
+func parseCSVRecords(data []byte) (records []importRecord, err error) {
+	var rows [][]string
+
+	rows, err = csv.NewReader(bytes.NewReader(data)).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	header := rows[0]
+	for _, row := range rows[1:] {
+		values := make(map[string]any, len(header))
+		for i, column := range header {
+			values[column] = row[i]
+		}
+
+		records = append(records, importRecord{values: values})
+	}
+
+	return
+}
+
+// mapRecord creates a new resource of the message type mt out of the record according to the mapping.
+func mapRecord(mt protoreflect.MessageType, mapping *evidence.EvidenceMapping, record importRecord) (resource protoreflect.ProtoMessage, err error) {
+	var (
+		obj map[string]any
+		b   []byte
+		v   any
+		ok  bool
+	)
+
+	// Without any field mappings, we take the record as-is
+	if len(mapping.Fields) == 0 {
+		obj = record.values
+	} else {
+		obj = make(map[string]any)
+	}
+
+	for field, key := range mapping.Fields {
+		path := strings.Split(field, ".")
+
+		v, ok = record.values[key]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrMissingColumn, key)
+		}
+
+		// Values of CSV records are always strings, so we need to convert them according to the field type of the resource
+		if !record.typed {
+			if v, err = convertValue(mt.Descriptor(), path, v.(string)); err != nil {
+				return nil, err
+			}
+		}
+
+		setPath(obj, path, v)
+	}
+
+	b, err = json.Marshal(obj)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal resource: %w", err)
+	}
+
+	resource = mt.New().Interface()
+	err = protojson.Unmarshal(b, resource)
+	if err != nil {
+		return nil, fmt.Errorf("could not map record into %s: %w", mt.Descriptor().Name(), err)
+	}
+
+	return resource, nil
+}
+
+// convertValue converts a string value into the type of the field specified by path
+func convertValue(md protoreflect.MessageDescriptor, path []string, value string) (v any, err error) {
+	var fd protoreflect.FieldDescriptor
+
+	fd = md.Fields().ByJSONName(path[0])
+	if fd == nil {
+		fd = md.Fields().ByName(protoreflect.Name(path[0]))
+	}
+	if fd == nil {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownField, path[0])
+	}
+
+	// Descend into nested messages
+	if len(path) > 1 {
+		if fd.Message() == nil || fd.IsList() || fd.IsMap() {
+			return nil, fmt.Errorf("%w: %s is not a message", ErrUnknownField, path[0])
+		}
+
+		return convertValue(fd.Message(), path[1:], value)
+	}
+
+	// Lists are separated by a semicolon
+	if fd.IsList() {
+		var list []any
+
+		if value == "" {
+			return list, nil
+		}
+
+		for _, item := range strings.Split(value, ";") {
+			if v, err = convertScalar(fd, item); err != nil {
+				return nil, err
+			}
+
+			list = append(list, v)
+		}
+
+		return list, nil
+	}
+
+	return convertScalar(fd, value)
+}
+
+// convertScalar converts a string value into a JSON value that protojson accepts for the given field. All other kinds,
+// e.g. 64-bit integers, enums or well-known types such as timestamps are already accepted as strings.
+func convertScalar(fd protoreflect.FieldDescriptor, value string) (v any, err error) {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		v, err = strconv.ParseBool(value)
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		v, err = strconv.ParseInt(value, 10, 32)
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		v, err = strconv.ParseUint(value, 10, 32)
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		v, err = strconv.ParseFloat(value, 64)
+	default:
+		v = value
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid value for field %s: %w", fd.Name(), err)
+	}
+
+	return
+}
+
+// setPath sets the value v in the (nested) map obj, creating intermediate maps if necessary.
+func setPath(obj map[string]any, path []string, v any) {
+	for _, key := range path[:len(path)-1] {
+		next, ok := obj[key].(map[string]any)
+		if !ok {
+			next = make(map[string]any)
+			obj[key] = next
+		}
+		obj = next
+	}
+
+	obj[path[len(path)-1]] = v
+}
@@ -0,0 +1,360 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package evidences
+
+import (
+	"context"
+	"testing"
+
+	"clouditor.io/clouditor/v2/api/assessment"
+	"clouditor.io/clouditor/v2/api/evidence"
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/testutil/servicetest"
+	"clouditor.io/clouditor/v2/service"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// mockAssessor records the evidences it assesses.
+type mockAssessor struct {
+	evidences []*evidence.Evidence
+}
+
+func (m *mockAssessor) AssessEvidence(_ context.Context, req *assessment.AssessEvidenceRequest) (*assessment.AssessEvidenceResponse, error) {
+	m.evidences = append(m.evidences, req.Evidence)
+
+	return &assessment.AssessEvidenceResponse{}, nil
+}
+
+func TestService_ImportEvidences(t *testing.T) {
+	type fields struct {
+		authz      service.AuthorizationStrategy
+		assessment *mockAssessor
+	}
+	type args struct {
+		req *evidence.ImportEvidencesRequest
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantRes assert.Want[*evidence.ImportEvidencesResponse]
+		want    assert.Want[*Service]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "validation error",
+			args: args{
+				req: &evidence.ImportEvidencesRequest{},
+			},
+			wantRes: assert.Nil[*evidence.ImportEvidencesResponse],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "cloud_service_id: value is empty")
+			},
+		},
+		{
+			name: "permission denied",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategy(false, testdata.MockCloudServiceID2),
+			},
+			args: args{
+				req: &evidence.ImportEvidencesRequest{
+					CloudServiceId: testdata.MockCloudServiceID1,
+					ToolId:         testdata.MockEvidenceToolID1,
+					Format:         evidence.ImportFormat_IMPORT_FORMAT_JSON,
+					Data:           []byte(`[]`),
+					Mapping:        &evidence.EvidenceMapping{ResourceType: "ObjectStorage"},
+				},
+			},
+			wantRes: assert.Nil[*evidence.ImportEvidencesResponse],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, service.ErrPermissionDenied)
+			},
+		},
+		{
+			name: "unknown resource type",
+			args: args{
+				req: &evidence.ImportEvidencesRequest{
+					CloudServiceId: testdata.MockCloudServiceID1,
+					ToolId:         testdata.MockEvidenceToolID1,
+					Format:         evidence.ImportFormat_IMPORT_FORMAT_JSON,
+					Data:           []byte(`[]`),
+					Mapping:        &evidence.EvidenceMapping{ResourceType: "DoesNotExist"},
+				},
+			},
+			wantRes: assert.Nil[*evidence.ImportEvidencesResponse],
+			wantErr: func(t *testing.T, err error) bool {
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+				return assert.ErrorContains(t, err, ErrUnknownResourceType.Error())
+			},
+		},
+		{
+			name: "invalid JSON",
+			args: args{
+				req: &evidence.ImportEvidencesRequest{
+					CloudServiceId: testdata.MockCloudServiceID1,
+					ToolId:         testdata.MockEvidenceToolID1,
+					Format:         evidence.ImportFormat_IMPORT_FORMAT_JSON,
+					Data:           []byte(`{`),
+					Mapping:        &evidence.EvidenceMapping{ResourceType: "ObjectStorage"},
+				},
+			},
+			wantRes: assert.Nil[*evidence.ImportEvidencesResponse],
+			wantErr: func(t *testing.T, err error) bool {
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+				return assert.ErrorContains(t, err, "could not parse data")
+			},
+		},
+		{
+			name: "JSON without field mapping",
+			args: args{
+				req: &evidence.ImportEvidencesRequest{
+					CloudServiceId: testdata.MockCloudServiceID1,
+					ToolId:         testdata.MockEvidenceToolID1,
+					Format:         evidence.ImportFormat_IMPORT_FORMAT_JSON,
+					Data:           []byte(`[{"id": "bucket-1", "name": "bucket-1", "publicAccess": true}, {"id": "bucket-2"}]`),
+					Mapping:        &evidence.EvidenceMapping{ResourceType: "ObjectStorage"},
+				},
+			},
+			wantRes: func(t *testing.T, got *evidence.ImportEvidencesResponse) bool {
+				assert.Equal(t, int32(1), got.ImportedCount)
+				assert.Equal(t, 1, len(got.Failures))
+				return assert.Equal(t, int32(1), got.Failures[0].Record)
+			},
+			want: func(t *testing.T, svc *Service) bool {
+				var evidences []*evidence.Evidence
+				err := svc.storage.List(&evidences, "", true, 0, -1)
+				assert.NoError(t, err)
+				assert.Equal(t, 1, len(evidences))
+
+				storage := assert.Is[*ontology.ObjectStorage](t, unmarshalResource(t, evidences[0]))
+				assert.Equal(t, "bucket-1", storage.Id)
+				return assert.True(t, storage.PublicAccess)
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "JSON with assessment",
+			fields: fields{
+				assessment: &mockAssessor{},
+			},
+			args: args{
+				req: &evidence.ImportEvidencesRequest{
+					CloudServiceId: testdata.MockCloudServiceID1,
+					ToolId:         testdata.MockEvidenceToolID1,
+					Format:         evidence.ImportFormat_IMPORT_FORMAT_JSON,
+					Data:           []byte(`[{"id": "bucket-1", "name": "bucket-1"}]`),
+					Mapping:        &evidence.EvidenceMapping{ResourceType: "ObjectStorage"},
+				},
+			},
+			wantRes: func(t *testing.T, got *evidence.ImportEvidencesResponse) bool {
+				return assert.Equal(t, int32(1), got.ImportedCount)
+			},
+			want: func(t *testing.T, svc *Service) bool {
+				evidences := svc.assessment.(*mockAssessor).evidences
+				assert.Equal(t, 1, len(evidences))
+				assert.Equal(t, testdata.MockCloudServiceID1, evidences[0].CloudServiceId)
+				assert.Equal(t, "bucket-1", unmarshalResource(t, evidences[0]).GetId())
+
+				// The assessment stores the evidence, so that it is not stored twice
+				var stored []*evidence.Evidence
+				err := svc.storage.List(&stored, "", true, 0, -1)
+				assert.NoError(t, err)
+				return assert.Equal(t, 0, len(stored))
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "CSV with field mapping",
+			args: args{
+				req: &evidence.ImportEvidencesRequest{
+					CloudServiceId: testdata.MockCloudServiceID1,
+					ToolId:         testdata.MockEvidenceToolID1,
+					Format:         evidence.ImportFormat_IMPORT_FORMAT_CSV,
+					Data:           []byte("bucket,public,encrypted\nbucket-1,false,true\nbucket-2,maybe,true\n"),
+					Mapping: &evidence.EvidenceMapping{
+						ResourceType: "ObjectStorage",
+						Fields: map[string]string{
+							"id":           "bucket",
+							"name":         "bucket",
+							"publicAccess": "public",
+							"atRestEncryption.managedKeyEncryption.enabled": "encrypted",
+						},
+					},
+				},
+			},
+			wantRes: func(t *testing.T, got *evidence.ImportEvidencesResponse) bool {
+				assert.Equal(t, int32(1), got.ImportedCount)
+				assert.Equal(t, 1, len(got.Failures))
+				return assert.Contains(t, got.Failures[0].Message, "invalid value for field public_access")
+			},
+			want: func(t *testing.T, svc *Service) bool {
+				var evidences []*evidence.Evidence
+				err := svc.storage.List(&evidences, "", true, 0, -1)
+				assert.NoError(t, err)
+				assert.Equal(t, 1, len(evidences))
+
+				storage := assert.Is[*ontology.ObjectStorage](t, unmarshalResource(t, evidences[0]))
+				assert.Equal(t, "bucket-1", storage.Name)
+				return assert.True(t, storage.GetAtRestEncryption().GetManagedKeyEncryption().GetEnabled())
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "CSV with missing column",
+			args: args{
+				req: &evidence.ImportEvidencesRequest{
+					CloudServiceId: testdata.MockCloudServiceID1,
+					ToolId:         testdata.MockEvidenceToolID1,
+					Format:         evidence.ImportFormat_IMPORT_FORMAT_CSV,
+					Data:           []byte("bucket\nbucket-1\n"),
+					Mapping: &evidence.EvidenceMapping{
+						ResourceType: "ObjectStorage",
+						Fields: map[string]string{
+							"id":   "bucket",
+							"name": "name",
+						},
+					},
+				},
+			},
+			wantRes: func(t *testing.T, got *evidence.ImportEvidencesResponse) bool {
+				assert.Equal(t, int32(0), got.ImportedCount)
+				assert.Equal(t, 1, len(got.Failures))
+				return assert.Contains(t, got.Failures[0].Message, ErrMissingColumn.Error())
+			},
+			wantErr: assert.Nil[error],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := NewService()
+			if tt.fields.authz != nil {
+				svc.authz = tt.fields.authz
+			}
+			if tt.fields.assessment != nil {
+				svc.assessment = tt.fields.assessment
+			}
+
+			gotRes, err := servicetest.Validated(svc.ImportEvidences)(context.TODO(), tt.args.req)
+
+			tt.wantErr(t, err)
+			tt.wantRes(t, gotRes)
+			assert.Optional(t, tt.want, svc)
+		})
+	}
+}
+
+func TestService_importRecord(t *testing.T) {
+	svc := NewService()
+
+	mt, err := resourceType("ObjectStorage")
+	assert.NoError(t, err)
+
+	// The import request is not validated here, so the resulting evidence is invalid
+	err = svc.importRecord(context.TODO(), &evidence.ImportEvidencesRequest{
+		CloudServiceId: testdata.MockCloudServiceID1,
+		Mapping:        &evidence.EvidenceMapping{ResourceType: "ObjectStorage"},
+	}, mt, importRecord{values: map[string]any{"id": "bucket-1", "name": "bucket-1"}, typed: true})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.ErrorContains(t, err, "evidence.tool_id: value length must be at least 1 characters")
+
+	var evidences []*evidence.Evidence
+	err = svc.storage.List(&evidences, "", true, 0, -1)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(evidences))
+}
+
+func Test_convertValue(t *testing.T) {
+	type args struct {
+		path  []string
+		value string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    any
+		wantErr assert.WantErr
+	}{
+		{
+			name: "unknown field",
+			args: args{
+				path:  []string{"doesNotExist"},
+				value: "true",
+			},
+			want: nil,
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, ErrUnknownField)
+			},
+		},
+		{
+			name: "not a message",
+			args: args{
+				path:  []string{"name", "other"},
+				value: "true",
+			},
+			want: nil,
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, ErrUnknownField)
+			},
+		},
+		{
+			name: "nested field",
+			args: args{
+				path:  []string{"geoLocation", "region"},
+				value: "eu-west",
+			},
+			want:    "eu-west",
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "list",
+			args: args{
+				path:  []string{"redundancies"},
+				value: "",
+			},
+			want:    []any(nil),
+			wantErr: assert.Nil[error],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := convertValue((&ontology.ObjectStorage{}).ProtoReflect().Descriptor(), tt.args.path, tt.args.value)
+
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func unmarshalResource(t *testing.T, ev *evidence.Evidence) ontology.IsResource {
+	m, err := ev.Resource.UnmarshalNew()
+	assert.NoError(t, err)
+
+	return assert.Is[ontology.IsResource](t, m)
+}
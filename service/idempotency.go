@@ -0,0 +1,171 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package service
+
+import (
+	"bytes"
+	"context"
+	"sync"
+	"time"
+
+	"clouditor.io/clouditor/v2/api"
+
+	"google.golang.org/grpc/status"
+)
+
+// IdempotencyKeyHeader is the (lower-case) header that contains the idempotency key of a request. Collectors set a
+// unique key per evidence, so that retrying a request, e.g., after a timeout, does not submit the evidence twice.
+const IdempotencyKeyHeader = "idempotency-key"
+
+// DefaultIdempotencyKeyTTL is the default duration for which an idempotency key is tracked after the request
+// succeeded.
+const DefaultIdempotencyKeyTTL = 24 * time.Hour
+
+// MaxIdempotencyKeyLength is the maximum length of an idempotency key.
+const MaxIdempotencyKeyLength = 255
+
+// idempotencySweepInterval is the interval in which expired idempotency keys are removed.
+const idempotencySweepInterval = time.Minute
+
+// IdempotentMethods contains the RPCs that support idempotency keys. These are the ones that submit a single
+// evidence.
+var IdempotentMethods = []string{
+	"/clouditor.assessment.v1.Assessment/AssessEvidence",
+	"/clouditor.evidence.v1.EvidenceStore/StoreEvidence",
+}
+
+// ErrIdempotencyKeyReused indicates that an idempotency key was already used for a different request.
+var ErrIdempotencyKeyReused = api.ErrorCodeIdempotencyKeyReuse.Err()
+
+// idempotencyEntry tracks the request of an idempotency key and, once it succeeded, its response.
+type idempotencyEntry struct {
+	fingerprint []byte
+	resp        any
+	done        chan struct{}
+	expiresAt   time.Time
+}
+
+// IdempotencyKeys tracks idempotency keys in memory. The request of a key is only executed once as long as the key
+// is tracked, i.e., until the TTL after its success elapsed. Failed requests are not tracked, so that they can be
+// retried with the same key.
+type IdempotencyKeys struct {
+	ttl time.Duration
+
+	mu        sync.Mutex
+	entries   map[string]*idempotencyEntry
+	lastSweep time.Time
+
+	// now returns the current time. It defaults to [time.Now].
+	now func() time.Time
+}
+
+// NewIdempotencyKeys creates a new [IdempotencyKeys] that tracks keys for ttl.
+func NewIdempotencyKeys(ttl time.Duration) *IdempotencyKeys {
+	return &IdempotencyKeys{
+		ttl:     ttl,
+		entries: make(map[string]*idempotencyEntry),
+		now:     time.Now,
+	}
+}
+
+// Do executes fn, unless a request with the same key was already successful, in which case its response is returned
+// instead. The fingerprint identifies the request, e.g., a hash of it, and needs to match the one of the previous
+// request with this key. If a request with the same key is still in progress, Do waits for it.
+func (k *IdempotencyKeys) Do(ctx context.Context, key string, fingerprint []byte, fn func() (any, error)) (resp any, err error) {
+	for {
+		k.mu.Lock()
+
+		now := k.now()
+		k.sweep(now)
+
+		entry, ok := k.entries[key]
+		if ok && entry.resp != nil && !now.Before(entry.expiresAt) {
+			delete(k.entries, key)
+			ok = false
+		}
+
+		if !ok {
+			entry = &idempotencyEntry{fingerprint: fingerprint, done: make(chan struct{})}
+			k.entries[key] = entry
+			k.mu.Unlock()
+
+			return k.execute(key, entry, fn)
+		}
+
+		k.mu.Unlock()
+
+		if !bytes.Equal(entry.fingerprint, fingerprint) {
+			return nil, ErrIdempotencyKeyReused
+		}
+
+		select {
+		case <-entry.done:
+		case <-ctx.Done():
+			return nil, status.FromContextError(ctx.Err()).Err()
+		}
+
+		// If the previous request failed, it was removed and we try again
+		if entry.resp != nil {
+			return entry.resp, nil
+		}
+	}
+}
+
+// execute executes fn for the new entry of key and records its response, if it succeeded.
+func (k *IdempotencyKeys) execute(key string, entry *idempotencyEntry, fn func() (any, error)) (resp any, err error) {
+	defer close(entry.done)
+
+	resp, err = fn()
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	if err != nil || resp == nil {
+		delete(k.entries, key)
+		return resp, err
+	}
+
+	entry.resp = resp
+	entry.expiresAt = k.now().Add(k.ttl)
+
+	return resp, nil
+}
+
+// sweep removes the expired keys, if the last sweep is longer ago than the sweep interval. It needs to be called
+// while holding the lock.
+func (k *IdempotencyKeys) sweep(now time.Time) {
+	if now.Sub(k.lastSweep) < idempotencySweepInterval {
+		return
+	}
+
+	for key, entry := range k.entries {
+		if entry.resp != nil && !now.Before(entry.expiresAt) {
+			delete(k.entries, key)
+		}
+	}
+
+	k.lastSweep = now
+}
@@ -0,0 +1,130 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+)
+
+func TestIdempotencyKeys_Do(t *testing.T) {
+	var (
+		now   = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		calls int
+		fn    = func() (any, error) {
+			calls++
+			return calls, nil
+		}
+		ctx = context.Background()
+	)
+
+	k := NewIdempotencyKeys(time.Hour)
+	k.now = func() time.Time { return now }
+
+	resp, err := k.Do(ctx, "key", []byte("a"), fn)
+	assert.NoError(t, err)
+	assert.Equal[any](t, 1, resp)
+
+	// A retry returns the first response
+	resp, err = k.Do(ctx, "key", []byte("a"), fn)
+	assert.NoError(t, err)
+	assert.Equal[any](t, 1, resp)
+
+	// A different request with the same key fails
+	_, err = k.Do(ctx, "key", []byte("b"), fn)
+	assert.ErrorIs(t, err, ErrIdempotencyKeyReused)
+
+	// After the TTL, the key can be used again
+	now = now.Add(time.Hour)
+
+	resp, err = k.Do(ctx, "key", []byte("b"), fn)
+	assert.NoError(t, err)
+	assert.Equal[any](t, 2, resp)
+	assert.Equal(t, 2, calls)
+}
+
+func TestIdempotencyKeys_Do_failed(t *testing.T) {
+	var (
+		calls int
+		ctx   = context.Background()
+	)
+
+	k := NewIdempotencyKeys(time.Hour)
+
+	_, err := k.Do(ctx, "key", []byte("a"), func() (any, error) {
+		calls++
+		return nil, errors.New("timeout")
+	})
+	assert.Error(t, err)
+
+	// Failed requests are not tracked and can be retried
+	resp, err := k.Do(ctx, "key", []byte("a"), func() (any, error) {
+		calls++
+		return "ok", nil
+	})
+	assert.NoError(t, err)
+	assert.Equal[any](t, "ok", resp)
+	assert.Equal(t, 2, calls)
+}
+
+func TestIdempotencyKeys_Do_inProgress(t *testing.T) {
+	var (
+		ctx     = context.Background()
+		started = make(chan struct{})
+		release = make(chan struct{})
+		done    = make(chan any)
+	)
+
+	k := NewIdempotencyKeys(time.Hour)
+
+	go func() {
+		resp, _ := k.Do(ctx, "key", []byte("a"), func() (any, error) {
+			close(started)
+			<-release
+			return "first", nil
+		})
+		done <- resp
+	}()
+
+	<-started
+
+	go func() {
+		resp, _ := k.Do(ctx, "key", []byte("a"), func() (any, error) {
+			return "second", nil
+		})
+		done <- resp
+	}()
+
+	close(release)
+
+	// The concurrent retry waits for the first request and returns its response
+	assert.Equal[any](t, "first", <-done)
+	assert.Equal[any](t, "first", <-done)
+}
@@ -0,0 +1,147 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"clouditor.io/clouditor/v2/persistence"
+
+	"github.com/google/uuid"
+)
+
+// DefaultLeaseDuration is the default duration of the lease of a leader. If the leader does not renew its lease
+// within this duration, e.g., because it crashed, another replica takes over.
+const DefaultLeaseDuration = 30 * time.Second
+
+// ErrNotLeader indicates that this instance is currently not the leader and therefore must not run scheduled jobs.
+var ErrNotLeader = errors.New("instance is not the leader")
+
+// LeaderElector decides which of multiple replicas of a service runs scheduled jobs, such as discoveries, evaluations
+// or the purge of removed entities, so that they are executed on exactly one instance. It matches the Elector
+// interface of gocron, so it can be used as the distributed elector of a scheduler.
+type LeaderElector interface {
+	// IsLeader returns nil, if this instance is the leader, and [ErrNotLeader] or another error otherwise.
+	IsLeader(ctx context.Context) error
+}
+
+// IsLeading checks, whether this instance is the leader according to the elector. Without an elector, i.e., in
+// single-instance deployments, each instance is the leader.
+func IsLeading(ctx context.Context, e LeaderElector) bool {
+	if e == nil {
+		return true
+	}
+
+	err := e.IsLeader(ctx)
+	if err != nil && !errors.Is(err, ErrNotLeader) {
+		log.Warnf("Could not determine leader: %v", err)
+	}
+
+	return err == nil
+}
+
+// StorageLeaderElector is a [LeaderElector] that uses a [persistence.Lease] in a storage shared by all replicas, e.g.,
+// a PostgreSQL database. The lease is acquired, if it is not held by another instance or if it expired, and renewed
+// each time the leadership is checked.
+type StorageLeaderElector struct {
+	storage  persistence.Storage
+	name     string
+	holder   string
+	duration time.Duration
+
+	// now returns the current time. It defaults to [time.Now].
+	now func() time.Time
+}
+
+// NewStorageLeaderElector creates a new [StorageLeaderElector] for the lease with the given name. Only replicas using
+// the same name compete for the leadership.
+func NewStorageLeaderElector(storage persistence.Storage, name string, duration time.Duration) *StorageLeaderElector {
+	host, _ := os.Hostname()
+
+	return &StorageLeaderElector{
+		storage:  storage,
+		name:     name,
+		holder:   fmt.Sprintf("%s-%s", host, uuid.NewString()),
+		duration: duration,
+		now:      time.Now,
+	}
+}
+
+// IsLeader implements [LeaderElector]. It acquires or renews the lease, if possible.
+func (e *StorageLeaderElector) IsLeader(_ context.Context) (err error) {
+	var now = e.now().UTC()
+
+	lease := &persistence.Lease{
+		Name:      e.name,
+		Holder:    e.holder,
+		ExpiresAt: now.Add(e.duration),
+	}
+
+	err = e.storage.Create(lease)
+	if errors.Is(err, persistence.ErrUniqueConstraintFailed) {
+		// The lease already exists, so we can only take it, if it is ours or expired
+		err = e.storage.Update(lease, "name = ? AND (holder = ? OR expires_at < ?)", e.name, e.holder, now)
+		if errors.Is(err, persistence.ErrRecordNotFound) {
+			return ErrNotLeader
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("could not acquire lease: %w", err)
+	}
+
+	return nil
+}
+
+// Start renews the lease periodically until the context is done, so that the leadership does not switch between
+// the replicas in between scheduled jobs. Afterward, the lease is released, so that another replica can take over
+// immediately.
+func (e *StorageLeaderElector) Start(ctx context.Context) {
+	ticker := time.NewTicker(e.duration / 3)
+	defer ticker.Stop()
+
+	for {
+		_ = IsLeading(ctx, e)
+
+		select {
+		case <-ctx.Done():
+			e.release()
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// release deletes the lease, if it is held by this instance.
+func (e *StorageLeaderElector) release() {
+	err := e.storage.Delete(&persistence.Lease{}, "name = ? AND holder = ?", e.name, e.holder)
+	if err != nil && !errors.Is(err, persistence.ErrRecordNotFound) {
+		log.Warnf("Could not release lease %s: %v", e.name, err)
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"clouditor.io/clouditor/v2/internal/testutil"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/persistence"
+)
+
+func TestStorageLeaderElector_IsLeader(t *testing.T) {
+	var (
+		now     = time.Now()
+		storage = testutil.NewInMemoryStorage(t)
+		a       = NewStorageLeaderElector(storage, "test", time.Minute)
+		b       = NewStorageLeaderElector(storage, "test", time.Minute)
+		other   = NewStorageLeaderElector(storage, "other", time.Minute)
+		ctx     = context.Background()
+	)
+
+	a.now = func() time.Time { return now }
+	b.now = func() time.Time { return now }
+
+	// The first replica acquires the lease and keeps it when asked again
+	assert.NoError(t, a.IsLeader(ctx))
+	assert.NoError(t, a.IsLeader(ctx))
+	assert.ErrorIs(t, b.IsLeader(ctx), ErrNotLeader)
+
+	// Leases of other names are independent
+	assert.NoError(t, other.IsLeader(ctx))
+
+	// Once the lease expired, the second replica takes over
+	b.now = func() time.Time { return now.Add(2 * time.Minute) }
+	assert.NoError(t, b.IsLeader(ctx))
+
+	a.now = b.now
+	assert.ErrorIs(t, a.IsLeader(ctx), ErrNotLeader)
+
+	// After releasing the lease, it can be acquired immediately
+	b.release()
+	assert.NoError(t, a.IsLeader(ctx))
+}
+
+func TestStorageLeaderElector_Start(t *testing.T) {
+	var (
+		storage = testutil.NewInMemoryStorage(t)
+		e       = NewStorageLeaderElector(storage, "test", time.Minute)
+		lease   persistence.Lease
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// The lease is acquired once and released after the context is done
+	e.Start(ctx)
+
+	assert.ErrorIs(t, storage.Get(&lease, "name = ?", "test"), persistence.ErrRecordNotFound)
+}
+
+func TestIsLeading(t *testing.T) {
+	var storage = testutil.NewInMemoryStorage(t)
+
+	assert.True(t, IsLeading(context.Background(), nil))
+	assert.True(t, IsLeading(context.Background(), NewStorageLeaderElector(storage, "test", time.Minute)))
+	assert.False(t, IsLeading(context.Background(), NewStorageLeaderElector(storage, "test", time.Minute)))
+	assert.False(t, IsLeading(context.Background(), NewStorageLeaderElector(
+		&testutil.StorageWithError{CreateErr: persistence.ErrConstraintFailed}, "test", time.Minute)))
+}
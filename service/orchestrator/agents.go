@@ -0,0 +1,239 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"clouditor.io/clouditor/v2/api"
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/internal/logging"
+	"clouditor.io/clouditor/v2/persistence"
+	"clouditor.io/clouditor/v2/service"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// DefaultAgentCollectionInterval is the interval in which newly registered agents collect evidences.
+const DefaultAgentCollectionInterval = 5 * time.Minute
+
+// ErrAgentNotFound indicates the agent was not found
+var ErrAgentNotFound = api.ErrorCodeAgentNotFound.Err()
+
+// RegisterAgent implements method for registering an external evidence collector (agent). Agents that register again
+// with their ID keep their configuration.
+func (svc *Service) RegisterAgent(ctx context.Context, req *orchestrator.RegisterAgentRequest) (res *orchestrator.Agent, err error) {
+	var existing *orchestrator.Agent
+
+	// Check, if this request has access to the cloud service according to our authorization strategy
+	if !svc.authz.CheckAccess(ctx, service.AccessCreate, req) {
+		return nil, service.ErrPermissionDenied
+	}
+
+	res = req.Agent
+
+	if res.Id != "" {
+		existing, err = svc.getAgent(ctx, res.Id)
+		if err != nil && !errors.Is(err, ErrAgentNotFound) {
+			return nil, err
+		}
+	}
+
+	if existing != nil {
+		res.Configuration = existing.Configuration
+	} else {
+		// Generate a new ID, unless the agent brings its own one
+		if res.Id == "" {
+			res.Id = uuid.NewString()
+		}
+
+		res.Configuration = &orchestrator.AgentConfiguration{
+			CollectionInterval: int64(DefaultAgentCollectionInterval.Seconds()),
+			HeartbeatInterval:  int64(orchestrator.DefaultAgentHeartbeatInterval.Seconds()),
+		}
+	}
+
+	// Registering counts as first heartbeat
+	res.RegisteredAt = timestamppb.Now()
+	res.LastHeartbeatAt = res.RegisteredAt
+
+	err = svc.storage.Save(res, "id = ?", res.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not add agent to the database: %v", err)
+	}
+
+	logging.LogRequest(log, logrus.DebugLevel, logging.Register, req)
+
+	res.Health = res.HealthAt(time.Now())
+
+	return res, nil
+}
+
+// SendAgentHeartbeat implements method for recording a heartbeat of an agent. The response contains the current
+// configuration of the agent.
+func (svc *Service) SendAgentHeartbeat(ctx context.Context, req *orchestrator.SendAgentHeartbeatRequest) (res *orchestrator.SendAgentHeartbeatResponse, err error) {
+	var agent *orchestrator.Agent
+
+	agent, err = svc.getAgent(ctx, req.AgentId)
+	if err != nil {
+		return nil, err
+	}
+
+	agent.LastHeartbeatAt = timestamppb.Now()
+
+	err = svc.storage.Save(agent, "id = ?", agent.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
+	return &orchestrator.SendAgentHeartbeatResponse{Configuration: agent.Configuration}, nil
+}
+
+// ListAgents implements method for listing all agents the user is authorized to see, together with their health
+func (svc *Service) ListAgents(ctx context.Context, req *orchestrator.ListAgentsRequest) (res *orchestrator.ListAgentsResponse, err error) {
+	var (
+		query []string
+		args  []any
+		now   = time.Now()
+	)
+
+	// Retrieve list of allowed cloud service according to our authorization strategy
+	all, allowed := svc.authz.AllowedCloudServices(ctx)
+
+	if req.Filter != nil {
+		// The content of the filtered cloud service ID must be in the list of allowed cloud service IDs, unless one can
+		// access *all* the cloud services.
+		if !all && req.Filter.CloudServiceId != nil && !slices.Contains(allowed, req.Filter.GetCloudServiceId()) {
+			return nil, service.ErrPermissionDenied
+		}
+
+		if req.Filter.CloudServiceId != nil {
+			query = append(query, "cloud_service_id = ?")
+			args = append(args, req.Filter.GetCloudServiceId())
+		}
+
+		if req.Filter.ToolId != nil {
+			query = append(query, "tool_id = ?")
+			args = append(args, req.Filter.GetToolId())
+		}
+	}
+
+	if !all {
+		query = append(query, "cloud_service_id IN ?")
+		args = append(args, allowed)
+	}
+
+	res = new(orchestrator.ListAgentsResponse)
+
+	res.Agents, res.NextPageToken, err = service.PaginateStorage[*orchestrator.Agent](req, svc.storage,
+		service.DefaultPaginationOpts, persistence.BuildConds(query, args)...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not paginate results: %v", err)
+	}
+
+	for _, agent := range res.Agents {
+		agent.Health = agent.HealthAt(now)
+	}
+
+	return
+}
+
+// UpdateAgentConfiguration implements method for updating the configuration of an agent
+func (svc *Service) UpdateAgentConfiguration(ctx context.Context, req *orchestrator.UpdateAgentConfigurationRequest) (res *orchestrator.Agent, err error) {
+	res, err = svc.getAgent(ctx, req.AgentId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !svc.authz.CheckAccess(ctx, service.AccessUpdate, res) {
+		return nil, service.ErrPermissionDenied
+	}
+
+	res.Configuration = req.Configuration
+
+	err = svc.storage.Save(res, "id = ?", res.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
+	logging.LogRequest(log, logrus.DebugLevel, logging.Update, req)
+
+	res.Health = res.HealthAt(time.Now())
+
+	return res, nil
+}
+
+// DeregisterAgent implements method for deregistering an agent
+func (svc *Service) DeregisterAgent(ctx context.Context, req *orchestrator.DeregisterAgentRequest) (res *emptypb.Empty, err error) {
+	var agent *orchestrator.Agent
+
+	// Check, if the agent exists and if we have access to it
+	agent, err = svc.getAgent(ctx, req.AgentId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !svc.authz.CheckAccess(ctx, service.AccessDelete, agent) {
+		return nil, service.ErrPermissionDenied
+	}
+
+	err = svc.storage.Delete(&orchestrator.Agent{}, "id = ?", req.AgentId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
+	logging.LogRequest(log, logrus.DebugLevel, logging.Remove, req)
+
+	return &emptypb.Empty{}, nil
+}
+
+// getAgent retrieves the agent with the given ID from the storage and checks, whether the user has access to its
+// cloud service.
+func (svc *Service) getAgent(ctx context.Context, id string) (agent *orchestrator.Agent, err error) {
+	agent = new(orchestrator.Agent)
+
+	err = svc.storage.Get(agent, "id = ?", id)
+	if errors.Is(err, persistence.ErrRecordNotFound) {
+		return nil, ErrAgentNotFound
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
+	all, allowed := svc.authz.AllowedCloudServices(ctx)
+	if !all && !slices.Contains(allowed, agent.CloudServiceId) {
+		return nil, service.ErrPermissionDenied
+	}
+
+	return agent, nil
+}
@@ -0,0 +1,308 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package orchestrator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/testutil/servicetest"
+	"clouditor.io/clouditor/v2/internal/util"
+	"clouditor.io/clouditor/v2/persistence"
+	"clouditor.io/clouditor/v2/service"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const mockAgentID = "cccccccc-cccc-cccc-cccc-cccccccccccc"
+
+func newMockAgent(lastHeartbeat time.Time) *orchestrator.Agent {
+	return &orchestrator.Agent{
+		Id:              mockAgentID,
+		ToolId:          "my-collector",
+		CloudServiceId:  testdata.MockCloudServiceID1,
+		Capabilities:    []string{"discovery"},
+		ResourceTypes:   []string{"VirtualMachine"},
+		Configuration:   &orchestrator.AgentConfiguration{CollectionInterval: 3600, HeartbeatInterval: 60},
+		LastHeartbeatAt: timestamppb.New(lastHeartbeat),
+	}
+}
+
+func TestService_RegisterAgent(t *testing.T) {
+	type fields struct {
+		storage persistence.Storage
+		authz   service.AuthorizationStrategy
+	}
+	type args struct {
+		req *orchestrator.RegisterAgentRequest
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantRes assert.Want[*orchestrator.Agent]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "Validation error",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t),
+				authz:   servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &orchestrator.RegisterAgentRequest{
+					Agent: &orchestrator.Agent{CloudServiceId: testdata.MockCloudServiceID1},
+				},
+			},
+			wantRes: assert.Nil[*orchestrator.Agent],
+			wantErr: func(t *testing.T, err error) bool {
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+				return assert.ErrorContains(t, err, "agent.tool_id")
+			},
+		},
+		{
+			name: "Permission denied",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t),
+				authz:   servicetest.NewAuthorizationStrategy(false, testdata.MockCloudServiceID2),
+			},
+			args: args{
+				req: &orchestrator.RegisterAgentRequest{
+					Agent: &orchestrator.Agent{ToolId: "my-collector", CloudServiceId: testdata.MockCloudServiceID1},
+				},
+			},
+			wantRes: assert.Nil[*orchestrator.Agent],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, service.ErrPermissionDenied)
+			},
+		},
+		{
+			name: "New agent",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t),
+				authz:   servicetest.NewAuthorizationStrategy(false, testdata.MockCloudServiceID1),
+			},
+			args: args{
+				req: &orchestrator.RegisterAgentRequest{
+					Agent: &orchestrator.Agent{ToolId: "my-collector", CloudServiceId: testdata.MockCloudServiceID1},
+				},
+			},
+			wantRes: func(t *testing.T, got *orchestrator.Agent) bool {
+				assert.NotEmpty(t, got.Id)
+				assert.NotNil(t, got.RegisteredAt)
+				assert.Equal(t, orchestrator.AgentHealth_AGENT_HEALTH_ALIVE, got.Health)
+				return assert.Equal(t, int64(DefaultAgentCollectionInterval.Seconds()), got.Configuration.GetCollectionInterval())
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "Re-registration keeps configuration",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					assert.NoError(t, s.Create(newMockAgent(time.Now().Add(-time.Hour))))
+				}),
+				authz: servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &orchestrator.RegisterAgentRequest{
+					Agent: &orchestrator.Agent{
+						Id:             mockAgentID,
+						ToolId:         "my-collector",
+						CloudServiceId: testdata.MockCloudServiceID1,
+						Version:        "2.0.0",
+					},
+				},
+			},
+			wantRes: func(t *testing.T, got *orchestrator.Agent) bool {
+				assert.Equal(t, mockAgentID, got.Id)
+				assert.Equal(t, "2.0.0", got.Version)
+				return assert.Equal(t, int64(3600), got.Configuration.GetCollectionInterval())
+			},
+			wantErr: assert.Nil[error],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				storage: tt.fields.storage,
+				authz:   tt.fields.authz,
+			}
+
+			gotRes, err := servicetest.Validated(svc.RegisterAgent)(context.Background(), tt.args.req)
+			tt.wantErr(t, err)
+			tt.wantRes(t, gotRes)
+		})
+	}
+}
+
+func TestService_SendAgentHeartbeat(t *testing.T) {
+	svc := &Service{
+		storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+			assert.NoError(t, s.Create(newMockAgent(time.Now().Add(-time.Hour))))
+		}),
+		authz: servicetest.NewAuthorizationStrategy(false, testdata.MockCloudServiceID2),
+	}
+
+	_, err := svc.SendAgentHeartbeat(context.Background(), &orchestrator.SendAgentHeartbeatRequest{AgentId: "dddddddd-dddd-dddd-dddd-dddddddddddd"})
+	assert.ErrorIs(t, err, ErrAgentNotFound)
+
+	_, err = svc.SendAgentHeartbeat(context.Background(), &orchestrator.SendAgentHeartbeatRequest{AgentId: mockAgentID})
+	assert.ErrorIs(t, err, service.ErrPermissionDenied)
+
+	svc.authz = servicetest.NewAuthorizationStrategy(true)
+
+	res, err := svc.SendAgentHeartbeat(context.Background(), &orchestrator.SendAgentHeartbeatRequest{AgentId: mockAgentID})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3600), res.GetConfiguration().GetCollectionInterval())
+
+	agent, err := svc.getAgent(context.Background(), mockAgentID)
+	assert.NoError(t, err)
+	assert.Equal(t, orchestrator.AgentHealth_AGENT_HEALTH_ALIVE, agent.HealthAt(time.Now()))
+}
+
+func TestService_ListAgents(t *testing.T) {
+	type args struct {
+		req *orchestrator.ListAgentsRequest
+	}
+	tests := []struct {
+		name    string
+		authz   service.AuthorizationStrategy
+		args    args
+		wantRes assert.Want[*orchestrator.ListAgentsResponse]
+		wantErr assert.WantErr
+	}{
+		{
+			name:  "Permission denied for filtered cloud service",
+			authz: servicetest.NewAuthorizationStrategy(false, testdata.MockCloudServiceID2),
+			args: args{
+				req: &orchestrator.ListAgentsRequest{
+					Filter: &orchestrator.ListAgentsRequest_Filter{CloudServiceId: util.Ref(testdata.MockCloudServiceID1)},
+				},
+			},
+			wantRes: assert.Nil[*orchestrator.ListAgentsResponse],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, service.ErrPermissionDenied)
+			},
+		},
+		{
+			name:  "Only allowed cloud services",
+			authz: servicetest.NewAuthorizationStrategy(false, testdata.MockCloudServiceID2),
+			args: args{
+				req: &orchestrator.ListAgentsRequest{},
+			},
+			wantRes: func(t *testing.T, got *orchestrator.ListAgentsResponse) bool {
+				return assert.Equal(t, 0, len(got.Agents))
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name:  "With health",
+			authz: servicetest.NewAuthorizationStrategy(true),
+			args: args{
+				req: &orchestrator.ListAgentsRequest{
+					Filter:  &orchestrator.ListAgentsRequest_Filter{ToolId: util.Ref("my-collector")},
+					OrderBy: "id",
+					Asc:     true,
+				},
+			},
+			wantRes: func(t *testing.T, got *orchestrator.ListAgentsResponse) bool {
+				if !assert.Equal(t, 2, len(got.Agents)) {
+					return false
+				}
+
+				assert.Equal(t, orchestrator.AgentHealth_AGENT_HEALTH_ALIVE, got.Agents[0].Health)
+				return assert.Equal(t, orchestrator.AgentHealth_AGENT_HEALTH_DEAD, got.Agents[1].Health)
+			},
+			wantErr: assert.Nil[error],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					assert.NoError(t, s.Create(newMockAgent(time.Now())))
+
+					dead := newMockAgent(time.Now().Add(-time.Hour))
+					dead.Id = "dddddddd-dddd-dddd-dddd-dddddddddddd"
+					assert.NoError(t, s.Create(dead))
+				}),
+				authz: tt.authz,
+			}
+
+			gotRes, err := svc.ListAgents(context.Background(), tt.args.req)
+			tt.wantErr(t, err)
+			tt.wantRes(t, gotRes)
+		})
+	}
+}
+
+func TestService_UpdateAgentConfiguration(t *testing.T) {
+	svc := &Service{
+		storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+			assert.NoError(t, s.Create(newMockAgent(time.Now())))
+		}),
+		authz: servicetest.NewAuthorizationStrategy(true),
+	}
+
+	res, err := svc.UpdateAgentConfiguration(context.Background(), &orchestrator.UpdateAgentConfigurationRequest{
+		AgentId:       mockAgentID,
+		Configuration: &orchestrator.AgentConfiguration{CollectionInterval: 600, Scopes: []string{"my-resource-group"}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"my-resource-group"}, res.GetConfiguration().GetScopes())
+
+	// The agent receives the new configuration with its next heartbeat
+	heartbeat, err := svc.SendAgentHeartbeat(context.Background(), &orchestrator.SendAgentHeartbeatRequest{AgentId: mockAgentID})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(600), heartbeat.GetConfiguration().GetCollectionInterval())
+}
+
+func TestService_DeregisterAgent(t *testing.T) {
+	svc := &Service{
+		storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+			assert.NoError(t, s.Create(newMockAgent(time.Now())))
+		}),
+		authz: servicetest.NewAuthorizationStrategy(false, testdata.MockCloudServiceID2),
+	}
+
+	_, err := svc.DeregisterAgent(context.Background(), &orchestrator.DeregisterAgentRequest{AgentId: mockAgentID})
+	assert.ErrorIs(t, err, service.ErrPermissionDenied)
+
+	svc.authz = servicetest.NewAuthorizationStrategy(true)
+
+	_, err = svc.DeregisterAgent(context.Background(), &orchestrator.DeregisterAgentRequest{AgentId: mockAgentID})
+	assert.NoError(t, err)
+
+	_, err = svc.getAgent(context.Background(), mockAgentID)
+	assert.ErrorIs(t, err, ErrAgentNotFound)
+}
@@ -0,0 +1,303 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package orchestrator
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"clouditor.io/clouditor/v2/api"
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/internal/logging"
+	"clouditor.io/clouditor/v2/persistence"
+	"clouditor.io/clouditor/v2/service"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// apiKeySecretLength is the number of random bytes of the secret of an API key
+const apiKeySecretLength = 32
+
+var (
+	// ErrApiKeyNotFound indicates the API key was not found
+	ErrApiKeyNotFound = api.ErrorCodeAPIKeyNotFound.Err()
+
+	// ErrInvalidApiKey indicates that an API key is malformed, unknown, expired or has a wrong secret
+	ErrInvalidApiKey = errors.New("invalid API key")
+)
+
+// CreateApiKey implements method for creating a new API key. The user needs to be an admin for all cloud services of
+// the API key. The key itself is only returned here.
+func (svc *Service) CreateApiKey(ctx context.Context, req *orchestrator.CreateApiKeyRequest) (res *orchestrator.CreateApiKeyResponse, err error) {
+	if !svc.isAdminOfAll(ctx, req.ApiKey.CloudServiceIds) {
+		return nil, service.ErrPermissionDenied
+	}
+
+	// Generate a new ID and secret
+	req.ApiKey.Id = uuid.NewString()
+	req.ApiKey.CreatedAt = timestamppb.Now()
+	req.ApiKey.RotatedAt = nil
+
+	key, err := newApiKeySecret(req.ApiKey)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not generate API key: %v", err)
+	}
+
+	// Persist the new API key in our database
+	err = svc.storage.Create(req.ApiKey)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not add API key to the database: %v", err)
+	}
+
+	req.ApiKey.SecretHash = ""
+
+	logging.LogRequest(log, logrus.DebugLevel, logging.Create, req)
+
+	return &orchestrator.CreateApiKeyResponse{ApiKey: req.ApiKey, Key: key}, nil
+}
+
+// GetApiKey implements method for getting an API key. The user needs access to all cloud services of the API key.
+func (svc *Service) GetApiKey(ctx context.Context, req *orchestrator.GetApiKeyRequest) (res *orchestrator.ApiKey, err error) {
+	res, err = svc.getApiKey(ctx, req.ApiKeyId)
+	if err != nil {
+		return nil, err
+	}
+
+	res.SecretHash = ""
+
+	return res, nil
+}
+
+// ListApiKeys implements method for listing all API keys the user is authorized to see, i.e., the ones for which the
+// user has access to all their cloud services.
+func (svc *Service) ListApiKeys(ctx context.Context, req *orchestrator.ListApiKeysRequest) (res *orchestrator.ListApiKeysResponse, err error) {
+	var (
+		conds []any
+		keys  []*orchestrator.ApiKey
+		ids   []string
+	)
+
+	all, allowed := svc.authz.AllowedCloudServices(ctx)
+	if !all {
+		// The cloud services are serialized as JSON, so we need to filter them ourselves
+		err = svc.storage.List(&keys, "", true, 0, -1)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "database error: %v", err)
+		}
+
+		for _, key := range keys {
+			if containsAll(allowed, key.CloudServiceIds) {
+				ids = append(ids, key.Id)
+			}
+		}
+
+		conds = append(conds, "id IN ?", ids)
+	}
+
+	res = new(orchestrator.ListApiKeysResponse)
+
+	res.ApiKeys, res.NextPageToken, err = service.PaginateStorage[*orchestrator.ApiKey](req, svc.storage,
+		service.DefaultPaginationOpts, conds...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not paginate results: %v", err)
+	}
+
+	for _, key := range res.ApiKeys {
+		key.SecretHash = ""
+	}
+
+	return
+}
+
+// RotateApiKey implements method for rotating an API key. A new key is issued and the old one is invalid immediately.
+// The user needs to be an admin for all cloud services of the API key.
+func (svc *Service) RotateApiKey(ctx context.Context, req *orchestrator.RotateApiKeyRequest) (res *orchestrator.CreateApiKeyResponse, err error) {
+	var apiKey *orchestrator.ApiKey
+
+	apiKey, err = svc.getApiKey(ctx, req.ApiKeyId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !svc.isAdminOfAll(ctx, apiKey.CloudServiceIds) {
+		return nil, service.ErrPermissionDenied
+	}
+
+	key, err := newApiKeySecret(apiKey)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not generate API key: %v", err)
+	}
+
+	apiKey.RotatedAt = timestamppb.Now()
+
+	err = svc.storage.Save(apiKey, "id = ?", apiKey.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
+	logging.LogRequest(log, logrus.DebugLevel, logging.Update, req)
+
+	apiKey.SecretHash = ""
+
+	return &orchestrator.CreateApiKeyResponse{ApiKey: apiKey, Key: key}, nil
+}
+
+// RemoveApiKey implements method for removing (revoking) an API key. The user needs to be an admin for all cloud
+// services of the API key.
+func (svc *Service) RemoveApiKey(ctx context.Context, req *orchestrator.RemoveApiKeyRequest) (res *emptypb.Empty, err error) {
+	var apiKey *orchestrator.ApiKey
+
+	apiKey, err = svc.getApiKey(ctx, req.ApiKeyId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !svc.isAdminOfAll(ctx, apiKey.CloudServiceIds) {
+		return nil, service.ErrPermissionDenied
+	}
+
+	err = svc.storage.Delete(&orchestrator.ApiKey{}, "id = ?", req.ApiKeyId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
+	logging.LogRequest(log, logrus.DebugLevel, logging.Remove, req)
+
+	return &emptypb.Empty{}, nil
+}
+
+// VerifyAPIKey implements [service.APIKeyVerifier]. It checks whether the key belongs to an API key, which is not
+// expired, and returns the cloud services of the API key.
+func (svc *Service) VerifyAPIKey(_ context.Context, key string) (cloudServiceIDs []string, err error) {
+	var apiKey orchestrator.ApiKey
+
+	id, secret, ok := strings.Cut(key, ".")
+	if !ok || uuid.Validate(id) != nil {
+		return nil, ErrInvalidApiKey
+	}
+
+	err = svc.storage.Get(&apiKey, "id = ?", id)
+	if errors.Is(err, persistence.ErrRecordNotFound) {
+		return nil, ErrInvalidApiKey
+	} else if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+
+	if apiKey.ExpiresAt != nil && apiKey.ExpiresAt.AsTime().Before(time.Now()) {
+		return nil, ErrInvalidApiKey
+	}
+
+	if subtle.ConstantTimeCompare([]byte(hashApiKeySecret(secret)), []byte(apiKey.SecretHash)) != 1 {
+		return nil, ErrInvalidApiKey
+	}
+
+	return apiKey.CloudServiceIds, nil
+}
+
+// getApiKey retrieves the API key with the given ID from the storage and checks, whether the user has access to all
+// its cloud services.
+func (svc *Service) getApiKey(ctx context.Context, id string) (apiKey *orchestrator.ApiKey, err error) {
+	apiKey = new(orchestrator.ApiKey)
+
+	err = svc.storage.Get(apiKey, "id = ?", id)
+	if errors.Is(err, persistence.ErrRecordNotFound) {
+		return nil, ErrApiKeyNotFound
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
+	all, allowed := svc.authz.AllowedCloudServices(ctx)
+	if !all && !containsAll(allowed, apiKey.CloudServiceIds) {
+		return nil, service.ErrPermissionDenied
+	}
+
+	return apiKey, nil
+}
+
+// isAdminOfAll checks, whether the current user is an admin of all the given cloud services.
+func (svc *Service) isAdminOfAll(ctx context.Context, cloudServiceIDs []string) bool {
+	for _, id := range cloudServiceIDs {
+		if !svc.isAdmin(ctx, id) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// newApiKeySecret generates a new secret for the API key and stores its hash in the API key. It returns the key
+// that needs to be supplied by the client, which consists of the ID of the API key and the secret.
+func newApiKeySecret(apiKey *orchestrator.ApiKey) (key string, err error) {
+	return newSecret(apiKey.Id, &apiKey.SecretHash)
+}
+
+// newSecret generates a new random secret and stores its hash in hash. It returns the ID and the secret joined by a
+// dot, which is the form in which API keys and the tokens of auditor grants are supplied by clients.
+func newSecret(id string, hash *string) (key string, err error) {
+	var b = make([]byte, apiKeySecretLength)
+
+	_, err = rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+
+	secret := base64.RawURLEncoding.EncodeToString(b)
+	*hash = hashApiKeySecret(secret)
+
+	return id + "." + secret, nil
+}
+
+// hashApiKeySecret returns the hex-encoded SHA-256 hash of the secret of an API key.
+func hashApiKeySecret(secret string) string {
+	h := sha256.Sum256([]byte(secret))
+
+	return hex.EncodeToString(h[:])
+}
+
+// containsAll checks whether list contains all elements of elems.
+func containsAll(list []string, elems []string) bool {
+	for _, e := range elems {
+		if !slices.Contains(list, e) {
+			return false
+		}
+	}
+
+	return true
+}
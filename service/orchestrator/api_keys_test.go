@@ -0,0 +1,245 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package orchestrator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/testutil/servicetest"
+	"clouditor.io/clouditor/v2/service"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestService_CreateApiKey(t *testing.T) {
+	type fields struct {
+		authz service.AuthorizationStrategy
+	}
+	type args struct {
+		req *orchestrator.CreateApiKeyRequest
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantRes assert.Want[*orchestrator.CreateApiKeyResponse]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "Validation error: no cloud services",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &orchestrator.CreateApiKeyRequest{
+					ApiKey: &orchestrator.ApiKey{Name: "collector"},
+				},
+			},
+			wantRes: assert.Nil[*orchestrator.CreateApiKeyResponse],
+			wantErr: func(t *testing.T, err error) bool {
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+				return assert.ErrorContains(t, err, "api_key.cloud_service_ids")
+			},
+		},
+		{
+			name: "Permission denied: not an admin",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategyWithRoles(true, []string{service.RoleAuditor}),
+			},
+			args: args{
+				req: &orchestrator.CreateApiKeyRequest{
+					ApiKey: &orchestrator.ApiKey{Name: "collector", CloudServiceIds: []string{testdata.MockCloudServiceID1}},
+				},
+			},
+			wantRes: assert.Nil[*orchestrator.CreateApiKeyResponse],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, service.ErrPermissionDenied)
+			},
+		},
+		{
+			name: "Permission denied: admin of only one of the cloud services",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategyWithUser(false, mockAdminUser, testdata.MockCloudServiceID1, testdata.MockCloudServiceID2),
+			},
+			args: args{
+				req: &orchestrator.CreateApiKeyRequest{
+					ApiKey: &orchestrator.ApiKey{
+						Name:            "collector",
+						CloudServiceIds: []string{testdata.MockCloudServiceID1, testdata.MockCloudServiceID2},
+					},
+				},
+			},
+			wantRes: assert.Nil[*orchestrator.CreateApiKeyResponse],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, service.ErrPermissionDenied)
+			},
+		},
+		{
+			name: "Happy path",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategyWithUser(false, mockAdminUser, testdata.MockCloudServiceID1),
+			},
+			args: args{
+				req: &orchestrator.CreateApiKeyRequest{
+					ApiKey: &orchestrator.ApiKey{Name: "collector", CloudServiceIds: []string{testdata.MockCloudServiceID1}},
+				},
+			},
+			wantRes: func(t *testing.T, got *orchestrator.CreateApiKeyResponse) bool {
+				assert.NotEmpty(t, got.ApiKey.Id)
+				assert.NotNil(t, got.ApiKey.CreatedAt)
+				assert.Empty(t, got.ApiKey.SecretHash)
+				return assert.NotEmpty(t, got.Key)
+			},
+			wantErr: assert.Nil[error],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				storage: newMockRoleAssignmentStorage(t),
+				authz:   tt.fields.authz,
+			}
+
+			gotRes, err := servicetest.Validated(svc.CreateApiKey)(context.Background(), tt.args.req)
+			tt.wantErr(t, err)
+			tt.wantRes(t, gotRes)
+		})
+	}
+}
+
+func TestService_ApiKeyLifecycle(t *testing.T) {
+	var ctx = context.Background()
+
+	svc := &Service{
+		storage: testutil.NewInMemoryStorage(t),
+		authz:   servicetest.NewAuthorizationStrategyWithRoles(true, []string{service.RoleAdmin}),
+	}
+
+	created, err := svc.CreateApiKey(ctx, &orchestrator.CreateApiKeyRequest{
+		ApiKey: &orchestrator.ApiKey{Name: "collector", CloudServiceIds: []string{testdata.MockCloudServiceID1}},
+	})
+	assert.NoError(t, err)
+
+	// The key is valid for its cloud services
+	ids, err := svc.VerifyAPIKey(ctx, created.Key)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{testdata.MockCloudServiceID1}, ids)
+
+	// Malformed, unknown and wrong keys are rejected
+	for _, key := range []string{"", "not-a-key", testdata.MockCloudServiceID2 + ".secret", created.ApiKey.Id + ".secret"} {
+		_, err = svc.VerifyAPIKey(ctx, key)
+		assert.ErrorIs(t, err, ErrInvalidApiKey)
+	}
+
+	// The hash of the secret is never returned
+	res, err := svc.GetApiKey(ctx, &orchestrator.GetApiKeyRequest{ApiKeyId: created.ApiKey.Id})
+	assert.NoError(t, err)
+	assert.Empty(t, res.SecretHash)
+
+	// After rotating, only the new key is valid
+	rotated, err := svc.RotateApiKey(ctx, &orchestrator.RotateApiKeyRequest{ApiKeyId: created.ApiKey.Id})
+	assert.NoError(t, err)
+	assert.NotNil(t, rotated.ApiKey.RotatedAt)
+
+	_, err = svc.VerifyAPIKey(ctx, created.Key)
+	assert.ErrorIs(t, err, ErrInvalidApiKey)
+
+	_, err = svc.VerifyAPIKey(ctx, rotated.Key)
+	assert.NoError(t, err)
+
+	// After removing, the key is invalid
+	_, err = svc.RemoveApiKey(ctx, &orchestrator.RemoveApiKeyRequest{ApiKeyId: created.ApiKey.Id})
+	assert.NoError(t, err)
+
+	_, err = svc.VerifyAPIKey(ctx, rotated.Key)
+	assert.ErrorIs(t, err, ErrInvalidApiKey)
+
+	_, err = svc.GetApiKey(ctx, &orchestrator.GetApiKeyRequest{ApiKeyId: created.ApiKey.Id})
+	assert.ErrorIs(t, err, ErrApiKeyNotFound)
+}
+
+func TestService_VerifyAPIKey_expired(t *testing.T) {
+	var ctx = context.Background()
+
+	svc := &Service{
+		storage: testutil.NewInMemoryStorage(t),
+		authz:   servicetest.NewAuthorizationStrategyWithRoles(true, []string{service.RoleAdmin}),
+	}
+
+	created, err := svc.CreateApiKey(ctx, &orchestrator.CreateApiKeyRequest{
+		ApiKey: &orchestrator.ApiKey{
+			Name:            "collector",
+			CloudServiceIds: []string{testdata.MockCloudServiceID1},
+			ExpiresAt:       timestamppb.New(time.Now().Add(-time.Minute)),
+		},
+	})
+	assert.NoError(t, err)
+
+	_, err = svc.VerifyAPIKey(ctx, created.Key)
+	assert.ErrorIs(t, err, ErrInvalidApiKey)
+}
+
+func TestService_ListApiKeys(t *testing.T) {
+	var ctx = context.Background()
+
+	svc := &Service{
+		storage: testutil.NewInMemoryStorage(t),
+		authz:   servicetest.NewAuthorizationStrategyWithRoles(true, []string{service.RoleAdmin}),
+	}
+
+	for _, ids := range [][]string{
+		{testdata.MockCloudServiceID1},
+		{testdata.MockCloudServiceID1, testdata.MockCloudServiceID2},
+	} {
+		_, err := svc.CreateApiKey(ctx, &orchestrator.CreateApiKeyRequest{
+			ApiKey: &orchestrator.ApiKey{Name: "collector", CloudServiceIds: ids},
+		})
+		assert.NoError(t, err)
+	}
+
+	res, err := svc.ListApiKeys(ctx, &orchestrator.ListApiKeysRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(res.ApiKeys))
+	for _, key := range res.ApiKeys {
+		assert.Empty(t, key.SecretHash)
+	}
+
+	// Only API keys for which the user has access to all cloud services are visible
+	svc.authz = servicetest.NewAuthorizationStrategy(false, testdata.MockCloudServiceID1)
+
+	res, err = svc.ListApiKeys(ctx, &orchestrator.ListApiKeysRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(res.ApiKeys))
+	assert.Equal(t, []string{testdata.MockCloudServiceID1}, res.ApiKeys[0].CloudServiceIds)
+}
@@ -0,0 +1,199 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package orchestrator
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+
+	"clouditor.io/clouditor/v2/api/assessment"
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/service"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// resultSubscriberBufferSize is the number of events that are buffered for each subscriber of assessment results.
+// Events are dropped for a subscriber whose buffer is full.
+const resultSubscriberBufferSize = 1000
+
+// resultSubscriber is a subscriber of assessment result events, e.g., a UI or a SIEM forwarder.
+type resultSubscriber struct {
+	events chan *orchestrator.AssessmentResultEvent
+	filter *orchestrator.SubscribeAssessmentResultsRequest_Filter
+
+	// all and allowed are the cloud services the subscriber was allowed to access at the time of subscription
+	all     bool
+	allowed []string
+}
+
+// matches checks, whether the event is relevant for the subscriber according to its filter and allowed cloud
+// services.
+func (sub *resultSubscriber) matches(event *orchestrator.AssessmentResultEvent) bool {
+	var (
+		result = event.GetResult()
+		filter = sub.filter
+	)
+
+	if !sub.all && !slices.Contains(sub.allowed, result.GetCloudServiceId()) {
+		return false
+	}
+
+	if filter == nil {
+		return true
+	}
+
+	if filter.CloudServiceId != nil && filter.GetCloudServiceId() != result.GetCloudServiceId() {
+		return false
+	}
+
+	if len(filter.MetricIds) > 0 && !slices.Contains(filter.MetricIds, result.GetMetricId()) {
+		return false
+	}
+
+	if filter.Compliant != nil && filter.GetCompliant() != result.GetCompliant() {
+		return false
+	}
+
+	if filter.GetComplianceChangedOnly() && event.Type != orchestrator.AssessmentResultEvent_TYPE_COMPLIANCE_CHANGED {
+		return false
+	}
+
+	return true
+}
+
+// SubscribeAssessmentResults implements a stream of new assessment results to the subscribed client. The stream ends
+// when the client cancels it.
+func (svc *Service) SubscribeAssessmentResults(req *orchestrator.SubscribeAssessmentResultsRequest, stream orchestrator.Orchestrator_SubscribeAssessmentResultsServer) (err error) {
+	var (
+		all     bool
+		allowed []string
+		event   *orchestrator.AssessmentResultEvent
+	)
+
+	// Retrieve list of allowed cloud service according to our authorization strategy. The content of the filtered
+	// cloud service ID must be in the list of allowed cloud service IDs, unless one can access *all* the cloud
+	// services.
+	all, allowed = svc.authz.AllowedCloudServices(stream.Context())
+	if !all && req.Filter != nil && req.Filter.CloudServiceId != nil && !slices.Contains(allowed, req.Filter.GetCloudServiceId()) {
+		return service.ErrPermissionDenied
+	}
+
+	sub := svc.subscribeAssessmentResults(req.Filter, all, allowed)
+	defer svc.unsubscribeAssessmentResults(sub)
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case event = <-sub.events:
+		}
+
+		err = stream.Send(event)
+
+		// Check for send errors
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			err = fmt.Errorf("cannot stream response to the client: %w", err)
+			log.Error(err)
+
+			return status.Errorf(codes.Unknown, "%v", err)
+		}
+	}
+}
+
+// subscribeAssessmentResults registers a new subscriber of assessment result events.
+func (svc *Service) subscribeAssessmentResults(filter *orchestrator.SubscribeAssessmentResultsRequest_Filter, all bool, allowed []string) (sub *resultSubscriber) {
+	sub = &resultSubscriber{
+		events:  make(chan *orchestrator.AssessmentResultEvent, resultSubscriberBufferSize),
+		filter:  filter,
+		all:     all,
+		allowed: allowed,
+	}
+
+	svc.subscriberMutex.Lock()
+	defer svc.subscriberMutex.Unlock()
+
+	if svc.resultSubscribers == nil {
+		svc.resultSubscribers = make(map[*resultSubscriber]struct{})
+	}
+
+	svc.resultSubscribers[sub] = struct{}{}
+
+	return
+}
+
+// unsubscribeAssessmentResults removes a subscriber of assessment result events.
+func (svc *Service) unsubscribeAssessmentResults(sub *resultSubscriber) {
+	svc.subscriberMutex.Lock()
+	defer svc.subscriberMutex.Unlock()
+
+	delete(svc.resultSubscribers, sub)
+}
+
+// publishAssessmentResult sends an event about a newly stored assessment result to all matching subscribers.
+func (svc *Service) publishAssessmentResult(result *assessment.AssessmentResult) {
+	svc.subscriberMutex.RLock()
+	defer svc.subscriberMutex.RUnlock()
+
+	// Spare us the retrieval of the previous result, if no one is listening
+	if len(svc.resultSubscribers) == 0 {
+		return
+	}
+
+	event := &orchestrator.AssessmentResultEvent{
+		Type:   orchestrator.AssessmentResultEvent_TYPE_CREATED,
+		Result: result,
+	}
+
+	previous, err := svc.previousAssessmentResult(result)
+	if err != nil {
+		log.Errorf("Could not retrieve previous assessment result of resource %s: %v", result.ResourceId, err)
+	} else if previous != nil {
+		event.PreviouslyCompliant = &previous.Compliant
+		if previous.Compliant != result.Compliant {
+			event.Type = orchestrator.AssessmentResultEvent_TYPE_COMPLIANCE_CHANGED
+		}
+	}
+
+	for sub := range svc.resultSubscribers {
+		if !sub.matches(event) {
+			continue
+		}
+
+		// Do not block the storage of results because of a slow subscriber
+		select {
+		case sub.events <- event:
+		default:
+			log.Warnf("Dropping assessment result event %s for a subscriber that cannot keep up", result.Id)
+		}
+	}
+}
@@ -0,0 +1,353 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"clouditor.io/clouditor/v2/api/assessment"
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/testutil/servicetest"
+	"clouditor.io/clouditor/v2/internal/util"
+	"clouditor.io/clouditor/v2/service"
+
+	"google.golang.org/grpc"
+)
+
+type mockResultSubscriptionStream struct {
+	grpc.ServerStream
+	ctx     context.Context
+	events  chan *orchestrator.AssessmentResultEvent
+	sendErr error
+}
+
+func newMockResultSubscriptionStream(ctx context.Context) *mockResultSubscriptionStream {
+	return &mockResultSubscriptionStream{
+		ctx:    ctx,
+		events: make(chan *orchestrator.AssessmentResultEvent, 10),
+	}
+}
+
+func (m *mockResultSubscriptionStream) Send(event *orchestrator.AssessmentResultEvent) error {
+	if m.sendErr != nil {
+		return m.sendErr
+	}
+
+	m.events <- event
+	return nil
+}
+
+func (m *mockResultSubscriptionStream) Context() context.Context {
+	return m.ctx
+}
+
+// waitForSubscribers waits until the service has the specified number of result subscribers
+func waitForSubscribers(t *testing.T, svc *Service, n int) {
+	for i := 0; i < 100; i++ {
+		svc.subscriberMutex.RLock()
+		l := len(svc.resultSubscribers)
+		svc.subscriberMutex.RUnlock()
+
+		if l == n {
+			return
+		}
+
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	t.Fatalf("timeout while waiting for %d subscribers", n)
+}
+
+func Test_resultSubscriber_matches(t *testing.T) {
+	created := &orchestrator.AssessmentResultEvent{
+		Type:   orchestrator.AssessmentResultEvent_TYPE_CREATED,
+		Result: newMockResult(testdata.MockAssessmentResultID, true, 1),
+	}
+	changed := &orchestrator.AssessmentResultEvent{
+		Type:                orchestrator.AssessmentResultEvent_TYPE_COMPLIANCE_CHANGED,
+		Result:              newMockResult(testdata.MockAssessmentResultID, false, 1),
+		PreviouslyCompliant: util.Ref(true),
+	}
+
+	type fields struct {
+		filter  *orchestrator.SubscribeAssessmentResultsRequest_Filter
+		all     bool
+		allowed []string
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		event  *orchestrator.AssessmentResultEvent
+		want   bool
+	}{
+		{
+			name:   "no filter, all allowed",
+			fields: fields{all: true},
+			event:  created,
+			want:   true,
+		},
+		{
+			name:   "cloud service not allowed",
+			fields: fields{allowed: []string{testdata.MockCloudServiceID2}},
+			event:  created,
+			want:   false,
+		},
+		{
+			name: "cloud service allowed",
+			fields: fields{
+				filter:  &orchestrator.SubscribeAssessmentResultsRequest_Filter{CloudServiceId: util.Ref(testdata.MockCloudServiceID1)},
+				allowed: []string{testdata.MockCloudServiceID1},
+			},
+			event: created,
+			want:  true,
+		},
+		{
+			name: "other cloud service",
+			fields: fields{
+				filter: &orchestrator.SubscribeAssessmentResultsRequest_Filter{CloudServiceId: util.Ref(testdata.MockCloudServiceID2)},
+				all:    true,
+			},
+			event: created,
+			want:  false,
+		},
+		{
+			name: "other metric",
+			fields: fields{
+				filter: &orchestrator.SubscribeAssessmentResultsRequest_Filter{MetricIds: []string{testdata.MockMetricID2}},
+				all:    true,
+			},
+			event: created,
+			want:  false,
+		},
+		{
+			name: "matching metric",
+			fields: fields{
+				filter: &orchestrator.SubscribeAssessmentResultsRequest_Filter{MetricIds: []string{testdata.MockMetricID2, testdata.MockMetricID1}},
+				all:    true,
+			},
+			event: created,
+			want:  true,
+		},
+		{
+			name: "only non-compliant",
+			fields: fields{
+				filter: &orchestrator.SubscribeAssessmentResultsRequest_Filter{Compliant: util.Ref(false)},
+				all:    true,
+			},
+			event: created,
+			want:  false,
+		},
+		{
+			name: "only compliance changes, created",
+			fields: fields{
+				filter: &orchestrator.SubscribeAssessmentResultsRequest_Filter{ComplianceChangedOnly: util.Ref(true)},
+				all:    true,
+			},
+			event: created,
+			want:  false,
+		},
+		{
+			name: "only compliance changes, changed",
+			fields: fields{
+				filter: &orchestrator.SubscribeAssessmentResultsRequest_Filter{ComplianceChangedOnly: util.Ref(true)},
+				all:    true,
+			},
+			event: changed,
+			want:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sub := &resultSubscriber{
+				filter:  tt.fields.filter,
+				all:     tt.fields.all,
+				allowed: tt.fields.allowed,
+			}
+
+			assert.Equal(t, tt.want, sub.matches(tt.event))
+		})
+	}
+}
+
+func TestService_SubscribeAssessmentResults(t *testing.T) {
+	type args struct {
+		req     *orchestrator.SubscribeAssessmentResultsRequest
+		sendErr error
+	}
+	tests := []struct {
+		name       string
+		authz      service.AuthorizationStrategy
+		args       args
+		results    []*assessment.AssessmentResult
+		wantEvents []*orchestrator.AssessmentResultEvent
+		wantErr    assert.WantErr
+	}{
+		{
+			name:  "invalid request",
+			authz: servicetest.NewAuthorizationStrategy(true),
+			args: args{
+				req: &orchestrator.SubscribeAssessmentResultsRequest{
+					Filter: &orchestrator.SubscribeAssessmentResultsRequest_Filter{CloudServiceId: util.Ref("not-a-uuid")},
+				},
+			},
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "filter.cloud_service_id: value must be a valid UUID")
+			},
+		},
+		{
+			name:  "permission denied",
+			authz: servicetest.NewAuthorizationStrategy(false, testdata.MockCloudServiceID2),
+			args: args{
+				req: &orchestrator.SubscribeAssessmentResultsRequest{
+					Filter: &orchestrator.SubscribeAssessmentResultsRequest_Filter{CloudServiceId: util.Ref(testdata.MockCloudServiceID1)},
+				},
+			},
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, service.ErrPermissionDenied)
+			},
+		},
+		{
+			name:  "only allowed cloud services",
+			authz: servicetest.NewAuthorizationStrategy(false, testdata.MockCloudServiceID1),
+			args: args{
+				req: &orchestrator.SubscribeAssessmentResultsRequest{},
+			},
+			results: []*assessment.AssessmentResult{
+				newMockResult("00000000-0000-0000-0000-000000000010", false, 1),
+				func() *assessment.AssessmentResult {
+					r := newMockResult("00000000-0000-0000-0000-000000000011", false, 1)
+					r.CloudServiceId = testdata.MockCloudServiceID2
+					return r
+				}(),
+				newMockResult("00000000-0000-0000-0000-000000000012", true, 2),
+			},
+			wantEvents: []*orchestrator.AssessmentResultEvent{
+				{
+					Type:   orchestrator.AssessmentResultEvent_TYPE_CREATED,
+					Result: newMockResult("00000000-0000-0000-0000-000000000010", false, 1),
+				},
+				{
+					Type:                orchestrator.AssessmentResultEvent_TYPE_COMPLIANCE_CHANGED,
+					Result:              newMockResult("00000000-0000-0000-0000-000000000012", true, 2),
+					PreviouslyCompliant: util.Ref(false),
+				},
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name:  "only compliance changes",
+			authz: servicetest.NewAuthorizationStrategy(true),
+			args: args{
+				req: &orchestrator.SubscribeAssessmentResultsRequest{
+					Filter: &orchestrator.SubscribeAssessmentResultsRequest_Filter{ComplianceChangedOnly: util.Ref(true)},
+				},
+			},
+			results: []*assessment.AssessmentResult{
+				newMockResult("00000000-0000-0000-0000-000000000010", true, 1),
+				newMockResult("00000000-0000-0000-0000-000000000011", true, 2),
+				newMockResult("00000000-0000-0000-0000-000000000012", false, 3),
+			},
+			wantEvents: []*orchestrator.AssessmentResultEvent{
+				{
+					Type:                orchestrator.AssessmentResultEvent_TYPE_COMPLIANCE_CHANGED,
+					Result:              newMockResult("00000000-0000-0000-0000-000000000012", false, 3),
+					PreviouslyCompliant: util.Ref(true),
+				},
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name:  "send error",
+			authz: servicetest.NewAuthorizationStrategy(true),
+			args: args{
+				req:     &orchestrator.SubscribeAssessmentResultsRequest{},
+				sendErr: errors.New("some error"),
+			},
+			results: []*assessment.AssessmentResult{
+				newMockResult("00000000-0000-0000-0000-000000000010", true, 1),
+			},
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "cannot stream response to the client: some error")
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := NewService(
+				WithStorage(testutil.NewInMemoryStorage(t)),
+				WithAuthorizationStrategy(tt.authz),
+			)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			stream := newMockResultSubscriptionStream(ctx)
+			stream.sendErr = tt.args.sendErr
+
+			errc := make(chan error, 1)
+			go func() {
+				errc <- servicetest.ValidatedStream(svc.SubscribeAssessmentResults)(tt.args.req, stream)
+			}()
+
+			if len(tt.results) > 0 {
+				waitForSubscribers(t, svc, 1)
+			}
+
+			for _, r := range tt.results {
+				// Store results of other cloud services directly, since we might not have access to them
+				err := svc.storage.Create(r)
+				assert.NoError(t, err)
+				svc.publishAssessmentResult(r)
+			}
+
+			for _, want := range tt.wantEvents {
+				select {
+				case got := <-stream.events:
+					assert.Equal(t, want, got)
+				case <-time.After(time.Second):
+					t.Fatal("timeout while waiting for event")
+				}
+			}
+
+			// End the subscription, unless it already returned
+			if tt.args.sendErr == nil {
+				cancel()
+			}
+
+			err := <-errc
+			tt.wantErr(t, err)
+
+			assert.Equal(t, 0, len(stream.events))
+			waitForSubscribers(t, svc, 0)
+		})
+	}
+}
@@ -31,35 +31,82 @@ import (
 	"fmt"
 	"io"
 	"slices"
+	"strconv"
 	"strings"
+	"time"
 
 	"clouditor.io/clouditor/v2/api"
 	"clouditor.io/clouditor/v2/api/assessment"
 	"clouditor.io/clouditor/v2/api/orchestrator"
 	"clouditor.io/clouditor/v2/internal/logging"
+	"clouditor.io/clouditor/v2/internal/telemetry"
 	"clouditor.io/clouditor/v2/persistence"
 	"clouditor.io/clouditor/v2/service"
 
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+const (
+	// DefaultResultBatchSize is the default maximum number of assessment results received by StoreAssessmentResults
+	// that are stored together within a single transaction.
+	DefaultResultBatchSize = 100
+
+	// DefaultResultBatchInterval is the default maximum time assessment results received by StoreAssessmentResults
+	// are held back before they are stored.
+	DefaultResultBatchInterval = 500 * time.Millisecond
+)
+
 // GetAssessmentResult gets one assessment result by id
 func (svc *Service) GetAssessmentResult(ctx context.Context, req *orchestrator.GetAssessmentResultRequest) (res *assessment.AssessmentResult, err error) {
-	var (
-		all     bool
-		allowed []string
-	)
-
-	// Validate request
-	if err = api.Validate(req); err != nil {
+	if err = api.ValidateFieldMask(req.ReadMask, &assessment.AssessmentResult{}); err != nil {
 		return
 	}
 
+	res, err = svc.getAssessmentResult(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only return the requested fields
+	api.ApplyFieldMask(req.ReadMask, res)
+
+	return
+}
+
+// GetAssessmentResultEvidence returns the assessment result together with the evidences it is based on, so that one
+// can verify which data led to the result. Evidences that are no longer stored, e.g., because they were purged, are
+// omitted.
+func (svc *Service) GetAssessmentResultEvidence(ctx context.Context, req *orchestrator.GetAssessmentResultEvidenceRequest) (res *orchestrator.GetAssessmentResultEvidenceResponse, err error) {
+	res = new(orchestrator.GetAssessmentResultEvidenceResponse)
+
+	res.Result, err = svc.getAssessmentResult(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Results stored before the evidence IDs were recorded only contain the ID of the assessed evidence
+	ids := res.Result.GetEvidenceIds()
+	if len(ids) == 0 {
+		ids = []string{res.Result.GetEvidenceId()}
+	}
+
+	err = svc.storage.List(&res.Evidences, "id", true, 0, -1, "id IN ?", ids)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
+	return
+}
+
+// getAssessmentResult retrieves the assessment result with the given ID from the storage and checks, whether the user
+// has access to its cloud service.
+func (svc *Service) getAssessmentResult(ctx context.Context, id string) (res *assessment.AssessmentResult, err error) {
 	// Fetch result
 	res = new(assessment.AssessmentResult)
-	err = svc.storage.Get(res, "Id = ?", req.Id)
+	err = svc.storage.Get(res, "Id = ?", id)
 
 	if errors.Is(err, persistence.ErrRecordNotFound) {
 		return nil, status.Errorf(codes.NotFound, "assessment result not found")
@@ -68,7 +115,7 @@ func (svc *Service) GetAssessmentResult(ctx context.Context, req *orchestrator.G
 	}
 
 	// Check if cloud_service_id in assessment_result is within allowed or one can access *all* the cloud services
-	all, allowed = svc.authz.AllowedCloudServices(ctx)
+	all, allowed := svc.authz.AllowedCloudServices(ctx)
 
 	// The content of the filtered cloud service ID must be in the list of allowed cloud service IDs,
 	// unless one can access *all* the cloud services.
@@ -76,7 +123,7 @@ func (svc *Service) GetAssessmentResult(ctx context.Context, req *orchestrator.G
 		return nil, service.ErrPermissionDenied
 	}
 
-	return
+	return res, nil
 }
 
 // ListAssessmentResults is a method implementation of the orchestrator interface
@@ -84,8 +131,7 @@ func (svc *Service) ListAssessmentResults(ctx context.Context, req *orchestrator
 	var allowed []string
 	var all bool
 
-	// Validate request
-	err = api.Validate(req)
+	err = api.ValidateFieldMask(req.ReadMask, &assessment.AssessmentResult{})
 	if err != nil {
 		return nil, err
 	}
@@ -110,6 +156,7 @@ func (svc *Service) ListAssessmentResults(ctx context.Context, req *orchestrator
 	// * compliant status
 	// * metric ID
 	// * tool ID
+	// * time frame
 	if req.Filter != nil {
 		if req.Filter.CloudServiceId != nil {
 			query = append(query, "cloud_service_id = ?")
@@ -127,6 +174,22 @@ func (svc *Service) ListAssessmentResults(ctx context.Context, req *orchestrator
 			query = append(query, "tool_id = ?")
 			args = append(args, req.Filter.ToolId)
 		}
+		if req.Filter.Since != nil {
+			query = append(query, "timestamp >= ?")
+			args = append(args, req.Filter.Since.AsTime())
+		}
+		if req.Filter.Until != nil {
+			query = append(query, "timestamp <= ?")
+			args = append(args, req.Filter.Until.AsTime())
+		}
+		if req.Filter.ResourceId != nil {
+			query = append(query, "resource_id = ?")
+			args = append(args, req.Filter.GetResourceId())
+		}
+		if req.Filter.EvidenceId != nil {
+			query = append(query, "evidence_id = ?")
+			args = append(args, req.Filter.GetEvidenceId())
+		}
 	}
 
 	// In any case, we need to make sure that we only select assessment results of cloud services that we have access to
@@ -138,21 +201,7 @@ func (svc *Service) ListAssessmentResults(ctx context.Context, req *orchestrator
 
 	// If we want to have it grouped by resource ID (and metric ID), we need to do a raw query
 	if req.GetLatestByResourceId() {
-		// In the raw SQL, we need to build the whole WHERE statement
-		var where string
-
-		if len(query) > 0 {
-			where = "WHERE " + strings.Join(query, " AND ")
-		}
-
-		// Execute the raw SQL statement
-		err = svc.storage.Raw(&res.Results,
-			fmt.Sprintf(`WITH sorted_results AS (
-				SELECT *, ROW_NUMBER() OVER (PARTITION BY resource_id, metric_id ORDER BY timestamp DESC) AS row_number
-				FROM assessment_results
-				%s
-		  	)
-		  	SELECT * FROM sorted_results WHERE row_number = 1;`, where), args...)
+		res.Results, err = svc.latestAssessmentResults(query, args)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "database error: %v", err)
 		}
@@ -161,22 +210,54 @@ func (svc *Service) ListAssessmentResults(ctx context.Context, req *orchestrator
 		args = append([]any{strings.Join(query, " AND ")}, args...)
 
 		// Paginate the results according to the request
-		res.Results, res.NextPageToken, err = service.PaginateStorage[*assessment.AssessmentResult](req, svc.storage, service.DefaultPaginationOpts, args...)
+		res.Results, res.NextPageToken, err = service.PaginateStorageWithCursor[*assessment.AssessmentResult](req, svc.storage, service.DefaultPaginationOpts, args...)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "could not paginate results: %v", err)
 		}
 	}
 
+	// Only return the requested fields
+	api.ApplyFieldMask(req.ReadMask, res.Results...)
+
+	return
+}
+
+// latestAssessmentResults retrieves the latest assessment result of each resource and metric that matches the given
+// query. Resources that are decommissioned, because they are no longer discovered, are excluded.
+func (svc *Service) latestAssessmentResults(query []string, args []any) (results []*assessment.AssessmentResult, err error) {
+	query = append(slices.Clip(query), `NOT EXISTS (SELECT 1 FROM assessed_resources
+		WHERE assessed_resources.cloud_service_id = assessment_results.cloud_service_id
+		AND assessed_resources.resource_id = assessment_results.resource_id
+		AND assessed_resources.decommissioned_at IS NOT NULL)`)
+
+	// In the raw SQL, we need to build the whole WHERE statement
+	where := "WHERE " + strings.Join(query, " AND ")
+
+	// Execute the raw SQL statement
+	err = svc.storage.Raw(&results,
+		fmt.Sprintf(`WITH sorted_results AS (
+			SELECT *, ROW_NUMBER() OVER (PARTITION BY resource_id, metric_id ORDER BY timestamp DESC) AS row_number
+			FROM assessment_results
+			%s
+		)
+		SELECT * FROM sorted_results WHERE row_number = 1;`, where), args...)
+
 	return
 }
 
 // StoreAssessmentResult is a method implementation of the orchestrator interface: It receives an assessment result and stores it
 func (svc *Service) StoreAssessmentResult(ctx context.Context, req *orchestrator.StoreAssessmentResultRequest) (res *orchestrator.StoreAssessmentResultResponse, err error) {
-	// Validate request
-	err = api.Validate(req)
-	if err != nil {
-		return nil, err
-	}
+	// Continue the trace of the sender, e.g., the assessment
+	ctx, span := startStoreAssessmentResultSpan(ctx, req)
+	defer func() {
+		telemetry.EndSpan(span, err)
+	}()
+
+	// Correlate all log entries with the assessed evidence, also if the result was received in a stream
+	ctx = logging.WithFields(ctx, logrus.Fields{
+		logging.EvidenceIDField:     req.GetResult().GetEvidenceId(),
+		logging.CloudServiceIDField: req.GetResult().GetCloudServiceId(),
+	})
 
 	// Check, if this request has access to the cloud service according to our authorization strategy.
 	if !svc.authz.CheckAccess(ctx, service.AccessRead, req) {
@@ -188,65 +269,192 @@ func (svc *Service) StoreAssessmentResult(ctx context.Context, req *orchestrator
 		return nil, status.Errorf(codes.Internal, "database error: %v", err)
 	}
 
-	go svc.informHook(ctx, req.Result, nil)
+	svc.updateAssessmentStatistics(req.Result)
+
+	svc.informAboutResult(ctx, req.Result)
 
 	res = &orchestrator.StoreAssessmentResultResponse{}
 
-	logging.LogRequest(log, logrus.DebugLevel, logging.Store, req)
+	logging.LogRequest(log.WithContext(ctx), logrus.DebugLevel, logging.Store, req)
 
 	return res, nil
 }
 
+// StoreAssessmentResults stores the assessment results received from the stream. The results are collected into
+// batches, which are stored within a single transaction once they reach the configured batch size or the batch
+// interval elapsed. A response is sent for each result after its batch was stored.
 func (s *Service) StoreAssessmentResults(stream orchestrator.Orchestrator_StoreAssessmentResultsServer) (err error) {
 	var (
-		result *orchestrator.StoreAssessmentResultRequest
-		res    *orchestrator.StoreAssessmentResultsResponse
+		batch    []*orchestrator.StoreAssessmentResultRequest
+		recvErr  error
+		size     = max(s.resultBatchSize, 1)
+		interval = s.resultBatchInterval
+		reqs     = make(chan *orchestrator.StoreAssessmentResultRequest)
+		errs     = make(chan error, 1)
+		done     = make(chan struct{})
 	)
 
+	if interval <= 0 {
+		interval = DefaultResultBatchInterval
+	}
+
+	defer close(done)
+
+	// Receive the requests in a separate goroutine, so that we can store an incomplete batch once the interval elapsed
+	go func() {
+		for {
+			req, err := stream.Recv()
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			select {
+			case reqs <- req:
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
 	for {
-		result, err = stream.Recv()
+		select {
+		case req := <-reqs:
+			log.WithContext(stream.Context()).Debugf("Assessment result received (%v)", req.GetResult().GetId())
 
-		// If no more input of the stream is available, return
+			batch = append(batch, req)
+			if len(batch) < size {
+				continue
+			}
+		case <-ticker.C:
+			if len(batch) == 0 {
+				continue
+			}
+		case recvErr = <-errs:
+			// Store the remaining results below, before we return
+		}
+
+		err = s.flushAssessmentResults(stream, batch)
+		batch = nil
+
+		// Check for send errors
 		if errors.Is(err, io.EOF) {
 			return nil
 		}
 		if err != nil {
-			newError := fmt.Errorf("cannot receive stream request: %w", err)
-			log.Error(newError)
+			newError := fmt.Errorf("cannot stream response to the client: %w", err)
+			log.WithContext(stream.Context()).Error(newError)
+			return status.Errorf(codes.Unknown, "%v", newError.Error())
+		}
+
+		// If no more input of the stream is available, return
+		if errors.Is(recvErr, io.EOF) {
+			return nil
+		}
+		if recvErr != nil {
+			newError := fmt.Errorf("cannot receive stream request: %w", recvErr)
+			log.WithContext(stream.Context()).Error(newError)
 			return status.Errorf(codes.Unknown, "%v", newError)
 		}
+	}
+}
+
+// flushAssessmentResults stores a batch of assessment results and sends a response for each of them to the client.
+func (s *Service) flushAssessmentResults(stream orchestrator.Orchestrator_StoreAssessmentResultsServer, batch []*orchestrator.StoreAssessmentResultRequest) (err error) {
+	for _, res := range s.storeAssessmentResultBatch(stream.Context(), batch) {
+		err = stream.Send(res)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// storeAssessmentResultBatch stores a batch of assessment results within a single transaction and returns a response
+// for each of them. Invalid results are rejected individually, so that they do not prevent the storage of the
+// others.
+func (svc *Service) storeAssessmentResultBatch(ctx context.Context, batch []*orchestrator.StoreAssessmentResultRequest) (responses []*orchestrator.StoreAssessmentResultsResponse) {
+	var (
+		results []*assessment.AssessmentResult
+		err     error
+	)
+
+	responses = make([]*orchestrator.StoreAssessmentResultsResponse, len(batch))
 
-		// Call StoreAssessmentResult() for storing a single assessment
-		storeAssessmentResultReq := &orchestrator.StoreAssessmentResultRequest{
-			Result: result.Result,
+	for i, req := range batch {
+		// Validate request and check, if this request has access to the cloud service according to our
+		// authorization strategy.
+		err = api.Validate(req)
+		if err == nil && !svc.authz.CheckAccess(ctx, service.AccessRead, req) {
+			err = service.ErrPermissionDenied
 		}
-		_, err = s.StoreAssessmentResult(stream.Context(), storeAssessmentResultReq)
 		if err != nil {
-			// Create response message. The StoreAssessmentResult method does not need that message, so we have to create it here for the stream response.
-			res = &orchestrator.StoreAssessmentResultsResponse{
+			responses[i] = &orchestrator.StoreAssessmentResultsResponse{
 				Status:        false,
 				StatusMessage: err.Error(),
 			}
-		} else {
-			res = &orchestrator.StoreAssessmentResultsResponse{
-				Status: true,
-			}
+			continue
 		}
 
-		log.Debugf("Assessment result received (%v)", result.Result.Id)
+		results = append(results, req.Result)
+	}
 
-		err = stream.Send(res)
+	if len(results) > 0 {
+		err = svc.storage.CreateInBatches(results, len(results))
+		if err != nil {
+			log.WithContext(ctx).Warnf("Could not store batch of %d assessment results, storing them individually: %v", len(results), err)
+		} else {
+			svc.updateAssessmentStatistics(results...)
+		}
+	}
 
-		// Check for send errors
-		if errors.Is(err, io.EOF) {
-			return nil
+	for i, req := range batch {
+		if responses[i] != nil {
+			continue
 		}
+
+		// The whole batch was rolled back, so we store each result on its own to find out which of them failed
 		if err != nil {
-			newError := fmt.Errorf("cannot stream response to the client: %w", err)
-			log.Error(newError)
-			return status.Errorf(codes.Unknown, "%v", newError.Error())
+			_, storeErr := svc.StoreAssessmentResult(ctx, req)
+			if storeErr != nil {
+				responses[i] = &orchestrator.StoreAssessmentResultsResponse{
+					Status:        false,
+					StatusMessage: storeErr.Error(),
+				}
+			} else {
+				responses[i] = &orchestrator.StoreAssessmentResultsResponse{
+					Status: true,
+				}
+			}
+			continue
 		}
+
+		rctx, span := startStoreAssessmentResultSpan(ctx, req)
+		svc.informAboutResult(rctx, req.Result)
+		span.End()
+
+		responses[i] = &orchestrator.StoreAssessmentResultsResponse{
+			Status: true,
+		}
+
+		logging.LogRequest(log, logrus.DebugLevel, logging.Store, req)
 	}
+
+	return
+}
+
+// startStoreAssessmentResultSpan starts a span for storing the assessment result of req, which continues the trace
+// contained in the request.
+func startStoreAssessmentResultSpan(ctx context.Context, req *orchestrator.StoreAssessmentResultRequest) (context.Context, trace.Span) {
+	return telemetry.StartSpan(telemetry.ExtractTraceContext(ctx, req.GetTraceContext()), "orchestrator.StoreAssessmentResult",
+		telemetry.CloudServiceIDKey.String(req.GetResult().GetCloudServiceId()),
+		telemetry.EvidenceIDKey.String(req.GetResult().GetEvidenceId()),
+		telemetry.AssessmentResultIDKey.String(req.GetResult().GetId()),
+	)
 }
 
 func (s *Service) RegisterAssessmentResultHook(hook assessment.ResultHookFunc) {
@@ -266,3 +474,35 @@ func (s *Service) informHook(ctx context.Context, result *assessment.AssessmentR
 		}
 	}
 }
+
+// previousAssessmentResult retrieves the assessment result of the same resource and metric that precedes the
+// specified result. It returns nil, if there is none.
+func (svc *Service) previousAssessmentResult(result *assessment.AssessmentResult) (previous *assessment.AssessmentResult, err error) {
+	var results []*assessment.AssessmentResult
+
+	err = svc.storage.List(&results, "timestamp", false, 0, 1,
+		"cloud_service_id = ? AND resource_id = ? AND metric_id = ? AND id != ? AND timestamp <= ?",
+		result.CloudServiceId, result.ResourceId, result.MetricId, result.Id, result.Timestamp.AsTime())
+	if err != nil {
+		return nil, err
+	}
+
+	if len(results) == 0 {
+		return nil, nil
+	}
+
+	return results[0], nil
+}
+
+// informAboutResult informs our hooks, webhooks, ticket systems and subscribers about a newly stored assessment result.
+func (svc *Service) informAboutResult(ctx context.Context, result *assessment.AssessmentResult) {
+	telemetry.AssessmentResultsStoredTotal.WithLabelValues(strconv.FormatBool(result.Compliant)).Inc()
+
+	go svc.informHook(ctx, result, nil)
+	go svc.informWebhooksAboutResult(result)
+	go svc.handleTickets(result)
+
+	// Publish the result to our subscribers. This is done synchronously, so that subscribers receive the results in
+	// the order they were stored.
+	svc.publishAssessmentResult(result)
+}
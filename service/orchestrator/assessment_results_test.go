@@ -34,13 +34,18 @@ import (
 	"runtime"
 	"sync"
 	"testing"
+	"time"
 
 	"clouditor.io/clouditor/v2/api"
 	"clouditor.io/clouditor/v2/api/assessment"
+	"clouditor.io/clouditor/v2/api/evidence"
+	"clouditor.io/clouditor/v2/api/ontology"
 	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/internal/telemetry"
 	"clouditor.io/clouditor/v2/internal/testdata"
 	"clouditor.io/clouditor/v2/internal/testutil"
 	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/testutil/prototest"
 	"clouditor.io/clouditor/v2/internal/testutil/servicetest"
 	"clouditor.io/clouditor/v2/internal/testutil/servicetest/orchestratortest"
 	"clouditor.io/clouditor/v2/internal/util"
@@ -48,11 +53,17 @@ import (
 	"clouditor.io/clouditor/v2/service"
 
 	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -166,6 +177,44 @@ func TestService_GetAssessmentResult(t *testing.T) {
 			res:     orchestratortest.MockAssessmentResult1,
 			wantErr: assert.NoError,
 		},
+		{
+			name: "Happy path with read mask",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					assert.NoError(t, s.Create(&orchestratortest.MockAssessmentResult1))
+				}),
+				authz: servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &orchestrator.GetAssessmentResultRequest{
+					Id:       testdata.MockAssessmentResult1ID,
+					ReadMask: &fieldmaskpb.FieldMask{Paths: []string{"id", "compliant"}},
+				},
+			},
+			res: &assessment.AssessmentResult{
+				Id:        orchestratortest.MockAssessmentResult1.Id,
+				Compliant: orchestratortest.MockAssessmentResult1.Compliant,
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "invalid read mask",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t),
+				authz:   servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &orchestrator.GetAssessmentResultRequest{
+					Id:       testdata.MockAssessmentResult1ID,
+					ReadMask: &fieldmaskpb.FieldMask{Paths: []string{"metric_configuration.unknown"}},
+				},
+			},
+			res: nil,
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+				return assert.ErrorContains(t, err, api.ErrInvalidFieldMask.Error())
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -174,19 +223,151 @@ func TestService_GetAssessmentResult(t *testing.T) {
 				storage: tt.fields.storage,
 				authz:   tt.fields.authz,
 			}
-			gotRes, err := svc.GetAssessmentResult(context.Background(), tt.args.req)
+			gotRes, err := servicetest.Validated(svc.GetAssessmentResult)(context.Background(), tt.args.req)
 			tt.wantErr(t, err)
 
 			if tt.res == nil {
 				assert.Nil(t, gotRes)
 			} else {
-				assert.NoError(t, api.Validate(gotRes))
+				// Partial responses are not valid on their own
+				if tt.args.req.GetReadMask() == nil {
+					assert.NoError(t, api.Validate(gotRes))
+				}
 				assert.Equal(t, tt.res, gotRes)
 			}
 		})
 	}
 }
 
+func TestService_GetAssessmentResultEvidence(t *testing.T) {
+	var (
+		ev1 = &evidence.Evidence{
+			Id:             testdata.MockEvidenceID1,
+			CloudServiceId: testdata.MockCloudServiceID1,
+			ToolId:         testdata.MockEvidenceToolID1,
+			Timestamp:      timestamppb.New(time.Unix(1, 0)),
+			Resource:       prototest.NewAny(t, &ontology.VirtualMachine{Id: testdata.MockResourceID1}),
+		}
+		ev2 = &evidence.Evidence{
+			Id:             testdata.MockEvidenceID2,
+			CloudServiceId: testdata.MockCloudServiceID1,
+			ToolId:         testdata.MockEvidenceToolID2,
+			Timestamp:      timestamppb.New(time.Unix(2, 0)),
+			Resource:       prototest.NewAny(t, &ontology.VirtualMachine{Id: testdata.MockResourceID1}),
+		}
+		merged = &assessment.AssessmentResult{
+			Id:             testdata.MockAssessmentResult4ID,
+			Timestamp:      timestamppb.New(time.Unix(2, 0)),
+			CloudServiceId: testdata.MockCloudServiceID1,
+			MetricId:       testdata.MockMetricID1,
+			Compliant:      true,
+			EvidenceId:     testdata.MockEvidenceID2,
+			EvidenceIds:    []string{testdata.MockEvidenceID1, testdata.MockEvidenceID2, "33333333-3333-3333-3333-333333333333"},
+			EvidenceProperties: structpb.NewStructValue(&structpb.Struct{Fields: map[string]*structpb.Value{
+				"bootLogging.enabled": structpb.NewBoolValue(true),
+			}}),
+			ResourceId:    testdata.MockResourceID1,
+			ResourceTypes: []string{"Resource"},
+		}
+	)
+
+	type fields struct {
+		storage persistence.Storage
+		authz   service.AuthorizationStrategy
+	}
+	type args struct {
+		req *orchestrator.GetAssessmentResultEvidenceRequest
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantRes *orchestrator.GetAssessmentResultEvidenceResponse
+		wantErr assert.WantErr
+	}{
+		{
+			name: "permission denied",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					assert.NoError(t, s.Create(&orchestratortest.MockAssessmentResult1))
+				}),
+				authz: servicetest.NewAuthorizationStrategy(false, testdata.MockCloudServiceID2),
+			},
+			args: args{
+				req: &orchestrator.GetAssessmentResultEvidenceRequest{Id: testdata.MockAssessmentResult1ID},
+			},
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, service.ErrPermissionDenied)
+			},
+		},
+		{
+			name: "assessment result not found",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t),
+				authz:   servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &orchestrator.GetAssessmentResultEvidenceRequest{Id: testdata.MockAssessmentResult1ID},
+			},
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.Equal(t, codes.NotFound, status.Code(err))
+			},
+		},
+		{
+			name: "result without evidence IDs",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					assert.NoError(t, s.Create(&orchestratortest.MockAssessmentResult1))
+					assert.NoError(t, s.Create(ev1))
+					assert.NoError(t, s.Create(ev2))
+				}),
+				authz: servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &orchestrator.GetAssessmentResultEvidenceRequest{Id: testdata.MockAssessmentResult1ID},
+			},
+			wantRes: &orchestrator.GetAssessmentResultEvidenceResponse{
+				Result:    orchestratortest.MockAssessmentResult1,
+				Evidences: []*evidence.Evidence{ev1},
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "result of merged evidences, one of them purged",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					assert.NoError(t, s.Create(merged))
+					assert.NoError(t, s.Create(ev1))
+					assert.NoError(t, s.Create(ev2))
+				}),
+				authz: servicetest.NewAuthorizationStrategy(false, testdata.MockCloudServiceID1),
+			},
+			args: args{
+				req: &orchestrator.GetAssessmentResultEvidenceRequest{Id: testdata.MockAssessmentResult4ID},
+			},
+			wantRes: &orchestrator.GetAssessmentResultEvidenceResponse{
+				Result:    merged,
+				Evidences: []*evidence.Evidence{ev1, ev2},
+			},
+			wantErr: assert.Nil[error],
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				storage: tt.fields.storage,
+				authz:   tt.fields.authz,
+			}
+
+			gotRes, err := servicetest.Validated(svc.GetAssessmentResultEvidence)(context.Background(), tt.args.req)
+
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.wantRes, gotRes)
+		})
+	}
+}
+
 func TestService_ListAssessmentResults(t *testing.T) {
 	type fields struct {
 		storage persistence.Storage
@@ -408,6 +589,122 @@ func TestService_ListAssessmentResults(t *testing.T) {
 			},
 			wantErr: assert.NoError,
 		},
+		{
+			name: "return next page of assessment results ordered by metric id",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					assert.NoError(t, s.Create(orchestratortest.MockAssessmentResults))
+				}),
+				authz: servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				ctx: context.TODO(),
+				req: &orchestrator.ListAssessmentResultsRequest{
+					PageSize: 2,
+					PageToken: func() string {
+						b64, _ := (&api.PageToken{Size: 2, LastId: testdata.MockAssessmentResult4ID}).Encode()
+						return b64
+					}(),
+					OrderBy: "metric_id",
+					Asc:     false,
+				},
+			},
+			wantRes: &orchestrator.ListAssessmentResultsResponse{
+				Results: []*assessment.AssessmentResult{
+					orchestratortest.MockAssessmentResult3,
+					orchestratortest.MockAssessmentResult2,
+				},
+				NextPageToken: func() string {
+					b64, _ := (&api.PageToken{Size: 2, LastId: testdata.MockAssessmentResult2ID}).Encode()
+					return b64
+				}(),
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "invalid order by",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t),
+				authz:   servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				ctx: context.TODO(),
+				req: &orchestrator.ListAssessmentResultsRequest{
+					OrderBy: "non_compliance_comments; DROP TABLE assessment_results",
+				},
+			},
+			wantRes: nil,
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+				return assert.ErrorContains(t, err, "order_by: value must be in list")
+			},
+		},
+		{
+			name: "return filtered assessment results of a specific resource and evidence",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					assert.NoError(t, s.Create(orchestratortest.MockAssessmentResults))
+				}),
+				authz: servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				ctx: context.TODO(),
+				req: &orchestrator.ListAssessmentResultsRequest{
+					Filter: &orchestrator.Filter{
+						ResourceId: util.Ref(testdata.MockResourceID2),
+						EvidenceId: util.Ref(testdata.MockEvidenceID1),
+					},
+				},
+			},
+			wantRes: &orchestrator.ListAssessmentResultsResponse{
+				Results: []*assessment.AssessmentResult{
+					orchestratortest.MockAssessmentResult4,
+				},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "return filtered assessment results within time frame",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					assert.NoError(t, s.Create(orchestratortest.MockAssessmentResults))
+				}),
+				authz: servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				ctx: context.TODO(),
+				req: &orchestrator.ListAssessmentResultsRequest{
+					Filter: &orchestrator.Filter{
+						Since: timestamppb.New(time.Unix(1, 0)),
+						Until: timestamppb.New(time.Unix(1, 0)),
+					},
+				},
+			},
+			wantRes: &orchestrator.ListAssessmentResultsResponse{
+				Results: orchestratortest.MockAssessmentResults,
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "return filtered assessment results since a time without results",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					assert.NoError(t, s.Create(orchestratortest.MockAssessmentResults))
+				}),
+				authz: servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				ctx: context.TODO(),
+				req: &orchestrator.ListAssessmentResultsRequest{
+					LatestByResourceId: util.Ref(true),
+					Filter: &orchestrator.Filter{
+						Since: timestamppb.New(time.Unix(2, 0)),
+					},
+				},
+			},
+			wantRes: &orchestrator.ListAssessmentResultsResponse{},
+			wantErr: assert.NoError,
+		},
 		{
 			name: "return filtered non-compliant assessment results",
 			fields: fields{
@@ -582,7 +879,7 @@ func TestService_ListAssessmentResults(t *testing.T) {
 				storage: tt.fields.storage,
 				authz:   tt.fields.authz,
 			}
-			gotRes, err := svc.ListAssessmentResults(tt.args.ctx, tt.args.req)
+			gotRes, err := servicetest.Validated(svc.ListAssessmentResults)(tt.args.ctx, tt.args.req)
 			tt.wantErr(t, err)
 
 			if tt.wantRes == nil {
@@ -768,7 +1065,7 @@ func TestStoreAssessmentResult(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			s := NewService()
-			gotResp, err := s.StoreAssessmentResult(tt.args.in0, tt.args.assessment)
+			gotResp, err := servicetest.Validated(s.StoreAssessmentResult)(tt.args.in0, tt.args.assessment)
 			tt.wantErr(t, err)
 			assert.Equal(t, tt.wantResp, gotResp)
 
@@ -915,6 +1212,123 @@ func createStoreAssessmentResultRequestMockWithMissingMetricID(count int) []*orc
 }
 
 // createStoreAssessmentResultRequestMocks creates store assessment result requests with random assessment result IDs
+func TestStoreAssessmentResults_batchInterval(t *testing.T) {
+	s := NewService(WithAssessmentResultBatching(10, 10*time.Millisecond))
+	stream := newMockBlockingStreamer()
+
+	errc := make(chan error, 1)
+	go func() {
+		errc <- s.StoreAssessmentResults(stream)
+	}()
+
+	for _, req := range createStoreAssessmentResultRequestsMock(2) {
+		stream.requests <- req
+	}
+
+	// The batch is not full, but we should still receive our responses once the interval elapsed
+	for i := 0; i < 2; i++ {
+		select {
+		case res := <-stream.responses:
+			assert.True(t, res.Status)
+		case <-time.After(time.Second):
+			t.Fatal("timeout while waiting for response")
+		}
+	}
+
+	var results []*assessment.AssessmentResult
+	assert.NoError(t, s.storage.List(&results, "", true, 0, -1))
+	assert.Equal(t, 2, len(results))
+
+	close(stream.requests)
+	assert.NoError(t, <-errc)
+}
+
+func TestService_storeAssessmentResultBatch(t *testing.T) {
+	var (
+		valid        = createStoreAssessmentResultRequestsMock(3)
+		invalid      = createStoreAssessmentResultRequestMockWithMissingMetricID(1)[0]
+		otherService = createStoreAssessmentResultRequestsMock(1)[0]
+		existing     = createStoreAssessmentResultRequestsMock(1)[0]
+	)
+
+	otherService.Result.CloudServiceId = testdata.MockCloudServiceID2
+
+	type fields struct {
+		storage persistence.Storage
+		authz   service.AuthorizationStrategy
+	}
+	type args struct {
+		batch []*orchestrator.StoreAssessmentResultRequest
+	}
+	tests := []struct {
+		name          string
+		fields        fields
+		args          args
+		wantResponses []*orchestrator.StoreAssessmentResultsResponse
+		wantStored    int
+	}{
+		{
+			name: "all valid",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t),
+				authz:   servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{batch: valid},
+			wantResponses: []*orchestrator.StoreAssessmentResultsResponse{
+				{Status: true},
+				{Status: true},
+				{Status: true},
+			},
+			wantStored: 3,
+		},
+		{
+			name: "invalid and unauthorized results",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t),
+				authz:   servicetest.NewAuthorizationStrategy(false, testdata.MockCloudServiceID1),
+			},
+			args: args{batch: []*orchestrator.StoreAssessmentResultRequest{valid[0], invalid, otherService}},
+			wantResponses: []*orchestrator.StoreAssessmentResultsResponse{
+				{Status: true},
+				{Status: false, StatusMessage: api.Validate(invalid).Error()},
+				{Status: false, StatusMessage: service.ErrPermissionDenied.Error()},
+			},
+			wantStored: 1,
+		},
+		{
+			name: "already existing result",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					assert.NoError(t, s.Create(existing.Result))
+				}),
+				authz: servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{batch: []*orchestrator.StoreAssessmentResultRequest{valid[0], existing, valid[1]}},
+			wantResponses: []*orchestrator.StoreAssessmentResultsResponse{
+				{Status: true},
+				{Status: false, StatusMessage: status.Errorf(codes.Internal, "database error: %v", persistence.ErrUniqueConstraintFailed).Error()},
+				{Status: true},
+			},
+			wantStored: 3,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				storage: tt.fields.storage,
+				authz:   tt.fields.authz,
+			}
+
+			gotResponses := svc.storeAssessmentResultBatch(context.TODO(), tt.args.batch)
+			assert.Equal(t, tt.wantResponses, gotResponses)
+
+			var results []*assessment.AssessmentResult
+			assert.NoError(t, svc.storage.List(&results, "", true, 0, -1))
+			assert.Equal(t, tt.wantStored, len(results))
+		})
+	}
+}
+
 func createStoreAssessmentResultRequestsMock(count int) []*orchestrator.StoreAssessmentResultRequest {
 	var mockRequests []*orchestrator.StoreAssessmentResultRequest
 
@@ -1010,6 +1424,39 @@ func (mockStreamer) RecvMsg(_ interface{}) error {
 	panic("implement me")
 }
 
+// mockBlockingStreamer is a stream whose Recv blocks until a request is available. It returns io.EOF once the
+// requests channel is closed.
+type mockBlockingStreamer struct {
+	grpc.ServerStream
+	requests  chan *orchestrator.StoreAssessmentResultRequest
+	responses chan *orchestrator.StoreAssessmentResultsResponse
+}
+
+func newMockBlockingStreamer() *mockBlockingStreamer {
+	return &mockBlockingStreamer{
+		requests:  make(chan *orchestrator.StoreAssessmentResultRequest),
+		responses: make(chan *orchestrator.StoreAssessmentResultsResponse, 100),
+	}
+}
+
+func (m *mockBlockingStreamer) Send(res *orchestrator.StoreAssessmentResultsResponse) error {
+	m.responses <- res
+	return nil
+}
+
+func (m *mockBlockingStreamer) Recv() (*orchestrator.StoreAssessmentResultRequest, error) {
+	req, ok := <-m.requests
+	if !ok {
+		return nil, io.EOF
+	}
+
+	return req, nil
+}
+
+func (*mockBlockingStreamer) Context() context.Context {
+	return context.TODO()
+}
+
 type mockStreamerWithSendErr struct {
 	grpc.ServerStream
 	RecvToServer   chan *orchestrator.StoreAssessmentResultRequest
@@ -1079,3 +1526,53 @@ func createMockStreamWithRecvErr(requests []*orchestrator.StoreAssessmentResultR
 	m.SentFromServer = make(chan *orchestrator.StoreAssessmentResultsResponse, len(requests))
 	return m
 }
+
+func Test_startStoreAssessmentResultSpan(t *testing.T) {
+	otel.SetTracerProvider(sdktrace.NewTracerProvider())
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	// Simulate the span of the assessment, which sent the result
+	ctx, parent := telemetry.StartSpan(context.Background(), "assessment.AssessEvidence")
+	defer parent.End()
+
+	type args struct {
+		ctx context.Context
+		req *orchestrator.StoreAssessmentResultRequest
+	}
+	tests := []struct {
+		name        string
+		args        args
+		wantTraceID trace.TraceID
+	}{
+		{
+			name: "continue trace of request",
+			args: args{
+				ctx: context.Background(),
+				req: &orchestrator.StoreAssessmentResultRequest{
+					Result:       orchestratortest.MockAssessmentResult1,
+					TraceContext: telemetry.InjectTraceContext(ctx),
+				},
+			},
+			wantTraceID: parent.SpanContext().TraceID(),
+		},
+		{
+			name: "continue trace of context",
+			args: args{
+				ctx: ctx,
+				req: &orchestrator.StoreAssessmentResultRequest{
+					Result: orchestratortest.MockAssessmentResult1,
+				},
+			},
+			wantTraceID: parent.SpanContext().TraceID(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotCtx, gotSpan := startStoreAssessmentResultSpan(tt.args.ctx, tt.args.req)
+			defer gotSpan.End()
+
+			assert.Equal(t, tt.wantTraceID, gotSpan.SpanContext().TraceID())
+			assert.Equal(t, gotSpan.SpanContext(), trace.SpanContextFromContext(gotCtx))
+		})
+	}
+}
@@ -0,0 +1,204 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"clouditor.io/clouditor/v2/api/assessment"
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/persistence"
+	"clouditor.io/clouditor/v2/service"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetAssessmentStatistics is a method implementation of the orchestrator interface: It retrieves the statistics about
+// the assessment results of a cloud service. They are updated incrementally whenever results are stored, so that
+// dashboards do not need to aggregate the results themselves.
+func (svc *Service) GetAssessmentStatistics(ctx context.Context, req *orchestrator.GetAssessmentStatisticsRequest) (res *orchestrator.AssessmentStatistics, err error) {
+	// Check, if this request has access to the cloud service according to our authorization strategy.
+	if !svc.authz.CheckAccess(ctx, service.AccessRead, req) {
+		return nil, service.ErrPermissionDenied
+	}
+
+	res = new(orchestrator.AssessmentStatistics)
+
+	err = svc.storage.Get(res, "cloud_service_id = ?", req.CloudServiceId)
+	if errors.Is(err, persistence.ErrRecordNotFound) {
+		// No results were stored for this cloud service yet
+		res = &orchestrator.AssessmentStatistics{CloudServiceId: req.CloudServiceId}
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
+	err = svc.storage.List(&res.Metrics, "metric_id", true, 0, -1, "cloud_service_id = ?", req.CloudServiceId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
+	err = svc.storage.List(&res.Tools, "tool_id", true, 0, -1, "cloud_service_id = ?", req.CloudServiceId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
+	if res.Results > 0 {
+		res.ComplianceRatio = float64(res.CompliantResults) / float64(res.Results)
+	}
+
+	return res, nil
+}
+
+// updateAssessmentStatistics incrementally updates the assessment statistics of the cloud services with the given
+// (stored) assessment results. The results are aggregated first, so that each statistic is only updated once per
+// call, e.g., for a whole batch of results.
+func (svc *Service) updateAssessmentStatistics(results ...*assessment.AssessmentResult) {
+	var (
+		stats     = make(map[string]*orchestrator.AssessmentStatistics)
+		metrics   = make(map[[2]string]*orchestrator.MetricAssessmentStatistics)
+		tools     = make(map[[2]string]*orchestrator.ToolAssessmentStatistics)
+		resources = make(map[[2]string]time.Time)
+		err       error
+	)
+
+	for _, result := range results {
+		s, ok := stats[result.CloudServiceId]
+		if !ok {
+			s = &orchestrator.AssessmentStatistics{CloudServiceId: result.CloudServiceId}
+			stats[result.CloudServiceId] = s
+		}
+
+		m, ok := metrics[[2]string{result.CloudServiceId, result.MetricId}]
+		if !ok {
+			m = &orchestrator.MetricAssessmentStatistics{CloudServiceId: result.CloudServiceId, MetricId: result.MetricId}
+			metrics[[2]string{result.CloudServiceId, result.MetricId}] = m
+		}
+
+		s.Results++
+		m.Results++
+
+		if result.Compliant {
+			s.CompliantResults++
+			m.CompliantResults++
+		} else {
+			s.NonCompliantResults++
+			m.NonCompliantResults++
+		}
+
+		if result.ToolId != nil {
+			key := [2]string{result.CloudServiceId, result.GetToolId()}
+
+			t, ok := tools[key]
+			if !ok || t.LastEvidenceTimestamp.AsTime().Before(result.Timestamp.AsTime()) {
+				tools[key] = &orchestrator.ToolAssessmentStatistics{
+					CloudServiceId:        result.CloudServiceId,
+					ToolId:                result.GetToolId(),
+					LastEvidenceTimestamp: result.Timestamp,
+				}
+			}
+		}
+
+		key := [2]string{result.CloudServiceId, result.ResourceId}
+		if seen := result.Timestamp.AsTime(); seen.After(resources[key]) {
+			resources[key] = seen
+		}
+	}
+
+	svc.statisticsMutex.Lock()
+	defer svc.statisticsMutex.Unlock()
+
+	// A resource is only counted, if it was not assessed before
+	for key, seen := range resources {
+		isNew, err := svc.markResourceSeen(key[0], key[1], seen)
+		if err != nil {
+			log.Errorf("Could not record assessed resource %s: %v", key[1], err)
+		} else if isNew {
+			stats[key[0]].AssessedResources++
+		}
+	}
+
+	for _, delta := range stats {
+		var s orchestrator.AssessmentStatistics
+
+		err = svc.storage.Get(&s, "cloud_service_id = ?", delta.CloudServiceId)
+		if err != nil && !errors.Is(err, persistence.ErrRecordNotFound) {
+			log.Errorf("Could not retrieve assessment statistics of cloud service %s: %v", delta.CloudServiceId, err)
+			continue
+		}
+
+		s.CloudServiceId = delta.CloudServiceId
+		s.AssessedResources += delta.AssessedResources
+		s.Results += delta.Results
+		s.CompliantResults += delta.CompliantResults
+		s.NonCompliantResults += delta.NonCompliantResults
+
+		if err = svc.storage.Save(&s, "cloud_service_id = ?", s.CloudServiceId); err != nil {
+			log.Errorf("Could not update assessment statistics of cloud service %s: %v", s.CloudServiceId, err)
+		}
+	}
+
+	for _, delta := range metrics {
+		var m orchestrator.MetricAssessmentStatistics
+
+		err = svc.storage.Get(&m, "cloud_service_id = ? AND metric_id = ?", delta.CloudServiceId, delta.MetricId)
+		if err != nil && !errors.Is(err, persistence.ErrRecordNotFound) {
+			log.Errorf("Could not retrieve assessment statistics of metric %s: %v", delta.MetricId, err)
+			continue
+		}
+
+		m.CloudServiceId = delta.CloudServiceId
+		m.MetricId = delta.MetricId
+		m.Results += delta.Results
+		m.CompliantResults += delta.CompliantResults
+		m.NonCompliantResults += delta.NonCompliantResults
+
+		if err = svc.storage.Save(&m, "cloud_service_id = ? AND metric_id = ?", m.CloudServiceId, m.MetricId); err != nil {
+			log.Errorf("Could not update assessment statistics of metric %s: %v", m.MetricId, err)
+		}
+	}
+
+	for _, latest := range tools {
+		var t orchestrator.ToolAssessmentStatistics
+
+		err = svc.storage.Get(&t, "cloud_service_id = ? AND tool_id = ?", latest.CloudServiceId, latest.ToolId)
+		if err != nil && !errors.Is(err, persistence.ErrRecordNotFound) {
+			log.Errorf("Could not retrieve assessment statistics of tool %s: %v", latest.ToolId, err)
+			continue
+		}
+
+		// Results might be stored out of order, e.g., if they are sent by multiple assessment instances
+		if t.LastEvidenceTimestamp != nil && !t.LastEvidenceTimestamp.AsTime().Before(latest.LastEvidenceTimestamp.AsTime()) {
+			continue
+		}
+
+		if err = svc.storage.Save(latest, "cloud_service_id = ? AND tool_id = ?", latest.CloudServiceId, latest.ToolId); err != nil {
+			log.Errorf("Could not update assessment statistics of tool %s: %v", latest.ToolId, err)
+		}
+	}
+}
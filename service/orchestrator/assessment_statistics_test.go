@@ -0,0 +1,187 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package orchestrator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"clouditor.io/clouditor/v2/api/assessment"
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/testutil/servicetest"
+	"clouditor.io/clouditor/v2/internal/testutil/servicetest/orchestratortest"
+	"clouditor.io/clouditor/v2/persistence"
+	"clouditor.io/clouditor/v2/service"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestService_GetAssessmentStatistics(t *testing.T) {
+	type fields struct {
+		storage persistence.Storage
+		authz   service.AuthorizationStrategy
+	}
+	type args struct {
+		ctx context.Context
+		req *orchestrator.GetAssessmentStatisticsRequest
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    assert.Want[*orchestrator.AssessmentStatistics]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "validation error",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t),
+				authz:   servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &orchestrator.GetAssessmentStatisticsRequest{},
+			},
+			want: assert.Nil[*orchestrator.AssessmentStatistics],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "cloud_service_id: value is empty")
+			},
+		},
+		{
+			name: "permission denied",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t),
+				authz:   servicetest.NewAuthorizationStrategy(false, testdata.MockCloudServiceID2),
+			},
+			args: args{
+				req: &orchestrator.GetAssessmentStatisticsRequest{
+					CloudServiceId: testdata.MockCloudServiceID1,
+				},
+			},
+			want: assert.Nil[*orchestrator.AssessmentStatistics],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, service.ErrPermissionDenied)
+			},
+		},
+		{
+			name: "no results yet",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t),
+				authz:   servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &orchestrator.GetAssessmentStatisticsRequest{
+					CloudServiceId: testdata.MockCloudServiceID1,
+				},
+			},
+			want: func(t *testing.T, got *orchestrator.AssessmentStatistics) bool {
+				return assert.Equal(t, &orchestrator.AssessmentStatistics{
+					CloudServiceId: testdata.MockCloudServiceID1,
+				}, got)
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "happy path",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					svc := &Service{storage: s}
+
+					// The second result of the first resource must not increase the number of assessed resources
+					svc.updateAssessmentStatistics(orchestratortest.MockAssessmentResult1, orchestratortest.MockAssessmentResult2)
+					svc.updateAssessmentStatistics(orchestratortest.MockAssessmentResult3, mockStorageAssessmentResult)
+
+					// An older result must not change the timestamp of the last evidence
+					svc.updateAssessmentStatistics(&assessment.AssessmentResult{
+						Timestamp:      timestamppb.New(time.Unix(0, 0)),
+						CloudServiceId: testdata.MockCloudServiceID1,
+						MetricId:       testdata.MockMetricID2,
+						Compliant:      true,
+						ResourceId:     testdata.MockResourceID1,
+						ToolId:         orchestratortest.MockAssessmentResult1.ToolId,
+					})
+				}),
+				authz: servicetest.NewAuthorizationStrategy(false, testdata.MockCloudServiceID1),
+			},
+			args: args{
+				req: &orchestrator.GetAssessmentStatisticsRequest{
+					CloudServiceId: testdata.MockCloudServiceID1,
+				},
+			},
+			want: func(t *testing.T, got *orchestrator.AssessmentStatistics) bool {
+				want := &orchestrator.AssessmentStatistics{
+					CloudServiceId:      testdata.MockCloudServiceID1,
+					AssessedResources:   2,
+					Results:             4,
+					CompliantResults:    3,
+					NonCompliantResults: 1,
+					ComplianceRatio:     0.75,
+					Metrics: []*orchestrator.MetricAssessmentStatistics{
+						{
+							CloudServiceId:   testdata.MockCloudServiceID1,
+							MetricId:         testdata.MockMetricID1,
+							Results:          2,
+							CompliantResults: 2,
+						},
+						{
+							CloudServiceId:      testdata.MockCloudServiceID1,
+							MetricId:            testdata.MockMetricID2,
+							Results:             2,
+							CompliantResults:    1,
+							NonCompliantResults: 1,
+						},
+					},
+					Tools: []*orchestrator.ToolAssessmentStatistics{
+						{
+							CloudServiceId:        testdata.MockCloudServiceID1,
+							ToolId:                assessment.AssessmentToolId,
+							LastEvidenceTimestamp: timestamppb.New(time.Unix(1, 0)),
+						},
+					},
+				}
+
+				return assert.Equal(t, want, got)
+			},
+			wantErr: assert.Nil[error],
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				storage: tt.fields.storage,
+				authz:   tt.fields.authz,
+			}
+			got, err := servicetest.Validated(svc.GetAssessmentStatistics)(tt.args.ctx, tt.args.req)
+
+			tt.wantErr(t, err)
+			tt.want(t, got)
+		})
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package orchestrator
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"clouditor.io/clouditor/v2/api/assessment"
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/service"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetResourceAssessmentSummary is a method implementation of the orchestrator interface: It aggregates the latest
+// assessment results of a single resource, so that clients do not need to retrieve all of its results.
+func (svc *Service) GetResourceAssessmentSummary(ctx context.Context, req *orchestrator.GetResourceAssessmentSummaryRequest) (res *orchestrator.ResourceAssessmentSummary, err error) {
+	var results []*assessment.AssessmentResult
+
+	// Check, if this request has access to the cloud service according to our authorization strategy.
+	if !svc.authz.CheckAccess(ctx, service.AccessRead, req) {
+		return nil, service.ErrPermissionDenied
+	}
+
+	results, err = svc.latestAssessmentResults([]string{"cloud_service_id = ?", "resource_id = ?"},
+		[]any{req.CloudServiceId, req.ResourceId})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
+	if len(results) == 0 {
+		return nil, status.Errorf(codes.NotFound, "no assessment results found for resource")
+	}
+
+	slices.SortFunc(results, func(a, b *assessment.AssessmentResult) int {
+		return strings.Compare(a.MetricId, b.MetricId)
+	})
+
+	res = &orchestrator.ResourceAssessmentSummary{
+		CloudServiceId: req.CloudServiceId,
+		ResourceId:     req.ResourceId,
+		LatestResults:  results,
+	}
+
+	for _, result := range results {
+		if result.Compliant {
+			res.Compliant++
+		} else {
+			res.NonCompliant++
+		}
+
+		// The resource types of the latest result are the most accurate ones
+		if res.LastAssessed == nil || result.Timestamp.AsTime().After(res.LastAssessed.AsTime()) {
+			res.LastAssessed = result.Timestamp
+			res.ResourceTypes = result.ResourceTypes
+		}
+	}
+
+	return res, nil
+}
+
+// ListResourceTypeAssessmentSummaries is a method implementation of the orchestrator interface: It aggregates the
+// latest assessment results of a cloud service per resource type.
+func (svc *Service) ListResourceTypeAssessmentSummaries(ctx context.Context, req *orchestrator.ListResourceTypeAssessmentSummariesRequest) (res *orchestrator.ListResourceTypeAssessmentSummariesResponse, err error) {
+	var (
+		results   []*assessment.AssessmentResult
+		summaries = make(map[string]*orchestrator.ResourceTypeAssessmentSummary)
+		// nonCompliant contains whether a resource has at least one non-compliant result
+		nonCompliant = make(map[string]bool)
+		// resourceType contains the (most specific) type of each resource
+		resourceType = make(map[string]string)
+	)
+
+	// Check, if this request has access to the cloud service according to our authorization strategy.
+	if !svc.authz.CheckAccess(ctx, service.AccessRead, req) {
+		return nil, service.ErrPermissionDenied
+	}
+
+	results, err = svc.latestAssessmentResults([]string{"cloud_service_id = ?"}, []any{req.CloudServiceId})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
+	for _, result := range results {
+		typ := "Unknown"
+		if len(result.ResourceTypes) > 0 {
+			typ = result.ResourceTypes[0]
+		}
+
+		summary, ok := summaries[typ]
+		if !ok {
+			summary = &orchestrator.ResourceTypeAssessmentSummary{ResourceType: typ}
+			summaries[typ] = summary
+		}
+
+		if result.Compliant {
+			summary.CompliantResults++
+		} else {
+			summary.NonCompliantResults++
+			nonCompliant[result.ResourceId] = true
+		}
+
+		resourceType[result.ResourceId] = typ
+	}
+
+	// Count the resources per type
+	for id, typ := range resourceType {
+		summary := summaries[typ]
+		summary.Resources++
+
+		if nonCompliant[id] {
+			summary.NonCompliantResources++
+		} else {
+			summary.CompliantResources++
+		}
+	}
+
+	res = &orchestrator.ListResourceTypeAssessmentSummariesResponse{
+		Summaries: make([]*orchestrator.ResourceTypeAssessmentSummary, 0, len(summaries)),
+	}
+
+	for _, summary := range summaries {
+		res.Summaries = append(res.Summaries, summary)
+	}
+
+	slices.SortFunc(res.Summaries, func(a, b *orchestrator.ResourceTypeAssessmentSummary) int {
+		return strings.Compare(a.ResourceType, b.ResourceType)
+	})
+
+	return res, nil
+}
@@ -0,0 +1,289 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package orchestrator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"clouditor.io/clouditor/v2/api/assessment"
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/testutil/servicetest"
+	"clouditor.io/clouditor/v2/internal/testutil/servicetest/orchestratortest"
+	"clouditor.io/clouditor/v2/persistence"
+	"clouditor.io/clouditor/v2/service"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	// mockOutdatedAssessmentResult is an older (non-compliant) result for the same resource and metric as
+	// [orchestratortest.MockAssessmentResult1] and must therefore not be part of any summary.
+	mockOutdatedAssessmentResult = &assessment.AssessmentResult{
+		Id:             "55555555-5555-5555-5555-555555555555",
+		Timestamp:      timestamppb.New(time.Unix(0, 0)),
+		CloudServiceId: testdata.MockCloudServiceID1,
+		MetricId:       testdata.MockMetricID1,
+		Compliant:      false,
+		EvidenceId:     testdata.MockEvidenceID1,
+		ResourceId:     testdata.MockResourceID1,
+		ResourceTypes:  []string{"Resource"},
+	}
+	mockStorageAssessmentResult = &assessment.AssessmentResult{
+		Id:             "66666666-6666-6666-6666-666666666666",
+		Timestamp:      timestamppb.New(time.Unix(2, 0)),
+		CloudServiceId: testdata.MockCloudServiceID1,
+		MetricId:       testdata.MockMetricID1,
+		Compliant:      true,
+		EvidenceId:     testdata.MockEvidenceID1,
+		ResourceId:     testdata.MockResourceID2,
+		ResourceTypes:  []string{"ObjectStorage", "Storage", "Resource"},
+	}
+)
+
+func TestService_GetResourceAssessmentSummary(t *testing.T) {
+	type fields struct {
+		storage persistence.Storage
+		authz   service.AuthorizationStrategy
+	}
+	type args struct {
+		ctx context.Context
+		req *orchestrator.GetResourceAssessmentSummaryRequest
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    assert.Want[*orchestrator.ResourceAssessmentSummary]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "validation error",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t),
+				authz:   servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &orchestrator.GetResourceAssessmentSummaryRequest{},
+			},
+			want: assert.Nil[*orchestrator.ResourceAssessmentSummary],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "resource_id: value length must be at least 1 characters")
+			},
+		},
+		{
+			name: "permission denied",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t),
+				authz:   servicetest.NewAuthorizationStrategy(false, testdata.MockCloudServiceID2),
+			},
+			args: args{
+				req: &orchestrator.GetResourceAssessmentSummaryRequest{
+					CloudServiceId: testdata.MockCloudServiceID1,
+					ResourceId:     testdata.MockResourceID1,
+				},
+			},
+			want: assert.Nil[*orchestrator.ResourceAssessmentSummary],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, service.ErrPermissionDenied)
+			},
+		},
+		{
+			name: "resource not found",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					assert.NoError(t, s.Create(orchestratortest.MockAssessmentResults))
+				}),
+				authz: servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &orchestrator.GetResourceAssessmentSummaryRequest{
+					CloudServiceId: testdata.MockCloudServiceID1,
+					ResourceId:     "unknown",
+				},
+			},
+			want: assert.Nil[*orchestrator.ResourceAssessmentSummary],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.Equal(t, codes.NotFound, status.Code(err))
+			},
+		},
+		{
+			name: "happy path",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					assert.NoError(t, s.Create(orchestratortest.MockAssessmentResults))
+					assert.NoError(t, s.Create(mockOutdatedAssessmentResult))
+				}),
+				authz: servicetest.NewAuthorizationStrategy(false, testdata.MockCloudServiceID1),
+			},
+			args: args{
+				req: &orchestrator.GetResourceAssessmentSummaryRequest{
+					CloudServiceId: testdata.MockCloudServiceID1,
+					ResourceId:     testdata.MockResourceID1,
+				},
+			},
+			want: func(t *testing.T, got *orchestrator.ResourceAssessmentSummary) bool {
+				want := &orchestrator.ResourceAssessmentSummary{
+					CloudServiceId: testdata.MockCloudServiceID1,
+					ResourceId:     testdata.MockResourceID1,
+					ResourceTypes:  []string{"Resource"},
+					LatestResults: []*assessment.AssessmentResult{
+						orchestratortest.MockAssessmentResult1,
+						orchestratortest.MockAssessmentResult3,
+					},
+					Compliant:    1,
+					NonCompliant: 1,
+					LastAssessed: timestamppb.New(time.Unix(1, 0)),
+				}
+
+				return assert.Equal(t, want, got)
+			},
+			wantErr: assert.Nil[error],
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				storage: tt.fields.storage,
+				authz:   tt.fields.authz,
+			}
+			got, err := servicetest.Validated(svc.GetResourceAssessmentSummary)(tt.args.ctx, tt.args.req)
+
+			tt.wantErr(t, err)
+			tt.want(t, got)
+		})
+	}
+}
+
+func TestService_ListResourceTypeAssessmentSummaries(t *testing.T) {
+	type fields struct {
+		storage persistence.Storage
+		authz   service.AuthorizationStrategy
+	}
+	type args struct {
+		ctx context.Context
+		req *orchestrator.ListResourceTypeAssessmentSummariesRequest
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    assert.Want[*orchestrator.ListResourceTypeAssessmentSummariesResponse]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "validation error",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t),
+				authz:   servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &orchestrator.ListResourceTypeAssessmentSummariesRequest{},
+			},
+			want: assert.Nil[*orchestrator.ListResourceTypeAssessmentSummariesResponse],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "cloud_service_id: value is empty")
+			},
+		},
+		{
+			name: "permission denied",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t),
+				authz:   servicetest.NewAuthorizationStrategy(false, testdata.MockCloudServiceID2),
+			},
+			args: args{
+				req: &orchestrator.ListResourceTypeAssessmentSummariesRequest{
+					CloudServiceId: testdata.MockCloudServiceID1,
+				},
+			},
+			want: assert.Nil[*orchestrator.ListResourceTypeAssessmentSummariesResponse],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, service.ErrPermissionDenied)
+			},
+		},
+		{
+			name: "happy path",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					assert.NoError(t, s.Create(orchestratortest.MockAssessmentResults))
+					assert.NoError(t, s.Create(mockOutdatedAssessmentResult))
+					assert.NoError(t, s.Create(mockStorageAssessmentResult))
+				}),
+				authz: servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &orchestrator.ListResourceTypeAssessmentSummariesRequest{
+					CloudServiceId: testdata.MockCloudServiceID1,
+				},
+			},
+			want: func(t *testing.T, got *orchestrator.ListResourceTypeAssessmentSummariesResponse) bool {
+				want := &orchestrator.ListResourceTypeAssessmentSummariesResponse{
+					Summaries: []*orchestrator.ResourceTypeAssessmentSummary{
+						{
+							ResourceType:          "ObjectStorage",
+							Resources:             1,
+							CompliantResources:    1,
+							NonCompliantResources: 0,
+							CompliantResults:      1,
+							NonCompliantResults:   0,
+						},
+						{
+							ResourceType:          "Resource",
+							Resources:             1,
+							CompliantResources:    0,
+							NonCompliantResources: 1,
+							CompliantResults:      1,
+							NonCompliantResults:   1,
+						},
+					},
+				}
+
+				return assert.Equal(t, want, got)
+			},
+			wantErr: assert.Nil[error],
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				storage: tt.fields.storage,
+				authz:   tt.fields.authz,
+			}
+			got, err := servicetest.Validated(svc.ListResourceTypeAssessmentSummaries)(tt.args.ctx, tt.args.req)
+
+			tt.wantErr(t, err)
+			tt.want(t, got)
+		})
+	}
+}
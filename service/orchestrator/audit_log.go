@@ -0,0 +1,219 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package orchestrator
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/internal/pseudonym"
+	"clouditor.io/clouditor/v2/persistence"
+	"clouditor.io/clouditor/v2/service"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// auditLogCSVHeader contains the columns of the CSV export of the audit log.
+var auditLogCSVHeader = []string{"id", "timestamp", "user_id", "method", "cloud_service_id", "code", "message", "peer", "duration_ms"}
+
+// RecordAuditLogEntry implements server.AuditLogRecorder. It persists the entry in the audit log storage.
+func (svc *Service) RecordAuditLogEntry(_ context.Context, entry *orchestrator.AuditLogEntry) (err error) {
+	if entry.Id == "" {
+		entry.Id = uuid.NewString()
+	}
+
+	if entry.Timestamp == nil {
+		entry.Timestamp = timestamppb.Now()
+	}
+
+	return svc.auditStorage.Create(entry)
+}
+
+// ListAuditLogEntries implements method for listing the entries of the audit log.
+func (svc *Service) ListAuditLogEntries(ctx context.Context, req *orchestrator.ListAuditLogEntriesRequest) (res *orchestrator.ListAuditLogEntriesResponse, err error) {
+	var conds []any
+
+	conds = svc.auditLogConds(ctx, req.Filter)
+
+	res = new(orchestrator.ListAuditLogEntriesResponse)
+
+	res.Entries, res.NextPageToken, err = service.PaginateStorage[*orchestrator.AuditLogEntry](req, svc.auditStorage,
+		service.DefaultPaginationOpts, conds...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not paginate results: %v", err)
+	}
+
+	return
+}
+
+// ExportAuditLog implements method for exporting all entries of the audit log that match the filter, ordered by their
+// timestamp. If requested, the IP addresses and account IDs contained in the peers and messages are pseudonymized.
+func (svc *Service) ExportAuditLog(ctx context.Context, req *orchestrator.ExportAuditLogRequest) (res *orchestrator.ExportAuditLogResponse, err error) {
+	var entries []*orchestrator.AuditLogEntry
+
+	if req.Pseudonymize && svc.pseudonymizer == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "%v", pseudonym.ErrNotConfigured)
+	}
+
+	err = svc.auditStorage.List(&entries, "timestamp", true, 0, -1, svc.auditLogConds(ctx, req.Filter)...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
+	if req.Pseudonymize {
+		for _, entry := range entries {
+			if entry.Peer != nil {
+				*entry.Peer = svc.pseudonymizer.Text(*entry.Peer)
+			}
+
+			if entry.Message != nil {
+				*entry.Message = svc.pseudonymizer.Text(*entry.Message)
+			}
+		}
+	}
+
+	res = new(orchestrator.ExportAuditLogResponse)
+
+	if req.Format == "csv" {
+		res.Data, err = auditLogToCSV(entries)
+	} else {
+		res.Data, err = auditLogToJSON(entries)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not export audit log: %v", err)
+	}
+
+	return res, nil
+}
+
+// auditLogConds builds the storage conditions for the filter. Unless the user has access to all cloud services, only
+// entries of the cloud services the user has access to are included.
+func (svc *Service) auditLogConds(ctx context.Context, filter *orchestrator.AuditLogFilter) []any {
+	var (
+		query []string
+		args  []any
+	)
+
+	if filter != nil {
+		if filter.UserId != nil {
+			query = append(query, "user_id = ?")
+			args = append(args, filter.GetUserId())
+		}
+
+		if filter.CloudServiceId != nil {
+			query = append(query, "cloud_service_id = ?")
+			args = append(args, filter.GetCloudServiceId())
+		}
+
+		if filter.Method != nil {
+			query = append(query, "method = ?")
+			args = append(args, filter.GetMethod())
+		}
+
+		if filter.Code != nil {
+			query = append(query, "code = ?")
+			args = append(args, filter.GetCode())
+		}
+
+		if filter.Since != nil {
+			query = append(query, "timestamp >= ?")
+			args = append(args, filter.Since.AsTime())
+		}
+
+		if filter.Until != nil {
+			query = append(query, "timestamp <= ?")
+			args = append(args, filter.Until.AsTime())
+		}
+	}
+
+	// Retrieve list of allowed cloud service according to our authorization strategy
+	all, allowed := svc.authz.AllowedCloudServices(ctx)
+	if !all {
+		query = append(query, "cloud_service_id IN ?")
+		args = append(args, allowed)
+	}
+
+	return persistence.BuildConds(query, args)
+}
+
+// auditLogToJSON exports the entries as a JSON array.
+func auditLogToJSON(entries []*orchestrator.AuditLogEntry) (data []byte, err error) {
+	var list = make([]json.RawMessage, 0, len(entries))
+
+	for _, entry := range entries {
+		b, err := protojson.Marshal(entry)
+		if err != nil {
+			return nil, err
+		}
+
+		list = append(list, b)
+	}
+
+	return json.MarshalIndent(list, "", "  ")
+}
+
+// auditLogToCSV exports the entries as CSV with the columns of auditLogCSVHeader.
+func auditLogToCSV(entries []*orchestrator.AuditLogEntry) (data []byte, err error) {
+	var (
+		buf bytes.Buffer
+		w   = csv.NewWriter(&buf)
+	)
+
+	err = w.Write(auditLogCSVHeader)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range entries {
+		err = w.Write([]string{
+			entry.Id,
+			entry.Timestamp.AsTime().Format(time.RFC3339Nano),
+			entry.UserId,
+			entry.Method,
+			entry.GetCloudServiceId(),
+			entry.Code,
+			entry.GetMessage(),
+			entry.GetPeer(),
+			strconv.FormatInt(entry.DurationMs, 10),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	w.Flush()
+
+	return buf.Bytes(), w.Error()
+}
@@ -0,0 +1,201 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package orchestrator
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/internal/pseudonym"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/testutil/servicetest"
+	"clouditor.io/clouditor/v2/internal/util"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// newMockAuditLogService creates a service with an audit log that contains a successful call for cloud service 1, a
+// denied call for cloud service 2 and a call without a cloud service.
+func newMockAuditLogService(t *testing.T) *Service {
+	svc := &Service{
+		storage:      testutil.NewInMemoryStorage(t),
+		auditStorage: testutil.NewInMemoryStorage(t),
+		authz:        servicetest.NewAuthorizationStrategy(true),
+	}
+
+	now := time.Now()
+
+	for _, entry := range []*orchestrator.AuditLogEntry{
+		{
+			Timestamp:      timestamppb.New(now.Add(-2 * time.Hour)),
+			UserId:         "alice",
+			Method:         "/clouditor.orchestrator.v1.Orchestrator/UpdateCloudService",
+			CloudServiceId: util.Ref(testdata.MockCloudServiceID1),
+			Code:           "OK",
+		},
+		{
+			Timestamp:      timestamppb.New(now.Add(-time.Hour)),
+			UserId:         "bob",
+			Method:         "/clouditor.orchestrator.v1.Orchestrator/RemoveCloudService",
+			CloudServiceId: util.Ref(testdata.MockCloudServiceID2),
+			Code:           "PermissionDenied",
+			Message:        util.Ref("access denied"),
+			Peer:           util.Ref("10.0.0.1:51234"),
+		},
+		{
+			Timestamp: timestamppb.New(now),
+			UserId:    "alice",
+			Method:    "/clouditor.orchestrator.v1.Orchestrator/ListCloudServices",
+			Code:      "OK",
+		},
+	} {
+		assert.NoError(t, svc.RecordAuditLogEntry(context.Background(), entry))
+		assert.NotEmpty(t, entry.Id)
+	}
+
+	return svc
+}
+
+func TestService_ListAuditLogEntries(t *testing.T) {
+	type args struct {
+		req *orchestrator.ListAuditLogEntriesRequest
+	}
+	tests := []struct {
+		name    string
+		allowed []string
+		args    args
+		want    int
+	}{
+		{
+			name: "all entries",
+			args: args{req: &orchestrator.ListAuditLogEntriesRequest{}},
+			want: 3,
+		},
+		{
+			name: "filter by user",
+			args: args{req: &orchestrator.ListAuditLogEntriesRequest{
+				Filter: &orchestrator.AuditLogFilter{UserId: util.Ref("alice")},
+			}},
+			want: 2,
+		},
+		{
+			name: "filter by code and cloud service",
+			args: args{req: &orchestrator.ListAuditLogEntriesRequest{
+				Filter: &orchestrator.AuditLogFilter{
+					Code:           util.Ref("PermissionDenied"),
+					CloudServiceId: util.Ref(testdata.MockCloudServiceID2),
+				},
+			}},
+			want: 1,
+		},
+		{
+			name: "filter by time",
+			args: args{req: &orchestrator.ListAuditLogEntriesRequest{
+				Filter: &orchestrator.AuditLogFilter{
+					Since: timestamppb.New(time.Now().Add(-90 * time.Minute)),
+				},
+			}},
+			want: 2,
+		},
+		{
+			name:    "only allowed cloud services",
+			allowed: []string{testdata.MockCloudServiceID1},
+			args:    args{req: &orchestrator.ListAuditLogEntriesRequest{}},
+			want:    1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := newMockAuditLogService(t)
+			if tt.allowed != nil {
+				svc.authz = servicetest.NewAuthorizationStrategy(false, tt.allowed...)
+			}
+
+			res, err := svc.ListAuditLogEntries(context.Background(), tt.args.req)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, len(res.Entries))
+		})
+	}
+}
+
+func TestService_ExportAuditLog(t *testing.T) {
+	svc := newMockAuditLogService(t)
+
+	// JSON is the default format
+	res, err := servicetest.Validated(svc.ExportAuditLog)(context.Background(), &orchestrator.ExportAuditLogRequest{})
+	assert.NoError(t, err)
+
+	var list []map[string]any
+	assert.NoError(t, json.Unmarshal(res.Data, &list))
+	assert.Equal(t, 3, len(list))
+	assert.Equal[any](t, "bob", list[1]["userId"])
+
+	res, err = svc.ExportAuditLog(context.Background(), &orchestrator.ExportAuditLogRequest{
+		Format: "csv",
+		Filter: &orchestrator.AuditLogFilter{UserId: util.Ref("alice")},
+	})
+	assert.NoError(t, err)
+
+	rows, err := csv.NewReader(strings.NewReader(string(res.Data))).ReadAll()
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(rows))
+	assert.Equal(t, auditLogCSVHeader, rows[0])
+	assert.Equal(t, "/clouditor.orchestrator.v1.Orchestrator/UpdateCloudService", rows[1][3])
+
+	_, err = servicetest.Validated(svc.ExportAuditLog)(context.Background(), &orchestrator.ExportAuditLogRequest{Format: "xml"})
+	assert.ErrorContains(t, err, "format")
+}
+
+func TestService_ExportAuditLog_pseudonymize(t *testing.T) {
+	svc := newMockAuditLogService(t)
+
+	// Pseudonymization needs to be configured
+	_, err := svc.ExportAuditLog(context.Background(), &orchestrator.ExportAuditLogRequest{Pseudonymize: true})
+	assert.ErrorContains(t, err, pseudonym.ErrNotConfigured.Error())
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	svc.pseudonymizer, err = pseudonym.New("my-salt")
+	assert.NoError(t, err)
+
+	res, err := svc.ExportAuditLog(context.Background(), &orchestrator.ExportAuditLogRequest{Format: "csv", Pseudonymize: true})
+	assert.NoError(t, err)
+
+	rows, err := csv.NewReader(strings.NewReader(string(res.Data))).ReadAll()
+	assert.NoError(t, err)
+	assert.Equal(t, 4, len(rows))
+	assert.Equal(t, svc.pseudonymizer.Pseudonym(pseudonym.FieldIP, "10.0.0.1")+":51234", rows[2][7])
+	assert.Equal(t, "access denied", rows[2][6])
+	assert.Equal(t, "", rows[1][7])
+}
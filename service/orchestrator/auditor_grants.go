@@ -0,0 +1,300 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package orchestrator
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"clouditor.io/clouditor/v2/api"
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/internal/logging"
+	"clouditor.io/clouditor/v2/persistence"
+	"clouditor.io/clouditor/v2/persistence/gorm"
+	"clouditor.io/clouditor/v2/service"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// DefaultMaxAuditorGrantDuration is the default maximum time an auditor grant can be valid for.
+const DefaultMaxAuditorGrantDuration = 30 * 24 * time.Hour
+
+var (
+	// ErrAuditorGrantNotFound indicates the auditor grant was not found
+	ErrAuditorGrantNotFound = api.ErrorCodeAuditorGrantNotFound.Err()
+
+	// ErrInvalidAuditorGrant indicates that the token of an auditor grant is malformed, unknown, expired, revoked or
+	// has a wrong secret
+	ErrInvalidAuditorGrant = errors.New("invalid auditor grant")
+)
+
+// WithAuditorGrantURL is an option to configure the URL of a UI, which is used to build a shareable link for new
+// auditor grants. The token is appended as fragment ("#grant=<token>"), so that it is not sent to the server hosting
+// the UI and does not end up in its access logs.
+func WithAuditorGrantURL(u string) ServiceOption {
+	return func(s *Service) {
+		s.auditorGrantURL = u
+	}
+}
+
+// WithMaxAuditorGrantDuration is an option to configure the maximum time an auditor grant can be valid for. A value
+// of 0 removes the limit.
+func WithMaxAuditorGrantDuration(d time.Duration) ServiceOption {
+	return func(s *Service) {
+		s.maxAuditorGrantDuration = d
+	}
+}
+
+// CreateAuditorGrant implements method for creating a new auditor grant for a target of evaluation. The user needs to
+// be an admin for the cloud service of the grant. The token is only returned here.
+func (svc *Service) CreateAuditorGrant(ctx context.Context, req *orchestrator.CreateAuditorGrantRequest) (res *orchestrator.CreateAuditorGrantResponse, err error) {
+	var (
+		grant = req.AuditorGrant
+		now   = time.Now()
+	)
+
+	if !grant.IsActive(now) {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", orchestrator.ErrAuditorGrantExpired)
+	}
+
+	if svc.maxAuditorGrantDuration > 0 && grant.ExpiresAt.AsTime().After(now.Add(svc.maxAuditorGrantDuration)) {
+		return nil, status.Errorf(codes.InvalidArgument, "an auditor grant must not be valid for more than %s", svc.maxAuditorGrantDuration)
+	}
+
+	if !svc.isAdmin(ctx, grant.CloudServiceId) {
+		return nil, service.ErrPermissionDenied
+	}
+
+	// Auditors can only be granted access to a catalog the cloud service is evaluated against
+	err = svc.storage.Get(&orchestrator.TargetOfEvaluation{}, gorm.WithoutPreload(), "cloud_service_id = ? AND catalog_id = ?", grant.CloudServiceId, grant.CatalogId)
+	if errors.Is(err, persistence.ErrRecordNotFound) {
+		return nil, api.ErrorCodeToENotFound.Err()
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
+	// Generate a new ID and secret
+	grant.Id = uuid.NewString()
+	grant.CreatedAt = timestamppb.New(now)
+	grant.RevokedAt = nil
+	grant.CreatedBy, _ = svc.currentUser(ctx)
+
+	token, err := newSecret(grant.Id, &grant.SecretHash)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not generate token: %v", err)
+	}
+
+	// Persist the new auditor grant in our database
+	err = svc.storage.Create(grant)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not add auditor grant to the database: %v", err)
+	}
+
+	grant.SecretHash = ""
+
+	logging.LogRequest(log, logrus.InfoLevel, logging.Create, req, fmt.Sprintf("for catalog '%s' until %s", grant.CatalogId,
+		grant.ExpiresAt.AsTime().Format(time.RFC3339)))
+
+	res = &orchestrator.CreateAuditorGrantResponse{AuditorGrant: grant, Token: token}
+
+	if svc.auditorGrantURL != "" {
+		link, err := auditorGrantLink(svc.auditorGrantURL, token)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "could not build link: %v", err)
+		}
+
+		res.Link = &link
+	}
+
+	return res, nil
+}
+
+// GetAuditorGrant implements method for getting an auditor grant. The user needs access to the cloud service of the
+// grant.
+func (svc *Service) GetAuditorGrant(ctx context.Context, req *orchestrator.GetAuditorGrantRequest) (res *orchestrator.AuditorGrant, err error) {
+	res, err = svc.getAuditorGrant(ctx, req.AuditorGrantId)
+	if err != nil {
+		return nil, err
+	}
+
+	res.SecretHash = ""
+
+	return res, nil
+}
+
+// ListAuditorGrants implements method for listing all auditor grants the user is authorized to see
+func (svc *Service) ListAuditorGrants(ctx context.Context, req *orchestrator.ListAuditorGrantsRequest) (res *orchestrator.ListAuditorGrantsResponse, err error) {
+	var (
+		query []string
+		args  []any
+	)
+
+	// Retrieve list of allowed cloud service according to our authorization strategy
+	all, allowed := svc.authz.AllowedCloudServices(ctx)
+
+	if req.Filter != nil {
+		if !all && req.Filter.CloudServiceId != nil && !slices.Contains(allowed, req.Filter.GetCloudServiceId()) {
+			return nil, service.ErrPermissionDenied
+		}
+
+		if req.Filter.CloudServiceId != nil {
+			query = append(query, "cloud_service_id = ?")
+			args = append(args, req.Filter.GetCloudServiceId())
+		}
+
+		if req.Filter.Active != nil {
+			if req.Filter.GetActive() {
+				query = append(query, "expires_at > ? AND revoked_at IS NULL")
+			} else {
+				query = append(query, "(expires_at <= ? OR revoked_at IS NOT NULL)")
+			}
+			args = append(args, time.Now())
+		}
+	}
+
+	if !all {
+		query = append(query, "cloud_service_id IN ?")
+		args = append(args, allowed)
+	}
+
+	res = new(orchestrator.ListAuditorGrantsResponse)
+
+	res.AuditorGrants, res.NextPageToken, err = service.PaginateStorage[*orchestrator.AuditorGrant](req, svc.storage,
+		service.DefaultPaginationOpts, persistence.BuildConds(query, args)...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not paginate results: %v", err)
+	}
+
+	for _, grant := range res.AuditorGrants {
+		grant.SecretHash = ""
+	}
+
+	return
+}
+
+// RevokeAuditorGrant implements method for revoking an auditor grant before it expires. The grant is kept, so that the
+// audit log entries of the auditor can still be related to it. The user needs to be an admin for the cloud service of
+// the grant.
+func (svc *Service) RevokeAuditorGrant(ctx context.Context, req *orchestrator.RevokeAuditorGrantRequest) (res *orchestrator.AuditorGrant, err error) {
+	res, err = svc.getAuditorGrant(ctx, req.AuditorGrantId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !svc.isAdmin(ctx, res.CloudServiceId) {
+		return nil, service.ErrPermissionDenied
+	}
+
+	// Revoking a grant twice should not change the time of its revocation
+	if res.RevokedAt == nil {
+		res.RevokedAt = timestamppb.Now()
+
+		err = svc.storage.Save(res, "id = ?", res.Id)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "database error: %v", err)
+		}
+
+		logging.LogRequest(log, logrus.InfoLevel, logging.Update, req, "revoked")
+	}
+
+	res.SecretHash = ""
+
+	return res, nil
+}
+
+// VerifyAuditorGrant implements [service.AuditorGrantVerifier]. It checks whether the token belongs to an auditor
+// grant, which is neither expired nor revoked, and returns the scope of the grant.
+func (svc *Service) VerifyAuditorGrant(_ context.Context, token string) (scope *service.AuditorGrantScope, err error) {
+	var grant orchestrator.AuditorGrant
+
+	id, secret, ok := strings.Cut(token, ".")
+	if !ok || uuid.Validate(id) != nil {
+		return nil, ErrInvalidAuditorGrant
+	}
+
+	err = svc.storage.Get(&grant, "id = ?", id)
+	if errors.Is(err, persistence.ErrRecordNotFound) {
+		return nil, ErrInvalidAuditorGrant
+	} else if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+
+	if !grant.IsActive(time.Now()) {
+		return nil, ErrInvalidAuditorGrant
+	}
+
+	if subtle.ConstantTimeCompare([]byte(hashApiKeySecret(secret)), []byte(grant.SecretHash)) != 1 {
+		return nil, ErrInvalidAuditorGrant
+	}
+
+	return &service.AuditorGrantScope{
+		GrantID:        grant.Id,
+		CloudServiceID: grant.CloudServiceId,
+		CatalogID:      grant.CatalogId,
+	}, nil
+}
+
+// getAuditorGrant retrieves the auditor grant with the given ID from the storage and checks, whether the user has
+// access to its cloud service.
+func (svc *Service) getAuditorGrant(ctx context.Context, id string) (grant *orchestrator.AuditorGrant, err error) {
+	grant = new(orchestrator.AuditorGrant)
+
+	err = svc.storage.Get(grant, "id = ?", id)
+	if errors.Is(err, persistence.ErrRecordNotFound) {
+		return nil, ErrAuditorGrantNotFound
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
+	all, allowed := svc.authz.AllowedCloudServices(ctx)
+	if !all && !slices.Contains(allowed, grant.CloudServiceId) {
+		return nil, service.ErrPermissionDenied
+	}
+
+	return grant, nil
+}
+
+// auditorGrantLink builds a shareable link to the UI at base, which contains the token as fragment.
+func auditorGrantLink(base string, token string) (link string, err error) {
+	u, err := url.Parse(base)
+	if err != nil {
+		return "", err
+	}
+
+	u.Fragment = "grant=" + token
+
+	return u.String(), nil
+}
@@ -0,0 +1,341 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package orchestrator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"clouditor.io/clouditor/v2/api"
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/testutil/servicetest"
+	"clouditor.io/clouditor/v2/internal/testutil/servicetest/orchestratortest"
+	"clouditor.io/clouditor/v2/internal/util"
+	"clouditor.io/clouditor/v2/persistence"
+	"clouditor.io/clouditor/v2/service"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// newMockAuditorGrantStorage returns a storage that contains a target of evaluation for the mock cloud service and
+// catalog.
+func newMockAuditorGrantStorage(t *testing.T) persistence.Storage {
+	return testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+		assert.NoError(t, s.Create(&orchestrator.CloudService{Id: testdata.MockCloudServiceID1}))
+		assert.NoError(t, s.Create(orchestratortest.NewCatalog()))
+		assert.NoError(t, s.Create(orchestratortest.NewTargetOfEvaluation(testdata.AssuranceLevelBasic)))
+	})
+}
+
+func TestService_CreateAuditorGrant(t *testing.T) {
+	type fields struct {
+		authz           service.AuthorizationStrategy
+		auditorGrantURL string
+	}
+	type args struct {
+		req *orchestrator.CreateAuditorGrantRequest
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantRes assert.Want[*orchestrator.CreateAuditorGrantResponse]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "Validation error: no expiry",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategyWithRoles(true, []string{service.RoleAdmin}),
+			},
+			args: args{
+				req: &orchestrator.CreateAuditorGrantRequest{
+					AuditorGrant: &orchestrator.AuditorGrant{
+						Name:           "audit",
+						CloudServiceId: testdata.MockCloudServiceID1,
+						CatalogId:      testdata.MockCatalogID,
+					},
+				},
+			},
+			wantRes: assert.Nil[*orchestrator.CreateAuditorGrantResponse],
+			wantErr: func(t *testing.T, err error) bool {
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+				return assert.ErrorContains(t, err, "auditor_grant.expires_at")
+			},
+		},
+		{
+			name: "Already expired",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategyWithRoles(true, []string{service.RoleAdmin}),
+			},
+			args: args{
+				req: &orchestrator.CreateAuditorGrantRequest{
+					AuditorGrant: &orchestrator.AuditorGrant{
+						Name:           "audit",
+						CloudServiceId: testdata.MockCloudServiceID1,
+						CatalogId:      testdata.MockCatalogID,
+						ExpiresAt:      timestamppb.New(time.Now().Add(-time.Hour)),
+					},
+				},
+			},
+			wantRes: assert.Nil[*orchestrator.CreateAuditorGrantResponse],
+			wantErr: func(t *testing.T, err error) bool {
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+				return assert.ErrorContains(t, err, orchestrator.ErrAuditorGrantExpired.Error())
+			},
+		},
+		{
+			name: "Valid for too long",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategyWithRoles(true, []string{service.RoleAdmin}),
+			},
+			args: args{
+				req: &orchestrator.CreateAuditorGrantRequest{
+					AuditorGrant: &orchestrator.AuditorGrant{
+						Name:           "audit",
+						CloudServiceId: testdata.MockCloudServiceID1,
+						CatalogId:      testdata.MockCatalogID,
+						ExpiresAt:      timestamppb.New(time.Now().Add(DefaultMaxAuditorGrantDuration + time.Hour)),
+					},
+				},
+			},
+			wantRes: assert.Nil[*orchestrator.CreateAuditorGrantResponse],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			name: "Permission denied: not an admin",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategyWithRoles(true, []string{service.RoleAuditor}),
+			},
+			args: args{
+				req: &orchestrator.CreateAuditorGrantRequest{
+					AuditorGrant: &orchestrator.AuditorGrant{
+						Name:           "audit",
+						CloudServiceId: testdata.MockCloudServiceID1,
+						CatalogId:      testdata.MockCatalogID,
+						ExpiresAt:      timestamppb.New(time.Now().Add(time.Hour)),
+					},
+				},
+			},
+			wantRes: assert.Nil[*orchestrator.CreateAuditorGrantResponse],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, service.ErrPermissionDenied)
+			},
+		},
+		{
+			name: "Target of evaluation not found",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategyWithRoles(true, []string{service.RoleAdmin}),
+			},
+			args: args{
+				req: &orchestrator.CreateAuditorGrantRequest{
+					AuditorGrant: &orchestrator.AuditorGrant{
+						Name:           "audit",
+						CloudServiceId: testdata.MockCloudServiceID2,
+						CatalogId:      testdata.MockCatalogID,
+						ExpiresAt:      timestamppb.New(time.Now().Add(time.Hour)),
+					},
+				},
+			},
+			wantRes: assert.Nil[*orchestrator.CreateAuditorGrantResponse],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, api.ErrorCodeToENotFound.Err())
+			},
+		},
+		{
+			name: "Happy path",
+			fields: fields{
+				authz:           servicetest.NewAuthorizationStrategyWithRoles(true, []string{service.RoleAdmin}),
+				auditorGrantURL: "https://clouditor.example/audit",
+			},
+			args: args{
+				req: &orchestrator.CreateAuditorGrantRequest{
+					AuditorGrant: &orchestrator.AuditorGrant{
+						Name:           "audit",
+						CloudServiceId: testdata.MockCloudServiceID1,
+						CatalogId:      testdata.MockCatalogID,
+						ExpiresAt:      timestamppb.New(time.Now().Add(time.Hour)),
+					},
+				},
+			},
+			wantRes: func(t *testing.T, got *orchestrator.CreateAuditorGrantResponse) bool {
+				assert.NotEmpty(t, got.AuditorGrant.Id)
+				assert.NotNil(t, got.AuditorGrant.CreatedAt)
+				assert.Empty(t, got.AuditorGrant.SecretHash)
+				assert.NotEmpty(t, got.Token)
+				return assert.Equal(t, "https://clouditor.example/audit#grant="+got.Token, got.GetLink())
+			},
+			wantErr: assert.Nil[error],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				storage:                 newMockAuditorGrantStorage(t),
+				authz:                   tt.fields.authz,
+				auditorGrantURL:         tt.fields.auditorGrantURL,
+				maxAuditorGrantDuration: DefaultMaxAuditorGrantDuration,
+			}
+
+			gotRes, err := servicetest.Validated(svc.CreateAuditorGrant)(context.Background(), tt.args.req)
+			tt.wantErr(t, err)
+			tt.wantRes(t, gotRes)
+		})
+	}
+}
+
+func TestService_AuditorGrantLifecycle(t *testing.T) {
+	var ctx = context.Background()
+
+	svc := &Service{
+		storage: newMockAuditorGrantStorage(t),
+		authz:   servicetest.NewAuthorizationStrategyWithRoles(true, []string{service.RoleAdmin}),
+	}
+
+	created, err := svc.CreateAuditorGrant(ctx, &orchestrator.CreateAuditorGrantRequest{
+		AuditorGrant: &orchestrator.AuditorGrant{
+			Name:           "audit",
+			CloudServiceId: testdata.MockCloudServiceID1,
+			CatalogId:      testdata.MockCatalogID,
+			ExpiresAt:      timestamppb.New(time.Now().Add(time.Hour)),
+		},
+	})
+	assert.NoError(t, err)
+	assert.Nil(t, created.Link)
+
+	// The token is valid for the cloud service and catalog of the grant
+	scope, err := svc.VerifyAuditorGrant(ctx, created.Token)
+	assert.NoError(t, err)
+	assert.Equal(t, &service.AuditorGrantScope{
+		GrantID:        created.AuditorGrant.Id,
+		CloudServiceID: testdata.MockCloudServiceID1,
+		CatalogID:      testdata.MockCatalogID,
+	}, scope)
+
+	// Malformed, unknown and wrong tokens are rejected
+	for _, token := range []string{"", "not-a-token", testdata.MockCloudServiceID2 + ".secret", created.AuditorGrant.Id + ".secret"} {
+		_, err = svc.VerifyAuditorGrant(ctx, token)
+		assert.ErrorIs(t, err, ErrInvalidAuditorGrant)
+	}
+
+	// The hash of the secret is never returned
+	res, err := svc.GetAuditorGrant(ctx, &orchestrator.GetAuditorGrantRequest{AuditorGrantId: created.AuditorGrant.Id})
+	assert.NoError(t, err)
+	assert.Empty(t, res.SecretHash)
+
+	list, err := svc.ListAuditorGrants(ctx, &orchestrator.ListAuditorGrantsRequest{
+		Filter: &orchestrator.ListAuditorGrantsRequest_Filter{Active: util.Ref(true)},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(list.AuditorGrants))
+
+	// After revoking, the token is invalid but the grant is kept
+	revoked, err := svc.RevokeAuditorGrant(ctx, &orchestrator.RevokeAuditorGrantRequest{AuditorGrantId: created.AuditorGrant.Id})
+	assert.NoError(t, err)
+	assert.NotNil(t, revoked.RevokedAt)
+
+	_, err = svc.VerifyAuditorGrant(ctx, created.Token)
+	assert.ErrorIs(t, err, ErrInvalidAuditorGrant)
+
+	list, err = svc.ListAuditorGrants(ctx, &orchestrator.ListAuditorGrantsRequest{
+		Filter: &orchestrator.ListAuditorGrantsRequest_Filter{Active: util.Ref(false)},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(list.AuditorGrants))
+
+	_, err = svc.GetAuditorGrant(ctx, &orchestrator.GetAuditorGrantRequest{AuditorGrantId: testdata.MockCloudServiceID2})
+	assert.ErrorIs(t, err, ErrAuditorGrantNotFound)
+}
+
+func TestService_VerifyAuditorGrant_expired(t *testing.T) {
+	var (
+		ctx   = context.Background()
+		grant = &orchestrator.AuditorGrant{
+			Id:             testdata.MockCloudServiceID2,
+			Name:           "audit",
+			CloudServiceId: testdata.MockCloudServiceID1,
+			CatalogId:      testdata.MockCatalogID,
+			ExpiresAt:      timestamppb.New(time.Now().Add(-time.Minute)),
+		}
+	)
+
+	token, err := newSecret(grant.Id, &grant.SecretHash)
+	assert.NoError(t, err)
+
+	svc := &Service{
+		storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+			assert.NoError(t, s.Create(grant))
+		}),
+	}
+
+	_, err = svc.VerifyAuditorGrant(ctx, token)
+	assert.ErrorIs(t, err, ErrInvalidAuditorGrant)
+}
+
+func TestService_auditorGrantScope_records(t *testing.T) {
+	// Like in the engine, the service is created without an authorization strategy, which allows all
+	svc := NewService(WithStorage(testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+		assert.NoError(t, s.Create(orchestratortest.MockAssessmentResult1))
+		assert.NoError(t, s.Create(orchestratortest.MockAssessmentResult2))
+		assert.NoError(t, s.Create(newMockWaiver(time.Now().Add(time.Hour))))
+	})))
+
+	ctx := service.WithAuditorGrantScope(context.Background(), &service.AuditorGrantScope{
+		GrantID:        "my-grant-id",
+		CloudServiceID: testdata.MockCloudServiceID1,
+		CatalogID:      testdata.MockCatalogID,
+	})
+
+	res, err := svc.GetAssessmentResult(ctx, &orchestrator.GetAssessmentResultRequest{Id: testdata.MockAssessmentResult1ID})
+	assert.NoError(t, err)
+	assert.Equal(t, testdata.MockCloudServiceID1, res.GetCloudServiceId())
+
+	// The result and the waiver belong to a different cloud service than the grant
+	_, err = svc.GetAssessmentResult(ctx, &orchestrator.GetAssessmentResultRequest{Id: testdata.MockAssessmentResult2ID})
+	assert.ErrorIs(t, err, service.ErrPermissionDenied)
+
+	ctx = service.WithAuditorGrantScope(context.Background(), &service.AuditorGrantScope{
+		GrantID:        "my-grant-id",
+		CloudServiceID: testdata.MockCloudServiceID2,
+		CatalogID:      testdata.MockCatalogID,
+	})
+
+	_, err = svc.GetWaiver(ctx, &orchestrator.GetWaiverRequest{WaiverId: mockWaiverID})
+	assert.ErrorIs(t, err, service.ErrPermissionDenied)
+
+	// The same applies to API keys
+	ctx = service.WithAPIKeyScope(context.Background(), []string{testdata.MockCloudServiceID2})
+
+	_, err = svc.GetWaiver(ctx, &orchestrator.GetWaiverRequest{WaiverId: mockWaiverID})
+	assert.ErrorIs(t, err, service.ErrPermissionDenied)
+}
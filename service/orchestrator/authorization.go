@@ -0,0 +1,227 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"clouditor.io/clouditor/v2/api"
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/persistence"
+	"clouditor.io/clouditor/v2/persistence/gorm"
+	"clouditor.io/clouditor/v2/service"
+)
+
+// scopedAuthorizationStrategy wraps a [service.AuthorizationStrategy] and additionally grants the current user access
+// to all cloud services
+//   - of the organizations (tenants) the user is a member of, if the strategy implements [service.UserIdentifier],
+//   - the user has a role assigned for, if the strategy implements [service.UserIdentifier], and
+//   - with one of the tags the user is allowed to access, if the strategy implements [service.TagRetriever].
+//
+// Requests authenticated by an API key or an auditor grant are additionally restricted to the cloud services of the
+// key or grant, regardless of the wrapped strategy. Since all RPCs of the orchestrator use the authorization strategy to check access to a cloud service or to retrieve
+// the list of allowed cloud services, they are all scoped accordingly.
+type scopedAuthorizationStrategy struct {
+	service.AuthorizationStrategy
+
+	storage persistence.Storage
+}
+
+// CheckAccess checks whether the current request can be fulfilled either by the wrapped authorization strategy or
+// because the cloud service is in one of the additional scopes of the user.
+func (a *scopedAuthorizationStrategy) CheckAccess(ctx context.Context, typ service.RequestType, req api.CloudServiceRequest) bool {
+	if scope, ok := credentialScope(ctx); ok && !slices.Contains(scope, req.GetCloudServiceId()) {
+		return false
+	}
+
+	if a.AuthorizationStrategy.CheckAccess(ctx, typ, req) {
+		return true
+	}
+
+	return slices.Contains(a.scopedCloudServices(ctx), req.GetCloudServiceId())
+}
+
+// AllowedCloudServices retrieves the list of allowed cloud service IDs of the wrapped authorization strategy and adds
+// the cloud services of the additional scopes of the user. The list is restricted to the cloud services of the API key
+// or auditor grant the request was authenticated with.
+func (a *scopedAuthorizationStrategy) AllowedCloudServices(ctx context.Context) (all bool, list []string) {
+	all, list = a.AuthorizationStrategy.AllowedCloudServices(ctx)
+	if !all {
+		list = append(list, a.scopedCloudServices(ctx)...)
+	}
+
+	scope, ok := credentialScope(ctx)
+	if !ok {
+		return all, list
+	} else if all {
+		return false, scope
+	}
+
+	return false, slices.DeleteFunc(list, func(id string) bool {
+		return !slices.Contains(scope, id)
+	})
+}
+
+// HasRole forwards the role check to the wrapped authorization strategy, if it implements [service.RoleChecker].
+func (a *scopedAuthorizationStrategy) HasRole(ctx context.Context, role string) bool {
+	checker, ok := a.AuthorizationStrategy.(service.RoleChecker)
+
+	return ok && checker.HasRole(ctx, role)
+}
+
+// CurrentUser forwards the retrieval of the current user to the wrapped authorization strategy, if it implements
+// [service.UserIdentifier].
+func (a *scopedAuthorizationStrategy) CurrentUser(ctx context.Context) (user string, ok bool) {
+	identifier, ok := a.AuthorizationStrategy.(service.UserIdentifier)
+	if !ok {
+		return "", false
+	}
+
+	return identifier.CurrentUser(ctx)
+}
+
+// AllowedTags forwards the retrieval of the allowed tags to the wrapped authorization strategy, if it implements
+// [service.TagRetriever].
+func (a *scopedAuthorizationStrategy) AllowedTags(ctx context.Context) (tags []string) {
+	retriever, ok := a.AuthorizationStrategy.(service.TagRetriever)
+	if !ok {
+		return nil
+	}
+
+	return retriever.AllowedTags(ctx)
+}
+
+// scopedCloudServices retrieves the IDs of all cloud services that belong to the organizations of the user, that the
+// user has a role assigned for or that have one of the allowed tags of the user.
+func (a *scopedAuthorizationStrategy) scopedCloudServices(ctx context.Context) (list []string) {
+	var (
+		services []*orchestrator.CloudService
+		query    []string
+		args     []any
+	)
+
+	orgs, err := userOrganizations(ctx, a, a.storage)
+	if err != nil {
+		log.Errorf("Could not retrieve organizations of user: %v", err)
+	} else if len(orgs) > 0 {
+		query = append(query, "organization_id IN ?")
+		args = append(args, orgs)
+	}
+
+	assigned, err := userRoleAssignments(ctx, a, a.storage)
+	if err != nil {
+		log.Errorf("Could not retrieve role assignments of user: %v", err)
+	} else if len(assigned) > 0 {
+		query = append(query, "id IN ?")
+		args = append(args, assigned)
+	}
+
+	tagQuery, tagArgs := tagConditions(a.AllowedTags(ctx))
+	query = append(query, tagQuery...)
+	args = append(args, tagArgs...)
+
+	if len(query) == 0 {
+		return nil
+	}
+
+	// A cloud service only needs to be in one of the scopes
+	conds := append([]any{gorm.WithoutPreload(), strings.Join(query, " OR ")}, args...)
+
+	err = a.storage.List(&services, "", true, 0, -1, conds...)
+	if err != nil {
+		log.Errorf("Could not retrieve scoped cloud services: %v", err)
+		return nil
+	}
+
+	for _, s := range services {
+		list = append(list, s.Id)
+	}
+
+	return list
+}
+
+// credentialScope returns the cloud services the API key or auditor grant the request was authenticated with is
+// restricted to. If the request was authenticated by neither, ok is false.
+func credentialScope(ctx context.Context) (scope []string, ok bool) {
+	if grant, isGrant := service.AuditorGrantScopeFromContext(ctx); isGrant {
+		scope, ok = []string{grant.CloudServiceID}, true
+	}
+
+	if keyScope, isKey := service.APIKeyScopeFromContext(ctx); isKey {
+		if ok {
+			keyScope = slices.DeleteFunc(slices.Clone(keyScope), func(id string) bool {
+				return !slices.Contains(scope, id)
+			})
+		}
+
+		scope, ok = keyScope, true
+	}
+
+	return scope, ok
+}
+
+// tagConditions builds one storage condition per tag, which matches cloud services that have the tag. Since the tags
+// are serialized as a JSON array, we need to match the quoted tag within it.
+func tagConditions(tags []string) (query []string, args []any) {
+	for _, tag := range tags {
+		query = append(query, `tags LIKE ? ESCAPE '\'`)
+		args = append(args, fmt.Sprintf(`%%"%s"%%`, strings.ReplaceAll(tag, "_", `\_`)))
+	}
+
+	return
+}
+
+// userRoleAssignments retrieves the IDs of the cloud services the current user has a role assigned for. This requires
+// authz to implement [service.UserIdentifier].
+func userRoleAssignments(ctx context.Context, authz service.AuthorizationStrategy, storage persistence.Storage) (ids []string, err error) {
+	var assignments []*orchestrator.RoleAssignment
+
+	identifier, ok := authz.(service.UserIdentifier)
+	if !ok {
+		return nil, nil
+	}
+
+	user, ok := identifier.CurrentUser(ctx)
+	if !ok {
+		return nil, nil
+	}
+
+	err = storage.List(&assignments, "", true, 0, -1, "user_id = ? AND cloud_service_id IS NOT NULL", user)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, a := range assignments {
+		if !slices.Contains(ids, a.GetCloudServiceId()) {
+			ids = append(ids, a.GetCloudServiceId())
+		}
+	}
+
+	return ids, nil
+}
@@ -0,0 +1,186 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package orchestrator
+
+import (
+	"context"
+	"testing"
+
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/testutil/servicetest"
+	"clouditor.io/clouditor/v2/internal/util"
+	"clouditor.io/clouditor/v2/service"
+)
+
+func Test_scopedAuthorizationStrategy(t *testing.T) {
+	type fields struct {
+		authz service.AuthorizationStrategy
+	}
+	tests := []struct {
+		name            string
+		fields          fields
+		ctx             context.Context
+		wantAll         bool
+		wantList        []string
+		wantCheckAccess map[string]bool
+	}{
+		{
+			name: "allow all",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategy(true),
+			},
+			wantAll: true,
+			wantCheckAccess: map[string]bool{
+				testdata.MockCloudServiceID1: true,
+				testdata.MockCloudServiceID2: true,
+			},
+		},
+		{
+			name: "member of organization",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategyWithUser(false, testdata.MockOrganizationUser),
+			},
+			wantList: []string{testdata.MockCloudServiceID1},
+			wantCheckAccess: map[string]bool{
+				testdata.MockCloudServiceID1: true,
+				testdata.MockCloudServiceID2: false,
+			},
+		},
+		{
+			name: "member of organization with additional cloud service",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategyWithUser(false, testdata.MockOrganizationUser, testdata.MockCloudServiceID2),
+			},
+			wantList: []string{testdata.MockCloudServiceID2, testdata.MockCloudServiceID1},
+			wantCheckAccess: map[string]bool{
+				testdata.MockCloudServiceID1: true,
+				testdata.MockCloudServiceID2: true,
+			},
+		},
+		{
+			name: "not a member of organization",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategyWithUser(false, "someone-else"),
+			},
+			wantList: nil,
+			wantCheckAccess: map[string]bool{
+				testdata.MockCloudServiceID1: false,
+				testdata.MockCloudServiceID2: false,
+			},
+		},
+		{
+			name: "role assigned for cloud service",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategyWithUser(false, "role-assignee"),
+			},
+			wantList: []string{testdata.MockCloudServiceID2},
+			wantCheckAccess: map[string]bool{
+				testdata.MockCloudServiceID1: false,
+				testdata.MockCloudServiceID2: true,
+			},
+		},
+		{
+			name: "allowed tag",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategyWithTags(false, []string{"team:payments"}),
+			},
+			wantList: []string{testdata.MockCloudServiceID2},
+			wantCheckAccess: map[string]bool{
+				testdata.MockCloudServiceID1: false,
+				testdata.MockCloudServiceID2: true,
+			},
+		},
+		{
+			name: "allow all with auditor grant",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategy(true),
+			},
+			ctx:      service.WithAuditorGrantScope(context.Background(), &service.AuditorGrantScope{CloudServiceID: testdata.MockCloudServiceID1}),
+			wantList: []string{testdata.MockCloudServiceID1},
+			wantCheckAccess: map[string]bool{
+				testdata.MockCloudServiceID1: true,
+				testdata.MockCloudServiceID2: false,
+			},
+		},
+		{
+			name: "member of organization with API key",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategyWithUser(false, testdata.MockOrganizationUser, testdata.MockCloudServiceID2),
+			},
+			ctx:      service.WithAPIKeyScope(context.Background(), []string{testdata.MockCloudServiceID1}),
+			wantList: []string{testdata.MockCloudServiceID1},
+			wantCheckAccess: map[string]bool{
+				testdata.MockCloudServiceID1: true,
+				testdata.MockCloudServiceID2: false,
+			},
+		},
+		{
+			name: "no user",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategy(false),
+			},
+			wantList: nil,
+			wantCheckAccess: map[string]bool{
+				testdata.MockCloudServiceID1: false,
+				testdata.MockCloudServiceID2: false,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			storage := newOrganizationStorage(t)
+			assert.NoError(t, storage.Create(&orchestrator.RoleAssignment{
+				Id:             "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+				UserId:         "role-assignee",
+				Role:           service.RoleReadOnly,
+				CloudServiceId: util.Ref(testdata.MockCloudServiceID2),
+			}))
+
+			a := &scopedAuthorizationStrategy{
+				AuthorizationStrategy: tt.fields.authz,
+				storage:               storage,
+			}
+
+			ctx := tt.ctx
+			if ctx == nil {
+				ctx = context.Background()
+			}
+
+			gotAll, gotList := a.AllowedCloudServices(ctx)
+			assert.Equal(t, tt.wantAll, gotAll)
+			assert.Equal(t, tt.wantList, gotList)
+
+			for id, want := range tt.wantCheckAccess {
+				got := a.CheckAccess(ctx, service.AccessRead, &orchestrator.GetCloudServiceRequest{CloudServiceId: id})
+				if got != want {
+					t.Errorf("CheckAccess(%s) = %v, want %v", id, got, want)
+				}
+			}
+		})
+	}
+}
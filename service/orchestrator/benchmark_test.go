@@ -0,0 +1,93 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package orchestrator
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+)
+
+// storeAssessmentResults streams n assessment results to the orchestrator using the specified batch size and waits
+// until all of them are acknowledged.
+func storeAssessmentResults(n int, batchSize int, b *testing.B) {
+	svc := NewService(WithAssessmentResultBatching(batchSize, DefaultResultBatchInterval))
+	stream := newMockBlockingStreamer()
+	requests := createStoreAssessmentResultRequestsMock(n)
+
+	// Consume the responses, so that the stream does not block
+	done := make(chan int)
+	go func() {
+		var stored int
+		for i := 0; i < n; i++ {
+			if res := <-stream.responses; res.Status {
+				stored++
+			}
+		}
+		done <- stored
+	}()
+
+	errc := make(chan error, 1)
+	go func() {
+		errc <- svc.StoreAssessmentResults(stream)
+	}()
+
+	for _, req := range requests {
+		stream.requests <- req
+	}
+	close(stream.requests)
+
+	if stored := <-done; stored != n {
+		b.Errorf("only %d of %d assessment results were stored", stored, n)
+	}
+
+	if err := <-errc; err != nil {
+		b.Errorf("Error while calling StoreAssessmentResults: %v", err)
+	}
+}
+
+var numResults = []int{1000, 10000}
+
+var resultBatchSizes = []int{1, 10, 100, 1000}
+
+// BenchmarkStoreAssessmentResults compares the throughput of StoreAssessmentResults for different batch sizes.
+// A batch size of 1 corresponds to one transaction per result.
+func BenchmarkStoreAssessmentResults(b *testing.B) {
+	logrus.SetLevel(logrus.PanicLevel)
+
+	for _, n := range numResults {
+		for _, size := range resultBatchSizes {
+			b.Run(fmt.Sprintf("%d/batch-%d", n, size), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					storeAssessmentResults(n, size, b)
+				}
+
+				b.ReportMetric(float64(n*b.N)/b.Elapsed().Seconds(), "results/s")
+			})
+		}
+	}
+}
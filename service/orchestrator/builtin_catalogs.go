@@ -0,0 +1,132 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package orchestrator
+
+import (
+	"context"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"slices"
+
+	"clouditor.io/clouditor/v2/api/orchestrator"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// builtinCatalogsFS contains the catalogs that are built into Clouditor, e.g., EUCS, BSI C5, CIS and ISO 27001.
+//
+//go:embed builtin_catalogs/*.json
+var builtinCatalogsFS embed.FS
+
+// ListBuiltinCatalogs lists all catalogs that are built into Clouditor. Each catalog only contains its meta-data.
+func (svc *Service) ListBuiltinCatalogs(_ context.Context, req *orchestrator.ListBuiltinCatalogsRequest) (res *orchestrator.ListBuiltinCatalogsResponse, err error) {
+	var catalogs []*orchestrator.Catalog
+
+	catalogs, err = builtinCatalogs()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not load built-in catalogs: %v", err)
+	}
+
+	res = new(orchestrator.ListBuiltinCatalogsResponse)
+
+	for _, catalog := range catalogs {
+		// We only want to return the meta-data of the catalog
+		catalog.Categories = nil
+		res.Catalogs = append(res.Catalogs, catalog)
+	}
+
+	return
+}
+
+// LoadBuiltinCatalog loads a built-in catalog into the storage. An already existing catalog with the same ID is updated
+// to the built-in version.
+func (svc *Service) LoadBuiltinCatalog(_ context.Context, req *orchestrator.LoadBuiltinCatalogRequest) (res *orchestrator.Catalog, err error) {
+	var (
+		catalogs []*orchestrator.Catalog
+		idx      int
+	)
+
+	catalogs, err = builtinCatalogs()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not load built-in catalogs: %v", err)
+	}
+
+	idx = slices.IndexFunc(catalogs, func(c *orchestrator.Catalog) bool {
+		return c.Id == req.CatalogId
+	})
+	if idx == -1 {
+		return nil, status.Errorf(codes.NotFound, "built-in catalog not found")
+	}
+
+	res = catalogs[idx]
+
+	prepareCatalogs([]*orchestrator.Catalog{res})
+
+	// We save the catalog as a slice (similar to loadCatalogs), because this results in an upsert, which also works if
+	// the catalog does not exist yet
+	err = svc.storage.Save([]*orchestrator.Catalog{res})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
+	log.Infof("Loaded built-in catalog %s in version %s", res.Id, res.Version)
+
+	return res, nil
+}
+
+// builtinCatalogs parses all built-in catalogs from our embedded file system.
+func builtinCatalogs() (catalogs []*orchestrator.Catalog, err error) {
+	var files []string
+
+	files, err = fs.Glob(builtinCatalogsFS, "builtin_catalogs/*.json")
+	if err != nil {
+		return nil, err
+	}
+
+	for _, file := range files {
+		var (
+			b                []byte
+			catalogsFromFile []*orchestrator.Catalog
+		)
+
+		b, err = builtinCatalogsFS.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("could not read %s: %w", file, err)
+		}
+
+		err = json.Unmarshal(b, &catalogsFromFile)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse %s: %w", file, err)
+		}
+
+		catalogs = append(catalogs, catalogsFromFile...)
+	}
+
+	return
+}
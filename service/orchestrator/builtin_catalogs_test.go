@@ -0,0 +1,142 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package orchestrator
+
+import (
+	"context"
+	"testing"
+
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/testutil/servicetest"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestService_ListBuiltinCatalogs(t *testing.T) {
+	svc := NewService()
+
+	res, err := svc.ListBuiltinCatalogs(context.Background(), &orchestrator.ListBuiltinCatalogsRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, 4, len(res.Catalogs))
+
+	for _, catalog := range res.Catalogs {
+		assert.NotEmpty(t, catalog.Version)
+		assert.Empty(t, catalog.Categories)
+	}
+}
+
+func TestService_LoadBuiltinCatalog(t *testing.T) {
+	type args struct {
+		req *orchestrator.LoadBuiltinCatalogRequest
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantRes assert.Want[*orchestrator.Catalog]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "invalid request",
+			args: args{
+				req: &orchestrator.LoadBuiltinCatalogRequest{},
+			},
+			wantRes: assert.Nil[*orchestrator.Catalog],
+			wantErr: func(t *testing.T, err error) bool {
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+				return assert.ErrorContains(t, err, "catalog_id: value length must be at least 1 characters")
+			},
+		},
+		{
+			name: "catalog not found",
+			args: args{
+				req: &orchestrator.LoadBuiltinCatalogRequest{CatalogId: "DoesNotExist"},
+			},
+			wantRes: assert.Nil[*orchestrator.Catalog],
+			wantErr: func(t *testing.T, err error) bool {
+				assert.Equal(t, codes.NotFound, status.Code(err))
+				return assert.ErrorContains(t, err, "built-in catalog not found")
+			},
+		},
+		{
+			name: "valid",
+			args: args{
+				req: &orchestrator.LoadBuiltinCatalogRequest{CatalogId: "EUCS"},
+			},
+			wantRes: func(t *testing.T, got *orchestrator.Catalog) bool {
+				assert.Equal(t, "EUCS", got.Id)
+				return assert.Equal(t, "2022", got.Version)
+			},
+			wantErr: assert.Nil[error],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := NewService()
+
+			gotRes, err := servicetest.Validated(svc.LoadBuiltinCatalog)(context.Background(), tt.args.req)
+			tt.wantErr(t, err)
+			tt.wantRes(t, gotRes)
+
+			if err != nil {
+				return
+			}
+
+			// Loading the catalog again should update the existing catalog
+			_, err = servicetest.Validated(svc.LoadBuiltinCatalog)(context.Background(), tt.args.req)
+			assert.NoError(t, err)
+
+			control, err := svc.GetControl(context.Background(), &orchestrator.GetControlRequest{
+				CatalogId:    "EUCS",
+				CategoryName: "Cryptography and Key Management",
+				ControlId:    "CKM-02",
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, 3, len(control.Controls))
+		})
+	}
+}
+
+func Test_builtinCatalogs(t *testing.T) {
+	catalogs, err := builtinCatalogs()
+	assert.NoError(t, err)
+
+	// All built-in catalogs should only reference existing metrics
+	svc := NewService()
+	for _, catalog := range catalogs {
+		for _, category := range catalog.Categories {
+			for _, control := range category.Controls {
+				for _, c := range append([]*orchestrator.Control{control}, control.Controls...) {
+					for _, m := range c.Metrics {
+						_, err = svc.GetMetric(context.Background(), &orchestrator.GetMetricRequest{MetricId: m.Id})
+						assert.NoError(t, err, "catalog %s references unknown metric %s", catalog.Id, m.Id)
+					}
+				}
+			}
+		}
+	}
+}
@@ -23,14 +23,21 @@ import (
 )
 
 // CreateCatalog implements a method for creating a new catalog.
-func (svc *Service) CreateCatalog(_ context.Context, req *orchestrator.CreateCatalogRequest) (
+func (svc *Service) CreateCatalog(ctx context.Context, req *orchestrator.CreateCatalogRequest) (
 	*orchestrator.Catalog, error) {
-	// Validate request
-	err := api.Validate(req)
-	if err != nil {
-		return nil, err
+	var err error
+
+	// Only members of an organization can create a catalog within it
+	if req.Catalog.OrganizationId != nil {
+		err = svc.checkOrganizationAccess(ctx, req.Catalog.GetOrganizationId())
+		if err != nil {
+			return nil, err
+		}
 	}
 
+	// New catalogs cannot be removed already
+	req.Catalog.DeletedAt = nil
+
 	// Persist the new catalog in our database
 	err = svc.storage.Create(req.Catalog)
 	if err != nil {
@@ -46,13 +53,7 @@ func (svc *Service) CreateCatalog(_ context.Context, req *orchestrator.CreateCat
 // GetCatalog retrieves a control specified by the catalog ID, the control's category
 // name and the control ID. If present, it also includes a list of sub-controls and any metrics associated to any
 // controls.
-func (svc *Service) GetCatalog(_ context.Context, req *orchestrator.GetCatalogRequest) (response *orchestrator.Catalog, err error) {
-	// Validate request
-	err = api.Validate(req)
-	if err != nil {
-		return nil, err
-	}
-
+func (svc *Service) GetCatalog(ctx context.Context, req *orchestrator.GetCatalogRequest) (response *orchestrator.Catalog, err error) {
 	response = new(orchestrator.Catalog)
 	err = svc.storage.Get(response,
 		// Preload fills in associated entities, in this case controls. We want to only select those controls which do
@@ -61,25 +62,45 @@ func (svc *Service) GetCatalog(_ context.Context, req *orchestrator.GetCatalogRe
 		// Select catalog by ID
 		"Id = ?", req.CatalogId)
 	if errors.Is(err, persistence.ErrRecordNotFound) {
-		return nil, status.Errorf(codes.NotFound, "catalog not found")
+		return nil, api.ErrorCodeCatalogNotFound.Err()
 	} else if err != nil {
 		return nil, status.Errorf(codes.Internal, "database error: %v", err)
 	}
+
+	// Catalogs of an organization can only be retrieved by its members
+	if response.OrganizationId != nil {
+		err = svc.checkOrganizationAccess(ctx, response.GetOrganizationId())
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return response, nil
 }
 
 // ListCatalogs Lists all security controls catalogs. Each catalog includes a list of its
 // categories but no additional sub-resources.
-func (svc *Service) ListCatalogs(_ context.Context, req *orchestrator.ListCatalogsRequest) (res *orchestrator.ListCatalogsResponse, err error) {
-	// Validate request
-	err = api.Validate(req)
+func (svc *Service) ListCatalogs(ctx context.Context, req *orchestrator.ListCatalogsRequest) (res *orchestrator.ListCatalogsResponse, err error) {
+	var conds []any
+
+	// Only list the catalogs of the organizations of the user, in addition to the ones available to everyone
+	all, orgs, err := svc.allowedOrganizations(ctx)
 	if err != nil {
 		return nil, err
 	}
 
+	if !all {
+		conds = append(conds, "organization_id IS NULL OR organization_id IN ?", orgs)
+	}
+
+	// Removed catalogs are only included on request
+	if req.ShowDeleted {
+		conds = append([]any{gorm.WithDeleted()}, conds...)
+	}
+
 	res = new(orchestrator.ListCatalogsResponse)
 	res.Catalogs, res.NextPageToken, err = service.PaginateStorage[*orchestrator.Catalog](req, svc.storage,
-		service.DefaultPaginationOpts)
+		service.DefaultPaginationOpts, conds...)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "could not paginate results: %v", err)
 	}
@@ -88,18 +109,15 @@ func (svc *Service) ListCatalogs(_ context.Context, req *orchestrator.ListCatalo
 
 // UpdateCatalog implements a method for updating an existing catalog
 func (svc *Service) UpdateCatalog(_ context.Context, req *orchestrator.UpdateCatalogRequest) (res *orchestrator.Catalog, err error) {
-	// Validate request
-	err = api.Validate(req)
-	if err != nil {
-		return nil, err
-	}
-
 	res = req.Catalog
 
+	// Removing a catalog is only possible using RemoveCatalog
+	res.DeletedAt = nil
+
 	err = svc.storage.Update(res, "id = ?", res.Id)
 
 	if err != nil && errors.Is(err, persistence.ErrRecordNotFound) {
-		return nil, status.Error(codes.NotFound, "catalog not found")
+		return nil, api.ErrorCodeCatalogNotFound.Err()
 	} else if err != nil {
 		return nil, status.Errorf(codes.Internal, "database error: %v", err)
 	}
@@ -111,15 +129,9 @@ func (svc *Service) UpdateCatalog(_ context.Context, req *orchestrator.UpdateCat
 
 // RemoveCatalog implements a method for removing a catalog
 func (svc *Service) RemoveCatalog(_ context.Context, req *orchestrator.RemoveCatalogRequest) (response *emptypb.Empty, err error) {
-	// Validate request
-	err = api.Validate(req)
-	if err != nil {
-		return nil, err
-	}
-
 	err = svc.storage.Delete(&orchestrator.Catalog{}, "Id = ?", req.CatalogId)
 	if errors.Is(err, persistence.ErrRecordNotFound) {
-		return nil, status.Errorf(codes.NotFound, "catalog not found")
+		return nil, api.ErrorCodeCatalogNotFound.Err()
 	} else if err != nil {
 		return nil, status.Errorf(codes.Internal, "database error: %v", err)
 	}
@@ -129,15 +141,29 @@ func (svc *Service) RemoveCatalog(_ context.Context, req *orchestrator.RemoveCat
 	return &emptypb.Empty{}, nil
 }
 
-// GetCategory retrieves a category of a catalog specified by the catalog ID and the category name. It includes the
-// first level of controls within each category.
-func (srv *Service) GetCategory(_ context.Context, req *orchestrator.GetCategoryRequest) (res *orchestrator.Category, err error) {
-	// Validate request
-	err = api.Validate(req)
+// RestoreCatalog implements a method for restoring a removed catalog, which has not been purged yet
+func (svc *Service) RestoreCatalog(_ context.Context, req *orchestrator.RestoreCatalogRequest) (res *orchestrator.Catalog, err error) {
+	err = svc.storage.Restore(&orchestrator.Catalog{}, "id = ?", req.CatalogId)
+	if errors.Is(err, persistence.ErrRecordNotFound) {
+		return nil, status.Errorf(codes.NotFound, "removed catalog not found")
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
+	res = new(orchestrator.Catalog)
+	err = svc.storage.Get(res, "id = ?", req.CatalogId)
 	if err != nil {
-		return nil, err
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
 	}
 
+	logging.LogRequest(log, logrus.DebugLevel, logging.Restore, req)
+
+	return res, nil
+}
+
+// GetCategory retrieves a category of a catalog specified by the catalog ID and the category name. It includes the
+// first level of controls within each category.
+func (srv *Service) GetCategory(_ context.Context, req *orchestrator.GetCategoryRequest) (res *orchestrator.Category, err error) {
 	res = new(orchestrator.Category)
 	err = srv.storage.Get(&res,
 		// Preload fills in associated entities, in this case controls. We want to only select those controls which do
@@ -157,18 +183,12 @@ func (srv *Service) GetCategory(_ context.Context, req *orchestrator.GetCategory
 // GetControl retrieves a control specified by the catalog ID, the control's category name and the control ID. If
 // present, it also includes a list of sub-controls and any metrics associated to the control.
 func (srv *Service) GetControl(_ context.Context, req *orchestrator.GetControlRequest) (res *orchestrator.Control, err error) {
-	// Validate request
-	err = api.Validate(req)
-	if err != nil {
-		return nil, err
-	}
-
 	res = new(orchestrator.Control)
 	err = srv.storage.Get(&res,
 		// We only want to select controls for the specified category and catalog
 		"Id = ? AND category_name = ? AND category_catalog_id = ?", req.ControlId, req.CategoryName, req.CatalogId)
 	if errors.Is(err, persistence.ErrRecordNotFound) {
-		return nil, status.Errorf(codes.NotFound, "control not found")
+		return nil, api.ErrorCodeControlNotFound.Err()
 	} else if err != nil {
 		return nil, status.Errorf(codes.Internal, "database error: %v", err)
 	}
@@ -176,6 +196,39 @@ func (srv *Service) GetControl(_ context.Context, req *orchestrator.GetControlRe
 	return res, nil
 }
 
+// SetControlAggregationStrategy sets the strategy that specifies how the results of the sub-controls of a control are
+// combined during the evaluation.
+func (svc *Service) SetControlAggregationStrategy(ctx context.Context, req *orchestrator.SetControlAggregationStrategyRequest) (res *orchestrator.Control, err error) {
+	err = svc.checkCatalogAccess(ctx, req.CatalogId)
+	if err != nil {
+		return nil, err
+	}
+
+	res = new(orchestrator.Control)
+	err = svc.storage.Get(res, gorm.WithoutPreload(),
+		"id = ? AND category_name = ? AND category_catalog_id = ?", req.ControlId, req.CategoryName, req.CatalogId)
+	if errors.Is(err, persistence.ErrRecordNotFound) {
+		return nil, api.ErrorCodeControlNotFound.Err()
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
+	res.AggregationStrategy = req.Strategy
+
+	err = svc.storage.Save(res, "id = ? AND category_name = ? AND category_catalog_id = ?", req.ControlId, req.CategoryName, req.CatalogId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
+	logging.LogRequest(log, logrus.DebugLevel, logging.Update, req)
+
+	return svc.GetControl(ctx, &orchestrator.GetControlRequest{
+		CatalogId:    req.CatalogId,
+		CategoryName: req.CategoryName,
+		ControlId:    req.ControlId,
+	})
+}
+
 // ListControls lists controls. If no additional parameters are specified, this lists all controls. If a catalog ID and
 // a category name is specified, then only controls containing in this category are returned.
 func (srv *Service) ListControls(_ context.Context, req *orchestrator.ListControlsRequest) (res *orchestrator.ListControlsResponse, err error) {
@@ -183,12 +236,6 @@ func (srv *Service) ListControls(_ context.Context, req *orchestrator.ListContro
 		args  []any
 		query []string
 	)
-	// Validate request
-	err = api.Validate(req)
-	if err != nil {
-		return nil, err
-	}
-
 	res = new(orchestrator.ListControlsResponse)
 
 	// If the category name is set (additional binding), forward it as a condition to the pagination method
@@ -275,6 +322,13 @@ func (svc *Service) loadEmbeddedCatalogs() (catalogs []*orchestrator.Catalog, er
 		catalogs = append(catalogs, catalogsFromFile...)
 	}
 
+	prepareCatalogs(catalogs)
+
+	return
+}
+
+// prepareCatalogs prepares catalogs loaded from a file so that they can be stored.
+func prepareCatalogs(catalogs []*orchestrator.Catalog) {
 	// We need to make sure that sub-controls have the category_name and category_catalog_id of their parents set,
 	// otherwise we are failing a constraint.
 	for _, catalog := range catalogs {
@@ -298,6 +352,4 @@ func (svc *Service) loadEmbeddedCatalogs() (catalogs []*orchestrator.Catalog, er
 			}
 		}
 	}
-
-	return
 }
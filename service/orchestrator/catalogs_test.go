@@ -36,10 +36,12 @@ import (
 	"clouditor.io/clouditor/v2/internal/testdata"
 	"clouditor.io/clouditor/v2/internal/testutil"
 	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/testutil/servicetest"
 	"clouditor.io/clouditor/v2/internal/testutil/servicetest/orchestratortest"
 	"clouditor.io/clouditor/v2/internal/util"
 	"clouditor.io/clouditor/v2/persistence"
 	"clouditor.io/clouditor/v2/persistence/gorm"
+	"clouditor.io/clouditor/v2/service"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -129,7 +131,7 @@ func TestService_CreateCatalog(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			s := NewService()
-			gotRes, err := s.CreateCatalog(tt.args.in0, tt.args.req)
+			gotRes, err := servicetest.Validated(s.CreateCatalog)(tt.args.in0, tt.args.req)
 			tt.wantErr(t, err)
 
 			// If no error is wanted, check response
@@ -210,7 +212,7 @@ func TestService_GetCatalog(t *testing.T) {
 			orchestratorService := Service{
 				storage: tt.fields.storage,
 			}
-			res, err := orchestratorService.GetCatalog(context.Background(), tt.args.req)
+			res, err := servicetest.Validated(orchestratorService.GetCatalog)(context.Background(), tt.args.req)
 			tt.wantErr(t, err)
 			tt.wantResponse(t, res)
 		})
@@ -239,17 +241,17 @@ func TestService_UpdateCatalog(t *testing.T) {
 	orchestratorService := NewService()
 
 	// 1st case: Catalog is nil
-	_, err = orchestratorService.UpdateCatalog(context.Background(), &orchestrator.UpdateCatalogRequest{})
+	_, err = servicetest.Validated(orchestratorService.UpdateCatalog)(context.Background(), &orchestrator.UpdateCatalogRequest{})
 	assert.Equal(t, codes.InvalidArgument, status.Code(err))
 
 	// 2nd case: Catalog ID is nil
-	_, err = orchestratorService.UpdateCatalog(context.Background(), &orchestrator.UpdateCatalogRequest{
+	_, err = servicetest.Validated(orchestratorService.UpdateCatalog)(context.Background(), &orchestrator.UpdateCatalogRequest{
 		Catalog: catalog,
 	})
 	assert.Equal(t, codes.InvalidArgument, status.Code(err))
 
 	// 3rd case: Catalog not found since there are no catalogs yet
-	_, err = orchestratorService.UpdateCatalog(context.Background(), &orchestrator.UpdateCatalogRequest{
+	_, err = servicetest.Validated(orchestratorService.UpdateCatalog)(context.Background(), &orchestrator.UpdateCatalogRequest{
 		Catalog: &orchestrator.Catalog{
 			Id:              testdata.MockCatalogID,
 			Name:            testdata.MockCatalogName,
@@ -269,7 +271,7 @@ func TestService_UpdateCatalog(t *testing.T) {
 
 	// update the Catalog's description and send the update request
 	mockCatalog.Description = "new description"
-	catalog, err = orchestratorService.UpdateCatalog(context.Background(), &orchestrator.UpdateCatalogRequest{
+	catalog, err = servicetest.Validated(orchestratorService.UpdateCatalog)(context.Background(), &orchestrator.UpdateCatalogRequest{
 		Catalog: mockCatalog,
 	})
 	assert.NoError(t, err)
@@ -285,12 +287,12 @@ func TestService_RemoveCatalog(t *testing.T) {
 	orchestratorService := NewService(WithCatalogsFolder("internal/testdata/empty_catalogs"))
 
 	// 1st case: Empty catalog ID error
-	_, err = orchestratorService.RemoveCatalog(context.Background(), &orchestrator.RemoveCatalogRequest{CatalogId: ""})
+	_, err = servicetest.Validated(orchestratorService.RemoveCatalog)(context.Background(), &orchestrator.RemoveCatalogRequest{CatalogId: ""})
 	assert.Error(t, err)
 	assert.Equal(t, status.Code(err), codes.InvalidArgument)
 
 	// 2nd case: ErrRecordNotFound
-	_, err = orchestratorService.RemoveCatalog(context.Background(), &orchestrator.RemoveCatalogRequest{CatalogId: "0000"})
+	_, err = servicetest.Validated(orchestratorService.RemoveCatalog)(context.Background(), &orchestrator.RemoveCatalogRequest{CatalogId: "0000"})
 	assert.Error(t, err)
 	assert.Equal(t, status.Code(err), codes.NotFound)
 
@@ -306,7 +308,7 @@ func TestService_RemoveCatalog(t *testing.T) {
 	assert.Equal(t, 1, len(listCatalogsResponse.Catalogs))
 
 	// Remove record
-	_, err = orchestratorService.RemoveCatalog(context.Background(), &orchestrator.RemoveCatalogRequest{CatalogId: mockCatalog.Id})
+	_, err = servicetest.Validated(orchestratorService.RemoveCatalog)(context.Background(), &orchestrator.RemoveCatalogRequest{CatalogId: mockCatalog.Id})
 	assert.NoError(t, err)
 
 	// There is no record left in the DB
@@ -316,6 +318,46 @@ func TestService_RemoveCatalog(t *testing.T) {
 	assert.Equal(t, 0, len(listCatalogsResponse.Catalogs))
 }
 
+func TestService_RestoreCatalog(t *testing.T) {
+	var (
+		err                  error
+		res                  *orchestrator.Catalog
+		listCatalogsResponse *orchestrator.ListCatalogsResponse
+	)
+	orchestratorService := NewService(WithCatalogsFolder("internal/testdata/empty_catalogs"))
+
+	// 1st case: Empty catalog ID error
+	_, err = servicetest.Validated(orchestratorService.RestoreCatalog)(context.Background(), &orchestrator.RestoreCatalogRequest{CatalogId: ""})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	mockCatalog := orchestratortest.NewCatalog()
+	err = orchestratorService.storage.Create(mockCatalog)
+	assert.NoError(t, err)
+
+	// 2nd case: Catalog is not removed
+	_, err = servicetest.Validated(orchestratorService.RestoreCatalog)(context.Background(), &orchestrator.RestoreCatalogRequest{CatalogId: mockCatalog.Id})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = servicetest.Validated(orchestratorService.RemoveCatalog)(context.Background(), &orchestrator.RemoveCatalogRequest{CatalogId: mockCatalog.Id})
+	assert.NoError(t, err)
+
+	// The removed catalog is only listed on request
+	listCatalogsResponse, err = orchestratorService.ListCatalogs(context.Background(), &orchestrator.ListCatalogsRequest{ShowDeleted: true})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(listCatalogsResponse.Catalogs))
+	assert.NotNil(t, listCatalogsResponse.Catalogs[0].DeletedAt)
+
+	// 3rd case: Catalog restored successfully
+	res, err = servicetest.Validated(orchestratorService.RestoreCatalog)(context.Background(), &orchestrator.RestoreCatalogRequest{CatalogId: mockCatalog.Id})
+	assert.NoError(t, err)
+	assert.Equal(t, mockCatalog.Id, res.Id)
+	assert.Nil(t, res.DeletedAt)
+
+	listCatalogsResponse, err = orchestratorService.ListCatalogs(context.Background(), &orchestrator.ListCatalogsRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(listCatalogsResponse.Catalogs))
+}
+
 func TestService_GetCategory(t *testing.T) {
 	type fields struct {
 		cloudServiceHooks     []orchestrator.CloudServiceHookFunc
@@ -496,6 +538,147 @@ func TestService_GetControl(t *testing.T) {
 	}
 }
 
+func TestService_SetControlAggregationStrategy(t *testing.T) {
+	type fields struct {
+		storage persistence.Storage
+		authz   service.AuthorizationStrategy
+	}
+	type args struct {
+		req *orchestrator.SetControlAggregationStrategyRequest
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    assert.Want[*orchestrator.Control]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "validation error",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t),
+				authz:   servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &orchestrator.SetControlAggregationStrategyRequest{
+					CatalogId:    testdata.MockCatalogID,
+					CategoryName: testdata.MockCategoryName,
+					ControlId:    testdata.MockControlID1,
+					Strategy: &orchestrator.AggregationStrategy{
+						Type:      orchestrator.AggregationStrategyType_AGGREGATION_STRATEGY_TYPE_PERCENTAGE_THRESHOLD,
+						Threshold: util.Ref(120.0),
+					},
+				},
+			},
+			want: assert.Nil[*orchestrator.Control],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "strategy.threshold")
+			},
+		},
+		{
+			name: "catalog not found",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t),
+				authz:   servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &orchestrator.SetControlAggregationStrategyRequest{
+					CatalogId:    testdata.MockCatalogID,
+					CategoryName: testdata.MockCategoryName,
+					ControlId:    testdata.MockControlID1,
+				},
+			},
+			want: assert.Nil[*orchestrator.Control],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.Equal(t, codes.NotFound, status.Code(err))
+			},
+		},
+		{
+			name: "control not found",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					assert.NoError(t, s.Create(orchestratortest.NewCatalog()))
+				}),
+				authz: servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &orchestrator.SetControlAggregationStrategyRequest{
+					CatalogId:    testdata.MockCatalogID,
+					CategoryName: testdata.MockCategoryName,
+					ControlId:    "not-there",
+				},
+			},
+			want: assert.Nil[*orchestrator.Control],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "control not found")
+			},
+		},
+		{
+			name: "happy path",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					assert.NoError(t, s.Create(orchestratortest.NewCatalog()))
+				}),
+				authz: servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &orchestrator.SetControlAggregationStrategyRequest{
+					CatalogId:    testdata.MockCatalogID,
+					CategoryName: testdata.MockCategoryName,
+					ControlId:    testdata.MockControlID1,
+					Strategy: &orchestrator.AggregationStrategy{
+						Type:              orchestrator.AggregationStrategyType_AGGREGATION_STRATEGY_TYPE_N_OF_M,
+						RequiredCompliant: util.Ref(int32(1)),
+					},
+				},
+			},
+			want: func(t *testing.T, got *orchestrator.Control) bool {
+				return assert.Equal(t, testdata.MockControlID1, got.Id) &&
+					assert.Equal(t, orchestrator.AggregationStrategyType_AGGREGATION_STRATEGY_TYPE_N_OF_M, got.AggregationStrategy.GetType()) &&
+					assert.Equal(t, int32(1), got.AggregationStrategy.GetRequiredCompliant()) &&
+					assert.Equal(t, 1, len(got.Controls))
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "happy path: reset strategy",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					catalog := orchestratortest.NewCatalog()
+					catalog.Categories[0].Controls[0].AggregationStrategy = &orchestrator.AggregationStrategy{
+						Type: orchestrator.AggregationStrategyType_AGGREGATION_STRATEGY_TYPE_PERCENTAGE_THRESHOLD,
+					}
+					assert.NoError(t, s.Create(catalog))
+				}),
+				authz: servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &orchestrator.SetControlAggregationStrategyRequest{
+					CatalogId:    testdata.MockCatalogID,
+					CategoryName: testdata.MockCategoryName,
+					ControlId:    testdata.MockControlID1,
+				},
+			},
+			want: func(t *testing.T, got *orchestrator.Control) bool {
+				return assert.Nil(t, got.AggregationStrategy)
+			},
+			wantErr: assert.Nil[error],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				storage: tt.fields.storage,
+				authz:   tt.fields.authz,
+			}
+			got, err := servicetest.Validated(svc.SetControlAggregationStrategy)(context.Background(), tt.args.req)
+
+			tt.wantErr(t, err)
+			tt.want(t, got)
+		})
+	}
+}
+
 func TestService_ListControls(t *testing.T) {
 	var (
 		res *orchestrator.ListControlsResponse
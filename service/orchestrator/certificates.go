@@ -3,38 +3,46 @@ package orchestrator
 import (
 	"context"
 	"errors"
+	"fmt"
 	"slices"
+	"time"
 
 	"clouditor.io/clouditor/v2/api"
 	"clouditor.io/clouditor/v2/api/orchestrator"
 	"clouditor.io/clouditor/v2/internal/logging"
+	"clouditor.io/clouditor/v2/internal/util"
 	"clouditor.io/clouditor/v2/persistence"
+	"clouditor.io/clouditor/v2/persistence/gorm"
 	"clouditor.io/clouditor/v2/service"
 
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // ErrCertificationNotFound indicates the certification was not found
-var ErrCertificationNotFound = status.Error(codes.NotFound, "certificate not found")
+var ErrCertificationNotFound = api.ErrorCodeCertificateNotFound.Err()
 
 // CreateCertificate implements method for creating a new certificate
 func (svc *Service) CreateCertificate(ctx context.Context, req *orchestrator.CreateCertificateRequest) (
 	res *orchestrator.Certificate, err error) {
 
-	// Validate request
-	if err = api.Validate(req); err != nil {
-		return
-	}
-
 	// Check if client is allowed to access the corresponding cloud service (targeted in the certificate)
 	if !svc.authz.CheckAccess(ctx, service.AccessCreate, req) {
 		err = service.ErrPermissionDenied
 		return
 	}
 
+	// New certificates are issued, unless specified otherwise
+	if req.Certificate.CurrentState == orchestrator.CertificateState_CERTIFICATE_STATE_UNSPECIFIED {
+		req.Certificate.CurrentState = orchestrator.CertificateState_CERTIFICATE_STATE_ISSUED
+	}
+
+	// New certificates cannot be removed already
+	req.Certificate.DeletedAt = nil
+
 	// Persist the new certificate in our database
 	err = svc.storage.Create(req.Certificate)
 	if err != nil {
@@ -42,6 +50,8 @@ func (svc *Service) CreateCertificate(ctx context.Context, req *orchestrator.Cre
 		return
 	}
 
+	go svc.informWebhooksAboutCertificate(req.Certificate)
+
 	logging.LogRequest(log, logrus.DebugLevel, logging.Create, req)
 
 	// Return certificate
@@ -53,11 +63,6 @@ func (svc *Service) CreateCertificate(ctx context.Context, req *orchestrator.Cre
 func (svc *Service) GetCertificate(ctx context.Context, req *orchestrator.GetCertificateRequest) (
 	res *orchestrator.Certificate, err error) {
 
-	// Validate request
-	if err = api.Validate(req); err != nil {
-		return
-	}
-
 	res = new(orchestrator.Certificate)
 	err = svc.storage.Get(res, "Id = ?", req.CertificateId)
 	if errors.Is(err, persistence.ErrRecordNotFound) {
@@ -80,11 +85,6 @@ func (svc *Service) GetCertificate(ctx context.Context, req *orchestrator.GetCer
 func (svc *Service) ListCertificates(ctx context.Context, req *orchestrator.ListCertificatesRequest) (
 	res *orchestrator.ListCertificatesResponse, err error) {
 
-	// Validate request
-	if err = api.Validate(req); err != nil {
-		return nil, err
-	}
-
 	// We only list certificates the user is authorized to see (w.r.t. the cloud service)
 	var (
 		query []string
@@ -97,10 +97,17 @@ func (svc *Service) ListCertificates(ctx context.Context, req *orchestrator.List
 		args = append(args, allowed)
 	}
 
+	conds := persistence.BuildConds(query, args)
+
+	// Removed certificates are only included on request
+	if req.ShowDeleted {
+		conds = append([]any{gorm.WithDeleted()}, conds...)
+	}
+
 	res = new(orchestrator.ListCertificatesResponse)
 
 	res.Certificates, res.NextPageToken, err = service.PaginateStorage[*orchestrator.Certificate](req, svc.storage,
-		service.DefaultPaginationOpts, persistence.BuildConds(query, args)...)
+		service.DefaultPaginationOpts, conds...)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "could not paginate results: %v", err)
 	}
@@ -110,12 +117,6 @@ func (svc *Service) ListCertificates(ctx context.Context, req *orchestrator.List
 
 // ListPublicCertificates implements method for getting all certificates without the state history, e.g. to show its state in the UI
 func (svc *Service) ListPublicCertificates(_ context.Context, req *orchestrator.ListPublicCertificatesRequest) (res *orchestrator.ListPublicCertificatesResponse, err error) {
-	// Validate request
-	err = api.Validate(req)
-	if err != nil {
-		return nil, err
-	}
-
 	res = new(orchestrator.ListPublicCertificatesResponse)
 
 	res.Certificates, res.NextPageToken, err = service.PaginateStorage[*orchestrator.Certificate](req, svc.storage,
@@ -134,11 +135,6 @@ func (svc *Service) ListPublicCertificates(_ context.Context, req *orchestrator.
 
 // UpdateCertificate implements method for updating an existing certificate
 func (svc *Service) UpdateCertificate(ctx context.Context, req *orchestrator.UpdateCertificateRequest) (response *orchestrator.Certificate, err error) {
-	// Validate request
-	if err = api.Validate(req); err != nil {
-		return nil, err
-	}
-
 	// Check authorization
 	if !svc.authz.CheckAccess(ctx, service.AccessUpdate, req) {
 		err = service.ErrPermissionDenied
@@ -156,23 +152,102 @@ func (svc *Service) UpdateCertificate(ctx context.Context, req *orchestrator.Upd
 
 	response = req.Certificate
 
+	// Removing a certificate is only possible using RemoveCertificate
+	response.DeletedAt = nil
+
 	err = svc.storage.Save(response, "Id = ?", response.Id)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "database error: %v", err)
 	}
 
+	go svc.informWebhooksAboutCertificate(response)
+
 	logging.LogRequest(log, logrus.DebugLevel, logging.Update, req)
 
 	return
 }
 
-// RemoveCertificate implements method for removing a certificate. The response does not indicate whether there are no certificates available or the access is denied.
-func (svc *Service) RemoveCertificate(ctx context.Context, req *orchestrator.RemoveCertificateRequest) (response *emptypb.Empty, err error) {
-	// Validate request
-	if err = api.Validate(req); err != nil {
-		return nil, err
+// UpdateCertificateState implements method for transitioning a certificate into a new lifecycle state. The transition
+// is recorded in the state history of the certificate and the notification channels of the cloud service are informed.
+func (svc *Service) UpdateCertificateState(ctx context.Context, req *orchestrator.UpdateCertificateStateRequest) (res *orchestrator.Certificate, err error) {
+	res = new(orchestrator.Certificate)
+	err = svc.storage.Get(res, "id = ?", req.CertificateId)
+	if errors.Is(err, persistence.ErrRecordNotFound) {
+		return nil, ErrCertificationNotFound
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
+	// Check if client is allowed to access the corresponding cloud service (targeted in the certificate)
+	all, allowed := svc.authz.AllowedCloudServices(ctx)
+	if !all && !slices.Contains(allowed, res.CloudServiceId) {
+		return nil, service.ErrPermissionDenied
+	}
+
+	// An expired certificate can only be re-issued, if it has been renewed before
+	if req.State == orchestrator.CertificateState_CERTIFICATE_STATE_ISSUED {
+		expiration, err := parseCertificateDate(res.ExpirationDate)
+		if err == nil && !expiration.After(time.Now()) {
+			return nil, status.Errorf(codes.FailedPrecondition, "certificate has expired on %s", expiration.Format(time.DateOnly))
+		}
+	}
+
+	err = res.TransitionTo(req.State, req.Reason, req.Automatic, time.Now())
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+
+	err = svc.storage.Save(res, "id = ?", res.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
 	}
 
+	go svc.informWebhooksAboutCertificate(res)
+
+	err = svc.notifyAboutCertificateState(res, util.Deref(req.Reason))
+	if err != nil {
+		log.Warnf("Could not send notification about state of certificate %s: %v", res.Id, err)
+	}
+
+	logging.LogRequest(log, logrus.DebugLevel, logging.Update, req)
+
+	return res, nil
+}
+
+// notifyAboutCertificateState informs the notification channels of the cloud service about the current lifecycle state
+// of the certificate.
+func (svc *Service) notifyAboutCertificateState(cert *orchestrator.Certificate, reason string) error {
+	n := &orchestrator.Notification{
+		CloudServiceId: cert.CloudServiceId,
+		CertificateId:  &cert.Id,
+		Timestamp:      timestamppb.Now(),
+	}
+
+	switch cert.CurrentState {
+	case orchestrator.CertificateState_CERTIFICATE_STATE_SUSPENDED:
+		n.Severity = orchestrator.NotificationSeverity_NOTIFICATION_SEVERITY_HIGH
+		n.Title = fmt.Sprintf("Certificate %s has been suspended", cert.Name)
+	case orchestrator.CertificateState_CERTIFICATE_STATE_EXPIRED:
+		n.Severity = orchestrator.NotificationSeverity_NOTIFICATION_SEVERITY_CRITICAL
+		n.Title = fmt.Sprintf("Certificate %s has expired", cert.Name)
+	case orchestrator.CertificateState_CERTIFICATE_STATE_WITHDRAWN:
+		n.Severity = orchestrator.NotificationSeverity_NOTIFICATION_SEVERITY_CRITICAL
+		n.Title = fmt.Sprintf("Certificate %s has been withdrawn", cert.Name)
+	default:
+		n.Severity = orchestrator.NotificationSeverity_NOTIFICATION_SEVERITY_LOW
+		n.Title = fmt.Sprintf("Certificate %s has been issued", cert.Name)
+	}
+
+	n.Text = fmt.Sprintf("The certificate %s is now %s.", cert.Name, cert.CurrentState.Name())
+	if reason != "" {
+		n.Text += fmt.Sprintf(" Reason: %s", reason)
+	}
+
+	return svc.notify(n)
+}
+
+// RemoveCertificate implements method for removing a certificate. The response does not indicate whether there are no certificates available or the access is denied.
+func (svc *Service) RemoveCertificate(ctx context.Context, req *orchestrator.RemoveCertificateRequest) (response *emptypb.Empty, err error) {
 	// Lookup if certificate entry is in DB. If not, return NotFound error
 	if err = svc.checkExistence(req); err != nil {
 		return
@@ -183,17 +258,57 @@ func (svc *Service) RemoveCertificate(ctx context.Context, req *orchestrator.Rem
 		return
 	}
 
+	// Retrieve the certificate before we delete it, so that we can inform the webhooks about it
+	cert := new(orchestrator.Certificate)
+	err = svc.storage.Get(cert, "Id = ?", req.CertificateId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
 	// Delete entry since client is authorized to do so
 	err = svc.storage.Delete(&orchestrator.Certificate{}, "Id = ?", req.CertificateId)
 	if err != nil { // Only internal errors left since others (Permission and NotFound) are already covered
 		return nil, status.Errorf(codes.Internal, "database error: %v", err)
 	}
 
+	go svc.informWebhooksAboutCertificate(cert)
+
 	logging.LogRequest(log, logrus.DebugLevel, logging.Remove, req)
 
 	return &emptypb.Empty{}, nil
 }
 
+// RestoreCertificate implements method for restoring a removed certificate, which has not been purged yet. Only
+// users authorized for the corresponding cloud service can restore the certificate.
+func (svc *Service) RestoreCertificate(ctx context.Context, req *orchestrator.RestoreCertificateRequest) (res *orchestrator.Certificate, err error) {
+	res = new(orchestrator.Certificate)
+	err = svc.storage.Get(res, gorm.WithDeleted(), "id = ? AND deleted_at IS NOT NULL", req.CertificateId)
+	if errors.Is(err, persistence.ErrRecordNotFound) {
+		return nil, status.Error(codes.NotFound, "removed certificate not found")
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
+	// Check if client is allowed to access the corresponding cloud service (targeted in the certificate)
+	all, allowed := svc.authz.AllowedCloudServices(ctx)
+	if !all && !slices.Contains(allowed, res.CloudServiceId) {
+		return nil, service.ErrPermissionDenied
+	}
+
+	err = svc.storage.Restore(&orchestrator.Certificate{}, "id = ?", req.CertificateId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
+	res.DeletedAt = nil
+
+	go svc.informWebhooksAboutCertificate(res)
+
+	logging.LogRequest(log, logrus.DebugLevel, logging.Restore, req)
+
+	return res, nil
+}
+
 // checkAuthorization checks if client is authorized to remove certificate by
 // 1) checking admin flag: If it is enabled (`all`) the client is authorized
 // 2) querying the DB within the range of cloud services (`allowed`) the client is allowed to access
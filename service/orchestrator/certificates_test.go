@@ -14,6 +14,7 @@ import (
 	"clouditor.io/clouditor/v2/internal/testutil/assert"
 	"clouditor.io/clouditor/v2/internal/testutil/servicetest"
 	"clouditor.io/clouditor/v2/internal/testutil/servicetest/orchestratortest"
+	"clouditor.io/clouditor/v2/internal/util"
 	"clouditor.io/clouditor/v2/persistence"
 	"clouditor.io/clouditor/v2/service"
 
@@ -142,7 +143,7 @@ func Test_CreateCertificate(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			svc := tt.fields.svc
-			gotRes, err := svc.CreateCertificate(tt.args.in0, tt.args.req)
+			gotRes, err := servicetest.Validated(svc.CreateCertificate)(tt.args.in0, tt.args.req)
 			if tt.wantRes != nil {
 				assert.NoError(t, api.Validate(gotRes))
 			}
@@ -248,7 +249,7 @@ func Test_GetCertificate(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			gotRes, err := tt.fields.svc.GetCertificate(context.Background(), tt.req)
+			gotRes, err := servicetest.Validated(tt.fields.svc.GetCertificate)(context.Background(), tt.req)
 
 			// Run ErrorAssertionFunc
 			tt.wantErr(t, err)
@@ -356,7 +357,7 @@ func Test_ListCertificates(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			res, err := tt.fields.svc.ListCertificates(context.TODO(), tt.args.req)
+			res, err := servicetest.Validated(tt.fields.svc.ListCertificates)(context.TODO(), tt.args.req)
 			tt.wantRes(t, res)
 			tt.wantErr(t, err)
 		})
@@ -458,7 +459,7 @@ func TestService_ListPublicCertificates(t *testing.T) {
 				events:                          tt.fields.events,
 				authz:                           tt.fields.authz,
 			}
-			gotRes, err := svc.ListPublicCertificates(tt.args.in0, tt.args.req)
+			gotRes, err := servicetest.Validated(svc.ListPublicCertificates)(tt.args.in0, tt.args.req)
 
 			tt.wantErr(t, err)
 
@@ -621,7 +622,7 @@ func Test_UpdateCertificate(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			gotRes, err := tt.fields.svc.UpdateCertificate(context.TODO(), tt.args.req)
+			gotRes, err := servicetest.Validated(tt.fields.svc.UpdateCertificate)(context.TODO(), tt.args.req)
 			// Run ErrorAssertionFunc
 			tt.wantErr(t, err)
 			// Assert response
@@ -633,6 +634,154 @@ func Test_UpdateCertificate(t *testing.T) {
 	}
 }
 
+func TestService_UpdateCertificateState(t *testing.T) {
+	type fields struct {
+		svc *Service
+	}
+	type args struct {
+		req *orchestrator.UpdateCertificateStateRequest
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantRes assert.Want[*orchestrator.Certificate]
+		wantErr assert.WantErr
+	}{
+		{
+			name:   "Validation error",
+			fields: fields{svc: NewService()},
+			args: args{
+				req: &orchestrator.UpdateCertificateStateRequest{CertificateId: testdata.MockCertificateID},
+			},
+			wantRes: assert.Nil[*orchestrator.Certificate],
+			wantErr: func(t *testing.T, err error) bool {
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+				return assert.ErrorContains(t, err, "state")
+			},
+		},
+		{
+			name: "Not found",
+			fields: fields{svc: NewService(
+				WithStorage(testutil.NewInMemoryStorage(t)),
+			)},
+			args: args{
+				req: &orchestrator.UpdateCertificateStateRequest{
+					CertificateId: testdata.MockCertificateID,
+					State:         orchestrator.CertificateState_CERTIFICATE_STATE_SUSPENDED,
+				},
+			},
+			wantRes: assert.Nil[*orchestrator.Certificate],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, ErrCertificationNotFound)
+			},
+		},
+		{
+			name: "Permission denied",
+			fields: fields{svc: NewService(
+				WithAuthorizationStrategy(servicetest.NewAuthorizationStrategy(false, testdata.MockCloudServiceID2)),
+				WithStorage(testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					assert.NoError(t, s.Create(orchestratortest.NewCertificate()))
+				})),
+			)},
+			args: args{
+				req: &orchestrator.UpdateCertificateStateRequest{
+					CertificateId: testdata.MockCertificateID,
+					State:         orchestrator.CertificateState_CERTIFICATE_STATE_SUSPENDED,
+				},
+			},
+			wantRes: assert.Nil[*orchestrator.Certificate],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, service.ErrPermissionDenied)
+			},
+		},
+		{
+			name: "Invalid transition",
+			fields: fields{svc: NewService(
+				WithStorage(testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					cert := orchestratortest.NewCertificate()
+					cert.CurrentState = orchestrator.CertificateState_CERTIFICATE_STATE_EXPIRED
+					assert.NoError(t, s.Create(cert))
+				})),
+			)},
+			args: args{
+				req: &orchestrator.UpdateCertificateStateRequest{
+					CertificateId: testdata.MockCertificateID,
+					State:         orchestrator.CertificateState_CERTIFICATE_STATE_SUSPENDED,
+				},
+			},
+			wantRes: assert.Nil[*orchestrator.Certificate],
+			wantErr: func(t *testing.T, err error) bool {
+				assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+				return assert.ErrorContains(t, err, orchestrator.ErrInvalidStateTransition.Error())
+			},
+		},
+		{
+			name: "Cannot re-issue expired certificate",
+			fields: fields{svc: NewService(
+				WithStorage(testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					cert := orchestratortest.NewCertificate()
+					cert.CurrentState = orchestrator.CertificateState_CERTIFICATE_STATE_SUSPENDED
+					assert.NoError(t, s.Create(cert))
+				})),
+			)},
+			args: args{
+				req: &orchestrator.UpdateCertificateStateRequest{
+					CertificateId: testdata.MockCertificateID,
+					State:         orchestrator.CertificateState_CERTIFICATE_STATE_ISSUED,
+				},
+			},
+			wantRes: assert.Nil[*orchestrator.Certificate],
+			wantErr: func(t *testing.T, err error) bool {
+				assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+				return assert.ErrorContains(t, err, "certificate has expired")
+			},
+		},
+		{
+			name: "Happy path",
+			fields: fields{svc: NewService(
+				WithAuthorizationStrategy(servicetest.NewAuthorizationStrategy(false, testdata.MockCloudServiceID1)),
+				WithStorage(testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					cert := orchestratortest.NewCertificate()
+					cert.CurrentState = orchestrator.CertificateState_CERTIFICATE_STATE_ISSUED
+					assert.NoError(t, s.Create(cert))
+				})),
+			)},
+			args: args{
+				req: &orchestrator.UpdateCertificateStateRequest{
+					CertificateId: testdata.MockCertificateID,
+					State:         orchestrator.CertificateState_CERTIFICATE_STATE_SUSPENDED,
+					Reason:        util.Ref("Audit finding"),
+				},
+			},
+			wantRes: func(t *testing.T, got *orchestrator.Certificate) bool {
+				assert.Equal(t, orchestrator.CertificateState_CERTIFICATE_STATE_SUSPENDED, got.CurrentState)
+				if !assert.Equal(t, 2, len(got.States)) {
+					return false
+				}
+
+				assert.Equal(t, "suspended", got.States[1].State)
+				return assert.Equal(t, "Audit finding", util.Deref(got.States[1].Reason))
+			},
+			wantErr: assert.Nil[error],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotRes, err := servicetest.Validated(tt.fields.svc.UpdateCertificateState)(context.Background(), tt.args.req)
+			tt.wantErr(t, err)
+			tt.wantRes(t, gotRes)
+
+			// Make sure that the state history was persisted as well
+			if gotRes != nil {
+				cert := new(orchestrator.Certificate)
+				assert.NoError(t, tt.fields.svc.storage.Get(cert, "id = ?", gotRes.Id))
+				assert.Equal(t, len(gotRes.States), len(cert.States))
+			}
+		})
+	}
+}
+
 func Test_RemoveCertificate(t *testing.T) {
 	type fields struct {
 		svc *Service
@@ -796,7 +945,107 @@ func Test_RemoveCertificate(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			res, err := tt.fields.svc.RemoveCertificate(context.TODO(), tt.args.req)
+			res, err := servicetest.Validated(tt.fields.svc.RemoveCertificate)(context.TODO(), tt.args.req)
+			tt.wantRes(t, res)
+			tt.wantErr(t, err)
+			assert.Optional(t, tt.wantSvc, tt.fields.svc)
+		})
+	}
+}
+
+func Test_RestoreCertificate(t *testing.T) {
+	// withRemovedCertificate creates a storage containing the removed mock certificate
+	withRemovedCertificate := func(s persistence.Storage) {
+		assert.NoError(t, s.Create(orchestratortest.NewCertificate()))
+		assert.NoError(t, s.Delete(&orchestrator.Certificate{}, "id = ?", testdata.MockCertificateID))
+	}
+
+	type fields struct {
+		svc *Service
+	}
+	type args struct {
+		req *orchestrator.RestoreCertificateRequest
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantRes assert.Want[*orchestrator.Certificate]
+		wantSvc assert.Want[*Service]
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "Validation Error - certificate id is empty",
+			fields: fields{
+				svc: NewService(),
+			},
+			args: args{
+				req: &orchestrator.RestoreCertificateRequest{CertificateId: ""},
+			},
+			wantRes: assert.Nil[*orchestrator.Certificate],
+			wantErr: func(t assert.TestingT, err error, _ ...interface{}) bool {
+				return assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			name: "Error - Not removed",
+			fields: fields{
+				svc: NewService(
+					WithStorage(testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+						assert.NoError(t, s.Create(orchestratortest.NewCertificate()))
+					}))),
+			},
+			args: args{
+				req: &orchestrator.RestoreCertificateRequest{CertificateId: testdata.MockCertificateID},
+			},
+			wantRes: assert.Nil[*orchestrator.Certificate],
+			wantErr: func(t assert.TestingT, err error, _ ...interface{}) bool {
+				return assert.Equal(t, codes.NotFound, status.Code(err))
+			},
+		},
+		{
+			name: "Error - Permission denied",
+			fields: fields{
+				svc: NewService(
+					WithAuthorizationStrategy(servicetest.NewAuthorizationStrategy(
+						false, testdata.MockCloudServiceID2)),
+					WithStorage(testutil.NewInMemoryStorage(t, withRemovedCertificate))),
+			},
+			args: args{
+				req: &orchestrator.RestoreCertificateRequest{CertificateId: testdata.MockCertificateID},
+			},
+			wantRes: assert.Nil[*orchestrator.Certificate],
+			wantErr: func(t assert.TestingT, err error, _ ...interface{}) bool {
+				return assert.ErrorIs(t, err, service.ErrPermissionDenied)
+			},
+		},
+		{
+			name: "Happy path",
+			fields: fields{
+				svc: NewService(
+					WithAuthorizationStrategy(servicetest.NewAuthorizationStrategy(
+						false, testdata.MockCloudServiceID1)),
+					WithStorage(testutil.NewInMemoryStorage(t, withRemovedCertificate))),
+			},
+			args: args{
+				req: &orchestrator.RestoreCertificateRequest{CertificateId: testdata.MockCertificateID},
+			},
+			wantRes: func(t *testing.T, got *orchestrator.Certificate) bool {
+				assert.Equal(t, testdata.MockCertificateID, got.Id)
+				return assert.Nil(t, got.DeletedAt)
+			},
+			wantSvc: func(t *testing.T, got *Service) bool {
+				n, err := got.storage.Count(&orchestrator.Certificate{}, "id = ?", testdata.MockCertificateID)
+				assert.NoError(t, err)
+				return assert.Equal(t, int64(1), n)
+			},
+			wantErr: assert.NoError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := servicetest.Validated(tt.fields.svc.RestoreCertificate)(context.TODO(), tt.args.req)
 			tt.wantRes(t, res)
 			tt.wantErr(t, err)
 			assert.Optional(t, tt.wantSvc, tt.fields.svc)
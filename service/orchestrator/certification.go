@@ -0,0 +1,167 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/internal/certification"
+	"clouditor.io/clouditor/v2/internal/logging"
+	"clouditor.io/clouditor/v2/persistence"
+	"clouditor.io/clouditor/v2/service"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// CertificationAuditUser is the user of the audit log entries that record the state changes of certificates derived
+// by the certification lifecycle engine.
+const CertificationAuditUser = "system:certification"
+
+// WithCertificationPolicy is an option to configure the observation window and the durations after which the
+// certification lifecycle engine suspends, continues or withdraws certificates.
+func WithCertificationPolicy(policy certification.Policy) ServiceOption {
+	return func(s *Service) {
+		s.certification = certification.NewEngine(policy)
+	}
+}
+
+// ReportCertificationObservation implements method for reporting the compliance of a target of evaluation. The
+// observation is stored and the certification lifecycle engine derives the state changes of all certificates of the
+// target of evaluation from the observations within its observation window. Each state change is recorded in the state
+// history of the certificate and in the audit log and the webhooks and notification channels are informed.
+func (svc *Service) ReportCertificationObservation(ctx context.Context, req *orchestrator.ReportCertificationObservationRequest) (res *orchestrator.ReportCertificationObservationResponse, err error) {
+	var (
+		observations []*orchestrator.CertificationObservation
+		certs        []*orchestrator.Certificate
+		now          = time.Now()
+	)
+
+	// Check, if this request has access to the cloud service according to our authorization strategy.
+	if !svc.authz.CheckAccess(ctx, service.AccessUpdate, req) {
+		return nil, service.ErrPermissionDenied
+	}
+
+	o := req.Observation
+	o.Id = uuid.NewString()
+	if o.Timestamp == nil {
+		o.Timestamp = timestamppb.New(now)
+	}
+
+	err = svc.storage.Create(o)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not add observation to the database: %v", err)
+	}
+
+	// Discard observations that left the observation window
+	since := svc.certification.Since(now)
+	err = svc.storage.Delete(&orchestrator.CertificationObservation{}, "cloud_service_id = ? AND catalog_id = ? AND timestamp < ?",
+		o.CloudServiceId, o.CatalogId, since)
+	if err != nil && !errors.Is(err, persistence.ErrRecordNotFound) {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
+	err = svc.storage.List(&observations, "timestamp", true, 0, -1, "cloud_service_id = ? AND catalog_id = ?",
+		o.CloudServiceId, o.CatalogId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
+	err = svc.storage.List(&certs, "", true, 0, -1, "cloud_service_id = ? AND catalog_id = ?", o.CloudServiceId, o.CatalogId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
+	res = new(orchestrator.ReportCertificationObservationResponse)
+
+	for _, cert := range certs {
+		d := svc.certification.Decide(cert, observations, now)
+		if d.Action == certification.ActionNone {
+			continue
+		}
+
+		err = svc.transitionCertificate(ctx, cert, d, now)
+		if err != nil {
+			return nil, err
+		}
+
+		res.ChangedCertificates = append(res.ChangedCertificates, cert)
+	}
+
+	logging.LogRequest(log, logrus.DebugLevel, logging.Create, req)
+
+	return res, nil
+}
+
+// transitionCertificate applies the decision of the certification lifecycle engine to the certificate, records it in
+// the audit log and informs the webhooks and notification channels about it.
+func (svc *Service) transitionCertificate(ctx context.Context, cert *orchestrator.Certificate, d certification.Decision, now time.Time) (err error) {
+	var (
+		from = cert.CurrentState
+		to   = d.Action.State()
+	)
+
+	err = cert.TransitionTo(to, &d.Reason, true, now)
+	if err != nil {
+		return status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+
+	err = svc.storage.Save(cert, "id = ?", cert.Id)
+	if err != nil {
+		return status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
+	msg := fmt.Sprintf("Certificate %s transitioned from %s to %s: %s", cert.Id, from.Name(), to.Name(), d.Reason)
+	err = svc.RecordAuditLogEntry(ctx, &orchestrator.AuditLogEntry{
+		Timestamp:      timestamppb.New(now),
+		UserId:         CertificationAuditUser,
+		Method:         orchestrator.Orchestrator_UpdateCertificateState_FullMethodName,
+		CloudServiceId: &cert.CloudServiceId,
+		Code:           codes.OK.String(),
+		Message:        &msg,
+	})
+	if err != nil {
+		log.Errorf("Could not record state change of certificate %s in the audit log: %v", cert.Id, err)
+	}
+
+	go svc.informWebhooksAboutCertificate(cert)
+
+	err = svc.notifyAboutCertificateState(cert, d.Reason)
+	if err != nil {
+		log.Warnf("Could not send notification about state of certificate %s: %v", cert.Id, err)
+	}
+
+	log.Infof("Certificate %s of Cloud Service '%s' is now %s", cert.Id, cert.CloudServiceId, to.Name())
+
+	return nil
+}
@@ -0,0 +1,177 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package orchestrator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/internal/certification"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/testutil/servicetest"
+	"clouditor.io/clouditor/v2/internal/testutil/servicetest/orchestratortest"
+	"clouditor.io/clouditor/v2/internal/util"
+	"clouditor.io/clouditor/v2/persistence"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func newMockObservation(compliant bool) *orchestrator.CertificationObservation {
+	o := &orchestrator.CertificationObservation{
+		CloudServiceId: testdata.MockCloudServiceID1,
+		CatalogId:      testdata.MockCatalogID,
+		Compliant:      compliant,
+	}
+
+	if !compliant {
+		o.NonCompliantControls = []string{testdata.MockControlID1}
+	}
+
+	return o
+}
+
+func TestService_ReportCertificationObservation(t *testing.T) {
+	newCertificate := func(cert *orchestrator.Certificate) {
+		cert.CatalogId = util.Ref(testdata.MockCatalogID)
+		cert.ExpirationDate = time.Now().AddDate(1, 0, 0).Format(time.RFC3339)
+		cert.CurrentState = orchestrator.CertificateState_CERTIFICATE_STATE_ISSUED
+	}
+
+	tests := []struct {
+		name         string
+		policy       certification.Policy
+		observations []*orchestrator.CertificationObservation
+		req          *orchestrator.ReportCertificationObservationRequest
+		allowed      bool
+		wantState    orchestrator.CertificateState
+		wantErr      assert.WantErr
+	}{
+		{
+			name:      "Validation error",
+			policy:    certification.DefaultPolicy(),
+			req:       &orchestrator.ReportCertificationObservationRequest{},
+			allowed:   true,
+			wantState: orchestrator.CertificateState_CERTIFICATE_STATE_ISSUED,
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			name:      "Permission denied",
+			policy:    certification.DefaultPolicy(),
+			req:       &orchestrator.ReportCertificationObservationRequest{Observation: newMockObservation(false)},
+			wantState: orchestrator.CertificateState_CERTIFICATE_STATE_ISSUED,
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.Equal(t, codes.PermissionDenied, status.Code(err))
+			},
+		},
+		{
+			name:      "Suspend immediately",
+			policy:    certification.DefaultPolicy(),
+			req:       &orchestrator.ReportCertificationObservationRequest{Observation: newMockObservation(false)},
+			allowed:   true,
+			wantState: orchestrator.CertificateState_CERTIFICATE_STATE_SUSPENDED,
+			wantErr:   assert.Nil[error],
+		},
+		{
+			name: "Not compliant long enough to suspend",
+			policy: certification.Policy{
+				ObservationWindow: 24 * time.Hour,
+				SuspendAfter:      time.Hour,
+			},
+			observations: []*orchestrator.CertificationObservation{
+				func() *orchestrator.CertificationObservation {
+					o := newMockObservation(false)
+					o.Id = "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa"
+					o.Timestamp = timestamppb.New(time.Now().Add(-2 * time.Hour))
+					return o
+				}(),
+			},
+			req:       &orchestrator.ReportCertificationObservationRequest{Observation: newMockObservation(false)},
+			allowed:   true,
+			wantState: orchestrator.CertificateState_CERTIFICATE_STATE_SUSPENDED,
+			wantErr:   assert.Nil[error],
+		},
+		{
+			name: "Hysteresis: not compliant too briefly",
+			policy: certification.Policy{
+				ObservationWindow: 24 * time.Hour,
+				SuspendAfter:      time.Hour,
+			},
+			req:       &orchestrator.ReportCertificationObservationRequest{Observation: newMockObservation(false)},
+			allowed:   true,
+			wantState: orchestrator.CertificateState_CERTIFICATE_STATE_ISSUED,
+			wantErr:   assert.Nil[error],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			storage := testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+				assert.NoError(t, s.Create(orchestratortest.NewCertificate(newCertificate)))
+				for _, o := range tt.observations {
+					assert.NoError(t, s.Create(o))
+				}
+			})
+
+			svc := NewService(
+				WithStorage(storage),
+				WithAuthorizationStrategy(servicetest.NewAuthorizationStrategy(tt.allowed)),
+				WithCertificationPolicy(tt.policy),
+			)
+
+			res, err := servicetest.Validated(svc.ReportCertificationObservation)(context.Background(), tt.req)
+			if !tt.wantErr(t, err) {
+				return
+			}
+
+			cert := new(orchestrator.Certificate)
+			assert.NoError(t, storage.Get(cert, "id = ?", testdata.MockCertificateID))
+			assert.Equal(t, tt.wantState, cert.CurrentState)
+
+			if err != nil || tt.wantState == orchestrator.CertificateState_CERTIFICATE_STATE_ISSUED {
+				return
+			}
+
+			// The state change is part of the response and recorded in the audit log
+			if assert.Equal(t, 1, len(res.ChangedCertificates)) {
+				assert.Equal(t, tt.wantState, res.ChangedCertificates[0].CurrentState)
+			}
+
+			var entries []*orchestrator.AuditLogEntry
+			assert.NoError(t, storage.List(&entries, "", true, 0, -1))
+			if assert.Equal(t, 1, len(entries)) {
+				assert.Equal(t, CertificationAuditUser, entries[0].UserId)
+				assert.Equal(t, testdata.MockCloudServiceID1, entries[0].GetCloudServiceId())
+			}
+		})
+	}
+}
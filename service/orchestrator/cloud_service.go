@@ -37,6 +37,7 @@ import (
 	"clouditor.io/clouditor/v2/api/orchestrator"
 	"clouditor.io/clouditor/v2/internal/logging"
 	"clouditor.io/clouditor/v2/persistence"
+	"clouditor.io/clouditor/v2/persistence/gorm"
 	"clouditor.io/clouditor/v2/service"
 
 	"github.com/google/uuid"
@@ -54,10 +55,12 @@ const (
 )
 
 func (s *Service) RegisterCloudService(ctx context.Context, req *orchestrator.RegisterCloudServiceRequest) (res *orchestrator.CloudService, err error) {
-	// Validate request
-	err = api.Validate(req)
-	if err != nil {
-		return nil, err
+	// Only members of an organization can register a cloud service within it
+	if req.CloudService.OrganizationId != nil {
+		err = s.checkOrganizationAccess(ctx, req.CloudService.GetOrganizationId())
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	res = new(orchestrator.CloudService)
@@ -66,6 +69,16 @@ func (s *Service) RegisterCloudService(ctx context.Context, req *orchestrator.Re
 	res.Id = uuid.NewString()
 	res.Name = req.CloudService.Name
 	res.Description = req.CloudService.Description
+	res.OrganizationId = req.CloudService.OrganizationId
+	res.Tags = req.CloudService.Tags
+	res.Owners = req.CloudService.Owners
+	res.Environment = req.CloudService.Environment
+	res.LifecycleState = req.CloudService.LifecycleState
+
+	// New cloud services are active, unless specified otherwise
+	if res.LifecycleState == orchestrator.LifecycleState_LIFECYCLE_STATE_UNSPECIFIED {
+		res.LifecycleState = orchestrator.LifecycleState_LIFECYCLE_STATE_ACTIVE
+	}
 
 	now := timestamppb.Now()
 
@@ -94,15 +107,12 @@ func (s *Service) RegisterCloudService(ctx context.Context, req *orchestrator.Re
 // ListCloudServices implements method for OrchestratorServer interface for listing all cloud services
 func (svc *Service) ListCloudServices(ctx context.Context, req *orchestrator.ListCloudServicesRequest) (
 	res *orchestrator.ListCloudServicesResponse, err error) {
-	var conds []any
-	var allowed []string
-	var all bool
-
-	// Validate request
-	err = api.Validate(req)
-	if err != nil {
-		return nil, err
-	}
+	var (
+		query   []string
+		args    []any
+		allowed []string
+		all     bool
+	)
 
 	res = new(orchestrator.ListCloudServicesResponse)
 
@@ -110,7 +120,37 @@ func (svc *Service) ListCloudServices(ctx context.Context, req *orchestrator.Lis
 	// to our storage request, if we are allowed to see all cloud services.
 	all, allowed = svc.authz.AllowedCloudServices(ctx)
 	if !all {
-		conds = append(conds, allowed)
+		query = append(query, "id IN ?")
+		args = append(args, allowed)
+	}
+
+	if req.Filter != nil {
+		if req.Filter.OrganizationId != nil {
+			query = append(query, "organization_id = ?")
+			args = append(args, req.Filter.GetOrganizationId())
+		}
+
+		// A cloud service needs to have all of the tags
+		tagQuery, tagArgs := tagConditions(req.Filter.Tags)
+		query = append(query, tagQuery...)
+		args = append(args, tagArgs...)
+
+		if req.Filter.Environment != nil {
+			query = append(query, "environment = ?")
+			args = append(args, req.Filter.GetEnvironment())
+		}
+
+		if req.Filter.LifecycleState != nil {
+			query = append(query, "lifecycle_state = ?")
+			args = append(args, req.Filter.GetLifecycleState())
+		}
+	}
+
+	conds := persistence.BuildConds(query, args)
+
+	// Removed cloud services are only included on request
+	if req.ShowDeleted {
+		conds = append([]any{gorm.WithDeleted()}, conds...)
 	}
 
 	// Paginate the cloud services according to the request
@@ -125,12 +165,6 @@ func (svc *Service) ListCloudServices(ctx context.Context, req *orchestrator.Lis
 
 // GetCloudService implements method for OrchestratorServer interface for getting a cloud service with provided id
 func (s *Service) GetCloudService(ctx context.Context, req *orchestrator.GetCloudServiceRequest) (response *orchestrator.CloudService, err error) {
-	// Validate request
-	err = api.Validate(req)
-	if err != nil {
-		return nil, err
-	}
-
 	// Check, if this request has access to the cloud service according to our authorization strategy.
 	if !s.authz.CheckAccess(ctx, service.AccessRead, req) {
 		return nil, service.ErrPermissionDenied
@@ -140,7 +174,7 @@ func (s *Service) GetCloudService(ctx context.Context, req *orchestrator.GetClou
 
 	err = s.storage.Get(response, "Id = ?", req.CloudServiceId)
 	if errors.Is(err, persistence.ErrRecordNotFound) {
-		return nil, status.Errorf(codes.NotFound, "service not found")
+		return nil, api.ErrorCodeCloudServiceNotFound.Err()
 	} else if err != nil {
 		return nil, status.Errorf(codes.Internal, "database error: %s", err)
 	}
@@ -150,12 +184,6 @@ func (s *Service) GetCloudService(ctx context.Context, req *orchestrator.GetClou
 
 // UpdateCloudService implements method for OrchestratorServer interface for updating a cloud service
 func (s *Service) UpdateCloudService(ctx context.Context, req *orchestrator.UpdateCloudServiceRequest) (res *orchestrator.CloudService, err error) {
-	// Validate request
-	err = api.Validate(req)
-	if err != nil {
-		return nil, err
-	}
-
 	// Check, if this request has access to the cloud service according to our authorization strategy.
 	if !s.authz.CheckAccess(ctx, service.AccessUpdate, req) {
 		return nil, service.ErrPermissionDenied
@@ -167,13 +195,28 @@ func (s *Service) UpdateCloudService(ctx context.Context, req *orchestrator.Upda
 	}
 
 	if count == 0 {
-		return nil, status.Error(codes.NotFound, "service not found")
+		return nil, api.ErrorCodeCloudServiceNotFound.Err()
+	}
+
+	// Only members of an organization can move a cloud service into it
+	if req.CloudService.OrganizationId != nil {
+		err = s.checkOrganizationAccess(ctx, req.CloudService.GetOrganizationId())
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	// Add id to response because otherwise it will overwrite ID with empty string
 	res = req.CloudService
 	res.UpdatedAt = timestamppb.Now()
 
+	// Removing a cloud service is only possible using RemoveCloudService
+	res.DeletedAt = nil
+
+	if res.LifecycleState == orchestrator.LifecycleState_LIFECYCLE_STATE_UNSPECIFIED {
+		res.LifecycleState = orchestrator.LifecycleState_LIFECYCLE_STATE_ACTIVE
+	}
+
 	// Since UpdateCloudService is a PUT method, we use storage.Save
 	err = s.storage.Save(res, "Id = ?", req.CloudService.Id)
 	if err != nil {
@@ -189,12 +232,6 @@ func (s *Service) UpdateCloudService(ctx context.Context, req *orchestrator.Upda
 
 // RemoveCloudService implements method for OrchestratorServer interface for removing a cloud service
 func (s *Service) RemoveCloudService(ctx context.Context, req *orchestrator.RemoveCloudServiceRequest) (response *emptypb.Empty, err error) {
-	// Validate request
-	err = api.Validate(req)
-	if err != nil {
-		return nil, err
-	}
-
 	// Check, if this request has access to the cloud service according to our authorization strategy.
 	if !s.authz.CheckAccess(ctx, service.AccessDelete, req) {
 		return nil, service.ErrPermissionDenied
@@ -202,7 +239,7 @@ func (s *Service) RemoveCloudService(ctx context.Context, req *orchestrator.Remo
 
 	err = s.storage.Delete(&orchestrator.CloudService{Id: req.CloudServiceId})
 	if errors.Is(err, persistence.ErrRecordNotFound) {
-		return nil, status.Errorf(codes.NotFound, "service not found")
+		return nil, api.ErrorCodeCloudServiceNotFound.Err()
 	} else if err != nil {
 		return nil, status.Errorf(codes.Internal, "database error: %s", err)
 	}
@@ -214,14 +251,36 @@ func (s *Service) RemoveCloudService(ctx context.Context, req *orchestrator.Remo
 	return &emptypb.Empty{}, nil
 }
 
-// GetCloudServiceStatistics implements method for OrchestratorServer interface for retrieving cloud service statistics
-func (s *Service) GetCloudServiceStatistics(ctx context.Context, req *orchestrator.GetCloudServiceStatisticsRequest) (response *orchestrator.GetCloudServiceStatisticsResponse, err error) {
-	// Validate request
-	err = api.Validate(req)
+// RestoreCloudService implements method for OrchestratorServer interface for restoring a removed cloud service, which
+// has not been purged yet
+func (s *Service) RestoreCloudService(ctx context.Context, req *orchestrator.RestoreCloudServiceRequest) (res *orchestrator.CloudService, err error) {
+	// Check, if this request has access to the cloud service according to our authorization strategy.
+	if !s.authz.CheckAccess(ctx, service.AccessDelete, req) {
+		return nil, service.ErrPermissionDenied
+	}
+
+	err = s.storage.Restore(&orchestrator.CloudService{}, "id = ?", req.CloudServiceId)
+	if errors.Is(err, persistence.ErrRecordNotFound) {
+		return nil, status.Errorf(codes.NotFound, "removed service not found")
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %s", err)
+	}
+
+	res = new(orchestrator.CloudService)
+	err = s.storage.Get(res, "id = ?", req.CloudServiceId)
 	if err != nil {
-		return nil, err
+		return nil, status.Errorf(codes.Internal, "database error: %s", err)
 	}
 
+	go s.informHooks(ctx, res, nil)
+
+	logging.LogRequest(log, logrus.DebugLevel, logging.Restore, req)
+
+	return res, nil
+}
+
+// GetCloudServiceStatistics implements method for OrchestratorServer interface for retrieving cloud service statistics
+func (s *Service) GetCloudServiceStatistics(ctx context.Context, req *orchestrator.GetCloudServiceStatisticsRequest) (response *orchestrator.GetCloudServiceStatisticsResponse, err error) {
 	// Check, if this request has access to the cloud service according to our authorization strategy.
 	if !s.authz.CheckAccess(ctx, service.AccessRead, req) {
 		return nil, service.ErrPermissionDenied
@@ -233,7 +292,7 @@ func (s *Service) GetCloudServiceStatistics(ctx context.Context, req *orchestrat
 	cloudService := new(orchestrator.CloudService)
 	err = s.storage.Get(cloudService, "Id = ?", req.CloudServiceId)
 	if errors.Is(err, persistence.ErrRecordNotFound) {
-		return nil, status.Errorf(codes.NotFound, "service not found")
+		return nil, api.ErrorCodeCloudServiceNotFound.Err()
 	} else if err != nil {
 		return nil, status.Errorf(codes.Internal, "database error getting cloud service: %s", err)
 	}
@@ -279,16 +338,32 @@ func (s *Service) CreateDefaultTargetCloudService() (service *orchestrator.Cloud
 	}
 
 	if count == 0 {
+		// The default target cloud service might have been removed, but not purged yet
+		err = s.storage.Restore(&orchestrator.CloudService{}, "id = ?", DefaultTargetCloudServiceId)
+		if err == nil {
+			service = new(orchestrator.CloudService)
+			err = s.storage.Get(service, "id = ?", DefaultTargetCloudServiceId)
+			if err != nil {
+				return nil, fmt.Errorf("storage error: %w", err)
+			}
+
+			log.Infof("Restored default target cloud service: %s", service.Id)
+			return
+		} else if !errors.Is(err, persistence.ErrRecordNotFound) {
+			return nil, fmt.Errorf("storage error: %w", err)
+		}
+
 		now := timestamppb.Now()
 
 		// Create a default target cloud service
 		service =
 			&orchestrator.CloudService{
-				Id:          DefaultTargetCloudServiceId,
-				Name:        DefaultTargetCloudServiceName,
-				Description: DefaultTargetCloudServiceDescription,
-				CreatedAt:   now,
-				UpdatedAt:   now,
+				Id:             DefaultTargetCloudServiceId,
+				Name:           DefaultTargetCloudServiceName,
+				Description:    DefaultTargetCloudServiceDescription,
+				CreatedAt:      now,
+				UpdatedAt:      now,
+				LifecycleState: orchestrator.LifecycleState_LIFECYCLE_STATE_ACTIVE,
 			}
 
 		// Save it in the database
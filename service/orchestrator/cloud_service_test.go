@@ -38,6 +38,7 @@ import (
 	"clouditor.io/clouditor/v2/internal/testutil"
 	"clouditor.io/clouditor/v2/internal/testutil/assert"
 	"clouditor.io/clouditor/v2/internal/testutil/servicetest"
+	"clouditor.io/clouditor/v2/internal/util"
 	"clouditor.io/clouditor/v2/persistence"
 	"clouditor.io/clouditor/v2/service"
 
@@ -81,6 +82,52 @@ func TestService_RegisterCloudService(t *testing.T) {
 					assert.Equal(t, codes.InvalidArgument, status.Code(err))
 			},
 		},
+		{
+			name: "Happy path: with tags, owners and environment",
+			req: &orchestrator.RegisterCloudServiceRequest{
+				CloudService: &orchestrator.CloudService{
+					Name:        "test",
+					Tags:        []string{"team:payments"},
+					Owners:      []*orchestrator.Contact{{Name: "Payments Team", Email: "payments@example.com"}},
+					Environment: orchestrator.Environment_ENVIRONMENT_STAGING,
+				},
+			},
+			res: &orchestrator.CloudService{
+				Name:           "test",
+				Metadata:       &orchestrator.CloudService_Metadata{},
+				Tags:           []string{"team:payments"},
+				Owners:         []*orchestrator.Contact{{Name: "Payments Team", Email: "payments@example.com"}},
+				Environment:    orchestrator.Environment_ENVIRONMENT_STAGING,
+				LifecycleState: orchestrator.LifecycleState_LIFECYCLE_STATE_ACTIVE,
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "invalid tag",
+			req: &orchestrator.RegisterCloudServiceRequest{
+				CloudService: &orchestrator.CloudService{
+					Name: "test",
+					Tags: []string{"not a tag"},
+				},
+			},
+			res: nil,
+			wantErr: func(tt assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorContains(t, err, "cloud_service.tags[0]: value does not match regex pattern")
+			},
+		},
+		{
+			name: "organization not found",
+			req: &orchestrator.RegisterCloudServiceRequest{
+				CloudService: &orchestrator.CloudService{
+					Name:           "test",
+					OrganizationId: util.Ref(testdata.MockOrganizationID),
+				},
+			},
+			res: nil,
+			wantErr: func(tt assert.TestingT, err error, i ...interface{}) bool {
+				return assert.Equal(t, codes.NotFound, status.Code(err))
+			},
+		},
 		{
 			name: "Happy path: without metadata as input",
 			req: &orchestrator.RegisterCloudServiceRequest{
@@ -90,9 +137,10 @@ func TestService_RegisterCloudService(t *testing.T) {
 				},
 			},
 			res: &orchestrator.CloudService{
-				Name:        "test",
-				Description: "some",
-				Metadata:    &orchestrator.CloudService_Metadata{},
+				Name:           "test",
+				Description:    "some",
+				Metadata:       &orchestrator.CloudService_Metadata{},
+				LifecycleState: orchestrator.LifecycleState_LIFECYCLE_STATE_ACTIVE,
 			},
 			wantErr: assert.NoError,
 		},
@@ -119,6 +167,7 @@ func TestService_RegisterCloudService(t *testing.T) {
 						"env":   "prod",
 					},
 				},
+				LifecycleState: orchestrator.LifecycleState_LIFECYCLE_STATE_ACTIVE,
 			},
 			wantErr: assert.NoError,
 		},
@@ -131,7 +180,7 @@ func TestService_RegisterCloudService(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			res, err := orchestratorService.RegisterCloudService(context.Background(), tt.req)
+			res, err := servicetest.Validated(orchestratorService.RegisterCloudService)(context.Background(), tt.req)
 			tt.wantErr(t, err)
 
 			if tt.res != nil {
@@ -196,9 +245,10 @@ func TestService_GetCloudService(t *testing.T) {
 			ctx:  context.Background(),
 			req:  &orchestrator.GetCloudServiceRequest{CloudServiceId: DefaultTargetCloudServiceId},
 			res: &orchestrator.CloudService{
-				Id:          DefaultTargetCloudServiceId,
-				Name:        DefaultTargetCloudServiceName,
-				Description: DefaultTargetCloudServiceDescription,
+				Id:             DefaultTargetCloudServiceId,
+				Name:           DefaultTargetCloudServiceName,
+				Description:    DefaultTargetCloudServiceDescription,
+				LifecycleState: orchestrator.LifecycleState_LIFECYCLE_STATE_ACTIVE,
 			},
 			wantErr: assert.NoError,
 		},
@@ -238,7 +288,7 @@ func TestService_GetCloudService(t *testing.T) {
 			_, err := tt.svc.CreateDefaultTargetCloudService()
 			assert.NoError(t, err)
 
-			res, err := tt.svc.GetCloudService(tt.ctx, tt.req)
+			res, err := servicetest.Validated(tt.svc.GetCloudService)(tt.ctx, tt.req)
 			tt.wantErr(t, err)
 
 			if tt.res != nil {
@@ -264,17 +314,17 @@ func TestService_UpdateCloudService(t *testing.T) {
 	orchestratorService := NewService(WithAuthorizationStrategy(servicetest.NewAuthorizationStrategy(false, testdata.MockCloudServiceID1)))
 
 	// 1st case: Service is nil
-	_, err = orchestratorService.UpdateCloudService(context.TODO(), &orchestrator.UpdateCloudServiceRequest{})
+	_, err = servicetest.Validated(orchestratorService.UpdateCloudService)(context.TODO(), &orchestrator.UpdateCloudServiceRequest{})
 	assert.Equal(t, codes.InvalidArgument, status.Code(err))
 
 	// 2nd case: Service ID is nil
-	_, err = orchestratorService.UpdateCloudService(context.TODO(), &orchestrator.UpdateCloudServiceRequest{
+	_, err = servicetest.Validated(orchestratorService.UpdateCloudService)(context.TODO(), &orchestrator.UpdateCloudServiceRequest{
 		CloudService: &orchestrator.CloudService{},
 	})
 	assert.Equal(t, codes.InvalidArgument, status.Code(err))
 
 	// 3rd case: Service not found since there are no services yet
-	_, err = orchestratorService.UpdateCloudService(context.TODO(), &orchestrator.UpdateCloudServiceRequest{
+	_, err = servicetest.Validated(orchestratorService.UpdateCloudService)(context.TODO(), &orchestrator.UpdateCloudServiceRequest{
 		CloudService: &orchestrator.CloudService{
 			Id:          testdata.MockCloudServiceID1,
 			Name:        DefaultTargetCloudServiceName,
@@ -293,7 +343,7 @@ func TestService_UpdateCloudService(t *testing.T) {
 	if err != nil {
 		return
 	}
-	cloudService, err = orchestratorService.UpdateCloudService(context.TODO(), &orchestrator.UpdateCloudServiceRequest{
+	cloudService, err = servicetest.Validated(orchestratorService.UpdateCloudService)(context.TODO(), &orchestrator.UpdateCloudServiceRequest{
 		CloudService: &orchestrator.CloudService{
 			Id:          testdata.MockCloudServiceID1,
 			Name:        "NewName",
@@ -317,12 +367,12 @@ func TestService_RemoveCloudService(t *testing.T) {
 	orchestratorService := NewService()
 
 	// 1st case: Empty service ID error
-	_, err = orchestratorService.RemoveCloudService(context.Background(), &orchestrator.RemoveCloudServiceRequest{CloudServiceId: ""})
+	_, err = servicetest.Validated(orchestratorService.RemoveCloudService)(context.Background(), &orchestrator.RemoveCloudServiceRequest{CloudServiceId: ""})
 	assert.Error(t, err)
 	assert.Equal(t, status.Code(err), codes.InvalidArgument)
 
 	// 2nd case: ErrRecordNotFound
-	_, err = orchestratorService.RemoveCloudService(context.Background(), &orchestrator.RemoveCloudServiceRequest{CloudServiceId: DefaultTargetCloudServiceId})
+	_, err = servicetest.Validated(orchestratorService.RemoveCloudService)(context.Background(), &orchestrator.RemoveCloudServiceRequest{CloudServiceId: DefaultTargetCloudServiceId})
 	assert.Error(t, err)
 	assert.Equal(t, status.Code(err), codes.NotFound)
 
@@ -332,17 +382,17 @@ func TestService_RemoveCloudService(t *testing.T) {
 	assert.NotNil(t, cloudServiceResponse)
 
 	// There is a record for cloud services in the DB (default one)
-	listCloudServicesResponse, err = orchestratorService.ListCloudServices(context.Background(), &orchestrator.ListCloudServicesRequest{})
+	listCloudServicesResponse, err = servicetest.Validated(orchestratorService.ListCloudServices)(context.Background(), &orchestrator.ListCloudServicesRequest{})
 	assert.NoError(t, err)
 	assert.NotNil(t, listCloudServicesResponse.Services)
 	assert.NotEmpty(t, listCloudServicesResponse.Services)
 
 	// Remove record
-	_, err = orchestratorService.RemoveCloudService(context.Background(), &orchestrator.RemoveCloudServiceRequest{CloudServiceId: DefaultTargetCloudServiceId})
+	_, err = servicetest.Validated(orchestratorService.RemoveCloudService)(context.Background(), &orchestrator.RemoveCloudServiceRequest{CloudServiceId: DefaultTargetCloudServiceId})
 	assert.NoError(t, err)
 
 	// There is a record for cloud services in the DB (default one)
-	listCloudServicesResponse, err = orchestratorService.ListCloudServices(context.Background(), &orchestrator.ListCloudServicesRequest{})
+	listCloudServicesResponse, err = servicetest.Validated(orchestratorService.ListCloudServices)(context.Background(), &orchestrator.ListCloudServicesRequest{})
 	assert.NoError(t, err)
 	assert.NotNil(t, listCloudServicesResponse.Services)
 	assert.Empty(t, listCloudServicesResponse.Services)
@@ -365,9 +415,10 @@ func TestService_CreateDefaultTargetCloudService(t *testing.T) {
 	cloudServiceResponse.UpdatedAt = nil
 
 	assert.Equal(t, &orchestrator.CloudService{
-		Id:          DefaultTargetCloudServiceId,
-		Name:        DefaultTargetCloudServiceName,
-		Description: DefaultTargetCloudServiceDescription,
+		Id:             DefaultTargetCloudServiceId,
+		Name:           DefaultTargetCloudServiceName,
+		Description:    DefaultTargetCloudServiceDescription,
+		LifecycleState: orchestrator.LifecycleState_LIFECYCLE_STATE_ACTIVE,
 	}, cloudServiceResponse)
 
 	// Check if CloudService is valid
@@ -377,6 +428,58 @@ func TestService_CreateDefaultTargetCloudService(t *testing.T) {
 	cloudServiceResponse, err = orchestratorService.CreateDefaultTargetCloudService()
 	assert.NoError(t, err)
 	assert.Nil(t, cloudServiceResponse)
+
+	// 3rd case: The default target service was removed, but not purged yet -> Default target service is restored
+	_, err = orchestratorService.RemoveCloudService(context.Background(), &orchestrator.RemoveCloudServiceRequest{CloudServiceId: DefaultTargetCloudServiceId})
+	assert.NoError(t, err)
+
+	cloudServiceResponse, err = orchestratorService.CreateDefaultTargetCloudService()
+	assert.NoError(t, err)
+	assert.Equal(t, DefaultTargetCloudServiceId, cloudServiceResponse.Id)
+	assert.Nil(t, cloudServiceResponse.DeletedAt)
+}
+
+func TestService_RestoreCloudService(t *testing.T) {
+	var (
+		cloudServiceResponse      *orchestrator.CloudService
+		err                       error
+		listCloudServicesResponse *orchestrator.ListCloudServicesResponse
+	)
+	orchestratorService := NewService()
+
+	// 1st case: Empty service ID error
+	_, err = servicetest.Validated(orchestratorService.RestoreCloudService)(context.Background(), &orchestrator.RestoreCloudServiceRequest{CloudServiceId: ""})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// 2nd case: Cloud service is not removed
+	_, err = orchestratorService.CreateDefaultTargetCloudService()
+	assert.NoError(t, err)
+
+	_, err = servicetest.Validated(orchestratorService.RestoreCloudService)(context.Background(), &orchestrator.RestoreCloudServiceRequest{CloudServiceId: DefaultTargetCloudServiceId})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = servicetest.Validated(orchestratorService.RemoveCloudService)(context.Background(), &orchestrator.RemoveCloudServiceRequest{CloudServiceId: DefaultTargetCloudServiceId})
+	assert.NoError(t, err)
+
+	// The removed cloud service is only listed on request
+	listCloudServicesResponse, err = servicetest.Validated(orchestratorService.ListCloudServices)(context.Background(), &orchestrator.ListCloudServicesRequest{})
+	assert.NoError(t, err)
+	assert.Empty(t, listCloudServicesResponse.Services)
+
+	listCloudServicesResponse, err = servicetest.Validated(orchestratorService.ListCloudServices)(context.Background(), &orchestrator.ListCloudServicesRequest{ShowDeleted: true})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(listCloudServicesResponse.Services))
+	assert.NotNil(t, listCloudServicesResponse.Services[0].DeletedAt)
+
+	// 3rd case: Cloud service restored successfully
+	cloudServiceResponse, err = servicetest.Validated(orchestratorService.RestoreCloudService)(context.Background(), &orchestrator.RestoreCloudServiceRequest{CloudServiceId: DefaultTargetCloudServiceId})
+	assert.NoError(t, err)
+	assert.Equal(t, DefaultTargetCloudServiceId, cloudServiceResponse.Id)
+	assert.Nil(t, cloudServiceResponse.DeletedAt)
+
+	listCloudServicesResponse, err = servicetest.Validated(orchestratorService.ListCloudServices)(context.Background(), &orchestrator.ListCloudServicesRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(listCloudServicesResponse.Services))
 }
 
 func TestService_ListCloudServices(t *testing.T) {
@@ -497,6 +600,92 @@ func TestService_ListCloudServices(t *testing.T) {
 			},
 			wantErr: assert.NoError,
 		},
+		{
+			name: "filter by organization",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					_ = s.Create(&orchestrator.CloudService{
+						Id:             testdata.MockCloudServiceID1,
+						Name:           testdata.MockCloudServiceName1,
+						OrganizationId: util.Ref(testdata.MockOrganizationID),
+					})
+					_ = s.Create(&orchestrator.CloudService{
+						Id:   testdata.MockCloudServiceID2,
+						Name: testdata.MockCloudServiceName2,
+					})
+				}),
+				authz: servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				ctx: context.TODO(),
+				req: &orchestrator.ListCloudServicesRequest{
+					Filter: &orchestrator.ListCloudServicesRequest_Filter{
+						OrganizationId: util.Ref(testdata.MockOrganizationID),
+					},
+				},
+			},
+			wantRes: &orchestrator.ListCloudServicesResponse{
+				Services: []*orchestrator.CloudService{
+					{
+						Id:             testdata.MockCloudServiceID1,
+						Name:           testdata.MockCloudServiceName1,
+						OrganizationId: util.Ref(testdata.MockOrganizationID),
+					},
+				},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "filter by tags, environment and lifecycle state",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					_ = s.Create(&orchestrator.CloudService{
+						Id:             testdata.MockCloudServiceID1,
+						Name:           testdata.MockCloudServiceName1,
+						Tags:           []string{"team:payments", "pci_dss"},
+						Environment:    orchestrator.Environment_ENVIRONMENT_PRODUCTION,
+						LifecycleState: orchestrator.LifecycleState_LIFECYCLE_STATE_ACTIVE,
+					})
+					_ = s.Create(&orchestrator.CloudService{
+						Id:             testdata.MockCloudServiceID2,
+						Name:           testdata.MockCloudServiceName2,
+						Tags:           []string{"team:payments", "pci-dss"},
+						Environment:    orchestrator.Environment_ENVIRONMENT_PRODUCTION,
+						LifecycleState: orchestrator.LifecycleState_LIFECYCLE_STATE_ACTIVE,
+					})
+					_ = s.Create(&orchestrator.CloudService{
+						Id:             DefaultTargetCloudServiceId,
+						Name:           DefaultTargetCloudServiceName,
+						Tags:           []string{"team:payments", "pci_dss"},
+						Environment:    orchestrator.Environment_ENVIRONMENT_DEVELOPMENT,
+						LifecycleState: orchestrator.LifecycleState_LIFECYCLE_STATE_ARCHIVED,
+					})
+				}),
+				authz: servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				ctx: context.TODO(),
+				req: &orchestrator.ListCloudServicesRequest{
+					Filter: &orchestrator.ListCloudServicesRequest_Filter{
+						Tags:           []string{"team:payments", "pci_dss"},
+						Environment:    util.Ref(orchestrator.Environment_ENVIRONMENT_PRODUCTION),
+						LifecycleState: util.Ref(orchestrator.LifecycleState_LIFECYCLE_STATE_ACTIVE),
+					},
+				},
+			},
+			wantRes: &orchestrator.ListCloudServicesResponse{
+				Services: []*orchestrator.CloudService{
+					{
+						Id:             testdata.MockCloudServiceID1,
+						Name:           testdata.MockCloudServiceName1,
+						Tags:           []string{"team:payments", "pci_dss"},
+						Environment:    orchestrator.Environment_ENVIRONMENT_PRODUCTION,
+						LifecycleState: orchestrator.LifecycleState_LIFECYCLE_STATE_ACTIVE,
+					},
+				},
+			},
+			wantErr: assert.NoError,
+		},
 	}
 
 	for _, tt := range tests {
@@ -689,7 +878,7 @@ func TestService_GetCloudServiceStatistics(t *testing.T) {
 				events:                tt.fields.events,
 				authz:                 tt.fields.authz,
 			}
-			gotRes, err := s.GetCloudServiceStatistics(tt.args.ctx, tt.args.req)
+			gotRes, err := servicetest.Validated(s.GetCloudServiceStatistics)(tt.args.ctx, tt.args.req)
 			tt.wantErr(t, err)
 			assert.Equal(t, tt.wantRes, gotRes)
 		})
@@ -0,0 +1,250 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"clouditor.io/clouditor/v2/api"
+	"clouditor.io/clouditor/v2/api/assessment"
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/internal/logging"
+	"clouditor.io/clouditor/v2/persistence"
+	"clouditor.io/clouditor/v2/persistence/gorm"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// controlMetric is an entry of the join table between controls and metrics, which is created by gorm for
+// [orchestrator.Control.Metrics].
+type controlMetric struct {
+	ControlId                string
+	ControlCategoryName      string
+	ControlCategoryCatalogId string
+	MetricId                 string
+}
+
+// TableName returns the name of the join table between controls and metrics.
+func (controlMetric) TableName() string {
+	return "control_metrics"
+}
+
+// AddControlMetric maps a metric to a control. It returns the control including its metrics.
+func (svc *Service) AddControlMetric(ctx context.Context, req *orchestrator.AddControlMetricRequest) (res *orchestrator.Control, err error) {
+	err = svc.checkCatalogAccess(ctx, req.CatalogId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check, if control and metric exist
+	count, err := svc.storage.Count(&orchestrator.Control{}, "id = ? AND category_name = ? AND category_catalog_id = ?",
+		req.ControlId, req.CategoryName, req.CatalogId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	} else if count == 0 {
+		return nil, api.ErrorCodeControlNotFound.Err()
+	}
+
+	count, err = svc.storage.Count(&assessment.Metric{}, "id = ?", req.MetricId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	} else if count == 0 {
+		return nil, ErrMetricNotFound
+	}
+
+	err = svc.storage.Create(&controlMetric{
+		ControlId:                req.ControlId,
+		ControlCategoryName:      req.CategoryName,
+		ControlCategoryCatalogId: req.CatalogId,
+		MetricId:                 req.MetricId,
+	})
+	if errors.Is(err, persistence.ErrUniqueConstraintFailed) {
+		return nil, status.Errorf(codes.AlreadyExists, "metric is already mapped to the control")
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
+	logging.LogRequest(log, logrus.DebugLevel, logging.Update, req, fmt.Sprintf("with added Metric '%s'", req.MetricId))
+
+	return svc.GetControl(ctx, &orchestrator.GetControlRequest{
+		CatalogId:    req.CatalogId,
+		CategoryName: req.CategoryName,
+		ControlId:    req.ControlId,
+	})
+}
+
+// RemoveControlMetric removes the mapping of a metric to a control.
+func (svc *Service) RemoveControlMetric(ctx context.Context, req *orchestrator.RemoveControlMetricRequest) (res *emptypb.Empty, err error) {
+	err = svc.checkCatalogAccess(ctx, req.CatalogId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = svc.storage.Delete(&controlMetric{},
+		"control_id = ? AND control_category_name = ? AND control_category_catalog_id = ? AND metric_id = ?",
+		req.ControlId, req.CategoryName, req.CatalogId, req.MetricId)
+	if errors.Is(err, persistence.ErrRecordNotFound) {
+		return nil, status.Errorf(codes.NotFound, "metric is not mapped to the control")
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
+	logging.LogRequest(log, logrus.DebugLevel, logging.Update, req, fmt.Sprintf("with removed Metric '%s'", req.MetricId))
+
+	return &emptypb.Empty{}, nil
+}
+
+// GetCatalogCoverage reports which controls of a catalog are covered by metrics. A control is covered, if at least
+// one metric is mapped to it or to one of its sub-controls.
+func (svc *Service) GetCatalogCoverage(ctx context.Context, req *orchestrator.GetCatalogCoverageRequest) (res *orchestrator.CatalogCoverage, err error) {
+	var (
+		controls []*orchestrator.Control
+		children = make(map[string][]*orchestrator.Control)
+		covered  = make(map[string]bool)
+	)
+
+	err = svc.checkCatalogAccess(ctx, req.CatalogId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Retrieve all controls of the catalog, including their metrics. The controls are ordered, so that the uncovered
+	// controls are reported in a stable order.
+	err = svc.storage.List(&controls, "id", true, 0, -1,
+		gorm.WithPreload("Metrics"), "category_catalog_id = ?", req.CatalogId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
+	for _, c := range controls {
+		if c.ParentControlId != nil {
+			parent := controlKey(c.GetParentControlCategoryName(), c.GetParentControlId())
+			children[parent] = append(children[parent], c)
+		}
+	}
+
+	// isCovered checks whether the control or one of its sub-controls has a metric
+	var isCovered func(c *orchestrator.Control) bool
+	isCovered = func(c *orchestrator.Control) bool {
+		key := controlKey(c.CategoryName, c.Id)
+		if v, ok := covered[key]; ok {
+			return v
+		}
+
+		// Mark the control as uncovered while we look at its sub-controls, so that a (broken) cycle cannot recurse
+		// endlessly
+		covered[key] = false
+
+		v := len(c.Metrics) > 0
+		for _, child := range children[key] {
+			// We need to visit all children anyway, so we do not short-circuit here
+			v = isCovered(child) || v
+		}
+
+		covered[key] = v
+		return v
+	}
+
+	res = &orchestrator.CatalogCoverage{
+		CatalogId:     req.CatalogId,
+		TotalControls: int32(len(controls)),
+	}
+
+	for _, c := range controls {
+		if isCovered(c) {
+			res.CoveredControls++
+			continue
+		}
+
+		res.UncoveredControls = append(res.UncoveredControls, &orchestrator.Control{
+			Id:                             c.Id,
+			CategoryName:                   c.CategoryName,
+			CategoryCatalogId:              c.CategoryCatalogId,
+			Name:                           c.Name,
+			Description:                    c.Description,
+			ParentControlId:                c.ParentControlId,
+			ParentControlCategoryName:      c.ParentControlCategoryName,
+			ParentControlCategoryCatalogId: c.ParentControlCategoryCatalogId,
+			AssuranceLevel:                 c.AssuranceLevel,
+		})
+	}
+
+	return res, nil
+}
+
+// ResultSeverity returns the severity of an assessment result, which is the highest severity of all controls its
+// metric is mapped to. If the metric is not mapped to any control with a severity or the controls cannot be retrieved,
+// [orchestrator.ControlSeverity_CONTROL_SEVERITY_UNSPECIFIED] is returned.
+func (svc *Service) ResultSeverity(result *assessment.AssessmentResult) (severity orchestrator.ControlSeverity) {
+	var controls []*orchestrator.Control
+
+	err := svc.storage.Raw(&controls, `SELECT controls.* FROM controls
+		JOIN control_metrics ON control_metrics.control_id = controls.id
+			AND control_metrics.control_category_name = controls.category_name
+			AND control_metrics.control_category_catalog_id = controls.category_catalog_id
+		WHERE control_metrics.metric_id = ?`, result.GetMetricId())
+	if err != nil {
+		log.Errorf("Could not retrieve controls of metric %s: %v", result.GetMetricId(), err)
+		return
+	}
+
+	for _, c := range controls {
+		if c.GetSeverity() > severity {
+			severity = c.GetSeverity()
+		}
+	}
+
+	return
+}
+
+// controlKey builds a key that identifies a control within a catalog.
+func controlKey(categoryName string, controlID string) string {
+	return categoryName + "/" + controlID
+}
+
+// checkCatalogAccess checks whether the catalog exists and, if it belongs to an organization, whether the user is a
+// member of it.
+func (svc *Service) checkCatalogAccess(ctx context.Context, catalogID string) (err error) {
+	var catalog orchestrator.Catalog
+
+	err = svc.storage.Get(&catalog, gorm.WithoutPreload(), "id = ?", catalogID)
+	if errors.Is(err, persistence.ErrRecordNotFound) {
+		return api.ErrorCodeCatalogNotFound.Err()
+	} else if err != nil {
+		return status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
+	if catalog.OrganizationId != nil {
+		return svc.checkOrganizationAccess(ctx, catalog.GetOrganizationId())
+	}
+
+	return nil
+}
@@ -0,0 +1,429 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package orchestrator
+
+import (
+	"context"
+	"testing"
+
+	"clouditor.io/clouditor/v2/api/assessment"
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/testutil/servicetest"
+	"clouditor.io/clouditor/v2/internal/testutil/servicetest/orchestratortest"
+	"clouditor.io/clouditor/v2/internal/util"
+	"clouditor.io/clouditor/v2/persistence"
+	"clouditor.io/clouditor/v2/service"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// newControlMetricStorage creates a new storage that contains the mock catalog with an additional control without
+// any metric and a second metric that is not mapped to any control.
+func newControlMetricStorage(t *testing.T) persistence.Storage {
+	return testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+		assert.NoError(t, s.Create(orchestratortest.NewCatalog()))
+		assert.NoError(t, s.Create(&orchestrator.Control{
+			Id:                testdata.MockControlID5,
+			Name:              testdata.MockControlName,
+			CategoryName:      testdata.MockCategoryName,
+			CategoryCatalogId: testdata.MockCatalogID,
+		}))
+		assert.NoError(t, s.Create(&assessment.Metric{
+			Id:   testdata.MockMetricID2,
+			Name: testdata.MockMetricName2,
+		}))
+	})
+}
+
+func TestService_AddControlMetric(t *testing.T) {
+	type fields struct {
+		storage persistence.Storage
+		authz   service.AuthorizationStrategy
+	}
+	type args struct {
+		req *orchestrator.AddControlMetricRequest
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    assert.Want[*orchestrator.Control]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "validation error",
+			fields: fields{
+				storage: newControlMetricStorage(t),
+				authz:   servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &orchestrator.AddControlMetricRequest{CatalogId: testdata.MockCatalogID},
+			},
+			want: assert.Nil[*orchestrator.Control],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "category_name: value length must be at least 1 characters")
+			},
+		},
+		{
+			name: "catalog not found",
+			fields: fields{
+				storage: newControlMetricStorage(t),
+				authz:   servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &orchestrator.AddControlMetricRequest{
+					CatalogId:    "not-there",
+					CategoryName: testdata.MockCategoryName,
+					ControlId:    testdata.MockControlID5,
+					MetricId:     testdata.MockMetricID2,
+				},
+			},
+			want: assert.Nil[*orchestrator.Control],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.Equal(t, codes.NotFound, status.Code(err))
+			},
+		},
+		{
+			name: "control not found",
+			fields: fields{
+				storage: newControlMetricStorage(t),
+				authz:   servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &orchestrator.AddControlMetricRequest{
+					CatalogId:    testdata.MockCatalogID,
+					CategoryName: testdata.MockCategoryName,
+					ControlId:    "not-there",
+					MetricId:     testdata.MockMetricID2,
+				},
+			},
+			want: assert.Nil[*orchestrator.Control],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "control not found")
+			},
+		},
+		{
+			name: "metric not found",
+			fields: fields{
+				storage: newControlMetricStorage(t),
+				authz:   servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &orchestrator.AddControlMetricRequest{
+					CatalogId:    testdata.MockCatalogID,
+					CategoryName: testdata.MockCategoryName,
+					ControlId:    testdata.MockControlID5,
+					MetricId:     "not-there",
+				},
+			},
+			want: assert.Nil[*orchestrator.Control],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, ErrMetricNotFound)
+			},
+		},
+		{
+			name: "already mapped",
+			fields: fields{
+				storage: newControlMetricStorage(t),
+				authz:   servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &orchestrator.AddControlMetricRequest{
+					CatalogId:    testdata.MockCatalogID,
+					CategoryName: testdata.MockCategoryName,
+					ControlId:    testdata.MockSubControlID11,
+					MetricId:     testdata.MockMetricID1,
+				},
+			},
+			want: assert.Nil[*orchestrator.Control],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.Equal(t, codes.AlreadyExists, status.Code(err))
+			},
+		},
+		{
+			name: "permission denied",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					catalog := orchestratortest.NewCatalog()
+					catalog.OrganizationId = util.Ref(testdata.MockOrganizationID)
+					assert.NoError(t, s.Create(mockOrganization))
+					assert.NoError(t, s.Create(catalog))
+				}),
+				authz: servicetest.NewAuthorizationStrategyWithUser(false, "other-user"),
+			},
+			args: args{
+				req: &orchestrator.AddControlMetricRequest{
+					CatalogId:    testdata.MockCatalogID,
+					CategoryName: testdata.MockCategoryName,
+					ControlId:    testdata.MockControlID1,
+					MetricId:     testdata.MockMetricID1,
+				},
+			},
+			want: assert.Nil[*orchestrator.Control],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, service.ErrPermissionDenied)
+			},
+		},
+		{
+			name: "happy path",
+			fields: fields{
+				storage: newControlMetricStorage(t),
+				authz:   servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &orchestrator.AddControlMetricRequest{
+					CatalogId:    testdata.MockCatalogID,
+					CategoryName: testdata.MockCategoryName,
+					ControlId:    testdata.MockControlID5,
+					MetricId:     testdata.MockMetricID2,
+				},
+			},
+			want: func(t *testing.T, got *orchestrator.Control) bool {
+				return assert.Equal(t, testdata.MockControlID5, got.Id) &&
+					assert.Equal(t, 1, len(got.Metrics)) &&
+					assert.Equal(t, testdata.MockMetricID2, got.Metrics[0].Id)
+			},
+			wantErr: assert.Nil[error],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				storage: tt.fields.storage,
+				authz:   tt.fields.authz,
+			}
+			got, err := servicetest.Validated(svc.AddControlMetric)(context.Background(), tt.args.req)
+
+			tt.wantErr(t, err)
+			tt.want(t, got)
+		})
+	}
+}
+
+func TestService_RemoveControlMetric(t *testing.T) {
+	type args struct {
+		req *orchestrator.RemoveControlMetricRequest
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    assert.Want[*emptypb.Empty]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "validation error",
+			args: args{
+				req: &orchestrator.RemoveControlMetricRequest{},
+			},
+			want: assert.Nil[*emptypb.Empty],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "catalog_id: value length must be at least 1 characters")
+			},
+		},
+		{
+			name: "not mapped",
+			args: args{
+				req: &orchestrator.RemoveControlMetricRequest{
+					CatalogId:    testdata.MockCatalogID,
+					CategoryName: testdata.MockCategoryName,
+					ControlId:    testdata.MockControlID5,
+					MetricId:     testdata.MockMetricID1,
+				},
+			},
+			want: assert.Nil[*emptypb.Empty],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "metric is not mapped to the control")
+			},
+		},
+		{
+			name: "happy path",
+			args: args{
+				req: &orchestrator.RemoveControlMetricRequest{
+					CatalogId:    testdata.MockCatalogID,
+					CategoryName: testdata.MockCategoryName,
+					ControlId:    testdata.MockSubControlID11,
+					MetricId:     testdata.MockMetricID1,
+				},
+			},
+			want:    assert.NotNil[*emptypb.Empty],
+			wantErr: assert.Nil[error],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				storage: newControlMetricStorage(t),
+				authz:   servicetest.NewAuthorizationStrategy(true),
+			}
+			got, err := servicetest.Validated(svc.RemoveControlMetric)(context.Background(), tt.args.req)
+
+			tt.wantErr(t, err)
+			tt.want(t, got)
+		})
+	}
+}
+
+func TestService_GetCatalogCoverage(t *testing.T) {
+	type fields struct {
+		storage persistence.Storage
+	}
+	type args struct {
+		req *orchestrator.GetCatalogCoverageRequest
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    assert.Want[*orchestrator.CatalogCoverage]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "validation error",
+			fields: fields{
+				storage: newControlMetricStorage(t),
+			},
+			args: args{
+				req: &orchestrator.GetCatalogCoverageRequest{},
+			},
+			want: assert.Nil[*orchestrator.CatalogCoverage],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "catalog_id: value length must be at least 1 characters")
+			},
+		},
+		{
+			name: "catalog not found",
+			fields: fields{
+				storage: newControlMetricStorage(t),
+			},
+			args: args{
+				req: &orchestrator.GetCatalogCoverageRequest{CatalogId: "not-there"},
+			},
+			want: assert.Nil[*orchestrator.CatalogCoverage],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.Equal(t, codes.NotFound, status.Code(err))
+			},
+		},
+		{
+			name: "parent controls are covered by their sub-controls",
+			fields: fields{
+				storage: newControlMetricStorage(t),
+			},
+			args: args{
+				req: &orchestrator.GetCatalogCoverageRequest{CatalogId: testdata.MockCatalogID},
+			},
+			want: func(t *testing.T, got *orchestrator.CatalogCoverage) bool {
+				return assert.Equal(t, testdata.MockCatalogID, got.CatalogId) &&
+					assert.Equal(t, int32(5), got.TotalControls) &&
+					assert.Equal(t, int32(4), got.CoveredControls) &&
+					assert.Equal(t, 1, len(got.UncoveredControls)) &&
+					assert.Equal(t, testdata.MockControlID5, got.UncoveredControls[0].Id)
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "uncovered sub-control",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					assert.NoError(t, s.Create(orchestratortest.NewCatalog()))
+					assert.NoError(t, s.Delete(&controlMetric{}, "control_id = ?", testdata.MockSubControlID11))
+				}),
+			},
+			args: args{
+				req: &orchestrator.GetCatalogCoverageRequest{CatalogId: testdata.MockCatalogID},
+			},
+			want: func(t *testing.T, got *orchestrator.CatalogCoverage) bool {
+				return assert.Equal(t, int32(4), got.TotalControls) &&
+					assert.Equal(t, int32(2), got.CoveredControls) &&
+					assert.Equal(t, 2, len(got.UncoveredControls)) &&
+					assert.Equal(t, testdata.MockControlID1, got.UncoveredControls[0].Id) &&
+					assert.Equal(t, testdata.MockSubControlID11, got.UncoveredControls[1].Id)
+			},
+			wantErr: assert.Nil[error],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				storage: tt.fields.storage,
+				authz:   servicetest.NewAuthorizationStrategy(true),
+			}
+			got, err := servicetest.Validated(svc.GetCatalogCoverage)(context.Background(), tt.args.req)
+
+			tt.wantErr(t, err)
+			tt.want(t, got)
+		})
+	}
+}
+
+func TestService_ResultSeverity(t *testing.T) {
+	type args struct {
+		result *assessment.AssessmentResult
+	}
+	tests := []struct {
+		name string
+		args args
+		want orchestrator.ControlSeverity
+	}{
+		{
+			name: "highest severity of mapped controls",
+			args: args{
+				result: &assessment.AssessmentResult{MetricId: testdata.MockMetricID1},
+			},
+			want: orchestrator.ControlSeverity_CONTROL_SEVERITY_HIGH,
+		},
+		{
+			name: "metric not mapped",
+			args: args{
+				result: &assessment.AssessmentResult{MetricId: testdata.MockMetricID2},
+			},
+			want: orchestrator.ControlSeverity_CONTROL_SEVERITY_UNSPECIFIED,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					assert.NoError(t, s.Create(orchestratortest.NewCatalog()))
+					assert.NoError(t, s.Create(&orchestrator.Control{
+						Id:                testdata.MockControlID5,
+						Name:              testdata.MockControlName,
+						CategoryName:      testdata.MockCategoryName,
+						CategoryCatalogId: testdata.MockCatalogID,
+						Severity:          util.Ref(orchestrator.ControlSeverity_CONTROL_SEVERITY_HIGH),
+						Metrics:           []*assessment.Metric{{Id: testdata.MockMetricID1}},
+					}))
+				}),
+			}
+
+			assert.Equal(t, tt.want, svc.ResultSeverity(tt.args.result))
+		})
+	}
+}
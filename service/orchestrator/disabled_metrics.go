@@ -0,0 +1,144 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package orchestrator
+
+import (
+	"context"
+	"errors"
+
+	"clouditor.io/clouditor/v2/api"
+	"clouditor.io/clouditor/v2/api/assessment"
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/internal/logging"
+	"clouditor.io/clouditor/v2/persistence"
+	"clouditor.io/clouditor/v2/service"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ErrMetricNotDisabled indicates that the metric is not disabled for the cloud service
+var ErrMetricNotDisabled = api.ErrorCodeMetricNotDisabled.Err()
+
+// DisableMetric disables the metric specified by req.MetricId for the cloud service specified by req.CloudServiceId.
+// The reason and the user who disabled the metric are recorded. If the metric is already disabled, the record is
+// updated.
+func (svc *Service) DisableMetric(ctx context.Context, req *orchestrator.DisableMetricRequest) (res *orchestrator.DisabledMetric, err error) {
+	// Check, if this request has access to the cloud service according to our authorization strategy.
+	if !svc.authz.CheckAccess(ctx, service.AccessUpdate, req) {
+		return nil, service.ErrPermissionDenied
+	}
+
+	// Check, if the metric exists
+	count, err := svc.storage.Count(&assessment.Metric{}, "id = ?", req.MetricId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	} else if count == 0 {
+		return nil, ErrMetricNotFound
+	}
+
+	res = &orchestrator.DisabledMetric{
+		CloudServiceId: req.CloudServiceId,
+		MetricId:       req.MetricId,
+		Reason:         req.Reason,
+		DisabledAt:     timestamppb.Now(),
+	}
+	res.DisabledBy, _ = svc.currentUser(ctx)
+
+	err = svc.storage.Save(res, "cloud_service_id = ? AND metric_id = ?", req.CloudServiceId, req.MetricId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
+	svc.notifyMetricToggled(req.CloudServiceId, req.MetricId)
+
+	logging.LogRequest(log, logrus.DebugLevel, logging.Update, req)
+
+	return
+}
+
+// EnableMetric re-enables the metric specified by req.MetricId for the cloud service specified by req.CloudServiceId.
+func (svc *Service) EnableMetric(ctx context.Context, req *orchestrator.EnableMetricRequest) (res *emptypb.Empty, err error) {
+	// Check, if this request has access to the cloud service according to our authorization strategy.
+	if !svc.authz.CheckAccess(ctx, service.AccessUpdate, req) {
+		return nil, service.ErrPermissionDenied
+	}
+
+	err = svc.storage.Delete(&orchestrator.DisabledMetric{}, "cloud_service_id = ? AND metric_id = ?", req.CloudServiceId, req.MetricId)
+	if errors.Is(err, persistence.ErrRecordNotFound) {
+		return nil, ErrMetricNotDisabled
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
+	svc.notifyMetricToggled(req.CloudServiceId, req.MetricId)
+
+	logging.LogRequest(log, logrus.DebugLevel, logging.Update, req)
+
+	return &emptypb.Empty{}, nil
+}
+
+// ListDisabledMetrics lists all metrics that are disabled for the cloud service specified by req.CloudServiceId.
+func (svc *Service) ListDisabledMetrics(ctx context.Context, req *orchestrator.ListDisabledMetricsRequest) (res *orchestrator.ListDisabledMetricsResponse, err error) {
+	// Check, if this request has access to the cloud service according to our authorization strategy.
+	if !svc.authz.CheckAccess(ctx, service.AccessRead, req) {
+		return nil, service.ErrPermissionDenied
+	}
+
+	res = new(orchestrator.ListDisabledMetricsResponse)
+
+	err = svc.storage.List(&res.DisabledMetrics, "metric_id", true, 0, -1, "cloud_service_id = ?", req.CloudServiceId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
+	return
+}
+
+// isMetricDisabled checks, whether the metric is disabled for the cloud service.
+func (svc *Service) isMetricDisabled(cloudServiceID, metricID string) (disabled bool, err error) {
+	count, err := svc.storage.Count(&orchestrator.DisabledMetric{}, "cloud_service_id = ? AND metric_id = ?", cloudServiceID, metricID)
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// notifyMetricToggled informs the event listeners that the configuration of the metric changed, so that they can
+// refresh their cached configuration, which includes whether the metric is disabled.
+func (svc *Service) notifyMetricToggled(cloudServiceID, metricID string) {
+	go func() {
+		svc.events <- &orchestrator.MetricChangeEvent{
+			Type:           orchestrator.MetricChangeEvent_TYPE_CONFIG_CHANGED,
+			CloudServiceId: cloudServiceID,
+			MetricId:       metricID,
+		}
+	}()
+}
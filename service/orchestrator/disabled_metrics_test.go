@@ -0,0 +1,213 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package orchestrator
+
+import (
+	"context"
+	"testing"
+
+	"clouditor.io/clouditor/v2/api/assessment"
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/testutil/servicetest"
+	"clouditor.io/clouditor/v2/persistence"
+	"clouditor.io/clouditor/v2/service"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestService_DisableMetric(t *testing.T) {
+	type fields struct {
+		storage persistence.Storage
+		authz   service.AuthorizationStrategy
+	}
+	type args struct {
+		req *orchestrator.DisableMetricRequest
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantRes assert.Want[*orchestrator.DisabledMetric]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "Validation error",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t),
+				authz:   servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &orchestrator.DisableMetricRequest{
+					CloudServiceId: testdata.MockCloudServiceID1,
+					MetricId:       testdata.MockMetricID1,
+				},
+			},
+			wantRes: assert.Nil[*orchestrator.DisabledMetric],
+			wantErr: func(t *testing.T, err error) bool {
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+				return assert.ErrorContains(t, err, "reason")
+			},
+		},
+		{
+			name: "Permission denied",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t),
+				authz:   servicetest.NewAuthorizationStrategy(false, testdata.MockCloudServiceID2),
+			},
+			args: args{
+				req: &orchestrator.DisableMetricRequest{
+					CloudServiceId: testdata.MockCloudServiceID1,
+					MetricId:       testdata.MockMetricID1,
+					Reason:         "Not relevant for our service",
+				},
+			},
+			wantRes: assert.Nil[*orchestrator.DisabledMetric],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, service.ErrPermissionDenied)
+			},
+		},
+		{
+			name: "Metric not found",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t),
+				authz:   servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &orchestrator.DisableMetricRequest{
+					CloudServiceId: testdata.MockCloudServiceID1,
+					MetricId:       testdata.MockMetricID1,
+					Reason:         "Not relevant for our service",
+				},
+			},
+			wantRes: assert.Nil[*orchestrator.DisabledMetric],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, ErrMetricNotFound)
+			},
+		},
+		{
+			name: "Happy path",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					assert.NoError(t, s.Create(&assessment.Metric{Id: testdata.MockMetricID1}))
+				}),
+				authz: servicetest.NewAuthorizationStrategyWithUser(true, "alice"),
+			},
+			args: args{
+				req: &orchestrator.DisableMetricRequest{
+					CloudServiceId: testdata.MockCloudServiceID1,
+					MetricId:       testdata.MockMetricID1,
+					Reason:         "Not relevant for our service",
+				},
+			},
+			wantRes: func(t *testing.T, got *orchestrator.DisabledMetric) bool {
+				assert.NotNil(t, got.DisabledAt)
+				assert.Equal(t, "Not relevant for our service", got.Reason)
+				return assert.Equal(t, "alice", got.DisabledBy)
+			},
+			wantErr: assert.Nil[error],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				storage: tt.fields.storage,
+				authz:   tt.fields.authz,
+				events:  make(chan *orchestrator.MetricChangeEvent, 10),
+			}
+
+			gotRes, err := servicetest.Validated(svc.DisableMetric)(context.Background(), tt.args.req)
+			tt.wantErr(t, err)
+			tt.wantRes(t, gotRes)
+		})
+	}
+}
+
+func TestService_EnableMetric(t *testing.T) {
+	svc := &Service{
+		storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+			assert.NoError(t, s.Create(&assessment.Metric{Id: testdata.MockMetricID1}))
+			assert.NoError(t, s.Create(&orchestrator.CloudService{Id: testdata.MockCloudServiceID1}))
+			assert.NoError(t, s.Create(&assessment.MetricConfiguration{
+				MetricId:       testdata.MockMetricID1,
+				CloudServiceId: testdata.MockCloudServiceID1,
+				Operator:       "==",
+				TargetValue:    structpb.NewBoolValue(true),
+			}))
+		}),
+		authz:  servicetest.NewAuthorizationStrategy(true),
+		events: make(chan *orchestrator.MetricChangeEvent, 10),
+	}
+
+	// Enabling a metric that is not disabled fails
+	_, err := servicetest.Validated(svc.EnableMetric)(context.Background(), &orchestrator.EnableMetricRequest{
+		CloudServiceId: testdata.MockCloudServiceID1,
+		MetricId:       testdata.MockMetricID1,
+	})
+	assert.ErrorIs(t, err, ErrMetricNotDisabled)
+
+	_, err = servicetest.Validated(svc.DisableMetric)(context.Background(), &orchestrator.DisableMetricRequest{
+		CloudServiceId: testdata.MockCloudServiceID1,
+		MetricId:       testdata.MockMetricID1,
+		Reason:         "Not relevant for our service",
+	})
+	assert.NoError(t, err)
+
+	// The configuration and the list of disabled metrics reflect the disabled metric
+	config, err := svc.GetMetricConfiguration(context.Background(), &orchestrator.GetMetricConfigurationRequest{
+		CloudServiceId: testdata.MockCloudServiceID1,
+		MetricId:       testdata.MockMetricID1,
+	})
+	assert.NoError(t, err)
+	assert.True(t, config.GetDisabled())
+
+	list, err := servicetest.Validated(svc.ListDisabledMetrics)(context.Background(), &orchestrator.ListDisabledMetricsRequest{
+		CloudServiceId: testdata.MockCloudServiceID1,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(list.GetDisabledMetrics()))
+
+	// Event listeners are informed about the change of the configuration
+	event := <-svc.events
+	assert.Equal(t, orchestrator.MetricChangeEvent_TYPE_CONFIG_CHANGED, event.Type)
+
+	_, err = servicetest.Validated(svc.EnableMetric)(context.Background(), &orchestrator.EnableMetricRequest{
+		CloudServiceId: testdata.MockCloudServiceID1,
+		MetricId:       testdata.MockMetricID1,
+	})
+	assert.NoError(t, err)
+
+	config, err = svc.GetMetricConfiguration(context.Background(), &orchestrator.GetMetricConfigurationRequest{
+		CloudServiceId: testdata.MockCloudServiceID1,
+		MetricId:       testdata.MockMetricID1,
+	})
+	assert.NoError(t, err)
+	assert.False(t, config.GetDisabled())
+}
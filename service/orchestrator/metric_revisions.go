@@ -0,0 +1,110 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package orchestrator
+
+import (
+	"context"
+	"errors"
+
+	"clouditor.io/clouditor/v2/api/assessment"
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/persistence"
+	"clouditor.io/clouditor/v2/service"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ListMetricImplementationRevisions lists the history of the implementation of a metric, specified by req.MetricId.
+func (svc *Service) ListMetricImplementationRevisions(_ context.Context, req *orchestrator.ListMetricImplementationRevisionsRequest) (res *orchestrator.ListMetricImplementationRevisionsResponse, err error) {
+	res = new(orchestrator.ListMetricImplementationRevisionsResponse)
+
+	res.Revisions, res.NextPageToken, err = service.PaginateStorage[*assessment.MetricImplementationRevision](req, svc.storage,
+		service.DefaultPaginationOpts, "metric_id = ?", req.MetricId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not paginate results: %v", err)
+	}
+
+	return
+}
+
+// ListMetricConfigurationRevisions lists the history of the configuration of a metric, specified by req.MetricId, for
+// the cloud service specified by req.CloudServiceId.
+func (svc *Service) ListMetricConfigurationRevisions(ctx context.Context, req *orchestrator.ListMetricConfigurationRevisionsRequest) (res *orchestrator.ListMetricConfigurationRevisionsResponse, err error) {
+	// Check, if this request has access to the cloud service according to our authorization strategy.
+	if !svc.authz.CheckAccess(ctx, service.AccessRead, req) {
+		return nil, service.ErrPermissionDenied
+	}
+
+	res = new(orchestrator.ListMetricConfigurationRevisionsResponse)
+
+	res.Revisions, res.NextPageToken, err = service.PaginateStorage[*assessment.MetricConfigurationRevision](req, svc.storage,
+		service.DefaultPaginationOpts, "metric_id = ? AND cloud_service_id = ?", req.MetricId, req.CloudServiceId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not paginate results: %v", err)
+	}
+
+	return
+}
+
+// createImplementationRevision stores an immutable revision of the metric implementation. The revision also contains
+// the version of the metric at this point in time.
+func (svc *Service) createImplementationRevision(impl *assessment.MetricImplementation, version string) error {
+	return svc.storage.Create(&assessment.MetricImplementationRevision{
+		Id:             uuid.NewString(),
+		MetricId:       impl.MetricId,
+		MetricVersion:  version,
+		Implementation: impl,
+		CreatedAt:      timestamppb.Now(),
+	})
+}
+
+// createConfigurationRevision stores an immutable revision of the metric configuration. The revision also contains the
+// version of the metric at this point in time. If the configuration has a revision ID, it is used as ID of the revision.
+func (svc *Service) createConfigurationRevision(config *assessment.MetricConfiguration) (err error) {
+	var metric assessment.Metric
+
+	err = svc.storage.Get(&metric, "id = ?", config.MetricId)
+	if err != nil && !errors.Is(err, persistence.ErrRecordNotFound) {
+		return err
+	}
+
+	id := config.GetRevisionId()
+	if id == "" {
+		id = uuid.NewString()
+	}
+
+	return svc.storage.Create(&assessment.MetricConfigurationRevision{
+		Id:             id,
+		MetricId:       config.MetricId,
+		CloudServiceId: config.CloudServiceId,
+		MetricVersion:  metric.Version,
+		Configuration:  config,
+		CreatedAt:      timestamppb.Now(),
+	})
+}
@@ -0,0 +1,218 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package orchestrator
+
+import (
+	"context"
+	"testing"
+
+	"clouditor.io/clouditor/v2/api/assessment"
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/testutil/servicetest"
+	"clouditor.io/clouditor/v2/persistence"
+	"clouditor.io/clouditor/v2/service"
+)
+
+func TestService_ListMetricImplementationRevisions(t *testing.T) {
+	type fields struct {
+		storage persistence.Storage
+	}
+	type args struct {
+		req *orchestrator.ListMetricImplementationRevisionsRequest
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantRes assert.Want[*orchestrator.ListMetricImplementationRevisionsResponse]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "Validation error",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t),
+			},
+			args: args{
+				req: &orchestrator.ListMetricImplementationRevisionsRequest{},
+			},
+			wantRes: assert.Nil[*orchestrator.ListMetricImplementationRevisionsResponse],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "metric_id: value length must be at least 1 characters")
+			},
+		},
+		{
+			name: "Happy path",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					svc := &Service{storage: s}
+					assert.NoError(t, s.Create(&assessment.Metric{Id: testdata.MockMetricID1, Version: "1.0.0"}))
+					assert.NoError(t, svc.createImplementationRevision(&assessment.MetricImplementation{
+						MetricId: testdata.MockMetricID1,
+						Lang:     assessment.MetricImplementation_LANGUAGE_REGO,
+						Code:     "package example",
+					}, "1.0.0"))
+					assert.NoError(t, svc.createImplementationRevision(&assessment.MetricImplementation{
+						MetricId: testdata.MockMetricID2,
+						Lang:     assessment.MetricImplementation_LANGUAGE_REGO,
+						Code:     "package other",
+					}, "1.0.0"))
+				}),
+			},
+			args: args{
+				req: &orchestrator.ListMetricImplementationRevisionsRequest{
+					MetricId: testdata.MockMetricID1,
+				},
+			},
+			wantRes: func(t *testing.T, got *orchestrator.ListMetricImplementationRevisionsResponse) bool {
+				if !assert.Equal(t, 1, len(got.Revisions)) {
+					return false
+				}
+
+				rev := got.Revisions[0]
+				assert.Equal(t, testdata.MockMetricID1, rev.MetricId)
+				assert.Equal(t, "1.0.0", rev.MetricVersion)
+				return assert.Equal(t, "package example", rev.Implementation.Code)
+			},
+			wantErr: assert.Nil[error],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				storage: tt.fields.storage,
+			}
+
+			gotRes, err := servicetest.Validated(svc.ListMetricImplementationRevisions)(context.Background(), tt.args.req)
+			tt.wantErr(t, err)
+			tt.wantRes(t, gotRes)
+		})
+	}
+}
+
+func TestService_ListMetricConfigurationRevisions(t *testing.T) {
+	type fields struct {
+		storage persistence.Storage
+		authz   service.AuthorizationStrategy
+	}
+	type args struct {
+		req *orchestrator.ListMetricConfigurationRevisionsRequest
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantRes assert.Want[*orchestrator.ListMetricConfigurationRevisionsResponse]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "Validation error",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t),
+				authz:   servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &orchestrator.ListMetricConfigurationRevisionsRequest{
+					MetricId: testdata.MockMetricID1,
+				},
+			},
+			wantRes: assert.Nil[*orchestrator.ListMetricConfigurationRevisionsResponse],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "cloud_service_id: value is empty, which is not a valid UUID")
+			},
+		},
+		{
+			name: "Permission denied",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t),
+				authz:   servicetest.NewAuthorizationStrategy(false, testdata.MockCloudServiceID2),
+			},
+			args: args{
+				req: &orchestrator.ListMetricConfigurationRevisionsRequest{
+					MetricId:       testdata.MockMetricID1,
+					CloudServiceId: testdata.MockCloudServiceID1,
+				},
+			},
+			wantRes: assert.Nil[*orchestrator.ListMetricConfigurationRevisionsResponse],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, service.ErrPermissionDenied)
+			},
+		},
+		{
+			name: "Happy path",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					svc := &Service{storage: s}
+					assert.NoError(t, s.Create(&assessment.Metric{Id: testdata.MockMetricID1, Version: "1.2.0"}))
+					assert.NoError(t, svc.createConfigurationRevision(&assessment.MetricConfiguration{
+						MetricId:       testdata.MockMetricID1,
+						CloudServiceId: testdata.MockCloudServiceID1,
+						Operator:       "==",
+						TargetValue:    testdata.MockMetricConfigurationTargetValueString,
+					}))
+					assert.NoError(t, svc.createConfigurationRevision(&assessment.MetricConfiguration{
+						MetricId:       testdata.MockMetricID1,
+						CloudServiceId: testdata.MockCloudServiceID2,
+						Operator:       "==",
+						TargetValue:    testdata.MockMetricConfigurationTargetValueString,
+					}))
+				}),
+				authz: servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &orchestrator.ListMetricConfigurationRevisionsRequest{
+					MetricId:       testdata.MockMetricID1,
+					CloudServiceId: testdata.MockCloudServiceID1,
+				},
+			},
+			wantRes: func(t *testing.T, got *orchestrator.ListMetricConfigurationRevisionsResponse) bool {
+				if !assert.Equal(t, 1, len(got.Revisions)) {
+					return false
+				}
+
+				rev := got.Revisions[0]
+				assert.Equal(t, testdata.MockCloudServiceID1, rev.CloudServiceId)
+				assert.Equal(t, "1.2.0", rev.MetricVersion)
+				return assert.Equal(t, "==", rev.Configuration.Operator)
+			},
+			wantErr: assert.Nil[error],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				storage: tt.fields.storage,
+				authz:   tt.fields.authz,
+			}
+
+			gotRes, err := servicetest.Validated(svc.ListMetricConfigurationRevisions)(context.Background(), tt.args.req)
+			tt.wantErr(t, err)
+			tt.wantRes(t, gotRes)
+		})
+	}
+}
@@ -32,24 +32,33 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"strings"
 
 	"clouditor.io/clouditor/v2/api"
 	"clouditor.io/clouditor/v2/api/assessment"
+	"clouditor.io/clouditor/v2/api/discovery"
+	"clouditor.io/clouditor/v2/api/evidence"
+	"clouditor.io/clouditor/v2/api/ontology"
 	"clouditor.io/clouditor/v2/api/orchestrator"
 	"clouditor.io/clouditor/v2/internal/logging"
+	"clouditor.io/clouditor/v2/internal/util"
 	"clouditor.io/clouditor/v2/persistence"
 	"clouditor.io/clouditor/v2/persistence/gorm"
+	"clouditor.io/clouditor/v2/policies"
 	"clouditor.io/clouditor/v2/service"
+	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
 
+	"github.com/open-policy-agent/opa/ast"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // ErrMetricNotFound indicates the certification was not found
-var ErrMetricNotFound = status.Error(codes.NotFound, "metric not found")
+var ErrMetricNotFound = api.ErrorCodeMetricNotFound.Err()
 
 // loadMetrics takes care of loading the metric definitions from the (embedded) metrics.json as
 // well as the default metric implementations from the Rego files.
@@ -76,6 +85,11 @@ func (svc *Service) loadMetrics() (err error) {
 	// we intentionally do not have the implementation, because they are assess outside the Clouditor toolset, but we
 	// still need to be aware of the particular metric.
 	for _, m := range metrics {
+		// Metrics without an explicit version are considered to be in their initial version
+		if m.Version == "" {
+			m.Version = assessment.DefaultMetricVersion
+		}
+
 		// Somehow, we first need to create the metric, otherwise we are running into weird constraint issues.
 		// We use Save() instead of Create()because it may be that the metrics already exist in the database and just need to be updated.
 		err = svc.storage.Save(m, "id = ? ", m.Id)
@@ -90,12 +104,25 @@ func (svc *Service) loadMetrics() (err error) {
 			continue
 		}
 
+		// Check, whether the implementation has changed, so that we can keep its history
+		var existing assessment.MetricImplementation
+		err = svc.storage.Get(&existing, "metric_id = ?", m.Id)
+		changed := err != nil || existing.Code != m.Implementation.Code
+
 		err = svc.storage.Save(m.Implementation, "metric_id = ? ", m.Id)
 		if err != nil {
 			log.Errorf("Error while saving metric implementation for '%s': %v", m.Id, err)
 			continue
 		}
 
+		if changed {
+			err = svc.createImplementationRevision(m.Implementation, m.Version)
+			if err != nil {
+				log.Errorf("Error while saving metric implementation revision for '%s': %v", m.Id, err)
+				continue
+			}
+		}
+
 		log.Debugf("Metric loaded with id '%s'.", m.GetId())
 
 	}
@@ -180,12 +207,6 @@ func loadMetricImplementation(metricID, file string) (impl *assessment.MetricImp
 func (svc *Service) CreateMetric(_ context.Context, req *orchestrator.CreateMetricRequest) (metric *assessment.Metric, err error) {
 	var count int64
 
-	// Validate request
-	err = api.Validate(req)
-	if err != nil {
-		return nil, err
-	}
-
 	// Check, if metric id already exists
 	count, err = svc.storage.Count(metric, "id = ?", req.Metric.Id)
 	if err != nil {
@@ -199,10 +220,20 @@ func (svc *Service) CreateMetric(_ context.Context, req *orchestrator.CreateMetr
 	// Build a new metric out of the request
 	metric = req.Metric
 
-	if metric.DeprecatedSince != nil {
+	if metric.DeprecatedSince != nil || metric.State == assessment.Metric_STATE_DEPRECATED {
 		return nil, status.Errorf(codes.FailedPrecondition, "the metric shouldn't be set to deprecated at creation time")
 	}
 
+	// New metrics are published directly, unless they are explicitly created as a draft
+	if metric.State == assessment.Metric_STATE_UNSPECIFIED {
+		metric.State = assessment.Metric_STATE_PUBLISHED
+	}
+
+	// Published metrics always need a version
+	if metric.State == assessment.Metric_STATE_PUBLISHED && metric.Version == "" {
+		metric.Version = assessment.DefaultMetricVersion
+	}
+
 	// Append metric
 	err = svc.storage.Create(metric)
 	if err != nil {
@@ -224,12 +255,6 @@ func (svc *Service) CreateMetric(_ context.Context, req *orchestrator.CreateMetr
 
 // UpdateMetric updates an existing metric, specified by the identifier in req.MetricId.
 func (svc *Service) UpdateMetric(_ context.Context, req *orchestrator.UpdateMetricRequest) (metric *assessment.Metric, err error) {
-	// Validate request
-	err = api.Validate(req)
-	if err != nil {
-		return nil, err
-	}
-
 	metric = new(assessment.Metric)
 
 	// Check, if metric exists according to req.Metric.Id
@@ -240,6 +265,21 @@ func (svc *Service) UpdateMetric(_ context.Context, req *orchestrator.UpdateMetr
 		return nil, status.Errorf(codes.Internal, "database error: %s", err)
 	}
 
+	// Deprecated metrics are kept as they are for backward compatibility
+	if metric.LifecycleState() == assessment.Metric_STATE_DEPRECATED {
+		return nil, status.Errorf(codes.FailedPrecondition, "deprecated metrics cannot be updated")
+	}
+
+	version, err := updatedMetricVersion(metric, req.Metric)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := updatedMetricState(metric, req.Metric)
+	if err != nil {
+		return nil, err
+	}
+
 	// Update metric
 	metric.Name = req.Metric.Name
 	metric.Description = req.Metric.Description
@@ -247,6 +287,109 @@ func (svc *Service) UpdateMetric(_ context.Context, req *orchestrator.UpdateMetr
 	metric.Range = req.Metric.Range
 	metric.Scale = req.Metric.Scale
 	metric.DeprecatedSince = req.Metric.DeprecatedSince
+	metric.Version = version
+	metric.State = state
+
+	// Make sure, that deprecated metrics also have the time of their deprecation set
+	if metric.State == assessment.Metric_STATE_DEPRECATED && metric.DeprecatedSince == nil {
+		metric.DeprecatedSince = timestamppb.Now()
+	}
+
+	err = svc.storage.Save(metric, "id = ? ", metric.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %s", err)
+	}
+
+	// Notify event listeners
+	go func() {
+		svc.events <- &orchestrator.MetricChangeEvent{
+			Type:     orchestrator.MetricChangeEvent_TYPE_METADATA_CHANGED,
+			MetricId: metric.Id,
+		}
+	}()
+
+	logging.LogRequest(log, logrus.DebugLevel, logging.Update, req)
+
+	return
+}
+
+// updatedMetricVersion returns the version of a metric after the update. Drafts can be updated without changing their
+// version. A published metric needs a greater version for each update. If no version is specified, the patch version is
+// incremented automatically.
+func updatedMetricVersion(current *assessment.Metric, updated *assessment.Metric) (version string, err error) {
+	var cmp int
+
+	if current.LifecycleState() == assessment.Metric_STATE_DRAFT {
+		if updated.Version == "" {
+			return current.Version, nil
+		}
+
+		return updated.Version, nil
+	}
+
+	if updated.Version == "" {
+		version, err = assessment.NextMetricVersion(current.Version)
+		if err != nil {
+			return "", status.Errorf(codes.Internal, "could not increment version: %v", err)
+		}
+
+		return version, nil
+	}
+
+	// An unversioned metric can be updated to any version
+	if current.Version == "" {
+		return updated.Version, nil
+	}
+
+	cmp, err = assessment.CompareMetricVersions(updated.Version, current.Version)
+	if err != nil {
+		return "", status.Errorf(codes.InvalidArgument, "%v", err)
+	} else if cmp <= 0 {
+		return "", status.Errorf(codes.FailedPrecondition, "version of published metric must be greater than %s", current.Version)
+	}
+
+	return updated.Version, nil
+}
+
+// updatedMetricState returns the lifecycle state of a metric after the update. A metric can only move forward in its
+// lifecycle, i.e., from draft to published and from draft or published to deprecated.
+func updatedMetricState(current *assessment.Metric, updated *assessment.Metric) (state assessment.Metric_State, err error) {
+	state = updated.State
+
+	// Keep the current state, if no state is specified. Setting deprecated_since implicitly deprecates the metric.
+	if state == assessment.Metric_STATE_UNSPECIFIED {
+		if updated.DeprecatedSince != nil {
+			state = assessment.Metric_STATE_DEPRECATED
+		} else {
+			state = current.LifecycleState()
+		}
+	}
+
+	if state < current.LifecycleState() {
+		return assessment.Metric_STATE_UNSPECIFIED, status.Errorf(codes.FailedPrecondition,
+			"invalid state transition from %s to %s", current.LifecycleState(), state)
+	}
+
+	return state, nil
+}
+
+// PublishMetric publishes a metric, specified by req.MetricId, that is currently in the draft state.
+func (svc *Service) PublishMetric(_ context.Context, req *orchestrator.PublishMetricRequest) (metric *assessment.Metric, err error) {
+	err = svc.storage.Get(&metric, "id = ?", req.MetricId)
+	if errors.Is(err, persistence.ErrRecordNotFound) {
+		return nil, ErrMetricNotFound
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %s", err)
+	}
+
+	if metric.LifecycleState() != assessment.Metric_STATE_DRAFT {
+		return nil, status.Errorf(codes.FailedPrecondition, "only draft metrics can be published")
+	}
+
+	metric.State = assessment.Metric_STATE_PUBLISHED
+	if metric.Version == "" {
+		metric.Version = assessment.DefaultMetricVersion
+	}
 
 	err = svc.storage.Save(metric, "id = ? ", metric.Id)
 	if err != nil {
@@ -272,12 +415,6 @@ func (svc *Service) UpdateMetricImplementation(_ context.Context, req *orchestra
 		metric *assessment.Metric
 	)
 
-	// Validate request
-	err = api.Validate(req)
-	if err != nil {
-		return nil, err
-	}
-
 	// Check, if metric exists according to the metric ID
 	err = svc.storage.Get(&metric, "id = ?", req.Implementation.MetricId)
 	if errors.Is(err, persistence.ErrRecordNotFound) {
@@ -296,6 +433,12 @@ func (svc *Service) UpdateMetricImplementation(_ context.Context, req *orchestra
 		return nil, fmt.Errorf("could not save metric implementation: %w", err)
 	}
 
+	// Keep the history of the implementation
+	err = svc.createImplementationRevision(impl, metric.Version)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %s", err)
+	}
+
 	// Notify event listeners
 	go func() {
 		svc.events <- &orchestrator.MetricChangeEvent{
@@ -311,20 +454,26 @@ func (svc *Service) UpdateMetricImplementation(_ context.Context, req *orchestra
 
 // ListMetrics lists all available metrics.
 func (svc *Service) ListMetrics(_ context.Context, req *orchestrator.ListMetricsRequest) (res *orchestrator.ListMetricsResponse, err error) {
-	// Validate request
-	err = api.Validate(req)
-	if err != nil {
-		return nil, err
-	}
-
 	res = new(orchestrator.ListMetricsResponse)
-	var conds []any
+	var (
+		query []string
+		args  []any
+	)
 
 	// Add the deprecated metrics as well if requested
 	if !req.Filter.GetIncludeDeprecated() {
-		conds = append(conds, "deprecated_since IS NULL")
+		query = append(query, "deprecated_since IS NULL")
+	}
+
+	// Add the draft metrics as well if requested
+	if !req.Filter.GetIncludeDrafts() {
+		query = append(query, "state != ?")
+		args = append(args, assessment.Metric_STATE_DRAFT)
 	}
 
+	// Join query with AND and prepend the query
+	conds := append([]any{strings.Join(query, " AND ")}, args...)
+
 	// Paginate the metrics according to the request
 	res.Metrics, res.NextPageToken, err = service.PaginateStorage[*assessment.Metric](req, svc.storage,
 		service.DefaultPaginationOpts, conds...)
@@ -341,12 +490,6 @@ func (svc *Service) RemoveMetric(ctx context.Context, req *orchestrator.RemoveMe
 		metric *assessment.Metric
 	)
 
-	// Validate request
-	err = api.Validate(req)
-	if err != nil {
-		return nil, err
-	}
-
 	// Check, if metric exists according to the metric ID
 	err = svc.storage.Get(&metric, "id = ?", req.MetricId)
 	if errors.Is(err, persistence.ErrRecordNotFound) {
@@ -360,6 +503,8 @@ func (svc *Service) RemoveMetric(ctx context.Context, req *orchestrator.RemoveMe
 		metric.DeprecatedSince = timestamppb.Now()
 	}
 
+	metric.State = assessment.Metric_STATE_DEPRECATED
+
 	// Update metric with property deprecated is true
 	err = svc.storage.Update(metric, "Id = ?", req.MetricId)
 	if err != nil {
@@ -371,14 +516,43 @@ func (svc *Service) RemoveMetric(ctx context.Context, req *orchestrator.RemoveMe
 	return &emptypb.Empty{}, nil
 }
 
-// GetMetric retrieves a metric specified by req.MetricId.
-func (svc *Service) GetMetric(_ context.Context, req *orchestrator.GetMetricRequest) (metric *assessment.Metric, err error) {
-	// Validate request
-	err = api.Validate(req)
+// RestoreMetric restores a metric specified by req.MetricId, which was removed using RemoveMetric. Since metrics are
+// only deprecated on removal, the deprecation is reverted and the metric is published again.
+func (svc *Service) RestoreMetric(_ context.Context, req *orchestrator.RestoreMetricRequest) (metric *assessment.Metric, err error) {
+	err = svc.storage.Get(&metric, "id = ?", req.MetricId)
+	if errors.Is(err, persistence.ErrRecordNotFound) {
+		return nil, ErrMetricNotFound
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %s", err)
+	}
+
+	if metric.LifecycleState() != assessment.Metric_STATE_DEPRECATED {
+		return nil, status.Errorf(codes.FailedPrecondition, "only removed metrics can be restored")
+	}
+
+	metric.DeprecatedSince = nil
+	metric.State = assessment.Metric_STATE_PUBLISHED
+
+	err = svc.storage.Save(metric, "id = ?", metric.Id)
 	if err != nil {
-		return nil, err
+		return nil, status.Errorf(codes.Internal, "database error: %s", err)
 	}
 
+	// Notify event listeners
+	go func() {
+		svc.events <- &orchestrator.MetricChangeEvent{
+			Type:     orchestrator.MetricChangeEvent_TYPE_METADATA_CHANGED,
+			MetricId: metric.Id,
+		}
+	}()
+
+	logging.LogRequest(log, logrus.DebugLevel, logging.Restore, req)
+
+	return
+}
+
+// GetMetric retrieves a metric specified by req.MetricId.
+func (svc *Service) GetMetric(_ context.Context, req *orchestrator.GetMetricRequest) (metric *assessment.Metric, err error) {
 	err = svc.storage.Get(&metric, "id = ?", req.MetricId)
 	if errors.Is(err, persistence.ErrRecordNotFound) {
 		return nil, ErrMetricNotFound
@@ -390,21 +564,40 @@ func (svc *Service) GetMetric(_ context.Context, req *orchestrator.GetMetricRequ
 }
 
 func (svc *Service) GetMetricConfiguration(ctx context.Context, req *orchestrator.GetMetricConfigurationRequest) (res *assessment.MetricConfiguration, err error) {
-	// Validate request
-	err = api.Validate(req)
+	// Check, if this request has access to the cloud service according to our authorization strategy.
+	if !svc.authz.CheckAccess(ctx, service.AccessRead, req) {
+		return nil, service.ErrPermissionDenied
+	}
+
+	res, err = svc.metricConfiguration(req)
 	if err != nil {
 		return nil, err
 	}
 
-	// Check, if this request has access to the cloud service according to our authorization strategy.
-	if !svc.authz.CheckAccess(ctx, service.AccessRead, req) {
-		return nil, service.ErrPermissionDenied
+	// Mark the configuration, if the metric is disabled for the cloud service
+	res.Disabled, err = svc.isMetricDisabled(req.GetCloudServiceId(), req.GetMetricId())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %s", err)
 	}
 
+	return
+}
+
+// metricConfiguration retrieves the configuration of a metric for a cloud service. It falls back to the default
+// configuration of the organization of the cloud service and to our default configuration.
+func (svc *Service) metricConfiguration(req *orchestrator.GetMetricConfigurationRequest) (res *assessment.MetricConfiguration, err error) {
 	res = new(assessment.MetricConfiguration)
 
 	err = svc.storage.Get(res, gorm.WithoutPreload(), "cloud_service_id = ? AND metric_id = ?", req.CloudServiceId, req.MetricId)
 	if errors.Is(err, persistence.ErrRecordNotFound) {
+		// Otherwise, fall back to the default configuration of the organization of the cloud service
+		res, err = svc.organizationMetricConfiguration(req.GetCloudServiceId(), req.GetMetricId())
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "database error: %s", err)
+		} else if res != nil {
+			return res, nil
+		}
+
 		// Otherwise, fall back to our default configuration
 		if config, ok := defaultMetricConfigurations[req.MetricId]; ok {
 			// Copy the metric configuration and set the cloud service id
@@ -428,22 +621,65 @@ func (svc *Service) GetMetricConfiguration(ctx context.Context, req *orchestrato
 	return
 }
 
-// UpdateMetricConfiguration updates the configuration for a metric, specified by the identifier in req.MetricId.
-func (svc *Service) UpdateMetricConfiguration(ctx context.Context, req *orchestrator.UpdateMetricConfigurationRequest) (res *assessment.MetricConfiguration, err error) {
-	// Validate request
-	err = api.Validate(req)
-	if err != nil {
+// organizationMetricConfiguration retrieves the default configuration of a metric of the organization the cloud
+// service belongs to. If the cloud service does not belong to an organization or the organization has no default
+// configuration for the metric, nil is returned.
+func (svc *Service) organizationMetricConfiguration(cloudServiceID, metricID string) (config *assessment.MetricConfiguration, err error) {
+	var (
+		cld orchestrator.CloudService
+		org orchestrator.Organization
+	)
+
+	err = svc.storage.Get(&cld, gorm.WithoutPreload(), "id = ?", cloudServiceID)
+	if errors.Is(err, persistence.ErrRecordNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	if cld.OrganizationId == nil {
+		return nil, nil
+	}
+
+	err = svc.storage.Get(&org, "id = ?", cld.GetOrganizationId())
+	if errors.Is(err, persistence.ErrRecordNotFound) {
+		return nil, nil
+	} else if err != nil {
 		return nil, err
 	}
 
+	def, ok := org.DefaultMetricConfigurations[metricID]
+	if !ok {
+		return nil, nil
+	}
+
+	return &assessment.MetricConfiguration{
+		Operator:       def.GetOperator(),
+		TargetValue:    def.GetTargetValue(),
+		IsDefault:      true,
+		UpdatedAt:      org.GetUpdatedAt(),
+		MetricId:       metricID,
+		CloudServiceId: cloudServiceID,
+	}, nil
+}
+
+// UpdateMetricConfiguration updates the configuration for a metric, specified by the identifier in req.MetricId.
+func (svc *Service) UpdateMetricConfiguration(ctx context.Context, req *orchestrator.UpdateMetricConfigurationRequest) (res *assessment.MetricConfiguration, err error) {
 	// Check, if this request has access to the cloud service according to our authorization strategy.
 	if !svc.authz.CheckAccess(ctx, service.AccessRead, req) {
 		return nil, service.ErrPermissionDenied
 	}
 
-	// Make sure that the configuration also has updatedAt and isDefault set
+	err = assessment.CheckTargetValue(req.Configuration.GetOperator(), req.Configuration.GetTargetValue())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	// Make sure that the configuration also has updatedAt and isDefault set. The revision ID identifies this version of
+	// the configuration in the assessment results.
 	req.Configuration.UpdatedAt = timestamppb.Now()
 	req.Configuration.IsDefault = false
+	req.Configuration.RevisionId = uuid.NewString()
 
 	err = svc.storage.Save(&req.Configuration, "metric_id = ? AND cloud_service_id = ?", req.GetMetricId(), req.GetCloudServiceId())
 	if err != nil && errors.Is(err, persistence.ErrConstraintFailed) {
@@ -452,6 +688,12 @@ func (svc *Service) UpdateMetricConfiguration(ctx context.Context, req *orchestr
 		return nil, status.Errorf(codes.Internal, "database error: %s", err)
 	}
 
+	// Keep the history of the configuration
+	err = svc.createConfigurationRevision(req.Configuration)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %s", err)
+	}
+
 	// Notify event listeners
 	go func() {
 		svc.events <- &orchestrator.MetricChangeEvent{
@@ -476,12 +718,6 @@ func (svc *Service) UpdateMetricConfiguration(ctx context.Context, req *orchestr
 // configuration for a particular metric within the service, the default metric configuration is
 // inserted into the list.
 func (svc *Service) ListMetricConfigurations(ctx context.Context, req *orchestrator.ListMetricConfigurationRequest) (response *orchestrator.ListMetricConfigurationResponse, err error) {
-	// Validate request
-	err = api.Validate(req)
-	if err != nil {
-		return nil, err
-	}
-
 	// Check, if this request has access to the cloud service according to our authorization strategy.
 	if !svc.authz.CheckAccess(ctx, service.AccessRead, req) {
 		return nil, service.ErrPermissionDenied
@@ -510,12 +746,6 @@ func (svc *Service) ListMetricConfigurations(ctx context.Context, req *orchestra
 
 // GetMetricImplementation retrieves a metric implementation specified by req.MetricId.
 func (svc *Service) GetMetricImplementation(_ context.Context, req *orchestrator.GetMetricImplementationRequest) (res *assessment.MetricImplementation, err error) {
-	// Validate request
-	err = api.Validate(req)
-	if err != nil {
-		return nil, err
-	}
-
 	res = new(assessment.MetricImplementation)
 
 	err = svc.storage.Get(res, "metric_id = ?", req.MetricId)
@@ -528,6 +758,104 @@ func (svc *Service) GetMetricImplementation(_ context.Context, req *orchestrator
 	return
 }
 
+// TestMetricImplementation compiles the Rego code of a metric implementation and evaluates it against the resources of
+// the sample evidences in req.Evidences, without storing the implementation. This allows metric authors to iterate on
+// their implementation without deploying it.
+func (svc *Service) TestMetricImplementation(ctx context.Context, req *orchestrator.TestMetricImplementationRequest) (res *orchestrator.TestMetricImplementationResponse, err error) {
+	var (
+		config   *assessment.MetricConfiguration
+		resource ontology.IsResource
+		result   *policies.Result
+		errs     ast.Errors
+	)
+
+	res = new(orchestrator.TestMetricImplementationResponse)
+
+	for _, ev := range req.Evidences {
+		testResult := &orchestrator.MetricImplementationTestResult{
+			EvidenceId: ev.GetId(),
+		}
+		res.Results = append(res.Results, testResult)
+
+		config, err = svc.testMetricConfiguration(ctx, req, ev.GetCloudServiceId())
+		if errors.Is(err, service.ErrPermissionDenied) {
+			return nil, err
+		} else if err != nil {
+			testResult.Error = util.Ref(err.Error())
+			continue
+		}
+
+		resource, err = evidenceResource(ev)
+		if err != nil {
+			testResult.Error = util.Ref(err.Error())
+			continue
+		}
+
+		result, err = policies.EvalImplementation(req.Implementation, config, resource)
+		if errors.As(err, &errs) {
+			// Errors in the code itself affect all evidences, so there is no need to evaluate the others
+			for _, e := range errs {
+				res.CompileErrors = append(res.CompileErrors, e.Error())
+			}
+
+			res.Results = nil
+			return res, nil
+		} else if err != nil {
+			testResult.Error = util.Ref(err.Error())
+			continue
+		}
+
+		testResult.Applicable = result.Applicable
+		testResult.Compliant = result.Compliant
+		testResult.Operator = result.Operator
+		testResult.TargetValue, err = structpb.NewValue(result.TargetValue)
+		if err != nil {
+			testResult.Error = util.Ref(fmt.Sprintf("invalid target value: %v", err))
+		}
+	}
+
+	return res, nil
+}
+
+// testMetricConfiguration returns the metric configuration that is used to test a metric implementation against an
+// evidence of the given cloud service. This is either the configuration supplied in the request or the configuration
+// of the metric for the cloud service.
+func (svc *Service) testMetricConfiguration(ctx context.Context, req *orchestrator.TestMetricImplementationRequest, cloudServiceID string) (config *assessment.MetricConfiguration, err error) {
+	if req.Configuration != nil {
+		err = assessment.CheckTargetValue(req.Configuration.GetOperator(), req.Configuration.GetTargetValue())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+
+		return &assessment.MetricConfiguration{
+			Operator:       req.Configuration.GetOperator(),
+			TargetValue:    req.Configuration.GetTargetValue(),
+			MetricId:       req.Implementation.GetMetricId(),
+			CloudServiceId: cloudServiceID,
+		}, nil
+	}
+
+	return svc.GetMetricConfiguration(ctx, &orchestrator.GetMetricConfigurationRequest{
+		CloudServiceId: cloudServiceID,
+		MetricId:       req.Implementation.GetMetricId(),
+	})
+}
+
+// evidenceResource extracts the ontology resource embedded in an evidence.
+func evidenceResource(ev *evidence.Evidence) (resource ontology.IsResource, err error) {
+	m, err := ev.Resource.UnmarshalNew()
+	if err != nil {
+		return nil, fmt.Errorf("could not unmarshal resource proto message: %w", err)
+	}
+
+	resource, ok := m.(ontology.IsResource)
+	if !ok {
+		return nil, discovery.ErrNotOntologyResource
+	}
+
+	return resource, nil
+}
+
 // SubscribeMetricChangeEvents implements a stream of metric events to the subscribed client.
 func (svc *Service) SubscribeMetricChangeEvents(_ *orchestrator.SubscribeMetricChangeEventRequest, stream orchestrator.Orchestrator_SubscribeMetricChangeEventsServer) (err error) {
 	var (
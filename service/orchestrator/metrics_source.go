@@ -0,0 +1,68 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package orchestrator
+
+import (
+	"context"
+
+	"clouditor.io/clouditor/v2/api/assessment"
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/policies"
+)
+
+// metricsSource implements [policies.MetricsSource] by directly accessing the metrics of an orchestrator service.
+type metricsSource struct {
+	svc *Service
+}
+
+// MetricsSource returns a [policies.MetricsSource] for the metrics of the service. This is used to assess evidences
+// without the assessment service and without a connection to a running orchestrator, e.g., by `cl assess file`.
+func (svc *Service) MetricsSource() policies.MetricsSource {
+	return &metricsSource{svc: svc}
+}
+
+// Metrics returns all metrics, which are neither deprecated nor drafts.
+func (src *metricsSource) Metrics() (metrics []*assessment.Metric, err error) {
+	err = src.svc.storage.List(&metrics, "id", true, 0, -1, "deprecated_since IS NULL AND state != ?", assessment.Metric_STATE_DRAFT)
+
+	return
+}
+
+// MetricConfiguration returns the configuration of the metric for the cloud service, which falls back to the default
+// configuration of the metric.
+func (src *metricsSource) MetricConfiguration(cloudServiceID, metricID string) (*assessment.MetricConfiguration, error) {
+	return src.svc.GetMetricConfiguration(context.Background(), &orchestrator.GetMetricConfigurationRequest{
+		CloudServiceId: cloudServiceID,
+		MetricId:       metricID,
+	})
+}
+
+// MetricImplementation returns the implementation of the metric.
+func (src *metricsSource) MetricImplementation(_ assessment.MetricImplementation_Language, metricID string) (*assessment.MetricImplementation, error) {
+	return src.svc.GetMetricImplementation(context.Background(), &orchestrator.GetMetricImplementationRequest{
+		MetricId: metricID,
+	})
+}
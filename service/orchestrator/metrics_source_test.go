@@ -0,0 +1,56 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package orchestrator
+
+import (
+	"testing"
+
+	"clouditor.io/clouditor/v2/api/assessment"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+)
+
+func TestService_MetricsSource(t *testing.T) {
+	svc := NewService(WithStorage(testutil.NewInMemoryStorage(t)))
+	src := svc.MetricsSource()
+
+	metrics, err := src.Metrics()
+	assert.NoError(t, err)
+	assert.NotEmpty(t, metrics)
+
+	config, err := src.MetricConfiguration(testdata.MockCloudServiceID1, "BootLoggingEnabled")
+	assert.NoError(t, err)
+	assert.True(t, config.IsDefault)
+	assert.Equal(t, testdata.MockCloudServiceID1, config.CloudServiceId)
+
+	impl, err := src.MetricImplementation(assessment.MetricImplementation_LANGUAGE_REGO, "BootLoggingEnabled")
+	assert.NoError(t, err)
+	assert.Equal(t, "BootLoggingEnabled", impl.MetricId)
+
+	_, err = src.MetricImplementation(assessment.MetricImplementation_LANGUAGE_REGO, "DoesNotExist")
+	assert.ErrorContains(t, err, "implementation for metric not found")
+}
@@ -34,12 +34,16 @@ import (
 
 	"clouditor.io/clouditor/v2/api"
 	"clouditor.io/clouditor/v2/api/assessment"
+	"clouditor.io/clouditor/v2/api/evidence"
+	"clouditor.io/clouditor/v2/api/ontology"
 	"clouditor.io/clouditor/v2/api/orchestrator"
 	"clouditor.io/clouditor/v2/internal/testdata"
 	"clouditor.io/clouditor/v2/internal/testutil"
 	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/testutil/prototest"
 	"clouditor.io/clouditor/v2/internal/testutil/servicetest"
 	"clouditor.io/clouditor/v2/internal/testutil/servicetest/orchestratortest"
+	"clouditor.io/clouditor/v2/internal/util"
 	"clouditor.io/clouditor/v2/persistence"
 	persistence_gorm "clouditor.io/clouditor/v2/persistence/gorm"
 	"clouditor.io/clouditor/v2/service"
@@ -273,14 +277,64 @@ func TestService_CreateMetric(t *testing.T) {
 					},
 				},
 			},
+			wantMetric: &assessment.Metric{
+				Id:      testdata.MockMetricID1,
+				Name:    testdata.MockMetricName1,
+				Scale:   assessment.Metric_ORDINAL,
+				Range:   &assessment.Range{Range: &assessment.Range_MinMax{}},
+				Version: assessment.DefaultMetricVersion,
+				State:   assessment.Metric_STATE_PUBLISHED,
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "Create draft metric",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t),
+			},
+			args: args{
+				context.TODO(),
+				&orchestrator.CreateMetricRequest{
+					Metric: &assessment.Metric{
+						Id:    testdata.MockMetricID1,
+						Name:  testdata.MockMetricName1,
+						Scale: assessment.Metric_ORDINAL,
+						Range: &assessment.Range{Range: &assessment.Range_MinMax{}},
+						State: assessment.Metric_STATE_DRAFT,
+					},
+				},
+			},
 			wantMetric: &assessment.Metric{
 				Id:    testdata.MockMetricID1,
 				Name:  testdata.MockMetricName1,
 				Scale: assessment.Metric_ORDINAL,
 				Range: &assessment.Range{Range: &assessment.Range_MinMax{}},
+				State: assessment.Metric_STATE_DRAFT,
 			},
 			wantErr: assert.NoError,
 		},
+		{
+			name: "Create deprecated metric",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t),
+			},
+			args: args{
+				context.TODO(),
+				&orchestrator.CreateMetricRequest{
+					Metric: &assessment.Metric{
+						Id:    testdata.MockMetricID1,
+						Name:  testdata.MockMetricName1,
+						Scale: assessment.Metric_ORDINAL,
+						Range: &assessment.Range{Range: &assessment.Range_MinMax{}},
+						State: assessment.Metric_STATE_DEPRECATED,
+					},
+				},
+			},
+			wantMetric: nil,
+			wantErr: func(tt assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorContains(t, err, "the metric shouldn't be set to deprecated at creation time")
+			},
+		},
 		{
 			name: "Create invalid metric",
 			fields: fields{
@@ -393,7 +447,7 @@ func TestService_CreateMetric(t *testing.T) {
 			svc := &Service{
 				storage: tt.fields.storage,
 			}
-			gotMetric, err := svc.CreateMetric(tt.args.in0, tt.args.req)
+			gotMetric, err := servicetest.Validated(svc.CreateMetric)(tt.args.in0, tt.args.req)
 			if tt.wantErr(t, err) && err == nil {
 				assert.NoError(t, api.Validate(gotMetric))
 			}
@@ -444,9 +498,111 @@ func TestService_UpdateMetric(t *testing.T) {
 				Scale:           assessment.Metric_NOMINAL,
 				Range:           &assessment.Range{Range: &assessment.Range_AllowedValues{}},
 				DeprecatedSince: timestamp,
+				Version:         assessment.DefaultMetricVersion,
+				State:           assessment.Metric_STATE_DEPRECATED,
 			},
 			wantErr: assert.NoError,
 		},
+		{
+			name: "Update published metric with explicit version",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					_ = s.Create(&assessment.Metric{Id: "TransportEncryptionEnabled", Version: "1.2.0", State: assessment.Metric_STATE_PUBLISHED})
+				}),
+			},
+			args: args{
+				context.TODO(),
+				&orchestrator.UpdateMetricRequest{
+					Metric: &assessment.Metric{
+						Id:      "TransportEncryptionEnabled",
+						Name:    "A slightly updated metric",
+						Scale:   assessment.Metric_NOMINAL,
+						Range:   &assessment.Range{Range: &assessment.Range_AllowedValues{}},
+						Version: "2.0.0",
+					},
+				},
+			},
+			wantMetric: &assessment.Metric{
+				Id:      "TransportEncryptionEnabled",
+				Name:    "A slightly updated metric",
+				Scale:   assessment.Metric_NOMINAL,
+				Range:   &assessment.Range{Range: &assessment.Range_AllowedValues{}},
+				Version: "2.0.0",
+				State:   assessment.Metric_STATE_PUBLISHED,
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "Update published metric without version increase",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					_ = s.Create(&assessment.Metric{Id: "TransportEncryptionEnabled", Version: "1.2.0", State: assessment.Metric_STATE_PUBLISHED})
+				}),
+			},
+			args: args{
+				context.TODO(),
+				&orchestrator.UpdateMetricRequest{
+					Metric: &assessment.Metric{
+						Id:      "TransportEncryptionEnabled",
+						Name:    "A slightly updated metric",
+						Scale:   assessment.Metric_NOMINAL,
+						Range:   &assessment.Range{Range: &assessment.Range_AllowedValues{}},
+						Version: "1.2.0",
+					},
+				},
+			},
+			wantMetric: nil,
+			wantErr: func(tt assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorContains(t, err, "version of published metric must be greater than 1.2.0")
+			},
+		},
+		{
+			name: "Update published metric back to draft",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					_ = s.Create(&assessment.Metric{Id: "TransportEncryptionEnabled", Version: "1.2.0", State: assessment.Metric_STATE_PUBLISHED})
+				}),
+			},
+			args: args{
+				context.TODO(),
+				&orchestrator.UpdateMetricRequest{
+					Metric: &assessment.Metric{
+						Id:    "TransportEncryptionEnabled",
+						Name:  "A slightly updated metric",
+						Scale: assessment.Metric_NOMINAL,
+						Range: &assessment.Range{Range: &assessment.Range_AllowedValues{}},
+						State: assessment.Metric_STATE_DRAFT,
+					},
+				},
+			},
+			wantMetric: nil,
+			wantErr: func(tt assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorContains(t, err, "invalid state transition from STATE_PUBLISHED to STATE_DRAFT")
+			},
+		},
+		{
+			name: "Update deprecated metric",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					_ = s.Create(&assessment.Metric{Id: "TransportEncryptionEnabled", DeprecatedSince: timestamp})
+				}),
+			},
+			args: args{
+				context.TODO(),
+				&orchestrator.UpdateMetricRequest{
+					Metric: &assessment.Metric{
+						Id:    "TransportEncryptionEnabled",
+						Name:  "A slightly updated metric",
+						Scale: assessment.Metric_NOMINAL,
+						Range: &assessment.Range{Range: &assessment.Range_AllowedValues{}},
+					},
+				},
+			},
+			wantMetric: nil,
+			wantErr: func(tt assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorContains(t, err, "deprecated metrics cannot be updated")
+			},
+		},
 		{
 			name: "storage error: Get",
 			fields: fields{
@@ -533,7 +689,7 @@ func TestService_UpdateMetric(t *testing.T) {
 			svc := &Service{
 				storage: tt.fields.storage,
 			}
-			gotMetric, err := svc.UpdateMetric(tt.args.in0, tt.args.req)
+			gotMetric, err := servicetest.Validated(svc.UpdateMetric)(tt.args.in0, tt.args.req)
 			if tt.wantErr(t, err) && err == nil {
 				assert.NoError(t, api.Validate(gotMetric))
 			}
@@ -640,7 +796,7 @@ func TestService_GetMetric(t *testing.T) {
 			svc := &Service{
 				storage: tt.fields.storage,
 			}
-			gotMetric, err := svc.GetMetric(tt.args.in0, tt.args.req)
+			gotMetric, err := servicetest.Validated(svc.GetMetric)(tt.args.in0, tt.args.req)
 			if tt.wantErr(t, err) && err == nil {
 				assert.NoError(t, api.Validate(gotMetric))
 			}
@@ -760,6 +916,61 @@ func TestService_ListMetrics(t *testing.T) {
 			},
 			wantErr: assert.NoError,
 		},
+		{
+			name: "Happy path: drafts are only included if requested",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					_ = s.Create(&assessment.Metric{
+						Id:          testdata.MockMetricID1,
+						Name:        testdata.MockMetricName1,
+						Description: testdata.MockMetricDescription1,
+						Scale:       assessment.Metric_ORDINAL,
+						Range:       MockMetricRange1,
+						State:       assessment.Metric_STATE_DRAFT,
+					})
+				}),
+			},
+			args: args{
+				req: &orchestrator.ListMetricsRequest{
+					Filter: &orchestrator.ListMetricsRequest_Filter{
+						IncludeDrafts: proto.Bool(true),
+					},
+				},
+			},
+			wantRes: &orchestrator.ListMetricsResponse{
+				Metrics: []*assessment.Metric{
+					{
+						Id:          testdata.MockMetricID1,
+						Name:        testdata.MockMetricName1,
+						Description: testdata.MockMetricDescription1,
+						Scale:       assessment.Metric_ORDINAL,
+						Range:       MockMetricRange1,
+						State:       assessment.Metric_STATE_DRAFT,
+					},
+				},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "Happy path: drafts are excluded",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					_ = s.Create(&assessment.Metric{
+						Id:          testdata.MockMetricID1,
+						Name:        testdata.MockMetricName1,
+						Description: testdata.MockMetricDescription1,
+						Scale:       assessment.Metric_ORDINAL,
+						Range:       MockMetricRange1,
+						State:       assessment.Metric_STATE_DRAFT,
+					})
+				}),
+			},
+			args: args{
+				req: &orchestrator.ListMetricsRequest{},
+			},
+			wantRes: &orchestrator.ListMetricsResponse{},
+			wantErr: assert.NoError,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -775,7 +986,7 @@ func TestService_ListMetrics(t *testing.T) {
 				events:                tt.fields.events,
 				authz:                 tt.fields.authz,
 			}
-			gotRes, err := svc.ListMetrics(tt.args.in0, tt.args.req)
+			gotRes, err := servicetest.Validated(svc.ListMetrics)(tt.args.in0, tt.args.req)
 			tt.wantErr(t, err)
 
 			if tt.wantRes != nil {
@@ -872,7 +1083,7 @@ func TestService_GetMetricImplementation(t *testing.T) {
 				events:                tt.fields.events,
 			}
 
-			gotRes, err := svc.GetMetricImplementation(tt.args.ctx, tt.args.req)
+			gotRes, err := servicetest.Validated(svc.GetMetricImplementation)(tt.args.ctx, tt.args.req)
 			if tt.wantErr(t, err) && err == nil {
 				assert.NoError(t, api.Validate(gotRes))
 			}
@@ -882,6 +1093,216 @@ func TestService_GetMetricImplementation(t *testing.T) {
 	}
 }
 
+func TestService_TestMetricImplementation(t *testing.T) {
+	const code = `package clouditor.metrics.automatic_updates_enabled
+
+import data.clouditor.compare
+
+default applicable = false
+
+default compliant = false
+
+applicable {
+	input.automaticUpdates
+}
+
+compliant {
+	compare(data.operator, data.target_value, input.automaticUpdates.enabled)
+}`
+
+	var (
+		vm = &evidence.Evidence{
+			Id:             testdata.MockEvidenceID1,
+			Timestamp:      timestamppb.Now(),
+			CloudServiceId: testdata.MockCloudServiceID1,
+			ToolId:         testdata.MockEvidenceToolID1,
+			Resource: prototest.NewAny(t, &ontology.VirtualMachine{
+				Id:               testdata.MockResourceID1,
+				AutomaticUpdates: &ontology.AutomaticUpdates{Enabled: true},
+			}),
+		}
+		storage = &evidence.Evidence{
+			Id:             testdata.MockEvidenceID2,
+			Timestamp:      timestamppb.Now(),
+			CloudServiceId: testdata.MockCloudServiceID1,
+			ToolId:         testdata.MockEvidenceToolID1,
+			Resource:       prototest.NewAny(t, &ontology.ObjectStorage{Id: testdata.MockResourceID2}),
+		}
+		impl = &assessment.MetricImplementation{
+			MetricId: "AutomaticUpdatesEnabled",
+			Lang:     assessment.MetricImplementation_LANGUAGE_REGO,
+			Code:     code,
+		}
+	)
+
+	type fields struct {
+		authz service.AuthorizationStrategy
+	}
+	type args struct {
+		req *orchestrator.TestMetricImplementationRequest
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantRes assert.Want[*orchestrator.TestMetricImplementationResponse]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "no evidences",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &orchestrator.TestMetricImplementationRequest{Implementation: impl},
+			},
+			wantRes: assert.Nil[*orchestrator.TestMetricImplementationResponse],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "evidences: value must contain at least 1 item(s)")
+			},
+		},
+		{
+			name: "supplied configuration",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &orchestrator.TestMetricImplementationRequest{
+					Implementation: impl,
+					Configuration: &orchestrator.DefaultMetricConfiguration{
+						Operator:    "==",
+						TargetValue: structpb.NewBoolValue(false),
+					},
+					Evidences: []*evidence.Evidence{vm, storage},
+				},
+			},
+			wantRes: func(t *testing.T, got *orchestrator.TestMetricImplementationResponse) bool {
+				return assert.Equal(t, &orchestrator.TestMetricImplementationResponse{
+					Results: []*orchestrator.MetricImplementationTestResult{
+						{
+							EvidenceId:  testdata.MockEvidenceID1,
+							Applicable:  true,
+							Compliant:   false,
+							Operator:    "==",
+							TargetValue: structpb.NewBoolValue(false),
+						},
+						{
+							EvidenceId:  testdata.MockEvidenceID2,
+							Applicable:  false,
+							Compliant:   false,
+							Operator:    "==",
+							TargetValue: structpb.NewBoolValue(false),
+						},
+					},
+				}, got)
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "configuration of the cloud service",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &orchestrator.TestMetricImplementationRequest{
+					Implementation: impl,
+					Evidences:      []*evidence.Evidence{vm},
+				},
+			},
+			wantRes: func(t *testing.T, got *orchestrator.TestMetricImplementationResponse) bool {
+				return assert.Equal(t, &orchestrator.TestMetricImplementationResponse{
+					Results: []*orchestrator.MetricImplementationTestResult{
+						{
+							EvidenceId:  testdata.MockEvidenceID1,
+							Applicable:  true,
+							Compliant:   true,
+							Operator:    "==",
+							TargetValue: structpb.NewBoolValue(true),
+						},
+					},
+				}, got)
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "configuration of unknown metric",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &orchestrator.TestMetricImplementationRequest{
+					Implementation: &assessment.MetricImplementation{
+						MetricId: "MyCustomMetric",
+						Code:     "package clouditor.metrics.my_custom_metric\n\napplicable = true\n\ncompliant = true",
+					},
+					Evidences: []*evidence.Evidence{vm},
+				},
+			},
+			wantRes: func(t *testing.T, got *orchestrator.TestMetricImplementationResponse) bool {
+				if !assert.Equal(t, 1, len(got.Results)) {
+					return false
+				}
+
+				return assert.Contains(t, got.Results[0].GetError(), "metric configuration not found for metric with id 'MyCustomMetric'")
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "compile error",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &orchestrator.TestMetricImplementationRequest{
+					Implementation: &assessment.MetricImplementation{
+						MetricId: "AutomaticUpdatesEnabled",
+						Code:     "package clouditor.metrics.automatic_updates_enabled\n\napplicable {",
+					},
+					Evidences: []*evidence.Evidence{vm, storage},
+				},
+			},
+			wantRes: func(t *testing.T, got *orchestrator.TestMetricImplementationResponse) bool {
+				assert.Empty(t, got.Results)
+				if !assert.Equal(t, 1, len(got.CompileErrors)) {
+					return false
+				}
+
+				return assert.Contains(t, got.CompileErrors[0], "rego_parse_error")
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "permission denied",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategy(false, testdata.MockCloudServiceID2),
+			},
+			args: args{
+				req: &orchestrator.TestMetricImplementationRequest{
+					Implementation: impl,
+					Evidences:      []*evidence.Evidence{vm},
+				},
+			},
+			wantRes: assert.Nil[*orchestrator.TestMetricImplementationResponse],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, service.ErrPermissionDenied)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := NewService(
+				WithStorage(testutil.NewInMemoryStorage(t)),
+				WithAuthorizationStrategy(tt.fields.authz),
+			)
+
+			gotRes, err := servicetest.Validated(svc.TestMetricImplementation)(context.Background(), tt.args.req)
+
+			tt.wantErr(t, err)
+			tt.wantRes(t, gotRes)
+		})
+	}
+}
+
 func TestService_UpdateMetricImplementation(t *testing.T) {
 	type fields struct {
 		AssessmentResultHooks []assessment.ResultHookFunc
@@ -1003,7 +1424,7 @@ func TestService_UpdateMetricImplementation(t *testing.T) {
 				catalogsFolder:        tt.fields.catalogsFolder,
 				events:                tt.fields.events,
 			}
-			gotImpl, err := svc.UpdateMetricImplementation(tt.args.in0, tt.args.req)
+			gotImpl, err := servicetest.Validated(svc.UpdateMetricImplementation)(tt.args.in0, tt.args.req)
 
 			tt.wantErr(t, err)
 			tt.wantImpl(t, gotImpl)
@@ -1114,6 +1535,49 @@ func TestService_GetMetricConfiguration(t *testing.T) {
 			},
 			wantErr: assert.NoError,
 		},
+		{
+			name: "default configuration of organization",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					_ = s.Create(&assessment.Metric{Id: testdata.MockMetricID1})
+					_ = s.Create(&orchestrator.Organization{
+						Id:        testdata.MockOrganizationID,
+						Name:      testdata.MockOrganizationName,
+						UpdatedAt: timestamppb.New(time.Unix(1, 0)),
+						DefaultMetricConfigurations: map[string]*orchestrator.DefaultMetricConfiguration{
+							testdata.MockMetricID1: {
+								Operator:    ">=",
+								TargetValue: structpb.NewNumberValue(2),
+							},
+						},
+					})
+					_ = s.Create(&orchestrator.CloudService{
+						Id:             testdata.MockCloudServiceID1,
+						OrganizationId: util.Ref(testdata.MockOrganizationID),
+					})
+				}),
+				authz: servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &orchestrator.GetMetricConfigurationRequest{
+					MetricId:       testdata.MockMetricID1,
+					CloudServiceId: testdata.MockCloudServiceID1,
+				},
+			},
+			want: func(t *testing.T, got *assessment.MetricConfiguration) bool {
+				wantResp := &assessment.MetricConfiguration{
+					MetricId:       testdata.MockMetricID1,
+					CloudServiceId: testdata.MockCloudServiceID1,
+					Operator:       ">=",
+					TargetValue:    structpb.NewNumberValue(2),
+					IsDefault:      true,
+					UpdatedAt:      timestamppb.New(time.Unix(1, 0)),
+				}
+
+				return assert.Equal(t, wantResp, got)
+			},
+			wantErr: assert.NoError,
+		},
 		{
 			name: "metric not found",
 			fields: fields{
@@ -1141,7 +1605,7 @@ func TestService_GetMetricConfiguration(t *testing.T) {
 				events:                tt.fields.events,
 				authz:                 tt.fields.authz,
 			}
-			gotResponse, err := s.GetMetricConfiguration(tt.args.in0, tt.args.req)
+			gotResponse, err := servicetest.Validated(s.GetMetricConfiguration)(tt.args.in0, tt.args.req)
 			if tt.wantErr(t, err, tt.args) && err == nil {
 				assert.NoError(t, api.Validate(gotResponse))
 			}
@@ -1245,7 +1709,7 @@ func TestService_ListMetricConfigurations(t *testing.T) {
 				events:                tt.fields.events,
 				authz:                 tt.fields.authz,
 			}
-			gotRes, err := svc.ListMetricConfigurations(tt.args.ctx, tt.args.req)
+			gotRes, err := servicetest.Validated(svc.ListMetricConfigurations)(tt.args.ctx, tt.args.req)
 			tt.wantErr(t, err)
 
 			if tt.wantRes != nil {
@@ -1299,6 +1763,29 @@ func TestService_UpdateMetricConfiguration(t *testing.T) {
 				return assert.ErrorContains(t, err, "value does not match regex pattern")
 			},
 		},
+		{
+			name: "Target value does not fit the operator",
+			fields: fields{
+				authz: &service.AuthorizationStrategyAllowAll{},
+			},
+			args: args{
+				req: &orchestrator.UpdateMetricConfigurationRequest{
+					CloudServiceId: testdata.MockCloudServiceID1,
+					MetricId:       testdata.MockMetricID1,
+					Configuration: &assessment.MetricConfiguration{
+						CloudServiceId: testdata.MockCloudServiceID1,
+						MetricId:       testdata.MockMetricID1,
+						Operator:       "inRange",
+						TargetValue:    testdata.MockMetricConfigurationTargetValueString,
+					},
+				},
+			},
+			want: assert.Nil[*assessment.MetricConfiguration],
+			wantErr: func(tt assert.TestingT, err error, i ...interface{}) bool {
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+				return assert.ErrorContains(t, err, assessment.ErrInvalidTargetValue.Error())
+			},
+		},
 		{
 			name: "Permission denied",
 			fields: fields{
@@ -1572,10 +2059,19 @@ func TestService_UpdateMetricConfiguration(t *testing.T) {
 					return false
 				}
 
-				return assert.Equal(t, config.Operator, "<")
+				// The configuration refers to its revision
+				var revision *assessment.MetricConfigurationRevision
+				err = got.storage.Get(&revision, "id = ?", config.RevisionId)
+				if !assert.NoError(t, err) {
+					return false
+				}
+
+				return assert.Equal(t, config.Operator, "<") &&
+					assert.Equal(t, config.RevisionId, revision.Configuration.GetRevisionId())
 			},
 			want: func(t *testing.T, got *assessment.MetricConfiguration) bool {
-				return assert.NoError(t, api.Validate(got))
+				return assert.NoError(t, api.Validate(got)) &&
+					assert.NotEmpty(t, got.RevisionId)
 			},
 		},
 		{
@@ -1634,7 +2130,7 @@ func TestService_UpdateMetricConfiguration(t *testing.T) {
 				events:                tt.fields.events,
 				authz:                 tt.fields.authz,
 			}
-			gotRes, err := svc.UpdateMetricConfiguration(tt.args.in0, tt.args.req)
+			gotRes, err := servicetest.Validated(svc.UpdateMetricConfiguration)(tt.args.in0, tt.args.req)
 			tt.wantErr(t, err)
 			tt.want(t, gotRes)
 			assert.Optional(t, tt.wantSvc, svc)
@@ -1807,10 +2303,190 @@ func TestService_RemoveMetric(t *testing.T) {
 				storage: tt.fields.storage,
 			}
 
-			res, err := svc.RemoveMetric(context.TODO(), tt.args.req)
+			res, err := servicetest.Validated(svc.RemoveMetric)(context.TODO(), tt.args.req)
 			tt.wantErr(t, err)
 			tt.wantRes(t, res)
 			assert.Optional(t, tt.wantSvc, svc)
 		})
 	}
 }
+
+func TestService_RestoreMetric(t *testing.T) {
+	type fields struct {
+		storage persistence.Storage
+	}
+	type args struct {
+		req *orchestrator.RestoreMetricRequest
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantRes assert.Want[*assessment.Metric]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "Validation error",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t),
+			},
+			args: args{
+				req: &orchestrator.RestoreMetricRequest{},
+			},
+			wantRes: assert.Nil[*assessment.Metric],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			name: "Metric not found",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t),
+			},
+			args: args{
+				req: &orchestrator.RestoreMetricRequest{MetricId: testdata.MockMetricID1},
+			},
+			wantRes: assert.Nil[*assessment.Metric],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, ErrMetricNotFound)
+			},
+		},
+		{
+			name: "Metric is not removed",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					assert.NoError(t, s.Create(orchestratortest.NewMetric()))
+				}),
+			},
+			args: args{
+				req: &orchestrator.RestoreMetricRequest{MetricId: testdata.MockMetricID1},
+			},
+			wantRes: assert.Nil[*assessment.Metric],
+			wantErr: func(t *testing.T, err error) bool {
+				assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+				return assert.ErrorContains(t, err, "only removed metrics can be restored")
+			},
+		},
+		{
+			name: "Happy path",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					m := orchestratortest.NewMetric()
+					m.State = assessment.Metric_STATE_DEPRECATED
+					m.DeprecatedSince = timestamppb.Now()
+					assert.NoError(t, s.Create(m))
+				}),
+			},
+			args: args{
+				req: &orchestrator.RestoreMetricRequest{MetricId: testdata.MockMetricID1},
+			},
+			wantRes: func(t *testing.T, got *assessment.Metric) bool {
+				assert.Equal(t, assessment.Metric_STATE_PUBLISHED, got.State)
+				return assert.Nil(t, got.DeprecatedSince)
+			},
+			wantErr: assert.Nil[error],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				storage: tt.fields.storage,
+				events:  make(chan *orchestrator.MetricChangeEvent, 1),
+			}
+
+			gotRes, err := servicetest.Validated(svc.RestoreMetric)(context.Background(), tt.args.req)
+			tt.wantErr(t, err)
+			tt.wantRes(t, gotRes)
+		})
+	}
+}
+
+func TestService_PublishMetric(t *testing.T) {
+	type fields struct {
+		storage persistence.Storage
+	}
+	type args struct {
+		req *orchestrator.PublishMetricRequest
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantRes assert.Want[*assessment.Metric]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "Validation error",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t),
+			},
+			args: args{
+				req: &orchestrator.PublishMetricRequest{},
+			},
+			wantRes: assert.Nil[*assessment.Metric],
+			wantErr: func(t *testing.T, err error) bool {
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+				return assert.ErrorContains(t, err, "metric_id: value length must be at least 1 characters")
+			},
+		},
+		{
+			name: "Metric not found",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t),
+			},
+			args: args{
+				req: &orchestrator.PublishMetricRequest{MetricId: testdata.MockMetricID1},
+			},
+			wantRes: assert.Nil[*assessment.Metric],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, ErrMetricNotFound)
+			},
+		},
+		{
+			name: "Metric is not a draft",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					assert.NoError(t, s.Create(orchestratortest.NewMetric()))
+				}),
+			},
+			args: args{
+				req: &orchestrator.PublishMetricRequest{MetricId: testdata.MockMetricID1},
+			},
+			wantRes: assert.Nil[*assessment.Metric],
+			wantErr: func(t *testing.T, err error) bool {
+				assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+				return assert.ErrorContains(t, err, "only draft metrics can be published")
+			},
+		},
+		{
+			name: "Happy path",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					m := orchestratortest.NewMetric()
+					m.State = assessment.Metric_STATE_DRAFT
+					assert.NoError(t, s.Create(m))
+				}),
+			},
+			args: args{
+				req: &orchestrator.PublishMetricRequest{MetricId: testdata.MockMetricID1},
+			},
+			wantRes: func(t *testing.T, got *assessment.Metric) bool {
+				assert.Equal(t, assessment.Metric_STATE_PUBLISHED, got.State)
+				return assert.Equal(t, assessment.DefaultMetricVersion, got.Version)
+			},
+			wantErr: assert.Nil[error],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				storage: tt.fields.storage,
+				events:  make(chan *orchestrator.MetricChangeEvent, 1),
+			}
+
+			gotRes, err := servicetest.Validated(svc.PublishMetric)(context.Background(), tt.args.req)
+			tt.wantErr(t, err)
+			tt.wantRes(t, gotRes)
+		})
+	}
+}
@@ -0,0 +1,407 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"clouditor.io/clouditor/v2/api"
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/internal/logging"
+	"clouditor.io/clouditor/v2/persistence"
+	"clouditor.io/clouditor/v2/service"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// DefaultCertificateReminderDays are the default number of days before the expiration of a certificate at which
+// reminders are sent.
+var DefaultCertificateReminderDays = []uint32{30, 7, 1}
+
+// ErrNotificationChannelNotFound indicates the notification channel was not found
+var ErrNotificationChannelNotFound = api.ErrorCodeNotificationChannelNotFound.Err()
+
+// certificateDateLayouts contains the layouts we accept for the expiration date of a certificate
+var certificateDateLayouts = []string{
+	time.RFC3339,
+	time.DateOnly,
+	"2006-01-02 15:04:05.999999999 -0700 MST",
+}
+
+// CreateNotificationChannel implements method for creating a new notification channel
+func (svc *Service) CreateNotificationChannel(ctx context.Context, req *orchestrator.CreateNotificationChannelRequest) (res *orchestrator.NotificationChannel, err error) {
+	err = req.Channel.ValidateTarget()
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	// Check, if this request has access to the cloud service according to our authorization strategy.
+	if !svc.authz.CheckAccess(ctx, service.AccessCreate, req) {
+		return nil, service.ErrPermissionDenied
+	}
+
+	// Generate a new ID
+	req.Channel.Id = uuid.NewString()
+	req.Channel.CreatedAt = timestamppb.Now()
+
+	// Persist the new notification channel in our database
+	err = svc.storage.Create(req.Channel)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not add notification channel to the database: %v", err)
+	}
+
+	logging.LogRequest(log, logrus.DebugLevel, logging.Create, req)
+
+	return req.Channel, nil
+}
+
+// GetNotificationChannel implements method for getting a notification channel
+func (svc *Service) GetNotificationChannel(ctx context.Context, req *orchestrator.GetNotificationChannelRequest) (res *orchestrator.NotificationChannel, err error) {
+	return svc.getNotificationChannel(ctx, req.ChannelId)
+}
+
+// ListNotificationChannels implements method for listing all notification channels the user is authorized to see
+func (svc *Service) ListNotificationChannels(ctx context.Context, req *orchestrator.ListNotificationChannelsRequest) (res *orchestrator.ListNotificationChannelsResponse, err error) {
+	var (
+		query []string
+		args  []any
+	)
+
+	// Retrieve list of allowed cloud service according to our authorization strategy
+	all, allowed := svc.authz.AllowedCloudServices(ctx)
+
+	// The content of the filtered cloud service ID must be in the list of allowed cloud service IDs, unless one can
+	// access *all* the cloud services.
+	if !all && req.CloudServiceId != nil && !slices.Contains(allowed, req.GetCloudServiceId()) {
+		return nil, service.ErrPermissionDenied
+	}
+
+	if req.CloudServiceId != nil {
+		query = append(query, "cloud_service_id = ?")
+		args = append(args, req.GetCloudServiceId())
+	}
+
+	if !all {
+		query = append(query, "cloud_service_id IN ?")
+		args = append(args, allowed)
+	}
+
+	res = new(orchestrator.ListNotificationChannelsResponse)
+
+	res.Channels, res.NextPageToken, err = service.PaginateStorage[*orchestrator.NotificationChannel](req, svc.storage,
+		service.DefaultPaginationOpts, persistence.BuildConds(query, args)...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not paginate results: %v", err)
+	}
+
+	return
+}
+
+// UpdateNotificationChannel implements method for updating an existing notification channel
+func (svc *Service) UpdateNotificationChannel(ctx context.Context, req *orchestrator.UpdateNotificationChannelRequest) (res *orchestrator.NotificationChannel, err error) {
+	var existing *orchestrator.NotificationChannel
+
+	if req.Channel.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "channel.id: value is empty, which is not a valid UUID")
+	}
+
+	err = req.Channel.ValidateTarget()
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	// Check, if this request has access to the (new) cloud service according to our authorization strategy.
+	if !svc.authz.CheckAccess(ctx, service.AccessUpdate, req) {
+		return nil, service.ErrPermissionDenied
+	}
+
+	// Retrieve the existing channel, which also checks the access to its current cloud service
+	existing, err = svc.getNotificationChannel(ctx, req.Channel.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	res = req.Channel
+	res.CreatedAt = existing.CreatedAt
+
+	err = svc.storage.Save(res, "id = ?", res.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
+	logging.LogRequest(log, logrus.DebugLevel, logging.Update, req)
+
+	return res, nil
+}
+
+// RemoveNotificationChannel implements method for removing a notification channel
+func (svc *Service) RemoveNotificationChannel(ctx context.Context, req *orchestrator.RemoveNotificationChannelRequest) (res *emptypb.Empty, err error) {
+	// Check, if the channel exists and if we have access to it
+	_, err = svc.getNotificationChannel(ctx, req.ChannelId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = svc.storage.Delete(&orchestrator.NotificationChannel{}, "id = ?", req.ChannelId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
+	logging.LogRequest(log, logrus.DebugLevel, logging.Remove, req)
+
+	return &emptypb.Empty{}, nil
+}
+
+// SendNotification implements method for sending a notification to the notification channels of a cloud service.
+// Errors during the delivery to a single channel are only logged, since other channels might still be reachable.
+func (svc *Service) SendNotification(ctx context.Context, req *orchestrator.SendNotificationRequest) (res *emptypb.Empty, err error) {
+	// Check, if this request has access to the cloud service according to our authorization strategy.
+	if !svc.authz.CheckAccess(ctx, service.AccessCreate, req) {
+		return nil, service.ErrPermissionDenied
+	}
+
+	err = svc.notify(req.Notification)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// CheckCertificateExpiry transitions certificates that reached their expiration date into the expired state and sends
+// a reminder for each certificate that crossed one of its reminder thresholds since the last reminder. The severity of
+// the reminders increases as the expiration date approaches.
+func (svc *Service) CheckCertificateExpiry() (err error) {
+	var (
+		certs []*orchestrator.Certificate
+		now   = time.Now()
+	)
+
+	err = svc.storage.List(&certs, "", true, 0, -1)
+	if err != nil {
+		return fmt.Errorf("could not retrieve certificates: %w", err)
+	}
+
+	for _, cert := range certs {
+		if cert.CurrentState == orchestrator.CertificateState_CERTIFICATE_STATE_EXPIRED ||
+			cert.CurrentState == orchestrator.CertificateState_CERTIFICATE_STATE_WITHDRAWN {
+			continue
+		}
+
+		expiration, err := parseCertificateDate(cert.ExpirationDate)
+		if err != nil {
+			log.Warnf("Could not parse expiration date of certificate %s: %v", cert.Id, err)
+			continue
+		}
+
+		if !expiration.After(now) {
+			err = svc.expireCertificate(cert, now)
+			if err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		days := cert.ReminderDays
+		if len(days) == 0 {
+			svc.notificationMutex.RLock()
+			days = svc.certificateReminderDays
+			svc.notificationMutex.RUnlock()
+		}
+
+		reminder, ok := dueReminder(days, expiration, cert.LastReminderAt, now)
+		if !ok {
+			continue
+		}
+
+		n := &orchestrator.Notification{
+			CloudServiceId: cert.CloudServiceId,
+			CertificateId:  &cert.Id,
+			Severity:       orchestrator.NotificationSeverity_NOTIFICATION_SEVERITY_MEDIUM,
+			Title:          fmt.Sprintf("Certificate %s is about to expire", cert.Name),
+			Text: fmt.Sprintf("The certificate %s expires on %s, which is in less than %d day(s).",
+				cert.Name, expiration.Format(time.DateOnly), reminder),
+			Timestamp: timestamppb.New(now),
+		}
+
+		if reminder <= 7 {
+			n.Severity = orchestrator.NotificationSeverity_NOTIFICATION_SEVERITY_HIGH
+		}
+
+		cert.LastReminderAt = timestamppb.New(now)
+		err = svc.storage.Save(cert, "id = ?", cert.Id)
+		if err != nil {
+			return fmt.Errorf("could not store reminder of certificate %s: %w", cert.Id, err)
+		}
+
+		err = svc.notify(n)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// expireCertificate transitions the certificate into the expired state and informs the notification channels about it.
+func (svc *Service) expireCertificate(cert *orchestrator.Certificate, now time.Time) (err error) {
+	var reason = "The expiration date has been reached"
+
+	err = cert.TransitionTo(orchestrator.CertificateState_CERTIFICATE_STATE_EXPIRED, &reason, true, now)
+	if err != nil {
+		return err
+	}
+
+	err = svc.storage.Save(cert, "id = ?", cert.Id)
+	if err != nil {
+		return fmt.Errorf("could not store state of certificate %s: %w", cert.Id, err)
+	}
+
+	go svc.informWebhooksAboutCertificate(cert)
+
+	return svc.notifyAboutCertificateState(cert, reason)
+}
+
+// dueReminder returns the most urgent reminder (in days before the expiration) that has been reached, but for which no
+// reminder was sent yet.
+func dueReminder(days []uint32, expiration time.Time, last *timestamppb.Timestamp, now time.Time) (reminder uint32, ok bool) {
+	for _, d := range days {
+		if expiration.Sub(now) > time.Duration(d)*24*time.Hour {
+			continue
+		}
+
+		if !ok || d < reminder {
+			reminder, ok = d, true
+		}
+	}
+
+	if !ok {
+		return 0, false
+	}
+
+	// Check, whether we already sent a reminder after the threshold was reached
+	threshold := expiration.Add(-time.Duration(reminder) * 24 * time.Hour)
+	if last != nil && !last.AsTime().Before(threshold) {
+		return 0, false
+	}
+
+	return reminder, true
+}
+
+// StartCertificateExpiryCheck checks the expiration of the certificates in the given interval until the context is
+// done. If a leader elector is configured, only the leader checks them, so that reminders are not sent twice.
+func (svc *Service) StartCertificateExpiryCheck(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if service.IsLeading(ctx, svc.leader) {
+			if err := svc.CheckCertificateExpiry(); err != nil {
+				log.Errorf("Could not check expiration of certificates: %v", err)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// getNotificationChannel retrieves the notification channel with the given ID from the storage and checks, whether
+// the user has access to its cloud service.
+func (svc *Service) getNotificationChannel(ctx context.Context, id string) (channel *orchestrator.NotificationChannel, err error) {
+	channel = new(orchestrator.NotificationChannel)
+
+	err = svc.storage.Get(channel, "id = ?", id)
+	if errors.Is(err, persistence.ErrRecordNotFound) {
+		return nil, ErrNotificationChannelNotFound
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
+	all, allowed := svc.authz.AllowedCloudServices(ctx)
+	if !all && !slices.Contains(allowed, channel.CloudServiceId) {
+		return nil, service.ErrPermissionDenied
+	}
+
+	return channel, nil
+}
+
+// notify sends the notification to all enabled channels of its cloud service that accept its severity. An error is
+// only returned if the channels could not be retrieved.
+func (svc *Service) notify(n *orchestrator.Notification) (err error) {
+	var channels []*orchestrator.NotificationChannel
+
+	if n.Timestamp == nil {
+		n.Timestamp = timestamppb.Now()
+	}
+
+	err = svc.storage.List(&channels, "", true, 0, -1, "cloud_service_id = ? AND enabled = ?", n.CloudServiceId, true)
+	if err != nil {
+		return err
+	}
+
+	for _, channel := range channels {
+		if !channel.Accepts(n.Severity) {
+			continue
+		}
+
+		err := svc.sendToChannel(channel, n)
+		if err != nil {
+			log.Errorf("Could not send notification to channel %s: %v", channel.Id, err)
+		}
+	}
+
+	return nil
+}
+
+// parseCertificateDate parses the (expiration) date of a certificate, which is stored as a string.
+func parseCertificateDate(s string) (t time.Time, err error) {
+	// Strip the monotonic clock reading, which is part of the output of [time.Time.String]
+	s, _, _ = strings.Cut(s, " m=")
+
+	for _, layout := range certificateDateLayouts {
+		t, err = time.Parse(layout, s)
+		if err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("unsupported date format: %s", s)
+}
@@ -0,0 +1,882 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package orchestrator
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/smtp"
+	"sync"
+	"testing"
+	"time"
+
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/testutil/servicetest"
+	"clouditor.io/clouditor/v2/internal/util"
+	"clouditor.io/clouditor/v2/persistence"
+	"clouditor.io/clouditor/v2/service"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const mockNotificationChannelID = "55555555-5555-5555-5555-555555555555"
+
+func newMockNotificationChannel() *orchestrator.NotificationChannel {
+	return &orchestrator.NotificationChannel{
+		Id:             mockNotificationChannelID,
+		CloudServiceId: testdata.MockCloudServiceID1,
+		Name:           "My Slack Channel",
+		Type:           orchestrator.NotificationChannelType_NOTIFICATION_CHANNEL_TYPE_SLACK,
+		Url:            "https://hooks.slack.com/services/T000/B000/XXXX",
+		MinSeverity:    orchestrator.NotificationSeverity_NOTIFICATION_SEVERITY_MEDIUM,
+		Enabled:        true,
+	}
+}
+
+// notificationRecorder records the JSON messages that are posted to a Slack or Teams incoming webhook.
+type notificationRecorder struct {
+	mu       sync.Mutex
+	messages []map[string]any
+}
+
+func (rec *notificationRecorder) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var msg map[string]any
+
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+
+	if err := json.NewDecoder(r.Body).Decode(&msg); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	rec.messages = append(rec.messages, msg)
+}
+
+// mailRecorder records the emails that are sent using the sendMail function of the service.
+type mailRecorder struct {
+	addr string
+	to   [][]string
+	msgs []string
+}
+
+func (rec *mailRecorder) sendMail(addr string, _ smtp.Auth, _ string, to []string, msg []byte) error {
+	rec.addr = addr
+	rec.to = append(rec.to, to)
+	rec.msgs = append(rec.msgs, string(msg))
+
+	return nil
+}
+
+func TestService_CreateNotificationChannel(t *testing.T) {
+	type fields struct {
+		storage persistence.Storage
+		authz   service.AuthorizationStrategy
+	}
+	type args struct {
+		req *orchestrator.CreateNotificationChannelRequest
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantRes assert.Want[*orchestrator.NotificationChannel]
+		wantSvc assert.Want[*Service]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "Validation error",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t),
+				authz:   servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &orchestrator.CreateNotificationChannelRequest{
+					Channel: &orchestrator.NotificationChannel{
+						CloudServiceId: testdata.MockCloudServiceID1,
+						Name:           "My Channel",
+					},
+				},
+			},
+			wantRes: assert.Nil[*orchestrator.NotificationChannel],
+			wantSvc: assert.AnyValue[*Service],
+			wantErr: func(t *testing.T, err error) bool {
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+				return assert.ErrorContains(t, err, "channel.type")
+			},
+		},
+		{
+			name: "Email channel without recipients",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t),
+				authz:   servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &orchestrator.CreateNotificationChannelRequest{
+					Channel: &orchestrator.NotificationChannel{
+						CloudServiceId: testdata.MockCloudServiceID1,
+						Name:           "My Channel",
+						Type:           orchestrator.NotificationChannelType_NOTIFICATION_CHANNEL_TYPE_EMAIL,
+					},
+				},
+			},
+			wantRes: assert.Nil[*orchestrator.NotificationChannel],
+			wantSvc: assert.AnyValue[*Service],
+			wantErr: func(t *testing.T, err error) bool {
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+				return assert.ErrorContains(t, err, orchestrator.ErrNotificationNoRecipients.Error())
+			},
+		},
+		{
+			name: "Permission denied",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t),
+				authz:   servicetest.NewAuthorizationStrategy(false, testdata.MockCloudServiceID2),
+			},
+			args: args{
+				req: &orchestrator.CreateNotificationChannelRequest{
+					Channel: newMockNotificationChannel(),
+				},
+			},
+			wantRes: assert.Nil[*orchestrator.NotificationChannel],
+			wantSvc: assert.AnyValue[*Service],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, service.ErrPermissionDenied)
+			},
+		},
+		{
+			name: "Happy path",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t),
+				authz:   servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &orchestrator.CreateNotificationChannelRequest{
+					Channel: &orchestrator.NotificationChannel{
+						CloudServiceId: testdata.MockCloudServiceID1,
+						Name:           "Security Team",
+						Type:           orchestrator.NotificationChannelType_NOTIFICATION_CHANNEL_TYPE_EMAIL,
+						Recipients:     []string{"security@example.com"},
+						MinSeverity:    orchestrator.NotificationSeverity_NOTIFICATION_SEVERITY_HIGH,
+						Enabled:        true,
+					},
+				},
+			},
+			wantRes: func(t *testing.T, got *orchestrator.NotificationChannel) bool {
+				assert.NotEmpty(t, got.Id)
+				return assert.NotNil(t, got.CreatedAt)
+			},
+			wantSvc: func(t *testing.T, got *Service) bool {
+				var channels []*orchestrator.NotificationChannel
+				err := got.storage.List(&channels, "", true, 0, -1)
+				assert.NoError(t, err)
+				if !assert.Equal(t, 1, len(channels)) {
+					return false
+				}
+
+				return assert.Equal(t, []string{"security@example.com"}, channels[0].Recipients)
+			},
+			wantErr: assert.Nil[error],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				storage: tt.fields.storage,
+				authz:   tt.fields.authz,
+			}
+
+			gotRes, err := servicetest.Validated(svc.CreateNotificationChannel)(context.Background(), tt.args.req)
+			tt.wantErr(t, err)
+			tt.wantRes(t, gotRes)
+			tt.wantSvc(t, svc)
+		})
+	}
+}
+
+func TestService_GetNotificationChannel(t *testing.T) {
+	type fields struct {
+		storage persistence.Storage
+		authz   service.AuthorizationStrategy
+	}
+	type args struct {
+		req *orchestrator.GetNotificationChannelRequest
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantRes assert.Want[*orchestrator.NotificationChannel]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "Not found",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t),
+				authz:   servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &orchestrator.GetNotificationChannelRequest{ChannelId: mockNotificationChannelID},
+			},
+			wantRes: assert.Nil[*orchestrator.NotificationChannel],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, ErrNotificationChannelNotFound)
+			},
+		},
+		{
+			name: "Permission denied",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					assert.NoError(t, s.Create(newMockNotificationChannel()))
+				}),
+				authz: servicetest.NewAuthorizationStrategy(false, testdata.MockCloudServiceID2),
+			},
+			args: args{
+				req: &orchestrator.GetNotificationChannelRequest{ChannelId: mockNotificationChannelID},
+			},
+			wantRes: assert.Nil[*orchestrator.NotificationChannel],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, service.ErrPermissionDenied)
+			},
+		},
+		{
+			name: "Happy path",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					assert.NoError(t, s.Create(newMockNotificationChannel()))
+				}),
+				authz: servicetest.NewAuthorizationStrategy(false, testdata.MockCloudServiceID1),
+			},
+			args: args{
+				req: &orchestrator.GetNotificationChannelRequest{ChannelId: mockNotificationChannelID},
+			},
+			wantRes: func(t *testing.T, got *orchestrator.NotificationChannel) bool {
+				return assert.Equal(t, newMockNotificationChannel(), got)
+			},
+			wantErr: assert.Nil[error],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				storage: tt.fields.storage,
+				authz:   tt.fields.authz,
+			}
+
+			gotRes, err := svc.GetNotificationChannel(context.Background(), tt.args.req)
+			tt.wantErr(t, err)
+			tt.wantRes(t, gotRes)
+		})
+	}
+}
+
+func TestService_ListNotificationChannels(t *testing.T) {
+	type fields struct {
+		storage persistence.Storage
+		authz   service.AuthorizationStrategy
+	}
+	type args struct {
+		req *orchestrator.ListNotificationChannelsRequest
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantRes assert.Want[*orchestrator.ListNotificationChannelsResponse]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "Permission denied for filtered cloud service",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t),
+				authz:   servicetest.NewAuthorizationStrategy(false, testdata.MockCloudServiceID2),
+			},
+			args: args{
+				req: &orchestrator.ListNotificationChannelsRequest{CloudServiceId: util.Ref(testdata.MockCloudServiceID1)},
+			},
+			wantRes: assert.Nil[*orchestrator.ListNotificationChannelsResponse],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, service.ErrPermissionDenied)
+			},
+		},
+		{
+			name: "Only allowed cloud services",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					assert.NoError(t, s.Create(newMockNotificationChannel()))
+
+					other := newMockNotificationChannel()
+					other.Id = "66666666-6666-6666-6666-666666666666"
+					other.CloudServiceId = testdata.MockCloudServiceID2
+					assert.NoError(t, s.Create(other))
+				}),
+				authz: servicetest.NewAuthorizationStrategy(false, testdata.MockCloudServiceID1),
+			},
+			args: args{
+				req: &orchestrator.ListNotificationChannelsRequest{},
+			},
+			wantRes: func(t *testing.T, got *orchestrator.ListNotificationChannelsResponse) bool {
+				if !assert.Equal(t, 1, len(got.Channels)) {
+					return false
+				}
+
+				return assert.Equal(t, mockNotificationChannelID, got.Channels[0].Id)
+			},
+			wantErr: assert.Nil[error],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				storage: tt.fields.storage,
+				authz:   tt.fields.authz,
+			}
+
+			gotRes, err := svc.ListNotificationChannels(context.Background(), tt.args.req)
+			tt.wantErr(t, err)
+			tt.wantRes(t, gotRes)
+		})
+	}
+}
+
+func TestService_UpdateNotificationChannel(t *testing.T) {
+	type fields struct {
+		storage persistence.Storage
+		authz   service.AuthorizationStrategy
+	}
+	type args struct {
+		req *orchestrator.UpdateNotificationChannelRequest
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantRes assert.Want[*orchestrator.NotificationChannel]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "Missing ID",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t),
+				authz:   servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &orchestrator.UpdateNotificationChannelRequest{
+					Channel: func() *orchestrator.NotificationChannel {
+						channel := newMockNotificationChannel()
+						channel.Id = ""
+						return channel
+					}(),
+				},
+			},
+			wantRes: assert.Nil[*orchestrator.NotificationChannel],
+			wantErr: func(t *testing.T, err error) bool {
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+				return assert.ErrorContains(t, err, "channel.id")
+			},
+		},
+		{
+			name: "Slack channel without URL",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t),
+				authz:   servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &orchestrator.UpdateNotificationChannelRequest{
+					Channel: func() *orchestrator.NotificationChannel {
+						channel := newMockNotificationChannel()
+						channel.Url = ""
+						return channel
+					}(),
+				},
+			},
+			wantRes: assert.Nil[*orchestrator.NotificationChannel],
+			wantErr: func(t *testing.T, err error) bool {
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+				return assert.ErrorContains(t, err, orchestrator.ErrNotificationURLMissing.Error())
+			},
+		},
+		{
+			name: "Not found",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t),
+				authz:   servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &orchestrator.UpdateNotificationChannelRequest{Channel: newMockNotificationChannel()},
+			},
+			wantRes: assert.Nil[*orchestrator.NotificationChannel],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, ErrNotificationChannelNotFound)
+			},
+		},
+		{
+			name: "Happy path",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					assert.NoError(t, s.Create(newMockNotificationChannel()))
+				}),
+				authz: servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &orchestrator.UpdateNotificationChannelRequest{
+					Channel: func() *orchestrator.NotificationChannel {
+						channel := newMockNotificationChannel()
+						channel.MinSeverity = orchestrator.NotificationSeverity_NOTIFICATION_SEVERITY_CRITICAL
+						return channel
+					}(),
+				},
+			},
+			wantRes: func(t *testing.T, got *orchestrator.NotificationChannel) bool {
+				return assert.Equal(t, orchestrator.NotificationSeverity_NOTIFICATION_SEVERITY_CRITICAL, got.MinSeverity)
+			},
+			wantErr: assert.Nil[error],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				storage: tt.fields.storage,
+				authz:   tt.fields.authz,
+			}
+
+			gotRes, err := svc.UpdateNotificationChannel(context.Background(), tt.args.req)
+			tt.wantErr(t, err)
+			tt.wantRes(t, gotRes)
+		})
+	}
+}
+
+func TestService_RemoveNotificationChannel(t *testing.T) {
+	type fields struct {
+		storage persistence.Storage
+		authz   service.AuthorizationStrategy
+	}
+	type args struct {
+		req *orchestrator.RemoveNotificationChannelRequest
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantSvc assert.Want[*Service]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "Not found",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t),
+				authz:   servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &orchestrator.RemoveNotificationChannelRequest{ChannelId: mockNotificationChannelID},
+			},
+			wantSvc: assert.AnyValue[*Service],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, ErrNotificationChannelNotFound)
+			},
+		},
+		{
+			name: "Happy path",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					assert.NoError(t, s.Create(newMockNotificationChannel()))
+				}),
+				authz: servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &orchestrator.RemoveNotificationChannelRequest{ChannelId: mockNotificationChannelID},
+			},
+			wantSvc: func(t *testing.T, got *Service) bool {
+				var channels []*orchestrator.NotificationChannel
+				err := got.storage.List(&channels, "", true, 0, -1)
+				assert.NoError(t, err)
+				return assert.Equal(t, 0, len(channels))
+			},
+			wantErr: assert.Nil[error],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				storage: tt.fields.storage,
+				authz:   tt.fields.authz,
+			}
+
+			_, err := svc.RemoveNotificationChannel(context.Background(), tt.args.req)
+			tt.wantErr(t, err)
+			tt.wantSvc(t, svc)
+		})
+	}
+}
+
+func TestService_SendNotification(t *testing.T) {
+	type args struct {
+		req *orchestrator.SendNotificationRequest
+	}
+	tests := []struct {
+		name     string
+		authz    service.AuthorizationStrategy
+		args     args
+		wantRec  assert.Want[*notificationRecorder]
+		wantMail assert.Want[*mailRecorder]
+		wantErr  assert.WantErr
+	}{
+		{
+			name:  "Validation error",
+			authz: servicetest.NewAuthorizationStrategy(true),
+			args: args{
+				req: &orchestrator.SendNotificationRequest{
+					Notification: &orchestrator.Notification{
+						CloudServiceId: testdata.MockCloudServiceID1,
+						Title:          "Something happened",
+					},
+				},
+			},
+			wantRec:  assert.AnyValue[*notificationRecorder],
+			wantMail: assert.AnyValue[*mailRecorder],
+			wantErr: func(t *testing.T, err error) bool {
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+				return assert.ErrorContains(t, err, "notification.severity")
+			},
+		},
+		{
+			name:  "Permission denied",
+			authz: servicetest.NewAuthorizationStrategy(false, testdata.MockCloudServiceID2),
+			args: args{
+				req: &orchestrator.SendNotificationRequest{
+					Notification: &orchestrator.Notification{
+						CloudServiceId: testdata.MockCloudServiceID1,
+						Severity:       orchestrator.NotificationSeverity_NOTIFICATION_SEVERITY_HIGH,
+						Title:          "Something happened",
+					},
+				},
+			},
+			wantRec:  assert.AnyValue[*notificationRecorder],
+			wantMail: assert.AnyValue[*mailRecorder],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, service.ErrPermissionDenied)
+			},
+		},
+		{
+			name:  "Below severity threshold",
+			authz: servicetest.NewAuthorizationStrategy(true),
+			args: args{
+				req: &orchestrator.SendNotificationRequest{
+					Notification: &orchestrator.Notification{
+						CloudServiceId: testdata.MockCloudServiceID1,
+						Severity:       orchestrator.NotificationSeverity_NOTIFICATION_SEVERITY_LOW,
+						Title:          "Something happened",
+					},
+				},
+			},
+			wantRec: func(t *testing.T, got *notificationRecorder) bool {
+				return assert.Equal(t, 0, len(got.messages))
+			},
+			wantMail: func(t *testing.T, got *mailRecorder) bool {
+				return assert.Equal(t, 0, len(got.msgs))
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name:  "Happy path",
+			authz: servicetest.NewAuthorizationStrategy(true),
+			args: args{
+				req: &orchestrator.SendNotificationRequest{
+					Notification: &orchestrator.Notification{
+						CloudServiceId: testdata.MockCloudServiceID1,
+						Severity:       orchestrator.NotificationSeverity_NOTIFICATION_SEVERITY_HIGH,
+						Title:          "Control OPS-13 became non-compliant",
+						ControlId:      util.Ref("OPS-13"),
+					},
+				},
+			},
+			wantRec: func(t *testing.T, got *notificationRecorder) bool {
+				if !assert.Equal(t, 2, len(got.messages)) {
+					return false
+				}
+
+				// The order of the channels is not guaranteed, but only the Teams message has a title
+				for _, msg := range got.messages {
+					if msg["@type"] == "MessageCard" {
+						assert.Equal(t, "[HIGH] Control OPS-13 became non-compliant", msg["title"])
+					} else {
+						assert.Contains(t, msg["text"], "*[HIGH] Control OPS-13 became non-compliant*")
+					}
+				}
+
+				return true
+			},
+			wantMail: func(t *testing.T, got *mailRecorder) bool {
+				if !assert.Equal(t, 1, len(got.msgs)) {
+					return false
+				}
+
+				assert.Equal(t, "smtp.example.com:587", got.addr)
+				assert.Equal(t, []string{"security@example.com"}, got.to[0])
+				assert.Contains(t, got.msgs[0], "Subject: [HIGH] Control OPS-13 became non-compliant\r\n")
+				return assert.Contains(t, got.msgs[0], "Control: OPS-13\r\n")
+			},
+			wantErr: assert.Nil[error],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := &notificationRecorder{}
+			srv := httptest.NewServer(rec)
+			defer srv.Close()
+
+			mail := &mailRecorder{}
+
+			svc := &Service{
+				storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					slack := newMockNotificationChannel()
+					slack.Url = srv.URL
+					assert.NoError(t, s.Create(slack))
+
+					teams := newMockNotificationChannel()
+					teams.Id = "66666666-6666-6666-6666-666666666666"
+					teams.Type = orchestrator.NotificationChannelType_NOTIFICATION_CHANNEL_TYPE_TEAMS
+					teams.Url = srv.URL
+					assert.NoError(t, s.Create(teams))
+
+					email := newMockNotificationChannel()
+					email.Id = "77777777-7777-7777-7777-777777777777"
+					email.Type = orchestrator.NotificationChannelType_NOTIFICATION_CHANNEL_TYPE_EMAIL
+					email.Url = ""
+					email.Recipients = []string{"security@example.com"}
+					assert.NoError(t, s.Create(email))
+
+					disabled := newMockNotificationChannel()
+					disabled.Id = "88888888-8888-8888-8888-888888888888"
+					disabled.Url = srv.URL
+					disabled.Enabled = false
+					assert.NoError(t, s.Create(disabled))
+				}),
+				authz:    tt.authz,
+				smtp:     SMTPConfig{Host: "smtp.example.com", Port: 587, From: "clouditor@example.com"},
+				sendMail: mail.sendMail,
+			}
+
+			_, err := servicetest.Validated(svc.SendNotification)(context.Background(), tt.args.req)
+			tt.wantErr(t, err)
+			tt.wantRec(t, rec)
+			tt.wantMail(t, mail)
+		})
+	}
+}
+
+func TestService_CheckCertificateExpiry(t *testing.T) {
+	var now = time.Now()
+
+	rec := &notificationRecorder{}
+	srv := httptest.NewServer(rec)
+	defer srv.Close()
+
+	svc := &Service{
+		storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+			channel := newMockNotificationChannel()
+			channel.Url = srv.URL
+			channel.MinSeverity = orchestrator.NotificationSeverity_NOTIFICATION_SEVERITY_HIGH
+			assert.NoError(t, s.Create(channel))
+
+			// Expired, which results in a critical notification
+			assert.NoError(t, s.Create(&orchestrator.Certificate{
+				Id:             "1",
+				Name:           "Expired",
+				CloudServiceId: testdata.MockCloudServiceID1,
+				ExpirationDate: now.AddDate(0, 0, -1).String(),
+			}))
+
+			// Expires soon, which results in a high notification
+			assert.NoError(t, s.Create(&orchestrator.Certificate{
+				Id:             "2",
+				Name:           "Soon",
+				CloudServiceId: testdata.MockCloudServiceID1,
+				ExpirationDate: now.AddDate(0, 0, 3).Format(time.RFC3339),
+			}))
+
+			// Expires within the warning period, but the channel only accepts high severities
+			assert.NoError(t, s.Create(&orchestrator.Certificate{
+				Id:             "3",
+				Name:           "Later",
+				CloudServiceId: testdata.MockCloudServiceID1,
+				ExpirationDate: now.AddDate(0, 0, 20).Format(time.DateOnly),
+			}))
+
+			// Outside the warning period
+			assert.NoError(t, s.Create(&orchestrator.Certificate{
+				Id:             "4",
+				Name:           "Valid",
+				CloudServiceId: testdata.MockCloudServiceID1,
+				ExpirationDate: now.AddDate(1, 0, 0).String(),
+			}))
+
+			// Invalid dates are skipped
+			assert.NoError(t, s.Create(&orchestrator.Certificate{
+				Id:             "5",
+				Name:           "Invalid",
+				CloudServiceId: testdata.MockCloudServiceID1,
+				ExpirationDate: "tomorrow",
+			}))
+		}),
+		certificateReminderDays: DefaultCertificateReminderDays,
+	}
+
+	err := svc.CheckCertificateExpiry()
+	assert.NoError(t, err)
+
+	if assert.Equal(t, 2, len(rec.messages)) {
+		assert.Contains(t, rec.messages[0]["text"], "[CRITICAL] Certificate Expired has expired")
+		assert.Contains(t, rec.messages[1]["text"], "[HIGH] Certificate Soon is about to expire")
+	}
+
+	// The expired certificate transitioned into the expired state
+	cert := new(orchestrator.Certificate)
+	assert.NoError(t, svc.storage.Get(cert, "id = ?", "1"))
+	assert.Equal(t, orchestrator.CertificateState_CERTIFICATE_STATE_EXPIRED, cert.CurrentState)
+	assert.Equal(t, 1, len(cert.States))
+
+	// Reminders are only sent once per threshold
+	err = svc.CheckCertificateExpiry()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(rec.messages))
+}
+
+func Test_dueReminder(t *testing.T) {
+	var (
+		now        = time.Now()
+		expiration = now.Add(5 * 24 * time.Hour)
+	)
+
+	type args struct {
+		days []uint32
+		last *timestamppb.Timestamp
+	}
+	tests := []struct {
+		name         string
+		args         args
+		wantReminder uint32
+		wantOk       bool
+	}{
+		{
+			name:   "No threshold reached",
+			args:   args{days: []uint32{3, 1}},
+			wantOk: false,
+		},
+		{
+			name:         "Most urgent threshold",
+			args:         args{days: []uint32{30, 7, 1}},
+			wantReminder: 7,
+			wantOk:       true,
+		},
+		{
+			name:   "Already reminded",
+			args:   args{days: []uint32{30, 7, 1}, last: timestamppb.New(now.Add(-time.Hour))},
+			wantOk: false,
+		},
+		{
+			name:         "Reminded for previous threshold",
+			args:         args{days: []uint32{30, 7, 1}, last: timestamppb.New(now.Add(-20 * 24 * time.Hour))},
+			wantReminder: 7,
+			wantOk:       true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotReminder, gotOk := dueReminder(tt.args.days, expiration, tt.args.last, now)
+			assert.Equal(t, tt.wantReminder, gotReminder)
+			assert.Equal(t, tt.wantOk, gotOk)
+		})
+	}
+}
+
+func Test_parseCertificateDate(t *testing.T) {
+	type args struct {
+		s string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    time.Time
+		wantErr assert.WantErr
+	}{
+		{
+			name:    "RFC 3339",
+			args:    args{s: "2016-07-01T00:00:00Z"},
+			want:    time.Date(2016, 7, 1, 0, 0, 0, 0, time.UTC),
+			wantErr: assert.Nil[error],
+		},
+		{
+			name:    "Date only",
+			args:    args{s: "2016-07-01"},
+			want:    time.Date(2016, 7, 1, 0, 0, 0, 0, time.UTC),
+			wantErr: assert.Nil[error],
+		},
+		{
+			name:    "Go time string",
+			args:    args{s: time.Date(2016, 7, 1, 0, 0, 0, 0, time.UTC).String()},
+			want:    time.Date(2016, 7, 1, 0, 0, 0, 0, time.UTC),
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "Unsupported format",
+			args: args{s: "01.07.2016"},
+			want: time.Time{},
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "unsupported date format")
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseCertificateDate(tt.args.s)
+			tt.wantErr(t, err)
+			assert.True(t, tt.want.Equal(got))
+		})
+	}
+}
+
+func TestService_UpdateNotificationConfig(t *testing.T) {
+	var mail mailRecorder
+
+	svc := NewService(WithSMTP(SMTPConfig{Host: "smtp.example.com", Port: 587}))
+	svc.sendMail = mail.sendMail
+
+	svc.UpdateNotificationConfig(SMTPConfig{Host: "mail.example.com", Port: 25, From: "clouditor@example.com"}, 14)
+
+	err := svc.sendEmail([]string{"security@example.com"}, "Subject", "Body")
+	assert.NoError(t, err)
+	assert.Equal(t, "mail.example.com:25", mail.addr)
+	assert.Equal(t, []uint32{14}, svc.certificateReminderDays)
+}
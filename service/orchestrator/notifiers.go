@@ -0,0 +1,218 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package orchestrator
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"clouditor.io/clouditor/v2/api/orchestrator"
+)
+
+// SMTPConfig contains the configuration of the SMTP server that is used to send email notifications.
+type SMTPConfig struct {
+	Host     string
+	Port     uint16
+	Username string
+	Password string
+	From     string
+}
+
+var (
+	// subjectTemplate is the template of the subject of an email or the title of a chat message
+	subjectTemplate = template.Must(template.New("subject").Parse(
+		`[{{.Severity}}] {{.Title}}`))
+
+	// bodyTemplate is the template of the body of an email or the text of a chat message
+	bodyTemplate = template.Must(template.New("body").Parse(
+		`{{with .Text}}{{.}}
+
+{{end}}Cloud service: {{.CloudServiceId}}
+{{- with .CatalogId}}
+Catalog: {{.}}{{end}}
+{{- with .ControlId}}
+Control: {{.}}{{end}}
+{{- with .CertificateId}}
+Certificate: {{.}}{{end}}
+Time: {{.Time}}`))
+
+	// severityColors contains the theme colors of Teams messages for each severity
+	severityColors = map[orchestrator.NotificationSeverity]string{
+		orchestrator.NotificationSeverity_NOTIFICATION_SEVERITY_LOW:      "2EB886",
+		orchestrator.NotificationSeverity_NOTIFICATION_SEVERITY_MEDIUM:   "DAA038",
+		orchestrator.NotificationSeverity_NOTIFICATION_SEVERITY_HIGH:     "E8912D",
+		orchestrator.NotificationSeverity_NOTIFICATION_SEVERITY_CRITICAL: "A30200",
+	}
+)
+
+// notificationData is the data that is passed to the notification templates
+type notificationData struct {
+	Severity       string
+	Title          string
+	Text           string
+	CloudServiceId string
+	CatalogId      string
+	ControlId      string
+	CertificateId  string
+	Time           string
+}
+
+// slackMessage is the payload of a message to a Slack incoming webhook
+type slackMessage struct {
+	Text string `json:"text"`
+}
+
+// teamsMessage is the payload of a message card to a Microsoft Teams incoming webhook
+type teamsMessage struct {
+	Type       string `json:"@type"`
+	Context    string `json:"@context"`
+	Summary    string `json:"summary"`
+	ThemeColor string `json:"themeColor"`
+	Title      string `json:"title"`
+	Text       string `json:"text"`
+}
+
+// sendToChannel renders the notification and sends it to the channel according to its type.
+func (svc *Service) sendToChannel(channel *orchestrator.NotificationChannel, n *orchestrator.Notification) (err error) {
+	subject, body, err := renderNotification(n)
+	if err != nil {
+		return fmt.Errorf("could not render notification: %w", err)
+	}
+
+	switch channel.Type {
+	case orchestrator.NotificationChannelType_NOTIFICATION_CHANNEL_TYPE_SLACK:
+		return svc.postJSON(channel.Url, &slackMessage{
+			Text: fmt.Sprintf("*%s*\n%s", subject, body),
+		})
+	case orchestrator.NotificationChannelType_NOTIFICATION_CHANNEL_TYPE_TEAMS:
+		return svc.postJSON(channel.Url, &teamsMessage{
+			Type:       "MessageCard",
+			Context:    "https://schema.org/extensions",
+			Summary:    subject,
+			ThemeColor: severityColors[n.Severity],
+			Title:      subject,
+			// Teams uses Markdown, which needs two spaces before a line break
+			Text: strings.ReplaceAll(body, "\n", "  \n"),
+		})
+	case orchestrator.NotificationChannelType_NOTIFICATION_CHANNEL_TYPE_EMAIL:
+		return svc.sendEmail(channel.Recipients, subject, body)
+	default:
+		return fmt.Errorf("unsupported channel type %s", channel.Type)
+	}
+}
+
+// renderNotification renders the subject and the body of the notification using our templates.
+func renderNotification(n *orchestrator.Notification) (subject string, body string, err error) {
+	var (
+		buf  bytes.Buffer
+		data = notificationData{
+			Severity:       strings.TrimPrefix(n.Severity.String(), "NOTIFICATION_SEVERITY_"),
+			Title:          n.Title,
+			Text:           n.Text,
+			CloudServiceId: n.CloudServiceId,
+			CatalogId:      n.GetCatalogId(),
+			ControlId:      n.GetControlId(),
+			CertificateId:  n.GetCertificateId(),
+			Time:           n.GetTimestamp().AsTime().Format(time.RFC1123),
+		}
+	)
+
+	if err = subjectTemplate.Execute(&buf, data); err != nil {
+		return
+	}
+	subject = buf.String()
+
+	buf.Reset()
+	if err = bodyTemplate.Execute(&buf, data); err != nil {
+		return
+	}
+	body = buf.String()
+
+	return
+}
+
+// postJSON sends the message as JSON to the URL of an incoming webhook.
+func (svc *Service) postJSON(url string, msg any) (err error) {
+	var client = svc.webhookClient
+
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	payload, err := json.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("could not marshal message: %w", err)
+	}
+
+	res, err := client.Post(url, "application/json", bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status code %d", res.StatusCode)
+	}
+
+	return nil
+}
+
+// sendEmail sends a plain text email to the recipients using the configured SMTP server.
+func (svc *Service) sendEmail(to []string, subject string, body string) (err error) {
+	var (
+		auth smtp.Auth
+		msg  bytes.Buffer
+	)
+
+	svc.notificationMutex.RLock()
+	config := svc.smtp
+	svc.notificationMutex.RUnlock()
+
+	if config.Host == "" {
+		return fmt.Errorf("no SMTP server configured")
+	}
+
+	if config.Username != "" {
+		auth = smtp.PlainAuth("", config.Username, config.Password, config.Host)
+	}
+
+	fmt.Fprintf(&msg, "From: %s\r\n", config.From)
+	fmt.Fprintf(&msg, "To: %s\r\n", strings.Join(to, ", "))
+	fmt.Fprintf(&msg, "Subject: %s\r\n", subject)
+	fmt.Fprintf(&msg, "MIME-Version: 1.0\r\n")
+	fmt.Fprintf(&msg, "Content-Type: text/plain; charset=\"utf-8\"\r\n")
+	fmt.Fprintf(&msg, "\r\n%s\r\n", strings.ReplaceAll(body, "\n", "\r\n"))
+
+	return svc.sendMail(net.JoinHostPort(config.Host, strconv.Itoa(int(config.Port))), auth, config.From, to, msg.Bytes())
+}
@@ -28,11 +28,17 @@ package orchestrator
 import (
 	"context"
 	"embed"
+	"fmt"
+	"net/http"
+	"net/smtp"
 	"sync"
+	"time"
 
 	"clouditor.io/clouditor/v2/api/assessment"
 	"clouditor.io/clouditor/v2/api/orchestrator"
 	"clouditor.io/clouditor/v2/api/runtime"
+	"clouditor.io/clouditor/v2/internal/certification"
+	"clouditor.io/clouditor/v2/internal/pseudonym"
 	"clouditor.io/clouditor/v2/persistence"
 	"clouditor.io/clouditor/v2/persistence/inmemory"
 	"clouditor.io/clouditor/v2/service"
@@ -72,6 +78,9 @@ type Service struct {
 
 	storage persistence.Storage
 
+	// auditStorage is the storage of the audit log. It defaults to storage.
+	auditStorage persistence.Storage
+
 	metricsFile string
 
 	// loadMetricsFunc is a function that is used to initially load metrics at the start of the orchestrator
@@ -84,9 +93,79 @@ type Service struct {
 
 	events chan *orchestrator.MetricChangeEvent
 
+	// resultSubscribers contains the subscribers of assessment result events
+	resultSubscribers map[*resultSubscriber]struct{}
+
+	// subscriberMutex is used for (un)locking the subscribers of assessment result events
+	subscriberMutex sync.RWMutex
+
 	// authz defines our authorization strategy, e.g., which user can access which cloud service and associated
 	// resources, such as evidences and assessment results.
 	authz service.AuthorizationStrategy
+
+	// webhookClient is the HTTP client that is used to deliver events to webhooks, notifications to Slack and Teams as
+	// well as to open and resolve tickets in external ticket systems
+	webhookClient *http.Client
+
+	// webhookMaxRetries is the number of retries if the delivery to a webhook fails
+	webhookMaxRetries int
+
+	// webhookRetryInterval is the interval before the first retry of a failed delivery to a webhook. It is doubled
+	// with each further retry.
+	webhookRetryInterval time.Duration
+
+	// smtp is the configuration of the SMTP server that is used to send email notifications
+	smtp SMTPConfig
+
+	// sendMail is the function that is used to send emails. It defaults to [smtp.SendMail].
+	sendMail func(addr string, a smtp.Auth, from string, to []string, msg []byte) error
+
+	// certificateReminderDays are the number of days before the expiration of a certificate at which reminders are
+	// sent, unless the certificate specifies its own reminders
+	certificateReminderDays []uint32
+
+	// notificationMutex guards smtp and certificateReminderDays, which can be changed at runtime using
+	// [Service.UpdateNotificationConfig]
+	notificationMutex sync.RWMutex
+
+	// ticketMutex is used to serialize the handling of tickets, so that no duplicate tickets are opened
+	ticketMutex sync.Mutex
+
+	// statisticsMutex is used to serialize the incremental updates of the assessment statistics
+	statisticsMutex sync.Mutex
+
+	// resultBatchSize is the maximum number of assessment results received by StoreAssessmentResults that are stored
+	// together within a single transaction
+	resultBatchSize int
+
+	// resultBatchInterval is the maximum time assessment results received by StoreAssessmentResults are held back
+	// before they are stored
+	resultBatchInterval time.Duration
+
+	// waiverRole is the role a user needs to create or remove waivers. If empty, every user with access to the cloud
+	// service can.
+	waiverRole string
+
+	// auditorGrantURL is the URL of a UI, which is used to build shareable links for auditor grants. If empty, no link
+	// is returned.
+	auditorGrantURL string
+
+	// maxAuditorGrantDuration is the maximum time an auditor grant can be valid for
+	maxAuditorGrantDuration time.Duration
+
+	// staleResources configures when resources that are no longer discovered are decommissioned
+	staleResources StaleResourcePolicy
+
+	// leader decides whether this replica runs the periodic jobs, e.g., the purge of removed entities. If nil, they
+	// always run.
+	leader service.LeaderElector
+
+	// certification is the certification lifecycle engine, which derives state changes of certificates from the
+	// reported observations
+	certification *certification.Engine
+
+	// pseudonymizer pseudonymizes the exported assessment results and audit log on request. If nil, pseudonymized exports are not available.
+	pseudonymizer *pseudonym.Pseudonymizer
 }
 
 func init() {
@@ -131,6 +210,14 @@ func WithStorage(storage persistence.Storage) ServiceOption {
 	}
 }
 
+// WithAuditLogStorage is an option to store the audit log in a dedicated storage instead of the storage of the
+// orchestrator, e.g., to retain it independently.
+func WithAuditLogStorage(storage persistence.Storage) ServiceOption {
+	return func(s *Service) {
+		s.auditStorage = storage
+	}
+}
+
 // WithAuthorizationStrategyJWT is an option that configures an JWT-based authorization strategy using a specific claim key.
 func WithAuthorizationStrategyJWT(key string, allowAllKey string) ServiceOption {
 	return func(s *Service) {
@@ -144,6 +231,73 @@ func WithAuthorizationStrategy(authz service.AuthorizationStrategy) ServiceOptio
 	}
 }
 
+// WithWebhookRetries is an option to configure how often and in which interval the delivery to a webhook is retried.
+func WithWebhookRetries(maxRetries int, interval time.Duration) ServiceOption {
+	return func(s *Service) {
+		s.webhookMaxRetries = maxRetries
+		s.webhookRetryInterval = interval
+	}
+}
+
+// WithSMTP is an option to configure the SMTP server that is used to send email notifications.
+func WithSMTP(config SMTPConfig) ServiceOption {
+	return func(s *Service) {
+		s.smtp = config
+	}
+}
+
+// WithCertificateReminderDays is an option to configure the default number of days before the expiration of a
+// certificate at which reminders are sent.
+func WithCertificateReminderDays(days ...uint32) ServiceOption {
+	return func(s *Service) {
+		s.certificateReminderDays = days
+	}
+}
+
+// UpdateNotificationConfig changes the SMTP server and the default certificate reminder days at runtime, e.g., after
+// the configuration was reloaded.
+func (svc *Service) UpdateNotificationConfig(config SMTPConfig, days ...uint32) {
+	svc.notificationMutex.Lock()
+	defer svc.notificationMutex.Unlock()
+
+	svc.smtp = config
+	svc.certificateReminderDays = days
+}
+
+// WithWaiverRole is an option to configure the role a user needs to create or remove waivers, i.e., to accept risks.
+// The authorization strategy needs to implement [service.RoleChecker] for this to take effect.
+func WithWaiverRole(role string) ServiceOption {
+	return func(s *Service) {
+		s.waiverRole = role
+	}
+}
+
+// WithPseudonymizer is an option to configure the pseudonymizer that is used for pseudonymized exports of the
+// assessment results and the audit log.
+func WithPseudonymizer(p *pseudonym.Pseudonymizer) ServiceOption {
+	return func(s *Service) {
+		s.pseudonymizer = p
+	}
+}
+
+// WithLeaderElector is an option to only run the periodic jobs, i.e., the certificate expiry check and the purge of
+// removed entities, on the replica that is elected as leader.
+func WithLeaderElector(e service.LeaderElector) ServiceOption {
+	return func(s *Service) {
+		s.leader = e
+	}
+}
+
+// WithAssessmentResultBatching is an option to configure how assessment results received by StoreAssessmentResults
+// are batched. A batch is stored once it contains size results or the interval elapsed. A size of 1 disables the
+// batching.
+func WithAssessmentResultBatching(size int, interval time.Duration) ServiceOption {
+	return func(s *Service) {
+		s.resultBatchSize = size
+		s.resultBatchInterval = interval
+	}
+}
+
 // NewService creates a new Orchestrator service
 func NewService(opts ...ServiceOption) *Service {
 	var err error
@@ -151,6 +305,17 @@ func NewService(opts ...ServiceOption) *Service {
 		metricsFile:    DefaultMetricsFile,
 		catalogsFolder: DefaultCatalogsFolder,
 		events:         make(chan *orchestrator.MetricChangeEvent, 1000),
+		webhookClient: &http.Client{
+			Timeout: DefaultWebhookTimeout,
+		},
+		webhookMaxRetries:       DefaultWebhookMaxRetries,
+		webhookRetryInterval:    DefaultWebhookRetryInterval,
+		sendMail:                smtp.SendMail,
+		certificateReminderDays: DefaultCertificateReminderDays,
+		resultBatchSize:         DefaultResultBatchSize,
+		resultBatchInterval:     DefaultResultBatchInterval,
+		maxAuditorGrantDuration: DefaultMaxAuditorGrantDuration,
+		certification:           certification.NewEngine(certification.DefaultPolicy()),
 	}
 
 	// Apply service options
@@ -166,11 +331,19 @@ func NewService(opts ...ServiceOption) *Service {
 		}
 	}
 
+	// Default to storing the audit log in our storage
+	if s.auditStorage == nil {
+		s.auditStorage = s.storage
+	}
+
 	// Default to an allow-all authorization strategy
 	if s.authz == nil {
 		s.authz = &service.AuthorizationStrategyAllowAll{}
 	}
 
+	// Additionally grant access to the cloud services of the organizations and tags of a user
+	s.authz = &scopedAuthorizationStrategy{AuthorizationStrategy: s.authz, storage: s.storage}
+
 	if err = s.loadMetrics(); err != nil {
 		log.Errorf("Could not load embedded metrics. Will continue with empty metric list: %v", err)
 	}
@@ -207,3 +380,12 @@ func (s *Service) RegisterCloudServiceHook(hook orchestrator.CloudServiceHookFun
 func (*Service) GetRuntimeInfo(_ context.Context, _ *runtime.GetRuntimeInfoRequest) (res *runtime.Runtime, err error) {
 	return service.GetRuntimeInfo()
 }
+
+// CheckHealth implements [service.HealthChecker]. The orchestrator only depends on its storage.
+func (svc *Service) CheckHealth(ctx context.Context) error {
+	if err := svc.storage.Ping(ctx); err != nil {
+		return fmt.Errorf("storage is not reachable: %w", err)
+	}
+
+	return nil
+}
@@ -28,6 +28,7 @@ package orchestrator
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"os"
 	"reflect"
 	"runtime"
@@ -36,8 +37,10 @@ import (
 
 	"clouditor.io/clouditor/v2/api/orchestrator"
 	apiruntime "clouditor.io/clouditor/v2/api/runtime"
+	"clouditor.io/clouditor/v2/internal/testutil"
 	"clouditor.io/clouditor/v2/internal/testutil/assert"
 	"clouditor.io/clouditor/v2/internal/testutil/clitest"
+	"clouditor.io/clouditor/v2/persistence"
 	"clouditor.io/clouditor/v2/persistence/inmemory"
 	"clouditor.io/clouditor/v2/service"
 
@@ -114,7 +117,9 @@ func TestNewService(t *testing.T) {
 				opts: []ServiceOption{WithAuthorizationStrategy(&service.AuthorizationStrategyAllowAll{})},
 			},
 			want: func(t *testing.T, got *Service) bool {
-				return assert.Equal[service.AuthorizationStrategy](t, &service.AuthorizationStrategyAllowAll{}, got.authz)
+				// The authorization strategy is wrapped, so that the organizations of a user are taken into account
+				authz := assert.Is[*scopedAuthorizationStrategy](t, got.authz)
+				return assert.Equal[service.AuthorizationStrategy](t, &service.AuthorizationStrategyAllowAll{}, authz.AuthorizationStrategy)
 			},
 		},
 	}
@@ -243,3 +248,40 @@ func TestService_GetRuntimeInfo(t *testing.T) {
 		})
 	}
 }
+
+func TestService_CheckHealth(t *testing.T) {
+	type fields struct {
+		storage persistence.Storage
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		wantErr assert.WantErr
+	}{
+		{
+			name: "storage reachable",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t),
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "storage not reachable",
+			fields: fields{
+				storage: &testutil.StorageWithError{PingErr: errors.New("connection refused")},
+			},
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "storage is not reachable: connection refused")
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				storage: tt.fields.storage,
+			}
+			err := svc.CheckHealth(context.Background())
+			tt.wantErr(t, err)
+		})
+	}
+}
@@ -0,0 +1,266 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"clouditor.io/clouditor/v2/api"
+	"clouditor.io/clouditor/v2/api/assessment"
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/internal/logging"
+	"clouditor.io/clouditor/v2/persistence"
+	"clouditor.io/clouditor/v2/service"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// userOrganizations retrieves the IDs of the organizations the current user is a member of. This requires authz to
+// implement [service.UserIdentifier].
+func userOrganizations(ctx context.Context, authz service.AuthorizationStrategy, storage persistence.Storage) (ids []string, err error) {
+	var orgs []*orchestrator.Organization
+
+	identifier, ok := authz.(service.UserIdentifier)
+	if !ok {
+		return nil, nil
+	}
+
+	user, ok := identifier.CurrentUser(ctx)
+	if !ok {
+		return nil, nil
+	}
+
+	// The users are serialized as JSON, so we need to filter them ourselves. The number of organizations is usually
+	// small, so this is fine.
+	err = storage.List(&orgs, "", true, 0, -1)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, org := range orgs {
+		if slices.Contains(org.Users, user) {
+			ids = append(ids, org.Id)
+		}
+	}
+
+	return ids, nil
+}
+
+// allowedOrganizations retrieves the IDs of the organizations the current user is a member of. If the user can access
+// all cloud services, all is true and the user can access all organizations as well.
+func (svc *Service) allowedOrganizations(ctx context.Context) (all bool, ids []string, err error) {
+	all, _ = svc.authz.AllowedCloudServices(ctx)
+	if all {
+		return true, nil, nil
+	}
+
+	ids, err = userOrganizations(ctx, svc.authz, svc.storage)
+	if err != nil {
+		return false, nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
+	return false, ids, nil
+}
+
+// checkOrganizationAccess checks whether the organization exists and whether the current user can access it.
+func (svc *Service) checkOrganizationAccess(ctx context.Context, organizationID string) (err error) {
+	all, ids, err := svc.allowedOrganizations(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !all && !slices.Contains(ids, organizationID) {
+		return service.ErrPermissionDenied
+	}
+
+	count, err := svc.storage.Count(&orchestrator.Organization{}, "id = ?", organizationID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "database error: %v", err)
+	} else if count == 0 {
+		return api.ErrorCodeOrganizationNotFound.Err()
+	}
+
+	return nil
+}
+
+// checkDefaultMetricConfigurations checks, whether the target values of the default metric configurations of the
+// organization fit their operators.
+func checkDefaultMetricConfigurations(org *orchestrator.Organization) error {
+	for metricID, config := range org.DefaultMetricConfigurations {
+		if err := assessment.CheckTargetValue(config.GetOperator(), config.GetTargetValue()); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid configuration of metric %s: %v", metricID, err)
+		}
+	}
+
+	return nil
+}
+
+// CreateOrganization implements method for creating a new organization. Only users that can access all cloud services
+// are allowed to create organizations.
+func (svc *Service) CreateOrganization(ctx context.Context, req *orchestrator.CreateOrganizationRequest) (res *orchestrator.Organization, err error) {
+	if all, _ := svc.authz.AllowedCloudServices(ctx); !all {
+		return nil, service.ErrPermissionDenied
+	}
+
+	err = checkDefaultMetricConfigurations(req.Organization)
+	if err != nil {
+		return nil, err
+	}
+
+	res = req.Organization
+
+	// Generate a new ID
+	res.Id = uuid.NewString()
+
+	now := timestamppb.Now()
+
+	res.CreatedAt = now
+	res.UpdatedAt = now
+
+	err = svc.storage.Create(res)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
+	logging.LogRequest(log, logrus.DebugLevel, logging.Create, req)
+
+	return res, nil
+}
+
+// GetOrganization implements method for retrieving an organization
+func (svc *Service) GetOrganization(ctx context.Context, req *orchestrator.GetOrganizationRequest) (res *orchestrator.Organization, err error) {
+	all, ids, err := svc.allowedOrganizations(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !all && !slices.Contains(ids, req.OrganizationId) {
+		return nil, service.ErrPermissionDenied
+	}
+
+	res = new(orchestrator.Organization)
+	err = svc.storage.Get(res, "id = ?", req.OrganizationId)
+	if errors.Is(err, persistence.ErrRecordNotFound) {
+		return nil, api.ErrorCodeOrganizationNotFound.Err()
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
+	return res, nil
+}
+
+// ListOrganizations implements method for listing all organizations the current user is a member of
+func (svc *Service) ListOrganizations(ctx context.Context, req *orchestrator.ListOrganizationsRequest) (res *orchestrator.ListOrganizationsResponse, err error) {
+	var conds []any
+
+	all, ids, err := svc.allowedOrganizations(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !all {
+		conds = append(conds, "id IN ?", ids)
+	}
+
+	res = new(orchestrator.ListOrganizationsResponse)
+
+	res.Organizations, res.NextPageToken, err = service.PaginateStorage[*orchestrator.Organization](req, svc.storage,
+		service.DefaultPaginationOpts, conds...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not paginate results: %v", err)
+	}
+
+	return
+}
+
+// UpdateOrganization implements method for updating an existing organization. Only users that can access all cloud
+// services are allowed to update organizations, since this includes the users of an organization.
+func (svc *Service) UpdateOrganization(ctx context.Context, req *orchestrator.UpdateOrganizationRequest) (res *orchestrator.Organization, err error) {
+	if all, _ := svc.authz.AllowedCloudServices(ctx); !all {
+		return nil, service.ErrPermissionDenied
+	}
+
+	count, err := svc.storage.Count(req.Organization, "id = ?", req.Organization.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
+	if count == 0 {
+		return nil, api.ErrorCodeOrganizationNotFound.Err()
+	}
+
+	err = checkDefaultMetricConfigurations(req.Organization)
+	if err != nil {
+		return nil, err
+	}
+
+	res = req.Organization
+	res.UpdatedAt = timestamppb.Now()
+
+	err = svc.storage.Save(res, "id = ?", res.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
+	logging.LogRequest(log, logrus.DebugLevel, logging.Update, req)
+
+	return res, nil
+}
+
+// RemoveOrganization implements method for removing an organization. Only users that can access all cloud services
+// are allowed to remove organizations and the organization must not contain any cloud services.
+func (svc *Service) RemoveOrganization(ctx context.Context, req *orchestrator.RemoveOrganizationRequest) (res *emptypb.Empty, err error) {
+	if all, _ := svc.authz.AllowedCloudServices(ctx); !all {
+		return nil, service.ErrPermissionDenied
+	}
+
+	count, err := svc.storage.Count(&orchestrator.CloudService{}, "organization_id = ?", req.OrganizationId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
+	if count > 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "organization still contains %d cloud service(s)", count)
+	}
+
+	err = svc.storage.Delete(&orchestrator.Organization{}, "id = ?", req.OrganizationId)
+	if errors.Is(err, persistence.ErrRecordNotFound) {
+		return nil, api.ErrorCodeOrganizationNotFound.Err()
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
+	logging.LogRequest(log, logrus.DebugLevel, logging.Remove, req)
+
+	return &emptypb.Empty{}, nil
+}
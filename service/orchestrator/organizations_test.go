@@ -0,0 +1,472 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package orchestrator
+
+import (
+	"context"
+	"testing"
+
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/testutil/servicetest"
+	"clouditor.io/clouditor/v2/internal/util"
+	"clouditor.io/clouditor/v2/persistence"
+	"clouditor.io/clouditor/v2/service"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+var mockOrganization = &orchestrator.Organization{
+	Id:    testdata.MockOrganizationID,
+	Name:  testdata.MockOrganizationName,
+	Users: []string{testdata.MockOrganizationUser},
+}
+
+// newOrganizationStorage creates a new storage that contains an organization with cloud service 1 and a (tagged) cloud
+// service 2 that does not belong to any organization.
+func newOrganizationStorage(t *testing.T) persistence.Storage {
+	return testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+		assert.NoError(t, s.Create(mockOrganization))
+		assert.NoError(t, s.Create(&orchestrator.CloudService{
+			Id:             testdata.MockCloudServiceID1,
+			Name:           testdata.MockCloudServiceName1,
+			OrganizationId: util.Ref(testdata.MockOrganizationID),
+		}))
+		assert.NoError(t, s.Create(&orchestrator.CloudService{
+			Id:   testdata.MockCloudServiceID2,
+			Name: testdata.MockCloudServiceName2,
+			Tags: []string{"team:payments"},
+		}))
+	})
+}
+
+func TestService_CreateOrganization(t *testing.T) {
+	type fields struct {
+		authz service.AuthorizationStrategy
+	}
+	type args struct {
+		req *orchestrator.CreateOrganizationRequest
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    assert.Want[*orchestrator.Organization]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "validation error",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &orchestrator.CreateOrganizationRequest{Organization: &orchestrator.Organization{}},
+			},
+			want: assert.Nil[*orchestrator.Organization],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "organization.name: value length must be at least 1 characters")
+			},
+		},
+		{
+			name: "permission denied",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategyWithUser(false, testdata.MockOrganizationUser),
+			},
+			args: args{
+				req: &orchestrator.CreateOrganizationRequest{Organization: &orchestrator.Organization{
+					Name: testdata.MockOrganizationName,
+				}},
+			},
+			want: assert.Nil[*orchestrator.Organization],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, service.ErrPermissionDenied)
+			},
+		},
+		{
+			name: "happy path",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &orchestrator.CreateOrganizationRequest{Organization: &orchestrator.Organization{
+					Name:  testdata.MockOrganizationName,
+					Users: []string{testdata.MockOrganizationUser},
+				}},
+			},
+			want: func(t *testing.T, got *orchestrator.Organization) bool {
+				return assert.NotEmpty(t, got.Id) &&
+					assert.Equal(t, testdata.MockOrganizationName, got.Name) &&
+					assert.Equal(t, []string{testdata.MockOrganizationUser}, got.Users) &&
+					assert.NotNil(t, got.CreatedAt)
+			},
+			wantErr: assert.Nil[error],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				storage: testutil.NewInMemoryStorage(t),
+				authz:   tt.fields.authz,
+			}
+			got, err := servicetest.Validated(svc.CreateOrganization)(context.Background(), tt.args.req)
+
+			tt.wantErr(t, err)
+			tt.want(t, got)
+		})
+	}
+}
+
+func TestService_GetOrganization(t *testing.T) {
+	type fields struct {
+		authz service.AuthorizationStrategy
+	}
+	type args struct {
+		req *orchestrator.GetOrganizationRequest
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    assert.Want[*orchestrator.Organization]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "validation error",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &orchestrator.GetOrganizationRequest{},
+			},
+			want: assert.Nil[*orchestrator.Organization],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "organization_id: value is empty, which is not a valid UUID")
+			},
+		},
+		{
+			name: "permission denied",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategyWithUser(false, "someone-else"),
+			},
+			args: args{
+				req: &orchestrator.GetOrganizationRequest{OrganizationId: testdata.MockOrganizationID},
+			},
+			want: assert.Nil[*orchestrator.Organization],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, service.ErrPermissionDenied)
+			},
+		},
+		{
+			name: "not found",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &orchestrator.GetOrganizationRequest{OrganizationId: testdata.MockCloudServiceID1},
+			},
+			want: assert.Nil[*orchestrator.Organization],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.Equal(t, codes.NotFound, status.Code(err))
+			},
+		},
+		{
+			name: "happy path: member",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategyWithUser(false, testdata.MockOrganizationUser),
+			},
+			args: args{
+				req: &orchestrator.GetOrganizationRequest{OrganizationId: testdata.MockOrganizationID},
+			},
+			want: func(t *testing.T, got *orchestrator.Organization) bool {
+				return assert.Equal(t, mockOrganization, got)
+			},
+			wantErr: assert.Nil[error],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				storage: newOrganizationStorage(t),
+				authz:   tt.fields.authz,
+			}
+			got, err := servicetest.Validated(svc.GetOrganization)(context.Background(), tt.args.req)
+
+			tt.wantErr(t, err)
+			tt.want(t, got)
+		})
+	}
+}
+
+func TestService_ListOrganizations(t *testing.T) {
+	type fields struct {
+		authz service.AuthorizationStrategy
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		want    assert.Want[*orchestrator.ListOrganizationsResponse]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "allow all",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategy(true),
+			},
+			want: func(t *testing.T, got *orchestrator.ListOrganizationsResponse) bool {
+				return assert.Equal(t, 2, len(got.Organizations))
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "only organizations of user",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategyWithUser(false, testdata.MockOrganizationUser),
+			},
+			want: func(t *testing.T, got *orchestrator.ListOrganizationsResponse) bool {
+				return assert.Equal(t, []*orchestrator.Organization{mockOrganization}, got.Organizations)
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "no organizations without user",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategy(false),
+			},
+			want: func(t *testing.T, got *orchestrator.ListOrganizationsResponse) bool {
+				return assert.Empty(t, got.Organizations)
+			},
+			wantErr: assert.Nil[error],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				storage: newOrganizationStorage(t),
+				authz:   tt.fields.authz,
+			}
+			assert.NoError(t, svc.storage.Create(&orchestrator.Organization{
+				Id:   testdata.MockCloudServiceID2,
+				Name: "Another Organization",
+			}))
+
+			got, err := svc.ListOrganizations(context.Background(), &orchestrator.ListOrganizationsRequest{})
+
+			tt.wantErr(t, err)
+			tt.want(t, got)
+		})
+	}
+}
+
+func TestService_UpdateOrganization(t *testing.T) {
+	type fields struct {
+		authz service.AuthorizationStrategy
+	}
+	type args struct {
+		req *orchestrator.UpdateOrganizationRequest
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    assert.Want[*orchestrator.Organization]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "permission denied for members",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategyWithUser(false, testdata.MockOrganizationUser),
+			},
+			args: args{
+				req: &orchestrator.UpdateOrganizationRequest{Organization: &orchestrator.Organization{
+					Id:   testdata.MockOrganizationID,
+					Name: "Updated",
+				}},
+			},
+			want: assert.Nil[*orchestrator.Organization],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, service.ErrPermissionDenied)
+			},
+		},
+		{
+			name: "not found",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &orchestrator.UpdateOrganizationRequest{Organization: &orchestrator.Organization{
+					Id:   testdata.MockCloudServiceID1,
+					Name: "Updated",
+				}},
+			},
+			want: assert.Nil[*orchestrator.Organization],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.Equal(t, codes.NotFound, status.Code(err))
+			},
+		},
+		{
+			name: "happy path",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &orchestrator.UpdateOrganizationRequest{Organization: &orchestrator.Organization{
+					Id:    testdata.MockOrganizationID,
+					Name:  "Updated",
+					Users: []string{testdata.MockOrganizationUser, "another-user"},
+				}},
+			},
+			want: func(t *testing.T, got *orchestrator.Organization) bool {
+				return assert.Equal(t, "Updated", got.Name) &&
+					assert.Equal(t, []string{testdata.MockOrganizationUser, "another-user"}, got.Users) &&
+					assert.NotNil(t, got.UpdatedAt)
+			},
+			wantErr: assert.Nil[error],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				storage: newOrganizationStorage(t),
+				authz:   tt.fields.authz,
+			}
+			got, err := svc.UpdateOrganization(context.Background(), tt.args.req)
+
+			tt.wantErr(t, err)
+			tt.want(t, got)
+		})
+	}
+}
+
+func TestService_RemoveOrganization(t *testing.T) {
+	type fields struct {
+		storage persistence.Storage
+		authz   service.AuthorizationStrategy
+	}
+	type args struct {
+		req *orchestrator.RemoveOrganizationRequest
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    assert.Want[*emptypb.Empty]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "permission denied",
+			fields: fields{
+				storage: newOrganizationStorage(t),
+				authz:   servicetest.NewAuthorizationStrategyWithUser(false, testdata.MockOrganizationUser),
+			},
+			args: args{
+				req: &orchestrator.RemoveOrganizationRequest{OrganizationId: testdata.MockOrganizationID},
+			},
+			want: assert.Nil[*emptypb.Empty],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, service.ErrPermissionDenied)
+			},
+		},
+		{
+			name: "organization still contains cloud services",
+			fields: fields{
+				storage: newOrganizationStorage(t),
+				authz:   servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &orchestrator.RemoveOrganizationRequest{OrganizationId: testdata.MockOrganizationID},
+			},
+			want: assert.Nil[*emptypb.Empty],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+			},
+		},
+		{
+			name: "not found",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t),
+				authz:   servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &orchestrator.RemoveOrganizationRequest{OrganizationId: testdata.MockOrganizationID},
+			},
+			want: assert.Nil[*emptypb.Empty],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.Equal(t, codes.NotFound, status.Code(err))
+			},
+		},
+		{
+			name: "happy path",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					assert.NoError(t, s.Create(mockOrganization))
+				}),
+				authz: servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &orchestrator.RemoveOrganizationRequest{OrganizationId: testdata.MockOrganizationID},
+			},
+			want:    assert.NotNil[*emptypb.Empty],
+			wantErr: assert.Nil[error],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				storage: tt.fields.storage,
+				authz:   tt.fields.authz,
+			}
+			got, err := svc.RemoveOrganization(context.Background(), tt.args.req)
+
+			tt.wantErr(t, err)
+			tt.want(t, got)
+		})
+	}
+}
+
+func TestService_ListCatalogs_organizations(t *testing.T) {
+	svc := &Service{
+		storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+			assert.NoError(t, s.Create(mockOrganization))
+			assert.NoError(t, s.Create(&orchestrator.Catalog{Id: "global", Name: "Global"}))
+			assert.NoError(t, s.Create(&orchestrator.Catalog{Id: "own", Name: "Own", OrganizationId: util.Ref(testdata.MockOrganizationID)}))
+			assert.NoError(t, s.Create(&orchestrator.Catalog{Id: "other", Name: "Other", OrganizationId: util.Ref(testdata.MockCloudServiceID2)}))
+		}),
+		authz: servicetest.NewAuthorizationStrategyWithUser(false, testdata.MockOrganizationUser),
+	}
+
+	res, err := svc.ListCatalogs(context.Background(), &orchestrator.ListCatalogsRequest{OrderBy: "id", Asc: true})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(res.Catalogs))
+	assert.Equal(t, "global", res.Catalogs[0].Id)
+	assert.Equal(t, "own", res.Catalogs[1].Id)
+
+	_, err = svc.GetCatalog(context.Background(), &orchestrator.GetCatalogRequest{CatalogId: "other"})
+	assert.ErrorIs(t, err, service.ErrPermissionDenied)
+}
@@ -0,0 +1,550 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package orchestrator
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"clouditor.io/clouditor/v2/api"
+	"clouditor.io/clouditor/v2/api/assessment"
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/internal/oscal"
+	"clouditor.io/clouditor/v2/internal/pseudonym"
+	"clouditor.io/clouditor/v2/internal/util"
+	"clouditor.io/clouditor/v2/persistence"
+	"clouditor.io/clouditor/v2/service"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// defaultOscalCategory is the name of the category that contains all controls of an OSCAL catalog that are not part
+// of a group.
+const defaultOscalCategory = "Controls"
+
+// ImportOscalCatalog imports an OSCAL catalog or profile as a new catalog. A profile is resolved against the catalog
+// specified in the base catalog ID.
+func (svc *Service) ImportOscalCatalog(_ context.Context, req *orchestrator.ImportOscalCatalogRequest) (res *orchestrator.Catalog, err error) {
+	var doc oscal.Document
+
+	err = json.Unmarshal(req.Data, &doc)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid OSCAL document: %v", err)
+	}
+
+	switch {
+	case doc.Catalog != nil:
+		res = catalogFromOscal(doc.Catalog, util.Deref(req.CatalogId))
+	case doc.Profile != nil:
+		var (
+			base     orchestrator.Catalog
+			controls []*orchestrator.Control
+		)
+
+		if req.BaseCatalogId == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "base catalog ID is required to import an OSCAL profile")
+		}
+
+		err = svc.storage.Get(&base, "Id = ?", req.GetBaseCatalogId())
+		if errors.Is(err, persistence.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "base catalog not found")
+		} else if err != nil {
+			return nil, status.Errorf(codes.Internal, "database error: %v", err)
+		}
+
+		err = svc.storage.List(&controls, "", true, 0, -1, "category_catalog_id = ?", base.Id)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "database error: %v", err)
+		}
+
+		res = catalogFromOscalProfile(doc.Profile, util.Deref(req.CatalogId), &base, controls)
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "OSCAL document neither contains a catalog nor a profile")
+	}
+
+	// Make sure that all referenced metrics exist
+	err = svc.resolveCatalogMetrics(res)
+	if err != nil {
+		return nil, err
+	}
+
+	err = api.Validate(&orchestrator.CreateCatalogRequest{Catalog: res})
+	if err != nil {
+		return nil, err
+	}
+
+	prepareCatalogs([]*orchestrator.Catalog{res})
+
+	err = svc.storage.Create(res)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
+	log.Infof("Imported OSCAL document as catalog %s", res.Id)
+
+	return res, nil
+}
+
+// ExportOscalAssessmentResults exports the latest assessment results of a cloud service as OSCAL assessment-results.
+// Each control of the specified catalog, for which we have assessment results, is reported as a finding. If requested,
+// the IDs of the assessed resources are pseudonymized.
+func (svc *Service) ExportOscalAssessmentResults(ctx context.Context, req *orchestrator.ExportOscalAssessmentResultsRequest) (res *orchestrator.ExportOscalAssessmentResultsResponse, err error) {
+	var (
+		catalog  orchestrator.Catalog
+		controls []*orchestrator.Control
+		results  *orchestrator.ListAssessmentResultsResponse
+		doc      *oscal.Document
+	)
+
+	// Check, if this request has access to the cloud service according to our authorization strategy.
+	if !svc.authz.CheckAccess(ctx, service.AccessRead, req) {
+		return nil, service.ErrPermissionDenied
+	}
+
+	if req.Pseudonymize && svc.pseudonymizer == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "%v", pseudonym.ErrNotConfigured)
+	}
+
+	err = svc.storage.Get(&catalog, "Id = ?", req.CatalogId)
+	if errors.Is(err, persistence.ErrRecordNotFound) {
+		return nil, api.ErrorCodeCatalogNotFound.Err()
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
+	err = svc.storage.List(&controls, "", true, 0, -1, "category_catalog_id = ?", req.CatalogId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
+	results, err = svc.ListAssessmentResults(ctx, &orchestrator.ListAssessmentResultsRequest{
+		Filter: &orchestrator.Filter{
+			CloudServiceId: &req.CloudServiceId,
+		},
+		LatestByResourceId: util.Ref(true),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Pseudonymize {
+		for _, r := range results.Results {
+			r.ResourceId = svc.pseudonymizer.ResourceID(r.ResourceId)
+		}
+	}
+
+	doc = assessmentResultsToOscal(req.CloudServiceId, &catalog, controls, results.Results, time.Now())
+
+	res = new(orchestrator.ExportOscalAssessmentResultsResponse)
+	res.Data, err = json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not marshal OSCAL document: %v", err)
+	}
+
+	return res, nil
+}
+
+// catalogFromOscal converts an OSCAL catalog into a catalog. Groups are converted into categories, nested groups are
+// flattened. Since our controls only have one level of sub-controls, control enhancements are flattened as well.
+func catalogFromOscal(oc *oscal.Catalog, id string) (catalog *orchestrator.Catalog) {
+	if id == "" {
+		id = oc.UUID
+	}
+
+	catalog = &orchestrator.Catalog{
+		Id:          id,
+		Name:        oc.Metadata.Title,
+		Description: oc.Metadata.Remarks,
+		Version:     oc.Metadata.Version,
+	}
+
+	if len(oc.Controls) > 0 {
+		catalog.Categories = append(catalog.Categories, categoryFromOscal(oscal.Group{
+			Title:    defaultOscalCategory,
+			Controls: oc.Controls,
+		}, id))
+	}
+
+	for _, g := range flattenGroups(oc.Groups) {
+		catalog.Categories = append(catalog.Categories, categoryFromOscal(g, id))
+	}
+
+	// Gather the assurance levels used by the controls
+	for _, category := range catalog.Categories {
+		for _, control := range category.Controls {
+			for _, c := range append([]*orchestrator.Control{control}, control.Controls...) {
+				if c.AssuranceLevel != nil && !slices.Contains(catalog.AssuranceLevels, *c.AssuranceLevel) {
+					catalog.AssuranceLevels = append(catalog.AssuranceLevels, *c.AssuranceLevel)
+				}
+			}
+		}
+	}
+
+	return
+}
+
+// flattenGroups returns all groups (including nested groups) in a flat list. Nested groups are removed from their
+// parent.
+func flattenGroups(groups []oscal.Group) (flat []oscal.Group) {
+	for _, g := range groups {
+		nested := g.Groups
+		g.Groups = nil
+
+		flat = append(flat, g)
+		flat = append(flat, flattenGroups(nested)...)
+	}
+
+	return
+}
+
+// categoryFromOscal converts an OSCAL group into a category.
+func categoryFromOscal(g oscal.Group, catalogID string) (category *orchestrator.Category) {
+	category = &orchestrator.Category{
+		Name:        g.Title,
+		CatalogId:   catalogID,
+		Description: prose(g.Parts, ""),
+	}
+
+	for _, c := range g.Controls {
+		control := controlFromOscal(c, category)
+
+		for _, sub := range flattenControls(c.Controls) {
+			control.Controls = append(control.Controls, controlFromOscal(sub, category))
+		}
+
+		category.Controls = append(category.Controls, control)
+	}
+
+	return
+}
+
+// flattenControls returns all controls (including nested controls) in a flat list.
+func flattenControls(controls []oscal.Control) (flat []oscal.Control) {
+	for _, c := range controls {
+		flat = append(flat, c)
+		flat = append(flat, flattenControls(c.Controls)...)
+	}
+
+	return
+}
+
+// controlFromOscal converts an OSCAL control into a control without its sub-controls. The mapping to metrics and the
+// assurance level are taken from the Clouditor-specific properties of the control.
+func controlFromOscal(c oscal.Control, category *orchestrator.Category) (control *orchestrator.Control) {
+	control = &orchestrator.Control{
+		Id:                c.ID,
+		CategoryName:      category.Name,
+		CategoryCatalogId: category.CatalogId,
+		Name:              c.Title,
+		Description:       prose(c.Parts, "statement"),
+	}
+
+	for _, p := range c.Props {
+		if p.Ns != oscal.ClouditorNamespace {
+			continue
+		}
+
+		switch p.Name {
+		case oscal.PropertyMetric:
+			control.Metrics = append(control.Metrics, &assessment.Metric{Id: p.Value})
+		case oscal.PropertyAssuranceLevel:
+			control.AssuranceLevel = util.Ref(p.Value)
+		}
+	}
+
+	return
+}
+
+// prose returns the prose of all parts with the given name (including their nested parts). If name is empty, the
+// prose of all parts is returned.
+func prose(parts []oscal.Part, name string) string {
+	var texts []string
+
+	for _, p := range parts {
+		if name != "" && p.Name != name {
+			continue
+		}
+
+		if p.Prose != "" {
+			texts = append(texts, p.Prose)
+		}
+
+		if s := prose(p.Parts, ""); s != "" {
+			texts = append(texts, s)
+		}
+	}
+
+	return strings.Join(texts, "\n")
+}
+
+// catalogFromOscalProfile resolves an OSCAL profile against the base catalog and its (flat list of) controls. The
+// resulting catalog contains the categories of the base catalog, but only the controls selected by the profile.
+func catalogFromOscalProfile(p *oscal.Profile, id string, base *orchestrator.Catalog, controls []*orchestrator.Control) (catalog *orchestrator.Catalog) {
+	if id == "" {
+		id = p.UUID
+	}
+
+	catalog = &orchestrator.Catalog{
+		Id:              id,
+		Name:            p.Metadata.Title,
+		Description:     p.Metadata.Remarks,
+		Version:         p.Metadata.Version,
+		AllInScope:      base.AllInScope,
+		AssuranceLevels: base.AssuranceLevels,
+		ShortName:       base.ShortName,
+	}
+
+	for _, category := range base.Categories {
+		var selected []*orchestrator.Control
+
+		for _, control := range controls {
+			if control.ParentControlId != nil || control.CategoryName != category.Name {
+				continue
+			}
+
+			var subs []*orchestrator.Control
+			for _, sub := range controls {
+				if sub.GetParentControlId() == control.Id && sub.GetParentControlCategoryName() == category.Name &&
+					profileSelects(p, sub.Id, control.Id) {
+					subs = append(subs, copyControl(sub, id))
+				}
+			}
+
+			// We also need to include the parent control, if any of its sub-controls are selected
+			if profileSelects(p, control.Id, "") || len(subs) > 0 {
+				c := copyControl(control, id)
+				c.Controls = subs
+				selected = append(selected, c)
+			}
+		}
+
+		if len(selected) > 0 {
+			catalog.Categories = append(catalog.Categories, &orchestrator.Category{
+				Name:        category.Name,
+				CatalogId:   id,
+				Description: category.Description,
+				Controls:    selected,
+			})
+		}
+	}
+
+	return
+}
+
+// profileSelects checks, whether the profile selects the control with the given ID. If the control is a sub-control,
+// the ID of its parent needs to be specified as well, since it can be selected as a child control of its parent.
+func profileSelects(p *oscal.Profile, id string, parentID string) bool {
+	var included bool
+
+	for _, imp := range p.Imports {
+		if imp.IncludeAll != nil || selectsControl(imp.IncludeControls, id, parentID) {
+			included = true
+		}
+
+		if selectsControl(imp.ExcludeControls, id, parentID) {
+			return false
+		}
+	}
+
+	return included
+}
+
+// selectsControl checks, whether any of the selections selects the control with the given ID (or its parent with
+// all its child controls).
+func selectsControl(selections []oscal.SelectControlByID, id string, parentID string) bool {
+	for _, sel := range selections {
+		if slices.Contains(sel.WithIDs, id) ||
+			(parentID != "" && sel.WithChildControls == "yes" && slices.Contains(sel.WithIDs, parentID)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// copyControl copies a control (without its sub-controls) into the catalog with the given ID.
+func copyControl(control *orchestrator.Control, catalogID string) *orchestrator.Control {
+	c := &orchestrator.Control{
+		Id:                control.Id,
+		CategoryName:      control.CategoryName,
+		CategoryCatalogId: catalogID,
+		Name:              control.Name,
+		Description:       control.Description,
+		AssuranceLevel:    control.AssuranceLevel,
+	}
+
+	for _, m := range control.Metrics {
+		c.Metrics = append(c.Metrics, &assessment.Metric{Id: m.Id})
+	}
+
+	return c
+}
+
+// resolveCatalogMetrics replaces the metrics referenced by the controls of the catalog with the stored metrics. An
+// error is returned, if a metric does not exist.
+func (svc *Service) resolveCatalogMetrics(catalog *orchestrator.Catalog) (err error) {
+	for _, category := range catalog.Categories {
+		for _, control := range category.Controls {
+			for _, c := range append([]*orchestrator.Control{control}, control.Controls...) {
+				for i, m := range c.Metrics {
+					var metric assessment.Metric
+
+					err = svc.storage.Get(&metric, "id = ?", m.Id)
+					if errors.Is(err, persistence.ErrRecordNotFound) {
+						return status.Errorf(codes.InvalidArgument, "unknown metric %s", m.Id)
+					} else if err != nil {
+						return status.Errorf(codes.Internal, "database error: %v", err)
+					}
+
+					c.Metrics[i] = &metric
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// assessmentResultsToOscal converts the assessment results of a cloud service into OSCAL assessment-results. Each
+// assessment result is an observation. Each control of the catalog (and its (flat list of) controls), for which we
+// have observations, is a finding that is satisfied if all of its observations are compliant.
+func assessmentResultsToOscal(cloudServiceID string, catalog *orchestrator.Catalog, controls []*orchestrator.Control, results []*assessment.AssessmentResult, now time.Time) *oscal.Document {
+	var (
+		result = oscal.Result{
+			UUID:        uuid.NewString(),
+			Title:       fmt.Sprintf("Assessment of cloud service %s", cloudServiceID),
+			Description: fmt.Sprintf("Automated assessment of cloud service %s against catalog %s", cloudServiceID, catalog.Name),
+			Start:       now,
+			End:         &now,
+		}
+		ids     []string
+		version = catalog.Version
+	)
+
+	// OSCAL requires a version of the document, so we fall back to a default, if the catalog has no version
+	if version == "" {
+		version = "1.0"
+	}
+
+	for _, r := range results {
+		ts := r.GetTimestamp().AsTime()
+		if ts.Before(result.Start) {
+			result.Start = ts
+		}
+
+		result.Observations = append(result.Observations, oscal.Observation{
+			UUID:        r.Id,
+			Title:       fmt.Sprintf("Assessment of metric %s", r.MetricId),
+			Description: fmt.Sprintf("Resource %s was assessed against metric %s", r.ResourceId, r.MetricId),
+			Props: []oscal.Property{
+				{Name: oscal.PropertyResourceID, Ns: oscal.ClouditorNamespace, Value: r.ResourceId},
+				{Name: oscal.PropertyCompliant, Ns: oscal.ClouditorNamespace, Value: strconv.FormatBool(r.Compliant)},
+			},
+			Methods: []string{"TEST"},
+			RelevantEvidence: []oscal.RelevantEvidence{
+				{Description: fmt.Sprintf("Evidence %s", r.EvidenceId)},
+			},
+			Collected: ts,
+		})
+	}
+
+	for _, control := range controls {
+		var (
+			metrics   []string
+			related   []oscal.RelatedObservation
+			satisfied = true
+		)
+
+		// A top-level control also covers the metrics of its sub-controls
+		for _, c := range controls {
+			if c == control || (control.ParentControlId == nil && c.GetParentControlId() == control.Id &&
+				c.GetParentControlCategoryName() == control.CategoryName) {
+				for _, m := range c.Metrics {
+					metrics = append(metrics, m.Id)
+				}
+			}
+		}
+
+		for _, r := range results {
+			if slices.Contains(metrics, r.MetricId) {
+				related = append(related, oscal.RelatedObservation{ObservationUUID: r.Id})
+				satisfied = satisfied && r.Compliant
+			}
+		}
+
+		ids = append(ids, control.Id)
+
+		// We cannot conclude anything about a control without any observations
+		if len(related) == 0 {
+			continue
+		}
+
+		f := oscal.Finding{
+			UUID:        uuid.NewString(),
+			Title:       fmt.Sprintf("%s: %s", control.Id, control.Name),
+			Description: control.Description,
+			Target: oscal.FindingTarget{
+				Type:     "objective-id",
+				TargetID: control.Id,
+				Status:   oscal.ObjectiveStatus{State: oscal.StateNotSatisfied},
+			},
+			RelatedObservations: related,
+		}
+
+		if satisfied {
+			f.Target.Status.State = oscal.StateSatisfied
+		}
+
+		result.Findings = append(result.Findings, f)
+	}
+
+	result.ReviewedControls.ControlSelections = []oscal.ControlSelection{
+		{IncludeControls: []oscal.SelectControlByID{{WithIDs: ids}}},
+	}
+
+	return &oscal.Document{
+		AssessmentResults: &oscal.AssessmentResults{
+			UUID: uuid.NewString(),
+			Metadata: oscal.Metadata{
+				Title:        fmt.Sprintf("Assessment results of cloud service %s", cloudServiceID),
+				LastModified: now,
+				Version:      version,
+				OscalVersion: oscal.Version,
+			},
+			// We do not have an assessment plan, therefore we reference this document itself
+			ImportAP: oscal.ImportAP{Href: "#"},
+			Results:  []oscal.Result{result},
+		},
+	}
+}
@@ -0,0 +1,480 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package orchestrator
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/internal/oscal"
+	"clouditor.io/clouditor/v2/internal/pseudonym"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/testutil/servicetest"
+	"clouditor.io/clouditor/v2/internal/testutil/servicetest/orchestratortest"
+	"clouditor.io/clouditor/v2/internal/util"
+	"clouditor.io/clouditor/v2/persistence"
+	"clouditor.io/clouditor/v2/service"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const mockOscalCatalog = `{
+	"catalog": {
+		"uuid": "74c8ba1e-5cd4-4ad1-bbfd-d888e2f6c724",
+		"metadata": {
+			"title": "Mock OSCAL Catalog",
+			"version": "1.0",
+			"oscal-version": "1.1.2"
+		},
+		"groups": [
+			{
+				"id": "ac",
+				"title": "Access Control",
+				"parts": [{"name": "overview", "prose": "Controls about access control"}],
+				"controls": [
+					{
+						"id": "ac-1",
+						"title": "Policy and Procedures",
+						"parts": [{"name": "statement", "prose": "Develop an access control policy"}],
+						"controls": [
+							{
+								"id": "ac-1.1",
+								"title": "Automated Enforcement",
+								"props": [
+									{"name": "metric", "ns": "https://clouditor.io/ns/oscal", "value": "Mock Metric 1"},
+									{"name": "assurance-level", "ns": "https://clouditor.io/ns/oscal", "value": "high"},
+									{"name": "label", "value": "AC-1(1)"}
+								],
+								"parts": [{"name": "statement", "prose": "Enforce the policy", "parts": [{"name": "item", "prose": "automatically"}]}]
+							}
+						]
+					}
+				]
+			}
+		]
+	}
+}`
+
+const mockOscalProfile = `{
+	"profile": {
+		"uuid": "0d6f33f8-bd6a-4c44-9e5e-ea8c4c6a2b23",
+		"metadata": {
+			"title": "Mock OSCAL Profile",
+			"version": "1.0",
+			"oscal-version": "1.1.2"
+		},
+		"imports": [
+			{
+				"href": "#",
+				"include-controls": [{"with-ids": ["Cont1"], "with-child-controls": "yes"}]
+			}
+		]
+	}
+}`
+
+func TestService_ImportOscalCatalog(t *testing.T) {
+	type fields struct {
+		storage persistence.Storage
+	}
+	type args struct {
+		req *orchestrator.ImportOscalCatalogRequest
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantRes assert.Want[*orchestrator.Catalog]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "invalid request",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t),
+			},
+			args: args{
+				req: &orchestrator.ImportOscalCatalogRequest{},
+			},
+			wantRes: assert.Nil[*orchestrator.Catalog],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "data: value length must be at least 1 bytes")
+			},
+		},
+		{
+			name: "invalid document",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t),
+			},
+			args: args{
+				req: &orchestrator.ImportOscalCatalogRequest{Data: []byte("{")},
+			},
+			wantRes: assert.Nil[*orchestrator.Catalog],
+			wantErr: func(t *testing.T, err error) bool {
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+				return assert.ErrorContains(t, err, "invalid OSCAL document")
+			},
+		},
+		{
+			name: "neither catalog nor profile",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t),
+			},
+			args: args{
+				req: &orchestrator.ImportOscalCatalogRequest{Data: []byte(`{"assessment-results": {}}`)},
+			},
+			wantRes: assert.Nil[*orchestrator.Catalog],
+			wantErr: func(t *testing.T, err error) bool {
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+				return assert.ErrorContains(t, err, "OSCAL document neither contains a catalog nor a profile")
+			},
+		},
+		{
+			name: "catalog with unknown metric",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t),
+			},
+			args: args{
+				req: &orchestrator.ImportOscalCatalogRequest{Data: []byte(mockOscalCatalog)},
+			},
+			wantRes: assert.Nil[*orchestrator.Catalog],
+			wantErr: func(t *testing.T, err error) bool {
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+				return assert.ErrorContains(t, err, "unknown metric Mock Metric 1")
+			},
+		},
+		{
+			name: "valid catalog",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					assert.NoError(t, s.Create(orchestratortest.NewCatalog()))
+				}),
+			},
+			args: args{
+				req: &orchestrator.ImportOscalCatalogRequest{
+					Data:      []byte(mockOscalCatalog),
+					CatalogId: util.Ref("NIST"),
+				},
+			},
+			wantRes: func(t *testing.T, got *orchestrator.Catalog) bool {
+				assert.Equal(t, "NIST", got.Id)
+				assert.Equal(t, "Mock OSCAL Catalog", got.Name)
+				assert.Equal(t, []string{"high"}, got.AssuranceLevels)
+				assert.Equal(t, 1, len(got.Categories))
+
+				category := got.Categories[0]
+				assert.Equal(t, "Access Control", category.Name)
+				assert.Equal(t, "Controls about access control", category.Description)
+				assert.Equal(t, 1, len(category.Controls))
+
+				control := category.Controls[0]
+				assert.Equal(t, "ac-1", control.Id)
+				assert.Equal(t, "Develop an access control policy", control.Description)
+				assert.Equal(t, 1, len(control.Controls))
+
+				sub := control.Controls[0]
+				assert.Equal(t, "Enforce the policy\nautomatically", sub.Description)
+				assert.Equal(t, "high", util.Deref(sub.AssuranceLevel))
+				assert.Equal(t, "ac-1", util.Deref(sub.ParentControlId))
+				return assert.Equal(t, testdata.MockMetricID1, sub.Metrics[0].Id)
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "profile without base catalog",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t),
+			},
+			args: args{
+				req: &orchestrator.ImportOscalCatalogRequest{Data: []byte(mockOscalProfile)},
+			},
+			wantRes: assert.Nil[*orchestrator.Catalog],
+			wantErr: func(t *testing.T, err error) bool {
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+				return assert.ErrorContains(t, err, "base catalog ID is required")
+			},
+		},
+		{
+			name: "profile with base catalog not found",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t),
+			},
+			args: args{
+				req: &orchestrator.ImportOscalCatalogRequest{
+					Data:          []byte(mockOscalProfile),
+					BaseCatalogId: util.Ref(testdata.MockCatalogID),
+				},
+			},
+			wantRes: assert.Nil[*orchestrator.Catalog],
+			wantErr: func(t *testing.T, err error) bool {
+				assert.Equal(t, codes.NotFound, status.Code(err))
+				return assert.ErrorContains(t, err, "base catalog not found")
+			},
+		},
+		{
+			name: "valid profile",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					assert.NoError(t, s.Create(orchestratortest.NewCatalog()))
+				}),
+			},
+			args: args{
+				req: &orchestrator.ImportOscalCatalogRequest{
+					Data:          []byte(mockOscalProfile),
+					BaseCatalogId: util.Ref(testdata.MockCatalogID),
+				},
+			},
+			wantRes: func(t *testing.T, got *orchestrator.Catalog) bool {
+				assert.Equal(t, "0d6f33f8-bd6a-4c44-9e5e-ea8c4c6a2b23", got.Id)
+				assert.Equal(t, "Mock OSCAL Profile", got.Name)
+				assert.True(t, got.AllInScope)
+				assert.Equal(t, 1, len(got.Categories))
+				assert.Equal(t, 1, len(got.Categories[0].Controls))
+
+				control := got.Categories[0].Controls[0]
+				assert.Equal(t, testdata.MockControlID1, control.Id)
+				assert.Equal(t, got.Id, control.CategoryCatalogId)
+				assert.Equal(t, 1, len(control.Controls))
+				return assert.Equal(t, testdata.MockSubControlID11, control.Controls[0].Id)
+			},
+			wantErr: assert.Nil[error],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				storage: tt.fields.storage,
+			}
+
+			gotRes, err := servicetest.Validated(svc.ImportOscalCatalog)(context.Background(), tt.args.req)
+			tt.wantErr(t, err)
+			tt.wantRes(t, gotRes)
+
+			// The imported catalog should be stored
+			if err == nil {
+				_, err = servicetest.Validated(svc.GetCatalog)(context.Background(), &orchestrator.GetCatalogRequest{CatalogId: gotRes.Id})
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestService_ExportOscalAssessmentResults(t *testing.T) {
+	p, _ := pseudonym.New("my-salt")
+
+	type fields struct {
+		storage       persistence.Storage
+		authz         service.AuthorizationStrategy
+		pseudonymizer *pseudonym.Pseudonymizer
+	}
+	type args struct {
+		req *orchestrator.ExportOscalAssessmentResultsRequest
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantRes assert.Want[*oscal.AssessmentResults]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "invalid request",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t),
+				authz:   servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &orchestrator.ExportOscalAssessmentResultsRequest{},
+			},
+			wantRes: assert.Nil[*oscal.AssessmentResults],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "cloud_service_id: value is empty, which is not a valid UUID")
+			},
+		},
+		{
+			name: "permission denied",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t),
+				authz:   servicetest.NewAuthorizationStrategy(false, testdata.MockCloudServiceID2),
+			},
+			args: args{
+				req: &orchestrator.ExportOscalAssessmentResultsRequest{
+					CloudServiceId: testdata.MockCloudServiceID1,
+					CatalogId:      testdata.MockCatalogID,
+				},
+			},
+			wantRes: assert.Nil[*oscal.AssessmentResults],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, service.ErrPermissionDenied)
+			},
+		},
+		{
+			name: "catalog not found",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t),
+				authz:   servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &orchestrator.ExportOscalAssessmentResultsRequest{
+					CloudServiceId: testdata.MockCloudServiceID1,
+					CatalogId:      testdata.MockCatalogID,
+				},
+			},
+			wantRes: assert.Nil[*oscal.AssessmentResults],
+			wantErr: func(t *testing.T, err error) bool {
+				assert.Equal(t, codes.NotFound, status.Code(err))
+				return assert.ErrorContains(t, err, "catalog not found")
+			},
+		},
+		{
+			name: "valid",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					assert.NoError(t, s.Create(orchestratortest.NewCatalog()))
+					assert.NoError(t, s.Create(orchestratortest.MockAssessmentResult1))
+					assert.NoError(t, s.Create(orchestratortest.MockAssessmentResult2))
+					assert.NoError(t, s.Create(orchestratortest.MockAssessmentResult3))
+				}),
+				authz: servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &orchestrator.ExportOscalAssessmentResultsRequest{
+					CloudServiceId: testdata.MockCloudServiceID1,
+					CatalogId:      testdata.MockCatalogID,
+				},
+			},
+			wantRes: func(t *testing.T, got *oscal.AssessmentResults) bool {
+				assert.Equal(t, oscal.Version, got.Metadata.OscalVersion)
+				assert.Equal(t, 1, len(got.Results))
+
+				result := got.Results[0]
+
+				// Only the results of the first cloud service are exported
+				assert.Equal(t, 2, len(result.Observations))
+				assert.NotEmpty(t, result.Findings)
+
+				// Only metric 1 is mapped to our controls and its result is compliant
+				for _, f := range result.Findings {
+					assert.Equal(t, oscal.StateSatisfied, f.Target.Status.State)
+					assert.Equal(t, []oscal.RelatedObservation{{ObservationUUID: testdata.MockAssessmentResult1ID}}, f.RelatedObservations)
+				}
+
+				return true
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "pseudonymization not configured",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t),
+				authz:   servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &orchestrator.ExportOscalAssessmentResultsRequest{
+					CloudServiceId: testdata.MockCloudServiceID1,
+					CatalogId:      testdata.MockCatalogID,
+					Pseudonymize:   true,
+				},
+			},
+			wantRes: assert.Nil[*oscal.AssessmentResults],
+			wantErr: func(t *testing.T, err error) bool {
+				assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+				return assert.ErrorContains(t, err, pseudonym.ErrNotConfigured.Error())
+			},
+		},
+		{
+			name: "valid with pseudonymization",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					assert.NoError(t, s.Create(orchestratortest.NewCatalog()))
+					assert.NoError(t, s.Create(orchestratortest.MockAssessmentResult1))
+				}),
+				authz:         servicetest.NewAuthorizationStrategy(true),
+				pseudonymizer: p,
+			},
+			args: args{
+				req: &orchestrator.ExportOscalAssessmentResultsRequest{
+					CloudServiceId: testdata.MockCloudServiceID1,
+					CatalogId:      testdata.MockCatalogID,
+					Pseudonymize:   true,
+				},
+			},
+			wantRes: func(t *testing.T, got *oscal.AssessmentResults) bool {
+				observations := got.Results[0].Observations
+				assert.Equal(t, 1, len(observations))
+				assert.False(t, strings.Contains(observations[0].Description, testdata.MockResourceID1))
+
+				return assert.Contains(t, observations[0].Props, oscal.Property{
+					Name:  oscal.PropertyResourceID,
+					Ns:    oscal.ClouditorNamespace,
+					Value: p.Pseudonym(pseudonym.FieldResourceID, testdata.MockResourceID1),
+				})
+			},
+			wantErr: assert.Nil[error],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				storage:       tt.fields.storage,
+				authz:         tt.fields.authz,
+				pseudonymizer: tt.fields.pseudonymizer,
+			}
+
+			gotRes, err := servicetest.Validated(svc.ExportOscalAssessmentResults)(context.Background(), tt.args.req)
+			tt.wantErr(t, err)
+
+			var doc oscal.Document
+			if gotRes != nil {
+				assert.NoError(t, json.Unmarshal(gotRes.Data, &doc))
+			}
+
+			tt.wantRes(t, doc.AssessmentResults)
+		})
+	}
+}
+
+func Test_profileSelects(t *testing.T) {
+	p := &oscal.Profile{
+		Imports: []oscal.Import{
+			{
+				IncludeAll: &struct{}{},
+				ExcludeControls: []oscal.SelectControlByID{
+					{WithIDs: []string{"ac-2"}, WithChildControls: "yes"},
+				},
+			},
+		},
+	}
+
+	assert.True(t, profileSelects(p, "ac-1", ""))
+	assert.True(t, profileSelects(p, "ac-1.1", "ac-1"))
+	assert.False(t, profileSelects(p, "ac-2", ""))
+	assert.False(t, profileSelects(p, "ac-2.1", "ac-2"))
+}
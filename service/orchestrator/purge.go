@@ -0,0 +1,85 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/service"
+)
+
+// purgeInterval is the interval in which removed entities are checked for permanent deletion.
+const purgeInterval = time.Hour
+
+// Purge permanently deletes all cloud services, catalogs and certificates that have been removed before the given
+// window. Afterward, they can no longer be restored. Metrics are not affected, since they are only deprecated on
+// removal.
+func (svc *Service) Purge(window time.Duration) (err error) {
+	var before = time.Now().Add(-window)
+
+	for _, r := range []any{
+		&orchestrator.Certificate{},
+		&orchestrator.Catalog{},
+		&orchestrator.CloudService{},
+	} {
+		n, perr := svc.storage.Purge(r, before)
+		if perr != nil {
+			err = errors.Join(err, fmt.Errorf("could not purge %T: %w", r, perr))
+			continue
+		}
+
+		if n > 0 {
+			log.Infof("Purged %d removed entities of type %T", n, r)
+		}
+	}
+
+	return
+}
+
+// StartPurge purges the entities removed before the given window periodically until the context is done. If a leader
+// elector is configured, only the leader purges.
+func (svc *Service) StartPurge(ctx context.Context, window time.Duration) {
+	ticker := time.NewTicker(purgeInterval)
+	defer ticker.Stop()
+
+	for {
+		if service.IsLeading(ctx, svc.leader) {
+			if err := svc.Purge(window); err != nil {
+				log.Errorf("Could not purge removed entities: %v", err)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
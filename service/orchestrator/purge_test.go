@@ -0,0 +1,99 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package orchestrator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/testutil/servicetest/orchestratortest"
+	"clouditor.io/clouditor/v2/persistence"
+	persistence_gorm "clouditor.io/clouditor/v2/persistence/gorm"
+	"clouditor.io/clouditor/v2/service"
+)
+
+func TestService_Purge(t *testing.T) {
+	svc := NewService(WithStorage(testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+		assert.NoError(t, s.Create(orchestratortest.NewCertificate()))
+		assert.NoError(t, s.Create(orchestratortest.NewCertificate2()))
+		assert.NoError(t, s.Delete(&orchestrator.Certificate{}, "id = ?", testdata.MockCertificateID))
+	})))
+
+	// The certificate was removed just now, so it is kept
+	assert.NoError(t, svc.Purge(time.Hour))
+
+	var cert orchestrator.Certificate
+	assert.NoError(t, svc.storage.Get(&cert, persistence_gorm.WithDeleted(), "id = ?", testdata.MockCertificateID))
+
+	// Without a window, all removed entities are purged
+	assert.NoError(t, svc.Purge(-time.Second))
+	assert.ErrorIs(t, svc.storage.Get(&cert, persistence_gorm.WithDeleted(), "id = ?", testdata.MockCertificateID),
+		persistence.ErrRecordNotFound)
+
+	// Other certificates are not affected
+	var other orchestrator.Certificate
+	assert.NoError(t, svc.storage.Get(&other, "id = ?", testdata.MockCertificateID2))
+
+	// Errors are reported
+	svc = NewService(WithStorage(&testutil.StorageWithError{PurgeErr: ErrSomeError}))
+	assert.ErrorIs(t, svc.Purge(time.Hour), ErrSomeError)
+}
+
+// notLeader is a [service.LeaderElector], which is never the leader.
+type notLeader struct{}
+
+func (notLeader) IsLeader(_ context.Context) error {
+	return service.ErrNotLeader
+}
+
+func TestService_StartPurge(t *testing.T) {
+	var cert orchestrator.Certificate
+
+	svc := NewService(WithLeaderElector(notLeader{}), WithStorage(testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+		assert.NoError(t, s.Create(orchestratortest.NewCertificate()))
+		assert.NoError(t, s.Delete(&orchestrator.Certificate{}, "id = ?", testdata.MockCertificateID))
+	})))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// Only the leader purges, so the certificate is kept
+	svc.StartPurge(ctx, -time.Second)
+	assert.NoError(t, svc.storage.Get(&cert, persistence_gorm.WithDeleted(), "id = ?", testdata.MockCertificateID))
+
+	// Without an elector, it is purged
+	svc.leader = nil
+	svc.StartPurge(ctx, -time.Second)
+
+	var purged orchestrator.Certificate
+	assert.ErrorIs(t, svc.storage.Get(&purged, persistence_gorm.WithDeleted(), "id = ?", testdata.MockCertificateID),
+		persistence.ErrRecordNotFound)
+}
@@ -0,0 +1,226 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"clouditor.io/clouditor/v2/api"
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/internal/logging"
+	"clouditor.io/clouditor/v2/persistence"
+	"clouditor.io/clouditor/v2/service"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ErrRoleAssignmentNotFound indicates the role assignment was not found
+var ErrRoleAssignmentNotFound = api.ErrorCodeRoleAssignmentNotFound.Err()
+
+// CreateRoleAssignment implements method for creating a new role assignment. The user needs to be an admin for the
+// cloud service of the role assignment, or for all cloud services if the role is assigned for all of them.
+func (svc *Service) CreateRoleAssignment(ctx context.Context, req *orchestrator.CreateRoleAssignmentRequest) (res *orchestrator.RoleAssignment, err error) {
+	if !svc.isAdmin(ctx, req.RoleAssignment.GetCloudServiceId()) {
+		return nil, service.ErrPermissionDenied
+	}
+
+	// Generate a new ID
+	req.RoleAssignment.Id = uuid.NewString()
+	req.RoleAssignment.CreatedAt = timestamppb.Now()
+
+	// Persist the new role assignment in our database
+	err = svc.storage.Create(req.RoleAssignment)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not add role assignment to the database: %v", err)
+	}
+
+	logging.LogRequest(log, logrus.DebugLevel, logging.Create, req)
+
+	return req.RoleAssignment, nil
+}
+
+// GetRoleAssignment implements method for getting a role assignment
+func (svc *Service) GetRoleAssignment(ctx context.Context, req *orchestrator.GetRoleAssignmentRequest) (res *orchestrator.RoleAssignment, err error) {
+	return svc.getRoleAssignment(ctx, req.RoleAssignmentId)
+}
+
+// ListRoleAssignments implements method for listing all role assignments the user is authorized to see. These are the
+// role assignments of the cloud services the user has access to as well as the user's own role assignments.
+func (svc *Service) ListRoleAssignments(ctx context.Context, req *orchestrator.ListRoleAssignmentsRequest) (res *orchestrator.ListRoleAssignmentsResponse, err error) {
+	var (
+		query []string
+		args  []any
+	)
+
+	if req.Filter != nil {
+		if req.Filter.UserId != nil {
+			query = append(query, "user_id = ?")
+			args = append(args, req.Filter.GetUserId())
+		}
+
+		if req.Filter.CloudServiceId != nil {
+			query = append(query, "cloud_service_id = ?")
+			args = append(args, req.Filter.GetCloudServiceId())
+		}
+
+		if req.Filter.Role != nil {
+			query = append(query, "role = ?")
+			args = append(args, req.Filter.GetRole())
+		}
+	}
+
+	// Retrieve list of allowed cloud service according to our authorization strategy
+	all, allowed := svc.authz.AllowedCloudServices(ctx)
+	if !all {
+		user, _ := svc.currentUser(ctx)
+
+		query = append(query, "(cloud_service_id IN ? OR user_id = ?)")
+		args = append(args, allowed, user)
+	}
+
+	res = new(orchestrator.ListRoleAssignmentsResponse)
+
+	res.RoleAssignments, res.NextPageToken, err = service.PaginateStorage[*orchestrator.RoleAssignment](req, svc.storage,
+		service.DefaultPaginationOpts, persistence.BuildConds(query, args)...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not paginate results: %v", err)
+	}
+
+	return
+}
+
+// RemoveRoleAssignment implements method for removing a role assignment. The user needs to be an admin for the cloud
+// service of the role assignment, or for all cloud services if the role is assigned for all of them.
+func (svc *Service) RemoveRoleAssignment(ctx context.Context, req *orchestrator.RemoveRoleAssignmentRequest) (res *emptypb.Empty, err error) {
+	var assignment *orchestrator.RoleAssignment
+
+	// Check, if the role assignment exists and if we have access to it
+	assignment, err = svc.getRoleAssignment(ctx, req.RoleAssignmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !svc.isAdmin(ctx, assignment.GetCloudServiceId()) {
+		return nil, service.ErrPermissionDenied
+	}
+
+	err = svc.storage.Delete(&orchestrator.RoleAssignment{}, "id = ?", req.RoleAssignmentId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
+	logging.LogRequest(log, logrus.DebugLevel, logging.Remove, req)
+
+	return &emptypb.Empty{}, nil
+}
+
+// AssignedRoles implements [service.RoleAssignmentProvider]. It retrieves the roles that are assigned to the user for
+// all cloud services and, if cloudServiceID is not empty, for this specific cloud service.
+func (svc *Service) AssignedRoles(_ context.Context, user string, cloudServiceID string) (roles []string, err error) {
+	var assignments []*orchestrator.RoleAssignment
+
+	err = svc.storage.List(&assignments, "", true, 0, -1,
+		"user_id = ? AND (cloud_service_id IS NULL OR cloud_service_id = ?)", user, cloudServiceID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, a := range assignments {
+		if !slices.Contains(roles, a.Role) {
+			roles = append(roles, a.Role)
+		}
+	}
+
+	return roles, nil
+}
+
+// getRoleAssignment retrieves the role assignment with the given ID from the storage and checks, whether the user has
+// access to its cloud service or whether it is the user's own role assignment.
+func (svc *Service) getRoleAssignment(ctx context.Context, id string) (assignment *orchestrator.RoleAssignment, err error) {
+	assignment = new(orchestrator.RoleAssignment)
+
+	err = svc.storage.Get(assignment, "id = ?", id)
+	if errors.Is(err, persistence.ErrRecordNotFound) {
+		return nil, ErrRoleAssignmentNotFound
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
+	if user, ok := svc.currentUser(ctx); ok && user == assignment.UserId {
+		return assignment, nil
+	}
+
+	all, allowed := svc.authz.AllowedCloudServices(ctx)
+	if !all && (assignment.CloudServiceId == nil || !slices.Contains(allowed, assignment.GetCloudServiceId())) {
+		return nil, service.ErrPermissionDenied
+	}
+
+	return assignment, nil
+}
+
+// isAdmin checks, whether the current user is an admin of the given cloud service, e.g., to manage its role
+// assignments. If cloudServiceID is empty, all cloud services are meant. This requires access to the cloud service(s)
+// as well as the admin role, either from the authorization strategy or from a role assignment.
+func (svc *Service) isAdmin(ctx context.Context, cloudServiceID string) bool {
+	all, allowed := svc.authz.AllowedCloudServices(ctx)
+	if !all && (cloudServiceID == "" || !slices.Contains(allowed, cloudServiceID)) {
+		return false
+	}
+
+	if checker, ok := svc.authz.(service.RoleChecker); ok && checker.HasRole(ctx, service.RoleAdmin) {
+		return true
+	}
+
+	user, ok := svc.currentUser(ctx)
+	if !ok {
+		return false
+	}
+
+	roles, err := svc.AssignedRoles(ctx, user, cloudServiceID)
+	if err != nil {
+		log.Errorf("Could not retrieve role assignments of user %s: %v", user, err)
+		return false
+	}
+
+	return slices.Contains(roles, service.RoleAdmin)
+}
+
+// currentUser retrieves the current user, if the authorization strategy implements [service.UserIdentifier].
+func (svc *Service) currentUser(ctx context.Context) (user string, ok bool) {
+	identifier, ok := svc.authz.(service.UserIdentifier)
+	if !ok {
+		return "", false
+	}
+
+	return identifier.CurrentUser(ctx)
+}
@@ -0,0 +1,388 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package orchestrator
+
+import (
+	"context"
+	"testing"
+
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/testutil/servicetest"
+	"clouditor.io/clouditor/v2/internal/util"
+	"clouditor.io/clouditor/v2/persistence"
+	"clouditor.io/clouditor/v2/service"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	mockRoleAssignmentID1 = "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa"
+	mockRoleAssignmentID2 = "bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb"
+	mockRoleAssignmentID3 = "cccccccc-cccc-cccc-cccc-cccccccccccc"
+	mockAdminUser         = "admin-of-service1"
+	mockReaderUser        = "reader"
+)
+
+// newMockRoleAssignmentStorage creates a storage that contains an admin of cloud service 1, a reader of all cloud
+// services and an auditor of cloud service 2.
+func newMockRoleAssignmentStorage(t *testing.T) persistence.Storage {
+	return testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+		assert.NoError(t, s.Create(&orchestrator.RoleAssignment{
+			Id:             mockRoleAssignmentID1,
+			UserId:         mockAdminUser,
+			Role:           service.RoleAdmin,
+			CloudServiceId: util.Ref(testdata.MockCloudServiceID1),
+		}))
+		assert.NoError(t, s.Create(&orchestrator.RoleAssignment{
+			Id:     mockRoleAssignmentID2,
+			UserId: mockReaderUser,
+			Role:   service.RoleReadOnly,
+		}))
+		assert.NoError(t, s.Create(&orchestrator.RoleAssignment{
+			Id:             mockRoleAssignmentID3,
+			UserId:         mockReaderUser,
+			Role:           service.RoleAuditor,
+			CloudServiceId: util.Ref(testdata.MockCloudServiceID2),
+		}))
+	})
+}
+
+func TestService_CreateRoleAssignment(t *testing.T) {
+	type fields struct {
+		authz service.AuthorizationStrategy
+	}
+	type args struct {
+		req *orchestrator.CreateRoleAssignmentRequest
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantRes assert.Want[*orchestrator.RoleAssignment]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "Validation error: unknown role",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &orchestrator.CreateRoleAssignmentRequest{
+					RoleAssignment: &orchestrator.RoleAssignment{
+						UserId: "someone",
+						Role:   "risk-manager",
+					},
+				},
+			},
+			wantRes: assert.Nil[*orchestrator.RoleAssignment],
+			wantErr: func(t *testing.T, err error) bool {
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+				return assert.ErrorContains(t, err, "role_assignment.role")
+			},
+		},
+		{
+			name: "Permission denied: not an admin",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategyWithRoles(true, []string{service.RoleAuditor}),
+			},
+			args: args{
+				req: &orchestrator.CreateRoleAssignmentRequest{
+					RoleAssignment: &orchestrator.RoleAssignment{
+						UserId: "someone",
+						Role:   service.RoleReadOnly,
+					},
+				},
+			},
+			wantRes: assert.Nil[*orchestrator.RoleAssignment],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, service.ErrPermissionDenied)
+			},
+		},
+		{
+			name: "Permission denied: admin of another cloud service",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategyWithUser(false, mockAdminUser, testdata.MockCloudServiceID1, testdata.MockCloudServiceID2),
+			},
+			args: args{
+				req: &orchestrator.CreateRoleAssignmentRequest{
+					RoleAssignment: &orchestrator.RoleAssignment{
+						UserId:         "someone",
+						Role:           service.RoleReadOnly,
+						CloudServiceId: util.Ref(testdata.MockCloudServiceID2),
+					},
+				},
+			},
+			wantRes: assert.Nil[*orchestrator.RoleAssignment],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, service.ErrPermissionDenied)
+			},
+		},
+		{
+			name: "Permission denied: admin of a cloud service cannot assign roles for all cloud services",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategyWithUser(false, mockAdminUser, testdata.MockCloudServiceID1),
+			},
+			args: args{
+				req: &orchestrator.CreateRoleAssignmentRequest{
+					RoleAssignment: &orchestrator.RoleAssignment{
+						UserId: "someone",
+						Role:   service.RoleReadOnly,
+					},
+				},
+			},
+			wantRes: assert.Nil[*orchestrator.RoleAssignment],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, service.ErrPermissionDenied)
+			},
+		},
+		{
+			name: "Happy path: admin role assigned for the cloud service",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategyWithUser(false, mockAdminUser, testdata.MockCloudServiceID1),
+			},
+			args: args{
+				req: &orchestrator.CreateRoleAssignmentRequest{
+					RoleAssignment: &orchestrator.RoleAssignment{
+						UserId:         "someone",
+						Role:           service.RoleMetricAuthor,
+						CloudServiceId: util.Ref(testdata.MockCloudServiceID1),
+					},
+				},
+			},
+			wantRes: func(t *testing.T, got *orchestrator.RoleAssignment) bool {
+				assert.NotEmpty(t, got.Id)
+				assert.NotNil(t, got.CreatedAt)
+				return assert.Equal(t, service.RoleMetricAuthor, got.Role)
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "Happy path: admin role in token",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategyWithRoles(true, []string{service.RoleAdmin}),
+			},
+			args: args{
+				req: &orchestrator.CreateRoleAssignmentRequest{
+					RoleAssignment: &orchestrator.RoleAssignment{
+						UserId: "someone",
+						Role:   service.RoleAdmin,
+					},
+				},
+			},
+			wantRes: func(t *testing.T, got *orchestrator.RoleAssignment) bool {
+				assert.NotEmpty(t, got.Id)
+				return assert.Nil(t, got.CloudServiceId)
+			},
+			wantErr: assert.Nil[error],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				storage: newMockRoleAssignmentStorage(t),
+				authz:   tt.fields.authz,
+			}
+
+			gotRes, err := servicetest.Validated(svc.CreateRoleAssignment)(context.Background(), tt.args.req)
+			tt.wantErr(t, err)
+			tt.wantRes(t, gotRes)
+		})
+	}
+}
+
+func TestService_GetRoleAssignment(t *testing.T) {
+	svc := &Service{
+		storage: newMockRoleAssignmentStorage(t),
+		authz:   servicetest.NewAuthorizationStrategyWithUser(false, mockAdminUser, testdata.MockCloudServiceID1),
+	}
+
+	_, err := svc.GetRoleAssignment(context.Background(), &orchestrator.GetRoleAssignmentRequest{RoleAssignmentId: "dddddddd-dddd-dddd-dddd-dddddddddddd"})
+	assert.ErrorIs(t, err, ErrRoleAssignmentNotFound)
+
+	// The role assignment for all cloud services of another user is not visible
+	_, err = svc.GetRoleAssignment(context.Background(), &orchestrator.GetRoleAssignmentRequest{RoleAssignmentId: mockRoleAssignmentID2})
+	assert.ErrorIs(t, err, service.ErrPermissionDenied)
+
+	// The own role assignment is always visible
+	svc.authz = servicetest.NewAuthorizationStrategyWithUser(false, mockReaderUser)
+
+	res, err := svc.GetRoleAssignment(context.Background(), &orchestrator.GetRoleAssignmentRequest{RoleAssignmentId: mockRoleAssignmentID2})
+	assert.NoError(t, err)
+	assert.Equal(t, service.RoleReadOnly, res.GetRole())
+}
+
+func TestService_ListRoleAssignments(t *testing.T) {
+	type args struct {
+		req *orchestrator.ListRoleAssignmentsRequest
+	}
+	tests := []struct {
+		name    string
+		authz   service.AuthorizationStrategy
+		args    args
+		wantRes assert.Want[*orchestrator.ListRoleAssignmentsResponse]
+		wantErr assert.WantErr
+	}{
+		{
+			name:  "All",
+			authz: servicetest.NewAuthorizationStrategy(true),
+			args: args{
+				req: &orchestrator.ListRoleAssignmentsRequest{},
+			},
+			wantRes: func(t *testing.T, got *orchestrator.ListRoleAssignmentsResponse) bool {
+				return assert.Equal(t, 3, len(got.RoleAssignments))
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name:  "Filter by user and role",
+			authz: servicetest.NewAuthorizationStrategy(true),
+			args: args{
+				req: &orchestrator.ListRoleAssignmentsRequest{
+					Filter: &orchestrator.ListRoleAssignmentsRequest_Filter{
+						UserId: util.Ref(mockReaderUser),
+						Role:   util.Ref(service.RoleAuditor),
+					},
+				},
+			},
+			wantRes: func(t *testing.T, got *orchestrator.ListRoleAssignmentsResponse) bool {
+				if !assert.Equal(t, 1, len(got.RoleAssignments)) {
+					return false
+				}
+
+				return assert.Equal(t, mockRoleAssignmentID3, got.RoleAssignments[0].Id)
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name:  "Only allowed cloud services and own role assignments",
+			authz: servicetest.NewAuthorizationStrategyWithUser(false, mockReaderUser, testdata.MockCloudServiceID1),
+			args: args{
+				req: &orchestrator.ListRoleAssignmentsRequest{},
+			},
+			wantRes: func(t *testing.T, got *orchestrator.ListRoleAssignmentsResponse) bool {
+				return assert.Equal(t, 3, len(got.RoleAssignments))
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name:  "Only allowed cloud services",
+			authz: servicetest.NewAuthorizationStrategy(false, testdata.MockCloudServiceID1),
+			args: args{
+				req: &orchestrator.ListRoleAssignmentsRequest{},
+			},
+			wantRes: func(t *testing.T, got *orchestrator.ListRoleAssignmentsResponse) bool {
+				if !assert.Equal(t, 1, len(got.RoleAssignments)) {
+					return false
+				}
+
+				return assert.Equal(t, mockRoleAssignmentID1, got.RoleAssignments[0].Id)
+			},
+			wantErr: assert.Nil[error],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				storage: newMockRoleAssignmentStorage(t),
+				authz:   tt.authz,
+			}
+
+			gotRes, err := svc.ListRoleAssignments(context.Background(), tt.args.req)
+			tt.wantErr(t, err)
+			tt.wantRes(t, gotRes)
+		})
+	}
+}
+
+func TestService_RemoveRoleAssignment(t *testing.T) {
+	type fields struct {
+		authz service.AuthorizationStrategy
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		wantSvc assert.Want[*Service]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "Permission denied: own role assignment without admin role",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategyWithUser(false, mockAdminUser),
+			},
+			wantSvc: assert.AnyValue[*Service],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, service.ErrPermissionDenied)
+			},
+		},
+		{
+			name: "Happy path",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategyWithUser(false, mockAdminUser, testdata.MockCloudServiceID1),
+			},
+			wantSvc: func(t *testing.T, got *Service) bool {
+				var assignments []*orchestrator.RoleAssignment
+				err := got.storage.List(&assignments, "", true, 0, -1)
+				assert.NoError(t, err)
+				return assert.Equal(t, 2, len(assignments))
+			},
+			wantErr: assert.Nil[error],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				storage: newMockRoleAssignmentStorage(t),
+				authz:   tt.fields.authz,
+			}
+
+			_, err := svc.RemoveRoleAssignment(context.Background(), &orchestrator.RemoveRoleAssignmentRequest{RoleAssignmentId: mockRoleAssignmentID1})
+			tt.wantErr(t, err)
+			tt.wantSvc(t, svc)
+		})
+	}
+}
+
+func TestService_AssignedRoles(t *testing.T) {
+	svc := &Service{
+		storage: newMockRoleAssignmentStorage(t),
+	}
+
+	roles, err := svc.AssignedRoles(context.Background(), mockReaderUser, "")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{service.RoleReadOnly}, roles)
+
+	roles, err = svc.AssignedRoles(context.Background(), mockReaderUser, testdata.MockCloudServiceID2)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(roles))
+
+	roles, err = svc.AssignedRoles(context.Background(), mockAdminUser, testdata.MockCloudServiceID2)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(roles))
+}
@@ -0,0 +1,180 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"clouditor.io/clouditor/v2/api/assessment"
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/persistence"
+	"clouditor.io/clouditor/v2/service"
+)
+
+// staleResourceCheckInterval is the interval in which resources are checked for staleness.
+const staleResourceCheckInterval = time.Hour
+
+// StaleResourcePolicy configures how the orchestrator handles resources that no longer appear in the discovery, i.e.,
+// for which no new assessment results are stored.
+type StaleResourcePolicy struct {
+	// DecommissionAfter is the time after which a resource that was not seen is marked as decommissioned. The results
+	// of decommissioned resources are no longer part of the latest results and are therefore excluded from the
+	// evaluation. A value of 0 disables the detection of stale resources.
+	DecommissionAfter time.Duration
+
+	// DeleteResultsAfter is the grace period after which the assessment results of a decommissioned resource are
+	// deleted. A value of 0 keeps them.
+	DeleteResultsAfter time.Duration
+}
+
+// WithStaleResourcePolicy is an option to configure when resources that are no longer discovered are marked as
+// decommissioned and when their assessment results are deleted.
+func WithStaleResourcePolicy(policy StaleResourcePolicy) ServiceOption {
+	return func(s *Service) {
+		s.staleResources = policy
+	}
+}
+
+// markResourceSeen records that the resource was seen at the given time, e.g., because a new assessment result was
+// stored for it. A decommissioned resource is no longer considered as such. It returns whether the resource was seen
+// for the first time.
+func (svc *Service) markResourceSeen(cloudServiceID string, resourceID string, seen time.Time) (isNew bool, err error) {
+	var r orchestrator.AssessedResource
+
+	err = svc.storage.Get(&r, "cloud_service_id = ? AND resource_id = ?", cloudServiceID, resourceID)
+	if errors.Is(err, persistence.ErrRecordNotFound) {
+		err = svc.storage.Create(&orchestrator.AssessedResource{
+			CloudServiceId: cloudServiceID,
+			ResourceId:     resourceID,
+			LastSeen:       seen,
+		})
+		if errors.Is(err, persistence.ErrUniqueConstraintFailed) {
+			// Another replica was faster
+			return false, nil
+		}
+
+		return err == nil, err
+	} else if err != nil {
+		return false, err
+	}
+
+	// Results might be stored out of order, e.g., if they are sent by multiple assessment instances
+	if !seen.After(r.LastSeen) {
+		return false, nil
+	}
+
+	if r.DecommissionedAt != nil {
+		log.Infof("Resource %s of cloud service %s was seen again and is no longer decommissioned", resourceID, cloudServiceID)
+	}
+
+	r.LastSeen = seen
+	r.DecommissionedAt = nil
+
+	return false, svc.storage.Save(&r, "cloud_service_id = ? AND resource_id = ?", cloudServiceID, resourceID)
+}
+
+// CheckStaleResources marks all resources as decommissioned that were not seen within the period configured in the
+// [StaleResourcePolicy]. Afterward, it deletes the assessment results of resources that have been decommissioned for
+// longer than the grace period, if configured.
+func (svc *Service) CheckStaleResources() (err error) {
+	var (
+		now     = time.Now()
+		policy  = svc.staleResources
+		stale   []*orchestrator.AssessedResource
+		expired []*orchestrator.AssessedResource
+	)
+
+	if policy.DecommissionAfter <= 0 {
+		return nil
+	}
+
+	err = svc.storage.List(&stale, "", true, 0, -1, "decommissioned_at IS NULL AND last_seen < ?", now.Add(-policy.DecommissionAfter))
+	if err != nil {
+		return fmt.Errorf("could not retrieve stale resources: %w", err)
+	}
+
+	for _, r := range stale {
+		r.DecommissionedAt = &now
+
+		serr := svc.storage.Save(r, "cloud_service_id = ? AND resource_id = ?", r.CloudServiceId, r.ResourceId)
+		if serr != nil {
+			err = errors.Join(err, fmt.Errorf("could not decommission resource %s: %w", r.ResourceId, serr))
+			continue
+		}
+
+		log.Infof("Resource %s of cloud service %s was not seen since %v and is now decommissioned", r.ResourceId,
+			r.CloudServiceId, r.LastSeen)
+	}
+
+	if policy.DeleteResultsAfter <= 0 {
+		return
+	}
+
+	lerr := svc.storage.List(&expired, "", true, 0, -1, "decommissioned_at < ?", now.Add(-policy.DeleteResultsAfter))
+	if lerr != nil {
+		return errors.Join(err, fmt.Errorf("could not retrieve decommissioned resources: %w", lerr))
+	}
+
+	for _, r := range expired {
+		derr := svc.storage.Delete(&assessment.AssessmentResult{}, "cloud_service_id = ? AND resource_id = ?", r.CloudServiceId, r.ResourceId)
+		if errors.Is(derr, persistence.ErrRecordNotFound) {
+			// The results were already deleted in an earlier run
+			continue
+		} else if derr != nil {
+			err = errors.Join(err, fmt.Errorf("could not delete results of resource %s: %w", r.ResourceId, derr))
+			continue
+		}
+
+		log.Infof("Deleted the assessment results of decommissioned resource %s of cloud service %s", r.ResourceId,
+			r.CloudServiceId)
+	}
+
+	return
+}
+
+// StartStaleResourceCheck checks for stale resources periodically until the context is done. If a leader elector is
+// configured, only the leader checks.
+func (svc *Service) StartStaleResourceCheck(ctx context.Context) {
+	ticker := time.NewTicker(staleResourceCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		if service.IsLeading(ctx, svc.leader) {
+			if err := svc.CheckStaleResources(); err != nil {
+				log.Errorf("Could not check for stale resources: %v", err)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,172 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package orchestrator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"clouditor.io/clouditor/v2/api/assessment"
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/testutil/servicetest/orchestratortest"
+	"clouditor.io/clouditor/v2/internal/util"
+	"clouditor.io/clouditor/v2/persistence"
+)
+
+func TestService_CheckStaleResources(t *testing.T) {
+	var (
+		now     = time.Now()
+		results []*assessment.AssessmentResult
+	)
+
+	svc := NewService(
+		WithStaleResourcePolicy(StaleResourcePolicy{DecommissionAfter: time.Hour}),
+		WithStorage(testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+			assert.NoError(t, s.Create(orchestratortest.MockAssessmentResult1))
+			assert.NoError(t, s.Create(mockStorageAssessmentResult))
+			assert.NoError(t, s.Create(&orchestrator.AssessedResource{
+				CloudServiceId: testdata.MockCloudServiceID1,
+				ResourceId:     testdata.MockResourceID1,
+				LastSeen:       now.Add(-2 * time.Hour),
+			}))
+			assert.NoError(t, s.Create(&orchestrator.AssessedResource{
+				CloudServiceId: testdata.MockCloudServiceID1,
+				ResourceId:     testdata.MockResourceID2,
+				LastSeen:       now,
+			}))
+		})),
+	)
+
+	// Only the resource that was not seen within the last hour is decommissioned
+	assert.NoError(t, svc.CheckStaleResources())
+
+	assert.NotNil(t, getAssessedResource(t, svc, testdata.MockResourceID1).DecommissionedAt)
+	assert.Nil(t, getAssessedResource(t, svc, testdata.MockResourceID2).DecommissionedAt)
+
+	// Its results are excluded from the latest results, but not deleted
+	results, err := svc.latestAssessmentResults([]string{"cloud_service_id = ?"}, []any{testdata.MockCloudServiceID1})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(results))
+	assert.Equal(t, testdata.MockResourceID2, results[0].ResourceId)
+
+	count, err := svc.storage.Count(&assessment.AssessmentResult{}, "resource_id = ?", testdata.MockResourceID1)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	// Once the resource is seen again, it is no longer decommissioned
+	isNew, err := svc.markResourceSeen(testdata.MockCloudServiceID1, testdata.MockResourceID1, now)
+	assert.NoError(t, err)
+	assert.False(t, isNew)
+	assert.Nil(t, getAssessedResource(t, svc, testdata.MockResourceID1).DecommissionedAt)
+
+	// After the grace period, the results of decommissioned resources are deleted
+	svc.staleResources.DeleteResultsAfter = time.Hour
+	assert.NoError(t, svc.storage.Save(&orchestrator.AssessedResource{
+		CloudServiceId:   testdata.MockCloudServiceID1,
+		ResourceId:       testdata.MockResourceID1,
+		LastSeen:         now.Add(-3 * time.Hour),
+		DecommissionedAt: util.Ref(now.Add(-2 * time.Hour)),
+	}, "cloud_service_id = ? AND resource_id = ?", testdata.MockCloudServiceID1, testdata.MockResourceID1))
+	assert.NoError(t, svc.CheckStaleResources())
+
+	count, err = svc.storage.Count(&assessment.AssessmentResult{}, "resource_id = ?", testdata.MockResourceID1)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), count)
+
+	// Running it again does not fail, although there are no results left to delete
+	assert.NoError(t, svc.CheckStaleResources())
+
+	// Errors are reported
+	svc = NewService(
+		WithStaleResourcePolicy(StaleResourcePolicy{DecommissionAfter: time.Hour}),
+		WithStorage(&testutil.StorageWithError{ListErr: ErrSomeError}),
+	)
+	assert.ErrorIs(t, svc.CheckStaleResources(), ErrSomeError)
+
+	// Without a policy, nothing happens
+	svc.staleResources = StaleResourcePolicy{}
+	assert.NoError(t, svc.CheckStaleResources())
+}
+
+func TestService_markResourceSeen(t *testing.T) {
+	var now = time.Now()
+
+	svc := NewService(WithStorage(testutil.NewInMemoryStorage(t)))
+
+	isNew, err := svc.markResourceSeen(testdata.MockCloudServiceID1, testdata.MockResourceID1, now)
+	assert.NoError(t, err)
+	assert.True(t, isNew)
+
+	// An older result does not change the last seen timestamp
+	isNew, err = svc.markResourceSeen(testdata.MockCloudServiceID1, testdata.MockResourceID1, now.Add(-time.Hour))
+	assert.NoError(t, err)
+	assert.False(t, isNew)
+
+	assert.Equal(t, now.Unix(), getAssessedResource(t, svc, testdata.MockResourceID1).LastSeen.Unix())
+
+	// Errors are reported
+	svc = NewService(WithStorage(&testutil.StorageWithError{GetErr: ErrSomeError}))
+	_, err = svc.markResourceSeen(testdata.MockCloudServiceID1, testdata.MockResourceID1, now)
+	assert.ErrorIs(t, err, ErrSomeError)
+}
+
+func TestService_StartStaleResourceCheck(t *testing.T) {
+	svc := NewService(
+		WithLeaderElector(notLeader{}),
+		WithStaleResourcePolicy(StaleResourcePolicy{DecommissionAfter: time.Hour}),
+		WithStorage(testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+			assert.NoError(t, s.Create(&orchestrator.AssessedResource{
+				CloudServiceId: testdata.MockCloudServiceID1,
+				ResourceId:     testdata.MockResourceID1,
+				LastSeen:       time.Now().Add(-2 * time.Hour),
+			}))
+		})),
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// Only the leader checks, so the resource is not decommissioned
+	svc.StartStaleResourceCheck(ctx)
+	assert.Nil(t, getAssessedResource(t, svc, testdata.MockResourceID1).DecommissionedAt)
+
+	// Without an elector, it is decommissioned
+	svc.leader = nil
+	svc.StartStaleResourceCheck(ctx)
+	assert.NotNil(t, getAssessedResource(t, svc, testdata.MockResourceID1).DecommissionedAt)
+}
+
+// getAssessedResource retrieves the assessed resource with the given ID from the storage of svc.
+func getAssessedResource(t *testing.T, svc *Service, resourceID string) (r *orchestrator.AssessedResource) {
+	r = new(orchestrator.AssessedResource)
+	assert.NoError(t, svc.storage.Get(r, "resource_id = ?", resourceID))
+
+	return r
+}
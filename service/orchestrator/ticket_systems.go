@@ -0,0 +1,228 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package orchestrator
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"clouditor.io/clouditor/v2/api/assessment"
+	"clouditor.io/clouditor/v2/api/orchestrator"
+)
+
+const (
+	// DefaultJiraResolveTransition is the ID of the Jira transition that is used to resolve a ticket, if the
+	// integration does not specify one. This is the "Done" transition of the default Jira workflow.
+	DefaultJiraResolveTransition = "31"
+
+	// DefaultServiceNowResolveState is the ServiceNow incident state that is used to resolve a ticket, if the
+	// integration does not specify one. This is the "Resolved" state of the default incident table.
+	DefaultServiceNowResolveState = "6"
+)
+
+// ticketSystem abstracts the API of an external ticket system, such as Jira or ServiceNow.
+type ticketSystem interface {
+	// open opens a new ticket for the non-compliant assessment result and returns the ID and the URL of the ticket in
+	// the external system.
+	open(result *assessment.AssessmentResult) (id string, url string, err error)
+
+	// resolve resolves the ticket in the external system.
+	resolve(ticket *orchestrator.Ticket) error
+}
+
+// newTicketSystem returns the ticket system of the integration.
+func newTicketSystem(client *http.Client, integration *orchestrator.TicketIntegration) (ticketSystem, error) {
+	switch integration.System {
+	case orchestrator.TicketSystem_TICKET_SYSTEM_JIRA:
+		return &jira{client: client, integration: integration}, nil
+	case orchestrator.TicketSystem_TICKET_SYSTEM_SERVICENOW:
+		return &serviceNow{client: client, integration: integration}, nil
+	default:
+		return nil, fmt.Errorf("unsupported ticket system %s", integration.System)
+	}
+}
+
+// jira opens and resolves issues using the REST API (v2) of Jira.
+type jira struct {
+	client      *http.Client
+	integration *orchestrator.TicketIntegration
+}
+
+func (j *jira) open(result *assessment.AssessmentResult) (id string, link string, err error) {
+	var res struct {
+		Key string `json:"key"`
+	}
+
+	err = doTicketRequest(j.client, j.integration, http.MethodPost, "/rest/api/2/issue", map[string]any{
+		"fields": map[string]any{
+			"project":     map[string]any{"key": j.integration.ProjectFor(result.MetricId)},
+			"summary":     ticketSummary(result),
+			"description": ticketDescription(result),
+			"issuetype":   map[string]any{"name": "Bug"},
+			"labels":      []string{"clouditor"},
+		},
+	}, &res)
+	if err != nil {
+		return "", "", err
+	}
+
+	return res.Key, j.integration.Url + "/browse/" + res.Key, nil
+}
+
+func (j *jira) resolve(ticket *orchestrator.Ticket) error {
+	var transition = j.integration.ResolveState
+
+	if transition == "" {
+		transition = DefaultJiraResolveTransition
+	}
+
+	return doTicketRequest(j.client, j.integration, http.MethodPost, "/rest/api/2/issue/"+url.PathEscape(ticket.ExternalId)+"/transitions", map[string]any{
+		"transition": map[string]any{"id": transition},
+		"update": map[string]any{
+			"comment": []any{
+				map[string]any{"add": map[string]any{"body": "The resource is compliant again."}},
+			},
+		},
+	}, nil)
+}
+
+// serviceNow opens and resolves incidents using the table API of ServiceNow.
+type serviceNow struct {
+	client      *http.Client
+	integration *orchestrator.TicketIntegration
+}
+
+func (sn *serviceNow) open(result *assessment.AssessmentResult) (id string, link string, err error) {
+	var res struct {
+		Result struct {
+			SysID string `json:"sys_id"`
+		} `json:"result"`
+	}
+
+	err = doTicketRequest(sn.client, sn.integration, http.MethodPost, "/api/now/table/incident", map[string]any{
+		"short_description": ticketSummary(result),
+		"description":       ticketDescription(result),
+		"assignment_group":  sn.integration.ProjectFor(result.MetricId),
+		"correlation_id":    result.ResourceId + "/" + result.MetricId,
+	}, &res)
+	if err != nil {
+		return "", "", err
+	}
+
+	return res.Result.SysID, sn.integration.Url + "/nav_to.do?uri=incident.do%3Fsys_id%3D" + res.Result.SysID, nil
+}
+
+func (sn *serviceNow) resolve(ticket *orchestrator.Ticket) error {
+	var state = sn.integration.ResolveState
+
+	if state == "" {
+		state = DefaultServiceNowResolveState
+	}
+
+	return doTicketRequest(sn.client, sn.integration, http.MethodPatch, "/api/now/table/incident/"+url.PathEscape(ticket.ExternalId), map[string]any{
+		"state":       state,
+		"close_code":  "Solved (Permanently)",
+		"close_notes": "The resource is compliant again.",
+	}, nil)
+}
+
+// ticketSummary returns the summary of a ticket for the non-compliant assessment result.
+func ticketSummary(result *assessment.AssessmentResult) string {
+	return fmt.Sprintf("Resource %s is not compliant to metric %s", result.ResourceId, result.MetricId)
+}
+
+// ticketDescription returns the description of a ticket for the non-compliant assessment result.
+func ticketDescription(result *assessment.AssessmentResult) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "Clouditor found that the resource %s", result.ResourceId)
+	if len(result.ResourceTypes) > 0 {
+		fmt.Fprintf(&b, " (%s)", result.ResourceTypes[0])
+	}
+	fmt.Fprintf(&b, " of cloud service %s is not compliant to metric %s.\n\n", result.CloudServiceId, result.MetricId)
+
+	if result.NonComplianceComments != "" {
+		fmt.Fprintf(&b, "%s\n\n", result.NonComplianceComments)
+	}
+
+	fmt.Fprintf(&b, "Assessment result: %s", result.Id)
+
+	return b.String()
+}
+
+// doTicketRequest sends the JSON request body to the path of the ticket system and decodes the JSON response into
+// out, if it is not nil.
+func doTicketRequest(client *http.Client, integration *orchestrator.TicketIntegration, method string, path string, in any, out any) (err error) {
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	body, err := json.Marshal(in)
+	if err != nil {
+		return fmt.Errorf("could not marshal request: %w", err)
+	}
+
+	req, err := http.NewRequest(method, strings.TrimSuffix(integration.Url, "/")+path, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("could not create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+
+	if integration.Username != "" {
+		req.SetBasicAuth(integration.Username, integration.Token)
+	} else if integration.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+integration.Token)
+	}
+
+	res, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(res.Body, 512))
+		return fmt.Errorf("unexpected status code %d: %s", res.StatusCode, msg)
+	}
+
+	if out == nil {
+		return nil
+	}
+
+	err = json.NewDecoder(res.Body).Decode(out)
+	if err != nil {
+		return fmt.Errorf("could not decode response: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package orchestrator
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+)
+
+func Test_serviceNow(t *testing.T) {
+	rec := &ticketSystemRecorder{}
+	srv := httptest.NewServer(rec)
+	defer srv.Close()
+
+	integration := newMockTicketIntegration()
+	integration.System = orchestrator.TicketSystem_TICKET_SYSTEM_SERVICENOW
+	integration.Url = srv.URL
+	integration.Project = "Cloud Operations"
+	integration.MetricProjects = map[string]string{testdata.MockMetricID1: "Security Operations"}
+
+	system, err := newTicketSystem(http.DefaultClient, integration)
+	assert.NoError(t, err)
+
+	id, link, err := system.open(newMockResult("00000000-0000-0000-0000-000000000011", false, 2))
+	assert.NoError(t, err)
+	assert.Equal(t, "abc123", id)
+	assert.Equal(t, srv.URL+"/nav_to.do?uri=incident.do%3Fsys_id%3Dabc123", link)
+	assert.Equal[any](t, "Security Operations", rec.bodies[0]["assignment_group"])
+	assert.Equal[any](t, testdata.MockResourceID1+"/"+testdata.MockMetricID1, rec.bodies[0]["correlation_id"])
+
+	err = system.resolve(&orchestrator.Ticket{ExternalId: id})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"POST /api/now/table/incident", "PATCH /api/now/table/incident/abc123"}, rec.paths)
+	assert.Equal[any](t, DefaultServiceNowResolveState, rec.bodies[1]["state"])
+}
+
+func Test_newTicketSystem(t *testing.T) {
+	_, err := newTicketSystem(http.DefaultClient, &orchestrator.TicketIntegration{})
+	assert.ErrorContains(t, err, "unsupported ticket system")
+}
+
+func Test_doTicketRequest(t *testing.T) {
+	var gotUser, gotPassword string
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotUser, gotPassword, _ = r.BasicAuth()
+
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte("invalid credentials"))
+	}))
+	defer srv.Close()
+
+	integration := newMockTicketIntegration()
+	integration.Url = srv.URL + "/"
+
+	err := doTicketRequest(nil, integration, http.MethodPost, "/rest/api/2/issue", map[string]any{}, nil)
+	assert.ErrorContains(t, err, "unexpected status code 401: invalid credentials")
+	assert.Equal(t, "clouditor@example.com", gotUser)
+	assert.Equal(t, mockTicketIntegrationToken, gotPassword)
+}
+
+func Test_ticketDescription(t *testing.T) {
+	result := newMockResult("00000000-0000-0000-0000-000000000011", false, 2)
+	result.NonComplianceComments = "Encryption is disabled"
+
+	assert.Equal(t, "Clouditor found that the resource "+testdata.MockResourceID1+" (Resource) of cloud service "+
+		testdata.MockCloudServiceID1+" is not compliant to metric "+testdata.MockMetricID1+".\n\n"+
+		"Encryption is disabled\n\nAssessment result: 00000000-0000-0000-0000-000000000011", ticketDescription(result))
+}
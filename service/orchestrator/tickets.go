@@ -0,0 +1,364 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"clouditor.io/clouditor/v2/api"
+	"clouditor.io/clouditor/v2/api/assessment"
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/internal/logging"
+	"clouditor.io/clouditor/v2/persistence"
+	"clouditor.io/clouditor/v2/service"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ErrTicketIntegrationNotFound indicates the ticket integration was not found
+var ErrTicketIntegrationNotFound = api.ErrorCodeTicketIntegrationNotFound.Err()
+
+// CreateTicketIntegration implements method for creating a new ticket integration
+func (svc *Service) CreateTicketIntegration(ctx context.Context, req *orchestrator.CreateTicketIntegrationRequest) (res *orchestrator.TicketIntegration, err error) {
+	// Check, if this request has access to the cloud service according to our authorization strategy.
+	if !svc.authz.CheckAccess(ctx, service.AccessCreate, req) {
+		return nil, service.ErrPermissionDenied
+	}
+
+	// Generate a new ID
+	req.Integration.Id = uuid.NewString()
+	req.Integration.CreatedAt = timestamppb.Now()
+
+	// Persist the new integration in our database
+	err = svc.storage.Create(req.Integration)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not add ticket integration to the database: %v", err)
+	}
+
+	logging.LogRequest(log, logrus.DebugLevel, logging.Create, req)
+
+	return withoutToken(req.Integration), nil
+}
+
+// GetTicketIntegration implements method for getting a ticket integration
+func (svc *Service) GetTicketIntegration(ctx context.Context, req *orchestrator.GetTicketIntegrationRequest) (res *orchestrator.TicketIntegration, err error) {
+	res, err = svc.getTicketIntegration(ctx, req.IntegrationId)
+	if err != nil {
+		return nil, err
+	}
+
+	return withoutToken(res), nil
+}
+
+// ListTicketIntegrations implements method for listing all ticket integrations the user is authorized to see
+func (svc *Service) ListTicketIntegrations(ctx context.Context, req *orchestrator.ListTicketIntegrationsRequest) (res *orchestrator.ListTicketIntegrationsResponse, err error) {
+	var (
+		query []string
+		args  []any
+	)
+
+	// Retrieve list of allowed cloud service according to our authorization strategy
+	all, allowed := svc.authz.AllowedCloudServices(ctx)
+
+	// The content of the filtered cloud service ID must be in the list of allowed cloud service IDs, unless one can
+	// access *all* the cloud services.
+	if !all && req.CloudServiceId != nil && !slices.Contains(allowed, req.GetCloudServiceId()) {
+		return nil, service.ErrPermissionDenied
+	}
+
+	if req.CloudServiceId != nil {
+		query = append(query, "cloud_service_id = ?")
+		args = append(args, req.GetCloudServiceId())
+	}
+
+	if !all {
+		query = append(query, "cloud_service_id IN ?")
+		args = append(args, allowed)
+	}
+
+	res = new(orchestrator.ListTicketIntegrationsResponse)
+
+	res.Integrations, res.NextPageToken, err = service.PaginateStorage[*orchestrator.TicketIntegration](req, svc.storage,
+		service.DefaultPaginationOpts, persistence.BuildConds(query, args)...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not paginate results: %v", err)
+	}
+
+	// Never expose the tokens
+	for i, integration := range res.Integrations {
+		res.Integrations[i] = withoutToken(integration)
+	}
+
+	return
+}
+
+// UpdateTicketIntegration implements method for updating an existing ticket integration. If the request does not
+// contain a token, the existing token is kept.
+func (svc *Service) UpdateTicketIntegration(ctx context.Context, req *orchestrator.UpdateTicketIntegrationRequest) (res *orchestrator.TicketIntegration, err error) {
+	var existing *orchestrator.TicketIntegration
+
+	if req.Integration.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "integration.id: value is empty, which is not a valid UUID")
+	}
+
+	// Check, if this request has access to the (new) cloud service according to our authorization strategy.
+	if !svc.authz.CheckAccess(ctx, service.AccessUpdate, req) {
+		return nil, service.ErrPermissionDenied
+	}
+
+	// Retrieve the existing integration, which also checks the access to its current cloud service
+	existing, err = svc.getTicketIntegration(ctx, req.Integration.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	res = req.Integration
+	res.CreatedAt = existing.CreatedAt
+	if res.Token == "" {
+		res.Token = existing.Token
+	}
+
+	err = svc.storage.Save(res, "id = ?", res.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
+	logging.LogRequest(log, logrus.DebugLevel, logging.Update, req)
+
+	return withoutToken(res), nil
+}
+
+// RemoveTicketIntegration implements method for removing a ticket integration and its tickets
+func (svc *Service) RemoveTicketIntegration(ctx context.Context, req *orchestrator.RemoveTicketIntegrationRequest) (res *emptypb.Empty, err error) {
+	// Check, if the integration exists and if we have access to it
+	_, err = svc.getTicketIntegration(ctx, req.IntegrationId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = svc.storage.Delete(&orchestrator.Ticket{}, "integration_id = ?", req.IntegrationId)
+	if err != nil && !errors.Is(err, persistence.ErrRecordNotFound) {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
+	err = svc.storage.Delete(&orchestrator.TicketIntegration{}, "id = ?", req.IntegrationId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
+	logging.LogRequest(log, logrus.DebugLevel, logging.Remove, req)
+
+	return &emptypb.Empty{}, nil
+}
+
+// ListTickets implements method for listing all tickets the user is authorized to see
+func (svc *Service) ListTickets(ctx context.Context, req *orchestrator.ListTicketsRequest) (res *orchestrator.ListTicketsResponse, err error) {
+	var (
+		query []string
+		args  []any
+	)
+
+	// Retrieve list of allowed cloud service according to our authorization strategy
+	all, allowed := svc.authz.AllowedCloudServices(ctx)
+
+	if req.Filter != nil {
+		// The content of the filtered cloud service ID must be in the list of allowed cloud service IDs, unless one can
+		// access *all* the cloud services.
+		if !all && req.Filter.CloudServiceId != nil && !slices.Contains(allowed, req.Filter.GetCloudServiceId()) {
+			return nil, service.ErrPermissionDenied
+		}
+
+		if req.Filter.CloudServiceId != nil {
+			query = append(query, "cloud_service_id = ?")
+			args = append(args, req.Filter.GetCloudServiceId())
+		}
+
+		if req.Filter.IntegrationId != nil {
+			query = append(query, "integration_id = ?")
+			args = append(args, req.Filter.GetIntegrationId())
+		}
+
+		if req.Filter.Status != nil {
+			query = append(query, "status = ?")
+			args = append(args, req.Filter.GetStatus())
+		}
+	}
+
+	if !all {
+		query = append(query, "cloud_service_id IN ?")
+		args = append(args, allowed)
+	}
+
+	res = new(orchestrator.ListTicketsResponse)
+
+	res.Tickets, res.NextPageToken, err = service.PaginateStorage[*orchestrator.Ticket](req, svc.storage,
+		service.DefaultPaginationOpts, persistence.BuildConds(query, args)...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not paginate results: %v", err)
+	}
+
+	return
+}
+
+// getTicketIntegration retrieves the ticket integration with the given ID from the storage and checks, whether the
+// user has access to its cloud service.
+func (svc *Service) getTicketIntegration(ctx context.Context, id string) (integration *orchestrator.TicketIntegration, err error) {
+	integration = new(orchestrator.TicketIntegration)
+
+	err = svc.storage.Get(integration, "id = ?", id)
+	if errors.Is(err, persistence.ErrRecordNotFound) {
+		return nil, ErrTicketIntegrationNotFound
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
+	all, allowed := svc.authz.AllowedCloudServices(ctx)
+	if !all && !slices.Contains(allowed, integration.CloudServiceId) {
+		return nil, service.ErrPermissionDenied
+	}
+
+	return integration, nil
+}
+
+// withoutToken returns a copy of the ticket integration without its token.
+func withoutToken(integration *orchestrator.TicketIntegration) *orchestrator.TicketIntegration {
+	integration = proto.Clone(integration).(*orchestrator.TicketIntegration)
+	integration.Token = ""
+
+	return integration
+}
+
+// handleTickets opens or resolves tickets of the enabled ticket integrations of the cloud service for a new
+// assessment result. A ticket is opened, if the resource is persistently non-compliant regarding the metric and there
+// is no open ticket yet. An open ticket is resolved, once the resource is compliant again.
+func (svc *Service) handleTickets(result *assessment.AssessmentResult) {
+	var integrations []*orchestrator.TicketIntegration
+
+	// We need to make sure that concurrent results do not lead to duplicate tickets
+	svc.ticketMutex.Lock()
+	defer svc.ticketMutex.Unlock()
+
+	err := svc.storage.List(&integrations, "", true, 0, -1, "cloud_service_id = ? AND enabled = ?", result.CloudServiceId, true)
+	if err != nil {
+		log.Errorf("Could not retrieve ticket integrations for cloud service %s: %v", result.CloudServiceId, err)
+		return
+	}
+
+	for _, integration := range integrations {
+		err = svc.handleTicket(integration, result)
+		if err != nil {
+			log.Errorf("Could not handle ticket of integration %s for resource %s: %v", integration.Id, result.ResourceId, err)
+		}
+	}
+}
+
+// handleTicket opens or resolves the ticket of a single ticket integration for the assessment result.
+func (svc *Service) handleTicket(integration *orchestrator.TicketIntegration, result *assessment.AssessmentResult) (err error) {
+	var (
+		open   []*orchestrator.Ticket
+		latest []*assessment.AssessmentResult
+		ticket *orchestrator.Ticket
+	)
+
+	system, err := newTicketSystem(svc.webhookClient, integration)
+	if err != nil {
+		return err
+	}
+
+	// Retrieve the open ticket for the resource and metric, if there is one
+	err = svc.storage.List(&open, "", true, 0, 1, "integration_id = ? AND resource_id = ? AND metric_id = ? AND status = ?",
+		integration.Id, result.ResourceId, result.MetricId, orchestrator.TicketStatus_TICKET_STATUS_OPEN)
+	if err != nil {
+		return err
+	}
+
+	if result.Compliant {
+		if len(open) == 0 {
+			return nil
+		}
+
+		ticket = open[0]
+
+		err = system.resolve(ticket)
+		if err != nil {
+			return err
+		}
+
+		ticket.Status = orchestrator.TicketStatus_TICKET_STATUS_RESOLVED
+		ticket.ResolvedAt = timestamppb.Now()
+
+		log.Infof("Resolved ticket %s for resource %s and metric %s", ticket.ExternalId, ticket.ResourceId, ticket.MetricId)
+
+		return svc.storage.Save(ticket, "id = ?", ticket.Id)
+	}
+
+	// Deduplicate tickets per resource and metric
+	if len(open) > 0 {
+		return nil
+	}
+
+	// Only open a ticket if the latest results of the resource regarding the metric are all non-compliant
+	err = svc.storage.List(&latest, "timestamp", false, 0, integration.ConsecutiveResults(),
+		"cloud_service_id = ? AND resource_id = ? AND metric_id = ? AND timestamp <= ?",
+		result.CloudServiceId, result.ResourceId, result.MetricId, result.Timestamp.AsTime())
+	if err != nil {
+		return err
+	}
+
+	if len(latest) < integration.ConsecutiveResults() || slices.ContainsFunc(latest, func(r *assessment.AssessmentResult) bool {
+		return r.Compliant
+	}) {
+		return nil
+	}
+
+	ticket = &orchestrator.Ticket{
+		Id:                 uuid.NewString(),
+		IntegrationId:      integration.Id,
+		CloudServiceId:     result.CloudServiceId,
+		ResourceId:         result.ResourceId,
+		MetricId:           result.MetricId,
+		Status:             orchestrator.TicketStatus_TICKET_STATUS_OPEN,
+		AssessmentResultId: result.Id,
+		CreatedAt:          timestamppb.Now(),
+	}
+
+	ticket.ExternalId, ticket.ExternalUrl, err = system.open(result)
+	if err != nil {
+		return err
+	}
+
+	log.Infof("Opened ticket %s for resource %s and metric %s", ticket.ExternalId, ticket.ResourceId, ticket.MetricId)
+
+	return svc.storage.Create(ticket)
+}
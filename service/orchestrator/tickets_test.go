@@ -0,0 +1,718 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package orchestrator
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"clouditor.io/clouditor/v2/api/assessment"
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/testutil/servicetest"
+	"clouditor.io/clouditor/v2/internal/util"
+	"clouditor.io/clouditor/v2/persistence"
+	"clouditor.io/clouditor/v2/service"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	mockTicketIntegrationID    = "99999999-9999-9999-9999-999999999999"
+	mockTicketIntegrationToken = "t0k3n"
+)
+
+func newMockTicketIntegration() *orchestrator.TicketIntegration {
+	return &orchestrator.TicketIntegration{
+		Id:             mockTicketIntegrationID,
+		CloudServiceId: testdata.MockCloudServiceID1,
+		Name:           "My Jira",
+		System:         orchestrator.TicketSystem_TICKET_SYSTEM_JIRA,
+		Url:            "https://example.atlassian.net",
+		Username:       "clouditor@example.com",
+		Token:          mockTicketIntegrationToken,
+		Project:        "SEC",
+		Enabled:        true,
+	}
+}
+
+func newMockTicket(status orchestrator.TicketStatus) *orchestrator.Ticket {
+	return &orchestrator.Ticket{
+		Id:             "00000000-0000-0000-0000-000000000020",
+		IntegrationId:  mockTicketIntegrationID,
+		CloudServiceId: testdata.MockCloudServiceID1,
+		ResourceId:     testdata.MockResourceID1,
+		MetricId:       testdata.MockMetricID1,
+		ExternalId:     "SEC-1",
+		Status:         status,
+		CreatedAt:      timestamppb.Now(),
+	}
+}
+
+// ticketSystemRecorder is a fake ticket system, which records the requests it receives.
+type ticketSystemRecorder struct {
+	mu     sync.Mutex
+	paths  []string
+	bodies []map[string]any
+}
+
+func (rec *ticketSystemRecorder) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var body map[string]any
+
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+
+	_ = json.NewDecoder(r.Body).Decode(&body)
+
+	rec.paths = append(rec.paths, r.Method+" "+r.URL.Path)
+	rec.bodies = append(rec.bodies, body)
+
+	switch r.URL.Path {
+	case "/rest/api/2/issue":
+		_, _ = w.Write([]byte(`{"id":"10000","key":"SEC-1"}`))
+	case "/api/now/table/incident":
+		_, _ = w.Write([]byte(`{"result":{"sys_id":"abc123","number":"INC0010001"}}`))
+	default:
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func TestService_CreateTicketIntegration(t *testing.T) {
+	type fields struct {
+		storage persistence.Storage
+		authz   service.AuthorizationStrategy
+	}
+	type args struct {
+		req *orchestrator.CreateTicketIntegrationRequest
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantRes assert.Want[*orchestrator.TicketIntegration]
+		wantSvc assert.Want[*Service]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "Validation error",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t),
+				authz:   servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &orchestrator.CreateTicketIntegrationRequest{
+					Integration: &orchestrator.TicketIntegration{
+						CloudServiceId: testdata.MockCloudServiceID1,
+						Name:           "My Jira",
+						System:         orchestrator.TicketSystem_TICKET_SYSTEM_JIRA,
+						Url:            "https://example.atlassian.net",
+					},
+				},
+			},
+			wantRes: assert.Nil[*orchestrator.TicketIntegration],
+			wantSvc: assert.AnyValue[*Service],
+			wantErr: func(t *testing.T, err error) bool {
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+				return assert.ErrorContains(t, err, "integration.project")
+			},
+		},
+		{
+			name: "Permission denied",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t),
+				authz:   servicetest.NewAuthorizationStrategy(false, testdata.MockCloudServiceID2),
+			},
+			args: args{
+				req: &orchestrator.CreateTicketIntegrationRequest{
+					Integration: newMockTicketIntegration(),
+				},
+			},
+			wantRes: assert.Nil[*orchestrator.TicketIntegration],
+			wantSvc: assert.AnyValue[*Service],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, service.ErrPermissionDenied)
+			},
+		},
+		{
+			name: "Happy path",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t),
+				authz:   servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &orchestrator.CreateTicketIntegrationRequest{
+					Integration: func() *orchestrator.TicketIntegration {
+						integration := newMockTicketIntegration()
+						integration.Id = ""
+						integration.MetricProjects = map[string]string{testdata.MockMetricID1: "OPS"}
+						return integration
+					}(),
+				},
+			},
+			wantRes: func(t *testing.T, got *orchestrator.TicketIntegration) bool {
+				assert.NotEmpty(t, got.Id)
+				assert.NotNil(t, got.CreatedAt)
+				return assert.Empty(t, got.Token)
+			},
+			wantSvc: func(t *testing.T, got *Service) bool {
+				var integrations []*orchestrator.TicketIntegration
+				err := got.storage.List(&integrations, "", true, 0, -1)
+				assert.NoError(t, err)
+				if !assert.Equal(t, 1, len(integrations)) {
+					return false
+				}
+
+				assert.Equal(t, map[string]string{testdata.MockMetricID1: "OPS"}, integrations[0].MetricProjects)
+				return assert.Equal(t, mockTicketIntegrationToken, integrations[0].Token)
+			},
+			wantErr: assert.Nil[error],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				storage: tt.fields.storage,
+				authz:   tt.fields.authz,
+			}
+
+			gotRes, err := servicetest.Validated(svc.CreateTicketIntegration)(context.Background(), tt.args.req)
+			tt.wantErr(t, err)
+			tt.wantRes(t, gotRes)
+			tt.wantSvc(t, svc)
+		})
+	}
+}
+
+func TestService_GetTicketIntegration(t *testing.T) {
+	type fields struct {
+		storage persistence.Storage
+		authz   service.AuthorizationStrategy
+	}
+	type args struct {
+		req *orchestrator.GetTicketIntegrationRequest
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantRes assert.Want[*orchestrator.TicketIntegration]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "Not found",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t),
+				authz:   servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &orchestrator.GetTicketIntegrationRequest{IntegrationId: mockTicketIntegrationID},
+			},
+			wantRes: assert.Nil[*orchestrator.TicketIntegration],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, ErrTicketIntegrationNotFound)
+			},
+		},
+		{
+			name: "Permission denied",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					assert.NoError(t, s.Create(newMockTicketIntegration()))
+				}),
+				authz: servicetest.NewAuthorizationStrategy(false, testdata.MockCloudServiceID2),
+			},
+			args: args{
+				req: &orchestrator.GetTicketIntegrationRequest{IntegrationId: mockTicketIntegrationID},
+			},
+			wantRes: assert.Nil[*orchestrator.TicketIntegration],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, service.ErrPermissionDenied)
+			},
+		},
+		{
+			name: "Happy path",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					assert.NoError(t, s.Create(newMockTicketIntegration()))
+				}),
+				authz: servicetest.NewAuthorizationStrategy(false, testdata.MockCloudServiceID1),
+			},
+			args: args{
+				req: &orchestrator.GetTicketIntegrationRequest{IntegrationId: mockTicketIntegrationID},
+			},
+			wantRes: func(t *testing.T, got *orchestrator.TicketIntegration) bool {
+				assert.Equal(t, "SEC", got.Project)
+				return assert.Empty(t, got.Token)
+			},
+			wantErr: assert.Nil[error],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				storage: tt.fields.storage,
+				authz:   tt.fields.authz,
+			}
+
+			gotRes, err := svc.GetTicketIntegration(context.Background(), tt.args.req)
+			tt.wantErr(t, err)
+			tt.wantRes(t, gotRes)
+		})
+	}
+}
+
+func TestService_ListTicketIntegrations(t *testing.T) {
+	svc := &Service{
+		storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+			assert.NoError(t, s.Create(newMockTicketIntegration()))
+
+			other := newMockTicketIntegration()
+			other.Id = "00000000-0000-0000-0000-000000000099"
+			other.CloudServiceId = testdata.MockCloudServiceID2
+			assert.NoError(t, s.Create(other))
+		}),
+		authz: servicetest.NewAuthorizationStrategy(false, testdata.MockCloudServiceID1),
+	}
+
+	_, err := svc.ListTicketIntegrations(context.Background(), &orchestrator.ListTicketIntegrationsRequest{
+		CloudServiceId: util.Ref(testdata.MockCloudServiceID2),
+	})
+	assert.ErrorIs(t, err, service.ErrPermissionDenied)
+
+	res, err := svc.ListTicketIntegrations(context.Background(), &orchestrator.ListTicketIntegrationsRequest{})
+	assert.NoError(t, err)
+	if assert.Equal(t, 1, len(res.Integrations)) {
+		assert.Equal(t, mockTicketIntegrationID, res.Integrations[0].Id)
+		assert.Empty(t, res.Integrations[0].Token)
+	}
+}
+
+func TestService_UpdateTicketIntegration(t *testing.T) {
+	type fields struct {
+		storage persistence.Storage
+		authz   service.AuthorizationStrategy
+	}
+	type args struct {
+		req *orchestrator.UpdateTicketIntegrationRequest
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantRes assert.Want[*orchestrator.TicketIntegration]
+		wantSvc assert.Want[*Service]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "Missing ID",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t),
+				authz:   servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &orchestrator.UpdateTicketIntegrationRequest{
+					Integration: func() *orchestrator.TicketIntegration {
+						integration := newMockTicketIntegration()
+						integration.Id = ""
+						return integration
+					}(),
+				},
+			},
+			wantRes: assert.Nil[*orchestrator.TicketIntegration],
+			wantSvc: assert.AnyValue[*Service],
+			wantErr: func(t *testing.T, err error) bool {
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+				return assert.ErrorContains(t, err, "integration.id")
+			},
+		},
+		{
+			name: "Not found",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t),
+				authz:   servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &orchestrator.UpdateTicketIntegrationRequest{Integration: newMockTicketIntegration()},
+			},
+			wantRes: assert.Nil[*orchestrator.TicketIntegration],
+			wantSvc: assert.AnyValue[*Service],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, ErrTicketIntegrationNotFound)
+			},
+		},
+		{
+			name: "Happy path: keep token",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					assert.NoError(t, s.Create(newMockTicketIntegration()))
+				}),
+				authz: servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &orchestrator.UpdateTicketIntegrationRequest{
+					Integration: func() *orchestrator.TicketIntegration {
+						integration := newMockTicketIntegration()
+						integration.Token = ""
+						integration.Project = "OPS"
+						return integration
+					}(),
+				},
+			},
+			wantRes: func(t *testing.T, got *orchestrator.TicketIntegration) bool {
+				assert.Equal(t, "OPS", got.Project)
+				return assert.Empty(t, got.Token)
+			},
+			wantSvc: func(t *testing.T, got *Service) bool {
+				integration := new(orchestrator.TicketIntegration)
+				err := got.storage.Get(integration, "id = ?", mockTicketIntegrationID)
+				assert.NoError(t, err)
+				return assert.Equal(t, mockTicketIntegrationToken, integration.Token)
+			},
+			wantErr: assert.Nil[error],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				storage: tt.fields.storage,
+				authz:   tt.fields.authz,
+			}
+
+			gotRes, err := svc.UpdateTicketIntegration(context.Background(), tt.args.req)
+			tt.wantErr(t, err)
+			tt.wantRes(t, gotRes)
+			tt.wantSvc(t, svc)
+		})
+	}
+}
+
+func TestService_RemoveTicketIntegration(t *testing.T) {
+	type fields struct {
+		storage persistence.Storage
+		authz   service.AuthorizationStrategy
+	}
+	type args struct {
+		req *orchestrator.RemoveTicketIntegrationRequest
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantSvc assert.Want[*Service]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "Permission denied",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					assert.NoError(t, s.Create(newMockTicketIntegration()))
+				}),
+				authz: servicetest.NewAuthorizationStrategy(false, testdata.MockCloudServiceID2),
+			},
+			args: args{
+				req: &orchestrator.RemoveTicketIntegrationRequest{IntegrationId: mockTicketIntegrationID},
+			},
+			wantSvc: assert.AnyValue[*Service],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, service.ErrPermissionDenied)
+			},
+		},
+		{
+			name: "Happy path without tickets",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					assert.NoError(t, s.Create(newMockTicketIntegration()))
+				}),
+				authz: servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &orchestrator.RemoveTicketIntegrationRequest{IntegrationId: mockTicketIntegrationID},
+			},
+			wantSvc: func(t *testing.T, got *Service) bool {
+				var integrations []*orchestrator.TicketIntegration
+				err := got.storage.List(&integrations, "", true, 0, -1)
+				assert.NoError(t, err)
+				return assert.Equal(t, 0, len(integrations))
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "Happy path with tickets",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					assert.NoError(t, s.Create(newMockTicketIntegration()))
+					assert.NoError(t, s.Create(newMockTicket(orchestrator.TicketStatus_TICKET_STATUS_OPEN)))
+				}),
+				authz: servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &orchestrator.RemoveTicketIntegrationRequest{IntegrationId: mockTicketIntegrationID},
+			},
+			wantSvc: func(t *testing.T, got *Service) bool {
+				var tickets []*orchestrator.Ticket
+				err := got.storage.List(&tickets, "", true, 0, -1)
+				assert.NoError(t, err)
+				return assert.Equal(t, 0, len(tickets))
+			},
+			wantErr: assert.Nil[error],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				storage: tt.fields.storage,
+				authz:   tt.fields.authz,
+			}
+
+			_, err := svc.RemoveTicketIntegration(context.Background(), tt.args.req)
+			tt.wantErr(t, err)
+			tt.wantSvc(t, svc)
+		})
+	}
+}
+
+func TestService_ListTickets(t *testing.T) {
+	type args struct {
+		req *orchestrator.ListTicketsRequest
+	}
+	tests := []struct {
+		name    string
+		authz   service.AuthorizationStrategy
+		args    args
+		wantRes assert.Want[*orchestrator.ListTicketsResponse]
+		wantErr assert.WantErr
+	}{
+		{
+			name:  "Permission denied for filtered cloud service",
+			authz: servicetest.NewAuthorizationStrategy(false, testdata.MockCloudServiceID2),
+			args: args{
+				req: &orchestrator.ListTicketsRequest{
+					Filter: &orchestrator.ListTicketsRequest_Filter{CloudServiceId: util.Ref(testdata.MockCloudServiceID1)},
+				},
+			},
+			wantRes: assert.Nil[*orchestrator.ListTicketsResponse],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, service.ErrPermissionDenied)
+			},
+		},
+		{
+			name:  "Filter by status",
+			authz: servicetest.NewAuthorizationStrategy(true),
+			args: args{
+				req: &orchestrator.ListTicketsRequest{
+					Filter: &orchestrator.ListTicketsRequest_Filter{
+						IntegrationId: util.Ref(mockTicketIntegrationID),
+						Status:        util.Ref(orchestrator.TicketStatus_TICKET_STATUS_RESOLVED),
+					},
+				},
+			},
+			wantRes: func(t *testing.T, got *orchestrator.ListTicketsResponse) bool {
+				if !assert.Equal(t, 1, len(got.Tickets)) {
+					return false
+				}
+
+				return assert.Equal(t, "SEC-2", got.Tickets[0].ExternalId)
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name:  "Without filter",
+			authz: servicetest.NewAuthorizationStrategy(true),
+			args:  args{req: &orchestrator.ListTicketsRequest{}},
+			wantRes: func(t *testing.T, got *orchestrator.ListTicketsResponse) bool {
+				return assert.Equal(t, 2, len(got.Tickets))
+			},
+			wantErr: assert.Nil[error],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					assert.NoError(t, s.Create(newMockTicket(orchestrator.TicketStatus_TICKET_STATUS_OPEN)))
+
+					resolved := newMockTicket(orchestrator.TicketStatus_TICKET_STATUS_RESOLVED)
+					resolved.Id = "00000000-0000-0000-0000-000000000021"
+					resolved.ExternalId = "SEC-2"
+					assert.NoError(t, s.Create(resolved))
+				}),
+				authz: tt.authz,
+			}
+
+			gotRes, err := svc.ListTickets(context.Background(), tt.args.req)
+			tt.wantErr(t, err)
+			tt.wantRes(t, gotRes)
+		})
+	}
+}
+
+func TestService_handleTickets(t *testing.T) {
+	type args struct {
+		minConsecutive uint32
+		ticket         *orchestrator.Ticket
+		previous       []*assessment.AssessmentResult
+		result         *assessment.AssessmentResult
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantRec assert.Want[*ticketSystemRecorder]
+		wantSvc assert.Want[*Service]
+	}{
+		{
+			name: "Compliant without open ticket",
+			args: args{
+				result: newMockResult("00000000-0000-0000-0000-000000000011", true, 2),
+			},
+			wantRec: func(t *testing.T, got *ticketSystemRecorder) bool {
+				return assert.Equal(t, 0, len(got.paths))
+			},
+			wantSvc: assert.AnyValue[*Service],
+		},
+		{
+			name: "Non-compliant opens ticket",
+			args: args{
+				result: newMockResult("00000000-0000-0000-0000-000000000011", false, 2),
+			},
+			wantRec: func(t *testing.T, got *ticketSystemRecorder) bool {
+				if !assert.Equal(t, []string{"POST /rest/api/2/issue"}, got.paths) {
+					return false
+				}
+
+				fields := got.bodies[0]["fields"].(map[string]any)
+				return assert.Equal[any](t, map[string]any{"key": "SEC"}, fields["project"])
+			},
+			wantSvc: func(t *testing.T, got *Service) bool {
+				var tickets []*orchestrator.Ticket
+				err := got.storage.List(&tickets, "", true, 0, -1)
+				assert.NoError(t, err)
+				if !assert.Equal(t, 1, len(tickets)) {
+					return false
+				}
+
+				assert.Equal(t, "SEC-1", tickets[0].ExternalId)
+				assert.Equal(t, orchestrator.TicketStatus_TICKET_STATUS_OPEN, tickets[0].Status)
+				return assert.Equal(t, "00000000-0000-0000-0000-000000000011", tickets[0].AssessmentResultId)
+			},
+		},
+		{
+			name: "Non-compliant, but not persistent yet",
+			args: args{
+				minConsecutive: 2,
+				previous: []*assessment.AssessmentResult{
+					newMockResult("00000000-0000-0000-0000-000000000010", true, 1),
+				},
+				result: newMockResult("00000000-0000-0000-0000-000000000011", false, 2),
+			},
+			wantRec: func(t *testing.T, got *ticketSystemRecorder) bool {
+				return assert.Equal(t, 0, len(got.paths))
+			},
+			wantSvc: assert.AnyValue[*Service],
+		},
+		{
+			name: "Persistent non-compliant",
+			args: args{
+				minConsecutive: 2,
+				previous: []*assessment.AssessmentResult{
+					newMockResult("00000000-0000-0000-0000-000000000010", false, 1),
+				},
+				result: newMockResult("00000000-0000-0000-0000-000000000011", false, 2),
+			},
+			wantRec: func(t *testing.T, got *ticketSystemRecorder) bool {
+				return assert.Equal(t, []string{"POST /rest/api/2/issue"}, got.paths)
+			},
+			wantSvc: assert.AnyValue[*Service],
+		},
+		{
+			name: "Deduplicate",
+			args: args{
+				ticket: newMockTicket(orchestrator.TicketStatus_TICKET_STATUS_OPEN),
+				result: newMockResult("00000000-0000-0000-0000-000000000011", false, 2),
+			},
+			wantRec: func(t *testing.T, got *ticketSystemRecorder) bool {
+				return assert.Equal(t, 0, len(got.paths))
+			},
+			wantSvc: assert.AnyValue[*Service],
+		},
+		{
+			name: "Compliant resolves ticket",
+			args: args{
+				ticket: newMockTicket(orchestrator.TicketStatus_TICKET_STATUS_OPEN),
+				result: newMockResult("00000000-0000-0000-0000-000000000011", true, 2),
+			},
+			wantRec: func(t *testing.T, got *ticketSystemRecorder) bool {
+				if !assert.Equal(t, []string{"POST /rest/api/2/issue/SEC-1/transitions"}, got.paths) {
+					return false
+				}
+
+				return assert.Equal[any](t, map[string]any{"id": DefaultJiraResolveTransition}, got.bodies[0]["transition"])
+			},
+			wantSvc: func(t *testing.T, got *Service) bool {
+				ticket := new(orchestrator.Ticket)
+				err := got.storage.Get(ticket, "id = ?", "00000000-0000-0000-0000-000000000020")
+				assert.NoError(t, err)
+				assert.NotNil(t, ticket.ResolvedAt)
+				return assert.Equal(t, orchestrator.TicketStatus_TICKET_STATUS_RESOLVED, ticket.Status)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := &ticketSystemRecorder{}
+			srv := httptest.NewServer(rec)
+			defer srv.Close()
+
+			svc := &Service{
+				storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					integration := newMockTicketIntegration()
+					integration.Url = srv.URL
+					integration.MinConsecutiveResults = tt.args.minConsecutive
+					assert.NoError(t, s.Create(integration))
+
+					if tt.args.ticket != nil {
+						assert.NoError(t, s.Create(tt.args.ticket))
+					}
+
+					for _, r := range tt.args.previous {
+						assert.NoError(t, s.Create(r))
+					}
+					assert.NoError(t, s.Create(tt.args.result))
+				}),
+			}
+
+			svc.handleTickets(tt.args.result)
+			tt.wantRec(t, rec)
+			tt.wantSvc(t, svc)
+		})
+	}
+}
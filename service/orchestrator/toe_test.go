@@ -31,6 +31,7 @@ import (
 	"runtime"
 	"sync"
 	"testing"
+	"time"
 
 	"clouditor.io/clouditor/v2/api"
 	"clouditor.io/clouditor/v2/api/assessment"
@@ -46,6 +47,7 @@ import (
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func TestService_CreateTargetOfEvaluation(t *testing.T) {
@@ -88,6 +90,25 @@ func TestService_CreateTargetOfEvaluation(t *testing.T) {
 			},
 			want: assert.Nil[*orchestrator.TargetOfEvaluation],
 		},
+		{
+			name: "Invalid scope",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{req: &orchestrator.CreateTargetOfEvaluationRequest{
+				TargetOfEvaluation: &orchestrator.TargetOfEvaluation{
+					CloudServiceId: testdata.MockCloudServiceID1,
+					CatalogId:      testdata.MockCatalogID,
+					ScopeStart:     timestamppb.New(time.Unix(2, 0)),
+					ScopeEnd:       timestamppb.New(time.Unix(1, 0)),
+				},
+			}},
+			want: assert.Nil[*orchestrator.TargetOfEvaluation],
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+				return assert.ErrorContains(t, err, orchestrator.ErrInvalidScope.Error())
+			},
+		},
 		{
 			name: "Error getting catalog",
 			fields: fields{
@@ -180,6 +201,41 @@ func TestService_CreateTargetOfEvaluation(t *testing.T) {
 			want:    assert.AnyValue[*orchestrator.TargetOfEvaluation],
 			wantErr: assert.NoError,
 		},
+		{
+			name: "valid with audit scope",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					err := s.Create(&orchestrator.CloudService{Id: testdata.MockCloudServiceID1})
+					assert.NoError(t, err)
+
+					err = s.Create(orchestratortest.NewCatalog())
+					assert.NoError(t, err)
+				}),
+				authz: servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{req: &orchestrator.CreateTargetOfEvaluationRequest{
+				TargetOfEvaluation: &orchestrator.TargetOfEvaluation{
+					CloudServiceId: testdata.MockCloudServiceID1,
+					CatalogId:      testdata.MockCatalogID,
+					AssuranceLevel: &testdata.AssuranceLevelBasic,
+					ResourceTypes:  []string{"VirtualMachine"},
+					ScopeStart:     timestamppb.New(time.Unix(1, 0)),
+					ScopeEnd:       timestamppb.New(time.Unix(2, 0)),
+				},
+			}},
+			wantSvc: func(t *testing.T, got *Service) bool {
+				var toe orchestrator.TargetOfEvaluation
+				err := got.storage.Get(&toe, gorm.WithoutPreload(), "cloud_service_id = ?", testdata.MockCloudServiceID1)
+				if !assert.NoError(t, err) {
+					return false
+				}
+
+				assert.Equal(t, []string{"VirtualMachine"}, toe.ResourceTypes)
+				return assert.Equal(t, time.Unix(2, 0).UTC(), toe.ScopeEnd.AsTime())
+			},
+			want:    assert.AnyValue[*orchestrator.TargetOfEvaluation],
+			wantErr: assert.NoError,
+		},
 	}
 
 	for _, tt := range tests {
@@ -196,9 +252,13 @@ func TestService_CreateTargetOfEvaluation(t *testing.T) {
 				authz:                 tt.fields.authz,
 			}
 
-			gotRes, err := svc.CreateTargetOfEvaluation(tt.args.ctx, tt.args.req)
+			gotRes, err := servicetest.Validated(svc.CreateTargetOfEvaluation)(tt.args.ctx, tt.args.req)
 			tt.wantErr(t, err)
 			tt.want(t, gotRes)
+
+			if tt.wantSvc != nil {
+				tt.wantSvc(t, svc)
+			}
 		})
 	}
 }
@@ -306,7 +366,7 @@ func TestService_GetTargetOfEvaluation(t *testing.T) {
 				storage: tt.fields.storage,
 				authz:   tt.fields.authz,
 			}
-			res, err := orchestratorService.GetTargetOfEvaluation(context.Background(), tt.args.req)
+			res, err := servicetest.Validated(orchestratorService.GetTargetOfEvaluation)(context.Background(), tt.args.req)
 			tt.wantErr(t, err)
 			tt.wantResponse(t, res)
 		})
@@ -355,20 +415,20 @@ func TestService_UpdateTargetOfEvaluation(t *testing.T) {
 	assert.NoError(t, err)
 
 	// 1st case: ToE is nil
-	_, err = orchestratorService.UpdateTargetOfEvaluation(context.Background(), &orchestrator.UpdateTargetOfEvaluationRequest{
+	_, err = servicetest.Validated(orchestratorService.UpdateTargetOfEvaluation)(context.Background(), &orchestrator.UpdateTargetOfEvaluationRequest{
 		TargetOfEvaluation: nil,
 	})
 	assert.Equal(t, codes.InvalidArgument, status.Code(err))
 
 	// 2nd case: Ids are empty
-	_, err = orchestratorService.UpdateTargetOfEvaluation(context.Background(), &orchestrator.UpdateTargetOfEvaluationRequest{
+	_, err = servicetest.Validated(orchestratorService.UpdateTargetOfEvaluation)(context.Background(), &orchestrator.UpdateTargetOfEvaluationRequest{
 		TargetOfEvaluation: &orchestrator.TargetOfEvaluation{},
 	})
 	assert.Equal(t, codes.InvalidArgument, status.Code(err))
 	assert.ErrorContains(t, err, "target_of_evaluation.cloud_service_id: value is empty, which is not a valid UUID")
 
 	// 3rd case: ToE not found since there are no ToEs
-	_, err = orchestratorService.UpdateTargetOfEvaluation(context.Background(), &orchestrator.UpdateTargetOfEvaluationRequest{
+	_, err = servicetest.Validated(orchestratorService.UpdateTargetOfEvaluation)(context.Background(), &orchestrator.UpdateTargetOfEvaluationRequest{
 		TargetOfEvaluation: orchestratortest.NewTargetOfEvaluation(testdata.AssuranceLevelBasic),
 	})
 	assert.Equal(t, codes.NotFound, status.Code(err))
@@ -378,7 +438,7 @@ func TestService_UpdateTargetOfEvaluation(t *testing.T) {
 	assert.NoError(t, err)
 
 	// update the toe's assurance level and send the update request
-	toe, err = orchestratorService.UpdateTargetOfEvaluation(context.Background(), &orchestrator.UpdateTargetOfEvaluationRequest{
+	toe, err = servicetest.Validated(orchestratorService.UpdateTargetOfEvaluation)(context.Background(), &orchestrator.UpdateTargetOfEvaluationRequest{
 		TargetOfEvaluation: &orchestrator.TargetOfEvaluation{
 			CloudServiceId: testdata.MockCloudServiceID1,
 			CatalogId:      testdata.MockCatalogID,
@@ -389,6 +449,17 @@ func TestService_UpdateTargetOfEvaluation(t *testing.T) {
 	assert.NotNil(t, toe)
 	assert.NoError(t, api.Validate(toe))
 	assert.Equal(t, &testdata.AssuranceLevelBasic, toe.AssuranceLevel)
+
+	// 5th case: Invalid audit scope
+	_, err = servicetest.Validated(orchestratorService.UpdateTargetOfEvaluation)(context.Background(), &orchestrator.UpdateTargetOfEvaluationRequest{
+		TargetOfEvaluation: &orchestrator.TargetOfEvaluation{
+			CloudServiceId: testdata.MockCloudServiceID1,
+			CatalogId:      testdata.MockCatalogID,
+			ScopeStart:     timestamppb.Now(),
+			ScopeEnd:       timestamppb.New(time.Unix(0, 0)),
+		},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
 }
 
 func TestService_RemoveTargetOfEvaluation(t *testing.T) {
@@ -403,7 +474,7 @@ func TestService_RemoveTargetOfEvaluation(t *testing.T) {
 	assert.NoError(t, err)
 
 	// 1st case: Empty ID error
-	_, err = orchestratorService.RemoveTargetOfEvaluation(context.Background(), &orchestrator.RemoveTargetOfEvaluationRequest{
+	_, err = servicetest.Validated(orchestratorService.RemoveTargetOfEvaluation)(context.Background(), &orchestrator.RemoveTargetOfEvaluationRequest{
 		CloudServiceId: "",
 		CatalogId:      "",
 	})
@@ -411,7 +482,7 @@ func TestService_RemoveTargetOfEvaluation(t *testing.T) {
 	assert.Equal(t, status.Code(err), codes.InvalidArgument)
 
 	// 2nd case: ErrRecordNotFound
-	_, err = orchestratorService.RemoveTargetOfEvaluation(context.Background(), &orchestrator.RemoveTargetOfEvaluationRequest{
+	_, err = servicetest.Validated(orchestratorService.RemoveTargetOfEvaluation)(context.Background(), &orchestrator.RemoveTargetOfEvaluationRequest{
 		CloudServiceId: testdata.MockCloudServiceID1,
 		CatalogId:      "0000",
 	})
@@ -423,21 +494,21 @@ func TestService_RemoveTargetOfEvaluation(t *testing.T) {
 	assert.NoError(t, err)
 
 	// Verify that there is a record for ToE in the DB
-	listTargetsOfEvaluationResponse, err = orchestratorService.ListTargetsOfEvaluation(context.Background(), &orchestrator.ListTargetsOfEvaluationRequest{})
+	listTargetsOfEvaluationResponse, err = servicetest.Validated(orchestratorService.ListTargetsOfEvaluation)(context.Background(), &orchestrator.ListTargetsOfEvaluationRequest{})
 	assert.NoError(t, err)
 	assert.NotNil(t, listTargetsOfEvaluationResponse.TargetOfEvaluation)
 	assert.NoError(t, api.Validate(listTargetsOfEvaluationResponse.TargetOfEvaluation[0]))
 	assert.Equal(t, 1, len(listTargetsOfEvaluationResponse.TargetOfEvaluation))
 
 	// Remove record
-	_, err = orchestratorService.RemoveTargetOfEvaluation(context.Background(), &orchestrator.RemoveTargetOfEvaluationRequest{
+	_, err = servicetest.Validated(orchestratorService.RemoveTargetOfEvaluation)(context.Background(), &orchestrator.RemoveTargetOfEvaluationRequest{
 		CloudServiceId: testdata.MockCloudServiceID1,
 		CatalogId:      testdata.MockCatalogID,
 	})
 	assert.NoError(t, err)
 
 	// There is no record for ToE in the DB
-	listTargetsOfEvaluationResponse, err = orchestratorService.ListTargetsOfEvaluation(context.Background(), &orchestrator.ListTargetsOfEvaluationRequest{})
+	listTargetsOfEvaluationResponse, err = servicetest.Validated(orchestratorService.ListTargetsOfEvaluation)(context.Background(), &orchestrator.ListTargetsOfEvaluationRequest{})
 	assert.NoError(t, err)
 	assert.Equal(t, 0, len(listTargetsOfEvaluationResponse.TargetOfEvaluation))
 }
@@ -0,0 +1,194 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"clouditor.io/clouditor/v2/api"
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/internal/logging"
+	"clouditor.io/clouditor/v2/persistence"
+	"clouditor.io/clouditor/v2/service"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ErrWaiverNotFound indicates the waiver was not found
+var ErrWaiverNotFound = api.ErrorCodeWaiverNotFound.Err()
+
+// CreateWaiver implements method for creating a new waiver
+func (svc *Service) CreateWaiver(ctx context.Context, req *orchestrator.CreateWaiverRequest) (res *orchestrator.Waiver, err error) {
+	if !req.Waiver.IsActive(time.Now()) {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", orchestrator.ErrWaiverExpired)
+	}
+
+	// Check, if this request has access to the cloud service according to our authorization strategy and if the user
+	// is allowed to accept risks.
+	if !svc.authz.CheckAccess(ctx, service.AccessCreate, req) || !svc.hasWaiverRole(ctx) {
+		return nil, service.ErrPermissionDenied
+	}
+
+	// Generate a new ID
+	req.Waiver.Id = uuid.NewString()
+	req.Waiver.CreatedAt = timestamppb.Now()
+
+	// Persist the new waiver in our database
+	err = svc.storage.Create(req.Waiver)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not add waiver to the database: %v", err)
+	}
+
+	logging.LogRequest(log, logrus.DebugLevel, logging.Create, req)
+
+	return req.Waiver, nil
+}
+
+// GetWaiver implements method for getting a waiver
+func (svc *Service) GetWaiver(ctx context.Context, req *orchestrator.GetWaiverRequest) (res *orchestrator.Waiver, err error) {
+	return svc.getWaiver(ctx, req.WaiverId)
+}
+
+// ListWaivers implements method for listing all waivers the user is authorized to see
+func (svc *Service) ListWaivers(ctx context.Context, req *orchestrator.ListWaiversRequest) (res *orchestrator.ListWaiversResponse, err error) {
+	var (
+		query []string
+		args  []any
+	)
+
+	// Retrieve list of allowed cloud service according to our authorization strategy
+	all, allowed := svc.authz.AllowedCloudServices(ctx)
+
+	if req.Filter != nil {
+		// The content of the filtered cloud service ID must be in the list of allowed cloud service IDs, unless one can
+		// access *all* the cloud services.
+		if !all && req.Filter.CloudServiceId != nil && !slices.Contains(allowed, req.Filter.GetCloudServiceId()) {
+			return nil, service.ErrPermissionDenied
+		}
+
+		if req.Filter.CloudServiceId != nil {
+			query = append(query, "cloud_service_id = ?")
+			args = append(args, req.Filter.GetCloudServiceId())
+		}
+
+		if req.Filter.ResourceId != nil {
+			query = append(query, "resource_id = ?")
+			args = append(args, req.Filter.GetResourceId())
+		}
+
+		if req.Filter.MetricId != nil {
+			query = append(query, "metric_id = ?")
+			args = append(args, req.Filter.GetMetricId())
+		}
+
+		if req.Filter.Active != nil {
+			if req.Filter.GetActive() {
+				query = append(query, "expires_at > ?")
+			} else {
+				query = append(query, "expires_at <= ?")
+			}
+			args = append(args, time.Now())
+		}
+	}
+
+	if !all {
+		query = append(query, "cloud_service_id IN ?")
+		args = append(args, allowed)
+	}
+
+	res = new(orchestrator.ListWaiversResponse)
+
+	res.Waivers, res.NextPageToken, err = service.PaginateStorage[*orchestrator.Waiver](req, svc.storage,
+		service.DefaultPaginationOpts, persistence.BuildConds(query, args)...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not paginate results: %v", err)
+	}
+
+	return
+}
+
+// RemoveWaiver implements method for removing (revoking) a waiver
+func (svc *Service) RemoveWaiver(ctx context.Context, req *orchestrator.RemoveWaiverRequest) (res *emptypb.Empty, err error) {
+	// Check, if the waiver exists and if we have access to it
+	_, err = svc.getWaiver(ctx, req.WaiverId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !svc.hasWaiverRole(ctx) {
+		return nil, service.ErrPermissionDenied
+	}
+
+	err = svc.storage.Delete(&orchestrator.Waiver{}, "id = ?", req.WaiverId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
+	logging.LogRequest(log, logrus.DebugLevel, logging.Remove, req)
+
+	return &emptypb.Empty{}, nil
+}
+
+// getWaiver retrieves the waiver with the given ID from the storage and checks, whether the user has access to its
+// cloud service.
+func (svc *Service) getWaiver(ctx context.Context, id string) (waiver *orchestrator.Waiver, err error) {
+	waiver = new(orchestrator.Waiver)
+
+	err = svc.storage.Get(waiver, "id = ?", id)
+	if errors.Is(err, persistence.ErrRecordNotFound) {
+		return nil, ErrWaiverNotFound
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
+	all, allowed := svc.authz.AllowedCloudServices(ctx)
+	if !all && !slices.Contains(allowed, waiver.CloudServiceId) {
+		return nil, service.ErrPermissionDenied
+	}
+
+	return waiver, nil
+}
+
+// hasWaiverRole checks, whether the current user is allowed to create or remove waivers. If no waiver role is
+// configured, every user with access to the cloud service is allowed to. Otherwise, the authorization strategy needs
+// to implement [service.RoleChecker] and the user needs to have the role.
+func (svc *Service) hasWaiverRole(ctx context.Context) bool {
+	if svc.waiverRole == "" {
+		return true
+	}
+
+	checker, ok := svc.authz.(service.RoleChecker)
+
+	return ok && checker.HasRole(ctx, svc.waiverRole)
+}
@@ -0,0 +1,347 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package orchestrator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/testutil/servicetest"
+	"clouditor.io/clouditor/v2/internal/util"
+	"clouditor.io/clouditor/v2/persistence"
+	"clouditor.io/clouditor/v2/service"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	mockWaiverID   = "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa"
+	mockWaiverRole = "risk-manager"
+)
+
+func newMockWaiver(expiresAt time.Time) *orchestrator.Waiver {
+	return &orchestrator.Waiver{
+		Id:             mockWaiverID,
+		CloudServiceId: testdata.MockCloudServiceID1,
+		ResourceId:     testdata.MockResourceID1,
+		MetricId:       testdata.MockMetricID1,
+		Justification:  "Legacy system, will be decommissioned",
+		Approver:       "CISO",
+		ExpiresAt:      timestamppb.New(expiresAt),
+	}
+}
+
+func TestService_CreateWaiver(t *testing.T) {
+	type fields struct {
+		storage    persistence.Storage
+		authz      service.AuthorizationStrategy
+		waiverRole string
+	}
+	type args struct {
+		req *orchestrator.CreateWaiverRequest
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantRes assert.Want[*orchestrator.Waiver]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "Validation error",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t),
+				authz:   servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &orchestrator.CreateWaiverRequest{
+					Waiver: func() *orchestrator.Waiver {
+						waiver := newMockWaiver(time.Now().Add(time.Hour))
+						waiver.Justification = ""
+						return waiver
+					}(),
+				},
+			},
+			wantRes: assert.Nil[*orchestrator.Waiver],
+			wantErr: func(t *testing.T, err error) bool {
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+				return assert.ErrorContains(t, err, "waiver.justification")
+			},
+		},
+		{
+			name: "Already expired",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t),
+				authz:   servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &orchestrator.CreateWaiverRequest{
+					Waiver: newMockWaiver(time.Now().Add(-time.Hour)),
+				},
+			},
+			wantRes: assert.Nil[*orchestrator.Waiver],
+			wantErr: func(t *testing.T, err error) bool {
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+				return assert.ErrorContains(t, err, orchestrator.ErrWaiverExpired.Error())
+			},
+		},
+		{
+			name: "Permission denied: cloud service",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t),
+				authz:   servicetest.NewAuthorizationStrategy(false, testdata.MockCloudServiceID2),
+			},
+			args: args{
+				req: &orchestrator.CreateWaiverRequest{
+					Waiver: newMockWaiver(time.Now().Add(time.Hour)),
+				},
+			},
+			wantRes: assert.Nil[*orchestrator.Waiver],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, service.ErrPermissionDenied)
+			},
+		},
+		{
+			name: "Permission denied: missing role",
+			fields: fields{
+				storage:    testutil.NewInMemoryStorage(t),
+				authz:      servicetest.NewAuthorizationStrategyWithRoles(true, []string{"auditor"}),
+				waiverRole: mockWaiverRole,
+			},
+			args: args{
+				req: &orchestrator.CreateWaiverRequest{
+					Waiver: newMockWaiver(time.Now().Add(time.Hour)),
+				},
+			},
+			wantRes: assert.Nil[*orchestrator.Waiver],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, service.ErrPermissionDenied)
+			},
+		},
+		{
+			name: "Happy path",
+			fields: fields{
+				storage:    testutil.NewInMemoryStorage(t),
+				authz:      servicetest.NewAuthorizationStrategyWithRoles(false, []string{mockWaiverRole}, testdata.MockCloudServiceID1),
+				waiverRole: mockWaiverRole,
+			},
+			args: args{
+				req: &orchestrator.CreateWaiverRequest{
+					Waiver: func() *orchestrator.Waiver {
+						waiver := newMockWaiver(time.Now().Add(time.Hour))
+						waiver.Id = ""
+						return waiver
+					}(),
+				},
+			},
+			wantRes: func(t *testing.T, got *orchestrator.Waiver) bool {
+				assert.NotEmpty(t, got.Id)
+				assert.NotNil(t, got.CreatedAt)
+				return assert.Equal(t, "CISO", got.Approver)
+			},
+			wantErr: assert.Nil[error],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				storage:    tt.fields.storage,
+				authz:      tt.fields.authz,
+				waiverRole: tt.fields.waiverRole,
+			}
+
+			gotRes, err := servicetest.Validated(svc.CreateWaiver)(context.Background(), tt.args.req)
+			tt.wantErr(t, err)
+			tt.wantRes(t, gotRes)
+		})
+	}
+}
+
+func TestService_GetWaiver(t *testing.T) {
+	svc := &Service{
+		storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+			assert.NoError(t, s.Create(newMockWaiver(time.Now().Add(time.Hour))))
+		}),
+		authz: servicetest.NewAuthorizationStrategy(false, testdata.MockCloudServiceID2),
+	}
+
+	_, err := svc.GetWaiver(context.Background(), &orchestrator.GetWaiverRequest{WaiverId: "bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb"})
+	assert.ErrorIs(t, err, ErrWaiverNotFound)
+
+	_, err = svc.GetWaiver(context.Background(), &orchestrator.GetWaiverRequest{WaiverId: mockWaiverID})
+	assert.ErrorIs(t, err, service.ErrPermissionDenied)
+
+	svc.authz = servicetest.NewAuthorizationStrategy(true)
+
+	res, err := svc.GetWaiver(context.Background(), &orchestrator.GetWaiverRequest{WaiverId: mockWaiverID})
+	assert.NoError(t, err)
+	assert.Equal(t, "CISO", res.GetApprover())
+}
+
+func TestService_ListWaivers(t *testing.T) {
+	type args struct {
+		req *orchestrator.ListWaiversRequest
+	}
+	tests := []struct {
+		name    string
+		authz   service.AuthorizationStrategy
+		args    args
+		wantRes assert.Want[*orchestrator.ListWaiversResponse]
+		wantErr assert.WantErr
+	}{
+		{
+			name:  "Permission denied for filtered cloud service",
+			authz: servicetest.NewAuthorizationStrategy(false, testdata.MockCloudServiceID2),
+			args: args{
+				req: &orchestrator.ListWaiversRequest{
+					Filter: &orchestrator.ListWaiversRequest_Filter{CloudServiceId: util.Ref(testdata.MockCloudServiceID1)},
+				},
+			},
+			wantRes: assert.Nil[*orchestrator.ListWaiversResponse],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, service.ErrPermissionDenied)
+			},
+		},
+		{
+			name:  "Only active",
+			authz: servicetest.NewAuthorizationStrategy(true),
+			args: args{
+				req: &orchestrator.ListWaiversRequest{
+					Filter: &orchestrator.ListWaiversRequest_Filter{
+						ResourceId: util.Ref(testdata.MockResourceID1),
+						Active:     util.Ref(true),
+					},
+				},
+			},
+			wantRes: func(t *testing.T, got *orchestrator.ListWaiversResponse) bool {
+				if !assert.Equal(t, 1, len(got.Waivers)) {
+					return false
+				}
+
+				return assert.Equal(t, mockWaiverID, got.Waivers[0].Id)
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name:  "Only expired",
+			authz: servicetest.NewAuthorizationStrategy(true),
+			args: args{
+				req: &orchestrator.ListWaiversRequest{
+					Filter: &orchestrator.ListWaiversRequest_Filter{
+						Active: util.Ref(false),
+					},
+				},
+			},
+			wantRes: func(t *testing.T, got *orchestrator.ListWaiversResponse) bool {
+				if !assert.Equal(t, 1, len(got.Waivers)) {
+					return false
+				}
+
+				return assert.Equal(t, "bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb", got.Waivers[0].Id)
+			},
+			wantErr: assert.Nil[error],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					assert.NoError(t, s.Create(newMockWaiver(time.Now().Add(time.Hour))))
+
+					expired := newMockWaiver(time.Now().Add(-time.Hour))
+					expired.Id = "bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb"
+					assert.NoError(t, s.Create(expired))
+				}),
+				authz: tt.authz,
+			}
+
+			gotRes, err := svc.ListWaivers(context.Background(), tt.args.req)
+			tt.wantErr(t, err)
+			tt.wantRes(t, gotRes)
+		})
+	}
+}
+
+func TestService_RemoveWaiver(t *testing.T) {
+	type fields struct {
+		authz      service.AuthorizationStrategy
+		waiverRole string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		wantSvc assert.Want[*Service]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "Permission denied: missing role",
+			fields: fields{
+				authz:      servicetest.NewAuthorizationStrategy(true),
+				waiverRole: mockWaiverRole,
+			},
+			wantSvc: assert.AnyValue[*Service],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, service.ErrPermissionDenied)
+			},
+		},
+		{
+			name: "Happy path",
+			fields: fields{
+				authz:      servicetest.NewAuthorizationStrategyWithRoles(true, []string{mockWaiverRole}),
+				waiverRole: mockWaiverRole,
+			},
+			wantSvc: func(t *testing.T, got *Service) bool {
+				var waivers []*orchestrator.Waiver
+				err := got.storage.List(&waivers, "", true, 0, -1)
+				assert.NoError(t, err)
+				return assert.Equal(t, 0, len(waivers))
+			},
+			wantErr: assert.Nil[error],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					assert.NoError(t, s.Create(newMockWaiver(time.Now().Add(time.Hour))))
+				}),
+				authz:      tt.fields.authz,
+				waiverRole: tt.fields.waiverRole,
+			}
+
+			_, err := svc.RemoveWaiver(context.Background(), &orchestrator.RemoveWaiverRequest{WaiverId: mockWaiverID})
+			tt.wantErr(t, err)
+			tt.wantSvc(t, svc)
+		})
+	}
+}
@@ -0,0 +1,396 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package orchestrator
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	"clouditor.io/clouditor/v2/api"
+	"clouditor.io/clouditor/v2/api/assessment"
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/internal/logging"
+	"clouditor.io/clouditor/v2/persistence"
+	"clouditor.io/clouditor/v2/service"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// DefaultWebhookMaxRetries is the default number of retries if the delivery to a webhook fails.
+	DefaultWebhookMaxRetries = 3
+
+	// DefaultWebhookRetryInterval is the default interval before the first retry of a failed delivery.
+	DefaultWebhookRetryInterval = 5 * time.Second
+
+	// DefaultWebhookTimeout is the default timeout of a single delivery to a webhook.
+	DefaultWebhookTimeout = 10 * time.Second
+
+	// WebhookEventHeader is the HTTP header that contains the type of the delivered event.
+	WebhookEventHeader = "X-Clouditor-Event"
+
+	// WebhookSignatureHeader is the HTTP header that contains the HMAC-SHA256 signature of the delivered payload in the
+	// form `sha256=<hex>`, if the webhook has a secret.
+	WebhookSignatureHeader = "X-Clouditor-Signature"
+)
+
+// ErrWebhookNotFound indicates the webhook was not found
+var ErrWebhookNotFound = api.ErrorCodeWebhookNotFound.Err()
+
+// CreateWebhook implements method for creating a new webhook
+func (svc *Service) CreateWebhook(ctx context.Context, req *orchestrator.CreateWebhookRequest) (res *orchestrator.Webhook, err error) {
+	// Check, if this request has access to the cloud service according to our authorization strategy.
+	if !svc.authz.CheckAccess(ctx, service.AccessCreate, req) {
+		return nil, service.ErrPermissionDenied
+	}
+
+	// Generate a new ID
+	req.Webhook.Id = uuid.NewString()
+	req.Webhook.CreatedAt = timestamppb.Now()
+
+	// Persist the new webhook in our database
+	err = svc.storage.Create(req.Webhook)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not add webhook to the database: %v", err)
+	}
+
+	logging.LogRequest(log, logrus.DebugLevel, logging.Create, req)
+
+	return withoutSecret(req.Webhook), nil
+}
+
+// GetWebhook implements method for getting a webhook
+func (svc *Service) GetWebhook(ctx context.Context, req *orchestrator.GetWebhookRequest) (res *orchestrator.Webhook, err error) {
+	res, err = svc.getWebhook(ctx, req.WebhookId)
+	if err != nil {
+		return nil, err
+	}
+
+	return withoutSecret(res), nil
+}
+
+// ListWebhooks implements method for listing all webhooks the user is authorized to see
+func (svc *Service) ListWebhooks(ctx context.Context, req *orchestrator.ListWebhooksRequest) (res *orchestrator.ListWebhooksResponse, err error) {
+	var (
+		query []string
+		args  []any
+	)
+
+	// Retrieve list of allowed cloud service according to our authorization strategy
+	all, allowed := svc.authz.AllowedCloudServices(ctx)
+
+	// The content of the filtered cloud service ID must be in the list of allowed cloud service IDs, unless one can
+	// access *all* the cloud services.
+	if !all && req.CloudServiceId != nil && !slices.Contains(allowed, req.GetCloudServiceId()) {
+		return nil, service.ErrPermissionDenied
+	}
+
+	if req.CloudServiceId != nil {
+		query = append(query, "cloud_service_id = ?")
+		args = append(args, req.GetCloudServiceId())
+	}
+
+	if !all {
+		query = append(query, "cloud_service_id IN ?")
+		args = append(args, allowed)
+	}
+
+	res = new(orchestrator.ListWebhooksResponse)
+
+	res.Webhooks, res.NextPageToken, err = service.PaginateStorage[*orchestrator.Webhook](req, svc.storage,
+		service.DefaultPaginationOpts, persistence.BuildConds(query, args)...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not paginate results: %v", err)
+	}
+
+	// Never expose the secrets
+	for i, hook := range res.Webhooks {
+		res.Webhooks[i] = withoutSecret(hook)
+	}
+
+	return
+}
+
+// UpdateWebhook implements method for updating an existing webhook. If the request does not contain a secret, the
+// existing secret is kept.
+func (svc *Service) UpdateWebhook(ctx context.Context, req *orchestrator.UpdateWebhookRequest) (res *orchestrator.Webhook, err error) {
+	var existing *orchestrator.Webhook
+
+	if req.Webhook.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "webhook.id: value is empty, which is not a valid UUID")
+	}
+
+	// Check, if this request has access to the (new) cloud service according to our authorization strategy.
+	if !svc.authz.CheckAccess(ctx, service.AccessUpdate, req) {
+		return nil, service.ErrPermissionDenied
+	}
+
+	// Retrieve the existing webhook, which also checks the access to its current cloud service
+	existing, err = svc.getWebhook(ctx, req.Webhook.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	res = req.Webhook
+	res.CreatedAt = existing.CreatedAt
+	if res.Secret == "" {
+		res.Secret = existing.Secret
+	}
+
+	err = svc.storage.Save(res, "id = ?", res.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
+	logging.LogRequest(log, logrus.DebugLevel, logging.Update, req)
+
+	return withoutSecret(res), nil
+}
+
+// RemoveWebhook implements method for removing a webhook
+func (svc *Service) RemoveWebhook(ctx context.Context, req *orchestrator.RemoveWebhookRequest) (res *emptypb.Empty, err error) {
+	// Check, if the webhook exists and if we have access to it
+	_, err = svc.getWebhook(ctx, req.WebhookId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = svc.storage.Delete(&orchestrator.Webhook{}, "id = ?", req.WebhookId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
+	logging.LogRequest(log, logrus.DebugLevel, logging.Remove, req)
+
+	return &emptypb.Empty{}, nil
+}
+
+// getWebhook retrieves the webhook with the given ID from the storage and checks, whether the user has access to its
+// cloud service.
+func (svc *Service) getWebhook(ctx context.Context, id string) (hook *orchestrator.Webhook, err error) {
+	hook = new(orchestrator.Webhook)
+
+	err = svc.storage.Get(hook, "id = ?", id)
+	if errors.Is(err, persistence.ErrRecordNotFound) {
+		return nil, ErrWebhookNotFound
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
+	all, allowed := svc.authz.AllowedCloudServices(ctx)
+	if !all && !slices.Contains(allowed, hook.CloudServiceId) {
+		return nil, service.ErrPermissionDenied
+	}
+
+	return hook, nil
+}
+
+// withoutSecret returns a copy of the webhook without its secret.
+func withoutSecret(hook *orchestrator.Webhook) *orchestrator.Webhook {
+	hook = proto.Clone(hook).(*orchestrator.Webhook)
+	hook.Secret = ""
+
+	return hook
+}
+
+// informWebhooksAboutResult informs the webhooks of the cloud service about a new assessment result. An event is
+// delivered if the result is non-compliant or if the compliance of the resource regarding the metric has changed
+// compared to the previous result.
+func (svc *Service) informWebhooksAboutResult(result *assessment.AssessmentResult) {
+	var previous *assessment.AssessmentResult
+
+	hooks, err := svc.enabledWebhooks(result.CloudServiceId)
+	if err != nil {
+		log.Errorf("Could not retrieve webhooks for cloud service %s: %v", result.CloudServiceId, err)
+		return
+	} else if len(hooks) == 0 {
+		return
+	}
+
+	if !result.Compliant {
+		svc.deliverToWebhooks(hooks, newWebhookEvent(orchestrator.WebhookEventType_WEBHOOK_EVENT_TYPE_NON_COMPLIANT_ASSESSMENT_RESULT,
+			result.CloudServiceId, func(event *orchestrator.WebhookEvent) {
+				event.AssessmentResult = result
+			}))
+	}
+
+	// Retrieve the previous result of the same resource and metric
+	previous, err = svc.previousAssessmentResult(result)
+	if err != nil {
+		log.Errorf("Could not retrieve previous assessment result of resource %s: %v", result.ResourceId, err)
+		return
+	}
+
+	if previous != nil && previous.Compliant != result.Compliant {
+		svc.deliverToWebhooks(hooks, newWebhookEvent(orchestrator.WebhookEventType_WEBHOOK_EVENT_TYPE_COMPLIANCE_STATE_CHANGED,
+			result.CloudServiceId, func(event *orchestrator.WebhookEvent) {
+				event.AssessmentResult = result
+				event.PreviouslyCompliant = &previous.Compliant
+			}))
+	}
+}
+
+// informWebhooksAboutCertificate informs the webhooks of the cloud service about a changed certificate.
+func (svc *Service) informWebhooksAboutCertificate(cert *orchestrator.Certificate) {
+	hooks, err := svc.enabledWebhooks(cert.CloudServiceId)
+	if err != nil {
+		log.Errorf("Could not retrieve webhooks for cloud service %s: %v", cert.CloudServiceId, err)
+		return
+	}
+
+	svc.deliverToWebhooks(hooks, newWebhookEvent(orchestrator.WebhookEventType_WEBHOOK_EVENT_TYPE_CERTIFICATE_CHANGED,
+		cert.CloudServiceId, func(event *orchestrator.WebhookEvent) {
+			event.Certificate = cert
+		}))
+}
+
+// enabledWebhooks retrieves all enabled webhooks of the given cloud service.
+func (svc *Service) enabledWebhooks(cloudServiceID string) (hooks []*orchestrator.Webhook, err error) {
+	err = svc.storage.List(&hooks, "", true, 0, -1, "cloud_service_id = ? AND enabled = ?", cloudServiceID, true)
+
+	return
+}
+
+// newWebhookEvent creates a new webhook event of the given type. The function f can be used to set the payload of the
+// event.
+func newWebhookEvent(typ orchestrator.WebhookEventType, cloudServiceID string, f func(event *orchestrator.WebhookEvent)) *orchestrator.WebhookEvent {
+	event := &orchestrator.WebhookEvent{
+		Id:             uuid.NewString(),
+		Type:           typ,
+		Timestamp:      timestamppb.Now(),
+		CloudServiceId: cloudServiceID,
+	}
+
+	f(event)
+
+	return event
+}
+
+// deliverToWebhooks delivers the event in parallel to all webhooks that are subscribed to its type and waits until
+// all deliveries are finished.
+func (svc *Service) deliverToWebhooks(hooks []*orchestrator.Webhook, event *orchestrator.WebhookEvent) {
+	var wg sync.WaitGroup
+
+	payload, err := protojson.Marshal(event)
+	if err != nil {
+		log.Errorf("Could not marshal webhook event: %v", err)
+		return
+	}
+
+	for _, hook := range hooks {
+		if !hook.IsSubscribedTo(event.Type) {
+			continue
+		}
+
+		wg.Add(1)
+		go func(hook *orchestrator.Webhook) {
+			defer wg.Done()
+
+			err := svc.deliverWebhook(hook, event.Type, payload)
+			if err != nil {
+				log.Errorf("Could not deliver event %s to webhook %s: %v", event.Id, hook.Id, err)
+			}
+		}(hook)
+	}
+
+	wg.Wait()
+}
+
+// deliverWebhook delivers the payload to the webhook. If the delivery fails, it is retried up to webhookMaxRetries
+// times, doubling the waiting time with each retry.
+func (svc *Service) deliverWebhook(hook *orchestrator.Webhook, typ orchestrator.WebhookEventType, payload []byte) (err error) {
+	var client = svc.webhookClient
+
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	for attempt := 0; ; attempt++ {
+		err = sendWebhook(client, hook, typ, payload)
+		if err == nil {
+			return nil
+		}
+
+		if attempt >= svc.webhookMaxRetries {
+			return fmt.Errorf("giving up after %d attempt(s): %w", attempt+1, err)
+		}
+
+		log.Debugf("Delivery to webhook %s failed, retrying: %v", hook.Id, err)
+
+		time.Sleep(svc.webhookRetryInterval << attempt)
+	}
+}
+
+// sendWebhook sends a single HTTP request with the payload to the webhook.
+func sendWebhook(client *http.Client, hook *orchestrator.Webhook, typ orchestrator.WebhookEventType, payload []byte) (err error) {
+	req, err := http.NewRequest(http.MethodPost, hook.Url, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("could not create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(WebhookEventHeader, typ.String())
+
+	if hook.Secret != "" {
+		req.Header.Set(WebhookSignatureHeader, "sha256="+signPayload(hook.Secret, payload))
+	}
+
+	res, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status code %d", res.StatusCode)
+	}
+
+	return nil
+}
+
+// signPayload computes the hex-encoded HMAC-SHA256 of the payload using the secret.
+func signPayload(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,814 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package orchestrator
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"clouditor.io/clouditor/v2/api/assessment"
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/testutil/servicetest"
+	"clouditor.io/clouditor/v2/internal/util"
+	"clouditor.io/clouditor/v2/persistence"
+	"clouditor.io/clouditor/v2/service"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	mockWebhookID     = "33333333-3333-3333-3333-333333333333"
+	mockWebhookSecret = "s3cr3t"
+)
+
+func newMockWebhook() *orchestrator.Webhook {
+	return &orchestrator.Webhook{
+		Id:             mockWebhookID,
+		CloudServiceId: testdata.MockCloudServiceID1,
+		Name:           "My Webhook",
+		Url:            "https://example.com/hook",
+		Secret:         mockWebhookSecret,
+		Enabled:        true,
+	}
+}
+
+func TestService_CreateWebhook(t *testing.T) {
+	type fields struct {
+		storage persistence.Storage
+		authz   service.AuthorizationStrategy
+	}
+	type args struct {
+		req *orchestrator.CreateWebhookRequest
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantRes assert.Want[*orchestrator.Webhook]
+		wantSvc assert.Want[*Service]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "Validation error",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t),
+				authz:   servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &orchestrator.CreateWebhookRequest{
+					Webhook: &orchestrator.Webhook{
+						CloudServiceId: testdata.MockCloudServiceID1,
+						Name:           "My Webhook",
+						Url:            "not a url",
+					},
+				},
+			},
+			wantRes: assert.Nil[*orchestrator.Webhook],
+			wantSvc: assert.AnyValue[*Service],
+			wantErr: func(t *testing.T, err error) bool {
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+				return assert.ErrorContains(t, err, "webhook.url")
+			},
+		},
+		{
+			name: "Permission denied",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t),
+				authz:   servicetest.NewAuthorizationStrategy(false, testdata.MockCloudServiceID2),
+			},
+			args: args{
+				req: &orchestrator.CreateWebhookRequest{
+					Webhook: newMockWebhook(),
+				},
+			},
+			wantRes: assert.Nil[*orchestrator.Webhook],
+			wantSvc: assert.AnyValue[*Service],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, service.ErrPermissionDenied)
+			},
+		},
+		{
+			name: "Happy path",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t),
+				authz:   servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &orchestrator.CreateWebhookRequest{
+					Webhook: &orchestrator.Webhook{
+						CloudServiceId: testdata.MockCloudServiceID1,
+						Name:           "My Webhook",
+						Url:            "https://example.com/hook",
+						Secret:         mockWebhookSecret,
+						Enabled:        true,
+						Events:         []orchestrator.WebhookEventType{orchestrator.WebhookEventType_WEBHOOK_EVENT_TYPE_CERTIFICATE_CHANGED},
+					},
+				},
+			},
+			wantRes: func(t *testing.T, got *orchestrator.Webhook) bool {
+				assert.NotEmpty(t, got.Id)
+				assert.NotNil(t, got.CreatedAt)
+				return assert.Empty(t, got.Secret)
+			},
+			wantSvc: func(t *testing.T, got *Service) bool {
+				var hooks []*orchestrator.Webhook
+				err := got.storage.List(&hooks, "", true, 0, -1)
+				assert.NoError(t, err)
+				if !assert.Equal(t, 1, len(hooks)) {
+					return false
+				}
+
+				assert.Equal(t, []orchestrator.WebhookEventType{orchestrator.WebhookEventType_WEBHOOK_EVENT_TYPE_CERTIFICATE_CHANGED}, hooks[0].Events)
+				return assert.Equal(t, mockWebhookSecret, hooks[0].Secret)
+			},
+			wantErr: assert.Nil[error],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				storage: tt.fields.storage,
+				authz:   tt.fields.authz,
+			}
+
+			gotRes, err := servicetest.Validated(svc.CreateWebhook)(context.Background(), tt.args.req)
+			tt.wantErr(t, err)
+			tt.wantRes(t, gotRes)
+			tt.wantSvc(t, svc)
+		})
+	}
+}
+
+func TestService_GetWebhook(t *testing.T) {
+	type fields struct {
+		storage persistence.Storage
+		authz   service.AuthorizationStrategy
+	}
+	type args struct {
+		req *orchestrator.GetWebhookRequest
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantRes assert.Want[*orchestrator.Webhook]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "Validation error",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t),
+				authz:   servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &orchestrator.GetWebhookRequest{},
+			},
+			wantRes: assert.Nil[*orchestrator.Webhook],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "webhook_id: value is empty, which is not a valid UUID")
+			},
+		},
+		{
+			name: "Not found",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t),
+				authz:   servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &orchestrator.GetWebhookRequest{WebhookId: mockWebhookID},
+			},
+			wantRes: assert.Nil[*orchestrator.Webhook],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, ErrWebhookNotFound)
+			},
+		},
+		{
+			name: "Permission denied",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					assert.NoError(t, s.Create(newMockWebhook()))
+				}),
+				authz: servicetest.NewAuthorizationStrategy(false, testdata.MockCloudServiceID2),
+			},
+			args: args{
+				req: &orchestrator.GetWebhookRequest{WebhookId: mockWebhookID},
+			},
+			wantRes: assert.Nil[*orchestrator.Webhook],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, service.ErrPermissionDenied)
+			},
+		},
+		{
+			name: "Happy path",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					assert.NoError(t, s.Create(newMockWebhook()))
+				}),
+				authz: servicetest.NewAuthorizationStrategy(false, testdata.MockCloudServiceID1),
+			},
+			args: args{
+				req: &orchestrator.GetWebhookRequest{WebhookId: mockWebhookID},
+			},
+			wantRes: func(t *testing.T, got *orchestrator.Webhook) bool {
+				want := newMockWebhook()
+				want.Secret = ""
+
+				return assert.Equal(t, want, got)
+			},
+			wantErr: assert.Nil[error],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				storage: tt.fields.storage,
+				authz:   tt.fields.authz,
+			}
+
+			gotRes, err := servicetest.Validated(svc.GetWebhook)(context.Background(), tt.args.req)
+			tt.wantErr(t, err)
+			tt.wantRes(t, gotRes)
+		})
+	}
+}
+
+func TestService_ListWebhooks(t *testing.T) {
+	type fields struct {
+		storage persistence.Storage
+		authz   service.AuthorizationStrategy
+	}
+	type args struct {
+		req *orchestrator.ListWebhooksRequest
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantRes assert.Want[*orchestrator.ListWebhooksResponse]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "Permission denied",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t),
+				authz:   servicetest.NewAuthorizationStrategy(false, testdata.MockCloudServiceID2),
+			},
+			args: args{
+				req: &orchestrator.ListWebhooksRequest{CloudServiceId: util.Ref(testdata.MockCloudServiceID1)},
+			},
+			wantRes: assert.Nil[*orchestrator.ListWebhooksResponse],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, service.ErrPermissionDenied)
+			},
+		},
+		{
+			name: "Happy path: only allowed cloud services",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					assert.NoError(t, s.Create(newMockWebhook()))
+
+					other := newMockWebhook()
+					other.Id = "44444444-4444-4444-4444-444444444444"
+					other.CloudServiceId = testdata.MockCloudServiceID2
+					assert.NoError(t, s.Create(other))
+				}),
+				authz: servicetest.NewAuthorizationStrategy(false, testdata.MockCloudServiceID1),
+			},
+			args: args{
+				req: &orchestrator.ListWebhooksRequest{},
+			},
+			wantRes: func(t *testing.T, got *orchestrator.ListWebhooksResponse) bool {
+				if !assert.Equal(t, 1, len(got.Webhooks)) {
+					return false
+				}
+
+				assert.Equal(t, mockWebhookID, got.Webhooks[0].Id)
+				return assert.Empty(t, got.Webhooks[0].Secret)
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "Happy path: filtered cloud service",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					assert.NoError(t, s.Create(newMockWebhook()))
+
+					other := newMockWebhook()
+					other.Id = "44444444-4444-4444-4444-444444444444"
+					other.CloudServiceId = testdata.MockCloudServiceID2
+					assert.NoError(t, s.Create(other))
+				}),
+				authz: servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &orchestrator.ListWebhooksRequest{CloudServiceId: util.Ref(testdata.MockCloudServiceID2)},
+			},
+			wantRes: func(t *testing.T, got *orchestrator.ListWebhooksResponse) bool {
+				if !assert.Equal(t, 1, len(got.Webhooks)) {
+					return false
+				}
+
+				return assert.Equal(t, testdata.MockCloudServiceID2, got.Webhooks[0].CloudServiceId)
+			},
+			wantErr: assert.Nil[error],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				storage: tt.fields.storage,
+				authz:   tt.fields.authz,
+			}
+
+			gotRes, err := svc.ListWebhooks(context.Background(), tt.args.req)
+			tt.wantErr(t, err)
+			tt.wantRes(t, gotRes)
+		})
+	}
+}
+
+func TestService_UpdateWebhook(t *testing.T) {
+	type fields struct {
+		storage persistence.Storage
+		authz   service.AuthorizationStrategy
+	}
+	type args struct {
+		req *orchestrator.UpdateWebhookRequest
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantRes assert.Want[*orchestrator.Webhook]
+		wantSvc assert.Want[*Service]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "Missing ID",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t),
+				authz:   servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &orchestrator.UpdateWebhookRequest{
+					Webhook: &orchestrator.Webhook{
+						CloudServiceId: testdata.MockCloudServiceID1,
+						Name:           "My Webhook",
+						Url:            "https://example.com/hook",
+					},
+				},
+			},
+			wantRes: assert.Nil[*orchestrator.Webhook],
+			wantSvc: assert.AnyValue[*Service],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			name: "Not found",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t),
+				authz:   servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &orchestrator.UpdateWebhookRequest{
+					Webhook: newMockWebhook(),
+				},
+			},
+			wantRes: assert.Nil[*orchestrator.Webhook],
+			wantSvc: assert.AnyValue[*Service],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, ErrWebhookNotFound)
+			},
+		},
+		{
+			name: "Permission denied for existing cloud service",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					assert.NoError(t, s.Create(newMockWebhook()))
+				}),
+				authz: servicetest.NewAuthorizationStrategy(false, testdata.MockCloudServiceID2),
+			},
+			args: args{
+				req: &orchestrator.UpdateWebhookRequest{
+					Webhook: &orchestrator.Webhook{
+						Id:             mockWebhookID,
+						CloudServiceId: testdata.MockCloudServiceID2,
+						Name:           "My Webhook",
+						Url:            "https://example.com/hook",
+					},
+				},
+			},
+			wantRes: assert.Nil[*orchestrator.Webhook],
+			wantSvc: assert.AnyValue[*Service],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, service.ErrPermissionDenied)
+			},
+		},
+		{
+			name: "Happy path: keep secret",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					assert.NoError(t, s.Create(newMockWebhook()))
+				}),
+				authz: servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &orchestrator.UpdateWebhookRequest{
+					Webhook: &orchestrator.Webhook{
+						Id:             mockWebhookID,
+						CloudServiceId: testdata.MockCloudServiceID1,
+						Name:           "My new Webhook",
+						Url:            "https://example.com/new",
+					},
+				},
+			},
+			wantRes: func(t *testing.T, got *orchestrator.Webhook) bool {
+				assert.Equal(t, "My new Webhook", got.Name)
+				return assert.Empty(t, got.Secret)
+			},
+			wantSvc: func(t *testing.T, got *Service) bool {
+				var hook orchestrator.Webhook
+				err := got.storage.Get(&hook, "id = ?", mockWebhookID)
+				assert.NoError(t, err)
+
+				assert.Equal(t, "https://example.com/new", hook.Url)
+				assert.False(t, hook.Enabled)
+				return assert.Equal(t, mockWebhookSecret, hook.Secret)
+			},
+			wantErr: assert.Nil[error],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				storage: tt.fields.storage,
+				authz:   tt.fields.authz,
+			}
+
+			gotRes, err := svc.UpdateWebhook(context.Background(), tt.args.req)
+			tt.wantErr(t, err)
+			tt.wantRes(t, gotRes)
+			tt.wantSvc(t, svc)
+		})
+	}
+}
+
+func TestService_RemoveWebhook(t *testing.T) {
+	type fields struct {
+		storage persistence.Storage
+		authz   service.AuthorizationStrategy
+	}
+	type args struct {
+		req *orchestrator.RemoveWebhookRequest
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantSvc assert.Want[*Service]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "Not found",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t),
+				authz:   servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &orchestrator.RemoveWebhookRequest{WebhookId: mockWebhookID},
+			},
+			wantSvc: assert.AnyValue[*Service],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, ErrWebhookNotFound)
+			},
+		},
+		{
+			name: "Permission denied",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					assert.NoError(t, s.Create(newMockWebhook()))
+				}),
+				authz: servicetest.NewAuthorizationStrategy(false, testdata.MockCloudServiceID2),
+			},
+			args: args{
+				req: &orchestrator.RemoveWebhookRequest{WebhookId: mockWebhookID},
+			},
+			wantSvc: func(t *testing.T, got *Service) bool {
+				n, err := got.storage.Count(&orchestrator.Webhook{})
+				assert.NoError(t, err)
+				return assert.Equal(t, int64(1), n)
+			},
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, service.ErrPermissionDenied)
+			},
+		},
+		{
+			name: "Happy path",
+			fields: fields{
+				storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					assert.NoError(t, s.Create(newMockWebhook()))
+				}),
+				authz: servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &orchestrator.RemoveWebhookRequest{WebhookId: mockWebhookID},
+			},
+			wantSvc: func(t *testing.T, got *Service) bool {
+				n, err := got.storage.Count(&orchestrator.Webhook{})
+				assert.NoError(t, err)
+				return assert.Equal(t, int64(0), n)
+			},
+			wantErr: assert.Nil[error],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				storage: tt.fields.storage,
+				authz:   tt.fields.authz,
+			}
+
+			_, err := svc.RemoveWebhook(context.Background(), tt.args.req)
+			tt.wantErr(t, err)
+			tt.wantSvc(t, svc)
+		})
+	}
+}
+
+// webhookRecorder is a simple HTTP handler that records all delivered webhook events. The first n requests, with n
+// being the number of failures, are answered with an internal server error.
+type webhookRecorder struct {
+	mu       sync.Mutex
+	failures int
+	requests []*http.Request
+	events   []*orchestrator.WebhookEvent
+	bodies   [][]byte
+}
+
+func (rec *webhookRecorder) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+
+	if rec.failures > 0 {
+		rec.failures--
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	body, _ := io.ReadAll(r.Body)
+
+	event := new(orchestrator.WebhookEvent)
+	_ = protojson.Unmarshal(body, event)
+
+	rec.requests = append(rec.requests, r)
+	rec.events = append(rec.events, event)
+	rec.bodies = append(rec.bodies, body)
+}
+
+func TestService_deliverWebhook(t *testing.T) {
+	type fields struct {
+		failures   int
+		maxRetries int
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		wantRec assert.Want[*webhookRecorder]
+		wantErr assert.WantErr
+	}{
+		{
+			name: "Happy path",
+			fields: fields{
+				maxRetries: 0,
+			},
+			wantRec: func(t *testing.T, got *webhookRecorder) bool {
+				if !assert.Equal(t, 1, len(got.requests)) {
+					return false
+				}
+
+				mac := hmac.New(sha256.New, []byte(mockWebhookSecret))
+				mac.Write(got.bodies[0])
+
+				assert.Equal(t, orchestrator.WebhookEventType_WEBHOOK_EVENT_TYPE_CERTIFICATE_CHANGED.String(), got.requests[0].Header.Get(WebhookEventHeader))
+				return assert.Equal(t, "sha256="+hex.EncodeToString(mac.Sum(nil)), got.requests[0].Header.Get(WebhookSignatureHeader))
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "Successful after retry",
+			fields: fields{
+				failures:   2,
+				maxRetries: 2,
+			},
+			wantRec: func(t *testing.T, got *webhookRecorder) bool {
+				return assert.Equal(t, 1, len(got.requests))
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "Giving up",
+			fields: fields{
+				failures:   3,
+				maxRetries: 2,
+			},
+			wantRec: func(t *testing.T, got *webhookRecorder) bool {
+				return assert.Equal(t, 0, len(got.requests))
+			},
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "giving up after 3 attempt(s): unexpected status code 500")
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := &webhookRecorder{failures: tt.fields.failures}
+			srv := httptest.NewServer(rec)
+			defer srv.Close()
+
+			svc := &Service{
+				webhookMaxRetries:    tt.fields.maxRetries,
+				webhookRetryInterval: time.Millisecond,
+			}
+
+			hook := newMockWebhook()
+			hook.Url = srv.URL
+
+			err := svc.deliverWebhook(hook, orchestrator.WebhookEventType_WEBHOOK_EVENT_TYPE_CERTIFICATE_CHANGED, []byte(`{}`))
+			tt.wantErr(t, err)
+			tt.wantRec(t, rec)
+		})
+	}
+}
+
+func TestService_informWebhooksAboutResult(t *testing.T) {
+	type args struct {
+		previous *assessment.AssessmentResult
+		result   *assessment.AssessmentResult
+		events   []orchestrator.WebhookEventType
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantRec assert.Want[*webhookRecorder]
+	}{
+		{
+			name: "Compliant result without previous result",
+			args: args{
+				result: newMockResult("00000000-0000-0000-0000-000000000011", true, 2),
+			},
+			wantRec: func(t *testing.T, got *webhookRecorder) bool {
+				return assert.Equal(t, 0, len(got.events))
+			},
+		},
+		{
+			name: "Non-compliant result without previous result",
+			args: args{
+				result: newMockResult("00000000-0000-0000-0000-000000000011", false, 2),
+			},
+			wantRec: func(t *testing.T, got *webhookRecorder) bool {
+				if !assert.Equal(t, 1, len(got.events)) {
+					return false
+				}
+
+				return assert.Equal(t, orchestrator.WebhookEventType_WEBHOOK_EVENT_TYPE_NON_COMPLIANT_ASSESSMENT_RESULT, got.events[0].Type)
+			},
+		},
+		{
+			name: "Compliance state changed",
+			args: args{
+				previous: newMockResult("00000000-0000-0000-0000-000000000010", false, 1),
+				result:   newMockResult("00000000-0000-0000-0000-000000000011", true, 2),
+			},
+			wantRec: func(t *testing.T, got *webhookRecorder) bool {
+				if !assert.Equal(t, 1, len(got.events)) {
+					return false
+				}
+
+				assert.Equal(t, orchestrator.WebhookEventType_WEBHOOK_EVENT_TYPE_COMPLIANCE_STATE_CHANGED, got.events[0].Type)
+				assert.Equal(t, "00000000-0000-0000-0000-000000000011", got.events[0].AssessmentResult.GetId())
+				return assert.False(t, got.events[0].GetPreviouslyCompliant())
+			},
+		},
+		{
+			name: "Only subscribed events",
+			args: args{
+				previous: newMockResult("00000000-0000-0000-0000-000000000010", true, 1),
+				result:   newMockResult("00000000-0000-0000-0000-000000000011", false, 2),
+				events:   []orchestrator.WebhookEventType{orchestrator.WebhookEventType_WEBHOOK_EVENT_TYPE_COMPLIANCE_STATE_CHANGED},
+			},
+			wantRec: func(t *testing.T, got *webhookRecorder) bool {
+				if !assert.Equal(t, 1, len(got.events)) {
+					return false
+				}
+
+				assert.Equal(t, orchestrator.WebhookEventType_WEBHOOK_EVENT_TYPE_COMPLIANCE_STATE_CHANGED, got.events[0].Type)
+				return assert.True(t, got.events[0].GetPreviouslyCompliant())
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := &webhookRecorder{}
+			srv := httptest.NewServer(rec)
+			defer srv.Close()
+
+			svc := &Service{
+				storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					hook := newMockWebhook()
+					hook.Url = srv.URL
+					hook.Events = tt.args.events
+					assert.NoError(t, s.Create(hook))
+
+					if tt.args.previous != nil {
+						assert.NoError(t, s.Create(tt.args.previous))
+					}
+					assert.NoError(t, s.Create(tt.args.result))
+				}),
+			}
+
+			svc.informWebhooksAboutResult(tt.args.result)
+			tt.wantRec(t, rec)
+		})
+	}
+}
+
+func TestService_informWebhooksAboutCertificate(t *testing.T) {
+	rec := &webhookRecorder{}
+	srv := httptest.NewServer(rec)
+	defer srv.Close()
+
+	svc := &Service{
+		storage: testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+			hook := newMockWebhook()
+			hook.Url = srv.URL
+			assert.NoError(t, s.Create(hook))
+
+			disabled := newMockWebhook()
+			disabled.Id = "44444444-4444-4444-4444-444444444444"
+			disabled.Url = srv.URL
+			disabled.Enabled = false
+			assert.NoError(t, s.Create(disabled))
+		}),
+	}
+
+	svc.informWebhooksAboutCertificate(&orchestrator.Certificate{
+		Id:             testdata.MockCertificateID,
+		CloudServiceId: testdata.MockCloudServiceID1,
+	})
+
+	if assert.Equal(t, 1, len(rec.events)) {
+		assert.Equal(t, orchestrator.WebhookEventType_WEBHOOK_EVENT_TYPE_CERTIFICATE_CHANGED, rec.events[0].Type)
+		assert.Equal(t, testdata.MockCertificateID, rec.events[0].Certificate.GetId())
+	}
+}
+
+func newMockResult(id string, compliant bool, sec int64) *assessment.AssessmentResult {
+	return &assessment.AssessmentResult{
+		Id:             id,
+		Timestamp:      timestamppb.New(time.Unix(sec, 0)),
+		CloudServiceId: testdata.MockCloudServiceID1,
+		MetricId:       testdata.MockMetricID1,
+		Compliant:      compliant,
+		EvidenceId:     testdata.MockEvidenceID1,
+		ResourceId:     testdata.MockResourceID1,
+		ResourceTypes:  []string{"Resource"},
+	}
+}
@@ -97,6 +97,46 @@ func PaginateStorage[T any](req api.PaginatedRequest, storage persistence.Storag
 	})
 }
 
+// PaginateStorageWithCursor is a helper function that helps to paginate records in persisted storage based on list
+// requests. In contrast to PaginateStorage, the next page token contains the ID of the last record of the page as a
+// cursor instead of an offset. Therefore, the database does not need to skip over all previous records and pages
+// remain consistent, even if records are added in the meantime. The order column is not validated here, this needs to
+// be done in the request validation.
+func PaginateStorageWithCursor[T interface{ GetId() string }](req api.PaginatedRequest, storage persistence.Storage,
+	opts PaginationOpts, conds ...interface{}) (page []T, npt string, err error) {
+	var (
+		token *api.PageToken
+		size  int32
+	)
+
+	token, size, err = pageTokenAndSize(req, opts)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// Retrieve values after our cursor from the DB
+	err = storage.ListAfter(&page, req.GetOrderBy(), req.GetAsc(), token.LastId, int(size), conds...)
+	if err != nil {
+		return nil, "", fmt.Errorf("database error: %w", err)
+	}
+
+	if len(page) == 0 || len(page) < int(size) {
+		// We are at the end
+		return
+	}
+
+	// Move the cursor "forward"
+	token.LastId = page[len(page)-1].GetId()
+
+	// Encode next page token
+	npt, err = token.Encode()
+	if err != nil {
+		return nil, "", fmt.Errorf("could not create page token: %w", err)
+	}
+
+	return
+}
+
 // paginate takes cares of the heavy lifting of handling the actual pagination request. It takes the paginated request
 // req, calculates offsets and sizes, which can be fine-tuned using opts and supplies them to the pager function. The
 // pager function needs to return the actual page contents based on the calculated size and offset. This result is then
@@ -108,6 +148,35 @@ func paginate[T any](req api.PaginatedRequest, opts PaginationOpts, pager func(s
 		done  bool
 	)
 
+	token, size, err = pageTokenAndSize(req, opts)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// Call our pager function with the offset and size
+	page, done, err = pager(token.Start, size)
+	if err != nil {
+		// Transparently return the error
+		return nil, "", err
+	}
+
+	if !done {
+		// Move the token "forward"
+		token.Start = token.Start + int64(len(page))
+
+		// Encode next page token
+		npt, err = token.Encode()
+		if err != nil {
+			return nil, "", fmt.Errorf("could not create page token: %w", err)
+		}
+	}
+
+	return
+}
+
+// pageTokenAndSize returns the page token of the paginated request req or a new one, if this is the first request. It
+// also returns the page size, which is fine-tuned using opts.
+func pageTokenAndSize(req api.PaginatedRequest, opts PaginationOpts) (token *api.PageToken, size int32, err error) {
 	// Check, if the size was specified and is within our maximum size
 	if req.GetPageSize() == 0 {
 		size = opts.DefaultPageSize
@@ -128,25 +197,7 @@ func paginate[T any](req api.PaginatedRequest, opts PaginationOpts, pager func(s
 		// Try to decode our existing token
 		token, err = api.DecodePageToken(req.GetPageToken())
 		if err != nil {
-			return nil, "", fmt.Errorf("could not decode page token: %w", err)
-		}
-	}
-
-	// Call our pager function with the offset and size
-	page, done, err = pager(token.Start, size)
-	if err != nil {
-		// Transparently return the error
-		return nil, "", err
-	}
-
-	if !done {
-		// Move the token "forward"
-		token.Start = token.Start + int64(len(page))
-
-		// Encode next page token
-		npt, err = token.Encode()
-		if err != nil {
-			return nil, "", fmt.Errorf("could not create page token: %w", err)
+			return nil, 0, fmt.Errorf("could not decode page token: %w", err)
 		}
 	}
 
@@ -225,3 +225,158 @@ func TestPaginateStorage(t *testing.T) {
 		})
 	}
 }
+
+func TestPaginateStorageWithCursor(t *testing.T) {
+	token := func(lastID string) string {
+		b64, err := (&api.PageToken{Size: 2, LastId: lastID}).Encode()
+		assert.NoError(t, err)
+		return b64
+	}
+
+	storage := testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+		_ = s.Save(&orchestrator.CloudService{Id: "1", Name: "e"})
+		_ = s.Save(&orchestrator.CloudService{Id: "2", Name: "d"})
+		_ = s.Save(&orchestrator.CloudService{Id: "3", Name: "c"})
+		_ = s.Save(&orchestrator.CloudService{Id: "4", Name: "b"})
+		_ = s.Save(&orchestrator.CloudService{Id: "5", Name: "a"})
+	})
+
+	ids := func(services []*orchestrator.CloudService) (ids []string) {
+		for _, s := range services {
+			ids = append(ids, s.Id)
+		}
+		return
+	}
+
+	type args struct {
+		req     api.PaginatedRequest
+		storage persistence.Storage
+		opts    PaginationOpts
+		conds   []interface{}
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantPage assert.Want[[]*orchestrator.CloudService]
+		wantNbt  assert.Want[string]
+		wantErr  assert.WantErr
+	}{
+		{
+			name: "first page",
+			args: args{
+				req: &orchestrator.ListCloudServicesRequest{
+					PageSize: 2,
+				},
+				storage: storage,
+				opts:    PaginationOpts{10, 10},
+			},
+			wantPage: func(t *testing.T, got []*orchestrator.CloudService) bool {
+				return assert.Equal(t, []string{"1", "2"}, ids(got))
+			},
+			wantNbt: func(t *testing.T, got string) bool {
+				return assert.Equal(t, token("2"), got)
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "next page",
+			args: args{
+				req: &orchestrator.ListCloudServicesRequest{
+					PageSize:  2,
+					PageToken: token("2"),
+				},
+				storage: storage,
+				opts:    PaginationOpts{10, 10},
+			},
+			wantPage: func(t *testing.T, got []*orchestrator.CloudService) bool {
+				return assert.Equal(t, []string{"3", "4"}, ids(got))
+			},
+			wantNbt: func(t *testing.T, got string) bool {
+				return assert.Equal(t, token("4"), got)
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "last page",
+			args: args{
+				req: &orchestrator.ListCloudServicesRequest{
+					PageSize:  2,
+					PageToken: token("4"),
+				},
+				storage: storage,
+				opts:    PaginationOpts{10, 10},
+			},
+			wantPage: func(t *testing.T, got []*orchestrator.CloudService) bool {
+				return assert.Equal(t, []string{"5"}, ids(got))
+			},
+			wantNbt: func(t *testing.T, got string) bool {
+				return assert.Equal(t, "", got)
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "next page with order by and conditions",
+			args: args{
+				req: &orchestrator.ListCloudServicesRequest{
+					PageSize:  2,
+					PageToken: token("4"),
+					OrderBy:   "name",
+					Asc:       true,
+				},
+				storage: storage,
+				opts:    PaginationOpts{10, 10},
+				conds:   []interface{}{"id <> ?", "2"},
+			},
+			wantPage: func(t *testing.T, got []*orchestrator.CloudService) bool {
+				return assert.Equal(t, []string{"3", "1"}, ids(got))
+			},
+			wantNbt: func(t *testing.T, got string) bool {
+				return assert.Equal(t, token("1"), got)
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "invalid page token",
+			args: args{
+				req: &orchestrator.ListCloudServicesRequest{
+					PageToken: "!!!",
+				},
+				storage: storage,
+				opts:    PaginationOpts{10, 10},
+			},
+			wantPage: assert.Nil[[]*orchestrator.CloudService],
+			wantNbt: func(t *testing.T, got string) bool {
+				return assert.Equal(t, "", got)
+			},
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "could not decode page token")
+			},
+		},
+		{
+			name: "database error",
+			args: args{
+				req:     &orchestrator.ListCloudServicesRequest{},
+				storage: &testutil.StorageWithError{ListErr: persistence.ErrUnsupportedType},
+				opts:    PaginationOpts{10, 10},
+			},
+			wantPage: assert.Nil[[]*orchestrator.CloudService],
+			wantNbt: func(t *testing.T, got string) bool {
+				return assert.Equal(t, "", got)
+			},
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, persistence.ErrUnsupportedType)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotPage, gotNbt, err := PaginateStorageWithCursor[*orchestrator.CloudService](tt.args.req, tt.args.storage,
+				tt.args.opts, tt.args.conds...)
+
+			tt.wantErr(t, err)
+			tt.wantNbt(t, gotNbt)
+			tt.wantPage(t, gotPage)
+		})
+	}
+}
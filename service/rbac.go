@@ -0,0 +1,297 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package service
+
+import (
+	"context"
+	"path"
+	"slices"
+
+	"clouditor.io/clouditor/v2/api"
+)
+
+const (
+	// RoleAdmin permits all RPCs.
+	RoleAdmin = "admin"
+
+	// RoleAuditor permits reading everything as well as managing certificates, attestations and evaluations.
+	RoleAuditor = "auditor"
+
+	// RoleMetricAuthor permits reading everything as well as managing metrics, their implementations and
+	// configurations.
+	RoleMetricAuthor = "metric-author"
+
+	// RoleReadOnly permits reading everything.
+	RoleReadOnly = "read-only"
+
+	// RoleAssessmentService permits the RPCs the assessment service needs to call, i.e., retrieving metrics as well as
+	// storing evidences and assessment results.
+	RoleAssessmentService = "assessment-service"
+
+	// RoleDiscoveryService permits the RPCs the discovery service (and edge bridge) as well as external evidence
+	// collectors (agents) need to call, i.e., sending evidences to the assessment service and registering agents.
+	RoleDiscoveryService = "discovery-service"
+
+	// RoleEvaluationService permits the RPCs the evaluation service needs to call, i.e., reading from the orchestrator,
+	// updating the state of certificates and sending notifications.
+	RoleEvaluationService = "evaluation-service"
+)
+
+// Permissions maps a role to the RPCs it permits. An RPC is specified by a pattern of its full method name, e.g.,
+// "/clouditor.orchestrator.v1.Orchestrator/ListMetrics", using the syntax of [path.Match]. This way, all RPCs of a
+// service ("/clouditor.orchestrator.v1.Orchestrator/*") or a group of RPCs across all services ("/*/List*") can be
+// permitted at once.
+type Permissions map[string][]string
+
+// readPermissions contains the RPCs that only read data and are therefore permitted to all roles.
+var readPermissions = []string{
+	"/*/Get*",
+	"/*/List*",
+	"/*/Subscribe*",
+	"/*/Export*",
+	"/*/DrillDown*",
+	"/clouditor.evaluation.v1.Evaluation/GenerateComplianceReport",
+	"/clouditor.discovery.v1experimental.ExperimentalDiscovery/QueryGraph",
+}
+
+// DefaultPermissions contains the default permissions of the roles [RoleAdmin], [RoleAuditor], [RoleMetricAuthor]
+// and [RoleReadOnly].
+var DefaultPermissions = Permissions{
+	RoleAdmin: {"/*/*"},
+	RoleAuditor: append([]string{
+		"/clouditor.orchestrator.v1.Orchestrator/CreateCertificate",
+		"/clouditor.orchestrator.v1.Orchestrator/UpdateCertificate",
+		"/clouditor.orchestrator.v1.Orchestrator/UpdateCertificateState",
+		"/clouditor.orchestrator.v1.Orchestrator/RemoveCertificate",
+		"/clouditor.orchestrator.v1.Orchestrator/RestoreCertificate",
+		"/clouditor.evaluation.v1.Evaluation/StartEvaluation",
+		"/clouditor.evaluation.v1.Evaluation/StopEvaluation",
+		"/clouditor.evaluation.v1.Evaluation/TriggerEvaluation",
+		"/clouditor.evaluation.v1.Evaluation/CreateAttestation",
+		"/clouditor.evaluation.v1.Evaluation/AddAttestationDocument",
+		"/clouditor.evaluation.v1.Evaluation/RemoveAttestation",
+	}, readPermissions...),
+	RoleMetricAuthor: append([]string{
+		"/clouditor.orchestrator.v1.Orchestrator/CreateMetric",
+		"/clouditor.orchestrator.v1.Orchestrator/UpdateMetric",
+		"/clouditor.orchestrator.v1.Orchestrator/RemoveMetric",
+		"/clouditor.orchestrator.v1.Orchestrator/RestoreMetric",
+		"/clouditor.orchestrator.v1.Orchestrator/PublishMetric",
+		"/clouditor.orchestrator.v1.Orchestrator/UpdateMetricImplementation",
+		"/clouditor.orchestrator.v1.Orchestrator/TestMetricImplementation",
+		"/clouditor.orchestrator.v1.Orchestrator/UpdateMetricConfiguration",
+		"/clouditor.orchestrator.v1.Orchestrator/DisableMetric",
+		"/clouditor.orchestrator.v1.Orchestrator/EnableMetric",
+		"/clouditor.orchestrator.v1.Orchestrator/AddControlMetric",
+		"/clouditor.orchestrator.v1.Orchestrator/RemoveControlMetric",
+	}, readPermissions...),
+	RoleReadOnly: readPermissions,
+	RoleAssessmentService: {
+		"/clouditor.orchestrator.v1.Orchestrator/GetMetric*",
+		"/clouditor.orchestrator.v1.Orchestrator/ListMetric*",
+		"/clouditor.orchestrator.v1.Orchestrator/SubscribeMetricChangeEvents",
+		"/clouditor.orchestrator.v1.Orchestrator/StoreAssessmentResult*",
+		"/clouditor.evidence.v1.EvidenceStore/StoreEvidence*",
+	},
+	RoleDiscoveryService: {
+		"/clouditor.assessment.v1.Assessment/AssessEvidence*",
+		"/clouditor.orchestrator.v1.Orchestrator/RegisterAgent",
+		"/clouditor.orchestrator.v1.Orchestrator/SendAgentHeartbeat",
+	},
+	RoleEvaluationService: {
+		"/clouditor.orchestrator.v1.Orchestrator/Get*",
+		"/clouditor.orchestrator.v1.Orchestrator/List*",
+		"/clouditor.orchestrator.v1.Orchestrator/ReportCertificationObservation",
+		"/clouditor.orchestrator.v1.Orchestrator/SendNotification",
+	},
+}
+
+// Permits checks whether one of the given roles permits the RPC with the given full method name.
+func (p Permissions) Permits(fullMethod string, roles ...string) bool {
+	for _, role := range roles {
+		for _, pattern := range p[role] {
+			if ok, _ := path.Match(pattern, fullMethod); ok {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// RoleAssignmentProvider is an interface that retrieves the roles assigned to a user, e.g., by the Orchestrator. If
+// cloudServiceID is empty, only the roles that are assigned for all cloud services are returned, otherwise also the
+// ones that are assigned for this specific cloud service.
+type RoleAssignmentProvider interface {
+	AssignedRoles(ctx context.Context, user string, cloudServiceID string) (roles []string, err error)
+}
+
+// RBAC enforces role-based access control on the RPCs of our services. The roles of the current user are collected
+// from
+//   - the authorization strategy, if it implements [RoleChecker], e.g., the roles claim of the token,
+//   - the configured admins, if the authorization strategy implements [UserIdentifier], and
+//   - the role assignments of the user, if a [RoleAssignmentProvider] is configured. Role assignments for a specific
+//     cloud service only apply to requests that implement [api.CloudServiceRequest] for this cloud service.
+//
+// RBAC only decides whether the user is permitted to call an RPC at all. Whether the user has access to the requested
+// cloud service is still decided by the authorization strategy of the individual service. Requests that were
+// authenticated by an API key are not subject to RBAC, since they are already restricted to [APIKeyMethods]. The same
+// applies to requests that were authenticated by an auditor grant, which are restricted to [AuditorGrantMethods].
+//
+// If the authorization strategy implements [ScopeRetriever] and the token carries scopes that name a role, e.g., a
+// service token obtained by token exchange, the RPC additionally needs to be permitted by one of these scopes. Scopes
+// therefore only restrict the permissions of a token, but never extend them.
+type RBAC struct {
+	authz       AuthorizationStrategy
+	assignments RoleAssignmentProvider
+	permissions Permissions
+	admins      []string
+}
+
+// WithRoleAssignments is an option to retrieve the roles of a user additionally from a [RoleAssignmentProvider].
+func WithRoleAssignments(provider RoleAssignmentProvider) Option[RBAC] {
+	return func(r *RBAC) {
+		r.assignments = provider
+	}
+}
+
+// WithPermissions is an option to use different permissions than [DefaultPermissions].
+func WithPermissions(permissions Permissions) Option[RBAC] {
+	return func(r *RBAC) {
+		r.permissions = permissions
+	}
+}
+
+// WithAdmins is an option to always grant [RoleAdmin] to the given users. This is needed to bootstrap the role
+// assignments and for the service accounts of our own services.
+func WithAdmins(users ...string) Option[RBAC] {
+	return func(r *RBAC) {
+		r.admins = users
+	}
+}
+
+// NewRBAC creates a new [RBAC] that uses authz to retrieve the current user and their roles.
+func NewRBAC(authz AuthorizationStrategy, opts ...Option[RBAC]) *RBAC {
+	r := &RBAC{
+		authz:       authz,
+		permissions: DefaultPermissions,
+	}
+
+	for _, o := range opts {
+		o(r)
+	}
+
+	return r
+}
+
+// Authorize checks whether the current user is permitted to call the RPC with the given full method name. The request
+// is used to determine the cloud service of role assignments and can be nil, e.g., for streams, in which case only
+// roles for all cloud services are considered.
+func (r *RBAC) Authorize(ctx context.Context, fullMethod string, req any) error {
+	var cloudServiceID string
+
+	if _, ok := APIKeyScopeFromContext(ctx); ok && slices.Contains(APIKeyMethods, fullMethod) {
+		return nil
+	}
+
+	if _, ok := AuditorGrantScopeFromContext(ctx); ok {
+		if slices.Contains(AuditorGrantMethods, fullMethod) {
+			return nil
+		}
+
+		return ErrPermissionDenied
+	}
+
+	if csr, ok := req.(api.CloudServiceRequest); ok {
+		cloudServiceID = csr.GetCloudServiceId()
+	}
+
+	if !r.permissions.Permits(fullMethod, r.Roles(ctx, cloudServiceID)...) {
+		log.Debugf("Permission to call %s denied", fullMethod)
+		return ErrPermissionDenied
+	}
+
+	if scopes := r.Scopes(ctx); len(scopes) > 0 && !r.permissions.Permits(fullMethod, scopes...) {
+		log.Debugf("Permission to call %s denied by the scopes %v of the token", fullMethod, scopes)
+		return ErrPermissionDenied
+	}
+
+	return nil
+}
+
+// Scopes retrieves the scopes of the current token that name a role. Other scopes, such as "openid" or "profile",
+// are ignored.
+func (r *RBAC) Scopes(ctx context.Context) (scopes []string) {
+	retriever, ok := r.authz.(ScopeRetriever)
+	if !ok {
+		return nil
+	}
+
+	for _, scope := range retriever.Scopes(ctx) {
+		if _, ok := r.permissions[scope]; ok {
+			scopes = append(scopes, scope)
+		}
+	}
+
+	return scopes
+}
+
+// Roles retrieves the roles of the current user. If cloudServiceID is not empty, the roles assigned for this cloud
+// service are included.
+func (r *RBAC) Roles(ctx context.Context, cloudServiceID string) (roles []string) {
+	if checker, ok := r.authz.(RoleChecker); ok {
+		for role := range r.permissions {
+			if checker.HasRole(ctx, role) {
+				roles = append(roles, role)
+			}
+		}
+	}
+
+	identifier, ok := r.authz.(UserIdentifier)
+	if !ok {
+		return roles
+	}
+
+	user, ok := identifier.CurrentUser(ctx)
+	if !ok {
+		return roles
+	}
+
+	if slices.Contains(r.admins, user) {
+		roles = append(roles, RoleAdmin)
+	}
+
+	if r.assignments != nil {
+		assigned, err := r.assignments.AssignedRoles(ctx, user, cloudServiceID)
+		if err != nil {
+			log.Errorf("Could not retrieve role assignments of user %s: %v", user, err)
+		}
+
+		roles = append(roles, assigned...)
+	}
+
+	return roles
+}
@@ -0,0 +1,334 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc/metadata"
+)
+
+// mockRoleAssignments is a [RoleAssignmentProvider] that contains the roles of a user per cloud service. The empty
+// cloud service ID contains the roles for all cloud services.
+type mockRoleAssignments map[string]map[string][]string
+
+func (m mockRoleAssignments) AssignedRoles(_ context.Context, user string, cloudServiceID string) (roles []string, err error) {
+	if m == nil {
+		return nil, errors.New("some error")
+	}
+
+	roles = append(roles, m[user][""]...)
+
+	if cloudServiceID != "" {
+		roles = append(roles, m[user][cloudServiceID]...)
+	}
+
+	return roles, nil
+}
+
+func TestPermissions_Permits(t *testing.T) {
+	type args struct {
+		fullMethod string
+		roles      []string
+	}
+	tests := []struct {
+		name string
+		p    Permissions
+		args args
+		want bool
+	}{
+		{
+			name: "admin permits everything",
+			p:    DefaultPermissions,
+			args: args{
+				fullMethod: "/clouditor.orchestrator.v1.Orchestrator/RemoveCloudService",
+				roles:      []string{RoleAdmin},
+			},
+			want: true,
+		},
+		{
+			name: "read-only permits reading",
+			p:    DefaultPermissions,
+			args: args{
+				fullMethod: "/clouditor.orchestrator.v1.Orchestrator/ListCloudServices",
+				roles:      []string{RoleReadOnly},
+			},
+			want: true,
+		},
+		{
+			name: "read-only does not permit writing",
+			p:    DefaultPermissions,
+			args: args{
+				fullMethod: "/clouditor.orchestrator.v1.Orchestrator/RemoveCloudService",
+				roles:      []string{RoleReadOnly},
+			},
+			want: false,
+		},
+		{
+			name: "metric-author permits updating metrics",
+			p:    DefaultPermissions,
+			args: args{
+				fullMethod: "/clouditor.orchestrator.v1.Orchestrator/UpdateMetricImplementation",
+				roles:      []string{RoleReadOnly, RoleMetricAuthor},
+			},
+			want: true,
+		},
+		{
+			name: "auditor does not permit updating metrics",
+			p:    DefaultPermissions,
+			args: args{
+				fullMethod: "/clouditor.orchestrator.v1.Orchestrator/UpdateMetricImplementation",
+				roles:      []string{RoleAuditor},
+			},
+			want: false,
+		},
+		{
+			name: "auditor permits starting evaluations",
+			p:    DefaultPermissions,
+			args: args{
+				fullMethod: "/clouditor.evaluation.v1.Evaluation/StartEvaluation",
+				roles:      []string{RoleAuditor},
+			},
+			want: true,
+		},
+		{
+			name: "no roles",
+			p:    DefaultPermissions,
+			args: args{
+				fullMethod: "/clouditor.orchestrator.v1.Orchestrator/ListCloudServices",
+			},
+			want: false,
+		},
+		{
+			name: "unknown role",
+			p:    DefaultPermissions,
+			args: args{
+				fullMethod: "/clouditor.orchestrator.v1.Orchestrator/ListCloudServices",
+				roles:      []string{"risk-manager"},
+			},
+			want: false,
+		},
+		{
+			name: "custom permissions",
+			p: Permissions{
+				"risk-manager": {"/clouditor.orchestrator.v1.Orchestrator/*Waiver*"},
+			},
+			args: args{
+				fullMethod: "/clouditor.orchestrator.v1.Orchestrator/CreateWaiver",
+				roles:      []string{"risk-manager"},
+			},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.p.Permits(tt.args.fullMethod, tt.args.roles...))
+		})
+	}
+}
+
+func TestRBAC_Authorize(t *testing.T) {
+	type fields struct {
+		authz AuthorizationStrategy
+		opts  []Option[RBAC]
+	}
+	type args struct {
+		ctx        context.Context
+		fullMethod string
+		req        any
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr assert.WantErr
+	}{
+		{
+			name: "allow all",
+			fields: fields{
+				authz: &AuthorizationStrategyAllowAll{},
+			},
+			args: args{
+				ctx:        context.Background(),
+				fullMethod: "/clouditor.orchestrator.v1.Orchestrator/RemoveCloudService",
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "admin role in claim",
+			fields: fields{
+				authz: &AuthorizationStrategyJWT{RolesKey: "groups"},
+			},
+			args: args{
+				ctx:        TestContextAllowAll,
+				fullMethod: "/clouditor.orchestrator.v1.Orchestrator/RemoveCloudService",
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "role in claim without permission",
+			fields: fields{
+				authz: &AuthorizationStrategyJWT{},
+			},
+			args: args{
+				ctx:        TestContextOnlyService1,
+				fullMethod: "/clouditor.orchestrator.v1.Orchestrator/ListCloudServices",
+			},
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, ErrPermissionDenied)
+			},
+		},
+		{
+			name: "configured admin",
+			fields: fields{
+				authz: &AuthorizationStrategyJWT{},
+				opts:  []Option[RBAC]{WithAdmins("me")},
+			},
+			args: args{
+				ctx:        TestContextOnlyService1,
+				fullMethod: "/clouditor.orchestrator.v1.Orchestrator/RemoveCloudService",
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "role assigned for the requested cloud service",
+			fields: fields{
+				authz: &AuthorizationStrategyJWT{},
+				opts: []Option[RBAC]{WithRoleAssignments(mockRoleAssignments{
+					"me": {testdata.MockCloudServiceID1: {RoleReadOnly}},
+				})},
+			},
+			args: args{
+				ctx:        TestContextOnlyService1,
+				fullMethod: "/clouditor.orchestrator.v1.Orchestrator/GetCloudService",
+				req:        &orchestrator.GetCloudServiceRequest{CloudServiceId: testdata.MockCloudServiceID1},
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "role assigned for another cloud service",
+			fields: fields{
+				authz: &AuthorizationStrategyJWT{},
+				opts: []Option[RBAC]{WithRoleAssignments(mockRoleAssignments{
+					"me": {testdata.MockCloudServiceID2: {RoleReadOnly}},
+				})},
+			},
+			args: args{
+				ctx:        TestContextOnlyService1,
+				fullMethod: "/clouditor.orchestrator.v1.Orchestrator/GetCloudService",
+				req:        &orchestrator.GetCloudServiceRequest{CloudServiceId: testdata.MockCloudServiceID1},
+			},
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, ErrPermissionDenied)
+			},
+		},
+		{
+			name: "role assigned for all cloud services without permission",
+			fields: fields{
+				authz: &AuthorizationStrategyJWT{},
+				opts: []Option[RBAC]{WithRoleAssignments(mockRoleAssignments{
+					"me": {"": {RoleReadOnly}},
+				})},
+			},
+			args: args{
+				ctx:        TestContextOnlyService1,
+				fullMethod: "/clouditor.orchestrator.v1.Orchestrator/RemoveCloudService",
+				req:        &orchestrator.RemoveCloudServiceRequest{CloudServiceId: testdata.MockCloudServiceID1},
+			},
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, ErrPermissionDenied)
+			},
+		},
+		{
+			name: "role assignments error",
+			fields: fields{
+				authz: &AuthorizationStrategyJWT{},
+				opts:  []Option[RBAC]{WithRoleAssignments(mockRoleAssignments(nil))},
+			},
+			args: args{
+				ctx:        TestContextOnlyService1,
+				fullMethod: "/clouditor.orchestrator.v1.Orchestrator/ListCloudServices",
+			},
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, ErrPermissionDenied)
+			},
+		},
+		{
+			name: "no token",
+			fields: fields{
+				authz: &AuthorizationStrategyJWT{},
+				opts:  []Option[RBAC]{WithAdmins("me")},
+			},
+			args: args{
+				ctx:        context.Background(),
+				fullMethod: "/clouditor.orchestrator.v1.Orchestrator/ListCloudServices",
+			},
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, ErrPermissionDenied)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewRBAC(tt.fields.authz, tt.fields.opts...)
+
+			err := r.Authorize(tt.args.ctx, tt.args.fullMethod, tt.args.req)
+			tt.wantErr(t, err)
+		})
+	}
+}
+
+func TestRBAC_Authorize_scopes(t *testing.T) {
+	s, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"sub":   "clouditor",
+		"scope": "openid " + RoleAssessmentService,
+	}).SignedString([]byte("mykey"))
+	assert.NoError(t, err)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.New(map[string]string{
+		"authorization": "bearer " + s,
+	}))
+
+	// The service client is an admin, but its token is restricted to the RPCs of the assessment service
+	r := NewRBAC(&AuthorizationStrategyJWT{}, WithAdmins("clouditor"))
+
+	assert.NoError(t, r.Authorize(ctx, "/clouditor.orchestrator.v1.Orchestrator/StoreAssessmentResults", nil))
+	assert.NoError(t, r.Authorize(ctx, "/clouditor.orchestrator.v1.Orchestrator/GetMetricConfiguration", nil))
+	assert.ErrorIs(t, r.Authorize(ctx, "/clouditor.orchestrator.v1.Orchestrator/RemoveCatalog", nil), ErrPermissionDenied)
+	assert.ErrorIs(t, r.Authorize(ctx, "/clouditor.orchestrator.v1.Orchestrator/ListCloudServices", nil), ErrPermissionDenied)
+
+	// Scopes never extend the permissions of the user
+	r = NewRBAC(&AuthorizationStrategyJWT{})
+
+	assert.ErrorIs(t, r.Authorize(ctx, "/clouditor.orchestrator.v1.Orchestrator/StoreAssessmentResults", nil), ErrPermissionDenied)
+}
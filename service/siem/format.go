@@ -0,0 +1,172 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package siem
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"clouditor.io/clouditor/v2/api/assessment"
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/service"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	// DefaultHECSourceType is the default source type of Splunk HEC events.
+	DefaultHECSourceType = "clouditor:assessment_result"
+
+	// DefaultHECSource is the default source of Splunk HEC events.
+	DefaultHECSource = "clouditor"
+)
+
+var (
+	// cefSeverities maps the severity of a result to the CEF severity (0-10)
+	cefSeverities = map[orchestrator.ControlSeverity]int{
+		orchestrator.ControlSeverity_CONTROL_SEVERITY_LOW:      3,
+		orchestrator.ControlSeverity_CONTROL_SEVERITY_MEDIUM:   5,
+		orchestrator.ControlSeverity_CONTROL_SEVERITY_HIGH:     8,
+		orchestrator.ControlSeverity_CONTROL_SEVERITY_CRITICAL: 10,
+	}
+
+	// cefHeaderEscaper escapes the pipe and backslash characters in CEF header fields
+	cefHeaderEscaper = strings.NewReplacer(`\`, `\\`, `|`, `\|`, "\r", " ", "\n", " ")
+
+	// cefExtensionEscaper escapes the equal sign, backslash and line breaks in CEF extension values
+	cefExtensionEscaper = strings.NewReplacer(`\`, `\\`, `=`, `\=`, "\r", `\r`, "\n", `\n`)
+)
+
+// CEFFormatter formats results as messages in the ArcSight Common Event Format (CEF).
+type CEFFormatter struct {
+	Vendor  string
+	Product string
+	Version string
+}
+
+// NewCEFFormatter creates a new [CEFFormatter] that identifies the running Clouditor version as device.
+func NewCEFFormatter() *CEFFormatter {
+	rt, _ := service.GetRuntimeInfo()
+
+	return &CEFFormatter{
+		Vendor:  "Clouditor",
+		Product: "Clouditor",
+		Version: rt.VersionString(),
+	}
+}
+
+// Format formats the result as CEF message. The metric is used as signature ID of the event.
+func (c *CEFFormatter) Format(result *assessment.AssessmentResult, severity orchestrator.ControlSeverity) ([]byte, error) {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "CEF:0|%s|%s|%s|%s|%s|%d|",
+		cefHeaderEscaper.Replace(c.Vendor),
+		cefHeaderEscaper.Replace(c.Product),
+		cefHeaderEscaper.Replace(c.Version),
+		cefHeaderEscaper.Replace(result.GetMetricId()),
+		"Non-compliant resource",
+		cefSeverities[normalizeSeverity(severity)],
+	)
+
+	extensions := [][2]string{
+		{"rt", fmt.Sprint(result.GetTimestamp().AsTime().UnixMilli())},
+		{"externalId", result.GetId()},
+		{"cs1Label", "cloudServiceId"},
+		{"cs1", result.GetCloudServiceId()},
+		{"cs2Label", "resourceId"},
+		{"cs2", result.GetResourceId()},
+		{"cs3Label", "resourceTypes"},
+		{"cs3", strings.Join(result.GetResourceTypes(), ",")},
+		{"cs4Label", "evidenceId"},
+		{"cs4", result.GetEvidenceId()},
+		{"msg", result.GetNonComplianceComments()},
+	}
+
+	for i, e := range extensions {
+		if i > 0 {
+			b.WriteByte(' ')
+		}
+
+		b.WriteString(e[0])
+		b.WriteByte('=')
+		b.WriteString(cefExtensionEscaper.Replace(e[1]))
+	}
+
+	return []byte(b.String()), nil
+}
+
+// hecEvent is a single event of the Splunk HTTP Event Collector.
+type hecEvent struct {
+	Time       float64           `json:"time"`
+	Host       string            `json:"host,omitempty"`
+	Source     string            `json:"source,omitempty"`
+	SourceType string            `json:"sourcetype,omitempty"`
+	Index      string            `json:"index,omitempty"`
+	Event      json.RawMessage   `json:"event"`
+	Fields     map[string]string `json:"fields,omitempty"`
+}
+
+// HECFormatter formats results as JSON events of the Splunk HTTP Event Collector (HEC). The result itself is used as
+// event data, the cloud service, metric and severity are additionally added as indexed fields.
+type HECFormatter struct {
+	Host       string
+	Source     string
+	SourceType string
+	Index      string
+}
+
+// NewHECFormatter creates a new [HECFormatter] that sends events to the given index. If index is empty, the default
+// index of the HEC token is used.
+func NewHECFormatter(index string) *HECFormatter {
+	return &HECFormatter{
+		Source:     DefaultHECSource,
+		SourceType: DefaultHECSourceType,
+		Index:      index,
+	}
+}
+
+// Format formats the result as HEC event.
+func (h *HECFormatter) Format(result *assessment.AssessmentResult, severity orchestrator.ControlSeverity) ([]byte, error) {
+	data, err := protojson.Marshal(result)
+	if err != nil {
+		return nil, fmt.Errorf("could not serialize result: %w", err)
+	}
+
+	return json.Marshal(&hecEvent{
+		Time:       float64(result.GetTimestamp().AsTime().UnixMilli()) / 1000,
+		Host:       h.Host,
+		Source:     h.Source,
+		SourceType: h.SourceType,
+		Index:      h.Index,
+		Event:      data,
+		Fields: map[string]string{
+			"cloud_service_id": result.GetCloudServiceId(),
+			"metric_id":        result.GetMetricId(),
+			"severity":         strings.TrimPrefix(normalizeSeverity(severity).String(), "CONTROL_SEVERITY_"),
+		},
+	})
+}
@@ -0,0 +1,102 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package siem
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"clouditor.io/clouditor/v2/api/assessment"
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var mockResult = &assessment.AssessmentResult{
+	Id:                    testdata.MockAssessmentResult1ID,
+	Timestamp:             timestamppb.New(time.Unix(1, 0)),
+	MetricId:              testdata.MockMetricID1,
+	EvidenceId:            testdata.MockEvidenceID1,
+	ResourceId:            testdata.MockResourceID1,
+	ResourceTypes:         []string{"VirtualMachine", "Compute"},
+	NonComplianceComments: "boot logging=disabled\nsecond line",
+	CloudServiceId:        testdata.MockCloudServiceID1,
+}
+
+func TestCEFFormatter_Format(t *testing.T) {
+	c := &CEFFormatter{Vendor: "Clouditor", Product: "Clouditor|Engine", Version: "v1.0.0"}
+
+	got, err := c.Format(mockResult, orchestrator.ControlSeverity_CONTROL_SEVERITY_HIGH)
+	assert.NoError(t, err)
+	assert.Equal(t, "CEF:0|Clouditor|Clouditor\\|Engine|v1.0.0|"+testdata.MockMetricID1+"|Non-compliant resource|8|"+
+		"rt=1000 externalId="+testdata.MockAssessmentResult1ID+
+		" cs1Label=cloudServiceId cs1="+testdata.MockCloudServiceID1+
+		" cs2Label=resourceId cs2="+testdata.MockResourceID1+
+		" cs3Label=resourceTypes cs3=VirtualMachine,Compute"+
+		" cs4Label=evidenceId cs4="+testdata.MockEvidenceID1+
+		" msg=boot logging\\=disabled\\nsecond line", string(got))
+
+	// Results without a severity are treated as low
+	got, err = c.Format(mockResult, orchestrator.ControlSeverity_CONTROL_SEVERITY_UNSPECIFIED)
+	assert.NoError(t, err)
+	assert.Contains(t, string(got), "|Non-compliant resource|3|")
+}
+
+func TestHECFormatter_Format(t *testing.T) {
+	var (
+		event  hecEvent
+		result assessment.AssessmentResult
+	)
+
+	h := NewHECFormatter("main")
+
+	got, err := h.Format(mockResult, orchestrator.ControlSeverity_CONTROL_SEVERITY_CRITICAL)
+	assert.NoError(t, err)
+	assert.NoError(t, json.Unmarshal(got, &event))
+	assert.Equal(t, float64(1), event.Time)
+	assert.Equal(t, "main", event.Index)
+	assert.Equal(t, DefaultHECSourceType, event.SourceType)
+	assert.Equal(t, "CRITICAL", event.Fields["severity"])
+	assert.Equal(t, testdata.MockCloudServiceID1, event.Fields["cloud_service_id"])
+	assert.NoError(t, protojson.Unmarshal(event.Event, &result))
+	assert.Equal(t, mockResult.Id, result.Id)
+}
+
+func TestSyslogFormatter_Format(t *testing.T) {
+	s := &SyslogFormatter{
+		Formatter: &CEFFormatter{Vendor: "Clouditor", Product: "Clouditor", Version: "v1.0.0"},
+		Hostname:  "host",
+		AppName:   "clouditor",
+	}
+
+	got, err := s.Format(mockResult, orchestrator.ControlSeverity_CONTROL_SEVERITY_CRITICAL)
+	assert.NoError(t, err)
+	assert.Contains(t, string(got), "<130>1 1970-01-01T00:00:01Z host clouditor - - - CEF:0|Clouditor|")
+}
@@ -0,0 +1,224 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package siem
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"clouditor.io/clouditor/v2/api/assessment"
+	"clouditor.io/clouditor/v2/api/orchestrator"
+)
+
+const (
+	// DefaultSyslogPort is the default port of a syslog server.
+	DefaultSyslogPort = "514"
+
+	// DefaultHECPath is the path of the event endpoint of the Splunk HTTP Event Collector.
+	DefaultHECPath = "/services/collector/event"
+
+	// syslogFacility is the syslog facility of the messages (local0).
+	syslogFacility = 16
+)
+
+// syslogSeverities maps the severity of a result to the syslog severity
+var syslogSeverities = map[orchestrator.ControlSeverity]int{
+	orchestrator.ControlSeverity_CONTROL_SEVERITY_LOW:      5, // notice
+	orchestrator.ControlSeverity_CONTROL_SEVERITY_MEDIUM:   4, // warning
+	orchestrator.ControlSeverity_CONTROL_SEVERITY_HIGH:     3, // error
+	orchestrator.ControlSeverity_CONTROL_SEVERITY_CRITICAL: 2, // critical
+}
+
+// SyslogSender is a [Sender] that sends events as RFC 5424 syslog messages over TCP or UDP. Over TCP, messages are
+// framed using octet counting (RFC 6587). The events themselves need to carry their severity, therefore the sender
+// should be used together with a [SyslogFormatter].
+type SyslogSender struct {
+	network string
+	addr    string
+
+	conn net.Conn
+}
+
+// NewSyslogSender creates a new [SyslogSender] for the syslog server at rawURL, e.g., "tcp://localhost:514" or
+// "udp://localhost:514".
+func NewSyslogSender(rawURL string) (s *SyslogSender, err error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid syslog URL: %w", err)
+	}
+
+	if u.Scheme != "tcp" && u.Scheme != "udp" {
+		return nil, fmt.Errorf("invalid syslog URL: unsupported scheme %q", u.Scheme)
+	}
+
+	s = &SyslogSender{
+		network: u.Scheme,
+		addr:    u.Host,
+	}
+
+	if u.Port() == "" {
+		s.addr = net.JoinHostPort(u.Hostname(), DefaultSyslogPort)
+	}
+
+	return s, nil
+}
+
+// Send sends each event as a separate syslog message. The connection is (re-)established lazily.
+func (s *SyslogSender) Send(ctx context.Context, events [][]byte) (err error) {
+	if s.conn == nil {
+		var d net.Dialer
+
+		s.conn, err = d.DialContext(ctx, s.network, s.addr)
+		if err != nil {
+			return fmt.Errorf("could not connect to syslog server: %w", err)
+		}
+	}
+
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = s.conn.SetWriteDeadline(deadline)
+	}
+
+	for _, event := range events {
+		if s.network == "tcp" {
+			_, err = fmt.Fprintf(s.conn, "%d %s", len(event), event)
+		} else {
+			_, err = s.conn.Write(event)
+		}
+
+		if err != nil {
+			// Discard the broken connection, so that the next attempt reconnects
+			_ = s.conn.Close()
+			s.conn = nil
+
+			return fmt.Errorf("could not send to syslog server: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Close closes the connection to the syslog server.
+func (s *SyslogSender) Close() (err error) {
+	if s.conn != nil {
+		err = s.conn.Close()
+		s.conn = nil
+	}
+
+	return
+}
+
+// SyslogFormatter wraps another [Formatter], e.g., the [CEFFormatter], and embeds its events into RFC 5424 syslog
+// messages.
+type SyslogFormatter struct {
+	Formatter
+
+	Hostname string
+	AppName  string
+}
+
+// NewSyslogFormatter creates a new [SyslogFormatter] that embeds the events of f.
+func NewSyslogFormatter(f Formatter) *SyslogFormatter {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "-"
+	}
+
+	return &SyslogFormatter{
+		Formatter: f,
+		Hostname:  hostname,
+		AppName:   "clouditor",
+	}
+}
+
+// Format formats the result using the embedded formatter and adds the syslog header.
+func (s *SyslogFormatter) Format(result *assessment.AssessmentResult, severity orchestrator.ControlSeverity) ([]byte, error) {
+	msg, err := s.Formatter.Format(result, severity)
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(fmt.Sprintf("<%d>1 %s %s %s - - - %s",
+		syslogFacility*8+syslogSeverities[normalizeSeverity(severity)],
+		result.GetTimestamp().AsTime().UTC().Format(time.RFC3339Nano),
+		s.Hostname,
+		s.AppName,
+		msg,
+	)), nil
+}
+
+// HECSender is a [Sender] that sends events to the Splunk HTTP Event Collector (HEC).
+type HECSender struct {
+	url    string
+	token  string
+	client *http.Client
+}
+
+// NewHECSender creates a new [HECSender] for the HEC at baseURL, e.g., "https://splunk:8088", which authenticates
+// using token.
+func NewHECSender(baseURL string, token string) *HECSender {
+	return &HECSender{
+		url:    strings.TrimSuffix(baseURL, "/") + DefaultHECPath,
+		token:  token,
+		client: http.DefaultClient,
+	}
+}
+
+// Send sends all events with a single request. The HEC accepts multiple concatenated JSON events.
+func (h *HECSender) Send(ctx context.Context, events [][]byte) (err error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, h.url, bytes.NewReader(bytes.Join(events, []byte("\n"))))
+	if err != nil {
+		return fmt.Errorf("could not create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Splunk "+h.token)
+
+	res, err := h.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("could not send to HEC: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+		return fmt.Errorf("could not send to HEC: unexpected status %d: %s", res.StatusCode, bytes.TrimSpace(msg))
+	}
+
+	return nil
+}
+
+// Close does nothing, since the HEC is stateless.
+func (*HECSender) Close() error {
+	return nil
+}
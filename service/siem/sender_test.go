@@ -0,0 +1,114 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package siem
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+)
+
+func TestNewSyslogSender(t *testing.T) {
+	s, err := NewSyslogSender("udp://localhost")
+	assert.NoError(t, err)
+	assert.Equal(t, "udp", s.network)
+	assert.Equal(t, "localhost:514", s.addr)
+
+	s, err = NewSyslogSender("http://localhost")
+	assert.ErrorContains(t, err, "unsupported scheme")
+	assert.Nil(t, s)
+}
+
+func TestSyslogSender_Send(t *testing.T) {
+	sock, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	defer sock.Close()
+
+	received := make(chan string, 1)
+
+	go func() {
+		conn, err := sock.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		// Read both framed messages
+		buf := make([]byte, len("5 hello5 world"))
+		_, _ = io.ReadFull(bufio.NewReader(conn), buf)
+		received <- string(buf)
+	}()
+
+	s, err := NewSyslogSender("tcp://" + sock.Addr().String())
+	assert.NoError(t, err)
+	defer s.Close()
+
+	err = s.Send(context.Background(), [][]byte{[]byte("hello"), []byte("world")})
+	assert.NoError(t, err)
+	assert.Equal(t, "5 hello5 world", <-received)
+}
+
+func TestHECSender_Send(t *testing.T) {
+	var (
+		path  string
+		auth  string
+		body  []byte
+		token = "token"
+	)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		auth = r.Header.Get("Authorization")
+		body, _ = io.ReadAll(r.Body)
+
+		if auth != "Splunk "+token {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"text":"Invalid token","code":4}`))
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"text":"Success","code":0}`))
+	}))
+	defer srv.Close()
+
+	h := NewHECSender(srv.URL+"/", token)
+	defer h.Close()
+
+	err := h.Send(context.Background(), [][]byte{[]byte(`{"event":1}`), []byte(`{"event":2}`)})
+	assert.NoError(t, err)
+	assert.Equal(t, DefaultHECPath, path)
+	assert.Equal(t, "{\"event\":1}\n{\"event\":2}", string(body))
+
+	h = NewHECSender(srv.URL, "wrong")
+	err = h.Send(context.Background(), [][]byte{[]byte(`{"event":1}`)})
+	assert.ErrorContains(t, err, "unexpected status 403")
+}
@@ -0,0 +1,340 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+// Package siem contains a forwarder that streams non-compliant assessment results to a SIEM, either as CEF messages
+// over syslog or as events of the Splunk HTTP Event Collector (HEC).
+package siem
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"clouditor.io/clouditor/v2/api/assessment"
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/service"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// DefaultBufferSize is the default number of events that are buffered before new events are dropped.
+	DefaultBufferSize = 1000
+
+	// DefaultBatchSize is the default maximum number of events that are sent together.
+	DefaultBatchSize = 100
+
+	// DefaultFlushInterval is the default maximum time an event is buffered before it is sent.
+	DefaultFlushInterval = 5 * time.Second
+
+	// DefaultMaxRetries is the default number of retries if sending a batch fails.
+	DefaultMaxRetries = 3
+
+	// DefaultRetryInterval is the default interval before the first retry of a failed batch.
+	DefaultRetryInterval = 5 * time.Second
+
+	// AnyCloudService can be used in a [Rule] to match the results of all cloud services.
+	AnyCloudService = "*"
+)
+
+// ErrInvalidRule is returned if a rule cannot be parsed.
+var ErrInvalidRule = errors.New("invalid rule")
+
+var log *logrus.Entry
+
+func init() {
+	log = logrus.WithField("component", "siem")
+}
+
+// Formatter formats an assessment result with the given severity as a single SIEM event.
+type Formatter interface {
+	Format(result *assessment.AssessmentResult, severity orchestrator.ControlSeverity) ([]byte, error)
+}
+
+// Sender sends a batch of formatted events to a SIEM endpoint.
+type Sender interface {
+	Send(ctx context.Context, events [][]byte) error
+	Close() error
+}
+
+// SeverityFunc determines the severity of an assessment result, e.g., based on the controls its metric is mapped to.
+type SeverityFunc func(result *assessment.AssessmentResult) orchestrator.ControlSeverity
+
+// Rule specifies which non-compliant results of a cloud service are forwarded. Results of the cloud service (or of all
+// cloud services, if CloudServiceID is [AnyCloudService]) are forwarded, if their severity is at least MinSeverity.
+type Rule struct {
+	CloudServiceID string
+	MinSeverity    orchestrator.ControlSeverity
+}
+
+// ParseRule parses a rule in the form "<cloud service ID>=<severity>", e.g., "*=high". The severity is one of low,
+// medium, high or critical. If the severity is omitted, all severities are matched.
+func ParseRule(s string) (r Rule, err error) {
+	id, sev, found := strings.Cut(s, "=")
+
+	r.CloudServiceID = strings.TrimSpace(id)
+	if r.CloudServiceID == "" {
+		return r, fmt.Errorf("%w: missing cloud service in %q", ErrInvalidRule, s)
+	}
+
+	if !found {
+		return r, nil
+	}
+
+	v, ok := orchestrator.ControlSeverity_value["CONTROL_SEVERITY_"+strings.ToUpper(strings.TrimSpace(sev))]
+	if !ok {
+		return r, fmt.Errorf("%w: unknown severity in %q", ErrInvalidRule, s)
+	}
+	r.MinSeverity = orchestrator.ControlSeverity(v)
+
+	return r, nil
+}
+
+// Matches checks whether the rule matches a result of the cloud service with the given severity. Results without a
+// severity are treated like results with a low severity.
+func (r Rule) Matches(cloudServiceID string, severity orchestrator.ControlSeverity) bool {
+	if r.CloudServiceID != AnyCloudService && r.CloudServiceID != cloudServiceID {
+		return false
+	}
+
+	return normalizeSeverity(severity) >= r.MinSeverity
+}
+
+// Forwarder buffers formatted non-compliant assessment results and sends them in batches to a SIEM. Its method
+// [Forwarder.ForwardAssessmentResult] can directly be registered as hook function of the orchestrator. The
+// forwarder needs to be started with [Forwarder.Start].
+type Forwarder struct {
+	formatter Formatter
+	sender    Sender
+	rules     []Rule
+	severity  SeverityFunc
+
+	queue         chan []byte
+	batchSize     int
+	flushInterval time.Duration
+	maxRetries    int
+	retryInterval time.Duration
+
+	wg sync.WaitGroup
+}
+
+// WithRules is an option to configure which results are forwarded. A result is forwarded if any of the rules matches.
+// Without rules, all non-compliant results are forwarded.
+func WithRules(rules ...Rule) service.Option[Forwarder] {
+	return func(f *Forwarder) {
+		f.rules = rules
+	}
+}
+
+// WithSeverityFunc is an option to configure how the severity of a result is determined. Without it, all results have
+// the severity [orchestrator.ControlSeverity_CONTROL_SEVERITY_UNSPECIFIED].
+func WithSeverityFunc(severity SeverityFunc) service.Option[Forwarder] {
+	return func(f *Forwarder) {
+		f.severity = severity
+	}
+}
+
+// WithBuffering is an option to configure the size of the buffer, the maximum number of events in a batch and the
+// maximum time an event is buffered before it is sent.
+func WithBuffering(bufferSize int, batchSize int, flushInterval time.Duration) service.Option[Forwarder] {
+	return func(f *Forwarder) {
+		f.queue = make(chan []byte, bufferSize)
+		f.batchSize = batchSize
+		f.flushInterval = flushInterval
+	}
+}
+
+// WithRetries is an option to configure how often and in which interval sending a batch is retried.
+func WithRetries(maxRetries int, interval time.Duration) service.Option[Forwarder] {
+	return func(f *Forwarder) {
+		f.maxRetries = maxRetries
+		f.retryInterval = interval
+	}
+}
+
+// NewForwarder creates a new forwarder that formats results with formatter and sends them with sender.
+func NewForwarder(formatter Formatter, sender Sender, opts ...service.Option[Forwarder]) (f *Forwarder) {
+	f = &Forwarder{
+		formatter:     formatter,
+		sender:        sender,
+		queue:         make(chan []byte, DefaultBufferSize),
+		batchSize:     DefaultBatchSize,
+		flushInterval: DefaultFlushInterval,
+		maxRetries:    DefaultMaxRetries,
+		retryInterval: DefaultRetryInterval,
+	}
+
+	for _, o := range opts {
+		o(f)
+	}
+
+	if f.batchSize < 1 {
+		f.batchSize = 1
+	}
+
+	return f
+}
+
+// Start sends the buffered events in the background until ctx is done. Afterwards, the remaining events are sent and
+// the sender is closed. [Forwarder.Wait] can be used to wait for this.
+func (f *Forwarder) Start(ctx context.Context) {
+	f.wg.Add(1)
+
+	go func() {
+		defer f.wg.Done()
+
+		f.run(ctx)
+	}()
+}
+
+// Wait waits until the forwarder has stopped after the context supplied to [Forwarder.Start] is done.
+func (f *Forwarder) Wait() {
+	f.wg.Wait()
+}
+
+// ForwardAssessmentResult buffers a non-compliant assessment result, if it matches the rules of the forwarder. It
+// implements [assessment.ResultHookFunc]. If the buffer is full, the result is dropped.
+func (f *Forwarder) ForwardAssessmentResult(_ context.Context, result *assessment.AssessmentResult, err error) {
+	var severity orchestrator.ControlSeverity
+
+	if err != nil || result == nil || result.Compliant {
+		return
+	}
+
+	if f.severity != nil {
+		severity = f.severity(result)
+	}
+
+	if !f.matches(result.CloudServiceId, severity) {
+		return
+	}
+
+	event, err := f.formatter.Format(result, severity)
+	if err != nil {
+		log.Errorf("Could not format assessment result %s: %v", result.Id, err)
+		return
+	}
+
+	select {
+	case f.queue <- event:
+	default:
+		log.Warnf("Dropping assessment result %s, because the SIEM buffer is full", result.Id)
+	}
+}
+
+// matches checks whether any of the rules matches. Without rules, everything matches.
+func (f *Forwarder) matches(cloudServiceID string, severity orchestrator.ControlSeverity) bool {
+	if len(f.rules) == 0 {
+		return true
+	}
+
+	for _, r := range f.rules {
+		if r.Matches(cloudServiceID, severity) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// run collects events from the queue and sends them if either the batch is full or the flush interval has passed.
+func (f *Forwarder) run(ctx context.Context) {
+	var (
+		batch  [][]byte
+		ticker = time.NewTicker(f.flushInterval)
+	)
+
+	defer ticker.Stop()
+	defer func() {
+		_ = f.sender.Close()
+	}()
+
+	for {
+		select {
+		case event := <-f.queue:
+			batch = append(batch, event)
+			if len(batch) >= f.batchSize {
+				f.send(ctx, batch)
+				batch = nil
+			}
+		case <-ticker.C:
+			if len(batch) > 0 {
+				f.send(ctx, batch)
+				batch = nil
+			}
+		case <-ctx.Done():
+			// Send everything that is still buffered, but do not retry anymore
+			for {
+				select {
+				case event := <-f.queue:
+					batch = append(batch, event)
+				default:
+					if len(batch) > 0 {
+						if err := f.sender.Send(context.Background(), batch); err != nil {
+							log.Errorf("Could not send %d event(s) to SIEM: %v", len(batch), err)
+						}
+					}
+					return
+				}
+			}
+		}
+	}
+}
+
+// send sends the batch. If sending fails, it is retried up to maxRetries times, doubling the waiting time with each
+// retry.
+func (f *Forwarder) send(ctx context.Context, batch [][]byte) {
+	for attempt := 0; ; attempt++ {
+		err := f.sender.Send(ctx, batch)
+		if err == nil {
+			return
+		}
+
+		if attempt >= f.maxRetries {
+			log.Errorf("Could not send %d event(s) to SIEM, giving up after %d attempt(s): %v", len(batch), attempt+1, err)
+			return
+		}
+
+		log.Debugf("Sending events to SIEM failed, retrying: %v", err)
+
+		select {
+		case <-time.After(f.retryInterval << attempt):
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// normalizeSeverity treats results without a severity like results with a low severity.
+func normalizeSeverity(severity orchestrator.ControlSeverity) orchestrator.ControlSeverity {
+	if severity == orchestrator.ControlSeverity_CONTROL_SEVERITY_UNSPECIFIED {
+		return orchestrator.ControlSeverity_CONTROL_SEVERITY_LOW
+	}
+
+	return severity
+}
@@ -0,0 +1,227 @@
+// Copyright 2024 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package siem
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"clouditor.io/clouditor/v2/api/assessment"
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+)
+
+// idFormatter formats a result as its ID.
+type idFormatter struct{}
+
+func (idFormatter) Format(result *assessment.AssessmentResult, _ orchestrator.ControlSeverity) ([]byte, error) {
+	return []byte(result.Id), nil
+}
+
+// mockSender records all sent batches. The first calls fail, until failures is used up.
+type mockSender struct {
+	mu       sync.Mutex
+	batches  [][][]byte
+	failures int
+	closed   bool
+}
+
+func (s *mockSender) Send(_ context.Context, events [][]byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.failures > 0 {
+		s.failures--
+		return errors.New("some error")
+	}
+
+	s.batches = append(s.batches, events)
+	return nil
+}
+
+func (s *mockSender) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.closed = true
+	return nil
+}
+
+func (s *mockSender) events() (events []string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, b := range s.batches {
+		for _, e := range b {
+			events = append(events, string(e))
+		}
+	}
+
+	return
+}
+
+func TestParseRule(t *testing.T) {
+	type args struct {
+		s string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    Rule
+		wantErr assert.WantErr
+	}{
+		{
+			name:    "any cloud service",
+			args:    args{s: "*=high"},
+			want:    Rule{CloudServiceID: AnyCloudService, MinSeverity: orchestrator.ControlSeverity_CONTROL_SEVERITY_HIGH},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name:    "without severity",
+			args:    args{s: testdata.MockCloudServiceID1},
+			want:    Rule{CloudServiceID: testdata.MockCloudServiceID1},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "missing cloud service",
+			args: args{s: "=low"},
+			want: Rule{},
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, ErrInvalidRule)
+			},
+		},
+		{
+			name: "unknown severity",
+			args: args{s: "*=urgent"},
+			want: Rule{CloudServiceID: AnyCloudService},
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "unknown severity")
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseRule(tt.args.s)
+			assert.Equal(t, tt.want, got)
+			tt.wantErr(t, err)
+		})
+	}
+}
+
+func TestRule_Matches(t *testing.T) {
+	type args struct {
+		cloudServiceID string
+		severity       orchestrator.ControlSeverity
+	}
+	tests := []struct {
+		name string
+		rule Rule
+		args args
+		want bool
+	}{
+		{
+			name: "other cloud service",
+			rule: Rule{CloudServiceID: testdata.MockCloudServiceID1},
+			args: args{cloudServiceID: testdata.MockCloudServiceID2},
+			want: false,
+		},
+		{
+			name: "severity too low",
+			rule: Rule{CloudServiceID: AnyCloudService, MinSeverity: orchestrator.ControlSeverity_CONTROL_SEVERITY_HIGH},
+			args: args{cloudServiceID: testdata.MockCloudServiceID1, severity: orchestrator.ControlSeverity_CONTROL_SEVERITY_MEDIUM},
+			want: false,
+		},
+		{
+			name: "unspecified severity counts as low",
+			rule: Rule{CloudServiceID: testdata.MockCloudServiceID1, MinSeverity: orchestrator.ControlSeverity_CONTROL_SEVERITY_LOW},
+			args: args{cloudServiceID: testdata.MockCloudServiceID1},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.rule.Matches(tt.args.cloudServiceID, tt.args.severity))
+		})
+	}
+}
+
+func TestForwarder_ForwardAssessmentResult(t *testing.T) {
+	sender := &mockSender{failures: 1}
+
+	f := NewForwarder(idFormatter{}, sender,
+		WithRules(
+			Rule{CloudServiceID: testdata.MockCloudServiceID1},
+			Rule{CloudServiceID: testdata.MockCloudServiceID2, MinSeverity: orchestrator.ControlSeverity_CONTROL_SEVERITY_CRITICAL},
+		),
+		WithSeverityFunc(func(result *assessment.AssessmentResult) orchestrator.ControlSeverity {
+			return orchestrator.ControlSeverity_CONTROL_SEVERITY_HIGH
+		}),
+		WithBuffering(10, 2, time.Hour),
+		WithRetries(1, time.Millisecond),
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	f.Start(ctx)
+
+	// Forwarded
+	f.ForwardAssessmentResult(ctx, &assessment.AssessmentResult{Id: "1", CloudServiceId: testdata.MockCloudServiceID1}, nil)
+	// Compliant
+	f.ForwardAssessmentResult(ctx, &assessment.AssessmentResult{Id: "2", CloudServiceId: testdata.MockCloudServiceID1, Compliant: true}, nil)
+	// Severity too low
+	f.ForwardAssessmentResult(ctx, &assessment.AssessmentResult{Id: "3", CloudServiceId: testdata.MockCloudServiceID2}, nil)
+	// Error
+	f.ForwardAssessmentResult(ctx, &assessment.AssessmentResult{Id: "4", CloudServiceId: testdata.MockCloudServiceID1}, errors.New("some error"))
+	// Forwarded, completes the first batch which fails once and is then retried
+	f.ForwardAssessmentResult(ctx, &assessment.AssessmentResult{Id: "5", CloudServiceId: testdata.MockCloudServiceID1}, nil)
+	// Forwarded, only sent on shutdown
+	f.ForwardAssessmentResult(ctx, &assessment.AssessmentResult{Id: "6", CloudServiceId: testdata.MockCloudServiceID1}, nil)
+
+	assert.Eventually(t, func() bool {
+		return len(sender.events()) == 2
+	}, time.Second, time.Millisecond)
+
+	cancel()
+	f.Wait()
+
+	assert.Equal(t, []string{"1", "5", "6"}, sender.events())
+	assert.True(t, sender.closed)
+}
+
+func TestForwarder_BufferFull(t *testing.T) {
+	sender := &mockSender{}
+
+	// The forwarder is not started, so nothing is taken out of the buffer
+	f := NewForwarder(idFormatter{}, sender, WithBuffering(1, 1, time.Hour))
+
+	f.ForwardAssessmentResult(context.Background(), &assessment.AssessmentResult{Id: "1"}, nil)
+	f.ForwardAssessmentResult(context.Background(), &assessment.AssessmentResult{Id: "2"}, nil)
+
+	assert.Equal(t, 1, len(f.queue))
+}